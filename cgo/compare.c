@@ -30,12 +30,18 @@
 #define	Type_UINT128  29
 #define	Type_FLOAT32  30
 #define	Type_FLOAT64  31
+#define	Type_BIT      34
 
 // Time
 #define Type_DATE       50
+#define Type_TIME       51
 #define Type_DATETIME   52
 #define Type_TIMESTAMP  53
 
+// Enum and Set
+#define Type_ENUM       80
+#define Type_SET        81
+
 
 /*
  * Equal operator (=)
@@ -160,11 +166,11 @@ int32_t Numeric_VecEq(void *r, void *a, void  *b, uint64_t n, uint64_t *nulls, i
         MO_COMPARE_T(COMPARE_EQ, int64_t);
     } else if (type == Type_UINT8) {
         MO_COMPARE_T(COMPARE_EQ, uint8_t);
-    } else if (type == Type_UINT16) {
+    } else if (type == Type_UINT16 || type == Type_ENUM) {
         MO_COMPARE_T(COMPARE_EQ, uint16_t);
     } else if (type == Type_UINT32) {
         MO_COMPARE_T(COMPARE_EQ, uint32_t);
-    } else if (type == Type_UINT64) {
+    } else if (type == Type_UINT64 || type == Type_SET || type == Type_BIT) {
         MO_COMPARE_T(COMPARE_EQ, uint64_t);
     } else if (type == Type_FLOAT32) {
         MO_COMPARE_T(COMPARE_EQ, float);
@@ -172,6 +178,8 @@ int32_t Numeric_VecEq(void *r, void *a, void  *b, uint64_t n, uint64_t *nulls, i
         MO_COMPARE_T(COMPARE_EQ, double);
     } else if (type == Type_DATE) {
         MO_COMPARE_T(COMPARE_EQ, int32_t);
+    } else if (type == Type_TIME) {
+        MO_COMPARE_T(COMPARE_EQ, int64_t);
     } else if (type == Type_DATETIME) {
         MO_COMPARE_T(COMPARE_EQ, int64_t);
     } else if (type == Type_TIMESTAMP) {
@@ -197,11 +205,11 @@ int32_t Numeric_VecNe(void *r, void *a, void  *b, uint64_t n, uint64_t *nulls, i
         MO_COMPARE_T(COMPARE_NE, int64_t);
     } else if (type == Type_UINT8) {
         MO_COMPARE_T(COMPARE_NE, uint8_t);
-    } else if (type == Type_UINT16) {
+    } else if (type == Type_UINT16 || type == Type_ENUM) {
         MO_COMPARE_T(COMPARE_NE, uint16_t);
     } else if (type == Type_UINT32) {
         MO_COMPARE_T(COMPARE_NE, uint32_t);
-    } else if (type == Type_UINT64) {
+    } else if (type == Type_UINT64 || type == Type_SET || type == Type_BIT) {
         MO_COMPARE_T(COMPARE_NE, uint64_t);
     } else if (type == Type_FLOAT32) {
         MO_COMPARE_T(COMPARE_NE, float);
@@ -209,6 +217,8 @@ int32_t Numeric_VecNe(void *r, void *a, void  *b, uint64_t n, uint64_t *nulls, i
         MO_COMPARE_T(COMPARE_NE, double);
     } else if (type == Type_DATE) {
         MO_COMPARE_T(COMPARE_NE, int32_t);
+    } else if (type == Type_TIME) {
+        MO_COMPARE_T(COMPARE_NE, int64_t);
     } else if (type == Type_DATETIME) {
         MO_COMPARE_T(COMPARE_NE, int64_t);
     } else if (type == Type_TIMESTAMP) {
@@ -234,11 +244,11 @@ int32_t Numeric_VecGt(void *r, void *a, void  *b, uint64_t n, uint64_t *nulls, i
         MO_COMPARE_T(COMPARE_GT, int64_t);
     } else if (type == Type_UINT8) {
         MO_COMPARE_T(COMPARE_GT, uint8_t);
-    } else if (type == Type_UINT16) {
+    } else if (type == Type_UINT16 || type == Type_ENUM) {
         MO_COMPARE_T(COMPARE_GT, uint16_t);
     } else if (type == Type_UINT32) {
         MO_COMPARE_T(COMPARE_GT, uint32_t);
-    } else if (type == Type_UINT64) {
+    } else if (type == Type_UINT64 || type == Type_SET || type == Type_BIT) {
         MO_COMPARE_T(COMPARE_GT, uint64_t);
     } else if (type == Type_FLOAT32) {
         MO_COMPARE_T(COMPARE_GT, float);
@@ -246,6 +256,8 @@ int32_t Numeric_VecGt(void *r, void *a, void  *b, uint64_t n, uint64_t *nulls, i
         MO_COMPARE_T(COMPARE_GT, double);
     } else if (type == Type_DATE) {
         MO_COMPARE_T(COMPARE_GT, int32_t);
+    } else if (type == Type_TIME) {
+        MO_COMPARE_T(COMPARE_GT, int64_t);
     } else if (type == Type_DATETIME) {
         MO_COMPARE_T(COMPARE_GT, int64_t);
     } else if (type == Type_TIMESTAMP) {
@@ -270,11 +282,11 @@ int32_t Numeric_VecGe(void *r, void *a, void  *b, uint64_t n, uint64_t *nulls, i
         MO_COMPARE_T(COMPARE_GE, int64_t);
     } else if (type == Type_UINT8) {
         MO_COMPARE_T(COMPARE_GE, uint8_t);
-    } else if (type == Type_UINT16) {
+    } else if (type == Type_UINT16 || type == Type_ENUM) {
         MO_COMPARE_T(COMPARE_GE, uint16_t);
     } else if (type == Type_UINT32) {
         MO_COMPARE_T(COMPARE_GE, uint32_t);
-    } else if (type == Type_UINT64) {
+    } else if (type == Type_UINT64 || type == Type_SET || type == Type_BIT) {
         MO_COMPARE_T(COMPARE_GE, uint64_t);
     } else if (type == Type_FLOAT32) {
         MO_COMPARE_T(COMPARE_GE, float);
@@ -282,6 +294,8 @@ int32_t Numeric_VecGe(void *r, void *a, void  *b, uint64_t n, uint64_t *nulls, i
         MO_COMPARE_T(COMPARE_GE, double);
     } else if (type == Type_DATE) {
         MO_COMPARE_T(COMPARE_GE, int32_t);
+    } else if (type == Type_TIME) {
+        MO_COMPARE_T(COMPARE_GE, int64_t);
     } else if (type == Type_DATETIME) {
         MO_COMPARE_T(COMPARE_GE, int64_t);
     } else if (type == Type_TIMESTAMP) {
@@ -308,11 +322,11 @@ int32_t Numeric_VecLt(void *r, void *a, void  *b, uint64_t n, uint64_t *nulls, i
         MO_COMPARE_T(COMPARE_LT, int64_t);
     } else if (type == Type_UINT8) {
         MO_COMPARE_T(COMPARE_LT, uint8_t);
-    } else if (type == Type_UINT16) {
+    } else if (type == Type_UINT16 || type == Type_ENUM) {
         MO_COMPARE_T(COMPARE_LT, uint16_t);
     } else if (type == Type_UINT32) {
         MO_COMPARE_T(COMPARE_LT, uint32_t);
-    } else if (type == Type_UINT64) {
+    } else if (type == Type_UINT64 || type == Type_SET || type == Type_BIT) {
         MO_COMPARE_T(COMPARE_LT, uint64_t);
     } else if (type == Type_FLOAT32) {
         MO_COMPARE_T(COMPARE_LT, float);
@@ -320,6 +334,8 @@ int32_t Numeric_VecLt(void *r, void *a, void  *b, uint64_t n, uint64_t *nulls, i
         MO_COMPARE_T(COMPARE_LT, double);
     } else if (type == Type_DATE) {
         MO_COMPARE_T(COMPARE_LT, int32_t);
+    } else if (type == Type_TIME) {
+        MO_COMPARE_T(COMPARE_LT, int64_t);
     } else if (type == Type_DATETIME) {
         MO_COMPARE_T(COMPARE_LT, int64_t);
     } else if (type == Type_TIMESTAMP) {
@@ -345,11 +361,11 @@ int32_t Numeric_VecLe(void *r, void *a, void  *b, uint64_t n, uint64_t *nulls, i
         MO_COMPARE_T(COMPARE_LE, int64_t);
     } else if (type == Type_UINT8) {
         MO_COMPARE_T(COMPARE_LE, uint8_t);
-    } else if (type == Type_UINT16) {
+    } else if (type == Type_UINT16 || type == Type_ENUM) {
         MO_COMPARE_T(COMPARE_LE, uint16_t);
     } else if (type == Type_UINT32) {
         MO_COMPARE_T(COMPARE_LE, uint32_t);
-    } else if (type == Type_UINT64) {
+    } else if (type == Type_UINT64 || type == Type_SET || type == Type_BIT) {
         MO_COMPARE_T(COMPARE_LE, uint64_t);
     } else if (type == Type_FLOAT32) {
         MO_COMPARE_T(COMPARE_LE, float);
@@ -357,6 +373,8 @@ int32_t Numeric_VecLe(void *r, void *a, void  *b, uint64_t n, uint64_t *nulls, i
         MO_COMPARE_T(COMPARE_LE, double);
     } else if (type == Type_DATE) {
         MO_COMPARE_T(COMPARE_LE, int32_t);
+    } else if (type == Type_TIME) {
+        MO_COMPARE_T(COMPARE_LE, int64_t);
     } else if (type == Type_DATETIME) {
         MO_COMPARE_T(COMPARE_LE, int64_t);
     } else if (type == Type_TIMESTAMP) {
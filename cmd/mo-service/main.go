@@ -26,9 +26,7 @@ import (
 	"syscall"
 	"time"
 
-	"github.com/matrixorigin/matrixone/pkg/cnservice/cnclient"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
-	"github.com/matrixorigin/matrixone/pkg/sql/compile"
 
 	"github.com/matrixorigin/matrixone/pkg/cnservice"
 
@@ -105,7 +103,6 @@ func startCNService(
 			&c,
 			ctx,
 			fileService,
-			cnservice.WithMessageHandle(compile.CnServerMessageHandler),
 		)
 		if err != nil {
 			panic(err)
@@ -113,11 +110,6 @@ func startCNService(
 		if err := s.Start(); err != nil {
 			panic(err)
 		}
-		err = cnclient.NewCNClient(&cnclient.ClientConfig{})
-		if err != nil {
-			panic(err)
-		}
-
 		<-ctx.Done()
 		if err := s.Close(); err != nil {
 			panic(err)
@@ -14,6 +14,7 @@ require (
 	github.com/docker/go-units v0.4.0
 	github.com/fagongzi/goetty/v2 v2.0.3-0.20220812142536-dfcb3d33cfdc
 	github.com/fagongzi/util v0.0.0-20210923134909-bccc37b5040d
+	github.com/fraugster/parquet-go v0.12.0
 	github.com/go-sql-driver/mysql v1.6.0
 	github.com/gogo/protobuf v1.3.2
 	github.com/golang/mock v1.6.0
@@ -40,6 +41,7 @@ require (
 
 require (
 	github.com/VictoriaMetrics/metrics v1.18.1 // indirect
+	github.com/apache/thrift v0.16.0 // indirect
 	github.com/armon/go-metrics v0.0.0-20180917152333-f0300d1749da // indirect
 	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.4.2 // indirect
 	github.com/aws/aws-sdk-go-v2/credentials v1.12.6 // indirect
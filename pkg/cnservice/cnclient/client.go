@@ -24,9 +24,21 @@ import (
 
 var Client *CNClient
 
+// mu protects Client and its references
+var mu sync.Mutex
+
+// GetClient returns the Client, nil if no CN service uses it.
+func GetClient() *CNClient {
+	mu.Lock()
+	defer mu.Unlock()
+	return Client
+}
+
 type CNClient struct {
 	config *ClientConfig
 	client morpc.RPCClient
+	// refs is the number of the CN services using the client
+	refs int
 
 	// pool for send message
 	requestPool *sync.Pool
@@ -40,7 +52,17 @@ func (c *CNClient) NewStream(backend string) (morpc.Stream, error) {
 	return c.client.NewStream(backend)
 }
 
+// Close releases a reference to the client, it is closed once no CN service
+// uses it.
 func (c *CNClient) Close() error {
+	mu.Lock()
+	defer mu.Unlock()
+	if c.refs--; c.refs > 0 {
+		return nil
+	}
+	if Client == c {
+		Client = nil
+	}
 	return c.client.Close()
 }
 
@@ -61,23 +83,37 @@ type ClientConfig struct {
 	WriteBufferSize       int
 }
 
-func NewCNClient(cfg *ClientConfig) error {
-	var err error
+// NewCNClient returns the Client shared by all the CN services of the process,
+// it is created by the first call. Each call takes a reference to the client,
+// which is released by Close.
+func NewCNClient(cfg *ClientConfig) (*CNClient, error) {
+	mu.Lock()
+	defer mu.Unlock()
+	if Client != nil {
+		Client.refs++
+		return Client, nil
+	}
+
 	cfg.Fill()
-	Client = &CNClient{config: cfg}
-	Client.requestPool = &sync.Pool{New: func() any { return &pipeline.Message{} }}
+	c := &CNClient{config: cfg, refs: 1}
+	c.requestPool = &sync.Pool{New: func() any { return &pipeline.Message{} }}
 
-	codec := morpc.NewMessageCodec(Client.acquireMessage, cfg.PayLoadCopyBufferSize)
+	codec := morpc.NewMessageCodec(c.acquireMessage, cfg.PayLoadCopyBufferSize)
 	factory := morpc.NewGoettyBasedBackendFactory(codec,
 		morpc.WithBackendConnectWhenCreate(),
 		morpc.WithBackendGoettyOptions(goetty.WithSessionRWBUfferSize(
 			cfg.ReadBufferSize, cfg.WriteBufferSize)),
 	)
 
-	Client.client, err = morpc.NewClient(factory,
+	var err error
+	c.client, err = morpc.NewClient(factory,
 		morpc.WithClientMaxBackendPerHost(cfg.MaxSenderNumber),
 	)
-	return err
+	if err != nil {
+		return nil, err
+	}
+	Client = c
+	return c, nil
 }
 
 func (c *CNClient) acquireMessage() morpc.Message {
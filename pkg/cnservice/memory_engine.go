@@ -47,6 +47,7 @@ func (s *service) initMemoryEngine(
 			ctx,
 			hakeeper,
 		),
+		txnengine.WithCommitOrRollbackTimeout(s.cfg.Engine.CommitOrRollbackTimeout.Duration),
 	)
 
 	return nil
@@ -19,12 +19,15 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/matrixorigin/matrixone/pkg/cnservice/cnclient"
+	"github.com/matrixorigin/matrixone/pkg/common/stopper"
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/frontend"
 	"github.com/matrixorigin/matrixone/pkg/logservice"
 	"github.com/matrixorigin/matrixone/pkg/sql/compile"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
+	"github.com/matrixorigin/matrixone/pkg/txn/clock"
 	"github.com/matrixorigin/matrixone/pkg/txn/rpc"
 	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
 	"github.com/matrixorigin/matrixone/pkg/util/metric"
@@ -54,7 +57,11 @@ func NewService(
 		cfg:         cfg,
 		fileService: fileService,
 	}
+	for _, opt := range options {
+		opt(srv)
+	}
 	srv.logger = logutil.Adjust(srv.logger)
+	srv.stopper = stopper.NewStopper("cn-service", stopper.WithLogger(srv.logger))
 	srv.responsePool = &sync.Pool{
 		New: func() any {
 			return &pipeline.Message{}
@@ -70,16 +77,32 @@ func NewService(
 
 	server, err := morpc.NewRPCServer("cn-server", cfg.ListenAddress,
 		morpc.NewMessageCodec(srv.acquireMessage, cfg.PayLoadCopyBufferSize),
+		morpc.WithServerErrorResponse(func(_ morpc.Message, err error) morpc.Message {
+			return &pipeline.Message{
+				Cmd:  pipeline.MessageEnd,
+				Code: []byte(err.Error()),
+			}
+		}),
 		morpc.WithServerGoettyOptions(goetty.WithSessionRWBUfferSize(cfg.ReadBufferSize, cfg.WriteBufferSize)))
 	if err != nil {
 		return nil, err
 	}
-	server.RegisterRequestHandler(compile.NewServer().HandleRequest)
+	// the server keeps the connectors of the pipelines pushed down to this CN
+	srv.pipelineServer = compile.NewServer()
+	if srv.requestHandler == nil {
+		srv.requestHandler = compile.NewMessageHandler(pu.StorageEngine, pu.TxnClient,
+			pu.FileService, pu.HostMmu, pu.SV.GuestMmuLimitation).HandleMessage
+	}
+	server.RegisterRequestHandler(srv.handleRequest)
 	srv.server = server
 
-	srv.requestHandler = defaultRequestHandler
-	for _, opt := range options {
-		opt(srv)
+	// the client sends the pipelines to the other CNs
+	if srv.pipelineClient, err = cnclient.NewCNClient(&cnclient.ClientConfig{
+		PayLoadCopyBufferSize: cfg.PayLoadCopyBufferSize,
+		ReadBufferSize:        cfg.ReadBufferSize,
+		WriteBufferSize:       cfg.WriteBufferSize,
+	}); err != nil {
+		return nil, err
 	}
 
 	return srv, nil
@@ -90,30 +113,47 @@ func (s *service) Start() error {
 	if err != nil {
 		return err
 	}
-	return s.server.Start()
+	if err := s.server.Start(); err != nil {
+		return err
+	}
+	if s.cfg.UUID == "" {
+		return nil
+	}
+	return s.stopper.RunTask(s.heartbeatTask)
 }
 
 func (s *service) Close() error {
+	s.stopper.Stop()
 	err := s.serverShutdown(true)
 	if err != nil {
 		return err
 	}
 	s.cancelMoServerFunc()
-	return s.server.Close()
+	if s._hakeeperClient != nil {
+		if err := s._hakeeperClient.Close(); err != nil {
+			return err
+		}
+	}
+	if s._txnSender != nil {
+		if err := s._txnSender.Close(); err != nil {
+			return err
+		}
+	}
+	if err := s.server.Close(); err != nil {
+		return err
+	}
+	s.pipelineServer.Close()
+	return s.pipelineClient.Close()
 }
 
 func (s *service) acquireMessage() morpc.Message {
 	return s.responsePool.Get().(*pipeline.Message)
 }
 
-func defaultRequestHandler(ctx context.Context, message morpc.Message, cs morpc.ClientSession) error {
-	return nil
+func (s *service) handleRequest(ctx context.Context, req morpc.Message, _ uint64, cs morpc.ClientSession) error {
+	return s.requestHandler(ctx, req, cs)
 }
 
-//func (s *service) handleRequest(ctx context.Context, req morpc.Message, _ uint64, cs morpc.ClientSession) error {
-//	return s.requestHandler(ctx, req, cs)
-//}
-
 func (s *service) initMOServer(ctx context.Context, pu *config.ParameterUnit) error {
 	var err error
 	logutil.Infof("Shutdown The Server With Ctrl+C | Ctrl+\\.")
@@ -218,6 +258,10 @@ func (s *service) getHAKeeperClient() (client logservice.CNHAKeeperClient, err e
 			s.cfg.HAKeeper.DiscoveryTimeout.Duration,
 		)
 		defer cancel()
+		if s.options.backendFilter != nil {
+			// transfer morpc.BackendOption via context
+			ctx = logservice.SetBackendOptions(ctx, morpc.WithBackendFilter(s.options.backendFilter))
+		}
 		client, err = logservice.NewCNHAKeeperClient(ctx, s.cfg.HAKeeper.ClientConfig)
 		if err != nil {
 			return
@@ -230,7 +274,10 @@ func (s *service) getHAKeeperClient() (client logservice.CNHAKeeperClient, err e
 
 func (s *service) getTxnSender() (sender rpc.TxnSender, err error) {
 	s.initTxnSenderOnce.Do(func() {
-		sender, err = rpc.NewSenderWithConfig(s.cfg.RPC, s.logger)
+		sender, err = rpc.NewSenderWithConfig(s.cfg.RPC, s.logger,
+			rpc.WithSenderBackendOptions(morpc.WithBackendFilter(func(m morpc.Message, backendAddr string) bool {
+				return s.options.backendFilter == nil || s.options.backendFilter(m, backendAddr)
+			})))
 		if err != nil {
 			return
 		}
@@ -247,7 +294,10 @@ func (s *service) getTxnClient() (c client.TxnClient, err error) {
 		if err != nil {
 			return
 		}
-		c = client.NewTxnClient(sender)
+		// the clock must be of the same precision as the dn's
+		c = client.NewTxnClient(sender,
+			client.WithClock(clock.NewUnixNanoHLCClockWithStopper(s.stopper,
+				s.cfg.Txn.Clock.MaxClockOffset.Duration)))
 		s._txnClient = c
 	})
 	c = s._txnClient
@@ -259,3 +309,10 @@ func WithMessageHandle(f func(ctx context.Context, message morpc.Message, cs mor
 		s.requestHandler = f
 	}
 }
+
+// WithBackendFilter set filtering requests sent to hakeeper and dn stores
+func WithBackendFilter(filter func(morpc.Message, string) bool) Options {
+	return func(s *service) {
+		s.options.backendFilter = filter
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cnservice
+
+import (
+	"context"
+	"time"
+
+	logservicepb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+	"go.uber.org/zap"
+)
+
+func (s *service) heartbeatTask(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.HAKeeper.HeatbeatDuration.Duration)
+	defer ticker.Stop()
+
+	s.logger.Info("CNStore heartbeat started")
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("CNStore heartbeat stopped")
+			return
+		case <-ticker.C:
+			s.heartbeat(ctx)
+		}
+	}
+}
+
+func (s *service) heartbeat(ctx context.Context) {
+	client, err := s.getHAKeeperClient()
+	if err != nil {
+		s.logger.Error("get hakeeper client failed", zap.Error(err))
+		return
+	}
+	// NB: the client is nil if it failed to be initialized before.
+	if client == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.HAKeeper.HeatbeatTimeout.Duration)
+	defer cancel()
+
+	err = client.SendCNHeartbeat(ctx, logservicepb.CNStoreHeartbeat{
+		UUID:           s.cfg.UUID,
+		ServiceAddress: s.cfg.ListenAddress,
+	})
+	if err != nil {
+		s.logger.Error("send CNStore heartbeat request failed", zap.Error(err))
+	}
+}
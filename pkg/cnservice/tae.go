@@ -42,6 +42,11 @@ func initTAE(
 	}
 	syscall.Umask(mask)
 
+	if err := restoreTAE(cancelMoServerCtx, pu, targetDir+"/tae"); err != nil {
+		logutil.Infof("Restore tae failed. error:%v", err)
+		return err
+	}
+
 	tae, err := db.Open(targetDir+"/tae", nil)
 	if err != nil {
 		logutil.Infof("Open tae failed. error:%v", err)
@@ -55,3 +60,22 @@ func initTAE(
 
 	return nil
 }
+
+// restoreTAE rebuilds the empty storage dir from the backup in restoreFrom. A
+// dir that is not empty is kept, so a restarted cn does not restore again.
+func restoreTAE(ctx context.Context, pu *config.ParameterUnit, dir string) error {
+	if pu.SV.RestoreFrom == "" {
+		return nil
+	}
+	if entries, err := os.ReadDir(dir); err == nil && len(entries) > 0 {
+		logutil.Infof("Skip restore from %s, %s is not empty", pu.SV.RestoreFrom, dir)
+		return nil
+	} else if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	manifest, err := db.ReadBackupManifest(ctx, pu.FileService, pu.SV.RestoreFrom)
+	if err != nil {
+		return err
+	}
+	return db.Restore(ctx, pu.FileService, manifest, dir)
+}
@@ -19,10 +19,13 @@ import (
 	"sync"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/cnservice/cnclient"
+	"github.com/matrixorigin/matrixone/pkg/common/stopper"
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/frontend"
 	"github.com/matrixorigin/matrixone/pkg/logservice"
+	"github.com/matrixorigin/matrixone/pkg/sql/compile"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/txn/rpc"
 	"github.com/matrixorigin/matrixone/pkg/util/toml"
@@ -46,12 +49,17 @@ const (
 
 // Config cn service
 type Config struct {
+	// UUID cn store uuid, heartbeat is sent to HAKeeper only if it was set
+	UUID string `toml:"uuid"`
 	// ListenAddress listening address for receiving external requests
 	ListenAddress string `toml:"listen-address"`
 	// FileService file service configuration
 
 	Engine struct {
 		Type EngineType `toml:"type"`
+		// CommitOrRollbackTimeout timeout of committing or rolling back a txn on
+		// the memory engine. Default is 5m
+		CommitOrRollbackTimeout toml.Duration `toml:"commit-or-rollback-timeout"`
 	}
 
 	// parameters for cn-server related buffer.
@@ -90,6 +98,15 @@ type Config struct {
 
 	// RPC rpc config used to build txn sender
 	RPC rpc.Config `toml:"rpc"`
+
+	// Txn txn config
+	Txn struct {
+		// Clock txn clock config
+		Clock struct {
+			// MaxClockOffset max clock offset between two nodes. Default is 500ms
+			MaxClockOffset toml.Duration `toml:"max-clock-offset"`
+		}
+	}
 }
 
 func (c *Config) Validate() error {
@@ -102,6 +119,12 @@ func (c *Config) Validate() error {
 	if c.HAKeeper.HeatbeatTimeout.Duration == 0 {
 		c.HAKeeper.HeatbeatTimeout.Duration = time.Millisecond * 500
 	}
+	if c.Engine.CommitOrRollbackTimeout.Duration == 0 {
+		c.Engine.CommitOrRollbackTimeout.Duration = time.Minute * 5
+	}
+	if c.Txn.Clock.MaxClockOffset.Duration == 0 {
+		c.Txn.Clock.MaxClockOffset.Duration = time.Millisecond * 500
+	}
 	return nil
 }
 
@@ -120,4 +143,13 @@ type service struct {
 	initTxnClientOnce      sync.Once
 	_txnClient             client.TxnClient
 	fileService            fileservice.FileService
+	stopper                *stopper.Stopper
+	// pipelineServer keeps the connectors of the pipelines pushed down to
+	// this CN, and pipelineClient sends the pipelines to the other CNs.
+	pipelineServer *compile.Server
+	pipelineClient *cnclient.CNClient
+
+	options struct {
+		backendFilter func(msg morpc.Message, backendAddr string) bool
+	}
 }
@@ -125,6 +125,8 @@ func (m *StrHashMap) InsertValue(val any) (bool, error) {
 		m.keys[0] = append(m.keys[0], types.EncodeFixed(v)...)
 	case types.Decimal128:
 		m.keys[0] = append(m.keys[0], types.EncodeFixed(v)...)
+	case types.Uuid:
+		m.keys[0] = append(m.keys[0], types.EncodeFixed(v)...)
 	}
 	if l := len(m.keys[0]); l < 16 {
 		m.keys[0] = append(m.keys[0], hashtable.StrKeyPadding[l:]...)
@@ -153,6 +155,22 @@ func (m *StrHashMap) Insert(vecs []*vector.Vector, row int) (bool, error) {
 	return false, nil
 }
 
+// Hash returns the hash values of the rows vecs[start, start+count) without inserting them,
+// the rows with the same keys always have the same hash value. count must not be greater than UnitLimit.
+func (m *StrHashMap) Hash(start, count int, vecs []*vector.Vector) []uint64 {
+	defer func() {
+		for i := 0; i < count; i++ {
+			m.keys[i] = m.keys[i][:0]
+		}
+	}()
+	m.encodeHashKeys(vecs, start, count)
+	hashtable.AesBytesBatchGenHashStates(&m.keys[0], &m.strHashStates[0], count)
+	for i := 0; i < count; i++ {
+		m.values[i] = m.strHashStates[i][0]
+	}
+	return m.values[:count]
+}
+
 func (m *StrHashMap) encodeHashKeys(vecs []*vector.Vector, start, count int) {
 	for _, vec := range vecs {
 		switch typLen := vec.Typ.TypeSize(); typLen {
@@ -177,14 +195,17 @@ func (m *StrHashMap) encodeHashKeys(vecs []*vector.Vector, start, count int) {
 	}
 }
 
+// fillStringGroupStr appends the strings to the keys, the strings are encoded as the sort keys of
+// their collation, so that the strings equal under the collation are in the same group
 func fillStringGroupStr(m *StrHashMap, vec *vector.Vector, n int, start int) {
 	vs := vector.GetStrColumn(vec)
+	collation := vec.Typ.Collation
 	if !vec.GetNulls().Any() {
 		for i := 0; i < n; i++ {
 			if m.hasNull {
 				m.keys[i] = append(m.keys[i], byte(0))
 			}
-			m.keys[i] = append(m.keys[i], vs.Get(int64(i+start))...)
+			m.keys[i] = collation.AppendKey(m.keys[i], vs.Get(int64(i+start)))
 		}
 	} else {
 		nsp := vec.GetNulls()
@@ -195,14 +216,14 @@ func fillStringGroupStr(m *StrHashMap, vec *vector.Vector, n int, start int) {
 					m.keys[i] = append(m.keys[i], byte(1))
 				} else {
 					m.keys[i] = append(m.keys[i], byte(0))
-					m.keys[i] = append(m.keys[i], vs.Get(int64(i+start))...)
+					m.keys[i] = collation.AppendKey(m.keys[i], vs.Get(int64(i+start)))
 				}
 			} else {
 				if hasNull {
 					m.zValues[i] = 0
 					continue
 				}
-				m.keys[i] = append(m.keys[i], vs.Get(int64(i+start))...)
+				m.keys[i] = collation.AppendKey(m.keys[i], vs.Get(int64(i+start)))
 			}
 		}
 	}
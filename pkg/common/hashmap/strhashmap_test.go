@@ -60,6 +60,26 @@ func TestInsert(t *testing.T) {
 	require.Equal(t, int64(0), m.Size())
 }
 
+func TestInsertCollation(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	m := mheap.New(gm)
+	mp, err := NewStrMap(false, 0, 0, m)
+	require.NoError(t, err)
+	vec := vector.New(types.Type{Oid: types.T_varchar, Size: 24, Collation: types.CollationGeneralCI})
+	for _, s := range []string{"abc", "ABC", "Abc ", "abd"} {
+		require.NoError(t, vec.Append([]byte(s), m))
+	}
+	for i, want := range []bool{true, false, false, true} {
+		ok, err := mp.Insert([]*vector.Vector{vec}, i)
+		require.NoError(t, err)
+		require.Equal(t, want, ok)
+	}
+	vec.Free(m)
+	mp.Free()
+	require.Equal(t, int64(0), m.Size())
+}
+
 func TestInertValue(t *testing.T) {
 	hm := host.New(1 << 30)
 	gm := guest.New(1<<30, hm)
@@ -196,6 +216,33 @@ func TestIterator(t *testing.T) {
 	}
 }
 
+func TestHash(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	m := mheap.New(gm)
+	mp, err := NewStrMap(true, 0, 0, m)
+	require.NoError(t, err)
+	ts := []types.Type{
+		types.New(types.T_int8, 0, 0, 0),
+		types.New(types.T_int64, 0, 0, 0),
+		types.New(types.T_char, 0, 0, 0),
+	}
+	vecs := newVectorsWithNull(ts, false, Rows, m)
+	hs := append([]uint64{}, mp.Hash(0, Rows, vecs)...)
+	for i := 2; i < Rows; i += 2 {
+		require.Equal(t, hs[0], hs[i])
+	}
+	for i := 1; i < Rows; i += 2 {
+		require.NotEqual(t, hs[0], hs[i])
+	}
+	require.Equal(t, hs[1:], mp.Hash(1, Rows-1, vecs))
+	for _, vec := range vecs {
+		vec.Free(m)
+	}
+	mp.Free()
+	require.Equal(t, int64(0), m.Size())
+}
+
 func newVectors(ts []types.Type, random bool, n int, m *mheap.Mheap) []*vector.Vector {
 	vecs := make([]*vector.Vector, len(ts))
 	for i := range vecs {
@@ -25,6 +25,7 @@ import (
 	"github.com/fagongzi/goetty/v2"
 	"github.com/matrixorigin/matrixone/pkg/common/stopper"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/util/fault"
 	"go.uber.org/zap"
 )
 
@@ -235,6 +236,15 @@ func (rb *remoteBackend) Send(ctx context.Context, request Message) (*Future, er
 	f := rb.newFuture()
 	f.init(request.GetID(), ctx)
 	rb.addFuture(f)
+	drop, err := fault.Inject(fault.RPCSend)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	if drop {
+		// the future will be done with ctx timeout
+		return f, nil
+	}
 	if err := rb.doSend(backendSendMessage{message: RPCMessage{Ctx: ctx, Message: request}, completed: f.unRef}); err != nil {
 		f.Close()
 		return nil, err
@@ -23,6 +23,7 @@ import (
 	"github.com/fagongzi/goetty/v2"
 	"github.com/matrixorigin/matrixone/pkg/common/stopper"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/util/fault"
 	"go.uber.org/zap"
 )
 
@@ -65,6 +66,15 @@ func WithServerBatchSendSize(size int) ServerOption {
 	}
 }
 
+// WithServerErrorResponse set the func to build the response of a request
+// which failed before it was handled, e.g. by an injected fault. The ID of the
+// response is set by the server. Without it, such requests are discarded.
+func WithServerErrorResponse(errorResponse func(request Message, err error) Message) ServerOption {
+	return func(s *server) {
+		s.options.errorResponse = errorResponse
+	}
+}
+
 type server struct {
 	name        string
 	address     string
@@ -77,6 +87,7 @@ type server struct {
 		goettyOptions []goetty.Option
 		bufferSize    int
 		batchSendSize int
+		errorResponse func(Message, error) Message
 		filter        func(Message) bool
 	}
 }
@@ -171,6 +182,22 @@ func (s *server) onMessage(rs goetty.IOSession, value any, sequence uint64) erro
 	if request.cancel != nil {
 		defer request.cancel()
 	}
+	drop, err := fault.Inject(fault.RPCReceive)
+	if err != nil {
+		// keep the session, other requests on it are not affected
+		if s.options.errorResponse == nil {
+			s.logger.Error("discard request",
+				zap.Uint64("request-id", request.Message.GetID()),
+				zap.Error(err))
+			return nil
+		}
+		response := s.options.errorResponse(request.Message, err)
+		response.SetID(request.Message.GetID())
+		return cs.Write(request.Ctx, response)
+	}
+	if drop {
+		return nil
+	}
 	if err := s.handler(request.Ctx, request.Message, sequence, cs); err != nil {
 		s.logger.Error("handle request failed",
 			zap.Uint64("sequence", sequence),
@@ -23,6 +23,7 @@ import (
 
 	"github.com/fagongzi/goetty/v2"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/util/fault"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 )
@@ -60,6 +61,39 @@ func TestHandleServer(t *testing.T) {
 	})
 }
 
+func TestHandleServerWithInjectedError(t *testing.T) {
+	fault.Enable()
+	defer fault.Disable()
+	assert.NoError(t, fault.AddFaultPoint(fault.RPCReceive, "1:1::", "error", 0, "broken"))
+
+	testRPCServer(t, func(rs *server) {
+		c := newTestClient(t)
+		defer func() {
+			assert.NoError(t, c.Close())
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+
+		rs.RegisterRequestHandler(func(_ context.Context, request Message, sequence uint64, cs ClientSession) error {
+			return cs.Write(ctx, request)
+		})
+
+		// the error is sent back, and the session is kept for the next request
+		for i, payload := range []string{"fault injection", ""} {
+			f, err := c.Send(ctx, testAddr, newTestMessage(uint64(i+1)))
+			assert.NoError(t, err)
+			resp, err := f.Get()
+			assert.NoError(t, err)
+			assert.Equal(t, uint64(i+1), resp.GetID())
+			assert.Contains(t, string(resp.(*testMessage).payload), payload)
+			f.Close()
+		}
+	}, WithServerErrorResponse(func(_ Message, err error) Message {
+		return &testMessage{payload: []byte(err.Error())}
+	}))
+}
+
 func TestHandleServerWithPayloadMessage(t *testing.T) {
 	testRPCServer(t, func(rs *server) {
 		c := newTestClient(t)
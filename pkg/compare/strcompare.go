@@ -15,8 +15,6 @@
 package compare
 
 import (
-	"bytes"
-
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -42,8 +40,10 @@ func (c *strCompare) Copy(vecSrc, vecDst int, src, dst int64, proc *process.Proc
 
 func (c *strCompare) Compare(veci, vecj int, vi, vj int64) int {
 	x, y := c.vs[veci].Col.(*types.Bytes), c.vs[vecj].Col.(*types.Bytes)
+	// the vectors of a column share the collation
+	collation := c.vs[veci].Typ.Collation
 	if c.desc {
-		return bytes.Compare(x.Get(vi), y.Get(vj)) * -1
+		return collation.Compare(x.Get(vi), y.Get(vj)) * -1
 	}
-	return bytes.Compare(x.Get(vi), y.Get(vj))
+	return collation.Compare(x.Get(vi), y.Get(vj))
 }
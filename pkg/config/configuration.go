@@ -156,6 +156,9 @@ type FrontendParameters struct {
 	//the root directory of the storage and matrixcube's data. The actual dir is cubeDirPrefix + nodeID
 	StorePath string `toml:"storePath"`
 
+	//default is ''. The dir of a backup taken by the BACKUP statement, in the file service of the cn. The storage is restored from it when the storage dir is empty.
+	RestoreFrom string `toml:"restoreFrom"`
+
 	//the length of query printed into console. -1, complete string. 0, empty string. >0 , length of characters at the header of the string.
 	LengthOfQueryPrinted int64 `toml:"lengthOfQueryPrinted"`
 
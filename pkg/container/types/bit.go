@@ -0,0 +1,64 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+// The Bit value is stored as the uint64 value of the bits, the width of the BIT(n) column is kept in Type.Width.
+// It is sent to the client and converted to the binary string as the big-endian bytes of (n+7)/8 length.
+
+const MaxBitLen = 64
+
+// CheckBit checks whether the value fits in the bits of the width
+func CheckBit(v uint64, width int32) (uint64, error) {
+	if width > 0 && width < MaxBitLen && v>>width != 0 {
+		return 0, errors.New(errno.DataException, fmt.Sprintf("Data too long, %d is out of the range of bit(%d)", v, width))
+	}
+	return v, nil
+}
+
+// EncodeBit returns the big-endian bytes of the bit value
+func EncodeBit(v uint64, width int32) []byte {
+	n := (width + 7) / 8
+	if n == 0 {
+		n = 1
+	}
+	data := make([]byte, n)
+	for i := n - 1; i >= 0; i-- {
+		data[i] = byte(v)
+		v >>= 8
+	}
+	return data
+}
+
+// DecodeBit returns the bit value of the big-endian bytes
+func DecodeBit(data []byte, width int32) (uint64, error) {
+	for len(data) > 0 && data[0] == 0 {
+		data = data[1:]
+	}
+	if len(data) > 8 {
+		return 0, errors.New(errno.DataException, fmt.Sprintf("Data too long, %d bytes is out of the range of bit(%d)", len(data), width))
+	}
+	var v uint64
+	for _, b := range data {
+		v = v<<8 | uint64(b)
+	}
+	return CheckBit(v, width)
+}
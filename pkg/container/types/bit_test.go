@@ -0,0 +1,43 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBit(t *testing.T) {
+	_, err := CheckBit(0xff, 8)
+	require.NoError(t, err)
+	_, err = CheckBit(0x100, 8)
+	require.Error(t, err)
+	_, err = CheckBit(^uint64(0), 64)
+	require.NoError(t, err)
+
+	require.Equal(t, []byte{0x01, 0x02}, EncodeBit(0x102, 10))
+	require.Equal(t, []byte{0x00}, EncodeBit(0, 1))
+
+	v, err := DecodeBit([]byte{0x00, 0x01, 0x02}, 10)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0x102), v)
+	_, err = DecodeBit([]byte{0x01, 0x02}, 8)
+	require.Error(t, err)
+	_, err = DecodeBit(make([]byte, 9), 64)
+	require.NoError(t, err)
+	_, err = DecodeBit([]byte{1, 0, 0, 0, 0, 0, 0, 0, 0}, 64)
+	require.Error(t, err)
+}
@@ -0,0 +1,234 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+// Collation decides how two strings of a char, varchar or text column are compared.
+// The zero value is the binary collation which compares the bytes, so the types made
+// without a collation keep the byte-wise behavior.
+type Collation uint8
+
+const (
+	CollationBinary Collation = iota
+	CollationGeneralCI
+	CollationUnicodeCI
+	Collation0900AICI
+	Collation0900ASCI
+)
+
+var collationNames = [...]string{
+	CollationBinary:    "utf8mb4_bin",
+	CollationGeneralCI: "utf8mb4_general_ci",
+	CollationUnicodeCI: "utf8mb4_unicode_ci",
+	Collation0900AICI:  "utf8mb4_0900_ai_ci",
+	Collation0900ASCI:  "utf8mb4_0900_as_ci",
+}
+
+// ParseCollation returns the collation of the name, the utf8 (utf8mb3) names are taken
+// as the utf8mb4 ones, and both the empty name and 'default' are the binary collation.
+func ParseCollation(name string) (Collation, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	switch name {
+	case "", "default", "binary":
+		return CollationBinary, nil
+	}
+	if strings.HasPrefix(name, "utf8_") || strings.HasPrefix(name, "utf8mb3_") {
+		name = "utf8mb4_" + name[strings.IndexByte(name, '_')+1:]
+	}
+	for i, n := range collationNames {
+		if n == name {
+			return Collation(i), nil
+		}
+	}
+	return CollationBinary, errors.New(errno.UndefinedObject, fmt.Sprintf("Unknown collation: '%s'", name))
+}
+
+// CollationNames returns the names of all the collations
+func CollationNames() []string {
+	return append([]string{}, collationNames[:]...)
+}
+
+func (c Collation) String() string {
+	if int(c) < len(collationNames) {
+		return collationNames[c]
+	}
+	return fmt.Sprintf("collation(%d)", uint8(c))
+}
+
+// IsBinary returns true if the strings are compared byte by byte
+func (c Collation) IsBinary() bool {
+	return c == CollationBinary
+}
+
+func (c Collation) caseInsensitive() bool {
+	return c != CollationBinary
+}
+
+func (c Collation) accentInsensitive() bool {
+	return c == CollationGeneralCI || c == CollationUnicodeCI || c == Collation0900AICI
+}
+
+// padSpace returns true if the trailing spaces are ignored in comparison, as the
+// PAD SPACE collations of MySQL do. The 0900 collations are NO PAD.
+func (c Collation) padSpace() bool {
+	return c == CollationGeneralCI || c == CollationUnicodeCI
+}
+
+// weight returns the weight of the first character of s and its length in bytes. The
+// bytes of invalid utf-8 are weighted after all the characters.
+func (c Collation) weight(s []byte) (rune, int) {
+	r, n := utf8.DecodeRune(s)
+	if r == utf8.RuneError && n <= 1 {
+		return unicode.MaxRune + 1 + rune(s[0]), 1
+	}
+	if c.accentInsensitive() {
+		if base, ok := accentFolding[r]; ok {
+			r = base
+		}
+	}
+	if c.caseInsensitive() {
+		r = unicode.ToUpper(r)
+	}
+	return r, n
+}
+
+// Compare returns an integer comparing the two strings under the collation
+func (c Collation) Compare(a, b []byte) int {
+	if c == CollationBinary {
+		return bytes.Compare(a, b)
+	}
+	if c.padSpace() {
+		a, b = bytes.TrimRight(a, " "), bytes.TrimRight(b, " ")
+	}
+	for len(a) > 0 && len(b) > 0 {
+		wa, na := c.weight(a)
+		wb, nb := c.weight(b)
+		if wa != wb {
+			if wa < wb {
+				return -1
+			}
+			return 1
+		}
+		a, b = a[na:], b[nb:]
+	}
+	switch {
+	case len(a) == len(b):
+		return 0
+	case len(a) == 0:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// Fold appends the string with every character replaced by its weight to dst, so that
+// the strings equal under the collation are folded to the same bytes. It keeps the
+// trailing spaces, see AppendKey.
+func (c Collation) Fold(dst, s []byte) []byte {
+	if c == CollationBinary {
+		return append(dst, s...)
+	}
+	for len(s) > 0 {
+		w, n := c.weight(s)
+		if w > unicode.MaxRune {
+			dst = append(dst, s[0])
+		} else {
+			dst = utf8.AppendRune(dst, w)
+		}
+		s = s[n:]
+	}
+	return dst
+}
+
+// AppendKey appends the sort key of the string to dst. Two strings are equal under the
+// collation if and only if their keys are equal, so the keys are used for hashing.
+func (c Collation) AppendKey(dst, s []byte) []byte {
+	if c.padSpace() {
+		s = bytes.TrimRight(s, " ")
+	}
+	return c.Fold(dst, s)
+}
+
+// MergeCollation returns the collation to compare the strings of the two collations,
+// the non binary one wins, and it is an error to mix two different non binary collations.
+func MergeCollation(c1, c2 Collation) (Collation, error) {
+	switch {
+	case c1 == c2 || c2 == CollationBinary:
+		return c1, nil
+	case c1 == CollationBinary:
+		return c2, nil
+	}
+	return c1, errors.New(errno.CollationMismatch, fmt.Sprintf("Illegal mix of collations (%s) and (%s)", c1, c2))
+}
+
+// accentFolding maps the accented latin letters to the letters without accent
+var accentFolding = func() map[rune]rune {
+	m := make(map[rune]rune)
+	for base, accented := range map[rune]string{
+		'A': "ÀÁÂÃÄÅĀĂĄ",
+		'a': "àáâãäåāăą",
+		'C': "ÇĆĈĊČ",
+		'c': "çćĉċč",
+		'D': "ĎĐ",
+		'd': "ďđ",
+		'E': "ÈÉÊËĒĔĖĘĚ",
+		'e': "èéêëēĕėęě",
+		'G': "ĜĞĠĢ",
+		'g': "ĝğġģ",
+		'H': "ĤĦ",
+		'h': "ĥħ",
+		'I': "ÌÍÎÏĨĪĬĮİ",
+		'i': "ìíîïĩīĭįı",
+		'J': "Ĵ",
+		'j': "ĵ",
+		'K': "Ķ",
+		'k': "ķ",
+		'L': "ĹĻĽĿŁ",
+		'l': "ĺļľŀł",
+		'N': "ÑŃŅŇ",
+		'n': "ñńņň",
+		'O': "ÒÓÔÕÖØŌŎŐ",
+		'o': "òóôõöøōŏő",
+		'R': "ŔŖŘ",
+		'r': "ŕŗř",
+		'S': "ŚŜŞŠ",
+		's': "śŝşš",
+		'T': "ŢŤŦ",
+		't': "ţťŧ",
+		'U': "ÙÚÛÜŨŪŬŮŰŲ",
+		'u': "ùúûüũūŭůűų",
+		'W': "Ŵ",
+		'w': "ŵ",
+		'Y': "ÝŶŸ",
+		'y': "ýÿŷ",
+		'Z': "ŹŻŽ",
+		'z': "źżž",
+	} {
+		for _, r := range accented {
+			m[r] = base
+		}
+	}
+	return m
+}()
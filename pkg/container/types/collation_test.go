@@ -0,0 +1,81 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCollation(t *testing.T) {
+	kases := []struct {
+		name string
+		want Collation
+	}{
+		{name: "", want: CollationBinary},
+		{name: "default", want: CollationBinary},
+		{name: "utf8mb4_bin", want: CollationBinary},
+		{name: "UTF8MB4_GENERAL_CI", want: CollationGeneralCI},
+		{name: "utf8_general_ci", want: CollationGeneralCI},
+		{name: "utf8mb4_unicode_ci", want: CollationUnicodeCI},
+		{name: "utf8mb4_0900_ai_ci", want: Collation0900AICI},
+		{name: "utf8mb4_0900_as_ci", want: Collation0900ASCI},
+	}
+	for _, k := range kases {
+		c, err := ParseCollation(k.name)
+		require.NoError(t, err, k.name)
+		require.Equal(t, k.want, c, k.name)
+	}
+	_, err := ParseCollation("latin1_swedish_ci")
+	require.Error(t, err)
+	require.Equal(t, "utf8mb4_general_ci", CollationGeneralCI.String())
+}
+
+func TestCollationCompare(t *testing.T) {
+	kases := []struct {
+		c    Collation
+		a, b string
+		want int
+	}{
+		{c: CollationBinary, a: "ABC", b: "abc", want: -1},
+		{c: CollationGeneralCI, a: "ABC", b: "abc", want: 0},
+		{c: CollationGeneralCI, a: "abc", b: "abd", want: -1},
+		{c: CollationGeneralCI, a: "abc  ", b: "ABC", want: 0},
+		{c: CollationGeneralCI, a: "café", b: "CAFE", want: 0},
+		{c: Collation0900AICI, a: "Crème", b: "creme", want: 0},
+		{c: Collation0900AICI, a: "abc ", b: "abc", want: 1},
+		{c: Collation0900ASCI, a: "café", b: "CAFÉ", want: 0},
+		{c: Collation0900ASCI, a: "café", b: "cafe", want: 1},
+		{c: CollationGeneralCI, a: "b", b: "A", want: 1},
+		{c: CollationGeneralCI, a: "a\xff", b: "A\xff", want: 0},
+	}
+	for _, k := range kases {
+		require.Equal(t, k.want, k.c.Compare([]byte(k.a), []byte(k.b)), "%s %q %q", k.c, k.a, k.b)
+		ka, kb := k.c.AppendKey(nil, []byte(k.a)), k.c.AppendKey(nil, []byte(k.b))
+		require.Equal(t, k.want == 0, string(ka) == string(kb), "%s %q %q", k.c, k.a, k.b)
+	}
+}
+
+func TestMergeCollation(t *testing.T) {
+	c, err := MergeCollation(CollationBinary, CollationGeneralCI)
+	require.NoError(t, err)
+	require.Equal(t, CollationGeneralCI, c)
+	c, err = MergeCollation(Collation0900AICI, CollationBinary)
+	require.NoError(t, err)
+	require.Equal(t, Collation0900AICI, c)
+	_, err = MergeCollation(CollationGeneralCI, Collation0900AICI)
+	require.Error(t, err)
+}
@@ -684,3 +684,28 @@ func (d Date) Day() uint8 {
 	_, _, day, _ := d.Calendar(true)
 	return day
 }
+
+// LastDayOfMonth returns the date of the last day of the month the date is in
+func (d Date) LastDayOfMonth() Date {
+	year, month, day, _ := d.Calendar(true)
+	return d + Date(LastDay(year, month)-day)
+}
+
+// MakeDate returns the date of the dayOfYear-th day of the year, as mysql's makedate does,
+// year 0 to 69 means 2000 to 2069 and year 70 to 99 means 1970 to 1999.
+// It returns false if the dayOfYear is not positive or the date is out of range.
+func MakeDate(year, dayOfYear int64) (Date, bool) {
+	if year < 0 || year > MaxDateYear || dayOfYear <= 0 {
+		return 0, false
+	}
+	if year < 70 {
+		year += 2000
+	} else if year < 100 {
+		year += 1900
+	}
+	first := FromCalendar(int32(year), 1, 1)
+	if dayOfYear-1 > int64(FromCalendar(MaxDateYear, 12, 31)-first) {
+		return 0, false
+	}
+	return first + Date(dayOfYear-1), true
+}
@@ -121,3 +121,36 @@ func TestParseDate(t *testing.T) {
 		})
 	}
 }
+
+func TestLastDayAndMakeDate(t *testing.T) {
+	for s, want := range map[string]string{"2003-02-05": "2003-02-28", "2004-02-05": "2004-02-29", "2004-12-31": "2004-12-31"} {
+		d, err := ParseDate(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := d.LastDayOfMonth().String(); got != want {
+			t.Errorf("last day of %s: got %s, want %s", s, got, want)
+		}
+	}
+
+	cases := []struct {
+		year, yday int64
+		want       string
+	}{
+		{2011, 31, "2011-01-31"},
+		{2011, 32, "2011-02-01"},
+		{2011, 365, "2011-12-31"},
+		{2011, 366, "2012-01-01"},
+		{11, 1, "2011-01-01"},
+		{70, 1, "1970-01-01"},
+		{2011, 0, ""},
+		{9999, 366, ""},
+		{10000, 1, ""},
+	}
+	for _, c := range cases {
+		d, ok := MakeDate(c.year, c.yday)
+		if ok != (c.want != "") || ok && d.String() != c.want {
+			t.Errorf("makedate(%d, %d): got %s %v, want %s", c.year, c.yday, d, ok, c.want)
+		}
+	}
+}
@@ -253,6 +253,56 @@ func (dt Datetime) ConvertToGoTime(loc *time.Location) time.Time {
 	return time.Date(int(year), time.Month(mon), int(day), int(hour), int(minute), int(sec), int(nsec), loc)
 }
 
+// ConvertTimeZone converts the datetime in the time zone from to the same moment in the time zone to
+func (dt Datetime) ConvertTimeZone(from, to *time.Location) Datetime {
+	t := dt.ConvertToGoTime(from).In(to)
+	_, offset := t.Zone()
+	return Datetime(t.UnixMicro() + int64(offset)*microSecsPerSec + unixEpoch)
+}
+
+// TimestampDiff returns end - begin in the unit its truncated toward zero, as mysql's timestampdiff does.
+// It returns false if its is not a single unit such as DAY_HOUR.
+func TimestampDiff(its IntervalType, begin, end Datetime) (int64, bool) {
+	diff := int64(end - begin)
+	switch its {
+	case MicroSecond:
+		return diff, true
+	case Second:
+		return diff / microSecsPerSec, true
+	case Minute:
+		return diff / (microSecsPerSec * secsPerMinute), true
+	case Hour:
+		return diff / (microSecsPerSec * secsPerHour), true
+	case Day:
+		return diff / (microSecsPerSec * secsPerDay), true
+	case Week:
+		return diff / (microSecsPerSec * secsPerWeek), true
+	case Month:
+		return monthsBetween(begin, end), true
+	case Quarter:
+		return monthsBetween(begin, end) / 3, true
+	case Year:
+		return monthsBetween(begin, end) / 12, true
+	}
+	return 0, false
+}
+
+// monthsBetween returns the number of whole months from begin to end
+func monthsBetween(begin, end Datetime) int64 {
+	y1, m1, d1, _ := begin.ToDate().Calendar(true)
+	y2, m2, d2, _ := end.ToDate().Calendar(true)
+	months := int64(y2-y1)*12 + int64(m2) - int64(m1)
+	// the offsets of begin and end from the start of their months decide whether the last month is whole
+	offset1 := int64(d1)*secsPerDay*microSecsPerSec + int64(begin)%(secsPerDay*microSecsPerSec)
+	offset2 := int64(d2)*secsPerDay*microSecsPerSec + int64(end)%(secsPerDay*microSecsPerSec)
+	if months > 0 && offset2 < offset1 {
+		months--
+	} else if months < 0 && offset2 > offset1 {
+		months++
+	}
+	return months
+}
+
 func (dt Datetime) AddDateTime(addMonth, addYear int64, timeType TimeType) (Datetime, bool) {
 	// corner case: mysql: date_add('2022-01-31',interval 1 month) -> 2022-02-28
 	// only in the month year year-month
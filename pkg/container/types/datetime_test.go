@@ -349,3 +349,87 @@ func TestDatetime_DayOfYear(t *testing.T) {
 		})
 	}
 }
+
+func TestTimestampDiff(t *testing.T) {
+	parse := func(s string) Datetime {
+		dt, err := ParseDatetime(s, 6)
+		require.NoError(t, err)
+		return dt
+	}
+	cases := []struct {
+		its        IntervalType
+		begin, end string
+		want       int64
+	}{
+		{MicroSecond, "2022-01-01 00:00:00", "2022-01-01 00:00:01.5", 1500000},
+		{Second, "2022-01-01 00:00:00", "2021-12-31 23:59:58.5", -1},
+		{Minute, "2022-01-01 00:00:00", "2022-01-01 01:30:00", 90},
+		{Day, "2022-01-01 12:00:00", "2022-01-03 11:59:59", 1},
+		{Week, "2022-01-01", "2022-01-15", 2},
+		{Month, "2003-02-01", "2003-05-01", 3},
+		{Month, "2020-01-31", "2020-02-29", 0},
+		{Month, "2020-03-31 10:00:00", "2020-01-31 10:00:01", -1},
+		{Quarter, "2020-01-01", "2020-12-31", 3},
+		{Year, "2002-05-01", "2001-01-01", -1},
+	}
+	for _, c := range cases {
+		got, ok := TimestampDiff(c.its, parse(c.begin), parse(c.end))
+		require.True(t, ok)
+		require.Equal(t, c.want, got, "%s %s %s", c.its, c.begin, c.end)
+	}
+	_, ok := TimestampDiff(Day_Hour, 0, 0)
+	require.False(t, ok)
+}
+
+func TestConvertTimeZone(t *testing.T) {
+	dt, err := ParseDatetime("2004-01-01 12:00:00", 0)
+	require.NoError(t, err)
+	from, err := ParseTimeZone("+00:00")
+	require.NoError(t, err)
+	to, err := ParseTimeZone("+10:00")
+	require.NoError(t, err)
+	require.Equal(t, "2004-01-01 22:00:00", dt.ConvertTimeZone(from, to).String())
+
+	to, err = ParseTimeZone("-05:30")
+	require.NoError(t, err)
+	require.Equal(t, "2004-01-01 06:30:00", dt.ConvertTimeZone(from, to).String())
+
+	for _, s := range []string{"", "+14:01", "-14:00", "+8:00", "+0a:00", "No/Such_Zone"} {
+		_, err = ParseTimeZone(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestParseDatetimeByFormat(t *testing.T) {
+	cases := []struct {
+		s, format string
+		want      string
+	}{
+		{"01,5,2013", "%d,%m,%Y", "2013-05-01 00:00:00"},
+		{"May 1, 2013", "%M %d,%Y", "2013-05-01 00:00:00"},
+		{"a09:30:17", "a%h:%i:%s", ""},
+		{"2009-10-18 09:30:17 pm", "%Y-%m-%d %h:%i:%s %p", "2009-10-18 21:30:17"},
+		{"12:00:00 AM 2020-02-29", "%r %Y-%m-%d", "2020-02-29 00:00:00"},
+		{"20-060", "%y-%j", "2020-02-29 00:00:00"},
+		{"Thu, 3rd Mar 2022 10:20:30.25", "%a, %D %b %Y %T.%f", "2022-03-03 10:20:30"},
+		{"2022-02-30", "%Y-%m-%d", ""},
+		{"2022-02-01 25:00:00", "%Y-%m-%d %H:%i:%s", ""},
+		{"2022-02-01 trailing", "%Y-%m-%d", "2022-02-01 00:00:00"},
+		{"100%", "%Y%%", ""},
+		{"2022/02/01", "%Y-%m-%d", ""},
+	}
+	for _, c := range cases {
+		dt, ok := ParseDatetimeByFormat(c.s, c.format)
+		if c.want == "" {
+			require.False(t, ok, c.s)
+			continue
+		}
+		require.True(t, ok, c.s)
+		require.Equal(t, c.want, dt.String(), c.s)
+	}
+	dt, ok := ParseDatetimeByFormat("10:20:30.25", "%H:%i:%s.%f")
+	require.False(t, ok)
+	dt, ok = ParseDatetimeByFormat("2022-01-01 10:20:30.25", "%Y-%m-%d %H:%i:%s.%f")
+	require.True(t, ok)
+	require.Equal(t, int64(250000), dt.MicroSec())
+}
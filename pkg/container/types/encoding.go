@@ -29,18 +29,22 @@ import (
 
 var TSize int
 var DateSize int
+var TimeSize int
 var DatetimeSize int
 var TimestampSize int
 var Decimal64Size int
 var Decimal128Size int
+var UuidSize int
 
 func init() {
 	TSize = int(unsafe.Sizeof(Type{}))
 	DateSize = int(unsafe.Sizeof(Date(0)))
+	TimeSize = int(unsafe.Sizeof(Time(0)))
 	DatetimeSize = int(unsafe.Sizeof(Datetime(0)))
 	TimestampSize = int(unsafe.Sizeof(Timestamp(0)))
 	Decimal64Size = int(unsafe.Sizeof(Decimal64{}))
 	Decimal128Size = int(unsafe.Sizeof(Decimal128{}))
+	UuidSize = int(unsafe.Sizeof(Uuid{}))
 }
 
 func EncodeSlice[T any](v []T, sz int) (ret []byte) {
@@ -88,7 +92,12 @@ func EncodeType(v Type) []byte {
 }
 
 func DecodeType(v []byte) Type {
-	return *(*Type)(unsafe.Pointer(&v[0]))
+	typ := *(*Type)(unsafe.Pointer(&v[0]))
+	if int(typ.Collation) >= len(collationNames) {
+		// the padding of a type encoded before the collation is not a collation
+		typ.Collation = CollationBinary
+	}
+	return typ
 }
 
 func EncodeFixed[T any](v T) []byte {
@@ -195,6 +204,14 @@ func DecodeDate(v []byte) Date {
 	return *(*Date)(unsafe.Pointer(&v[0]))
 }
 
+func EncodeTime(v Time) []byte {
+	return unsafe.Slice((*byte)(unsafe.Pointer(&v)), 8)
+}
+
+func DecodeTime(v []byte) Time {
+	return *(*Time)(unsafe.Pointer(&v[0]))
+}
+
 func EncodeDatetime(v Datetime) []byte {
 	return unsafe.Slice((*byte)(unsafe.Pointer(&v)), 8)
 }
@@ -351,6 +368,14 @@ func DecodeDateSlice(v []byte) []Date {
 	return DecodeFixedSlice[Date](v, DateSize)
 }
 
+func EncodeTimeSlice(v []Time) []byte {
+	return EncodeFixedSlice(v, TimeSize)
+}
+
+func DecodeTimeSlice(v []byte) (ret []Time) {
+	return DecodeFixedSlice[Time](v, TimeSize)
+}
+
 func EncodeDatetimeSlice(v []Datetime) []byte {
 	return EncodeFixedSlice(v, DatetimeSize)
 }
@@ -383,6 +408,14 @@ func DecodeDecimal128Slice(v []byte) (ret []Decimal128) {
 	return DecodeFixedSlice[Decimal128](v, Decimal128Size)
 }
 
+func EncodeUuidSlice(v []Uuid) []byte {
+	return EncodeFixedSlice(v, UuidSize)
+}
+
+func DecodeUuidSlice(v []byte) (ret []Uuid) {
+	return DecodeFixedSlice[Uuid](v, UuidSize)
+}
+
 func EncodeStringSlice(vs []string) []byte {
 	var o int32
 	var buf bytes.Buffer
@@ -441,11 +474,11 @@ func DecodeValue(val []byte, typ Type) any {
 		return DecodeFixed[int64](val)
 	case T_uint8:
 		return DecodeFixed[uint8](val)
-	case T_uint16:
+	case T_uint16, T_enum:
 		return DecodeFixed[uint16](val)
 	case T_uint32:
 		return DecodeFixed[uint32](val)
-	case T_uint64:
+	case T_uint64, T_set, T_bit:
 		return DecodeFixed[uint64](val)
 	case T_float32:
 		return DecodeFixed[float32](val)
@@ -453,6 +486,8 @@ func DecodeValue(val []byte, typ Type) any {
 		return DecodeFixed[float64](val)
 	case T_date:
 		return DecodeFixed[Date](val)
+	case T_time:
+		return DecodeFixed[Time](val)
 	case T_datetime:
 		return DecodeFixed[Datetime](val)
 	case T_timestamp:
@@ -461,7 +496,9 @@ func DecodeValue(val []byte, typ Type) any {
 		return DecodeFixed[Decimal64](val)
 	case T_decimal128:
 		return DecodeFixed[Decimal128](val)
-	case T_char, T_varchar:
+	case T_uuid:
+		return DecodeFixed[Uuid](val)
+	case T_char, T_varchar, T_binary, T_varbinary:
 		return val
 	default:
 		panic("unsupported type")
@@ -482,16 +519,18 @@ func EncodeValue(val any, typ Type) []byte {
 		return EncodeFixed(val.(int64))
 	case T_uint8:
 		return EncodeFixed(val.(uint8))
-	case T_uint16:
+	case T_uint16, T_enum:
 		return EncodeFixed(val.(uint16))
 	case T_uint32:
 		return EncodeFixed(val.(uint32))
-	case T_uint64:
+	case T_uint64, T_set, T_bit:
 		return EncodeFixed(val.(uint64))
 	case T_decimal64:
 		return EncodeFixed(val.(Decimal64))
 	case T_decimal128:
 		return EncodeFixed(val.(Decimal128))
+	case T_uuid:
+		return EncodeFixed(val.(Uuid))
 	case T_float32:
 		return EncodeFixed(val.(float32))
 	case T_float64:
@@ -500,9 +539,11 @@ func EncodeValue(val any, typ Type) []byte {
 		return EncodeFixed(val.(Date))
 	case T_timestamp:
 		return EncodeFixed(val.(Timestamp))
+	case T_time:
+		return EncodeFixed(val.(Time))
 	case T_datetime:
 		return EncodeFixed(val.(Datetime))
-	case T_char, T_varchar:
+	case T_char, T_varchar, T_binary, T_varbinary:
 		return val.([]byte)
 	default:
 		panic("unsupported type")
@@ -582,6 +623,11 @@ func WriteValues(w io.Writer, vals ...any) (n int64, err error) {
 				return
 			}
 			n += int64(nr)
+		case Time:
+			if nr, err = w.Write(EncodeFixed(v)); err != nil {
+				return
+			}
+			n += int64(nr)
 		case Datetime:
 			if nr, err = w.Write(EncodeFixed(v)); err != nil {
 				return
@@ -602,6 +648,11 @@ func WriteValues(w io.Writer, vals ...any) (n int64, err error) {
 				return
 			}
 			n += int64(nr)
+		case Uuid:
+			if nr, err = w.Write(EncodeFixed(v)); err != nil {
+				return
+			}
+			n += int64(nr)
 		default:
 			panic(fmt.Errorf("%T:%v not supported", v, v))
 		}
@@ -33,6 +33,28 @@ func TestEncodeType(t *testing.T) {
 	}
 }
 
+func TestEncodeTypeLayout(t *testing.T) {
+	// the encoded types are stored, the collation must not change their size
+	if TSize != 20 {
+		t.Fatalf("the size of the encoded type is %d", TSize)
+	}
+	typ := T_varchar.ToType()
+	typ.Collation = Collation0900AICI
+	data := EncodeType(typ)
+	if DecodeType(data) != typ {
+		t.Fatalf("Type Encoding Error\n")
+	}
+	// the type encoded before the collation is binary
+	data[1] = 0
+	if DecodeType(data).Collation != CollationBinary {
+		t.Fatalf("Type Encoding Error\n")
+	}
+	data[1] = 0xff
+	if DecodeType(data).Collation != CollationBinary {
+		t.Fatalf("Type Encoding Error\n")
+	}
+}
+
 func TestEncodeInt8(t *testing.T) {
 	nums := []int8{math.MinInt8, math.MaxInt8, 0}
 	for _, num := range nums {
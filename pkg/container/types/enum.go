@@ -0,0 +1,152 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+// The Enum value is stored as the uint16 ordinal of the value in the value list, starting from 1,
+// and the 0 is the special empty value. The Set value is stored as the uint64 bitmap of the members,
+// the bit i is set if the i-th value of the list is a member. The value list is kept in the column
+// definition rather than the Type, so the functions below take it as an argument.
+
+const (
+	MaxEnumLen = 65535
+	MaxSetLen  = 64
+)
+
+// CheckEnumValues checks the value list of an enum or a set column definition
+func CheckEnumValues(values []string, isSet bool) error {
+	if isSet && len(values) > MaxSetLen {
+		return errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("Too many strings for column SET, the max is %d", MaxSetLen))
+	}
+	if len(values) > MaxEnumLen {
+		return errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("Too many strings for column ENUM, the max is %d", MaxEnumLen))
+	}
+	for i, v := range values {
+		if isSet && strings.Contains(v, ",") {
+			return errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("Illegal set '%s' value found during parsing", v))
+		}
+		for _, w := range values[:i] {
+			if strings.EqualFold(v, w) {
+				return errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("Column has duplicated value '%s' in ENUM/SET", v))
+			}
+		}
+	}
+	return nil
+}
+
+// EncodeEnumValues encodes the value list to be the string argument of the enum and set functions
+func EncodeEnumValues(values []string) string {
+	data, _ := json.Marshal(values)
+	return string(data)
+}
+
+// DecodeEnumValues decodes the value list encoded by EncodeEnumValues
+func DecodeEnumValues(s string) ([]string, error) {
+	var values []string
+	if err := json.Unmarshal([]byte(s), &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// ParseEnum returns the ordinal of the string in the enum values, the match is case-insensitive
+// and a numeric string is taken as the ordinal itself
+func ParseEnum(values []string, s string) (uint16, error) {
+	s = strings.TrimRight(s, " ")
+	for i, v := range values {
+		if strings.EqualFold(v, s) {
+			return uint16(i + 1), nil
+		}
+	}
+	if num, err := strconv.ParseUint(s, 10, 64); err == nil {
+		return ParseEnumIndex(values, num)
+	}
+	return 0, errors.New(errno.DataException, fmt.Sprintf("Data truncated, '%s' is not a value of the enum", s))
+}
+
+// ParseEnumIndex checks the ordinal of the enum values
+func ParseEnumIndex(values []string, idx uint64) (uint16, error) {
+	if idx == 0 || idx > uint64(len(values)) {
+		return 0, errors.New(errno.DataException, fmt.Sprintf("Data truncated, %d is out of the range of the enum", idx))
+	}
+	return uint16(idx), nil
+}
+
+// EnumString returns the value of the ordinal, the 0 and the invalid ordinal is the empty string
+func EnumString(values []string, v uint16) string {
+	if v == 0 || int(v) > len(values) {
+		return ""
+	}
+	return values[v-1]
+}
+
+// ParseSet returns the bitmap of the comma separated members in the set values,
+// the match is case-insensitive and a numeric string is taken as the bitmap itself
+func ParseSet(values []string, s string) (uint64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	var bm uint64
+	for _, m := range strings.Split(s, ",") {
+		m = strings.TrimRight(m, " ")
+		found := false
+		for i, v := range values {
+			if strings.EqualFold(v, m) {
+				bm |= 1 << i
+				found = true
+				break
+			}
+		}
+		if !found {
+			if num, err := strconv.ParseUint(s, 10, 64); err == nil {
+				return ParseSetBitmap(values, num)
+			}
+			return 0, errors.New(errno.DataException, fmt.Sprintf("Data truncated, '%s' is not a value of the set", m))
+		}
+	}
+	return bm, nil
+}
+
+// ParseSetBitmap checks the bitmap of the set values
+func ParseSetBitmap(values []string, bm uint64) (uint64, error) {
+	if len(values) < MaxSetLen && bm>>len(values) != 0 {
+		return 0, errors.New(errno.DataException, fmt.Sprintf("Data truncated, %d is out of the range of the set", bm))
+	}
+	return bm, nil
+}
+
+// SetString returns the comma separated members of the bitmap in the order of the set values
+func SetString(values []string, bm uint64) string {
+	var buf strings.Builder
+	for i, v := range values {
+		if bm&(1<<i) == 0 {
+			continue
+		}
+		if buf.Len() > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(v)
+	}
+	return buf.String()
+}
@@ -0,0 +1,89 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckEnumValues(t *testing.T) {
+	require.NoError(t, CheckEnumValues([]string{"a", "b", "c"}, false))
+	require.NoError(t, CheckEnumValues([]string{"a,b", "c"}, false))
+	require.Error(t, CheckEnumValues([]string{"a,b", "c"}, true))
+	require.Error(t, CheckEnumValues([]string{"a", "B", "b"}, false))
+
+	values := make([]string, MaxSetLen+1)
+	for i := range values {
+		values[i] = string(rune('a'+i/26)) + string(rune('a'+i%26))
+	}
+	require.NoError(t, CheckEnumValues(values, false))
+	require.Error(t, CheckEnumValues(values, true))
+
+	s, err := DecodeEnumValues(EncodeEnumValues(values))
+	require.NoError(t, err)
+	require.Equal(t, values, s)
+}
+
+func TestParseEnum(t *testing.T) {
+	values := []string{"small", "medium", "large"}
+	kases := []struct {
+		s    string
+		want uint16
+	}{
+		{s: "small", want: 1},
+		{s: "LARGE", want: 3},
+		{s: "medium  ", want: 2},
+		{s: "2", want: 2},
+	}
+	for _, k := range kases {
+		v, err := ParseEnum(values, k.s)
+		require.NoError(t, err, k.s)
+		require.Equal(t, k.want, v, k.s)
+	}
+	for _, s := range []string{"", "tiny", "0", "4", " small"} {
+		_, err := ParseEnum(values, s)
+		require.Error(t, err, s)
+	}
+	require.Equal(t, "medium", EnumString(values, 2))
+	require.Equal(t, "", EnumString(values, 0))
+	require.Equal(t, "", EnumString(values, 4))
+}
+
+func TestParseSet(t *testing.T) {
+	values := []string{"a", "b", "c", "d"}
+	kases := []struct {
+		s    string
+		want uint64
+		str  string
+	}{
+		{s: "", want: 0, str: ""},
+		{s: "a", want: 1, str: "a"},
+		{s: "d,B", want: 10, str: "b,d"},
+		{s: "c,a,c", want: 5, str: "a,c"},
+		{s: "15", want: 15, str: "a,b,c,d"},
+	}
+	for _, k := range kases {
+		v, err := ParseSet(values, k.s)
+		require.NoError(t, err, k.s)
+		require.Equal(t, k.want, v, k.s)
+		require.Equal(t, k.str, SetString(values, v), k.s)
+	}
+	for _, s := range []string{"e", "a,e", "16", "a,,b"} {
+		_, err := ParseSet(values, s)
+		require.Error(t, err, s)
+	}
+}
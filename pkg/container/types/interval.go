@@ -107,9 +107,11 @@ func (it IntervalType) String() string {
 	return "INVALID_INTERVAL_TYPE"
 }
 
+// IntervalTypeOf returns the interval type of the unit, the SQL_TSI_ prefix of the unit is ignored
 func IntervalTypeOf(s string) (IntervalType, error) {
+	s = strings.TrimPrefix(strings.ToUpper(s), "SQL_TSI_")
 	for i := 1; i < int(IntervalTypeMax); i++ {
-		if IntervalType(i).String() == strings.ToUpper(s) {
+		if IntervalType(i).String() == s {
 			return IntervalType(i), nil
 		}
 	}
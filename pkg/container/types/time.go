@@ -0,0 +1,243 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+// The Time type holds the signed number of microseconds of a time of day or an elapsed time,
+// it is in the range of [-838:59:59.999999, 838:59:59.999999] like the TIME type of mysql.
+
+const (
+	MaxTimeHour = 838
+	MaxTime     = Time((MaxTimeHour*secsPerHour+59*secsPerMinute+59)*microSecsPerSec + 999999)
+	MinTime     = -MaxTime
+)
+
+var (
+	ErrIncorrectTimeValue = errors.New(errno.DataException, "Incorrect time value")
+	ErrTimeOutOfRange     = errors.New(errno.DataException, "Time value is out of range")
+)
+
+func (t Time) String() string {
+	return t.String2(0)
+}
+
+// String2 formats the time as [-]hh:mm:ss with precision digits of the fractional seconds
+func (t Time) String2(precision int32) string {
+	neg, hour, minute, sec, msec := t.Clock()
+	sign := ""
+	if neg {
+		sign = "-"
+	}
+	if precision > 0 {
+		msecInStr := fmt.Sprintf("%06d", msec)[:precision]
+		return fmt.Sprintf("%s%02d:%02d:%02d.%s", sign, hour, minute, sec, msecInStr)
+	}
+	return fmt.Sprintf("%s%02d:%02d:%02d", sign, hour, minute, sec)
+}
+
+// Clock returns the sign, hours, minutes, seconds and microseconds of the time
+func (t Time) Clock() (neg bool, hour int64, minute, sec int8, msec int64) {
+	v := int64(t)
+	if v < 0 {
+		neg, v = true, -v
+	}
+	msec = v % microSecsPerSec
+	secs := v / microSecsPerSec
+	hour = secs / secsPerHour
+	minute = int8(secs % secsPerHour / secsPerMinute)
+	sec = int8(secs % secsPerMinute)
+	return
+}
+
+// TimeFromClock returns the time of the clock, it returns an error if the time is out of range
+func TimeFromClock(neg bool, hour uint64, minute, sec uint8, msec uint32) (Time, error) {
+	if minute > maxMinuteInHour || sec > maxSecondInMinute+1 || msec >= microSecsPerSec {
+		return 0, ErrIncorrectTimeValue
+	}
+	if hour > MaxTimeHour {
+		return 0, ErrTimeOutOfRange
+	}
+	v := int64((hour*secsPerHour+uint64(minute)*secsPerMinute+uint64(sec))*microSecsPerSec + uint64(msec))
+	if v > int64(MaxTime) {
+		return 0, ErrTimeOutOfRange
+	}
+	if neg {
+		v = -v
+	}
+	return Time(v), nil
+}
+
+// TruncateTime returns the time of the microseconds, it is truncated to the range of the Time like mysql
+func TruncateTime(v int64) Time {
+	if v > int64(MaxTime) {
+		return MaxTime
+	}
+	if v < int64(MinTime) {
+		return MinTime
+	}
+	return Time(v)
+}
+
+// ParseTime will parse a string to be a Time
+// Support Format:
+// 1. [-][d ]hh:mm:ss(.msec), [-][d ]hh:mm and [-]d hh
+// 2. [-]hhmmss(.msec), [-]mmss(.msec) and [-]ss(.msec)
+// 3. all the Datetime value, the time of day is used
+// during parsing, the Time value will be rounded(away from zero) to the predefined precision like the Datetime
+func ParseTime(s string, precision int32) (Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, ErrIncorrectTimeValue
+	}
+	if len(s) >= 14 && !strings.HasPrefix(s, "-") {
+		if dt, err := ParseDatetime(s, precision); err == nil {
+			return dt.ToTime(precision), nil
+		}
+	}
+	neg := false
+	if s[0] == '-' {
+		neg, s = true, s[1:]
+	}
+	frac := ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		s, frac = s[:i], s[i+1:]
+	}
+	var msec, carry uint32
+	if frac != "" {
+		var err error
+		if msec, carry, err = getMsec(frac, precision); err != nil {
+			return 0, ErrIncorrectTimeValue
+		}
+	}
+
+	var day, hour uint64
+	var minute, sec uint64
+	var err error
+	if i := strings.IndexByte(s, ' '); i >= 0 {
+		if day, err = strconv.ParseUint(s[:i], 10, 32); err != nil {
+			return 0, ErrIncorrectTimeValue
+		}
+		s = strings.TrimSpace(s[i+1:])
+		if !strings.Contains(s, ":") {
+			if frac != "" {
+				return 0, ErrIncorrectTimeValue
+			}
+			if hour, err = strconv.ParseUint(s, 10, 32); err != nil {
+				return 0, ErrIncorrectTimeValue
+			}
+			return TimeFromClock(neg, day*24+hour, 0, 0, 0)
+		}
+	}
+	if strings.Contains(s, ":") {
+		parts := strings.Split(s, ":")
+		if len(parts) > 3 || (len(parts) == 2 && frac != "") {
+			return 0, ErrIncorrectTimeValue
+		}
+		nums := make([]uint64, 3)
+		for i, part := range parts {
+			if nums[i], err = strconv.ParseUint(part, 10, 32); err != nil {
+				return 0, ErrIncorrectTimeValue
+			}
+		}
+		hour, minute, sec = nums[0], nums[1], nums[2]
+	} else {
+		if day != 0 {
+			return 0, ErrIncorrectTimeValue
+		}
+		num, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return 0, ErrIncorrectTimeValue
+		}
+		hour, minute, sec = num/10000, num/100%100, num%100
+	}
+	if minute > maxMinuteInHour || sec > maxSecondInMinute {
+		return 0, ErrIncorrectTimeValue
+	}
+	return TimeFromClock(neg, day*24+hour, uint8(minute), uint8(sec)+uint8(carry), msec)
+}
+
+// ParseInt64ToTime parses the integer of the format [-]hhmmss to be a Time
+func ParseInt64ToTime(v int64) (Time, error) {
+	neg := v < 0
+	if neg {
+		v = -v
+	}
+	minute, sec := uint8(v/100%100), uint8(v%100)
+	if minute > maxMinuteInHour || sec > maxSecondInMinute {
+		return 0, ErrIncorrectTimeValue
+	}
+	return TimeFromClock(neg, uint64(v/10000), minute, sec, 0)
+}
+
+// ToInt64 returns the integer of the format [-]hhmmss, the fractional seconds are rounded
+func (t Time) ToInt64() int64 {
+	neg, hour, minute, sec, _ := t.Round(0).Clock()
+	v := hour*10000 + int64(minute)*100 + int64(sec)
+	if neg {
+		return -v
+	}
+	return v
+}
+
+func (t Time) abs() int64 {
+	if t < 0 {
+		return -int64(t)
+	}
+	return int64(t)
+}
+
+// Round rounds the fractional seconds of the time to precision digits away from zero
+func (t Time) Round(precision int32) Time {
+	if precision >= 6 {
+		return t
+	}
+	scale := int64(scaleTable[precision])
+	v := t.abs()
+	if r := v % scale; r >= scale/2 {
+		v += scale - r
+	} else {
+		v -= r
+	}
+	if v > int64(MaxTime) {
+		v = int64(MaxTime) - int64(MaxTime)%scale
+	}
+	if t < 0 {
+		return Time(-v)
+	}
+	return Time(v)
+}
+
+// Seconds returns the number of the whole seconds of the time
+func (t Time) Seconds() int64 {
+	return int64(t) / microSecsPerSec
+}
+
+// ToDatetime returns the datetime of the time on the date
+func (t Time) ToDatetime(d Date) Datetime {
+	return Datetime(int64(d.ToDatetime()) + int64(t))
+}
+
+// ToTime returns the time of day of the datetime rounded to precision digits
+func (dt Datetime) ToTime(precision int32) Time {
+	return Time(int64(dt) % (secsPerDay * microSecsPerSec)).Round(precision)
+}
@@ -14,6 +14,8 @@
 
 package types
 
+import "strings"
+
 // full name for day of week
 var longDayNames = []string{
 	"Sunday",
@@ -25,7 +27,6 @@ var longDayNames = []string{
 	"Saturday",
 }
 
-/*
 // Short name for day of week
 var shortDayNames = []string{
 	"Sun",
@@ -68,4 +69,193 @@ var longMonthNames = []string{
 	"November",
 	"December",
 }
-*/
+
+// ParseDatetimeByFormat parses the string in the format of mysql's str_to_date, for example
+// '%Y-%m-%d %H:%i:%s'. It returns false if the string does not match the format or the result
+// is not a valid datetime, the month and the day can not be zero. The characters after the
+// matched part of the string are ignored.
+func ParseDatetimeByFormat(s, format string) (Datetime, bool) {
+	p := &datetimeParser{s: s}
+	if !p.parse(format) {
+		return 0, false
+	}
+	hour := p.hour
+	if p.hour12 {
+		if hour < 1 || hour > 12 {
+			return 0, false
+		}
+		hour %= 12
+		if p.pm {
+			hour += 12
+		}
+	} else if p.pmSet {
+		return 0, false
+	}
+	if p.yday > 0 {
+		if p.month != 0 || p.day != 0 || p.year < MinDateYear || p.year > MaxDateYear || p.yday > calcDaysInYear(p.year) {
+			return 0, false
+		}
+		_, p.month, p.day, _ = (FromCalendar(int32(p.year), 1, 1) + Date(p.yday-1)).Calendar(true)
+	}
+	if !validDatetime(int32(p.year), uint8(p.month), uint8(p.day)) ||
+		!validTimeInDay(uint8(hour), uint8(p.minute), uint8(p.second)) {
+		return 0, false
+	}
+	return FromClock(int32(p.year), uint8(p.month), uint8(p.day), uint8(hour), uint8(p.minute), uint8(p.second), p.microsecond), true
+}
+
+type datetimeParser struct {
+	s           string
+	year        int
+	month, day  uint8
+	yday        int
+	hour        int
+	minute      int
+	second      int
+	microsecond uint32
+	hour12      bool
+	pm, pmSet   bool
+}
+
+// parse matches the string with the format as mysql does, the spaces before each part of
+// the string are skipped and the rest of the format is ignored once the string runs out
+func (p *datetimeParser) parse(format string) bool {
+	for i := 0; i < len(format); i++ {
+		if p.s = strings.TrimLeft(p.s, " "); len(p.s) == 0 {
+			break
+		}
+		switch {
+		case format[i] == '%' && i < len(format)-1:
+			i++
+			if !p.specifier(format[i]) {
+				return false
+			}
+		case format[i] != ' ':
+			if !p.literal(format[i]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (p *datetimeParser) literal(c byte) bool {
+	if len(p.s) == 0 || p.s[0] != c {
+		return false
+	}
+	p.s = p.s[1:]
+	return true
+}
+
+func (p *datetimeParser) specifier(c byte) bool {
+	ok := true
+	switch c {
+	case 'Y':
+		p.year, ok = p.number(4)
+	case 'y':
+		p.year, ok = p.number(2)
+		if p.year < 70 {
+			p.year += 2000
+		} else {
+			p.year += 1900
+		}
+	case 'm', 'c':
+		var month int
+		month, ok = p.number(2)
+		p.month = uint8(month)
+	case 'M':
+		p.month, ok = p.name(longMonthNames)
+	case 'b':
+		p.month, ok = p.name(shortMonthNames)
+	case 'd', 'e':
+		var day int
+		day, ok = p.number(2)
+		p.day = uint8(day)
+	case 'D':
+		var day int
+		if day, ok = p.number(2); ok && len(p.s) >= 2 {
+			p.day, p.s = uint8(day), p.s[2:]
+		} else {
+			ok = false
+		}
+	case 'j':
+		p.yday, ok = p.number(3)
+		ok = ok && p.yday > 0
+	case 'H', 'k':
+		p.hour, ok = p.number(2)
+	case 'h', 'I', 'l':
+		p.hour, ok = p.number(2)
+		p.hour12 = true
+	case 'i':
+		p.minute, ok = p.number(2)
+	case 's', 'S':
+		p.second, ok = p.number(2)
+	case 'f':
+		var digits string
+		digits, p.s = leadingDigits(p.s, 6)
+		for n := len(digits); n < 6; n++ {
+			digits += "0"
+		}
+		for _, d := range digits {
+			p.microsecond = p.microsecond*10 + uint32(d-'0')
+		}
+	case 'p':
+		if len(p.s) < 2 {
+			return false
+		}
+		switch strings.ToUpper(p.s[:2]) {
+		case "AM":
+		case "PM":
+			p.pm = true
+		default:
+			return false
+		}
+		p.pmSet, p.s = true, p.s[2:]
+	case 'W':
+		_, ok = p.name(longDayNames)
+	case 'a':
+		_, ok = p.name(shortDayNames)
+	case 'T':
+		return p.parse("%H:%i:%s")
+	case 'r':
+		return p.parse("%h:%i:%s %p")
+	case '%':
+		return p.literal('%')
+	default:
+		return false
+	}
+	return ok
+}
+
+// number parses an unsigned number of at most n digits
+func (p *datetimeParser) number(n int) (int, bool) {
+	var digits string
+	digits, p.s = leadingDigits(p.s, n)
+	if len(digits) == 0 {
+		return 0, false
+	}
+	v := 0
+	for _, d := range digits {
+		v = v*10 + int(d-'0')
+	}
+	return v, true
+}
+
+// name parses one of the names case-insensitively and returns its 1-based index
+func (p *datetimeParser) name(names []string) (uint8, bool) {
+	for i, name := range names {
+		if len(p.s) >= len(name) && strings.EqualFold(p.s[:len(name)], name) {
+			p.s = p.s[len(name):]
+			return uint8(i + 1), true
+		}
+	}
+	return 0, false
+}
+
+func leadingDigits(s string, n int) (string, string) {
+	i := 0
+	for i < len(s) && i < n && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	return s[:i], s[i:]
+}
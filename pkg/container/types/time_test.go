@@ -0,0 +1,75 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTime(t *testing.T) {
+	kases := []struct {
+		s         string
+		precision int32
+		want      string
+	}{
+		{s: "12:34:56", precision: 0, want: "12:34:56"},
+		{s: "-838:59:59", precision: 0, want: "-838:59:59"},
+		{s: "838:59:59.999999", precision: 6, want: "838:59:59.999999"},
+		{s: "12:34:56.1256", precision: 2, want: "12:34:56.13"},
+		{s: "12:34:56.9999", precision: 0, want: "12:34:57"},
+		{s: "2 10:00:00", precision: 0, want: "58:00:00"},
+		{s: "1 2", precision: 0, want: "26:00:00"},
+		{s: "12:34", precision: 0, want: "12:34:00"},
+		{s: "123456", precision: 0, want: "12:34:56"},
+		{s: "-3456.5", precision: 1, want: "-00:34:56.5"},
+		{s: "2022-08-12 10:20:30.5", precision: 1, want: "10:20:30.5"},
+	}
+	for _, k := range kases {
+		v, err := ParseTime(k.s, k.precision)
+		require.NoError(t, err, k.s)
+		require.Equal(t, k.want, v.String2(k.precision), k.s)
+	}
+
+	for _, s := range []string{"", "839:00:00", "12:60:00", "12:00:61", "abc", "1:2:3:4"} {
+		_, err := ParseTime(s, 6)
+		require.Error(t, err, s)
+	}
+}
+
+func TestTimeConvert(t *testing.T) {
+	v, err := ParseTime("-12:34:56.5", 6)
+	require.NoError(t, err)
+	require.Equal(t, int64(-123457), v.ToInt64())
+	require.Equal(t, int64(-45296), v.Seconds())
+	require.Equal(t, "-12:34:57", v.Round(0).String())
+
+	v, err = ParseInt64ToTime(-123456)
+	require.NoError(t, err)
+	require.Equal(t, "-12:34:56", v.String())
+	_, err = ParseInt64ToTime(8390000)
+	require.Error(t, err)
+
+	require.Equal(t, "838:59:59", MaxTime.Round(0).String())
+	require.Equal(t, MaxTime, TruncateTime(int64(MaxTime)+1))
+	require.Equal(t, MinTime, TruncateTime(int64(MinTime)-1))
+
+	dt, err := ParseDatetime("2022-08-12 10:20:30.123456", 6)
+	require.NoError(t, err)
+	v = dt.ToTime(6)
+	require.Equal(t, "10:20:30.123456", v.String2(6))
+	require.Equal(t, dt, v.ToDatetime(dt.ToDate()))
+}
@@ -80,6 +80,46 @@ func (ts Timestamp) String2(loc *time.Location, precision int32) string {
 	return fmt.Sprintf("%04d-%02d-%02d %02d:%02d:%02d", y, m, d, hour, minute, sec)
 }
 
+// Truncate truncates the fractional seconds of the timestamp to the precision
+func (ts Timestamp) Truncate(precision int32) Timestamp {
+	if precision < 0 || precision >= int32(len(scaleTable))-1 {
+		return ts
+	}
+	scale := Timestamp(scaleTable[precision])
+	return ts - ts%scale
+}
+
+// ParseTimeZone parses the time zone in the format of the time_zone variable,
+// which is 'SYSTEM', an offset from '-13:59' to '+14:00' or a named time zone
+func ParseTimeZone(s string) (*time.Location, error) {
+	if s == "SYSTEM" {
+		return time.Local, nil
+	}
+	if len(s) == 0 {
+		return nil, errors.New(errno.DataException, "incorrect timezone "+s)
+	}
+	if s[0] != '+' && s[0] != '-' {
+		return time.LoadLocation(s)
+	}
+	if len(s) != 6 || s[3] != ':' {
+		return nil, errors.New(errno.DataException, "incorrect timezone "+s)
+	}
+	for _, i := range []int{1, 2, 4, 5} {
+		if s[i] < '0' || s[i] > '9' {
+			return nil, errors.New(errno.DataException, "incorrect timezone "+s)
+		}
+	}
+	hour := int(s[1]-'0')*10 + int(s[2]-'0')
+	minute := hour*60 + int(s[4]-'0')*10 + int(s[5]-'0')
+	if minute > 14*60 || s[0] == '-' && minute == 14*60 {
+		return nil, errors.New(errno.DataException, "incorrect timezone "+s)
+	}
+	if s[0] == '-' {
+		minute = -minute
+	}
+	return time.FixedZone("FixedZone", minute*60), nil
+}
+
 func (ts Timestamp) Unix() int64 {
 	return (int64(ts) - unixEpoch) / microSecsPerSec
 }
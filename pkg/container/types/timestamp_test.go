@@ -139,3 +139,11 @@ func TestLocation(t *testing.T) {
 	locPtr = (*unsafeLoc)(unsafe.Pointer(loc))
 	require.Greater(t, len(locPtr.zone), 1)
 }
+
+func TestTimestamp_Truncate(t *testing.T) {
+	ts, err := ParseTimestamp(time.UTC, "2022-01-01 10:20:30.123456", 6)
+	require.NoError(t, err)
+	require.Equal(t, "2022-01-01 10:20:30", ts.Truncate(0).String2(time.UTC, 6)[:19])
+	require.Equal(t, "2022-01-01 10:20:30.120000", ts.Truncate(2).String2(time.UTC, 6))
+	require.Equal(t, ts, ts.Truncate(6))
+}
@@ -107,6 +107,17 @@ func (ts TS) ToString() string {
 	return fmt.Sprintf("%d-%d", ts.physical(), ts.logical())
 }
 
+func (ts TS) ToTimestamp() timestamp.Timestamp {
+	return timestamp.Timestamp{
+		PhysicalTime: ts.physical(),
+		LogicalTime:  ts.logical(),
+	}
+}
+
+func TimestampToTS(ts timestamp.Timestamp) TS {
+	return buildTS(ts.PhysicalTime, ts.LogicalTime)
+}
+
 func StringToTS(s string) (ts TS) {
 	tmp := strings.Split(s, "-")
 	if len(tmp) != 2 {
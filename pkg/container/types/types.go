@@ -52,6 +52,9 @@ const (
 	T_decimal64  T = 32
 	T_decimal128 T = 33
 
+	// bit value, stored as the uint64 value of the bits
+	T_bit T = 34
+
 	// pseudo numerics, not used
 
 	// date and time
@@ -66,9 +69,20 @@ const (
 	T_varchar T = 61
 	T_json    T = 62
 
+	// binary strings, compared and padded byte-wise
+	T_binary    T = 63
+	T_varbinary T = 64
+
 	// blobs
 	T_blob T = 70
 
+	// enum and set, stored as the ordinal of the value and the bitmap of the values
+	T_enum T = 80
+	T_set  T = 81
+
+	// uuid, stored as the 16 bytes of the canonical form
+	T_uuid T = 90
+
 	// Transaction TS
 	T_TS T = 100
 
@@ -78,7 +92,12 @@ const (
 )
 
 type Type struct {
-	Oid  T     `json:"oid,string"`
+	Oid T `json:"oid,string"`
+	// Collation of the char, varchar and text types, the zero value compares the bytes.
+	// It is kept next to Oid in the padding before Size, so the encoded type keeps its size
+	// and the types encoded before it decode with the binary collation.
+	Collation Collation `json:"collation,omitempty"`
+
 	Size int32 `json:"size,string"` // e.g. int8.Size = 1, int16.Size = 2, char.Size = 24(SliceHeader size)
 
 	// Width means max Display width for float and double, char and varchar // todo: need to add new attribute DisplayWidth ?
@@ -97,12 +116,15 @@ type Bytes struct {
 
 type Date int32
 
+type Time int64
 type Datetime int64
 type Timestamp int64
 
 type Decimal64 [8]byte
 type Decimal128 [16]byte
 
+type Uuid [16]byte
+
 // timestamp for transaction: physical time (higher 8 bytes) + logical (lower 4 bytes)
 // See txts.go for impl.
 type TS [12]byte
@@ -120,7 +142,7 @@ type Floats interface {
 }
 
 type OrderedT interface {
-	constraints.Ordered | Date | Datetime | Timestamp
+	constraints.Ordered | Date | Time | Datetime | Timestamp
 }
 
 type Decimal interface {
@@ -128,7 +150,7 @@ type Decimal interface {
 }
 
 type FixedSizeT interface {
-	bool | OrderedT | Decimal
+	bool | OrderedT | Decimal | Uuid
 }
 
 type VarSizeT interface {
@@ -144,7 +166,7 @@ type String interface {
 }
 
 type Generic interface {
-	Ints | UInts | Floats | Date | Datetime | Timestamp
+	Ints | UInts | Floats | Date | Time | Datetime | Timestamp
 }
 
 var Types map[string]T = map[string]T{
@@ -169,6 +191,7 @@ var Types map[string]T = map[string]T{
 	"double": T_float64,
 
 	"date":      T_date,
+	"time":      T_time,
 	"datetime":  T_datetime,
 	"timestamp": T_timestamp,
 	"interval":  T_interval,
@@ -176,8 +199,17 @@ var Types map[string]T = map[string]T{
 	"char":    T_char,
 	"varchar": T_varchar,
 
+	"binary":    T_binary,
+	"varbinary": T_varbinary,
+	"bit":       T_bit,
+
 	"json": T_json,
 	"text": T_blob,
+
+	"enum": T_enum,
+	"set":  T_set,
+
+	"uuid": T_uuid,
 }
 
 func New(oid T, width, scale, precision int32) Type {
@@ -203,7 +235,7 @@ func (t Type) IsBoolean() bool {
 }
 
 func (t Type) IsString() bool {
-	return t.Oid == T_char || t.Oid == T_varchar || t.Oid == T_blob
+	return t.Oid == T_char || t.Oid == T_varchar || t.Oid == T_blob || t.Oid == T_binary || t.Oid == T_varbinary
 }
 
 func (t Type) IsIntOrUint() bool {
@@ -238,15 +270,15 @@ func (t T) ToType() Type {
 		typ.Size = 2
 	case T_int32, T_date:
 		typ.Size = 4
-	case T_int64, T_datetime, T_timestamp:
+	case T_int64, T_time, T_datetime, T_timestamp:
 		typ.Size = 8
 	case T_uint8:
 		typ.Size = 1
-	case T_uint16:
+	case T_uint16, T_enum:
 		typ.Size = 2
 	case T_uint32:
 		typ.Size = 4
-	case T_uint64:
+	case T_uint64, T_set, T_bit:
 		typ.Size = 8
 	case T_float32:
 		typ.Size = 4
@@ -254,13 +286,13 @@ func (t T) ToType() Type {
 		typ.Size = 8
 	case T_char:
 		typ.Size = 24
-	case T_varchar:
+	case T_varchar, T_binary, T_varbinary:
 		typ.Size = 24
 	case T_sel:
 		typ.Size = 8
 	case T_decimal64:
 		typ.Size = 8
-	case T_decimal128:
+	case T_decimal128, T_uuid:
 		typ.Size = 16
 	case T_blob:
 		typ.Size = 24
@@ -296,6 +328,8 @@ func (t T) String() string {
 		return "DOUBLE"
 	case T_date:
 		return "DATE"
+	case T_time:
+		return "TIME"
 	case T_datetime:
 		return "DATETIME"
 	case T_timestamp:
@@ -304,6 +338,12 @@ func (t T) String() string {
 		return "CHAR"
 	case T_varchar:
 		return "VARCHAR"
+	case T_binary:
+		return "BINARY"
+	case T_varbinary:
+		return "VARBINARY"
+	case T_bit:
+		return "BIT"
 	case T_json:
 		return "JSON"
 	case T_sel:
@@ -316,6 +356,12 @@ func (t T) String() string {
 		return "DECIMAL128"
 	case T_blob:
 		return "TEXT"
+	case T_enum:
+		return "ENUM"
+	case T_set:
+		return "SET"
+	case T_uuid:
+		return "UUID"
 	}
 	return fmt.Sprintf("unexpected type: %d", t)
 }
@@ -355,8 +401,16 @@ func (t T) OidString() string {
 		return "T_char"
 	case T_varchar:
 		return "T_varchar"
+	case T_binary:
+		return "T_binary"
+	case T_varbinary:
+		return "T_varbinary"
+	case T_bit:
+		return "T_bit"
 	case T_date:
 		return "T_date"
+	case T_time:
+		return "T_time"
 	case T_datetime:
 		return "T_datetime"
 	case T_timestamp:
@@ -367,6 +421,12 @@ func (t T) OidString() string {
 		return "T_decimal128"
 	case T_blob:
 		return "T_blob"
+	case T_enum:
+		return "T_enum"
+	case T_set:
+		return "T_set"
+	case T_uuid:
+		return "T_uuid"
 	}
 	return "unknown_type"
 }
@@ -390,20 +450,22 @@ func (t T) GoType() string {
 		return "float32"
 	case T_uint8:
 		return "uint8"
-	case T_uint16:
+	case T_uint16, T_enum:
 		return "uint16"
 	case T_uint32:
 		return "uint32"
-	case T_uint64:
+	case T_uint64, T_set, T_bit:
 		return "uint64"
 	case T_sel:
 		return "int64"
 	case T_char:
 		return "string"
-	case T_varchar:
+	case T_varchar, T_binary, T_varbinary:
 		return "string"
 	case T_date:
 		return "date"
+	case T_time:
+		return "time"
 	case T_datetime:
 		return "datetime"
 	case T_timestamp:
@@ -412,6 +474,8 @@ func (t T) GoType() string {
 		return "decimal64"
 	case T_decimal128:
 		return "decimal128"
+	case T_uuid:
+		return "uuid"
 	case T_blob:
 		return "string"
 	}
@@ -420,7 +484,7 @@ func (t T) GoType() string {
 
 // GoGoType returns special go type string for T
 func (t T) GoGoType() string {
-	if t == T_char || t == T_varchar || t == T_blob || t == T_json {
+	if t == T_char || t == T_varchar || t == T_blob || t == T_json || t == T_binary || t == T_varbinary {
 		return "Str"
 	}
 	k := t.GoType()
@@ -438,15 +502,15 @@ func (t T) TypeLen() int {
 		return 2
 	case T_int32, T_date:
 		return 4
-	case T_int64, T_datetime, T_timestamp:
+	case T_int64, T_time, T_datetime, T_timestamp:
 		return 8
 	case T_uint8:
 		return 1
-	case T_uint16:
+	case T_uint16, T_enum:
 		return 2
 	case T_uint32:
 		return 4
-	case T_uint64:
+	case T_uint64, T_set, T_bit:
 		return 8
 	case T_float32:
 		return 4
@@ -454,13 +518,13 @@ func (t T) TypeLen() int {
 		return 8
 	case T_char:
 		return 24
-	case T_varchar:
+	case T_varchar, T_binary, T_varbinary:
 		return 24
 	case T_sel:
 		return 8
 	case T_decimal64:
 		return 8
-	case T_decimal128:
+	case T_decimal128, T_uuid:
 		return 16
 	case T_blob:
 		return 24
@@ -475,19 +539,21 @@ func (t T) FixedLength() int {
 		return -24
 	case T_int8, T_uint8, T_bool:
 		return 1
-	case T_int16, T_uint16:
+	case T_int16, T_uint16, T_enum:
 		return 2
 	case T_int32, T_uint32, T_date, T_float32:
 		return 4
-	case T_int64, T_uint64, T_datetime, T_float64, T_timestamp:
+	case T_int64, T_uint64, T_set, T_bit, T_time, T_datetime, T_float64, T_timestamp:
 		return 8
 	case T_decimal64:
 		return -8
 	case T_decimal128:
 		return -16
+	case T_uuid:
+		return 16
 	case T_char:
 		return -24
-	case T_varchar:
+	case T_varchar, T_binary, T_varbinary:
 		return -24
 	case T_sel:
 		return 8
@@ -531,14 +597,14 @@ func IsFloat(t T) bool {
 
 // isString: return true if the types.T is string type
 func IsString(t T) bool {
-	if t == T_char || t == T_varchar || t == T_blob {
+	if t == T_char || t == T_varchar || t == T_blob || t == T_binary || t == T_varbinary {
 		return true
 	}
 	return false
 }
 
 func IsDateRelate(t T) bool {
-	if t == T_date || t == T_datetime || t == T_timestamp {
+	if t == T_date || t == T_time || t == T_datetime || t == T_timestamp {
 		return true
 	}
 	return false
@@ -0,0 +1,70 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+// The Uuid value is stored as the 16 bytes in the order of the canonical text form,
+// so the byte-wise comparison orders the values as their strings do.
+
+const UuidStringLen = 36
+
+// ParseUuid parses the canonical form xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx,
+// the forms without hyphens, in braces or with the urn:uuid: prefix are accepted too
+func ParseUuid(s string) (Uuid, error) {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return Uuid{}, errors.New(errno.DataException, fmt.Sprintf("Incorrect uuid value: '%s'", s))
+	}
+	return Uuid(id), nil
+}
+
+// BytesToUuid converts the 16 bytes to the uuid
+func BytesToUuid(data []byte) (Uuid, error) {
+	id, err := uuid.FromBytes(data)
+	if err != nil {
+		return Uuid{}, errors.New(errno.DataException, fmt.Sprintf("Incorrect uuid value: %d bytes", len(data)))
+	}
+	return Uuid(id), nil
+}
+
+// NewUuid returns a new time-based (version 1) uuid
+func NewUuid() (Uuid, error) {
+	id, err := uuid.NewUUID()
+	if err != nil {
+		return Uuid{}, err
+	}
+	return Uuid(id), nil
+}
+
+// String returns the canonical form of the uuid in lower case
+func (u Uuid) String() string {
+	return uuid.UUID(u).String()
+}
+
+func (u Uuid) Compare(v Uuid) int {
+	return bytes.Compare(u[:], v[:])
+}
+
+func (u Uuid) Lt(v Uuid) bool {
+	return u.Compare(v) < 0
+}
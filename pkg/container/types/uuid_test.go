@@ -0,0 +1,48 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUuid(t *testing.T) {
+	u, err := ParseUuid("6ccd780c-baba-1026-9564-5b8c656024db")
+	require.NoError(t, err)
+	require.Equal(t, "6ccd780c-baba-1026-9564-5b8c656024db", u.String())
+	require.Equal(t, byte(0x6c), u[0])
+	require.Equal(t, byte(0xdb), u[15])
+
+	v, err := ParseUuid("{6CCD780C-BABA-1026-9564-5B8C656024DC}")
+	require.NoError(t, err)
+	require.Equal(t, "6ccd780c-baba-1026-9564-5b8c656024dc", v.String())
+	require.True(t, u.Lt(v))
+	require.Equal(t, 1, v.Compare(u))
+	require.Equal(t, 0, u.Compare(u))
+
+	_, err = ParseUuid("6ccd780c-baba-1026-9564")
+	require.Error(t, err)
+
+	w, err := BytesToUuid(u[:])
+	require.NoError(t, err)
+	require.Equal(t, u, w)
+	_, err = BytesToUuid(u[:8])
+	require.Error(t, err)
+
+	vs := []Uuid{u, v}
+	require.Equal(t, vs, DecodeUuidSlice(EncodeUuidSlice(vs)))
+}
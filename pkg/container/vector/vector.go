@@ -91,12 +91,13 @@ func (v *Vector) MarshalBinary() ([]byte, error) {
 }
 
 func (v *Vector) UnmarshalBinary(data []byte) error {
-	if data[1] == 1 {
+	if data[0] == 1 {
 		v.IsConst = true
 	}
 	data = data[1:]
 	v.Length = int(types.DecodeInt64(data[:8]))
-	data = data[8:]
+	// the decoders may reuse data after returning, so it is not retained
+	data = append([]byte(nil), data[8:]...)
 	return v.Read(data)
 }
 
@@ -138,11 +139,11 @@ func (v *Vector) FillDefaultValue() {
 		fillDefaultValue[int64](v)
 	case types.T_uint8:
 		fillDefaultValue[uint8](v)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		fillDefaultValue[uint16](v)
 	case types.T_uint32:
 		fillDefaultValue[uint32](v)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		fillDefaultValue[uint64](v)
 	case types.T_float32:
 		fillDefaultValue[uint64](v)
@@ -152,13 +153,17 @@ func (v *Vector) FillDefaultValue() {
 		fillDefaultValue[types.Date](v)
 	case types.T_datetime:
 		fillDefaultValue[types.Datetime](v)
+	case types.T_time:
+		fillDefaultValue[types.Time](v)
 	case types.T_timestamp:
 		fillDefaultValue[types.Timestamp](v)
 	case types.T_decimal64:
 		fillDefaultValue[types.Decimal64](v)
 	case types.T_decimal128:
 		fillDefaultValue[types.Decimal128](v)
-	case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+	case types.T_uuid:
+		fillDefaultValue[types.Uuid](v)
+	case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		col := v.Col.(*types.Bytes)
 		rows := v.Nsp.Np.ToArray()
 		for _, row := range rows {
@@ -185,11 +190,11 @@ func (v *Vector) ToConst(row int) *Vector {
 		return toConstVector[int64](v, row)
 	case types.T_uint8:
 		return toConstVector[uint8](v, row)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return toConstVector[uint16](v, row)
 	case types.T_uint32:
 		return toConstVector[uint32](v, row)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		return toConstVector[uint64](v, row)
 	case types.T_float32:
 		return toConstVector[float32](v, row)
@@ -199,13 +204,17 @@ func (v *Vector) ToConst(row int) *Vector {
 		return toConstVector[types.Date](v, row)
 	case types.T_datetime:
 		return toConstVector[types.Datetime](v, row)
+	case types.T_time:
+		return toConstVector[types.Time](v, row)
 	case types.T_timestamp:
 		return toConstVector[types.Timestamp](v, row)
 	case types.T_decimal64:
 		return toConstVector[types.Decimal64](v, row)
 	case types.T_decimal128:
 		return toConstVector[types.Decimal128](v, row)
-	case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+	case types.T_uuid:
+		return toConstVector[types.Uuid](v, row)
+	case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		col := v.Col.(*types.Bytes)
 		src := col.Data[col.Offsets[row] : col.Offsets[row]+col.Lengths[row]]
 		data := make([]byte, len(src))
@@ -250,11 +259,11 @@ func (v *Vector) ConstExpand(m *mheap.Mheap) *Vector {
 		expandVector[int64](v, 8, m)
 	case types.T_uint8:
 		expandVector[uint8](v, 1, m)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		expandVector[uint16](v, 2, m)
 	case types.T_uint32:
 		expandVector[uint32](v, 4, m)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		expandVector[uint64](v, 8, m)
 	case types.T_float32:
 		expandVector[float32](v, 4, m)
@@ -264,13 +273,17 @@ func (v *Vector) ConstExpand(m *mheap.Mheap) *Vector {
 		expandVector[types.Date](v, 4, m)
 	case types.T_datetime:
 		expandVector[types.Datetime](v, 8, m)
+	case types.T_time:
+		expandVector[types.Time](v, 8, m)
 	case types.T_timestamp:
 		expandVector[types.Timestamp](v, 8, m)
 	case types.T_decimal64:
 		expandVector[types.Decimal64](v, 8, m)
 	case types.T_decimal128:
 		expandVector[types.Decimal128](v, 16, m)
-	case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+	case types.T_uuid:
+		expandVector[types.Uuid](v, 16, m)
+	case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		col := v.Col.(*types.Bytes)
 		if nulls.Any(v.Nsp) {
 			col.Offsets = col.Offsets[:0]
@@ -295,6 +308,7 @@ func (v *Vector) ConstExpand(m *mheap.Mheap) *Vector {
 				o += uint32(len(col.Data))
 				data = append(data, col.Data...)
 			}
+			v.Data = data
 			col.Data = data
 			col.Offsets = os
 			col.Lengths = ns
@@ -410,7 +424,7 @@ func New(typ types.Type) *Vector {
 			Col: []uint8{},
 			Nsp: &nulls.Nulls{},
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return &Vector{
 			Typ: typ,
 			Col: []uint16{},
@@ -422,7 +436,7 @@ func New(typ types.Type) *Vector {
 			Col: []uint32{},
 			Nsp: &nulls.Nulls{},
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		return &Vector{
 			Typ: typ,
 			Col: []uint64{},
@@ -452,6 +466,12 @@ func New(typ types.Type) *Vector {
 			Col: []types.Datetime{},
 			Nsp: &nulls.Nulls{},
 		}
+	case types.T_time:
+		return &Vector{
+			Typ: typ,
+			Col: []types.Time{},
+			Nsp: &nulls.Nulls{},
+		}
 	case types.T_timestamp:
 		return &Vector{
 			Typ: typ,
@@ -470,7 +490,7 @@ func New(typ types.Type) *Vector {
 			Nsp: &nulls.Nulls{},
 			Col: [][]interface{}{},
 		}
-	case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+	case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		return &Vector{
 			Typ: typ,
 			Col: &types.Bytes{},
@@ -488,6 +508,12 @@ func New(typ types.Type) *Vector {
 			Col: []types.Decimal128{},
 			Nsp: &nulls.Nulls{},
 		}
+	case types.T_uuid:
+		return &Vector{
+			Typ: typ,
+			Col: []types.Uuid{},
+			Nsp: &nulls.Nulls{},
+		}
 	default:
 		panic(fmt.Sprintf("unexpect type %s for function vector.New", typ))
 	}
@@ -524,11 +550,11 @@ func (v *Vector) initConst(typ types.Type) {
 		v.Col = []int64{0}
 	case types.T_uint8:
 		v.Col = []uint8{0}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		v.Col = []uint16{0}
 	case types.T_uint32:
 		v.Col = []uint32{0}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		v.Col = []uint64{0}
 	case types.T_float32:
 		v.Col = []float32{0}
@@ -538,13 +564,17 @@ func (v *Vector) initConst(typ types.Type) {
 		v.Col = make([]types.Date, 1)
 	case types.T_datetime:
 		v.Col = make([]types.Datetime, 1)
+	case types.T_time:
+		v.Col = make([]types.Time, 1)
 	case types.T_timestamp:
 		v.Col = make([]types.Timestamp, 1)
 	case types.T_decimal64:
 		v.Col = make([]types.Decimal64, 1)
 	case types.T_decimal128:
 		v.Col = make([]types.Decimal128, 1)
-	case types.T_char, types.T_varchar, types.T_blob:
+	case types.T_uuid:
+		v.Col = make([]types.Uuid, 1)
+	case types.T_char, types.T_varchar, types.T_blob, types.T_binary, types.T_varbinary:
 		v.Col = &types.Bytes{
 			Offsets: []uint32{0},
 			Lengths: []uint32{0},
@@ -608,22 +638,26 @@ func (v *Vector) Realloc(size int, m *mheap.Mheap) error {
 		v.Col = types.DecodeSlice[int64](v.Data[:len(data)], size)[:oldLen/size]
 	case types.T_uint8:
 		v.Col = types.DecodeSlice[uint8](v.Data[:len(data)], size)[:oldLen/size]
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		v.Col = types.DecodeSlice[uint16](v.Data[:len(data)], size)[:oldLen/size]
 	case types.T_uint32:
 		v.Col = types.DecodeSlice[uint32](v.Data[:len(data)], size)[:oldLen/size]
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		v.Col = types.DecodeSlice[uint64](v.Data[:len(data)], size)[:oldLen/size]
 	case types.T_date:
 		v.Col = types.DecodeSlice[types.Date](v.Data[:len(data)], size)[:oldLen/size]
 	case types.T_datetime:
 		v.Col = types.DecodeSlice[types.Datetime](v.Data[:len(data)], size)[:oldLen/size]
+	case types.T_time:
+		v.Col = types.DecodeSlice[types.Time](v.Data[:len(data)], size)[:oldLen/size]
 	case types.T_timestamp:
 		v.Col = types.DecodeSlice[types.Timestamp](v.Data[:len(data)], size)[:oldLen/size]
 	case types.T_decimal64:
 		v.Col = types.DecodeSlice[types.Decimal64](v.Data[:len(data)], size)[:oldLen/size]
 	case types.T_decimal128:
 		v.Col = types.DecodeSlice[types.Decimal128](v.Data[:len(data)], size)[:oldLen/size]
+	case types.T_uuid:
+		v.Col = types.DecodeSlice[types.Uuid](v.Data[:len(data)], size)[:oldLen/size]
 	}
 	return nil
 }
@@ -708,7 +742,7 @@ func (v *Vector) Append(w any, m *mheap.Mheap) error {
 		col = append(col, wv)
 		v.Col = col
 		v.Data = v.Data[:(n + 1)]
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		wv := w.(uint16)
 		col := v.Col.([]uint16)
 		n := len(col)
@@ -734,7 +768,7 @@ func (v *Vector) Append(w any, m *mheap.Mheap) error {
 		col = append(col, wv)
 		v.Col = col
 		v.Data = v.Data[:(n+1)*4]
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		wv := w.(uint64)
 		col := v.Col.([]uint64)
 		n := len(col)
@@ -799,6 +833,19 @@ func (v *Vector) Append(w any, m *mheap.Mheap) error {
 		col = append(col, wv)
 		v.Col = col
 		v.Data = v.Data[:(n+1)*8]
+	case types.T_time:
+		wv := w.(types.Time)
+		col := v.Col.([]types.Time)
+		n := len(col)
+		if n+1 >= cap(col) {
+			if err := v.Realloc(8, m); err != nil {
+				return err
+			}
+			col = v.Col.([]types.Time)
+		}
+		col = append(col, wv)
+		v.Col = col
+		v.Data = v.Data[:(n+1)*8]
 	case types.T_timestamp:
 		wv := w.(types.Timestamp)
 		col := v.Col.([]types.Timestamp)
@@ -838,7 +885,20 @@ func (v *Vector) Append(w any, m *mheap.Mheap) error {
 		col = append(col, wv)
 		v.Col = col
 		v.Data = v.Data[:(n+1)*16]
-	case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+	case types.T_uuid:
+		wv := w.(types.Uuid)
+		col := v.Col.([]types.Uuid)
+		n := len(col)
+		if n+1 >= cap(col) {
+			if err := v.Realloc(16, m); err != nil {
+				return err
+			}
+			col = v.Col.([]types.Uuid)
+		}
+		col = append(col, wv)
+		v.Col = col
+		v.Data = v.Data[:(n+1)*16]
+	case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		wv := w.([]byte)
 		n := len(v.Data)
 		if n+len(wv) >= cap(v.Data) {
@@ -858,7 +918,7 @@ func (v *Vector) Append(w any, m *mheap.Mheap) error {
 
 func Reset(v *Vector) {
 	switch v.Typ.Oid {
-	case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+	case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		v.Col.(*types.Bytes).Reset()
 	default:
 		*(*int)(unsafe.Pointer(uintptr((*(*emptyInterface)(unsafe.Pointer(&v.Col))).word) + uintptr(strconv.IntSize>>3))) = 0
@@ -889,7 +949,7 @@ func SetCol(v *Vector, col interface{}) {
 func PreAlloc(v, w *Vector, rows int, m *mheap.Mheap) {
 	defer func() {
 		size := v.Typ.Oid.TypeLen()
-		if v.Typ.Oid != types.T_char && v.Typ.Oid != types.T_varchar && v.Typ.Oid != types.T_blob && v.Typ.Oid != types.T_json {
+		if v.Typ.Oid != types.T_char && v.Typ.Oid != types.T_varchar && v.Typ.Oid != types.T_blob && v.Typ.Oid != types.T_json && v.Typ.Oid != types.T_binary && v.Typ.Oid != types.T_varbinary {
 			v.Data = v.Data[:reflect.ValueOf(v.Col).Len()*size]
 		}
 	}()
@@ -930,7 +990,7 @@ func PreAlloc(v, w *Vector, rows int, m *mheap.Mheap) {
 		}
 		v.Data = data
 		v.Col = types.DecodeUint8Slice(v.Data)[:0]
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		data, err := mheap.Alloc(m, int64(rows*2))
 		if err != nil {
 			return
@@ -944,7 +1004,7 @@ func PreAlloc(v, w *Vector, rows int, m *mheap.Mheap) {
 		}
 		v.Data = data
 		v.Col = types.DecodeUint32Slice(v.Data)[:0]
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		data, err := mheap.Alloc(m, int64(rows*8))
 		if err != nil {
 			return
@@ -979,6 +1039,13 @@ func PreAlloc(v, w *Vector, rows int, m *mheap.Mheap) {
 		}
 		v.Data = data
 		v.Col = types.DecodeDatetimeSlice(v.Data)[:0]
+	case types.T_time:
+		data, err := mheap.Alloc(m, int64(rows*8))
+		if err != nil {
+			return
+		}
+		v.Data = data
+		v.Col = types.DecodeTimeSlice(v.Data)[:0]
 	case types.T_timestamp:
 		data, err := mheap.Alloc(m, int64(rows*8))
 		if err != nil {
@@ -986,7 +1053,7 @@ func PreAlloc(v, w *Vector, rows int, m *mheap.Mheap) {
 		}
 		v.Data = data
 		v.Col = types.DecodeTimestampSlice(v.Data)[:0]
-	case types.T_char, types.T_varchar, types.T_blob, types.T_json:
+	case types.T_char, types.T_varchar, types.T_blob, types.T_json, types.T_binary, types.T_varbinary:
 		vs, ws := v.Col.(*types.Bytes), w.Col.(*types.Bytes)
 		data, err := mheap.Alloc(m, int64(rows*len(ws.Data)/len(ws.Offsets)))
 		if err != nil {
@@ -1004,7 +1071,7 @@ func Length(v *Vector) int {
 		return v.Length
 	}
 	switch v.Typ.Oid {
-	case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+	case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		return len(v.Col.(*types.Bytes).Offsets)
 	default:
 		return reflect.ValueOf(v.Col).Len()
@@ -1049,13 +1116,13 @@ func SetVectorLength(v *Vector, n int) {
 	case types.T_uint8:
 		v.Data = v.Data[:n*1]
 		setLengthFixed[uint8](v, n)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		v.Data = v.Data[:n*2]
 		setLengthFixed[uint16](v, n)
 	case types.T_uint32:
 		v.Data = v.Data[:n*4]
 		setLengthFixed[uint32](v, n)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		v.Data = v.Data[:n*8]
 		setLengthFixed[uint64](v, n)
 	case types.T_float32:
@@ -1070,6 +1137,9 @@ func SetVectorLength(v *Vector, n int) {
 	case types.T_datetime:
 		v.Data = v.Data[:n*8]
 		setLengthFixed[types.Datetime](v, n)
+	case types.T_time:
+		v.Data = v.Data[:n*8]
+		setLengthFixed[types.Time](v, n)
 	case types.T_timestamp:
 		v.Data = v.Data[:n*8]
 		setLengthFixed[types.Timestamp](v, n)
@@ -1080,6 +1150,10 @@ func SetVectorLength(v *Vector, n int) {
 		v.Data = v.Data[:n*16]
 		setLengthFixed[types.Decimal128](v, n)
 
+	case types.T_uuid:
+		v.Data = v.Data[:n*16]
+		setLengthFixed[types.Uuid](v, n)
+
 	case types.T_sel:
 		vs := v.Col.([]int64)
 		m := len(vs)
@@ -1090,7 +1164,7 @@ func SetVectorLength(v *Vector, n int) {
 		m := len(vs)
 		v.Col = vs[:n]
 		nulls.RemoveRange(v.Nsp, uint64(n), uint64(m))
-	case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+	case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		vs := v.Col.(*types.Bytes)
 		m := len(vs.Offsets)
 		vs.Data = vs.Data[:vs.Offsets[n-1]+vs.Lengths[n-1]]
@@ -1105,7 +1179,7 @@ func SetVectorLength(v *Vector, n int) {
 func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 	defer func() {
 		size := v.Typ.Oid.TypeLen()
-		if v.Typ.Oid != types.T_char && v.Typ.Oid != types.T_varchar && v.Typ.Oid != types.T_blob && v.Typ.Oid != types.T_json {
+		if v.Typ.Oid != types.T_char && v.Typ.Oid != types.T_varchar && v.Typ.Oid != types.T_blob && v.Typ.Oid != types.T_json && v.Typ.Oid != types.T_binary && v.Typ.Oid != types.T_varbinary {
 			v.Data = v.Data[:reflect.ValueOf(v.Col).Len()*size]
 		}
 	}()
@@ -1206,7 +1280,7 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		vs := v.Col.([]uint16)
 		data, err := mheap.Alloc(m, int64(len(vs)*2))
 		if err != nil {
@@ -1238,7 +1312,7 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		vs := v.Col.([]uint64)
 		data, err := mheap.Alloc(m, int64(len(vs)*8))
 		if err != nil {
@@ -1286,7 +1360,7 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
-	case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+	case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		var err error
 		var data []byte
 
@@ -1346,6 +1420,22 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
+	case types.T_time:
+		vs := v.Col.([]types.Time)
+		data, err := mheap.Alloc(m, int64(len(vs)*8))
+		if err != nil {
+			return nil, err
+		}
+		ws := types.DecodeTimeSlice(data)
+		copy(ws, vs)
+		return &Vector{
+			Col:  ws,
+			Data: data,
+			Typ:  v.Typ,
+			Nsp:  v.Nsp,
+			Ref:  v.Ref,
+			Link: v.Link,
+		}, nil
 	case types.T_timestamp:
 		vs := v.Col.([]types.Timestamp)
 		data, err := mheap.Alloc(m, int64(len(vs)*8))
@@ -1394,6 +1484,22 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
+	case types.T_uuid:
+		vs := v.Col.([]types.Uuid)
+		data, err := mheap.Alloc(m, int64(len(vs)*16))
+		if err != nil {
+			return nil, err
+		}
+		ws := types.DecodeUuidSlice(data)
+		copy(ws, vs)
+		return &Vector{
+			Col:  ws,
+			Data: data,
+			Typ:  v.Typ,
+			Nsp:  v.Nsp,
+			Ref:  v.Ref,
+			Link: v.Link,
+		}, nil
 	}
 	return nil, fmt.Errorf("unsupport type %v", v.Typ)
 }
@@ -1419,13 +1525,13 @@ func Window(v *Vector, start, end int, w *Vector) *Vector {
 	case types.T_uint8:
 		w.Col = v.Col.([]uint8)[start:end]
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		w.Col = v.Col.([]uint16)[start:end]
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
 	case types.T_uint32:
 		w.Col = v.Col.([]uint32)[start:end]
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		w.Col = v.Col.([]uint64)[start:end]
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
 	case types.T_float32:
@@ -1440,7 +1546,7 @@ func Window(v *Vector, start, end int, w *Vector) *Vector {
 	case types.T_tuple:
 		w.Col = v.Col.([][]interface{})[start:end]
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
-	case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+	case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		w.Col = v.Col.(*types.Bytes).Window(start, end)
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
 	case types.T_date:
@@ -1449,6 +1555,9 @@ func Window(v *Vector, start, end int, w *Vector) *Vector {
 	case types.T_datetime:
 		w.Col = v.Col.([]types.Datetime)[start:end]
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
+	case types.T_time:
+		w.Col = v.Col.([]types.Time)[start:end]
+		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
 	case types.T_timestamp:
 		w.Col = v.Col.([]types.Timestamp)[start:end]
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
@@ -1458,6 +1567,9 @@ func Window(v *Vector, start, end int, w *Vector) *Vector {
 	case types.T_decimal128:
 		w.Col = v.Col.([]types.Decimal128)[start:end]
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
+	case types.T_uuid:
+		w.Col = v.Col.([]types.Uuid)[start:end]
+		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
 	default:
 		panic(fmt.Sprintf("unexpect type %s for function vector.Window", v.Typ))
 	}
@@ -1484,13 +1596,13 @@ func Append(v *Vector, arg interface{}) error {
 	case types.T_uint8:
 		v.Col = append(v.Col.([]uint8), arg.([]uint8)...)
 		v.Data = types.EncodeFixedSlice(v.Col.([]uint8), 1)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		v.Col = append(v.Col.([]uint16), arg.([]uint16)...)
 		v.Data = types.EncodeFixedSlice(v.Col.([]uint16), 2)
 	case types.T_uint32:
 		v.Col = append(v.Col.([]uint32), arg.([]uint32)...)
 		v.Data = types.EncodeFixedSlice(v.Col.([]uint32), 4)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		v.Col = append(v.Col.([]uint64), arg.([]uint64)...)
 		v.Data = types.EncodeFixedSlice(v.Col.([]uint64), 8)
 	case types.T_float32:
@@ -1505,6 +1617,9 @@ func Append(v *Vector, arg interface{}) error {
 	case types.T_datetime:
 		v.Col = append(v.Col.([]types.Datetime), arg.([]types.Datetime)...)
 		v.Data = types.EncodeFixedSlice(v.Col.([]types.Datetime), 8)
+	case types.T_time:
+		v.Col = append(v.Col.([]types.Time), arg.([]types.Time)...)
+		v.Data = types.EncodeFixedSlice(v.Col.([]types.Time), 8)
 	case types.T_timestamp:
 		v.Col = append(v.Col.([]types.Timestamp), arg.([]types.Timestamp)...)
 		v.Data = types.EncodeFixedSlice(v.Col.([]types.Timestamp), 8)
@@ -1512,7 +1627,7 @@ func Append(v *Vector, arg interface{}) error {
 		v.Col = append(v.Col.([]int64), arg.([]int64)...)
 	case types.T_tuple:
 		v.Col = append(v.Col.([][]interface{}), arg.([][]interface{})...)
-	case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+	case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		return v.Col.(*types.Bytes).Append(arg.([][]byte))
 	case types.T_decimal64:
 		v.Col = append(v.Col.([]types.Decimal64), arg.([]types.Decimal64)...)
@@ -1520,6 +1635,9 @@ func Append(v *Vector, arg interface{}) error {
 	case types.T_decimal128:
 		v.Col = append(v.Col.([]types.Decimal128), arg.([]types.Decimal128)...)
 		v.Data = types.EncodeFixedSlice(v.Col.([]types.Decimal128), 16)
+	case types.T_uuid:
+		v.Col = append(v.Col.([]types.Uuid), arg.([]types.Uuid)...)
+		v.Data = types.EncodeFixedSlice(v.Col.([]types.Uuid), 16)
 	default:
 		return fmt.Errorf("unexpect type %s for function vector.Append", v.Typ)
 	}
@@ -1533,7 +1651,7 @@ func Shrink(v *Vector, sels []int64) {
 	}
 	defer func() {
 		size := v.Typ.Oid.TypeLen()
-		if v.Typ.Oid != types.T_char && v.Typ.Oid != types.T_varchar && v.Typ.Oid != types.T_blob && v.Typ.Oid != types.T_json {
+		if v.Typ.Oid != types.T_char && v.Typ.Oid != types.T_varchar && v.Typ.Oid != types.T_blob && v.Typ.Oid != types.T_json && v.Typ.Oid != types.T_binary && v.Typ.Oid != types.T_varbinary {
 			v.Data = v.Data[:reflect.ValueOf(v.Col).Len()*size]
 		}
 	}()
@@ -1585,7 +1703,7 @@ func Shrink(v *Vector, sels []int64) {
 		v.Col = vs[:len(sels)]
 		v.Data = v.Data[:len(sels)*1]
 		v.Nsp = nulls.Filter(v.Nsp, sels)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		vs := v.Col.([]uint16)
 		for i, sel := range sels {
 			vs[i] = vs[sel]
@@ -1601,7 +1719,7 @@ func Shrink(v *Vector, sels []int64) {
 		v.Col = vs[:len(sels)]
 		v.Data = v.Data[:len(sels)*4]
 		v.Nsp = nulls.Filter(v.Nsp, sels)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		vs := v.Col.([]uint64)
 		for i, sel := range sels {
 			vs[i] = vs[sel]
@@ -1640,7 +1758,7 @@ func Shrink(v *Vector, sels []int64) {
 		}
 		v.Col = vs[:len(sels)]
 		v.Nsp = nulls.Filter(v.Nsp, sels)
-	case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+	case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		vs := v.Col.(*types.Bytes)
 		for i, sel := range sels {
 			vs.Offsets[i] = vs.Offsets[sel]
@@ -1665,6 +1783,14 @@ func Shrink(v *Vector, sels []int64) {
 		v.Col = vs[:len(sels)]
 		v.Data = v.Data[:len(sels)*8]
 		v.Nsp = nulls.Filter(v.Nsp, sels)
+	case types.T_time:
+		vs := v.Col.([]types.Time)
+		for i, sel := range sels {
+			vs[i] = vs[sel]
+		}
+		v.Col = vs[:len(sels)]
+		v.Data = v.Data[:len(sels)*8]
+		v.Nsp = nulls.Filter(v.Nsp, sels)
 	case types.T_timestamp:
 		vs := v.Col.([]types.Timestamp)
 		for i, sel := range sels {
@@ -1689,6 +1815,14 @@ func Shrink(v *Vector, sels []int64) {
 		v.Col = vs[:len(sels)]
 		v.Data = v.Data[:len(sels)*16]
 		v.Nsp = nulls.Filter(v.Nsp, sels)
+	case types.T_uuid:
+		vs := v.Col.([]types.Uuid)
+		for i, sel := range sels {
+			vs[i] = vs[sel]
+		}
+		v.Col = vs[:len(sels)]
+		v.Data = v.Data[:len(sels)*16]
+		v.Nsp = nulls.Filter(v.Nsp, sels)
 	}
 }
 
@@ -1699,7 +1833,7 @@ func Shuffle(v *Vector, sels []int64, m *mheap.Mheap) error {
 	}
 	defer func() {
 		size := v.Typ.Oid.TypeLen()
-		if v.Typ.Oid != types.T_char && v.Typ.Oid != types.T_varchar && v.Typ.Oid != types.T_blob && v.Typ.Oid != types.T_json {
+		if v.Typ.Oid != types.T_char && v.Typ.Oid != types.T_varchar && v.Typ.Oid != types.T_blob && v.Typ.Oid != types.T_json && v.Typ.Oid != types.T_binary && v.Typ.Oid != types.T_varbinary {
 			v.Data = v.Data[:reflect.ValueOf(v.Col).Len()*size]
 		}
 	}()
@@ -1769,7 +1903,7 @@ func Shuffle(v *Vector, sels []int64, m *mheap.Mheap) error {
 		v.Nsp = nulls.Filter(v.Nsp, sels)
 		v.Data = v.Data[:len(sels)*1]
 		mheap.Free(m, data)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		vs := v.Col.([]uint16)
 		data, err := mheap.Alloc(m, int64(len(vs)*2))
 		if err != nil {
@@ -1791,7 +1925,7 @@ func Shuffle(v *Vector, sels []int64, m *mheap.Mheap) error {
 		v.Nsp = nulls.Filter(v.Nsp, sels)
 		v.Data = v.Data[:len(sels)*4]
 		mheap.Free(m, data)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		vs := v.Col.([]uint64)
 		data, err := mheap.Alloc(m, int64(len(vs)*8))
 		if err != nil {
@@ -1840,7 +1974,7 @@ func Shuffle(v *Vector, sels []int64, m *mheap.Mheap) error {
 		ws := make([][]interface{}, len(vs))
 		v.Col = shuffle.TupleShuffle(vs, ws, sels)
 		v.Nsp = nulls.Filter(v.Nsp, sels)
-	case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+	case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		vs := v.Col.(*types.Bytes)
 		odata, err := mheap.Alloc(m, int64(len(vs.Offsets)*4))
 		if err != nil {
@@ -1879,6 +2013,17 @@ func Shuffle(v *Vector, sels []int64, m *mheap.Mheap) error {
 		v.Nsp = nulls.Filter(v.Nsp, sels)
 		v.Data = v.Data[:len(sels)*8]
 		mheap.Free(m, data)
+	case types.T_time:
+		vs := v.Col.([]types.Time)
+		data, err := mheap.Alloc(m, int64(len(vs)*8))
+		if err != nil {
+			return err
+		}
+		ws := types.DecodeTimeSlice(data)
+		v.Col = shuffle.TimeShuffle(vs, ws, sels)
+		v.Nsp = nulls.Filter(v.Nsp, sels)
+		v.Data = v.Data[:len(sels)*8]
+		mheap.Free(m, data)
 	case types.T_timestamp:
 		vs := v.Col.([]types.Timestamp)
 		data, err := mheap.Alloc(m, int64(len(vs)*8))
@@ -1912,6 +2057,17 @@ func Shuffle(v *Vector, sels []int64, m *mheap.Mheap) error {
 		v.Nsp = nulls.Filter(v.Nsp, sels)
 		v.Data = v.Data[:len(sels)*1]
 		mheap.Free(m, data)
+	case types.T_uuid:
+		vs := v.Col.([]types.Uuid)
+		data, err := mheap.Alloc(m, int64(len(vs)*16))
+		if err != nil {
+			return err
+		}
+		ws := types.DecodeUuidSlice(data)
+		v.Col = shuffle.UuidShuffle(vs, ws, sels)
+		v.Nsp = nulls.Filter(v.Nsp, sels)
+		v.Data = v.Data[:len(sels)*16]
+		mheap.Free(m, data)
 	default:
 		panic(fmt.Sprintf("unexpect type %s for function vector.Shuffle", v.Typ))
 	}
@@ -1922,7 +2078,7 @@ func Shuffle(v *Vector, sels []int64, m *mheap.Mheap) error {
 func Copy(v, w *Vector, vi, wi int64, m *mheap.Mheap) error {
 	defer func() {
 		size := v.Typ.Oid.TypeLen()
-		if v.Typ.Oid != types.T_char && v.Typ.Oid != types.T_varchar && v.Typ.Oid != types.T_blob && v.Typ.Oid != types.T_json {
+		if v.Typ.Oid != types.T_char && v.Typ.Oid != types.T_varchar && v.Typ.Oid != types.T_blob && v.Typ.Oid != types.T_json && v.Typ.Oid != types.T_binary && v.Typ.Oid != types.T_varbinary {
 			v.Data = v.Data[:reflect.ValueOf(v.Col).Len()*size]
 		}
 	}()
@@ -1958,7 +2114,7 @@ func UnionOne(v, w *Vector, sel int64, m *mheap.Mheap) error {
 	}
 	defer func() {
 		size := v.Typ.Oid.TypeLen()
-		if v.Typ.Oid != types.T_char && v.Typ.Oid != types.T_varchar && v.Typ.Oid != types.T_blob && v.Typ.Oid != types.T_json {
+		if v.Typ.Oid != types.T_char && v.Typ.Oid != types.T_varchar && v.Typ.Oid != types.T_blob && v.Typ.Oid != types.T_json && v.Typ.Oid != types.T_binary && v.Typ.Oid != types.T_varbinary {
 			v.Data = v.Data[:reflect.ValueOf(v.Col).Len()*size]
 		}
 	}()
@@ -2130,7 +2286,7 @@ func UnionOne(v, w *Vector, sel int64, m *mheap.Mheap) error {
 			v.Col = vs
 			v.Data = v.Data[:len(vs)*1]
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		if len(v.Data) == 0 {
 			data, err := mheap.Alloc(m, 2*8)
 			if err != nil {
@@ -2186,7 +2342,7 @@ func UnionOne(v, w *Vector, sel int64, m *mheap.Mheap) error {
 			v.Col = vs
 			v.Data = v.Data[:len(vs)*4]
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		if len(v.Data) == 0 {
 			data, err := mheap.Alloc(m, 8*8)
 			if err != nil {
@@ -2275,7 +2431,7 @@ func UnionOne(v, w *Vector, sel int64, m *mheap.Mheap) error {
 		vs, ws := v.Col.([][]interface{}), w.Col.([][]interface{})
 		vs = append(vs, ws[sel])
 		v.Col = vs
-	case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+	case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		vs, ws := v.Col.(*types.Bytes), w.Col.(*types.Bytes)
 		from := ws.Get(sel)
 		if len(v.Data) == 0 {
@@ -2363,6 +2519,34 @@ func UnionOne(v, w *Vector, sel int64, m *mheap.Mheap) error {
 			v.Col = vs
 			v.Data = v.Data[:len(vs)*8]
 		}
+	case types.T_time:
+		if len(v.Data) == 0 {
+			data, err := mheap.Alloc(m, 8*8)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := types.DecodeTimeSlice(data)
+			vs[0] = w.Col.([]types.Time)[sel]
+			v.Col = vs[:1]
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Time)
+			if n := len(vs); n+1 >= cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*8], int64(n+1)*8)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = types.DecodeTimeSlice(data)
+				vs = vs[:n]
+				v.Col = vs
+				v.Data = data
+			}
+			vs = append(vs, w.Col.([]types.Time)[sel])
+			v.Col = vs
+			v.Data = v.Data[:len(vs)*8]
+		}
 	case types.T_timestamp:
 		if len(v.Data) == 0 {
 			data, err := mheap.Alloc(m, 8*8)
@@ -2447,6 +2631,34 @@ func UnionOne(v, w *Vector, sel int64, m *mheap.Mheap) error {
 			v.Col = vs
 			v.Data = v.Data[:len(vs)*16]
 		}
+	case types.T_uuid:
+		if len(v.Data) == 0 {
+			data, err := mheap.Alloc(m, 16*8)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := types.DecodeUuidSlice(data)
+			vs[0] = w.Col.([]types.Uuid)[sel]
+			v.Col = vs[:1]
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Uuid)
+			if n := len(vs); n+1 >= cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*16], int64(n+1)*16)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = types.DecodeUuidSlice(data)
+				vs = vs[:n]
+				v.Col = vs
+				v.Data = data
+			}
+			vs = append(vs, w.Col.([]types.Uuid)[sel])
+			v.Col = vs
+			v.Data = v.Data[:len(vs)*16]
+		}
 	}
 	if nulls.Any(w.Nsp) && nulls.Contains(w.Nsp, uint64(sel)) {
 		nulls.Add(v.Nsp, uint64(Length(v)-1))
@@ -2460,7 +2672,7 @@ func UnionNull(v, _ *Vector, m *mheap.Mheap) error {
 	}
 	defer func() {
 		size := v.Typ.Oid.TypeLen()
-		if v.Typ.Oid != types.T_char && v.Typ.Oid != types.T_varchar && v.Typ.Oid != types.T_blob && v.Typ.Oid != types.T_json {
+		if v.Typ.Oid != types.T_char && v.Typ.Oid != types.T_varchar && v.Typ.Oid != types.T_blob && v.Typ.Oid != types.T_json && v.Typ.Oid != types.T_binary && v.Typ.Oid != types.T_varbinary {
 			v.Data = v.Data[:reflect.ValueOf(v.Col).Len()*size]
 		}
 	}()
@@ -2620,7 +2832,7 @@ func UnionNull(v, _ *Vector, m *mheap.Mheap) error {
 			v.Col = vs
 			v.Data = v.Data[:len(vs)*1]
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		if len(v.Data) == 0 {
 			data, err := mheap.Alloc(m, 2*8)
 			if err != nil {
@@ -2672,7 +2884,7 @@ func UnionNull(v, _ *Vector, m *mheap.Mheap) error {
 			v.Col = vs
 			v.Data = v.Data[:len(vs)*4]
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		if len(v.Data) == 0 {
 			data, err := mheap.Alloc(m, 8*8)
 			if err != nil {
@@ -2750,7 +2962,7 @@ func UnionNull(v, _ *Vector, m *mheap.Mheap) error {
 			v.Col = vs
 			v.Data = v.Data[:len(vs)*8]
 		}
-	case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+	case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		vs := v.Col.(*types.Bytes)
 		n := len(vs.Offsets)
 		if n > 0 {
@@ -2812,6 +3024,32 @@ func UnionNull(v, _ *Vector, m *mheap.Mheap) error {
 			v.Col = vs
 			v.Data = v.Data[:len(vs)*8]
 		}
+	case types.T_time:
+		if len(v.Data) == 0 {
+			data, err := mheap.Alloc(m, 8*8)
+			if err != nil {
+				return err
+			}
+			vs := types.DecodeTimeSlice(data)
+			v.Col = vs[:1]
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Time)
+			if n := len(vs); n+1 >= cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*8], int64(n+1)*8)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = types.DecodeTimeSlice(data)
+				vs = vs[:n]
+				v.Col = vs
+				v.Data = data
+			}
+			vs = append(vs, vs[0])
+			v.Col = vs
+			v.Data = v.Data[:len(vs)*8]
+		}
 	case types.T_timestamp:
 		if len(v.Data) == 0 {
 			data, err := mheap.Alloc(m, 8*8)
@@ -2890,6 +3128,32 @@ func UnionNull(v, _ *Vector, m *mheap.Mheap) error {
 			v.Col = vs
 			v.Data = v.Data[:len(vs)*16]
 		}
+	case types.T_uuid:
+		if len(v.Data) == 0 {
+			data, err := mheap.Alloc(m, 16*8)
+			if err != nil {
+				return err
+			}
+			vs := types.DecodeUuidSlice(data)
+			v.Col = vs[:1]
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Uuid)
+			if n := len(vs); n+1 >= cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*16], int64(n+1)*16)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = types.DecodeUuidSlice(data)
+				vs = vs[:n]
+				v.Col = vs
+				v.Data = data
+			}
+			vs = append(vs, vs[0])
+			v.Col = vs
+			v.Data = v.Data[:len(vs)*16]
+		}
 	}
 	nulls.Add(v.Nsp, uint64(Length(v)-1))
 	return nil
@@ -2901,7 +3165,7 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 	}
 	defer func() {
 		size := v.Typ.Oid.TypeLen()
-		if v.Typ.Oid != types.T_char && v.Typ.Oid != types.T_varchar && v.Typ.Oid != types.T_blob && v.Typ.Oid != types.T_json {
+		if v.Typ.Oid != types.T_char && v.Typ.Oid != types.T_varchar && v.Typ.Oid != types.T_blob && v.Typ.Oid != types.T_json && v.Typ.Oid != types.T_binary && v.Typ.Oid != types.T_varbinary {
 			v.Data = v.Data[:reflect.ValueOf(v.Col).Len()*size]
 		}
 	}()
@@ -3033,7 +3297,7 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 			j++
 		}
 		v.Col = vs
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		cnt := len(sels)
 		ws := w.Col.([]uint16)
 		vs := v.Col.([]uint16)
@@ -3075,7 +3339,7 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 			j++
 		}
 		v.Col = vs
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		cnt := len(sels)
 		ws := w.Col.([]uint64)
 		vs := v.Col.([]uint64)
@@ -3138,7 +3402,7 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 			j++
 		}
 		v.Col = vs
-	case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+	case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		vs, ws := v.Col.(*types.Bytes), w.Col.(*types.Bytes)
 		incSize := 0
 		for _, sel := range sels {
@@ -3202,6 +3466,27 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 			j++
 		}
 		v.Col = vs
+	case types.T_time:
+		cnt := len(sels)
+		ws := w.Col.([]types.Time)
+		vs := v.Col.([]types.Time)
+		n := len(vs)
+		if n+cnt >= cap(vs) {
+			data, err := mheap.Grow(m, v.Data[:n], int64(n+cnt)*8)
+			if err != nil {
+				return err
+			}
+			mheap.Free(m, v.Data)
+			vs = types.DecodeTimeSlice(data)
+			v.Data = data
+		}
+		vs = vs[:n+cnt]
+		j := n
+		for i, sel := range sels {
+			vs[i] = ws[sel]
+			j++
+		}
+		v.Col = vs
 	case types.T_timestamp:
 		cnt := len(sels)
 		ws := w.Col.([]types.Timestamp)
@@ -3242,7 +3527,7 @@ func UnionBatch(v, w *Vector, offset int64, cnt int, flags []uint8, m *mheap.Mhe
 	}
 	defer func() {
 		size := v.Typ.Oid.TypeLen()
-		if v.Typ.Oid != types.T_char && v.Typ.Oid != types.T_varchar && v.Typ.Oid != types.T_blob && v.Typ.Oid != types.T_json {
+		if v.Typ.Oid != types.T_char && v.Typ.Oid != types.T_varchar && v.Typ.Oid != types.T_blob && v.Typ.Oid != types.T_json && v.Typ.Oid != types.T_binary && v.Typ.Oid != types.T_varbinary {
 			v.Data = v.Data[:reflect.ValueOf(v.Col).Len()*size]
 		}
 	}()
@@ -3507,7 +3792,7 @@ func UnionBatch(v, w *Vector, offset int64, cnt int, flags []uint8, m *mheap.Mhe
 			v.Col = vs
 		}
 
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		col := w.Col.([]uint16)
 		if len(v.Data) == 0 {
 			newSize := 8
@@ -3594,7 +3879,7 @@ func UnionBatch(v, w *Vector, offset int64, cnt int, flags []uint8, m *mheap.Mhe
 			v.Col = vs
 		}
 
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		col := w.Col.([]uint64)
 		if len(v.Data) == 0 {
 			newSize := 8
@@ -3733,7 +4018,7 @@ func UnionBatch(v, w *Vector, offset int64, cnt int, flags []uint8, m *mheap.Mhe
 		}
 		v.Col = vs
 
-	case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+	case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		vs, ws := v.Col.(*types.Bytes), w.Col.(*types.Bytes)
 		incSize := 0
 		for i, flag := range flags {
@@ -3860,6 +4145,49 @@ func UnionBatch(v, w *Vector, offset int64, cnt int, flags []uint8, m *mheap.Mhe
 			v.Col = vs
 		}
 
+	case types.T_time:
+		col := w.Col.([]types.Time)
+		if len(v.Data) == 0 {
+			newSize := 8
+			for newSize < cnt {
+				newSize <<= 1
+			}
+			data, err := mheap.Alloc(m, int64(newSize)*8)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := types.DecodeTimeSlice(data)[:cnt]
+			for i, j := 0, 0; i < len(flags); i++ {
+				if flags[i] > 0 {
+					vs[j] = col[int(offset)+i]
+					j++
+				}
+			}
+			v.Col = vs
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Time)
+			n := len(vs)
+			if n+cnt > cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*8], int64(n+cnt)*8)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = types.DecodeTimeSlice(data)
+				v.Data = data
+			}
+			vs = vs[:n+cnt]
+			for i, j := 0, n; i < len(flags); i++ {
+				if flags[i] > 0 {
+					vs[j] = col[int(offset)+i]
+					j++
+				}
+			}
+			v.Col = vs
+		}
+
 	case types.T_timestamp:
 		col := w.Col.([]types.Timestamp)
 		if len(v.Data) == 0 {
@@ -3988,6 +4316,49 @@ func UnionBatch(v, w *Vector, offset int64, cnt int, flags []uint8, m *mheap.Mhe
 			v.Col = vs
 		}
 
+	case types.T_uuid:
+		col := w.Col.([]types.Uuid)
+		if len(v.Data) == 0 {
+			newSize := 8
+			for newSize < cnt {
+				newSize <<= 1
+			}
+			data, err := mheap.Alloc(m, int64(newSize)*16)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := types.DecodeUuidSlice(data)[:cnt]
+			for i, j := 0, 0; i < len(flags); i++ {
+				if flags[i] > 0 {
+					vs[j] = col[int(offset)+i]
+					j++
+				}
+			}
+			v.Col = vs
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Uuid)
+			n := len(vs)
+			if n+cnt > cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*16], int64(n+cnt)*16)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = types.DecodeUuidSlice(data)
+				v.Data = data
+			}
+			vs = vs[:n+cnt]
+			for i, j := 0, n; i < len(flags); i++ {
+				if flags[i] > 0 {
+					vs[j] = col[int(offset)+i]
+					j++
+				}
+			}
+			v.Col = vs
+		}
+
 	}
 
 	for i, j := 0, uint64(oldLen); i < len(flags); i++ {
@@ -4077,7 +4448,7 @@ func (v *Vector) Show() ([]byte, error) {
 		}
 		buf.Write(types.EncodeUint8Slice(v.Col.([]uint8)))
 		return buf.Bytes(), nil
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		buf.Write(types.EncodeType(v.Typ))
 		nb, err := v.Nsp.Show()
 		if err != nil {
@@ -4101,7 +4472,7 @@ func (v *Vector) Show() ([]byte, error) {
 		}
 		buf.Write(types.EncodeUint32Slice(v.Col.([]uint32)))
 		return buf.Bytes(), nil
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		buf.Write(types.EncodeType(v.Typ))
 		nb, err := v.Nsp.Show()
 		if err != nil {
@@ -4161,6 +4532,18 @@ func (v *Vector) Show() ([]byte, error) {
 		}
 		buf.Write(types.EncodeDatetimeSlice(v.Col.([]types.Datetime)))
 		return buf.Bytes(), nil
+	case types.T_time:
+		buf.Write(types.EncodeType(v.Typ))
+		nb, err := v.Nsp.Show()
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(types.EncodeUint32(uint32(len(nb))))
+		if len(nb) > 0 {
+			buf.Write(nb)
+		}
+		buf.Write(types.EncodeTimeSlice(v.Col.([]types.Time)))
+		return buf.Bytes(), nil
 	case types.T_timestamp:
 		buf.Write(types.EncodeType(v.Typ))
 		nb, err := v.Nsp.Show()
@@ -4185,7 +4568,7 @@ func (v *Vector) Show() ([]byte, error) {
 		}
 		buf.Write(types.EncodeInt64Slice(v.Col.([]int64)))
 		return buf.Bytes(), nil
-	case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+	case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		buf.Write(types.EncodeType(v.Typ))
 		nb, err := v.Nsp.Show()
 		if err != nil {
@@ -4202,7 +4585,10 @@ func (v *Vector) Show() ([]byte, error) {
 			return buf.Bytes(), nil
 		}
 		buf.Write(types.EncodeUint32Slice(Col.Lengths))
-		buf.Write(Col.Data)
+		// the offsets are not encoded, so the values are written in order
+		for i := range Col.Offsets {
+			buf.Write(Col.Get(int64(i)))
+		}
 		return buf.Bytes(), nil
 	case types.T_tuple:
 		buf.Write(types.EncodeType(v.Typ))
@@ -4244,6 +4630,18 @@ func (v *Vector) Show() ([]byte, error) {
 		}
 		buf.Write(types.EncodeDecimal128Slice(v.Col.([]types.Decimal128)))
 		return buf.Bytes(), nil
+	case types.T_uuid:
+		buf.Write(types.EncodeType(v.Typ))
+		nb, err := v.Nsp.Show()
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(types.EncodeUint32(uint32(len(nb))))
+		if len(nb) > 0 {
+			buf.Write(nb)
+		}
+		buf.Write(types.EncodeUuidSlice(v.Col.([]types.Uuid)))
+		return buf.Bytes(), nil
 	default:
 		return nil, fmt.Errorf("unsupport types type %s", v.Typ.Oid)
 	}
@@ -4335,7 +4733,7 @@ func (v *Vector) Read(data []byte) error {
 			v.Data = data[size:]
 			v.Col = types.DecodeUint8Slice(data[size:])
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		size := types.DecodeUint32(data)
 		if size == 0 {
 			v.Data = data[4:]
@@ -4361,7 +4759,7 @@ func (v *Vector) Read(data []byte) error {
 			v.Data = data[size:]
 			v.Col = types.DecodeUint32Slice(data[size:])
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		size := types.DecodeUint32(data)
 		if size == 0 {
 			v.Data = data[4:]
@@ -4426,6 +4824,19 @@ func (v *Vector) Read(data []byte) error {
 			v.Data = data[size:]
 			v.Col = types.DecodeDatetimeSlice(data[size:])
 		}
+	case types.T_time:
+		size := types.DecodeUint32(data)
+		if size == 0 {
+			v.Data = data[4:]
+			v.Col = types.DecodeTimeSlice(data[4:])
+		} else {
+			data = data[4:]
+			if err := v.Nsp.Read(data[:size]); err != nil {
+				return err
+			}
+			v.Data = data[size:]
+			v.Col = types.DecodeTimeSlice(data[size:])
+		}
 	case types.T_timestamp:
 		size := types.DecodeUint32(data)
 		if size == 0 {
@@ -4439,7 +4850,7 @@ func (v *Vector) Read(data []byte) error {
 			v.Data = data[size:]
 			v.Col = types.DecodeTimestampSlice(data[size:])
 		}
-	case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+	case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		if v.Col == nil {
 			v.Col = &types.Bytes{}
 		}
@@ -4512,6 +4923,19 @@ func (v *Vector) Read(data []byte) error {
 			v.Data = data[size:]
 			v.Col = types.DecodeDecimal128Slice(data[size:])
 		}
+	case types.T_uuid:
+		size := types.DecodeUint32(data)
+		if size == 0 {
+			v.Data = data[4:]
+			v.Col = types.DecodeUuidSlice(data[4:])
+		} else {
+			data = data[4:]
+			if err := v.Nsp.Read(data[:size]); err != nil {
+				return err
+			}
+			v.Data = data[size:]
+			v.Col = types.DecodeUuidSlice(data[size:])
+		}
 	}
 	return nil
 }
@@ -4572,7 +4996,7 @@ func (v *Vector) String() string {
 				return fmt.Sprintf("%v", col[0])
 			}
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		col := v.Col.([]uint16)
 		if len(col) == 1 {
 			if nulls.Contains(v.Nsp, 0) {
@@ -4590,7 +5014,7 @@ func (v *Vector) String() string {
 				return fmt.Sprintf("%v", col[0])
 			}
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		col := v.Col.([]uint64)
 		if len(col) == 1 {
 			if nulls.Contains(v.Nsp, 0) {
@@ -4635,6 +5059,15 @@ func (v *Vector) String() string {
 				return fmt.Sprintf("%v", col[0])
 			}
 		}
+	case types.T_time:
+		col := v.Col.([]types.Time)
+		if len(col) == 1 {
+			if nulls.Contains(v.Nsp, 0) {
+				return "null"
+			} else {
+				return fmt.Sprintf("%v", col[0])
+			}
+		}
 	case types.T_timestamp:
 		col := v.Col.([]types.Timestamp)
 		if len(col) == 1 {
@@ -4662,7 +5095,7 @@ func (v *Vector) String() string {
 				return fmt.Sprintf("%v", col[0])
 			}
 		}
-	case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+	case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		col := v.Col.(*types.Bytes)
 		if len(col.Offsets) == 1 {
 			if nulls.Contains(v.Nsp, 0) {
@@ -4689,6 +5122,15 @@ func (v *Vector) String() string {
 				return fmt.Sprintf("%v", col[0])
 			}
 		}
+	case types.T_uuid:
+		col := v.Col.([]types.Uuid)
+		if len(col) == 1 {
+			if nulls.Contains(v.Nsp, 0) {
+				return "null"
+			} else {
+				return fmt.Sprintf("%v", col[0])
+			}
+		}
 	}
 	return fmt.Sprintf("%v-%s", v.Col, v.Nsp)
 }
@@ -4864,7 +5306,7 @@ func (v *Vector) GetColumnData(selectIndexs []int64, occurCounts []int64, rs []s
 				rs[i] = rs[i-1]
 			}
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		vs := v.Col.([]uint16)
 		for i := 0; i < rows; i++ {
 			index := i
@@ -4918,7 +5360,7 @@ func (v *Vector) GetColumnData(selectIndexs []int64, occurCounts []int64, rs []s
 				rs[i] = rs[i-1]
 			}
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		vs := v.Col.([]uint64)
 		for i := 0; i < rows; i++ {
 			index := i
@@ -4999,7 +5441,7 @@ func (v *Vector) GetColumnData(selectIndexs []int64, occurCounts []int64, rs []s
 				rs[i] = rs[i-1]
 			}
 		}
-	case types.T_char, types.T_varchar, types.T_blob, types.T_json:
+	case types.T_char, types.T_varchar, types.T_blob, types.T_json, types.T_binary, types.T_varbinary:
 		vs := v.Col.(*types.Bytes)
 		var i int64
 		for i = 0; i < int64(rows); i++ {
@@ -5081,6 +5523,33 @@ func (v *Vector) GetColumnData(selectIndexs []int64, occurCounts []int64, rs []s
 				rs[i] = rs[i-1]
 			}
 		}
+	case types.T_time:
+		vs := v.Col.([]types.Time)
+		for i := 0; i < rows; i++ {
+			index := i
+			count := occurCounts[i]
+			if count <= 0 {
+				i--
+				continue
+			}
+			if ifSel {
+				index = int(selectIndexs[i])
+			}
+			if allData {
+				rs[i] = vs[index].String()
+			} else {
+				if nulls.Contains(v.Nsp, uint64(index)) {
+					rs[i] = nullStr
+				} else {
+					rs[i] = vs[index].String()
+				}
+			}
+			for count > 1 {
+				count--
+				i++
+				rs[i] = rs[i-1]
+			}
+		}
 	case types.T_timestamp:
 		vs := v.Col.([]types.Timestamp)
 		for i := 0; i < rows; i++ {
@@ -5162,6 +5631,33 @@ func (v *Vector) GetColumnData(selectIndexs []int64, occurCounts []int64, rs []s
 				rs[i] = rs[i-1]
 			}
 		}
+	case types.T_uuid:
+		vs := v.Col.([]types.Uuid)
+		for i := 0; i < rows; i++ {
+			index := i
+			count := occurCounts[i]
+			if count <= 0 {
+				i--
+				continue
+			}
+			if ifSel {
+				index = int(selectIndexs[i])
+			}
+			if allData {
+				rs[i] = vs[index].String()
+			} else {
+				if nulls.Contains(v.Nsp, uint64(index)) {
+					rs[i] = nullStr
+				} else {
+					rs[i] = vs[index].String()
+				}
+			}
+			for count > 1 {
+				count--
+				i++
+				rs[i] = rs[i-1]
+			}
+		}
 	default:
 		return fmt.Errorf("unexpect type %v for function vector.GetColumnData", typ)
 	}
@@ -181,7 +181,7 @@ func (s *store) handleWithRetry(ctx context.Context,
 }
 
 func (s *store) maybeRetry(ctx context.Context, request *txn.TxnRequest, response *txn.TxnResponse) bool {
-	if request.Options != nil &&
+	if request.Options == nil ||
 		len(request.Options.RetryCodes) == 0 ||
 		response.TxnError == nil {
 		return false
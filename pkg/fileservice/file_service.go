@@ -66,6 +66,8 @@ type IOEntry struct {
 
 	// number of bytes to read or write, [1, len(file)]
 	// when reading, pass -1 to read to the end of file
+	// when writing, pass -1 with ReaderForWrite in the last entry to write until the reader returns EOF,
+	// only the ETL file services support it
 	Size int
 
 	// raw content
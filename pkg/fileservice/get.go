@@ -16,6 +16,7 @@ package fileservice
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
 )
 
@@ -46,3 +47,25 @@ func Get[T any](fs FileService, name string) (res T, err error) {
 	}
 	return
 }
+
+// GetForETL returns the etl file service of path and the path to access the file in it.
+// The path without a service is a local file, which is served by a local etl fs of its directory.
+func GetForETL(fs FileService, path string) (res ETLFileService, readPath string, err error) {
+	fsPath, err := ParsePath(path)
+	if err != nil {
+		return nil, "", err
+	}
+	if fsPath.Service == "" {
+		dir, file := filepath.Split(path)
+		res, err = NewLocalETLFS("etl", dir)
+		if err != nil {
+			return nil, "", err
+		}
+		return res, file, nil
+	}
+	res, err = Get[ETLFileService](fs, fsPath.Service)
+	if err != nil {
+		return nil, "", err
+	}
+	return res, fsPath.Full, nil
+}
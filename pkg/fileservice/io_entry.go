@@ -61,6 +61,21 @@ func (i *ioEntriesReader) Read(buf []byte) (n int, err error) {
 			return
 		}
 
+		// read until EOF
+		if entry.Size < 0 {
+			var bytesRead int
+			bytesRead, err = entry.ReaderForWrite.Read(buf)
+			i.entries[0].Offset += bytesRead
+			i.offset += bytesRead
+			n += bytesRead
+			if err == io.EOF {
+				err = nil
+				i.entries = i.entries[1:]
+				continue
+			}
+			return
+		}
+
 		// copy data
 		numBytes := entry.Size
 		if len(buf) < numBytes {
@@ -97,6 +112,19 @@ func (i *ioEntriesReader) Read(buf []byte) (n int, err error) {
 	}
 }
 
+// ioEntriesSize returns the size of the file written by the sorted entries,
+// it is -1 if the last entry is written until EOF
+func ioEntriesSize(entries []IOEntry) int64 {
+	if len(entries) == 0 {
+		return 0
+	}
+	last := entries[len(entries)-1]
+	if last.Size < 0 {
+		return -1
+	}
+	return int64(last.Offset + last.Size)
+}
+
 func (e *IOEntry) setObjectFromData() error {
 	if e.ToObject == nil {
 		return nil
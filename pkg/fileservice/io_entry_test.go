@@ -51,4 +51,18 @@ func TestIOEntriesReader(t *testing.T) {
 	}
 	assert.Nil(t, iotest.TestReader(newIOEntriesReader(entries), bytes.Repeat([]byte("a"), 1024)))
 
+	entries = []IOEntry{
+		{
+			Offset: 0,
+			Size:   2,
+			Data:   []byte("ab"),
+		},
+		{
+			Offset:         2,
+			Size:           -1,
+			ReaderForWrite: iotest.OneByteReader(bytes.NewReader([]byte("cde"))),
+		},
+	}
+	assert.Nil(t, iotest.TestReader(newIOEntriesReader(entries), []byte("abcde")))
+
 }
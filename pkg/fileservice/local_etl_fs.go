@@ -87,11 +87,7 @@ func (l *LocalETLFS) write(ctx context.Context, vector IOVector) error {
 	})
 
 	// size
-	var size int64
-	if len(vector.Entries) > 0 {
-		last := vector.Entries[len(vector.Entries)-1]
-		size = int64(last.Offset + last.Size)
-	}
+	size := ioEntriesSize(vector.Entries)
 
 	// write
 	if err := l.ensureTempDir(); err != nil {
@@ -105,12 +101,14 @@ func (l *LocalETLFS) write(ctx context.Context, vector IOVector) error {
 		return err
 	}
 	n, err := io.Copy(f, newIOEntriesReader(vector.Entries))
+	if err == nil && size >= 0 && n != size {
+		err = ErrSizeNotMatch
+	}
 	if err != nil {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
 		return err
 	}
-	if n != size {
-		return ErrSizeNotMatch
-	}
 	if err := f.Close(); err != nil {
 		return err
 	}
@@ -15,6 +15,8 @@
 package fileservice
 
 import (
+	"context"
+	"io"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -41,3 +43,58 @@ func TestLocalETLFS(t *testing.T) {
 	})
 
 }
+
+func TestLocalETLFSWriteUntilEOF(t *testing.T) {
+	ctx := context.Background()
+	fs, err := NewLocalETLFS("etl", t.TempDir())
+	assert.Nil(t, err)
+
+	pr, pw := io.Pipe()
+	go func() {
+		for i := 0; i < 3; i++ {
+			_, _ = pw.Write([]byte("abc"))
+		}
+		_ = pw.Close()
+	}()
+	err = fs.Write(ctx, IOVector{
+		FilePath: "foo",
+		Entries: []IOEntry{
+			{
+				Offset:         0,
+				Size:           -1,
+				ReaderForWrite: pr,
+			},
+		},
+	})
+	assert.Nil(t, err)
+
+	vec := IOVector{
+		FilePath: "foo",
+		Entries: []IOEntry{
+			{
+				Offset: 0,
+				Size:   -1,
+			},
+		},
+	}
+	assert.Nil(t, fs.Read(ctx, &vec))
+	assert.Equal(t, []byte("abcabcabc"), vec.Entries[0].Data)
+
+	// a failed reader leaves no file
+	pr, pw = io.Pipe()
+	_ = pw.CloseWithError(io.ErrClosedPipe)
+	err = fs.Write(ctx, IOVector{
+		FilePath: "bar",
+		Entries: []IOEntry{
+			{
+				Offset:         0,
+				Size:           -1,
+				ReaderForWrite: pr,
+			},
+		},
+	})
+	assert.ErrorIs(t, err, io.ErrClosedPipe)
+	entries, err := fs.List(ctx, "")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(entries))
+}
@@ -31,6 +31,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/matrixorigin/matrixone/pkg/util/fault"
 )
 
 // S3FS is a FileService implementation backed by S3
@@ -257,6 +259,10 @@ func (s *S3FS) Write(ctx context.Context, vector IOVector) error {
 }
 
 func (s *S3FS) write(ctx context.Context, vector IOVector) error {
+	if _, err := fault.Inject(fault.S3FSWrite); err != nil {
+		return err
+	}
+
 	path, err := ParsePathAtService(vector.FilePath, s.name)
 	if err != nil {
 		return err
@@ -269,16 +275,30 @@ func (s *S3FS) write(ctx context.Context, vector IOVector) error {
 	})
 
 	// size
-	var size int64
-	if len(vector.Entries) > 0 {
-		last := vector.Entries[len(vector.Entries)-1]
-		size = int64(last.Offset + last.Size)
+	size := ioEntriesSize(vector.Entries)
+	r := io.Reader(newIOEntriesReader(vector.Entries))
+
+	if size > s3MultipartThreshold {
+		return s.multipartWrite(ctx, key, r, size)
 	}
 
 	// put
-	content, err := io.ReadAll(newIOEntriesReader(vector.Entries))
-	if err != nil {
-		return err
+	var content []byte
+	if size < 0 {
+		// read one byte over the threshold to know if the content fits in one object
+		content, err = io.ReadAll(io.LimitReader(r, s3MultipartThreshold+1))
+		if err != nil {
+			return err
+		}
+		if len(content) > s3MultipartThreshold {
+			return s.multipartWrite(ctx, key, io.MultiReader(bytes.NewReader(content), r), -1)
+		}
+		size = int64(len(content))
+	} else {
+		content, err = io.ReadAll(r)
+		if err != nil {
+			return err
+		}
 	}
 	_, err = s.client.PutObject(
 		ctx,
@@ -296,6 +316,96 @@ func (s *S3FS) write(ctx context.Context, vector IOVector) error {
 	return nil
 }
 
+const (
+	// s3MultipartThreshold is the object size over which it is uploaded in parts
+	s3MultipartThreshold = 64 << 20
+	// s3PartSize is the size of every part but the last one, at least 5MB as required by s3
+	s3PartSize = 16 << 20
+)
+
+// multipartWrite uploads the content of r in parts, the upload is aborted if any part fails.
+// r is read until EOF if size is -1.
+func (s *S3FS) multipartWrite(ctx context.Context, key string, r io.Reader, size int64) (err error) {
+	output, err := s.client.CreateMultipartUpload(
+		ctx,
+		&s3.CreateMultipartUploadInput{
+			Bucket: ptrTo(s.bucket),
+			Key:    ptrTo(key),
+		},
+	)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_, _ = s.client.AbortMultipartUpload(
+				ctx,
+				&s3.AbortMultipartUploadInput{
+					Bucket:   ptrTo(s.bucket),
+					Key:      ptrTo(key),
+					UploadId: output.UploadId,
+				},
+			)
+		}
+	}()
+
+	var parts []s3types.CompletedPart
+	buf := make([]byte, s3PartSize)
+	for partNumber := int32(1); size != 0; partNumber++ {
+		n := int64(len(buf))
+		if size > 0 && size < n {
+			n = size
+		}
+		var bytesRead int
+		bytesRead, err = io.ReadFull(r, buf[:n])
+		if size < 0 && (err == io.EOF || err == io.ErrUnexpectedEOF) {
+			// the last part
+			err = nil
+			n = int64(bytesRead)
+			size = n
+		}
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			break
+		}
+		var part *s3.UploadPartOutput
+		part, err = s.client.UploadPart(
+			ctx,
+			&s3.UploadPartInput{
+				Bucket:        ptrTo(s.bucket),
+				Key:           ptrTo(key),
+				UploadId:      output.UploadId,
+				PartNumber:    partNumber,
+				Body:          bytes.NewReader(buf[:n]),
+				ContentLength: n,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		parts = append(parts, s3types.CompletedPart{
+			ETag:       part.ETag,
+			PartNumber: partNumber,
+		})
+		size -= n
+	}
+
+	_, err = s.client.CompleteMultipartUpload(
+		ctx,
+		&s3.CompleteMultipartUploadInput{
+			Bucket:   ptrTo(s.bucket),
+			Key:      ptrTo(key),
+			UploadId: output.UploadId,
+			MultipartUpload: &s3types.CompletedMultipartUpload{
+				Parts: parts,
+			},
+		},
+	)
+	return err
+}
+
 func (s *S3FS) Read(ctx context.Context, vector *IOVector) error {
 
 	if len(vector.Entries) == 0 {
@@ -315,6 +425,10 @@ func (s *S3FS) Read(ctx context.Context, vector *IOVector) error {
 }
 
 func (s *S3FS) read(ctx context.Context, vector *IOVector) error {
+	if _, err := fault.Inject(fault.S3FSRead); err != nil {
+		return err
+	}
+
 	path, err := ParsePathAtService(vector.FilePath, s.name)
 	if err != nil {
 		return err
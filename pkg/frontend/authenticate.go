@@ -465,6 +465,8 @@ var (
 		"mo_user_grant": 0,
 		"mo_role_grant": 0,
 		"mo_role_priv":  0,
+
+		"mo_user_defined_function": 0,
 	}
 	//the sqls creating many tables for the tenant.
 	//Wrap them in a transaction
@@ -523,6 +525,7 @@ var (
 				granted_time timestamp,
 				with_grant_option bool
 			);`,
+		createMoUserDefinedFunctionSql,
 	}
 
 	initMoAccountFormat = `insert into mo_catalog.mo_account(
@@ -706,9 +709,10 @@ func checkSysExistsOrNot(ctx context.Context, pu *config.ParameterUnit) (bool, e
 		return false, err
 	}
 
+	//an engine without any database returns no result set
 	results := bh.GetExecResultSet()
-	if len(results) != 1 {
-		panic("it must have result set")
+	if len(results) > 1 {
+		panic("it must have one result set at most")
 	}
 
 	rsset, err = convertIntoResultSet(results)
@@ -716,10 +720,12 @@ func checkSysExistsOrNot(ctx context.Context, pu *config.ParameterUnit) (bool, e
 		return false, err
 	}
 
-	for i := uint64(0); i < rsset[0].GetRowCount(); i++ {
-		_, err := rsset[0].GetString(i, 0)
-		if err != nil {
-			return false, err
+	for _, rs := range rsset {
+		for i := uint64(0); i < rs.GetRowCount(); i++ {
+			_, err := rs.GetString(i, 0)
+			if err != nil {
+				return false, err
+			}
 		}
 	}
 
@@ -732,8 +738,8 @@ func checkSysExistsOrNot(ctx context.Context, pu *config.ParameterUnit) (bool, e
 	}
 
 	results = bh.GetExecResultSet()
-	if len(results) != 1 {
-		panic("it must have result set")
+	if len(results) > 1 {
+		panic("it must have one result set at most")
 	}
 
 	rsset, err = convertIntoResultSet(results)
@@ -742,12 +748,14 @@ func checkSysExistsOrNot(ctx context.Context, pu *config.ParameterUnit) (bool, e
 	}
 
 	tableNames := []string{}
-	for i := uint64(0); i < rsset[0].GetRowCount(); i++ {
-		tableName, err := rsset[0].GetString(i, 0)
-		if err != nil {
-			return false, err
+	for _, rs := range rsset {
+		for i := uint64(0); i < rs.GetRowCount(); i++ {
+			tableName, err := rs.GetString(i, 0)
+			if err != nil {
+				return false, err
+			}
+			tableNames = append(tableNames, tableName)
 		}
-		tableNames = append(tableNames, tableName)
 	}
 
 	//if there is at least one catalog table, it denotes the sys tenant exists.
@@ -0,0 +1,45 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
+)
+
+// handleBackup takes an online backup of the storage embedded in the CN into
+// the dir of the file service of the CN. Only the moadmin of the sys account
+// can run it.
+func (mce *MysqlCmdExecutor) handleBackup(requestCtx context.Context, st *tree.Backup) error {
+	ses := mce.GetSession()
+	tenant := ses.GetTenantInfo()
+	if !(tenant.IsSysTenant() && tenant.IsMoAdminRole()) {
+		return moerr.NewInternalError("tenant %s user %s role %s do not have the privilege to backup", tenant.GetTenant(), tenant.GetUser(), tenant.GetDefaultRole())
+	}
+	be, ok := ses.GetStorage().(moengine.BackupEngine)
+	if !ok {
+		return moerr.NewInternalError("the storage of the cn does not support backup")
+	}
+	manifest, err := be.Backup(requestCtx, ses.Pu.FileService, st.Dir, st.Base)
+	if err != nil {
+		return err
+	}
+	logutil.Infof("backup to %s done, %d files", manifest.Dir, len(manifest.Files))
+	return nil
+}
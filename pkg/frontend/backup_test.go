@@ -0,0 +1,71 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fagongzi/goetty/v2/buf"
+	"github.com/golang/mock/gomock"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func Test_handleBackup(t *testing.T) {
+	ctx := context.TODO()
+	convey.Convey("backup needs the moadmin of sys and a storage with backup", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		eng := mock_frontend.NewMockEngine(ctrl)
+		txnClient := mock_frontend.NewMockTxnClient(ctrl)
+
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+
+		pu, err := getParameterUnit("test/system_vars_config.toml", eng, txnClient)
+		convey.So(err, convey.ShouldBeNil)
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
+		guestMmu := guest.New(pu.SV.GuestMmuLimitation, pu.HostMmu)
+		var gSys GlobalSystemVariables
+		InitGlobalSystemVariables(&gSys)
+
+		ses := NewSession(proto, guestMmu, pu.Mempool, pu, &gSys)
+		ses.SetRequestContext(ctx)
+		mce := NewMysqlCmdExecutor()
+		mce.PrepareSessionBeforeExecRequest(ses)
+
+		stmt, err := parsers.ParseOne(dialect.MYSQL, "backup to 'backups/b1'")
+		convey.So(err, convey.ShouldBeNil)
+		st := stmt.(*tree.Backup)
+		convey.So(IsAdministrativeStatement(st), convey.ShouldBeTrue)
+
+		ses.SetTenantInfo(&TenantInfo{Tenant: "acc1", User: "u1", DefaultRole: "r1"})
+		err = mce.handleBackup(ctx, st)
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.Error(), convey.ShouldContainSubstring, "privilege")
+
+		ses.SetTenantInfo(&TenantInfo{Tenant: sysAccountName, User: rootName, DefaultRole: moAdminRoleName})
+		err = mce.handleBackup(ctx, st)
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.Error(), convey.ShouldContainSubstring, "does not support backup")
+	})
+}
@@ -16,21 +16,23 @@ package frontend
 
 import (
 	"bufio"
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
-	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"io"
-	"os"
 	"strconv"
+	"strings"
 	"sync"
 
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
-var OpenFile = os.OpenFile
-
 type CloseExportData struct {
 	stopExportData chan interface{}
 	onceClose      sync.Once
@@ -72,42 +74,121 @@ func initExportFileParam(ep *tree.ExportParam, mrs *MysqlResultSet) {
 }
 
 var openNewFile = func(ep *tree.ExportParam, mrs *MysqlResultSet) error {
-	lineSize := ep.LineSize
-	var err error
+	switch ep.FileFormat {
+	case "", tree.CSV, tree.JSONLINE, tree.PARQUET:
+	default:
+		return fmt.Errorf("the export format '%s' is not supported", ep.FileFormat)
+	}
+	// the part of the line written when the last file was full is moved to the new file
+	pending := ep.OutputStr
+	ep.OutputStr = nil
+	ep.LineSize = 0
 	ep.CurFileSize = 0
-	filePath := getExportFilePath(ep.FilePath, ep.FileCnt)
-	ep.File, err = OpenFile(filePath, os.O_RDWR|os.O_EXCL|os.O_CREATE, 0o666)
-	if err != nil {
+	ep.Rows = 0
+	if err := createExportFile(ep); err != nil {
 		return err
 	}
-	ep.Writer = bufio.NewWriterSize(ep.File, int(ep.DefaultBufSize))
-	if ep.Header {
-		var header string
-		n := len(mrs.Columns)
-		if n == 0 {
-			return nil
-		}
-		for i := 0; i < n-1; i++ {
-			header += mrs.Columns[i].Name() + ep.Fields.Terminated
-		}
-		header += mrs.Columns[n-1].Name() + ep.Lines.TerminatedBy
-		if ep.MaxFileSize != 0 && uint64(len(header)) >= ep.MaxFileSize {
-			return errors.New("the header line size is over the maxFileSize")
+	switch ep.FileFormat {
+	case "", tree.CSV:
+		if ep.Header {
+			var header string
+			n := len(mrs.Columns)
+			if n == 0 {
+				return nil
+			}
+			for i := 0; i < n-1; i++ {
+				header += mrs.Columns[i].Name() + ep.Fields.Terminated
+			}
+			header += mrs.Columns[n-1].Name() + ep.Lines.TerminatedBy
+			if ep.MaxFileSize != 0 && uint64(len(header)) >= ep.MaxFileSize {
+				return errors.New("the header line size is over the maxFileSize")
+			}
+			if err := writeDataToCSVFile(ep, []byte(header)); err != nil {
+				return err
+			}
+			if err := flushLine(ep); err != nil {
+				return err
+			}
 		}
-		if err := writeDataToCSVFile(ep, []byte(header)); err != nil {
+	case tree.PARQUET:
+		w, err := newParquetFileWriter(ep.Writer, mrs)
+		if err != nil {
 			return err
 		}
+		ep.FormatWriter = w
+		return nil
 	}
-	if lineSize != 0 {
-		ep.LineSize = 0
-		ep.Rows = 0
-		if err := writeDataToCSVFile(ep, ep.OutputStr); err != nil {
+	if len(pending) != 0 {
+		if err := writeDataToCSVFile(ep, pending); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// createExportFile starts writing the current file to the file service of the export path,
+// the content written to ep.Writer is streamed into the file until it is closed.
+func createExportFile(ep *tree.ExportParam) error {
+	fs, filePath, err := fileservice.GetForETL(ep.FileService, getExportFilePath(ep.FilePath, ep.FileCnt))
+	if err != nil {
+		return err
+	}
+	// fail before running the query if the file exists, the file service checks it again at the write
+	dir, name := "", filePath
+	if i := strings.LastIndex(filePath, "/"); i >= 0 {
+		dir, name = filePath[:i], filePath[i+1:]
+	}
+	entries, err := fs.List(context.TODO(), dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if entry.Name == name {
+			return fileservice.ErrFileExisted
+		}
+	}
+
+	pr, pw := io.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		vec := fileservice.IOVector{
+			FilePath: filePath,
+			Entries: []fileservice.IOEntry{
+				{
+					Offset:         0,
+					Size:           -1,
+					ReaderForWrite: pr,
+				},
+			},
+		}
+		err := fs.Write(context.TODO(), vec)
+		// unblock the writer if the file service fails before reading all of the content
+		_ = pr.CloseWithError(err)
+		done <- err
+	}()
+	ep.Pipe = pw
+	ep.Writer = bufio.NewWriterSize(pw, int(ep.DefaultBufSize))
+	ep.WriteDone = done
+	return nil
+}
+
+// closeExportFile ends the current file and waits for the file service to write it,
+// nothing is written if err is not nil.
+func closeExportFile(ep *tree.ExportParam, err error) error {
+	ep.FormatWriter = nil
+	if ep.Pipe == nil {
+		return err
+	}
+	_ = ep.Pipe.CloseWithError(err)
+	if writeErr := <-ep.WriteDone; err == nil {
+		err = writeErr
+	}
+	ep.Pipe = nil
+	ep.Writer = nil
+	ep.WriteDone = nil
+	return err
+}
+
 func getExportFilePath(filename string, fileCnt uint) string {
 	if fileCnt == 0 {
 		return filename
@@ -137,25 +218,16 @@ var formatOutputString = func(oq *outputQueue, tmp, symbol []byte, enclosed byte
 	return nil
 }
 
-var Flush = func(ep *tree.ExportParam) error {
-	return ep.Writer.Flush()
-}
-
-var Seek = func(ep *tree.ExportParam) (int64, error) {
-	return ep.File.Seek(int64(ep.CurFileSize-ep.LineSize), io.SeekStart)
-}
-
-var Read = func(ep *tree.ExportParam) (int, error) {
-	ep.OutputStr = make([]byte, ep.LineSize)
-	return ep.File.Read(ep.OutputStr)
-}
-
-var Truncate = func(ep *tree.ExportParam) error {
-	return ep.File.Truncate(int64(ep.CurFileSize - ep.LineSize))
-}
-
+// Close writes the rest of the current file to the file service of the export path
 var Close = func(ep *tree.ExportParam) error {
-	return ep.File.Close()
+	var err error
+	if w, ok := ep.FormatWriter.(*parquetFileWriter); ok {
+		err = w.close()
+	}
+	if err == nil && ep.Writer != nil {
+		err = ep.Writer.Flush()
+	}
+	return closeExportFile(ep, err)
 }
 
 var Write = func(ep *tree.ExportParam, output []byte) (int, error) {
@@ -167,28 +239,10 @@ func writeToCSVFile(oq *outputQueue, output []byte) error {
 		if oq.ep.Rows == 0 {
 			return errors.New("the OneLine size is over the maxFileSize")
 		}
-		oq.ep.FileCnt++
-		if err := Flush(oq.ep); err != nil {
-			return err
-		}
-		if oq.ep.LineSize != 0 {
-			if _, err := Seek(oq.ep); err != nil {
-				return err
-			}
-			for {
-				if n, err := Read(oq.ep); err != nil {
-					return err
-				} else if uint64(n) == oq.ep.LineSize {
-					break
-				}
-			}
-			if err := Truncate(oq.ep); err != nil {
-				return err
-			}
-		}
 		if err := Close(oq.ep); err != nil {
 			return err
 		}
+		oq.ep.FileCnt++
 		if err := openNewFile(oq.ep, oq.mrs); err != nil {
 			return err
 		}
@@ -200,19 +254,153 @@ func writeToCSVFile(oq *outputQueue, output []byte) error {
 	return nil
 }
 
+// writeDataToCSVFile adds output to the current line, the line is written by flushLine
+// after it is complete so that it can be moved to the next file.
 var writeDataToCSVFile = func(ep *tree.ExportParam, output []byte) error {
-	for {
-		if n, err := Write(ep, output); err != nil {
+	ep.OutputStr = append(ep.OutputStr, output...)
+	ep.LineSize += uint64(len(output))
+	ep.CurFileSize += uint64(len(output))
+	return nil
+}
+
+// flushLine writes the current line into the file
+func flushLine(ep *tree.ExportParam) error {
+	if len(ep.OutputStr) == 0 {
+		return nil
+	}
+	for output := ep.OutputStr; len(output) > 0; {
+		n, err := Write(ep, output)
+		if err != nil {
 			return err
-		} else if n == len(output) {
-			break
 		}
+		output = output[n:]
 	}
-	ep.LineSize += uint64(len(output))
-	ep.CurFileSize += uint64(len(output))
+	ep.OutputStr = ep.OutputStr[:0]
+	ep.LineSize = 0
 	return nil
 }
 
+// exportData writes the row of oq in the format of the export
+func exportData(oq *outputQueue) error {
+	switch oq.ep.FileFormat {
+	case tree.JSONLINE:
+		return exportDataToJsonLineFile(oq)
+	case tree.PARQUET:
+		return exportDataToParquetFile(oq)
+	default:
+		return exportDataToCSVFile(oq)
+	}
+}
+
+// exportDataToJsonLineFile writes the row as a json object of the column names to the values
+func exportDataToJsonLineFile(oq *outputQueue) error {
+	oq.ep.LineSize = 0
+
+	oq.resetLineStr()
+	oq.lineStr = append(oq.lineStr, '{')
+	for i := uint64(0); i < oq.mrs.GetColumnCount(); i++ {
+		column, err := oq.mrs.GetColumn(i)
+		if err != nil {
+			return err
+		}
+		mysqlColumn, ok := column.(*MysqlColumn)
+		if !ok {
+			return fmt.Errorf("sendColumn need MysqlColumn")
+		}
+		if i > 0 {
+			oq.lineStr = append(oq.lineStr, ',')
+		}
+		name, err := json.Marshal(mysqlColumn.Name())
+		if err != nil {
+			return err
+		}
+		oq.lineStr = append(oq.lineStr, name...)
+		oq.lineStr = append(oq.lineStr, ':')
+		if isNil, err := oq.mrs.ColumnIsNull(0, i); err != nil {
+			return err
+		} else if isNil {
+			oq.lineStr = append(oq.lineStr, "null"...)
+			continue
+		}
+
+		switch mysqlColumn.ColumnType() {
+		case defines.MYSQL_TYPE_DECIMAL, defines.MYSQL_TYPE_BOOL:
+			value, err := oq.mrs.GetString(0, i)
+			if err != nil {
+				return err
+			}
+			oq.lineStr = append(oq.lineStr, value...)
+		case defines.MYSQL_TYPE_TINY, defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_LONG, defines.MYSQL_TYPE_YEAR:
+			value, err := oq.mrs.GetInt64(0, i)
+			if err != nil {
+				return err
+			}
+			oq.lineStr = strconv.AppendInt(oq.lineStr, value, 10)
+		case defines.MYSQL_TYPE_FLOAT, defines.MYSQL_TYPE_DOUBLE:
+			value, err := oq.mrs.GetFloat64(0, i)
+			if err != nil {
+				return err
+			}
+			data, err := json.Marshal(value)
+			if err != nil {
+				return err
+			}
+			oq.lineStr = append(oq.lineStr, data...)
+		case defines.MYSQL_TYPE_LONGLONG:
+			if uint32(mysqlColumn.Flag())&defines.UNSIGNED_FLAG != 0 {
+				value, err := oq.mrs.GetUint64(0, i)
+				if err != nil {
+					return err
+				}
+				oq.lineStr = strconv.AppendUint(oq.lineStr, value, 10)
+			} else {
+				value, err := oq.mrs.GetInt64(0, i)
+				if err != nil {
+					return err
+				}
+				oq.lineStr = strconv.AppendInt(oq.lineStr, value, 10)
+			}
+		case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING,
+			defines.MYSQL_TYPE_DATE, defines.MYSQL_TYPE_TIME, defines.MYSQL_TYPE_DATETIME, defines.MYSQL_TYPE_TIMESTAMP:
+			value, err := oq.mrs.GetValue(0, i)
+			if err != nil {
+				return err
+			}
+			var str string
+			switch v := value.(type) {
+			case []byte:
+				str = string(v)
+			case types.Date:
+				str = v.String()
+			default:
+				if str, err = oq.mrs.GetString(0, i); err != nil {
+					return err
+				}
+			}
+			data, err := json.Marshal(str)
+			if err != nil {
+				return err
+			}
+			oq.lineStr = append(oq.lineStr, data...)
+		case defines.MYSQL_TYPE_JSON:
+			value, err := oq.mrs.GetValue(0, i)
+			if err != nil {
+				return err
+			}
+			oq.lineStr = append(oq.lineStr, value.(bytejson.ByteJson).String()...)
+		default:
+			return fmt.Errorf("unsupported column type %d ", mysqlColumn.ColumnType())
+		}
+	}
+	oq.lineStr = append(oq.lineStr, '}')
+	oq.lineStr = append(oq.lineStr, oq.ep.Lines.TerminatedBy...)
+	if err := writeToCSVFile(oq, oq.lineStr); err != nil {
+		return err
+	}
+	oq.ep.Rows++
+	return flushLine(oq.ep)
+}
+
 func exportDataToCSVFile(oq *outputQueue) error {
 	oq.ep.LineSize = 0
 
@@ -353,11 +541,17 @@ func exportDataToCSVFile(oq *outputQueue) error {
 				return err
 			}
 		case defines.MYSQL_TYPE_TIME:
-			return fmt.Errorf("unsupported DATE/DATETIME/TIMESTAMP/MYSQL_TYPE_TIME")
+			value, err := oq.mrs.GetString(0, i)
+			if err != nil {
+				return err
+			}
+			if err = formatOutputString(oq, []byte(value), symbol[i], closeby, flag[i]); err != nil {
+				return err
+			}
 		default:
 			return fmt.Errorf("unsupported column type %d ", mysqlColumn.ColumnType())
 		}
 	}
 	oq.ep.Rows++
-	return nil
+	return flushLine(oq.ep)
 }
@@ -0,0 +1,247 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	goparquet "github.com/fraugster/parquet-go"
+	"github.com/fraugster/parquet-go/parquet"
+	"github.com/fraugster/parquet-go/parquetschema"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+)
+
+// parquetFileWriter writes the rows of the result set into a parquet file,
+// the column names of the file are the names of the result set columns
+// with a suffix if they are duplicated.
+type parquetFileWriter struct {
+	fw    *goparquet.FileWriter
+	names []string
+}
+
+func newParquetFileWriter(w io.Writer, mrs *MysqlResultSet) (*parquetFileWriter, error) {
+	root := &parquetschema.ColumnDefinition{
+		SchemaElement: &parquet.SchemaElement{Name: "result"},
+	}
+	names := make([]string, len(mrs.Columns))
+	seen := make(map[string]bool)
+	for i, column := range mrs.Columns {
+		mysqlColumn, ok := column.(*MysqlColumn)
+		if !ok {
+			return nil, fmt.Errorf("sendColumn need MysqlColumn")
+		}
+		name := mysqlColumn.Name()
+		for j := 1; seen[name]; j++ {
+			name = mysqlColumn.Name() + "_" + strconv.Itoa(j)
+		}
+		seen[name] = true
+		names[i] = name
+		elem, err := getParquetSchemaElement(mysqlColumn)
+		if err != nil {
+			return nil, err
+		}
+		elem.Name = name
+		root.Children = append(root.Children, &parquetschema.ColumnDefinition{SchemaElement: elem})
+	}
+	sd := parquetschema.SchemaDefinitionFromColumnDefinition(root)
+	if err := sd.Validate(); err != nil {
+		return nil, err
+	}
+	fw := goparquet.NewFileWriter(w,
+		goparquet.WithSchemaDefinition(sd),
+		goparquet.WithCompressionCodec(parquet.CompressionCodec_SNAPPY),
+		goparquet.WithCreator("matrixone"),
+	)
+	return &parquetFileWriter{fw: fw, names: names}, nil
+}
+
+func getParquetSchemaElement(column *MysqlColumn) (*parquet.SchemaElement, error) {
+	elem := &parquet.SchemaElement{
+		RepetitionType: parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_OPTIONAL),
+	}
+	unsigned := uint32(column.Flag())&defines.UNSIGNED_FLAG != 0
+	switch column.ColumnType() {
+	case defines.MYSQL_TYPE_BOOL:
+		elem.Type = parquet.TypePtr(parquet.Type_BOOLEAN)
+	case defines.MYSQL_TYPE_TINY, defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_YEAR:
+		elem.Type = parquet.TypePtr(parquet.Type_INT32)
+	case defines.MYSQL_TYPE_LONG:
+		if unsigned {
+			elem.Type = parquet.TypePtr(parquet.Type_INT64)
+		} else {
+			elem.Type = parquet.TypePtr(parquet.Type_INT32)
+		}
+	case defines.MYSQL_TYPE_LONGLONG:
+		elem.Type = parquet.TypePtr(parquet.Type_INT64)
+		if unsigned {
+			elem.ConvertedType = parquet.ConvertedTypePtr(parquet.ConvertedType_UINT_64)
+			elem.LogicalType = &parquet.LogicalType{INTEGER: &parquet.IntType{BitWidth: 64, IsSigned: false}}
+		}
+	case defines.MYSQL_TYPE_FLOAT:
+		elem.Type = parquet.TypePtr(parquet.Type_FLOAT)
+	case defines.MYSQL_TYPE_DOUBLE:
+		elem.Type = parquet.TypePtr(parquet.Type_DOUBLE)
+	case defines.MYSQL_TYPE_DECIMAL, defines.MYSQL_TYPE_TIME, defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING:
+		// the scale of the decimal is unknown here, and the time may be out of the range
+		// of the parquet time of day, so they are written as the text
+		elem.Type = parquet.TypePtr(parquet.Type_BYTE_ARRAY)
+		elem.ConvertedType = parquet.ConvertedTypePtr(parquet.ConvertedType_UTF8)
+		elem.LogicalType = &parquet.LogicalType{STRING: &parquet.StringType{}}
+	case defines.MYSQL_TYPE_DATE:
+		elem.Type = parquet.TypePtr(parquet.Type_INT32)
+		elem.ConvertedType = parquet.ConvertedTypePtr(parquet.ConvertedType_DATE)
+		elem.LogicalType = &parquet.LogicalType{DATE: &parquet.DateType{}}
+	case defines.MYSQL_TYPE_DATETIME, defines.MYSQL_TYPE_TIMESTAMP:
+		elem.Type = parquet.TypePtr(parquet.Type_INT64)
+		elem.LogicalType = &parquet.LogicalType{TIMESTAMP: &parquet.TimestampType{
+			IsAdjustedToUTC: false,
+			Unit:            &parquet.TimeUnit{MICROS: parquet.NewMicroSeconds()},
+		}}
+	case defines.MYSQL_TYPE_JSON:
+		elem.Type = parquet.TypePtr(parquet.Type_BYTE_ARRAY)
+		elem.ConvertedType = parquet.ConvertedTypePtr(parquet.ConvertedType_JSON)
+		elem.LogicalType = &parquet.LogicalType{JSON: &parquet.JsonType{}}
+	default:
+		return nil, fmt.Errorf("unsupported column type %d ", column.ColumnType())
+	}
+	return elem, nil
+}
+
+// getParquetValue returns the value of the column of the first row as the parquet type of the column
+func getParquetValue(mrs *MysqlResultSet, i uint64, column *MysqlColumn) (any, error) {
+	switch column.ColumnType() {
+	case defines.MYSQL_TYPE_BOOL:
+		value, err := mrs.GetValue(0, i)
+		if err != nil {
+			return nil, err
+		}
+		return value.(bool), nil
+	case defines.MYSQL_TYPE_TINY, defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_YEAR:
+		value, err := mrs.GetInt64(0, i)
+		return int32(value), err
+	case defines.MYSQL_TYPE_LONG:
+		value, err := mrs.GetInt64(0, i)
+		if uint32(column.Flag())&defines.UNSIGNED_FLAG != 0 {
+			return value, err
+		}
+		return int32(value), err
+	case defines.MYSQL_TYPE_LONGLONG:
+		if uint32(column.Flag())&defines.UNSIGNED_FLAG != 0 {
+			value, err := mrs.GetUint64(0, i)
+			return int64(value), err
+		}
+		return mrs.GetInt64(0, i)
+	case defines.MYSQL_TYPE_FLOAT:
+		value, err := mrs.GetFloat64(0, i)
+		return float32(value), err
+	case defines.MYSQL_TYPE_DOUBLE:
+		return mrs.GetFloat64(0, i)
+	case defines.MYSQL_TYPE_DECIMAL, defines.MYSQL_TYPE_TIME:
+		value, err := mrs.GetString(0, i)
+		return []byte(value), err
+	case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING:
+		value, err := mrs.GetValue(0, i)
+		if err != nil {
+			return nil, err
+		}
+		return value.([]byte), nil
+	case defines.MYSQL_TYPE_DATE:
+		value, err := mrs.GetValue(0, i)
+		if err != nil {
+			return nil, err
+		}
+		t := value.(types.Date).ToDatetime().ConvertToGoTime(time.UTC)
+		return int32(t.Unix() / (24 * 60 * 60)), nil
+	case defines.MYSQL_TYPE_DATETIME, defines.MYSQL_TYPE_TIMESTAMP:
+		value, err := mrs.GetString(0, i)
+		if err != nil {
+			return nil, err
+		}
+		dt, err := types.ParseDatetime(value, 6)
+		if err != nil {
+			return nil, err
+		}
+		return dt.ConvertToGoTime(time.UTC).UnixMicro(), nil
+	case defines.MYSQL_TYPE_JSON:
+		value, err := mrs.GetValue(0, i)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(value.(bytejson.ByteJson).String()), nil
+	default:
+		return nil, fmt.Errorf("unsupported column type %d ", column.ColumnType())
+	}
+}
+
+func (w *parquetFileWriter) close() error {
+	return w.fw.Close()
+}
+
+// exportDataToParquetFile adds the row into the parquet file, the file is closed
+// and a new one is opened before the row if the file is over MaxFileSize.
+func exportDataToParquetFile(oq *outputQueue) error {
+	w, ok := oq.ep.FormatWriter.(*parquetFileWriter)
+	if !ok {
+		return fmt.Errorf("the parquet file is not opened")
+	}
+	if oq.ep.MaxFileSize != 0 && oq.ep.Rows > 0 &&
+		uint64(w.fw.CurrentFileSize()+w.fw.CurrentRowGroupSize()) >= oq.ep.MaxFileSize {
+		if err := Close(oq.ep); err != nil {
+			return err
+		}
+		oq.ep.FileCnt++
+		oq.ep.Rows = 0
+		if err := openNewFile(oq.ep, oq.mrs); err != nil {
+			return err
+		}
+		w = oq.ep.FormatWriter.(*parquetFileWriter)
+	}
+	row := make(map[string]any, len(w.names))
+	for i := uint64(0); i < oq.mrs.GetColumnCount(); i++ {
+		column, err := oq.mrs.GetColumn(i)
+		if err != nil {
+			return err
+		}
+		mysqlColumn, ok := column.(*MysqlColumn)
+		if !ok {
+			return fmt.Errorf("sendColumn need MysqlColumn")
+		}
+		if isNil, err := oq.mrs.ColumnIsNull(0, i); err != nil {
+			return err
+		} else if isNil {
+			continue
+		}
+		value, err := getParquetValue(oq.mrs, i, mysqlColumn)
+		if err != nil {
+			return err
+		}
+		row[w.names[i]] = value
+	}
+	if err := w.fw.AddData(row); err != nil {
+		return err
+	}
+	oq.ep.Rows++
+	if oq.ep.DefaultBufSize > 0 && w.fw.CurrentRowGroupSize() >= oq.ep.DefaultBufSize {
+		if err := w.fw.FlushRowGroup(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
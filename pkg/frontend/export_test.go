@@ -15,16 +15,19 @@
 package frontend
 
 import (
-	"bufio"
 	"errors"
 	"os"
+	"path/filepath"
 	"testing"
 
+	goparquet "github.com/fraugster/parquet-go"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/prashantv/gostub"
 	"github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/require"
 )
 
 var colName1, colName2 = "DATABASE()", "VARIABLE_VALUE"
@@ -56,14 +59,13 @@ func Test_openNewFile(t *testing.T) {
 		var oq = &outputQueue{
 			mrs: &MysqlResultSet{},
 			ep: &tree.ExportParam{
-				Lines:    &tree.Lines{},
-				Fields:   &tree.Fields{},
-				Header:   true,
-				FilePath: "test/export.csv",
+				Lines:      &tree.Lines{},
+				Fields:     &tree.Fields{},
+				Header:     true,
+				FilePath:   "test/export.csv",
+				FileFormat: "xml",
 			},
 		}
-		stubs := gostub.StubFunc(&OpenFile, nil, errors.New("can not open file"))
-		defer stubs.Reset()
 		convey.So(openNewFile(oq.ep, oq.mrs), convey.ShouldNotBeNil)
 	})
 
@@ -71,11 +73,11 @@ func Test_openNewFile(t *testing.T) {
 		var oq = &outputQueue{
 			mrs: &MysqlResultSet{},
 			ep: &tree.ExportParam{
-				Lines:    &tree.Lines{},
-				Fields:   &tree.Fields{},
-				Header:   true,
-				FilePath: "test/export.csv",
-				LineSize: 1,
+				Lines:     &tree.Lines{},
+				Fields:    &tree.Fields{},
+				Header:    true,
+				FilePath:  filepath.Join(t.TempDir(), "export.csv"),
+				OutputStr: []byte{'1'},
 			},
 		}
 		col1 := new(MysqlColumn)
@@ -85,14 +87,11 @@ func Test_openNewFile(t *testing.T) {
 		oq.mrs.AddColumn(col1)
 		oq.mrs.AddColumn(col2)
 
-		var file = &os.File{}
-		stubs := gostub.StubFunc(&OpenFile, file, nil)
-		defer stubs.Reset()
-
-		stubs = gostub.StubFunc(&writeDataToCSVFile, nil)
+		stubs := gostub.StubFunc(&writeDataToCSVFile, nil)
 		defer stubs.Reset()
 
 		convey.So(openNewFile(oq.ep, oq.mrs), convey.ShouldBeNil)
+		convey.So(Close(oq.ep), convey.ShouldBeNil)
 	})
 }
 
@@ -128,66 +127,41 @@ func Test_writeToCSVFile(t *testing.T) {
 				Header:   true,
 				FilePath: "test/export.csv",
 				LineSize: 1,
-				Writer:   &bufio.Writer{},
 			},
 		}
 		var output = []byte{'1', '2'}
 		oq.ep.MaxFileSize = 1
+		write := func() error {
+			return writeToCSVFile(oq, output)
+		}
 
-		convey.So(writeToCSVFile(oq, output), convey.ShouldNotBeNil)
+		convey.So(write(), convey.ShouldNotBeNil)
 
 		oq.ep.Rows = 1
-		stubs := gostub.StubFunc(&Flush, errors.New("Flush error"))
-		defer stubs.Reset()
-
-		convey.So(writeToCSVFile(oq, output), convey.ShouldNotBeNil)
-
-		stubs = gostub.StubFunc(&Flush, nil)
-		defer stubs.Reset()
-
-		stubs = gostub.StubFunc(&Seek, int64(0), errors.New("Seek error"))
-		defer stubs.Reset()
-		convey.So(writeToCSVFile(oq, output), convey.ShouldNotBeNil)
-
-		stubs = gostub.StubFunc(&Seek, int64(0), nil)
-		defer stubs.Reset()
-		stubs = gostub.StubFunc(&Read, 0, errors.New("Read error"))
-		defer stubs.Reset()
-		convey.So(writeToCSVFile(oq, output), convey.ShouldNotBeNil)
-
-		stubs = gostub.StubFunc(&Read, 1, nil)
+		stubs := gostub.StubFunc(&Close, errors.New("Close error"))
 		defer stubs.Reset()
-
-		stubs = gostub.StubFunc(&Truncate, errors.New("Truncate error"))
-		defer stubs.Reset()
-		convey.So(writeToCSVFile(oq, output), convey.ShouldNotBeNil)
-
-		stubs = gostub.StubFunc(&Truncate, nil)
-		defer stubs.Reset()
-		stubs = gostub.StubFunc(&Close, errors.New("Close error"))
-		defer stubs.Reset()
-		convey.So(writeToCSVFile(oq, output), convey.ShouldNotBeNil)
+		convey.So(write(), convey.ShouldNotBeNil)
 
 		stubs = gostub.StubFunc(&Close, nil)
 		defer stubs.Reset()
 		stubs = gostub.StubFunc(&openNewFile, errors.New("openNewFile error"))
 		defer stubs.Reset()
-		convey.So(writeToCSVFile(oq, output), convey.ShouldNotBeNil)
+		convey.So(write(), convey.ShouldNotBeNil)
 
 		stubs = gostub.StubFunc(&openNewFile, nil)
 		defer stubs.Reset()
 		stubs = gostub.StubFunc(&writeDataToCSVFile, errors.New("writeDataToCSVFile error"))
 		defer stubs.Reset()
-		convey.So(writeToCSVFile(oq, output), convey.ShouldNotBeNil)
+		convey.So(write(), convey.ShouldNotBeNil)
 
 		stubs = gostub.StubFunc(&writeDataToCSVFile, nil)
 		defer stubs.Reset()
-		convey.So(writeToCSVFile(oq, output), convey.ShouldBeNil)
+		convey.So(write(), convey.ShouldBeNil)
 	})
 }
 
-func Test_writeDataToCSVFile(t *testing.T) {
-	convey.Convey("writeDataToCSVFile case", t, func() {
+func Test_flushLine(t *testing.T) {
+	convey.Convey("flushLine case", t, func() {
 		var oq = &outputQueue{
 			mrs: &MysqlResultSet{},
 			ep: &tree.ExportParam{
@@ -195,20 +169,21 @@ func Test_writeDataToCSVFile(t *testing.T) {
 				Fields:   &tree.Fields{},
 				Header:   true,
 				FilePath: "test/export.csv",
-				LineSize: 1,
-				Writer:   &bufio.Writer{},
 			},
 		}
 		var output = []byte{'1', '2'}
+		convey.So(writeDataToCSVFile(oq.ep, output), convey.ShouldBeNil)
+		convey.So(oq.ep.LineSize, convey.ShouldEqual, len(output))
+
 		stubs := gostub.StubFunc(&Write, 0, errors.New("writeDataToCSVFile error"))
 		defer stubs.Reset()
-
-		convey.So(writeDataToCSVFile(oq.ep, output), convey.ShouldNotBeNil)
+		convey.So(flushLine(oq.ep), convey.ShouldNotBeNil)
 
 		stubs = gostub.StubFunc(&Write, len(output), nil)
 		defer stubs.Reset()
-		convey.So(writeDataToCSVFile(oq.ep, output), convey.ShouldBeNil)
-
+		convey.So(flushLine(oq.ep), convey.ShouldBeNil)
+		convey.So(oq.ep.LineSize, convey.ShouldEqual, 0)
+		convey.So(oq.ep.OutputStr, convey.ShouldBeEmpty)
 	})
 }
 
@@ -222,7 +197,6 @@ func Test_exportDataToCSVFile(t *testing.T) {
 				Header:   true,
 				FilePath: "test/export.csv",
 				LineSize: 1,
-				Writer:   &bufio.Writer{},
 			},
 		}
 
@@ -270,7 +244,6 @@ func Test_exportDataToCSVFile(t *testing.T) {
 				Header:   true,
 				FilePath: "test/export.csv",
 				LineSize: 1,
-				Writer:   &bufio.Writer{},
 			},
 		}
 		var col = make([]MysqlColumn, 1)
@@ -292,3 +265,93 @@ func Test_exportDataToCSVFile(t *testing.T) {
 		convey.So(exportDataToCSVFile(oq), convey.ShouldBeNil)
 	})
 }
+
+// exportTestRows exports the rows of columns id, name and day into the files of path
+func exportTestRows(t *testing.T, path, format string, maxFileSize uint64, rows [][]interface{}) {
+	var oq = &outputQueue{
+		mrs: &MysqlResultSet{},
+		ep: &tree.ExportParam{
+			Outfile:     true,
+			FilePath:    path,
+			FileFormat:  format,
+			Lines:       &tree.Lines{TerminatedBy: "\n"},
+			Fields:      &tree.Fields{Terminated: ",", EnclosedBy: '"'},
+			Header:      true,
+			MaxFileSize: maxFileSize,
+		},
+	}
+	colTypes := []uint8{defines.MYSQL_TYPE_LONG, defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_DATE}
+	for i, name := range []string{"id", "name", "day"} {
+		col := new(MysqlColumn)
+		col.SetName(name)
+		col.SetColumnType(colTypes[i])
+		oq.mrs.AddColumn(col)
+	}
+	initExportFileParam(oq.ep, oq.mrs)
+	require.NoError(t, openNewFile(oq.ep, oq.mrs))
+	for _, row := range rows {
+		oq.mrs.Data = nil
+		oq.mrs.AddRow(row)
+		require.NoError(t, exportData(oq))
+	}
+	require.NoError(t, Close(oq.ep))
+}
+
+func Test_exportToFiles(t *testing.T) {
+	rows := [][]interface{}{
+		{int32(1), []byte("a"), types.Date(738000)},
+		{int32(2), nil, types.Date(738001)},
+		{int32(3), []byte("c,d"), nil},
+	}
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "result.csv")
+	exportTestRows(t, path, "", 30, rows)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "id,name,day\n1,\"a\",2021-07-30\n", string(data))
+	data, err = os.ReadFile(path + ".1")
+	require.NoError(t, err)
+	require.Equal(t, "id,name,day\n2,\\N,2021-07-31\n", string(data))
+	data, err = os.ReadFile(path + ".2")
+	require.NoError(t, err)
+	require.Equal(t, "id,name,day\n3,\"c,d\",\\N\n", string(data))
+
+	path = filepath.Join(dir, "result.json")
+	exportTestRows(t, path, tree.JSONLINE, 0, rows)
+	data, err = os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, `{"id":1,"name":"a","day":"2021-07-30"}
+{"id":2,"name":null,"day":"2021-07-31"}
+{"id":3,"name":"c,d","day":null}
+`, string(data))
+
+	// the existing file is not overwritten
+	ep := &tree.ExportParam{FilePath: path, FileFormat: tree.JSONLINE}
+	require.ErrorIs(t, openNewFile(ep, &MysqlResultSet{}), fileservice.ErrFileExisted)
+
+	// the file is not written if the export fails
+	failedPath := filepath.Join(dir, "failed.csv")
+	ep = &tree.ExportParam{FilePath: failedPath, Lines: &tree.Lines{}, Fields: &tree.Fields{}}
+	require.NoError(t, openNewFile(ep, &MysqlResultSet{}))
+	_, err = Write(ep, []byte("partial"))
+	require.NoError(t, err)
+	require.Error(t, closeExportFile(ep, errors.New("export failed")))
+	_, err = os.Stat(failedPath)
+	require.True(t, os.IsNotExist(err))
+
+	path = filepath.Join(dir, "result.parquet")
+	exportTestRows(t, path, tree.PARQUET, 0, rows)
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	fr, err := goparquet.NewFileReader(f)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), fr.NumRows())
+	row, err := fr.NextRow()
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"id": int32(1), "name": []byte("a"), "day": int32(18838)}, row)
+	row, err = fr.NextRow()
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"id": int32(2), "day": int32(18839)}, row)
+}
@@ -423,7 +423,7 @@ func extractColumnsInfoFromAttribute(schema *CatalogSchema, i int) []string {
 	//| attnum                | int           | UK    | The number of the column. Ordinary columns are numbered from 1 up.                                                                                                              |
 	ret[4] = fmt.Sprintf("%d", i)
 	//| att_length            | int           |       | bytes count for the type.                                                                                                                                                       |
-	if attr.GetType().Oid == types.T_varchar || attr.GetType().Oid == types.T_char {
+	if attr.GetType().Oid == types.T_varchar || attr.GetType().Oid == types.T_char || attr.GetType().Oid == types.T_binary || attr.GetType().Oid == types.T_varbinary {
 		ret[5] = fmt.Sprintf("%d", attr.GetType().Width)
 	} else {
 		ret[5] = fmt.Sprintf("%d", attr.GetType().Size)
@@ -297,17 +297,17 @@ func makeBatch(handler *ParseLineHandler, id int) *PoolElement {
 			vec.Col = make([]int64, batchSize)
 		case types.T_uint8:
 			vec.Col = make([]uint8, batchSize)
-		case types.T_uint16:
+		case types.T_uint16, types.T_enum:
 			vec.Col = make([]uint16, batchSize)
 		case types.T_uint32:
 			vec.Col = make([]uint32, batchSize)
-		case types.T_uint64:
+		case types.T_uint64, types.T_set, types.T_bit:
 			vec.Col = make([]uint64, batchSize)
 		case types.T_float32:
 			vec.Col = make([]float32, batchSize)
 		case types.T_float64:
 			vec.Col = make([]float64, batchSize)
-		case types.T_char, types.T_varchar, types.T_json:
+		case types.T_char, types.T_varchar, types.T_json, types.T_binary, types.T_varbinary:
 			vBytes := &types.Bytes{
 				Offsets: make([]uint32, batchSize),
 				Lengths: make([]uint32, batchSize),
@@ -318,10 +318,14 @@ func makeBatch(handler *ParseLineHandler, id int) *PoolElement {
 			vec.Col = make([]types.Date, batchSize)
 		case types.T_datetime:
 			vec.Col = make([]types.Datetime, batchSize)
+		case types.T_time:
+			vec.Col = make([]types.Time, batchSize)
 		case types.T_decimal64:
 			vec.Col = make([]types.Decimal64, batchSize)
 		case types.T_decimal128:
 			vec.Col = make([]types.Decimal128, batchSize)
+		case types.T_uuid:
+			vec.Col = make([]types.Uuid, batchSize)
 		case types.T_timestamp:
 			vec.Col = make([]types.Timestamp, batchSize)
 		default:
@@ -352,6 +356,9 @@ func initParseLineHandler(requestCtx context.Context, handler *ParseLineHandler)
 	for _, def := range defs {
 		attr, ok := def.(*engine.AttributeDef)
 		if ok {
+			if attr.Attr.Generated != nil {
+				return fmt.Errorf("load data into the generated column %s is not supported", attr.Attr.Name)
+			}
 			cols = append(cols, attr)
 		}
 	}
@@ -419,7 +426,7 @@ func releaseBatch(handler *ParseLineHandler, pl *PoolElement) {
 	for _, vec := range pl.bat.Vecs {
 		vec.Nsp = &nulls.Nulls{}
 		switch vec.Typ.Oid {
-		case types.T_char, types.T_varchar, types.T_json:
+		case types.T_char, types.T_varchar, types.T_json, types.T_binary, types.T_varbinary:
 			vBytes := vec.Col.(*types.Bytes)
 			vBytes.Data = vBytes.Data[:0]
 		}
@@ -910,7 +917,7 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 						}
 						cols[rowIdx] = d
 					}
-				case types.T_char, types.T_varchar:
+				case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary:
 					vBytes := vec.Col.(*types.Bytes)
 					if isNullOrEmpty {
 						nulls.Add(vec.Nsp, uint64(rowIdx))
@@ -976,6 +983,55 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 						}
 						cols[rowIdx] = d
 					}
+				case types.T_time:
+					cols := vec.Col.([]types.Time)
+					if isNullOrEmpty {
+						nulls.Add(vec.Nsp, uint64(rowIdx))
+					} else {
+						fs := field
+						d, err := types.ParseTime(fs, vec.Typ.Precision)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return makeParsedFailedError(vec.Typ.String(), field, vecAttr, base, offset)
+							}
+							result.Warnings++
+							d = 0
+						}
+						cols[rowIdx] = d
+					}
+				case types.T_enum:
+					cols := vec.Col.([]uint16)
+					if isNullOrEmpty {
+						nulls.Add(vec.Nsp, uint64(rowIdx))
+					} else {
+						d, err := types.ParseEnum(handler.cols[colIdx].Attr.EnumValues, field)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return makeParsedFailedError(vec.Typ.String(), field, vecAttr, base, offset)
+							}
+							result.Warnings++
+							d = 0
+						}
+						cols[rowIdx] = d
+					}
+				case types.T_set:
+					cols := vec.Col.([]uint64)
+					if isNullOrEmpty {
+						nulls.Add(vec.Nsp, uint64(rowIdx))
+					} else {
+						d, err := types.ParseSet(handler.cols[colIdx].Attr.EnumValues, field)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return makeParsedFailedError(vec.Typ.String(), field, vecAttr, base, offset)
+							}
+							result.Warnings++
+							d = 0
+						}
+						cols[rowIdx] = d
+					}
 				case types.T_decimal64:
 					cols := vec.Col.([]types.Decimal64)
 					if isNullOrEmpty {
@@ -1014,6 +1070,22 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 						}
 						cols[rowIdx] = d
 					}
+				case types.T_uuid:
+					cols := vec.Col.([]types.Uuid)
+					if isNullOrEmpty {
+						nulls.Add(vec.Nsp, uint64(rowIdx))
+					} else {
+						d, err := types.ParseUuid(field)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return makeParsedFailedError(vec.Typ.String(), field, vecAttr, base, offset)
+							}
+							result.Warnings++
+							d = types.Uuid{}
+						}
+						cols[rowIdx] = d
+					}
 				case types.T_timestamp:
 					cols := vec.Col.([]types.Timestamp)
 					if isNullOrEmpty {
@@ -1043,7 +1115,7 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 				if columnFLags[k] == 0 {
 					vec := batchData.Vecs[k]
 					switch vec.Typ.Oid {
-					case types.T_char, types.T_varchar, types.T_json:
+					case types.T_char, types.T_varchar, types.T_json, types.T_binary, types.T_varbinary:
 						vBytes := vec.Col.(*types.Bytes)
 						vBytes.Offsets[rowIdx] = uint32(len(vBytes.Data))
 						vBytes.Lengths[rowIdx] = uint32(0)
@@ -1419,7 +1491,7 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 						cols[i] = d
 					}
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary:
 				vBytes := vec.Col.(*types.Bytes)
 				//row
 				for i := 0; i < countOfLineArray; i++ {
@@ -1515,6 +1587,68 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 						cols[i] = d
 					}
 				}
+			case types.T_time:
+				cols := vec.Col.([]types.Time)
+				for i := 0; i < countOfLineArray; i++ {
+					line := fetchLines[i]
+					if j >= len(line) || len(line[j]) == 0 {
+						nulls.Add(vec.Nsp, uint64(i))
+					} else {
+						field := line[j]
+						//logutil.Infof("==== > field string [%s] ",fs)
+						d, err := types.ParseTime(field, vec.Typ.Precision)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return err
+							}
+							result.Warnings++
+							d = 0
+							//break
+						}
+						cols[i] = d
+					}
+				}
+			case types.T_enum:
+				cols := vec.Col.([]uint16)
+				for i := 0; i < countOfLineArray; i++ {
+					line := fetchLines[i]
+					if j >= len(line) || len(line[j]) == 0 {
+						nulls.Add(vec.Nsp, uint64(i))
+					} else {
+						field := line[j]
+						d, err := types.ParseEnum(handler.cols[colIdx].Attr.EnumValues, field)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return err
+							}
+							result.Warnings++
+							d = 0
+						}
+						cols[i] = d
+					}
+				}
+			case types.T_set:
+				cols := vec.Col.([]uint64)
+				for i := 0; i < countOfLineArray; i++ {
+					line := fetchLines[i]
+					if j >= len(line) || len(line[j]) == 0 {
+						nulls.Add(vec.Nsp, uint64(i))
+					} else {
+						field := line[j]
+						d, err := types.ParseSet(handler.cols[colIdx].Attr.EnumValues, field)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return err
+							}
+							result.Warnings++
+							d = 0
+						}
+						cols[i] = d
+					}
+				}
 			case types.T_decimal64:
 				cols := vec.Col.([]types.Decimal64)
 				for i := 0; i < countOfLineArray; i++ {
@@ -1559,6 +1693,28 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 						cols[i] = d
 					}
 				}
+			case types.T_uuid:
+				cols := vec.Col.([]types.Uuid)
+				for i := 0; i < countOfLineArray; i++ {
+					line := fetchLines[i]
+					if j >= len(line) || len(line[j]) == 0 {
+						nulls.Add(vec.Nsp, uint64(i))
+					} else {
+						field := line[j]
+						//logutil.Infof("==== > field string [%s] ",fs)
+						d, err := types.ParseUuid(field)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return err
+							}
+							result.Warnings++
+							d = types.Uuid{}
+							//break
+						}
+						cols[i] = d
+					}
+				}
 			case types.T_timestamp:
 				cols := vec.Col.([]types.Timestamp)
 				for i := 0; i < countOfLineArray; i++ {
@@ -1595,7 +1751,7 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 				//row
 				for i := 0; i < countOfLineArray; i++ {
 					switch vec.Typ.Oid {
-					case types.T_char, types.T_varchar, types.T_json:
+					case types.T_char, types.T_varchar, types.T_json, types.T_binary, types.T_varbinary:
 						vBytes := vec.Col.(*types.Bytes)
 						vBytes.Offsets[i] = uint32(len(vBytes.Data))
 						vBytes.Lengths[i] = uint32(0)
@@ -1745,7 +1901,7 @@ func writeBatchToStorage(handler *WriteBatchHandler, force bool) error {
 		for _, vec := range handler.batchData.Vecs {
 			vec.Nsp = &nulls.Nulls{}
 			switch vec.Typ.Oid {
-			case types.T_char, types.T_varchar, types.T_json:
+			case types.T_char, types.T_varchar, types.T_json, types.T_binary, types.T_varbinary:
 				vBytes := vec.Col.(*types.Bytes)
 				vBytes.Data = vBytes.Data[:0]
 			}
@@ -1785,13 +1941,13 @@ func writeBatchToStorage(handler *WriteBatchHandler, force bool) error {
 					case types.T_uint8:
 						cols := vec.Col.([]uint8)
 						vec.Col = cols[:needLen]
-					case types.T_uint16:
+					case types.T_uint16, types.T_enum:
 						cols := vec.Col.([]uint16)
 						vec.Col = cols[:needLen]
 					case types.T_uint32:
 						cols := vec.Col.([]uint32)
 						vec.Col = cols[:needLen]
-					case types.T_uint64:
+					case types.T_uint64, types.T_set, types.T_bit:
 						cols := vec.Col.([]uint64)
 						vec.Col = cols[:needLen]
 					case types.T_float32:
@@ -1800,7 +1956,7 @@ func writeBatchToStorage(handler *WriteBatchHandler, force bool) error {
 					case types.T_float64:
 						cols := vec.Col.([]float64)
 						vec.Col = cols[:needLen]
-					case types.T_char, types.T_varchar, types.T_json: //bytes is different
+					case types.T_char, types.T_varchar, types.T_json, types.T_binary, types.T_varbinary: //bytes is different
 						vBytes := vec.Col.(*types.Bytes)
 						//logutil.Infof("saveBatchToStorage before data %s ",vBytes.String())
 						if len(vBytes.Offsets) > needLen {
@@ -1814,12 +1970,18 @@ func writeBatchToStorage(handler *WriteBatchHandler, force bool) error {
 					case types.T_datetime:
 						cols := vec.Col.([]types.Datetime)
 						vec.Col = cols[:needLen]
+					case types.T_time:
+						cols := vec.Col.([]types.Time)
+						vec.Col = cols[:needLen]
 					case types.T_decimal64:
 						cols := vec.Col.([]types.Decimal64)
 						vec.Col = cols[:needLen]
 					case types.T_decimal128:
 						cols := vec.Col.([]types.Decimal128)
 						vec.Col = cols[:needLen]
+					case types.T_uuid:
+						cols := vec.Col.([]types.Uuid)
+						vec.Col = cols[:needLen]
 					case types.T_timestamp:
 						cols := vec.Col.([]types.Timestamp)
 						vec.Col = cols[:needLen]
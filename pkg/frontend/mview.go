@@ -0,0 +1,857 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	goErrors "errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// handleCreateMaterializedView fills the materialized view created by the
+// statement.
+func (mce *MysqlCmdExecutor) handleCreateMaterializedView(requestCtx context.Context, proc *process.Process, cv *tree.CreateView) error {
+	dbName, err := mce.GetSession().GetTxnCompilerContext().ensureDatabaseIsNotEmpty(string(cv.Name.SchemaName))
+	if err != nil {
+		return err
+	}
+	viewName := string(cv.Name.ObjectName)
+	_, tableDef := mce.GetSession().GetTxnCompilerContext().Resolve(dbName, viewName)
+	// if not exists keeps the existing relation untouched
+	if tableDef == nil || !plan2.IsMaterializedView(tableDef) {
+		return nil
+	}
+	view, viewData, err := plan2.GetMaterializedView(tableDef)
+	if err != nil {
+		return err
+	}
+	return mce.fullRefresh(requestCtx, proc, dbName, viewName, view, viewData)
+}
+
+// handleRefreshMaterializedView recomputes the materialized view, or applies
+// the changes of its base table since the last refresh if incremental refresh
+// is requested and supported by the view.
+func (mce *MysqlCmdExecutor) handleRefreshMaterializedView(requestCtx context.Context, proc *process.Process, st *tree.RefreshMaterializedView) error {
+	dbName, err := mce.GetSession().GetTxnCompilerContext().ensureDatabaseIsNotEmpty(string(st.Name.SchemaName))
+	if err != nil {
+		return err
+	}
+	viewName := string(st.Name.ObjectName)
+	_, tableDef := mce.GetSession().GetTxnCompilerContext().Resolve(dbName, viewName)
+	if tableDef == nil {
+		return errors.New(errno.UndefinedTable, fmt.Sprintf("materialized view '%v' doesn't exist", viewName))
+	}
+	view, viewData, err := plan2.GetMaterializedView(tableDef)
+	if err != nil {
+		return err
+	}
+	if st.Incremental {
+		if iv := plan2.BuildIncrementalView(tableDef, view, viewData.DefaultDatabase); iv != nil {
+			done, err := mce.incrementalRefresh(proc, dbName, viewName, iv)
+			if err != nil || done {
+				return err
+			}
+		}
+	}
+	return mce.fullRefresh(requestCtx, proc, dbName, viewName, view, viewData)
+}
+
+// fullRefresh replaces the rows of the materialized view with the result of
+// its query. The rows are written through the relation, direct DML on the
+// storage of a materialized view is rejected by the planner.
+func (mce *MysqlCmdExecutor) fullRefresh(requestCtx context.Context, proc *process.Process,
+	dbName, viewName string, view *tree.CreateView, viewData *plan2.ViewData) error {
+	ses := mce.GetSession()
+	tcc := ses.GetTxnCompilerContext()
+	ctx := ses.GetRequestContext()
+	db, err := ses.GetStorage().Database(ctx, dbName, ses.GetTxnHandler().GetTxn())
+	if err != nil {
+		return err
+	}
+	rel, err := db.Relation(ctx, viewName)
+	if err != nil {
+		return err
+	}
+	if err = deleteAllRows(ctx, proc, rel); err != nil {
+		return err
+	}
+	defs, err := rel.TableDefs(ctx)
+	if err != nil {
+		return err
+	}
+	var attrs []engine.Attribute
+	for _, def := range defs {
+		if attr, ok := def.(*engine.AttributeDef); ok && !attr.Attr.IsHidden {
+			attrs = append(attrs, attr.Attr)
+		}
+	}
+
+	// the query of the view is resolved in the database it was created in
+	defaultDB := tcc.DefaultDatabase()
+	tcc.SetDatabase(viewData.DefaultDatabase)
+	var mu sync.Mutex
+	err = mce.runStatement(requestCtx, proc, view.AsSource, func(_ interface{}, bat *batch.Batch) error {
+		if bat == nil || len(bat.Zs) == 0 {
+			return nil
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		return writeMVBatch(ctx, proc, rel, attrs, bat)
+	})
+	tcc.SetDatabase(defaultDB)
+	if err != nil {
+		return err
+	}
+
+	var ts timestamp.Timestamp
+	if cr, ok := rel.(engine.ChangesRelation); ok {
+		ts = cr.SnapshotTS()
+	}
+	return setMVRefreshTS(ctx, proc, db, viewName, ts)
+}
+
+// handleDropMaterializedView removes the refresh ts of the dropped view, it
+// does nothing for a plain view.
+func (mce *MysqlCmdExecutor) handleDropMaterializedView(proc *process.Process, dv *tree.DropView) error {
+	ses := mce.GetSession()
+	ctx := ses.GetRequestContext()
+	for _, name := range dv.Names {
+		dbName, err := ses.GetTxnCompilerContext().ensureDatabaseIsNotEmpty(string(name.SchemaName))
+		if err != nil {
+			return err
+		}
+		db, err := ses.GetStorage().Database(ctx, dbName, ses.GetTxnHandler().GetTxn())
+		if err != nil {
+			// dropped with if exists
+			continue
+		}
+		if err = deleteMVRefreshTS(ctx, proc, db, string(name.ObjectName)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runStatement runs the statement in the transaction of the session, the
+// settings of proc are copied to a new process. The result of a query is
+// passed to fill.
+func (mce *MysqlCmdExecutor) runStatement(requestCtx context.Context, proc *process.Process,
+	stmt tree.Statement, fill func(interface{}, *batch.Batch) error) error {
+	ses := mce.GetSession()
+	stmtProc := process.New(mheap.New(ses.GuestMmu))
+	stmtProc.Id = mce.getNextProcessId()
+	stmtProc.Lim = proc.Lim
+	stmtProc.SessionInfo = proc.SessionInfo
+	stmtProc.FileService = proc.FileService
+	cw := InitTxnComputationWrapper(ses, stmt, stmtProc)
+	ret, err := cw.Compile(requestCtx, ses, fill)
+	if err != nil {
+		return err
+	}
+	return ret.(ComputationRunner).Run(0)
+}
+
+// deleteAllRows deletes every row of the relation by its hidden key.
+func deleteAllRows(ctx context.Context, proc *process.Process, rel engine.Relation) error {
+	hideKeys, err := rel.GetHideKeys(ctx)
+	if err != nil {
+		return err
+	}
+	readers, err := rel.NewReader(ctx, 1, nil, nil)
+	if err != nil {
+		return err
+	}
+	// the rows are deleted after reading, so the reader is not affected
+	var rowIDs []*vector.Vector
+	for {
+		bat, err := readers[0].Read([]string{hideKeys[0].Name}, nil, proc.Mp)
+		if err != nil {
+			return err
+		}
+		if bat == nil {
+			break
+		}
+		if vector.Length(bat.Vecs[0]) > 0 {
+			rowIDs = append(rowIDs, bat.Vecs[0])
+		}
+	}
+	for _, vec := range rowIDs {
+		if err = rel.Delete(ctx, vec, hideKeys[0].Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeMVBatch writes a batch of the query result into the materialized view,
+// the constant vectors of the projection are expanded first.
+func writeMVBatch(ctx context.Context, proc *process.Process, rel engine.Relation,
+	attrs []engine.Attribute, bat *batch.Batch) error {
+	names := make([]string, len(attrs))
+	for i, attr := range attrs {
+		names[i] = attr.Name
+	}
+	out := batch.New(true, names)
+	out.Zs = bat.Zs
+	for i, vec := range bat.Vecs {
+		switch {
+		case vec.IsScalarNull():
+			out.Vecs[i] = vector.New(attrs[i].Type)
+			for range bat.Zs {
+				if err := mvAppendValue(out.Vecs[i], types.Null{}); err != nil {
+					return err
+				}
+			}
+		case vec.IsScalar():
+			out.Vecs[i] = vec.ConstExpand(proc.Mp)
+		default:
+			out.Vecs[i] = vec
+		}
+	}
+	return rel.Write(ctx, out)
+}
+
+// mvGroup is a row of an incrementally refreshed materialized view.
+type mvGroup struct {
+	// rowID is the physical address of the row, nil for new groups
+	rowID   any
+	vals    []any
+	changed bool
+	// stale is set if the min or max of the group has been deleted
+	stale bool
+}
+
+// incrementalRefresh returns false if the view must be recomputed, e.g. the
+// changes of the base table are unavailable, or a deleted row was the min or
+// max of a group which still has rows.
+func (mce *MysqlCmdExecutor) incrementalRefresh(proc *process.Process,
+	dbName, viewName string, iv *plan2.IncrementalView) (bool, error) {
+	ses := mce.GetSession()
+	ctx := ses.GetRequestContext()
+	txn := ses.GetTxnHandler().GetTxn()
+	db, err := ses.GetStorage().Database(ctx, dbName, txn)
+	if err != nil {
+		return false, err
+	}
+	from, ok, err := getMVRefreshTS(ctx, proc, db, viewName)
+	if err != nil || !ok || from.IsEmpty() {
+		return false, err
+	}
+	baseDB, err := ses.GetStorage().Database(ctx, iv.Database, txn)
+	if err != nil {
+		return false, err
+	}
+	rel, err := baseDB.Relation(ctx, iv.Table)
+	if err != nil {
+		return false, err
+	}
+	base, ok := rel.(engine.ChangesRelation)
+	if !ok {
+		return false, nil
+	}
+	mvRel, err := db.Relation(ctx, viewName)
+	if err != nil {
+		return false, err
+	}
+
+	var attrs []string
+	argIdx := make(map[string]int)
+	for _, col := range iv.Cols {
+		if _, ok := argIdx[col.Arg]; !ok && col.Arg != "" {
+			argIdx[col.Arg] = len(attrs)
+			attrs = append(attrs, col.Arg)
+		}
+	}
+	// virtual generated columns are not persisted, so their changes are unknown
+	defs, err := rel.TableDefs(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, def := range defs {
+		if attr, ok := def.(*engine.AttributeDef); ok && attr.Attr.Generated != nil && !attr.Attr.Generated.Stored {
+			if _, ok = argIdx[attr.Attr.Name]; ok {
+				return false, nil
+			}
+		}
+	}
+	inserted, deleted, err := base.Changes(ctx, from, attrs)
+	if goErrors.Is(err, engine.ErrChangesUnavailable) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if deleted.Length() > 0 && !canApplyDeletes(ctx, iv, base) {
+		return false, nil
+	}
+
+	m, err := newMVMerger(ctx, proc, iv, mvRel, argIdx)
+	if err != nil {
+		return false, err
+	}
+	for row := 0; row < deleted.Length(); row++ {
+		if !m.apply(deleted, row, -1) {
+			return false, nil
+		}
+	}
+	for row := 0; row < inserted.Length(); row++ {
+		if !m.apply(inserted, row, 1) {
+			return false, nil
+		}
+	}
+	if m.hasStaleGroup() {
+		return false, nil
+	}
+	if err = m.flush(ctx); err != nil {
+		return false, err
+	}
+	return true, setMVRefreshTS(ctx, proc, db, viewName, base.SnapshotTS())
+}
+
+// canApplyDeletes checks the groups can be maintained with deletes, which
+// needs count(*) to find out the empty groups, and the sums of nullable
+// columns need count(col) to find out the null ones.
+func canApplyDeletes(ctx context.Context, iv *plan2.IncrementalView, base engine.Relation) bool {
+	counts := make(map[string]bool)
+	for _, col := range iv.Cols {
+		if col.Agg == "count" {
+			counts[col.Arg] = true
+		}
+	}
+	if !counts[""] {
+		return false
+	}
+	defs, err := base.TableDefs(ctx)
+	if err != nil {
+		return false
+	}
+	nullable := make(map[string]bool)
+	for _, def := range defs {
+		if attr, ok := def.(*engine.AttributeDef); ok {
+			nullable[attr.Attr.Name] = !attr.Attr.Primary &&
+				(attr.Attr.Default == nil || attr.Attr.Default.NullAbility)
+		}
+	}
+	for _, col := range iv.Cols {
+		if col.Agg == "sum" && nullable[col.Arg] && !counts[col.Arg] {
+			return false
+		}
+	}
+	return true
+}
+
+type mvMerger struct {
+	iv      *plan2.IncrementalView
+	rel     engine.Relation
+	argIdx  map[string]int
+	names   []string
+	types   []types.Type
+	rowID   engine.Attribute
+	groups  map[string]*mvGroup
+	ordered []*mvGroup
+}
+
+func newMVMerger(ctx context.Context, proc *process.Process, iv *plan2.IncrementalView,
+	rel engine.Relation, argIdx map[string]int) (*mvMerger, error) {
+	m := &mvMerger{
+		iv:     iv,
+		rel:    rel,
+		argIdx: argIdx,
+		groups: make(map[string]*mvGroup),
+	}
+	defs, err := rel.TableDefs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	colTypes := make(map[string]types.Type)
+	for _, def := range defs {
+		if attr, ok := def.(*engine.AttributeDef); ok {
+			colTypes[attr.Attr.Name] = attr.Attr.Type
+		}
+	}
+	for _, col := range iv.Cols {
+		m.names = append(m.names, col.Name)
+		m.types = append(m.types, colTypes[col.Name])
+	}
+	hideKeys, err := rel.GetHideKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	m.rowID = *hideKeys[0]
+
+	attrs := append(append([]string{}, m.names...), m.rowID.Name)
+	readers, err := rel.NewReader(ctx, 1, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		bat, err := readers[0].Read(attrs, nil, proc.Mp)
+		if err != nil {
+			return nil, err
+		}
+		if bat == nil {
+			break
+		}
+		for row := 0; row < vector.Length(bat.Vecs[0]); row++ {
+			g := &mvGroup{
+				rowID: mvGetValue(bat.Vecs[len(m.names)], row),
+				vals:  make([]any, len(m.names)),
+			}
+			for i := range m.names {
+				g.vals[i] = mvGetValue(bat.Vecs[i], row)
+			}
+			m.groups[m.groupKey(g.vals)] = g
+			m.ordered = append(m.ordered, g)
+		}
+	}
+	return m, nil
+}
+
+// groupKey encodes the group by values of a row. Each value is a null marker
+// followed by its length-prefixed bytes, so distinct groups never share a key.
+func (m *mvMerger) groupKey(vals []any) string {
+	var key []byte
+	var size [binary.MaxVarintLen64]byte
+	for i, col := range m.iv.Cols {
+		if col.Agg != "" {
+			continue
+		}
+		if types.IsNull(vals[i]) {
+			key = append(key, 0)
+			continue
+		}
+		data, ok := vals[i].([]byte)
+		if !ok {
+			data = types.EncodeValue(vals[i], m.types[i])
+		}
+		key = append(key, 1)
+		key = append(key, size[:binary.PutUvarint(size[:], uint64(len(data)))]...)
+		key = append(key, data...)
+	}
+	return string(key)
+}
+
+// apply adds the row of the base table to its group if sign is 1, or removes
+// it if sign is -1, false is returned if the group can not be maintained.
+func (m *mvMerger) apply(bat *batch.Batch, row int, sign int64) bool {
+	args := make([]any, len(bat.Vecs))
+	for i, vec := range bat.Vecs {
+		args[i] = mvGetValue(vec, row)
+	}
+	vals := make([]any, len(m.iv.Cols))
+	for i, col := range m.iv.Cols {
+		if col.Agg == "" {
+			vals[i] = args[m.argIdx[col.Arg]]
+		}
+	}
+	key := m.groupKey(vals)
+	g, ok := m.groups[key]
+	if !ok {
+		if sign < 0 {
+			return false
+		}
+		for i, col := range m.iv.Cols {
+			switch col.Agg {
+			case "count":
+				vals[i] = int64(0)
+			case "sum", "min", "max":
+				vals[i] = types.Null{}
+			}
+		}
+		g = &mvGroup{vals: vals}
+		m.groups[key] = g
+		m.ordered = append(m.ordered, g)
+	}
+	g.changed = true
+
+	for i, col := range m.iv.Cols {
+		var arg any
+		if col.Arg != "" {
+			arg = args[m.argIdx[col.Arg]]
+		}
+		switch col.Agg {
+		case "count":
+			if col.Arg == "" || !types.IsNull(arg) {
+				g.vals[i] = g.vals[i].(int64) + sign
+			}
+		case "sum":
+			if types.IsNull(arg) {
+				continue
+			}
+			sum, ok := addValue(g.vals[i], arg, sign, m.types[i])
+			if !ok {
+				return false
+			}
+			g.vals[i] = sum
+		case "min", "max":
+			if types.IsNull(arg) {
+				continue
+			}
+			cur := g.vals[i]
+			if sign < 0 {
+				// the new min or max is unknown without the other rows
+				if types.IsNull(cur) || mvCompare(cur, arg) == 0 {
+					g.stale = true
+				}
+				continue
+			}
+			if types.IsNull(cur) {
+				g.vals[i] = arg
+				continue
+			}
+			res := mvCompare(arg, cur)
+			if (col.Agg == "min" && res < 0) || (col.Agg == "max" && res > 0) {
+				g.vals[i] = arg
+			}
+		}
+	}
+	return true
+}
+
+func addValue(sum, v any, sign int64, typ types.Type) (any, bool) {
+	switch typ.Oid {
+	case types.T_int64:
+		n, ok := toInt64(v)
+		if !ok {
+			return nil, false
+		}
+		if types.IsNull(sum) {
+			return sign * n, true
+		}
+		return sum.(int64) + sign*n, true
+	case types.T_uint64:
+		n, ok := toInt64(v)
+		if !ok {
+			return nil, false
+		}
+		if types.IsNull(sum) {
+			return uint64(sign * n), true
+		}
+		return uint64(int64(sum.(uint64)) + sign*n), true
+	case types.T_float64:
+		f, ok := toFloat64(v)
+		if !ok {
+			return nil, false
+		}
+		if types.IsNull(sum) {
+			return float64(sign) * f, true
+		}
+		return sum.(float64) + float64(sign)*f, true
+	}
+	return nil, false
+}
+
+func toInt64(v any) (int64, bool) {
+	switch n := v.(type) {
+	case int8:
+		return int64(n), true
+	case int16:
+		return int64(n), true
+	case int32:
+		return int64(n), true
+	case int64:
+		return n, true
+	case uint8:
+		return int64(n), true
+	case uint16:
+		return int64(n), true
+	case uint32:
+		return int64(n), true
+	case uint64:
+		return int64(n), true
+	}
+	return 0, false
+}
+
+func toFloat64(v any) (float64, bool) {
+	switch n := v.(type) {
+	case float32:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	if n, ok := toInt64(v); ok {
+		return float64(n), true
+	}
+	return 0, false
+}
+
+func (m *mvMerger) countIdx() int {
+	for i, col := range m.iv.Cols {
+		if col.Agg == "count" && col.Arg == "" {
+			return i
+		}
+	}
+	return -1
+}
+
+// hasStaleGroup checks if the min or max of a group which is not empty has
+// been deleted.
+func (m *mvMerger) hasStaleGroup() bool {
+	countIdx := m.countIdx()
+	for _, g := range m.ordered {
+		if g.stale && (countIdx < 0 || g.vals[countIdx].(int64) > 0) {
+			return true
+		}
+	}
+	return false
+}
+
+// flush replaces the changed rows of the view, the groups without rows are
+// removed.
+func (m *mvMerger) flush(ctx context.Context) error {
+	countIdx := m.countIdx()
+	rowIDs := vector.New(m.rowID.Type)
+	bat := batch.New(true, m.names)
+	for i, typ := range m.types {
+		bat.Vecs[i] = vector.New(typ)
+	}
+	rows := 0
+	for _, g := range m.ordered {
+		if !g.changed {
+			continue
+		}
+		if g.rowID != nil {
+			if err := mvAppendValue(rowIDs, g.rowID); err != nil {
+				return err
+			}
+		}
+		if countIdx >= 0 && g.vals[countIdx].(int64) <= 0 {
+			continue
+		}
+		m.nullEmptySums(g)
+		for i, v := range g.vals {
+			if err := mvAppendValue(bat.Vecs[i], v); err != nil {
+				return err
+			}
+		}
+		rows++
+	}
+	if vector.Length(rowIDs) > 0 {
+		if err := m.rel.Delete(ctx, rowIDs, m.rowID.Name); err != nil {
+			return err
+		}
+	}
+	if rows == 0 {
+		return nil
+	}
+	bat.Zs = make([]int64, rows)
+	for i := range bat.Zs {
+		bat.Zs[i] = 1
+	}
+	return m.rel.Write(ctx, bat)
+}
+
+// nullEmptySums sets the sums without non-null values to null.
+func (m *mvMerger) nullEmptySums(g *mvGroup) {
+	for i, col := range m.iv.Cols {
+		if col.Agg != "sum" {
+			continue
+		}
+		for j, count := range m.iv.Cols {
+			if count.Agg == "count" && count.Arg == col.Arg && g.vals[j].(int64) == 0 {
+				g.vals[i] = types.Null{}
+			}
+		}
+	}
+}
+
+var mvRefreshTableDefs = []engine.TableDef{
+	&engine.AttributeDef{Attr: engine.Attribute{
+		Name:    plan2.MVRefreshTableColNames[0],
+		Type:    types.T_varchar.ToType(),
+		Default: &plan.Default{},
+		Primary: true,
+	}},
+	&engine.AttributeDef{Attr: engine.Attribute{
+		Name:    plan2.MVRefreshTableColNames[1],
+		Type:    types.T_int64.ToType(),
+		Default: &plan.Default{},
+	}},
+	&engine.AttributeDef{Attr: engine.Attribute{
+		Name:    plan2.MVRefreshTableColNames[2],
+		Type:    types.T_int64.ToType(),
+		Default: &plan.Default{},
+	}},
+	&engine.PrimaryIndexDef{
+		Names: []string{plan2.MVRefreshTableColNames[0]},
+	},
+}
+
+// getMVRefreshTS returns false if the materialized view has no refresh ts.
+func getMVRefreshTS(ctx context.Context, proc *process.Process, db engine.Database, name string) (timestamp.Timestamp, bool, error) {
+	var ts timestamp.Timestamp
+	rel, err := db.Relation(ctx, plan2.MVRefreshTable)
+	if err != nil {
+		return ts, false, nil
+	}
+	readers, err := rel.NewReader(ctx, 1, nil, nil)
+	if err != nil {
+		return ts, false, err
+	}
+	for {
+		bat, err := readers[0].Read(plan2.MVRefreshTableColNames, nil, proc.Mp)
+		if err != nil || bat == nil {
+			return ts, false, err
+		}
+		names := bat.Vecs[0].Col.(*types.Bytes)
+		for row := 0; row < vector.Length(bat.Vecs[0]); row++ {
+			if string(names.Get(int64(row))) == name {
+				ts.PhysicalTime = bat.Vecs[1].Col.([]int64)[row]
+				ts.LogicalTime = uint32(bat.Vecs[2].Col.([]int64)[row])
+				return ts, true, nil
+			}
+		}
+	}
+}
+
+// setMVRefreshTS saves the refresh ts of the materialized view, a zero ts
+// makes the next incremental refresh recompute the view.
+func setMVRefreshTS(ctx context.Context, proc *process.Process, db engine.Database, name string, ts timestamp.Timestamp) error {
+	if err := deleteMVRefreshTS(ctx, proc, db, name); err != nil {
+		return err
+	}
+	rel, err := db.Relation(ctx, plan2.MVRefreshTable)
+	if err != nil {
+		if err = db.Create(ctx, plan2.MVRefreshTable, mvRefreshTableDefs); err != nil {
+			return err
+		}
+		if rel, err = db.Relation(ctx, plan2.MVRefreshTable); err != nil {
+			return err
+		}
+	}
+	bat := batch.New(true, plan2.MVRefreshTableColNames)
+	bat.Vecs[0] = vector.New(types.T_varchar.ToType())
+	bat.Vecs[1] = vector.New(types.T_int64.ToType())
+	bat.Vecs[2] = vector.New(types.T_int64.ToType())
+	for i, v := range []any{[]byte(name), ts.PhysicalTime, int64(ts.LogicalTime)} {
+		if err = mvAppendValue(bat.Vecs[i], v); err != nil {
+			return err
+		}
+	}
+	bat.Zs = []int64{1}
+	return rel.Write(ctx, bat)
+}
+
+// deleteMVRefreshTS removes the refresh ts of the materialized view if it
+// has one.
+func deleteMVRefreshTS(ctx context.Context, proc *process.Process, db engine.Database, name string) error {
+	_, found, err := getMVRefreshTS(ctx, proc, db, name)
+	if err != nil || !found {
+		return err
+	}
+	rel, err := db.Relation(ctx, plan2.MVRefreshTable)
+	if err != nil {
+		return err
+	}
+	vec := vector.New(types.T_varchar.ToType())
+	if err = mvAppendValue(vec, []byte(name)); err != nil {
+		return err
+	}
+	return rel.Delete(ctx, vec, plan2.MVRefreshTableColNames[0])
+}
+
+// mvGetValue returns the value of the row, types.Null for a null.
+func mvGetValue(vec *vector.Vector, row int) any {
+	if nulls.Contains(vec.Nsp, uint64(row)) {
+		return types.Null{}
+	}
+	if col, ok := vec.Col.(*types.Bytes); ok {
+		return col.Get(int64(row))
+	}
+	return reflect.ValueOf(vec.Col).Index(row).Interface()
+}
+
+// mvAppendValue appends a value returned by mvGetValue to the vector.
+func mvAppendValue(vec *vector.Vector, v any) error {
+	_, isBytes := vec.Col.(*types.Bytes)
+	if types.IsNull(v) {
+		nulls.Add(vec.Nsp, uint64(vector.Length(vec)))
+		if isBytes {
+			v = []byte{}
+		} else {
+			v = reflect.Zero(reflect.TypeOf(vec.Col).Elem()).Interface()
+		}
+	}
+	if isBytes {
+		return vector.Append(vec, [][]byte{v.([]byte)})
+	}
+	vals := reflect.MakeSlice(reflect.TypeOf(vec.Col), 1, 1)
+	vals.Index(0).Set(reflect.ValueOf(v))
+	return vector.Append(vec, vals.Interface())
+}
+
+// mvCompare compares two non-null values of the same type returned by
+// mvGetValue.
+func mvCompare(a, b any) int {
+	switch x := a.(type) {
+	case []byte:
+		return bytes.Compare(x, b.([]byte))
+	case bool:
+		switch y := b.(bool); {
+		case x == y:
+			return 0
+		case y:
+			return -1
+		}
+		return 1
+	case types.Decimal64:
+		return int(types.CompareDecimal64Decimal64Aligned(x, b.(types.Decimal64)))
+	case types.Decimal128:
+		return int(types.CompareDecimal128Decimal128Aligned(x, b.(types.Decimal128)))
+	case types.Uuid:
+		y := b.(types.Uuid)
+		return bytes.Compare(x[:], y[:])
+	}
+	// the other types are integers or floats, dates and times included
+	x, y := reflect.ValueOf(a), reflect.ValueOf(b)
+	switch x.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return compareOrdered(x.Int(), y.Int())
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return compareOrdered(x.Uint(), y.Uint())
+	case reflect.Float32, reflect.Float64:
+		return compareOrdered(x.Float(), y.Float())
+	}
+	panic(fmt.Sprintf("unsupported type %T", a))
+}
+
+func compareOrdered[T int64 | uint64 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,92 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func Test_mvMerger(t *testing.T) {
+	convey.Convey("group keys are unambiguous", t, func() {
+		m := &mvMerger{
+			iv: &plan2.IncrementalView{Cols: []plan2.IncrementalViewCol{
+				{Name: "a", Arg: "a"},
+				{Name: "b", Arg: "b"},
+				{Name: "c", Agg: "count"},
+			}},
+			types: []types.Type{types.T_varchar.ToType(), types.T_varchar.ToType(), types.T_int64.ToType()},
+		}
+		keys := make(map[string]bool)
+		for _, vals := range [][]any{
+			{[]byte("a b"), []byte(""), int64(1)},
+			{[]byte("a"), []byte("b"), int64(1)},
+			{[]byte("[a"), []byte("b]"), int64(1)},
+			{types.Null{}, []byte("b"), int64(1)},
+			{[]byte("{}"), []byte("b"), int64(1)},
+			{[]byte("<nil>"), []byte("b"), int64(1)},
+			{[]byte(""), []byte("b"), int64(1)},
+		} {
+			key := m.groupKey(vals)
+			convey.So(keys[key], convey.ShouldBeFalse)
+			keys[key] = true
+		}
+		// the aggregates are not a part of the key
+		convey.So(m.groupKey([]any{[]byte("a"), []byte("b"), int64(2)}), convey.ShouldEqual,
+			m.groupKey([]any{[]byte("a"), []byte("b"), int64(1)}))
+	})
+
+	convey.Convey("values are copied between vectors", t, func() {
+		for _, vals := range [][]any{
+			{int32(1), types.Null{}, int32(-3)},
+			{types.Date(10), types.Null{}, types.Date(5)},
+			{[]byte("x"), types.Null{}, []byte("")},
+			{types.Decimal128{}, types.Null{}, types.Decimal128{}},
+		} {
+			var typ types.Type
+			switch vals[0].(type) {
+			case int32:
+				typ = types.T_int32.ToType()
+			case types.Date:
+				typ = types.T_date.ToType()
+			case []byte:
+				typ = types.T_varchar.ToType()
+			default:
+				typ = types.T_decimal128.ToType()
+			}
+			vec := vector.New(typ)
+			for _, v := range vals {
+				convey.So(mvAppendValue(vec, v), convey.ShouldBeNil)
+			}
+			convey.So(vector.Length(vec), convey.ShouldEqual, len(vals))
+			for i, v := range vals {
+				convey.So(mvGetValue(vec, i), convey.ShouldResemble, v)
+			}
+		}
+	})
+
+	convey.Convey("values are compared by type", t, func() {
+		convey.So(mvCompare(int8(-1), int8(2)), convey.ShouldEqual, -1)
+		convey.So(mvCompare(uint64(3), uint64(3)), convey.ShouldEqual, 0)
+		convey.So(mvCompare(float64(2.5), float64(1)), convey.ShouldEqual, 1)
+		convey.So(mvCompare(types.Datetime(1), types.Datetime(2)), convey.ShouldEqual, -1)
+		convey.So(mvCompare([]byte("b"), []byte("a")), convey.ShouldEqual, 1)
+		convey.So(mvCompare(false, true), convey.ShouldEqual, -1)
+	})
+}
@@ -259,8 +259,8 @@ func (o *outputQueue) flush() error {
 		return nil
 	}
 	if o.ep.Outfile {
-		if err := exportDataToCSVFile(o); err != nil {
-			logutil.Errorf("export to csv file error %v \n", err)
+		if err := exportData(o); err != nil {
+			logutil.Errorf("export to %s file error %v \n", o.ep.FileFormat, err)
 			return err
 		}
 	} else {
@@ -686,7 +686,7 @@ func extractRowFromVector(ses *Session, vec *vector.Vector, i int, row []interfa
 				row[i] = vs.Get(rowIndex)
 			}
 		}
-	case types.T_varchar:
+	case types.T_varchar, types.T_binary, types.T_varbinary:
 		if !nulls.Any(vec.Nsp) { //all data in this column are not null
 			vs := vec.Col.(*types.Bytes)
 			row[i] = vs.Get(rowIndex)
@@ -698,6 +698,13 @@ func extractRowFromVector(ses *Session, vec *vector.Vector, i int, row []interfa
 				row[i] = vs.Get(rowIndex)
 			}
 		}
+	case types.T_bit:
+		if nulls.Contains(vec.Nsp, uint64(rowIndex)) { //is null
+			row[i] = nil
+		} else {
+			vs := vec.Col.([]uint64)
+			row[i] = types.EncodeBit(vs[rowIndex], vec.Typ.Width)
+		}
 	case types.T_date:
 		if !nulls.Any(vec.Nsp) { //all data in this column are not null
 			vs := vec.Col.([]types.Date)
@@ -723,6 +730,19 @@ func extractRowFromVector(ses *Session, vec *vector.Vector, i int, row []interfa
 				row[i] = vs[rowIndex].String2(precision)
 			}
 		}
+	case types.T_time:
+		precision := vec.Typ.Precision
+		if !nulls.Any(vec.Nsp) { //all data in this column are not null
+			vs := vec.Col.([]types.Time)
+			row[i] = vs[rowIndex].String2(precision)
+		} else {
+			if nulls.Contains(vec.Nsp, uint64(rowIndex)) { //is null
+				row[i] = nil
+			} else {
+				vs := vec.Col.([]types.Time)
+				row[i] = vs[rowIndex].String2(precision)
+			}
+		}
 	case types.T_timestamp:
 		precision := vec.Typ.Precision
 		if !nulls.Any(vec.Nsp) { //all data in this column are not null
@@ -762,6 +782,18 @@ func extractRowFromVector(ses *Session, vec *vector.Vector, i int, row []interfa
 				row[i] = vs[rowIndex].ToStringWithScale(scale)
 			}
 		}
+	case types.T_uuid:
+		if !nulls.Any(vec.Nsp) { //all data in this column are not null
+			vs := vec.Col.([]types.Uuid)
+			row[i] = vs[rowIndex].String()
+		} else {
+			if nulls.Contains(vec.Nsp, uint64(rowIndex)) {
+				row[i] = nil
+			} else {
+				vs := vec.Col.([]types.Uuid)
+				row[i] = vs[rowIndex].String()
+			}
+		}
 	case types.T_blob:
 		if !nulls.Any(vec.Nsp) { //all data in this column are not null
 			vs := vec.Col.(*types.Bytes)
@@ -1100,6 +1132,16 @@ func (mce *MysqlCmdExecutor) handleSetVar(sv *tree.SetVar) error {
 					return err
 				}
 			}
+			// SET NAMES charset COLLATE collation
+			if assign.Reserved != nil {
+				collation, err := GetSimpleExprValue(assign.Reserved)
+				if err != nil {
+					return err
+				}
+				if err = setVarFunc(true, assign.Global, "collation_connection", collation); err != nil {
+					return err
+				}
+			}
 		} else {
 			err = setVarFunc(assign.System, assign.Global, name, value)
 			if err != nil {
@@ -1708,6 +1750,9 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 		Database:     ses.GetDatabaseName(),
 		Version:      serverVersion,
 		TimeZone:     ses.timeZone,
+
+		BlockEncryptionMode: ses.GetBlockEncryptionMode(),
+		Sequences:           ses,
 	}
 	proc.FileService = ses.Pu.FileService
 
@@ -1739,6 +1784,8 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 	for _, cw := range cws {
 		ses.SetMysqlResultSet(&MysqlResultSet{})
 		stmt := cw.GetAst()
+		// the warnings are counted for each statement
+		proc.TakeWarnings()
 		ctx := mce.RecordStatement(requestCtx, ses, proc, cw, beginInstant)
 
 		/*
@@ -1798,6 +1845,7 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 
 		selfHandle = false
 		ses.GetTxnCompileCtx().SetQueryType(TXN_DEFAULT)
+		ses.GetTxnCompileCtx().ResetUdfs()
 
 		switch st := stmt.(type) {
 		case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction:
@@ -1900,6 +1948,41 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			if err = mce.handleCreateRole(requestCtx, st); err != nil {
 				goto handleFailed
 			}
+		case *tree.RefreshMaterializedView:
+			selfHandle = true
+			if err = mce.handleRefreshMaterializedView(requestCtx, proc, st); err != nil {
+				goto handleFailed
+			}
+		case *tree.CreateFunction:
+			selfHandle = true
+			if err = mce.handleCreateFunction(requestCtx, st); err != nil {
+				goto handleFailed
+			}
+		case *tree.DropFunction:
+			selfHandle = true
+			if err = mce.handleDropFunction(requestCtx, st); err != nil {
+				goto handleFailed
+			}
+		case *tree.CreateSequence:
+			selfHandle = true
+			if err = mce.handleCreateSequence(requestCtx, proc, st); err != nil {
+				goto handleFailed
+			}
+		case *tree.AlterSequence:
+			selfHandle = true
+			if err = mce.handleAlterSequence(requestCtx, proc, st); err != nil {
+				goto handleFailed
+			}
+		case *tree.DropSequence:
+			selfHandle = true
+			if err = mce.handleDropSequence(requestCtx, proc, st); err != nil {
+				goto handleFailed
+			}
+		case *tree.Backup:
+			selfHandle = true
+			if err = mce.handleBackup(requestCtx, st); err != nil {
+				goto handleFailed
+			}
 		}
 
 		if selfHandle {
@@ -1977,6 +2060,7 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			*/
 			if ses.ep.Outfile {
 				ses.ep.DefaultBufSize = ses.Pu.SV.ExportDataDefaultFlushSize
+				ses.ep.FileService = ses.Pu.FileService
 				initExportFileParam(ses.ep, ses.Mrs)
 				if err = openNewFile(ses.ep, ses.Mrs); err != nil {
 					goto handleFailed
@@ -1992,10 +2076,7 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			}
 
 			if ses.ep.Outfile {
-				if err = ses.ep.Writer.Flush(); err != nil {
-					goto handleFailed
-				}
-				if err = ses.ep.File.Close(); err != nil {
+				if err = Close(ses.ep); err != nil {
 					goto handleFailed
 				}
 			}
@@ -2033,6 +2114,16 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			if err = runner.Run(0); err != nil {
 				goto handleFailed
 			}
+			if cv, ok := stmt.(*tree.CreateView); ok && cv.Materialized {
+				if err = mce.handleCreateMaterializedView(requestCtx, proc, cv); err != nil {
+					goto handleFailed
+				}
+			}
+			if dv, ok := stmt.(*tree.DropView); ok {
+				if err = mce.handleDropMaterializedView(proc, dv); err != nil {
+					goto handleFailed
+				}
+			}
 
 			if !ses.Pu.SV.DisableRecordTimeElapsedOfSqlRequest {
 				logutil.Infof("time of Exec.Run : %s", time.Since(runBegin).String())
@@ -2054,7 +2145,9 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			switch stmt.(type) {
 			case *tree.CreateTable, *tree.DropTable, *tree.CreateDatabase, *tree.DropDatabase,
 				*tree.CreateIndex, *tree.DropIndex, *tree.Insert, *tree.Update,
-				*tree.CreateView, *tree.DropView, *tree.Load,
+				*tree.CreateView, *tree.DropView,
+				*tree.RefreshMaterializedView, *tree.CreateFunction, *tree.DropFunction,
+				*tree.CreateSequence, *tree.AlterSequence, *tree.DropSequence, *tree.Backup,
 				*tree.CreateAccount, *tree.DropAccount, *tree.AlterAccount,
 				*tree.CreateUser, *tree.DropUser, *tree.AlterUser,
 				*tree.CreateRole, *tree.DropRole, *tree.Revoke, *tree.Grant,
@@ -2065,6 +2158,15 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 					return fmt.Errorf("routine send response failed. error:%v ", err)
 				}
 
+			case *tree.Load:
+				// the lines skipped by the 'max_errors' option are the warnings
+				warnings := proc.TakeWarnings()
+				info := NewMysqlError(ER_LOAD_INFO, rspLen, 0, warnings, warnings, 0).Error()
+				resp := NewOkResponse(rspLen, 0, uint16(warnings), 0, int(COM_QUERY), info)
+				if err := mce.GetSession().protocol.SendResponse(resp); err != nil {
+					return fmt.Errorf("routine send response failed. error:%v ", err)
+				}
+
 			case *tree.PrepareStmt, *tree.PrepareString:
 				if mce.ses.Cmd == int(COM_STMT_PREPARE) {
 					if err := mce.ses.protocol.SendPrepareResponse(prepareStmt); err != nil {
@@ -2080,6 +2182,10 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 		}
 		goto handleNext
 	handleFailed:
+		if ses.ep.Outfile {
+			// drop the file being exported
+			_ = closeExportFile(ses.ep, err)
+		}
 		if !fromLoadData {
 			txnErr = ses.TxnRollbackSingleStatement(stmt)
 			if txnErr != nil {
@@ -2289,10 +2395,12 @@ StatementCanBeExecutedInUncommittedTransaction checks the statement can be execu
 func StatementCanBeExecutedInUncommittedTransaction(stmt tree.Statement) bool {
 	switch st := stmt.(type) {
 	//ddl statement
-	case *tree.CreateTable, *tree.CreateDatabase, *tree.CreateIndex, *tree.CreateView:
+	case *tree.CreateTable, *tree.CreateDatabase, *tree.CreateIndex, *tree.CreateView,
+		*tree.CreateSequence, *tree.AlterSequence, *tree.DropSequence:
 		return true
 		//dml statement
-	case *tree.Insert, *tree.Update, *tree.Delete, *tree.Select, *tree.Load:
+	case *tree.Insert, *tree.Update, *tree.Delete, *tree.Select, *tree.Load,
+		*tree.RefreshMaterializedView:
 		return true
 		//transaction
 	case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction:
@@ -2346,7 +2454,8 @@ func IsAdministrativeStatement(stmt tree.Statement) bool {
 		*tree.CreateUser, *tree.DropUser, *tree.AlterUser,
 		*tree.CreateRole, *tree.DropRole,
 		*tree.Revoke, *tree.Grant,
-		*tree.SetDefaultRole, *tree.SetRole, *tree.SetPassword:
+		*tree.SetDefaultRole, *tree.SetRole, *tree.SetPassword,
+		*tree.CreateFunction, *tree.DropFunction, *tree.Backup:
 		return true
 	case *tree.Use:
 		return st.IsUseRole()
@@ -2421,16 +2530,31 @@ func convertEngineTypeToMysqlType(engineType types.T, col *MysqlColumn) error {
 		col.SetColumnType(defines.MYSQL_TYPE_STRING)
 	case types.T_varchar:
 		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	case types.T_binary:
+		col.SetColumnType(defines.MYSQL_TYPE_STRING)
+		col.SetCharset(charsetBinary)
+		col.SetFlag(col.Flag() | uint16(defines.BINARY_FLAG))
+	case types.T_varbinary:
+		col.SetColumnType(defines.MYSQL_TYPE_VAR_STRING)
+		col.SetCharset(charsetBinary)
+		col.SetFlag(col.Flag() | uint16(defines.BINARY_FLAG))
+	case types.T_bit:
+		col.SetColumnType(defines.MYSQL_TYPE_BIT)
+		col.SetSigned(false)
 	case types.T_date:
 		col.SetColumnType(defines.MYSQL_TYPE_DATE)
 	case types.T_datetime:
 		col.SetColumnType(defines.MYSQL_TYPE_DATETIME)
+	case types.T_time:
+		col.SetColumnType(defines.MYSQL_TYPE_TIME)
 	case types.T_timestamp:
 		col.SetColumnType(defines.MYSQL_TYPE_TIMESTAMP)
 	case types.T_decimal64:
 		col.SetColumnType(defines.MYSQL_TYPE_DECIMAL)
 	case types.T_decimal128:
 		col.SetColumnType(defines.MYSQL_TYPE_DECIMAL)
+	case types.T_uuid:
+		col.SetColumnType(defines.MYSQL_TYPE_STRING)
 	case types.T_blob:
 		col.SetColumnType(defines.MYSQL_TYPE_BLOB)
 	default:
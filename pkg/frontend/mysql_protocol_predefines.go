@@ -25,6 +25,9 @@ type charsetCollationName struct {
 	collationName string
 }
 
+// charsetBinary is the collation id of the binary charset, the binary strings are sent with it
+const charsetBinary uint16 = 63
+
 // the map: collation id --> (charset, collation name)
 // Run the SQL below in Mysql 8.0.23 to get the map.
 // the SQL: select concat(RelationName,':\t\t{"',CHARACTER_SET_NAME,'",\t"',collation_name,'"},') from INFORMATION_SCHEMA.COLLATIONS order by id;
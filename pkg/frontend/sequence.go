@@ -0,0 +1,120 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+var _ process.Sequences = &Session{}
+
+// handleCreateSequence saves the sequence in the sequence table of the database
+// in the transaction of the statement.
+func (mce *MysqlCmdExecutor) handleCreateSequence(requestCtx context.Context, proc *process.Process, st *tree.CreateSequence) error {
+	dbName, err := mce.GetSession().GetTxnCompilerContext().ensureDatabaseIsNotEmpty(string(st.Name.SchemaName))
+	if err != nil {
+		return err
+	}
+	return colexec.CreateSequence(mce.GetSession().GetStorage(), requestCtx, mce.sequenceProcess(proc),
+		dbName, string(st.Name.ObjectName), st.Options, st.IfNotExists)
+}
+
+// handleAlterSequence changes the options of the sequence
+func (mce *MysqlCmdExecutor) handleAlterSequence(requestCtx context.Context, proc *process.Process, st *tree.AlterSequence) error {
+	dbName, err := mce.GetSession().GetTxnCompilerContext().ensureDatabaseIsNotEmpty(string(st.Name.SchemaName))
+	if err != nil {
+		return err
+	}
+	return colexec.AlterSequence(mce.GetSession().GetStorage(), requestCtx, mce.sequenceProcess(proc),
+		dbName, string(st.Name.ObjectName), st.Options, st.IfExists)
+}
+
+// handleDropSequence removes the sequence
+func (mce *MysqlCmdExecutor) handleDropSequence(requestCtx context.Context, proc *process.Process, st *tree.DropSequence) error {
+	dbName, err := mce.GetSession().GetTxnCompilerContext().ensureDatabaseIsNotEmpty(string(st.Name.SchemaName))
+	if err != nil {
+		return err
+	}
+	return colexec.DropSequence(mce.GetSession().GetStorage(), requestCtx, mce.sequenceProcess(proc),
+		dbName, string(st.Name.ObjectName), st.IfExists)
+}
+
+func (mce *MysqlCmdExecutor) sequenceProcess(proc *process.Process) *process.Process {
+	proc.TxnOperator = mce.GetSession().GetTxnHandler().GetTxn()
+	return proc
+}
+
+// newSequenceProcess returns the process reading the sequence table for the
+// sequence functions of the session.
+func (ses *Session) newSequenceProcess() *process.Process {
+	proc := process.New(mheap.New(ses.GuestMmu))
+	proc.TxnOperator = ses.GetTxnHandler().GetTxn()
+	return proc
+}
+
+func (ses *Session) sequenceContext() context.Context {
+	if ctx := ses.GetRequestContext(); ctx != nil {
+		return ctx
+	}
+	return context.Background()
+}
+
+// NextVal advances the sequence, the value is kept as the currval of the
+// sequence and the lastval of the session.
+func (ses *Session) NextVal(dbName, name string) (int64, error) {
+	v, err := colexec.NextSequenceValue(ses.GetStorage(), ses.Pu.TxnClient, ses.sequenceContext(), ses.newSequenceProcess(), dbName, name)
+	if err != nil {
+		return 0, err
+	}
+	ses.sequenceMu.Lock()
+	defer ses.sequenceMu.Unlock()
+	if ses.sequenceValues == nil {
+		ses.sequenceValues = make(map[string]int64)
+	}
+	ses.sequenceValues[dbName+"."+name] = v
+	ses.lastSequenceValue, ses.hasLastSequenceValue = v, true
+	return v, nil
+}
+
+// SetVal sets the last value of the sequence, it changes neither the currval
+// nor the lastval of the session.
+func (ses *Session) SetVal(dbName, name string, value int64, isCalled bool) error {
+	return colexec.SetSequenceValue(ses.GetStorage(), ses.Pu.TxnClient, ses.sequenceContext(), ses.newSequenceProcess(), dbName, name, value, isCalled)
+}
+
+func (ses *Session) CurrVal(dbName, name string) (int64, error) {
+	ses.sequenceMu.Lock()
+	defer ses.sequenceMu.Unlock()
+	v, ok := ses.sequenceValues[dbName+"."+name]
+	if !ok {
+		return 0, moerr.NewInternalError("currval of sequence '%s' is not yet defined in this session", name)
+	}
+	return v, nil
+}
+
+func (ses *Session) LastVal() (int64, error) {
+	ses.sequenceMu.Lock()
+	defer ses.sequenceMu.Unlock()
+	if !ses.hasLastSequenceValue {
+		return 0, moerr.NewInternalError("lastval is not yet defined in this session")
+	}
+	return ses.lastSequenceValue, nil
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
@@ -29,6 +30,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/cryptofunc"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
@@ -114,6 +117,13 @@ type Session struct {
 	uuid uuid.UUID
 
 	timeZone *time.Location
+
+	// the values most recently returned by nextval in the session, the
+	// parallel pipelines of a query can call nextval at the same time
+	sequenceMu           sync.Mutex
+	sequenceValues       map[string]int64
+	lastSequenceValue    int64
+	hasLastSequenceValue bool
 }
 
 func NewSession(proto Protocol, gm *guest.Mmu, mp *mempool.Mempool, PU *config.ParameterUnit, gSysVars *GlobalSystemVariables) *Session {
@@ -199,6 +209,16 @@ func (ses *Session) GetTimeZone() *time.Location {
 	return ses.timeZone
 }
 
+// GetBlockEncryptionMode gets the block_encryption_mode used by aes_encrypt and aes_decrypt
+func (ses *Session) GetBlockEncryptionMode() string {
+	if v, err := ses.GetSessionVar("block_encryption_mode"); err == nil {
+		if mode, ok := v.(string); ok {
+			return mode
+		}
+	}
+	return cryptofunc.DefaultBlockEncryptionMode
+}
+
 func (ses *Session) SetMysqlResultSet(mrs *MysqlResultSet) {
 	ses.Mrs = mrs
 }
@@ -788,6 +808,8 @@ type TxnCompilerContext struct {
 	QryTyp     QueryType
 	txnHandler *TxnHandler
 	ses        *Session
+	// user-defined functions of the tenant, loaded once per statement
+	udfs map[string]*function.Udf
 }
 
 func InitTxnCompilerContext(txn *TxnHandler, db string) *TxnCompilerContext {
@@ -889,15 +911,18 @@ func (tcc *TxnCompilerContext) Resolve(dbName string, tableName string) (*plan2.
 			cols = append(cols, &plan2.ColDef{
 				Name: attr.Attr.Name,
 				Typ: &plan2.Type{
-					Id:        int32(attr.Attr.Type.Oid),
-					Width:     attr.Attr.Type.Width,
-					Precision: attr.Attr.Type.Precision,
-					Scale:     attr.Attr.Type.Scale,
+					Id:         int32(attr.Attr.Type.Oid),
+					Width:      attr.Attr.Type.Width,
+					Precision:  attr.Attr.Type.Precision,
+					Scale:      attr.Attr.Type.Scale,
+					Enumvalues: attr.Attr.EnumValues,
+					Collation:  int32(attr.Attr.Type.Collation),
 				},
 				Primary:       attr.Attr.Primary,
 				Default:       attr.Attr.Default,
 				Comment:       attr.Attr.Comment,
 				AutoIncrement: attr.Attr.AutoIncrement,
+				Generated:     attr.Attr.Generated,
 			})
 		} else if pro, ok := def.(*engine.PropertiesDef); ok {
 			for _, p := range pro.Properties {
@@ -972,6 +997,70 @@ func (tcc *TxnCompilerContext) Resolve(dbName string, tableName string) (*plan2.
 	return obj, tableDef
 }
 
+func (tcc *TxnCompilerContext) ResolveUdf(dbName string, funcName string) (*function.Udf, error) {
+	if len(dbName) == 0 {
+		dbName = tcc.DefaultDatabase()
+	}
+	if len(dbName) == 0 {
+		return nil, nil
+	}
+	if tcc.udfs == nil {
+		udfs, err := tcc.loadUdfs()
+		if err != nil {
+			return nil, err
+		}
+		tcc.udfs = udfs
+	}
+	return tcc.udfs[dbName+"."+strings.ToLower(funcName)], nil
+}
+
+// ResetUdfs drops the user-defined functions loaded by the last statement.
+func (tcc *TxnCompilerContext) ResetUdfs() {
+	tcc.udfs = nil
+}
+
+// loadUdfs reads all user-defined functions of the tenant at once, so a
+// statement queries mo_catalog once however many functions it calls.
+func (tcc *TxnCompilerContext) loadUdfs() (map[string]*function.Udf, error) {
+	udfs := make(map[string]*function.Udf)
+	if !tcc.udfTableExists() {
+		return udfs, nil
+	}
+	ses := tcc.ses
+	rsset, err := executeSQLInBackgroundSession(ses.GetRequestContext(), ses.GuestMmu, ses.Mempool, ses.Pu, getAllUdfsSql)
+	if err != nil {
+		return nil, err
+	}
+	for _, rs := range rsset {
+		for i := uint64(0); i < rs.GetRowCount(); i++ {
+			udf := &function.Udf{}
+			if udf.Db, err = rs.GetString(i, 0); err != nil {
+				return nil, err
+			}
+			if udf.Name, err = rs.GetString(i, 1); err != nil {
+				return nil, err
+			}
+			if udf.Definition, err = rs.GetString(i, 2); err != nil {
+				return nil, err
+			}
+			udfs[udf.Db+"."+udf.Name] = udf
+		}
+	}
+	return udfs, nil
+}
+
+// udfTableExists checks the tenant has mo_user_defined_function, which is
+// missing in the tenants created before it.
+func (tcc *TxnCompilerContext) udfTableExists() bool {
+	ctx := tcc.ses.GetRequestContext()
+	db, err := tcc.txnHandler.GetStorage().Database(ctx, "mo_catalog", tcc.txnHandler.GetTxn())
+	if err != nil {
+		return false
+	}
+	_, err = db.Relation(ctx, udfTableName)
+	return err == nil
+}
+
 func (tcc *TxnCompilerContext) ResolveVariable(varName string, isSystemVar, isGlobalVar bool) (interface{}, error) {
 	if isSystemVar {
 		if isGlobalVar {
@@ -0,0 +1,246 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+)
+
+var (
+	// createMoUserDefinedFunctionSql creates the table with the catalog of
+	// a tenant. Tenants created before the table existed get it on their first
+	// CREATE FUNCTION.
+	createMoUserDefinedFunctionSql = `create table if not exists mo_catalog.mo_user_defined_function(
+				function_id int primary key,
+				name varchar(100),
+				db varchar(100),
+				definition text,
+				creator int,
+				owner int,
+				created_time timestamp
+			);`
+
+	initMoUserDefinedFunctionFormat = `insert into mo_catalog.mo_user_defined_function(
+				function_id,
+				name,
+				db,
+				definition,
+				creator,
+				owner,
+				created_time
+			) values(%d,"%s","%s","%s",%d,%d,"%s");`
+
+	getUdfFormat = `select definition,creator from mo_catalog.mo_user_defined_function where db = "%s" and name = "%s";`
+
+	getAllUdfsSql = `select db,name,definition from mo_catalog.mo_user_defined_function;`
+
+	getMaxUdfIdSql = `select coalesce(max(function_id),0) from mo_catalog.mo_user_defined_function;`
+
+	deleteUdfFormat = `delete from mo_catalog.mo_user_defined_function where db = "%s" and name = "%s";`
+
+	checkFunctionPrivilegeFormat = `select privilege_id from mo_catalog.mo_role_priv where role_id = %d and obj_type = "%s" and privilege_id in (%d,%d);`
+
+	udfStringEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+)
+
+const udfTableName = "mo_user_defined_function"
+
+func getSqlForUdf(dbName, name string) string {
+	return fmt.Sprintf(getUdfFormat, udfStringEscaper.Replace(dbName), udfStringEscaper.Replace(name))
+}
+
+func getSqlForDeleteUdf(dbName, name string) string {
+	return fmt.Sprintf(deleteUdfFormat, udfStringEscaper.Replace(dbName), udfStringEscaper.Replace(name))
+}
+
+func getSqlForCheckFunctionPrivilege(roleID uint32) string {
+	return fmt.Sprintf(checkFunctionPrivilegeFormat, roleID, objectTypeFunction, PrivilegeTypeAll, PrivilegeTypeOwnership)
+}
+
+// execSqlForResultSet runs the sql in the background and returns its result.
+func execSqlForResultSet(ctx context.Context, bh BackgroundExec, sql string) ([]ExecResult, error) {
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, sql); err != nil {
+		return nil, err
+	}
+	return convertIntoResultSet(bh.GetExecResultSet())
+}
+
+// handleCreateFunction saves the user-defined function in mo_catalog of the
+// tenant after its body is checked.
+func (mce *MysqlCmdExecutor) handleCreateFunction(ctx context.Context, cf *tree.CreateFunction) error {
+	ses := mce.GetSession()
+	dbName, err := plan2.CheckCreateFunction(cf, ses.GetTxnCompilerContext())
+	if err != nil {
+		return err
+	}
+	return InitFunction(ctx, ses.GetTenantInfo(), dbName, cf)
+}
+
+// handleDropFunction removes the user-defined function
+func (mce *MysqlCmdExecutor) handleDropFunction(ctx context.Context, df *tree.DropFunction) error {
+	ses := mce.GetSession()
+	tcc := ses.GetTxnCompilerContext()
+	dbName, err := tcc.ensureDatabaseIsNotEmpty(string(df.Name.SchemaName))
+	if err != nil {
+		return err
+	}
+	if !tcc.udfTableExists() {
+		if df.IfExists {
+			return nil
+		}
+		return moerr.NewInternalError("the function %s.%s does not exist", dbName, strings.ToLower(string(df.Name.ObjectName)))
+	}
+	return DropFunction(ctx, ses.GetTenantInfo(), dbName, df)
+}
+
+// checkCreateFunctionPrivilege checks the role of the tenant can create
+// functions, which needs the privilege all or ownership on functions.
+func checkCreateFunctionPrivilege(ctx context.Context, bh BackgroundExec, tenant *TenantInfo) error {
+	if tenant.IsMoAdminRole() {
+		return nil
+	}
+	rsset, err := execSqlForResultSet(ctx, bh, getSqlForCheckFunctionPrivilege(tenant.GetDefaultRoleID()))
+	if err != nil {
+		return err
+	}
+	if len(rsset) < 1 || rsset[0].GetRowCount() < 1 {
+		return moerr.NewInternalError("do not have privilege to create function")
+	}
+	return nil
+}
+
+// InitFunction creates the user-defined function in the database
+func InitFunction(ctx context.Context, tenant *TenantInfo, dbName string, cf *tree.CreateFunction) error {
+	var err error
+	var rsset []ExecResult
+	var maxId int64
+	pu := config.GetParameterUnit(ctx)
+	name := strings.ToLower(string(cf.Name.ObjectName))
+
+	guestMMu := guest.New(pu.SV.GuestMmuLimitation, pu.HostMmu)
+	bh := NewBackgroundHandler(ctx, guestMMu, pu.Mempool, pu)
+	defer bh.Close()
+
+	if err = checkCreateFunctionPrivilege(ctx, bh, tenant); err != nil {
+		return err
+	}
+	if err = bh.Exec(ctx, createMoUserDefinedFunctionSql); err != nil {
+		return err
+	}
+
+	//the check and the insert are in one transaction. The new function_id
+	//follows the largest one, so one of two concurrent creations conflicts
+	//on the primary key and fails to commit.
+	if err = bh.Exec(ctx, "begin;"); err != nil {
+		return err
+	}
+	rsset, err = execSqlForResultSet(ctx, bh, getSqlForUdf(dbName, name))
+	if err != nil {
+		goto handleFailed
+	}
+	if len(rsset) >= 1 && rsset[0].GetRowCount() >= 1 {
+		if !cf.IfNotExists {
+			err = moerr.NewInternalError("the function %s.%s exists", dbName, name)
+		}
+		goto handleFailed
+	}
+
+	rsset, err = execSqlForResultSet(ctx, bh, getMaxUdfIdSql)
+	if err != nil {
+		goto handleFailed
+	}
+	if len(rsset) >= 1 && rsset[0].GetRowCount() >= 1 {
+		if maxId, err = rsset[0].GetInt64(0, 0); err != nil {
+			goto handleFailed
+		}
+	}
+
+	err = bh.Exec(ctx, fmt.Sprintf(initMoUserDefinedFunctionFormat, maxId+1,
+		udfStringEscaper.Replace(name), udfStringEscaper.Replace(dbName),
+		udfStringEscaper.Replace(tree.String(cf, dialect.MYSQL)),
+		tenant.GetUserID(), tenant.GetDefaultRoleID(), types.CurrentTimestamp().String2(time.UTC, 0)))
+	if err != nil {
+		goto handleFailed
+	}
+	return bh.Exec(ctx, "commit;")
+
+handleFailed:
+	//ROLLBACK the transaction
+	rbErr := bh.Exec(ctx, "rollback;")
+	if rbErr != nil {
+		return rbErr
+	}
+	return err
+}
+
+// DropFunction drops the user-defined function in the database, which is only
+// allowed for its creator and the moadmin role.
+func DropFunction(ctx context.Context, tenant *TenantInfo, dbName string, df *tree.DropFunction) error {
+	var err error
+	var rsset []ExecResult
+	var creator int64
+	pu := config.GetParameterUnit(ctx)
+	name := strings.ToLower(string(df.Name.ObjectName))
+
+	guestMMu := guest.New(pu.SV.GuestMmuLimitation, pu.HostMmu)
+	bh := NewBackgroundHandler(ctx, guestMMu, pu.Mempool, pu)
+	defer bh.Close()
+
+	if err = bh.Exec(ctx, "begin;"); err != nil {
+		return err
+	}
+	rsset, err = execSqlForResultSet(ctx, bh, getSqlForUdf(dbName, name))
+	if err != nil {
+		goto handleFailed
+	}
+	if len(rsset) < 1 || rsset[0].GetRowCount() < 1 {
+		if !df.IfExists {
+			err = moerr.NewInternalError("the function %s.%s does not exist", dbName, name)
+		}
+		goto handleFailed
+	}
+	if creator, err = rsset[0].GetInt64(0, 1); err != nil {
+		goto handleFailed
+	}
+	if !tenant.IsMoAdminRole() && uint32(creator) != tenant.GetUserID() {
+		err = moerr.NewInternalError("do not have privilege to drop the function %s.%s", dbName, name)
+		goto handleFailed
+	}
+
+	if err = bh.Exec(ctx, getSqlForDeleteUdf(dbName, name)); err != nil {
+		goto handleFailed
+	}
+	return bh.Exec(ctx, "commit;")
+
+handleFailed:
+	//ROLLBACK the transaction
+	rbErr := bh.Exec(ctx, "rollback;")
+	if rbErr != nil {
+		return rbErr
+	}
+	return err
+}
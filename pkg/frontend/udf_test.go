@@ -0,0 +1,162 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/prashantv/gostub"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func Test_userDefinedFunction(t *testing.T) {
+	convey.Convey("create and drop function", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+
+		pu.HostMmu = host.New(pu.SV.HostMmuLimitation)
+		pu.Mempool = mempool.New()
+		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+
+		// the function db.f exists, and it was created by the user 2
+		var sqls []string
+		bh := mock_frontend.NewMockBackgroundExec(ctrl)
+		bh.EXPECT().Close().Return().AnyTimes()
+		bh.EXPECT().ClearExecResultSet().Return().AnyTimes()
+		bh.EXPECT().Exec(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, sql string) error {
+			sqls = append(sqls, sql)
+			return nil
+		}).AnyTimes()
+		rs := mock_frontend.NewMockExecResult(ctrl)
+		rs.EXPECT().GetRowCount().Return(uint64(1)).AnyTimes()
+		rs.EXPECT().GetInt64(gomock.Any(), gomock.Any()).Return(int64(2), nil).AnyTimes()
+		bh.EXPECT().GetExecResultSet().Return([]interface{}{rs}).AnyTimes()
+
+		bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+		defer bhStub.Reset()
+
+		admin := &TenantInfo{
+			Tenant:        sysAccountName,
+			User:          rootName,
+			DefaultRole:   moAdminRoleName,
+			TenantID:      sysAccountID,
+			UserID:        rootID,
+			DefaultRoleID: moAdminRoleID,
+		}
+		user := &TenantInfo{
+			Tenant:        sysAccountName,
+			User:          "u1",
+			DefaultRole:   publicRoleName,
+			TenantID:      sysAccountID,
+			UserID:        1,
+			DefaultRoleID: publicRoleID,
+		}
+		name := tree.NewTableName("f", tree.ObjectNamePrefix{})
+
+		cf := &tree.CreateFunction{
+			Name:       name,
+			ReturnType: &tree.T{},
+			Body:       tree.NewNumVal(nil, "1", false),
+		}
+		err := InitFunction(ctx, admin, "db", cf)
+		convey.So(err, convey.ShouldNotBeNil)
+		cf.IfNotExists = true
+		err = InitFunction(ctx, admin, "db", cf)
+		convey.So(err, convey.ShouldBeNil)
+
+		df := &tree.DropFunction{Name: name}
+		sqls = nil
+		err = DropFunction(ctx, user, "db", df)
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(sqls, convey.ShouldResemble, []string{"begin;", getSqlForUdf("db", "f"), "rollback;"})
+
+		sqls = nil
+		err = DropFunction(ctx, admin, "db", df)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(sqls, convey.ShouldResemble, []string{"begin;", getSqlForUdf("db", "f"), getSqlForDeleteUdf("db", "f"), "commit;"})
+	})
+
+	convey.Convey("create function in a transaction", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+
+		pu.HostMmu = host.New(pu.SV.HostMmuLimitation)
+		pu.Mempool = mempool.New()
+		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+
+		// there is no function, and the role of the user has no privilege
+		var sqls []string
+		bh := mock_frontend.NewMockBackgroundExec(ctrl)
+		bh.EXPECT().Close().Return().AnyTimes()
+		bh.EXPECT().ClearExecResultSet().Return().AnyTimes()
+		bh.EXPECT().Exec(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, sql string) error {
+			sqls = append(sqls, sql)
+			return nil
+		}).AnyTimes()
+		rs := mock_frontend.NewMockExecResult(ctrl)
+		rs.EXPECT().GetRowCount().Return(uint64(0)).AnyTimes()
+		bh.EXPECT().GetExecResultSet().Return([]interface{}{rs}).AnyTimes()
+
+		bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+		defer bhStub.Reset()
+
+		admin := &TenantInfo{
+			Tenant:        sysAccountName,
+			User:          rootName,
+			DefaultRole:   moAdminRoleName,
+			TenantID:      sysAccountID,
+			UserID:        rootID,
+			DefaultRoleID: moAdminRoleID,
+		}
+		user := &TenantInfo{
+			Tenant:        sysAccountName,
+			User:          "u1",
+			DefaultRole:   publicRoleName,
+			TenantID:      sysAccountID,
+			UserID:        1,
+			DefaultRoleID: publicRoleID,
+		}
+		cf := &tree.CreateFunction{
+			Name:       tree.NewTableName("f", tree.ObjectNamePrefix{}),
+			ReturnType: &tree.T{},
+			Body:       tree.NewNumVal(nil, "1", false),
+		}
+
+		err := InitFunction(ctx, user, "db", cf)
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(sqls, convey.ShouldResemble, []string{getSqlForCheckFunctionPrivilege(publicRoleID)})
+
+		sqls = nil
+		err = InitFunction(ctx, admin, "db", cf)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(sqls), convey.ShouldEqual, 6)
+		convey.So(sqls[:4], convey.ShouldResemble, []string{createMoUserDefinedFunctionSql, "begin;", getSqlForUdf("db", "f"), getMaxUdfIdSql})
+		convey.So(sqls[4], convey.ShouldStartWith, "insert into mo_catalog.mo_user_defined_function")
+		convey.So(sqls[5], convey.ShouldEqual, "commit;")
+	})
+}
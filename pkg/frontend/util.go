@@ -16,6 +16,7 @@ package frontend
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"go/constant"
@@ -395,7 +396,7 @@ func AllocateBatchBasedOnEngineAttributeDefinition(attributeDefs []*engine.Attri
 		case types.T_float64:
 			vec.Data = make([]byte, rowCount*int(toTypesType(types.T_float64).Size))
 			vec.Col = types.DecodeFloat64Slice(vec.Data)
-		case types.T_char, types.T_varchar, types.T_json:
+		case types.T_char, types.T_varchar, types.T_json, types.T_binary, types.T_varbinary:
 			vBytes := &types.Bytes{
 				Offsets: make([]uint32, rowCount),
 				Lengths: make([]uint32, rowCount),
@@ -409,6 +410,9 @@ func AllocateBatchBasedOnEngineAttributeDefinition(attributeDefs []*engine.Attri
 		case types.T_datetime:
 			vec.Data = make([]byte, rowCount*int(toTypesType(types.T_datetime).Size))
 			vec.Col = types.DecodeDatetimeSlice(vec.Data)
+		case types.T_time:
+			vec.Data = make([]byte, rowCount*int(toTypesType(types.T_time).Size))
+			vec.Col = types.DecodeTimeSlice(vec.Data)
 		default:
 			panic("unsupported vector type")
 		}
@@ -560,7 +564,7 @@ func FillBatchWithData(data [][]string, batch *batch.Batch) {
 					}
 					cols[rowIdx] = d
 				}
-			case types.T_char, types.T_varchar, types.T_json:
+			case types.T_char, types.T_varchar, types.T_json, types.T_binary, types.T_varbinary:
 				vBytes := vec.Col.(*types.Bytes)
 				if isNullOrEmpty {
 					nulls.Add(vec.Nsp, uint64(rowIdx))
@@ -597,6 +601,19 @@ func FillBatchWithData(data [][]string, batch *batch.Batch) {
 					}
 					cols[rowIdx] = d
 				}
+			case types.T_time:
+				cols := vec.Col.([]types.Time)
+				if isNullOrEmpty {
+					nulls.Add(vec.Nsp, uint64(rowIdx))
+				} else {
+					fs := field
+					d, err := types.ParseTime(fs, vec.Typ.Precision)
+					if err != nil {
+						logutil.Errorf("parse field[%v] err:%v", field, err)
+						d = 0
+					}
+					cols[rowIdx] = d
+				}
 			default:
 				panic("unsupported oid")
 			}
@@ -742,7 +759,7 @@ func FormatLineInBatch(bat *batch.Batch, rowIndex int) []string {
 					row[i] = vs[rowIndex]
 				}
 			}
-		case types.T_char, types.T_varchar, types.T_json:
+		case types.T_char, types.T_varchar, types.T_json, types.T_binary, types.T_varbinary:
 			if !nulls.Any(vec.Nsp) { //all data in this column are not null
 				vs := vec.Col.(*types.Bytes)
 				row[i] = string(vs.Get(int64(rowIndex)))
@@ -778,6 +795,18 @@ func FormatLineInBatch(bat *batch.Batch, rowIndex int) []string {
 					row[i] = vs[rowIndex]
 				}
 			}
+		case types.T_time:
+			if !nulls.Any(vec.Nsp) { //all data in this column are not null
+				vs := vec.Col.([]types.Time)
+				row[i] = vs[rowIndex]
+			} else {
+				if nulls.Contains(vec.Nsp, uint64(rowIndex)) { //is null
+					row[i] = nil
+				} else {
+					vs := vec.Col.([]types.Time)
+					row[i] = vs[rowIndex]
+				}
+			}
 		default:
 			panic(fmt.Sprintf("reader.Read : unsupported type %d \n", vec.Typ.Oid))
 		}
@@ -852,15 +881,13 @@ func GetSimpleExprValue(e tree.Expr) (interface{}, error) {
 		case tree.P_float64:
 			value, _ = constant.Float64Val(v.Value)
 		case tree.P_hexnum:
-			value, _, err = types.ParseStringToDecimal128WithoutTable(v.String())
-			if err != nil {
+			var data []byte
+			if data, err = hex.DecodeString(constant.StringVal(v.Value)); err != nil {
 				return nil, err
 			}
+			value = string(data)
 		case tree.P_bit:
-			value, _, err = types.ParseStringToDecimal128WithoutTable(v.String())
-			if err != nil {
-				return nil, err
-			}
+			value = string(bitStringToBytes(constant.StringVal(v.Value)))
 		default:
 			return nil, errorNumericTypeIsNotSupported
 		}
@@ -888,3 +915,15 @@ func GetSimpleExprValue(e tree.Expr) (interface{}, error) {
 	}
 	return value, nil
 }
+
+// bitStringToBytes converts the digits of the bit string literal to the bytes
+func bitStringToBytes(bits string) []byte {
+	data := make([]byte, (len(bits)+7)/8)
+	for i := range bits {
+		if bits[i] == '1' {
+			pos := len(bits) - 1 - i
+			data[len(data)-1-pos/8] |= 1 << (pos % 8)
+		}
+	}
+	return data
+}
@@ -22,7 +22,6 @@ import (
 	"strconv"
 	"strings"
 	"sync"
-	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
@@ -937,6 +936,28 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableIntType("max_allowed_packet", 1024, 1073741824, false),
 		Default:           int64(16777216),
 	},
+	"group_concat_max_len": {
+		Name:              "group_concat_max_len",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: true,
+		Type:              InitSystemVariableIntType("group_concat_max_len", 4, math.MaxInt64, false),
+		Default:           int64(1024),
+	},
+	"block_encryption_mode": {
+		Name:              "block_encryption_mode",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type: InitSystemSystemEnumType("block_encryption_mode",
+			"aes-128-ecb", "aes-192-ecb", "aes-256-ecb",
+			"aes-128-cbc", "aes-192-cbc", "aes-256-cbc",
+			"aes-128-cfb1", "aes-192-cfb1", "aes-256-cfb1",
+			"aes-128-cfb8", "aes-192-cfb8", "aes-256-cfb8",
+			"aes-128-cfb128", "aes-192-cfb128", "aes-256-cfb128",
+			"aes-128-ofb", "aes-192-ofb", "aes-256-ofb"),
+		Default: "aes-128-ecb",
+	},
 	"version_comment": {
 		Name:              "version_comment",
 		Scope:             ScopeGlobal,
@@ -1027,11 +1048,27 @@ var gSysVarsDefs = map[string]SystemVariable{
 	},
 	"collation_connection": {
 		Name:              "collation_connection",
-		Scope:             ScopeGlobal,
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemSystemEnumType("collation_connection", types.CollationNames()...),
+		Default:           "utf8mb4_bin",
+	},
+	"collation_database": {
+		Name:              "collation_database",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemSystemEnumType("collation_database", types.CollationNames()...),
+		Default:           "utf8mb4_bin",
+	},
+	"collation_server": {
+		Name:              "collation_server",
+		Scope:             ScopeBoth,
 		Dynamic:           true,
 		SetVarHintApplies: false,
-		Type:              InitSystemVariableStringType("collation_connection"),
-		Default:           "default",
+		Type:              InitSystemSystemEnumType("collation_server", types.CollationNames()...),
+		Default:           "utf8mb4_bin",
 	},
 	"autocommit": {
 		Name:              "autocommit",
@@ -1075,86 +1112,12 @@ func updateTimeZone(sess *Session, vars map[string]interface{}, name string, val
 	}
 
 	tzStr := val.(string)
-	if tzStr == "SYSTEM" {
-		vars[name] = "SYSTEM"
-		sess.SetTimeZone(time.Local)
-	} else if tzStr[0] == '-' {
-		if len(tzStr) != 6 {
-			return errors.New("incorrect timezone " + tzStr)
-		}
-
-		if tzStr[1] < '0' || tzStr[1] > '9' {
-			return errors.New("incorrect timezone " + tzStr)
-		}
-		hour := int(tzStr[1]-'0') * 10
-		if tzStr[2] < '0' || tzStr[2] > '9' {
-			return errors.New("incorrect timezone " + tzStr)
-		}
-		hour += int(tzStr[2] - '0')
-
-		if tzStr[3] != ':' {
-			return errors.New("incorrect timezone " + tzStr)
-		}
-
-		if tzStr[4] < '0' || tzStr[4] > '9' {
-			return errors.New("incorrect timezone " + tzStr)
-		}
-		minute := int(tzStr[4]-'0') * 10
-		if tzStr[5] < '0' || tzStr[5] > '9' {
-			return errors.New("incorrect timezone " + tzStr)
-		}
-		minute += int(tzStr[5] - '0')
-
-		minute += hour * 60
-		if minute >= 14*60 {
-			return errors.New("incorrect timezone " + tzStr)
-		}
-
-		vars[name] = tzStr
-		sess.SetTimeZone(time.FixedZone("FixedZone", -minute*60))
-	} else if tzStr[0] == '+' {
-		if len(tzStr) != 6 {
-			return errors.New("incorrect timezone " + tzStr)
-		}
-
-		if tzStr[1] < '0' || tzStr[1] > '9' {
-			return errors.New("incorrect timezone " + tzStr)
-		}
-		hour := int(tzStr[1]-'0') * 10
-		if tzStr[2] < '0' || tzStr[2] > '9' {
-			return errors.New("incorrect timezone " + tzStr)
-		}
-		hour += int(tzStr[2] - '0')
-
-		if tzStr[3] != ':' {
-			return errors.New("incorrect timezone " + tzStr)
-		}
-
-		if tzStr[4] < '0' || tzStr[4] > '9' {
-			return errors.New("incorrect timezone " + tzStr)
-		}
-		minute := int(tzStr[4]-'0') * 10
-		if tzStr[5] < '0' || tzStr[5] > '9' {
-			return errors.New("incorrect timezone " + tzStr)
-		}
-		minute += int(tzStr[5] - '0')
-
-		minute += hour * 60
-		if minute > 14*60 {
-			return errors.New("incorrect timezone " + tzStr)
-		}
-
-		vars[name] = tzStr
-		sess.SetTimeZone(time.FixedZone("FixedZone", minute*60))
-	} else {
-		loc, err := time.LoadLocation(tzStr)
-		if err != nil {
-			return err
-		}
-
-		vars[name] = tzStr
-		sess.SetTimeZone(loc)
+	loc, err := types.ParseTimeZone(tzStr)
+	if err != nil {
+		return err
 	}
+	vars[name] = tzStr
+	sess.SetTimeZone(loc)
 
 	return nil
 }
@@ -26,4 +26,5 @@ func TestTimeoutConfig(t *testing.T) {
 	assert.Equal(t, DefaultTickPerSecond, c.TickPerSecond)
 	assert.Equal(t, DefaultLogStoreTimeout, c.LogStoreTimeout)
 	assert.Equal(t, DefaultDNStoreTimeout, c.DNStoreTimeout)
+	assert.Equal(t, DefaultCNStoreTimeout, c.CNStoreTimeout)
 }
@@ -401,6 +401,7 @@ func (s *stateMachine) handleStateQuery() interface{} {
 		ClusterInfo: s.state.ClusterInfo,
 		DNState:     s.state.DNState,
 		LogState:    s.state.LogState,
+		CNState:     s.state.CNState,
 		State:       s.state.State,
 	}
 	copied := deepcopy.Copy(internal)
@@ -426,9 +427,14 @@ func (s *stateMachine) handleClusterDetailsQuery(cfg Config) *pb.ClusterDetails
 		LogStores: make([]pb.LogStore, 0, len(s.state.LogState.Stores)),
 	}
 	for uuid, info := range s.state.CNState.Stores {
+		state := pb.NormalState
+		if cfg.CnStoreExpired(info.Tick, s.state.Tick) {
+			state = pb.TimeoutState
+		}
 		n := pb.CNStore{
 			UUID:           uuid,
 			Tick:           info.Tick,
+			State:          state,
 			ServiceAddress: info.ServiceAddress,
 		}
 		cd.CNStores = append(cd.CNStores, n)
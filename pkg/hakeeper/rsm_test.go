@@ -469,6 +469,28 @@ func TestClusterDetailsQuery(t *testing.T) {
 	assert.Equal(t, expected, result)
 }
 
+func TestClusterDetailsQueryCNStoreState(t *testing.T) {
+	cfg := Config{}
+	cfg.Fill()
+	tsm := NewStateMachine(0, 1).(*stateMachine)
+	tsm.state.CNState = pb.CNState{
+		Stores: make(map[string]pb.CNStoreInfo),
+	}
+	tsm.state.CNState.Stores["uuid1"] = pb.CNStoreInfo{Tick: 1}
+	tsm.state.Tick = cfg.ExpiredTick(1, cfg.CNStoreTimeout) + 1
+	tsm.state.CNState.Stores["uuid2"] = pb.CNStoreInfo{Tick: tsm.state.Tick}
+
+	v, err := tsm.Lookup(&ClusterDetailsQuery{Cfg: cfg})
+	require.NoError(t, err)
+	result := v.(*pb.ClusterDetails)
+	sort.Slice(result.CNStores, func(i, j int) bool {
+		return result.CNStores[i].UUID < result.CNStores[j].UUID
+	})
+	require.Equal(t, 2, len(result.CNStores))
+	assert.Equal(t, pb.TimeoutState, result.CNStores[0].State)
+	assert.Equal(t, pb.NormalState, result.CNStores[1].State)
+}
+
 func TestInitialState(t *testing.T) {
 	rsm := NewStateMachine(0, 1).(*stateMachine)
 	assert.Equal(t, pb.HAKeeperCreated, rsm.state.State)
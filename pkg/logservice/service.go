@@ -30,6 +30,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/stopper"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	pb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+	"github.com/matrixorigin/matrixone/pkg/util/fault"
 )
 
 var (
@@ -107,6 +108,12 @@ func NewService(
 	// TODO: check and fix all these magic numbers
 	codec := morpc.NewMessageCodecWithChecksum(mf, 16*1024)
 	server, err := morpc.NewRPCServer(LogServiceRPCName, cfg.ServiceListenAddress, codec,
+		morpc.WithServerErrorResponse(func(request morpc.Message, err error) morpc.Message {
+			response := respPool.Get().(*RPCResponse)
+			response.Method = request.(*RPCRequest).Method
+			response.ErrorCode, response.ErrorMessage = toErrorCode(err)
+			return response
+		}),
 		morpc.WithServerGoettyOptions(goetty.WithSessionReleaseMsgFunc(func(i interface{}) {
 			respPool.Put(i.(morpc.RPCMessage).Message)
 		})))
@@ -281,6 +288,10 @@ func (s *Service) handleConnectRO(ctx context.Context, req pb.Request) pb.Respon
 func (s *Service) handleAppend(ctx context.Context, req pb.Request, payload []byte) pb.Response {
 	r := req.LogRequest
 	resp := getResponse(req)
+	if _, err := fault.Inject(fault.LogServiceAppend); err != nil {
+		resp.ErrorCode, resp.ErrorMessage = toErrorCode(err)
+		return resp
+	}
 	lsn, err := s.store.append(ctx, r.ShardID, payload)
 	if err != nil {
 		resp.ErrorCode, resp.ErrorMessage = toErrorCode(err)
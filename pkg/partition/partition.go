@@ -169,6 +169,30 @@ func Partition(sels []int64, diffs []bool, partitions []int64, vec *vector.Vecto
 			diffs[i] = diffs[i] || (v != w)
 			v = w
 		}
+	case types.T_time:
+		var n bool
+		var v types.Time
+
+		vs := vec.Col.([]types.Time)
+		if nulls.Any(vec.Nsp) {
+			for i, sel := range sels {
+				w := vs[sel]
+				isNull := nulls.Contains(vec.Nsp, uint64(sel))
+				if n != isNull {
+					diffs[i] = true
+				} else {
+					diffs[i] = diffs[i] || (v != vs[sel])
+				}
+				v = w
+				n = isNull
+			}
+			break
+		}
+		for i, sel := range sels {
+			w := vs[sel]
+			diffs[i] = diffs[i] || (v != w)
+			v = w
+		}
 	case types.T_uint8:
 		var n bool
 		var v uint8
@@ -193,7 +217,7 @@ func Partition(sels []int64, diffs []bool, partitions []int64, vec *vector.Vecto
 			diffs[i] = diffs[i] || (v != w)
 			v = w
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		var n bool
 		var v uint16
 
@@ -241,7 +265,7 @@ func Partition(sels []int64, diffs []bool, partitions []int64, vec *vector.Vecto
 			diffs[i] = diffs[i] || (v != w)
 			v = w
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		var n bool
 		var v uint64
 
@@ -313,11 +337,17 @@ func Partition(sels []int64, diffs []bool, partitions []int64, vec *vector.Vecto
 			diffs[i] = diffs[i] || (v != w)
 			v = w
 		}
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_binary, types.T_varbinary:
 		var n bool
 		var v []byte
 
 		vs := vec.Col.(*types.Bytes)
+		equal := bytes.Equal
+		if collation := vec.Typ.Collation; !collation.IsBinary() {
+			equal = func(a, b []byte) bool {
+				return collation.Compare(a, b) == 0
+			}
+		}
 		if nulls.Any(vec.Nsp) {
 			for i, sel := range sels {
 				w := vs.Get(sel)
@@ -325,7 +355,7 @@ func Partition(sels []int64, diffs []bool, partitions []int64, vec *vector.Vecto
 				if n != isNull {
 					diffs[i] = true
 				} else {
-					diffs[i] = diffs[i] || !bytes.Equal(v, w)
+					diffs[i] = diffs[i] || !equal(v, w)
 				}
 				n = isNull
 				v = w
@@ -334,7 +364,7 @@ func Partition(sels []int64, diffs []bool, partitions []int64, vec *vector.Vecto
 		}
 		for i, sel := range sels {
 			w := vs.Get(sel)
-			diffs[i] = diffs[i] || !bytes.Equal(v, w)
+			diffs[i] = diffs[i] || !equal(v, w)
 			v = w
 		}
 	}
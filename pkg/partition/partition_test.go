@@ -149,3 +149,19 @@ func TestPartition(t *testing.T) {
 	Partition([]int64{1, 3, 5}, []bool{false, false, false}, partitions, v11)
 	require.Equal(t, []int64{0, 1}, partitions)
 }
+
+func TestPartitionCollation(t *testing.T) {
+	vec := vector.New(types.Type{Oid: types.T_varchar, Collation: types.CollationGeneralCI})
+	vec.Col = &types.Bytes{
+		Data:    []byte("abcABCabdABD"),
+		Offsets: []uint32{0, 3, 6, 9},
+		Lengths: []uint32{3, 3, 3, 3},
+	}
+	vec.Nsp = &nulls.Nulls{}
+	partitions := make([]int64, 4)
+	partitions = Partition([]int64{0, 1, 2, 3}, []bool{false, false, false, false}, partitions, vec)
+	require.Equal(t, []int64{0, 2}, partitions)
+	vec.Typ.Collation = types.CollationBinary
+	partitions = Partition([]int64{0, 1, 2, 3}, []bool{false, false, false, false}, partitions, vec)
+	require.Equal(t, []int64{0, 1, 2, 3}, partitions)
+}
@@ -2542,6 +2542,7 @@ type CheckerState struct {
 	DNState              DNState       `protobuf:"bytes,3,opt,name=DNState,proto3" json:"DNState"`
 	LogState             LogState      `protobuf:"bytes,4,opt,name=LogState,proto3" json:"LogState"`
 	State                HAKeeperState `protobuf:"varint,5,opt,name=State,proto3,enum=logservice.HAKeeperState" json:"State,omitempty"`
+	CNState              CNState       `protobuf:"bytes,6,opt,name=CNState,proto3" json:"CNState"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
@@ -2615,6 +2616,13 @@ func (m *CheckerState) GetState() HAKeeperState {
 	return HAKeeperCreated
 }
 
+func (m *CheckerState) GetCNState() CNState {
+	if m != nil {
+		return m.CNState
+	}
+	return CNState{}
+}
+
 // HAKeeperRSMState contains state maintained by HAKeeper's RSM.
 type HAKeeperRSMState struct {
 	Tick                 uint64                  `protobuf:"varint,1,opt,name=Tick,proto3" json:"Tick,omitempty"`
@@ -2932,164 +2940,163 @@ func init() {
 func init() { proto.RegisterFile("logservice.proto", fileDescriptor_fd1040c5381ab5a7) }
 
 var fileDescriptor_fd1040c5381ab5a7 = []byte{
-	// 2499 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x39, 0xcb, 0x6f, 0x1b, 0xc7,
-	0xf9, 0x5a, 0x92, 0xe2, 0xe3, 0xa3, 0xc4, 0xac, 0x46, 0x92, 0xcd, 0x28, 0xf9, 0xc9, 0xfa, 0x6d,
-	0x53, 0xc3, 0x65, 0x12, 0x09, 0x90, 0xe1, 0x20, 0x6e, 0x15, 0x1b, 0x14, 0x97, 0xb6, 0x18, 0xcb,
-	0x2b, 0x75, 0x49, 0xf5, 0x10, 0x20, 0x50, 0x57, 0xdc, 0x11, 0xb9, 0x35, 0xb9, 0xcb, 0xee, 0x2e,
-	0x55, 0x2b, 0xa7, 0xf6, 0x90, 0x00, 0x45, 0x0f, 0x6d, 0x6f, 0x41, 0x11, 0xf4, 0x9f, 0x68, 0x0e,
-	0xbd, 0xf4, 0xd6, 0x02, 0x39, 0xfa, 0xd2, 0x6b, 0xd0, 0xfa, 0xd2, 0xde, 0xfa, 0x2f, 0x14, 0xf3,
-	0xda, 0x9d, 0x21, 0x29, 0xa9, 0x8e, 0x5d, 0x20, 0xbd, 0xed, 0x7c, 0xaf, 0x99, 0xf9, 0xde, 0xf3,
-	0x2d, 0xe8, 0x83, 0xa0, 0x17, 0xe1, 0xf0, 0xcc, 0xeb, 0xe2, 0xcd, 0x51, 0x18, 0xc4, 0x01, 0x82,
-	0x14, 0xb2, 0xf6, 0x6e, 0xcf, 0x8b, 0xfb, 0xe3, 0x93, 0xcd, 0x6e, 0x30, 0xdc, 0xea, 0x05, 0xbd,
-	0x60, 0x8b, 0x92, 0x9c, 0x8c, 0x4f, 0xe9, 0x8a, 0x2e, 0xe8, 0x17, 0x63, 0x5d, 0xab, 0x0c, 0x71,
-	0xec, 0xb8, 0x4e, 0xec, 0xb0, 0xb5, 0xf1, 0x99, 0x06, 0x85, 0x86, 0xd5, 0x8e, 0x83, 0x10, 0x23,
-	0x04, 0xb9, 0xa3, 0xa3, 0x96, 0x59, 0xd5, 0x36, 0xb4, 0x5b, 0x25, 0x9b, 0x7e, 0xa3, 0x9b, 0x50,
-	0x69, 0xb3, 0x9d, 0xea, 0xae, 0x1b, 0xe2, 0x28, 0xaa, 0x66, 0x28, 0x76, 0x02, 0x4a, 0x78, 0x3b,
-	0x5e, 0xf7, 0x49, 0x35, 0xbb, 0xa1, 0xdd, 0xca, 0xd9, 0xf4, 0x1b, 0xbd, 0x0d, 0xf3, 0xed, 0xd8,
-	0x89, 0x71, 0x35, 0xb7, 0xa1, 0xdd, 0xaa, 0x6c, 0xaf, 0x6e, 0x4a, 0x17, 0xb1, 0x02, 0x17, 0x53,
-	0xa4, 0xcd, 0x68, 0x8c, 0x3f, 0x69, 0x50, 0x30, 0xbf, 0x05, 0x07, 0x41, 0x77, 0x20, 0xdf, 0xee,
-	0x3b, 0xa1, 0x1b, 0x55, 0xe7, 0x37, 0xb2, 0xb7, 0xca, 0xdb, 0xd7, 0x65, 0x6a, 0xd3, 0xa2, 0xb8,
-	0x96, 0x7f, 0x1a, 0xec, 0xe6, 0xbe, 0xfa, 0xfa, 0xc6, 0x9c, 0xcd, 0x89, 0x8d, 0xbf, 0x68, 0x50,
-	0xdc, 0x0f, 0x7a, 0xdf, 0x82, 0x0b, 0xec, 0x40, 0xd1, 0xc6, 0xa3, 0x81, 0xd7, 0x75, 0xc4, 0x15,
-	0xd6, 0x64, 0xfa, 0xfd, 0xa0, 0xc7, 0xd1, 0xd2, 0x2d, 0x12, 0x0e, 0xe3, 0x5f, 0x1a, 0x2c, 0x90,
-	0x7b, 0x88, 0x6b, 0xa2, 0x2a, 0x14, 0xd8, 0x82, 0x5d, 0x27, 0x67, 0x8b, 0x25, 0xda, 0x95, 0x36,
-	0xca, 0xd0, 0x8d, 0x6e, 0x4e, 0x6c, 0x94, 0x48, 0xd9, 0x14, 0x84, 0x4d, 0x3f, 0x0e, 0xcf, 0xd3,
-	0xed, 0xd0, 0x0a, 0xcc, 0x37, 0x47, 0x41, 0xb7, 0xcf, 0xaf, 0xcb, 0x16, 0x68, 0x0d, 0x8a, 0xfb,
-	0xd8, 0x71, 0x71, 0xd8, 0x32, 0xe9, 0x95, 0x73, 0x76, 0xb2, 0xa6, 0xfa, 0xc1, 0xe1, 0xb0, 0x3a,
-	0xcf, 0xf5, 0x83, 0xc3, 0xe1, 0xda, 0x0f, 0x60, 0x51, 0xd9, 0x00, 0xe9, 0x90, 0x7d, 0x82, 0xcf,
-	0xf9, 0x81, 0xc9, 0x27, 0xd9, 0xe8, 0xcc, 0x19, 0x8c, 0x31, 0xd7, 0x3a, 0x5b, 0x7c, 0x3f, 0xf3,
-	0xbe, 0x66, 0x9c, 0x41, 0x45, 0xd5, 0x09, 0x7a, 0xa0, 0xaa, 0x80, 0x8a, 0x29, 0x6f, 0x57, 0x2f,
-	0xba, 0xdc, 0x6e, 0x91, 0xe8, 0xf0, 0xd9, 0xd7, 0x37, 0x34, 0x5b, 0x55, 0xdd, 0x9b, 0x50, 0x12,
-	0x62, 0x4d, 0xba, 0x6f, 0xce, 0x4e, 0x01, 0x86, 0x05, 0x3a, 0x8f, 0xbc, 0x3d, 0xec, 0x84, 0xf1,
-	0x09, 0x76, 0xe2, 0x97, 0x71, 0x1c, 0xe3, 0xaf, 0x1a, 0x2c, 0x09, 0x0f, 0xbc, 0x5c, 0xe2, 0x06,
-	0x94, 0x6d, 0xe7, 0x34, 0x56, 0xc5, 0xc9, 0xa0, 0x19, 0x7b, 0x66, 0x67, 0x3a, 0xeb, 0x5b, 0xb0,
-	0xf8, 0x30, 0x88, 0x22, 0x6f, 0x24, 0xc8, 0x72, 0x94, 0x4c, 0x05, 0xbe, 0xa4, 0x47, 0x36, 0xa1,
-	0x2c, 0x85, 0xdd, 0x25, 0xfe, 0x78, 0xb9, 0xba, 0x3f, 0xd5, 0x40, 0x37, 0x5f, 0xa1, 0xbe, 0xa5,
-	0x44, 0x91, 0x7d, 0x91, 0x44, 0xf1, 0xcb, 0x0c, 0x14, 0xed, 0xf6, 0x63, 0x16, 0xab, 0x3a, 0x64,
-	0x3b, 0x51, 0x20, 0xfc, 0xb4, 0x13, 0x05, 0xc4, 0x4f, 0x5b, 0xbe, 0x8b, 0x9f, 0xf2, 0x0b, 0xb0,
-	0x05, 0xd1, 0xf3, 0x3e, 0x76, 0x22, 0xbc, 0x17, 0x0c, 0x58, 0x54, 0xb0, 0x70, 0x51, 0x81, 0xc8,
-	0x80, 0x85, 0x4e, 0x38, 0xf6, 0xbb, 0x4e, 0x8c, 0xdd, 0xfd, 0xc8, 0xe7, 0xa1, 0xa3, 0xc0, 0xd0,
-	0x87, 0xb0, 0xc0, 0x98, 0xbc, 0x28, 0x0e, 0xc2, 0x73, 0x6e, 0x0f, 0x25, 0x70, 0xc5, 0xe9, 0x36,
-	0x65, 0x42, 0x16, 0xb8, 0x0a, 0xef, 0xda, 0x7d, 0x58, 0x9a, 0x22, 0xb9, 0x2a, 0xf4, 0x72, 0x72,
-	0xe8, 0x7d, 0x0c, 0x25, 0x6a, 0xfc, 0x6e, 0x10, 0xba, 0x84, 0x91, 0x1c, 0x9a, 0x33, 0x92, 0xb3,
-	0xd6, 0x20, 0xd7, 0x39, 0x1f, 0x31, 0xbe, 0xca, 0xf6, 0x35, 0xe5, 0x8c, 0x94, 0x87, 0x60, 0x6d,
-	0x4a, 0x43, 0x2c, 0x69, 0x3a, 0xb1, 0x43, 0x15, 0xb3, 0x60, 0xd3, 0x6f, 0xe3, 0x73, 0x0d, 0x80,
-	0xca, 0xff, 0xe9, 0x18, 0x47, 0xd4, 0xd8, 0x96, 0x33, 0xc4, 0xc2, 0xd8, 0xe4, 0x5b, 0xf6, 0xa6,
-	0x8c, 0xea, 0x4d, 0xfc, 0x38, 0xd9, 0xf4, 0x38, 0x55, 0x28, 0x3c, 0x76, 0x9e, 0xb6, 0xbd, 0x4f,
-	0x30, 0xd7, 0xac, 0x58, 0x12, 0xcf, 0x13, 0x06, 0x37, 0x79, 0x62, 0x4a, 0x01, 0xf4, 0x68, 0x56,
-	0xcb, 0xac, 0xe6, 0x59, 0xc6, 0x22, 0xdf, 0x86, 0x01, 0xd0, 0x89, 0x02, 0x71, 0xb2, 0x15, 0x98,
-	0x6f, 0x04, 0x63, 0x3f, 0xe6, 0x97, 0x67, 0x0b, 0xe3, 0x37, 0x59, 0x28, 0x08, 0x0a, 0xea, 0xdb,
-	0xf4, 0x33, 0xf1, 0xfb, 0x14, 0x80, 0x36, 0x21, 0xff, 0x18, 0xc7, 0xfd, 0xc0, 0x9d, 0xa5, 0x2a,
-	0x86, 0xa1, 0xaa, 0xe2, 0x54, 0x68, 0x47, 0xd6, 0x0b, 0xbd, 0x62, 0x59, 0xe5, 0x49, 0xb1, 0xdc,
-	0x7b, 0x65, 0x3d, 0xd6, 0x69, 0x7a, 0x4c, 0x82, 0x88, 0x2a, 0xa3, 0xbc, 0xfd, 0x7f, 0x93, 0xe9,
-	0x51, 0x89, 0x34, 0x5b, 0x61, 0x41, 0xf7, 0xa0, 0xdc, 0xb0, 0x52, 0x09, 0xf3, 0x54, 0xc2, 0x9b,
-	0xb2, 0x84, 0xc9, 0xd4, 0x68, 0xcb, 0x0c, 0x84, 0xdf, 0x94, 0xf8, 0xf3, 0xd3, 0xfc, 0xe6, 0x14,
-	0xbf, 0xc4, 0x80, 0xde, 0x93, 0xd5, 0x5f, 0x2d, 0x4c, 0x2b, 0x20, 0xc5, 0xda, 0x12, 0xa5, 0xd1,
-	0x86, 0x32, 0x55, 0x44, 0x34, 0x0a, 0xfc, 0x08, 0x5f, 0x92, 0x8b, 0xb8, 0xf7, 0x64, 0x14, 0xef,
-	0xd9, 0x77, 0xa2, 0x38, 0xf5, 0x29, 0xb1, 0x34, 0x7e, 0x9d, 0x23, 0xf9, 0x91, 0x8b, 0x7c, 0xb5,
-	0x86, 0xbe, 0x0d, 0xa5, 0x66, 0x18, 0x06, 0x61, 0x23, 0x70, 0x31, 0xdd, 0x76, 0xa2, 0x79, 0x48,
-	0x90, 0x76, 0x4a, 0x47, 0xd2, 0x08, 0x5d, 0x3c, 0xc6, 0x51, 0xe4, 0xf4, 0x30, 0xcf, 0xe9, 0x0a,
-	0x0c, 0xad, 0x03, 0xb4, 0xa2, 0xbd, 0xfa, 0x23, 0x8c, 0x47, 0x38, 0xa4, 0xf6, 0x2b, 0xda, 0x12,
-	0x04, 0xdd, 0x57, 0x14, 0xc5, 0x0d, 0x74, 0x7d, 0xca, 0xc5, 0x18, 0x9a, 0xfb, 0x98, 0xa2, 0xda,
-	0x1d, 0x58, 0x68, 0x04, 0xc3, 0xa1, 0xe3, 0xbb, 0xbb, 0x4e, 0xdc, 0xed, 0x73, 0x1b, 0x29, 0x35,
-	0x58, 0xc6, 0xdb, 0x0a, 0x35, 0xba, 0x0b, 0x65, 0x6a, 0x35, 0xbe, 0x7d, 0x71, 0x7a, 0x7b, 0x09,
-	0x6d, 0xcb, 0xb4, 0x68, 0x17, 0x2a, 0x8d, 0xc1, 0x38, 0x8a, 0x71, 0x68, 0xe2, 0xd8, 0xf1, 0x06,
-	0x51, 0xb5, 0x44, 0xb9, 0x95, 0x92, 0xa5, 0x52, 0xd8, 0x13, 0x1c, 0xe8, 0x1e, 0x94, 0xd2, 0xee,
-	0x01, 0x28, 0xfb, 0x86, 0xcc, 0x9e, 0x20, 0x7f, 0x38, 0xc6, 0xe1, 0xb9, 0x8d, 0xa3, 0xf1, 0x20,
-	0xb6, 0x53, 0x16, 0xe3, 0x43, 0x5a, 0xc9, 0x59, 0x8e, 0x4b, 0x0e, 0x76, 0x87, 0x64, 0x03, 0x02,
-	0x89, 0xaa, 0x1a, 0x4d, 0xda, 0xab, 0x53, 0xea, 0x24, 0x58, 0xae, 0x4c, 0x41, 0x6b, 0x7c, 0x47,
-	0x51, 0x05, 0x49, 0x35, 0x3f, 0xa2, 0xc9, 0x98, 0xa7, 0x1a, 0xba, 0x30, 0x7e, 0xa5, 0x11, 0xe1,
-	0xb4, 0x54, 0xce, 0xac, 0x89, 0x17, 0xa7, 0x49, 0xa5, 0xe8, 0x66, 0x27, 0x8a, 0x6e, 0xda, 0xde,
-	0xe5, 0xe4, 0xf6, 0x6e, 0x9d, 0xa6, 0x1f, 0x35, 0x5f, 0x4a, 0x10, 0xe3, 0x77, 0x19, 0x62, 0x7c,
-	0xff, 0xd4, 0xeb, 0x35, 0xfa, 0x8e, 0xdf, 0xc3, 0xe8, 0x76, 0x72, 0x3a, 0xde, 0x8b, 0x2d, 0xab,
-	0xb5, 0x80, 0xa2, 0xd2, 0x8b, 0xb3, 0x7b, 0xec, 0x00, 0x30, 0x76, 0xa9, 0x86, 0xa8, 0x29, 0x46,
-	0xda, 0x82, 0x46, 0x8d, 0x44, 0x8f, 0x3a, 0x50, 0x69, 0xf9, 0x5e, 0xec, 0x39, 0x83, 0xc7, 0x78,
-	0x78, 0x82, 0x43, 0x51, 0xe5, 0xdf, 0xb9, 0x48, 0xc2, 0xa6, 0x4a, 0xce, 0xea, 0xe5, 0x84, 0x8c,
-	0xb5, 0x3a, 0x2c, 0xcf, 0x20, 0x7b, 0xa1, 0x76, 0xf5, 0x7b, 0xb0, 0xd8, 0xee, 0x8f, 0x63, 0x37,
-	0xf8, 0x99, 0xcf, 0x1e, 0x1b, 0xc4, 0x36, 0xe4, 0x23, 0x31, 0x99, 0x58, 0x1a, 0x9f, 0x66, 0xe0,
-	0xb5, 0x76, 0xb7, 0x8f, 0xdd, 0xf1, 0x00, 0xf3, 0xf0, 0x98, 0x69, 0xdd, 0xb7, 0x60, 0x71, 0x37,
-	0x08, 0xe2, 0x28, 0x0e, 0x9d, 0xd1, 0xc8, 0xf3, 0x7b, 0x74, 0xd3, 0xa2, 0xad, 0x02, 0x59, 0x44,
-	0xa6, 0xf7, 0xe5, 0x65, 0xa3, 0x7a, 0x91, 0x3e, 0x6c, 0xd5, 0x84, 0x77, 0xa1, 0xcc, 0xfb, 0x27,
-	0x6a, 0x0e, 0xf6, 0x90, 0x51, 0x22, 0x52, 0x42, 0xdb, 0x32, 0x2d, 0xba, 0x3f, 0x71, 0x63, 0x5e,
-	0x2e, 0x5e, 0x57, 0x23, 0x4a, 0x22, 0xb0, 0x55, 0x7a, 0xc3, 0x51, 0x73, 0x49, 0xf2, 0x84, 0xd0,
-	0xd2, 0x27, 0x04, 0xfa, 0x00, 0x8a, 0x9c, 0x46, 0x3c, 0x66, 0xde, 0x50, 0xe4, 0xab, 0x6a, 0x14,
-	0x4d, 0xaa, 0x60, 0x31, 0x5a, 0xa4, 0xa0, 0x31, 0xbd, 0x93, 0x26, 0x55, 0x3c, 0xe2, 0x34, 0xe9,
-	0x11, 0xf7, 0x9f, 0xf6, 0xf1, 0x5f, 0xf0, 0x27, 0x39, 0xe9, 0x0f, 0x3f, 0x80, 0x3c, 0x15, 0x2a,
-	0x42, 0xfe, 0xc6, 0x64, 0x89, 0x24, 0x6d, 0x1a, 0xa3, 0xa0, 0x9e, 0x94, 0xf4, 0x9a, 0x14, 0xb4,
-	0x66, 0x43, 0x59, 0x42, 0xca, 0x6e, 0x56, 0x62, 0x6e, 0xf6, 0xae, 0xec, 0x66, 0x13, 0x19, 0x52,
-	0xba, 0x8f, 0xec, 0x7f, 0x3f, 0xd7, 0x68, 0x3f, 0xfe, 0x2a, 0xae, 0xfa, 0x4d, 0x5b, 0xe8, 0x2f,
-	0xf8, 0xac, 0xe0, 0x4a, 0x0d, 0x99, 0xff, 0x5d, 0x0d, 0x99, 0xb3, 0x35, 0xf4, 0x47, 0x6d, 0xb2,
-	0x84, 0xa0, 0x3b, 0x50, 0xe4, 0xc4, 0xe2, 0x9c, 0xcb, 0x33, 0x04, 0x09, 0xaf, 0x12, 0xa4, 0x84,
-	0xad, 0x21, 0xd8, 0x32, 0xd3, 0x6c, 0x0d, 0x95, 0x4d, 0x90, 0xa2, 0xf7, 0x69, 0x5b, 0xcd, 0xf9,
-	0x98, 0x66, 0x57, 0x66, 0x75, 0x67, 0x9c, 0x31, 0x25, 0x36, 0x3e, 0xd3, 0xa0, 0xcc, 0x8f, 0x4e,
-	0x8d, 0x7b, 0x97, 0x9e, 0x9b, 0x99, 0x48, 0xe3, 0x26, 0x4a, 0x26, 0x48, 0x1c, 0xa3, 0x94, 0x9d,
-	0x84, 0x1c, 0xed, 0xb0, 0x43, 0x30, 0x5e, 0x76, 0xf8, 0x6a, 0xca, 0x2b, 0x50, 0x0a, 0x73, 0xca,
-	0x60, 0xfc, 0x56, 0x83, 0x55, 0x9e, 0x29, 0xf9, 0x79, 0x44, 0xf7, 0x79, 0x13, 0x2a, 0xd6, 0x78,
-	0x78, 0x70, 0x9a, 0x0a, 0x67, 0x9e, 0x37, 0x01, 0x25, 0x49, 0x8d, 0x42, 0x92, 0xf3, 0xb3, 0xc2,
-	0xa5, 0x02, 0x51, 0x0d, 0x74, 0xc1, 0x97, 0x3c, 0x51, 0x59, 0x15, 0x9b, 0x82, 0x1b, 0xcf, 0xf8,
-	0x68, 0xe4, 0x52, 0xd7, 0xff, 0xdf, 0x7a, 0x5b, 0x7f, 0x99, 0xe1, 0x53, 0x2b, 0x12, 0x4a, 0xf7,
-	0x92, 0x68, 0x64, 0xa6, 0xde, 0x98, 0xf2, 0x19, 0x1a, 0x4b, 0x94, 0x44, 0x8d, 0x25, 0xa6, 0xcb,
-	0x7b, 0x49, 0x28, 0x66, 0x2e, 0xe3, 0xbf, 0x30, 0x16, 0xdb, 0x50, 0x96, 0x84, 0xcf, 0x28, 0x8a,
-	0x9b, 0x6a, 0x2c, 0x5e, 0x38, 0x90, 0x91, 0x82, 0x91, 0x0a, 0xbd, 0x34, 0xc0, 0xaf, 0x12, 0x3a,
-	0x2b, 0xc2, 0x7f, 0x41, 0x1a, 0x94, 0x3e, 0xee, 0x3e, 0xc1, 0x21, 0x53, 0xdd, 0x2c, 0x4f, 0xb8,
-	0xaf, 0x84, 0xd2, 0xcc, 0x0c, 0x9b, 0xa2, 0x45, 0x0b, 0x2c, 0x07, 0xdf, 0xed, 0x24, 0xcb, 0xf1,
-	0x5a, 0xbb, 0x3c, 0x23, 0xb7, 0x89, 0xae, 0x47, 0xe4, 0xc3, 0xf7, 0x52, 0x83, 0xf2, 0x87, 0xd9,
-	0xca, 0x2c, 0x33, 0x08, 0x4f, 0x48, 0x8c, 0xbf, 0x25, 0x46, 0x8c, 0xf3, 0xb4, 0x32, 0x2b, 0xc5,
-	0x55, 0x74, 0xf5, 0xca, 0xc0, 0xf6, 0xcb, 0x79, 0xd0, 0x05, 0x22, 0x99, 0x67, 0xcc, 0xd2, 0xc3,
-	0x35, 0xc8, 0x5b, 0xf8, 0x69, 0x9c, 0xb4, 0x8e, 0x7c, 0x95, 0x54, 0xe1, 0xac, 0x54, 0x85, 0xb7,
-	0xd4, 0x41, 0xe7, 0x95, 0xa7, 0x40, 0x2e, 0xe8, 0x13, 0xa5, 0x59, 0x84, 0xc1, 0xf6, 0x2c, 0xde,
-	0x64, 0xb4, 0x31, 0xc9, 0x24, 0xfb, 0xe3, 0x94, 0x44, 0xd4, 0x92, 0x73, 0x59, 0x9e, 0x8a, 0x7f,
-	0xfb, 0x52, 0xf1, 0x09, 0x35, 0x6b, 0x03, 0x53, 0x6e, 0x62, 0x54, 0x5e, 0xb7, 0xf9, 0x93, 0x66,
-	0x79, 0x46, 0x49, 0x17, 0x46, 0x15, 0x6d, 0x80, 0xe4, 0x09, 0xc5, 0x6f, 0xe4, 0x09, 0xa5, 0x17,
-	0xf0, 0x84, 0x09, 0xbf, 0x85, 0x17, 0xf5, 0xdb, 0xb5, 0x8f, 0x61, 0x75, 0xa6, 0x7a, 0x5f, 0x30,
-	0xf8, 0x94, 0xe7, 0x9d, 0x14, 0xd1, 0x3b, 0x74, 0x5e, 0x7b, 0x41, 0xa6, 0x28, 0x5d, 0x35, 0x72,
-	0x6a, 0x41, 0x59, 0x1e, 0xf5, 0xbe, 0xcc, 0xc0, 0xf5, 0xf7, 0x19, 0x58, 0x99, 0xf5, 0x92, 0xbb,
-	0x64, 0x2c, 0x70, 0x38, 0x35, 0x32, 0xdf, 0xbc, 0xea, 0x5d, 0xa8, 0x8e, 0xce, 0x27, 0x33, 0xf8,
-	0x2b, 0x1a, 0xa0, 0x77, 0xae, 0x1e, 0xa0, 0x5f, 0xd6, 0x08, 0x49, 0x1a, 0x95, 0x74, 0x5d, 0xfb,
-	0x31, 0xc0, 0xd1, 0xc8, 0x75, 0x62, 0xd6, 0xc6, 0x5f, 0x87, 0x65, 0x65, 0x5c, 0xc9, 0x50, 0xfa,
-	0x1c, 0x5a, 0x85, 0x25, 0x31, 0xa2, 0xdc, 0x6f, 0x5b, 0x1c, 0xac, 0xa1, 0x65, 0x78, 0xed, 0x28,
-	0xc2, 0x21, 0x3d, 0x0f, 0x07, 0x66, 0xd0, 0x22, 0x94, 0x3a, 0xed, 0x03, 0xbe, 0xcc, 0xd6, 0x36,
-	0xa1, 0x94, 0xfc, 0xff, 0x40, 0xaf, 0x41, 0xd9, 0x0a, 0xc2, 0xa1, 0x33, 0xa0, 0x4b, 0x7d, 0x0e,
-	0xe9, 0xb0, 0xd0, 0xf1, 0x86, 0x38, 0x18, 0xc7, 0x0c, 0xa2, 0xd5, 0xfe, 0xa1, 0x01, 0xa4, 0x63,
-	0x12, 0x54, 0x01, 0xe8, 0xb4, 0x0f, 0x8e, 0x8f, 0x0e, 0xcd, 0x7a, 0xa7, 0xa9, 0xcf, 0x21, 0x80,
-	0x7c, 0xfd, 0xf0, 0xb0, 0x69, 0x99, 0xba, 0x86, 0x8a, 0x90, 0xb3, 0x9b, 0x75, 0x53, 0xcf, 0xa0,
-	0x05, 0x28, 0x76, 0xec, 0x23, 0xab, 0x41, 0x68, 0xb2, 0x44, 0xe8, 0xc3, 0x66, 0xe7, 0x38, 0x81,
-	0xe4, 0x50, 0x19, 0x0a, 0x8d, 0x03, 0xcb, 0x6a, 0x36, 0x3a, 0xfa, 0x3c, 0x11, 0xc9, 0x17, 0xc7,
-	0xf6, 0x81, 0x9e, 0x47, 0x4b, 0xb0, 0xb8, 0x7f, 0xf0, 0xf0, 0x78, 0xaf, 0x59, 0xb7, 0x3b, 0xbb,
-	0xcd, 0x7a, 0x47, 0x2f, 0x10, 0x09, 0x0d, 0x4b, 0x82, 0x14, 0x09, 0xc4, 0x94, 0x21, 0x25, 0x84,
-	0xa0, 0xd2, 0xd8, 0x6b, 0x36, 0x1e, 0x1d, 0xef, 0xd5, 0x1f, 0x35, 0x9b, 0x87, 0x4d, 0x5b, 0x07,
-	0xa2, 0x40, 0xb2, 0x73, 0x63, 0xff, 0xa8, 0xdd, 0x69, 0xda, 0xc7, 0x66, 0xb3, 0x53, 0x6f, 0xed,
-	0xb7, 0xf5, 0x32, 0x21, 0x26, 0x88, 0xf6, 0x5e, 0xdd, 0x36, 0x8f, 0x5b, 0xd6, 0x83, 0x03, 0x7d,
-	0xa1, 0x66, 0x01, 0xa4, 0x33, 0x52, 0x72, 0x2a, 0xa2, 0x4b, 0x06, 0xd1, 0xe7, 0xc8, 0x95, 0x5a,
-	0x7e, 0x8c, 0x43, 0xdf, 0x19, 0xe8, 0x1a, 0x51, 0x1c, 0xb5, 0x4c, 0xa2, 0xe5, 0x25, 0x3e, 0x6e,
-	0xb6, 0xf1, 0x4f, 0x70, 0x37, 0xc6, 0xae, 0x9e, 0xad, 0xfd, 0x21, 0x23, 0x8d, 0x92, 0xc8, 0x95,
-	0xad, 0x80, 0x2e, 0xf5, 0x39, 0xb2, 0xe0, 0x6a, 0xd6, 0x35, 0x22, 0xb9, 0xe1, 0xf8, 0x5d, 0x3c,
-	0xc0, 0xae, 0x9e, 0x21, 0x17, 0x6b, 0xf9, 0x67, 0xce, 0xc0, 0x73, 0xa9, 0x6b, 0xeb, 0x59, 0x72,
-	0x56, 0x0e, 0x11, 0x3c, 0x39, 0x09, 0x76, 0xe8, 0x9c, 0x0f, 0x02, 0xc7, 0xd5, 0xe7, 0xd1, 0x35,
-	0x40, 0x2a, 0xac, 0xed, 0x7d, 0x82, 0xf5, 0x3c, 0x91, 0x9f, 0x9c, 0xaa, 0x40, 0x0e, 0x4a, 0x05,
-	0x5b, 0x41, 0x6c, 0x63, 0xc7, 0x3d, 0x67, 0xba, 0x6c, 0x9f, 0x47, 0x31, 0x1e, 0x36, 0x06, 0x41,
-	0x84, 0x5d, 0xbd, 0x44, 0x1d, 0x2f, 0xf2, 0xeb, 0x83, 0x90, 0x50, 0x24, 0xc3, 0x70, 0xdd, 0x25,
-	0x5a, 0x39, 0x18, 0xc7, 0x07, 0xa7, 0x36, 0x79, 0xa1, 0xea, 0xa4, 0x7a, 0x55, 0xac, 0x20, 0x96,
-	0x9c, 0x54, 0x3f, 0x65, 0x4e, 0x15, 0x8b, 0x04, 0xaf, 0xf7, 0xd0, 0x0a, 0xe8, 0x22, 0xfd, 0x58,
-	0x41, 0xfc, 0x20, 0x18, 0xfb, 0xae, 0xde, 0x47, 0xab, 0xa0, 0x1f, 0xc4, 0x7d, 0x1c, 0xb2, 0xad,
-	0x99, 0x66, 0xfe, 0x59, 0xa8, 0xfd, 0x59, 0x03, 0x24, 0x78, 0xa5, 0x50, 0x20, 0x7e, 0xe7, 0x75,
-	0x9f, 0xc8, 0x11, 0x20, 0x4d, 0x37, 0x93, 0x08, 0x58, 0x85, 0x25, 0x73, 0x0a, 0x9c, 0x21, 0xaa,
-	0x91, 0x87, 0xa9, 0x22, 0x18, 0xc8, 0x51, 0x1f, 0xe2, 0x38, 0x09, 0xac, 0x1c, 0x7a, 0x7d, 0x2a,
-	0x11, 0x73, 0xd4, 0x3c, 0xb9, 0x6a, 0x1b, 0xb3, 0xb0, 0xe0, 0xb0, 0x3c, 0xaa, 0xc2, 0x8a, 0xda,
-	0x72, 0x73, 0x4c, 0xa1, 0xf6, 0xb9, 0x06, 0x8b, 0x4a, 0xf9, 0x25, 0xc1, 0x29, 0x00, 0x8d, 0x10,
-	0x53, 0x7d, 0xce, 0x91, 0xfd, 0x04, 0x50, 0x19, 0x1d, 0xe8, 0x1a, 0xfa, 0x2e, 0xfc, 0xff, 0x14,
-	0x4a, 0x14, 0x07, 0x1b, 0x77, 0xb1, 0x77, 0x46, 0xfd, 0xe5, 0x0d, 0xb8, 0x3e, 0x45, 0xf6, 0xc0,
-	0xf1, 0x88, 0x33, 0x65, 0xe5, 0x3d, 0xed, 0xb1, 0xef, 0x13, 0xc1, 0xb9, 0x5a, 0x1f, 0xf4, 0xc9,
-	0x39, 0x0e, 0x51, 0x6f, 0xdd, 0x75, 0x79, 0x52, 0xd2, 0xe7, 0x88, 0x97, 0xd8, 0x78, 0x18, 0x9c,
-	0x61, 0x01, 0xd2, 0xa8, 0x97, 0xc4, 0x4e, 0x18, 0x0b, 0x48, 0x86, 0x68, 0xaf, 0x1d, 0x07, 0x23,
-	0x01, 0xc8, 0x12, 0x29, 0x8f, 0xbc, 0xc1, 0xe0, 0xa3, 0x60, 0x78, 0xe2, 0x61, 0x3d, 0x57, 0x7b,
-	0x47, 0x99, 0x60, 0x10, 0x34, 0xf1, 0x03, 0x06, 0xd1, 0xe7, 0x48, 0x66, 0x32, 0x7d, 0xb1, 0xd4,
-	0x76, 0x9b, 0xcf, 0xfe, 0xbe, 0x3e, 0xf7, 0xd5, 0xf3, 0x75, 0xed, 0xd9, 0xf3, 0x75, 0xed, 0x6f,
-	0xcf, 0xd7, 0xb5, 0x8f, 0x6e, 0x4b, 0x7f, 0xea, 0x87, 0x4e, 0x1c, 0x7a, 0x4f, 0x83, 0xd0, 0xeb,
-	0x79, 0xbe, 0x58, 0xf8, 0x78, 0x6b, 0xf4, 0xa4, 0xb7, 0x35, 0x3a, 0xd9, 0x4a, 0x33, 0xeb, 0x49,
-	0x9e, 0xfe, 0xa6, 0xbf, 0xfd, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbb, 0xc9, 0x6c, 0x74, 0x05,
-	0x20, 0x00, 0x00,
+	// 2492 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x19, 0x4d, 0x6f, 0x1b, 0xc7,
+	0x55, 0xcb, 0x6f, 0x3e, 0x4a, 0xcc, 0x6a, 0x24, 0xc5, 0x8c, 0x92, 0xca, 0xea, 0x36, 0x35, 0x5c,
+	0x25, 0x91, 0x00, 0x19, 0x0e, 0xe2, 0x56, 0xb1, 0x41, 0x71, 0x69, 0x8b, 0xb1, 0xbc, 0x52, 0x97,
+	0x54, 0x0f, 0x01, 0x02, 0x75, 0xc5, 0x1d, 0x91, 0x5b, 0x93, 0xbb, 0xec, 0xee, 0x52, 0xb5, 0x7a,
+	0xea, 0x25, 0x05, 0x8a, 0x1e, 0xda, 0xde, 0x82, 0x22, 0xe8, 0x9f, 0x68, 0x50, 0xf4, 0xd2, 0x5b,
+	0x0b, 0xe4, 0xe8, 0x4b, 0xaf, 0x41, 0xeb, 0x4b, 0x7b, 0xeb, 0x5f, 0x28, 0xe6, 0x6b, 0x77, 0x86,
+	0xa4, 0x24, 0x3b, 0x76, 0x81, 0xf4, 0xb6, 0xf3, 0xbe, 0xe6, 0xcd, 0x9b, 0xf7, 0x35, 0x6f, 0x41,
+	0x1f, 0x04, 0xbd, 0x08, 0x87, 0x67, 0x5e, 0x17, 0x6f, 0x8e, 0xc2, 0x20, 0x0e, 0x10, 0xa4, 0x90,
+	0xd5, 0xf7, 0x7a, 0x5e, 0xdc, 0x1f, 0x9f, 0x6c, 0x76, 0x83, 0xe1, 0x56, 0x2f, 0xe8, 0x05, 0x5b,
+	0x94, 0xe4, 0x64, 0x7c, 0x4a, 0x57, 0x74, 0x41, 0xbf, 0x18, 0xeb, 0x6a, 0x75, 0x88, 0x63, 0xc7,
+	0x75, 0x62, 0x87, 0xad, 0x8d, 0x5f, 0x6a, 0x50, 0x6c, 0x58, 0xed, 0x38, 0x08, 0x31, 0x42, 0x90,
+	0x3b, 0x3a, 0x6a, 0x99, 0x35, 0x6d, 0x5d, 0xbb, 0x59, 0xb6, 0xe9, 0x37, 0xba, 0x01, 0xd5, 0x36,
+	0xdb, 0xa9, 0xee, 0xba, 0x21, 0x8e, 0xa2, 0x5a, 0x86, 0x62, 0x27, 0xa0, 0x84, 0xb7, 0xe3, 0x75,
+	0x1f, 0xd7, 0xb2, 0xeb, 0xda, 0xcd, 0x9c, 0x4d, 0xbf, 0xd1, 0x3b, 0x90, 0x6f, 0xc7, 0x4e, 0x8c,
+	0x6b, 0xb9, 0x75, 0xed, 0x66, 0x75, 0x7b, 0x65, 0x53, 0x3a, 0x88, 0x15, 0xb8, 0x98, 0x22, 0x6d,
+	0x46, 0x63, 0xfc, 0x45, 0x83, 0xa2, 0xf9, 0x0d, 0x50, 0x04, 0xdd, 0x86, 0x42, 0xbb, 0xef, 0x84,
+	0x6e, 0x54, 0xcb, 0xaf, 0x67, 0x6f, 0x56, 0xb6, 0xaf, 0xc9, 0xd4, 0xa6, 0x45, 0x71, 0x2d, 0xff,
+	0x34, 0xd8, 0xcd, 0x7d, 0xf9, 0xd5, 0xf5, 0x39, 0x9b, 0x13, 0x1b, 0x7f, 0xd3, 0xa0, 0xb4, 0x1f,
+	0xf4, 0xbe, 0x01, 0x07, 0xd8, 0x81, 0x92, 0x8d, 0x47, 0x03, 0xaf, 0xeb, 0x88, 0x23, 0xac, 0xca,
+	0xf4, 0xfb, 0x41, 0x8f, 0xa3, 0xa5, 0x53, 0x24, 0x1c, 0xc6, 0x7f, 0x34, 0x98, 0x27, 0xe7, 0x10,
+	0xc7, 0x44, 0x35, 0x28, 0xb2, 0x05, 0x3b, 0x4e, 0xce, 0x16, 0x4b, 0xb4, 0x2b, 0x6d, 0x94, 0xa1,
+	0x1b, 0xdd, 0x98, 0xd8, 0x28, 0x91, 0xb2, 0x29, 0x08, 0x9b, 0x7e, 0x1c, 0x9e, 0xa7, 0xdb, 0xa1,
+	0x65, 0xc8, 0x37, 0x47, 0x41, 0xb7, 0xcf, 0x8f, 0xcb, 0x16, 0x68, 0x15, 0x4a, 0xfb, 0xd8, 0x71,
+	0x71, 0xd8, 0x32, 0xe9, 0x91, 0x73, 0x76, 0xb2, 0xa6, 0xf6, 0xc1, 0xe1, 0xb0, 0x96, 0xe7, 0xf6,
+	0xc1, 0xe1, 0x70, 0xf5, 0x07, 0xb0, 0xa0, 0x6c, 0x80, 0x74, 0xc8, 0x3e, 0xc6, 0xe7, 0x5c, 0x61,
+	0xf2, 0x49, 0x36, 0x3a, 0x73, 0x06, 0x63, 0xcc, 0xad, 0xce, 0x16, 0xdf, 0xcf, 0x7c, 0xa0, 0x19,
+	0x67, 0x50, 0x55, 0x6d, 0x82, 0xee, 0xab, 0x26, 0xa0, 0x62, 0x2a, 0xdb, 0xb5, 0x8b, 0x0e, 0xb7,
+	0x5b, 0x22, 0x36, 0x7c, 0xfa, 0xd5, 0x75, 0xcd, 0x56, 0x4d, 0xf7, 0x16, 0x94, 0x85, 0x58, 0x93,
+	0xee, 0x9b, 0xb3, 0x53, 0x80, 0x61, 0x81, 0xce, 0x23, 0x6f, 0x0f, 0x3b, 0x61, 0x7c, 0x82, 0x9d,
+	0xf8, 0x65, 0x1c, 0xc7, 0xf8, 0xbb, 0x06, 0x8b, 0xc2, 0x03, 0x2f, 0x97, 0xb8, 0x0e, 0x15, 0xdb,
+	0x39, 0x8d, 0x55, 0x71, 0x32, 0x68, 0xc6, 0x9e, 0xd9, 0x99, 0xce, 0xfa, 0x36, 0x2c, 0x3c, 0x08,
+	0xa2, 0xc8, 0x1b, 0x09, 0xb2, 0x1c, 0x25, 0x53, 0x81, 0x2f, 0xe9, 0x91, 0x4d, 0xa8, 0x98, 0xd6,
+	0xf3, 0xf8, 0xe3, 0xe5, 0xe6, 0xfe, 0x54, 0x03, 0xdd, 0x7c, 0x85, 0xf6, 0x96, 0x12, 0x45, 0xf6,
+	0x45, 0x12, 0xc5, 0xaf, 0x32, 0x50, 0xb2, 0xdb, 0x8f, 0x58, 0xac, 0xea, 0x90, 0xed, 0x44, 0x81,
+	0xf0, 0xd3, 0x4e, 0x14, 0x10, 0x3f, 0x6d, 0xf9, 0x2e, 0x7e, 0xc2, 0x0f, 0xc0, 0x16, 0xc4, 0xce,
+	0xfb, 0xd8, 0x89, 0xf0, 0x5e, 0x30, 0x60, 0x51, 0xc1, 0xc2, 0x45, 0x05, 0x22, 0x03, 0xe6, 0x3b,
+	0xe1, 0xd8, 0xef, 0x3a, 0x31, 0x76, 0xf7, 0x23, 0x9f, 0x87, 0x8e, 0x02, 0x43, 0x1f, 0xc1, 0x3c,
+	0x63, 0xf2, 0xa2, 0x38, 0x08, 0xcf, 0x6b, 0xf9, 0xe9, 0xc0, 0x15, 0xda, 0x6d, 0xca, 0x84, 0x2c,
+	0x70, 0x15, 0xde, 0xd5, 0x7b, 0xb0, 0x38, 0x45, 0x72, 0x55, 0xe8, 0xe5, 0xe4, 0xd0, 0xfb, 0x04,
+	0xca, 0xf4, 0xf2, 0xbb, 0x41, 0xe8, 0x12, 0x46, 0xa2, 0x34, 0x67, 0x24, 0xba, 0x6e, 0x40, 0xae,
+	0x73, 0x3e, 0x62, 0x7c, 0xd5, 0xed, 0xd7, 0x15, 0x1d, 0x29, 0x0f, 0xc1, 0xda, 0x94, 0x86, 0xdc,
+	0xa4, 0xe9, 0xc4, 0x0e, 0x35, 0xcc, 0xbc, 0x4d, 0xbf, 0x8d, 0xcf, 0x34, 0x00, 0x2a, 0xff, 0xa7,
+	0x63, 0x1c, 0xd1, 0xcb, 0xb6, 0x9c, 0x21, 0x16, 0x97, 0x4d, 0xbe, 0x65, 0x6f, 0xca, 0xa8, 0xde,
+	0xc4, 0xd5, 0xc9, 0xa6, 0xea, 0xd4, 0xa0, 0xf8, 0xc8, 0x79, 0xd2, 0xf6, 0x7e, 0x8e, 0xb9, 0x65,
+	0xc5, 0x92, 0x78, 0x9e, 0xb8, 0x70, 0x93, 0x27, 0xa6, 0x14, 0x40, 0x55, 0xb3, 0x5a, 0x66, 0xad,
+	0x40, 0x11, 0xf4, 0xdb, 0x30, 0x00, 0x3a, 0x51, 0x20, 0x34, 0x5b, 0x86, 0x7c, 0x23, 0x18, 0xfb,
+	0x31, 0x3f, 0x3c, 0x5b, 0x18, 0xbf, 0xcd, 0x42, 0x51, 0x50, 0x50, 0xdf, 0xa6, 0x9f, 0x89, 0xdf,
+	0xa7, 0x00, 0xb4, 0x09, 0x85, 0x47, 0x38, 0xee, 0x07, 0xee, 0x2c, 0x53, 0x31, 0x0c, 0x35, 0x15,
+	0xa7, 0x42, 0x3b, 0xb2, 0x5d, 0xe8, 0x11, 0x2b, 0x2a, 0x4f, 0x8a, 0xe5, 0xde, 0x2b, 0xdb, 0xb1,
+	0x4e, 0xd3, 0x63, 0x12, 0x44, 0xd4, 0x18, 0x95, 0xed, 0x6f, 0x4d, 0xa6, 0x47, 0x25, 0xd2, 0x6c,
+	0x85, 0x05, 0xdd, 0x85, 0x4a, 0xc3, 0x4a, 0x25, 0xe4, 0xa9, 0x84, 0xb7, 0x64, 0x09, 0x93, 0xa9,
+	0xd1, 0x96, 0x19, 0x08, 0xbf, 0x29, 0xf1, 0x17, 0xa6, 0xf9, 0xcd, 0x29, 0x7e, 0x89, 0x01, 0xbd,
+	0x2f, 0x9b, 0xbf, 0x56, 0x9c, 0x36, 0x40, 0x8a, 0xb5, 0x25, 0x4a, 0xa3, 0x0d, 0x15, 0x6a, 0x88,
+	0x68, 0x14, 0xf8, 0x11, 0xbe, 0x24, 0x17, 0x71, 0xef, 0xc9, 0x28, 0xde, 0xb3, 0xef, 0x44, 0x71,
+	0xea, 0x53, 0x62, 0x69, 0xfc, 0x26, 0x07, 0xa5, 0x44, 0xe4, 0xab, 0xbd, 0xe8, 0x5b, 0x50, 0x6e,
+	0x86, 0x61, 0x10, 0x36, 0x02, 0x17, 0xd7, 0xb2, 0xd3, 0xcd, 0x43, 0x82, 0xb4, 0x53, 0x3a, 0x92,
+	0x46, 0xe8, 0xe2, 0x11, 0x8e, 0x22, 0xa7, 0x87, 0x79, 0x4e, 0x57, 0x60, 0x68, 0x0d, 0xa0, 0x15,
+	0xed, 0xd5, 0x1f, 0x62, 0x3c, 0xc2, 0x21, 0xbd, 0xbf, 0x92, 0x2d, 0x41, 0xd0, 0x3d, 0xc5, 0x50,
+	0xfc, 0x82, 0xae, 0x4d, 0xb9, 0x18, 0x43, 0x73, 0x1f, 0x53, 0x4c, 0xbb, 0x03, 0xf3, 0x8d, 0x60,
+	0x38, 0x74, 0x7c, 0x77, 0xd7, 0x89, 0xbb, 0xfd, 0x5a, 0x71, 0xba, 0x06, 0xcb, 0x78, 0x5b, 0xa1,
+	0x46, 0x77, 0xa0, 0x42, 0x6f, 0x8d, 0x6f, 0x5f, 0x9a, 0xde, 0x5e, 0x42, 0xdb, 0x32, 0x2d, 0xda,
+	0x85, 0x6a, 0x63, 0x30, 0x8e, 0x62, 0x1c, 0x9a, 0x38, 0x76, 0xbc, 0x41, 0x54, 0x2b, 0xaf, 0x6b,
+	0x93, 0x25, 0x4b, 0xa5, 0xb0, 0x27, 0x38, 0xd0, 0x5d, 0x28, 0xa7, 0xdd, 0x03, 0x50, 0xf6, 0x75,
+	0x99, 0x3d, 0x41, 0xfe, 0x70, 0x8c, 0xc3, 0x73, 0x1b, 0x47, 0xe3, 0x41, 0x6c, 0xa7, 0x2c, 0xc6,
+	0x47, 0xb4, 0x92, 0xb3, 0x1c, 0x97, 0x28, 0x76, 0x1b, 0x8a, 0x0c, 0x12, 0xd5, 0x34, 0x9a, 0xb4,
+	0x57, 0xa6, 0xcc, 0x49, 0xb0, 0xdc, 0x98, 0x82, 0xd6, 0xf8, 0x8e, 0x62, 0x0a, 0x92, 0x6a, 0x7e,
+	0x44, 0x93, 0x31, 0x4f, 0x35, 0x74, 0x61, 0xfc, 0x5a, 0x83, 0x22, 0x2f, 0x95, 0x33, 0x6b, 0xe2,
+	0xc5, 0x69, 0x52, 0x29, 0xba, 0xd9, 0x89, 0xa2, 0x9b, 0xb6, 0x77, 0x39, 0xb9, 0xbd, 0x5b, 0xa3,
+	0xe9, 0x47, 0xcd, 0x97, 0x12, 0xc4, 0xf8, 0x7d, 0x86, 0x5c, 0xbe, 0x7f, 0xea, 0xf5, 0x1a, 0x7d,
+	0xc7, 0xef, 0x61, 0x74, 0x2b, 0xd1, 0x8e, 0xf7, 0x62, 0x4b, 0x6a, 0x2d, 0xa0, 0xa8, 0xf4, 0xe0,
+	0xec, 0x1c, 0x3b, 0x00, 0x8c, 0x5d, 0xaa, 0x21, 0x6a, 0x8a, 0x91, 0xb6, 0xa0, 0x51, 0x23, 0xd1,
+	0xa3, 0x0e, 0x54, 0x5b, 0xbe, 0x17, 0x7b, 0xce, 0xe0, 0x11, 0x1e, 0x9e, 0xe0, 0x50, 0x54, 0xf9,
+	0x77, 0x2f, 0x92, 0xb0, 0xa9, 0x92, 0xb3, 0x7a, 0x39, 0x21, 0x63, 0xb5, 0x0e, 0x4b, 0x33, 0xc8,
+	0x5e, 0xa8, 0x5d, 0xfd, 0x1e, 0x2c, 0xb4, 0xfb, 0xe3, 0xd8, 0x0d, 0x7e, 0xe6, 0xb3, 0xc7, 0x06,
+	0xb9, 0x1b, 0xf2, 0x91, 0x5c, 0x99, 0x58, 0x1a, 0x9f, 0x66, 0xe0, 0xb5, 0x76, 0xb7, 0x8f, 0xdd,
+	0xf1, 0x00, 0xf3, 0xf0, 0x98, 0x79, 0xbb, 0x6f, 0xc3, 0xc2, 0x6e, 0x10, 0xc4, 0x51, 0x1c, 0x3a,
+	0xa3, 0x91, 0xe7, 0xf7, 0xe8, 0xa6, 0x25, 0x5b, 0x05, 0xb2, 0x88, 0x4c, 0xcf, 0x5b, 0xcb, 0xce,
+	0x8a, 0xc8, 0x14, 0x6f, 0xab, 0x57, 0x78, 0x07, 0x2a, 0xbc, 0x7f, 0xa2, 0xd7, 0xc1, 0x1e, 0x32,
+	0x4a, 0x44, 0x4a, 0x68, 0x5b, 0xa6, 0x45, 0xf7, 0x26, 0x4e, 0xcc, 0xcb, 0xc5, 0x1b, 0x6a, 0x44,
+	0x49, 0x04, 0xb6, 0x4a, 0x6f, 0x38, 0x6a, 0x2e, 0x49, 0x9e, 0x10, 0x5a, 0xfa, 0x84, 0x40, 0x1f,
+	0x42, 0x89, 0xd3, 0x88, 0xc7, 0xcc, 0x9b, 0x8a, 0x7c, 0xd5, 0x8c, 0xa2, 0x49, 0x15, 0x2c, 0x46,
+	0x8b, 0x14, 0x34, 0x66, 0x77, 0xd2, 0xa4, 0x8a, 0x47, 0x9c, 0x26, 0x3d, 0xe2, 0x9e, 0xb7, 0x8f,
+	0xff, 0x9c, 0x3f, 0xc9, 0x49, 0x7f, 0xf8, 0x21, 0x14, 0xa8, 0x50, 0x11, 0xf2, 0xd7, 0x27, 0x4b,
+	0x24, 0x69, 0xd3, 0x18, 0x05, 0xf5, 0xa4, 0xa4, 0xd7, 0xa4, 0xa0, 0x55, 0x1b, 0x2a, 0x12, 0x52,
+	0x76, 0xb3, 0x32, 0x73, 0xb3, 0xf7, 0x64, 0x37, 0x9b, 0xc8, 0x90, 0xd2, 0x79, 0x64, 0xff, 0xfb,
+	0x85, 0x46, 0xfb, 0xf1, 0x57, 0x71, 0xd4, 0xaf, 0xdb, 0x42, 0x7f, 0xce, 0x67, 0x05, 0x57, 0x5a,
+	0xc8, 0xfc, 0xdf, 0x5a, 0xc8, 0x9c, 0x6d, 0xa1, 0x3f, 0x6b, 0x93, 0x25, 0x04, 0xdd, 0x86, 0x92,
+	0x69, 0x29, 0x7a, 0x2e, 0xcd, 0x10, 0x24, 0xbc, 0x4a, 0x90, 0x12, 0xb6, 0x86, 0x60, 0xcb, 0x4c,
+	0xb3, 0x35, 0x54, 0x36, 0x41, 0x8a, 0x3e, 0xa0, 0x6d, 0x35, 0xe7, 0x63, 0x96, 0x5d, 0x9e, 0xd5,
+	0x9d, 0x71, 0xc6, 0x94, 0x98, 0x8c, 0x83, 0x2a, 0x5c, 0x75, 0x7a, 0xb9, 0x77, 0xa8, 0xde, 0xec,
+	0x8a, 0x34, 0x7e, 0x45, 0xc9, 0x04, 0x89, 0x63, 0x94, 0xb2, 0x93, 0x90, 0xa3, 0x1d, 0xa6, 0x04,
+	0xe3, 0x65, 0xca, 0xd7, 0x52, 0x5e, 0x81, 0x52, 0x98, 0x53, 0x06, 0xe3, 0x77, 0x1a, 0xac, 0xf0,
+	0x4c, 0xc9, 0xf5, 0x11, 0xdd, 0xe7, 0x0d, 0xa8, 0x5a, 0xe3, 0xe1, 0xc1, 0x69, 0x2a, 0x9c, 0x79,
+	0xde, 0x04, 0x94, 0x24, 0x35, 0x0a, 0x49, 0xf4, 0x67, 0x85, 0x4b, 0x05, 0xa2, 0x0d, 0xd0, 0x05,
+	0x5f, 0xf2, 0x44, 0x65, 0x55, 0x6c, 0x0a, 0x6e, 0x3c, 0xe5, 0xa3, 0x91, 0x4b, 0x5d, 0xff, 0xff,
+	0xeb, 0x6d, 0xfd, 0x45, 0x86, 0x4f, 0xad, 0x48, 0x28, 0xdd, 0x85, 0x82, 0x72, 0xd5, 0xeb, 0x53,
+	0x3e, 0x43, 0x63, 0x89, 0x92, 0xa8, 0xb1, 0xc4, 0x6c, 0x79, 0x37, 0x09, 0xc5, 0xcc, 0x65, 0xfc,
+	0x17, 0xc6, 0x62, 0x1b, 0x2a, 0x92, 0xf0, 0x19, 0x45, 0x71, 0x53, 0x8d, 0xc5, 0x0b, 0x07, 0x32,
+	0x52, 0x30, 0x52, 0xa1, 0x97, 0x06, 0xf8, 0x55, 0x42, 0x67, 0x45, 0xf8, 0x9f, 0x48, 0x83, 0xd2,
+	0xc7, 0xdd, 0xc7, 0x38, 0x64, 0xa6, 0x9b, 0xe5, 0x09, 0xf7, 0x94, 0x50, 0x9a, 0x99, 0x61, 0x53,
+	0xb4, 0x68, 0x81, 0x25, 0x10, 0xe9, 0x7a, 0x78, 0x02, 0xe3, 0xb5, 0x76, 0x69, 0x46, 0x6e, 0x13,
+	0x5d, 0x0f, 0x5f, 0xa2, 0xf7, 0xd3, 0x0b, 0xe5, 0x0f, 0xb3, 0xe5, 0x59, 0xd7, 0x20, 0x3c, 0x21,
+	0xb9, 0xfc, 0x2d, 0x31, 0x62, 0xcc, 0xd3, 0xca, 0xac, 0x14, 0x57, 0xd1, 0xd5, 0x2b, 0x63, 0xc6,
+	0x5b, 0x49, 0x95, 0xaa, 0x15, 0xa6, 0xb5, 0x6b, 0xa8, 0xda, 0xf1, 0xa5, 0xf1, 0x45, 0x1e, 0x74,
+	0x21, 0x2d, 0x19, 0x82, 0xcc, 0x32, 0xde, 0xeb, 0x50, 0xb0, 0xf0, 0x93, 0x38, 0xe9, 0x37, 0xf9,
+	0x2a, 0x29, 0xdd, 0x59, 0xa9, 0x74, 0x6f, 0xa9, 0xd3, 0xd1, 0xab, 0x55, 0x77, 0x41, 0x9f, 0xa8,
+	0xe7, 0x22, 0x76, 0xb6, 0x67, 0xf1, 0x26, 0xf3, 0x90, 0x49, 0x26, 0xd9, 0x89, 0xa7, 0x24, 0xa2,
+	0x96, 0x9c, 0x00, 0x0b, 0x54, 0xfc, 0x3b, 0x97, 0x8a, 0x4f, 0xa8, 0xa9, 0x5c, 0x29, 0x1b, 0xca,
+	0xb6, 0x2e, 0x3e, 0xaf, 0xad, 0x65, 0xf7, 0x29, 0x7d, 0x2d, 0xf7, 0x29, 0xbf, 0x80, 0xfb, 0x4c,
+	0x38, 0x3b, 0xbc, 0xa8, 0xb3, 0xaf, 0x7e, 0x02, 0x2b, 0x33, 0xcd, 0xfb, 0x82, 0x11, 0xab, 0xbc,
+	0x09, 0xa5, 0x34, 0xb0, 0x03, 0xd5, 0xc4, 0x9c, 0x17, 0xc9, 0xbd, 0x78, 0x4e, 0xd5, 0x82, 0x8a,
+	0x3c, 0x1f, 0x7e, 0x99, 0x29, 0xed, 0x1f, 0x32, 0xb0, 0x3c, 0xeb, 0xf9, 0x77, 0xc9, 0x2c, 0xe1,
+	0x70, 0x6a, 0xce, 0xbe, 0x79, 0xd5, 0x63, 0x52, 0x9d, 0xb7, 0x4f, 0xa6, 0xfd, 0x57, 0x34, 0x75,
+	0xef, 0x5c, 0x3d, 0x75, 0xbf, 0xac, 0x7b, 0x92, 0x2c, 0x2a, 0xd9, 0x7a, 0xe3, 0xc7, 0x00, 0x47,
+	0x23, 0xd7, 0x89, 0x59, 0xef, 0x7f, 0x0d, 0x96, 0x94, 0x19, 0x27, 0x43, 0xe9, 0x73, 0x68, 0x05,
+	0x16, 0xc5, 0x5c, 0x73, 0xbf, 0x6d, 0x71, 0xb0, 0x86, 0x96, 0xe0, 0xb5, 0xa3, 0x08, 0x87, 0x54,
+	0x1f, 0x0e, 0xcc, 0xa0, 0x05, 0x28, 0x77, 0xda, 0x07, 0x7c, 0x99, 0xdd, 0xd8, 0x84, 0x72, 0xf2,
+	0xd3, 0x04, 0xbd, 0x06, 0x15, 0x2b, 0x08, 0x87, 0xce, 0x80, 0x2e, 0xf5, 0x39, 0xa4, 0xc3, 0x7c,
+	0xc7, 0x1b, 0xe2, 0x60, 0x1c, 0x33, 0x88, 0xb6, 0xf1, 0x2f, 0x0d, 0x20, 0x9d, 0xad, 0xa0, 0x2a,
+	0x40, 0xa7, 0x7d, 0x70, 0x7c, 0x74, 0x68, 0xd6, 0x3b, 0x4d, 0x7d, 0x0e, 0x01, 0x14, 0xea, 0x87,
+	0x87, 0x4d, 0xcb, 0xd4, 0x35, 0x54, 0x82, 0x9c, 0xdd, 0xac, 0x9b, 0x7a, 0x06, 0xcd, 0x43, 0xa9,
+	0x63, 0x1f, 0x59, 0x0d, 0x42, 0x93, 0x25, 0x42, 0x1f, 0x34, 0x3b, 0xc7, 0x09, 0x24, 0x87, 0x2a,
+	0x50, 0x6c, 0x1c, 0x58, 0x56, 0xb3, 0xd1, 0xd1, 0xf3, 0x44, 0x24, 0x5f, 0x1c, 0xdb, 0x07, 0x7a,
+	0x01, 0x2d, 0xc2, 0xc2, 0xfe, 0xc1, 0x83, 0xe3, 0xbd, 0x66, 0xdd, 0xee, 0xec, 0x36, 0xeb, 0x1d,
+	0xbd, 0x48, 0x24, 0x34, 0x2c, 0x09, 0x52, 0x22, 0x10, 0x53, 0x86, 0x94, 0x11, 0x82, 0x6a, 0x63,
+	0xaf, 0xd9, 0x78, 0x78, 0xbc, 0x57, 0x7f, 0xd8, 0x6c, 0x1e, 0x36, 0x6d, 0x1d, 0x88, 0x01, 0xc9,
+	0xce, 0x8d, 0xfd, 0xa3, 0x76, 0xa7, 0x69, 0x1f, 0x9b, 0xcd, 0x4e, 0xbd, 0xb5, 0xdf, 0xd6, 0x2b,
+	0x84, 0x98, 0x20, 0xda, 0x7b, 0x75, 0xdb, 0x3c, 0x6e, 0x59, 0xf7, 0x0f, 0xf4, 0xf9, 0x0d, 0x0b,
+	0x20, 0x1d, 0xac, 0x12, 0xad, 0x88, 0x2d, 0x19, 0x44, 0x9f, 0x23, 0x47, 0x6a, 0xf9, 0x31, 0x0e,
+	0x7d, 0x67, 0xa0, 0x6b, 0xc4, 0x70, 0xf4, 0x66, 0x12, 0x2b, 0x2f, 0xf2, 0x19, 0xb5, 0x8d, 0x7f,
+	0x82, 0xbb, 0x31, 0x76, 0xf5, 0xec, 0xc6, 0x1f, 0x33, 0xd2, 0xfc, 0x89, 0x1c, 0xd9, 0x0a, 0xe8,
+	0x52, 0x9f, 0x23, 0x0b, 0x6e, 0x66, 0x5d, 0x23, 0x92, 0x1b, 0x8e, 0xdf, 0xc5, 0x03, 0xec, 0xea,
+	0x19, 0x72, 0xb0, 0x96, 0x7f, 0xe6, 0x0c, 0x3c, 0x97, 0xba, 0xb6, 0x9e, 0x25, 0xba, 0x72, 0x88,
+	0xe0, 0xc9, 0x49, 0xb0, 0x43, 0xe7, 0x7c, 0x10, 0x38, 0xae, 0x9e, 0x47, 0xaf, 0x03, 0x52, 0x61,
+	0x64, 0x3a, 0xab, 0x17, 0x88, 0xfc, 0x44, 0xab, 0x22, 0x51, 0x94, 0x0a, 0xb6, 0x82, 0xd8, 0xc6,
+	0x8e, 0x7b, 0xce, 0x6c, 0xd9, 0x3e, 0x8f, 0x62, 0x3c, 0x6c, 0x0c, 0x82, 0x08, 0xbb, 0x7a, 0x99,
+	0x3a, 0x5e, 0xe4, 0xd7, 0x07, 0x21, 0xa1, 0x48, 0x26, 0xe8, 0xba, 0x4b, 0xac, 0x72, 0x30, 0x8e,
+	0x0f, 0x4e, 0x6d, 0xf2, 0xac, 0xd5, 0x49, 0xf5, 0xaa, 0x5a, 0x41, 0x2c, 0x39, 0xa9, 0x7e, 0xca,
+	0x9c, 0x2a, 0x16, 0x09, 0x5e, 0xef, 0xa1, 0x65, 0xd0, 0x45, 0xfa, 0xb1, 0x82, 0xf8, 0x7e, 0x30,
+	0xf6, 0x5d, 0xbd, 0x8f, 0x56, 0x40, 0x3f, 0x88, 0xfb, 0x38, 0x64, 0x5b, 0x33, 0xcb, 0xfc, 0xbb,
+	0xb8, 0xf1, 0x57, 0x0d, 0x90, 0xe0, 0x95, 0x42, 0x81, 0xf8, 0x9d, 0xd7, 0x7d, 0x2c, 0x47, 0x80,
+	0x34, 0x12, 0x4d, 0x22, 0x60, 0x05, 0x16, 0xcd, 0x29, 0x70, 0x86, 0x98, 0x46, 0x9e, 0xc0, 0x8a,
+	0x60, 0x20, 0xaa, 0x3e, 0xc0, 0x71, 0x12, 0x58, 0x39, 0xf4, 0xc6, 0x54, 0x22, 0xe6, 0xa8, 0x3c,
+	0x39, 0x6a, 0x1b, 0xb3, 0xb0, 0xe0, 0xb0, 0x02, 0xaa, 0xc1, 0xb2, 0xda, 0xa7, 0x73, 0x4c, 0x71,
+	0xe3, 0x33, 0x0d, 0x16, 0x94, 0xf2, 0x4b, 0x82, 0x53, 0x00, 0x1a, 0x21, 0xa6, 0xf6, 0x9c, 0x23,
+	0xfb, 0x09, 0xa0, 0x32, 0x6f, 0xd0, 0x35, 0xf4, 0x5d, 0xf8, 0xf6, 0x14, 0x4a, 0x14, 0x07, 0x1b,
+	0x77, 0xb1, 0x77, 0x46, 0xfd, 0xe5, 0x4d, 0xb8, 0x36, 0x45, 0x76, 0xdf, 0xf1, 0x88, 0x33, 0x65,
+	0xe5, 0x3d, 0xed, 0xb1, 0xef, 0x13, 0xc1, 0xb9, 0x8d, 0x3e, 0xe8, 0x93, 0xc3, 0x1f, 0x62, 0xde,
+	0xba, 0xeb, 0xf2, 0xa4, 0xa4, 0xcf, 0x11, 0x2f, 0xb1, 0xf1, 0x30, 0x38, 0xc3, 0x02, 0xa4, 0x51,
+	0x2f, 0x89, 0x9d, 0x30, 0x16, 0x90, 0x0c, 0xb1, 0x5e, 0x3b, 0x0e, 0x46, 0x02, 0x90, 0x25, 0x52,
+	0x1e, 0x7a, 0x83, 0xc1, 0xc7, 0xc1, 0xf0, 0xc4, 0xc3, 0x7a, 0x6e, 0xe3, 0x5d, 0x65, 0xec, 0x41,
+	0xd0, 0xc4, 0x0f, 0x18, 0x44, 0x9f, 0x23, 0x99, 0xc9, 0xf4, 0xc5, 0x52, 0xdb, 0x6d, 0x3e, 0xfd,
+	0xe7, 0xda, 0xdc, 0x97, 0xcf, 0xd6, 0xb4, 0xa7, 0xcf, 0xd6, 0xb4, 0x7f, 0x3c, 0x5b, 0xd3, 0x3e,
+	0xbe, 0x25, 0xfd, 0xde, 0x1f, 0x3a, 0x71, 0xe8, 0x3d, 0x09, 0x42, 0xaf, 0xe7, 0xf9, 0x62, 0xe1,
+	0xe3, 0xad, 0xd1, 0xe3, 0xde, 0xd6, 0xe8, 0x64, 0x2b, 0xcd, 0xac, 0x27, 0x05, 0xfa, 0x6f, 0xff,
+	0xd6, 0x7f, 0x07, 0x00, 0xb2, 0x03, 0xc7, 0xd7, 0x3a, 0x20, 0x00, 0x00,
 }
 
 func (m *CNStore) Marshal() (dAtA []byte, err error) {
@@ -4895,6 +4902,16 @@ func (m *CheckerState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	{
+		size, err := m.CNState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLogservice(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if m.State != 0 {
 		i = encodeVarintLogservice(dAtA, i, uint64(m.State))
 		i--
@@ -6022,6 +6039,8 @@ func (m *CheckerState) Size() (n int) {
 	if m.State != 0 {
 		n += 1 + sovLogservice(uint64(m.State))
 	}
+	l = m.CNState.Size()
+	n += 1 + l + sovLogservice(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11430,6 +11449,39 @@ func (m *CheckerState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CNState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CNState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogservice(dAtA[iNdEx:])
@@ -16,7 +16,17 @@ package pipeline
 
 import "fmt"
 
-const MessageEnd = 1
+const (
+	// BatchMessage carries an encoded batch produced by a remote pipeline
+	BatchMessage = iota
+	// MessageEnd is the last message of a remote pipeline, it carries the
+	// error and the analysis information
+	MessageEnd
+	// PipelineMessage carries an encoded pipeline to run at the remote node
+	PipelineMessage
+	// CancelMessage stops the remote pipeline started by the same stream
+	CancelMessage
+)
 
 func (m *Message) Size() int {
 	return m.ProtoSize()
@@ -5,13 +5,12 @@ package pipeline
 
 import (
 	fmt "fmt"
-	io "io"
-	math "math"
-	math_bits "math/bits"
-
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	plan "github.com/matrixorigin/matrixone/pkg/pb/plan"
+	io "io"
+	math "math"
+	math_bits "math/bits"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -62,6 +61,7 @@ type Message struct {
 	Code                 []byte   `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
 	Data                 []byte   `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
 	Analyse              []byte   `protobuf:"bytes,5,opt,name=analyse,proto3" json:"analyse,omitempty"`
+	ProcInfoData         []byte   `protobuf:"bytes,6,opt,name=proc_info_data,json=procInfoData,proto3" json:"proc_info_data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -135,6 +135,13 @@ func (m *Message) GetAnalyse() []byte {
 	return nil
 }
 
+func (m *Message) GetProcInfoData() []byte {
+	if m != nil {
+		return m.ProcInfoData
+	}
+	return nil
+}
+
 type Connector struct {
 	PipelineId           int32    `protobuf:"varint,1,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
 	ConnectorIndex       int32    `protobuf:"varint,2,opt,name=connector_index,json=connectorIndex,proto3" json:"connector_index,omitempty"`
@@ -193,6 +200,8 @@ func (m *Connector) GetConnectorIndex() int32 {
 type Dispatch struct {
 	All                  bool         `protobuf:"varint,1,opt,name=all,proto3" json:"all,omitempty"`
 	Connector            []*Connector `protobuf:"bytes,2,rep,name=connector,proto3" json:"connector,omitempty"`
+	ShuffleKeys          []*plan.Expr `protobuf:"bytes,3,rep,name=shuffle_keys,json=shuffleKeys,proto3" json:"shuffle_keys,omitempty"`
+	Aggs                 []*Aggregate `protobuf:"bytes,4,rep,name=aggs,proto3" json:"aggs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -245,13 +254,28 @@ func (m *Dispatch) GetConnector() []*Connector {
 	return nil
 }
 
+func (m *Dispatch) GetShuffleKeys() []*plan.Expr {
+	if m != nil {
+		return m.ShuffleKeys
+	}
+	return nil
+}
+
+func (m *Dispatch) GetAggs() []*Aggregate {
+	if m != nil {
+		return m.Aggs
+	}
+	return nil
+}
+
 type Aggregate struct {
-	Op                   int32      `protobuf:"varint,1,opt,name=op,proto3" json:"op,omitempty"`
-	Dist                 bool       `protobuf:"varint,2,opt,name=dist,proto3" json:"dist,omitempty"`
-	Expr                 *plan.Expr `protobuf:"bytes,3,opt,name=expr,proto3" json:"expr,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Op                   int32        `protobuf:"varint,1,opt,name=op,proto3" json:"op,omitempty"`
+	Dist                 bool         `protobuf:"varint,2,opt,name=dist,proto3" json:"dist,omitempty"`
+	Expr                 *plan.Expr   `protobuf:"bytes,3,opt,name=expr,proto3" json:"expr,omitempty"`
+	Args                 []*plan.Expr `protobuf:"bytes,4,rep,name=args,proto3" json:"args,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *Aggregate) Reset()         { *m = Aggregate{} }
@@ -308,6 +332,13 @@ func (m *Aggregate) GetExpr() *plan.Expr {
 	return nil
 }
 
+func (m *Aggregate) GetArgs() []*plan.Expr {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
 type Group struct {
 	NeedEval             bool         `protobuf:"varint,1,opt,name=need_eval,json=needEval,proto3" json:"need_eval,omitempty"`
 	Ibucket              uint64       `protobuf:"varint,2,opt,name=ibucket,proto3" json:"ibucket,omitempty"`
@@ -1712,6 +1743,275 @@ func (m *Pipeline) GetChildrenCount() int32 {
 	return 0
 }
 
+type ProcessLimitation struct {
+	Size                 int64    `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	BatchRows            int64    `protobuf:"varint,2,opt,name=batch_rows,json=batchRows,proto3" json:"batch_rows,omitempty"`
+	BatchSize            int64    `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	PartitionRows        int64    `protobuf:"varint,4,opt,name=partition_rows,json=partitionRows,proto3" json:"partition_rows,omitempty"`
+	ReaderSize           int64    `protobuf:"varint,5,opt,name=reader_size,json=readerSize,proto3" json:"reader_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProcessLimitation) Reset()         { *m = ProcessLimitation{} }
+func (m *ProcessLimitation) String() string { return proto.CompactTextString(m) }
+func (*ProcessLimitation) ProtoMessage()    {}
+func (*ProcessLimitation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ac67a7adf3df9c7, []int{18}
+}
+func (m *ProcessLimitation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProcessLimitation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProcessLimitation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProcessLimitation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcessLimitation.Merge(m, src)
+}
+func (m *ProcessLimitation) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ProcessLimitation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProcessLimitation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProcessLimitation proto.InternalMessageInfo
+
+func (m *ProcessLimitation) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *ProcessLimitation) GetBatchRows() int64 {
+	if m != nil {
+		return m.BatchRows
+	}
+	return 0
+}
+
+func (m *ProcessLimitation) GetBatchSize() int64 {
+	if m != nil {
+		return m.BatchSize
+	}
+	return 0
+}
+
+func (m *ProcessLimitation) GetPartitionRows() int64 {
+	if m != nil {
+		return m.PartitionRows
+	}
+	return 0
+}
+
+func (m *ProcessLimitation) GetReaderSize() int64 {
+	if m != nil {
+		return m.ReaderSize
+	}
+	return 0
+}
+
+type SessionInfo struct {
+	User                 string   `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Host                 string   `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Role                 string   `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	ConnectionId         uint64   `protobuf:"varint,4,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Database             string   `protobuf:"bytes,5,opt,name=database,proto3" json:"database,omitempty"`
+	Version              string   `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
+	TimeZone             string   `protobuf:"bytes,7,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	TimeZoneOffset       int32    `protobuf:"varint,8,opt,name=time_zone_offset,json=timeZoneOffset,proto3" json:"time_zone_offset,omitempty"`
+	BlockEncryptionMode  string   `protobuf:"bytes,9,opt,name=block_encryption_mode,json=blockEncryptionMode,proto3" json:"block_encryption_mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SessionInfo) Reset()         { *m = SessionInfo{} }
+func (m *SessionInfo) String() string { return proto.CompactTextString(m) }
+func (*SessionInfo) ProtoMessage()    {}
+func (*SessionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ac67a7adf3df9c7, []int{19}
+}
+func (m *SessionInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SessionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SessionInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SessionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionInfo.Merge(m, src)
+}
+func (m *SessionInfo) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *SessionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionInfo proto.InternalMessageInfo
+
+func (m *SessionInfo) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *SessionInfo) GetHost() string {
+	if m != nil {
+		return m.Host
+	}
+	return ""
+}
+
+func (m *SessionInfo) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *SessionInfo) GetConnectionId() uint64 {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return 0
+}
+
+func (m *SessionInfo) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *SessionInfo) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *SessionInfo) GetTimeZone() string {
+	if m != nil {
+		return m.TimeZone
+	}
+	return ""
+}
+
+func (m *SessionInfo) GetTimeZoneOffset() int32 {
+	if m != nil {
+		return m.TimeZoneOffset
+	}
+	return 0
+}
+
+func (m *SessionInfo) GetBlockEncryptionMode() string {
+	if m != nil {
+		return m.BlockEncryptionMode
+	}
+	return ""
+}
+
+type ProcessInfo struct {
+	Id                   string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Lim                  *ProcessLimitation `protobuf:"bytes,2,opt,name=lim,proto3" json:"lim,omitempty"`
+	UnixTime             int64              `protobuf:"varint,3,opt,name=unix_time,json=unixTime,proto3" json:"unix_time,omitempty"`
+	Snapshot             []byte             `protobuf:"bytes,4,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	SessionInfo          *SessionInfo       `protobuf:"bytes,5,opt,name=session_info,json=sessionInfo,proto3" json:"session_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ProcessInfo) Reset()         { *m = ProcessInfo{} }
+func (m *ProcessInfo) String() string { return proto.CompactTextString(m) }
+func (*ProcessInfo) ProtoMessage()    {}
+func (*ProcessInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ac67a7adf3df9c7, []int{20}
+}
+func (m *ProcessInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProcessInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProcessInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProcessInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcessInfo.Merge(m, src)
+}
+func (m *ProcessInfo) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ProcessInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProcessInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProcessInfo proto.InternalMessageInfo
+
+func (m *ProcessInfo) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ProcessInfo) GetLim() *ProcessLimitation {
+	if m != nil {
+		return m.Lim
+	}
+	return nil
+}
+
+func (m *ProcessInfo) GetUnixTime() int64 {
+	if m != nil {
+		return m.UnixTime
+	}
+	return 0
+}
+
+func (m *ProcessInfo) GetSnapshot() []byte {
+	if m != nil {
+		return m.Snapshot
+	}
+	return nil
+}
+
+func (m *ProcessInfo) GetSessionInfo() *SessionInfo {
+	if m != nil {
+		return m.SessionInfo
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("pipeline.Pipeline_PipelineType", Pipeline_PipelineType_name, Pipeline_PipelineType_value)
 	proto.RegisterType((*Message)(nil), "pipeline.Message")
@@ -1732,103 +2032,128 @@ func init() {
 	proto.RegisterType((*Source)(nil), "pipeline.Source")
 	proto.RegisterType((*NodeInfo)(nil), "pipeline.NodeInfo")
 	proto.RegisterType((*Pipeline)(nil), "pipeline.Pipeline")
+	proto.RegisterType((*ProcessLimitation)(nil), "pipeline.ProcessLimitation")
+	proto.RegisterType((*SessionInfo)(nil), "pipeline.SessionInfo")
+	proto.RegisterType((*ProcessInfo)(nil), "pipeline.ProcessInfo")
 }
 
 func init() { proto.RegisterFile("pipeline.proto", fileDescriptor_7ac67a7adf3df9c7) }
 
 var fileDescriptor_7ac67a7adf3df9c7 = []byte{
-	// 1444 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4f, 0x6f, 0xdb, 0x46,
-	0x16, 0x8f, 0xfe, 0x50, 0x22, 0x9f, 0x64, 0x59, 0x99, 0x4d, 0x76, 0x99, 0xec, 0xae, 0xe3, 0x30,
-	0x9b, 0xc4, 0x8b, 0xdd, 0xd8, 0x88, 0x17, 0x39, 0xef, 0x3a, 0x4e, 0xb0, 0x70, 0x11, 0x3b, 0xc6,
-	0xb8, 0xbd, 0x14, 0x05, 0x84, 0x11, 0x39, 0xa2, 0x27, 0x26, 0x67, 0x58, 0xfe, 0x49, 0xac, 0x7e,
-	0x80, 0x1e, 0xda, 0x7e, 0x82, 0xf6, 0xd2, 0x6f, 0xd2, 0x53, 0x81, 0x1e, 0x7b, 0xeb, 0xb5, 0x48,
-	0xaf, 0xfd, 0x10, 0xc5, 0xbc, 0x21, 0x29, 0xda, 0x8a, 0x53, 0xa3, 0xe8, 0xad, 0xb9, 0xcd, 0xfb,
-	0xbd, 0xdf, 0x88, 0xef, 0xff, 0xcc, 0x08, 0x46, 0x89, 0x48, 0x78, 0x24, 0x24, 0xdf, 0x4c, 0x52,
-	0x95, 0x2b, 0x62, 0x57, 0xf2, 0xcd, 0x07, 0xa1, 0xc8, 0x8f, 0x8b, 0xe9, 0xa6, 0xaf, 0xe2, 0xad,
-	0x50, 0x85, 0x6a, 0x0b, 0x09, 0xd3, 0x62, 0x86, 0x12, 0x0a, 0xb8, 0x32, 0x1b, 0x6f, 0x42, 0x12,
-	0x31, 0x69, 0xd6, 0x9e, 0x82, 0xfe, 0x3e, 0xcf, 0x32, 0x16, 0x72, 0x32, 0x86, 0x4e, 0x26, 0x02,
-	0xb7, 0xb5, 0xde, 0xda, 0xe8, 0x52, 0xbd, 0xd4, 0x88, 0x1f, 0x07, 0x6e, 0xdb, 0x20, 0x7e, 0x1c,
-	0x10, 0x02, 0x5d, 0x5f, 0x05, 0xdc, 0xed, 0xac, 0xb7, 0x36, 0x86, 0x14, 0xd7, 0x1a, 0x0b, 0x58,
-	0xce, 0xdc, 0xae, 0xc1, 0xf4, 0x9a, 0xb8, 0xd0, 0x67, 0x92, 0x45, 0xf3, 0x8c, 0xbb, 0x16, 0xc2,
-	0x95, 0xe8, 0x7d, 0x00, 0xce, 0xae, 0x92, 0x92, 0xfb, 0xb9, 0x4a, 0xc9, 0x2d, 0x18, 0x54, 0x4e,
-	0x4c, 0xca, 0x4f, 0x5b, 0x14, 0x2a, 0x68, 0x2f, 0x20, 0xf7, 0x61, 0xd5, 0xaf, 0xd8, 0x13, 0x21,
-	0x03, 0x7e, 0x8a, 0xd6, 0x58, 0x74, 0x54, 0xc3, 0x7b, 0x1a, 0xf5, 0x9e, 0x83, 0xfd, 0x44, 0x64,
-	0x09, 0xcb, 0xfd, 0x63, 0x6d, 0x36, 0x8b, 0x22, 0xfc, 0x35, 0x9b, 0xea, 0x25, 0x79, 0x08, 0x4e,
-	0xcd, 0x77, 0xdb, 0xeb, 0x9d, 0x8d, 0xc1, 0xf6, 0x9f, 0x36, 0xeb, 0x70, 0xd6, 0xf6, 0xd0, 0x05,
-	0xcb, 0x7b, 0x0e, 0xce, 0x4e, 0x18, 0xa6, 0x3c, 0x64, 0x39, 0x27, 0x23, 0x68, 0xab, 0xa4, 0x34,
-	0xaf, 0xad, 0x12, 0x74, 0x59, 0x64, 0x39, 0xda, 0x62, 0x53, 0x5c, 0x93, 0x35, 0xe8, 0xf2, 0xd3,
-	0x24, 0xc5, 0xd0, 0x0c, 0xb6, 0x61, 0x13, 0x83, 0xfc, 0xf4, 0x34, 0x49, 0x29, 0xe2, 0xde, 0xb7,
-	0x2d, 0xb0, 0xfe, 0x9f, 0xaa, 0x22, 0x21, 0x7f, 0x05, 0x47, 0x72, 0x1e, 0x4c, 0xf8, 0x4b, 0x56,
-	0x59, 0x69, 0x6b, 0xe0, 0xe9, 0x4b, 0x16, 0xe9, 0xc8, 0x89, 0x69, 0xe1, 0x9f, 0xf0, 0xbc, 0x8c,
-	0x7b, 0x25, 0x6a, 0x8d, 0x2c, 0x35, 0x1d, 0xa3, 0x29, 0x45, 0xb2, 0x0e, 0x96, 0xfe, 0x44, 0xe6,
-	0x76, 0xd1, 0xb5, 0xe6, 0xb7, 0x8d, 0x42, 0x33, 0xf2, 0x79, 0xc2, 0x33, 0xd7, 0x6a, 0x32, 0xde,
-	0x9f, 0x27, 0x9c, 0x1a, 0x05, 0xb9, 0x0f, 0x5d, 0x16, 0x86, 0x99, 0xdb, 0x3b, 0x1f, 0x9d, 0x3a,
-	0x0a, 0x14, 0x09, 0xde, 0xa7, 0x6d, 0xe8, 0xbe, 0xa7, 0x84, 0x6c, 0x5a, 0xda, 0xba, 0xd0, 0xd2,
-	0xf6, 0x59, 0x4b, 0x6f, 0x80, 0x9d, 0xf2, 0x68, 0x12, 0xe9, 0xe0, 0x75, 0xd6, 0x3b, 0x1b, 0x16,
-	0xed, 0xa7, 0x3c, 0x7a, 0xa6, 0xe3, 0x77, 0x03, 0x6c, 0x5f, 0x95, 0xaa, 0xae, 0x51, 0xf9, 0xca,
-	0xa8, 0xaa, 0xd0, 0x5a, 0x6f, 0x0e, 0xed, 0xc2, 0xbb, 0xde, 0xc5, 0xde, 0x39, 0x11, 0x9f, 0xe5,
-	0x13, 0x5f, 0xc9, 0xc0, 0xed, 0x2f, 0x45, 0xc9, 0xd6, 0xca, 0x5d, 0x25, 0x03, 0xf2, 0x4f, 0x80,
-	0x54, 0x84, 0xc7, 0x25, 0xd3, 0x5e, 0x62, 0x3a, 0xa8, 0xd5, 0x54, 0xef, 0xe7, 0x16, 0xd8, 0x3b,
-	0x32, 0x17, 0xbf, 0x39, 0x18, 0x7f, 0x86, 0x5e, 0xca, 0xb3, 0x22, 0xaa, 0x42, 0x51, 0x4a, 0xb5,
-	0xbb, 0xdd, 0x5f, 0x73, 0xd7, 0xba, 0x94, 0xbb, 0xbd, 0x4b, 0xbb, 0xdb, 0x7f, 0x9b, 0xbb, 0x9f,
-	0xb7, 0xc1, 0xd9, 0x93, 0x92, 0xa7, 0xef, 0x92, 0x2f, 0x03, 0xef, 0xb3, 0x36, 0xd8, 0xcf, 0xf8,
-	0x2c, 0x7f, 0x17, 0x8c, 0xb2, 0x13, 0x8e, 0x78, 0xfc, 0x47, 0xe9, 0x84, 0x2f, 0xda, 0x00, 0x47,
-	0x42, 0x86, 0x11, 0x7f, 0x97, 0x7d, 0x19, 0x78, 0x5f, 0x75, 0xc0, 0xde, 0x67, 0xe9, 0xc9, 0xef,
-	0x9e, 0xfd, 0x33, 0xc6, 0x76, 0x2f, 0x6d, 0xac, 0xf5, 0x16, 0x63, 0x2f, 0x11, 0xa2, 0x35, 0x7d,
-	0xc5, 0xc1, 0xe8, 0x2c, 0x05, 0x59, 0xe3, 0xe4, 0x0e, 0xf4, 0x95, 0x34, 0xe9, 0x59, 0x0e, 0x4b,
-	0x4f, 0x49, 0xcc, 0xd4, 0x2d, 0x18, 0xa8, 0x22, 0x4f, 0x8a, 0x7c, 0x22, 0x8b, 0x28, 0x72, 0x1d,
-	0x3c, 0xe4, 0xc1, 0x40, 0x07, 0x45, 0x14, 0x35, 0x08, 0x31, 0x4b, 0x4f, 0x5c, 0x68, 0x12, 0x74,
-	0x30, 0xc9, 0x1d, 0x58, 0x29, 0x09, 0x4c, 0xce, 0x5f, 0xb1, 0xb9, 0x3b, 0x40, 0xca, 0xd0, 0x80,
-	0x3b, 0x88, 0x91, 0xdb, 0x30, 0xd4, 0xdb, 0x27, 0x31, 0x67, 0x52, 0xc8, 0xd0, 0x1d, 0x22, 0x67,
-	0xa0, 0xb1, 0x7d, 0x03, 0x79, 0x0c, 0xfa, 0x87, 0xa9, 0x0a, 0x0a, 0xff, 0x6c, 0xd1, 0xb5, 0x2e,
-	0x2e, 0xba, 0xf6, 0xd9, 0xa2, 0xab, 0x23, 0xd6, 0xb9, 0x20, 0x62, 0xde, 0x0f, 0x16, 0x0c, 0xf6,
-	0x64, 0x96, 0xa7, 0x85, 0x9f, 0x0b, 0x25, 0x97, 0x6e, 0x4b, 0x63, 0xe8, 0x88, 0xa0, 0xba, 0xb8,
-	0xe9, 0x25, 0xb9, 0x07, 0x5d, 0x26, 0x73, 0x51, 0xde, 0x95, 0x48, 0xe3, 0xb2, 0x51, 0x9e, 0xa7,
-	0x14, 0xf5, 0xe4, 0x01, 0xf4, 0xcb, 0x1b, 0x59, 0x39, 0x02, 0xde, 0x78, 0x6b, 0xab, 0x38, 0x64,
-	0x13, 0xec, 0xa0, 0xbc, 0x04, 0x96, 0x3d, 0xd2, 0xf8, 0xe9, 0xea, 0x7a, 0x48, 0x6b, 0x0e, 0xb9,
-	0x0d, 0x1d, 0x16, 0x86, 0x6e, 0x0f, 0xa9, 0xab, 0x0b, 0x2a, 0x5e, 0xd3, 0xa8, 0xd6, 0x91, 0x6d,
-	0x00, 0xa1, 0x0f, 0xbd, 0xc9, 0x0b, 0x25, 0x64, 0x59, 0x13, 0x0d, 0x23, 0xea, 0x03, 0x91, 0x3a,
-	0xa2, 0x3e, 0x1b, 0xb7, 0xca, 0xba, 0xc5, 0x2d, 0xf6, 0x79, 0x3b, 0xaa, 0x53, 0xc3, 0xd4, 0x6f,
-	0xb5, 0x21, 0xe3, 0xb1, 0x30, 0x1b, 0x9c, 0xf3, 0x1b, 0xaa, 0xc9, 0x4a, 0xed, 0xac, 0x9a, 0xb1,
-	0x8f, 0x60, 0x90, 0xe1, 0x00, 0x32, 0x5b, 0x00, 0xb7, 0x5c, 0x6b, 0x6c, 0xa9, 0xa7, 0x13, 0x85,
-	0x6c, 0x31, 0xa9, 0xb6, 0xc0, 0xc1, 0x72, 0xc1, 0x4d, 0x83, 0xf3, 0xdf, 0xa9, 0x7a, 0x98, 0xda,
-	0x71, 0xd5, 0xcd, 0x1e, 0x74, 0x91, 0x3b, 0x44, 0xee, 0x68, 0xc1, 0x35, 0x39, 0xd2, 0x3a, 0xf2,
-	0x2f, 0xe8, 0x27, 0xa6, 0xc0, 0xdc, 0x15, 0xa4, 0x5d, 0x5d, 0xd0, 0xca, 0xca, 0xa3, 0x15, 0x83,
-	0xfc, 0x1b, 0x6c, 0x95, 0x06, 0x3c, 0x9d, 0x4c, 0xe7, 0xee, 0x08, 0xeb, 0xe9, 0xaa, 0xa9, 0xa7,
-	0xe7, 0x1a, 0x7d, 0x3c, 0x3f, 0x4a, 0xb8, 0x4f, 0xfb, 0xca, 0x08, 0xe4, 0x01, 0x0c, 0x93, 0x54,
-	0xbd, 0xe0, 0x7e, 0x6e, 0x2a, 0x73, 0x75, 0xa9, 0xdf, 0x06, 0xa5, 0x1e, 0x2b, 0xd5, 0x83, 0xde,
-	0x4c, 0x44, 0x39, 0x4f, 0xdd, 0xf1, 0x52, 0xef, 0x96, 0x1a, 0x72, 0x0d, 0xac, 0x48, 0xc4, 0x22,
-	0x77, 0xaf, 0xe2, 0x0c, 0x32, 0x82, 0x9e, 0x40, 0x6a, 0x36, 0xcb, 0x78, 0xee, 0x12, 0x84, 0x4b,
-	0xc9, 0x7b, 0x04, 0xc3, 0x1d, 0x7c, 0xb7, 0x88, 0x0c, 0xbf, 0x70, 0x17, 0xba, 0x75, 0xf7, 0xd4,
-	0xa6, 0x23, 0xe3, 0x13, 0xbe, 0x27, 0x67, 0x8a, 0xa2, 0xda, 0xfb, 0xa6, 0x05, 0xbd, 0x23, 0x55,
-	0xa4, 0x3e, 0xd7, 0x7d, 0x9e, 0xf9, 0xc7, 0x3c, 0x66, 0x13, 0xc9, 0x62, 0x8e, 0x4d, 0xe1, 0x50,
-	0x30, 0xd0, 0x01, 0x8b, 0x39, 0xf9, 0x3b, 0x40, 0xce, 0xa6, 0x11, 0x37, 0xfa, 0x36, 0xea, 0x1d,
-	0x44, 0x50, 0xdd, 0x6c, 0x4c, 0xdd, 0x80, 0xce, 0xa2, 0x31, 0xaf, 0x81, 0x35, 0x8d, 0x94, 0x7f,
-	0x82, 0xad, 0xe1, 0x50, 0x23, 0xe0, 0x93, 0xaa, 0xc8, 0x8e, 0x03, 0xf5, 0x4a, 0xea, 0x27, 0x95,
-	0x85, 0xfe, 0x40, 0x05, 0xed, 0xe9, 0xf9, 0xb5, 0x52, 0x13, 0x58, 0x10, 0xa4, 0x58, 0xfe, 0x0e,
-	0x1d, 0x56, 0xe0, 0x4e, 0x10, 0xa4, 0xde, 0x47, 0x60, 0x1f, 0xa8, 0x00, 0x7d, 0xd2, 0x8f, 0x9d,
-	0xd8, 0x4f, 0x8a, 0xb2, 0xa1, 0x71, 0xad, 0x5b, 0x5c, 0x04, 0xa5, 0xb5, 0x6d, 0x81, 0xef, 0x42,
-	0xfc, 0xad, 0x0e, 0x22, 0xb8, 0xd6, 0x03, 0x3f, 0x61, 0xf3, 0x48, 0x31, 0x33, 0xbc, 0x1d, 0x5a,
-	0x89, 0xde, 0x97, 0x5d, 0xb0, 0x0f, 0xcb, 0x1a, 0x21, 0x4f, 0x60, 0xa5, 0x7e, 0x03, 0xea, 0x79,
-	0x82, 0xdf, 0x19, 0x6d, 0xdf, 0x6a, 0x54, 0xd1, 0xf9, 0x05, 0x0e, 0x9f, 0x61, 0xd2, 0x90, 0xce,
-	0xbf, 0x24, 0xdb, 0x4b, 0x2f, 0xc9, 0xbf, 0x41, 0xe7, 0xe3, 0x74, 0x7e, 0xf6, 0x75, 0x76, 0x18,
-	0x31, 0x49, 0x35, 0x4c, 0x1e, 0xc2, 0x40, 0xbf, 0x5b, 0x27, 0x19, 0x66, 0xad, 0x1c, 0x36, 0xe3,
-	0x46, 0x43, 0x21, 0x4e, 0x41, 0x93, 0xca, 0xcc, 0x6e, 0x82, 0xed, 0x1f, 0x8b, 0x28, 0x48, 0xb9,
-	0x2c, 0x8f, 0x1c, 0xb2, 0x6c, 0x32, 0xad, 0x39, 0xe4, 0x7f, 0x30, 0x16, 0x8b, 0x21, 0x69, 0x32,
-	0x6a, 0x0e, 0xa1, 0xeb, 0xcd, 0x79, 0x52, 0x33, 0xe8, 0x6a, 0x83, 0x8e, 0x09, 0xbf, 0x0e, 0x3d,
-	0x91, 0x4d, 0x78, 0x79, 0x36, 0xd9, 0xd4, 0x12, 0xd9, 0x53, 0x19, 0x90, 0xbf, 0x40, 0x5f, 0x64,
-	0x8b, 0x61, 0x63, 0xd3, 0x9e, 0xc8, 0xb0, 0x7b, 0xef, 0x41, 0x57, 0xea, 0xc7, 0xfa, 0xd2, 0x44,
-	0xa9, 0x52, 0x4b, 0x51, 0x4f, 0xfe, 0x01, 0x23, 0x9d, 0xfc, 0x89, 0xa9, 0x19, 0x39, 0x53, 0x38,
-	0x50, 0x2c, 0x53, 0x12, 0x4f, 0x74, 0xd5, 0xe8, 0x32, 0xb8, 0x0b, 0xa3, 0xca, 0x97, 0x89, 0xaf,
-	0x0a, 0x99, 0xe3, 0x04, 0xb1, 0xe8, 0x4a, 0x85, 0xee, 0x6a, 0xd0, 0xfb, 0x2f, 0x0c, 0x9b, 0x69,
-	0x22, 0x0e, 0x58, 0xfb, 0x3c, 0x0d, 0xf9, 0xf8, 0x0a, 0x01, 0xe8, 0x1d, 0xa8, 0x34, 0x66, 0xd1,
-	0xb8, 0xa5, 0xd7, 0x94, 0xc7, 0x2a, 0xe7, 0xe3, 0x36, 0x19, 0x82, 0x7d, 0xc8, 0x52, 0x16, 0x45,
-	0x3c, 0x1a, 0x77, 0x1e, 0xef, 0x7e, 0xf7, 0x7a, 0xad, 0xf5, 0xfd, 0xeb, 0xb5, 0xd6, 0x8f, 0xaf,
-	0xd7, 0xae, 0x7c, 0xfd, 0xd3, 0x5a, 0xeb, 0xc3, 0x87, 0x8d, 0xbf, 0x37, 0x62, 0x96, 0xa7, 0xe2,
-	0x54, 0xa5, 0x22, 0x14, 0xb2, 0x12, 0x24, 0xdf, 0x4a, 0x4e, 0xc2, 0xad, 0x64, 0xba, 0x55, 0x79,
-	0x38, 0xed, 0xe1, 0xbf, 0x1b, 0xff, 0xf9, 0x25, 0x00, 0x00, 0xff, 0xff, 0x49, 0x80, 0xd5, 0x98,
-	0x34, 0x11, 0x00, 0x00,
+	// 1795 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4f, 0x93, 0xdc, 0x38,
+	0x15, 0xdf, 0xfe, 0xe3, 0x6e, 0xfb, 0x75, 0xcf, 0xa4, 0xa3, 0x4d, 0xc0, 0x9b, 0xc0, 0x24, 0xeb,
+	0x25, 0xbb, 0xa1, 0x20, 0x33, 0x95, 0xa1, 0xb6, 0x8a, 0x1b, 0xcc, 0x26, 0x29, 0x6a, 0x20, 0x93,
+	0x9d, 0xd2, 0x2c, 0x97, 0x2d, 0xaa, 0x5c, 0x6a, 0x5b, 0xdd, 0xad, 0x1d, 0x5b, 0x32, 0x92, 0x9d,
+	0x4c, 0xe7, 0x03, 0x70, 0x00, 0xae, 0x5c, 0xe0, 0x00, 0x37, 0x4e, 0x7c, 0x06, 0x4e, 0x54, 0x71,
+	0xe4, 0xc6, 0x95, 0x0a, 0x57, 0x3e, 0x04, 0xa5, 0x67, 0xd9, 0xdd, 0x3d, 0xbd, 0xb3, 0x49, 0x51,
+	0xdc, 0x36, 0x37, 0xbd, 0xdf, 0xfb, 0x49, 0x7e, 0x7a, 0xff, 0x24, 0x19, 0x76, 0x0b, 0x51, 0xf0,
+	0x4c, 0x48, 0xbe, 0x5f, 0x68, 0x55, 0x2a, 0xe2, 0x37, 0xf2, 0xad, 0x07, 0x73, 0x51, 0x2e, 0xaa,
+	0xe9, 0x7e, 0xa2, 0xf2, 0x83, 0xb9, 0x9a, 0xab, 0x03, 0x24, 0x4c, 0xab, 0x19, 0x4a, 0x28, 0xe0,
+	0xa8, 0x9e, 0x78, 0x0b, 0x8a, 0x8c, 0xc9, 0x7a, 0x1c, 0xfd, 0xae, 0x03, 0xc3, 0x13, 0x6e, 0x0c,
+	0x9b, 0x73, 0x32, 0x81, 0x9e, 0x11, 0x69, 0xd8, 0xb9, 0xdb, 0xb9, 0xdf, 0xa7, 0x76, 0x68, 0x91,
+	0x24, 0x4f, 0xc3, 0x6e, 0x8d, 0x24, 0x79, 0x4a, 0x08, 0xf4, 0x13, 0x95, 0xf2, 0xb0, 0x77, 0xb7,
+	0x73, 0x7f, 0x4c, 0x71, 0x6c, 0xb1, 0x94, 0x95, 0x2c, 0xec, 0xd7, 0x98, 0x1d, 0x93, 0x10, 0x86,
+	0x4c, 0xb2, 0x6c, 0x69, 0x78, 0xe8, 0x21, 0xdc, 0x88, 0xe4, 0x3b, 0xb0, 0x5b, 0x68, 0x95, 0xc4,
+	0x42, 0xce, 0x54, 0x8c, 0xf3, 0x06, 0x48, 0x18, 0x5b, 0xf4, 0x58, 0xce, 0xd4, 0x63, 0x56, 0xb2,
+	0xe8, 0xe7, 0x10, 0x3c, 0x52, 0x52, 0xf2, 0xa4, 0x54, 0x9a, 0xdc, 0x81, 0x51, 0xb3, 0xd7, 0xd8,
+	0x19, 0xe8, 0x51, 0x68, 0xa0, 0xe3, 0x94, 0x7c, 0x04, 0xd7, 0x92, 0x86, 0x1d, 0x0b, 0x99, 0xf2,
+	0x0b, 0xb4, 0xd9, 0xa3, 0xbb, 0x2d, 0x7c, 0x6c, 0xd1, 0xe8, 0xcf, 0x1d, 0xf0, 0x1f, 0x0b, 0x53,
+	0xb0, 0x32, 0x59, 0xd8, 0xdd, 0xb1, 0x2c, 0xc3, 0xe5, 0x7c, 0x6a, 0x87, 0xe4, 0x21, 0x04, 0xed,
+	0x84, 0xb0, 0x7b, 0xb7, 0x77, 0x7f, 0x74, 0xf8, 0xee, 0x7e, 0xeb, 0xf6, 0xd6, 0x20, 0xba, 0x62,
+	0x91, 0x07, 0x30, 0x36, 0x8b, 0x6a, 0x36, 0xcb, 0x78, 0x7c, 0xce, 0x97, 0x26, 0xec, 0xe1, 0x2c,
+	0xd8, 0x47, 0x1f, 0x3f, 0xb9, 0x28, 0x34, 0x1d, 0x39, 0xfd, 0xcf, 0xf8, 0xd2, 0x90, 0x8f, 0xa0,
+	0xcf, 0xe6, 0x73, 0x13, 0xf6, 0x2f, 0x2f, 0x7e, 0x34, 0x9f, 0x6b, 0x3e, 0x67, 0x25, 0xa7, 0x48,
+	0x88, 0x14, 0x04, 0x2d, 0x44, 0x76, 0xa1, 0xab, 0x0a, 0xb7, 0xef, 0xae, 0x2a, 0xd0, 0xe3, 0xc2,
+	0x94, 0xb8, 0x49, 0x9f, 0xe2, 0x98, 0xec, 0x41, 0x9f, 0x5f, 0x14, 0x1a, 0x23, 0xb3, 0x69, 0x00,
+	0xe2, 0x56, 0xcf, 0x74, 0xfb, 0xe5, 0x0d, 0xbd, 0xc5, 0xa3, 0xbf, 0x75, 0xc0, 0xfb, 0x89, 0x56,
+	0x55, 0x41, 0x6e, 0x43, 0x20, 0x39, 0x4f, 0x63, 0xfe, 0x9c, 0x35, 0xde, 0xf1, 0x2d, 0xf0, 0xe4,
+	0x39, 0xcb, 0x6c, 0x60, 0xc5, 0xb4, 0x4a, 0xce, 0x79, 0xe9, 0xd2, 0xa2, 0x11, 0xad, 0x46, 0x3a,
+	0x4d, 0xaf, 0xd6, 0x38, 0x91, 0xdc, 0x05, 0xcf, 0x9a, 0xf0, 0x65, 0xdf, 0xae, 0x15, 0x96, 0x51,
+	0x2e, 0x0b, 0x6e, 0x42, 0x6f, 0x9d, 0xf1, 0xd9, 0xb2, 0xe0, 0xb4, 0x56, 0xb4, 0x8e, 0x1b, 0xbc,
+	0xce, 0x71, 0xbf, 0xea, 0x42, 0xff, 0xa7, 0x4a, 0xc8, 0x75, 0x4b, 0x3b, 0x57, 0x5a, 0xda, 0xdd,
+	0xb4, 0xf4, 0x3d, 0xf0, 0x35, 0xcf, 0xe2, 0xcc, 0x3a, 0xd7, 0x46, 0xd2, 0xa3, 0x43, 0xcd, 0xb3,
+	0xa7, 0xd6, 0xbf, 0xef, 0x81, 0x9f, 0x28, 0xa7, 0xea, 0xd7, 0xaa, 0x44, 0xd5, 0xaa, 0xc6, 0xf5,
+	0xde, 0x15, 0xae, 0x6f, 0x77, 0x37, 0xb8, 0x7a, 0x77, 0x41, 0xc6, 0x67, 0x65, 0x9c, 0x28, 0x99,
+	0x86, 0xc3, 0x2d, 0x2f, 0xf9, 0x56, 0xf9, 0x48, 0xc9, 0x94, 0x7c, 0x17, 0x40, 0x8b, 0xf9, 0xc2,
+	0x31, 0xfd, 0x2d, 0x66, 0x80, 0x5a, 0x4b, 0x8d, 0xfe, 0xd3, 0x01, 0xff, 0x48, 0x96, 0xe2, 0x7f,
+	0x76, 0xc6, 0x37, 0x60, 0xa0, 0xb9, 0xa9, 0xb2, 0xc6, 0x15, 0x4e, 0x6a, 0xb7, 0xdb, 0x7f, 0xdd,
+	0x76, 0xbd, 0x37, 0xda, 0xee, 0xe0, 0x8d, 0xb7, 0x3b, 0xfc, 0xaa, 0xed, 0xfe, 0xa6, 0x0b, 0xc1,
+	0xb1, 0x94, 0x5c, 0xbf, 0x0d, 0xbe, 0x4c, 0xa3, 0x5f, 0x77, 0xc1, 0x7f, 0xca, 0x67, 0xe5, 0x5b,
+	0x67, 0xb8, 0x4a, 0x38, 0xe3, 0xf9, 0xd7, 0xa5, 0x12, 0x7e, 0xdb, 0x05, 0x38, 0x13, 0x72, 0x9e,
+	0xf1, 0xb7, 0xd1, 0x97, 0x69, 0xf4, 0x87, 0x1e, 0xf8, 0x27, 0x4c, 0x9f, 0xff, 0xdf, 0xa3, 0xbf,
+	0x61, 0x6c, 0xff, 0x8d, 0x8d, 0xf5, 0xbe, 0xc2, 0xd8, 0x37, 0x70, 0xd1, 0x9e, 0xbd, 0x81, 0xa1,
+	0x77, 0xb6, 0x9c, 0x6c, 0x71, 0xf2, 0x01, 0x0c, 0x95, 0xac, 0xc3, 0xb3, 0xed, 0x96, 0x81, 0x92,
+	0x18, 0xa9, 0x3b, 0x30, 0x52, 0x55, 0x59, 0x54, 0x65, 0x2c, 0xab, 0x2c, 0x0b, 0x03, 0x3c, 0xe4,
+	0xa1, 0x86, 0x9e, 0x55, 0x59, 0xb6, 0x46, 0xc8, 0x99, 0x3e, 0x0f, 0x61, 0x9d, 0x60, 0x9d, 0x49,
+	0x3e, 0x80, 0x1d, 0x47, 0x60, 0x72, 0xf9, 0x82, 0x2d, 0xc3, 0x11, 0x52, 0xc6, 0x35, 0x78, 0x84,
+	0x18, 0x79, 0x1f, 0xc6, 0x76, 0x7a, 0x9c, 0x73, 0x26, 0x85, 0x9c, 0x87, 0x63, 0xe4, 0x8c, 0x2c,
+	0x76, 0x52, 0x43, 0x11, 0x83, 0xe1, 0xa9, 0x56, 0x69, 0x95, 0x6c, 0x26, 0x5d, 0xe7, 0xea, 0xa4,
+	0xeb, 0x6e, 0x26, 0x5d, 0xeb, 0xb1, 0xde, 0x15, 0x1e, 0x8b, 0xfe, 0xe9, 0xc1, 0xe8, 0x58, 0x9a,
+	0x52, 0x57, 0x49, 0x29, 0x94, 0xdc, 0xba, 0x4d, 0x4d, 0xa0, 0x27, 0xd2, 0xe6, 0xc6, 0x68, 0x87,
+	0xe4, 0x43, 0xe8, 0x33, 0x59, 0x0a, 0x77, 0x97, 0x22, 0x6b, 0x97, 0x0d, 0x77, 0x9e, 0x52, 0xd4,
+	0x93, 0x07, 0x30, 0x74, 0x37, 0x41, 0xd7, 0x02, 0xbe, 0xf4, 0xb6, 0xd8, 0x70, 0xc8, 0x3e, 0xf8,
+	0xa9, 0xbb, 0x7c, 0xba, 0x1a, 0x59, 0x5b, 0xba, 0xb9, 0x96, 0xd2, 0x96, 0x43, 0xde, 0x87, 0x1e,
+	0x9b, 0xcf, 0xf1, 0x7e, 0x3c, 0x3a, 0xbc, 0xb6, 0xa2, 0xe2, 0x35, 0x8d, 0x5a, 0x1d, 0x39, 0x04,
+	0x10, 0xf6, 0xd0, 0x8b, 0xbf, 0x50, 0x42, 0xba, 0x9c, 0x58, 0x33, 0xa2, 0x3d, 0x10, 0x69, 0x20,
+	0xda, 0xb3, 0xf1, 0xc0, 0xe5, 0x2d, 0x4e, 0xf1, 0x2f, 0xdb, 0xd1, 0x9c, 0x1a, 0x75, 0xfe, 0x36,
+	0x13, 0x0c, 0xcf, 0x45, 0x3d, 0x21, 0xb8, 0x3c, 0xa1, 0xe9, 0xac, 0xd4, 0x37, 0x4d, 0x8f, 0xfd,
+	0x18, 0x46, 0x06, 0x1b, 0x50, 0x3d, 0x05, 0x70, 0xca, 0x8d, 0xb5, 0x29, 0x6d, 0x77, 0xa2, 0x60,
+	0x56, 0x9d, 0xea, 0x00, 0x02, 0x4c, 0x17, 0x9c, 0x34, 0xba, 0xfc, 0x9d, 0xa6, 0x86, 0xa9, 0x9f,
+	0x37, 0xd5, 0x1c, 0x41, 0x1f, 0xb9, 0x63, 0xe4, 0xee, 0xae, 0xb8, 0x75, 0x8c, 0xac, 0x8e, 0x7c,
+	0x0f, 0x86, 0x45, 0x9d, 0x60, 0xe1, 0x0e, 0xd2, 0xae, 0xaf, 0x68, 0x2e, 0xf3, 0x68, 0xc3, 0x20,
+	0xdf, 0x07, 0x5f, 0xe9, 0x94, 0xeb, 0x78, 0xba, 0x0c, 0x77, 0x31, 0x9f, 0xae, 0xd7, 0xf9, 0xf4,
+	0xa9, 0x45, 0x3f, 0x59, 0x9e, 0x15, 0x3c, 0xa1, 0x43, 0x55, 0x0b, 0xf6, 0xee, 0x5f, 0x68, 0xf5,
+	0x05, 0x4f, 0xca, 0x3a, 0x33, 0xaf, 0x6d, 0xdf, 0xfd, 0x9d, 0x1e, 0x33, 0x35, 0x82, 0xc1, 0x4c,
+	0x64, 0x25, 0xd7, 0xe1, 0x64, 0xab, 0x76, 0x9d, 0x86, 0xdc, 0x00, 0x2f, 0x13, 0xb9, 0x28, 0xc3,
+	0xeb, 0xd8, 0x83, 0x6a, 0xc1, 0x76, 0x20, 0x35, 0x9b, 0x19, 0x5e, 0x86, 0x04, 0x61, 0x27, 0x45,
+	0x1f, 0xc3, 0xf8, 0x08, 0x9f, 0x55, 0xc2, 0xe0, 0x17, 0xee, 0x41, 0xbf, 0xad, 0x9e, 0xd6, 0x74,
+	0x64, 0xbc, 0xe4, 0xf6, 0x69, 0x45, 0x51, 0x1d, 0xfd, 0xb5, 0x03, 0x83, 0x33, 0x55, 0xe9, 0x84,
+	0xdb, 0x3a, 0x37, 0xc9, 0x82, 0xe7, 0x2c, 0x96, 0x2c, 0xe7, 0x58, 0x14, 0x01, 0x85, 0x1a, 0x7a,
+	0xc6, 0x72, 0x4e, 0xbe, 0x0d, 0x50, 0xb2, 0x69, 0xc6, 0x6b, 0x7d, 0x17, 0xf5, 0x01, 0x22, 0xa8,
+	0x5e, 0x2f, 0x4c, 0x5b, 0x80, 0xc1, 0xaa, 0x30, 0x6f, 0x80, 0x37, 0xcd, 0x54, 0x72, 0x8e, 0xa5,
+	0x11, 0xd0, 0x5a, 0xc0, 0xb7, 0x5c, 0x65, 0x16, 0xa9, 0x7a, 0x21, 0xed, 0x5b, 0xce, 0xc3, 0xfd,
+	0x40, 0x03, 0x1d, 0xdb, 0xfe, 0xb5, 0xd3, 0x12, 0x58, 0x9a, 0x6a, 0x4c, 0xff, 0x80, 0x8e, 0x1b,
+	0xf0, 0x28, 0x4d, 0x75, 0xf4, 0x0b, 0xf0, 0x9f, 0xa9, 0x14, 0xf7, 0x64, 0x1f, 0x43, 0x79, 0x52,
+	0x54, 0xae, 0xa0, 0x71, 0x6c, 0x4b, 0x5c, 0xa4, 0xce, 0xda, 0xae, 0xc0, 0x67, 0x2b, 0xae, 0xd5,
+	0x43, 0x04, 0xc7, 0xb6, 0xe1, 0x17, 0x6c, 0x99, 0x29, 0x56, 0x37, 0xef, 0x80, 0x36, 0x62, 0xf4,
+	0xfb, 0x3e, 0xf8, 0xa7, 0x2e, 0x47, 0xc8, 0x63, 0xd8, 0x69, 0x1f, 0x9f, 0xb6, 0x9f, 0xe0, 0x77,
+	0x76, 0x0f, 0xef, 0xac, 0x65, 0xd1, 0xe5, 0x01, 0x36, 0x9f, 0x71, 0xb1, 0x26, 0x5d, 0x7e, 0xc2,
+	0x76, 0xb7, 0x9e, 0xb0, 0xdf, 0x82, 0xde, 0x2f, 0xf5, 0x72, 0xf3, 0xf5, 0x76, 0x9a, 0x31, 0x49,
+	0x2d, 0x4c, 0x1e, 0xc2, 0xc8, 0x3e, 0x95, 0x63, 0x83, 0x51, 0x73, 0xcd, 0x66, 0xb2, 0x56, 0x50,
+	0x88, 0x53, 0xb0, 0x24, 0x17, 0xd9, 0x7d, 0xf0, 0x93, 0x85, 0xc8, 0x52, 0xcd, 0xa5, 0x3b, 0x72,
+	0xc8, 0xb6, 0xc9, 0xb4, 0xe5, 0x90, 0x1f, 0xc3, 0x44, 0xac, 0x9a, 0x64, 0x1d, 0xd1, 0xfa, 0x10,
+	0xba, 0xb9, 0xde, 0x4f, 0x5a, 0x06, 0xbd, 0xb6, 0x46, 0xc7, 0x80, 0xdf, 0x84, 0x81, 0x30, 0x31,
+	0x77, 0x67, 0x93, 0x4f, 0x3d, 0x61, 0x9e, 0xc8, 0x94, 0x7c, 0x13, 0x86, 0xc2, 0xac, 0x9a, 0x8d,
+	0x4f, 0x07, 0xc2, 0x60, 0xf5, 0x7e, 0x08, 0x7d, 0xa9, 0x52, 0xbe, 0xdd, 0x51, 0x9a, 0xd0, 0x52,
+	0xd4, 0xe3, 0x1f, 0x83, 0xca, 0x2c, 0xe2, 0x3a, 0x67, 0xe4, 0x4c, 0x61, 0x43, 0xf1, 0xea, 0x94,
+	0x78, 0x6c, 0xb3, 0xc6, 0xa6, 0xc1, 0x3d, 0xd8, 0x6d, 0xf6, 0x12, 0x27, 0xaa, 0x92, 0x25, 0x76,
+	0x10, 0x8f, 0xee, 0x34, 0xe8, 0x23, 0x0b, 0x46, 0x3f, 0x82, 0xf1, 0x7a, 0x98, 0x48, 0x00, 0xde,
+	0x09, 0xd7, 0x73, 0x3e, 0x79, 0x87, 0x00, 0x0c, 0x9e, 0x29, 0x9d, 0xb3, 0x6c, 0xd2, 0xb1, 0x63,
+	0xca, 0x73, 0x55, 0xf2, 0x49, 0x97, 0x8c, 0xc1, 0x3f, 0x65, 0x9a, 0x65, 0x19, 0xcf, 0x26, 0xbd,
+	0xe8, 0x2f, 0x1d, 0xb8, 0x7e, 0xaa, 0x55, 0xc2, 0x8d, 0x79, 0x6a, 0x8b, 0x93, 0xe1, 0x99, 0x42,
+	0xa0, 0x6f, 0xc4, 0xcb, 0x3a, 0x39, 0x7a, 0x14, 0xc7, 0xb6, 0x74, 0xa6, 0xb6, 0x8f, 0xc7, 0x5a,
+	0xbd, 0x30, 0x18, 0xf2, 0x1e, 0x0d, 0x10, 0xa1, 0xea, 0x85, 0x59, 0xa9, 0x71, 0x62, 0x6f, 0x4d,
+	0x7d, 0x66, 0x67, 0xdf, 0x83, 0xdd, 0x82, 0xe9, 0x52, 0x60, 0x34, 0x70, 0x85, 0x3e, 0x52, 0x76,
+	0x5a, 0x14, 0x57, 0xb9, 0x03, 0x23, 0xcd, 0x99, 0x6d, 0x59, 0xb8, 0x8c, 0x87, 0x1c, 0xa8, 0x21,
+	0xbb, 0x4e, 0xf4, 0xc7, 0x2e, 0x8c, 0xce, 0xb8, 0x31, 0x42, 0xc9, 0xa6, 0x5c, 0x2a, 0xc3, 0xb5,
+	0x2b, 0x75, 0x1c, 0x5b, 0x6c, 0xa1, 0xdc, 0xff, 0x84, 0x80, 0xe2, 0xd8, 0x62, 0x5a, 0x65, 0xbc,
+	0x29, 0x19, 0x3b, 0xb6, 0xb5, 0xe9, 0xce, 0x32, 0x6b, 0x94, 0x48, 0xd1, 0xa4, 0x3e, 0x1d, 0xaf,
+	0xc0, 0xe3, 0x94, 0xdc, 0x02, 0xdf, 0xa6, 0xe1, 0x94, 0xb9, 0x7f, 0x3f, 0x01, 0x6d, 0x65, 0x5b,
+	0x73, 0xcf, 0xb9, 0xb6, 0xb6, 0xb8, 0xb2, 0x6e, 0x44, 0x72, 0x1b, 0x82, 0x52, 0xe4, 0x3c, 0x7e,
+	0xa9, 0x24, 0xc7, 0xfc, 0x09, 0xa8, 0x6f, 0x81, 0xcf, 0x95, 0xe4, 0xe4, 0x3e, 0x4c, 0x5a, 0x65,
+	0xec, 0x3a, 0xa1, 0x5f, 0xff, 0xe0, 0x69, 0x38, 0x9f, 0x22, 0x4a, 0x0e, 0xe1, 0x26, 0xf6, 0x99,
+	0x98, 0xcb, 0x44, 0x2f, 0x0b, 0xb4, 0x33, 0x6f, 0x92, 0x2c, 0xa0, 0xef, 0xa2, 0xf2, 0x49, 0xab,
+	0x3b, 0x51, 0x29, 0xb7, 0xed, 0x70, 0xe4, 0x22, 0x8a, 0x1e, 0xaa, 0x9b, 0x47, 0xa7, 0x6d, 0x1e,
+	0x0f, 0xa0, 0x97, 0x89, 0x1c, 0x9d, 0x33, 0x3a, 0xbc, 0xbd, 0x71, 0x7a, 0x6c, 0x66, 0x01, 0xb5,
+	0x3c, 0xbb, 0x93, 0x4a, 0x8a, 0x8b, 0xd8, 0x5a, 0xe6, 0xc2, 0xea, 0x5b, 0xe0, 0x33, 0x91, 0x73,
+	0xeb, 0x1c, 0x23, 0x59, 0x61, 0x16, 0xaa, 0x74, 0xff, 0xcb, 0x5a, 0x99, 0xfc, 0x10, 0xc6, 0xa6,
+	0x0e, 0x54, 0x9d, 0xe5, 0xf5, 0x15, 0xe1, 0xe6, 0xfa, 0x49, 0xdb, 0x86, 0x91, 0x8e, 0xcc, 0x4a,
+	0xf8, 0xe4, 0xd1, 0xdf, 0x5f, 0xed, 0x75, 0xfe, 0xf1, 0x6a, 0xaf, 0xf3, 0xaf, 0x57, 0x7b, 0xef,
+	0xfc, 0xe9, 0xdf, 0x7b, 0x9d, 0xcf, 0x1f, 0xae, 0xfd, 0x12, 0xcc, 0x59, 0xa9, 0xc5, 0x85, 0xd2,
+	0x62, 0x2e, 0x64, 0x23, 0x48, 0x7e, 0x50, 0x9c, 0xcf, 0x0f, 0x8a, 0xe9, 0x41, 0xb3, 0xfa, 0x74,
+	0x80, 0x7f, 0x04, 0x7f, 0xf0, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2a, 0xcd, 0x31, 0x24, 0x68,
+	0x14, 0x00, 0x00,
 }
 
 func (m *Message) Marshal() (dAtA []byte, err error) {
@@ -1855,6 +2180,13 @@ func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ProcInfoData) > 0 {
+		i -= len(m.ProcInfoData)
+		copy(dAtA[i:], m.ProcInfoData)
+		i = encodeVarintPipeline(dAtA, i, uint64(len(m.ProcInfoData)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Analyse) > 0 {
 		i -= len(m.Analyse)
 		copy(dAtA[i:], m.Analyse)
@@ -1950,6 +2282,34 @@ func (m *Dispatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Aggs) > 0 {
+		for iNdEx := len(m.Aggs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Aggs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPipeline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ShuffleKeys) > 0 {
+		for iNdEx := len(m.ShuffleKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShuffleKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPipeline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Connector) > 0 {
 		for iNdEx := len(m.Connector) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2001,6 +2361,20 @@ func (m *Aggregate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Args[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPipeline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.Expr != nil {
 		{
 			size, err := m.Expr.MarshalToSizedBuffer(dAtA[:i])
@@ -3620,23 +3994,231 @@ func (m *Pipeline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintPipeline(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPipeline(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ProcessLimitation) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Message) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+
+func (m *ProcessLimitation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProcessLimitation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ReaderSize != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.ReaderSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PartitionRows != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.PartitionRows))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BatchSize != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.BatchSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BatchRows != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.BatchRows))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Size != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.Size))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SessionInfo) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SessionInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.BlockEncryptionMode) > 0 {
+		i -= len(m.BlockEncryptionMode)
+		copy(dAtA[i:], m.BlockEncryptionMode)
+		i = encodeVarintPipeline(dAtA, i, uint64(len(m.BlockEncryptionMode)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.TimeZoneOffset != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.TimeZoneOffset))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.TimeZone) > 0 {
+		i -= len(m.TimeZone)
+		copy(dAtA[i:], m.TimeZone)
+		i = encodeVarintPipeline(dAtA, i, uint64(len(m.TimeZone)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintPipeline(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Database) > 0 {
+		i -= len(m.Database)
+		copy(dAtA[i:], m.Database)
+		i = encodeVarintPipeline(dAtA, i, uint64(len(m.Database)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ConnectionId != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.ConnectionId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintPipeline(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Host) > 0 {
+		i -= len(m.Host)
+		copy(dAtA[i:], m.Host)
+		i = encodeVarintPipeline(dAtA, i, uint64(len(m.Host)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintPipeline(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProcessInfo) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProcessInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProcessInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SessionInfo != nil {
+		{
+			size, err := m.SessionInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPipeline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Snapshot) > 0 {
+		i -= len(m.Snapshot)
+		copy(dAtA[i:], m.Snapshot)
+		i = encodeVarintPipeline(dAtA, i, uint64(len(m.Snapshot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UnixTime != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.UnixTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Lim != nil {
+		{
+			size, err := m.Lim.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPipeline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintPipeline(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPipeline(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPipeline(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Message) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if m.Sid != 0 {
 		n += 1 + sovPipeline(uint64(m.Sid))
 	}
@@ -3655,6 +4237,10 @@ func (m *Message) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPipeline(uint64(l))
 	}
+	l = len(m.ProcInfoData)
+	if l > 0 {
+		n += 1 + l + sovPipeline(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3694,6 +4280,18 @@ func (m *Dispatch) ProtoSize() (n int) {
 			n += 1 + l + sovPipeline(uint64(l))
 		}
 	}
+	if len(m.ShuffleKeys) > 0 {
+		for _, e := range m.ShuffleKeys {
+			l = e.ProtoSize()
+			n += 1 + l + sovPipeline(uint64(l))
+		}
+	}
+	if len(m.Aggs) > 0 {
+		for _, e := range m.Aggs {
+			l = e.ProtoSize()
+			n += 1 + l + sovPipeline(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3716,6 +4314,12 @@ func (m *Aggregate) ProtoSize() (n int) {
 		l = m.Expr.ProtoSize()
 		n += 1 + l + sovPipeline(uint64(l))
 	}
+	if len(m.Args) > 0 {
+		for _, e := range m.Args {
+			l = e.ProtoSize()
+			n += 1 + l + sovPipeline(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4390,6 +4994,110 @@ func (m *Pipeline) ProtoSize() (n int) {
 	return n
 }
 
+func (m *ProcessLimitation) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Size != 0 {
+		n += 1 + sovPipeline(uint64(m.Size))
+	}
+	if m.BatchRows != 0 {
+		n += 1 + sovPipeline(uint64(m.BatchRows))
+	}
+	if m.BatchSize != 0 {
+		n += 1 + sovPipeline(uint64(m.BatchSize))
+	}
+	if m.PartitionRows != 0 {
+		n += 1 + sovPipeline(uint64(m.PartitionRows))
+	}
+	if m.ReaderSize != 0 {
+		n += 1 + sovPipeline(uint64(m.ReaderSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SessionInfo) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovPipeline(uint64(l))
+	}
+	l = len(m.Host)
+	if l > 0 {
+		n += 1 + l + sovPipeline(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovPipeline(uint64(l))
+	}
+	if m.ConnectionId != 0 {
+		n += 1 + sovPipeline(uint64(m.ConnectionId))
+	}
+	l = len(m.Database)
+	if l > 0 {
+		n += 1 + l + sovPipeline(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovPipeline(uint64(l))
+	}
+	l = len(m.TimeZone)
+	if l > 0 {
+		n += 1 + l + sovPipeline(uint64(l))
+	}
+	if m.TimeZoneOffset != 0 {
+		n += 1 + sovPipeline(uint64(m.TimeZoneOffset))
+	}
+	l = len(m.BlockEncryptionMode)
+	if l > 0 {
+		n += 1 + l + sovPipeline(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProcessInfo) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovPipeline(uint64(l))
+	}
+	if m.Lim != nil {
+		l = m.Lim.ProtoSize()
+		n += 1 + l + sovPipeline(uint64(l))
+	}
+	if m.UnixTime != 0 {
+		n += 1 + sovPipeline(uint64(m.UnixTime))
+	}
+	l = len(m.Snapshot)
+	if l > 0 {
+		n += 1 + l + sovPipeline(uint64(l))
+	}
+	if m.SessionInfo != nil {
+		l = m.SessionInfo.ProtoSize()
+		n += 1 + l + sovPipeline(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovPipeline(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4565,17 +5273,51 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 				m.Analyse = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPipeline(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPipeline
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcInfoData", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcInfoData = append(m.ProcInfoData[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProcInfoData == nil {
+				m.ProcInfoData = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPipeline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
 			iNdEx += skippy
@@ -4759,6 +5501,74 @@ func (m *Dispatch) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShuffleKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShuffleKeys = append(m.ShuffleKeys, &plan.Expr{})
+			if err := m.ShuffleKeys[len(m.ShuffleKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aggs = append(m.Aggs, &Aggregate{})
+			if err := m.Aggs[len(m.Aggs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -4885,6 +5695,40 @@ func (m *Aggregate) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, &plan.Expr{})
+			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -9345,6 +10189,673 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ProcessLimitation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPipeline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProcessLimitation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProcessLimitation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size", wireType)
+			}
+			m.Size = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchRows", wireType)
+			}
+			m.BatchRows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchRows |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			m.BatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionRows", wireType)
+			}
+			m.PartitionRows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartitionRows |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReaderSize", wireType)
+			}
+			m.ReaderSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReaderSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPipeline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPipeline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Host = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			m.ConnectionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConnectionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZoneOffset", wireType)
+			}
+			m.TimeZoneOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeZoneOffset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockEncryptionMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockEncryptionMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPipeline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProcessInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPipeline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProcessInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProcessInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Lim == nil {
+				m.Lim = &ProcessLimitation{}
+			}
+			if err := m.Lim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnixTime", wireType)
+			}
+			m.UnixTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnixTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Snapshot = append(m.Snapshot[:0], dAtA[iNdEx:postIndex]...)
+			if m.Snapshot == nil {
+				m.Snapshot = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SessionInfo == nil {
+				m.SessionInfo = &SessionInfo{}
+			}
+			if err := m.SessionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPipeline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPipeline(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
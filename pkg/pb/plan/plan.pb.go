@@ -6,12 +6,11 @@ package plan
 import (
 	encoding_binary "encoding/binary"
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
 	math_bits "math/bits"
-
-	_ "github.com/gogo/protobuf/gogoproto"
-	proto "github.com/gogo/protobuf/proto"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -242,7 +241,7 @@ func (x IndexDef_IndexType) String() string {
 }
 
 func (IndexDef_IndexType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{17, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{18, 0}
 }
 
 type OrderBySpec_OrderByFlag int32
@@ -279,7 +278,7 @@ func (x OrderBySpec_OrderByFlag) String() string {
 }
 
 func (OrderBySpec_OrderByFlag) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{28, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{29, 0}
 }
 
 type Node_NodeType int32
@@ -406,7 +405,7 @@ func (x Node_NodeType) String() string {
 }
 
 func (Node_NodeType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{32, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{33, 0}
 }
 
 type Node_JoinFlag int32
@@ -452,7 +451,7 @@ func (x Node_JoinFlag) String() string {
 }
 
 func (Node_JoinFlag) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{32, 1}
+	return fileDescriptor_2d655ab2f7683c23, []int{33, 1}
 }
 
 type Node_AggMode int32
@@ -480,7 +479,7 @@ func (x Node_AggMode) String() string {
 }
 
 func (Node_AggMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{32, 2}
+	return fileDescriptor_2d655ab2f7683c23, []int{33, 2}
 }
 
 type Query_StatementType int32
@@ -517,7 +516,7 @@ func (x Query_StatementType) String() string {
 }
 
 func (Query_StatementType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{34, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{35, 0}
 }
 
 type TransationControl_TclType int32
@@ -545,7 +544,7 @@ func (x TransationControl_TclType) String() string {
 }
 
 func (TransationControl_TclType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{35, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{36, 0}
 }
 
 type TransationBegin_TransationMode int32
@@ -573,7 +572,7 @@ func (x TransationBegin_TransationMode) String() string {
 }
 
 func (TransationBegin_TransationMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{36, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{37, 0}
 }
 
 type DataControl_DclType int32
@@ -613,7 +612,7 @@ func (x DataControl_DclType) String() string {
 }
 
 func (DataControl_DclType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{42, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{43, 0}
 }
 
 type DataDefinition_DdlType int32
@@ -695,16 +694,20 @@ func (x DataDefinition_DdlType) String() string {
 }
 
 func (DataDefinition_DdlType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{43, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{44, 0}
 }
 
 type Type struct {
-	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Nullable             bool     `protobuf:"varint,2,opt,name=nullable,proto3" json:"nullable,omitempty"`
-	Width                int32    `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
-	Precision            int32    `protobuf:"varint,4,opt,name=precision,proto3" json:"precision,omitempty"`
-	Size                 int32    `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
-	Scale                int32    `protobuf:"varint,6,opt,name=scale,proto3" json:"scale,omitempty"`
+	Id        int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nullable  bool  `protobuf:"varint,2,opt,name=nullable,proto3" json:"nullable,omitempty"`
+	Width     int32 `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	Precision int32 `protobuf:"varint,4,opt,name=precision,proto3" json:"precision,omitempty"`
+	Size      int32 `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	Scale     int32 `protobuf:"varint,6,opt,name=scale,proto3" json:"scale,omitempty"`
+	// enumvalues is the value list of the enum and set type
+	Enumvalues []string `protobuf:"bytes,7,rep,name=enumvalues,proto3" json:"enumvalues,omitempty"`
+	// collation of the string types, 0 is the binary collation
+	Collation            int32    `protobuf:"varint,8,opt,name=collation,proto3" json:"collation,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -785,6 +788,20 @@ func (m *Type) GetScale() int32 {
 	return 0
 }
 
+func (m *Type) GetEnumvalues() []string {
+	if m != nil {
+		return m.Enumvalues
+	}
+	return nil
+}
+
+func (m *Type) GetCollation() int32 {
+	if m != nil {
+		return m.Collation
+	}
+	return 0
+}
+
 // Const: if a const value can be reprensented by int64 or
 // double, use that, otherwise store a string representation.
 type Const struct {
@@ -1951,17 +1968,18 @@ func (m *Decimal128) GetB() int64 {
 }
 
 type ColDef struct {
-	Name                 string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Alg                  CompressType `protobuf:"varint,2,opt,name=alg,proto3,enum=plan.CompressType" json:"alg,omitempty"`
-	Typ                  *Type        `protobuf:"bytes,3,opt,name=typ,proto3" json:"typ,omitempty"`
-	Default              *Default     `protobuf:"bytes,4,opt,name=default,proto3" json:"default,omitempty"`
-	Primary              bool         `protobuf:"varint,5,opt,name=primary,proto3" json:"primary,omitempty"`
-	AutoIncrement        bool         `protobuf:"varint,6,opt,name=auto_increment,json=autoIncrement,proto3" json:"auto_increment,omitempty"`
-	Pkidx                int32        `protobuf:"varint,7,opt,name=pkidx,proto3" json:"pkidx,omitempty"`
-	Comment              string       `protobuf:"bytes,8,opt,name=comment,proto3" json:"comment,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Name                 string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Alg                  CompressType  `protobuf:"varint,2,opt,name=alg,proto3,enum=plan.CompressType" json:"alg,omitempty"`
+	Typ                  *Type         `protobuf:"bytes,3,opt,name=typ,proto3" json:"typ,omitempty"`
+	Default              *Default      `protobuf:"bytes,4,opt,name=default,proto3" json:"default,omitempty"`
+	Primary              bool          `protobuf:"varint,5,opt,name=primary,proto3" json:"primary,omitempty"`
+	AutoIncrement        bool          `protobuf:"varint,6,opt,name=auto_increment,json=autoIncrement,proto3" json:"auto_increment,omitempty"`
+	Pkidx                int32         `protobuf:"varint,7,opt,name=pkidx,proto3" json:"pkidx,omitempty"`
+	Comment              string        `protobuf:"bytes,8,opt,name=comment,proto3" json:"comment,omitempty"`
+	Generated            *GeneratedCol `protobuf:"bytes,9,opt,name=generated,proto3" json:"generated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *ColDef) Reset()         { *m = ColDef{} }
@@ -2053,6 +2071,13 @@ func (m *ColDef) GetComment() string {
 	return ""
 }
 
+func (m *ColDef) GetGenerated() *GeneratedCol {
+	if m != nil {
+		return m.Generated
+	}
+	return nil
+}
+
 type Default struct {
 	NullAbility          bool     `protobuf:"varint,1,opt,name=null_ability,json=nullAbility,proto3" json:"null_ability,omitempty"`
 	Expr                 *Expr    `protobuf:"bytes,2,opt,name=expr,proto3" json:"expr,omitempty"`
@@ -2116,6 +2141,71 @@ func (m *Default) GetOriginString() string {
 	return ""
 }
 
+// GeneratedCol describes a generated column. Expr references the other
+// columns of the table by their position in the table definition.
+type GeneratedCol struct {
+	Expr                 *Expr    `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
+	OriginString         string   `protobuf:"bytes,2,opt,name=origin_string,json=originString,proto3" json:"origin_string,omitempty"`
+	Stored               bool     `protobuf:"varint,3,opt,name=stored,proto3" json:"stored,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GeneratedCol) Reset()         { *m = GeneratedCol{} }
+func (m *GeneratedCol) String() string { return proto.CompactTextString(m) }
+func (*GeneratedCol) ProtoMessage()    {}
+func (*GeneratedCol) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{17}
+}
+func (m *GeneratedCol) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GeneratedCol) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GeneratedCol.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GeneratedCol) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeneratedCol.Merge(m, src)
+}
+func (m *GeneratedCol) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *GeneratedCol) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeneratedCol.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GeneratedCol proto.InternalMessageInfo
+
+func (m *GeneratedCol) GetExpr() *Expr {
+	if m != nil {
+		return m.Expr
+	}
+	return nil
+}
+
+func (m *GeneratedCol) GetOriginString() string {
+	if m != nil {
+		return m.OriginString
+	}
+	return ""
+}
+
+func (m *GeneratedCol) GetStored() bool {
+	if m != nil {
+		return m.Stored
+	}
+	return false
+}
+
 type IndexDef struct {
 	Typ                  IndexDef_IndexType `protobuf:"varint,1,opt,name=typ,proto3,enum=plan.IndexDef_IndexType" json:"typ,omitempty"`
 	Name                 string             `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -2129,7 +2219,7 @@ func (m *IndexDef) Reset()         { *m = IndexDef{} }
 func (m *IndexDef) String() string { return proto.CompactTextString(m) }
 func (*IndexDef) ProtoMessage()    {}
 func (*IndexDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{17}
+	return fileDescriptor_2d655ab2f7683c23, []int{18}
 }
 func (m *IndexDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2190,7 +2280,7 @@ func (m *PrimaryKeyDef) Reset()         { *m = PrimaryKeyDef{} }
 func (m *PrimaryKeyDef) String() string { return proto.CompactTextString(m) }
 func (*PrimaryKeyDef) ProtoMessage()    {}
 func (*PrimaryKeyDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{18}
+	return fileDescriptor_2d655ab2f7683c23, []int{19}
 }
 func (m *PrimaryKeyDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2238,7 +2328,7 @@ func (m *Property) Reset()         { *m = Property{} }
 func (m *Property) String() string { return proto.CompactTextString(m) }
 func (*Property) ProtoMessage()    {}
 func (*Property) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{19}
+	return fileDescriptor_2d655ab2f7683c23, []int{20}
 }
 func (m *Property) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2292,7 +2382,7 @@ func (m *PropertiesDef) Reset()         { *m = PropertiesDef{} }
 func (m *PropertiesDef) String() string { return proto.CompactTextString(m) }
 func (*PropertiesDef) ProtoMessage()    {}
 func (*PropertiesDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{20}
+	return fileDescriptor_2d655ab2f7683c23, []int{21}
 }
 func (m *PropertiesDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2347,7 +2437,7 @@ func (m *PartitionInfo) Reset()         { *m = PartitionInfo{} }
 func (m *PartitionInfo) String() string { return proto.CompactTextString(m) }
 func (*PartitionInfo) ProtoMessage()    {}
 func (*PartitionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{21}
+	return fileDescriptor_2d655ab2f7683c23, []int{22}
 }
 func (m *PartitionInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2455,7 +2545,7 @@ func (m *PartitionItem) Reset()         { *m = PartitionItem{} }
 func (m *PartitionItem) String() string { return proto.CompactTextString(m) }
 func (*PartitionItem) ProtoMessage()    {}
 func (*PartitionItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{22}
+	return fileDescriptor_2d655ab2f7683c23, []int{23}
 }
 func (m *PartitionItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2537,7 +2627,7 @@ func (m *ViewDef) Reset()         { *m = ViewDef{} }
 func (m *ViewDef) String() string { return proto.CompactTextString(m) }
 func (*ViewDef) ProtoMessage()    {}
 func (*ViewDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{23}
+	return fileDescriptor_2d655ab2f7683c23, []int{24}
 }
 func (m *ViewDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2589,7 +2679,7 @@ func (m *TableDef) Reset()         { *m = TableDef{} }
 func (m *TableDef) String() string { return proto.CompactTextString(m) }
 func (*TableDef) ProtoMessage()    {}
 func (*TableDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{24}
+	return fileDescriptor_2d655ab2f7683c23, []int{25}
 }
 func (m *TableDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2677,7 +2767,7 @@ func (m *TableDef_DefType) Reset()         { *m = TableDef_DefType{} }
 func (m *TableDef_DefType) String() string { return proto.CompactTextString(m) }
 func (*TableDef_DefType) ProtoMessage()    {}
 func (*TableDef_DefType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{24, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{25, 0}
 }
 func (m *TableDef_DefType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2802,7 +2892,7 @@ func (m *Cost) Reset()         { *m = Cost{} }
 func (m *Cost) String() string { return proto.CompactTextString(m) }
 func (*Cost) ProtoMessage()    {}
 func (*Cost) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{25}
+	return fileDescriptor_2d655ab2f7683c23, []int{26}
 }
 func (m *Cost) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2884,7 +2974,7 @@ func (m *ColData) Reset()         { *m = ColData{} }
 func (m *ColData) String() string { return proto.CompactTextString(m) }
 func (*ColData) ProtoMessage()    {}
 func (*ColData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{26}
+	return fileDescriptor_2d655ab2f7683c23, []int{27}
 }
 func (m *ColData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2981,7 +3071,7 @@ func (m *RowsetData) Reset()         { *m = RowsetData{} }
 func (m *RowsetData) String() string { return proto.CompactTextString(m) }
 func (*RowsetData) ProtoMessage()    {}
 func (*RowsetData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{27}
+	return fileDescriptor_2d655ab2f7683c23, []int{28}
 }
 func (m *RowsetData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3037,7 +3127,7 @@ func (m *OrderBySpec) Reset()         { *m = OrderBySpec{} }
 func (m *OrderBySpec) String() string { return proto.CompactTextString(m) }
 func (*OrderBySpec) ProtoMessage()    {}
 func (*OrderBySpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{28}
+	return fileDescriptor_2d655ab2f7683c23, []int{29}
 }
 func (m *OrderBySpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3101,7 +3191,7 @@ func (m *WindowSpec) Reset()         { *m = WindowSpec{} }
 func (m *WindowSpec) String() string { return proto.CompactTextString(m) }
 func (*WindowSpec) ProtoMessage()    {}
 func (*WindowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{29}
+	return fileDescriptor_2d655ab2f7683c23, []int{30}
 }
 func (m *WindowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3177,7 +3267,7 @@ func (m *UpdateCtx) Reset()         { *m = UpdateCtx{} }
 func (m *UpdateCtx) String() string { return proto.CompactTextString(m) }
 func (*UpdateCtx) ProtoMessage()    {}
 func (*UpdateCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{30}
+	return fileDescriptor_2d655ab2f7683c23, []int{31}
 }
 func (m *UpdateCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3285,7 +3375,7 @@ func (m *AnalyzeInfo) Reset()         { *m = AnalyzeInfo{} }
 func (m *AnalyzeInfo) String() string { return proto.CompactTextString(m) }
 func (*AnalyzeInfo) ProtoMessage()    {}
 func (*AnalyzeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{31}
+	return fileDescriptor_2d655ab2f7683c23, []int{32}
 }
 func (m *AnalyzeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3390,7 +3480,7 @@ func (m *Node) Reset()         { *m = Node{} }
 func (m *Node) String() string { return proto.CompactTextString(m) }
 func (*Node) ProtoMessage()    {}
 func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{32}
+	return fileDescriptor_2d655ab2f7683c23, []int{33}
 }
 func (m *Node) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3602,7 +3692,7 @@ func (m *DeleteTableCtx) Reset()         { *m = DeleteTableCtx{} }
 func (m *DeleteTableCtx) String() string { return proto.CompactTextString(m) }
 func (*DeleteTableCtx) ProtoMessage()    {}
 func (*DeleteTableCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{33}
+	return fileDescriptor_2d655ab2f7683c23, []int{34}
 }
 func (m *DeleteTableCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3687,7 +3777,7 @@ func (m *Query) Reset()         { *m = Query{} }
 func (m *Query) String() string { return proto.CompactTextString(m) }
 func (*Query) ProtoMessage()    {}
 func (*Query) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{34}
+	return fileDescriptor_2d655ab2f7683c23, []int{35}
 }
 func (m *Query) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3768,7 +3858,7 @@ func (m *TransationControl) Reset()         { *m = TransationControl{} }
 func (m *TransationControl) String() string { return proto.CompactTextString(m) }
 func (*TransationControl) ProtoMessage()    {}
 func (*TransationControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{35}
+	return fileDescriptor_2d655ab2f7683c23, []int{36}
 }
 func (m *TransationControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3872,7 +3962,7 @@ func (m *TransationBegin) Reset()         { *m = TransationBegin{} }
 func (m *TransationBegin) String() string { return proto.CompactTextString(m) }
 func (*TransationBegin) ProtoMessage()    {}
 func (*TransationBegin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{36}
+	return fileDescriptor_2d655ab2f7683c23, []int{37}
 }
 func (m *TransationBegin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3919,7 +4009,7 @@ func (m *TransationCommit) Reset()         { *m = TransationCommit{} }
 func (m *TransationCommit) String() string { return proto.CompactTextString(m) }
 func (*TransationCommit) ProtoMessage()    {}
 func (*TransationCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{37}
+	return fileDescriptor_2d655ab2f7683c23, []int{38}
 }
 func (m *TransationCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3966,7 +4056,7 @@ func (m *TransationRollback) Reset()         { *m = TransationRollback{} }
 func (m *TransationRollback) String() string { return proto.CompactTextString(m) }
 func (*TransationRollback) ProtoMessage()    {}
 func (*TransationRollback) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{38}
+	return fileDescriptor_2d655ab2f7683c23, []int{39}
 }
 func (m *TransationRollback) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4019,7 +4109,7 @@ func (m *Plan) Reset()         { *m = Plan{} }
 func (m *Plan) String() string { return proto.CompactTextString(m) }
 func (*Plan) ProtoMessage()    {}
 func (*Plan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{39}
+	return fileDescriptor_2d655ab2f7683c23, []int{40}
 }
 func (m *Plan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4145,7 +4235,7 @@ func (m *InsertValues) Reset()         { *m = InsertValues{} }
 func (m *InsertValues) String() string { return proto.CompactTextString(m) }
 func (*InsertValues) ProtoMessage()    {}
 func (*InsertValues) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{40}
+	return fileDescriptor_2d655ab2f7683c23, []int{41}
 }
 func (m *InsertValues) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4227,7 +4317,7 @@ func (m *Column) Reset()         { *m = Column{} }
 func (m *Column) String() string { return proto.CompactTextString(m) }
 func (*Column) ProtoMessage()    {}
 func (*Column) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{41}
+	return fileDescriptor_2d655ab2f7683c23, []int{42}
 }
 func (m *Column) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4281,7 +4371,7 @@ func (m *DataControl) Reset()         { *m = DataControl{} }
 func (m *DataControl) String() string { return proto.CompactTextString(m) }
 func (*DataControl) ProtoMessage()    {}
 func (*DataControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{42}
+	return fileDescriptor_2d655ab2f7683c23, []int{43}
 }
 func (m *DataControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4415,7 +4505,7 @@ func (m *DataDefinition) Reset()         { *m = DataDefinition{} }
 func (m *DataDefinition) String() string { return proto.CompactTextString(m) }
 func (*DataDefinition) ProtoMessage()    {}
 func (*DataDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{43}
+	return fileDescriptor_2d655ab2f7683c23, []int{44}
 }
 func (m *DataDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4623,7 +4713,7 @@ func (m *CreateDatabase) Reset()         { *m = CreateDatabase{} }
 func (m *CreateDatabase) String() string { return proto.CompactTextString(m) }
 func (*CreateDatabase) ProtoMessage()    {}
 func (*CreateDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{44}
+	return fileDescriptor_2d655ab2f7683c23, []int{45}
 }
 func (m *CreateDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4678,7 +4768,7 @@ func (m *AlterDatabase) Reset()         { *m = AlterDatabase{} }
 func (m *AlterDatabase) String() string { return proto.CompactTextString(m) }
 func (*AlterDatabase) ProtoMessage()    {}
 func (*AlterDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{45}
+	return fileDescriptor_2d655ab2f7683c23, []int{46}
 }
 func (m *AlterDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4733,7 +4823,7 @@ func (m *DropDatabase) Reset()         { *m = DropDatabase{} }
 func (m *DropDatabase) String() string { return proto.CompactTextString(m) }
 func (*DropDatabase) ProtoMessage()    {}
 func (*DropDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{46}
+	return fileDescriptor_2d655ab2f7683c23, []int{47}
 }
 func (m *DropDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4790,7 +4880,7 @@ func (m *CreateTable) Reset()         { *m = CreateTable{} }
 func (m *CreateTable) String() string { return proto.CompactTextString(m) }
 func (*CreateTable) ProtoMessage()    {}
 func (*CreateTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{47}
+	return fileDescriptor_2d655ab2f7683c23, []int{48}
 }
 func (m *CreateTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4859,7 +4949,7 @@ func (m *AlterTable) Reset()         { *m = AlterTable{} }
 func (m *AlterTable) String() string { return proto.CompactTextString(m) }
 func (*AlterTable) ProtoMessage()    {}
 func (*AlterTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{48}
+	return fileDescriptor_2d655ab2f7683c23, []int{49}
 }
 func (m *AlterTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4915,7 +5005,7 @@ func (m *DropTable) Reset()         { *m = DropTable{} }
 func (m *DropTable) String() string { return proto.CompactTextString(m) }
 func (*DropTable) ProtoMessage()    {}
 func (*DropTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{49}
+	return fileDescriptor_2d655ab2f7683c23, []int{50}
 }
 func (m *DropTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4977,7 +5067,7 @@ func (m *CreateIndex) Reset()         { *m = CreateIndex{} }
 func (m *CreateIndex) String() string { return proto.CompactTextString(m) }
 func (*CreateIndex) ProtoMessage()    {}
 func (*CreateIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{50}
+	return fileDescriptor_2d655ab2f7683c23, []int{51}
 }
 func (m *CreateIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5031,7 +5121,7 @@ func (m *AlterIndex) Reset()         { *m = AlterIndex{} }
 func (m *AlterIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterIndex) ProtoMessage()    {}
 func (*AlterIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{51}
+	return fileDescriptor_2d655ab2f7683c23, []int{52}
 }
 func (m *AlterIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5079,7 +5169,7 @@ func (m *DropIndex) Reset()         { *m = DropIndex{} }
 func (m *DropIndex) String() string { return proto.CompactTextString(m) }
 func (*DropIndex) ProtoMessage()    {}
 func (*DropIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{52}
+	return fileDescriptor_2d655ab2f7683c23, []int{53}
 }
 func (m *DropIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5133,7 +5223,7 @@ func (m *TruncateTable) Reset()         { *m = TruncateTable{} }
 func (m *TruncateTable) String() string { return proto.CompactTextString(m) }
 func (*TruncateTable) ProtoMessage()    {}
 func (*TruncateTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{53}
+	return fileDescriptor_2d655ab2f7683c23, []int{54}
 }
 func (m *TruncateTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5181,7 +5271,7 @@ func (m *ShowVariables) Reset()         { *m = ShowVariables{} }
 func (m *ShowVariables) String() string { return proto.CompactTextString(m) }
 func (*ShowVariables) ProtoMessage()    {}
 func (*ShowVariables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{54}
+	return fileDescriptor_2d655ab2f7683c23, []int{55}
 }
 func (m *ShowVariables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5235,7 +5325,7 @@ func (m *SetVariables) Reset()         { *m = SetVariables{} }
 func (m *SetVariables) String() string { return proto.CompactTextString(m) }
 func (*SetVariables) ProtoMessage()    {}
 func (*SetVariables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{55}
+	return fileDescriptor_2d655ab2f7683c23, []int{56}
 }
 func (m *SetVariables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5286,7 +5376,7 @@ func (m *SetVariablesItem) Reset()         { *m = SetVariablesItem{} }
 func (m *SetVariablesItem) String() string { return proto.CompactTextString(m) }
 func (*SetVariablesItem) ProtoMessage()    {}
 func (*SetVariablesItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{56}
+	return fileDescriptor_2d655ab2f7683c23, []int{57}
 }
 func (m *SetVariablesItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5364,7 +5454,7 @@ func (m *Prepare) Reset()         { *m = Prepare{} }
 func (m *Prepare) String() string { return proto.CompactTextString(m) }
 func (*Prepare) ProtoMessage()    {}
 func (*Prepare) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{57}
+	return fileDescriptor_2d655ab2f7683c23, []int{58}
 }
 func (m *Prepare) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5433,7 +5523,7 @@ func (m *Execute) Reset()         { *m = Execute{} }
 func (m *Execute) String() string { return proto.CompactTextString(m) }
 func (*Execute) ProtoMessage()    {}
 func (*Execute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{58}
+	return fileDescriptor_2d655ab2f7683c23, []int{59}
 }
 func (m *Execute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5487,7 +5577,7 @@ func (m *Deallocate) Reset()         { *m = Deallocate{} }
 func (m *Deallocate) String() string { return proto.CompactTextString(m) }
 func (*Deallocate) ProtoMessage()    {}
 func (*Deallocate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{59}
+	return fileDescriptor_2d655ab2f7683c23, []int{60}
 }
 func (m *Deallocate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5556,6 +5646,7 @@ func init() {
 	proto.RegisterType((*Decimal128)(nil), "plan.decimal128")
 	proto.RegisterType((*ColDef)(nil), "plan.ColDef")
 	proto.RegisterType((*Default)(nil), "plan.Default")
+	proto.RegisterType((*GeneratedCol)(nil), "plan.GeneratedCol")
 	proto.RegisterType((*IndexDef)(nil), "plan.IndexDef")
 	proto.RegisterType((*PrimaryKeyDef)(nil), "plan.PrimaryKeyDef")
 	proto.RegisterType((*Property)(nil), "plan.Property")
@@ -5606,314 +5697,317 @@ func init() {
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 4911 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x3a, 0x4d, 0x8f, 0x1b, 0xc9,
-	0x75, 0x6c, 0x7e, 0x36, 0x1f, 0xc9, 0x99, 0x56, 0x49, 0x2b, 0x51, 0xb2, 0x56, 0x3b, 0x6a, 0x7d,
-	0x78, 0xac, 0xf5, 0x6a, 0x77, 0x47, 0xb2, 0x2c, 0x1b, 0x8e, 0x6d, 0x0e, 0xa7, 0x35, 0xa4, 0xc5,
-	0x21, 0xc7, 0x45, 0xce, 0x68, 0xd7, 0x46, 0x40, 0x34, 0xd9, 0x4d, 0x4e, 0x4b, 0xcd, 0x6e, 0xba,
-	0xbb, 0xa9, 0x99, 0x59, 0x20, 0x80, 0x0f, 0x49, 0x80, 0x9c, 0xe2, 0x43, 0x80, 0xe4, 0x68, 0x04,
-	0x81, 0xef, 0xf9, 0x09, 0xb9, 0xe5, 0x18, 0x20, 0xc8, 0x21, 0xc8, 0x25, 0x71, 0x8e, 0xce, 0x2d,
-	0x37, 0x27, 0x87, 0xe0, 0xbd, 0xaa, 0x6e, 0x36, 0x87, 0x23, 0xef, 0x62, 0x91, 0x0b, 0x51, 0xef,
-	0xb3, 0x5e, 0x55, 0xbd, 0x7a, 0x1f, 0xc5, 0x06, 0x98, 0xbb, 0xa6, 0xf7, 0x78, 0x1e, 0xf8, 0x91,
-	0xcf, 0xf2, 0x38, 0xbe, 0xf5, 0xd1, 0xd4, 0x89, 0x4e, 0x16, 0xa3, 0xc7, 0x63, 0x7f, 0xf6, 0xf1,
-	0xd4, 0x9f, 0xfa, 0x1f, 0x13, 0x71, 0xb4, 0x98, 0x10, 0x44, 0x00, 0x8d, 0x84, 0x90, 0xfe, 0x2b,
-	0x05, 0xf2, 0x83, 0xf3, 0xb9, 0xcd, 0x36, 0x20, 0xeb, 0x58, 0x75, 0x65, 0x4b, 0xd9, 0x2e, 0xf0,
-	0xac, 0x63, 0xb1, 0x5b, 0xa0, 0x7a, 0x0b, 0xd7, 0x35, 0x47, 0xae, 0x5d, 0xcf, 0x6e, 0x29, 0xdb,
-	0x2a, 0x4f, 0x60, 0x76, 0x0d, 0x0a, 0xa7, 0x8e, 0x15, 0x9d, 0xd4, 0x73, 0xc4, 0x2e, 0x00, 0x76,
-	0x1b, 0xca, 0xf3, 0xc0, 0x1e, 0x3b, 0xa1, 0xe3, 0x7b, 0xf5, 0x3c, 0x51, 0x96, 0x08, 0xc6, 0x20,
-	0x1f, 0x3a, 0x5f, 0xd8, 0xf5, 0x02, 0x11, 0x68, 0x8c, 0x7a, 0xc2, 0xb1, 0xe9, 0xda, 0xf5, 0xa2,
-	0xd0, 0x43, 0x80, 0xfe, 0x0f, 0x39, 0x28, 0x34, 0x7d, 0x2f, 0x8c, 0xd8, 0x75, 0x28, 0x3a, 0x21,
-	0xce, 0x4a, 0x76, 0xa9, 0x5c, 0x42, 0xec, 0x1a, 0xe4, 0x9d, 0xb7, 0xa6, 0x4b, 0x76, 0xe5, 0x5a,
-	0x19, 0x4e, 0x10, 0x62, 0x2d, 0xc4, 0xa2, 0x51, 0x0a, 0x62, 0x2d, 0x89, 0x0d, 0x11, 0x8b, 0x06,
-	0x95, 0x11, 0x1b, 0x4a, 0xec, 0x08, 0xb1, 0x68, 0x8d, 0x8a, 0xd8, 0x91, 0xc4, 0x2e, 0x10, 0x8b,
-	0xe6, 0xe4, 0x11, 0xbb, 0x90, 0xd8, 0x09, 0x62, 0x4b, 0x5b, 0xca, 0x76, 0x16, 0xb1, 0x08, 0xb1,
-	0x5b, 0x50, 0xb2, 0xcc, 0xc8, 0x46, 0x82, 0x8a, 0xd6, 0xb7, 0x32, 0x3c, 0x46, 0x30, 0x1d, 0x2a,
-	0x38, 0x8c, 0x9c, 0x19, 0xd1, 0xcb, 0xd2, 0xcc, 0x34, 0x92, 0x7d, 0x07, 0xaa, 0x96, 0x3d, 0x76,
-	0x66, 0xa6, 0xfb, 0xec, 0x29, 0x32, 0xc1, 0x96, 0xb2, 0x5d, 0xd9, 0xd9, 0x7c, 0x4c, 0x07, 0x9a,
-	0x50, 0x5a, 0x19, 0xbe, 0xc2, 0xc6, 0x9e, 0x43, 0x4d, 0xc2, 0x9f, 0xee, 0x3c, 0x47, 0xb9, 0x0a,
-	0xc9, 0x69, 0x2b, 0x72, 0x9f, 0xee, 0x3c, 0x6f, 0x65, 0xf8, 0x2a, 0x23, 0xbb, 0x0f, 0x55, 0x9c,
-	0x3b, 0x8c, 0xcc, 0xd9, 0x1c, 0x05, 0xab, 0xd2, 0xaa, 0x15, 0x2c, 0x2e, 0xeb, 0x75, 0xe8, 0x7b,
-	0xc8, 0x50, 0x93, 0x3b, 0x16, 0x23, 0xd8, 0x16, 0x80, 0x65, 0x4f, 0xcc, 0x85, 0x1b, 0x21, 0x79,
-	0x43, 0x6e, 0x5d, 0x0a, 0xb7, 0x5b, 0x82, 0xc2, 0x5b, 0xd3, 0x5d, 0xd8, 0xfa, 0x6d, 0x50, 0x0f,
-	0xcd, 0xc0, 0x9c, 0x71, 0x7b, 0xc2, 0x34, 0xc8, 0xcd, 0xfd, 0x50, 0xba, 0x16, 0x0e, 0xf5, 0x0e,
-	0x14, 0x8f, 0xcd, 0x00, 0x69, 0x0c, 0xf2, 0x9e, 0x39, 0xb3, 0x89, 0x58, 0xe6, 0x34, 0xc6, 0x53,
-	0x0f, 0xcf, 0xc3, 0xc8, 0x9e, 0x49, 0xbf, 0x93, 0x10, 0xe2, 0xa7, 0xae, 0x3f, 0x92, 0x27, 0xac,
-	0x72, 0x09, 0xe9, 0x5d, 0x28, 0x36, 0x7d, 0x17, 0xb5, 0xdd, 0x80, 0x52, 0x60, 0xbb, 0xc3, 0xe5,
-	0x6c, 0xc5, 0xc0, 0x76, 0x0f, 0xfd, 0x10, 0x09, 0x63, 0x5f, 0x10, 0xb2, 0x82, 0x30, 0xf6, 0x89,
-	0x10, 0xcf, 0x9f, 0x5b, 0xce, 0xaf, 0x0f, 0x00, 0x9a, 0x7e, 0x10, 0x7c, 0x6d, 0x9d, 0xd7, 0xa0,
-	0x60, 0xd9, 0xf3, 0xe5, 0xed, 0x20, 0x40, 0x7f, 0x04, 0xaa, 0x71, 0x36, 0x0f, 0x3a, 0x4e, 0x18,
-	0xb1, 0x3b, 0x90, 0x77, 0x9d, 0x30, 0xaa, 0x2b, 0x5b, 0xb9, 0xed, 0xca, 0x0e, 0x88, 0xb3, 0x43,
-	0x2a, 0x27, 0xbc, 0xbe, 0x05, 0xea, 0x81, 0x79, 0x76, 0x8c, 0x3b, 0x89, 0xda, 0x68, 0x4b, 0xe5,
-	0x16, 0xc9, 0xfd, 0x7d, 0x04, 0x30, 0x30, 0x83, 0xa9, 0x1d, 0xd1, 0xdd, 0xbd, 0x0d, 0xb9, 0xe8,
-	0x7c, 0x4e, 0x1c, 0x89, 0x3a, 0x24, 0x70, 0x44, 0xeb, 0xff, 0xad, 0x40, 0xa5, 0xbf, 0x18, 0xfd,
-	0x62, 0x61, 0x07, 0xe7, 0xb8, 0xa2, 0xed, 0x25, 0xf7, 0xc6, 0xce, 0x75, 0xc1, 0x9d, 0xa2, 0x2f,
-	0x25, 0x71, 0x89, 0x9e, 0x6f, 0xd9, 0x43, 0xc7, 0x8a, 0x97, 0x88, 0x60, 0xdb, 0xc2, 0x60, 0xe1,
-	0xcf, 0xe5, 0xa6, 0x65, 0xfd, 0x39, 0xdb, 0x82, 0xc2, 0xf8, 0xc4, 0x71, 0x2d, 0xba, 0x65, 0xab,
-	0x2b, 0x12, 0x04, 0x76, 0x13, 0xd4, 0xc0, 0x3f, 0x1d, 0xa6, 0x42, 0x40, 0x29, 0xf0, 0x4f, 0xfb,
-	0xce, 0x17, 0xb8, 0xdf, 0x22, 0x02, 0x01, 0x14, 0xfb, 0xcd, 0x46, 0xa7, 0xc1, 0xb5, 0x0c, 0x8e,
-	0x8d, 0xcf, 0xda, 0xfd, 0x41, 0x5f, 0x53, 0xd8, 0x06, 0x40, 0xb7, 0x37, 0x18, 0x4a, 0x38, 0xcb,
-	0x8a, 0x90, 0x6d, 0x77, 0xb5, 0x1c, 0xf2, 0x20, 0xbe, 0xdd, 0xd5, 0xf2, 0xac, 0x04, 0xb9, 0x46,
-	0xf7, 0x73, 0xad, 0x40, 0x83, 0x4e, 0x47, 0x2b, 0xea, 0xff, 0xac, 0x40, 0xb9, 0x37, 0x7a, 0x6d,
-	0x8f, 0x23, 0x5c, 0x33, 0xfa, 0x94, 0x1d, 0xbc, 0xb5, 0x03, 0x5a, 0x76, 0x8e, 0x4b, 0x08, 0x17,
-	0x62, 0x8d, 0x44, 0x1c, 0xe1, 0x59, 0x6b, 0x44, 0x7c, 0xe3, 0x13, 0x7b, 0x66, 0xd2, 0xe2, 0x90,
-	0x8f, 0x20, 0xf4, 0x61, 0x7f, 0xf4, 0x9a, 0x96, 0x97, 0xe3, 0x38, 0x64, 0x1f, 0x40, 0x45, 0xe8,
-	0x18, 0x92, 0x03, 0x15, 0x68, 0x2f, 0x40, 0xa0, 0xba, 0xe8, 0xc6, 0x37, 0xa0, 0x64, 0x8d, 0x04,
-	0xb1, 0x48, 0xc4, 0xa2, 0x35, 0x22, 0x02, 0x4a, 0x92, 0x56, 0x41, 0x2c, 0x49, 0x49, 0x42, 0x11,
-	0xc3, 0x4d, 0x50, 0xfd, 0xd1, 0x6b, 0x41, 0x55, 0x89, 0x5a, 0xf2, 0x47, 0xaf, 0x91, 0xa4, 0xff,
-	0x87, 0x02, 0xea, 0x8b, 0x85, 0x37, 0x8e, 0x30, 0xa4, 0xde, 0x83, 0xfc, 0x64, 0xe1, 0x8d, 0xe5,
-	0xb9, 0xcb, 0xd0, 0x91, 0xac, 0x99, 0x13, 0x11, 0x7d, 0xcd, 0x0c, 0xa6, 0xe8, 0xa3, 0x6b, 0xbe,
-	0x86, 0x78, 0xfd, 0x2f, 0xa5, 0xc6, 0x17, 0xae, 0x39, 0x65, 0x2a, 0xe4, 0xbb, 0xbd, 0xae, 0xa1,
-	0x65, 0x58, 0x15, 0xd4, 0x76, 0x77, 0x60, 0xf0, 0x6e, 0xa3, 0xa3, 0x29, 0x74, 0x34, 0x83, 0xc6,
-	0x6e, 0xc7, 0xd0, 0xb2, 0x48, 0x39, 0xee, 0x75, 0x1a, 0x83, 0x76, 0xc7, 0xd0, 0xf2, 0x82, 0xc2,
-	0xdb, 0xcd, 0x81, 0xa6, 0x32, 0x0d, 0xaa, 0x87, 0xbc, 0xb7, 0x77, 0xd4, 0x34, 0x86, 0xdd, 0xa3,
-	0x4e, 0x47, 0xd3, 0xd8, 0x55, 0xd8, 0x4c, 0x30, 0x3d, 0x81, 0xdc, 0x42, 0x91, 0xe3, 0x06, 0x6f,
-	0xf0, 0x7d, 0xed, 0xc7, 0x4c, 0x85, 0x5c, 0x63, 0x7f, 0x5f, 0xfb, 0xa5, 0x82, 0xa3, 0x57, 0xed,
-	0xae, 0xf6, 0xcb, 0xac, 0xfe, 0xa7, 0x39, 0xc8, 0xa3, 0x81, 0x7f, 0xd8, 0xad, 0xd9, 0x37, 0x40,
-	0x19, 0xd3, 0xc9, 0x55, 0x76, 0x2a, 0x82, 0x46, 0x49, 0xa3, 0x95, 0xe1, 0x0a, 0xae, 0x5a, 0x11,
-	0xfe, 0x59, 0xd9, 0xd9, 0x10, 0xc4, 0x38, 0x1c, 0x21, 0x7d, 0xce, 0x6e, 0x83, 0xf2, 0x56, 0x3a,
-	0x6b, 0x55, 0xd0, 0x45, 0x40, 0x42, 0xea, 0x5b, 0xb6, 0x05, 0xb9, 0xb1, 0x2f, 0x92, 0x43, 0x42,
-	0x17, 0xe1, 0xa0, 0x95, 0xe1, 0x48, 0x42, 0xfd, 0x13, 0x3a, 0xd6, 0x44, 0x7f, 0x7c, 0x2a, 0xa8,
-	0x61, 0xc2, 0x1e, 0x40, 0x2e, 0x5c, 0x8c, 0xe8, 0x6c, 0x2b, 0x3b, 0x57, 0xd6, 0xee, 0x18, 0xaa,
-	0x09, 0x17, 0x23, 0xf6, 0x10, 0xf2, 0x63, 0x3f, 0x08, 0xe8, 0x94, 0x93, 0x20, 0xbe, 0x0c, 0x3e,
-	0x98, 0x6c, 0x90, 0xce, 0xb6, 0x40, 0x89, 0x28, 0x8d, 0x24, 0x4c, 0xcb, 0xdb, 0x8f, 0x13, 0x46,
-	0xec, 0xbe, 0x0c, 0x29, 0x90, 0xb6, 0x29, 0x0e, 0x38, 0xa8, 0x07, 0xa9, 0x4c, 0x87, 0xdc, 0xcc,
-	0x3c, 0x93, 0x39, 0x43, 0x32, 0xc5, 0x91, 0x06, 0x6d, 0x9a, 0x99, 0x67, 0xbb, 0x45, 0xc8, 0xdb,
-	0x67, 0xf3, 0x40, 0xbf, 0x09, 0xe5, 0x24, 0xf3, 0xb0, 0x2a, 0x28, 0xa6, 0xbc, 0x3a, 0x8a, 0xa9,
-	0x6f, 0x63, 0x22, 0x88, 0x73, 0xcb, 0x2a, 0x0d, 0xa1, 0xf8, 0x42, 0x29, 0x23, 0xfd, 0xf7, 0x0a,
-	0x05, 0xe7, 0xbd, 0x77, 0x84, 0xfa, 0xfb, 0x90, 0x33, 0xdd, 0x29, 0xb1, 0x6f, 0xec, 0xb0, 0x78,
-	0xf9, 0xb3, 0x79, 0x60, 0x87, 0xa1, 0x38, 0x69, 0xd3, 0x9d, 0xc6, 0x7e, 0x90, 0xbb, 0xdc, 0x0f,
-	0xbe, 0x09, 0x25, 0x99, 0x81, 0xe4, 0x81, 0xd6, 0x04, 0xc7, 0x9e, 0x40, 0xf2, 0x98, 0xca, 0xea,
-	0x50, 0x9a, 0x07, 0xce, 0xcc, 0x0c, 0xce, 0x45, 0xda, 0xe7, 0x31, 0xc8, 0x1e, 0xc0, 0x86, 0xb9,
-	0x88, 0xfc, 0xa1, 0xe3, 0x8d, 0x03, 0x7b, 0x66, 0x7b, 0x11, 0x1d, 0xad, 0xca, 0x6b, 0x88, 0x6d,
-	0xc7, 0x48, 0x0c, 0xc5, 0xf3, 0x37, 0x8e, 0x75, 0x46, 0xc7, 0x5a, 0xe0, 0x02, 0x40, 0xb5, 0x63,
-	0x7f, 0x46, 0x52, 0xf2, 0xb2, 0x4a, 0x50, 0xff, 0x05, 0x94, 0xa4, 0x11, 0xec, 0x2e, 0x54, 0xb1,
-	0x72, 0x19, 0x9a, 0x23, 0xc7, 0x75, 0xa2, 0x73, 0x59, 0xcf, 0x54, 0x10, 0xd7, 0x10, 0x28, 0xbc,
-	0xa8, 0xb8, 0xef, 0xd2, 0xa5, 0x57, 0x2e, 0x2a, 0xe2, 0xd9, 0x3d, 0xa8, 0xf9, 0x81, 0x33, 0x75,
-	0xbc, 0x61, 0x18, 0x05, 0x8e, 0x37, 0x95, 0xe1, 0xb7, 0x2a, 0x90, 0x7d, 0xc2, 0xe9, 0x7f, 0xad,
-	0x80, 0xda, 0xf6, 0x2c, 0xfb, 0x0c, 0x77, 0xfc, 0x51, 0x3a, 0xd0, 0xd7, 0x85, 0xc2, 0x98, 0x28,
-	0x06, 0xcb, 0x5d, 0x8c, 0x4f, 0x27, 0x9b, 0x3a, 0x9d, 0x6f, 0x40, 0x19, 0x33, 0x1c, 0x8e, 0xc3,
-	0x7a, 0x6e, 0x2b, 0xb7, 0x5d, 0xe6, 0xea, 0xd8, 0x77, 0x31, 0x10, 0x85, 0xfa, 0x63, 0x28, 0x27,
-	0x2a, 0x58, 0x05, 0x4a, 0xed, 0xee, 0x71, 0xa3, 0xdd, 0xd9, 0xd3, 0x32, 0x08, 0xfc, 0xac, 0xd7,
-	0x35, 0x0e, 0x1a, 0x87, 0x9a, 0x82, 0xf1, 0x78, 0xb7, 0xdf, 0xd6, 0xb2, 0xfa, 0x03, 0xa8, 0x1d,
-	0x8a, 0xed, 0x7e, 0x69, 0x9f, 0xa3, 0x75, 0xd7, 0xa0, 0x20, 0x34, 0x2b, 0xa4, 0x59, 0x00, 0xfa,
-	0x0e, 0xa8, 0x87, 0x81, 0x3f, 0xb7, 0x83, 0xe8, 0x1c, 0x83, 0xee, 0x1b, 0xfb, 0x5c, 0x3a, 0x0c,
-	0x0e, 0x97, 0xc9, 0x30, 0x9b, 0x4e, 0x86, 0x3f, 0x42, 0xd5, 0x24, 0xe3, 0xd8, 0x21, 0xaa, 0x7e,
-	0x0c, 0x30, 0x4f, 0x10, 0x32, 0xcb, 0xc6, 0x61, 0x40, 0x2a, 0xe7, 0x29, 0x0e, 0xfd, 0x7f, 0xb2,
-	0x50, 0x3b, 0x34, 0x83, 0xc8, 0xc1, 0x0b, 0xdc, 0xf6, 0x26, 0x3e, 0xfb, 0x26, 0xe4, 0xa3, 0xf3,
-	0xb9, 0x2d, 0xf7, 0xee, 0x6a, 0x12, 0x42, 0x04, 0x0b, 0x6d, 0x1b, 0x31, 0xe0, 0xa9, 0x19, 0xef,
-	0x38, 0x35, 0x8a, 0x61, 0x9f, 0xc0, 0xd5, 0x79, 0x2c, 0x86, 0x08, 0x3b, 0xa4, 0xf2, 0x58, 0x9c,
-	0xdd, 0x65, 0x24, 0x76, 0x1f, 0x4a, 0x4d, 0xdf, 0x5d, 0xcc, 0xbc, 0xb0, 0x9e, 0x5f, 0x8b, 0xd9,
-	0x31, 0x89, 0x3d, 0x02, 0x2d, 0x11, 0x8e, 0xd9, 0x0b, 0xb4, 0x91, 0x6b, 0x78, 0xa6, 0x43, 0x35,
-	0xc1, 0x75, 0x17, 0x33, 0x51, 0xde, 0xf2, 0x15, 0x1c, 0x7b, 0x02, 0x90, 0xc0, 0x61, 0xbd, 0x44,
-	0x13, 0x5f, 0x5c, 0x76, 0x3b, 0xb2, 0x67, 0x3c, 0xc5, 0x86, 0x15, 0xbf, 0xe9, 0x4e, 0xfd, 0xc0,
-	0x89, 0x4e, 0x66, 0xe4, 0xfc, 0x39, 0xbe, 0x44, 0xb0, 0x87, 0xb0, 0xe1, 0x84, 0xfd, 0xc5, 0x28,
-	0x91, 0xa7, 0x08, 0xa6, 0xf2, 0x0b, 0x58, 0xfd, 0x77, 0x4a, 0x7a, 0xf7, 0xb1, 0xd2, 0xbb, 0x0f,
-	0xb5, 0xa5, 0x71, 0xcb, 0x98, 0xb1, 0x8a, 0x64, 0xdb, 0xb0, 0xe9, 0x07, 0x96, 0xe3, 0x99, 0x58,
-	0x75, 0x89, 0x09, 0xf0, 0x14, 0x6a, 0xfc, 0x22, 0x9a, 0x6d, 0x41, 0xc5, 0xb2, 0xc3, 0x71, 0xe0,
-	0xcc, 0xa3, 0xe5, 0xe6, 0xa7, 0x51, 0xe9, 0x4b, 0x9c, 0x5f, 0xb9, 0xc4, 0xec, 0x21, 0xa8, 0x2e,
-	0x46, 0xa3, 0x13, 0xd3, 0xa3, 0x0d, 0x5e, 0x3d, 0x8f, 0x84, 0x86, 0x7c, 0x8e, 0x47, 0x81, 0x34,
-	0xac, 0x17, 0xd7, 0xf9, 0x62, 0x9a, 0xfe, 0x3e, 0x94, 0x8e, 0x1d, 0xfb, 0x54, 0x46, 0xc4, 0xb7,
-	0x8e, 0x7d, 0x1a, 0x47, 0x44, 0x1c, 0xeb, 0x7f, 0x97, 0x07, 0x75, 0x80, 0x4d, 0xd6, 0xbb, 0x42,
-	0xe6, 0x16, 0xa6, 0x0c, 0x37, 0xce, 0xe7, 0xcb, 0xe4, 0xb4, 0x87, 0x19, 0x1f, 0x29, 0xec, 0x11,
-	0xe4, 0x2d, 0x7b, 0x22, 0x6e, 0x6c, 0x25, 0x2e, 0xf0, 0x62, 0x9d, 0x18, 0x16, 0x85, 0xfb, 0x22,
-	0x0f, 0x7b, 0x1f, 0x20, 0x42, 0xca, 0x90, 0xbc, 0x5d, 0x2c, 0xbd, 0x4c, 0x18, 0x59, 0x58, 0x96,
-	0xc7, 0x81, 0x6d, 0x46, 0x76, 0xf8, 0x0b, 0x57, 0x96, 0x38, 0x4b, 0x04, 0x6b, 0xc1, 0x06, 0x9a,
-	0xb4, 0x83, 0x41, 0xc2, 0xc1, 0x58, 0x20, 0x17, 0x7e, 0xf7, 0xc2, 0x94, 0x5d, 0xc9, 0x44, 0xf1,
-	0xc2, 0xf0, 0xa2, 0xe0, 0x9c, 0xd7, 0xbc, 0x34, 0xee, 0xd6, 0x7f, 0x29, 0x14, 0x2a, 0x69, 0xce,
-	0x07, 0x90, 0x9d, 0xbf, 0x91, 0x49, 0x3f, 0xf6, 0xc0, 0x74, 0xe0, 0x68, 0x65, 0x78, 0x76, 0xfe,
-	0x06, 0x53, 0x19, 0x86, 0xe2, 0x6c, 0x3a, 0x95, 0xc5, 0xc1, 0x0d, 0x53, 0x19, 0x86, 0xe6, 0xef,
-	0xac, 0xc4, 0x81, 0xdc, 0xaa, 0xca, 0x54, 0xc0, 0xc0, 0x2e, 0x66, 0xc9, 0x88, 0x75, 0x15, 0x9d,
-	0xcb, 0x4a, 0x3a, 0x91, 0x87, 0x86, 0xa9, 0x14, 0x89, 0xec, 0x09, 0x94, 0x13, 0x77, 0x94, 0x95,
-	0xc2, 0xda, 0x7d, 0xf1, 0x26, 0x7e, 0x2b, 0xc3, 0x97, 0x7c, 0xbb, 0x05, 0xc8, 0x59, 0xf6, 0xe4,
-	0xd6, 0x8f, 0x81, 0xad, 0xef, 0xc9, 0x97, 0x85, 0xbb, 0x82, 0x0c, 0x77, 0xdf, 0xcf, 0x3e, 0x57,
-	0xf4, 0x00, 0xf2, 0x4d, 0x3f, 0x8c, 0xd0, 0x43, 0xc6, 0x66, 0x20, 0xfa, 0x76, 0x85, 0xd3, 0x18,
-	0x7d, 0x39, 0xf0, 0x4f, 0xa9, 0xd2, 0xce, 0x12, 0x3a, 0x06, 0x71, 0x06, 0xcf, 0x7a, 0x2b, 0x1a,
-	0x64, 0x8e, 0x43, 0xea, 0xc0, 0x23, 0x33, 0x10, 0x5e, 0xaf, 0x70, 0x01, 0x20, 0x36, 0xf2, 0x23,
-	0xd9, 0x1e, 0x2b, 0x5c, 0x00, 0xfa, 0xdf, 0x2b, 0x14, 0x99, 0xf6, 0xcc, 0xc8, 0xc4, 0xd4, 0x80,
-	0xe5, 0xfc, 0xd8, 0x5f, 0x78, 0x91, 0xec, 0x8b, 0xb0, 0xbe, 0x6f, 0x22, 0x8c, 0x4e, 0x45, 0xc9,
-	0x4e, 0x50, 0x85, 0xed, 0x65, 0xc4, 0x08, 0x32, 0x06, 0xfe, 0x85, 0xeb, 0x0a, 0x07, 0x55, 0xb9,
-	0x00, 0xd0, 0x36, 0xe7, 0xc9, 0x0e, 0x85, 0xbc, 0x02, 0xc7, 0x21, 0x61, 0x9e, 0x3d, 0xa5, 0x4b,
-	0x97, 0xe3, 0x38, 0x44, 0xcc, 0xe4, 0xc9, 0x0e, 0x79, 0x59, 0x96, 0xe3, 0x90, 0x30, 0xcf, 0x9e,
-	0x52, 0xbc, 0x52, 0x38, 0x0e, 0xb1, 0xfe, 0x08, 0xeb, 0x2a, 0x45, 0x42, 0x25, 0xd4, 0x5f, 0x01,
-	0x70, 0xff, 0x34, 0xb4, 0x23, 0xb2, 0xfa, 0x61, 0x52, 0xdd, 0x2b, 0x69, 0xb7, 0x89, 0x1d, 0x35,
-	0xa9, 0xf6, 0xef, 0xae, 0xdc, 0xb1, 0xda, 0xf2, 0x8e, 0x99, 0x91, 0x29, 0x2e, 0x99, 0xfe, 0x6f,
-	0x0a, 0x54, 0x7a, 0x81, 0x65, 0x07, 0xbb, 0xe7, 0xfd, 0xb9, 0x3d, 0x4e, 0xb2, 0xb7, 0xf2, 0x8e,
-	0xec, 0x7d, 0x9b, 0x72, 0xa9, 0x6b, 0x26, 0x61, 0x0a, 0x6f, 0x52, 0x8c, 0x60, 0x9f, 0x42, 0x7e,
-	0xe2, 0x9a, 0x22, 0xa5, 0x6f, 0xec, 0xbc, 0x2f, 0x2b, 0xf9, 0xa5, 0xfa, 0x78, 0x8c, 0x45, 0x3a,
-	0x27, 0x56, 0xfd, 0xe7, 0xc9, 0xfc, 0x54, 0xb9, 0x63, 0xdf, 0xd3, 0x6f, 0x6a, 0x19, 0x2c, 0xe1,
-	0xf7, 0x8c, 0x7e, 0x53, 0x53, 0xd8, 0x26, 0x54, 0xb0, 0xe2, 0xee, 0x0f, 0x5f, 0xb4, 0x79, 0x7f,
-	0xa0, 0x65, 0xa9, 0x91, 0x22, 0x44, 0xa7, 0xd1, 0x1f, 0x88, 0xda, 0xfd, 0xa8, 0xdb, 0xfe, 0xe9,
-	0x91, 0xa1, 0xa9, 0x2b, 0xf5, 0xbe, 0x86, 0x4d, 0x01, 0xbc, 0x72, 0x3c, 0xcb, 0x3f, 0xa5, 0xc5,
-	0x7d, 0x94, 0x4a, 0x20, 0xc3, 0xd1, 0xf9, 0x25, 0x7d, 0x6b, 0x65, 0xe9, 0xe3, 0xe7, 0xec, 0xdb,
-	0xa0, 0xfa, 0x68, 0x1a, 0xb2, 0x8a, 0x2d, 0xbc, 0xb2, 0xb6, 0x22, 0x5e, 0xf2, 0x05, 0x80, 0x2e,
-	0xec, 0xda, 0xa6, 0x25, 0xbb, 0x65, 0x1a, 0xe3, 0xb1, 0xe2, 0x76, 0x88, 0x47, 0x24, 0x1c, 0xea,
-	0xbf, 0xc9, 0x42, 0xf9, 0x68, 0x6e, 0x99, 0x91, 0xdd, 0x8c, 0xce, 0xd2, 0xbd, 0x95, 0xb2, 0xd2,
-	0x5b, 0xdd, 0x04, 0x35, 0x1a, 0x89, 0x92, 0x45, 0xee, 0x72, 0x29, 0x1a, 0xb9, 0x71, 0x3f, 0x36,
-	0x0f, 0x9c, 0x21, 0x5e, 0x31, 0x91, 0x00, 0x8a, 0xf3, 0xc0, 0x79, 0x69, 0x63, 0xe1, 0x55, 0x91,
-	0x84, 0x21, 0x46, 0x94, 0xe4, 0xe5, 0x0a, 0x89, 0x6d, 0xeb, 0x0c, 0x75, 0x9e, 0x38, 0x96, 0x4d,
-	0x92, 0x22, 0x06, 0x96, 0x10, 0x46, 0xd1, 0x2d, 0xa8, 0xc6, 0x24, 0x92, 0x15, 0xef, 0x58, 0x20,
-	0xc9, 0x28, 0xfc, 0x11, 0x54, 0x16, 0x64, 0xf6, 0x90, 0x3c, 0xaa, 0x74, 0x49, 0xd4, 0x06, 0xc1,
-	0xd0, 0xc4, 0xd8, 0xfd, 0x01, 0x54, 0xfc, 0xe8, 0xc4, 0x0e, 0x86, 0x66, 0x14, 0x05, 0xb1, 0x1f,
-	0x03, 0xa1, 0x1a, 0x88, 0x21, 0x06, 0xda, 0x5b, 0xc1, 0x50, 0x96, 0x0c, 0x88, 0x22, 0x06, 0xec,
-	0x7b, 0x2b, 0x0d, 0xcf, 0x74, 0xcf, 0xbf, 0xb0, 0xa9, 0x92, 0x79, 0x1f, 0xc0, 0xf1, 0xe6, 0x8b,
-	0x68, 0x88, 0x41, 0x40, 0x96, 0xe9, 0x65, 0xc2, 0xe0, 0xc5, 0x20, 0x7d, 0x8b, 0x28, 0xa1, 0x8b,
-	0xc2, 0x1d, 0x04, 0x8a, 0x18, 0x12, 0x79, 0x0a, 0x28, 0xb9, 0x94, 0x3c, 0x36, 0xef, 0x29, 0x79,
-	0xa2, 0xe7, 0xd3, 0xf2, 0xc4, 0x70, 0x0f, 0x6a, 0x91, 0x33, 0xc3, 0xe5, 0x7b, 0xe1, 0x62, 0x66,
-	0x5b, 0xb4, 0x85, 0x39, 0xf1, 0xea, 0xd4, 0x94, 0x38, 0xd4, 0x32, 0xb3, 0x67, 0x7e, 0x70, 0x2e,
-	0xb4, 0x14, 0x85, 0x16, 0x81, 0xa2, 0x37, 0x82, 0xdf, 0x57, 0x21, 0xdf, 0xf5, 0x2d, 0x9b, 0x7d,
-	0x02, 0x65, 0x7a, 0x92, 0x58, 0xaf, 0xce, 0x90, 0x4c, 0x3f, 0x94, 0xde, 0x54, 0x4f, 0x8e, 0xde,
-	0xfd, 0x88, 0x71, 0x07, 0x6f, 0x79, 0x18, 0xad, 0xf6, 0x15, 0x18, 0x55, 0x39, 0xe1, 0xc9, 0xeb,
-	0x03, 0x1f, 0xbb, 0xe9, 0x21, 0xb5, 0x56, 0xf9, 0x4b, 0xbc, 0x5e, 0xd0, 0xe9, 0x51, 0xe7, 0x16,
-	0xa8, 0xf4, 0xd4, 0x11, 0xd8, 0xa2, 0x50, 0x28, 0xf0, 0x04, 0x46, 0xab, 0x5f, 0xfb, 0x8e, 0x27,
-	0xac, 0x2e, 0xae, 0x59, 0xfd, 0x13, 0xdf, 0xf1, 0xe8, 0x6a, 0xab, 0xc8, 0x45, 0x56, 0xdf, 0x83,
-	0x92, 0xef, 0x89, 0x79, 0x4b, 0x6b, 0xf3, 0x16, 0x7d, 0x8f, 0xa6, 0xfc, 0x10, 0x2a, 0x13, 0xc7,
-	0x8d, 0xec, 0x40, 0x30, 0xaa, 0x6b, 0x8c, 0x20, 0xc8, 0xc4, 0xfc, 0x00, 0xd4, 0x69, 0xe0, 0x2f,
-	0xe6, 0x78, 0x2b, 0xcb, 0xeb, 0x85, 0x25, 0xd1, 0x76, 0xcf, 0x71, 0xd5, 0x34, 0x74, 0xbc, 0xe9,
-	0x30, 0xb4, 0xb1, 0xa1, 0x5c, 0x5b, 0x75, 0x4c, 0xef, 0xdb, 0xa4, 0xd5, 0x9c, 0x4e, 0xc5, 0xfc,
-	0x95, 0x75, 0xad, 0xe6, 0x74, 0x4a, 0x93, 0xa7, 0x43, 0x42, 0xf5, 0x4b, 0x43, 0xc2, 0x27, 0xcb,
-	0x4b, 0x13, 0x9d, 0x85, 0xf5, 0x1a, 0x09, 0xc8, 0xf7, 0x8d, 0x24, 0x08, 0x24, 0xf7, 0x26, 0x3a,
-	0x0b, 0xd9, 0x87, 0xa0, 0x9e, 0x62, 0x67, 0x34, 0xb7, 0xc7, 0xf4, 0x30, 0x99, 0xf4, 0xc9, 0xcb,
-	0x28, 0xc6, 0x4b, 0xa7, 0x8e, 0x47, 0xe1, 0x6c, 0x0b, 0x0a, 0xae, 0x33, 0x73, 0xa2, 0xfa, 0xe6,
-	0xfa, 0x6b, 0x15, 0x11, 0x98, 0x0e, 0x45, 0x7f, 0x32, 0xc1, 0xe5, 0x6b, 0x6b, 0x2c, 0x92, 0xc2,
-	0x3e, 0x04, 0x51, 0x28, 0x0d, 0x2d, 0x7b, 0x52, 0xbf, 0x72, 0x69, 0x3e, 0x51, 0xa3, 0xb8, 0x92,
-	0xdb, 0x81, 0x5a, 0xc2, 0x3c, 0x7c, 0x6b, 0x8f, 0xeb, 0x2c, 0xdd, 0x94, 0x24, 0x02, 0x95, 0x58,
-	0xe0, 0xd8, 0x1e, 0xb3, 0x6d, 0x28, 0xf9, 0xa3, 0xd7, 0xc3, 0xc0, 0x9e, 0xd4, 0xaf, 0x5e, 0xfe,
-	0xc2, 0x53, 0xf4, 0x47, 0xaf, 0xb9, 0x3d, 0x61, 0x9f, 0x42, 0x25, 0xa0, 0x2c, 0x37, 0xb4, 0xcc,
-	0xc8, 0xac, 0x5f, 0x4b, 0x6f, 0xc0, 0x32, 0xfd, 0x71, 0x08, 0x96, 0xa9, 0xf0, 0x1e, 0xd4, 0xec,
-	0xb3, 0x28, 0x30, 0x87, 0xfe, 0x5c, 0x94, 0xfc, 0xef, 0x89, 0x6e, 0x92, 0x90, 0x3d, 0x81, 0x63,
-	0x3f, 0x84, 0x4d, 0xcb, 0x76, 0xed, 0xc8, 0x26, 0x03, 0xc3, 0x66, 0x74, 0x56, 0xbf, 0x4e, 0x76,
-	0x5f, 0x8b, 0x5b, 0xec, 0x84, 0x88, 0x07, 0x72, 0x91, 0x19, 0xbb, 0xde, 0x91, 0xe3, 0x59, 0xe8,
-	0x4a, 0x91, 0x39, 0x0d, 0xeb, 0x37, 0xe8, 0x5a, 0x54, 0x24, 0x6e, 0x60, 0x4e, 0x43, 0xf6, 0x14,
-	0xaa, 0xa6, 0x88, 0x56, 0x43, 0xc7, 0x9b, 0xf8, 0xf5, 0x7a, 0xfa, 0xc5, 0x24, 0x15, 0xc7, 0x78,
-	0xc5, 0x5c, 0x02, 0xfa, 0xbf, 0xe4, 0x40, 0x8d, 0xaf, 0x3a, 0xf6, 0x9b, 0x47, 0xdd, 0x97, 0xdd,
-	0xde, 0xab, 0xae, 0x96, 0xc1, 0x1c, 0x77, 0xdc, 0xe8, 0x1c, 0x19, 0xc3, 0x7e, 0xb3, 0xd1, 0x15,
-	0x8f, 0x87, 0xf4, 0x70, 0x25, 0xe0, 0x2c, 0xbb, 0x02, 0xb5, 0x17, 0x47, 0xdd, 0xe6, 0xa0, 0xdd,
-	0xeb, 0x0a, 0x54, 0x0e, 0x51, 0xc6, 0x67, 0x22, 0xf5, 0x09, 0x54, 0x1e, 0x51, 0x07, 0x8d, 0x81,
-	0xc1, 0xdb, 0x31, 0xaa, 0x80, 0xb3, 0x1c, 0xf2, 0xde, 0x4f, 0x8c, 0xe6, 0x40, 0x03, 0xf6, 0x1e,
-	0x5c, 0x49, 0x44, 0x62, 0x75, 0x5a, 0x05, 0x93, 0x68, 0x2c, 0xa6, 0x5d, 0x43, 0x25, 0xdc, 0x68,
-	0x1e, 0xf1, 0x7e, 0xfb, 0xd8, 0x18, 0x36, 0x07, 0x86, 0xf6, 0x1e, 0x26, 0xe7, 0x7e, 0xbb, 0xfb,
-	0x52, 0xbb, 0xce, 0x6a, 0x50, 0xc6, 0x91, 0xd0, 0x7e, 0x83, 0xd2, 0xf7, 0xfe, 0xbe, 0x76, 0x07,
-	0x55, 0xec, 0xb5, 0xfb, 0x83, 0x76, 0xb7, 0x39, 0xd0, 0x3e, 0xc0, 0x0c, 0xfd, 0xa2, 0xdd, 0x19,
-	0x18, 0x5c, 0xdb, 0x42, 0xd9, 0x9f, 0xf4, 0xda, 0x5d, 0xed, 0x2e, 0xbd, 0xb9, 0x35, 0x0e, 0x0e,
-	0x3b, 0x86, 0xa6, 0x93, 0xc6, 0x1e, 0x1f, 0x68, 0xf7, 0x58, 0x19, 0x0a, 0x47, 0x5d, 0xb4, 0xe3,
-	0x3e, 0x2a, 0xa7, 0xe1, 0xb0, 0xd1, 0xe9, 0x68, 0x0f, 0x52, 0x79, 0xfe, 0x21, 0x8e, 0x5f, 0xb5,
-	0xbb, 0x7b, 0xbd, 0x57, 0xda, 0x37, 0x91, 0x6d, 0x97, 0xf7, 0x1a, 0x7b, 0x4d, 0x2c, 0x07, 0xb6,
-	0x51, 0x41, 0xff, 0xb0, 0xd3, 0x1e, 0x68, 0xdf, 0x42, 0xae, 0xfd, 0xc6, 0xa0, 0x65, 0x70, 0xed,
-	0x11, 0x8e, 0x1b, 0xfd, 0xbe, 0xc1, 0x07, 0xda, 0x0e, 0x8e, 0xdb, 0x5d, 0x1a, 0x3f, 0x21, 0xad,
-	0x87, 0x7b, 0x8d, 0x81, 0xa1, 0x3d, 0xc5, 0xf1, 0x9e, 0xd1, 0x31, 0x06, 0x86, 0xf6, 0x1d, 0xd4,
-	0x4a, 0x95, 0x44, 0x1f, 0xb7, 0xea, 0x19, 0xee, 0x42, 0x02, 0x92, 0x3d, 0xdf, 0xc5, 0x89, 0x0e,
-	0xda, 0xdd, 0xa3, 0xbe, 0xf6, 0x1c, 0x99, 0x69, 0x48, 0x94, 0xef, 0xe9, 0xaf, 0x41, 0x8d, 0x63,
-	0x21, 0x72, 0xb5, 0xbb, 0x5d, 0x83, 0x8b, 0x9a, 0xa6, 0x63, 0xbc, 0x18, 0x68, 0x0a, 0x22, 0x79,
-	0x7b, 0xbf, 0x85, 0xd5, 0x4c, 0x19, 0x0a, 0xbd, 0x23, 0xdc, 0x9a, 0x1c, 0x6d, 0x82, 0x71, 0xd0,
-	0xd6, 0xf2, 0x38, 0x6a, 0x74, 0x07, 0x6d, 0xad, 0x40, 0x9b, 0xd4, 0xee, 0xee, 0x77, 0x0c, 0xad,
-	0x88, 0xd8, 0x83, 0x06, 0x7f, 0xa9, 0x95, 0x50, 0xa8, 0x71, 0x78, 0xd8, 0xf9, 0x5c, 0x53, 0xf5,
-	0x6d, 0x28, 0x35, 0xa6, 0xd3, 0x03, 0x4c, 0x2a, 0x2a, 0xe4, 0x5f, 0x1c, 0x75, 0x3a, 0xe2, 0xdd,
-	0x79, 0xb7, 0x37, 0x18, 0xf4, 0x0e, 0xc4, 0xd3, 0xc5, 0xa0, 0x77, 0xa8, 0x65, 0xf5, 0xdf, 0x28,
-	0xb0, 0xb1, 0xea, 0xea, 0xec, 0x3a, 0xc8, 0x8a, 0xe3, 0x42, 0xfd, 0x51, 0x87, 0xb8, 0xde, 0xb8,
-	0x58, 0x7e, 0xe8, 0x50, 0x5d, 0x84, 0xb6, 0x50, 0xf3, 0x32, 0xa9, 0x41, 0x56, 0x70, 0xd8, 0xa7,
-	0x8e, 0x4d, 0x6f, 0x10, 0x2c, 0xbc, 0xb1, 0x19, 0x89, 0x64, 0xaa, 0xf2, 0x34, 0x0a, 0xcb, 0x48,
-	0x27, 0x6c, 0x89, 0xf2, 0x42, 0xbe, 0x62, 0x2d, 0x11, 0xfa, 0xaf, 0xb2, 0x50, 0xf8, 0xe9, 0xc2,
-	0x0e, 0xce, 0xd9, 0x33, 0x28, 0x87, 0xd1, 0x2c, 0x4a, 0xa7, 0xc9, 0x9b, 0xe2, 0x4e, 0x11, 0xfd,
-	0x71, 0x3f, 0x32, 0x23, 0x7a, 0xd4, 0x12, 0xc9, 0x12, 0x79, 0xe9, 0x2e, 0x51, 0x3f, 0x60, 0xcf,
-	0x45, 0xe9, 0x5b, 0xe0, 0x02, 0xc0, 0x80, 0x89, 0x39, 0x33, 0x6e, 0x29, 0x61, 0x99, 0xba, 0xb8,
-	0x20, 0x60, 0xc0, 0x9c, 0x9b, 0x81, 0x39, 0xbb, 0xec, 0xcd, 0x42, 0x52, 0x30, 0x41, 0x9e, 0xd8,
-	0x26, 0xde, 0xfc, 0xf8, 0xa9, 0x22, 0x81, 0xf5, 0x57, 0x50, 0x5b, 0x31, 0x69, 0xf5, 0x52, 0xe3,
-	0x59, 0x1a, 0x1d, 0xf4, 0x27, 0x25, 0xe5, 0x82, 0xd9, 0x94, 0xdb, 0xe5, 0x52, 0xee, 0x98, 0x27,
-	0x07, 0x33, 0xf8, 0xbe, 0xa1, 0x15, 0xf4, 0xbf, 0xcd, 0xc2, 0x95, 0x41, 0x60, 0x7a, 0xa1, 0x29,
-	0x5e, 0x44, 0xbc, 0x28, 0xf0, 0x5d, 0xf6, 0x7d, 0x50, 0xa3, 0xb1, 0x9b, 0xde, 0x9d, 0x0f, 0x64,
-	0x24, 0xbe, 0xc8, 0xfa, 0x78, 0x30, 0x76, 0x69, 0x8f, 0x4a, 0x91, 0x18, 0xb0, 0x8f, 0xa0, 0x30,
-	0xb2, 0xa7, 0x8e, 0x27, 0x5b, 0xcf, 0xf7, 0x2e, 0x0a, 0xee, 0x22, 0xb1, 0x95, 0xe1, 0x82, 0x8b,
-	0x7d, 0x02, 0xc5, 0xb1, 0x3f, 0xc3, 0x6c, 0x23, 0xea, 0x8c, 0xeb, 0xeb, 0x13, 0x21, 0xb5, 0x95,
-	0xe1, 0x92, 0x8f, 0x3d, 0x03, 0x35, 0xf0, 0x5d, 0x77, 0x64, 0x8e, 0xdf, 0xc8, 0x16, 0xb4, 0x7e,
-	0x51, 0x86, 0x4b, 0x7a, 0x2b, 0xc3, 0x13, 0x5e, 0xfd, 0x31, 0x94, 0xa4, 0xb1, 0xb8, 0x01, 0xbb,
-	0xc6, 0x7e, 0x5b, 0xee, 0x5d, 0xb3, 0x77, 0x70, 0xd0, 0xc6, 0xbd, 0xab, 0x82, 0xca, 0x7b, 0x9d,
-	0xce, 0x6e, 0xa3, 0xf9, 0x52, 0xcb, 0xee, 0xaa, 0x50, 0x34, 0xe9, 0xc9, 0x5a, 0xff, 0x73, 0x05,
-	0x36, 0x2f, 0x2c, 0x80, 0x3d, 0x87, 0xfc, 0xcc, 0xb7, 0xe2, 0xed, 0xb9, 0x7f, 0xe9, 0x2a, 0x53,
-	0x30, 0xde, 0x23, 0x4e, 0x12, 0xfa, 0xf7, 0x60, 0x63, 0x15, 0x9f, 0xfa, 0x5b, 0xa1, 0x06, 0x65,
-	0x6e, 0x34, 0xf6, 0x86, 0xbd, 0x6e, 0xe7, 0x73, 0x11, 0x9d, 0x09, 0x7c, 0xc5, 0xdb, 0x03, 0x43,
-	0xcb, 0xea, 0x3f, 0x07, 0xed, 0xe2, 0xc6, 0xb0, 0x7d, 0xd8, 0x1c, 0xfb, 0xb3, 0xb9, 0x6b, 0x53,
-	0xf7, 0x91, 0x3a, 0xb2, 0x3b, 0x97, 0xec, 0xa4, 0x64, 0xa3, 0x13, 0xdb, 0x18, 0xaf, 0xc0, 0xfa,
-	0x1f, 0x03, 0x5b, 0xdf, 0xc1, 0xff, 0x3f, 0xf5, 0xff, 0xaa, 0x40, 0xfe, 0xd0, 0x35, 0x3d, 0x76,
-	0x0f, 0x0a, 0xf4, 0xce, 0x2f, 0x7b, 0xc1, 0x4a, 0xea, 0xde, 0xa1, 0x5b, 0x10, 0x8d, 0x7d, 0x08,
-	0xb9, 0x68, 0xec, 0x4a, 0x1f, 0xba, 0xf1, 0x0e, 0xe7, 0x6b, 0x65, 0x38, 0x72, 0xb1, 0x6d, 0xc8,
-	0x59, 0x96, 0x2b, 0x1d, 0x28, 0xce, 0xbd, 0x66, 0x64, 0xee, 0xd9, 0x13, 0xc7, 0x73, 0xe4, 0xbf,
-	0x0e, 0xc8, 0xc2, 0x1e, 0x40, 0xce, 0x1a, 0xbb, 0xd2, 0x6d, 0xae, 0x2c, 0x39, 0x53, 0x0a, 0xad,
-	0xb1, 0xcb, 0x1e, 0x42, 0xce, 0xa1, 0x07, 0x43, 0x64, 0x63, 0xf1, 0xe3, 0x49, 0x68, 0x07, 0x91,
-	0x78, 0xa5, 0xa2, 0x07, 0x14, 0x2f, 0xdc, 0x2d, 0x02, 0x7d, 0x54, 0xa0, 0xff, 0x4e, 0x81, 0x6a,
-	0x9a, 0xfe, 0xb5, 0x1a, 0xb0, 0x4f, 0xb1, 0xe4, 0x98, 0xbb, 0xce, 0xd8, 0x89, 0x44, 0x33, 0x94,
-	0xbb, 0xa4, 0x19, 0xaa, 0xc6, 0x2c, 0xd4, 0x0e, 0x7d, 0x08, 0xa2, 0xf7, 0x11, 0xfc, 0xf9, 0x4b,
-	0xf8, 0xcb, 0x44, 0x4f, 0x7a, 0xa7, 0x54, 0x6b, 0x54, 0xb8, 0xd8, 0x1a, 0xb1, 0x87, 0xf4, 0x8f,
-	0x2d, 0x3d, 0x95, 0x16, 0xd3, 0xaa, 0x04, 0x92, 0xc7, 0x44, 0xfd, 0xdb, 0x78, 0x65, 0x71, 0x88,
-	0x61, 0x4d, 0x8c, 0x2e, 0x69, 0x79, 0x25, 0x45, 0xff, 0xdf, 0x2c, 0x54, 0x52, 0x5b, 0xcc, 0x9e,
-	0x82, 0x6a, 0xad, 0xc6, 0x96, 0x9b, 0x6b, 0xe7, 0xf0, 0x78, 0x2f, 0x8e, 0x2a, 0x96, 0xbc, 0xb1,
-	0xdf, 0x83, 0x1a, 0xd6, 0x6f, 0x6f, 0xcd, 0xc0, 0xa1, 0xf2, 0x49, 0x7a, 0x86, 0x3c, 0x9b, 0xbe,
-	0x1d, 0x1d, 0xc7, 0x94, 0x56, 0x86, 0x57, 0xc3, 0x14, 0xcc, 0xbe, 0x85, 0x8d, 0xad, 0x3d, 0x37,
-	0x03, 0x5b, 0x7a, 0x48, 0x2d, 0x7e, 0xe2, 0x22, 0x64, 0x2b, 0xc3, 0x63, 0x3a, 0xb2, 0xda, 0x67,
-	0xf6, 0x78, 0x21, 0x93, 0x4b, 0xc2, 0x6a, 0x08, 0x24, 0xb2, 0x4a, 0x3a, 0xdb, 0x01, 0xb0, 0x6c,
-	0xd3, 0x75, 0x7d, 0x4a, 0x45, 0x85, 0x74, 0x49, 0xb9, 0x97, 0xe0, 0xc5, 0xdf, 0xff, 0x31, 0xa4,
-	0x4f, 0xa1, 0x24, 0x17, 0x86, 0x69, 0xbf, 0x6f, 0x0c, 0x86, 0xc7, 0x0d, 0xde, 0xc6, 0xf2, 0xab,
-	0xaf, 0x65, 0x30, 0x28, 0xed, 0xf3, 0x46, 0x57, 0x06, 0x71, 0x6e, 0x1c, 0xf7, 0x5e, 0x1a, 0x5a,
-	0x56, 0x3c, 0x58, 0x74, 0x3f, 0xd7, 0x72, 0xa2, 0xc4, 0x32, 0x0e, 0x1b, 0x1c, 0x63, 0x78, 0x05,
-	0x4a, 0xc6, 0x67, 0x46, 0xf3, 0x68, 0x60, 0x68, 0x05, 0x8c, 0x13, 0x7b, 0x46, 0xa3, 0xd3, 0xe9,
-	0x35, 0x31, 0xc0, 0x17, 0x77, 0xcb, 0x78, 0x92, 0xb4, 0x93, 0xfa, 0x9f, 0x95, 0x61, 0x63, 0xf5,
-	0x2e, 0xb0, 0xef, 0x82, 0x6a, 0x59, 0x2b, 0x27, 0x70, 0xfb, 0xb2, 0x3b, 0xf3, 0x78, 0xcf, 0x8a,
-	0x0f, 0x41, 0x0c, 0xd8, 0xdd, 0xf8, 0xe6, 0x66, 0xd7, 0x6e, 0x6e, 0x7c, 0x6f, 0x7f, 0x04, 0x9b,
-	0xe2, 0x01, 0x94, 0x4a, 0xed, 0x91, 0x19, 0xda, 0xab, 0xd7, 0xb2, 0x49, 0xc4, 0x3d, 0x49, 0x6b,
-	0x65, 0xf8, 0xc6, 0x78, 0x05, 0xc3, 0x7e, 0x00, 0x1b, 0x26, 0xb5, 0x6c, 0x89, 0x7c, 0x3e, 0xfd,
-	0x78, 0xd8, 0x40, 0x5a, 0x4a, 0xbc, 0x66, 0xa6, 0x11, 0xe8, 0x26, 0x56, 0xe0, 0xcf, 0x97, 0xc2,
-	0x2b, 0x57, 0x78, 0x2f, 0xf0, 0xe7, 0x29, 0xd9, 0xaa, 0x95, 0x82, 0xd9, 0x33, 0xa8, 0x4a, 0xcb,
-	0xa9, 0xc9, 0x90, 0xff, 0x5e, 0x5e, 0x49, 0x9b, 0x4d, 0xe5, 0x4d, 0x2b, 0xc3, 0x2b, 0xe3, 0x25,
-	0xc8, 0x9e, 0x40, 0x45, 0x18, 0x2c, 0xc4, 0x4a, 0x69, 0x4f, 0x20, 0x6b, 0x63, 0x29, 0x30, 0x13,
-	0x88, 0x7d, 0x02, 0x40, 0x76, 0x0a, 0x19, 0x35, 0xdd, 0xbe, 0xa0, 0x91, 0xb1, 0x48, 0xd9, 0x8a,
-	0x81, 0x94, 0x79, 0xe2, 0x29, 0xb9, 0xbc, 0x6e, 0x1e, 0xbd, 0x95, 0x2e, 0xcd, 0x23, 0x70, 0x69,
-	0x9e, 0x10, 0x83, 0x35, 0xf3, 0x62, 0x29, 0x61, 0x9e, 0x10, 0x8a, 0xcd, 0x13, 0x32, 0x95, 0x8b,
-	0xe6, 0xc5, 0x22, 0x64, 0x9e, 0x90, 0xf8, 0x01, 0x6c, 0x44, 0xb2, 0x08, 0x93, 0x8b, 0xaa, 0xa6,
-	0x8f, 0x2d, 0x2e, 0xd0, 0xe2, 0x85, 0xd5, 0xa2, 0x34, 0x02, 0xa5, 0xc3, 0x13, 0xff, 0x34, 0x75,
-	0xbd, 0x6b, 0x69, 0xe9, 0xfe, 0x89, 0x7f, 0x9a, 0xbe, 0xdf, 0xb5, 0x30, 0x8d, 0xd0, 0xff, 0x2a,
-	0x07, 0x25, 0xe9, 0xab, 0xec, 0x2a, 0x6c, 0x36, 0xb9, 0xd1, 0x18, 0x18, 0xc3, 0xbd, 0xc6, 0xa0,
-	0xb1, 0xdb, 0xe8, 0x63, 0x56, 0x65, 0xb0, 0xd1, 0xc0, 0x2e, 0x61, 0x89, 0x53, 0xf0, 0x02, 0xee,
-	0xf1, 0xde, 0xe1, 0x12, 0x95, 0x65, 0x1a, 0x54, 0xa5, 0xac, 0xf8, 0x2f, 0x3f, 0xc7, 0x36, 0xa1,
-	0x22, 0x04, 0x05, 0x22, 0x4f, 0x17, 0x0d, 0xa5, 0x04, 0x5c, 0x48, 0x89, 0xb4, 0xbb, 0x7b, 0xc6,
-	0x67, 0x5a, 0x71, 0x29, 0x22, 0x10, 0xa5, 0x44, 0x44, 0xc0, 0x2a, 0x1a, 0x33, 0xe0, 0x47, 0xdd,
-	0xe6, 0x72, 0x9e, 0x32, 0xbb, 0x01, 0x57, 0xfb, 0xad, 0xde, 0xab, 0xa1, 0xd0, 0x95, 0x98, 0x04,
-	0xec, 0x1a, 0x68, 0x29, 0x82, 0x60, 0xaf, 0xa0, 0x0a, 0xc2, 0xc6, 0x8c, 0x7d, 0xad, 0x8a, 0xf3,
-	0x12, 0x6e, 0x20, 0xc2, 0x49, 0x0d, 0x4d, 0x13, 0xa2, 0xbd, 0xce, 0xd1, 0x41, 0xb7, 0xaf, 0x6d,
-	0xa0, 0x25, 0x84, 0x11, 0x96, 0x6c, 0x26, 0x6a, 0x96, 0x41, 0x48, 0xa3, 0xb8, 0x84, 0xb8, 0x57,
-	0x0d, 0xde, 0x6d, 0x77, 0xf7, 0xfb, 0xda, 0x95, 0x44, 0xb3, 0xc1, 0x79, 0x8f, 0xf7, 0x35, 0x96,
-	0x20, 0xfa, 0x83, 0xc6, 0xe0, 0xa8, 0xaf, 0x5d, 0x4d, 0xac, 0x3c, 0xe4, 0xbd, 0xa6, 0xd1, 0xef,
-	0x77, 0xda, 0xfd, 0x81, 0x76, 0x6d, 0xb7, 0x4a, 0x9f, 0x43, 0xc9, 0x60, 0xa2, 0x1f, 0xc2, 0xc6,
-	0xea, 0xdd, 0x67, 0x3a, 0xd4, 0x9c, 0xc9, 0xd0, 0xf3, 0xa3, 0xa1, 0x7d, 0xe6, 0x84, 0x51, 0x18,
-	0xff, 0xe9, 0xeb, 0x4c, 0xba, 0x7e, 0x64, 0x10, 0x0a, 0x6b, 0xe2, 0xe4, 0x2a, 0x8b, 0x74, 0x99,
-	0xc0, 0x7a, 0x0b, 0x6a, 0x2b, 0xd1, 0x80, 0x7d, 0x03, 0xca, 0xce, 0x64, 0x55, 0x99, 0xea, 0x4c,
-	0xbe, 0x82, 0xa6, 0x7d, 0xa8, 0xa6, 0x43, 0xc3, 0xd7, 0x57, 0xf4, 0x37, 0x0a, 0x54, 0x52, 0xa1,
-	0xe2, 0x2b, 0x2d, 0xf1, 0x36, 0x94, 0x23, 0x7b, 0x36, 0xf7, 0x03, 0x53, 0x06, 0x56, 0x95, 0x2f,
-	0x11, 0x2b, 0xb3, 0xe5, 0x56, 0x67, 0x5b, 0x7d, 0x61, 0xc9, 0xff, 0xe1, 0x17, 0x16, 0xbd, 0x07,
-	0xb0, 0x8c, 0x46, 0xf4, 0x0f, 0x06, 0xdd, 0x52, 0xf9, 0xd5, 0x14, 0x01, 0xab, 0x0a, 0xb3, 0x5f,
-	0xa2, 0xf0, 0x67, 0x50, 0x4e, 0x42, 0xd5, 0xd7, 0xde, 0xb1, 0xa5, 0x21, 0xb9, 0x94, 0x21, 0xfa,
-	0x7e, 0xbc, 0x8d, 0x22, 0xb8, 0x7c, 0x95, 0x6d, 0xbc, 0x06, 0x05, 0x11, 0xad, 0xe4, 0x5f, 0xdf,
-	0x04, 0xe8, 0xba, 0x5c, 0xb5, 0xd0, 0x93, 0xf0, 0x28, 0x69, 0x9e, 0x1f, 0x8a, 0x85, 0x08, 0x96,
-	0x3f, 0xb8, 0x90, 0xcb, 0xe7, 0x78, 0x00, 0xb5, 0x95, 0xf0, 0x76, 0xf9, 0xe6, 0xea, 0x6d, 0xa8,
-	0xad, 0xc4, 0xb1, 0xd4, 0xf7, 0x7a, 0x4a, 0xfa, 0x7b, 0x3d, 0xec, 0x26, 0x4f, 0x4f, 0xec, 0xc0,
-	0xbe, 0xe4, 0x93, 0x24, 0x41, 0xd0, 0x7f, 0x00, 0xd5, 0x74, 0xc5, 0xc3, 0xbe, 0x0d, 0x05, 0x27,
-	0xb2, 0x67, 0xf1, 0x5f, 0xf9, 0xd7, 0xd7, 0x8b, 0x22, 0xfa, 0x6b, 0x5a, 0x30, 0xe9, 0xbf, 0x56,
-	0x40, 0xbb, 0x48, 0x4b, 0x7d, 0x54, 0xa8, 0xbc, 0xe3, 0xa3, 0xc2, 0xec, 0x8a, 0x91, 0x97, 0x7c,
-	0x18, 0x88, 0x86, 0x8b, 0xbf, 0xe3, 0x2e, 0xf9, 0xca, 0x8d, 0x08, 0xec, 0x21, 0xa8, 0x81, 0x4d,
-	0xdf, 0x80, 0x59, 0x32, 0x33, 0xaf, 0xfc, 0x09, 0x1c, 0xd3, 0xf4, 0xbf, 0x50, 0xa0, 0x24, 0xcb,
-	0xb3, 0x4b, 0xff, 0xe4, 0xfd, 0x16, 0x94, 0xc4, 0x5f, 0x51, 0xf1, 0x7f, 0x50, 0x6b, 0x4f, 0x7f,
-	0x31, 0x9d, 0xdd, 0x11, 0x25, 0xfa, 0xea, 0x2b, 0x36, 0xf6, 0x21, 0x9c, 0xf0, 0x58, 0x15, 0x53,
-	0xff, 0x4d, 0xe5, 0x50, 0x28, 0xff, 0x5f, 0x03, 0x42, 0x61, 0x42, 0x09, 0xf5, 0x3f, 0x82, 0x92,
-	0x2c, 0xff, 0x2e, 0x35, 0xe5, 0xcb, 0xbe, 0x1f, 0xdb, 0x02, 0x58, 0xd6, 0x83, 0x97, 0x69, 0x78,
-	0x74, 0x17, 0xaa, 0xe9, 0x6f, 0x7a, 0xa8, 0x1b, 0xf4, 0x3d, 0x5b, 0xcb, 0xb0, 0x12, 0xe4, 0x3a,
-	0x5f, 0x3c, 0xd5, 0x94, 0x47, 0x7f, 0x92, 0xfa, 0x02, 0x80, 0x78, 0x4a, 0x90, 0x7b, 0x69, 0x7c,
-	0x2e, 0xde, 0xf3, 0x3a, 0xed, 0xae, 0xd1, 0xe0, 0x43, 0x84, 0x15, 0x14, 0x6e, 0x35, 0xfa, 0x2d,
-	0x2d, 0x8b, 0x51, 0x5a, 0x52, 0x08, 0x91, 0xa3, 0xb7, 0xa1, 0x46, 0x77, 0xdf, 0x10, 0xef, 0x77,
-	0x34, 0x4c, 0x92, 0x43, 0x81, 0xde, 0x90, 0x30, 0x6e, 0x17, 0x31, 0x71, 0xe0, 0x28, 0xa1, 0x95,
-	0x1e, 0xfd, 0x18, 0xea, 0xef, 0x6a, 0xf3, 0x50, 0x6b, 0xb3, 0xd5, 0xa0, 0x56, 0xba, 0x0a, 0x6a,
-	0xb7, 0x37, 0x14, 0x90, 0x82, 0x05, 0x2a, 0x37, 0x3a, 0x06, 0xa5, 0xd6, 0xdd, 0x1f, 0xfd, 0xe3,
-	0x6f, 0xef, 0x28, 0xff, 0xf4, 0xdb, 0x3b, 0xca, 0xbf, 0xff, 0xf6, 0x4e, 0xe6, 0xd7, 0xff, 0x79,
-	0x47, 0xf9, 0x59, 0xfa, 0x3b, 0xec, 0x99, 0x19, 0x05, 0xce, 0x99, 0xf8, 0x50, 0x27, 0x06, 0x3c,
-	0xfb, 0xe3, 0xf9, 0x9b, 0xe9, 0xc7, 0xf3, 0xd1, 0xc7, 0xb8, 0xa3, 0xa3, 0x22, 0x7d, 0x8e, 0xfd,
-	0xe4, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x98, 0x7b, 0x09, 0x98, 0xd1, 0x2d, 0x00, 0x00,
+	// 4955 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x7a, 0xcf, 0x8f, 0x1b, 0x57,
+	0x72, 0xff, 0x34, 0x7f, 0x36, 0x8b, 0xe4, 0xa8, 0xf5, 0x24, 0x4b, 0xb4, 0x56, 0x96, 0x47, 0x6d,
+	0x49, 0x3b, 0x2b, 0xaf, 0x65, 0x6b, 0xa4, 0xd5, 0x6a, 0x17, 0xfb, 0x5d, 0x9b, 0xc3, 0x69, 0xcd,
+	0xd0, 0xe2, 0x34, 0x67, 0x1f, 0x39, 0x23, 0xdb, 0x8b, 0x2f, 0x88, 0x26, 0xbb, 0x87, 0xd3, 0x52,
+	0xb3, 0x9b, 0xee, 0x6e, 0x6a, 0x66, 0x0c, 0x04, 0xd8, 0x43, 0x12, 0x20, 0xa7, 0xe4, 0x10, 0x20,
+	0x39, 0x2e, 0x82, 0x60, 0x4f, 0xb9, 0xe4, 0x4f, 0xc8, 0x2d, 0xa7, 0x20, 0x40, 0x90, 0x43, 0x90,
+	0x4b, 0xe2, 0x1c, 0x93, 0x5b, 0x6e, 0x49, 0x0e, 0x41, 0xd5, 0x7b, 0xdd, 0x6c, 0x0e, 0x29, 0xdb,
+	0x30, 0x72, 0x21, 0x5e, 0x7d, 0xaa, 0x5e, 0xbd, 0xdf, 0xf5, 0x8b, 0x0d, 0x30, 0xf5, 0x2c, 0xff,
+	0xc1, 0x34, 0x0c, 0xe2, 0x80, 0x15, 0xb0, 0x7d, 0xe3, 0x83, 0xb1, 0x1b, 0x9f, 0xcc, 0x86, 0x0f,
+	0x46, 0xc1, 0xe4, 0xc3, 0x71, 0x30, 0x0e, 0x3e, 0x24, 0xe6, 0x70, 0x76, 0x4c, 0x14, 0x11, 0xd4,
+	0x12, 0x9d, 0xf4, 0xbf, 0x53, 0xa0, 0xd0, 0x3f, 0x9f, 0x3a, 0x6c, 0x1d, 0x72, 0xae, 0xdd, 0x50,
+	0x36, 0x94, 0xcd, 0x22, 0xcf, 0xb9, 0x36, 0xbb, 0x01, 0xaa, 0x3f, 0xf3, 0x3c, 0x6b, 0xe8, 0x39,
+	0x8d, 0xdc, 0x86, 0xb2, 0xa9, 0xf2, 0x94, 0x66, 0x57, 0xa1, 0x78, 0xea, 0xda, 0xf1, 0x49, 0x23,
+	0x4f, 0xe2, 0x82, 0x60, 0x37, 0xa1, 0x32, 0x0d, 0x9d, 0x91, 0x1b, 0xb9, 0x81, 0xdf, 0x28, 0x10,
+	0x67, 0x0e, 0x30, 0x06, 0x85, 0xc8, 0xfd, 0xca, 0x69, 0x14, 0x89, 0x41, 0x6d, 0xd4, 0x13, 0x8d,
+	0x2c, 0xcf, 0x69, 0x94, 0x84, 0x1e, 0x22, 0xd8, 0x2d, 0x00, 0xc7, 0x9f, 0x4d, 0x5e, 0x5b, 0xde,
+	0xcc, 0x89, 0x1a, 0xe5, 0x8d, 0xfc, 0x66, 0x85, 0x67, 0x10, 0x1c, 0x67, 0x14, 0x78, 0x9e, 0x15,
+	0xe3, 0x38, 0xaa, 0x18, 0x27, 0x05, 0xf4, 0xbf, 0xc9, 0x43, 0xb1, 0x15, 0xf8, 0x51, 0xcc, 0xae,
+	0x41, 0xc9, 0x8d, 0x70, 0xce, 0xb4, 0x2a, 0x95, 0x4b, 0x8a, 0x5d, 0x85, 0x82, 0xfb, 0xda, 0xf2,
+	0x68, 0x55, 0xf9, 0xbd, 0x35, 0x4e, 0x14, 0xa2, 0x36, 0xa2, 0xb8, 0x24, 0x05, 0x51, 0x5b, 0xa2,
+	0x11, 0xa2, 0xb8, 0x9c, 0x0a, 0xa2, 0x91, 0x44, 0x87, 0x88, 0xe2, 0x5a, 0x54, 0x44, 0x87, 0x12,
+	0x9d, 0x21, 0x8a, 0x8b, 0x29, 0x20, 0x3a, 0x93, 0xe8, 0x31, 0xa2, 0xe5, 0x0d, 0x65, 0x33, 0x87,
+	0x28, 0x52, 0xec, 0x06, 0x94, 0x6d, 0x2b, 0x76, 0x90, 0x41, 0x2b, 0xd8, 0x5b, 0xe3, 0x09, 0xc0,
+	0x74, 0xa8, 0x62, 0x33, 0x76, 0x27, 0xc4, 0xaf, 0xc8, 0x69, 0x66, 0x41, 0xf6, 0x13, 0xa8, 0xd9,
+	0xce, 0xc8, 0x9d, 0x58, 0xde, 0x93, 0xc7, 0x28, 0x04, 0x1b, 0xca, 0x66, 0x75, 0xeb, 0xd2, 0x03,
+	0xba, 0x0e, 0x29, 0x67, 0x6f, 0x8d, 0x2f, 0x88, 0xb1, 0xa7, 0x50, 0x97, 0xf4, 0xc3, 0xad, 0xa7,
+	0xd8, 0xaf, 0x4a, 0xfd, 0xb4, 0x85, 0x7e, 0x0f, 0xb7, 0x9e, 0xee, 0xad, 0xf1, 0x45, 0x41, 0x76,
+	0x07, 0x6a, 0x38, 0x76, 0x14, 0x5b, 0x93, 0x29, 0x76, 0xac, 0xc9, 0x59, 0x2d, 0xa0, 0xb8, 0xac,
+	0x97, 0x51, 0xe0, 0xa3, 0x40, 0x5d, 0xee, 0x58, 0x02, 0xb0, 0x0d, 0x00, 0xdb, 0x39, 0xb6, 0x66,
+	0x5e, 0x8c, 0xec, 0x75, 0xb9, 0x75, 0x19, 0x6c, 0xbb, 0x0c, 0x45, 0x3a, 0x62, 0xfd, 0x26, 0xa8,
+	0x07, 0x56, 0x68, 0x4d, 0xb8, 0x73, 0xcc, 0x34, 0xc8, 0x4f, 0x83, 0x48, 0x5e, 0x4c, 0x6c, 0xea,
+	0x1d, 0x28, 0x1d, 0x59, 0x21, 0xf2, 0x18, 0x14, 0x7c, 0x6b, 0xe2, 0x10, 0xb3, 0xc2, 0xa9, 0x8d,
+	0xa7, 0x1e, 0x9d, 0x47, 0xb1, 0x33, 0x91, 0xb7, 0x56, 0x52, 0x88, 0x8f, 0xbd, 0x60, 0x28, 0x4f,
+	0x58, 0xe5, 0x92, 0xd2, 0x4d, 0x28, 0xb5, 0x02, 0x0f, 0xb5, 0x5d, 0x87, 0x72, 0xe8, 0x78, 0x83,
+	0xf9, 0x68, 0xa5, 0xd0, 0xf1, 0x0e, 0x82, 0x08, 0x19, 0xa3, 0x40, 0x30, 0x72, 0x82, 0x31, 0x0a,
+	0x88, 0x91, 0x8c, 0x9f, 0x9f, 0x8f, 0xaf, 0xf7, 0x01, 0x5a, 0x41, 0x18, 0x7e, 0x6f, 0x9d, 0x57,
+	0xa1, 0x68, 0x3b, 0xd3, 0xf9, 0xdb, 0x22, 0x42, 0xbf, 0x0f, 0xaa, 0x71, 0x36, 0x0d, 0x3b, 0x6e,
+	0x14, 0xb3, 0x5b, 0x50, 0xf0, 0xdc, 0x28, 0x6e, 0x28, 0x1b, 0xf9, 0xcd, 0xea, 0x16, 0x88, 0xb3,
+	0x43, 0x2e, 0x27, 0x5c, 0xdf, 0x00, 0x75, 0xdf, 0x3a, 0x3b, 0xc2, 0x9d, 0x64, 0x57, 0xe5, 0x96,
+	0xca, 0x2d, 0x92, 0xfb, 0x7b, 0x1f, 0xa0, 0x6f, 0x85, 0x63, 0x27, 0xa6, 0x97, 0x7f, 0x13, 0xf2,
+	0xf1, 0xf9, 0x94, 0x24, 0x52, 0x75, 0xc8, 0xe0, 0x08, 0xeb, 0xff, 0xa9, 0x40, 0xb5, 0x37, 0x1b,
+	0x7e, 0x39, 0x73, 0xc2, 0x73, 0x5c, 0xd1, 0xe6, 0x5c, 0x7a, 0x7d, 0xeb, 0x9a, 0x90, 0xce, 0xf0,
+	0xe7, 0x3d, 0x71, 0x89, 0x7e, 0x60, 0x3b, 0x03, 0xd7, 0x4e, 0x96, 0x88, 0x64, 0xdb, 0x46, 0x53,
+	0x13, 0x4c, 0xe5, 0xa6, 0xe5, 0x82, 0x29, 0xdb, 0x80, 0xe2, 0xe8, 0xc4, 0xf5, 0xec, 0x46, 0x21,
+	0x3b, 0x05, 0x5a, 0x91, 0x60, 0xb0, 0xb7, 0x41, 0x0d, 0x83, 0xd3, 0x41, 0xc6, 0x80, 0x94, 0xc3,
+	0xe0, 0xb4, 0xe7, 0x7e, 0x85, 0xfb, 0x2d, 0xec, 0x17, 0x40, 0xa9, 0xd7, 0x6a, 0x76, 0x9a, 0x5c,
+	0x5b, 0xc3, 0xb6, 0xf1, 0x59, 0xbb, 0xd7, 0xef, 0x69, 0x0a, 0x5b, 0x07, 0x30, 0xbb, 0xfd, 0x81,
+	0xa4, 0x73, 0xac, 0x04, 0xb9, 0xb6, 0xa9, 0xe5, 0x51, 0x06, 0xf1, 0xb6, 0xa9, 0x15, 0x58, 0x19,
+	0xf2, 0x4d, 0xf3, 0x73, 0xad, 0x48, 0x8d, 0x4e, 0x47, 0x2b, 0xe9, 0xff, 0xa0, 0x40, 0xa5, 0x3b,
+	0x7c, 0xe9, 0x8c, 0x62, 0x5c, 0x33, 0xde, 0x29, 0x27, 0x7c, 0xed, 0x84, 0xb4, 0xec, 0x3c, 0x97,
+	0x14, 0x2e, 0xc4, 0x1e, 0x0a, 0x3b, 0xc2, 0x73, 0xf6, 0x90, 0xe4, 0x46, 0x27, 0xce, 0xc4, 0x6a,
+	0xe4, 0xa5, 0x1c, 0x51, 0x78, 0x87, 0x83, 0xe1, 0x4b, 0x5a, 0x5e, 0x9e, 0x63, 0x93, 0xbd, 0x0b,
+	0x55, 0xa1, 0x63, 0x40, 0x17, 0xa8, 0x48, 0x7b, 0x01, 0x02, 0x32, 0xf1, 0x1a, 0x5f, 0x87, 0xb2,
+	0x3d, 0x14, 0xcc, 0x12, 0x31, 0x4b, 0xf6, 0x90, 0x18, 0xd8, 0x93, 0xb4, 0x0a, 0x66, 0x59, 0xf6,
+	0x24, 0x88, 0x04, 0xde, 0x06, 0x35, 0x18, 0xbe, 0x14, 0x5c, 0x95, 0xb8, 0xe5, 0x60, 0xf8, 0x12,
+	0x59, 0xfa, 0xbf, 0x2a, 0xa0, 0x3e, 0x9b, 0xf9, 0x23, 0x34, 0x94, 0xec, 0x3d, 0x28, 0x1c, 0xcf,
+	0xfc, 0x51, 0x43, 0xc9, 0x9a, 0x8e, 0x74, 0xcd, 0x9c, 0x98, 0x78, 0xd7, 0xac, 0x70, 0x8c, 0x77,
+	0x74, 0xe9, 0xae, 0x21, 0xae, 0xff, 0xb1, 0xd4, 0xf8, 0xcc, 0xb3, 0xc6, 0x4c, 0x85, 0x82, 0xd9,
+	0x35, 0x0d, 0x6d, 0x8d, 0xd5, 0x40, 0x6d, 0x9b, 0x7d, 0x83, 0x9b, 0xcd, 0x8e, 0xa6, 0xd0, 0xd1,
+	0xf4, 0x9b, 0xdb, 0x1d, 0x43, 0xcb, 0x21, 0xe7, 0xa8, 0xdb, 0x69, 0xf6, 0xdb, 0x1d, 0x43, 0x2b,
+	0x08, 0x0e, 0x6f, 0xb7, 0xfa, 0x9a, 0xca, 0x34, 0xa8, 0x1d, 0xf0, 0xee, 0xce, 0x61, 0xcb, 0x18,
+	0x98, 0x87, 0x9d, 0x8e, 0xa6, 0xb1, 0x2b, 0x70, 0x29, 0x45, 0xba, 0x02, 0xdc, 0xc0, 0x2e, 0x47,
+	0x4d, 0xde, 0xe4, 0xbb, 0xda, 0x27, 0x4c, 0x85, 0x7c, 0x73, 0x77, 0x57, 0xfb, 0x8d, 0x82, 0xad,
+	0x17, 0x6d, 0x53, 0xfb, 0x4d, 0x4e, 0xff, 0xfd, 0x3c, 0x14, 0x70, 0x82, 0xdf, 0x7c, 0xad, 0xd9,
+	0x0f, 0x40, 0x19, 0xd1, 0xc9, 0x55, 0xb7, 0xaa, 0x82, 0x47, 0x4e, 0x63, 0x6f, 0x8d, 0x2b, 0xb8,
+	0x6a, 0x45, 0xdc, 0xcf, 0xea, 0xd6, 0xba, 0x60, 0x26, 0xe6, 0x08, 0xf9, 0x53, 0x76, 0x13, 0x94,
+	0xd7, 0xf2, 0xb2, 0xd6, 0x04, 0x5f, 0x18, 0x24, 0xe4, 0xbe, 0x66, 0x1b, 0x90, 0x1f, 0x05, 0xc2,
+	0x39, 0xa4, 0x7c, 0x61, 0x0e, 0xf6, 0xd6, 0x38, 0xb2, 0x50, 0xff, 0x71, 0xa3, 0x94, 0xd5, 0x9f,
+	0x9c, 0x0a, 0x6a, 0x38, 0x66, 0x77, 0x21, 0x1f, 0xcd, 0x86, 0x74, 0xb6, 0xd5, 0xad, 0xcb, 0x4b,
+	0x6f, 0x0c, 0xd5, 0x44, 0xb3, 0x21, 0xbb, 0x07, 0x85, 0x51, 0x10, 0x86, 0x0d, 0x35, 0x6b, 0xc4,
+	0xe7, 0xc6, 0x07, 0x9d, 0x0d, 0xf2, 0xd9, 0x06, 0x28, 0x71, 0xa3, 0x92, 0x15, 0x9a, 0xbf, 0x7e,
+	0x1c, 0x30, 0x66, 0x77, 0xa4, 0x49, 0x81, 0xec, 0x9c, 0x12, 0x83, 0x83, 0x7a, 0x90, 0xcb, 0x74,
+	0xc8, 0x4f, 0xac, 0xb3, 0x46, 0x35, 0x2b, 0x94, 0x58, 0x1a, 0x9c, 0xd3, 0xc4, 0x3a, 0xdb, 0x2e,
+	0x41, 0xc1, 0x39, 0x9b, 0x86, 0xfa, 0xdb, 0x50, 0x49, 0x3d, 0x0f, 0xab, 0x81, 0x62, 0xc9, 0xa7,
+	0xa3, 0x58, 0xfa, 0x26, 0x80, 0x64, 0x3d, 0xdc, 0x7a, 0xba, 0xc8, 0x43, 0x2a, 0x79, 0x50, 0xca,
+	0x50, 0xff, 0xab, 0x1c, 0x19, 0xe7, 0x9d, 0x37, 0x98, 0xfa, 0x3b, 0x90, 0xb7, 0xbc, 0x31, 0x89,
+	0xaf, 0x6f, 0xb1, 0x64, 0xf9, 0x93, 0x69, 0xe8, 0x44, 0x91, 0x38, 0x69, 0xcb, 0x1b, 0x27, 0xf7,
+	0x20, 0xbf, 0xfa, 0x1e, 0xfc, 0x10, 0xca, 0xd2, 0x03, 0xc9, 0x03, 0xad, 0x0b, 0x89, 0x1d, 0x01,
+	0xf2, 0x84, 0xcb, 0x1a, 0x50, 0x9e, 0x86, 0xee, 0xc4, 0x0a, 0xcf, 0x85, 0xdb, 0xe7, 0x09, 0xc9,
+	0xee, 0xc2, 0xba, 0x35, 0x8b, 0x83, 0x81, 0xeb, 0x8f, 0x42, 0x67, 0xe2, 0xf8, 0x31, 0x1d, 0xad,
+	0xca, 0xeb, 0x88, 0xb6, 0x13, 0x10, 0x4d, 0xf1, 0xf4, 0x95, 0x6b, 0x9f, 0xd1, 0xb1, 0x16, 0xb9,
+	0x20, 0x50, 0xed, 0x28, 0x98, 0x50, 0x2f, 0xf9, 0x58, 0x25, 0xc9, 0x3e, 0x82, 0xca, 0xd8, 0xf1,
+	0x9d, 0xd0, 0x8a, 0x1d, 0x5b, 0x9e, 0x9e, 0x5c, 0xe3, 0x6e, 0x02, 0xe3, 0x39, 0xcf, 0x85, 0xf4,
+	0x2f, 0xa1, 0x2c, 0xa7, 0xcd, 0x6e, 0x43, 0x0d, 0x63, 0x9d, 0x81, 0x35, 0x74, 0x3d, 0x37, 0x3e,
+	0x97, 0x11, 0x50, 0x15, 0xb1, 0xa6, 0x80, 0xd8, 0x2d, 0x71, 0x52, 0x8d, 0x5c, 0x76, 0x63, 0xc4,
+	0xd3, 0x46, 0x9c, 0xbd, 0x07, 0xf5, 0x20, 0x74, 0xc7, 0xae, 0x3f, 0x88, 0xe2, 0xd0, 0xf5, 0xc7,
+	0xd2, 0x60, 0xd7, 0x04, 0xd8, 0x23, 0x4c, 0x7f, 0x05, 0xb5, 0xec, 0x6c, 0x52, 0xa5, 0xca, 0x77,
+	0x55, 0x9a, 0x5b, 0x56, 0x4a, 0x66, 0x34, 0x0e, 0x42, 0xc7, 0x4e, 0x5c, 0xb5, 0xa0, 0xf4, 0x3f,
+	0x53, 0x40, 0x6d, 0xfb, 0xb6, 0x73, 0x86, 0x17, 0xe2, 0x7e, 0xd6, 0x0f, 0x35, 0xc4, 0x40, 0x09,
+	0x53, 0x34, 0xe6, 0x87, 0x9c, 0x5c, 0x9e, 0x5c, 0xe6, 0xf2, 0xfc, 0x80, 0xa2, 0x48, 0x32, 0x93,
+	0x51, 0x23, 0x4f, 0x41, 0xa6, 0x3a, 0x0a, 0x3c, 0xb4, 0x93, 0x91, 0xfe, 0x00, 0x2a, 0xa9, 0x0a,
+	0x56, 0x85, 0x72, 0xdb, 0x3c, 0x6a, 0xb6, 0x3b, 0x3b, 0xda, 0x1a, 0x12, 0x5f, 0x74, 0x4d, 0x63,
+	0xbf, 0x79, 0xa0, 0x29, 0xe8, 0x2e, 0xb6, 0x7b, 0x6d, 0x2d, 0xa7, 0xdf, 0x85, 0xfa, 0x81, 0xb8,
+	0x0d, 0xcf, 0x9d, 0x73, 0x9c, 0xdd, 0x55, 0x28, 0x0a, 0xcd, 0x0a, 0x69, 0x16, 0x84, 0xbe, 0x05,
+	0xea, 0x41, 0x18, 0x4c, 0x9d, 0x30, 0x3e, 0x47, 0x9f, 0xf0, 0xca, 0x39, 0x97, 0xf7, 0x19, 0x9b,
+	0x73, 0x5f, 0x9d, 0xcb, 0xfa, 0xea, 0x8f, 0xa1, 0x2e, 0xfb, 0xb8, 0x4e, 0x84, 0xaa, 0x1f, 0x00,
+	0x4c, 0x53, 0x40, 0x06, 0x01, 0x89, 0x95, 0x92, 0xca, 0x79, 0x46, 0x42, 0xff, 0xef, 0x1c, 0xd4,
+	0x0f, 0xac, 0x30, 0x76, 0xd1, 0xbe, 0xb4, 0xfd, 0xe3, 0x80, 0xfd, 0x10, 0x0a, 0xf1, 0xf9, 0xd4,
+	0x91, 0x7b, 0x77, 0x25, 0xb5, 0x70, 0x42, 0x84, 0xb6, 0x8d, 0x04, 0xf0, 0x34, 0x8d, 0x37, 0x5c,
+	0x11, 0xfc, 0x65, 0x1f, 0xc1, 0x95, 0x69, 0xd2, 0x0d, 0x01, 0x27, 0xa2, 0xd8, 0x5f, 0x5c, 0x94,
+	0x55, 0x2c, 0x76, 0x07, 0xca, 0xad, 0xc0, 0x9b, 0x4d, 0xfc, 0xa8, 0x51, 0x58, 0x72, 0x29, 0x09,
+	0x8b, 0xdd, 0x07, 0x2d, 0xed, 0x9c, 0x88, 0x17, 0x69, 0x23, 0x97, 0x70, 0xa6, 0x43, 0x2d, 0xc5,
+	0xcc, 0xd9, 0x44, 0x44, 0xdf, 0x7c, 0x01, 0x63, 0x8f, 0x00, 0x52, 0x5a, 0x64, 0x14, 0xd5, 0xa5,
+	0x65, 0xb7, 0x63, 0x67, 0xc2, 0x33, 0x62, 0x98, 0x66, 0x58, 0xde, 0x38, 0x08, 0xdd, 0xf8, 0x64,
+	0x42, 0x6f, 0x33, 0xcf, 0xe7, 0x00, 0xbb, 0x07, 0xeb, 0x6e, 0xd4, 0x9b, 0x0d, 0xd3, 0xfe, 0xf4,
+	0x44, 0x55, 0x7e, 0x01, 0xd5, 0xff, 0x5d, 0xc9, 0xee, 0x3e, 0x06, 0xa2, 0x77, 0xa0, 0x3e, 0x9f,
+	0xdc, 0xdc, 0xa4, 0x2d, 0x82, 0x6c, 0x13, 0x2e, 0x05, 0xa1, 0xed, 0xfa, 0x16, 0x06, 0x85, 0x62,
+	0x00, 0x3c, 0x85, 0x3a, 0xbf, 0x08, 0xb3, 0x0d, 0xa8, 0xda, 0x4e, 0x34, 0x0a, 0xdd, 0x69, 0x3c,
+	0xdf, 0xfc, 0x2c, 0x94, 0xb5, 0x31, 0x85, 0x45, 0x1b, 0x73, 0x0f, 0x54, 0x0f, 0x8d, 0xe5, 0x89,
+	0xe5, 0x37, 0x8a, 0x4b, 0xe7, 0x91, 0xf2, 0x50, 0xce, 0xf5, 0x8f, 0x44, 0x42, 0x56, 0x5a, 0x96,
+	0x4b, 0x78, 0xfa, 0x3b, 0x50, 0x3e, 0x72, 0x9d, 0x53, 0x69, 0xb0, 0x5f, 0xbb, 0xce, 0x69, 0x62,
+	0xb0, 0xb1, 0xad, 0xff, 0x65, 0x01, 0xd4, 0x3e, 0x66, 0x90, 0x6f, 0xb2, 0xe8, 0x1b, 0xe8, 0xd1,
+	0xbc, 0x24, 0xdc, 0x98, 0xfb, 0xce, 0x1d, 0x0c, 0x48, 0x90, 0xc3, 0xee, 0x43, 0xc1, 0x76, 0x8e,
+	0xc5, 0x8b, 0xad, 0x26, 0xf1, 0x67, 0xa2, 0x13, 0xad, 0xb6, 0xb8, 0xbe, 0x28, 0xc3, 0xde, 0x01,
+	0x88, 0x91, 0x33, 0xa0, 0xdb, 0x2e, 0x96, 0x5e, 0x21, 0x44, 0xc6, 0xbd, 0x95, 0x51, 0xe8, 0x58,
+	0xb1, 0x13, 0x7d, 0xe9, 0xc9, 0x08, 0x6c, 0x0e, 0xb0, 0x3d, 0x58, 0xc7, 0x29, 0x6d, 0xa1, 0x91,
+	0x70, 0xd1, 0x16, 0xc8, 0x85, 0xdf, 0xbe, 0x30, 0xa4, 0x29, 0x85, 0xc8, 0x5e, 0x18, 0x7e, 0x1c,
+	0x9e, 0xf3, 0xba, 0x9f, 0xc5, 0x6e, 0xfc, 0x87, 0x42, 0x76, 0x99, 0xc6, 0xbc, 0x0b, 0xb9, 0xe9,
+	0x2b, 0x69, 0x1d, 0x93, 0x1b, 0x98, 0x35, 0x1c, 0x7b, 0x6b, 0x3c, 0x37, 0x7d, 0x85, 0x9e, 0x16,
+	0x3d, 0x45, 0x2e, 0xeb, 0x69, 0x13, 0xe3, 0x86, 0x9e, 0x16, 0x3d, 0xc7, 0x4f, 0x16, 0xec, 0x40,
+	0x7e, 0x51, 0x65, 0xc6, 0x60, 0x60, 0x92, 0x35, 0x17, 0xc4, 0xb0, 0x8f, 0xce, 0x65, 0xc1, 0xdb,
+	0xc9, 0x43, 0x43, 0x4f, 0x8f, 0x4c, 0xf6, 0x08, 0x2a, 0xe9, 0x75, 0x6c, 0x14, 0x17, 0x54, 0x67,
+	0x2d, 0xc9, 0xde, 0x1a, 0x9f, 0xcb, 0x6d, 0x17, 0x21, 0x6f, 0x3b, 0xc7, 0x37, 0x3e, 0x01, 0xb6,
+	0xbc, 0x27, 0xdf, 0x66, 0xee, 0x8a, 0xd2, 0xdc, 0xfd, 0x3c, 0xf7, 0x54, 0xd1, 0x43, 0x28, 0xb4,
+	0x82, 0x28, 0xc6, 0x1b, 0x32, 0xb2, 0x42, 0x51, 0x94, 0x50, 0x38, 0xb5, 0xf1, 0x2e, 0x87, 0xc1,
+	0x29, 0x25, 0x02, 0x39, 0x82, 0x13, 0x12, 0x47, 0xf0, 0xed, 0xd7, 0x22, 0x7f, 0xe7, 0xd8, 0xc4,
+	0x11, 0xa2, 0xd8, 0x0a, 0xc5, 0xad, 0x57, 0xb8, 0x20, 0x10, 0x8d, 0x83, 0x58, 0x66, 0xef, 0x0a,
+	0x17, 0x84, 0xfe, 0xd7, 0x0a, 0x59, 0xa6, 0x1d, 0x2b, 0xb6, 0xd0, 0x35, 0x60, 0xb6, 0x31, 0x0a,
+	0x66, 0x7e, 0x2c, 0xd3, 0x36, 0x4c, 0x3f, 0x5a, 0x48, 0xe3, 0xa5, 0x22, 0xcf, 0x2a, 0xb8, 0x62,
+	0xee, 0x15, 0x44, 0x04, 0x1b, 0x0d, 0xff, 0xcc, 0xf3, 0xc4, 0x05, 0x55, 0xb9, 0x20, 0x70, 0x6e,
+	0xee, 0xa3, 0x2d, 0x32, 0x79, 0x45, 0x8e, 0x4d, 0x42, 0x9e, 0x3c, 0xa6, 0x47, 0x97, 0xe7, 0xd8,
+	0x44, 0xe4, 0xf8, 0xd1, 0x16, 0xdd, 0xb2, 0x1c, 0xc7, 0x26, 0x21, 0x4f, 0x1e, 0x93, 0xbd, 0x52,
+	0x38, 0x36, 0x31, 0x3c, 0x8a, 0x1a, 0x2a, 0x59, 0x42, 0x25, 0xd2, 0x5f, 0x00, 0xf0, 0xe0, 0x34,
+	0x72, 0x62, 0x9a, 0xf5, 0xbd, 0x34, 0xf9, 0x50, 0xb2, 0xd7, 0x26, 0xb9, 0xa8, 0x69, 0x32, 0x72,
+	0x7b, 0xe1, 0x8d, 0xd5, 0xe7, 0x6f, 0xcc, 0x8a, 0x2d, 0xf1, 0xc8, 0xf4, 0x7f, 0x56, 0xa0, 0xda,
+	0x0d, 0x6d, 0x27, 0xdc, 0x3e, 0xef, 0x4d, 0x9d, 0xd1, 0xb7, 0x7a, 0xf5, 0x85, 0x8a, 0x4c, 0x4e,
+	0xbe, 0xa4, 0x04, 0x60, 0x0f, 0xa1, 0x70, 0xec, 0x59, 0x22, 0x7e, 0x58, 0xdf, 0x7a, 0x47, 0x26,
+	0x1a, 0x73, 0xf5, 0x49, 0x1b, 0x73, 0x08, 0x4e, 0xa2, 0xfa, 0xaf, 0xa1, 0x9a, 0x01, 0x29, 0x2d,
+	0xeb, 0xb5, 0xb4, 0x35, 0xcc, 0x30, 0x76, 0x8c, 0x5e, 0x4b, 0x53, 0xd8, 0x25, 0xa8, 0x62, 0x42,
+	0xd0, 0x1b, 0x3c, 0x6b, 0xf3, 0x5e, 0x5f, 0xcb, 0x51, 0x9e, 0x47, 0x40, 0xa7, 0xd9, 0xeb, 0x8b,
+	0xd4, 0xe2, 0xd0, 0x6c, 0xff, 0xea, 0xd0, 0xd0, 0xd4, 0x85, 0x74, 0x44, 0xc3, 0x9c, 0x05, 0x5e,
+	0xb8, 0xbe, 0x1d, 0x9c, 0xd2, 0xe2, 0x3e, 0xc8, 0x38, 0x90, 0xc1, 0xf0, 0x7c, 0x45, 0x5a, 0x5d,
+	0x9d, 0xdf, 0xf1, 0x73, 0xf6, 0x63, 0x50, 0x03, 0x9c, 0x1a, 0x8a, 0x8a, 0x2d, 0xbc, 0xbc, 0xb4,
+	0x22, 0x5e, 0x0e, 0x04, 0x81, 0x57, 0xd8, 0x73, 0x2c, 0x5b, 0x26, 0xf3, 0xd4, 0xc6, 0x63, 0xc5,
+	0xed, 0x10, 0x15, 0x32, 0x6c, 0xea, 0xbf, 0xcb, 0x41, 0xe5, 0x70, 0x8a, 0xf5, 0x9d, 0x56, 0x7c,
+	0x96, 0x4d, 0xfd, 0x94, 0x85, 0xd4, 0xef, 0x6d, 0x50, 0xe3, 0xa1, 0x08, 0x59, 0xe4, 0x2e, 0x97,
+	0xe3, 0xa1, 0x97, 0xa4, 0x8b, 0xd3, 0xd0, 0x1d, 0xe0, 0x13, 0x13, 0x0e, 0xa0, 0x34, 0x0d, 0xdd,
+	0xe7, 0x0e, 0x46, 0x79, 0x55, 0xc9, 0x18, 0xa0, 0x45, 0x49, 0xcb, 0x72, 0xc8, 0x6c, 0xdb, 0x67,
+	0xa8, 0xf3, 0xc4, 0xb5, 0x1d, 0xea, 0x29, 0x6c, 0x60, 0x19, 0x69, 0xec, 0xba, 0x01, 0xb5, 0x84,
+	0x45, 0x7d, 0x45, 0x91, 0x0e, 0x24, 0x1b, 0x3b, 0x7f, 0x00, 0xd5, 0x19, 0x4d, 0x7b, 0x40, 0x37,
+	0xaa, 0xbc, 0xc2, 0x6a, 0x83, 0x10, 0x68, 0xa1, 0xed, 0x7e, 0x17, 0xaa, 0x41, 0x7c, 0xe2, 0x84,
+	0x03, 0x2b, 0x8e, 0xc3, 0xe4, 0x1e, 0x03, 0x41, 0x4d, 0x44, 0x48, 0x20, 0xb4, 0x53, 0x81, 0x8a,
+	0x14, 0x08, 0x6d, 0x29, 0x80, 0x69, 0x79, 0xb5, 0xe9, 0x5b, 0xde, 0xf9, 0x57, 0x0e, 0x45, 0x32,
+	0xef, 0x00, 0xb8, 0xfe, 0x74, 0x16, 0x0f, 0xd0, 0x08, 0xc8, 0x2c, 0xa2, 0x42, 0x08, 0x3e, 0x0c,
+	0xd2, 0x37, 0x8b, 0x53, 0xbe, 0xc8, 0x2b, 0x40, 0x40, 0x24, 0x90, 0xf6, 0x27, 0x83, 0x92, 0xcf,
+	0xf4, 0xc7, 0xda, 0x42, 0xa6, 0x3f, 0xf1, 0x0b, 0xd9, 0xfe, 0x24, 0xf0, 0x1e, 0xd4, 0xb1, 0xfe,
+	0x35, 0x18, 0x05, 0x7e, 0x34, 0x9b, 0x38, 0x36, 0x6d, 0x61, 0x5e, 0x14, 0xc5, 0x5a, 0x12, 0x43,
+	0x2d, 0x13, 0x67, 0x12, 0x84, 0xe7, 0x42, 0x4b, 0x49, 0x68, 0x11, 0x10, 0x95, 0x30, 0xfe, 0xab,
+	0x06, 0x05, 0x33, 0xb0, 0x1d, 0x0c, 0xf9, 0xa9, 0x62, 0xb2, 0x1c, 0x9d, 0x21, 0x9b, 0x7e, 0xc8,
+	0xbd, 0xa9, 0xbe, 0x6c, 0xbd, 0xb9, 0xc6, 0x72, 0x0b, 0x5f, 0x79, 0x14, 0x2f, 0xa6, 0x3d, 0x68,
+	0x55, 0x39, 0xe1, 0x74, 0xeb, 0xc3, 0x00, 0x93, 0xfd, 0x01, 0x65, 0x7e, 0x85, 0x15, 0xb7, 0x5e,
+	0xf0, 0xa9, 0xe6, 0x74, 0x03, 0x54, 0xaa, 0xc4, 0x84, 0x8e, 0x08, 0x14, 0x8a, 0x3c, 0xa5, 0x71,
+	0xd6, 0x2f, 0x03, 0xd7, 0x17, 0xb3, 0x2e, 0x2d, 0xcd, 0xfa, 0xd3, 0xc0, 0xf5, 0xe9, 0x69, 0xab,
+	0x28, 0x45, 0xb3, 0x7e, 0x0f, 0xca, 0x81, 0x2f, 0xc6, 0x2d, 0x2f, 0x8d, 0x5b, 0x0a, 0x7c, 0x1a,
+	0xf2, 0x7d, 0xa8, 0x1e, 0xbb, 0x5e, 0xec, 0x84, 0x42, 0x50, 0x5d, 0x12, 0x04, 0xc1, 0x26, 0xe1,
+	0xbb, 0xa0, 0x8e, 0xc3, 0x60, 0x36, 0xc5, 0x57, 0x59, 0x59, 0x0e, 0x2c, 0x89, 0xb7, 0x7d, 0x8e,
+	0xab, 0xa6, 0xa6, 0xeb, 0x8f, 0x07, 0x91, 0x83, 0xf9, 0xee, 0xd2, 0xaa, 0x13, 0x7e, 0xcf, 0x21,
+	0xad, 0xd6, 0x78, 0x2c, 0xc6, 0xaf, 0x2e, 0x6b, 0xb5, 0xc6, 0x63, 0x1a, 0x3c, 0x6b, 0x12, 0x6a,
+	0xdf, 0x6a, 0x12, 0x3e, 0x9a, 0x3f, 0x9a, 0xf8, 0x2c, 0x6a, 0xd4, 0x37, 0xf2, 0xf3, 0xf2, 0x4b,
+	0x6a, 0x04, 0xd2, 0x77, 0x13, 0x9f, 0x45, 0xec, 0x7d, 0x50, 0x4f, 0x31, 0x63, 0x9a, 0x3a, 0xa3,
+	0xc6, 0x7a, 0x36, 0x8d, 0x9f, 0x5b, 0x31, 0x5e, 0x3e, 0x75, 0x7d, 0x6c, 0x60, 0x31, 0xcd, 0x73,
+	0x27, 0x6e, 0xdc, 0xb8, 0xb4, 0x5c, 0x4c, 0x23, 0x06, 0xd3, 0xa1, 0x14, 0x1c, 0x1f, 0xe3, 0xf2,
+	0xb5, 0x25, 0x11, 0xc9, 0x61, 0xef, 0x83, 0x08, 0x94, 0x06, 0xb6, 0x73, 0xdc, 0xb8, 0xbc, 0xd2,
+	0x9f, 0xa8, 0xb1, 0x6c, 0xb1, 0x2d, 0xa8, 0xa7, 0xc2, 0x83, 0xd7, 0xce, 0xa8, 0xc1, 0x36, 0xf2,
+	0x2b, 0x3a, 0x54, 0x93, 0x0e, 0x47, 0xce, 0x88, 0x6d, 0x02, 0x56, 0xa5, 0x06, 0xa1, 0x73, 0xdc,
+	0xb8, 0xb2, 0xba, 0x00, 0x55, 0x0a, 0x86, 0x2f, 0xb1, 0xf8, 0xf6, 0x10, 0xaa, 0x21, 0x79, 0xb9,
+	0x81, 0x6d, 0xc5, 0x56, 0xe3, 0x6a, 0x76, 0x03, 0xe6, 0xee, 0x8f, 0x43, 0x98, 0xb6, 0xf1, 0x59,
+	0x3a, 0x67, 0x71, 0x68, 0x0d, 0x82, 0xa9, 0x08, 0xf9, 0xdf, 0x12, 0x59, 0x26, 0x81, 0x5d, 0x81,
+	0xb1, 0x5f, 0xc2, 0x25, 0xdb, 0xf1, 0x9c, 0xd8, 0xa1, 0x09, 0x46, 0xad, 0xf8, 0xac, 0x71, 0x8d,
+	0xe6, 0x7d, 0x35, 0xa9, 0x00, 0xa4, 0x4c, 0x3c, 0x90, 0x8b, 0xc2, 0x98, 0x62, 0x0f, 0x5d, 0xdf,
+	0xc6, 0xab, 0x14, 0x5b, 0xe3, 0xa8, 0x71, 0x9d, 0x9e, 0x45, 0x55, 0x62, 0x7d, 0x6b, 0x1c, 0xb1,
+	0xc7, 0x50, 0xb3, 0x84, 0xb5, 0x1a, 0xb8, 0xfe, 0x71, 0xd0, 0x68, 0x64, 0x0b, 0x3a, 0x19, 0x3b,
+	0xc6, 0xab, 0xd6, 0x9c, 0xd0, 0xff, 0x31, 0x0f, 0x6a, 0xf2, 0xd4, 0x31, 0xdf, 0x3c, 0x34, 0x9f,
+	0x9b, 0xdd, 0x17, 0xa6, 0xb6, 0x86, 0x3e, 0xee, 0xa8, 0xd9, 0x39, 0x34, 0x06, 0xbd, 0x56, 0xd3,
+	0x14, 0xb5, 0x4d, 0xaa, 0xab, 0x09, 0x3a, 0xc7, 0x2e, 0x43, 0xfd, 0xd9, 0xa1, 0xd9, 0xea, 0xb7,
+	0xbb, 0xa6, 0x80, 0xf2, 0x08, 0x19, 0x9f, 0x09, 0xd7, 0x27, 0xa0, 0x02, 0x42, 0xfb, 0xcd, 0xbe,
+	0xc1, 0xdb, 0x09, 0x54, 0xc4, 0x51, 0x0e, 0x78, 0xf7, 0x53, 0xa3, 0xd5, 0xd7, 0x80, 0xbd, 0x05,
+	0x97, 0xd3, 0x2e, 0x89, 0x3a, 0xad, 0x8a, 0x4e, 0x34, 0xe9, 0xa6, 0x5d, 0x45, 0x25, 0xdc, 0x68,
+	0x1d, 0xf2, 0x5e, 0xfb, 0xc8, 0x18, 0xb4, 0xfa, 0x86, 0xf6, 0x16, 0x3a, 0xe7, 0x5e, 0xdb, 0x7c,
+	0xae, 0x5d, 0x63, 0x75, 0xa8, 0x60, 0x4b, 0x68, 0xbf, 0x4e, 0xee, 0x7b, 0x77, 0x57, 0xbb, 0x85,
+	0x2a, 0x76, 0xda, 0xbd, 0x7e, 0xdb, 0x6c, 0xf5, 0xb5, 0x77, 0xd1, 0x43, 0x3f, 0x6b, 0x77, 0xfa,
+	0x06, 0xd7, 0x36, 0xb0, 0xef, 0xa7, 0xdd, 0xb6, 0xa9, 0xdd, 0x46, 0xb4, 0xd7, 0xdc, 0x3f, 0xe8,
+	0x18, 0x9a, 0x4e, 0x1a, 0xbb, 0xbc, 0xaf, 0xbd, 0xc7, 0x2a, 0x50, 0x3c, 0x34, 0x71, 0x1e, 0x77,
+	0x50, 0x39, 0x35, 0x07, 0x58, 0xa9, 0xbd, 0x9b, 0xf1, 0xf3, 0xf7, 0xb0, 0xfd, 0xa2, 0x6d, 0xee,
+	0x74, 0x5f, 0x68, 0x3f, 0x44, 0xb1, 0x6d, 0xde, 0x6d, 0xee, 0xb4, 0x30, 0x1c, 0xd8, 0x44, 0x05,
+	0xbd, 0x83, 0x4e, 0xbb, 0xaf, 0xfd, 0x08, 0xa5, 0x76, 0x9b, 0xfd, 0x3d, 0x83, 0x6b, 0xf7, 0xb1,
+	0xdd, 0xec, 0xf5, 0x0c, 0xde, 0xd7, 0xb6, 0xb0, 0xdd, 0x36, 0xa9, 0xfd, 0x88, 0xb4, 0x1e, 0xec,
+	0x34, 0xfb, 0x86, 0xf6, 0x18, 0xdb, 0x3b, 0x46, 0xc7, 0xe8, 0x1b, 0xda, 0x4f, 0x50, 0x2b, 0x45,
+	0x12, 0x3d, 0xdc, 0xaa, 0x27, 0xb8, 0x0b, 0x29, 0x49, 0xf3, 0xf9, 0x29, 0x0e, 0xb4, 0xdf, 0x36,
+	0x0f, 0x7b, 0xda, 0x53, 0x14, 0xa6, 0x26, 0x71, 0x7e, 0xa6, 0xbf, 0x04, 0x35, 0xb1, 0x85, 0x28,
+	0xd5, 0x36, 0x4d, 0x83, 0x8b, 0x98, 0xa6, 0x63, 0x3c, 0xeb, 0x6b, 0x0a, 0x82, 0xbc, 0xbd, 0xbb,
+	0x87, 0xd1, 0x4c, 0x05, 0x8a, 0xdd, 0x43, 0xdc, 0x9a, 0x3c, 0x6d, 0x82, 0xb1, 0xdf, 0xd6, 0x0a,
+	0xd8, 0x6a, 0x9a, 0xfd, 0xb6, 0x56, 0xa4, 0x4d, 0x6a, 0x9b, 0xbb, 0x1d, 0x43, 0x2b, 0x21, 0xba,
+	0xdf, 0xe4, 0xcf, 0xb5, 0x32, 0x76, 0x6a, 0x1e, 0x1c, 0x74, 0x3e, 0xd7, 0x54, 0x7d, 0x13, 0xca,
+	0xcd, 0xf1, 0x78, 0x1f, 0x9d, 0x8a, 0x0a, 0x85, 0x67, 0x58, 0x3a, 0xa5, 0xb2, 0xf8, 0x76, 0xb7,
+	0xdf, 0xef, 0xee, 0x8b, 0xd2, 0x45, 0xbf, 0x7b, 0xa0, 0xe5, 0xf4, 0xdf, 0x29, 0xb0, 0xbe, 0x78,
+	0xd5, 0xb1, 0xfe, 0x22, 0x22, 0x8e, 0x0b, 0xf1, 0x47, 0x03, 0x92, 0x78, 0xe3, 0x62, 0xf8, 0xa1,
+	0x43, 0x6d, 0x16, 0x39, 0x42, 0xcd, 0xf3, 0x34, 0x06, 0x59, 0xc0, 0x30, 0x4f, 0x1d, 0x59, 0x7e,
+	0x3f, 0x9c, 0xf9, 0x23, 0x2b, 0x16, 0xce, 0x54, 0xe5, 0x59, 0x08, 0xc3, 0x48, 0x37, 0xda, 0x13,
+	0xe1, 0x85, 0x2c, 0xb2, 0xcd, 0x01, 0xfd, 0x4f, 0x72, 0x50, 0xfc, 0x15, 0x56, 0x40, 0xd9, 0x13,
+	0xa8, 0x44, 0xf1, 0x24, 0xce, 0xba, 0xc9, 0xb7, 0xc5, 0x9b, 0x22, 0xfe, 0x83, 0x5e, 0x6c, 0xc5,
+	0x54, 0x73, 0x13, 0xce, 0x12, 0x65, 0xb1, 0x25, 0xf2, 0x01, 0x67, 0x2a, 0x42, 0xdf, 0x22, 0x17,
+	0x04, 0x1a, 0x4c, 0xf4, 0x99, 0x49, 0x4a, 0x09, 0x73, 0xd7, 0xc5, 0x05, 0x03, 0x0d, 0xe6, 0x14,
+	0xeb, 0xbf, 0xab, 0x6a, 0x16, 0x92, 0x83, 0x0e, 0xf2, 0xc4, 0xb1, 0xf0, 0xe5, 0x27, 0xa5, 0x8a,
+	0x94, 0xd6, 0x5f, 0x40, 0x7d, 0x61, 0x4a, 0x8b, 0x8f, 0x1a, 0xcf, 0xd2, 0xe8, 0xe0, 0x7d, 0x52,
+	0x32, 0x57, 0x30, 0x97, 0xb9, 0x76, 0xf9, 0xcc, 0x75, 0x2c, 0xd0, 0x05, 0x33, 0xf8, 0xae, 0xa1,
+	0x15, 0xf5, 0xbf, 0xc8, 0xc1, 0xe5, 0x7e, 0x68, 0xf9, 0x91, 0x25, 0x2a, 0x22, 0x7e, 0x1c, 0x06,
+	0x1e, 0xfb, 0x39, 0xa8, 0xf1, 0xc8, 0xcb, 0xee, 0xce, 0xbb, 0xd2, 0x12, 0x5f, 0x14, 0x7d, 0xd0,
+	0x1f, 0x79, 0xb4, 0x47, 0xe5, 0x58, 0x34, 0xd8, 0x07, 0x50, 0x1c, 0x3a, 0x63, 0xd7, 0x97, 0xa9,
+	0xe7, 0x5b, 0x17, 0x3b, 0x6e, 0x23, 0x73, 0x6f, 0x8d, 0x0b, 0x29, 0xf6, 0x11, 0x94, 0xb0, 0x94,
+	0xe0, 0x26, 0x71, 0xc6, 0xb5, 0xe5, 0x81, 0x90, 0xbb, 0xb7, 0xc6, 0xa5, 0x1c, 0x7b, 0x82, 0xff,
+	0xe4, 0x78, 0xde, 0xd0, 0x1a, 0xbd, 0x92, 0x29, 0x68, 0xe3, 0x62, 0x1f, 0x2e, 0xf9, 0x7b, 0x6b,
+	0x3c, 0x95, 0xd5, 0x1f, 0x40, 0x59, 0x4e, 0x16, 0x37, 0x60, 0xdb, 0xd8, 0x6d, 0xcb, 0xbd, 0x6b,
+	0x75, 0xf7, 0xf7, 0xdb, 0xb8, 0x77, 0x35, 0x50, 0x79, 0xb7, 0xd3, 0xd9, 0x6e, 0xb6, 0x9e, 0x6b,
+	0xb9, 0x6d, 0x15, 0x4a, 0x16, 0x55, 0xd4, 0xf5, 0x3f, 0x54, 0xe0, 0xd2, 0x85, 0x05, 0xb0, 0xa7,
+	0x50, 0x98, 0x04, 0x76, 0xb2, 0x3d, 0x77, 0x56, 0xae, 0x32, 0x43, 0xe3, 0x3b, 0xe2, 0xd4, 0x43,
+	0xff, 0x19, 0xac, 0x2f, 0xe2, 0x99, 0x7f, 0x3d, 0xea, 0x50, 0xe1, 0x46, 0x73, 0x67, 0xd0, 0x35,
+	0x3b, 0x9f, 0x0b, 0xeb, 0x4c, 0xe4, 0x0b, 0xde, 0xee, 0x1b, 0x5a, 0x4e, 0xff, 0x35, 0x68, 0x17,
+	0x37, 0x86, 0xed, 0xc2, 0xa5, 0x51, 0x30, 0x99, 0x7a, 0x0e, 0x62, 0xd9, 0x23, 0xbb, 0xb5, 0x62,
+	0x27, 0xa5, 0x18, 0x9d, 0xd8, 0xfa, 0x68, 0x81, 0xd6, 0xff, 0x3f, 0xb0, 0xe5, 0x1d, 0xfc, 0xbf,
+	0x53, 0xff, 0x4f, 0x0a, 0x14, 0x0e, 0x3c, 0x0b, 0xff, 0x35, 0x2a, 0xd2, 0xdf, 0x10, 0x0d, 0x25,
+	0xfb, 0xdf, 0x09, 0xbd, 0x3b, 0xbc, 0x16, 0xc4, 0x63, 0xef, 0x43, 0x3e, 0x1e, 0x79, 0xf2, 0x0e,
+	0x5d, 0x7f, 0xc3, 0xe5, 0xc3, 0x3a, 0x46, 0x3c, 0xf2, 0xf0, 0x0f, 0x45, 0xdb, 0xf6, 0xe4, 0x05,
+	0x4a, 0x7c, 0xaf, 0x15, 0x5b, 0x3b, 0xce, 0xb1, 0xeb, 0xbb, 0xf2, 0x4f, 0x11, 0x14, 0xc1, 0xbf,
+	0x45, 0xec, 0x91, 0xd7, 0x28, 0x64, 0xbd, 0x28, 0x4a, 0x66, 0x14, 0xda, 0x23, 0x8f, 0xdd, 0x83,
+	0xbc, 0x4b, 0x05, 0xc3, 0x4c, 0xc9, 0xbc, 0xed, 0x47, 0x4e, 0x18, 0x8b, 0x2a, 0x15, 0xca, 0xb9,
+	0x7e, 0x84, 0x7f, 0x55, 0x20, 0x0f, 0x4b, 0x74, 0xb5, 0x2c, 0xff, 0x7b, 0x25, 0x60, 0x0f, 0x31,
+	0xe4, 0x98, 0x7a, 0xee, 0xc8, 0x8d, 0x45, 0x32, 0x94, 0x5f, 0x91, 0x0c, 0xd5, 0x12, 0x11, 0x4a,
+	0x87, 0xde, 0x07, 0x91, 0xfb, 0x08, 0xf9, 0xc2, 0x0a, 0xf9, 0x0a, 0xf1, 0xd3, 0xdc, 0x29, 0x93,
+	0x1a, 0x15, 0x2f, 0xa6, 0x46, 0xec, 0x1e, 0xfd, 0xa1, 0x4c, 0xa5, 0xd2, 0x52, 0x56, 0x95, 0x00,
+	0x79, 0xc2, 0xd4, 0x7f, 0x0c, 0x25, 0xd1, 0x64, 0x7a, 0xd2, 0x5a, 0x91, 0xf2, 0x4a, 0x8e, 0xfe,
+	0x3f, 0x39, 0xa8, 0x66, 0xb6, 0x98, 0x3d, 0x06, 0xd5, 0x1e, 0x79, 0x2b, 0x2c, 0x6f, 0x46, 0xe8,
+	0xc1, 0x4e, 0x62, 0x55, 0x6c, 0xd1, 0x60, 0x3f, 0x83, 0x3a, 0xc6, 0x6f, 0xaf, 0xad, 0xd0, 0xa5,
+	0xf0, 0xa9, 0x91, 0xcb, 0x9e, 0x4d, 0xcf, 0x89, 0x8f, 0x12, 0x0e, 0x7e, 0x51, 0x10, 0x65, 0x68,
+	0xf6, 0x23, 0x4c, 0x6c, 0x9d, 0xa9, 0x15, 0x3a, 0xf2, 0x86, 0xd4, 0x93, 0x12, 0x17, 0x81, 0xf8,
+	0x81, 0x81, 0xe4, 0xa3, 0xa8, 0x73, 0xe6, 0x8c, 0x66, 0xd2, 0xb9, 0xa4, 0xa2, 0x86, 0x00, 0x51,
+	0x54, 0xf2, 0xd9, 0x16, 0x80, 0xed, 0x58, 0x9e, 0x17, 0x90, 0x2b, 0x2a, 0x66, 0x43, 0xca, 0x9d,
+	0x14, 0x17, 0x5f, 0x27, 0x24, 0x94, 0x3e, 0x86, 0xb2, 0x5c, 0x18, 0xba, 0xfd, 0x9e, 0xd1, 0x1f,
+	0x1c, 0x35, 0x79, 0x1b, 0xc3, 0xaf, 0x9e, 0xb6, 0x86, 0x46, 0x69, 0x97, 0x37, 0x4d, 0x69, 0xc4,
+	0xb9, 0x71, 0xd4, 0x7d, 0x8e, 0x7f, 0x77, 0x52, 0xc1, 0xc2, 0xfc, 0x5c, 0xcb, 0x8b, 0x10, 0xcb,
+	0x38, 0x68, 0x72, 0xb4, 0xe1, 0x55, 0x28, 0x1b, 0x9f, 0x19, 0xad, 0xc3, 0xbe, 0xa1, 0x15, 0xd1,
+	0x4e, 0xec, 0x18, 0xcd, 0x4e, 0xa7, 0xdb, 0x42, 0x03, 0x5f, 0xda, 0xae, 0xe0, 0x49, 0xd2, 0x4e,
+	0xea, 0x7f, 0x50, 0x81, 0xf5, 0xc5, 0xb7, 0xc0, 0x7e, 0x0a, 0xaa, 0x6d, 0x2f, 0x9c, 0xc0, 0xcd,
+	0x55, 0x6f, 0xe6, 0xc1, 0x8e, 0x9d, 0x1c, 0x82, 0x68, 0xb0, 0xdb, 0xc9, 0xcb, 0xcd, 0x2d, 0xbd,
+	0xdc, 0xe4, 0xdd, 0x7e, 0x0c, 0x97, 0x44, 0x01, 0x94, 0x42, 0xed, 0xa1, 0x15, 0x39, 0x8b, 0xcf,
+	0xb2, 0x45, 0xcc, 0x1d, 0xc9, 0xdb, 0x5b, 0xe3, 0xeb, 0xa3, 0x05, 0x84, 0xfd, 0x02, 0xd6, 0x2d,
+	0x4a, 0xd9, 0xd2, 0xfe, 0x85, 0x6c, 0xf1, 0xb0, 0x89, 0xbc, 0x4c, 0xf7, 0xba, 0x95, 0x05, 0xf0,
+	0x9a, 0xd8, 0x61, 0x30, 0x9d, 0x77, 0x5e, 0x78, 0xc2, 0x3b, 0x61, 0x30, 0xcd, 0xf4, 0xad, 0xd9,
+	0x19, 0x9a, 0x3d, 0x81, 0x9a, 0x9c, 0x39, 0x25, 0x19, 0x8d, 0x52, 0xd6, 0x46, 0x88, 0x69, 0x53,
+	0x78, 0x83, 0xdf, 0xd1, 0x8c, 0xe6, 0x24, 0x7b, 0x04, 0x55, 0x31, 0x61, 0xd1, 0xad, 0x9c, 0xbd,
+	0x09, 0x34, 0xdb, 0xa4, 0x17, 0x58, 0x29, 0xc5, 0x3e, 0x02, 0xa0, 0x79, 0x8a, 0x3e, 0x6a, 0x36,
+	0x7d, 0xc1, 0x49, 0x26, 0x5d, 0x2a, 0x76, 0x42, 0x64, 0xa6, 0x27, 0x4a, 0xc9, 0x95, 0xe5, 0xe9,
+	0x51, 0xad, 0x74, 0x3e, 0x3d, 0x22, 0xe7, 0xd3, 0x13, 0xdd, 0x60, 0x69, 0x7a, 0x49, 0x2f, 0xb0,
+	0x52, 0x2a, 0x9d, 0x9e, 0xe8, 0x53, 0xbd, 0x38, 0xbd, 0xa4, 0x4b, 0xc5, 0x4e, 0x08, 0x3c, 0xb6,
+	0x58, 0x06, 0x61, 0x72, 0x51, 0xb5, 0xec, 0xb1, 0x25, 0x01, 0x5a, 0xb2, 0xb0, 0x7a, 0x9c, 0x05,
+	0xb0, 0x77, 0x74, 0x12, 0x9c, 0x66, 0x9e, 0x77, 0x3d, 0xdb, 0xbb, 0x77, 0x12, 0x9c, 0x66, 0xdf,
+	0x77, 0x3d, 0xca, 0x02, 0xfa, 0x9f, 0xe6, 0xa1, 0x2c, 0xef, 0x2a, 0xfe, 0xe1, 0xdf, 0xe2, 0x46,
+	0xb3, 0x6f, 0x0c, 0x76, 0x9a, 0xfd, 0xe6, 0x76, 0xb3, 0x87, 0x5e, 0x95, 0xc1, 0x7a, 0x13, 0xb3,
+	0x84, 0x39, 0xa6, 0xe0, 0x03, 0xdc, 0xe1, 0xdd, 0x83, 0x39, 0x94, 0xc3, 0xcf, 0x07, 0x64, 0x5f,
+	0xf1, 0xa9, 0x41, 0x1e, 0x4b, 0x84, 0xa2, 0xa3, 0x00, 0x0a, 0xf4, 0xd0, 0xb0, 0x97, 0xa0, 0x8b,
+	0x99, 0x2e, 0x6d, 0x73, 0xc7, 0xf8, 0x4c, 0x2b, 0xcd, 0xbb, 0x08, 0xa0, 0x9c, 0x76, 0x11, 0xb4,
+	0x8a, 0x93, 0xe9, 0xf3, 0x43, 0xb3, 0x35, 0x1f, 0xa7, 0xc2, 0xae, 0xc3, 0x95, 0xde, 0x5e, 0xf7,
+	0xc5, 0x40, 0xe8, 0x4a, 0xa7, 0x04, 0xec, 0x2a, 0x68, 0x19, 0x86, 0x10, 0xaf, 0xa2, 0x0a, 0x42,
+	0x13, 0xc1, 0x9e, 0x56, 0xc3, 0x71, 0x09, 0xeb, 0x0b, 0x73, 0x52, 0xc7, 0xa9, 0x89, 0xae, 0xdd,
+	0xce, 0xe1, 0xbe, 0xd9, 0xd3, 0xd6, 0x71, 0x26, 0x84, 0x88, 0x99, 0x5c, 0x4a, 0xd5, 0xcc, 0x8d,
+	0x90, 0x46, 0x76, 0x09, 0xb1, 0x17, 0x4d, 0x6e, 0xb6, 0xcd, 0xdd, 0x9e, 0x76, 0x39, 0xd5, 0x6c,
+	0x70, 0xde, 0xe5, 0x3d, 0x8d, 0xa5, 0x40, 0xaf, 0xdf, 0xec, 0x1f, 0xf6, 0xb4, 0x2b, 0xe9, 0x2c,
+	0x0f, 0x78, 0xb7, 0x65, 0xf4, 0x7a, 0x9d, 0x76, 0xaf, 0xaf, 0x5d, 0xdd, 0xae, 0xd1, 0xd7, 0x5a,
+	0xd2, 0x98, 0xe8, 0x07, 0xb0, 0xbe, 0xf8, 0xf6, 0x99, 0x0e, 0x75, 0xf7, 0x78, 0xe0, 0x07, 0xf1,
+	0xc0, 0x39, 0x73, 0xa3, 0x38, 0x4a, 0xfe, 0x61, 0x76, 0x8f, 0xcd, 0x20, 0x36, 0x08, 0xc2, 0x98,
+	0x38, 0x7d, 0xca, 0xc2, 0x5d, 0xa6, 0xb4, 0xbe, 0x07, 0xf5, 0x05, 0x6b, 0x80, 0x45, 0x77, 0xf7,
+	0x78, 0x51, 0x99, 0xea, 0x1e, 0x7f, 0x07, 0x4d, 0xbb, 0x50, 0xcb, 0x9a, 0x86, 0xef, 0xaf, 0xe8,
+	0xcf, 0x15, 0xa8, 0x66, 0x4c, 0xc5, 0x77, 0x5a, 0xe2, 0x4d, 0xa8, 0xc4, 0xce, 0x64, 0x1a, 0x84,
+	0x96, 0x34, 0xac, 0x2a, 0x9f, 0x03, 0x0b, 0xa3, 0xe5, 0x17, 0x47, 0x5b, 0xac, 0xb0, 0x14, 0xbe,
+	0xb9, 0xc2, 0xa2, 0x77, 0x01, 0xe6, 0xd6, 0x88, 0xfe, 0xc1, 0xc0, 0x46, 0xf2, 0x51, 0x17, 0x11,
+	0x8b, 0x0a, 0x73, 0xdf, 0xa2, 0xf0, 0x0b, 0xa8, 0xa4, 0xa6, 0xea, 0x7b, 0xef, 0xd8, 0x7c, 0x22,
+	0xf9, 0xcc, 0x44, 0xf4, 0xdd, 0x64, 0x1b, 0x85, 0x71, 0xf9, 0x2e, 0xdb, 0x78, 0x15, 0x8a, 0xc2,
+	0x5a, 0xc9, 0xbf, 0xbe, 0x89, 0xd0, 0x75, 0xb9, 0x6a, 0xa1, 0x27, 0x95, 0x51, 0xb2, 0x32, 0xbf,
+	0x14, 0x0b, 0x11, 0x22, 0xdf, 0xb8, 0x90, 0xd5, 0x63, 0xdc, 0x85, 0xfa, 0x82, 0x79, 0x5b, 0xbd,
+	0xb9, 0x7a, 0x1b, 0xea, 0x0b, 0x76, 0x2c, 0xf3, 0x39, 0xa1, 0x92, 0xfd, 0x9c, 0x10, 0xb3, 0xc9,
+	0xd3, 0x13, 0x27, 0x74, 0x56, 0x7c, 0x31, 0x25, 0x18, 0xfa, 0x2f, 0xa0, 0x96, 0x8d, 0x78, 0xd8,
+	0x8f, 0xa1, 0xe8, 0xc6, 0xce, 0x24, 0xf9, 0x2b, 0xff, 0xda, 0x72, 0x50, 0x44, 0x7f, 0x4d, 0x0b,
+	0x21, 0xfd, 0xb7, 0x0a, 0x68, 0x17, 0x79, 0x99, 0x6f, 0x1e, 0x95, 0x37, 0x7c, 0xf3, 0x98, 0x5b,
+	0x98, 0xe4, 0x8a, 0xef, 0x16, 0x71, 0xe2, 0xe2, 0xef, 0xb8, 0x15, 0x1f, 0xe1, 0x11, 0x03, 0xff,
+	0x04, 0x0e, 0x1d, 0xfa, 0x44, 0xcd, 0x6e, 0x14, 0x97, 0x84, 0x52, 0x9e, 0xfe, 0x47, 0x0a, 0x94,
+	0x65, 0x78, 0xb6, 0xf2, 0x4f, 0xde, 0x1f, 0x41, 0x59, 0xfc, 0x15, 0x95, 0xfc, 0x07, 0xb5, 0x54,
+	0xfa, 0x4b, 0xf8, 0x58, 0xc5, 0x46, 0xd6, 0x62, 0x15, 0x1b, 0xf3, 0x10, 0x4e, 0x38, 0x46, 0xc5,
+	0x94, 0x7f, 0x53, 0x38, 0x14, 0xc9, 0xff, 0xd7, 0x80, 0x20, 0x74, 0x28, 0x91, 0xfe, 0xff, 0xa0,
+	0x2c, 0xc3, 0xbf, 0x95, 0x53, 0xf9, 0xb6, 0xcf, 0xdb, 0x36, 0x00, 0xe6, 0xf1, 0xe0, 0x2a, 0x0d,
+	0xf7, 0x6f, 0x43, 0x2d, 0xfb, 0xc9, 0x11, 0x65, 0x83, 0x81, 0xef, 0x68, 0x6b, 0x58, 0x61, 0xe9,
+	0x7c, 0xf5, 0x58, 0x53, 0xee, 0xff, 0x5e, 0xe6, 0x0b, 0x00, 0x92, 0x29, 0x43, 0xfe, 0xb9, 0xf1,
+	0xb9, 0xa8, 0xe7, 0x75, 0xda, 0xa6, 0xd1, 0xe4, 0x03, 0xa4, 0xf1, 0x2b, 0xb6, 0xc2, 0x5e, 0xb3,
+	0xb7, 0xa7, 0xe5, 0xd0, 0x4a, 0x4b, 0x0e, 0x01, 0x79, 0xaa, 0x0d, 0x35, 0xcd, 0x5d, 0x43, 0xd4,
+	0xef, 0xa8, 0x99, 0x3a, 0x87, 0x22, 0x76, 0x24, 0xbb, 0x5d, 0x42, 0xc7, 0x81, 0xad, 0x94, 0x57,
+	0xbe, 0xff, 0x09, 0x34, 0xde, 0x94, 0xe6, 0xa1, 0xd6, 0xd6, 0x5e, 0x93, 0x52, 0xe9, 0x1a, 0xa8,
+	0x66, 0x77, 0x20, 0x28, 0x05, 0x03, 0x54, 0x6e, 0x74, 0x0c, 0x72, 0xad, 0xdb, 0x1f, 0xff, 0xed,
+	0xd7, 0xb7, 0x94, 0xbf, 0xff, 0xfa, 0x96, 0xf2, 0x2f, 0x5f, 0xdf, 0x5a, 0xfb, 0xed, 0xbf, 0xdd,
+	0x52, 0xbe, 0xc8, 0x7e, 0x64, 0x3e, 0xb1, 0xe2, 0xd0, 0x3d, 0x13, 0x1f, 0xf0, 0x24, 0x84, 0xef,
+	0x7c, 0x38, 0x7d, 0x35, 0xfe, 0x70, 0x3a, 0xfc, 0x10, 0x77, 0x74, 0x58, 0xa2, 0x6f, 0xcd, 0x1f,
+	0xfd, 0xef, 0x00, 0x04, 0x49, 0x9b, 0x07, 0xae, 0x2e, 0x00, 0x00,
 }
 
 func (m *Type) Marshal() (dAtA []byte, err error) {
@@ -5940,6 +6034,20 @@ func (m *Type) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Collation != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Collation))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Enumvalues) > 0 {
+		for iNdEx := len(m.Enumvalues) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Enumvalues[iNdEx])
+			copy(dAtA[i:], m.Enumvalues[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.Enumvalues[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.Scale != 0 {
 		i = encodeVarintPlan(dAtA, i, uint64(m.Scale))
 		i--
@@ -7040,6 +7148,18 @@ func (m *ColDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Generated != nil {
+		{
+			size, err := m.Generated.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.Comment) > 0 {
 		i -= len(m.Comment)
 		copy(dAtA[i:], m.Comment)
@@ -7167,6 +7287,62 @@ func (m *Default) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GeneratedCol) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GeneratedCol) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GeneratedCol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Stored {
+		i--
+		if m.Stored {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OriginString) > 0 {
+		i -= len(m.OriginString)
+		copy(dAtA[i:], m.OriginString)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.OriginString)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Expr != nil {
+		{
+			size, err := m.Expr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *IndexDef) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -7882,9 +8058,9 @@ func (m *ColData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	if len(m.F64) > 0 {
 		for iNdEx := len(m.F64) - 1; iNdEx >= 0; iNdEx-- {
-			f26 := math.Float64bits(float64(m.F64[iNdEx]))
+			f28 := math.Float64bits(float64(m.F64[iNdEx]))
 			i -= 8
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f26))
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f28))
 		}
 		i = encodeVarintPlan(dAtA, i, uint64(len(m.F64)*8))
 		i--
@@ -7892,49 +8068,49 @@ func (m *ColData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	if len(m.F32) > 0 {
 		for iNdEx := len(m.F32) - 1; iNdEx >= 0; iNdEx-- {
-			f27 := math.Float32bits(float32(m.F32[iNdEx]))
+			f29 := math.Float32bits(float32(m.F32[iNdEx]))
 			i -= 4
-			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(f27))
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(f29))
 		}
 		i = encodeVarintPlan(dAtA, i, uint64(len(m.F32)*4))
 		i--
 		dAtA[i] = 0x32
 	}
 	if len(m.I64) > 0 {
-		dAtA29 := make([]byte, len(m.I64)*10)
-		var j28 int
+		dAtA31 := make([]byte, len(m.I64)*10)
+		var j30 int
 		for _, num1 := range m.I64 {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA29[j28] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA31[j30] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j28++
+				j30++
 			}
-			dAtA29[j28] = uint8(num)
-			j28++
+			dAtA31[j30] = uint8(num)
+			j30++
 		}
-		i -= j28
-		copy(dAtA[i:], dAtA29[:j28])
-		i = encodeVarintPlan(dAtA, i, uint64(j28))
+		i -= j30
+		copy(dAtA[i:], dAtA31[:j30])
+		i = encodeVarintPlan(dAtA, i, uint64(j30))
 		i--
 		dAtA[i] = 0x2a
 	}
 	if len(m.I32) > 0 {
-		dAtA31 := make([]byte, len(m.I32)*10)
-		var j30 int
+		dAtA33 := make([]byte, len(m.I32)*10)
+		var j32 int
 		for _, num1 := range m.I32 {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA31[j30] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA33[j32] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j30++
+				j32++
 			}
-			dAtA31[j30] = uint8(num)
-			j30++
+			dAtA33[j32] = uint8(num)
+			j32++
 		}
-		i -= j30
-		copy(dAtA[i:], dAtA31[:j30])
-		i = encodeVarintPlan(dAtA, i, uint64(j30))
+		i -= j32
+		copy(dAtA[i:], dAtA33[:j32])
+		i = encodeVarintPlan(dAtA, i, uint64(j32))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -8326,21 +8502,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xc2
 	}
 	if len(m.BindingTags) > 0 {
-		dAtA36 := make([]byte, len(m.BindingTags)*10)
-		var j35 int
+		dAtA38 := make([]byte, len(m.BindingTags)*10)
+		var j37 int
 		for _, num1 := range m.BindingTags {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA36[j35] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA38[j37] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j35++
+				j37++
 			}
-			dAtA36[j35] = uint8(num)
-			j35++
+			dAtA38[j37] = uint8(num)
+			j37++
 		}
-		i -= j35
-		copy(dAtA[i:], dAtA36[:j35])
-		i = encodeVarintPlan(dAtA, i, uint64(j35))
+		i -= j37
+		copy(dAtA[i:], dAtA38[:j37])
+		i = encodeVarintPlan(dAtA, i, uint64(j37))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -8571,21 +8747,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x30
 	}
 	if len(m.Children) > 0 {
-		dAtA44 := make([]byte, len(m.Children)*10)
-		var j43 int
+		dAtA46 := make([]byte, len(m.Children)*10)
+		var j45 int
 		for _, num1 := range m.Children {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA44[j43] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA46[j45] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j43++
+				j45++
 			}
-			dAtA44[j43] = uint8(num)
-			j43++
+			dAtA46[j45] = uint8(num)
+			j45++
 		}
-		i -= j43
-		copy(dAtA[i:], dAtA44[:j43])
-		i = encodeVarintPlan(dAtA, i, uint64(j43))
+		i -= j45
+		copy(dAtA[i:], dAtA46[:j45])
+		i = encodeVarintPlan(dAtA, i, uint64(j45))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -8758,21 +8934,21 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Steps) > 0 {
-		dAtA47 := make([]byte, len(m.Steps)*10)
-		var j46 int
+		dAtA49 := make([]byte, len(m.Steps)*10)
+		var j48 int
 		for _, num1 := range m.Steps {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA47[j46] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA49[j48] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j46++
+				j48++
 			}
-			dAtA47[j46] = uint8(num)
-			j46++
+			dAtA49[j48] = uint8(num)
+			j48++
 		}
-		i -= j46
-		copy(dAtA[i:], dAtA47[:j46])
-		i = encodeVarintPlan(dAtA, i, uint64(j46))
+		i -= j48
+		copy(dAtA[i:], dAtA49[:j48])
+		i = encodeVarintPlan(dAtA, i, uint64(j48))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -10313,21 +10489,21 @@ func (m *Prepare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.ParamTypes) > 0 {
-		dAtA77 := make([]byte, len(m.ParamTypes)*10)
-		var j76 int
+		dAtA79 := make([]byte, len(m.ParamTypes)*10)
+		var j78 int
 		for _, num1 := range m.ParamTypes {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA77[j76] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA79[j78] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j76++
+				j78++
 			}
-			dAtA77[j76] = uint8(num)
-			j76++
+			dAtA79[j78] = uint8(num)
+			j78++
 		}
-		i -= j76
-		copy(dAtA[i:], dAtA77[:j76])
-		i = encodeVarintPlan(dAtA, i, uint64(j76))
+		i -= j78
+		copy(dAtA[i:], dAtA79[:j78])
+		i = encodeVarintPlan(dAtA, i, uint64(j78))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -10484,6 +10660,15 @@ func (m *Type) ProtoSize() (n int) {
 	if m.Scale != 0 {
 		n += 1 + sovPlan(uint64(m.Scale))
 	}
+	if len(m.Enumvalues) > 0 {
+		for _, s := range m.Enumvalues {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.Collation != 0 {
+		n += 1 + sovPlan(uint64(m.Collation))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11060,6 +11245,10 @@ func (m *ColDef) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPlan(uint64(l))
 	}
+	if m.Generated != nil {
+		l = m.Generated.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11089,6 +11278,29 @@ func (m *Default) ProtoSize() (n int) {
 	return n
 }
 
+func (m *GeneratedCol) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Expr != nil {
+		l = m.Expr.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.OriginString)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Stored {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *IndexDef) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -12768,6 +12980,57 @@ func (m *Type) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enumvalues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Enumvalues = append(m.Enumvalues, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collation", wireType)
+			}
+			m.Collation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Collation |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -15227,6 +15490,42 @@ func (m *ColDef) Unmarshal(dAtA []byte) error {
 			}
 			m.Comment = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Generated == nil {
+				m.Generated = &GeneratedCol{}
+			}
+			if err := m.Generated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -15388,6 +15687,145 @@ func (m *Default) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GeneratedCol) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeneratedCol: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeneratedCol: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expr == nil {
+				m.Expr = &Expr{}
+			}
+			if err := m.Expr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginString = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stored", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stored = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *IndexDef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -75,7 +75,7 @@ func Sort(desc bool, os []int64, vec *vector.Vector) {
 		} else {
 			genericSort(col, os, genericGreater[uint8])
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		col := vector.GenericVectorValues[uint16](vec)
 		if !desc {
 			genericSort(col, os, genericLess[uint16])
@@ -89,7 +89,7 @@ func Sort(desc bool, os []int64, vec *vector.Vector) {
 		} else {
 			genericSort(col, os, genericGreater[uint32])
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		col := vector.GenericVectorValues[uint64](vec)
 		if !desc {
 			genericSort(col, os, genericLess[uint64])
@@ -124,6 +124,13 @@ func Sort(desc bool, os []int64, vec *vector.Vector) {
 		} else {
 			genericSort(col, os, genericGreater[types.Datetime])
 		}
+	case types.T_time:
+		col := vector.GenericVectorValues[types.Time](vec)
+		if !desc {
+			genericSort(col, os, genericLess[types.Time])
+		} else {
+			genericSort(col, os, genericGreater[types.Time])
+		}
 	case types.T_timestamp:
 		col := vector.GenericVectorValues[types.Timestamp](vec)
 		if !desc {
@@ -145,8 +152,23 @@ func Sort(desc bool, os []int64, vec *vector.Vector) {
 		} else {
 			genericSort(col, os, decimal128Greater)
 		}
-	case types.T_char, types.T_varchar, types.T_blob:
+	case types.T_uuid:
+		col := vector.GenericVectorValues[types.Uuid](vec)
+		if !desc {
+			genericSort(col, os, uuidLess)
+		} else {
+			genericSort(col, os, uuidGreater)
+		}
+	case types.T_char, types.T_varchar, types.T_blob, types.T_binary, types.T_varbinary:
 		col := vec.Col.(*types.Bytes)
+		if collation := vec.Typ.Collation; !collation.IsBinary() {
+			if !desc {
+				genericSort([]types.String{col}, os, collatedStringLess(collation))
+			} else {
+				genericSort([]types.String{col}, os, collatedStringGreater(collation))
+			}
+			return
+		}
 		if !desc {
 			genericSort([]types.String{col}, os, stringLess[types.String])
 		} else {
@@ -155,6 +177,18 @@ func Sort(desc bool, os []int64, vec *vector.Vector) {
 	}
 }
 
+func collatedStringLess(collation types.Collation) func([]types.String, int64, int64) bool {
+	return func(data []types.String, i, j int64) bool {
+		return collation.Compare(data[0].Get(i), data[0].Get(j)) < 0
+	}
+}
+
+func collatedStringGreater(collation types.Collation) func([]types.String, int64, int64) bool {
+	return func(data []types.String, i, j int64) bool {
+		return collation.Compare(data[0].Get(i), data[0].Get(j)) > 0
+	}
+}
+
 func boolLess[T bool](data []T, i, j int64) bool {
 	return bool(!data[i] && data[j])
 }
@@ -187,6 +221,14 @@ func decimal128Greater(data []types.Decimal128, i, j int64) bool {
 	return data[i].Compare(data[j]) > 0
 }
 
+func uuidLess(data []types.Uuid, i, j int64) bool {
+	return data[i].Compare(data[j]) < 0
+}
+
+func uuidGreater(data []types.Uuid, i, j int64) bool {
+	return data[i].Compare(data[j]) > 0
+}
+
 func genericLess[T types.Generic](data []T, i, j int64) bool {
 	return data[i] < data[j]
 }
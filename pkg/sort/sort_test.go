@@ -107,6 +107,23 @@ func TestSort(t *testing.T) {
 	}
 }
 
+func TestSortCollation(t *testing.T) {
+	m := mheap.New(guest.New(1<<30, hm))
+	vec := testutil.NewStringVector(0, types.Type{Oid: types.T_varchar, Size: 24, Collation: types.CollationGeneralCI},
+		m, false, []string{"b", "A", "c", "B", "a"})
+	os := []int64{0, 1, 2, 3, 4}
+	Sort(false, os, vec)
+	col := vec.Col.(*types.Bytes)
+	for i := 1; i < len(os); i++ {
+		require.True(t, types.CollationGeneralCI.Compare(col.Get(os[i-1]), col.Get(os[i])) <= 0)
+	}
+	require.Equal(t, "c", string(col.Get(os[4])))
+	Sort(true, os, vec)
+	require.Equal(t, "c", string(col.Get(os[0])))
+	vec.Free(m)
+	require.Equal(t, int64(0), mheap.Size(m))
+}
+
 func BenchmarkSortInt(b *testing.B) {
 	vs := make([]int, BenchmarkRows)
 	for i := range vs {
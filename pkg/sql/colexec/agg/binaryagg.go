@@ -0,0 +1,188 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agg
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+func NewBinaryAgg[T1, T2, T3 any](priv any, ityps []types.Type, otyp types.Type, grows func(int),
+	eval func([]T3) []T3, merge func(int64, int64, T3, T3, bool, bool, any) (T3, bool),
+	fill func(int64, T1, T2, T3, int64, bool, bool) (T3, bool, error)) Agg[*BinaryAgg[T1, T2, T3]] {
+	return &BinaryAgg[T1, T2, T3]{
+		priv:  priv,
+		otyp:  otyp,
+		eval:  eval,
+		fill:  fill,
+		merge: merge,
+		grows: grows,
+		ityps: ityps,
+	}
+}
+
+func (a *BinaryAgg[T1, T2, T3]) String() string {
+	return fmt.Sprintf("%v", a.vs)
+}
+
+func (a *BinaryAgg[T1, T2, T3]) Free(m *mheap.Mheap) {
+	if a.da != nil {
+		m.Free(a.da)
+		a.da = nil
+		a.vs = nil
+	}
+}
+
+func (a *BinaryAgg[T1, T2, T3]) Dup() Agg[any] {
+	return &BinaryAgg[T1, T2, T3]{
+		priv:  a.priv,
+		otyp:  a.otyp,
+		ityps: a.ityps,
+		fill:  a.fill,
+		merge: a.merge,
+		grows: a.grows,
+		eval:  a.eval,
+	}
+}
+
+func (a *BinaryAgg[T1, T2, T3]) OutputType() types.Type {
+	return a.otyp
+}
+
+func (a *BinaryAgg[T1, T2, T3]) InputTypes() []types.Type {
+	return a.ityps
+}
+
+func (a *BinaryAgg[T1, T2, T3]) Grows(size int, m *mheap.Mheap) error {
+	sz := a.otyp.TypeSize()
+	n := len(a.vs)
+	if n == 0 {
+		data, err := m.Alloc(int64(size * sz))
+		if err != nil {
+			return err
+		}
+		a.da = data
+		a.es = make([]bool, 0, size)
+		a.vs = types.DecodeSlice[T3](a.da, sz)
+	} else if n+size >= cap(a.vs) {
+		a.da = a.da[:n*sz]
+		data, err := m.Grow(a.da, int64(n+size)*int64(sz))
+		if err != nil {
+			return err
+		}
+		m.Free(a.da)
+		a.da = data
+		a.vs = types.DecodeSlice[T3](a.da, sz)
+	}
+	a.vs = a.vs[:n+size]
+	a.da = a.da[:(n+size)*sz]
+	for i := 0; i < size; i++ {
+		a.es = append(a.es, true)
+	}
+	a.grows(size)
+	return nil
+}
+
+func (a *BinaryAgg[T1, T2, T3]) Fill(i int64, sel, z int64, vecs []*vector.Vector) error {
+	var err error
+
+	xs, ys := vector.GetColumn[T1](vecs[0]), vector.GetColumn[T2](vecs[1])
+	x, y := rowIndex(vecs[0], sel), rowIndex(vecs[1], sel)
+	hasNull := vecs[0].GetNulls().Contains(uint64(x)) || vecs[1].GetNulls().Contains(uint64(y))
+	a.vs[i], a.es[i], err = a.fill(i, xs[x], ys[y], a.vs[i], z, a.es[i], hasNull)
+	return err
+}
+
+func (a *BinaryAgg[T1, T2, T3]) BatchFill(start int64, os []uint8, vps []uint64, zs []int64, vecs []*vector.Vector) error {
+	var err error
+
+	xs, ys := vector.GetColumn[T1](vecs[0]), vector.GetColumn[T2](vecs[1])
+	xnsp, ynsp := vecs[0].GetNulls(), vecs[1].GetNulls()
+	for i := range os {
+		if vps[i] == 0 {
+			continue
+		}
+		j := vps[i] - 1
+		k := int64(i) + start
+		x, y := rowIndex(vecs[0], k), rowIndex(vecs[1], k)
+		hasNull := xnsp.Contains(uint64(x)) || ynsp.Contains(uint64(y))
+		if a.vs[j], a.es[j], err = a.fill(int64(j), xs[x], ys[y], a.vs[j], zs[k], a.es[j], hasNull); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *BinaryAgg[T1, T2, T3]) BulkFill(i int64, zs []int64, vecs []*vector.Vector) error {
+	var err error
+
+	xs, ys := vector.GetColumn[T1](vecs[0]), vector.GetColumn[T2](vecs[1])
+	xnsp, ynsp := vecs[0].GetNulls(), vecs[1].GetNulls()
+	for j := range zs {
+		x, y := rowIndex(vecs[0], int64(j)), rowIndex(vecs[1], int64(j))
+		hasNull := xnsp.Contains(uint64(x)) || ynsp.Contains(uint64(y))
+		if a.vs[i], a.es[i], err = a.fill(i, xs[x], ys[y], a.vs[i], zs[j], a.es[i], hasNull); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Merge a[x] += b[y]
+func (a *BinaryAgg[T1, T2, T3]) Merge(b Agg[any], x, y int64) error {
+	b0 := b.(*BinaryAgg[T1, T2, T3])
+	a.vs[x], a.es[x] = a.merge(x, y, a.vs[x], b0.vs[y], a.es[x], b0.es[y], b0.priv)
+	return nil
+}
+
+func (a *BinaryAgg[T1, T2, T3]) BatchMerge(b Agg[any], start int64, os []uint8, vps []uint64) error {
+	b0 := b.(*BinaryAgg[T1, T2, T3])
+	for i := range os {
+		if vps[i] == 0 {
+			continue
+		}
+		j := vps[i] - 1
+		k := int64(i) + start
+		a.vs[j], a.es[j] = a.merge(int64(j), k, a.vs[j], b0.vs[k], a.es[j], b0.es[k], b0.priv)
+	}
+	return nil
+}
+
+func (a *BinaryAgg[T1, T2, T3]) Eval(m *mheap.Mheap) (*vector.Vector, error) {
+	defer func() {
+		a.da = nil
+		a.vs = nil
+		a.es = nil
+	}()
+	nsp := nulls.NewWithSize(len(a.es))
+	for i, e := range a.es {
+		if e {
+			nsp.Set(uint64(i))
+		}
+	}
+	return vector.NewWithData(a.otyp, a.da, a.eval(a.vs), nsp), nil
+}
+
+// rowIndex returns the index of the row in the column of vec, a constant vector has only one value
+func rowIndex(vec *vector.Vector, row int64) int64 {
+	if vec.IsScalar() {
+		return 0
+	}
+	return row
+}
@@ -0,0 +1,157 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package covariance
+
+import (
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+func ReturnType(_ []types.Type) types.Type {
+	return types.New(types.T_float64, 0, 0, 0)
+}
+
+func NewCovarPop() *Covariance {
+	return &Covariance{kind: Pop}
+}
+
+func NewCovarSample() *Covariance {
+	return &Covariance{kind: Sample}
+}
+
+func NewCorr() *Covariance {
+	return &Covariance{kind: Corr}
+}
+
+func NewVarSample() *Variance {
+	return &Variance{}
+}
+
+func NewStdDevSample() *Variance {
+	return &Variance{sqrt: true}
+}
+
+// merge combines the moments of two disjoint sets of rows.
+func (m *moment) merge(o moment) {
+	if o.n == 0 {
+		return
+	}
+	if m.n == 0 {
+		*m = o
+		return
+	}
+	n := m.n + o.n
+	dx := o.meanX - m.meanX
+	dy := o.meanY - m.meanY
+	f := m.n * o.n / n
+	m.c += o.c + dx*dy*f
+	m.m2x += o.m2x + dx*dx*f
+	m.m2y += o.m2y + dy*dy*f
+	m.meanX += dx * o.n / n
+	m.meanY += dy * o.n / n
+	m.n = n
+}
+
+// add adds a row which is repeated z times.
+func (m *moment) add(x, y float64, z int64) {
+	m.merge(moment{n: float64(z), meanX: x, meanY: y})
+}
+
+// valid reports whether the aggregation of kind has a result
+func (m *moment) valid(kind int) bool {
+	switch kind {
+	case Pop:
+		return m.n > 0
+	case Sample:
+		return m.n > 1
+	default:
+		return m.n > 0 && m.m2x > 0 && m.m2y > 0
+	}
+}
+
+func (m *moment) eval(kind int) float64 {
+	switch kind {
+	case Pop:
+		return m.c / m.n
+	case Sample:
+		return m.c / (m.n - 1)
+	default:
+		return m.c / math.Sqrt(m.m2x*m.m2y)
+	}
+}
+
+func (c *Covariance) Grows(size int) {
+	for i := 0; i < size; i++ {
+		c.moments = append(c.moments, moment{})
+	}
+}
+
+func (c *Covariance) Eval(vs []float64) []float64 {
+	for i := range vs {
+		if c.moments[i].valid(c.kind) {
+			vs[i] = c.moments[i].eval(c.kind)
+		}
+	}
+	return vs
+}
+
+// Merge returns a group as empty as long as it has no result, for example the
+// sample covariance of a single row is null.
+func (c *Covariance) Merge(groupIndex1, groupIndex2 int64, x, _ float64, _ bool, _ bool, agg any) (float64, bool) {
+	c2 := agg.(*Covariance)
+	c.moments[groupIndex1].merge(c2.moments[groupIndex2])
+	return x, !c.moments[groupIndex1].valid(c.kind)
+}
+
+func (c *Covariance) Fill(groupIndex int64, v1, v2 float64, x float64, z int64, isEmpty bool, hasNull bool) (float64, bool, error) {
+	if hasNull {
+		return x, isEmpty, nil
+	}
+	c.moments[groupIndex].add(v1, v2, z)
+	return x, !c.moments[groupIndex].valid(c.kind), nil
+}
+
+func (v *Variance) Grows(size int) {
+	for i := 0; i < size; i++ {
+		v.moments = append(v.moments, moment{})
+	}
+}
+
+func (v *Variance) Eval(vs []float64) []float64 {
+	for i := range vs {
+		if v.moments[i].valid(Sample) {
+			vs[i] = v.moments[i].eval(Sample)
+			if v.sqrt {
+				vs[i] = math.Sqrt(vs[i])
+			}
+		}
+	}
+	return vs
+}
+
+func (v *Variance) Merge(groupIndex1, groupIndex2 int64, x, _ float64, _ bool, _ bool, agg any) (float64, bool) {
+	v2 := agg.(*Variance)
+	v.moments[groupIndex1].merge(v2.moments[groupIndex2])
+	return x, !v.moments[groupIndex1].valid(Sample)
+}
+
+func (v *Variance) Fill(groupIndex int64, v1 float64, x float64, z int64, isEmpty bool, hasNull bool) (float64, bool) {
+	if hasNull {
+		return x, isEmpty
+	}
+	v.moments[groupIndex].add(v1, v1, z)
+	return x, !v.moments[groupIndex].valid(Sample)
+}
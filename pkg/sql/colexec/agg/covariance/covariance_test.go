@@ -0,0 +1,91 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package covariance
+
+import (
+	"math"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+var float64Type = types.New(types.T_float64, 0, 0, 0)
+
+func TestCovariance(t *testing.T) {
+	m := mheap.New(guest.New(1<<30, host.New(1<<30)))
+	xs := testutil.MakeFloat64Vector([]float64{1, 2, 3, 4, 5}, []uint64{4})
+	ys := testutil.MakeFloat64Vector([]float64{2, 4, 5, 9, 1}, nil)
+	vecs := []*vector.Vector{xs, ys}
+
+	for _, c := range []struct {
+		newFn func() *Covariance
+		want  float64
+	}{
+		{NewCovarPop, 2.75},
+		{NewCovarSample, 11.0 / 3},
+		{NewCorr, 11 / math.Sqrt(130)},
+	} {
+		p0, p1 := c.newFn(), c.newFn()
+		ityps := []types.Type{float64Type, float64Type}
+		agg0 := agg.NewBinaryAgg(p0, ityps, float64Type, p0.Grows, p0.Eval, p0.Merge, p0.Fill)
+		agg1 := agg.NewBinaryAgg(p1, ityps, float64Type, p1.Grows, p1.Eval, p1.Merge, p1.Fill)
+		require.NoError(t, agg0.Grows(1, m))
+		require.NoError(t, agg1.Grows(1, m))
+		// the first two rows go to agg0 and the others go to agg1
+		require.NoError(t, agg0.BatchFill(0, []uint8{1, 0}, []uint64{1, 1}, []int64{1, 1}, vecs))
+		require.NoError(t, agg1.BatchFill(2, []uint8{1, 0, 0}, []uint64{1, 1, 1}, []int64{1, 1, 1, 1, 1}, vecs))
+		require.NoError(t, agg0.BatchMerge(agg1, 0, []uint8{0}, []uint64{1}))
+		v, err := agg0.Eval(m)
+		require.NoError(t, err)
+		require.False(t, v.Nsp.Contains(0))
+		require.InDelta(t, c.want, vector.GetColumn[float64](v)[0], 1e-12)
+		v.Free(m)
+		agg1.Free(m)
+	}
+	require.Equal(t, int64(0), m.Size())
+}
+
+func TestVarianceSample(t *testing.T) {
+	m := mheap.New(guest.New(1<<30, host.New(1<<30)))
+	xs := testutil.MakeFloat64Vector([]float64{1, 2, 3, 4}, nil)
+
+	for _, c := range []struct {
+		newFn func() *Variance
+		want  float64
+	}{
+		{NewVarSample, 5.0 / 3},
+		{NewStdDevSample, math.Sqrt(5.0 / 3)},
+	} {
+		p := c.newFn()
+		a := agg.NewUnaryAgg(p, false, float64Type, float64Type, p.Grows, p.Eval, p.Merge, p.Fill, nil)
+		require.NoError(t, a.Grows(2, m))
+		require.NoError(t, a.BulkFill(0, []int64{1, 1, 1, 1}, []*vector.Vector{xs}))
+		// the sample variance of a single row is null
+		require.NoError(t, a.Fill(1, 0, 1, []*vector.Vector{xs}))
+		v, err := a.Eval(m)
+		require.NoError(t, err)
+		require.InDelta(t, c.want, vector.GetColumn[float64](v)[0], 1e-12)
+		require.True(t, v.Nsp.Contains(1))
+		v.Free(m)
+	}
+	require.Equal(t, int64(0), m.Size())
+}
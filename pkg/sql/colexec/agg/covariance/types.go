@@ -0,0 +1,46 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package covariance
+
+const (
+	Pop = iota
+	Sample
+	Corr
+)
+
+// moment is the running state of a group: the count, the means and the
+// sums of squared differences from the means, which can be merged
+// without losing precision like a naive sum of squares.
+type moment struct {
+	n     float64
+	meanX float64
+	meanY float64
+	c     float64 // co-moment of x and y
+	m2x   float64
+	m2y   float64
+}
+
+// Covariance computes covar_pop, covar_samp and corr of two columns.
+type Covariance struct {
+	kind    int
+	moments []moment
+}
+
+// Variance computes var_samp and stddev_samp, whose state is
+// the moment of a column with itself.
+type Variance struct {
+	sqrt    bool
+	moments []moment
+}
@@ -48,10 +48,7 @@ func (a *UnaryDistAgg[T1, T2]) Free(m *mheap.Mheap) {
 		a.da = nil
 		a.vs = nil
 	}
-	for _, mp := range a.maps {
-		mp.Free()
-	}
-	a.maps = nil
+	a.set.Free()
 }
 
 func (a *UnaryDistAgg[T1, T2]) Dup() Agg[any] {
@@ -79,28 +76,20 @@ func (a *UnaryDistAgg[T1, T2]) Grows(size int, m *mheap.Mheap) error {
 			a.es = make([]bool, 0, size)
 			a.vs = make([]T2, 0, size)
 			a.srcs = make([][]T1, 0, size)
-			a.maps = make([]*hashmap.StrHashMap, 0, size)
 
 			a.vs = a.vs[:size]
 			for i := 0; i < size; i++ {
 				a.es = append(a.es, true)
 				a.srcs = append(a.srcs, make([]T1, 0, 1))
-				mp, err := hashmap.NewStrMap(true, 0, 0, m)
-				if err != nil {
-					return err
-				}
-				a.maps = append(a.maps, mp)
 			}
 		} else {
 			var v T2
 			a.es = append(a.es, true)
 			a.vs = append(a.vs, v)
 			a.srcs = append(a.srcs, make([]T1, 0, 1))
-			mp, err := hashmap.NewStrMap(true, 0, 0, m)
-			if err != nil {
-				return err
-			}
-			a.maps = append(a.maps, mp)
+		}
+		if err := a.set.Grows(size, m); err != nil {
+			return err
 		}
 		a.grows(size)
 		return nil
@@ -115,7 +104,6 @@ func (a *UnaryDistAgg[T1, T2]) Grows(size int, m *mheap.Mheap) error {
 		a.da = data
 		a.es = make([]bool, 0, size)
 		a.srcs = make([][]T1, 0, size)
-		a.maps = make([]*hashmap.StrHashMap, 0, size)
 		a.vs = types.DecodeSlice[T2](a.da, sz)
 	} else if n+size >= cap(a.vs) {
 		a.da = a.da[:n*sz]
@@ -131,19 +119,17 @@ func (a *UnaryDistAgg[T1, T2]) Grows(size int, m *mheap.Mheap) error {
 	a.da = a.da[:(n+size)*sz]
 	for i := 0; i < size; i++ {
 		a.es = append(a.es, true)
-		mp, err := hashmap.NewStrMap(true, 0, 0, m)
-		if err != nil {
-			return err
-		}
-		a.maps = append(a.maps, mp)
 		a.srcs = append(a.srcs, make([]T1, 0, 1))
 	}
+	if err := a.set.Grows(size, m); err != nil {
+		return err
+	}
 	a.grows(size)
 	return nil
 }
 
 func (a *UnaryDistAgg[T1, T2]) Fill(i int64, sel, z int64, vecs []*vector.Vector) error {
-	ok, err := a.maps[i].Insert(vecs, int(sel))
+	ok, err := a.set.Insert(i, vecs, int(sel))
 	if err != nil {
 		return err
 	}
@@ -178,7 +164,7 @@ func (a *UnaryDistAgg[T1, T2]) BatchFill(start int64, os []uint8, vps []uint64,
 				continue
 			}
 			j := vps[i] - 1
-			if ok, err = a.maps[j].Insert(vecs, i+int(start)); err != nil {
+			if ok, err = a.set.Insert(int64(j), vecs, i+int(start)); err != nil {
 				return err
 			}
 			if ok {
@@ -199,7 +185,7 @@ func (a *UnaryDistAgg[T1, T2]) BatchFill(start int64, os []uint8, vps []uint64,
 			continue
 		}
 		j := vps[i] - 1
-		if ok, err = a.maps[j].Insert(vecs, i+int(start)); err != nil {
+		if ok, err = a.set.Insert(int64(j), vecs, i+int(start)); err != nil {
 			return err
 		}
 		if ok {
@@ -223,7 +209,7 @@ func (a *UnaryDistAgg[T1, T2]) BulkFill(i int64, zs []int64, vecs []*vector.Vect
 	if vec.GetType().IsString() {
 		len := vec.Count()
 		for j := 0; j < len; j++ {
-			if ok, err = a.maps[i].Insert(vecs, j); err != nil {
+			if ok, err = a.set.Insert(i, vecs, j); err != nil {
 				return err
 			}
 			if ok {
@@ -240,7 +226,7 @@ func (a *UnaryDistAgg[T1, T2]) BulkFill(i int64, zs []int64, vecs []*vector.Vect
 	}
 	vs := vector.GetColumn[T1](vec)
 	for j, v := range vs {
-		if ok, err = a.maps[i].Insert(vecs, j); err != nil {
+		if ok, err = a.set.Insert(i, vecs, j); err != nil {
 			return err
 		}
 		if ok {
@@ -268,7 +254,7 @@ func (a *UnaryDistAgg[T1, T2]) Merge(b Agg[any], x, y int64) error {
 		a.otyp = b0.otyp
 	}
 	for _, v := range b0.srcs[y] {
-		if ok, err = a.maps[x].InsertValue(v); err != nil {
+		if ok, err = a.set.InsertValue(x, v); err != nil {
 			return err
 		}
 		if ok {
@@ -296,7 +282,7 @@ func (a *UnaryDistAgg[T1, T2]) BatchMerge(b Agg[any], start int64, os []uint8, v
 			a.otyp = b0.otyp
 		}
 		for _, v := range b0.srcs[k] {
-			if ok, err = a.maps[j].InsertValue(v); err != nil {
+			if ok, err = a.set.InsertValue(int64(j), v); err != nil {
 				return err
 			}
 			if ok {
@@ -312,10 +298,7 @@ func (a *UnaryDistAgg[T1, T2]) Eval(m *mheap.Mheap) (*vector.Vector, error) {
 		a.da = nil
 		a.vs = nil
 		a.es = nil
-		for _, mp := range a.maps {
-			mp.Free()
-		}
-		a.maps = nil
+		a.set.Free()
 	}()
 	nsp := nulls.NewWithSize(len(a.es))
 	if !a.isCount {
@@ -340,3 +323,32 @@ func (a *UnaryDistAgg[T1, T2]) Eval(m *mheap.Mheap) (*vector.Vector, error) {
 	}
 	return vector.NewWithData(a.otyp, a.da, a.eval(a.vs), nsp), nil
 }
+
+// Grows adds n groups to the set
+func (d *DistinctSet) Grows(n int, m *mheap.Mheap) error {
+	for i := 0; i < n; i++ {
+		mp, err := hashmap.NewStrMap(true, 0, 0, m)
+		if err != nil {
+			return err
+		}
+		d.maps = append(d.maps, mp)
+	}
+	return nil
+}
+
+// Insert adds the sel-th row of vecs to the i-th group, it returns false if the row is already in the group
+func (d *DistinctSet) Insert(i int64, vecs []*vector.Vector, sel int) (bool, error) {
+	return d.maps[i].Insert(vecs, sel)
+}
+
+// InsertValue adds v to the i-th group, it returns false if v is already in the group
+func (d *DistinctSet) InsertValue(i int64, v any) (bool, error) {
+	return d.maps[i].InsertValue(v)
+}
+
+func (d *DistinctSet) Free() {
+	for _, mp := range d.maps {
+		mp.Free()
+	}
+	d.maps = nil
+}
@@ -0,0 +1,394 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupconcat
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"unicode/utf8"
+	"unsafe"
+
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+const (
+	// DefaultSeparator is the separator of group_concat without a SEPARATOR clause
+	DefaultSeparator = ","
+	// DefaultMaxLen is the default value of the group_concat_max_len variable
+	DefaultMaxLen = 1024
+)
+
+func ReturnType(_ []types.Type) types.Type {
+	return types.New(types.T_varchar, types.MaxStringSize, 0, 0)
+}
+
+func ArrayReturnType(_ []types.Type) types.Type {
+	return types.New(types.T_json, 0, 0, 0)
+}
+
+func NewGroupConcat(typ types.Type, dist bool) agg.Agg[any] {
+	return &GroupConcat{
+		dist:  dist,
+		otyp:  ReturnType([]types.Type{typ}),
+		ityps: []types.Type{typ},
+	}
+}
+
+func NewArrayAgg(typ types.Type, dist bool) agg.Agg[any] {
+	return &GroupConcat{
+		dist:  dist,
+		array: true,
+		otyp:  ArrayReturnType([]types.Type{typ}),
+		ityps: []types.Type{typ},
+	}
+}
+
+func (a *GroupConcat) String() string {
+	return fmt.Sprintf("%v", a.rows)
+}
+
+func (a *GroupConcat) Free(_ *mheap.Mheap) {
+	a.set.Free()
+	a.rows = nil
+	if a.m != nil {
+		a.m.Decrease(a.size)
+	}
+	a.size = 0
+}
+
+func (a *GroupConcat) Dup() agg.Agg[any] {
+	return &GroupConcat{
+		dist:  a.dist,
+		array: a.array,
+		otyp:  a.otyp,
+		ityps: a.ityps,
+	}
+}
+
+func (a *GroupConcat) OutputType() types.Type {
+	return a.otyp
+}
+
+func (a *GroupConcat) InputTypes() []types.Type {
+	return a.ityps
+}
+
+func (a *GroupConcat) Grows(size int, m *mheap.Mheap) error {
+	a.m = m
+	for i := 0; i < size; i++ {
+		a.rows = append(a.rows, nil)
+	}
+	if a.dist {
+		return a.set.Grows(size, m)
+	}
+	return nil
+}
+
+func (a *GroupConcat) Fill(i int64, sel, z int64, vecs []*vector.Vector) error {
+	return a.fill(i, sel, z, vecs)
+}
+
+func (a *GroupConcat) BatchFill(start int64, os []uint8, vps []uint64, zs []int64, vecs []*vector.Vector) error {
+	for i := range os {
+		if vps[i] == 0 {
+			continue
+		}
+		k := int64(i) + start
+		if err := a.fill(int64(vps[i]-1), k, zs[k], vecs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *GroupConcat) BulkFill(i int64, zs []int64, vecs []*vector.Vector) error {
+	for j := range zs {
+		if err := a.fill(i, int64(j), zs[j], vecs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Merge a[x] += b[y]
+func (a *GroupConcat) Merge(b agg.Agg[any], x, y int64) error {
+	return a.merge(b.(*GroupConcat), x, y)
+}
+
+func (a *GroupConcat) BatchMerge(b agg.Agg[any], start int64, os []uint8, vps []uint64) error {
+	b0 := b.(*GroupConcat)
+	for i := range os {
+		if vps[i] == 0 {
+			continue
+		}
+		if err := a.merge(b0, int64(vps[i]-1), int64(i)+start); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *GroupConcat) Eval(m *mheap.Mheap) (*vector.Vector, error) {
+	defer a.Free(m)
+
+	nsp := nulls.NewWithSize(len(a.rows))
+	vec := vector.New(a.otyp)
+	vec.Nsp = nsp
+	for i, rows := range a.rows {
+		var v []byte
+
+		if len(rows) == 0 {
+			nsp.Set(uint64(i))
+		} else {
+			sort.SliceStable(rows, func(x, y int) bool {
+				return a.less(rows[x].keys, rows[y].keys)
+			})
+			var err error
+			if a.array {
+				v, err = concatArray(rows)
+			} else {
+				v = a.concat(rows)
+			}
+			if err != nil {
+				vec.Free(m)
+				return nil, err
+			}
+		}
+		if err := vec.Append(v, m); err != nil {
+			vec.Free(m)
+			return nil, err
+		}
+	}
+	return vec, nil
+}
+
+func (a *GroupConcat) fill(i int64, sel, z int64, vecs []*vector.Vector) error {
+	a.configure(vecs, sel)
+	vec := vecs[0]
+	isNull := vec.GetNulls().Contains(uint64(sel))
+	if isNull && !a.array {
+		return nil
+	}
+	val, err := a.value(vec, sel, isNull)
+	if err != nil {
+		return err
+	}
+	if a.dist {
+		ok, err := a.set.InsertValue(i, val)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		z = 1
+	}
+	keyVecs := vecs[a.keyStart():]
+	keys := make([]any, len(keyVecs))
+	for j, kvec := range keyVecs {
+		keys[j] = sortKey(kvec, sel)
+	}
+	for ; z > 0; z-- {
+		if err := a.append(i, row{val: val, keys: keys}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// append buffers r in the i-th group, the row is counted against the heap
+func (a *GroupConcat) append(i int64, r row) error {
+	n := rowSize(r)
+	if err := a.m.Increase(n); err != nil {
+		return err
+	}
+	a.size += n
+	a.rows[i] = append(a.rows[i], r)
+	return nil
+}
+
+// rowSize estimates the bytes taken by a buffered row
+func rowSize(r row) int64 {
+	n := int64(unsafe.Sizeof(r)) + int64(len(r.val))
+	for _, k := range r.keys {
+		n += 16
+		if s, ok := k.(string); ok {
+			n += int64(len(s))
+		}
+	}
+	return n
+}
+
+func (a *GroupConcat) merge(b *GroupConcat, x, y int64) error {
+	if !a.configured && b.configured {
+		a.configured = true
+		a.sep, a.maxLen, a.desc = b.sep, b.maxLen, b.desc
+	}
+	for _, r := range b.rows[y] {
+		if a.dist {
+			ok, err := a.set.InsertValue(x, r.val)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+		}
+		if err := a.append(x, r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// configure reads the constant arguments of the aggregation
+func (a *GroupConcat) configure(vecs []*vector.Vector, sel int64) {
+	if a.configured {
+		return
+	}
+	a.configured = true
+	if a.array {
+		a.desc = vector.GetColumn[int64](vecs[1])[sel]
+		return
+	}
+	a.sep = append([]byte{}, vecs[1].GetString(sel)...)
+	a.maxLen = vector.GetColumn[int64](vecs[2])[sel]
+	a.desc = vector.GetColumn[int64](vecs[3])[sel]
+}
+
+func (a *GroupConcat) keyStart() int {
+	if a.array {
+		return 2
+	}
+	return 4
+}
+
+// value returns the bytes of the sel-th value of vec, which is
+// the json text of the value for array_agg, the planner casts the
+// values that have no json counterpart to strings.
+func (a *GroupConcat) value(vec *vector.Vector, sel int64, isNull bool) ([]byte, error) {
+	if !a.array {
+		return append([]byte{}, vec.GetString(sel)...), nil
+	}
+	if isNull {
+		return []byte("null"), nil
+	}
+	var v any
+
+	switch vec.Typ.Oid {
+	case types.T_bool:
+		v = vector.GetColumn[bool](vec)[sel]
+	case types.T_int8:
+		v = vector.GetColumn[int8](vec)[sel]
+	case types.T_int16:
+		v = vector.GetColumn[int16](vec)[sel]
+	case types.T_int32:
+		v = vector.GetColumn[int32](vec)[sel]
+	case types.T_int64:
+		v = vector.GetColumn[int64](vec)[sel]
+	case types.T_uint8:
+		v = vector.GetColumn[uint8](vec)[sel]
+	case types.T_uint16:
+		v = vector.GetColumn[uint16](vec)[sel]
+	case types.T_uint32:
+		v = vector.GetColumn[uint32](vec)[sel]
+	case types.T_uint64:
+		v = vector.GetColumn[uint64](vec)[sel]
+	case types.T_float32:
+		v = vector.GetColumn[float32](vec)[sel]
+	case types.T_float64:
+		v = vector.GetColumn[float64](vec)[sel]
+	case types.T_decimal64:
+		v = json.Number(vector.GetColumn[types.Decimal64](vec)[sel].ToStringWithScale(vec.Typ.Scale))
+	case types.T_decimal128:
+		v = json.Number(vector.GetColumn[types.Decimal128](vec)[sel].ToStringWithScale(vec.Typ.Scale))
+	case types.T_json:
+		data, err := types.DecodeJson(vec.GetString(sel)).MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		v = json.RawMessage(data)
+	case types.T_char, types.T_varchar, types.T_blob:
+		v = string(vec.GetString(sel))
+	default:
+		return nil, fmt.Errorf("'%v' not support array_agg", vec.Typ)
+	}
+	return json.Marshal(v)
+}
+
+func (a *GroupConcat) less(x, y []any) bool {
+	for i := range x {
+		c := compareKey(x[i], y[i])
+		if c == 0 {
+			continue
+		}
+		if a.desc&(1<<i) != 0 {
+			return c > 0
+		}
+		return c < 0
+	}
+	return false
+}
+
+// concat joins the values with the separator, the result is
+// truncated to group_concat_max_len bytes.
+func (a *GroupConcat) concat(rows []row) []byte {
+	var buf bytes.Buffer
+
+	for i, r := range rows {
+		if i > 0 {
+			buf.Write(a.sep)
+		}
+		buf.Write(r.val)
+		if int64(buf.Len()) >= a.maxLen {
+			break
+		}
+	}
+	v := buf.Bytes()
+	if int64(len(v)) > a.maxLen {
+		n := int(a.maxLen)
+		// do not cut a multi-byte character in half
+		for n > 0 && !utf8.RuneStart(v[n]) {
+			n--
+		}
+		v = v[:n]
+	}
+	return v
+}
+
+func concatArray(rows []row) ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteByte('[')
+	for i, r := range rows {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.Write(r.val)
+	}
+	buf.WriteByte(']')
+	bj, err := bytejson.ParseFromByteSlice(buf.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return types.EncodeJson(bj)
+}
@@ -0,0 +1,102 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupconcat
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupConcat(t *testing.T) {
+	tcs := []struct {
+		dist   bool
+		maxLen int64
+		want   string
+	}{
+		{dist: false, maxLen: DefaultMaxLen, want: "a;c;b;a"},
+		{dist: true, maxLen: DefaultMaxLen, want: "c;b;a"},
+		{dist: false, maxLen: 3, want: "a;c"},
+	}
+	for _, tc := range tcs {
+		m := mheap.New(guest.New(1<<30, host.New(1<<30)))
+		typ := types.New(types.T_varchar, 0, 0, 0)
+		vecs := []*vector.Vector{
+			testutil.MakeVarcharVector([]string{"b", "a", "c", "a", ""}, []uint64{4}),
+			testutil.MakeVarcharVector([]string{";", ";", ";", ";", ";"}, nil),
+			testutil.MakeInt64Vector([]int64{tc.maxLen, tc.maxLen, tc.maxLen, tc.maxLen, tc.maxLen}, nil),
+			testutil.MakeInt64Vector([]int64{1, 1, 1, 1, 1}, nil),
+			testutil.MakeInt64Vector([]int64{2, 1, 3, 4, 0}, nil),
+		}
+
+		agg0, agg1 := NewGroupConcat(typ, tc.dist), NewGroupConcat(typ, tc.dist)
+		require.NoError(t, agg0.Grows(1, m))
+		require.NoError(t, agg1.Grows(1, m))
+		require.NoError(t, agg0.BulkFill(0, []int64{1, 1, 0, 0, 1}, vecs))
+		require.NoError(t, agg1.BulkFill(0, []int64{0, 0, 1, 1, 0}, vecs))
+		require.NoError(t, agg0.Merge(agg1, 0, 0))
+		v, err := agg0.Eval(m)
+		require.NoError(t, err)
+		require.Equal(t, tc.want, string(v.GetString(0)))
+		v.Free(m)
+		agg1.Free(m)
+		require.Equal(t, int64(0), m.Size())
+	}
+}
+
+func TestArrayAgg(t *testing.T) {
+	m := mheap.New(guest.New(1<<30, host.New(1<<30)))
+	typ := types.New(types.T_int64, 0, 0, 0)
+	vec := testutil.MakeInt64Vector([]int64{3, 1, 0, 2}, []uint64{2})
+	vecs := []*vector.Vector{vec, testutil.MakeInt64Vector([]int64{0, 0, 0, 0}, nil), vec}
+
+	a := NewArrayAgg(typ, false)
+	require.NoError(t, a.Grows(2, m))
+	require.NoError(t, a.BatchFill(0, []uint8{1, 1, 1, 1}, []uint64{1, 1, 1, 0}, []int64{1, 1, 1, 1}, vecs))
+	v, err := a.Eval(m)
+	require.NoError(t, err)
+	// the null is kept and sorted first, the second group is empty
+	require.Equal(t, "[null, 1, 3]", types.DecodeJson(v.GetString(0)).String())
+	require.True(t, v.GetNulls().Contains(1))
+	v.Free(m)
+	require.Equal(t, int64(0), m.Size())
+}
+
+func TestGroupConcatMemory(t *testing.T) {
+	m := mheap.New(guest.New(256, host.New(1<<30)))
+	typ := types.New(types.T_varchar, 0, 0, 0)
+	vecs := []*vector.Vector{
+		testutil.MakeVarcharVector([]string{"a", strings.Repeat("b", 256)}, nil),
+		testutil.MakeVarcharVector([]string{",", ","}, nil),
+		testutil.MakeInt64Vector([]int64{DefaultMaxLen, DefaultMaxLen}, nil),
+		testutil.MakeInt64Vector([]int64{0, 0}, nil),
+	}
+
+	a := NewGroupConcat(typ, false)
+	require.NoError(t, a.Grows(1, m))
+	// the buffered rows are counted against the heap
+	require.NoError(t, a.Fill(0, 0, 1, vecs))
+	require.Greater(t, m.Size(), int64(0))
+	require.Error(t, a.Fill(0, 1, 1, vecs))
+	a.Free(m)
+	require.Equal(t, int64(0), m.Size())
+}
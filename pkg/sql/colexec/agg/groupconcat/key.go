@@ -0,0 +1,118 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupconcat
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// sortKey returns the sel-th value of vec in a form that compareKey
+// can order, nil stands for null.
+func sortKey(vec *vector.Vector, sel int64) any {
+	if vec.GetNulls().Contains(uint64(sel)) {
+		return nil
+	}
+	switch vec.Typ.Oid {
+	case types.T_bool:
+		return vector.GetColumn[bool](vec)[sel]
+	case types.T_int8:
+		return int64(vector.GetColumn[int8](vec)[sel])
+	case types.T_int16:
+		return int64(vector.GetColumn[int16](vec)[sel])
+	case types.T_int32:
+		return int64(vector.GetColumn[int32](vec)[sel])
+	case types.T_int64:
+		return vector.GetColumn[int64](vec)[sel]
+	case types.T_uint8:
+		return uint64(vector.GetColumn[uint8](vec)[sel])
+	case types.T_uint16, types.T_enum:
+		return uint64(vector.GetColumn[uint16](vec)[sel])
+	case types.T_uint32:
+		return uint64(vector.GetColumn[uint32](vec)[sel])
+	case types.T_uint64, types.T_set, types.T_bit:
+		return vector.GetColumn[uint64](vec)[sel]
+	case types.T_float32:
+		return float64(vector.GetColumn[float32](vec)[sel])
+	case types.T_float64:
+		return vector.GetColumn[float64](vec)[sel]
+	case types.T_date:
+		return int64(vector.GetColumn[types.Date](vec)[sel])
+	case types.T_datetime:
+		return int64(vector.GetColumn[types.Datetime](vec)[sel])
+	case types.T_time:
+		return int64(vector.GetColumn[types.Time](vec)[sel])
+	case types.T_timestamp:
+		return int64(vector.GetColumn[types.Timestamp](vec)[sel])
+	case types.T_decimal64:
+		return vector.GetColumn[types.Decimal64](vec)[sel]
+	case types.T_decimal128:
+		return vector.GetColumn[types.Decimal128](vec)[sel]
+	case types.T_uuid:
+		v := vector.GetColumn[types.Uuid](vec)[sel]
+		return string(v[:])
+	default:
+		return string(vec.GetString(sel))
+	}
+}
+
+// compareKey compares two keys returned by sortKey,
+// null is smaller than any other value.
+func compareKey(x, y any) int {
+	if x == nil || y == nil {
+		switch {
+		case x == y:
+			return 0
+		case x == nil:
+			return -1
+		default:
+			return 1
+		}
+	}
+	switch v := x.(type) {
+	case bool:
+		return compare(boolToInt(v), boolToInt(y.(bool)))
+	case int64:
+		return compare(v, y.(int64))
+	case uint64:
+		return compare(v, y.(uint64))
+	case float64:
+		return compare(v, y.(float64))
+	case string:
+		return compare(v, y.(string))
+	case types.Decimal64:
+		return types.CompareDecimal64(v, y.(types.Decimal64))
+	case types.Decimal128:
+		return types.CompareDecimal128(v, y.(types.Decimal128))
+	}
+	return 0
+}
+
+func compare[T int64 | uint64 | float64 | string](x, y T) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+func boolToInt(v bool) int64 {
+	if v {
+		return 1
+	}
+	return 0
+}
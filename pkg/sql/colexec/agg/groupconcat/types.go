@@ -0,0 +1,65 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupconcat
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+// row is a value of a group together with the keys it is ordered by
+type row struct {
+	val  []byte
+	keys []any
+}
+
+// GroupConcat collects the values of every group and concatenates them
+// in the order of the keys when the groups are evaluated, it is used by
+// both group_concat and array_agg.
+//
+// The input vectors of group_concat are
+//
+//	value, separator, max length, descending flags, keys...
+//
+// and the input vectors of array_agg are
+//
+//	value, descending flags, keys...
+//
+// all arguments except the value and the keys are constants appended by
+// the planner. The i-th bit of the descending flags is set if the i-th
+// key is sorted in descending order.
+type GroupConcat struct {
+	dist bool
+	// array is true for array_agg, whose result is a json array
+	array bool
+
+	otyp  types.Type
+	ityps []types.Type
+
+	// configured is true once the constant arguments have been read
+	configured bool
+	sep        []byte
+	maxLen     int64
+	desc       int64
+
+	rows [][]row
+	// set is used to remove the duplicate values of every group if dist is true
+	set agg.DistinctSet
+
+	// m is the heap the buffered rows are counted against, size is the bytes of them
+	m    *mheap.Mheap
+	size int64
+}
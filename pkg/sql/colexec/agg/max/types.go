@@ -32,6 +32,9 @@ type Decimal64Max struct {
 type Decimal128Max struct {
 }
 
+type UuidMax struct {
+}
+
 type BoolMax struct {
 }
 
@@ -31,6 +31,9 @@ type Decimal64Min struct {
 type Decimal128Min struct {
 }
 
+type UuidMin struct {
+}
+
 type BoolMin struct {
 }
 
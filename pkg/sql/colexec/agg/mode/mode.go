@@ -0,0 +1,133 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mode
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"golang.org/x/exp/constraints"
+)
+
+func ReturnType(typs []types.Type) types.Type {
+	return typs[0]
+}
+
+type Ordered interface {
+	constraints.Integer | constraints.Float
+}
+
+func NewMode[T comparable](less func(T, T) bool) *Mode[T] {
+	return &Mode[T]{less: less}
+}
+
+// NewOrderedMode creates a mode for types which support the < operator
+func NewOrderedMode[T Ordered]() *Mode[T] {
+	return NewMode(func(a, b T) bool { return a < b })
+}
+
+func NewStrMode() *StrMode {
+	return &StrMode{}
+}
+
+func (m *Mode[T]) Grows(size int) {
+	for i := 0; i < size; i++ {
+		m.counts = append(m.counts, nil)
+	}
+}
+
+func (m *Mode[T]) Eval(vs []T) []T {
+	for i := range vs {
+		var best int64
+
+		for v, n := range m.counts[i] {
+			if n > best || (n == best && m.less(v, vs[i])) {
+				vs[i], best = v, n
+			}
+		}
+		m.counts[i] = nil
+	}
+	return vs
+}
+
+func (m *Mode[T]) Merge(groupIndex1, groupIndex2 int64, x, _ T, isEmpty1 bool, isEmpty2 bool, agg any) (T, bool) {
+	if isEmpty2 {
+		return x, isEmpty1
+	}
+	m2 := agg.(*Mode[T])
+	if m.counts[groupIndex1] == nil {
+		m.counts[groupIndex1] = make(map[T]int64)
+	}
+	for v, n := range m2.counts[groupIndex2] {
+		m.counts[groupIndex1][v] += n
+	}
+	return x, false
+}
+
+func (m *Mode[T]) Fill(groupIndex int64, v T, x T, z int64, isEmpty bool, hasNull bool) (T, bool) {
+	if hasNull {
+		return x, isEmpty
+	}
+	if m.counts[groupIndex] == nil {
+		m.counts[groupIndex] = make(map[T]int64)
+	}
+	m.counts[groupIndex][v] += z
+	return x, false
+}
+
+func (m *StrMode) Grows(size int) {
+	for i := 0; i < size; i++ {
+		m.counts = append(m.counts, nil)
+	}
+}
+
+func (m *StrMode) Eval(vs [][]byte) [][]byte {
+	for i := range vs {
+		var best int64
+		var mode string
+
+		for v, n := range m.counts[i] {
+			if n > best || (n == best && v < mode) {
+				mode, best = v, n
+			}
+		}
+		vs[i] = []byte(mode)
+		m.counts[i] = nil
+	}
+	return vs
+}
+
+func (m *StrMode) Merge(groupIndex1, groupIndex2 int64, x, _ []byte, isEmpty1 bool, isEmpty2 bool, agg any) ([]byte, bool) {
+	if isEmpty2 {
+		return x, isEmpty1
+	}
+	m2 := agg.(*StrMode)
+	if m.counts[groupIndex1] == nil {
+		m.counts[groupIndex1] = make(map[string]int64)
+	}
+	for v, n := range m2.counts[groupIndex2] {
+		m.counts[groupIndex1][v] += n
+	}
+	return x, false
+}
+
+func (m *StrMode) Fill(groupIndex int64, v []byte, x []byte, z int64, isEmpty bool, hasNull bool) ([]byte, bool) {
+	if hasNull {
+		return x, isEmpty
+	}
+	if m.counts[groupIndex] == nil {
+		m.counts[groupIndex] = make(map[string]int64)
+	}
+	m.counts[groupIndex][string(v)] += z
+	return x, false
+}
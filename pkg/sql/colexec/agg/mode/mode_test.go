@@ -0,0 +1,65 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mode
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMode(t *testing.T) {
+	m := mheap.New(guest.New(1<<30, host.New(1<<30)))
+	typ := types.New(types.T_int64, 0, 0, 0)
+	vec := testutil.MakeInt64Vector([]int64{3, 1, 3, 1, 2, 2}, []uint64{5})
+
+	p0, p1 := NewOrderedMode[int64](), NewOrderedMode[int64]()
+	agg0 := agg.NewUnaryAgg(p0, false, typ, ReturnType([]types.Type{typ}), p0.Grows, p0.Eval, p0.Merge, p0.Fill, nil)
+	agg1 := agg.NewUnaryAgg(p1, false, typ, ReturnType([]types.Type{typ}), p1.Grows, p1.Eval, p1.Merge, p1.Fill, nil)
+	require.NoError(t, agg0.Grows(1, m))
+	require.NoError(t, agg1.Grows(1, m))
+	require.NoError(t, agg0.BulkFill(0, []int64{1, 1, 1, 0, 0, 0}, []*vector.Vector{vec}))
+	require.NoError(t, agg1.BulkFill(0, []int64{0, 0, 0, 1, 2, 1}, []*vector.Vector{vec}))
+	require.NoError(t, agg0.Merge(agg1, 0, 0))
+	// 1, 2 and 3 all appear twice, the smallest one is chosen
+	v, err := agg0.Eval(m)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1}, vector.GetColumn[int64](v))
+	v.Free(m)
+	agg1.Free(m)
+	require.Equal(t, int64(0), m.Size())
+}
+
+func TestStrMode(t *testing.T) {
+	m := mheap.New(guest.New(1<<30, host.New(1<<30)))
+	typ := types.New(types.T_varchar, 0, 0, 0)
+	vec := testutil.MakeVarcharVector([]string{"b", "a", "b", "c"}, nil)
+
+	p := NewStrMode()
+	a := agg.NewUnaryAgg(p, false, typ, ReturnType([]types.Type{typ}), p.Grows, p.Eval, p.Merge, p.Fill, nil)
+	require.NoError(t, a.Grows(1, m))
+	require.NoError(t, a.BulkFill(0, []int64{1, 1, 1, 1}, []*vector.Vector{vec}))
+	v, err := a.Eval(m)
+	require.NoError(t, err)
+	require.Equal(t, []byte("b"), v.GetString(0))
+	v.Free(m)
+}
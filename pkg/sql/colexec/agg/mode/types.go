@@ -0,0 +1,28 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mode
+
+// Mode counts the occurrences of every value of a group, ties are
+// broken by choosing the smallest value so that the result does not
+// depend on the order rows are filled and merged.
+type Mode[T comparable] struct {
+	less   func(T, T) bool
+	counts []map[T]int64
+}
+
+// StrMode is the mode for string types
+type StrMode struct {
+	counts []map[string]int64
+}
@@ -0,0 +1,127 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package percentile
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+func ReturnType(_ []types.Type) types.Type {
+	return types.New(types.T_float64, 0, 0, 0)
+}
+
+// NewMedian is used to create the median aggregation, it equals to percentile_cont(0.5)
+func NewMedian() *Percentile {
+	return &Percentile{fraction: 0.5}
+}
+
+// NewCont is used to create percentile_cont(x, fraction)
+func NewCont() *Percentile {
+	return &Percentile{fraction: -1}
+}
+
+// NewDisc is used to create percentile_disc(x, fraction)
+func NewDisc() *Percentile {
+	return &Percentile{fraction: -1, disc: true}
+}
+
+func (p *Percentile) Grows(size int) {
+	for i := 0; i < size; i++ {
+		p.vals = append(p.vals, nil)
+		p.fractions = append(p.fractions, p.fraction)
+	}
+}
+
+func (p *Percentile) Eval(vs []float64) []float64 {
+	for i := range vs {
+		vals := p.vals[i]
+		if len(vals) == 0 {
+			continue
+		}
+		sort.Float64s(vals)
+		if p.disc {
+			vs[i] = disc(vals, p.fractions[i])
+		} else {
+			vs[i] = cont(vals, p.fractions[i])
+		}
+		p.vals[i] = nil
+	}
+	return vs
+}
+
+func (p *Percentile) Merge(groupIndex1, groupIndex2 int64, x, y float64, isEmpty1 bool, isEmpty2 bool, agg any) (float64, bool) {
+	if isEmpty2 {
+		return x, isEmpty1
+	}
+	p2 := agg.(*Percentile)
+	p.vals[groupIndex1] = append(p.vals[groupIndex1], p2.vals[groupIndex2]...)
+	if isEmpty1 {
+		p.fractions[groupIndex1] = p2.fractions[groupIndex2]
+	}
+	return x, false
+}
+
+// Fill is the fill function of median
+func (p *Percentile) Fill(groupIndex int64, v float64, x float64, z int64, isEmpty bool, hasNull bool) (float64, bool) {
+	if hasNull {
+		return x, isEmpty
+	}
+	for ; z > 0; z-- {
+		p.vals[groupIndex] = append(p.vals[groupIndex], v)
+	}
+	return x, false
+}
+
+// FillWithFraction is the fill function of percentile_cont and percentile_disc,
+// the fraction must be the same for all rows of a group.
+func (p *Percentile) FillWithFraction(groupIndex int64, v float64, fraction float64, x float64, z int64, isEmpty bool, hasNull bool) (float64, bool, error) {
+	if hasNull {
+		return x, isEmpty, nil
+	}
+	if fraction < 0 || fraction > 1 || math.IsNaN(fraction) {
+		return x, isEmpty, fmt.Errorf("percentile value %v is not between 0 and 1", fraction)
+	}
+	if isEmpty {
+		p.fractions[groupIndex] = fraction
+	} else if p.fractions[groupIndex] != fraction {
+		return x, isEmpty, fmt.Errorf("percentile value must be constant within a group")
+	}
+	x, isEmpty = p.Fill(groupIndex, v, x, z, isEmpty, hasNull)
+	return x, isEmpty, nil
+}
+
+// cont interpolates linearly between the two values nearest to the fraction
+func cont(vals []float64, fraction float64) float64 {
+	rank := fraction * float64(len(vals)-1)
+	lo := math.Floor(rank)
+	hi := math.Ceil(rank)
+	if lo == hi {
+		return vals[int(lo)]
+	}
+	return vals[int(lo)] + (rank-lo)*(vals[int(hi)]-vals[int(lo)])
+}
+
+// disc returns the first value whose cumulative distribution is not less than the fraction
+func disc(vals []float64, fraction float64) float64 {
+	idx := int(math.Ceil(fraction*float64(len(vals)))) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	return vals[idx]
+}
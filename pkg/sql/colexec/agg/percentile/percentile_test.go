@@ -0,0 +1,93 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package percentile
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+var float64Type = types.New(types.T_float64, 0, 0, 0)
+
+func TestMedian(t *testing.T) {
+	m := mheap.New(guest.New(1<<30, host.New(1<<30)))
+	vec := testutil.MakeFloat64Vector([]float64{4, 1, 3, 0, 2}, []uint64{3})
+
+	p0, p1 := NewMedian(), NewMedian()
+	agg0 := agg.NewUnaryAgg(p0, false, float64Type, float64Type, p0.Grows, p0.Eval, p0.Merge, p0.Fill, nil)
+	agg1 := agg.NewUnaryAgg(p1, false, float64Type, float64Type, p1.Grows, p1.Eval, p1.Merge, p1.Fill, nil)
+	require.NoError(t, agg0.Grows(2, m))
+	require.NoError(t, agg1.Grows(1, m))
+	// group 0 gets 4 and 1, group 1 keeps empty
+	require.NoError(t, agg0.Fill(0, 0, 1, []*vector.Vector{vec}))
+	require.NoError(t, agg0.Fill(0, 1, 1, []*vector.Vector{vec}))
+	// the partial result 3, null, 2 is merged into group 0
+	require.NoError(t, agg1.BulkFill(0, []int64{0, 0, 1, 1, 1}, []*vector.Vector{vec}))
+	require.NoError(t, agg0.Merge(agg1, 0, 0))
+
+	v, err := agg0.Eval(m)
+	require.NoError(t, err)
+	require.Equal(t, 2.5, vector.GetColumn[float64](v)[0])
+	require.True(t, v.Nsp.Contains(1))
+	v.Free(m)
+	agg1.Free(m)
+	require.Equal(t, int64(0), m.Size())
+}
+
+func TestPercentile(t *testing.T) {
+	m := mheap.New(guest.New(1<<30, host.New(1<<30)))
+	xs := testutil.MakeFloat64Vector([]float64{10, 40, 20, 30}, nil)
+
+	for _, c := range []struct {
+		disc     bool
+		fraction float64
+		want     float64
+	}{
+		{false, 0.5, 25},
+		{false, 0.25, 17.5},
+		{false, 1, 40},
+		{true, 0.5, 20},
+		{true, 0.6, 30},
+		{true, 0, 10},
+	} {
+		p := NewCont()
+		if c.disc {
+			p = NewDisc()
+		}
+		fs := testutil.MakeScalarFloat64(c.fraction, 4)
+		a := agg.NewBinaryAgg(p, []types.Type{float64Type, float64Type}, float64Type, p.Grows, p.Eval, p.Merge, p.FillWithFraction)
+		require.NoError(t, a.Grows(1, m))
+		require.NoError(t, a.BulkFill(0, []int64{1, 1, 1, 1}, []*vector.Vector{xs, fs}))
+		v, err := a.Eval(m)
+		require.NoError(t, err)
+		require.Equal(t, c.want, vector.GetColumn[float64](v)[0])
+		v.Free(m)
+	}
+
+	p := NewCont()
+	a := agg.NewBinaryAgg(p, []types.Type{float64Type, float64Type}, float64Type, p.Grows, p.Eval, p.Merge, p.FillWithFraction)
+	require.NoError(t, a.Grows(1, m))
+	require.Error(t, a.Fill(0, 0, 1, []*vector.Vector{xs, testutil.MakeScalarFloat64(1.5, 4)}))
+	a.Free(m)
+	require.Equal(t, int64(0), m.Size())
+}
@@ -0,0 +1,30 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package percentile
+
+// Percentile keeps every value of a group, the partial state of two groups
+// is merged by concatenating their values.
+type Percentile struct {
+	// disc is true for percentile_disc, which returns an input value
+	// instead of interpolating between the two nearest ones
+	disc bool
+	// fraction is the fixed percentile of median, it is negative for
+	// percentile_cont and percentile_disc, whose fraction is an argument
+	fraction float64
+	// fractions[i] is the percentile of the i-th group
+	fractions []float64
+	// vals[i] is the value list of the i-th group
+	vals [][]float64
+}
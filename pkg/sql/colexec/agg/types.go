@@ -134,7 +134,8 @@ type UnaryDistAgg[T1, T2 any] struct {
 	// iscount is true,  it means that the aggregation function is count
 	isCount bool
 
-	maps []*hashmap.StrHashMap
+	// set keeps the distinct values of every group
+	set DistinctSet
 
 	// raw values of input vectors
 	srcs [][]T1
@@ -166,3 +167,41 @@ type UnaryDistAgg[T1, T2 any] struct {
 	//  sixth represents whether the value to be fed is null
 	fill func(int64, T1, T2, int64, bool, bool) (T2, bool)
 }
+
+// DistinctSet keeps the distinct values of every group of an aggregation with distinct
+type DistinctSet struct {
+	maps []*hashmap.StrHashMap
+}
+
+// BinaryAgg generic aggregation function with two fixed-size input vectors and without distinct,
+// such as corr(x, y) or percentile_cont(x, fraction)
+type BinaryAgg[T1, T2, T3 any] struct {
+	priv any
+
+	// vs is result value list
+	vs []T3
+	// es, es[i] is true to indicate that this group has not yet been populated with any value
+	es []bool
+	// memory of vs
+	da []byte
+
+	// otyp is output vecotr's type
+	otyp types.Type
+	// ityps is type list of input vectors
+	ityps []types.Type
+
+	// grows used for add groups
+	grows func(int)
+	// eval used to get final aggregated value
+	eval func([]T3) []T3
+	// merge has the same arguments as the merge of UnaryAgg
+	merge func(int64, int64, T3, T3, bool, bool, any) (T3, bool)
+	// fill
+	//  first argument is the group number to be filled
+	// 	second and third parameters are the values to be fed
+	//	fourth is the value of the group to be filled
+	// 	fifth is the number of times the values need to be fed
+	//  sixth represents whether it is a new group
+	//  seventh represents whether one of the values to be fed is null
+	fill func(int64, T1, T2, T3, int64, bool, bool) (T3, bool, error)
+}
@@ -26,8 +26,12 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg/bit_or"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg/bit_xor"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg/count"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg/covariance"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg/groupconcat"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg/max"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg/min"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg/mode"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg/percentile"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg/stddevpop"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg/sum"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg/variance"
@@ -59,6 +63,20 @@ func ReturnType(op int, typ types.Type) (types.Type, error) {
 		otyp = bit_xor.ReturnType([]types.Type{typ})
 	case StdDevPop:
 		otyp = stddevpop.ReturnType([]types.Type{typ})
+	case Median, PercentileCont, PercentileDisc:
+		if typ.Oid == types.T_float64 {
+			otyp = percentile.ReturnType([]types.Type{typ})
+		}
+	case Corr, CovarPop, CovarSample, VarSample, StdDevSample:
+		if typ.Oid == types.T_float64 {
+			otyp = covariance.ReturnType([]types.Type{typ})
+		}
+	case Mode:
+		otyp = mode.ReturnType([]types.Type{typ})
+	case GroupConcat:
+		otyp = groupconcat.ReturnType([]types.Type{typ})
+	case ArrayAgg:
+		otyp = groupconcat.ArrayReturnType([]types.Type{typ})
 	}
 	if otyp.Oid == types.T_any {
 		return typ, fmt.Errorf("'%v' not support %s", typ, Names[op])
@@ -94,6 +112,19 @@ func New(op int, dist bool, typ types.Type) (agg.Agg[any], error) {
 		return NewStdDevPop(typ, dist), nil
 	case AnyValue:
 		return NewAnyValue(typ, dist), nil
+	case Mode:
+		return NewMode(typ, dist), nil
+	case Median, VarSample, StdDevSample:
+		return newFloat64Agg(op, typ, dist)
+	case PercentileCont, PercentileDisc, Corr, CovarPop, CovarSample:
+		if dist {
+			return nil, fmt.Errorf("distinct is not supported for aggregate %s", Names[op])
+		}
+		return newFloat64BinaryAgg(op, typ)
+	case GroupConcat:
+		return groupconcat.NewGroupConcat(typ, dist), nil
+	case ArrayAgg:
+		return groupconcat.NewArrayAgg(typ, dist), nil
 	}
 	panic(fmt.Errorf("unsupport type '%s' for aggregate %s", typ, Names[op]))
 }
@@ -116,7 +147,7 @@ func NewCount(typ types.Type, dist bool, isStar bool) agg.Agg[any] {
 		return newGenericCount[uint16](typ, dist, isStar)
 	case types.T_uint32:
 		return newGenericCount[uint32](typ, dist, isStar)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		return newGenericCount[uint64](typ, dist, isStar)
 	case types.T_float32:
 		return newGenericCount[float32](typ, dist, isStar)
@@ -124,7 +155,7 @@ func NewCount(typ types.Type, dist bool, isStar bool) agg.Agg[any] {
 		return newGenericCount[float64](typ, dist, isStar)
 	case types.T_char:
 		return newGenericCount[[]byte](typ, dist, isStar)
-	case types.T_varchar:
+	case types.T_varchar, types.T_binary, types.T_varbinary:
 		return newGenericCount[[]byte](typ, dist, isStar)
 	case types.T_blob:
 		return newGenericCount[[]byte](typ, dist, isStar)
@@ -132,12 +163,16 @@ func NewCount(typ types.Type, dist bool, isStar bool) agg.Agg[any] {
 		return newGenericCount[types.Date](typ, dist, isStar)
 	case types.T_datetime:
 		return newGenericCount[types.Datetime](typ, dist, isStar)
+	case types.T_time:
+		return newGenericCount[types.Time](typ, dist, isStar)
 	case types.T_timestamp:
 		return newGenericCount[types.Timestamp](typ, dist, isStar)
 	case types.T_decimal64:
 		return newGenericCount[types.Decimal64](typ, dist, isStar)
 	case types.T_decimal128:
 		return newGenericCount[types.Decimal128](typ, dist, isStar)
+	case types.T_uuid:
+		return newGenericCount[types.Uuid](typ, dist, isStar)
 	}
 	panic(fmt.Errorf("unsupport type '%s' for anyvalue", typ))
 }
@@ -160,7 +195,7 @@ func NewAnyValue(typ types.Type, dist bool) agg.Agg[any] {
 		return newGenericAnyValue[uint16](typ, dist)
 	case types.T_uint32:
 		return newGenericAnyValue[uint32](typ, dist)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		return newGenericAnyValue[uint64](typ, dist)
 	case types.T_float32:
 		return newGenericAnyValue[float32](typ, dist)
@@ -168,7 +203,7 @@ func NewAnyValue(typ types.Type, dist bool) agg.Agg[any] {
 		return newGenericAnyValue[float64](typ, dist)
 	case types.T_char:
 		return newGenericAnyValue[[]byte](typ, dist)
-	case types.T_varchar:
+	case types.T_varchar, types.T_binary, types.T_varbinary:
 		return newGenericAnyValue[[]byte](typ, dist)
 	case types.T_blob:
 		return newGenericAnyValue[[]byte](typ, dist)
@@ -176,12 +211,16 @@ func NewAnyValue(typ types.Type, dist bool) agg.Agg[any] {
 		return newGenericAnyValue[types.Date](typ, dist)
 	case types.T_datetime:
 		return newGenericAnyValue[types.Datetime](typ, dist)
+	case types.T_time:
+		return newGenericAnyValue[types.Time](typ, dist)
 	case types.T_timestamp:
 		return newGenericAnyValue[types.Timestamp](typ, dist)
 	case types.T_decimal64:
 		return newGenericAnyValue[types.Decimal64](typ, dist)
 	case types.T_decimal128:
 		return newGenericAnyValue[types.Decimal128](typ, dist)
+	case types.T_uuid:
+		return newGenericAnyValue[types.Uuid](typ, dist)
 	}
 	panic(fmt.Errorf("unsupport type '%s' for anyvalue", typ))
 }
@@ -284,7 +323,7 @@ func NewMax(typ types.Type, dist bool) agg.Agg[any] {
 		return newGenericMax[uint16](typ, dist)
 	case types.T_uint32:
 		return newGenericMax[uint32](typ, dist)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		return newGenericMax[uint64](typ, dist)
 	case types.T_float32:
 		return newGenericMax[float32](typ, dist)
@@ -296,7 +335,7 @@ func NewMax(typ types.Type, dist bool) agg.Agg[any] {
 			return agg.NewUnaryDistAgg(false, typ, max.ReturnType([]types.Type{typ}), aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill)
 		}
 		return agg.NewUnaryAgg(aggPriv, false, typ, max.ReturnType([]types.Type{typ}), aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill, nil)
-	case types.T_varchar:
+	case types.T_varchar, types.T_binary, types.T_varbinary:
 		aggPriv := max.NewStrMax()
 		if dist {
 			return agg.NewUnaryDistAgg(false, typ, max.ReturnType([]types.Type{typ}), aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill)
@@ -312,6 +351,8 @@ func NewMax(typ types.Type, dist bool) agg.Agg[any] {
 		return newGenericMax[types.Date](typ, dist)
 	case types.T_datetime:
 		return newGenericMax[types.Datetime](typ, dist)
+	case types.T_time:
+		return newGenericMax[types.Time](typ, dist)
 	case types.T_timestamp:
 		return newGenericMax[types.Timestamp](typ, dist)
 	case types.T_decimal64:
@@ -326,6 +367,12 @@ func NewMax(typ types.Type, dist bool) agg.Agg[any] {
 			return agg.NewUnaryDistAgg(false, typ, max.ReturnType([]types.Type{typ}), aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill)
 		}
 		return agg.NewUnaryAgg(aggPriv, false, typ, max.ReturnType([]types.Type{typ}), aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill, nil)
+	case types.T_uuid:
+		aggPriv := max.NewUuidMax()
+		if dist {
+			return agg.NewUnaryDistAgg(false, typ, max.ReturnType([]types.Type{typ}), aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill)
+		}
+		return agg.NewUnaryAgg(aggPriv, false, typ, max.ReturnType([]types.Type{typ}), aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill, nil)
 	}
 	panic(fmt.Errorf("unsupport type '%s' for anyvalue", typ))
 }
@@ -352,7 +399,7 @@ func NewMin(typ types.Type, dist bool) agg.Agg[any] {
 		return newGenericMin[uint16](typ, dist)
 	case types.T_uint32:
 		return newGenericMin[uint32](typ, dist)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		return newGenericMin[uint64](typ, dist)
 	case types.T_float32:
 		return newGenericMin[float32](typ, dist)
@@ -364,7 +411,7 @@ func NewMin(typ types.Type, dist bool) agg.Agg[any] {
 			return agg.NewUnaryDistAgg(false, typ, min.ReturnType([]types.Type{typ}), aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill)
 		}
 		return agg.NewUnaryAgg(aggPriv, false, typ, min.ReturnType([]types.Type{typ}), aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill, nil)
-	case types.T_varchar:
+	case types.T_varchar, types.T_binary, types.T_varbinary:
 		aggPriv := min.NewStrMin()
 		if dist {
 			return agg.NewUnaryDistAgg(false, typ, min.ReturnType([]types.Type{typ}), aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill)
@@ -380,6 +427,8 @@ func NewMin(typ types.Type, dist bool) agg.Agg[any] {
 		return newGenericMin[types.Date](typ, dist)
 	case types.T_datetime:
 		return newGenericMin[types.Datetime](typ, dist)
+	case types.T_time:
+		return newGenericMin[types.Time](typ, dist)
 	case types.T_timestamp:
 		return newGenericMin[types.Timestamp](typ, dist)
 	case types.T_decimal64:
@@ -394,6 +443,12 @@ func NewMin(typ types.Type, dist bool) agg.Agg[any] {
 			return agg.NewUnaryDistAgg(false, typ, min.ReturnType([]types.Type{typ}), aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill)
 		}
 		return agg.NewUnaryAgg(aggPriv, false, typ, min.ReturnType([]types.Type{typ}), aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill, nil)
+	case types.T_uuid:
+		aggPriv := min.NewUuidMin()
+		if dist {
+			return agg.NewUnaryDistAgg(false, typ, min.ReturnType([]types.Type{typ}), aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill)
+		}
+		return agg.NewUnaryAgg(aggPriv, false, typ, min.ReturnType([]types.Type{typ}), aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill, nil)
 	}
 	panic(fmt.Errorf("unsupport type '%s' for anyvalue", typ))
 }
@@ -416,7 +471,7 @@ func NewApprox(typ types.Type, dist bool) agg.Agg[any] {
 		return newGenericApproxcd[uint16](typ, dist)
 	case types.T_uint32:
 		return newGenericApproxcd[uint32](typ, dist)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		return newGenericApproxcd[uint64](typ, dist)
 	case types.T_float32:
 		return newGenericApproxcd[float32](typ, dist)
@@ -424,7 +479,7 @@ func NewApprox(typ types.Type, dist bool) agg.Agg[any] {
 		return newGenericApproxcd[float64](typ, dist)
 	case types.T_char:
 		return newGenericApproxcd[[]byte](typ, dist)
-	case types.T_varchar:
+	case types.T_varchar, types.T_binary, types.T_varbinary:
 		return newGenericApproxcd[[]byte](typ, dist)
 	case types.T_blob:
 		return newGenericApproxcd[[]byte](typ, dist)
@@ -432,6 +487,8 @@ func NewApprox(typ types.Type, dist bool) agg.Agg[any] {
 		return newGenericApproxcd[types.Date](typ, dist)
 	case types.T_datetime:
 		return newGenericApproxcd[types.Datetime](typ, dist)
+	case types.T_time:
+		return newGenericApproxcd[types.Time](typ, dist)
 	case types.T_timestamp:
 		return newGenericApproxcd[types.Timestamp](typ, dist)
 	case types.T_decimal64:
@@ -598,6 +655,115 @@ func NewStdDevPop(typ types.Type, dist bool) agg.Agg[any] {
 	panic(fmt.Errorf("unsupport type '%s' for avg", typ))
 }
 
+func NewMode(typ types.Type, dist bool) agg.Agg[any] {
+	switch typ.Oid {
+	case types.T_bool:
+		return newGenericMode(typ, dist, mode.NewMode(func(a, b bool) bool { return !a && b }))
+	case types.T_int8:
+		return newGenericMode(typ, dist, mode.NewOrderedMode[int8]())
+	case types.T_int16:
+		return newGenericMode(typ, dist, mode.NewOrderedMode[int16]())
+	case types.T_int32:
+		return newGenericMode(typ, dist, mode.NewOrderedMode[int32]())
+	case types.T_int64:
+		return newGenericMode(typ, dist, mode.NewOrderedMode[int64]())
+	case types.T_uint8:
+		return newGenericMode(typ, dist, mode.NewOrderedMode[uint8]())
+	case types.T_uint16:
+		return newGenericMode(typ, dist, mode.NewOrderedMode[uint16]())
+	case types.T_uint32:
+		return newGenericMode(typ, dist, mode.NewOrderedMode[uint32]())
+	case types.T_uint64, types.T_bit:
+		return newGenericMode(typ, dist, mode.NewOrderedMode[uint64]())
+	case types.T_float32:
+		return newGenericMode(typ, dist, mode.NewOrderedMode[float32]())
+	case types.T_float64:
+		return newGenericMode(typ, dist, mode.NewOrderedMode[float64]())
+	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_blob:
+		aggPriv := mode.NewStrMode()
+		if dist {
+			return agg.NewUnaryDistAgg(false, typ, mode.ReturnType([]types.Type{typ}), aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill)
+		}
+		return agg.NewUnaryAgg(aggPriv, false, typ, mode.ReturnType([]types.Type{typ}), aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill, nil)
+	case types.T_date:
+		return newGenericMode(typ, dist, mode.NewMode(func(a, b types.Date) bool { return a < b }))
+	case types.T_datetime:
+		return newGenericMode(typ, dist, mode.NewMode(func(a, b types.Datetime) bool { return a < b }))
+	case types.T_time:
+		return newGenericMode(typ, dist, mode.NewMode(func(a, b types.Time) bool { return a < b }))
+	case types.T_timestamp:
+		return newGenericMode(typ, dist, mode.NewMode(func(a, b types.Timestamp) bool { return a < b }))
+	case types.T_decimal64:
+		return newGenericMode(typ, dist, mode.NewMode(func(a, b types.Decimal64) bool { return a.Compare(b) < 0 }))
+	case types.T_decimal128:
+		return newGenericMode(typ, dist, mode.NewMode(func(a, b types.Decimal128) bool { return a.Compare(b) < 0 }))
+	case types.T_uuid:
+		return newGenericMode(typ, dist, mode.NewMode(func(a, b types.Uuid) bool { return a.Compare(b) < 0 }))
+	}
+	panic(fmt.Errorf("unsupport type '%s' for mode", typ))
+}
+
+// newFloat64Agg creates the unary statistical aggregations, whose argument
+// has been cast to float64 by the planner.
+func newFloat64Agg(op int, typ types.Type, dist bool) (agg.Agg[any], error) {
+	if typ.Oid != types.T_float64 {
+		return nil, fmt.Errorf("'%v' not support %s", typ, Names[op])
+	}
+	otyp := types.New(types.T_float64, 0, 0, 0)
+	switch op {
+	case Median:
+		aggPriv := percentile.NewMedian()
+		if dist {
+			return agg.NewUnaryDistAgg(false, typ, otyp, aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill), nil
+		}
+		return agg.NewUnaryAgg(aggPriv, false, typ, otyp, aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill, nil), nil
+	default:
+		// a group of the sample variance stays empty until it has two rows,
+		// which does not work with the distinct merge of partial results.
+		if dist {
+			return nil, fmt.Errorf("distinct is not supported for aggregate %s", Names[op])
+		}
+		aggPriv := covariance.NewVarSample()
+		if op == StdDevSample {
+			aggPriv = covariance.NewStdDevSample()
+		}
+		return agg.NewUnaryAgg(aggPriv, false, typ, otyp, aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill, nil), nil
+	}
+}
+
+// newFloat64BinaryAgg creates the aggregations of two float64 arguments.
+func newFloat64BinaryAgg(op int, typ types.Type) (agg.Agg[any], error) {
+	if typ.Oid != types.T_float64 {
+		return nil, fmt.Errorf("'%v' not support %s", typ, Names[op])
+	}
+	ityps := []types.Type{typ, typ}
+	otyp := types.New(types.T_float64, 0, 0, 0)
+	switch op {
+	case PercentileCont, PercentileDisc:
+		aggPriv := percentile.NewCont()
+		if op == PercentileDisc {
+			aggPriv = percentile.NewDisc()
+		}
+		return agg.NewBinaryAgg(aggPriv, ityps, otyp, aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.FillWithFraction), nil
+	default:
+		aggPriv := covariance.NewCovarPop()
+		switch op {
+		case CovarSample:
+			aggPriv = covariance.NewCovarSample()
+		case Corr:
+			aggPriv = covariance.NewCorr()
+		}
+		return agg.NewBinaryAgg(aggPriv, ityps, otyp, aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill), nil
+	}
+}
+
+func newGenericMode[T comparable](typ types.Type, dist bool, aggPriv *mode.Mode[T]) agg.Agg[any] {
+	if dist {
+		return agg.NewUnaryDistAgg(false, typ, mode.ReturnType([]types.Type{typ}), aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill)
+	}
+	return agg.NewUnaryAgg(aggPriv, false, typ, mode.ReturnType([]types.Type{typ}), aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill, nil)
+}
+
 func newGenericAnyValue[T any](typ types.Type, dist bool) agg.Agg[any] {
 	aggPriv := anyvalue.NewAnyvalue[T]()
 	if dist {
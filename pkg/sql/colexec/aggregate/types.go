@@ -35,6 +35,17 @@ const (
 	BitOr
 	StdDevPop
 	AnyValue
+	Median
+	Mode
+	PercentileCont
+	PercentileDisc
+	Corr
+	CovarPop
+	CovarSample
+	VarSample
+	StdDevSample
+	GroupConcat
+	ArrayAgg
 )
 
 var Names = [...]string{
@@ -51,6 +62,17 @@ var Names = [...]string{
 	BitOr:               "bit_or",
 	StdDevPop:           "stddev_pop",
 	AnyValue:            "any",
+	Median:              "median",
+	Mode:                "mode",
+	PercentileCont:      "percentile_cont",
+	PercentileDisc:      "percentile_disc",
+	Corr:                "corr",
+	CovarPop:            "covar_pop",
+	CovarSample:         "covar_samp",
+	VarSample:           "var_samp",
+	StdDevSample:        "stddev_samp",
+	GroupConcat:         "group_concat",
+	ArrayAgg:            "array_agg",
 }
 
 // Agg agg interface
@@ -113,4 +135,7 @@ type Aggregate struct {
 	Op   int
 	Dist bool
 	E    *plan.Expr
+	// Args are the remaining arguments of a multi-argument aggregation,
+	// such as the second column of corr(x, y).
+	Args []*plan.Expr
 }
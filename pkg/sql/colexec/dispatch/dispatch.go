@@ -19,7 +19,14 @@ import (
 	"sync/atomic"
 
 	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregate"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -27,9 +34,19 @@ func String(arg any, buf *bytes.Buffer) {
 	buf.WriteString("dispatch")
 }
 
-func Prepare(_ *process.Process, arg any) error {
+func Prepare(proc *process.Process, arg any) error {
+	var err error
+
 	ap := arg.(*Argument)
 	ap.ctr = new(container)
+	if len(ap.ShuffleKeys) > 0 {
+		if ap.ctr.mp, err = hashmap.NewStrMap(true, 0, 0, proc.Mp); err != nil {
+			return err
+		}
+		ap.ctr.sels = make([][]int64, len(ap.Regs))
+		ap.ctr.evecs = make([]evalVector, len(ap.ShuffleKeys))
+		ap.ctr.vecs = make([]*vector.Vector, len(ap.ShuffleKeys))
+	}
 	return nil
 }
 
@@ -37,6 +54,10 @@ func Call(_ int, proc *process.Process, arg any) (bool, error) {
 	ap := arg.(*Argument)
 	bat := proc.InputBatch()
 	if bat == nil {
+		if ap.ctr.mp != nil {
+			ap.ctr.mp.Free()
+			ap.ctr.mp = nil
+		}
 		for _, reg := range ap.Regs {
 			select {
 			case <-reg.Ctx.Done():
@@ -45,6 +66,14 @@ func Call(_ int, proc *process.Process, arg any) (bool, error) {
 		}
 		return true, nil
 	}
+	if len(ap.ShuffleKeys) > 0 {
+		if err := ap.ctr.shuffle(bat, ap, proc); err != nil {
+			ap.ctr.mp.Free()
+			ap.ctr.mp = nil
+			return true, err
+		}
+		return false, nil
+	}
 	vecs := ap.vecs[:0]
 	for i := range bat.Vecs {
 		if bat.Vecs[i].Or {
@@ -94,3 +123,136 @@ func Call(_ int, proc *process.Process, arg any) (bool, error) {
 	}
 	return true, nil
 }
+
+// shuffle partitions the rows of batch by the hash of the shuffle keys,
+// and sends each partition to its consumer.
+func (ctr *container) shuffle(bat *batch.Batch, ap *Argument, proc *process.Process) error {
+	defer bat.Clean(proc.Mp)
+	if bat.Length() == 0 {
+		return nil
+	}
+	for _, vec := range bat.Vecs {
+		if vec.ConstExpand(proc.Mp) == nil {
+			return mmu.ErrOutOfMemory
+		}
+	}
+	if err := ctr.evalShuffleKeys(bat, ap.ShuffleKeys, proc); err != nil {
+		return err
+	}
+	defer ctr.freeShuffleKeys(proc)
+	for i := range ctr.sels {
+		ctr.sels[i] = ctr.sels[i][:0]
+	}
+	n := uint64(len(ap.Regs))
+	count := bat.Length()
+	for i := 0; i < count; i += hashmap.UnitLimit {
+		m := count - i
+		if m > hashmap.UnitLimit {
+			m = hashmap.UnitLimit
+		}
+		for k, h := range ctr.mp.Hash(i, m, ctr.vecs) {
+			j := shuffleIndex(h, n)
+			ctr.sels[j] = append(ctr.sels[j], int64(i+k))
+		}
+	}
+	for i, reg := range ap.Regs {
+		if len(ctr.sels[i]) == 0 {
+			continue
+		}
+		rbat, err := partition(bat, ctr.sels[i], ap.Aggs, proc)
+		if err != nil {
+			return err
+		}
+		select {
+		case <-reg.Ctx.Done():
+			rbat.Clean(proc.Mp)
+		case reg.Ch <- rbat:
+		}
+	}
+	return nil
+}
+
+// shuffleIndex returns the consumer of the row with hash h. The consumers
+// build hash maps locating the rows by the low bits of the same hash, so the
+// high bits are used here, otherwise all the rows of a consumer would fall
+// into a fraction of its buckets.
+func shuffleIndex(h, n uint64) uint64 {
+	return (h >> 32) % n
+}
+
+// partition returns a new batch made up of the rows sels of batch. If the
+// batch is a partial result of a group, the states of the groups sels are
+// merged into new aggregations of aggs.
+func partition(bat *batch.Batch, sels []int64, aggs []aggregate.Aggregate, proc *process.Process) (*batch.Batch, error) {
+	rbat := batch.NewWithSize(len(bat.Vecs))
+	rbat.Attrs = bat.Attrs
+	rbat.Zs = proc.Mp.GetSels()
+	for i, vec := range bat.Vecs {
+		rbat.Vecs[i] = vector.New(vec.Typ)
+		if err := vector.Union(rbat.Vecs[i], vec, sels, proc.Mp); err != nil {
+			rbat.Clean(proc.Mp)
+			return nil, err
+		}
+	}
+	for _, sel := range sels {
+		rbat.Zs = append(rbat.Zs, bat.Zs[sel])
+	}
+	if len(bat.Aggs) == 0 {
+		return rbat, nil
+	}
+	if len(aggs) != len(bat.Aggs) {
+		rbat.Clean(proc.Mp)
+		return nil, moerr.New(moerr.INTERNAL_ERROR, "shuffle %d aggregations with %d states", len(aggs), len(bat.Aggs))
+	}
+	rbat.Aggs = make([]agg.Agg[any], len(bat.Aggs))
+	for i, src := range bat.Aggs {
+		dst, err := aggregate.New(aggs[i].Op, aggs[i].Dist, src.InputTypes()[0])
+		if err == nil {
+			rbat.Aggs[i] = dst
+			err = dst.Grows(len(sels), proc.Mp)
+		}
+		for j := 0; err == nil && j < len(sels); j++ {
+			err = dst.Merge(src, int64(j), sels[j])
+		}
+		if err != nil {
+			rbat.Clean(proc.Mp)
+			return nil, err
+		}
+	}
+	return rbat, nil
+}
+
+func (ctr *container) evalShuffleKeys(bat *batch.Batch, keys []*plan.Expr, proc *process.Process) error {
+	for i, key := range keys {
+		vec, err := colexec.EvalExpr(bat, proc, key)
+		if err == nil && vec.ConstExpand(proc.Mp) == nil {
+			err = mmu.ErrOutOfMemory
+		}
+		if err != nil {
+			for j := 0; j < i; j++ {
+				if ctr.evecs[j].needFree {
+					vector.Clean(ctr.evecs[j].vec, proc.Mp)
+				}
+			}
+			return err
+		}
+		ctr.vecs[i] = vec
+		ctr.evecs[i].vec = vec
+		ctr.evecs[i].needFree = true
+		for j := range bat.Vecs {
+			if bat.Vecs[j] == vec {
+				ctr.evecs[i].needFree = false
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func (ctr *container) freeShuffleKeys(proc *process.Process) {
+	for i := range ctr.evecs {
+		if ctr.evecs[i].needFree {
+			ctr.evecs[i].vec.Free(proc.Mp)
+		}
+	}
+}
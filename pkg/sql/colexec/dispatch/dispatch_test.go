@@ -21,6 +21,9 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregate"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
@@ -100,6 +103,134 @@ func TestDispatch(t *testing.T) {
 	}
 }
 
+func TestShuffle(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	proc := process.New(mheap.New(gm))
+	typ := types.Type{Oid: types.T_int64, Size: 8}
+	regs := make([]*process.WaitRegister, 3)
+	for i := range regs {
+		regs[i] = &process.WaitRegister{Ctx: context.Background(), Ch: make(chan *batch.Batch, 3)}
+	}
+	arg := &Argument{
+		Regs:        regs,
+		ShuffleKeys: []*plan.Expr{newExpr(0, typ)},
+	}
+	require.NoError(t, Prepare(proc, arg))
+	for i := 0; i < 2; i++ {
+		proc.Reg.InputBatch = newBatch(t, []types.Type{typ}, proc, Rows)
+		end, err := Call(0, proc, arg)
+		require.NoError(t, err)
+		require.False(t, end)
+	}
+	proc.Reg.InputBatch = nil
+	end, err := Call(0, proc, arg)
+	require.NoError(t, err)
+	require.True(t, end)
+	rows := 0
+	owners := make(map[int64]int)
+	for i, reg := range regs {
+		for {
+			bat := <-reg.Ch
+			if bat == nil {
+				break
+			}
+			for _, v := range bat.Vecs[0].Col.([]int64) {
+				if j, ok := owners[v]; ok {
+					require.Equal(t, j, i)
+				}
+				owners[v] = i
+			}
+			rows += bat.Length()
+			bat.Clean(proc.Mp)
+		}
+	}
+	require.Equal(t, 2*Rows, rows)
+	require.Equal(t, int64(0), mheap.Size(proc.Mp))
+}
+
+func TestShuffleAggs(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	proc := process.New(mheap.New(gm))
+	typ := types.Type{Oid: types.T_int64, Size: 8}
+	regs := make([]*process.WaitRegister, 3)
+	for i := range regs {
+		regs[i] = &process.WaitRegister{Ctx: context.Background(), Ch: make(chan *batch.Batch, 3)}
+	}
+	arg := &Argument{
+		Regs:        regs,
+		ShuffleKeys: []*plan.Expr{newExpr(0, typ)},
+		Aggs:        []aggregate.Aggregate{{Op: aggregate.Sum}},
+	}
+	require.NoError(t, Prepare(proc, arg))
+
+	// a partial result of group, the sum of each group is its key
+	bat := newBatch(t, []types.Type{typ}, proc, Rows)
+	sum, err := aggregate.New(aggregate.Sum, false, typ)
+	require.NoError(t, err)
+	require.NoError(t, sum.Grows(Rows, proc.Mp))
+	for i := 0; i < Rows; i++ {
+		require.NoError(t, sum.Fill(int64(i), int64(i), 1, bat.Vecs))
+	}
+	bat.Aggs = []agg.Agg[any]{sum}
+	proc.Reg.InputBatch = bat
+	_, err = Call(0, proc, arg)
+	require.NoError(t, err)
+	proc.Reg.InputBatch = nil
+	_, err = Call(0, proc, arg)
+	require.NoError(t, err)
+
+	rows := 0
+	for _, reg := range regs {
+		for {
+			bat := <-reg.Ch
+			if bat == nil {
+				break
+			}
+			require.Equal(t, 1, len(bat.Aggs))
+			vec, err := bat.Aggs[0].Eval(proc.Mp)
+			require.NoError(t, err)
+			bat.Aggs = nil
+			require.Equal(t, bat.Vecs[0].Col.([]int64), vec.Col.([]int64))
+			rows += bat.Length()
+			vec.Free(proc.Mp)
+			bat.Clean(proc.Mp)
+		}
+	}
+	require.Equal(t, Rows, rows)
+	require.Equal(t, int64(0), mheap.Size(proc.Mp))
+}
+
+func TestShuffleIndex(t *testing.T) {
+	// the low bits of the hashes sent to a consumer must still differ
+	const n = 4
+	lowBits := make(map[uint64]bool)
+	for i := uint64(0); i < 1024; i++ {
+		h := i * 0x9E3779B97F4A7C15
+		if shuffleIndex(h, n) == 0 {
+			lowBits[h%n] = true
+		}
+	}
+	require.Equal(t, n, len(lowBits))
+}
+
+func newExpr(pos int32, typ types.Type) *plan.Expr {
+	return &plan.Expr{
+		Typ: &plan.Type{
+			Size:  typ.Size,
+			Scale: typ.Scale,
+			Width: typ.Width,
+			Id:    int32(typ.Oid),
+		},
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				ColPos: pos,
+			},
+		},
+	}
+}
+
 func newTestCase(gm *guest.Mmu, all bool) dispatchTestCase {
 	proc := process.New(mheap.New(gm))
 	proc.Reg.MergeReceivers = make([]*process.WaitRegister, 2)
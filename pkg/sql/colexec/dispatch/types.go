@@ -15,12 +15,26 @@
 package dispatch
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregate"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
+type evalVector struct {
+	needFree bool
+	vec      *vector.Vector
+}
+
 type container struct {
 	i int
+	// mp computes the hash values of the shuffle keys
+	mp *hashmap.StrHashMap
+	// sels are the rows sent to each consumer
+	sels  [][]int64
+	evecs []evalVector
+	vecs  []*vector.Vector
 }
 
 type Argument struct {
@@ -28,4 +42,10 @@ type Argument struct {
 	All  bool // dispatch batch to each consumer
 	vecs []*vector.Vector
 	Regs []*process.WaitRegister
+	// ShuffleKeys, if not empty, partitions the rows of batch by the hash of the keys,
+	// so that the rows with the same keys are always dispatched to the same consumer
+	ShuffleKeys []*plan.Expr
+	// Aggs are the aggregations of the partial results of a group, whose
+	// states are split by the shuffle along with the groups
+	Aggs []aggregate.Aggregate
 }
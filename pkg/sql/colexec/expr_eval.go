@@ -91,6 +91,7 @@ func EvalExpr(bat *batch.Batch, proc *process.Process, expr *plan.Expr) (*vector
 				vec.Col = []types.Timestamp{types.Timestamp(t.C.GetTimestampval())}
 			case *plan.Const_Sval:
 				vec = vector.NewConst(constSType, length)
+				vec.Typ.Collation = types.Collation(expr.Typ.GetCollation())
 				sval := t.C.GetSval()
 				vec.Col = &types.Bytes{
 					Data:    []byte(sval),
@@ -109,6 +110,7 @@ func EvalExpr(bat *batch.Batch, proc *process.Process, expr *plan.Expr) (*vector
 			Width:     t.T.Typ.GetWidth(),
 			Scale:     t.T.Typ.GetScale(),
 			Precision: t.T.Typ.GetPrecision(),
+			Collation: types.Collation(t.T.Typ.GetCollation()),
 		}), nil
 	case *plan.Expr_Col:
 		vec := bat.Vecs[t.Col.ColPos]
@@ -188,6 +190,7 @@ func JoinFilterEvalExpr(r, s *batch.Batch, rRow int, proc *process.Process, expr
 				vec.Col = []float64{t.C.GetDval()}
 			case *plan.Const_Sval:
 				vec = vector.NewConst(constSType, 1)
+				vec.Typ.Collation = types.Collation(expr.Typ.GetCollation())
 				sval := t.C.GetSval()
 				vec.Col = &types.Bytes{
 					Data:    []byte(sval),
@@ -206,6 +209,7 @@ func JoinFilterEvalExpr(r, s *batch.Batch, rRow int, proc *process.Process, expr
 			Width:     t.T.Typ.GetWidth(),
 			Scale:     t.T.Typ.GetScale(),
 			Precision: t.T.Typ.GetPrecision(),
+			Collation: types.Collation(t.T.Typ.GetCollation()),
 		}), nil
 	case *plan.Expr_Col:
 		if t.Col.RelPos == 0 {
@@ -280,6 +284,7 @@ func JoinFilterEvalExprInBucket(r, s *batch.Batch, rRow, sRow int, proc *process
 				vec.Col = []float64{t.C.GetDval()}
 			case *plan.Const_Sval:
 				vec = vector.NewConst(constSType, 1)
+				vec.Typ.Collation = types.Collation(expr.Typ.GetCollation())
 				sval := t.C.GetSval()
 				vec.Col = &types.Bytes{
 					Data:    []byte(sval),
@@ -298,6 +303,7 @@ func JoinFilterEvalExprInBucket(r, s *batch.Batch, rRow, sRow int, proc *process
 			Width:     t.T.Typ.GetWidth(),
 			Scale:     t.T.Typ.GetScale(),
 			Precision: t.T.Typ.GetPrecision(),
+			Collation: types.Collation(t.T.Typ.GetCollation()),
 		}), nil
 	case *plan.Expr_Col:
 		if t.Col.RelPos == 0 {
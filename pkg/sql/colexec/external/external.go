@@ -27,7 +27,6 @@ import (
 	"io"
 	"math"
 	"path"
-	"path/filepath"
 	"strconv"
 	"strings"
 	"sync/atomic"
@@ -62,18 +61,21 @@ func Prepare(proc *process.Process, arg any) error {
 	param.extern.FileService = proc.FileService
 	param.IgnoreLineTag = int(param.extern.Tail.IgnoredLines)
 	param.IgnoreLine = param.IgnoreLineTag
-	fileList, err := ReadDir(param.extern)
-	if err != nil {
-		param.End = true
-		return err
+	if param.FileList == nil {
+		fileList, fileSize, err := ReadDir(param.extern)
+		if err != nil {
+			param.End = true
+			return err
+		}
+		param.FileList = fileList
+		param.FileSize = fileSize
 	}
 
-	if len(fileList) == 0 {
+	if len(param.FileList) == 0 {
 		param.End = true
 		return fmt.Errorf("no such file '%s'", param.extern.Filepath)
 	}
-	param.FileList = fileList
-	param.FileCnt = len(fileList)
+	param.FileCnt = len(param.FileList)
 	return nil
 }
 
@@ -84,7 +86,16 @@ func Call(_ int, proc *process.Process, arg any) (bool, error) {
 		return true, nil
 	}
 	param.extern.Filepath = param.FileList[param.FileIndex]
-	bat, err := ScanFileData(param, proc)
+	var bat *batch.Batch
+	var err error
+	switch param.extern.Format {
+	case tree.PARQUET:
+		bat, err = ScanParquetFile(param, proc)
+	case tree.JSONLINE:
+		bat, err = ScanJsonLineFile(param, proc)
+	default:
+		bat, err = ScanFileData(param, proc)
+	}
 	if err != nil {
 		param.End = true
 		return false, err
@@ -93,27 +104,28 @@ func Call(_ int, proc *process.Process, arg any) (bool, error) {
 	return false, nil
 }
 
-func ReadDir(param *tree.ExternParam) (fileList []string, err error) {
+// ReadDir returns the files matching the path of param and their sizes
+func ReadDir(param *tree.ExternParam) (fileList []string, fileSize []int64, err error) {
 	dir, pattern := path.Split(param.Filepath)
 
 	var fs fileservice.ETLFileService
 	var readPath string
 	fsPath, err := fileservice.ParsePath(dir)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if fsPath.Service == "" {
 		// no service, create ETL fs
 		fs, err = fileservice.NewLocalETLFS("etl", dir)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		readPath = ""
 	} else {
 		// get etl fs
 		fs, err = fileservice.Get[fileservice.ETLFileService](param.FileService, fsPath.Service)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		readPath = dir
 	}
@@ -121,7 +133,7 @@ func ReadDir(param *tree.ExternParam) (fileList []string, err error) {
 	ctx := context.TODO()
 	entries, err := fs.List(ctx, readPath)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	for _, entry := range entries {
@@ -130,35 +142,17 @@ func ReadDir(param *tree.ExternParam) (fileList []string, err error) {
 			continue
 		}
 		fileList = append(fileList, path.Join(dir, entry.Name))
+		fileSize = append(fileSize, int64(entry.Size))
 	}
 
 	return
 }
 
 func ReadFile(param *tree.ExternParam) (io.ReadCloser, error) {
-
-	var fs fileservice.ETLFileService
-	var readPath string
-	fsPath, err := fileservice.ParsePath(param.Filepath)
+	fs, readPath, err := getETLFileService(param)
 	if err != nil {
 		return nil, err
 	}
-	if fsPath.Service == "" {
-		// no service, create ETL fs
-		dir, file := filepath.Split(param.Filepath)
-		fs, err = fileservice.NewLocalETLFS("etl", dir)
-		if err != nil {
-			return nil, err
-		}
-		readPath = file
-	} else {
-		// get etl fs
-		fs, err = fileservice.Get[fileservice.ETLFileService](param.FileService, fsPath.Service)
-		if err != nil {
-			return nil, err
-		}
-		readPath = fsPath.Full
-	}
 
 	var r io.ReadCloser
 	vec := fileservice.IOVector{
@@ -179,6 +173,11 @@ func ReadFile(param *tree.ExternParam) (io.ReadCloser, error) {
 	return r, nil
 }
 
+// getETLFileService returns the file service of the file and the path to read it
+func getETLFileService(param *tree.ExternParam) (fileservice.ETLFileService, string, error) {
+	return fileservice.GetForETL(param.FileService, param.Filepath)
+}
+
 func getCompressType(param *tree.ExternParam) string {
 	if param.CompressType != "" && param.CompressType != tree.AUTO {
 		return param.CompressType
@@ -268,13 +267,13 @@ func makeBatch(param *ExternalParam, plh *ParseLineHandler) *batch.Batch {
 		case types.T_uint8:
 			vec.Data = make([]byte, batchSize)
 			vec.Col = types.DecodeUint8Slice(vec.Data)
-		case types.T_uint16:
+		case types.T_uint16, types.T_enum:
 			vec.Data = make([]byte, 2*batchSize)
 			vec.Col = types.DecodeUint16Slice(vec.Data)
 		case types.T_uint32:
 			vec.Data = make([]byte, 4*batchSize)
 			vec.Col = types.DecodeUint32Slice(vec.Data)
-		case types.T_uint64:
+		case types.T_uint64, types.T_set, types.T_bit:
 			vec.Data = make([]byte, 8*batchSize)
 			vec.Col = types.DecodeUint64Slice(vec.Data)
 		case types.T_float32:
@@ -283,7 +282,7 @@ func makeBatch(param *ExternalParam, plh *ParseLineHandler) *batch.Batch {
 		case types.T_float64:
 			vec.Data = make([]byte, 8*batchSize)
 			vec.Col = types.DecodeFloat64Slice(vec.Data)
-		case types.T_char, types.T_varchar, types.T_json:
+		case types.T_char, types.T_varchar, types.T_json, types.T_binary, types.T_varbinary:
 			vBytes := &types.Bytes{
 				Offsets: make([]uint32, batchSize),
 				Lengths: make([]uint32, batchSize),
@@ -297,12 +296,18 @@ func makeBatch(param *ExternalParam, plh *ParseLineHandler) *batch.Batch {
 		case types.T_datetime:
 			vec.Data = make([]byte, 8*batchSize)
 			vec.Col = types.DecodeDatetimeSlice(vec.Data)
+		case types.T_time:
+			vec.Data = make([]byte, 8*batchSize)
+			vec.Col = types.DecodeTimeSlice(vec.Data)
 		case types.T_decimal64:
 			vec.Data = make([]byte, 8*batchSize)
 			vec.Col = types.DecodeDecimal64Slice(vec.Data)
 		case types.T_decimal128:
 			vec.Data = make([]byte, 16*batchSize)
 			vec.Col = types.DecodeDecimal128Slice(vec.Data)
+		case types.T_uuid:
+			vec.Data = make([]byte, 16*batchSize)
+			vec.Col = types.DecodeUuidSlice(vec.Data)
 		case types.T_timestamp:
 			vec.Data = make([]byte, 8*batchSize)
 			vec.Col = types.DecodeTimestampSlice(vec.Data)
@@ -314,6 +319,22 @@ func makeBatch(param *ExternalParam, plh *ParseLineHandler) *batch.Batch {
 	return batchData
 }
 
+// getLineColumns returns the column of each field of the lines read by the column names,
+// every field is at the index of the column in the table, and only the columns scanned are read.
+func getLineColumns(param *ExternalParam) []string {
+	width := 0
+	for _, idx := range param.Name2ColIndex {
+		if int(idx) >= width {
+			width = int(idx) + 1
+		}
+	}
+	cols := make([]string, width)
+	for _, attr := range param.Attrs {
+		cols[param.Name2ColIndex[attr]] = attr
+	}
+	return cols
+}
+
 func GetBatchData(param *ExternalParam, plh *ParseLineHandler, proc *process.Process) (*batch.Batch, error) {
 	bat := makeBatch(param, plh)
 	var Line []string
@@ -538,7 +559,7 @@ func GetBatchData(param *ExternalParam, plh *ParseLineHandler, proc *process.Pro
 					}
 					cols[rowIdx] = d
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary:
 				vBytes := vec.Col.(*types.Bytes)
 				if isNullOrEmpty {
 					nulls.Add(vec.Nsp, uint64(rowIdx))
@@ -592,6 +613,42 @@ func GetBatchData(param *ExternalParam, plh *ParseLineHandler, proc *process.Pro
 					}
 					cols[rowIdx] = d
 				}
+			case types.T_time:
+				cols := vec.Col.([]types.Time)
+				if isNullOrEmpty {
+					nulls.Add(vec.Nsp, uint64(rowIdx))
+				} else {
+					d, err := types.ParseTime(field, vec.Typ.Precision)
+					if err != nil {
+						logutil.Errorf("parse field[%v] err:%v", field, err)
+						return nil, fmt.Errorf("the input value '%v' is not Time type for column %d", field, colIdx)
+					}
+					cols[rowIdx] = d
+				}
+			case types.T_enum:
+				cols := vec.Col.([]uint16)
+				if isNullOrEmpty {
+					nulls.Add(vec.Nsp, uint64(rowIdx))
+				} else {
+					d, err := types.ParseEnum(param.Cols[colIdx].Typ.Enumvalues, field)
+					if err != nil {
+						logutil.Errorf("parse field[%v] err:%v", field, err)
+						return nil, fmt.Errorf("the input value '%v' is not Enum type for column %d", field, colIdx)
+					}
+					cols[rowIdx] = d
+				}
+			case types.T_set:
+				cols := vec.Col.([]uint64)
+				if isNullOrEmpty {
+					nulls.Add(vec.Nsp, uint64(rowIdx))
+				} else {
+					d, err := types.ParseSet(param.Cols[colIdx].Typ.Enumvalues, field)
+					if err != nil {
+						logutil.Errorf("parse field[%v] err:%v", field, err)
+						return nil, fmt.Errorf("the input value '%v' is not Set type for column %d", field, colIdx)
+					}
+					cols[rowIdx] = d
+				}
 			case types.T_decimal64:
 				cols := vec.Col.([]types.Decimal64)
 				if isNullOrEmpty {
@@ -634,11 +691,29 @@ func GetBatchData(param *ExternalParam, plh *ParseLineHandler, proc *process.Pro
 					}
 					cols[rowIdx] = d
 				}
+			case types.T_uuid:
+				cols := vec.Col.([]types.Uuid)
+				if isNullOrEmpty {
+					nulls.Add(vec.Nsp, uint64(rowIdx))
+				} else {
+					d, err := types.ParseUuid(field)
+					if err != nil {
+						logutil.Errorf("parse field[%v] err:%v", field, err)
+						return nil, fmt.Errorf("the input value '%v' is not Uuid type for column %d", field, colIdx)
+					}
+					cols[rowIdx] = d
+				}
 			default:
 				return nil, fmt.Errorf("the value type %d is not support now", param.Cols[rowIdx].Typ.Id)
 			}
 		}
 	}
+	setZs(bat, proc)
+	return bat, nil
+}
+
+// setZs sets the count of every row of the batch to 1
+func setZs(bat *batch.Batch, proc *process.Process) {
 	n := vector.Length(bat.Vecs[0])
 	sels := proc.Mp.GetSels()
 	if n > cap(sels) {
@@ -649,18 +724,19 @@ func GetBatchData(param *ExternalParam, plh *ParseLineHandler, proc *process.Pro
 	for k := 0; k < n; k++ {
 		bat.Zs[k] = 1
 	}
-
-	return bat, nil
 }
 
 // get file reader from external file
 func GetSimdcsvReader(param *ExternalParam) (*ParseLineHandler, error) {
 	var err error
-	param.reader, err = ReadFile(param.extern)
-	if err != nil {
-		return nil, err
+	if param.FileRanges != nil {
+		param.reader, err = readFileRange(param, param.FileRanges[param.FileIndex])
+	} else {
+		param.reader, err = ReadFile(param.extern)
+		if err == nil {
+			param.reader, err = getUnCompressReader(param.extern, param.reader)
+		}
 	}
-	param.reader, err = getUnCompressReader(param.extern, param.reader)
 	if err != nil {
 		return nil, err
 	}
@@ -691,13 +767,25 @@ func ScanFileData(param *ExternalParam, proc *process.Process) (*batch.Batch, er
 		if err != nil {
 			return nil, err
 		}
+		// the ignored lines are at the beginning of the file
+		if param.FileRanges != nil && param.FileRanges[param.FileIndex].Offset > 0 {
+			param.IgnoreLine = 0
+		}
 	}
 	plh := param.plh
 	plh.simdCsvLineArray, err = plh.simdCsvReader.Read(param.batchSize, param.Ctx)
 	if err != nil {
 		return nil, err
 	}
-	if len(plh.simdCsvLineArray) < param.batchSize {
+	finished := len(plh.simdCsvLineArray) < param.batchSize
+	if param.IgnoreLine != 0 {
+		if param.IgnoreLine > len(plh.simdCsvLineArray) {
+			param.IgnoreLine = len(plh.simdCsvLineArray)
+		}
+		plh.simdCsvLineArray = plh.simdCsvLineArray[param.IgnoreLine:]
+		param.IgnoreLine = 0
+	}
+	if finished {
 		err := param.reader.Close()
 		if err != nil {
 			logutil.Errorf("close file failed. err:%v", err)
@@ -709,10 +797,6 @@ func ScanFileData(param *ExternalParam, proc *process.Process) (*batch.Batch, er
 			param.End = true
 		}
 	}
-	if param.IgnoreLine != 0 {
-		plh.simdCsvLineArray = plh.simdCsvLineArray[param.IgnoreLine:]
-		param.IgnoreLine = 0
-	}
 	plh.batchSize = len(plh.simdCsvLineArray)
 	bat, err = GetBatchData(param, plh, proc)
 	if err != nil {
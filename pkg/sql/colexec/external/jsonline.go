@@ -0,0 +1,281 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// jsonPath is a JSONPath-like expression as $.a.b[0]["c d"],
+// every step is an object key of string or an array index of int.
+type jsonPath []any
+
+func parseJsonPath(s string) (jsonPath, error) {
+	if !strings.HasPrefix(s, "$") {
+		return nil, fmt.Errorf("the json path '%s' should start with '$'", s)
+	}
+	var path jsonPath
+	for i := 1; i < len(s); {
+		switch s[i] {
+		case '.':
+			j := i + 1
+			for j < len(s) && s[j] != '.' && s[j] != '[' {
+				j++
+			}
+			if j == i+1 {
+				return nil, fmt.Errorf("the json path '%s' has an empty key", s)
+			}
+			path = append(path, s[i+1:j])
+			i = j
+		case '[':
+			j := strings.IndexByte(s[i:], ']')
+			if j < 0 {
+				return nil, fmt.Errorf("the json path '%s' misses ']'", s)
+			}
+			step := s[i+1 : i+j]
+			if len(step) >= 2 && (step[0] == '"' || step[0] == '\'') && step[len(step)-1] == step[0] {
+				path = append(path, step[1:len(step)-1])
+			} else if idx, err := strconv.Atoi(step); err == nil && idx >= 0 {
+				path = append(path, idx)
+			} else {
+				return nil, fmt.Errorf("the json path '%s' has an invalid step '%s'", s, step)
+			}
+			i += j + 1
+		default:
+			return nil, fmt.Errorf("the json path '%s' is invalid at %d", s, i)
+		}
+	}
+	return path, nil
+}
+
+// get returns the value at the path, the object keys match ignoring case
+// if there is no key exactly the same.
+func (p jsonPath) get(v any) any {
+	for _, step := range p {
+		switch step := step.(type) {
+		case string:
+			obj, ok := v.(map[string]any)
+			if !ok {
+				return nil
+			}
+			if v, ok = obj[step]; !ok {
+				for k, val := range obj {
+					if strings.EqualFold(k, step) {
+						v = val
+						break
+					}
+				}
+			}
+		case int:
+			arr, ok := v.([]any)
+			if !ok || step >= len(arr) {
+				return nil
+			}
+			v = arr[step]
+		}
+	}
+	return v
+}
+
+// JsonLineReader reads the json lines as the text fields of lines,
+// so that they are parsed the same way as the csv data.
+type JsonLineReader struct {
+	reader io.ReadCloser
+	br     *bufio.Reader
+	// paths is the path of each field, the field is null if it is nil
+	paths []jsonPath
+	// isJson is true if the field is stored into a json column
+	isJson      []bool
+	ignoreLines uint64
+	maxErrors   uint64
+	// Warnings is the number of the malformed lines skipped
+	Warnings uint64
+}
+
+// NewJsonLineReader opens the jsonline file of param, the i-th field of every line read
+// is the value of the key cols[i] or at the path given by param.JsonPaths for the column,
+// and it is the json text if isJson[i] is true.
+func NewJsonLineReader(param *tree.ExternParam, cols []string, isJson []bool) (*JsonLineReader, error) {
+	paths := make([]jsonPath, len(cols))
+	for i, col := range cols {
+		if col != "" {
+			paths[i] = jsonPath{col}
+		}
+	}
+	for _, p := range param.JsonPaths {
+		path, err := parseJsonPath(p.Path)
+		if err != nil {
+			return nil, err
+		}
+		for i, col := range cols {
+			if strings.EqualFold(col, p.Column) {
+				paths[i] = path
+			}
+		}
+	}
+	r, err := ReadFile(param)
+	if err != nil {
+		return nil, err
+	}
+	if r, err = getUnCompressReader(param, r); err != nil {
+		return nil, err
+	}
+	ignoreLines := uint64(0)
+	if param.Tail != nil {
+		ignoreLines = param.Tail.IgnoredLines
+	}
+	return &JsonLineReader{
+		reader:      r,
+		br:          bufio.NewReader(r),
+		paths:       paths,
+		isJson:      isJson,
+		ignoreLines: ignoreLines,
+		maxErrors:   param.MaxErrors,
+	}, nil
+}
+
+// Read returns at most n lines, it returns less than n lines only if the file is finished.
+// The malformed lines are skipped and counted as warnings, it fails if there are more
+// malformed lines than the limit.
+func (r *JsonLineReader) Read(n int) ([][]string, error) {
+	lines := make([][]string, 0, n)
+	for len(lines) < n {
+		data, err := r.br.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		eof := err == io.EOF
+		data = bytes.TrimSpace(data)
+		if len(data) > 0 {
+			if r.ignoreLines > 0 {
+				r.ignoreLines--
+			} else if line, ok := r.parseLine(data); ok {
+				lines = append(lines, line)
+			} else {
+				r.Warnings++
+				logutil.Warnf("skip the malformed json line '%s'", data)
+				if r.Warnings > r.maxErrors {
+					return nil, fmt.Errorf("there are %d malformed json lines more than the limit %d of '%s'", r.Warnings, r.maxErrors, tree.MAX_ERRORS)
+				}
+			}
+		}
+		if eof {
+			break
+		}
+	}
+	return lines, nil
+}
+
+func (r *JsonLineReader) parseLine(data []byte) ([]string, bool) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var obj map[string]any
+	if err := dec.Decode(&obj); err != nil || dec.More() || obj == nil {
+		return nil, false
+	}
+	line := make([]string, len(r.paths))
+	for i, path := range r.paths {
+		v := path.get(obj)
+		if path == nil || v == nil {
+			line[i] = NULL_FLAG
+			continue
+		}
+		if r.isJson[i] {
+			data, err := json.Marshal(v)
+			if err != nil {
+				return nil, false
+			}
+			line[i] = string(data)
+			continue
+		}
+		switch v := v.(type) {
+		case string:
+			line[i] = v
+		case json.Number:
+			line[i] = v.String()
+		case bool:
+			line[i] = strconv.FormatBool(v)
+		default:
+			data, err := json.Marshal(v)
+			if err != nil {
+				return nil, false
+			}
+			line[i] = string(data)
+		}
+	}
+	return line, true
+}
+
+func (r *JsonLineReader) Close() error {
+	return r.reader.Close()
+}
+
+// ScanJsonLineFile reads a batch of the table from the jsonline file
+func ScanJsonLineFile(param *ExternalParam, proc *process.Process) (*batch.Batch, error) {
+	if param.jsonLine == nil {
+		cols := getLineColumns(param)
+		isJson := make([]bool, len(cols))
+		for i, attr := range param.Attrs {
+			isJson[param.Name2ColIndex[attr]] = types.T(param.Cols[i].Typ.Id) == types.T_json
+		}
+		var err error
+		param.jsonLine, err = NewJsonLineReader(param.extern, cols, isJson)
+		if err != nil {
+			return nil, err
+		}
+		// the limit of the malformed lines is for all the files
+		param.jsonLine.Warnings = param.Warnings
+	}
+	warnings := param.jsonLine.Warnings
+	lines, err := param.jsonLine.Read(param.batchSize)
+	if err != nil {
+		return nil, err
+	}
+	// the malformed lines skipped are the warnings of the statement
+	proc.AddWarnings(param.jsonLine.Warnings - warnings)
+	if len(lines) < param.batchSize {
+		param.Warnings = param.jsonLine.Warnings
+		if err := param.jsonLine.Close(); err != nil {
+			logutil.Errorf("close file failed. err:%v", err)
+		}
+		param.jsonLine = nil
+		param.FileIndex++
+		if param.FileIndex >= param.FileCnt {
+			param.End = true
+		}
+	}
+	plh := &ParseLineHandler{
+		batchSize:        len(lines),
+		simdCsvLineArray: lines,
+	}
+	bat, err := GetBatchData(param, plh, proc)
+	if err != nil {
+		return nil, err
+	}
+	bat.Cnt = 1
+	return bat, nil
+}
@@ -0,0 +1,114 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+const testJsonLines = `{"ID": 1, "user": {"name": "a"}, "tags": ["x", "y"], "scores": [1.5, 2.5]}
+{"id": 2, "user": {"name": "b"}, "tags": {"k": 1}}
+{"id": 3, "user": "c",
+
+{"id": 4, "tags": "z", "scores": [3]}
+`
+
+func newJsonLineParam(t *testing.T, name string, maxErrors uint64) *Argument {
+	extern := &tree.ExternParam{
+		Filepath:     name,
+		CompressType: tree.AUTO,
+		Format:       tree.JSONLINE,
+		JsonPaths: []tree.JsonPath{
+			{Column: "name", Path: "$.user.name"},
+			{Column: "score", Path: "$.scores[1]"},
+		},
+		MaxErrors: maxErrors,
+		Tail:      &tree.TailParameter{},
+	}
+	data, err := json.Marshal(extern)
+	require.NoError(t, err)
+	cols := []*plan.ColDef{
+		{Name: "id", Typ: &plan.Type{Id: int32(types.T_int64)}},
+		{Name: "name", Typ: &plan.Type{Id: int32(types.T_varchar), Width: 20}},
+		{Name: "tags", Typ: &plan.Type{Id: int32(types.T_json)}},
+		{Name: "score", Typ: &plan.Type{Id: int32(types.T_float64)}},
+	}
+	param := &ExternalParam{
+		Cols:          cols,
+		Name2ColIndex: make(map[string]int32),
+		CreateSql:     string(data),
+		Ctx:           context.Background(),
+	}
+	for i, col := range cols {
+		param.Attrs = append(param.Attrs, col.Name)
+		param.Name2ColIndex[col.Name] = int32(i)
+	}
+	return &Argument{Es: param}
+}
+
+func TestJsonLineScan(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "test.json")
+	require.NoError(t, os.WriteFile(name, []byte(testJsonLines), 0644))
+
+	proc := testutil.NewProcess()
+	arg := newJsonLineParam(t, name, 1)
+	require.NoError(t, Prepare(proc, arg))
+	end, err := Call(0, proc, arg)
+	require.NoError(t, err)
+	require.False(t, end)
+	bat := proc.InputBatch()
+	require.Equal(t, []int64{1, 2, 4}, bat.Vecs[0].Col.([]int64))
+	require.Equal(t, "a", string(vector.GetStrColumn(bat.Vecs[1]).Get(0)))
+	require.True(t, bat.Vecs[1].Nsp.Contains(2))
+	require.Equal(t, `["x", "y"]`, types.DecodeJson(vector.GetStrColumn(bat.Vecs[2]).Get(0)).String())
+	require.Equal(t, `{"k": 1}`, types.DecodeJson(vector.GetStrColumn(bat.Vecs[2]).Get(1)).String())
+	require.Equal(t, `"z"`, types.DecodeJson(vector.GetStrColumn(bat.Vecs[2]).Get(2)).String())
+	require.Equal(t, 2.5, bat.Vecs[3].Col.([]float64)[0])
+	require.True(t, bat.Vecs[3].Nsp.Contains(1))
+	require.True(t, bat.Vecs[3].Nsp.Contains(2))
+	require.Equal(t, uint64(1), arg.Es.Warnings)
+	require.Equal(t, uint64(1), proc.TakeWarnings())
+
+	// the malformed line is more than the limit
+	arg = newJsonLineParam(t, name, 0)
+	require.NoError(t, Prepare(proc, arg))
+	_, err = Call(0, proc, arg)
+	require.Error(t, err)
+}
+
+func TestParseJsonPath(t *testing.T) {
+	path, err := parseJsonPath(`$.a["b c"][1].d`)
+	require.NoError(t, err)
+	require.Equal(t, jsonPath{"a", "b c", 1, "d"}, path)
+	var v any
+	require.NoError(t, json.Unmarshal([]byte(`{"a": {"B C": [0, {"d": "v"}]}}`), &v))
+	require.Equal(t, "v", path.get(v))
+
+	for _, s := range []string{"a.b", "$.", "$[1", "$[x]", "$a"} {
+		_, err = parseJsonPath(s)
+		require.Error(t, err, s)
+	}
+}
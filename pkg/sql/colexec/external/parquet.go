@@ -0,0 +1,1265 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	goparquet "github.com/fraugster/parquet-go"
+	"github.com/fraugster/parquet-go/parquet"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// parquetReadAhead is the least bytes read from the file service at a time,
+// the parquet reader issues many small reads for the page headers.
+const parquetReadAhead = 1 << 20
+
+// etlFile is an io.ReadSeeker over a file of the ETL file service
+type etlFile struct {
+	ctx    context.Context
+	fs     fileservice.ETLFileService
+	path   string
+	size   int64
+	offset int64
+	// buf caches the bytes of [bufOffset, bufOffset+len(buf))
+	buf       []byte
+	bufOffset int64
+}
+
+func openETLFile(ctx context.Context, param *tree.ExternParam) (*etlFile, error) {
+	fs, readPath, err := getETLFileService(param)
+	if err != nil {
+		return nil, err
+	}
+	dir, name := "", readPath
+	if i := strings.LastIndex(readPath, "/"); i >= 0 {
+		dir, name = readPath[:i], readPath[i+1:]
+	}
+	entries, err := fs.List(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if entry.Name == name && !entry.IsDir {
+			return &etlFile{
+				ctx:  ctx,
+				fs:   fs,
+				path: readPath,
+				size: int64(entry.Size),
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("no such file '%s'", param.Filepath)
+}
+
+// openScanFile opens the current file of the scan, the file is listed only if its size
+// is not known from the files of the scan.
+func openScanFile(param *ExternalParam) (*etlFile, error) {
+	if param.FileSize == nil {
+		return openETLFile(param.Ctx, param.extern)
+	}
+	fs, readPath, err := getETLFileService(param.extern)
+	if err != nil {
+		return nil, err
+	}
+	return &etlFile{
+		ctx:  param.Ctx,
+		fs:   fs,
+		path: readPath,
+		size: param.FileSize[param.FileIndex],
+	}, nil
+}
+
+func (f *etlFile) Read(p []byte) (int, error) {
+	if f.offset >= f.size {
+		return 0, io.EOF
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if f.offset < f.bufOffset || f.offset >= f.bufOffset+int64(len(f.buf)) {
+		size := int64(len(p))
+		if size < parquetReadAhead {
+			size = parquetReadAhead
+		}
+		if size > f.size-f.offset {
+			size = f.size - f.offset
+		}
+		vec := fileservice.IOVector{
+			FilePath: f.path,
+			Entries: []fileservice.IOEntry{
+				0: {
+					Offset: int(f.offset),
+					Size:   int(size),
+				},
+			},
+		}
+		if err := f.fs.Read(f.ctx, &vec); err != nil {
+			return 0, err
+		}
+		f.buf = vec.Entries[0].Data
+		f.bufOffset = f.offset
+	}
+	n := copy(p, f.buf[f.offset-f.bufOffset:])
+	f.offset += int64(n)
+	return n, nil
+}
+
+func (f *etlFile) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += f.offset
+	case io.SeekEnd:
+		offset += f.size
+	default:
+		return 0, fmt.Errorf("invalid whence %d", whence)
+	}
+	if offset < 0 {
+		return 0, errors.New("negative position")
+	}
+	f.offset = offset
+	return offset, nil
+}
+
+// ParquetReader reads the rows of a parquet file, the values are converted
+// to the types of the table columns directly.
+type ParquetReader struct {
+	file *etlFile
+	fr   *goparquet.FileReader
+	// names is the parquet column of each field, the field is null if it is empty
+	names []string
+	elems []*parquet.SchemaElement
+}
+
+// parquetDecimal is a parquet decimal, its value is unscaled / 10^scale
+type parquetDecimal struct {
+	unscaled *big.Int
+	scale    int32
+}
+
+// parquetTime is a parquet timestamp in microseconds since the unix epoch, it is
+// an instant if utc is true, otherwise it is a local time without a time zone.
+type parquetTime struct {
+	micros int64
+	utc    bool
+}
+
+// parquetJSON is a parquet list loaded as a json value
+type parquetJSON struct {
+	v any
+}
+
+// parquetEpoch is the date of the unix epoch, the parquet dates are the days since it
+var parquetEpoch = types.FromCalendar(1970, 1, 1)
+
+// NewParquetReader opens the parquet file of param, the i-th field of every row read
+// is the value of the parquet column whose name is cols[i] ignoring case, or null if
+// there is no such column.
+func NewParquetReader(ctx context.Context, param *tree.ExternParam, cols []string) (*ParquetReader, error) {
+	file, err := openETLFile(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+	return newParquetReader(file, cols, nil, nil)
+}
+
+func newParquetReader(file *etlFile, cols []string, filters []parquetFilter, loc *time.Location) (*ParquetReader, error) {
+	meta, err := goparquet.ReadFileMetaData(file, true)
+	if err != nil {
+		return nil, err
+	}
+	// the top level columns of the file by lower case name
+	columns := make(map[string]*parquet.SchemaElement)
+	for i, n := 1, 0; i < len(meta.Schema) && n < int(meta.Schema[0].GetNumChildren()); n++ {
+		columns[strings.ToLower(meta.Schema[i].Name)] = meta.Schema[i]
+		i = skipSchemaElement(meta.Schema, i)
+	}
+	r := &ParquetReader{
+		file:  file,
+		names: make([]string, len(cols)),
+		elems: make([]*parquet.SchemaElement, len(cols)),
+	}
+	var selected []string
+	for i, col := range cols {
+		if col == "" {
+			continue
+		}
+		elem, ok := columns[strings.ToLower(col)]
+		if !ok {
+			// the column not in the file is null, as the files of a table may have different schemas
+			continue
+		}
+		r.names[i] = elem.Name
+		r.elems[i] = elem
+		selected = append(selected, elem.Name)
+	}
+	if len(filters) > 0 {
+		pruned := *meta
+		pruned.RowGroups = nil
+		for _, rg := range meta.RowGroups {
+			if !skipRowGroup(rg, columns, filters, loc) {
+				pruned.RowGroups = append(pruned.RowGroups, rg)
+			}
+		}
+		meta = &pruned
+	}
+	r.fr, err = goparquet.NewFileReaderWithOptions(file,
+		goparquet.WithReaderContext(file.ctx),
+		goparquet.WithFileMetaData(meta),
+		goparquet.WithColumns(selected...))
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// skipSchemaElement returns the index of the next sibling of the schema element i
+func skipSchemaElement(schema []*parquet.SchemaElement, i int) int {
+	n := int(schema[i].GetNumChildren())
+	i++
+	for ; n > 0; n-- {
+		i = skipSchemaElement(schema, i)
+	}
+	return i
+}
+
+// Read returns at most n rows, it returns less than n rows only if the file is finished
+func (r *ParquetReader) Read(n int) ([]map[string]any, error) {
+	rows := make([]map[string]any, 0, n)
+	for len(rows) < n {
+		row, err := r.fr.NextRow()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func (r *ParquetReader) Close() error {
+	r.file.buf = nil
+	return nil
+}
+
+// fillBatch converts the rows read to a batch of the table, the timestamps are converted in the time zone loc
+func (r *ParquetReader) fillBatch(param *ExternalParam, rows []map[string]any, loc *time.Location) (*batch.Batch, error) {
+	bat := makeBatch(param, &ParseLineHandler{batchSize: len(rows)})
+	for colIdx, attr := range param.Attrs {
+		field := param.Name2ColIndex[attr]
+		name, elem := r.names[field], r.elems[field]
+		vec := bat.Vecs[colIdx]
+		for rowIdx, row := range rows {
+			v, ok := row[name]
+			if name == "" || !ok || v == nil {
+				setParquetNull(vec, rowIdx)
+				continue
+			}
+			pv, err := parquetValue(elem, v)
+			if err != nil {
+				return nil, err
+			}
+			if err := setParquetValue(vec, rowIdx, pv, loc); err != nil {
+				return nil, fmt.Errorf("the parquet value of column '%s' can not be loaded into column %d: %v", elem.Name, colIdx, err)
+			}
+		}
+	}
+	return bat, nil
+}
+
+func logicalType(elem *parquet.SchemaElement) *parquet.LogicalType {
+	if lt := elem.GetLogicalType(); lt != nil {
+		return lt
+	}
+	return &parquet.LogicalType{}
+}
+
+// isConvertedType returns true if the deprecated converted type of elem is ct,
+// the getter of the converted type returns UTF8 if it is not set.
+func isConvertedType(elem *parquet.SchemaElement, ct parquet.ConvertedType) bool {
+	return elem.IsSetConvertedType() && elem.GetConvertedType() == ct
+}
+
+// parquetValue converts a value read from the column elem by its logical type,
+// the result is a bool, int64, uint64, float32, float64, []byte, types.Date,
+// types.Uuid, parquetDecimal, parquetTime or parquetJSON.
+func parquetValue(elem *parquet.SchemaElement, v any) (any, error) {
+	lt := logicalType(elem)
+	switch {
+	case lt.IsSetLIST() || isConvertedType(elem, parquet.ConvertedType_LIST):
+		return parquetJSON{v: listValue(v)}, nil
+	case isDecimal(elem):
+		scale := elem.GetScale()
+		if lt.IsSetDECIMAL() {
+			scale = lt.DECIMAL.Scale
+		}
+		unscaled := new(big.Int)
+		switch v := v.(type) {
+		case int32:
+			unscaled.SetInt64(int64(v))
+		case int64:
+			unscaled.SetInt64(v)
+		case []byte:
+			// a big-endian two's complement integer
+			unscaled.SetBytes(v)
+			if len(v) > 0 && v[0]&0x80 != 0 {
+				unscaled.Sub(unscaled, new(big.Int).Lsh(big.NewInt(1), uint(len(v)*8)))
+			}
+		default:
+			return nil, fmt.Errorf("the parquet decimal value of column '%s' is not support now", elem.Name)
+		}
+		return parquetDecimal{unscaled: unscaled, scale: scale}, nil
+	case lt.IsSetDATE() || isConvertedType(elem, parquet.ConvertedType_DATE):
+		if d, ok := v.(int32); ok {
+			return parquetEpoch + types.Date(d), nil
+		}
+	case lt.IsSetTIMESTAMP():
+		if t, ok := v.(int64); ok {
+			unit := lt.TIMESTAMP.GetUnit()
+			switch {
+			case unit == nil || unit.IsSetMILLIS():
+				t *= 1000
+			case unit.IsSetNANOS():
+				t = floorDiv(t, 1000)
+			}
+			return parquetTime{micros: t, utc: lt.TIMESTAMP.IsAdjustedToUTC}, nil
+		}
+	case isConvertedType(elem, parquet.ConvertedType_TIMESTAMP_MILLIS):
+		if t, ok := v.(int64); ok {
+			return parquetTime{micros: t * 1000, utc: true}, nil
+		}
+	case isConvertedType(elem, parquet.ConvertedType_TIMESTAMP_MICROS):
+		if t, ok := v.(int64); ok {
+			return parquetTime{micros: t, utc: true}, nil
+		}
+	case lt.IsSetUUID():
+		if b, ok := v.([]byte); ok && len(b) == len(types.Uuid{}) {
+			var u types.Uuid
+			copy(u[:], b)
+			return u, nil
+		}
+	}
+	unsigned := isUnsigned(elem)
+	switch v := v.(type) {
+	case bool, float32, float64, []byte:
+		return v, nil
+	case int32:
+		if unsigned {
+			return uint64(uint32(v)), nil
+		}
+		return int64(v), nil
+	case int64:
+		if unsigned {
+			return uint64(v), nil
+		}
+		return v, nil
+	case [12]byte:
+		// the deprecated INT96 timestamps are instants in nanoseconds
+		return parquetTime{micros: goparquet.Int96ToTime(v).UnixMicro(), utc: true}, nil
+	}
+	return nil, fmt.Errorf("the parquet value of column '%s' is not support now", elem.Name)
+}
+
+func isUnsigned(elem *parquet.SchemaElement) bool {
+	lt := logicalType(elem)
+	return lt.IsSetINTEGER() && !lt.INTEGER.IsSigned ||
+		isConvertedType(elem, parquet.ConvertedType_UINT_8) || isConvertedType(elem, parquet.ConvertedType_UINT_16) ||
+		isConvertedType(elem, parquet.ConvertedType_UINT_32) || isConvertedType(elem, parquet.ConvertedType_UINT_64)
+}
+
+func isDecimal(elem *parquet.SchemaElement) bool {
+	return logicalType(elem).IsSetDECIMAL() || isConvertedType(elem, parquet.ConvertedType_DECIMAL)
+}
+
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && a < 0 {
+		q--
+	}
+	return q
+}
+
+func setParquetNull(vec *vector.Vector, row int) {
+	nulls.Add(vec.Nsp, uint64(row))
+	if vBytes, ok := vec.Col.(*types.Bytes); ok {
+		vBytes.Offsets[row] = uint32(len(vBytes.Data))
+	}
+}
+
+// setParquetValue sets the row of vec to the value returned by parquetValue
+func setParquetValue(vec *vector.Vector, row int, v any, loc *time.Location) error {
+	switch vec.Typ.Oid {
+	case types.T_bool:
+		b, ok := v.(bool)
+		if !ok {
+			return errParquetType(v, vec.Typ)
+		}
+		vec.Col.([]bool)[row] = b
+	case types.T_int8:
+		i, err := parquetToInt(v, vec.Typ, math.MinInt8, math.MaxInt8)
+		if err != nil {
+			return err
+		}
+		vec.Col.([]int8)[row] = int8(i)
+	case types.T_int16:
+		i, err := parquetToInt(v, vec.Typ, math.MinInt16, math.MaxInt16)
+		if err != nil {
+			return err
+		}
+		vec.Col.([]int16)[row] = int16(i)
+	case types.T_int32:
+		i, err := parquetToInt(v, vec.Typ, math.MinInt32, math.MaxInt32)
+		if err != nil {
+			return err
+		}
+		vec.Col.([]int32)[row] = int32(i)
+	case types.T_int64:
+		i, err := parquetToInt(v, vec.Typ, math.MinInt64, math.MaxInt64)
+		if err != nil {
+			return err
+		}
+		vec.Col.([]int64)[row] = i
+	case types.T_uint8:
+		u, err := parquetToUint(v, vec.Typ, math.MaxUint8)
+		if err != nil {
+			return err
+		}
+		vec.Col.([]uint8)[row] = uint8(u)
+	case types.T_uint16:
+		u, err := parquetToUint(v, vec.Typ, math.MaxUint16)
+		if err != nil {
+			return err
+		}
+		vec.Col.([]uint16)[row] = uint16(u)
+	case types.T_uint32:
+		u, err := parquetToUint(v, vec.Typ, math.MaxUint32)
+		if err != nil {
+			return err
+		}
+		vec.Col.([]uint32)[row] = uint32(u)
+	case types.T_uint64:
+		u, err := parquetToUint(v, vec.Typ, math.MaxUint64)
+		if err != nil {
+			return err
+		}
+		vec.Col.([]uint64)[row] = u
+	case types.T_float32:
+		f, err := parquetToFloat(v, vec.Typ)
+		if err != nil {
+			return err
+		}
+		vec.Col.([]float32)[row] = float32(f)
+	case types.T_float64:
+		f, err := parquetToFloat(v, vec.Typ)
+		if err != nil {
+			return err
+		}
+		vec.Col.([]float64)[row] = f
+	case types.T_decimal64:
+		d, err := parquetToDecimal64(v, vec.Typ)
+		if err != nil {
+			return err
+		}
+		vec.Col.([]types.Decimal64)[row] = d
+	case types.T_decimal128:
+		d, err := parquetToDecimal128(v, vec.Typ)
+		if err != nil {
+			return err
+		}
+		vec.Col.([]types.Decimal128)[row] = d
+	case types.T_date:
+		dt, err := parquetToDatetime(v, vec.Typ, loc)
+		if err != nil {
+			return err
+		}
+		vec.Col.([]types.Date)[row] = dt.ToDate()
+	case types.T_datetime:
+		dt, err := parquetToDatetime(v, vec.Typ, loc)
+		if err != nil {
+			return err
+		}
+		vec.Col.([]types.Datetime)[row] = dt
+	case types.T_timestamp:
+		ts, err := parquetToTimestamp(v, vec.Typ, loc)
+		if err != nil {
+			return err
+		}
+		vec.Col.([]types.Timestamp)[row] = ts
+	case types.T_uuid:
+		switch u := v.(type) {
+		case types.Uuid:
+			vec.Col.([]types.Uuid)[row] = u
+		case []byte:
+			d, err := types.ParseUuid(string(u))
+			if err != nil {
+				return err
+			}
+			vec.Col.([]types.Uuid)[row] = d
+		default:
+			return errParquetType(v, vec.Typ)
+		}
+	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary:
+		data, err := parquetToBytes(v, loc)
+		if err != nil {
+			return err
+		}
+		vBytes := vec.Col.(*types.Bytes)
+		vBytes.Offsets[row] = uint32(len(vBytes.Data))
+		vBytes.Data = append(vBytes.Data, data...)
+		vBytes.Lengths[row] = uint32(len(data))
+	case types.T_json:
+		var data []byte
+		var err error
+		if b, ok := v.([]byte); ok {
+			data = b
+		} else if j, ok := v.(parquetJSON); ok {
+			if data, err = json.Marshal(j.v); err != nil {
+				return err
+			}
+		} else if data, err = parquetToBytes(v, loc); err != nil {
+			return err
+		}
+		byteJson, err := types.ParseSliceToByteJson(data)
+		if err != nil {
+			return err
+		}
+		jsonBytes, err := types.EncodeJson(byteJson)
+		if err != nil {
+			return err
+		}
+		vBytes := vec.Col.(*types.Bytes)
+		vBytes.Offsets[row] = uint32(len(vBytes.Data))
+		vBytes.Data = append(vBytes.Data, jsonBytes...)
+		vBytes.Lengths[row] = uint32(len(jsonBytes))
+	default:
+		return errParquetType(v, vec.Typ)
+	}
+	return nil
+}
+
+func errParquetType(v any, typ types.Type) error {
+	return fmt.Errorf("the value of %T can not be converted to %s", v, typ.String())
+}
+
+// parquetToInt converts a number to an integer in [min, max], the floats are truncated
+func parquetToInt(v any, typ types.Type, min, max int64) (int64, error) {
+	switch v := v.(type) {
+	case int64:
+		if v >= min && v <= max {
+			return v, nil
+		}
+	case uint64:
+		if v <= uint64(max) {
+			return int64(v), nil
+		}
+	case float32:
+		if float64(v) >= float64(min) && float64(v) <= float64(max) {
+			return int64(v), nil
+		}
+	case float64:
+		if v >= float64(min) && v <= float64(max) {
+			return int64(v), nil
+		}
+	case []byte:
+		return strconv.ParseInt(string(v), 10, int(typ.Size*8))
+	default:
+		return 0, errParquetType(v, typ)
+	}
+	return 0, fmt.Errorf("the value %v is out of the range of %s", v, typ.String())
+}
+
+// parquetToUint converts a number to an unsigned integer not greater than max, the floats are truncated
+func parquetToUint(v any, typ types.Type, max uint64) (uint64, error) {
+	switch v := v.(type) {
+	case int64:
+		if v >= 0 && uint64(v) <= max {
+			return uint64(v), nil
+		}
+	case uint64:
+		if v <= max {
+			return v, nil
+		}
+	case float32:
+		if v >= 0 && float64(v) <= float64(max) {
+			return uint64(v), nil
+		}
+	case float64:
+		if v >= 0 && v <= float64(max) {
+			return uint64(v), nil
+		}
+	case []byte:
+		return strconv.ParseUint(string(v), 10, int(typ.Size*8))
+	default:
+		return 0, errParquetType(v, typ)
+	}
+	return 0, fmt.Errorf("the value %v is out of the range of %s", v, typ.String())
+}
+
+func parquetToFloat(v any, typ types.Type) (float64, error) {
+	switch v := v.(type) {
+	case int64:
+		return float64(v), nil
+	case uint64:
+		return float64(v), nil
+	case float32:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	case parquetDecimal:
+		f, _ := v.rat().Float64()
+		return f, nil
+	case []byte:
+		return strconv.ParseFloat(string(v), int(typ.Size*8))
+	}
+	return 0, errParquetType(v, typ)
+}
+
+func (d parquetDecimal) rat() *big.Rat {
+	return new(big.Rat).SetFrac(d.unscaled, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(d.scale)), nil))
+}
+
+func parquetToDecimal64(v any, typ types.Type) (types.Decimal64, error) {
+	switch v := v.(type) {
+	case int64:
+		return types.Decimal64_FromInt64(v), nil
+	case uint64:
+		return types.Decimal64_FromUint64(v), nil
+	case float32:
+		return types.Decimal64_FromFloat64(float64(v)), nil
+	case float64:
+		return types.Decimal64_FromFloat64(v), nil
+	case parquetDecimal:
+		if v.unscaled.IsInt64() {
+			d := types.Decimal64_FromInt64(v.unscaled.Int64())
+			for scale := v.scale; scale > 0; scale -= maxPow10Digits {
+				d = d.DivInt64(pow10(scale))
+			}
+			return d, nil
+		}
+		d, err := parquetToDecimal128(v, typ)
+		if err != nil {
+			return types.Decimal64{}, err
+		}
+		return d.ToDecimal64()
+	case []byte:
+		return types.Decimal64_FromStringWithScale(string(v), typ.Scale)
+	}
+	return types.Decimal64{}, errParquetType(v, typ)
+}
+
+func parquetToDecimal128(v any, typ types.Type) (types.Decimal128, error) {
+	switch v := v.(type) {
+	case int64:
+		return types.Decimal128_FromInt64(v), nil
+	case uint64:
+		return types.Decimal128_FromUint64(v), nil
+	case float32:
+		return types.Decimal128_FromFloat64(float64(v)), nil
+	case float64:
+		return types.Decimal128_FromFloat64(v), nil
+	case parquetDecimal:
+		// build the unscaled value from the digits in groups of maxPow10Digits
+		abs := new(big.Int).Abs(v.unscaled)
+		var groups []int64
+		base := big.NewInt(pow10(maxPow10Digits))
+		for abs.Sign() > 0 {
+			group := new(big.Int)
+			abs.DivMod(abs, base, group)
+			groups = append(groups, group.Int64())
+		}
+		d := types.Decimal128_FromInt64(0)
+		for i := len(groups) - 1; i >= 0; i-- {
+			d = d.MulInt64(pow10(maxPow10Digits)).AddInt64(groups[i])
+		}
+		if v.unscaled.Sign() < 0 {
+			d = types.Decimal128_FromInt64(0).Sub(d)
+		}
+		for scale := v.scale; scale > 0; scale -= maxPow10Digits {
+			d = d.DivInt64(pow10(scale))
+		}
+		return d, nil
+	case []byte:
+		return types.Decimal128_FromStringWithScale(string(v), typ.Scale)
+	}
+	return types.Decimal128{}, errParquetType(v, typ)
+}
+
+// maxPow10Digits is the largest n that 10^n is an int64
+const maxPow10Digits = 18
+
+// pow10 returns 10^n, n is at most maxPow10Digits
+func pow10(n int32) int64 {
+	if n > maxPow10Digits {
+		n = maxPow10Digits
+	}
+	p := int64(1)
+	for ; n > 0; n-- {
+		p *= 10
+	}
+	return p
+}
+
+// parquetToDatetime converts a date or timestamp to a datetime, the instants are converted to the local time of loc
+func parquetToDatetime(v any, typ types.Type, loc *time.Location) (types.Datetime, error) {
+	switch v := v.(type) {
+	case types.Date:
+		return v.ToDatetime(), nil
+	case parquetTime:
+		dt := types.Datetime(types.UnixMicroToTimestamp(v.micros).Truncate(typ.Precision))
+		if v.utc {
+			ts := []types.Timestamp{types.Timestamp(dt)}
+			dts := make([]types.Datetime, 1)
+			if _, err := types.TimestampToDatetime(loc, ts, dts); err != nil {
+				return 0, err
+			}
+			dt = dts[0]
+		}
+		return dt, nil
+	case []byte:
+		return types.ParseDatetime(string(v), typ.Precision)
+	}
+	return 0, errParquetType(v, typ)
+}
+
+// parquetToTimestamp converts a date or timestamp to a timestamp, the local times are in the time zone loc
+func parquetToTimestamp(v any, typ types.Type, loc *time.Location) (types.Timestamp, error) {
+	switch v := v.(type) {
+	case types.Date:
+		return v.ToTimestamp(loc), nil
+	case parquetTime:
+		ts := types.UnixMicroToTimestamp(v.micros).Truncate(typ.Precision)
+		if !v.utc {
+			ts = types.Datetime(ts).ToTimestamp(loc)
+		}
+		return ts, nil
+	case []byte:
+		return types.ParseTimestamp(loc, string(v), typ.Precision)
+	}
+	return 0, errParquetType(v, typ)
+}
+
+// parquetToBytes formats a value for the string columns
+func parquetToBytes(v any, loc *time.Location) ([]byte, error) {
+	switch v := v.(type) {
+	case []byte:
+		return v, nil
+	case bool:
+		return strconv.AppendBool(nil, v), nil
+	case int64:
+		return strconv.AppendInt(nil, v, 10), nil
+	case uint64:
+		return strconv.AppendUint(nil, v, 10), nil
+	case float32:
+		return strconv.AppendFloat(nil, float64(v), 'g', -1, 32), nil
+	case float64:
+		return strconv.AppendFloat(nil, v, 'g', -1, 64), nil
+	case parquetDecimal:
+		return []byte(v.rat().FloatString(int(v.scale))), nil
+	case types.Date:
+		return []byte(v.String()), nil
+	case types.Uuid:
+		return []byte(v.String()), nil
+	case parquetTime:
+		dt, err := parquetToDatetime(v, types.Type{Precision: 6}, loc)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(dt.String2(6)), nil
+	case parquetJSON:
+		return json.Marshal(v.v)
+	}
+	return nil, fmt.Errorf("the value of %T can not be converted to string", v)
+}
+
+// listValue unwraps the repeated group of a parquet LIST as a slice
+func listValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		if len(v) == 1 {
+			for _, elems := range v {
+				if list, ok := elems.([]map[string]any); ok {
+					vs := make([]any, len(list))
+					for i, elem := range list {
+						vs[i] = listElement(elem)
+					}
+					return vs
+				}
+			}
+		}
+		m := make(map[string]any, len(v))
+		for k, elem := range v {
+			m[k] = listValue(elem)
+		}
+		return m
+	case []map[string]any:
+		vs := make([]any, len(v))
+		for i, elem := range v {
+			vs[i] = listValue(elem)
+		}
+		return vs
+	case []any:
+		vs := make([]any, len(v))
+		for i, elem := range v {
+			vs[i] = listValue(elem)
+		}
+		return vs
+	case []byte:
+		return string(v)
+	case [12]byte:
+		return goparquet.Int96ToTime(v).UTC().Format(time.RFC3339Nano)
+	}
+	return v
+}
+
+func listElement(elem map[string]any) any {
+	if len(elem) == 1 {
+		for _, v := range elem {
+			return listValue(v)
+		}
+	}
+	return listValue(elem)
+}
+
+// parquetFilter is a comparison between a column and a constant,
+// the row groups whose statistics can not satisfy it are skipped.
+type parquetFilter struct {
+	col string
+	typ types.Type // the type of the column in the table
+	op  int32
+	val any // *big.Rat, string, types.Date, types.Datetime or types.Timestamp
+}
+
+// getParquetFilters returns the comparisons between a column and a constant in the filters of the scan
+func getParquetFilters(param *ExternalParam, proc *process.Process) []parquetFilter {
+	var filters []parquetFilter
+	for _, expr := range colexec.SplitAndExprs(param.Filters) {
+		f, ok := expr.Expr.(*plan.Expr_F)
+		if !ok || len(f.F.Args) != 2 {
+			continue
+		}
+		op, _ := function.DecodeOverloadID(f.F.Func.GetObj())
+		switch op {
+		case function.EQUAL, function.LESS_THAN, function.LESS_EQUAL, function.GREAT_THAN, function.GREAT_EQUAL:
+		default:
+			continue
+		}
+		left, right := f.F.Args[0], f.F.Args[1]
+		if _, ok := left.Expr.(*plan.Expr_Col); !ok {
+			// the constant is at the left, reverse the comparison
+			left, right = right, left
+			switch op {
+			case function.LESS_THAN:
+				op = function.GREAT_THAN
+			case function.LESS_EQUAL:
+				op = function.GREAT_EQUAL
+			case function.GREAT_THAN:
+				op = function.LESS_THAN
+			case function.GREAT_EQUAL:
+				op = function.LESS_EQUAL
+			}
+		}
+		col, ok := left.Expr.(*plan.Expr_Col)
+		if !ok || int(col.Col.ColPos) >= len(param.Attrs) || !isConstantExpr(right) {
+			continue
+		}
+		val, ok := evalParquetConstant(right, proc)
+		if !ok {
+			continue
+		}
+		def := param.Cols[col.Col.ColPos]
+		typ := types.New(types.T(def.Typ.Id), def.Typ.Width, def.Typ.Scale, def.Typ.Precision)
+		typ.Collation = types.Collation(def.Typ.Collation)
+		filters = append(filters, parquetFilter{
+			col: strings.ToLower(param.Attrs[col.Col.ColPos]),
+			typ: typ,
+			op:  op,
+			val: val,
+		})
+	}
+	return filters
+}
+
+// isConstantExpr returns true if expr is evaluated without any column
+func isConstantExpr(expr *plan.Expr) bool {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_C:
+		return !e.C.Isnull
+	case *plan.Expr_T:
+		return true
+	case *plan.Expr_F:
+		f, err := function.GetFunctionByID(e.F.Func.GetObj())
+		if err != nil || f.Volatile {
+			return false
+		}
+		for _, arg := range e.F.Args {
+			if !isConstantExpr(arg) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// evalParquetConstant evaluates a constant expression to the value compared with the statistics
+func evalParquetConstant(expr *plan.Expr, proc *process.Process) (any, bool) {
+	bat := batch.NewWithSize(0)
+	bat.Zs = []int64{1}
+	vec, err := colexec.EvalExpr(bat, proc, expr)
+	if err != nil {
+		return nil, false
+	}
+	defer vec.Free(proc.Mp)
+	if nulls.Any(vec.Nsp) {
+		return nil, false
+	}
+	switch vec.Typ.Oid {
+	case types.T_int8:
+		return new(big.Rat).SetInt64(int64(vec.Col.([]int8)[0])), true
+	case types.T_int16:
+		return new(big.Rat).SetInt64(int64(vec.Col.([]int16)[0])), true
+	case types.T_int32:
+		return new(big.Rat).SetInt64(int64(vec.Col.([]int32)[0])), true
+	case types.T_int64:
+		return new(big.Rat).SetInt64(vec.Col.([]int64)[0]), true
+	case types.T_uint8:
+		return new(big.Rat).SetInt64(int64(vec.Col.([]uint8)[0])), true
+	case types.T_uint16:
+		return new(big.Rat).SetInt64(int64(vec.Col.([]uint16)[0])), true
+	case types.T_uint32:
+		return new(big.Rat).SetInt64(int64(vec.Col.([]uint32)[0])), true
+	case types.T_uint64:
+		return new(big.Rat).SetInt(new(big.Int).SetUint64(vec.Col.([]uint64)[0])), true
+	case types.T_float32:
+		return floatRat(float64(vec.Col.([]float32)[0]))
+	case types.T_float64:
+		return floatRat(vec.Col.([]float64)[0])
+	case types.T_decimal64:
+		return new(big.Rat).SetString(vec.Col.([]types.Decimal64)[0].ToString())
+	case types.T_decimal128:
+		return new(big.Rat).SetString(vec.Col.([]types.Decimal128)[0].ToString())
+	case types.T_char, types.T_varchar:
+		return string(vector.GetStrColumn(vec).Get(0)), true
+	case types.T_date:
+		return vec.Col.([]types.Date)[0], true
+	case types.T_datetime:
+		return vec.Col.([]types.Datetime)[0], true
+	case types.T_timestamp:
+		return vec.Col.([]types.Timestamp)[0], true
+	}
+	return nil, false
+}
+
+func floatRat(f float64) (*big.Rat, bool) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, false
+	}
+	return new(big.Rat).SetFloat64(f), true
+}
+
+// skipRowGroup returns true if the statistics of the row group show no row satisfies the filters
+func skipRowGroup(rg *parquet.RowGroup, columns map[string]*parquet.SchemaElement, filters []parquetFilter, loc *time.Location) bool {
+	for _, filter := range filters {
+		elem, ok := columns[filter.col]
+		if !ok {
+			continue
+		}
+		for _, chunk := range rg.Columns {
+			md := chunk.GetMetaData()
+			if md == nil || len(md.PathInSchema) != 1 || md.PathInSchema[0] != elem.Name {
+				continue
+			}
+			min, max, ok := getStatistics(elem, md, filter.typ, loc)
+			if ok && !statisticsMatch(filter, min, max, loc) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getStatistics returns the min and max values of the column chunk converted to the column type typ
+func getStatistics(elem *parquet.SchemaElement, md *parquet.ColumnMetaData, typ types.Type, loc *time.Location) (min, max any, ok bool) {
+	stats := md.GetStatistics()
+	if stats == nil {
+		return nil, nil, false
+	}
+	minValue, maxValue := stats.GetMinValue(), stats.GetMaxValue()
+	if minValue == nil || maxValue == nil {
+		// the deprecated min and max are in the signed order, it is only right for the signed numbers
+		bytesType := md.Type == parquet.Type_BYTE_ARRAY || md.Type == parquet.Type_FIXED_LEN_BYTE_ARRAY
+		if bytesType && !isDecimal(elem) || isUnsigned(elem) {
+			return nil, nil, false
+		}
+		minValue, maxValue = stats.GetMin(), stats.GetMax()
+	}
+	if minValue == nil || maxValue == nil {
+		return nil, nil, false
+	}
+	decode := func(data []byte, isMax bool) (any, bool) {
+		var v any
+		switch md.Type {
+		case parquet.Type_INT32:
+			if len(data) != 4 {
+				return nil, false
+			}
+			v = int32(binary.LittleEndian.Uint32(data))
+		case parquet.Type_INT64:
+			if len(data) != 8 {
+				return nil, false
+			}
+			v = int64(binary.LittleEndian.Uint64(data))
+		case parquet.Type_FLOAT:
+			if len(data) != 4 {
+				return nil, false
+			}
+			v = math.Float32frombits(binary.LittleEndian.Uint32(data))
+		case parquet.Type_DOUBLE:
+			if len(data) != 8 {
+				return nil, false
+			}
+			v = math.Float64frombits(binary.LittleEndian.Uint64(data))
+		case parquet.Type_BYTE_ARRAY, parquet.Type_FIXED_LEN_BYTE_ARRAY:
+			v = data
+		default:
+			return nil, false
+		}
+		pv, err := parquetValue(elem, v)
+		if err != nil {
+			return nil, false
+		}
+		return statisticsValue(pv, typ, loc, isMax)
+	}
+	if min, ok = decode(minValue, false); !ok {
+		return nil, nil, false
+	}
+	if max, ok = decode(maxValue, true); !ok {
+		return nil, nil, false
+	}
+	return min, max, true
+}
+
+// statisticsValue converts the min or max value of a column chunk as it is loaded into
+// the column type typ, ok is false if the conversion may change the order of the values.
+func statisticsValue(v any, typ types.Type, loc *time.Location, isMax bool) (any, bool) {
+	switch typ.Oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		// the floats are truncated when loaded
+		switch v := v.(type) {
+		case int64:
+			return new(big.Rat).SetInt64(v), true
+		case uint64:
+			return new(big.Rat).SetInt(new(big.Int).SetUint64(v)), true
+		case float32:
+			return floatRat(math.Trunc(float64(v)))
+		case float64:
+			return floatRat(math.Trunc(v))
+		}
+	case types.T_float32, types.T_float64:
+		switch v.(type) {
+		case int64, uint64, float32, float64, parquetDecimal:
+			f, err := parquetToFloat(v, typ)
+			if err != nil {
+				return nil, false
+			}
+			if typ.Oid == types.T_float32 {
+				f = float64(float32(f))
+			}
+			return floatRat(f)
+		}
+	case types.T_decimal64, types.T_decimal128:
+		// the floats are rounded when loaded
+		switch v := v.(type) {
+		case int64:
+			return new(big.Rat).SetInt64(v), true
+		case uint64:
+			return new(big.Rat).SetInt(new(big.Int).SetUint64(v)), true
+		case parquetDecimal:
+			return v.rat(), true
+		}
+	case types.T_date, types.T_datetime, types.T_timestamp:
+		if t, ok := v.(parquetTime); ok && (t.utc != (typ.Oid == types.T_timestamp)) {
+			// the local time of an instant is not monotonic when the offset of the
+			// time zone changes, a day covers any change of the offset.
+			if isMax {
+				t.micros += int64(24 * time.Hour / time.Microsecond)
+			} else {
+				t.micros -= int64(24 * time.Hour / time.Microsecond)
+			}
+			v = t
+		}
+		switch v.(type) {
+		case types.Date, parquetTime:
+		default:
+			return nil, false
+		}
+		switch typ.Oid {
+		case types.T_date:
+			dt, err := parquetToDatetime(v, typ, loc)
+			return dt.ToDate(), err == nil
+		case types.T_datetime:
+			dt, err := parquetToDatetime(v, typ, loc)
+			return dt, err == nil
+		default:
+			ts, err := parquetToTimestamp(v, typ, loc)
+			return ts, err == nil
+		}
+	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary:
+		// the statistics of byte arrays are in the order of bytes
+		if v, ok := v.([]byte); ok && typ.Collation.IsBinary() {
+			return string(v), true
+		}
+	}
+	return nil, false
+}
+
+// statisticsMatch returns false if no value in [min, max] satisfies the filter
+func statisticsMatch(filter parquetFilter, min, max any, loc *time.Location) bool {
+	lo, ok := compareStatistics(min, filter.val, loc)
+	if !ok {
+		return true
+	}
+	hi, ok := compareStatistics(max, filter.val, loc)
+	if !ok {
+		return true
+	}
+	switch filter.op {
+	case function.EQUAL:
+		return lo <= 0 && hi >= 0
+	case function.LESS_THAN:
+		return lo < 0
+	case function.LESS_EQUAL:
+		return lo <= 0
+	case function.GREAT_THAN:
+		return hi > 0
+	case function.GREAT_EQUAL:
+		return hi >= 0
+	}
+	return true
+}
+
+// compareStatistics compares a value returned by statisticsValue with the constant of a filter
+func compareStatistics(a, b any, loc *time.Location) (int, bool) {
+	switch a := a.(type) {
+	case *big.Rat:
+		if b, ok := b.(*big.Rat); ok {
+			return a.Cmp(b), true
+		}
+	case string:
+		if b, ok := b.(string); ok {
+			return strings.Compare(a, b), true
+		}
+	case types.Date:
+		switch b := b.(type) {
+		case types.Date:
+			return compareInt64(int64(a), int64(b)), true
+		case types.Datetime:
+			return compareInt64(int64(a.ToDatetime()), int64(b)), true
+		case types.Timestamp:
+			return compareInt64(int64(a.ToTimestamp(loc)), int64(b)), true
+		}
+	case types.Datetime:
+		switch b := b.(type) {
+		case types.Date:
+			return compareInt64(int64(a), int64(b.ToDatetime())), true
+		case types.Datetime:
+			return compareInt64(int64(a), int64(b)), true
+		case types.Timestamp:
+			return compareInt64(int64(a.ToTimestamp(loc)), int64(b)), true
+		}
+	case types.Timestamp:
+		switch b := b.(type) {
+		case types.Date:
+			return compareInt64(int64(a), int64(b.ToTimestamp(loc))), true
+		case types.Datetime:
+			return compareInt64(int64(a), int64(b.ToTimestamp(loc))), true
+		case types.Timestamp:
+			return compareInt64(int64(a), int64(b)), true
+		}
+	}
+	return 0, false
+}
+
+func compareInt64(a, b int64) int {
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+	return 0
+}
+
+// ScanParquetFile reads a batch of the table from the parquet file
+func ScanParquetFile(param *ExternalParam, proc *process.Process) (*batch.Batch, error) {
+	loc := proc.SessionInfo.TimeZone
+	if loc == nil {
+		loc = time.Local
+	}
+	if param.parquet == nil {
+		file, err := openScanFile(param)
+		if err != nil {
+			return nil, err
+		}
+		param.parquet, err = newParquetReader(file, getLineColumns(param), getParquetFilters(param, proc), loc)
+		if err != nil {
+			return nil, err
+		}
+	}
+	rows, err := param.parquet.Read(param.batchSize)
+	if err != nil {
+		return nil, err
+	}
+	bat, err := param.parquet.fillBatch(param, rows, loc)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) < param.batchSize {
+		if err := param.parquet.Close(); err != nil {
+			logutil.Errorf("close file failed. err:%v", err)
+		}
+		param.parquet = nil
+		param.FileIndex++
+		if param.FileIndex >= param.FileCnt {
+			param.End = true
+		}
+	}
+	setZs(bat, proc)
+	bat.Cnt = 1
+	return bat, nil
+}
@@ -0,0 +1,224 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	goparquet "github.com/fraugster/parquet-go"
+	"github.com/fraugster/parquet-go/parquetschema"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+const testParquetSchema = `message test {
+	required int32 id;
+	optional binary name (STRING);
+	optional int64 price (DECIMAL(10,2));
+	optional int32 day (DATE);
+	optional int64 ts (TIMESTAMP(MICROS,true));
+	optional group tags (LIST) {
+		repeated group list {
+			required binary element (STRING);
+		}
+	}
+}`
+
+// writeTestParquet writes 6 rows in 2 row groups, the id of the rows is from 1 to 6
+func writeTestParquet(t *testing.T, name string) {
+	sd, err := parquetschema.ParseSchemaDefinition(testParquetSchema)
+	require.NoError(t, err)
+	f, err := os.Create(name)
+	require.NoError(t, err)
+	defer f.Close()
+	fw := goparquet.NewFileWriter(f, goparquet.WithSchemaDefinition(sd))
+	ts := time.Date(2022, 8, 1, 10, 20, 30, 123456000, time.UTC)
+	for i := int32(1); i <= 6; i++ {
+		row := map[string]any{
+			"id":    i,
+			"price": int64(i*100 + 5),
+			"day":   int32(19000) + i,
+			"ts":    ts.Add(time.Duration(i) * time.Hour).UnixMicro(),
+			"tags": map[string]any{
+				"list": []map[string]any{
+					{"element": []byte("a")},
+					{"element": []byte("b")},
+				},
+			},
+		}
+		if i%2 == 1 {
+			row["name"] = []byte("name" + string(rune('0'+i)))
+		}
+		require.NoError(t, fw.AddData(row))
+		if i == 3 {
+			require.NoError(t, fw.FlushRowGroup())
+		}
+	}
+	require.NoError(t, fw.Close())
+}
+
+func newParquetParam(t *testing.T, name string, filters []*plan.Expr) *Argument {
+	extern := &tree.ExternParam{
+		Filepath:     name,
+		CompressType: tree.AUTO,
+		Format:       tree.PARQUET,
+		Tail:         &tree.TailParameter{},
+	}
+	data, err := json.Marshal(extern)
+	require.NoError(t, err)
+	cols := []*plan.ColDef{
+		{Name: "id", Typ: &plan.Type{Id: int32(types.T_int32)}},
+		{Name: "name", Typ: &plan.Type{Id: int32(types.T_varchar), Width: 20}},
+		{Name: "price", Typ: &plan.Type{Id: int32(types.T_decimal64), Width: 10, Scale: 2}},
+		{Name: "day", Typ: &plan.Type{Id: int32(types.T_date)}},
+		{Name: "ts", Typ: &plan.Type{Id: int32(types.T_datetime), Precision: 6}},
+		{Name: "tags", Typ: &plan.Type{Id: int32(types.T_json)}},
+		{Name: "missing", Typ: &plan.Type{Id: int32(types.T_int64)}},
+	}
+	param := &ExternalParam{
+		Cols:          cols,
+		Name2ColIndex: make(map[string]int32),
+		CreateSql:     string(data),
+		Filters:       filters,
+		Ctx:           context.Background(),
+	}
+	for i, col := range cols {
+		param.Attrs = append(param.Attrs, col.Name)
+		param.Name2ColIndex[col.Name] = int32(i)
+	}
+	return &Argument{Es: param}
+}
+
+func TestParquetScan(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "test.parquet")
+	writeTestParquet(t, name)
+
+	proc := testutil.NewProcess()
+	// the instants are loaded as the local time of the session
+	proc.SessionInfo.TimeZone = time.FixedZone("UTC+8", 8*60*60)
+	arg := newParquetParam(t, name, nil)
+	require.NoError(t, Prepare(proc, arg))
+	end, err := Call(0, proc, arg)
+	require.NoError(t, err)
+	require.False(t, end)
+	bat := proc.InputBatch()
+	require.Equal(t, 6, vector.Length(bat.Vecs[0]))
+	require.Equal(t, []int32{1, 2, 3, 4, 5, 6}, bat.Vecs[0].Col.([]int32))
+	require.Equal(t, "name1", string(vector.GetStrColumn(bat.Vecs[1]).Get(0)))
+	require.True(t, bat.Vecs[1].Nsp.Contains(1))
+	require.Equal(t, "1.05", bat.Vecs[2].Col.([]types.Decimal64)[0].ToStringWithScale(2))
+	require.Equal(t, "2022-01-09", bat.Vecs[3].Col.([]types.Date)[0].String())
+	require.Equal(t, "2022-08-01 19:20:30.123456", bat.Vecs[4].Col.([]types.Datetime)[0].String2(6))
+	require.Equal(t, `["a", "b"]`, types.DecodeJson(vector.GetStrColumn(bat.Vecs[5]).Get(0)).String())
+	require.True(t, bat.Vecs[6].Nsp.Contains(0))
+	end, err = Call(0, proc, arg)
+	require.NoError(t, err)
+	require.True(t, end)
+}
+
+func TestParquetRowGroupPruning(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "test.parquet")
+	writeTestParquet(t, name)
+
+	compare := func(op int, pos int32, val *plan.Const) *plan.Expr {
+		return &plan.Expr{
+			Expr: &plan.Expr_F{
+				F: &plan.Function{
+					Func: &plan.ObjectRef{Obj: function.EncodeOverloadID(int32(op), 0)},
+					Args: []*plan.Expr{
+						{Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: pos}}},
+						{Expr: &plan.Expr_C{C: val}},
+					},
+				},
+			},
+		}
+	}
+	price := types.MustDecimal64FromString("3.50")
+	// the first row group has the ids from 1 to 3, the second one has the ids from 4 to 6
+	tests := []struct {
+		name   string
+		filter *plan.Expr
+		ids    []int32
+	}{
+		{
+			name:   "int",
+			filter: compare(function.GREAT_THAN, 0, &plan.Const{Value: &plan.Const_Ival{Ival: 4}}),
+			ids:    []int32{4, 5, 6},
+		},
+		{
+			name: "decimal",
+			filter: compare(function.GREAT_EQUAL, 2, &plan.Const{Value: &plan.Const_Decimal64Val{
+				Decimal64Val: &plan.Decimal64{A: types.Decimal64ToInt64Raw(price)},
+			}}),
+			ids: []int32{4, 5, 6},
+		},
+		{
+			name: "date",
+			filter: compare(function.LESS_EQUAL, 3, &plan.Const{Value: &plan.Const_Dateval{
+				Dateval: int32(types.FromCalendar(2022, 1, 10)),
+			}}),
+			ids: []int32{1, 2, 3},
+		},
+		{
+			name: "timestamp",
+			filter: compare(function.LESS_THAN, 4, &plan.Const{Value: &plan.Const_Datetimeval{
+				Datetimeval: int64(types.FromClock(2022, 7, 30, 0, 0, 0, 0)),
+			}}),
+			// both row groups are skipped
+			ids: nil,
+		},
+		{
+			name: "timestamp in range",
+			filter: compare(function.GREAT_EQUAL, 4, &plan.Const{Value: &plan.Const_Datetimeval{
+				Datetimeval: int64(types.FromClock(2022, 8, 1, 0, 0, 0, 0)),
+			}}),
+			ids: []int32{1, 2, 3, 4, 5, 6},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			proc := testutil.NewProcess()
+			proc.SessionInfo.TimeZone = time.UTC
+			arg := newParquetParam(t, name, []*plan.Expr{test.filter})
+			require.NoError(t, Prepare(proc, arg))
+			_, err := Call(0, proc, arg)
+			require.NoError(t, err)
+			require.Equal(t, test.ids, proc.InputBatch().Vecs[0].Col.([]int32))
+		})
+	}
+}
+
+func TestParquetDecimal(t *testing.T) {
+	unscaled, ok := new(big.Int).SetString("-12345678901234567890123", 10)
+	require.True(t, ok)
+	d128, err := parquetToDecimal128(parquetDecimal{unscaled: unscaled, scale: 5}, types.Type{})
+	require.NoError(t, err)
+	require.Equal(t, "-123456789012345678.90123", d128.ToStringWithScale(5))
+
+	d64, err := parquetToDecimal64(parquetDecimal{unscaled: big.NewInt(-105), scale: 2}, types.Type{})
+	require.NoError(t, err)
+	require.Equal(t, "-1.05", d64.ToStringWithScale(2))
+}
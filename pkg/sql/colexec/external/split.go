@@ -0,0 +1,268 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+const (
+	// minSplitSize and maxSplitSize bound the size of the byte ranges of the files
+	minSplitSize = 16 << 20
+	maxSplitSize = 128 << 20
+	// lineProbeSize is the bytes checked to find the start of a line from an offset
+	lineProbeSize = 64 << 10
+	// lineProbeLines is the lines checked to tell whether a line break is in a quoted field
+	lineProbeLines = 8
+	// rangeReadSize is the bytes read from the file service at a time when scanning a range
+	rangeReadSize = 8 << 20
+)
+
+// FileRange is a byte range of a file, the scan of the range reads the lines starting in it
+type FileRange struct {
+	Offset int64
+	Size   int64
+}
+
+// SplitFiles distributes the files among at most parallel scans, it returns the files, their
+// sizes and their ranges of every scan. The uncompressed csv files are split into the byte ranges,
+// the other files are scanned as a whole, and the rows are read in order by one scan
+// if it is requested by the keep_order option.
+func SplitFiles(param *tree.ExternParam, fileList []string, fileSize []int64, parallel int) ([][]string, [][]int64, [][]FileRange) {
+	if parallel <= 1 || param.KeepOrder || param.Format == tree.JSONLINE {
+		return [][]string{fileList}, [][]int64{fileSize}, [][]FileRange{nil}
+	}
+	splittable := param.Format == "" || param.Format == tree.CSV
+	var total int64
+	for i, name := range fileList {
+		compress := getCompressType(&tree.ExternParam{Filepath: name, CompressType: param.CompressType})
+		splittable = splittable && compress == tree.NOCOMPRESS
+		total += fileSize[i]
+	}
+	if !splittable {
+		n := parallel
+		if n > len(fileList) {
+			n = len(fileList)
+		}
+		fileLists := make([][]string, n)
+		fileSizes := make([][]int64, n)
+		for i, name := range fileList {
+			fileLists[i%n] = append(fileLists[i%n], name)
+			fileSizes[i%n] = append(fileSizes[i%n], fileSize[i])
+		}
+		return fileLists, fileSizes, make([][]FileRange, n)
+	}
+
+	splitSize := total / int64(parallel)
+	if splitSize < minSplitSize {
+		splitSize = minSplitSize
+	} else if splitSize > maxSplitSize {
+		splitSize = maxSplitSize
+	}
+	var names []string
+	var sizes []int64
+	var ranges []FileRange
+	for i, name := range fileList {
+		offset := int64(0)
+		for {
+			size := fileSize[i] - offset
+			if size > splitSize {
+				size = splitSize
+			}
+			names = append(names, name)
+			sizes = append(sizes, fileSize[i])
+			ranges = append(ranges, FileRange{Offset: offset, Size: size})
+			if offset += size; offset >= fileSize[i] {
+				break
+			}
+		}
+	}
+	n := parallel
+	if n > len(names) {
+		n = len(names)
+	}
+	fileLists := make([][]string, n)
+	fileSizes := make([][]int64, n)
+	fileRanges := make([][]FileRange, n)
+	for i := range names {
+		fileLists[i%n] = append(fileLists[i%n], names[i])
+		fileSizes[i%n] = append(fileSizes[i%n], sizes[i])
+		fileRanges[i%n] = append(fileRanges[i%n], ranges[i])
+	}
+	return fileLists, fileSizes, fileRanges
+}
+
+// lineFormat is the format of the csv lines used to find the start of a line in a range
+type lineFormat struct {
+	// sep is the field separator of FIELDS TERMINATED BY
+	sep byte
+	// quote encloses the fields of FIELDS ENCLOSED BY
+	quote byte
+	// lineEnd is the line terminator of LINES TERMINATED BY
+	lineEnd []byte
+}
+
+func getLineFormat(param *tree.ExternParam) lineFormat {
+	format := lineFormat{sep: ',', quote: '"', lineEnd: []byte{'\n'}}
+	if fields := param.Tail.Fields; fields != nil {
+		if fields.Terminated != "" {
+			format.sep = fields.Terminated[0]
+		}
+		if fields.EnclosedBy != 0 {
+			format.quote = fields.EnclosedBy
+		}
+	}
+	if lines := param.Tail.Lines; lines != nil && lines.TerminatedBy != "" {
+		format.lineEnd = []byte(lines.TerminatedBy)
+	}
+	return format
+}
+
+// readFileRange returns the reader of the lines starting in the range of the current file,
+// the last line is read to its end even if it is beyond the range.
+func readFileRange(param *ExternalParam, rang FileRange) (io.ReadCloser, error) {
+	file, err := openScanFile(param)
+	if err != nil {
+		return nil, err
+	}
+	format := getLineFormat(param.extern)
+	fields := len(getLineColumns(param))
+	start, err := alignLineStart(file, rang.Offset, format, fields)
+	if err != nil {
+		return nil, err
+	}
+	end, err := alignLineStart(file, rang.Offset+rang.Size, format, fields)
+	if err != nil {
+		return nil, err
+	}
+	if end < start {
+		end = start
+	}
+	if _, err = file.Seek(start, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return io.NopCloser(bufio.NewReaderSize(io.LimitReader(file, end-start), rangeReadSize)), nil
+}
+
+// alignLineStart returns the position of the first line starting at or after offset.
+// The scans of the adjacent ranges agree on the line starting around the boundary
+// of the ranges, since they check the same bytes.
+func alignLineStart(file *etlFile, offset int64, format lineFormat, fields int) (int64, error) {
+	if offset <= 0 {
+		return 0, nil
+	}
+	if offset >= file.size {
+		return file.size, nil
+	}
+	// the line starts at offset if the bytes before it are a line terminator
+	pos := offset - int64(len(format.lineEnd))
+	if pos < 0 {
+		pos = 0
+	}
+	buf := make([]byte, lineProbeSize)
+	for pos < file.size {
+		if _, err := file.Seek(pos, io.SeekStart); err != nil {
+			return 0, err
+		}
+		n, err := io.ReadFull(file, buf)
+		if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+			return 0, err
+		}
+		if i := findLineStart(buf[:n], format, fields); i >= 0 {
+			return pos + int64(i), nil
+		}
+		if pos+int64(n) >= file.size {
+			break
+		}
+		// the line terminator may cross the end of buf
+		pos += int64(n - len(format.lineEnd) + 1)
+	}
+	return file.size, nil
+}
+
+// findLineStart returns the index after the first line terminator in data which is followed by
+// the lines that look well formed, or after the first line terminator if there is no such one.
+// It returns -1 if there is no line terminator.
+func findLineStart(data []byte, format lineFormat, fields int) int {
+	first := -1
+	for i := bytes.Index(data, format.lineEnd); i >= 0; {
+		start := i + len(format.lineEnd)
+		if first < 0 {
+			first = start
+		}
+		if wellFormedLines(data[start:], format, fields) {
+			return start
+		}
+		j := bytes.Index(data[i+1:], format.lineEnd)
+		if j < 0 {
+			break
+		}
+		i += j + 1
+	}
+	return first
+}
+
+// wellFormedLines reports whether the complete lines at the beginning of data have the same
+// number of fields, which is at least fields, and every quoted field is followed by a field
+// separator or a line terminator. The line terminators in the quoted fields do not end the lines,
+// so the lines do not look well formed if data starts in a quoted field.
+func wellFormedLines(data []byte, format lineFormat, fields int) bool {
+	count, n, lines := -1, 1, 0
+	inQuote, quoted, fieldStart, empty := false, false, true, true
+	for i := 0; i < len(data) && lines < lineProbeLines; i++ {
+		c := data[i]
+		if inQuote {
+			if c == format.quote {
+				if i+1 < len(data) && data[i+1] == format.quote {
+					i++
+				} else {
+					inQuote, quoted = false, true
+				}
+			}
+			continue
+		}
+		lineEnd := bytes.HasPrefix(data[i:], format.lineEnd)
+		if quoted && c != format.sep && !lineEnd && c != '\r' {
+			return false
+		}
+		quoted = false
+		switch {
+		case lineEnd:
+			if !empty {
+				if n < fields || (count >= 0 && n != count) {
+					return false
+				}
+				count = n
+				lines++
+			}
+			n, fieldStart, empty = 1, true, true
+			i += len(format.lineEnd) - 1
+		case c == format.quote:
+			inQuote = fieldStart
+			fieldStart, empty = false, false
+		case c == format.sep:
+			n++
+			fieldStart, empty = true, false
+		case c == ' ', c == '\t', c == '\r':
+		default:
+			fieldStart, empty = false, false
+		}
+	}
+	return true
+}
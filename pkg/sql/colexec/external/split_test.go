@@ -0,0 +1,162 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitFiles(t *testing.T) {
+	param := &tree.ExternParam{CompressType: tree.AUTO}
+	files := []string{"a.csv", "b.csv"}
+
+	// the ranges are at least minSplitSize
+	fileLists, fileSizes, fileRanges := SplitFiles(param, files, []int64{3 * minSplitSize, 10}, 8)
+	require.Equal(t, [][]string{{"a.csv"}, {"a.csv"}, {"a.csv"}, {"b.csv"}}, fileLists)
+	require.Equal(t, [][]int64{{3 * minSplitSize}, {3 * minSplitSize}, {3 * minSplitSize}, {10}}, fileSizes)
+	require.Equal(t, [][]FileRange{
+		{{Offset: 0, Size: minSplitSize}},
+		{{Offset: minSplitSize, Size: minSplitSize}},
+		{{Offset: 2 * minSplitSize, Size: minSplitSize}},
+		{{Offset: 0, Size: 10}},
+	}, fileRanges)
+
+	// the ranges are distributed among the scans
+	fileLists, fileSizes, fileRanges = SplitFiles(param, files, []int64{6 * minSplitSize, 0}, 2)
+	require.Equal(t, [][]string{{"a.csv", "b.csv"}, {"a.csv"}}, fileLists)
+	require.Equal(t, [][]int64{{6 * minSplitSize, 0}, {6 * minSplitSize}}, fileSizes)
+	require.Equal(t, [][]FileRange{
+		{{Offset: 0, Size: 3 * minSplitSize}, {Offset: 0, Size: 0}},
+		{{Offset: 3 * minSplitSize, Size: 3 * minSplitSize}},
+	}, fileRanges)
+
+	// the compressed files are scanned as a whole
+	fileLists, fileSizes, fileRanges = SplitFiles(param, []string{"a.csv.gz", "b.csv"}, []int64{3 * minSplitSize, 10}, 4)
+	require.Equal(t, [][]string{{"a.csv.gz"}, {"b.csv"}}, fileLists)
+	require.Equal(t, [][]int64{{3 * minSplitSize}, {10}}, fileSizes)
+	require.Equal(t, [][]FileRange{nil, nil}, fileRanges)
+
+	param.KeepOrder = true
+	fileLists, fileSizes, fileRanges = SplitFiles(param, files, []int64{3 * minSplitSize, 10}, 4)
+	require.Equal(t, [][]string{files}, fileLists)
+	require.Equal(t, [][]int64{{3 * minSplitSize, 10}}, fileSizes)
+	require.Equal(t, [][]FileRange{nil}, fileRanges)
+}
+
+func TestFindLineStart(t *testing.T) {
+	format := lineFormat{sep: ',', quote: '"', lineEnd: []byte{'\n'}}
+	data := []byte("x\",1,2\n3,\"a\nb\",4\n5,\"c\",6\n7,8,9\n")
+	// the first line break is in the quoted field
+	require.Equal(t, 7, findLineStart(data, format, 3))
+	// the line break after 'a' is in the quoted field
+	require.Equal(t, 17, findLineStart(data[8:], format, 3)+8)
+	require.Equal(t, -1, findLineStart([]byte("abc"), format, 1))
+
+	// the terminators and the quote of the statement
+	format = lineFormat{sep: '|', quote: '\'', lineEnd: []byte("\r\n")}
+	data = []byte("x'|1|2\r\n3|'a\r\nb'|4\r\n5|'c'|6\r\n7|8|9\r\n")
+	require.Equal(t, 8, findLineStart(data, format, 3))
+	require.Equal(t, 20, findLineStart(data[9:], format, 3)+9)
+	require.Equal(t, -1, findLineStart([]byte("a\rb\n"), format, 1))
+}
+
+func newCSVParam(t *testing.T, name string, rang *FileRange) *Argument {
+	extern := &tree.ExternParam{
+		Filepath:     name,
+		CompressType: tree.AUTO,
+		Tail: &tree.TailParameter{
+			Fields:       &tree.Fields{Terminated: ","},
+			IgnoredLines: 1,
+		},
+	}
+	data, err := json.Marshal(extern)
+	require.NoError(t, err)
+	cols := []*plan.ColDef{
+		{Name: "id", Typ: &plan.Type{Id: int32(types.T_int64)}},
+		{Name: "name", Typ: &plan.Type{Id: int32(types.T_varchar), Width: 20}},
+	}
+	param := &ExternalParam{
+		Cols:          cols,
+		Name2ColIndex: make(map[string]int32),
+		CreateSql:     string(data),
+		Ctx:           context.Background(),
+	}
+	for i, col := range cols {
+		param.Attrs = append(param.Attrs, col.Name)
+		param.Name2ColIndex[col.Name] = int32(i)
+	}
+	if rang != nil {
+		info, err := os.Stat(name)
+		require.NoError(t, err)
+		param.FileList = []string{name}
+		param.FileSize = []int64{info.Size()}
+		param.FileRanges = []FileRange{*rang}
+	}
+	return &Argument{Es: param}
+}
+
+func TestScanFileRanges(t *testing.T) {
+	var sb strings.Builder
+	var expected []string
+	sb.WriteString("id,name\n")
+	for i := 0; i < 100; i++ {
+		name := fmt.Sprintf("name%d", i)
+		if i%3 == 0 {
+			name = fmt.Sprintf("line\n%d, \"quoted\"", i)
+		}
+		sb.WriteString(fmt.Sprintf("%d,\"%s\"\n", i, strings.ReplaceAll(name, "\"", "\"\"")))
+		expected = append(expected, fmt.Sprintf("%d:%s", i, name))
+	}
+	name := filepath.Join(t.TempDir(), "test.csv")
+	require.NoError(t, os.WriteFile(name, []byte(sb.String()), 0644))
+
+	proc := testutil.NewProcess()
+	var rows []string
+	size := int64(sb.Len())
+	for offset := int64(0); offset < size; offset += 37 {
+		arg := newCSVParam(t, name, &FileRange{Offset: offset, Size: 37})
+		require.NoError(t, Prepare(proc, arg))
+		for {
+			end, err := Call(0, proc, arg)
+			require.NoError(t, err)
+			if end {
+				break
+			}
+			bat := proc.InputBatch()
+			ids := bat.Vecs[0].Col.([]int64)
+			names := vector.GetStrColumn(bat.Vecs[1])
+			for i := range ids {
+				rows = append(rows, fmt.Sprintf("%d:%s", ids[i], names.Get(int64(i))))
+			}
+		}
+	}
+	sort.Strings(rows)
+	sort.Strings(expected)
+	require.Equal(t, expected, rows)
+}
@@ -30,6 +30,8 @@ type ExternalParam struct {
 	Cols          []*plan.ColDef
 	Name2ColIndex map[string]int32
 	CreateSql     string
+	// Filters are the filters of the scan, used to skip the data by the statistics of the file
+	Filters       []*plan.Expr
 	Ctx           context.Context
 	plh           *ParseLineHandler
 	extern        *tree.ExternParam
@@ -40,8 +42,18 @@ type ExternalParam struct {
 	FileCnt   int
 	FileIndex int
 	FileList  []string
-	batchSize int
-	reader    io.ReadCloser
+	// FileSize are the sizes of the files in FileList, the files are listed when
+	// they are opened if it is nil
+	FileSize []int64
+	// FileRanges are the byte ranges of the files in FileList to scan, the files are scanned
+	// as a whole if it is nil
+	FileRanges []FileRange
+	batchSize  int
+	reader     io.ReadCloser
+	parquet    *ParquetReader
+	jsonLine   *JsonLineReader
+	// Warnings is the number of the malformed lines skipped
+	Warnings uint64
 }
 
 type Argument struct {
@@ -0,0 +1,84 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// FillGeneratedColumns computes the generated columns of a batch which is
+// about to be written. The vectors of bat must be expanded and ordered as
+// ColDefs. Stored generated columns are evaluated from the other columns of
+// the same row, virtual generated columns are always persisted as null and
+// computed when they are read.
+func FillGeneratedColumns(proc *process.Process, ColDefs []*plan.ColDef, bat *batch.Batch) error {
+	rows := len(bat.Zs)
+	for i, def := range ColDefs {
+		g := def.GetGenerated()
+		if g == nil || i >= len(bat.Vecs) {
+			continue
+		}
+		if !g.Stored {
+			setAllNulls(bat.Vecs[i], rows)
+			continue
+		}
+		vec, err := EvalExpr(bat, proc, g.Expr)
+		if err != nil {
+			return err
+		}
+		if vec.IsScalarNull() {
+			setAllNulls(bat.Vecs[i], rows)
+			continue
+		}
+		if vec.IsConst {
+			vec = vec.ConstExpand(proc.Mp)
+		} else if isBatchVector(bat, vec) {
+			if vec, err = vector.Dup(vec, proc.Mp); err != nil {
+				return err
+			}
+		}
+		if !def.Default.GetNullAbility() && nulls.Any(vec.Nsp) {
+			vec.Free(proc.Mp)
+			return errors.New(errno.IntegrityConstraintViolation, fmt.Sprintf("Column '%s' cannot be null", def.Name))
+		}
+		vec.Typ = bat.Vecs[i].Typ
+		bat.Vecs[i].Free(proc.Mp)
+		bat.Vecs[i] = vec
+	}
+	return nil
+}
+
+func setAllNulls(vec *vector.Vector, rows int) {
+	for j := 0; j < rows; j++ {
+		nulls.Add(vec.Nsp, uint64(j))
+	}
+}
+
+func isBatchVector(bat *batch.Batch, vec *vector.Vector) bool {
+	for _, v := range bat.Vecs {
+		if v == vec {
+			return true
+		}
+	}
+	return false
+}
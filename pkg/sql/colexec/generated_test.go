@@ -0,0 +1,68 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFillGeneratedColumns(t *testing.T) {
+	proc := testutil.NewProcess()
+	typ := types.T_int64.ToType()
+	planTyp := &plan.Type{Id: int32(types.T_int64), Size: 8}
+
+	fid, _, _, err := function.GetFunctionByName("+", []types.Type{typ, typ})
+	require.NoError(t, err)
+	// b as (a + 1) stored
+	stored := &plan.Expr{
+		Typ: planTyp,
+		Expr: &plan.Expr_F{
+			F: &plan.Function{
+				Func: &plan.ObjectRef{Obj: fid},
+				Args: []*plan.Expr{
+					{Typ: planTyp, Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: 0}}},
+					{Typ: planTyp, Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_Ival{Ival: 1}}}},
+				},
+			},
+		},
+	}
+	defs := []*plan.ColDef{
+		{Name: "a", Typ: planTyp, Default: &plan.Default{NullAbility: true}},
+		{Name: "b", Typ: planTyp, Default: &plan.Default{NullAbility: true}, Generated: &plan.GeneratedCol{Expr: stored, Stored: true}},
+		{Name: "c", Typ: planTyp, Default: &plan.Default{NullAbility: true}, Generated: &plan.GeneratedCol{Expr: stored}},
+	}
+
+	bat := testutil.NewBatch([]types.Type{typ, typ, typ}, false, 3, proc.Mp)
+	require.NoError(t, FillGeneratedColumns(proc, defs, bat))
+	require.Equal(t, []int64{1, 2, 3}, bat.Vecs[1].Col)
+	for i := uint64(0); i < 3; i++ {
+		require.True(t, nulls.Contains(bat.Vecs[2].Nsp, i))
+	}
+	bat.Clean(proc.Mp)
+
+	// a not null stored column rejects a null result
+	defs[1].Default.NullAbility = false
+	bat = testutil.NewBatch([]types.Type{typ, typ, typ}, false, 2, proc.Mp)
+	nulls.Add(bat.Vecs[0].Nsp, 1)
+	require.Error(t, FillGeneratedColumns(proc, defs, bat))
+	bat.Clean(proc.Mp)
+}
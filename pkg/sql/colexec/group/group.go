@@ -21,6 +21,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregate"
@@ -96,6 +97,8 @@ func (ctr *container) process(ap *Argument, proc *process.Process, anal process.
 	proc.SetInputBatch(&batch.Batch{})
 	if len(ctr.aggVecs) == 0 {
 		ctr.aggVecs = make([]evalVector, len(ap.Aggs))
+		ctr.argVecs = make([][]evalVector, len(ap.Aggs))
+		ctr.aggInputs = make([][]*vector.Vector, len(ap.Aggs))
 	}
 	if err := ctr.evalAggVector(bat, ap.Aggs, proc); err != nil {
 		return false, err
@@ -171,6 +174,8 @@ func (ctr *container) processWithGroup(ap *Argument, proc *process.Process, anal
 	proc.SetInputBatch(&batch.Batch{})
 	if len(ctr.aggVecs) == 0 {
 		ctr.aggVecs = make([]evalVector, len(ap.Aggs))
+		ctr.argVecs = make([][]evalVector, len(ap.Aggs))
+		ctr.aggInputs = make([][]*vector.Vector, len(ap.Aggs))
 	}
 	if err := ctr.evalAggVector(bat, ap.Aggs, proc); err != nil {
 		ctr.clean()
@@ -274,7 +279,7 @@ func (ctr *container) processH0(bat *batch.Batch, ap *Argument, proc *process.Pr
 		ctr.bat.Zs[0] += z
 	}
 	for i, agg := range ctr.bat.Aggs {
-		agg.BulkFill(0, bat.Zs, []*vector.Vector{ctr.aggVecs[i].vec})
+		agg.BulkFill(0, bat.Zs, ctr.aggInputs[i])
 	}
 	return nil
 }
@@ -353,7 +358,7 @@ func (ctr *container) batchFill(i int, n int, bat *batch.Batch, vals []uint64, h
 		return nil
 	}
 	for j, ag := range ctr.bat.Aggs {
-		err := ag.BatchFill(int64(i), ctr.inserted[:n], vals, bat.Zs, []*vector.Vector{ctr.aggVecs[j].vec})
+		err := ag.BatchFill(int64(i), ctr.inserted[:n], vals, bat.Zs, ctr.aggInputs[j])
 		if err != nil {
 			return err
 		}
@@ -380,6 +385,40 @@ func (ctr *container) evalAggVector(bat *batch.Batch, aggs []aggregate.Aggregate
 				break
 			}
 		}
+		ctr.aggInputs[i] = append(ctr.aggInputs[i][:0], vec)
+		if len(ag.Args) > 0 {
+			if err := ctr.evalAggArgs(i, bat, ag.Args, proc); err != nil {
+				ctr.freeAggVector(proc)
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// evalAggArgs evaluates the extra arguments of the i-th aggregation
+// and appends them to its input vectors.
+func (ctr *container) evalAggArgs(i int, bat *batch.Batch, args []*plan.Expr, proc *process.Process) error {
+	if len(ctr.argVecs[i]) == 0 {
+		ctr.argVecs[i] = make([]evalVector, len(args))
+	}
+	for j, arg := range args {
+		vec, err := colexec.EvalExpr(bat, proc, arg)
+		if err != nil {
+			return err
+		}
+		ctr.argVecs[i][j].vec = vec
+		ctr.argVecs[i][j].needFree = true
+		for k := range bat.Vecs {
+			if bat.Vecs[k] == vec {
+				ctr.argVecs[i][j].needFree = false
+				break
+			}
+		}
+		if vec.ConstExpand(proc.GetMheap()) == nil {
+			return fmt.Errorf("failed to expand the argument of aggregation")
+		}
+		ctr.aggInputs[i] = append(ctr.aggInputs[i], vec)
 	}
 	return nil
 }
@@ -388,6 +427,13 @@ func (ctr *container) freeAggVector(proc *process.Process) {
 	for i := range ctr.aggVecs {
 		if ctr.aggVecs[i].needFree {
 			ctr.aggVecs[i].vec.Free(proc.GetMheap())
+			ctr.aggVecs[i].needFree = false
+		}
+		for j := range ctr.argVecs[i] {
+			if ctr.argVecs[i][j].needFree {
+				ctr.argVecs[i][j].vec.Free(proc.GetMheap())
+				ctr.argVecs[i][j].needFree = false
+			}
 		}
 	}
 }
@@ -87,6 +87,20 @@ func init() {
 			{Oid: types.T_varchar},
 			{Oid: types.T_decimal128},
 		}, []*plan.Expr{newExpression(1), newExpression(2), newExpression(3)}, []aggregate.Aggregate{{Op: 0, E: newExpression(0)}}),
+		newTestCase(mheap.New(gm), []bool{false, false}, []types.Type{
+			{Oid: types.T_float64},
+			{Oid: types.T_float64},
+		}, []*plan.Expr{}, []aggregate.Aggregate{{Op: aggregate.Corr, E: newExpression(0), Args: []*plan.Expr{newExpression(1)}}}),
+		newTestCase(mheap.New(gm), []bool{false, false}, []types.Type{
+			{Oid: types.T_float64},
+			{Oid: types.T_int64},
+		}, []*plan.Expr{newExpression(1)}, []aggregate.Aggregate{{Op: aggregate.PercentileCont, E: newExpression(0), Args: []*plan.Expr{newFloat64Expression(0.5)}}}),
+		newTestCase(mheap.New(gm), []bool{false, false, false}, []types.Type{
+			{Oid: types.T_varchar},
+			{Oid: types.T_int64},
+			{Oid: types.T_int64},
+		}, []*plan.Expr{newExpression(2)}, []aggregate.Aggregate{{Op: aggregate.GroupConcat, Dist: true, E: newExpression(0),
+			Args: []*plan.Expr{newStringExpression(";"), newInt64Expression(1024), newInt64Expression(1), newExpression(1)}}}),
 	}
 }
 
@@ -177,6 +191,39 @@ func newExpression(pos int32) *plan.Expr {
 	}
 }
 
+func newFloat64Expression(v float64) *plan.Expr {
+	return &plan.Expr{
+		Typ: &plan.Type{Id: int32(types.T_float64)},
+		Expr: &plan.Expr_C{
+			C: &plan.Const{
+				Value: &plan.Const_Dval{Dval: v},
+			},
+		},
+	}
+}
+
+func newInt64Expression(v int64) *plan.Expr {
+	return &plan.Expr{
+		Typ: &plan.Type{Id: int32(types.T_int64)},
+		Expr: &plan.Expr_C{
+			C: &plan.Const{
+				Value: &plan.Const_Ival{Ival: v},
+			},
+		},
+	}
+}
+
+func newStringExpression(v string) *plan.Expr {
+	return &plan.Expr{
+		Typ: &plan.Type{Id: int32(types.T_varchar)},
+		Expr: &plan.Expr_C{
+			C: &plan.Const{
+				Value: &plan.Const_Sval{Sval: v},
+			},
+		},
+	}
+}
+
 // create a new block based on the type information, flgs[i] == ture: has null
 func newBatch(t *testing.T, flgs []bool, ts []types.Type, proc *process.Process, rows int64) *batch.Batch {
 	return testutil.NewBatch(ts, false, int(rows), proc.Mp)
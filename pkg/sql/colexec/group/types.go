@@ -42,8 +42,12 @@ type container struct {
 	strHashMap *hashmap.StrHashMap
 
 	aggVecs   []evalVector
+	argVecs   [][]evalVector // extra arguments of multi-argument aggregations
 	groupVecs []evalVector
 
+	// aggInputs[i] is the list of input vectors of the i-th aggregation
+	aggInputs [][]*vector.Vector
+
 	vecs []*vector.Vector
 
 	bat *batch.Batch
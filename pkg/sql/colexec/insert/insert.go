@@ -80,7 +80,7 @@ func Call(_ int, proc *process.Process, arg any) (bool, error) {
 					bat.Vecs[i].Typ.Oid = types.T(n.TargetColDefs[i].Typ.GetId())
 				}
 				switch bat.Vecs[i].Typ.Oid {
-				case types.T_char, types.T_varchar, types.T_blob, types.T_json:
+				case types.T_char, types.T_varchar, types.T_blob, types.T_json, types.T_binary, types.T_varbinary:
 					bat.Vecs[i].Col = &types.Bytes{
 						Data:    nil,
 						Offsets: make([]uint32, len(bat.Zs)),
@@ -98,6 +98,9 @@ func Call(_ int, proc *process.Process, arg any) (bool, error) {
 	if err := colexec.UpdateInsertBatch(n.Engine, n.DB, ctx, proc, n.TargetColDefs, bat, n.TableID); err != nil {
 		return false, err
 	}
+	if err := colexec.FillGeneratedColumns(proc, n.TargetColDefs, bat); err != nil {
+		return false, err
+	}
 	err := n.TargetTable.Write(ctx, bat)
 	n.Affected += uint64(len(bat.Zs))
 	return false, err
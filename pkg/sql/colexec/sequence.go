@@ -0,0 +1,516 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/txn/client"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// SEQUENCE_TABLE is the hidden table of a database keeping its sequences, it
+// is created by the first CREATE SEQUENCE of the database.
+var SEQUENCE_TABLE = "%!%mo_sequences"
+var SEQUENCE_TABLE_COLNAME []string = []string{"name", "start", "increment", "minvalue", "maxvalue", "cache", "cycle", "last_value", "is_called"}
+
+// Sequence is a row of the sequence table. LastValue is the last value handed
+// out to a CN, or the next value to return if IsCalled is false.
+type Sequence struct {
+	Start     int64
+	Increment int64
+	MinValue  int64
+	MaxValue  int64
+	Cache     int64
+	Cycle     bool
+	LastValue int64
+	IsCalled  bool
+}
+
+// sequenceRange is the range of values cached by this CN for a sequence, so
+// that only one of every Cache calls of nextval needs a transaction.
+type sequenceRange struct {
+	next      int64
+	increment int64
+	remaining int64
+}
+
+// sequenceEntry guards the cached range of a sequence, so reserving a new
+// range in a transaction only blocks the callers of the same sequence.
+type sequenceEntry struct {
+	sync.Mutex
+	r *sequenceRange
+}
+
+var sequenceCache = struct {
+	sync.Mutex
+	entries map[string]*sequenceEntry
+}{entries: make(map[string]*sequenceEntry)}
+
+// NewSequence returns the sequence defined by the options of CREATE SEQUENCE.
+func NewSequence(opts tree.SequenceOptions) (*Sequence, error) {
+	seq := &Sequence{Increment: 1, Cache: 1}
+	if err := seq.alter(opts, true); err != nil {
+		return nil, err
+	}
+	return seq, nil
+}
+
+// alter applies the options to the sequence, the omitted bounds and start
+// value of a new sequence default to those of PostgreSQL.
+func (seq *Sequence) alter(opts tree.SequenceOptions, create bool) error {
+	var minSet, noMin, maxSet, noMax, startSet bool
+	var restart *tree.SequenceOption
+	for _, opt := range opts {
+		switch opt.Type {
+		case tree.SequenceIncrement:
+			if opt.Value == 0 {
+				return errors.New(errno.InvalidObjectDefinition, "INCREMENT must not be zero")
+			}
+			seq.Increment = opt.Value
+		case tree.SequenceMinValue:
+			seq.MinValue, minSet, noMin = opt.Value, true, false
+		case tree.SequenceNoMinValue:
+			minSet, noMin = false, true
+		case tree.SequenceMaxValue:
+			seq.MaxValue, maxSet, noMax = opt.Value, true, false
+		case tree.SequenceNoMaxValue:
+			maxSet, noMax = false, true
+		case tree.SequenceStart:
+			seq.Start, startSet = opt.Value, true
+		case tree.SequenceCache:
+			if opt.Value < 1 {
+				return errors.New(errno.InvalidObjectDefinition, fmt.Sprintf("CACHE (%d) must be greater than zero", opt.Value))
+			}
+			seq.Cache = opt.Value
+		case tree.SequenceCycle:
+			seq.Cycle = true
+		case tree.SequenceNoCycle:
+			seq.Cycle = false
+		case tree.SequenceRestart:
+			restart = opt
+		}
+	}
+	if noMin || (create && !minSet) {
+		seq.MinValue = 1
+		if seq.Increment < 0 {
+			seq.MinValue = math.MinInt64
+		}
+	}
+	if noMax || (create && !maxSet) {
+		seq.MaxValue = math.MaxInt64
+		if seq.Increment < 0 {
+			seq.MaxValue = -1
+		}
+	}
+	if create && !startSet {
+		seq.Start = seq.MinValue
+		if seq.Increment < 0 {
+			seq.Start = seq.MaxValue
+		}
+	}
+	if seq.MinValue >= seq.MaxValue {
+		return errors.New(errno.InvalidObjectDefinition, fmt.Sprintf("MINVALUE (%d) must be less than MAXVALUE (%d)", seq.MinValue, seq.MaxValue))
+	}
+	if seq.Start < seq.MinValue || seq.Start > seq.MaxValue {
+		return errors.New(errno.InvalidObjectDefinition, fmt.Sprintf("START value (%d) must be between MINVALUE (%d) and MAXVALUE (%d)", seq.Start, seq.MinValue, seq.MaxValue))
+	}
+	if create {
+		seq.LastValue, seq.IsCalled = seq.Start, false
+	}
+	if restart != nil {
+		seq.LastValue, seq.IsCalled = seq.Start, false
+		if restart.HasValue {
+			seq.LastValue = restart.Value
+		}
+		if seq.LastValue < seq.MinValue || seq.LastValue > seq.MaxValue {
+			return errors.New(errno.InvalidObjectDefinition, fmt.Sprintf("RESTART value (%d) must be between MINVALUE (%d) and MAXVALUE (%d)", seq.LastValue, seq.MinValue, seq.MaxValue))
+		}
+	}
+	return nil
+}
+
+// reserve hands out the next Cache values of the sequence. The reserved
+// values never wrap around, so fewer values are reserved near the bound of a
+// cycled sequence.
+func (seq *Sequence) reserve(name string) (*sequenceRange, error) {
+	first := seq.LastValue
+	if seq.IsCalled {
+		var ok bool
+		if first, ok = seq.nextOf(seq.LastValue); !ok {
+			bound := "maximum"
+			if seq.Increment < 0 {
+				bound = "minimum"
+			}
+			return nil, errors.New(errno.ObjectNotInPrerequisiteState, fmt.Sprintf("nextval: reached %s value of sequence '%s'", bound, name))
+		}
+	}
+	if first < seq.MinValue || first > seq.MaxValue {
+		return nil, errors.New(errno.ObjectNotInPrerequisiteState, fmt.Sprintf("nextval: value %d of sequence '%s' is out of range", first, name))
+	}
+	// the number of values from first to the bound
+	var avail uint64
+	if seq.Increment > 0 {
+		avail = (uint64(seq.MaxValue)-uint64(first))/uint64(seq.Increment) + 1
+	} else {
+		avail = (uint64(first)-uint64(seq.MinValue))/(-uint64(seq.Increment)) + 1
+	}
+	count := uint64(seq.Cache)
+	if avail < count {
+		count = avail
+	}
+	seq.LastValue = first + int64(count-1)*seq.Increment
+	seq.IsCalled = true
+	return &sequenceRange{next: first, increment: seq.Increment, remaining: int64(count)}, nil
+}
+
+// nextOf returns the value following v, and false if there is none because
+// the sequence doesn't cycle.
+func (seq *Sequence) nextOf(v int64) (int64, bool) {
+	if seq.Increment > 0 {
+		if v <= seq.MaxValue && uint64(seq.MaxValue)-uint64(v) >= uint64(seq.Increment) {
+			return v + seq.Increment, true
+		}
+		return seq.MinValue, seq.Cycle
+	}
+	if v >= seq.MinValue && uint64(v)-uint64(seq.MinValue) >= -uint64(seq.Increment) {
+		return v + seq.Increment, true
+	}
+	return seq.MaxValue, seq.Cycle
+}
+
+// CreateSequence saves a new sequence in the sequence table of the database.
+func CreateSequence(e engine.Engine, ctx context.Context, proc *process.Process, dbName, name string, opts tree.SequenceOptions, ifNotExists bool) error {
+	seq, err := NewSequence(opts)
+	if err != nil {
+		return err
+	}
+	rel, err := getSequenceRelation(e, ctx, proc.TxnOperator, dbName, true)
+	if err != nil {
+		return err
+	}
+	_, found, err := getSequence(ctx, proc, rel, name)
+	if err != nil {
+		return err
+	}
+	if found {
+		if ifNotExists {
+			return nil
+		}
+		return errors.New(errno.DuplicateObject, fmt.Sprintf("sequence '%s' already exists", name))
+	}
+	invalidateSequenceRange(ctx, dbName, name)
+	return writeSequence(ctx, rel, name, seq, false)
+}
+
+// AlterSequence changes the options of the sequence, a RESTART makes the next
+// call of nextval return the restart value.
+func AlterSequence(e engine.Engine, ctx context.Context, proc *process.Process, dbName, name string, opts tree.SequenceOptions, ifExists bool) error {
+	rel, err := getSequenceRelation(e, ctx, proc.TxnOperator, dbName, false)
+	if err != nil {
+		return err
+	}
+	var seq *Sequence
+	found := false
+	if rel != nil {
+		if seq, found, err = getSequence(ctx, proc, rel, name); err != nil {
+			return err
+		}
+	}
+	if !found {
+		if ifExists {
+			return nil
+		}
+		return errors.New(errno.UndefinedObject, fmt.Sprintf("sequence '%s' doesn't exist", name))
+	}
+	if err = seq.alter(opts, false); err != nil {
+		return err
+	}
+	invalidateSequenceRange(ctx, dbName, name)
+	return writeSequence(ctx, rel, name, seq, true)
+}
+
+// DropSequence removes the sequence from the sequence table of the database.
+func DropSequence(e engine.Engine, ctx context.Context, proc *process.Process, dbName, name string, ifExists bool) error {
+	rel, err := getSequenceRelation(e, ctx, proc.TxnOperator, dbName, false)
+	if err != nil {
+		return err
+	}
+	found := false
+	if rel != nil {
+		if _, found, err = getSequence(ctx, proc, rel, name); err != nil {
+			return err
+		}
+	}
+	if !found {
+		if ifExists {
+			return nil
+		}
+		return errors.New(errno.UndefinedObject, fmt.Sprintf("sequence '%s' doesn't exist", name))
+	}
+	invalidateSequenceRange(ctx, dbName, name)
+	return rel.Delete(ctx, makeSequenceBatch(name, &Sequence{}).GetVector(0), SEQUENCE_TABLE_COLNAME[0])
+}
+
+// NextSequenceValue returns the next value of the sequence. Values are taken
+// from the range cached by this CN, and a new range is reserved in a
+// transaction of its own once the range is used up, so the reserved values
+// are never given back even if the calling transaction rolls back. Other CNs
+// keep using their cached ranges after the sequence is altered.
+func NextSequenceValue(e engine.Engine, txnClient client.TxnClient, ctx context.Context, proc *process.Process, dbName, name string) (int64, error) {
+	ent := getSequenceEntry(sequenceKey(ctx, dbName, name))
+	ent.Lock()
+	defer ent.Unlock()
+	r := ent.r
+	if r == nil || r.remaining == 0 {
+		err := runSequenceTxn(e, txnClient, ctx, func(txnOp client.TxnOperator) error {
+			rel, err := getSequenceRelation(e, ctx, txnOp, dbName, false)
+			if err != nil {
+				return err
+			}
+			var seq *Sequence
+			found := false
+			if rel != nil {
+				if seq, found, err = getSequence(ctx, proc, rel, name); err != nil {
+					return err
+				}
+			}
+			if !found {
+				return errors.New(errno.UndefinedObject, fmt.Sprintf("sequence '%s' doesn't exist", name))
+			}
+			if r, err = seq.reserve(name); err != nil {
+				return err
+			}
+			return writeSequence(ctx, rel, name, seq, true)
+		})
+		if err != nil {
+			return 0, err
+		}
+		ent.r = r
+	}
+	v := r.next
+	if r.remaining--; r.remaining > 0 {
+		r.next += r.increment
+	}
+	return v, nil
+}
+
+// SetSequenceValue sets the last value of the sequence, the next call of
+// nextval returns value if isCalled is false, or the value after it.
+func SetSequenceValue(e engine.Engine, txnClient client.TxnClient, ctx context.Context, proc *process.Process, dbName, name string, value int64, isCalled bool) error {
+	ent := getSequenceEntry(sequenceKey(ctx, dbName, name))
+	ent.Lock()
+	defer ent.Unlock()
+	return runSequenceTxn(e, txnClient, ctx, func(txnOp client.TxnOperator) error {
+		rel, err := getSequenceRelation(e, ctx, txnOp, dbName, false)
+		if err != nil {
+			return err
+		}
+		var seq *Sequence
+		found := false
+		if rel != nil {
+			if seq, found, err = getSequence(ctx, proc, rel, name); err != nil {
+				return err
+			}
+		}
+		if !found {
+			return errors.New(errno.UndefinedObject, fmt.Sprintf("sequence '%s' doesn't exist", name))
+		}
+		if value < seq.MinValue || value > seq.MaxValue {
+			return errors.New(errno.ObjectNotInPrerequisiteState, fmt.Sprintf("setval: value %d is out of bounds for sequence '%s' (%d..%d)", value, name, seq.MinValue, seq.MaxValue))
+		}
+		seq.LastValue, seq.IsCalled = value, isCalled
+		ent.r = nil
+		return writeSequence(ctx, rel, name, seq, true)
+	})
+}
+
+// runSequenceTxn runs fn in a transaction of its own, which is committed
+// before the values reserved by fn are handed out. A range reserved in the
+// transaction of the caller could be rolled back, or read by another session
+// before it commits, and then be reserved again.
+func runSequenceTxn(e engine.Engine, txnClient client.TxnClient, ctx context.Context, fn func(client.TxnOperator) error) error {
+	txnOp, err := txnClient.New()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, e.Hints().CommitOrRollbackTimeout)
+	defer cancel()
+	if err = fn(txnOp); err != nil {
+		if err2 := txnOp.Rollback(ctx); err2 != nil {
+			return err2
+		}
+		return err
+	}
+	return txnOp.Commit(ctx)
+}
+
+func sequenceKey(ctx context.Context, dbName, name string) string {
+	tenantID, _ := ctx.Value(moengine.TenantIDKey{}).(uint32)
+	return fmt.Sprintf("%d.%s.%s", tenantID, dbName, name)
+}
+
+func getSequenceEntry(key string) *sequenceEntry {
+	sequenceCache.Lock()
+	defer sequenceCache.Unlock()
+	ent, ok := sequenceCache.entries[key]
+	if !ok {
+		ent = &sequenceEntry{}
+		sequenceCache.entries[key] = ent
+	}
+	return ent
+}
+
+func invalidateSequenceRange(ctx context.Context, dbName, name string) {
+	key := sequenceKey(ctx, dbName, name)
+	sequenceCache.Lock()
+	ent, ok := sequenceCache.entries[key]
+	delete(sequenceCache.entries, key)
+	sequenceCache.Unlock()
+	if ok {
+		ent.Lock()
+		ent.r = nil
+		ent.Unlock()
+	}
+}
+
+// getSequenceRelation returns the sequence table of the database, or nil if
+// it doesn't exist and create is false.
+func getSequenceRelation(e engine.Engine, ctx context.Context, txnOp client.TxnOperator, dbName string, create bool) (engine.Relation, error) {
+	db, err := e.Database(ctx, dbName, txnOp)
+	if err != nil {
+		return nil, errors.New(errno.InvalidSchemaName, fmt.Sprintf("database '%s' doesn't exist", dbName))
+	}
+	rel, err := db.Relation(ctx, SEQUENCE_TABLE)
+	if err == nil {
+		return rel, nil
+	}
+	if !create {
+		return nil, nil
+	}
+	if err = db.Create(ctx, SEQUENCE_TABLE, getSequenceTableDef()); err != nil {
+		return nil, err
+	}
+	return db.Relation(ctx, SEQUENCE_TABLE)
+}
+
+func getSequence(ctx context.Context, proc *process.Process, rel engine.Relation, name string) (*Sequence, bool, error) {
+	rds, err := rel.NewReader(ctx, 1, nil, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	for {
+		bat, err := rds[0].Read(SEQUENCE_TABLE_COLNAME, nil, proc.Mp)
+		if err != nil || bat == nil {
+			return nil, false, err
+		}
+		names := bat.Vecs[0].Col.(*types.Bytes)
+		for row := 0; row < vector.Length(bat.Vecs[0]); row++ {
+			if string(names.Get(int64(row))) != name {
+				continue
+			}
+			vs := make([]int64, len(SEQUENCE_TABLE_COLNAME)-1)
+			for i := range vs {
+				vs[i] = bat.Vecs[i+1].Col.([]int64)[row]
+			}
+			return &Sequence{
+				Start:     vs[0],
+				Increment: vs[1],
+				MinValue:  vs[2],
+				MaxValue:  vs[3],
+				Cache:     vs[4],
+				Cycle:     vs[5] != 0,
+				LastValue: vs[6],
+				IsCalled:  vs[7] != 0,
+			}, true, nil
+		}
+	}
+}
+
+func writeSequence(ctx context.Context, rel engine.Relation, name string, seq *Sequence, exists bool) error {
+	bat := makeSequenceBatch(name, seq)
+	if exists {
+		if err := rel.Delete(ctx, bat.GetVector(0), SEQUENCE_TABLE_COLNAME[0]); err != nil {
+			return err
+		}
+	}
+	return rel.Write(ctx, bat)
+}
+
+func makeSequenceBatch(name string, seq *Sequence) *batch.Batch {
+	var cycle, isCalled int64
+	if seq.Cycle {
+		cycle = 1
+	}
+	if seq.IsCalled {
+		isCalled = 1
+	}
+	bat := batch.New(true, SEQUENCE_TABLE_COLNAME)
+	bat.Vecs[0] = vector.New(types.T_varchar.ToType())
+	moengine.AppendValue(bat.Vecs[0], []byte(name))
+	for i, v := range []int64{seq.Start, seq.Increment, seq.MinValue, seq.MaxValue, seq.Cache, cycle, seq.LastValue, isCalled} {
+		bat.Vecs[i+1] = vector.New(types.T_int64.ToType())
+		moengine.AppendValue(bat.Vecs[i+1], v)
+	}
+	bat.Zs = []int64{1}
+	return bat
+}
+
+func getSequenceTableDef() []engine.TableDef {
+	/*
+		mo_sequences schema
+		| Attribute  |     Type     | Primary Key |               Note               |
+		| ---------- | ------------ | ----------- | -------------------------------- |
+		|    name    | varchar(770) |     yes     |     Name of the sequence         |
+		|   start    |    int64     |             |     start value                  |
+		| increment  |    int64     |             |     every increase step          |
+		|  minvalue  |    int64     |             |     lower bound                  |
+		|  maxvalue  |    int64     |             |     upper bound                  |
+		|   cache    |    int64     |             |  values reserved by a CN at once |
+		|   cycle    |    int64     |             |  1 if the sequence wraps around  |
+		| last_value |    int64     |             |  last value reserved by a CN     |
+		| is_called  |    int64     |             |  0 if last_value is not used yet |
+	*/
+	defs := make([]engine.TableDef, 0, len(SEQUENCE_TABLE_COLNAME)+1)
+	for i, name := range SEQUENCE_TABLE_COLNAME {
+		typ := types.T_int64.ToType()
+		if i == 0 {
+			typ = types.T_varchar.ToType()
+		}
+		defs = append(defs, &engine.AttributeDef{Attr: engine.Attribute{
+			Name:    name,
+			Alg:     0,
+			Type:    typ,
+			Default: &plan.Default{},
+			Primary: i == 0,
+		}})
+	}
+	defs = append(defs, &engine.PrimaryIndexDef{
+		Names: []string{SEQUENCE_TABLE_COLNAME[0]},
+	})
+	return defs
+}
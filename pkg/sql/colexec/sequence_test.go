@@ -0,0 +1,207 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/txn/client"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/dataio/mockio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+func seqOpt(typ tree.SequenceOptionType, v ...int64) *tree.SequenceOption {
+	if len(v) == 0 {
+		return &tree.SequenceOption{Type: typ}
+	}
+	return &tree.SequenceOption{Type: typ, Value: v[0], HasValue: true}
+}
+
+func TestNewSequence(t *testing.T) {
+	kases := []struct {
+		opts tree.SequenceOptions
+		want Sequence
+		err  bool
+	}{
+		{
+			want: Sequence{Start: 1, Increment: 1, MinValue: 1, MaxValue: math.MaxInt64, Cache: 1, LastValue: 1},
+		},
+		{
+			opts: tree.SequenceOptions{seqOpt(tree.SequenceIncrement, -2), seqOpt(tree.SequenceCache, 5), seqOpt(tree.SequenceCycle)},
+			want: Sequence{Start: -1, Increment: -2, MinValue: math.MinInt64, MaxValue: -1, Cache: 5, Cycle: true, LastValue: -1},
+		},
+		{
+			opts: tree.SequenceOptions{seqOpt(tree.SequenceMinValue, 10), seqOpt(tree.SequenceMaxValue, 20), seqOpt(tree.SequenceStart, 15)},
+			want: Sequence{Start: 15, Increment: 1, MinValue: 10, MaxValue: 20, Cache: 1, LastValue: 15},
+		},
+		{opts: tree.SequenceOptions{seqOpt(tree.SequenceIncrement, 0)}, err: true},
+		{opts: tree.SequenceOptions{seqOpt(tree.SequenceCache, 0)}, err: true},
+		{opts: tree.SequenceOptions{seqOpt(tree.SequenceMinValue, 5), seqOpt(tree.SequenceMaxValue, 5)}, err: true},
+		{opts: tree.SequenceOptions{seqOpt(tree.SequenceMaxValue, 5), seqOpt(tree.SequenceStart, 6)}, err: true},
+	}
+	for i, kase := range kases {
+		seq, err := NewSequence(kase.opts)
+		if kase.err {
+			require.Error(t, err, i)
+			continue
+		}
+		require.NoError(t, err, i)
+		require.Equal(t, kase.want, *seq, i)
+	}
+}
+
+func TestAlterSequence(t *testing.T) {
+	seq, err := NewSequence(tree.SequenceOptions{seqOpt(tree.SequenceMaxValue, 100)})
+	require.NoError(t, err)
+	seq.LastValue, seq.IsCalled = 50, true
+
+	// the last value is kept without a restart
+	require.NoError(t, seq.alter(tree.SequenceOptions{seqOpt(tree.SequenceIncrement, 5), seqOpt(tree.SequenceNoMaxValue)}, false))
+	require.Equal(t, Sequence{Start: 1, Increment: 5, MinValue: 1, MaxValue: math.MaxInt64, Cache: 1, LastValue: 50, IsCalled: true}, *seq)
+
+	require.NoError(t, seq.alter(tree.SequenceOptions{seqOpt(tree.SequenceRestart)}, false))
+	require.Equal(t, int64(1), seq.LastValue)
+	require.False(t, seq.IsCalled)
+
+	require.NoError(t, seq.alter(tree.SequenceOptions{seqOpt(tree.SequenceRestart, 30)}, false))
+	require.Equal(t, int64(30), seq.LastValue)
+
+	require.Error(t, seq.alter(tree.SequenceOptions{seqOpt(tree.SequenceRestart, 0)}, false))
+}
+
+func TestSequenceReserve(t *testing.T) {
+	seq, err := NewSequence(tree.SequenceOptions{
+		seqOpt(tree.SequenceMinValue, 1), seqOpt(tree.SequenceMaxValue, 10),
+		seqOpt(tree.SequenceIncrement, 3), seqOpt(tree.SequenceCache, 2),
+	})
+	require.NoError(t, err)
+
+	r, err := seq.reserve("s")
+	require.NoError(t, err)
+	require.Equal(t, sequenceRange{next: 1, increment: 3, remaining: 2}, *r)
+	require.Equal(t, int64(4), seq.LastValue)
+
+	// only 7 and 10 are left
+	r, err = seq.reserve("s")
+	require.NoError(t, err)
+	require.Equal(t, sequenceRange{next: 7, increment: 3, remaining: 2}, *r)
+	_, err = seq.reserve("s")
+	require.Error(t, err)
+
+	seq.Cycle = true
+	r, err = seq.reserve("s")
+	require.NoError(t, err)
+	require.Equal(t, sequenceRange{next: 1, increment: 3, remaining: 2}, *r)
+
+	// no overflow near the bound of bigint
+	seq, err = NewSequence(tree.SequenceOptions{seqOpt(tree.SequenceIncrement, math.MinInt64), seqOpt(tree.SequenceCache, 10)})
+	require.NoError(t, err)
+	r, err = seq.reserve("s")
+	require.NoError(t, err)
+	require.Equal(t, sequenceRange{next: -1, increment: math.MinInt64, remaining: 1}, *r)
+	_, err = seq.reserve("s")
+	require.Error(t, err)
+}
+
+func TestSequenceTable(t *testing.T) {
+	ctx := context.TODO()
+	mockio.ResetFS()
+	tae, err := db.Open(testutils.InitTestEnv("TestSequenceTable", t), nil)
+	require.NoError(t, err)
+	defer tae.Close()
+	e := moengine.NewEngine(tae)
+	txnClient := &countingTxnClient{TxnClient: moengine.EngineToTxnClient(e)}
+
+	proc := process.New(mheap.New(guest.New(1<<20, host.New(1<<20))))
+	txn, err := e.StartTxn(nil)
+	require.NoError(t, err)
+	proc.TxnOperator = moengine.TxnToTxnOperator(txn)
+	require.NoError(t, e.Create(ctx, "db", proc.TxnOperator))
+	opts := tree.SequenceOptions{seqOpt(tree.SequenceIncrement, 2), seqOpt(tree.SequenceCache, 3)}
+	require.NoError(t, CreateSequence(e, ctx, proc, "db", "s", opts, false))
+	require.Error(t, CreateSequence(e, ctx, proc, "db", "s", opts, false))
+	require.NoError(t, CreateSequence(e, ctx, proc, "db", "s", opts, true))
+	require.NoError(t, txn.Commit())
+
+	// the caller's txn is not used, each range of 3 values is reserved in a
+	// txn committed on its own
+	proc.TxnOperator = nil
+	for _, want := range []int64{1, 3, 5, 7, 9} {
+		v, err := NextSequenceValue(e, txnClient, ctx, proc, "db", "s")
+		require.NoError(t, err)
+		require.Equal(t, want, v)
+	}
+	require.Equal(t, 2, txnClient.commits)
+	_, err = NextSequenceValue(e, txnClient, ctx, proc, "db", "t")
+	require.Error(t, err)
+
+	require.NoError(t, SetSequenceValue(e, txnClient, ctx, proc, "db", "s", 100, false))
+	v, err := NextSequenceValue(e, txnClient, ctx, proc, "db", "s")
+	require.NoError(t, err)
+	require.Equal(t, int64(100), v)
+	require.Error(t, SetSequenceValue(e, txnClient, ctx, proc, "db", "s", 0, true))
+
+	txn, err = e.StartTxn(nil)
+	require.NoError(t, err)
+	proc.TxnOperator = moengine.TxnToTxnOperator(txn)
+	require.NoError(t, AlterSequence(e, ctx, proc, "db", "s", tree.SequenceOptions{seqOpt(tree.SequenceRestart, 50)}, false))
+	require.NoError(t, txn.Commit())
+	v, err = NextSequenceValue(e, txnClient, ctx, proc, "db", "s")
+	require.NoError(t, err)
+	require.Equal(t, int64(50), v)
+
+	txn, err = e.StartTxn(nil)
+	require.NoError(t, err)
+	proc.TxnOperator = moengine.TxnToTxnOperator(txn)
+	require.NoError(t, DropSequence(e, ctx, proc, "db", "s", false))
+	require.NoError(t, DropSequence(e, ctx, proc, "db", "s", true))
+	require.Error(t, AlterSequence(e, ctx, proc, "db", "s", opts, false))
+	require.NoError(t, txn.Commit())
+	_, err = NextSequenceValue(e, txnClient, ctx, proc, "db", "s")
+	require.Error(t, err)
+}
+
+type countingTxnClient struct {
+	client.TxnClient
+	commits int
+}
+
+func (c *countingTxnClient) New(options ...client.TxnOption) (client.TxnOperator, error) {
+	op, err := c.TxnClient.New(options...)
+	if err != nil {
+		return nil, err
+	}
+	return &countingTxnOperator{TxnOperator: op, c: c}, nil
+}
+
+type countingTxnOperator struct {
+	client.TxnOperator
+	c *countingTxnClient
+}
+
+func (op *countingTxnOperator) Commit(ctx context.Context) error {
+	op.c.commits++
+	return op.TxnOperator.Commit(ctx)
+}
@@ -83,6 +83,9 @@ func Call(_ int, proc *process.Process, arg any) (bool, error) {
 			if err := colexec.UpdateInsertBatch(p.Engine, p.DB[i], ctx, proc, p.TableDefVec[i].Cols, tmpBat, p.TableID[i]); err != nil {
 				return false, err
 			}
+			if err := colexec.FillGeneratedColumns(proc, p.TableDefVec[i].Cols, tmpBat); err != nil {
+				return false, err
+			}
 			err = updateCtx.TableSource.Write(ctx, tmpBat)
 			if err != nil {
 				return false, err
@@ -116,6 +119,9 @@ func Call(_ int, proc *process.Process, arg any) (bool, error) {
 			if err := colexec.UpdateInsertBatch(p.Engine, p.DB[i], ctx, proc, p.TableDefVec[i].Cols, tmpBat, p.TableID[i]); err != nil {
 				return false, err
 			}
+			if err := colexec.FillGeneratedColumns(proc, p.TableDefVec[i].Cols, tmpBat); err != nil {
+				return false, err
+			}
 			err = updateCtx.TableSource.Write(ctx, tmpBat)
 			if err != nil {
 				tmpBat.Clean(proc.Mp)
@@ -183,11 +189,11 @@ func fillVector(v *vector.Vector, batLen int, proc *process.Process) error {
 		v.Col = make([]int64, batLen)
 	case types.T_uint8:
 		v.Col = make([]uint8, batLen)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		v.Col = make([]uint16, batLen)
 	case types.T_uint32:
 		v.Col = make([]uint32, batLen)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		v.Col = make([]uint64, batLen)
 	case types.T_float32:
 		v.Col = make([]float32, batLen)
@@ -197,13 +203,17 @@ func fillVector(v *vector.Vector, batLen int, proc *process.Process) error {
 		v.Col = make([]types.Date, batLen)
 	case types.T_datetime:
 		v.Col = make([]types.Datetime, batLen)
+	case types.T_time:
+		v.Col = make([]types.Time, batLen)
 	case types.T_timestamp:
 		v.Col = make([]types.Timestamp, batLen)
 	case types.T_decimal64:
 		v.Col = make([]types.Decimal64, batLen)
 	case types.T_decimal128:
 		v.Col = make([]types.Decimal128, batLen)
-	case types.T_char, types.T_varchar, types.T_blob, types.T_json:
+	case types.T_uuid:
+		v.Col = make([]types.Uuid, batLen)
+	case types.T_char, types.T_varchar, types.T_blob, types.T_json, types.T_binary, types.T_varbinary:
 		v.Col = &types.Bytes{}
 		tmp := make([][]byte, batLen)
 		err := v.Col.(*types.Bytes).Append(tmp)
@@ -254,7 +264,7 @@ func getIndexValue(idx int, v *vector.Vector, isNull bool) any {
 		}
 		col := v.Col.([]uint8)
 		return col[idx]
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		if isNull {
 			return uint16(0)
 		}
@@ -266,7 +276,7 @@ func getIndexValue(idx int, v *vector.Vector, isNull bool) any {
 		}
 		col := v.Col.([]uint32)
 		return col[idx]
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		if isNull {
 			return uint64(0)
 		}
@@ -296,6 +306,12 @@ func getIndexValue(idx int, v *vector.Vector, isNull bool) any {
 		}
 		col := v.Col.([]types.Datetime)
 		return col[idx]
+	case types.T_time:
+		if isNull {
+			return types.Time(0)
+		}
+		col := v.Col.([]types.Time)
+		return col[idx]
 	case types.T_timestamp:
 		if isNull {
 			return types.Timestamp(0)
@@ -314,7 +330,13 @@ func getIndexValue(idx int, v *vector.Vector, isNull bool) any {
 		}
 		col := v.Col.([]types.Decimal128)
 		return col[idx]
-	case types.T_char, types.T_varchar, types.T_blob, types.T_json:
+	case types.T_uuid:
+		if isNull {
+			return types.Uuid([16]byte{})
+		}
+		col := v.Col.([]types.Uuid)
+		return col[idx]
+	case types.T_char, types.T_varchar, types.T_blob, types.T_json, types.T_binary, types.T_varbinary:
 		if isNull {
 			return []byte{}
 		}
@@ -17,11 +17,16 @@ package compile
 import (
 	"context"
 	"errors"
+	"sync/atomic"
+	"time"
+
 	"github.com/matrixorigin/matrixone/pkg/cnservice/cnclient"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/morpc"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/pb/pipeline"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
@@ -50,6 +55,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/minus"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/offset"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/output"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/product"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/projection"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
@@ -59,56 +65,54 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
-	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
-// CnServerMessageHandler deal the client message that received at cn-server.
-// the message is always *pipeline.Message here. It's a byte array which encoded by method encodeScope.
-// write back Analysis Information and error info if error occurs to client.
-func CnServerMessageHandler(ctx context.Context, message morpc.Message, cs morpc.ClientSession) error {
-	var errCode []byte = nil
-	// decode message and run it, get final analysis information and err info.
-	analysis, err := pipelineMessageHandle(ctx, message, cs)
+const (
+	// remoteRunTimeout is the deadline of a remote run if the query has none.
+	remoteRunTimeout = time.Hour
+	// remoteCancelTimeout is the timeout to send the cancel message of a remote run.
+	remoteCancelTimeout = time.Second * 10
+)
+
+// pipelineMessageHandle decodes the pipeline of the message and runs it, the result batches are
+// written to the client. The analysis information is returned after the pipeline finished.
+func pipelineMessageHandle(ctx context.Context, h *MessageHandler, m *pipeline.Message, cs morpc.ClientSession) (anaData []byte, err error) {
+	c, err := newCompile(ctx, h, m.GetProcInfoData())
 	if err != nil {
-		errCode = []byte(err.Error())
+		return nil, err
 	}
-	return cs.Write(ctx, &pipeline.Message{Sid: pipeline.MessageEnd, Code: errCode, Analyse: analysis})
-}
+	defer c.proc.Cancel()
 
-func pipelineMessageHandle(ctx context.Context, message morpc.Message, cs morpc.ClientSession) (anaData []byte, err error) {
-	m, ok := message.(*pipeline.Message)
-	if !ok {
-		panic("unexpected message type for cn-server")
-	}
-	c := newCompile(ctx)
-	var s *Scope
-	s, err = decodeScope(m.GetData(), c.proc)
+	p := &pipeline.Pipeline{}
+	if err = p.Unmarshal(m.GetData()); err != nil {
+		return nil, err
+	}
+	query := p.GetQry().GetQuery()
+	if query == nil {
+		return nil, moerr.New(moerr.INTERNAL_ERROR, "pipeline %d has no query plan", m.GetID())
+	}
+	c.initAnalyze(query)
+	s, err := decodePipeline(p, c.proc, c.anal.Nodes())
 	if err != nil {
 		return nil, err
 	}
+	// the whole scope is run here, it is never sent to another node again.
+	s.resetNodeAddr()
 	// refactor the last operator connect to output
-	refactorScope(ctx, s, cs)
+	refactorScope(ctx, s, m.GetID(), cs)
 
-	err = s.Run(c)
-	if err != nil {
+	if err = s.ParallelRun(c); err != nil {
 		return nil, err
 	}
 	// get analyse related information
-	if query, ok := s.Plan.Plan.(*plan.Plan_Query); ok {
-		nodes := query.Query.GetNodes()
-		anas := &pipeline.AnalysisList{}
-		anas.List = make([]*plan.AnalyzeInfo, len(nodes))
-		for i := range anas.List {
-			anas.List[i] = nodes[i].AnalyzeInfo
-		}
-		anaData, err = anas.Marshal()
-		if err != nil {
-			return nil, err
-		}
-		return anaData, nil
+	c.fillAnalyzeInfo()
+	anas := &pipeline.AnalysisList{}
+	anas.List = make([]*plan.AnalyzeInfo, len(query.Nodes))
+	for i := range anas.List {
+		anas.List[i] = query.Nodes[i].AnalyzeInfo
 	}
-	return nil, nil
+	return anas.Marshal()
 }
 
 // remoteRun sends a scope to a remote node for execution, and wait to receive the back message.
@@ -116,37 +120,75 @@ func pipelineMessageHandle(ctx context.Context, message morpc.Message, cs morpc.
 // 1. ErrMessage
 // 2. End Message with the result of analysis
 // 3. Batch Message
-func (s *Scope) remoteRun(c *Compile) error {
-	// encode the scope
-	sData, errEncode := encodeScope(s)
+// Only the first n instructions are sent, the remote node appends an operator sending the
+// batches back, and they are passed to the connector arg.
+// fallback is true if remoteRun failed before any batch was received, the scope can be run at
+// local then.
+func (s *Scope) remoteRun(c *Compile, n int, arg *connector.Argument) (fallback bool, err error) {
+	rs := *s
+	rs.Instructions = s.Instructions[:n]
+	if rs.Plan == nil && c.scope != nil {
+		rs.Plan = c.scope.Plan
+	}
+	sData, errEncode := encodeScope(&rs)
+	if errEncode != nil {
+		return true, errEncode
+	}
+	pData, errEncode := encodeProcessInfo(s.Proc)
 	if errEncode != nil {
-		return errEncode
+		return true, errEncode
 	}
 
 	// send encoded message
-	message := &pipeline.Message{Data: sData}
-	r, errSend := cnclient.Client.Send(c.ctx, s.NodeInfo.Addr, message)
-	if errSend != nil {
-		return errSend
+	cli := cnclient.GetClient()
+	if cli == nil {
+		return true, moerr.New(moerr.INTERNAL_ERROR, "the cn client is closed")
+	}
+	streamSender, errStream := cli.NewStream(s.NodeInfo.Addr)
+	if errStream != nil {
+		return true, errStream
+	}
+	messagesReceive, errReceive := streamSender.Receive()
+	if errReceive != nil {
+		_ = streamSender.Close()
+		return true, errReceive
+	}
+	defer closeStream(streamSender, messagesReceive)
+
+	ctx, cancel := remoteRunContext(c.ctx)
+	defer cancel()
+	message := &pipeline.Message{Cmd: pipeline.PipelineMessage, Data: sData, ProcInfoData: pData}
+	message.SetID(streamSender.ID())
+	if errSend := streamSender.Send(ctx, message); errSend != nil {
+		return true, errSend
 	}
-	defer r.Close()
 
 	// range to receive.
-	arg := s.Instructions[len(s.Instructions)-1].Arg.(*connector.Argument)
+	received := false
 	for {
-		val, errReceive := r.Get()
-		if errReceive != nil {
-			return errReceive
+		var val morpc.Message
+		select {
+		case <-ctx.Done():
+			cancelRemoteRun(streamSender)
+			return false, ctx.Err()
+		case <-arg.Reg.Ctx.Done():
+			// no more batch is needed
+			cancelRemoteRun(streamSender)
+			return false, nil
+		case val = <-messagesReceive:
+		}
+		if val == nil {
+			// the connection is broken
+			return !received, moerr.New(moerr.INTERNAL_ERROR, "lost the connection to %s", s.NodeInfo.Addr)
 		}
 		m := val.(*pipeline.Message)
 
 		errMessage := m.GetCode()
 		if len(errMessage) > 0 {
-			return errors.New(string(errMessage))
+			return false, errors.New(string(errMessage))
 		}
 
-		sid := m.GetID()
-		if sid == pipeline.MessageEnd {
+		if m.GetCmd() == pipeline.MessageEnd {
 			// get analyse information
 			anaData := m.GetAnalyse()
 			if len(anaData) > 0 {
@@ -154,24 +196,60 @@ func (s *Scope) remoteRun(c *Compile) error {
 				ana := new(pipeline.AnalysisList)
 				err := ana.Unmarshal(anaData)
 				if err != nil {
-					return err
+					return false, err
 				}
 				mergeAnalyseInfo(c.anal, ana)
 			}
-			break
+			return false, nil
 		}
 		// decoded message
 		bat, errBatch := decodeBatch(c.proc, m)
 		if errBatch != nil {
-			return errBatch
+			cancelRemoteRun(streamSender)
+			return false, errBatch
 		}
+		received = true
 		sendToConnectOperator(arg, bat)
 	}
+}
 
-	return nil
+// remoteRunContext returns the context of a remote run, morpc requires a deadline for each message.
+func remoteRunContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, remoteRunTimeout)
 }
 
-var _ = new(Scope).remoteRun
+// cancelRemoteRun tells the remote node to stop the pipeline sent by the stream.
+func cancelRemoteRun(streamSender morpc.Stream) {
+	// the message is sent asynchronously, so the context is
+	// released only after it expires.
+	ctx, cancel := context.WithTimeout(context.Background(), remoteCancelTimeout)
+	time.AfterFunc(remoteCancelTimeout, cancel)
+	message := &pipeline.Message{Cmd: pipeline.CancelMessage}
+	message.SetID(streamSender.ID())
+	if err := streamSender.Send(ctx, message); err != nil {
+		logutil.Errorf("failed to cancel remote pipeline %d: %v", streamSender.ID(), err)
+	}
+}
+
+// closeStream closes the stream while draining the messages still in flight, since
+// both the close and the receiving of the stream block if its channel is full.
+func closeStream(streamSender morpc.Stream, messagesReceive chan morpc.Message) {
+	closed := make(chan struct{})
+	go func() {
+		_ = streamSender.Close()
+		close(closed)
+	}()
+	for {
+		select {
+		case <-closed:
+			return
+		case <-messagesReceive:
+		}
+	}
+}
 
 // encodeScope generate a pipeline.Pipeline from Scope, encode pipeline, and returns.
 func encodeScope(s *Scope) ([]byte, error) {
@@ -190,21 +268,47 @@ func decodeScope(data []byte, proc *process.Process) (*Scope, error) {
 	if err != nil {
 		return nil, err
 	}
+	return decodePipeline(p, proc, nil)
+}
+
+// decodePipeline generates a Scope from the pipeline.Pipeline.
+func decodePipeline(p *pipeline.Pipeline, proc *process.Process, analNodes []*process.AnalyzeInfo) (*Scope, error) {
 	ctx := &scopeContext{
 		parent: nil,
 		id:     p.PipelineId,
 		regs:   make(map[*process.WaitRegister]int32),
 	}
 	ctx.root = ctx
-	s, err := generateScope(proc, p, ctx, nil)
+	s, err := generateScope(proc, p, ctx, analNodes)
 	if err != nil {
 		return nil, err
 	}
 	return s, fillInstructionsForScope(s, ctx, p)
 }
 
-func refactorScope(_ context.Context, _ *Scope, _ morpc.ClientSession) {
-	// refactor the scope
+// refactorScope appends an output operator to the scope, which sends the result
+// batches back to the client by the stream of id.
+func refactorScope(ctx context.Context, s *Scope, id uint64, cs morpc.ClientSession) {
+	s.appendInstruction(vm.Instruction{
+		Op: vm.Output,
+		Arg: &output.Argument{
+			Func: func(_ any, bat *batch.Batch) error {
+				data, err := types.Encode(bat)
+				if err != nil {
+					return err
+				}
+				return cs.Write(ctx, &pipeline.Message{Sid: id, Cmd: pipeline.BatchMessage, Data: data})
+			},
+		},
+	})
+}
+
+// resetNodeAddr makes the scope and its pre-scopes run at local.
+func (s *Scope) resetNodeAddr() {
+	s.NodeInfo.Addr = ""
+	for i := range s.PreScopes {
+		s.PreScopes[i].resetNodeAddr()
+	}
 }
 
 // fillPipeline convert the scope to pipeline.Pipeline structure through 2 iterations.
@@ -405,11 +509,16 @@ func convertToPipelineInstruction(opr *vm.Instruction, ctx *scopeContext, ctxId
 		}
 	case *dispatch.Argument:
 		in.Dispatch = &pipeline.Dispatch{
-			All: t.All,
+			All:         t.All,
+			ShuffleKeys: t.ShuffleKeys,
+			Aggs:        convertToPipelineAggregates(t.Aggs),
 		}
 		in.Dispatch.Connector = make([]*pipeline.Connector, len(t.Regs))
 		for i := range t.Regs {
 			idx, ctx0 := ctx.root.findRegister(t.Regs[i])
+			if ctx0 == nil {
+				return ctxId, nil, moerr.New(moerr.INTERNAL_ERROR, "dispatch to the receiver out of the scope")
+			}
 			if ctx0.root.isRemote(ctx0, 0) && !ctx0.isDescendant(ctx) {
 				id := srv.RegistConnector(t.Regs[i])
 				if ctxId, err = ctx0.addSubPipeline(id, idx, ctxId); err != nil {
@@ -565,6 +674,9 @@ func convertToPipelineInstruction(opr *vm.Instruction, ctx *scopeContext, ctxId
 		in.OrderBy = convertToPlanOrderByList(t.Fs)
 	case *connector.Argument:
 		idx, ctx0 := ctx.root.findRegister(t.Reg)
+		if ctx0 == nil {
+			return ctxId, nil, moerr.New(moerr.INTERNAL_ERROR, "connect to the receiver out of the scope")
+		}
 		if ctx0.root.isRemote(ctx0, 0) && !ctx0.isDescendant(ctx) {
 			id := srv.RegistConnector(t.Reg)
 			if ctxId, err = ctx0.addSubPipeline(id, idx, ctxId); err != nil {
@@ -619,8 +731,10 @@ func convertToVmInstruction(opr *pipeline.Instruction, ctx *scopeContext) (vm.In
 			regs[i] = ctx.root.getRegister(cp.PipelineId, cp.ConnectorIndex)
 		}
 		v.Arg = &dispatch.Argument{
-			Regs: regs,
-			All:  t.All,
+			Regs:        regs,
+			All:         t.All,
+			ShuffleKeys: t.ShuffleKeys,
+			Aggs:        convertToAggregates(t.Aggs),
 		}
 	case vm.Group:
 		t := opr.GetAgg()
@@ -797,21 +911,53 @@ func convertToVmInstruction(opr *pipeline.Instruction, ctx *scopeContext) (vm.In
 	return v, nil
 }
 
-// newCompile init a new compile for remote run.
-func newCompile(ctx context.Context) *Compile {
-	// not implement now, fill method should send by stream
-	c := &Compile{
+// newCompile returns the Compile of a pipeline sent by another CN, its process
+// is rebuilt from the process information of the client.
+func newCompile(ctx context.Context, h *MessageHandler, procInfoData []byte) (*Compile, error) {
+	procInfo := &pipeline.ProcessInfo{}
+	if err := procInfo.Unmarshal(procInfoData); err != nil {
+		return nil, err
+	}
+	proc := process.New(mheap.New(guest.New(h.guestLimit, h.hostMmu)))
+	proc.Id = procInfo.Id
+	proc.Lim = convertToProcessLimitation(procInfo.Lim)
+	proc.UnixTime = procInfo.UnixTime
+	proc.SessionInfo = convertToProcessSessionInfo(procInfo.SessionInfo)
+	proc.FileService = h.fs
+	if len(procInfo.Snapshot) > 0 {
+		if h.txnClient == nil {
+			return nil, moerr.New(moerr.INTERNAL_ERROR, "no txn client to run the pipeline")
+		}
+		txnOperator, err := h.txnClient.NewWithSnapshot(procInfo.Snapshot)
+		if err != nil {
+			return nil, err
+		}
+		proc.TxnOperator = txnOperator
+	}
+	proc.Ctx, proc.Cancel = context.WithCancel(ctx)
+	return &Compile{
+		e:    h.e,
 		ctx:  ctx,
-		proc: process.New(mheap.New(guest.New(1<<30, host.New(1<<20)))),
-	}
-	//c.fill = func(a any, b *batch.Batch) error {
-	//	stream, err := CNClient.NewStream("target")
-	//	if err != nil {
-	//		return err
-	//	}
-	//	stream.Send()
-	//}
-	return c
+		proc: proc,
+	}, nil
+}
+
+// encodeProcessInfo encodes the information to rebuild the process at the remote node.
+func encodeProcessInfo(proc *process.Process) ([]byte, error) {
+	procInfo := &pipeline.ProcessInfo{
+		Id:          proc.Id,
+		Lim:         convertToPipelineLimitation(proc.Lim),
+		UnixTime:    proc.UnixTime,
+		SessionInfo: convertToPipelineSessionInfo(proc.SessionInfo),
+	}
+	if proc.TxnOperator != nil {
+		snapshot, err := proc.TxnOperator.Snapshot()
+		if err != nil {
+			return nil, err
+		}
+		procInfo.Snapshot = snapshot
+	}
+	return procInfo.Marshal()
 }
 
 func mergeAnalyseInfo(target *anaylze, ana *pipeline.AnalysisList) {
@@ -821,12 +967,15 @@ func mergeAnalyseInfo(target *anaylze, ana *pipeline.AnalysisList) {
 	}
 	for i := range target.analInfos {
 		n := source[i]
-		target.analInfos[i].OutputSize += n.OutputSize
-		target.analInfos[i].OutputRows += n.OutputRows
-		target.analInfos[i].InputRows += n.InputRows
-		target.analInfos[i].InputSize += n.InputSize
-		target.analInfos[i].MemorySize += n.MemorySize
-		target.analInfos[i].TimeConsumed += n.TimeConsumed
+		if n == nil {
+			continue
+		}
+		atomic.AddInt64(&target.analInfos[i].OutputSize, n.OutputSize)
+		atomic.AddInt64(&target.analInfos[i].OutputRows, n.OutputRows)
+		atomic.AddInt64(&target.analInfos[i].InputRows, n.InputRows)
+		atomic.AddInt64(&target.analInfos[i].InputSize, n.InputSize)
+		atomic.AddInt64(&target.analInfos[i].MemorySize, n.MemorySize)
+		atomic.AddInt64(&target.analInfos[i].TimeConsumed, n.TimeConsumed)
 	}
 }
 
@@ -840,6 +989,7 @@ func convertToPlanTypes(ts []types.Type) []*plan.Type {
 			Precision: t.Precision,
 			Size:      t.Size,
 			Scale:     t.Scale,
+			Collation: int32(t.Collation),
 		}
 	}
 	return result
@@ -855,6 +1005,7 @@ func convertToTypes(ts []*plan.Type) []types.Type {
 			Precision: t.Precision,
 			Size:      t.Size,
 			Scale:     t.Scale,
+			Collation: types.Collation(t.Collation),
 		}
 	}
 	return result
@@ -868,6 +1019,7 @@ func convertToPipelineAggregates(ags []aggregate.Aggregate) []*pipeline.Aggregat
 			Op:   int32(a.Op),
 			Dist: a.Dist,
 			Expr: a.E,
+			Args: a.Args,
 		}
 	}
 	return result
@@ -881,6 +1033,7 @@ func convertToAggregates(ags []*pipeline.Aggregate) []aggregate.Aggregate {
 			Op:   int(a.Op),
 			Dist: a.Dist,
 			E:    a.Expr,
+			Args: a.Args,
 		}
 	}
 	return result
@@ -941,10 +1094,86 @@ func convertToResultPos(relList, colList []int32) []colexec.ResultPos {
 	return res
 }
 
-func decodeBatch(_ *process.Process, msg *pipeline.Message) (*batch.Batch, error) {
-	bat := new(batch.Batch) // TODO: allocate the memory from process may suitable.
-	err := types.Decode(msg.GetData(), bat)
-	return bat, err
+// convert process.Limitation to *pipeline.ProcessLimitation
+func convertToPipelineLimitation(lim process.Limitation) *pipeline.ProcessLimitation {
+	return &pipeline.ProcessLimitation{
+		Size:          lim.Size,
+		BatchRows:     lim.BatchRows,
+		BatchSize:     lim.BatchSize,
+		PartitionRows: lim.PartitionRows,
+		ReaderSize:    lim.ReaderSize,
+	}
+}
+
+// convert *pipeline.ProcessLimitation to process.Limitation
+func convertToProcessLimitation(lim *pipeline.ProcessLimitation) process.Limitation {
+	return process.Limitation{
+		Size:          lim.GetSize(),
+		BatchRows:     lim.GetBatchRows(),
+		BatchSize:     lim.GetBatchSize(),
+		PartitionRows: lim.GetPartitionRows(),
+		ReaderSize:    lim.GetReaderSize(),
+	}
+}
+
+// convert process.SessionInfo to *pipeline.SessionInfo
+func convertToPipelineSessionInfo(info process.SessionInfo) *pipeline.SessionInfo {
+	result := &pipeline.SessionInfo{
+		User:         info.User,
+		Host:         info.Host,
+		Role:         info.Role,
+		ConnectionId: info.ConnectionID,
+		Database:     info.Database,
+		Version:      info.Version,
+
+		BlockEncryptionMode: info.BlockEncryptionMode,
+	}
+	if info.TimeZone != nil {
+		_, offset := time.Now().In(info.TimeZone).Zone()
+		result.TimeZone = info.TimeZone.String()
+		result.TimeZoneOffset = int32(offset)
+	}
+	return result
+}
+
+// convert *pipeline.SessionInfo to process.SessionInfo
+func convertToProcessSessionInfo(info *pipeline.SessionInfo) process.SessionInfo {
+	result := process.SessionInfo{
+		User:         info.GetUser(),
+		Host:         info.GetHost(),
+		Role:         info.GetRole(),
+		ConnectionID: info.GetConnectionId(),
+		Database:     info.GetDatabase(),
+		Version:      info.GetVersion(),
+
+		BlockEncryptionMode: info.GetBlockEncryptionMode(),
+	}
+	if name := info.GetTimeZone(); len(name) > 0 {
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			// the fixed zones set by the session, e.g. '+08:00'
+			loc = time.FixedZone(name, int(info.GetTimeZoneOffset()))
+		}
+		result.TimeZone = loc
+	}
+	return result
+}
+
+func decodeBatch(proc *process.Process, msg *pipeline.Message) (*batch.Batch, error) {
+	bat := new(batch.Batch)
+	if err := types.Decode(msg.GetData(), bat); err != nil {
+		return nil, err
+	}
+	// the decoded vectors refer to the message, copy them as the connector does.
+	for i := range bat.Vecs {
+		vec, err := vector.Dup(bat.Vecs[i], proc.Mp)
+		if err != nil {
+			bat.Clean(proc.Mp)
+			return nil, err
+		}
+		bat.Vecs[i] = vec
+	}
+	return bat, nil
 }
 
 func sendToConnectOperator(arg *connector.Argument, bat *batch.Batch) {
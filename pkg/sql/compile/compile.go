@@ -16,6 +16,7 @@ package compile
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"runtime"
 	"sync/atomic"
@@ -30,10 +31,12 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/connector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/external"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/merge"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/output"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -214,6 +217,11 @@ func (c *Compile) compileQuery(qry *plan.Query) (*Scope, error) {
 		} else if len(c.cnList) > c.info.CnNumbers {
 			c.cnList = c.cnList[:c.info.CnNumbers]
 		}
+		// the sequences of the session are not sent to the other CNs, and
+		// neither are the uncommitted writes of the txn
+		if hasSessionFunction(qry) || hasUncommittedWrites(c.proc) {
+			c.cnList = engine.Nodes{engine.Node{Mcpu: c.NumCPU()}}
+		}
 	}
 	c.initAnalyze(qry)
 	ss, err := c.compilePlanScope(qry.Nodes[qry.Steps[0]], qry.Nodes)
@@ -342,10 +350,16 @@ func (c *Compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, err
 		ds.DataSource = &Source{Bat: bat}
 		return c.compileSort(n, c.compileProjection(n, []*Scope{ds})), nil
 	case plan.Node_EXTERNAL_SCAN:
-		ss := c.compileExternScan(n)
+		ss, err := c.compileExternScan(n)
+		if err != nil {
+			return nil, err
+		}
 		return c.compileSort(n, c.compileProjection(n, c.compileRestrict(n, ss))), nil
 	case plan.Node_TABLE_SCAN:
-		ss := c.compileTableScan(n)
+		ss, err := c.compileTableScan(n)
+		if err != nil {
+			return nil, err
+		}
 		return c.compileSort(n, c.compileProjection(n, c.compileRestrict(n, ss))), nil
 	case plan.Node_FILTER:
 		curr := c.anal.curr
@@ -373,7 +387,7 @@ func (c *Compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, err
 			return nil, err
 		}
 		c.anal.curr = curr
-		if len(n.GroupBy) == 0 || !c.info.WithBigMem {
+		if len(n.GroupBy) == 0 || !c.isBigInput(ns[n.Children[0]]) {
 			ss = c.compileAgg(n, ss, ns)
 		} else {
 			ss = c.compileGroup(n, ss, ns)
@@ -477,33 +491,91 @@ func (c *Compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, err
 	}
 }
 
-func (c *Compile) compileExternScan(n *plan.Node) []*Scope {
-	ds := &Scope{Magic: Normal}
-	ds.Proc = process.NewWithAnalyze(c.proc, c.ctx, 0, c.anal.Nodes())
-	bat := batch.NewWithSize(1)
-	{
-		bat.Vecs[0] = vector.NewConst(types.Type{Oid: types.T_int64}, 1)
-		bat.Vecs[0].Col = make([]int64, 1)
-		bat.InitZsOne(1)
-	}
-	ds.DataSource = &Source{Bat: bat}
-	ss := []*Scope{ds}
+// compileExternScan lists the files of the external scan and distributes them
+// among the parallel scopes, a large file is scanned by its byte ranges.
+func (c *Compile) compileExternScan(n *plan.Node) ([]*Scope, error) {
+	param := &tree.ExternParam{}
+	if err := json.Unmarshal([]byte(n.TableDef.Createsql), param); err != nil {
+		return nil, err
+	}
+	param.FileService = c.proc.FileService
+	fileList, fileSize, err := external.ReadDir(param)
+	if err != nil {
+		return nil, err
+	}
+	if len(fileList) == 0 {
+		return nil, fmt.Errorf("no such file '%s'", param.Filepath)
+	}
+	parallel := 1
+	if c.info.Typ != plan2.ExecTypeTP {
+		parallel = c.NumCPU()
+	}
+	fileLists, fileSizes, fileRanges := external.SplitFiles(param, fileList, fileSize, parallel)
+
+	ss := make([]*Scope, len(fileLists))
 	for i := range ss {
+		ss[i] = &Scope{Magic: Normal}
+		ss[i].Proc = process.NewWithAnalyze(c.proc, c.ctx, 0, c.anal.Nodes())
+		bat := batch.NewWithSize(1)
+		{
+			bat.Vecs[0] = vector.NewConst(types.Type{Oid: types.T_int64}, 1)
+			bat.Vecs[0].Col = make([]int64, 1)
+			bat.InitZsOne(1)
+		}
+		ss[i].DataSource = &Source{Bat: bat}
 		ss[i].appendInstruction(vm.Instruction{
 			Op:  vm.External,
 			Idx: c.anal.curr,
-			Arg: constructExternal(n, c.ctx),
+			Arg: constructExternal(n, c.ctx, fileLists[i], fileSizes[i], fileRanges[i]),
 		})
 	}
-	return ss
+	return ss, nil
 }
 
-func (c *Compile) compileTableScan(n *plan.Node) []*Scope {
-	ss := make([]*Scope, 0, len(c.cnList))
-	for i := range c.cnList {
-		ss = append(ss, c.compileTableScanWithNode(n, c.cnList[i]))
+func (c *Compile) compileTableScan(n *plan.Node) ([]*Scope, error) {
+	nodes, err := c.generateNodes(n)
+	if err != nil {
+		return nil, err
 	}
-	return ss
+	ss := make([]*Scope, 0, len(nodes))
+	for i := range nodes {
+		ss = append(ss, c.compileTableScanWithNode(n, nodes[i]))
+	}
+	return ss, nil
+}
+
+// generateNodes splits the ranges of the table among the nodes, so that each
+// node reads its own part of the table. The nodes without range are skipped.
+func (c *Compile) generateNodes(n *plan.Node) (engine.Nodes, error) {
+	if len(c.cnList) == 1 {
+		return c.cnList, nil
+	}
+	db, err := c.e.Database(c.ctx, n.ObjRef.SchemaName, c.proc.TxnOperator)
+	if err != nil {
+		return nil, err
+	}
+	rel, err := db.Relation(c.ctx, n.TableDef.Name)
+	if err != nil {
+		return nil, err
+	}
+	ranges, err := rel.Ranges(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(ranges) == 0 {
+		return c.cnList[:1], nil
+	}
+	nodes := make(engine.Nodes, 0, len(c.cnList))
+	for i, node := range c.cnList {
+		node.Data = nil
+		for j := i; j < len(ranges); j += len(c.cnList) {
+			node.Data = append(node.Data, ranges[j])
+		}
+		if len(node.Data) > 0 {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes, nil
 }
 
 func (c *Compile) compileTableScanWithNode(n *plan.Node, node engine.Node) *Scope {
@@ -615,8 +687,15 @@ func (c *Compile) compileUnionAll(n *plan.Node, ss []*Scope, children []*Scope)
 }
 
 func (c *Compile) compileJoin(n, right *plan.Node, ss []*Scope, children []*Scope, joinTyp plan.Node_JoinFlag) []*Scope {
-	rs := c.newJoinScopeList(ss, children)
+	var rs []*Scope
+
 	isEq := isEquiJoin(n.OnList)
+	if _, conds := extraJoinConditions(n.OnList); isEq && len(conds) > 0 &&
+		c.isBigInput(right) && isShuffleJoin(joinTyp) {
+		rs = c.newShuffleJoinScopeList(ss, children, constructJoinConditions(conds))
+	} else {
+		rs = c.newJoinScopeList(ss, children)
+	}
 	typs := make([]types.Type, len(right.ProjectList))
 	for i, expr := range right.ProjectList {
 		typs[i] = dupType(expr.Typ)
@@ -818,25 +897,36 @@ func (c *Compile) compileAgg(n *plan.Node, ss []*Scope, ns []*plan.Node) []*Scop
 	return []*Scope{rs}
 }
 
+// compileGroup aggregates in two phases: each scope of ss aggregates its own
+// rows, then the partial results are shuffled by the group keys to rs, which
+// merge the groups shuffled to them.
 func (c *Compile) compileGroup(n *plan.Node, ss []*Scope, ns []*plan.Node) []*Scope {
 	rs := c.newScopeList(validScopeCount(ss))
 	j := 0
 	for i := range ss {
 		if !ss[i].IsEnd {
+			arg := constructGroup(n, ns[n.Children[0]], 0, 0, false)
+			ss[i].appendInstruction(vm.Instruction{
+				Op:  vm.Group,
+				Idx: c.anal.curr,
+				Arg: arg,
+			})
 			ss[i].appendInstruction(vm.Instruction{
 				Op:  vm.Dispatch,
-				Arg: constructDispatch(true, extraRegisters(rs, j)),
+				Arg: constructGroupShuffleDispatch(n.GroupBy, arg.Aggs, extraRegisters(rs, j)),
 			})
 			j++
 			ss[i].IsEnd = true
 		}
 	}
+	// the groups of each scope are disjoint with the others, so the results
+	// only need to be merged
 	for i := range rs {
-		rs[i].Instructions = append(rs[i].Instructions, vm.Instruction{
-			Op:  vm.Group,
+		rs[i].Instructions[0] = vm.Instruction{
+			Op:  vm.MergeGroup,
 			Idx: c.anal.curr,
-			Arg: constructGroup(n, ns[n.Children[0]], i, len(rs), true),
-		})
+			Arg: constructMergeGroup(n, true),
+		}
 	}
 	return []*Scope{c.newMergeScope(append(rs, ss...))}
 }
@@ -950,6 +1040,52 @@ func (c *Compile) newJoinScopeList(ss []*Scope, children []*Scope) []*Scope {
 	return rs
 }
 
+// newShuffleJoinScopeList returns the scopes joining the partitions of both
+// sides, the rows of ss and children are shuffled to the scopes by the hash
+// of the join keys, so that the rows with the same keys meet in the same scope
+// and neither side is broadcast.
+func (c *Compile) newShuffleJoinScopeList(ss []*Scope, children []*Scope, conds [][]*plan.Expr) []*Scope {
+	left := c.newScopeList(validScopeCount(ss))
+	right := c.newScopeList(validScopeCount(children))
+	rs := make([]*Scope, len(left))
+	for i := range rs {
+		rs[i] = new(Scope)
+		rs[i].Magic = Remote
+		rs[i].IsJoin = true
+		rs[i].PreScopes = []*Scope{left[i], right[i]}
+		rs[i].Proc = process.NewWithAnalyze(c.proc, c.ctx, 2, c.anal.Nodes())
+		left[i].appendInstruction(vm.Instruction{
+			Op: vm.Connector,
+			Arg: &connector.Argument{
+				Reg: rs[i].Proc.Reg.MergeReceivers[0],
+			},
+		})
+		right[i].appendInstruction(vm.Instruction{
+			Op: vm.Connector,
+			Arg: &connector.Argument{
+				Reg: rs[i].Proc.Reg.MergeReceivers[1],
+			},
+		})
+	}
+	shuffle := func(ss []*Scope, rs []*Scope, keys []*plan.Expr) {
+		j := 0
+		for i := range ss {
+			if !ss[i].IsEnd {
+				ss[i].appendInstruction(vm.Instruction{
+					Op:  vm.Dispatch,
+					Arg: constructShuffleDispatch(keys, extraRegisters(rs, j)),
+				})
+				j++
+				ss[i].IsEnd = true
+			}
+		}
+		rs[0].PreScopes = append(rs[0].PreScopes, ss...)
+	}
+	shuffle(ss, left, conds[0])
+	shuffle(children, right, conds[1])
+	return rs
+}
+
 func (c *Compile) newLeftScope(s *Scope, ss []*Scope) *Scope {
 	rs := &Scope{
 		Magic: Merge,
@@ -1022,6 +1158,23 @@ func (anal *anaylze) Nodes() []*process.AnalyzeInfo {
 	return anal.analInfos
 }
 
+// isBigInput returns true if the node outputs too many rows to be
+// broadcast or merged into a single scope.
+func (c *Compile) isBigInput(n *plan.Node) bool {
+	return c.info.WithBigMem && plan2.IsBigInput(n)
+}
+
+// isShuffleJoin returns true if the join can be done partition by partition,
+// the anti and mark joins need to know whether the whole right side has null.
+func isShuffleJoin(joinTyp plan.Node_JoinFlag) bool {
+	switch joinTyp {
+	case plan.Node_INNER, plan.Node_SEMI, plan.Node_LEFT, plan.Node_SINGLE:
+		return true
+	default:
+		return false
+	}
+}
+
 func validScopeCount(ss []*Scope) int {
 	var cnt int
 
@@ -1066,6 +1219,62 @@ func rewriteExprForAggNode(expr *plan.Expr, groupSize int32) {
 	}
 }
 
+// sessionFunctions are evaluated with the state of the session, so a query
+// calling them runs on the CN of the session only.
+var sessionFunctions = map[int32]struct{}{
+	function.NEXTVAL: {},
+	function.SETVAL:  {},
+	function.CURRVAL: {},
+	function.LASTVAL: {},
+}
+
+func hasSessionFunction(qry *plan.Query) bool {
+	for _, n := range qry.Nodes {
+		for _, es := range [][]*plan.Expr{n.ProjectList, n.OnList, n.FilterList, n.GroupBy, n.GroupingSet, n.AggList} {
+			for _, e := range es {
+				if isSessionFunctionExpr(e) {
+					return true
+				}
+			}
+		}
+		for _, o := range n.OrderBy {
+			if isSessionFunctionExpr(o.Expr) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hasUncommittedWrites returns true if the txn of the process has written to
+// any DN. The other CNs rebuild the txn from its snapshot, which carries none
+// of the writes, so they would read the data without them.
+func hasUncommittedWrites(proc *process.Process) bool {
+	return proc.TxnOperator != nil && len(proc.TxnOperator.Txn().DNShards) > 0
+}
+
+func isSessionFunctionExpr(expr *plan.Expr) bool {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_F:
+		fid, _ := function.DecodeOverloadID(e.F.Func.GetObj())
+		if _, ok := sessionFunctions[fid]; ok {
+			return true
+		}
+		for i := range e.F.Args {
+			if isSessionFunctionExpr(e.F.Args[i]) {
+				return true
+			}
+		}
+	case *plan.Expr_List:
+		for i := range e.List.List {
+			if isSessionFunctionExpr(e.List.List[i]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func joinType(n *plan.Node, ns []*plan.Node) (bool, plan.Node_JoinFlag) {
 	switch n.JoinType {
 	case plan.Node_INNER:
@@ -1094,5 +1303,6 @@ func dupType(typ *plan.Type) types.Type {
 		Width:     typ.Width,
 		Scale:     typ.Scale,
 		Precision: typ.Precision,
+		Collation: types.Collation(typ.Collation),
 	}
 }
@@ -19,10 +19,17 @@ import (
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/dispatch"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/group"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergegroup"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -50,6 +57,10 @@ func init() {
 		newTestCase("select * from R limit 1", new(testing.T)),
 		newTestCase("select * from R limit 2, 1", new(testing.T)),
 		newTestCase("select count(*) from R", new(testing.T)),
+		newTestCase("select median(price), mode(uid), var_samp(price), stddev_samp(price) from R", new(testing.T)),
+		newTestCase("select uid, corr(uid, price), covar_pop(uid, price), covar_samp(uid, price) from R group by uid", new(testing.T)),
+		newTestCase("select percentile_cont(0.25) within group (order by price), percentile_disc(0.5) within group (order by price desc) from R", new(testing.T)),
+		newTestCase("select uid, group_concat(distinct price order by price desc separator ';'), array_agg(price order by uid) from R group by uid", new(testing.T)),
 		newTestCase("select * from R join S on R.uid = S.uid", new(testing.T)),
 		newTestCase("select * from R left join S on R.uid = S.uid", new(testing.T)),
 		newTestCase("select * from R right join S on R.uid = S.uid", new(testing.T)),
@@ -73,6 +84,117 @@ func TestCompile(t *testing.T) {
 	}
 }
 
+func TestShuffle(t *testing.T) {
+	for _, sql := range []string{
+		"select uid, count(*), sum(price) from R group by uid",
+		"select * from R join S on R.uid = S.uid",
+		"select * from R left join S on R.uid = S.uid",
+		"select * from R where uid in (select uid from S)",
+	} {
+		tc := newTestCase(sql, t)
+		rows := 0
+		c := New("test", sql, "", context.TODO(), tc.e, tc.proc, nil)
+		require.NoError(t, c.Compile(tc.pn, nil, func(_ any, bat *batch.Batch) error {
+			rows += bat.Length()
+			return nil
+		}))
+		require.False(t, hasShuffleDispatch(c.scope), sql)
+		require.NoError(t, c.Run(0), sql)
+
+		// pretend that every node outputs lots of rows
+		tc = newTestCase(sql, t)
+		for _, n := range tc.pn.GetQuery().Nodes {
+			n.Cost = &plan.Cost{Card: plan2.BigInputCardinality}
+		}
+		shuffledRows := 0
+		c = New("test", sql, "", context.TODO(), tc.e, tc.proc, nil)
+		require.NoError(t, c.Compile(tc.pn, nil, func(_ any, bat *batch.Batch) error {
+			shuffledRows += bat.Length()
+			return nil
+		}))
+		require.True(t, hasShuffleDispatch(c.scope), sql)
+		require.NoError(t, c.Run(0), sql)
+		require.NotZero(t, rows, sql)
+		require.Equal(t, rows, shuffledRows, sql)
+	}
+}
+
+func TestTwoPhaseGroup(t *testing.T) {
+	sql := "select uid, count(*), sum(price) from R group by uid"
+	run := func(big bool, check func(*Scope)) map[uint32][2]float64 {
+		tc := newTestCase(sql, t)
+		if big {
+			for _, n := range tc.pn.GetQuery().Nodes {
+				n.Cost = &plan.Cost{Card: plan2.BigInputCardinality}
+			}
+		}
+		groups := make(map[uint32][2]float64)
+		c := New("test", sql, "", context.TODO(), tc.e, tc.proc, nil)
+		require.NoError(t, c.Compile(tc.pn, nil, func(_ any, bat *batch.Batch) error {
+			if bat == nil || bat.Length() == 0 {
+				return nil
+			}
+			uids := vector.MustTCols[uint32](bat.Vecs[0])
+			cnts := vector.MustTCols[int64](bat.Vecs[1])
+			sums := vector.MustTCols[float64](bat.Vecs[2])
+			for i, uid := range uids {
+				_, ok := groups[uid]
+				require.False(t, ok, "group %d is output twice", uid)
+				groups[uid] = [2]float64{float64(cnts[i]), sums[i]}
+			}
+			return nil
+		}))
+		check(c.scope)
+		require.NoError(t, c.Run(0))
+		return groups
+	}
+
+	// the partial groups run before the shuffle, and the merge groups after it
+	var partial, merge int
+	var walk func(s *Scope)
+	walk = func(s *Scope) {
+		for i, in := range s.Instructions {
+			switch arg := in.Arg.(type) {
+			case *group.Argument:
+				require.False(t, arg.NeedEval)
+				require.Less(t, i+1, len(s.Instructions))
+				shuffle, ok := s.Instructions[i+1].Arg.(*dispatch.Argument)
+				require.True(t, ok)
+				require.Equal(t, len(arg.Aggs), len(shuffle.Aggs))
+				partial++
+			case *mergegroup.Argument:
+				if arg.NeedEval {
+					require.Equal(t, 0, i)
+					merge++
+				}
+			}
+		}
+		for _, ps := range s.PreScopes {
+			walk(ps)
+		}
+	}
+	expected := run(false, func(*Scope) {})
+	groups := run(true, walk)
+	require.NotZero(t, partial)
+	require.NotZero(t, merge)
+	require.NotEmpty(t, expected)
+	require.Equal(t, expected, groups)
+}
+
+func hasShuffleDispatch(s *Scope) bool {
+	for _, in := range s.Instructions {
+		if arg, ok := in.Arg.(*dispatch.Argument); ok && len(arg.ShuffleKeys) > 0 {
+			return true
+		}
+	}
+	for _, ps := range s.PreScopes {
+		if hasShuffleDispatch(ps) {
+			return true
+		}
+	}
+	return false
+}
+
 func newTestCase(sql string, t *testing.T) compileTestCase {
 	proc := testutil.NewProcess()
 	e := memEngine.NewTestEngine()
@@ -92,3 +214,34 @@ func newTestCase(sql string, t *testing.T) compileTestCase {
 		},
 	}
 }
+
+func TestHasSessionFunction(t *testing.T) {
+	for sql, expected := range map[string]bool{
+		"select uid from R":                                  false,
+		"select nextval('seq'), uid from R":                  true,
+		"select uid from R where uid > currval('seq')":       true,
+		"select uid from R order by uid + lastval()":         true,
+		"select uid from R where uid in (1, setval('s', 2))": true,
+	} {
+		tc := newTestCase(sql, t)
+		require.Equal(t, expected, hasSessionFunction(tc.pn.GetQuery()), sql)
+	}
+}
+
+type writtenTxnOperator struct {
+	client.TxnOperator
+	meta txn.TxnMeta
+}
+
+func (op *writtenTxnOperator) Txn() txn.TxnMeta {
+	return op.meta
+}
+
+func TestHasUncommittedWrites(t *testing.T) {
+	proc := testutil.NewProcess()
+	require.False(t, hasUncommittedWrites(proc))
+	proc.TxnOperator = &writtenTxnOperator{}
+	require.False(t, hasUncommittedWrites(proc))
+	proc.TxnOperator = &writtenTxnOperator{meta: txn.TxnMeta{DNShards: []metadata.DNShard{{}}}}
+	require.True(t, hasUncommittedWrites(proc))
+}
@@ -162,11 +162,14 @@ func planColsToExeCols(planCols []*plan.ColDef) []engine.TableDef {
 					Precision: colTyp.GetPrecision(),
 					Scale:     colTyp.GetScale(),
 					Size:      colTyp.GetSize(),
+					Collation: types.Collation(colTyp.GetCollation()),
 				},
 				Default:       planCols[i].GetDefault(),
 				Primary:       col.GetPrimary(),
 				Comment:       col.GetComment(),
 				AutoIncrement: col.GetAutoIncrement(),
+				EnumValues:    colTyp.GetEnumvalues(),
+				Generated:     col.GetGenerated(),
 			},
 		}
 	}
@@ -89,6 +89,9 @@ func (s *Scope) InsertValues(c *Compile, stmt *tree.Insert) (uint64, error) {
 	if err = colexec.UpdateInsertValueBatch(c.e, c.ctx, c.proc, p, bat); err != nil {
 		return 0, err
 	}
+	if err = colexec.FillGeneratedColumns(c.proc, p.ExplicitCols, bat); err != nil {
+		return 0, err
+	}
 	if err := relation.Write(c.ctx, bat); err != nil {
 		return 0, err
 	}
@@ -230,7 +233,7 @@ func fillBatch(bat *batch.Batch, p *plan.InsertValues, rows []tree.Exprs, proc *
 			if err := vector.Append(v, vs); err != nil {
 				return err
 			}
-		case types.T_uint16:
+		case types.T_uint16, types.T_enum:
 			vs := make([]uint16, rowCount)
 			{
 				for j, expr := range p.Columns[i].Column {
@@ -266,7 +269,7 @@ func fillBatch(bat *batch.Batch, p *plan.InsertValues, rows []tree.Exprs, proc *
 			if err := vector.Append(v, vs); err != nil {
 				return err
 			}
-		case types.T_uint64:
+		case types.T_uint64, types.T_set, types.T_bit:
 			vs := make([]uint64, rowCount)
 			{
 				for j, expr := range p.Columns[i].Column {
@@ -320,7 +323,7 @@ func fillBatch(bat *batch.Batch, p *plan.InsertValues, rows []tree.Exprs, proc *
 			if err := vector.Append(v, vs); err != nil {
 				return err
 			}
-		case types.T_char, types.T_varchar, types.T_blob:
+		case types.T_char, types.T_varchar, types.T_blob, types.T_binary, types.T_varbinary:
 			vs := make([][]byte, rowCount)
 			{
 				for j, expr := range p.Columns[i].Column {
@@ -374,6 +377,24 @@ func fillBatch(bat *batch.Batch, p *plan.InsertValues, rows []tree.Exprs, proc *
 			if err := vector.Append(v, vs); err != nil {
 				return err
 			}
+		case types.T_time:
+			vs := make([]types.Time, rowCount)
+			{
+				for j, expr := range p.Columns[i].Column {
+					vec, err := colexec.EvalExpr(tmpBat, proc, expr)
+					if err != nil {
+						return y.MakeInsertError(v.Typ.Oid, p.ExplicitCols[i], rows, i, j)
+					}
+					if nulls.Any(vec.Nsp) {
+						nulls.Add(v.Nsp, uint64(j))
+					} else {
+						vs[j] = vec.Col.([]types.Time)[0]
+					}
+				}
+			}
+			if err := vector.Append(v, vs); err != nil {
+				return err
+			}
 		case types.T_timestamp:
 			vs := make([]types.Timestamp, rowCount)
 			{
@@ -428,6 +449,24 @@ func fillBatch(bat *batch.Batch, p *plan.InsertValues, rows []tree.Exprs, proc *
 			if err := vector.Append(v, vs); err != nil {
 				return err
 			}
+		case types.T_uuid:
+			vs := make([]types.Uuid, rowCount)
+			{
+				for j, expr := range p.Columns[i].Column {
+					vec, err := colexec.EvalExpr(tmpBat, proc, expr)
+					if err != nil {
+						return y.MakeInsertError(v.Typ.Oid, p.ExplicitCols[i], rows, i, j)
+					}
+					if nulls.Any(vec.Nsp) {
+						nulls.Add(v.Nsp, uint64(j))
+					} else {
+						vs[j] = vec.Col.([]types.Uuid)[0]
+					}
+				}
+			}
+			if err := vector.Append(v, vs); err != nil {
+				return err
+			}
 		default:
 			return fmt.Errorf("data truncation: type of '%v' doesn't implement", v.Typ)
 		}
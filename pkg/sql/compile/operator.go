@@ -333,7 +333,7 @@ func constructProjection(n *plan.Node) *projection.Argument {
 	}
 }
 
-func constructExternal(n *plan.Node, ctx context.Context) *external.Argument {
+func constructExternal(n *plan.Node, ctx context.Context, fileList []string, fileSize []int64, fileRanges []external.FileRange) *external.Argument {
 	attrs := make([]string, len(n.TableDef.Cols))
 	for j, col := range n.TableDef.Cols {
 		attrs[j] = col.Name
@@ -344,7 +344,11 @@ func constructExternal(n *plan.Node, ctx context.Context) *external.Argument {
 			Cols:          n.TableDef.Cols,
 			Name2ColIndex: n.TableDef.Name2ColIndex,
 			CreateSql:     n.TableDef.Createsql,
+			Filters:       n.FilterList,
 			Ctx:           ctx,
+			FileList:      fileList,
+			FileSize:      fileSize,
+			FileRanges:    fileRanges,
 		},
 	}
 }
@@ -525,6 +529,7 @@ func constructGroup(n, cn *plan.Node, ibucket, nbucket int, needEval bool) *grou
 			}
 			aggs[i] = aggregate.Aggregate{
 				E:    f.F.Args[0],
+				Args: f.F.Args[1:],
 				Dist: distinct,
 				Op:   fun.AggregateInfo,
 			}
@@ -579,6 +584,32 @@ func constructDispatch(all bool, regs []*process.WaitRegister) *dispatch.Argumen
 	return arg
 }
 
+// constructShuffleDispatch returns a dispatch which sends the rows with the same keys to the same register.
+func constructShuffleDispatch(keys []*plan.Expr, regs []*process.WaitRegister) *dispatch.Argument {
+	arg := new(dispatch.Argument)
+	arg.ShuffleKeys = keys
+	arg.Regs = regs
+	return arg
+}
+
+// constructGroupShuffleDispatch returns a dispatch which sends the partial results of group
+// with the same group keys to the same register, the states of aggs are split along with the groups.
+func constructGroupShuffleDispatch(groupBy []*plan.Expr, aggs []aggregate.Aggregate, regs []*process.WaitRegister) *dispatch.Argument {
+	// the group keys are the leading columns of the partial results
+	keys := make([]*plan.Expr, len(groupBy))
+	for i, e := range groupBy {
+		keys[i] = &plan.Expr{
+			Typ: e.Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{ColPos: int32(i)},
+			},
+		}
+	}
+	arg := constructShuffleDispatch(keys, regs)
+	arg.Aggs = aggs
+	return arg
+}
+
 func constructMergeGroup(_ *plan.Node, needEval bool) *mergegroup.Argument {
 	return &mergegroup.Argument{
 		NeedEval: needEval,
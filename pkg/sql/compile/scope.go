@@ -15,6 +15,7 @@
 package compile
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/cnservice/cnclient"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/connector"
@@ -121,34 +122,79 @@ func (s *Scope) MergeRun(c *Compile) error {
 }
 
 // RemoteRun send the scope to a remote node (if target node is itself, it is same to function ParallelRun) and run it.
+// The scope is run at local if the remote node fails before sending back any batch.
 func (s *Scope) RemoteRun(c *Compile) error {
 	// if address itself, just run it parallel at local.
-	//	return s.ParallelRun(c)
-	/*
-		if len(s.NodeInfo.Addr) == 0 {
-			return s.ParallelRun(c)
-		}
-		err := s.remoteRun(c)
-		// tell to connect operator that it's over
-		arg := s.Instructions[len(s.Instructions)-1].Arg.(*connector.Argument)
-		sendToConnectOperator(arg, nil)
-	*/
-	/*
-		// just for test serialization codes.
-		n := len(s.Instructions) - 1
-		in := s.Instructions[n]
-		s.Instructions = s.Instructions[:n]
-		data, err := encodeScope(s)
-		if err != nil {
-			return err
+	if !s.canRemoteRun() {
+		return s.ParallelRun(c)
+	}
+	if i := s.localTailIndex(); i >= 0 {
+		return s.remoteRunWithLocalTail(c, i)
+	}
+	n := len(s.Instructions) - 1
+	arg := s.Instructions[n].Arg.(*connector.Argument)
+	fallback, err := s.remoteRun(c, n, arg)
+	if err != nil && fallback {
+		logutil.Warnf("failed to run the scope at %s, run it at local: %v", s.NodeInfo.Addr, err)
+		return s.ParallelRun(c)
+	}
+	// tell to connect operator that it's over
+	sendToConnectOperator(arg, nil)
+	return err
+}
+
+// canRemoteRun returns true if the scope has a target node and sends its
+// result to a connector, which receives the batches from the remote node.
+func (s *Scope) canRemoteRun() bool {
+	if len(s.NodeInfo.Addr) == 0 || cnclient.GetClient() == nil || len(s.Instructions) == 0 {
+		return false
+	}
+	_, ok := s.Instructions[len(s.Instructions)-1].Arg.(*connector.Argument)
+	return ok
+}
+
+// localTailIndex returns the index of the first group or hash build of the
+// scope, -1 if there is none. Their output batches carry the states of the
+// aggregations and the hash map, which can not be encoded, so they and the
+// instructions after them run at local.
+func (s *Scope) localTailIndex() int {
+	for i, in := range s.Instructions {
+		if in.Op == vm.Group || in.Op == vm.HashBuild {
+			return i
 		}
-		rs, err := decodeScope(data, s.Proc)
-		if err != nil {
-			return err
+	}
+	return -1
+}
+
+// remoteRunWithLocalTail sends the instructions before i to the remote node,
+// and runs the rest of them at local on the batches sent back.
+func (s *Scope) remoteRunWithLocalTail(c *Compile, i int) error {
+	proc := process.NewWithAnalyze(s.Proc, c.ctx, 1, c.anal.Nodes())
+	arg := &connector.Argument{Reg: proc.Reg.MergeReceivers[0]}
+	errChan := make(chan error, 1)
+	go func() {
+		fallback, err := s.remoteRun(c, i, arg)
+		if err != nil && fallback {
+			logutil.Warnf("failed to run the scope at %s, run it at local: %v", s.NodeInfo.Addr, err)
+			head := *s
+			head.Instructions = append(s.Instructions[:i:i], vm.Instruction{Op: vm.Connector, Arg: arg})
+			errChan <- head.ParallelRun(c)
+			return
 		}
-		rs.Instructions = append(rs.Instructions, in)
-	*/
-	return s.ParallelRun(c)
+		sendToConnectOperator(arg, nil)
+		errChan <- err
+	}()
+
+	tail := append([]vm.Instruction{{Op: vm.Merge, Arg: &merge.Argument{}}}, s.Instructions[i:]...)
+	_, err := pipeline.NewMerge(tail, s.Reg).MergeRun(proc)
+	if err != nil {
+		// stop the remote run waiting for the merge
+		proc.Cancel()
+	}
+	if remoteErr := <-errChan; err == nil {
+		err = remoteErr
+	}
+	return err
 }
 
 // ParallelRun try to execute the scope in parallel way.
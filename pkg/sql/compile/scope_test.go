@@ -17,15 +17,23 @@ package compile
 import (
 	"context"
 	"fmt"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/cnservice/cnclient"
+	"github.com/matrixorigin/matrixone/pkg/common/morpc"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/pb/pipeline"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
 	"github.com/stretchr/testify/require"
-	"testing"
 )
 
 func TestScopeSerialization(t *testing.T) {
@@ -93,3 +101,83 @@ func generateScopeCases(t *testing.T, testCases []string) []*Scope {
 	}
 	return result
 }
+
+func TestRemoteRun(t *testing.T) {
+	e := memEngine.NewTestEngine()
+	h := NewMessageHandler(e, nil, nil, host.New(1<<30), 1<<30)
+	addr := "unix://" + filepath.Join(t.TempDir(), "cn.sock")
+	server, err := morpc.NewRPCServer("test-cn-server", addr,
+		morpc.NewMessageCodec(func() morpc.Message { return &pipeline.Message{} }, 0))
+	require.NoError(t, err)
+	var pipelines int32
+	server.RegisterRequestHandler(func(ctx context.Context, req morpc.Message, _ uint64, cs morpc.ClientSession) error {
+		if req.(*pipeline.Message).GetCmd() == pipeline.PipelineMessage {
+			atomic.AddInt32(&pipelines, 1)
+		}
+		return h.HandleMessage(ctx, req, cs)
+	})
+	require.NoError(t, server.Start())
+	defer func() {
+		require.NoError(t, server.Close())
+	}()
+	cli, err := cnclient.NewCNClient(&cnclient.ClientConfig{})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, cli.Close())
+		require.Nil(t, cnclient.GetClient())
+	}()
+
+	testCases := []string{
+		"select * from R",
+		"select count(*) from R",
+		"select * from R where uid > 1",
+		"select * from R order by uid limit 2",
+		"select * from R limit 1",
+		"select * from R join S on R.uid = S.uid",
+	}
+	for _, sql := range testCases {
+		require.Equal(t, runWithNodeAddr(t, e, sql, ""), runWithNodeAddr(t, e, sql, addr), sql)
+	}
+	// the scan before a group or a hash build is sent too
+	for _, sql := range []string{
+		"select uid, count(*) from R group by uid",
+		"select * from R join S on R.uid = S.uid",
+	} {
+		sent := atomic.LoadInt32(&pipelines)
+		require.Equal(t, runWithNodeAddr(t, e, sql, ""), runWithNodeAddr(t, e, sql, addr), sql)
+		require.Greater(t, atomic.LoadInt32(&pipelines), sent, sql)
+	}
+	// run at local if the remote node is unavailable
+	sql := testCases[0]
+	require.Equal(t, runWithNodeAddr(t, e, sql, ""),
+		runWithNodeAddr(t, e, sql, "unix://"+filepath.Join(t.TempDir(), "none.sock")), sql)
+}
+
+// runWithNodeAddr runs the sql with the remote scopes sent to the addr, and returns the rows of the result.
+func runWithNodeAddr(t *testing.T, e engine.Engine, sql string, addr string) int {
+	proc := testutil.NewProcess()
+	opt := plan2.NewBaseOptimizer(e.(*memEngine.MemEngine))
+	stmts, err := mysql.Parse(sql)
+	require.NoError(t, err)
+	qry, err := opt.Optimize(stmts[0])
+	require.NoError(t, err)
+	rows := 0
+	c := New("test", sql, "", context.Background(), e, proc, nil)
+	err = c.Compile(&plan.Plan{Plan: &plan.Plan_Query{Query: qry}}, nil, func(_ any, bat *batch.Batch) error {
+		rows += bat.Length()
+		return nil
+	})
+	require.NoError(t, err)
+	setNodeAddr(c.scope, addr)
+	require.NoError(t, c.Run(0), sql)
+	return rows
+}
+
+func setNodeAddr(s *Scope, addr string) {
+	if s.Magic == Remote {
+		s.NodeInfo.Addr = addr
+	}
+	for i := range s.PreScopes {
+		setNodeAddr(s.PreScopes[i], addr)
+	}
+}
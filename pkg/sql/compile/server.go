@@ -16,24 +16,52 @@ package compile
 
 import (
 	"context"
+	"sync"
 
 	"github.com/matrixorigin/matrixone/pkg/common/morpc"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/pipeline"
+	"github.com/matrixorigin/matrixone/pkg/txn/client"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
 var srv *Server
 var cnAddr string
 
+// srvMu protects the creation and the references of srv
+var srvMu sync.Mutex
+
+// NewServer returns the server shared by all the CN services of the process,
+// it is created by the first call. Each call takes a reference to the server,
+// which is released by Close.
 func NewServer() *Server {
-	if srv != nil {
-		return srv
-	}
-	srv = &Server{
-		mp: make(map[uint64]*process.WaitRegister),
+	srvMu.Lock()
+	defer srvMu.Unlock()
+	if srv == nil {
+		srv = &Server{
+			mp: make(map[uint64]*process.WaitRegister),
+		}
 	}
+	srv.refs++
 	return srv
 }
 
+// Close releases a reference to the server, the connectors registered are
+// dropped once no CN service uses it.
+func (srv *Server) Close() {
+	srvMu.Lock()
+	defer srvMu.Unlock()
+	if srv.refs--; srv.refs > 0 {
+		return
+	}
+	srv.Lock()
+	srv.mp = make(map[uint64]*process.WaitRegister)
+	srv.Unlock()
+}
+
 func (srv *Server) GetConnector(id uint64) *process.WaitRegister {
 	srv.Lock()
 	defer srv.Unlock()
@@ -49,6 +77,75 @@ func (srv *Server) RegistConnector(reg *process.WaitRegister) uint64 {
 	return srv.id
 }
 
-func (srv *Server) HandleRequest(ctx context.Context, req morpc.Message, _ uint64, cs morpc.ClientSession) error {
+// NewMessageHandler returns the handler of the pipelines sent by the other CNs, the
+// pipelines read the engine with the txn rebuilt by the txn client.
+func NewMessageHandler(e engine.Engine, txnClient client.TxnClient, fs fileservice.FileService,
+	hostMmu *host.Mmu, guestLimit int64) *MessageHandler {
+	return &MessageHandler{
+		e:          e,
+		txnClient:  txnClient,
+		fs:         fs,
+		hostMmu:    hostMmu,
+		guestLimit: guestLimit,
+		running:    make(map[runningPipeline]context.CancelFunc),
+	}
+}
+
+// HandleMessage deal the client message that received at cn-server, the message is always
+// *pipeline.Message here. A pipeline message starts the pipeline in background, and the result
+// batches are streamed back to the client, followed by an end message with the analysis information
+// and the error. A cancel message stops the pipeline started by the same stream.
+func (h *MessageHandler) HandleMessage(ctx context.Context, message morpc.Message, cs morpc.ClientSession) error {
+	m, ok := message.(*pipeline.Message)
+	if !ok {
+		panic("unexpected message type for cn-server")
+	}
+	key := runningPipeline{cs: cs, id: m.GetID()}
+	switch m.GetCmd() {
+	case pipeline.PipelineMessage:
+		// the request context is canceled once the handler returns,
+		// the pipeline only keeps its deadline.
+		var runCtx context.Context
+		var cancel context.CancelFunc
+		if deadline, ok := ctx.Deadline(); ok {
+			runCtx, cancel = context.WithDeadline(context.Background(), deadline)
+		} else {
+			runCtx, cancel = context.WithCancel(context.Background())
+		}
+		h.Lock()
+		h.running[key] = cancel
+		h.Unlock()
+		go func() {
+			defer func() {
+				h.Lock()
+				delete(h.running, key)
+				h.Unlock()
+				cancel()
+			}()
+			var errCode []byte
+			analysis, err := pipelineMessageHandle(runCtx, h, m, cs)
+			if err != nil {
+				errCode = []byte(err.Error())
+			}
+			// nobody waits for the result of a canceled pipeline
+			if runCtx.Err() != nil {
+				return
+			}
+			if err = cs.Write(runCtx, &pipeline.Message{
+				Sid:     m.GetID(),
+				Cmd:     pipeline.MessageEnd,
+				Code:    errCode,
+				Analyse: analysis,
+			}); err != nil {
+				logutil.Errorf("failed to send the end of pipeline %d: %v", m.GetID(), err)
+			}
+		}()
+	case pipeline.CancelMessage:
+		h.Lock()
+		if cancel, ok := h.running[key]; ok {
+			cancel()
+		}
+		h.Unlock()
+	}
 	return nil
 }
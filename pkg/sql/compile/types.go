@@ -18,8 +18,10 @@ import (
 	"context"
 	"sync"
 
+	"github.com/matrixorigin/matrixone/pkg/common/morpc"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/pipeline"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
@@ -27,6 +29,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -123,6 +126,26 @@ type Server struct {
 	sync.Mutex
 	id uint64
 	mp map[uint64]*process.WaitRegister // k = id, v = reg
+	// refs is the number of the CN services using the server
+	refs int
+}
+
+// runningPipeline identifies a pipeline sent by the stream of a client.
+type runningPipeline struct {
+	cs morpc.ClientSession
+	id uint64
+}
+
+// MessageHandler runs the pipelines sent by the other CNs.
+type MessageHandler struct {
+	sync.Mutex
+	e          engine.Engine
+	txnClient  client.TxnClient
+	fs         fileservice.FileService
+	hostMmu    *host.Mmu
+	guestLimit int64
+	// running keeps the cancel function of the pipelines being run
+	running map[runningPipeline]context.CancelFunc
 }
 
 // Compile contains all the information needed for compilation.
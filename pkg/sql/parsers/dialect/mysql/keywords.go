@@ -29,6 +29,7 @@ func init() {
 		"analyze":                  ANALYZE,
 		"and":                      AND,
 		"any":                      ANY,
+		"array_agg":                ARRAY_AGG,
 		"as":                       AS,
 		"asc":                      ASC,
 		"ascii":                    ASCII,
@@ -163,7 +164,6 @@ func init() {
 		"fields":                   FIELDS,
 		"file":                     FILE,
 		"fixed":                    FIXED,
-		"generated":                UNUSED,
 		"geometry":                 GEOMETRY,
 		"geometrycollection":       GEOMETRYCOLLECTION,
 		"get":                      UNUSED,
@@ -180,6 +180,7 @@ func init() {
 		"ignore":                   IGNORE,
 		"in":                       IN,
 		"index":                    INDEX,
+		"incremental":              INCREMENTAL,
 		"indexes":                  INDEXES,
 		"infile":                   INFILE,
 		"inout":                    UNUSED,
@@ -232,6 +233,7 @@ func init() {
 		"local":                    LOCAL,
 		"master_bind":              UNUSED,
 		"match":                    MATCH,
+		"materialized":             MATERIALIZED,
 		"maxvalue":                 MAXVALUE,
 		"mediumblob":               MEDIUMBLOB,
 		"mediumint":                MEDIUMINT,
@@ -307,6 +309,7 @@ func init() {
 		"read_write":               UNUSED,
 		"real":                     REAL,
 		"references":               REFERENCES,
+		"refresh":                  REFRESH,
 		"regexp":                   REGEXP,
 		"release":                  RELEASE,
 		"rename":                   RENAME,
@@ -319,7 +322,19 @@ func init() {
 		"require":                  REQUIRE,
 		"resignal":                 UNUSED,
 		"restrict":                 RESTRICT,
-		"return":                   UNUSED,
+		"return":                   RETURN,
+		"returns":                  RETURNS,
+		"sequence":                 SEQUENCE,
+		"backup":                   BACKUP,
+		"increment":                INCREMENT,
+		"minvalue":                 MINVALUE,
+		"cache":                    CACHE,
+		"cycle":                    CYCLE,
+		"restart":                  RESTART,
+		"generated":                GENERATED,
+		"always":                   ALWAYS,
+		"virtual":                  VIRTUAL,
+		"stored":                   STORED,
 		"revoke":                   REVOKE,
 		"reverse":                  REVERSE,
 		"reload":                   RELOAD,
@@ -368,7 +383,6 @@ func init() {
 		"stats_auto_recalc":        STATS_AUTO_RECALC,
 		"stats_persistent":         STATS_PERSISTENT,
 		"stats_sample_pages":       STATS_SAMPLE_PAGES,
-		"stored":                   UNUSED,
 		"storage":                  STORAGE,
 		"straight_join":            STRAIGHT_JOIN,
 		"stream":                   STREAM,
@@ -407,13 +421,13 @@ func init() {
 		"utc_date":                 UTC_DATE,
 		"utc_time":                 UTC_TIME,
 		"utc_timestamp":            UTC_TIMESTAMP,
+		"uuid":                     UUID,
 		"values":                   VALUES,
 		"variables":                VARIABLES,
 		"varbinary":                VARBINARY,
 		"varchar":                  VARCHAR,
 		"varcharacter":             UNUSED,
 		"varying":                  UNUSED,
-		"virtual":                  UNUSED,
 		"view":                     VIEW,
 		"visible":                  VISIBLE,
 		"week":                     WEEK,
@@ -437,6 +451,8 @@ func init() {
 		"date_add":                 DATE_ADD,
 		"date_sub":                 DATE_SUB,
 		"extract":                  EXTRACT,
+		"timestampadd":             TIMESTAMPADD,
+		"timestampdiff":            TIMESTAMPDIFF,
 		"max":                      MAX,
 		"mid":                      MID,
 		"now":                      NOW,
@@ -455,6 +471,9 @@ func init() {
 		"variance":                 VARIANCE,
 		"var_pop":                  VAR_POP,
 		"var_samp":                 VAR_SAMP,
+		"percentile_cont":          PERCENTILE_CONT,
+		"percentile_disc":          PERCENTILE_DISC,
+		"within":                   WITHIN,
 		"type":                     TYPE,
 		"verbose":                  VERBOSE,
 		"sql_tsi_minute":           SQL_TSI_MINUTE,
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
@@ -124,11 +125,18 @@ func (l *Lexer) toHex(lval *yySymType, str string) int {
 }
 
 func (l *Lexer) toHexNum(lval *yySymType, str string) int {
+	// x'..' literals and the 0x.. literals out of the uint64 range are binary strings,
+	// the item keeps the hex digits only
+	if !strings.HasPrefix(str, "0x") {
+		lval.item = str
+		return HEXNUM
+	}
 	ival, err := strconv.ParseUint(str[2:], 16, 64)
 	if err != nil {
-		// TODO: toDecimal()
-		//l.scanner.LastError = err
-		lval.item = str
+		if len(str)%2 == 1 {
+			str = "0x0" + str[2:]
+		}
+		lval.item = str[2:]
 		return HEXNUM
 	}
 	switch {
@@ -142,11 +150,15 @@ func (l *Lexer) toHexNum(lval *yySymType, str string) int {
 }
 
 func (l *Lexer) toBit(lval *yySymType, str string) int {
+	// b'..' literals and the 0b.. literals out of the uint64 range are binary strings,
+	// the item keeps the bit digits only
+	if !strings.HasPrefix(str, "0b") {
+		lval.item = str
+		return BIT_LITERAL
+	}
 	ival, err := strconv.ParseUint(str[2:], 2, 64)
 	if err != nil {
-		// TODO: toDecimal()
-		//l.scanner.LastError = err
-		lval.item = str
+		lval.item = str[2:]
 		return BIT_LITERAL
 	}
 	switch {
@@ -13,6 +13,7 @@ import (
 import (
 	"fmt"
 	"go/constant"
+	"math"
 	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/defines"
@@ -171,316 +172,339 @@ const MEDIUMBLOB = 57493
 const LONGBLOB = 57494
 const JSON = 57495
 const ENUM = 57496
-const GEOMETRY = 57497
-const POINT = 57498
-const LINESTRING = 57499
-const POLYGON = 57500
-const GEOMETRYCOLLECTION = 57501
-const MULTIPOINT = 57502
-const MULTILINESTRING = 57503
-const MULTIPOLYGON = 57504
-const INT1 = 57505
-const INT2 = 57506
-const INT3 = 57507
-const INT4 = 57508
-const INT8 = 57509
-const SQL_SMALL_RESULT = 57510
-const SQL_BIG_RESULT = 57511
-const SQL_BUFFER_RESULT = 57512
-const LOW_PRIORITY = 57513
-const HIGH_PRIORITY = 57514
-const DELAYED = 57515
-const CREATE = 57516
-const ALTER = 57517
-const DROP = 57518
-const RENAME = 57519
-const ANALYZE = 57520
-const ADD = 57521
-const SCHEMA = 57522
-const TABLE = 57523
-const INDEX = 57524
-const VIEW = 57525
-const TO = 57526
-const IGNORE = 57527
-const IF = 57528
-const PRIMARY = 57529
-const COLUMN = 57530
-const CONSTRAINT = 57531
-const SPATIAL = 57532
-const FULLTEXT = 57533
-const FOREIGN = 57534
-const KEY_BLOCK_SIZE = 57535
-const SHOW = 57536
-const DESCRIBE = 57537
-const EXPLAIN = 57538
-const DATE = 57539
-const ESCAPE = 57540
-const REPAIR = 57541
-const OPTIMIZE = 57542
-const TRUNCATE = 57543
-const MAXVALUE = 57544
-const PARTITION = 57545
-const REORGANIZE = 57546
-const LESS = 57547
-const THAN = 57548
-const PROCEDURE = 57549
-const TRIGGER = 57550
-const STATUS = 57551
-const VARIABLES = 57552
-const ROLE = 57553
-const PROXY = 57554
-const AVG_ROW_LENGTH = 57555
-const STORAGE = 57556
-const DISK = 57557
-const MEMORY = 57558
-const CHECKSUM = 57559
-const COMPRESSION = 57560
-const DATA = 57561
-const DIRECTORY = 57562
-const DELAY_KEY_WRITE = 57563
-const ENCRYPTION = 57564
-const ENGINE = 57565
-const MAX_ROWS = 57566
-const MIN_ROWS = 57567
-const PACK_KEYS = 57568
-const ROW_FORMAT = 57569
-const STATS_AUTO_RECALC = 57570
-const STATS_PERSISTENT = 57571
-const STATS_SAMPLE_PAGES = 57572
-const DYNAMIC = 57573
-const COMPRESSED = 57574
-const REDUNDANT = 57575
-const COMPACT = 57576
-const FIXED = 57577
-const COLUMN_FORMAT = 57578
-const AUTO_RANDOM = 57579
-const RESTRICT = 57580
-const CASCADE = 57581
-const ACTION = 57582
-const PARTIAL = 57583
-const SIMPLE = 57584
-const CHECK = 57585
-const ENFORCED = 57586
-const RANGE = 57587
-const LIST = 57588
-const ALGORITHM = 57589
-const LINEAR = 57590
-const PARTITIONS = 57591
-const SUBPARTITION = 57592
-const SUBPARTITIONS = 57593
-const TYPE = 57594
-const ANY = 57595
-const SOME = 57596
-const EXTERNAL = 57597
-const LOCALFILE = 57598
-const URL = 57599
-const PREPARE = 57600
-const DEALLOCATE = 57601
-const PROPERTIES = 57602
-const PARSER = 57603
-const VISIBLE = 57604
-const INVISIBLE = 57605
-const BTREE = 57606
-const HASH = 57607
-const RTREE = 57608
-const BSI = 57609
-const ZONEMAP = 57610
-const LEADING = 57611
-const BOTH = 57612
-const TRAILING = 57613
-const UNKNOWN = 57614
-const EXPIRE = 57615
-const ACCOUNT = 57616
-const UNLOCK = 57617
-const DAY = 57618
-const NEVER = 57619
-const SECOND = 57620
-const ASCII = 57621
-const COALESCE = 57622
-const COLLATION = 57623
-const HOUR = 57624
-const MICROSECOND = 57625
-const MINUTE = 57626
-const MONTH = 57627
-const QUARTER = 57628
-const REPEAT = 57629
-const REVERSE = 57630
-const ROW_COUNT = 57631
-const WEEK = 57632
-const REVOKE = 57633
-const FUNCTION = 57634
-const PRIVILEGES = 57635
-const TABLESPACE = 57636
-const EXECUTE = 57637
-const SUPER = 57638
-const GRANT = 57639
-const OPTION = 57640
-const REFERENCES = 57641
-const REPLICATION = 57642
-const SLAVE = 57643
-const CLIENT = 57644
-const USAGE = 57645
-const RELOAD = 57646
-const FILE = 57647
-const TEMPORARY = 57648
-const ROUTINE = 57649
-const EVENT = 57650
-const SHUTDOWN = 57651
-const NULLX = 57652
-const AUTO_INCREMENT = 57653
-const APPROXNUM = 57654
-const SIGNED = 57655
-const UNSIGNED = 57656
-const ZEROFILL = 57657
-const ADMIN_NAME = 57658
-const RANDOM = 57659
-const SUSPEND = 57660
-const ATTRIBUTE = 57661
-const HISTORY = 57662
-const REUSE = 57663
-const CURRENT = 57664
-const OPTIONAL = 57665
-const FAILED_LOGIN_ATTEMPTS = 57666
-const PASSWORD_LOCK_TIME = 57667
-const UNBOUNDED = 57668
-const SECONDARY = 57669
-const USER = 57670
-const IDENTIFIED = 57671
-const CIPHER = 57672
-const ISSUER = 57673
-const X509 = 57674
-const SUBJECT = 57675
-const SAN = 57676
-const REQUIRE = 57677
-const SSL = 57678
-const NONE = 57679
-const PASSWORD = 57680
-const MAX_QUERIES_PER_HOUR = 57681
-const MAX_UPDATES_PER_HOUR = 57682
-const MAX_CONNECTIONS_PER_HOUR = 57683
-const MAX_USER_CONNECTIONS = 57684
-const FORMAT = 57685
-const VERBOSE = 57686
-const CONNECTION = 57687
-const LOAD = 57688
-const INFILE = 57689
-const TERMINATED = 57690
-const OPTIONALLY = 57691
-const ENCLOSED = 57692
-const ESCAPED = 57693
-const STARTING = 57694
-const LINES = 57695
-const ROWS = 57696
-const DATABASES = 57697
-const TABLES = 57698
-const EXTENDED = 57699
-const FULL = 57700
-const PROCESSLIST = 57701
-const FIELDS = 57702
-const COLUMNS = 57703
-const OPEN = 57704
-const ERRORS = 57705
-const WARNINGS = 57706
-const INDEXES = 57707
-const SCHEMAS = 57708
-const NAMES = 57709
-const GLOBAL = 57710
-const SESSION = 57711
-const ISOLATION = 57712
-const LEVEL = 57713
-const READ = 57714
-const WRITE = 57715
-const ONLY = 57716
-const REPEATABLE = 57717
-const COMMITTED = 57718
-const UNCOMMITTED = 57719
-const SERIALIZABLE = 57720
-const LOCAL = 57721
-const CURRENT_TIMESTAMP = 57722
-const DATABASE = 57723
-const CURRENT_TIME = 57724
-const LOCALTIME = 57725
-const LOCALTIMESTAMP = 57726
-const UTC_DATE = 57727
-const UTC_TIME = 57728
-const UTC_TIMESTAMP = 57729
-const REPLACE = 57730
-const CONVERT = 57731
-const SEPARATOR = 57732
-const CURRENT_DATE = 57733
-const CURRENT_USER = 57734
-const CURRENT_ROLE = 57735
-const SECOND_MICROSECOND = 57736
-const MINUTE_MICROSECOND = 57737
-const MINUTE_SECOND = 57738
-const HOUR_MICROSECOND = 57739
-const HOUR_SECOND = 57740
-const HOUR_MINUTE = 57741
-const DAY_MICROSECOND = 57742
-const DAY_SECOND = 57743
-const DAY_MINUTE = 57744
-const DAY_HOUR = 57745
-const YEAR_MONTH = 57746
-const SQL_TSI_HOUR = 57747
-const SQL_TSI_DAY = 57748
-const SQL_TSI_WEEK = 57749
-const SQL_TSI_MONTH = 57750
-const SQL_TSI_QUARTER = 57751
-const SQL_TSI_YEAR = 57752
-const SQL_TSI_SECOND = 57753
-const SQL_TSI_MINUTE = 57754
-const RECURSIVE = 57755
-const CONFIG = 57756
-const MATCH = 57757
-const AGAINST = 57758
-const BOOLEAN = 57759
-const LANGUAGE = 57760
-const WITH = 57761
-const QUERY = 57762
-const EXPANSION = 57763
-const ADDDATE = 57764
-const BIT_AND = 57765
-const BIT_OR = 57766
-const BIT_XOR = 57767
-const CAST = 57768
-const COUNT = 57769
-const APPROX_COUNT_DISTINCT = 57770
-const APPROX_PERCENTILE = 57771
-const CURDATE = 57772
-const CURTIME = 57773
-const DATE_ADD = 57774
-const DATE_SUB = 57775
-const EXTRACT = 57776
-const GROUP_CONCAT = 57777
-const MAX = 57778
-const MID = 57779
-const MIN = 57780
-const NOW = 57781
-const POSITION = 57782
-const SESSION_USER = 57783
-const STD = 57784
-const STDDEV = 57785
-const STDDEV_POP = 57786
-const STDDEV_SAMP = 57787
-const SUBDATE = 57788
-const SUBSTR = 57789
-const SUBSTRING = 57790
-const SUM = 57791
-const SYSDATE = 57792
-const SYSTEM_USER = 57793
-const TRANSLATE = 57794
-const TRIM = 57795
-const VARIANCE = 57796
-const VAR_POP = 57797
-const VAR_SAMP = 57798
-const AVG = 57799
-const JSON_EXTRACT = 57800
-const ROW = 57801
-const OUTFILE = 57802
-const HEADER = 57803
-const MAX_FILE_SIZE = 57804
-const FORCE_QUOTE = 57805
-const UNUSED = 57806
+const UUID = 57497
+const GEOMETRY = 57498
+const POINT = 57499
+const LINESTRING = 57500
+const POLYGON = 57501
+const GEOMETRYCOLLECTION = 57502
+const MULTIPOINT = 57503
+const MULTILINESTRING = 57504
+const MULTIPOLYGON = 57505
+const INT1 = 57506
+const INT2 = 57507
+const INT3 = 57508
+const INT4 = 57509
+const INT8 = 57510
+const SQL_SMALL_RESULT = 57511
+const SQL_BIG_RESULT = 57512
+const SQL_BUFFER_RESULT = 57513
+const LOW_PRIORITY = 57514
+const HIGH_PRIORITY = 57515
+const DELAYED = 57516
+const CREATE = 57517
+const ALTER = 57518
+const DROP = 57519
+const RENAME = 57520
+const ANALYZE = 57521
+const ADD = 57522
+const SCHEMA = 57523
+const TABLE = 57524
+const INDEX = 57525
+const VIEW = 57526
+const TO = 57527
+const IGNORE = 57528
+const IF = 57529
+const PRIMARY = 57530
+const COLUMN = 57531
+const CONSTRAINT = 57532
+const SPATIAL = 57533
+const FULLTEXT = 57534
+const FOREIGN = 57535
+const KEY_BLOCK_SIZE = 57536
+const SHOW = 57537
+const DESCRIBE = 57538
+const EXPLAIN = 57539
+const DATE = 57540
+const ESCAPE = 57541
+const REPAIR = 57542
+const OPTIMIZE = 57543
+const TRUNCATE = 57544
+const MAXVALUE = 57545
+const PARTITION = 57546
+const REORGANIZE = 57547
+const LESS = 57548
+const THAN = 57549
+const PROCEDURE = 57550
+const TRIGGER = 57551
+const STATUS = 57552
+const VARIABLES = 57553
+const ROLE = 57554
+const PROXY = 57555
+const AVG_ROW_LENGTH = 57556
+const STORAGE = 57557
+const DISK = 57558
+const MEMORY = 57559
+const CHECKSUM = 57560
+const COMPRESSION = 57561
+const DATA = 57562
+const DIRECTORY = 57563
+const DELAY_KEY_WRITE = 57564
+const ENCRYPTION = 57565
+const ENGINE = 57566
+const MAX_ROWS = 57567
+const MIN_ROWS = 57568
+const PACK_KEYS = 57569
+const ROW_FORMAT = 57570
+const STATS_AUTO_RECALC = 57571
+const STATS_PERSISTENT = 57572
+const STATS_SAMPLE_PAGES = 57573
+const DYNAMIC = 57574
+const COMPRESSED = 57575
+const REDUNDANT = 57576
+const COMPACT = 57577
+const FIXED = 57578
+const COLUMN_FORMAT = 57579
+const AUTO_RANDOM = 57580
+const RESTRICT = 57581
+const CASCADE = 57582
+const ACTION = 57583
+const PARTIAL = 57584
+const SIMPLE = 57585
+const CHECK = 57586
+const ENFORCED = 57587
+const RANGE = 57588
+const LIST = 57589
+const ALGORITHM = 57590
+const LINEAR = 57591
+const PARTITIONS = 57592
+const SUBPARTITION = 57593
+const SUBPARTITIONS = 57594
+const TYPE = 57595
+const ANY = 57596
+const SOME = 57597
+const EXTERNAL = 57598
+const LOCALFILE = 57599
+const URL = 57600
+const PREPARE = 57601
+const DEALLOCATE = 57602
+const MATERIALIZED = 57603
+const REFRESH = 57604
+const INCREMENTAL = 57605
+const RETURN = 57606
+const RETURNS = 57607
+const SEQUENCE = 57608
+const INCREMENT = 57609
+const MINVALUE = 57610
+const CACHE = 57611
+const CYCLE = 57612
+const RESTART = 57613
+const BACKUP = 57614
+const GENERATED = 57615
+const ALWAYS = 57616
+const VIRTUAL = 57617
+const STORED = 57618
+const PROPERTIES = 57619
+const PARSER = 57620
+const VISIBLE = 57621
+const INVISIBLE = 57622
+const BTREE = 57623
+const HASH = 57624
+const RTREE = 57625
+const BSI = 57626
+const ZONEMAP = 57627
+const LEADING = 57628
+const BOTH = 57629
+const TRAILING = 57630
+const UNKNOWN = 57631
+const EXPIRE = 57632
+const ACCOUNT = 57633
+const UNLOCK = 57634
+const DAY = 57635
+const NEVER = 57636
+const SECOND = 57637
+const ASCII = 57638
+const COALESCE = 57639
+const COLLATION = 57640
+const HOUR = 57641
+const MICROSECOND = 57642
+const MINUTE = 57643
+const MONTH = 57644
+const QUARTER = 57645
+const REPEAT = 57646
+const REVERSE = 57647
+const ROW_COUNT = 57648
+const WEEK = 57649
+const REVOKE = 57650
+const FUNCTION = 57651
+const PRIVILEGES = 57652
+const TABLESPACE = 57653
+const EXECUTE = 57654
+const SUPER = 57655
+const GRANT = 57656
+const OPTION = 57657
+const REFERENCES = 57658
+const REPLICATION = 57659
+const SLAVE = 57660
+const CLIENT = 57661
+const USAGE = 57662
+const RELOAD = 57663
+const FILE = 57664
+const TEMPORARY = 57665
+const ROUTINE = 57666
+const EVENT = 57667
+const SHUTDOWN = 57668
+const NULLX = 57669
+const AUTO_INCREMENT = 57670
+const APPROXNUM = 57671
+const SIGNED = 57672
+const UNSIGNED = 57673
+const ZEROFILL = 57674
+const ADMIN_NAME = 57675
+const RANDOM = 57676
+const SUSPEND = 57677
+const ATTRIBUTE = 57678
+const HISTORY = 57679
+const REUSE = 57680
+const CURRENT = 57681
+const OPTIONAL = 57682
+const FAILED_LOGIN_ATTEMPTS = 57683
+const PASSWORD_LOCK_TIME = 57684
+const UNBOUNDED = 57685
+const SECONDARY = 57686
+const USER = 57687
+const IDENTIFIED = 57688
+const CIPHER = 57689
+const ISSUER = 57690
+const X509 = 57691
+const SUBJECT = 57692
+const SAN = 57693
+const REQUIRE = 57694
+const SSL = 57695
+const NONE = 57696
+const PASSWORD = 57697
+const MAX_QUERIES_PER_HOUR = 57698
+const MAX_UPDATES_PER_HOUR = 57699
+const MAX_CONNECTIONS_PER_HOUR = 57700
+const MAX_USER_CONNECTIONS = 57701
+const FORMAT = 57702
+const VERBOSE = 57703
+const CONNECTION = 57704
+const LOAD = 57705
+const INFILE = 57706
+const TERMINATED = 57707
+const OPTIONALLY = 57708
+const ENCLOSED = 57709
+const ESCAPED = 57710
+const STARTING = 57711
+const LINES = 57712
+const ROWS = 57713
+const DATABASES = 57714
+const TABLES = 57715
+const EXTENDED = 57716
+const FULL = 57717
+const PROCESSLIST = 57718
+const FIELDS = 57719
+const COLUMNS = 57720
+const OPEN = 57721
+const ERRORS = 57722
+const WARNINGS = 57723
+const INDEXES = 57724
+const SCHEMAS = 57725
+const NAMES = 57726
+const GLOBAL = 57727
+const SESSION = 57728
+const ISOLATION = 57729
+const LEVEL = 57730
+const READ = 57731
+const WRITE = 57732
+const ONLY = 57733
+const REPEATABLE = 57734
+const COMMITTED = 57735
+const UNCOMMITTED = 57736
+const SERIALIZABLE = 57737
+const LOCAL = 57738
+const CURRENT_TIMESTAMP = 57739
+const DATABASE = 57740
+const CURRENT_TIME = 57741
+const LOCALTIME = 57742
+const LOCALTIMESTAMP = 57743
+const UTC_DATE = 57744
+const UTC_TIME = 57745
+const UTC_TIMESTAMP = 57746
+const REPLACE = 57747
+const CONVERT = 57748
+const SEPARATOR = 57749
+const CURRENT_DATE = 57750
+const CURRENT_USER = 57751
+const CURRENT_ROLE = 57752
+const SECOND_MICROSECOND = 57753
+const MINUTE_MICROSECOND = 57754
+const MINUTE_SECOND = 57755
+const HOUR_MICROSECOND = 57756
+const HOUR_SECOND = 57757
+const HOUR_MINUTE = 57758
+const DAY_MICROSECOND = 57759
+const DAY_SECOND = 57760
+const DAY_MINUTE = 57761
+const DAY_HOUR = 57762
+const YEAR_MONTH = 57763
+const SQL_TSI_HOUR = 57764
+const SQL_TSI_DAY = 57765
+const SQL_TSI_WEEK = 57766
+const SQL_TSI_MONTH = 57767
+const SQL_TSI_QUARTER = 57768
+const SQL_TSI_YEAR = 57769
+const SQL_TSI_SECOND = 57770
+const SQL_TSI_MINUTE = 57771
+const RECURSIVE = 57772
+const CONFIG = 57773
+const MATCH = 57774
+const AGAINST = 57775
+const BOOLEAN = 57776
+const LANGUAGE = 57777
+const WITH = 57778
+const QUERY = 57779
+const EXPANSION = 57780
+const ADDDATE = 57781
+const BIT_AND = 57782
+const BIT_OR = 57783
+const BIT_XOR = 57784
+const CAST = 57785
+const COUNT = 57786
+const APPROX_COUNT_DISTINCT = 57787
+const APPROX_PERCENTILE = 57788
+const CURDATE = 57789
+const CURTIME = 57790
+const DATE_ADD = 57791
+const DATE_SUB = 57792
+const EXTRACT = 57793
+const GROUP_CONCAT = 57794
+const MAX = 57795
+const MID = 57796
+const MIN = 57797
+const NOW = 57798
+const POSITION = 57799
+const SESSION_USER = 57800
+const STD = 57801
+const STDDEV = 57802
+const STDDEV_POP = 57803
+const STDDEV_SAMP = 57804
+const SUBDATE = 57805
+const SUBSTR = 57806
+const SUBSTRING = 57807
+const SUM = 57808
+const SYSDATE = 57809
+const SYSTEM_USER = 57810
+const TRANSLATE = 57811
+const TRIM = 57812
+const VARIANCE = 57813
+const VAR_POP = 57814
+const VAR_SAMP = 57815
+const AVG = 57816
+const PERCENTILE_CONT = 57817
+const PERCENTILE_DISC = 57818
+const WITHIN = 57819
+const ARRAY_AGG = 57820
+const TIMESTAMPADD = 57821
+const TIMESTAMPDIFF = 57822
+const JSON_EXTRACT = 57823
+const ROW = 57824
+const OUTFILE = 57825
+const HEADER = 57826
+const MAX_FILE_SIZE = 57827
+const FORCE_QUOTE = 57828
+const UNUSED = 57829
 
 var yyToknames = [...]string{
 	"$end",
@@ -654,6 +678,7 @@ var yyToknames = [...]string{
 	"LONGBLOB",
 	"JSON",
 	"ENUM",
+	"UUID",
 	"GEOMETRY",
 	"POINT",
 	"LINESTRING",
@@ -759,6 +784,22 @@ var yyToknames = [...]string{
 	"URL",
 	"PREPARE",
 	"DEALLOCATE",
+	"MATERIALIZED",
+	"REFRESH",
+	"INCREMENTAL",
+	"RETURN",
+	"RETURNS",
+	"SEQUENCE",
+	"INCREMENT",
+	"MINVALUE",
+	"CACHE",
+	"CYCLE",
+	"RESTART",
+	"BACKUP",
+	"GENERATED",
+	"ALWAYS",
+	"VIRTUAL",
+	"STORED",
 	"PROPERTIES",
 	"PARSER",
 	"VISIBLE",
@@ -957,6 +998,12 @@ var yyToknames = [...]string{
 	"VAR_POP",
 	"VAR_SAMP",
 	"AVG",
+	"PERCENTILE_CONT",
+	"PERCENTILE_DISC",
+	"WITHIN",
+	"ARRAY_AGG",
+	"TIMESTAMPADD",
+	"TIMESTAMPDIFF",
 	"JSON_EXTRACT",
 	"ROW",
 	"OUTFILE",
@@ -976,2342 +1023,2448 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line mysql_sql.y:7222
+//line mysql_sql.y:7668
 
 //line yacctab:1
 var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 63,
-	21, 433,
-	-2, 414,
 	-1, 68,
-	199, 588,
-	-2, 630,
-	-1, 85,
-	226, 291,
-	227, 291,
-	-2, 312,
-	-1, 366,
-	21, 434,
-	-2, 397,
-	-1, 442,
-	94, 1314,
-	105, 1314,
-	124, 1314,
-	-2, 1125,
-	-1, 472,
-	21, 434,
-	-2, 397,
-	-1, 633,
-	58, 1465,
-	-2, 1472,
-	-1, 641,
-	58, 1466,
-	-2, 1480,
-	-1, 643,
-	58, 1462,
-	-2, 1482,
-	-1, 644,
-	58, 1463,
-	-2, 1483,
-	-1, 649,
-	58, 1464,
-	-2, 1489,
-	-1, 650,
-	58, 1467,
-	-2, 1490,
-	-1, 651,
-	58, 1468,
-	-2, 1491,
-	-1, 652,
-	58, 887,
-	-2, 1492,
-	-1, 653,
-	58, 888,
-	-2, 1493,
-	-1, 654,
-	58, 889,
-	-2, 1494,
-	-1, 656,
-	58, 1469,
-	-2, 1496,
-	-1, 657,
-	58, 907,
-	-2, 1497,
-	-1, 658,
-	58, 906,
-	-2, 1498,
-	-1, 661,
-	58, 1470,
-	-2, 1501,
-	-1, 662,
-	58, 1471,
-	-2, 1502,
-	-1, 668,
-	58, 969,
-	-2, 1314,
-	-1, 669,
-	58, 978,
-	-2, 1339,
-	-1, 670,
-	58, 982,
-	-2, 1378,
-	-1, 671,
-	58, 993,
-	-2, 1438,
-	-1, 672,
-	58, 995,
-	-2, 1448,
-	-1, 673,
-	58, 983,
-	-2, 1453,
-	-1, 674,
-	58, 991,
-	-2, 1457,
-	-1, 675,
-	58, 972,
-	-2, 1458,
-	-1, 833,
-	1, 614,
-	60, 614,
-	482, 614,
-	-2, 621,
-	-1, 973,
-	21, 433,
-	-2, 815,
-	-1, 1020,
-	124, 1135,
-	-2, 1133,
-	-1, 1022,
-	124, 532,
-	-2, 1130,
-	-1, 1023,
-	124, 533,
-	-2, 1131,
-	-1, 1234,
-	1, 615,
-	60, 615,
-	482, 615,
-	-2, 621,
-	-1, 1322,
-	58, 1037,
-	-2, 1455,
-	-1, 1323,
-	58, 1038,
-	-2, 1456,
-	-1, 1491,
-	56, 354,
-	59, 354,
+	21, 453,
+	-2, 434,
+	-1, 75,
+	200, 635,
+	-2, 682,
+	-1, 94,
+	227, 305,
+	228, 305,
+	-2, 326,
+	-1, 398,
+	21, 454,
+	-2, 415,
+	-1, 478,
+	94, 1387,
+	105, 1387,
+	124, 1387,
+	-2, 1197,
+	-1, 510,
+	21, 454,
+	-2, 415,
+	-1, 679,
+	58, 1553,
+	-2, 1559,
+	-1, 687,
+	58, 1554,
+	-2, 1567,
+	-1, 689,
+	58, 1550,
+	-2, 1569,
+	-1, 690,
+	58, 1551,
+	-2, 1570,
+	-1, 694,
+	58, 977,
+	-2, 1574,
+	-1, 696,
+	58, 1552,
+	-2, 1576,
+	-1, 698,
+	58, 1555,
+	-2, 1578,
+	-1, 699,
+	58, 949,
+	-2, 1579,
+	-1, 700,
+	58, 950,
+	-2, 1580,
+	-1, 701,
+	58, 951,
+	-2, 1581,
+	-1, 703,
+	58, 1556,
+	-2, 1583,
+	-1, 704,
+	58, 976,
+	-2, 1584,
+	-1, 705,
+	58, 975,
+	-2, 1585,
+	-1, 708,
+	58, 1557,
+	-2, 1588,
+	-1, 709,
+	58, 1558,
+	-2, 1589,
+	-1, 715,
+	58, 947,
+	-2, 1595,
+	-1, 716,
+	58, 948,
+	-2, 1596,
+	-1, 720,
+	58, 1039,
+	-2, 1387,
+	-1, 721,
+	58, 1048,
+	-2, 1412,
+	-1, 723,
+	58, 1052,
+	-2, 1464,
+	-1, 724,
+	58, 1063,
+	-2, 1525,
+	-1, 725,
+	58, 1064,
+	-2, 1526,
+	-1, 726,
+	58, 1066,
+	-2, 1536,
+	-1, 727,
+	58, 1053,
+	-2, 1541,
+	-1, 728,
+	58, 1061,
+	-2, 1545,
+	-1, 729,
+	58, 1042,
+	-2, 1546,
+	-1, 894,
+	1, 661,
+	60, 661,
+	505, 661,
+	-2, 668,
+	-1, 1042,
+	21, 453,
+	-2, 872,
+	-1, 1090,
+	124, 1207,
+	-2, 1205,
+	-1, 1092,
+	124, 552,
+	-2, 1202,
+	-1, 1093,
+	124, 553,
+	-2, 1203,
+	-1, 1319,
+	1, 662,
+	60, 662,
+	505, 662,
+	-2, 668,
+	-1, 1411,
+	58, 1108,
+	-2, 1543,
+	-1, 1412,
+	58, 1109,
+	-2, 1544,
+	-1, 1606,
+	56, 372,
+	59, 372,
+	-2, 773,
+	-1, 1980,
+	79, 668,
+	120, 668,
+	156, 668,
+	159, 668,
 	-2, 721,
-	-1, 1811,
-	79, 621,
-	120, 621,
-	156, 621,
-	159, 621,
-	-2, 669,
-	-1, 1813,
-	260, 782,
-	-2, 763,
-	-1, 1843,
-	56, 354,
-	59, 354,
+	-1, 1982,
+	261, 839,
+	-2, 815,
+	-1, 2013,
+	56, 372,
+	59, 372,
+	-2, 774,
+	-1, 2088,
+	79, 668,
+	120, 668,
+	156, 668,
+	159, 668,
 	-2, 722,
-	-1, 1920,
-	79, 621,
-	120, 621,
-	156, 621,
-	159, 621,
-	-2, 670,
-	-1, 1948,
-	260, 782,
-	-2, 764,
-	-1, 2346,
-	59, 642,
-	60, 642,
-	-2, 621,
-	-1, 2350,
-	59, 642,
-	60, 642,
-	-2, 621,
-	-1, 2364,
-	59, 646,
-	60, 646,
-	-2, 621,
-	-1, 2369,
-	59, 647,
-	60, 647,
-	-2, 621,
+	-1, 2116,
+	261, 839,
+	-2, 816,
+	-1, 2546,
+	59, 694,
+	60, 694,
+	-2, 668,
+	-1, 2550,
+	59, 694,
+	60, 694,
+	-2, 668,
+	-1, 2564,
+	59, 698,
+	60, 698,
+	-2, 668,
+	-1, 2569,
+	59, 699,
+	60, 699,
+	-2, 668,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 21474
+const yyLast = 22429
 
 var yyAct = [...]int{
-	816, 1325, 2352, 2358, 2350, 2349, 2327, 808, 2195, 678,
-	1985, 2316, 2276, 1282, 1960, 697, 2260, 2167, 2233, 2171,
-	2148, 2261, 1908, 1916, 1218, 1983, 903, 600, 102, 1984,
-	1805, 2155, 2008, 319, 325, 609, 325, 1326, 1278, 1906,
-	367, 1864, 804, 366, 676, 1968, 1836, 1634, 868, 105,
-	1467, 1949, 329, 1997, 838, 1494, 440, 811, 1967, 1630,
-	550, 323, 22, 1857, 1875, 1867, 632, 539, 1506, 888,
-	862, 395, 1277, 709, 63, 1879, 101, 1639, 1635, 1817,
-	1707, 1197, 1192, 1002, 1697, 1715, 1569, 677, 1686, 1649,
-	1241, 467, 1017, 441, 1645, 335, 1020, 1628, 1193, 1011,
-	1003, 102, 1409, 881, 311, 1395, 63, 62, 1532, 1313,
-	687, 865, 1012, 1505, 847, 1264, 863, 1469, 322, 15,
-	320, 6, 552, 1464, 1924, 1235, 1240, 3, 321, 5,
-	1194, 802, 807, 818, 443, 1324, 679, 445, 826, 1327,
-	1339, 624, 397, 849, 848, 312, 482, 909, 906, 469,
-	1204, 885, 1227, 794, 1224, 432, 521, 1280, 940, 822,
-	22, 801, 448, 30, 1304, 855, 825, 315, 447, 394,
-	576, 338, 63, 12, 7, 337, 1910, 4, 2241, 1211,
-	98, 2015, 1912, 1201, 592, 1804, 813, 1005, 324, 840,
-	623, 610, 578, 2222, 2212, 30, 1976, 96, 501, 1450,
-	537, 1442, 446, 520, 93, 97, 332, 27, 87, 69,
-	1198, 1456, 1209, 384, 97, 870, 871, 15, 97, 6,
-	27, 87, 69, 416, 327, 97, 466, 5, 97, 97,
-	27, 87, 69, 851, 569, 310, 570, 1466, 795, 579,
-	799, 810, 1595, 92, 97, 518, 27, 87, 69, 514,
-	81, 433, 97, 94, 2248, 453, 452, 454, 563, 564,
-	779, 30, 94, 2246, 798, 561, 94, 759, 560, 563,
-	564, 51, 402, 94, 2237, 2238, 94, 94, 392, 2006,
-	756, 1465, 2264, 2265, 1622, 451, 2009, 2010, 2011, 2012,
-	2104, 2101, 94, 1623, 2018, 1624, 1806, 812, 485, 1437,
-	758, 476, 2170, 882, 1800, 1825, 584, 1205, 417, 1981,
-	1832, 1658, 1225, 475, 1994, 585, 1660, 1650, 790, 2076,
-	1863, 1862, 505, 474, 1965, 1616, 1614, 325, 509, 102,
-	386, 515, 456, 1447, 2221, 504, 516, 517, 2079, 1978,
-	383, 382, 363, 88, 89, 364, 90, 91, 449, 797,
-	2274, 471, 473, 326, 445, 472, 510, 878, 1655, 1656,
-	334, 377, 418, 2250, 2070, 2343, 368, 2156, 2157, 2158,
-	2160, 2159, 2359, 1657, 2283, 2193, 2194, 492, 2197, 2064,
-	2197, 1537, 1317, 1318, 2245, 2290, 68, 2219, 95, 2337,
-	63, 63, 447, 363, 395, 2033, 364, 2169, 2032, 2263,
-	365, 2203, 450, 2224, 2225, 380, 85, 2252, 2253, 68,
-	86, 95, 1478, 49, 485, 1210, 1480, 1481, 1482, 1483,
-	588, 513, 512, 2360, 375, 562, 446, 494, 496, 85,
-	80, 79, 538, 1472, 571, 441, 441, 441, 796, 507,
-	604, 604, 526, 2354, 541, 542, 1654, 544, 559, 558,
-	2366, 508, 511, 2328, 1570, 455, 381, 325, 627, 627,
-	444, 2021, 540, 487, 486, 468, 333, 2099, 606, 577,
-	1443, 761, 1291, 506, 501, 543, 1202, 820, 376, 30,
-	30, 2055, 602, 602, 478, 479, 1619, 545, 1643, 777,
-	424, 328, 547, 82, 83, 626, 626, 1316, 1317, 1318,
-	1525, 604, 493, 604, 475, 873, 2059, 762, 1314, 389,
-	390, 391, 1859, 1858, 809, 490, 1287, 2319, 612, 582,
-	554, 874, 311, 1286, 587, 872, 59, 566, 567, 757,
-	84, 385, 60, 420, 1652, 423, 1289, 1288, 2325, 426,
-	425, 63, 604, 555, 421, 833, 580, 581, 2280, 395,
-	1846, 786, 839, 1671, 63, 1625, 102, 829, 1534, 523,
-	2223, 2353, 815, 63, 2251, 819, 525, 1495, 1445, 480,
-	856, 856, 2168, 563, 564, 1199, 604, 102, 1199, 487,
-	486, 61, 563, 564, 1651, 1444, 1436, 1199, 2077, 1661,
-	441, 854, 604, 883, 1617, 1536, 1431, 1256, 1212, 1216,
-	1188, 806, 1909, 1200, 844, 821, 1644, 921, 785, 897,
-	782, 2365, 1977, 834, 1982, 1451, 500, 604, 781, 902,
-	102, 102, 598, 599, 574, 575, 2320, 918, 788, 763,
-	889, 70, 765, 803, 586, 907, 889, 889, 828, 858,
-	70, 842, 611, 30, 70, 622, 791, 905, 768, 754,
-	608, 70, 30, 310, 70, 70, 615, 616, 617, 618,
-	619, 620, 621, 784, 783, 764, 922, 780, 904, 904,
-	70, 908, 549, 805, 827, 800, 565, 444, 70, 568,
-	814, 845, 846, 975, 879, 877, 595, 596, 597, 1471,
-	884, 772, 773, 495, 2057, 1780, 488, 470, 2056, 1831,
-	896, 1640, 1643, 2060, 2061, 1653, 958, 850, 827, 1675,
-	1611, 1315, 553, 901, 1329, 1328, 556, 835, 836, 974,
-	1754, 1751, 1752, 1753, 1613, 591, 1785, 982, 1784, 1783,
-	1781, 459, 464, 465, 859, 2314, 857, 2303, 1475, 1476,
-	1468, 861, 860, 1196, 843, 880, 984, 2317, 2318, 803,
-	894, 895, 1474, 593, 408, 973, 852, 853, 2207, 1009,
-	1009, 1014, 408, 2107, 594, 1684, 898, 1952, 1620, 1775,
-	976, 977, 978, 979, 1612, 899, 1433, 776, 900, 1022,
-	891, 892, 893, 1293, 477, 775, 1410, 2336, 1782, 446,
-	839, 914, 387, 1195, 604, 1486, 590, 422, 2066, 980,
-	1334, 1955, 1410, 2027, 1575, 557, 413, 1950, 1462, 998,
-	824, 2133, 1963, 1964, 2065, 1023, 1821, 1816, 1951, 948,
-	1644, 102, 102, 917, 914, 1637, 1764, 2335, 2050, 1638,
-	1641, 410, 447, 2144, 409, 102, 1242, 2142, 2348, 410,
-	1337, 2333, 409, 63, 1190, 2300, 1646, 2140, 408, 1402,
-	1338, 319, 1956, 961, 962, 963, 964, 965, 958, 1258,
-	2284, 2181, 907, 1400, 1401, 1399, 446, 2178, 2143, 1221,
-	1223, 1008, 2141, 2130, 992, 915, 916, 917, 914, 1246,
-	419, 1642, 2139, 1238, 1777, 915, 916, 917, 914, 427,
-	461, 462, 463, 915, 916, 917, 914, 604, 908, 1979,
-	1829, 2177, 1001, 2150, 1786, 1787, 2128, 2127, 2129, 1360,
-	2126, 627, 1557, 102, 1487, 2123, 2117, 1187, 1283, 1015,
-	1309, 1016, 1311, 1021, 2114, 410, 2113, 2016, 409, 889,
-	889, 889, 30, 2002, 1980, 1830, 1962, 1186, 1636, 1298,
-	1335, 1336, 2001, 1285, 2000, 1996, 1191, 1995, 626, 1828,
-	1659, 1607, 1305, 1306, 1307, 1308, 766, 1556, 1236, 2273,
-	407, 2256, 1580, 1958, 1252, 2149, 1254, 1917, 411, 363,
-	1250, 1290, 364, 2239, 1332, 998, 2201, 1284, 1230, 915,
-	916, 917, 914, 1303, 1544, 1957, 1959, 1374, 830, 831,
-	832, 1319, 2200, 2188, 2176, 1419, 1253, 1251, 1255, 850,
-	2131, 1383, 1384, 1385, 1386, 1387, 1388, 1389, 1390, 1391,
-	1392, 1393, 1394, 1260, 1259, 1586, 1404, 1405, 2124, 2120,
-	2364, 1411, 2119, 1414, 2134, 2136, 2137, 2138, 2135, 915,
-	916, 917, 914, 2118, 1247, 1248, 1249, 915, 916, 917,
-	914, 1421, 2078, 1294, 1295, 1296, 2052, 1356, 1965, 1353,
-	2017, 2013, 1302, 1355, 1352, 1354, 1358, 1359, 1403, 2341,
-	1953, 1357, 957, 956, 966, 967, 959, 960, 961, 962,
-	963, 964, 965, 958, 1330, 1331, 1998, 1333, 1915, 1397,
-	969, 1913, 972, 1369, 1370, 1371, 1372, 1373, 1839, 1827,
-	1379, 1380, 1381, 1382, 1826, 1823, 970, 971, 968, 1301,
-	957, 956, 966, 967, 959, 960, 961, 962, 963, 964,
-	965, 958, 959, 960, 961, 962, 963, 964, 965, 958,
-	1802, 1413, 1415, 1416, 1793, 1412, 1648, 2302, 1618, 1528,
-	1454, 1420, 1424, 1422, 1219, 1220, 725, 724, 1663, 1423,
-	1213, 404, 994, 406, 416, 955, 954, 767, 403, 401,
-	400, 412, 405, 1901, 414, 415, 925, 926, 927, 928,
-	929, 930, 931, 923, 823, 1341, 1342, 1343, 1344, 1345,
-	1346, 1347, 1348, 1349, 1350, 1351, 1363, 1364, 1365, 1366,
-	1367, 1368, 1361, 1362, 2228, 915, 916, 917, 914, 1438,
-	1900, 1425, 2227, 370, 371, 372, 373, 2208, 1215, 604,
-	396, 604, 2092, 604, 1578, 2088, 369, 1577, 475, 1540,
-	2372, 2087, 915, 916, 917, 914, 1904, 1459, 1452, 966,
-	967, 959, 960, 961, 962, 963, 964, 965, 958, 604,
-	915, 916, 917, 914, 1584, 1214, 1540, 1540, 1583, 1902,
-	1491, 1540, 2371, 1448, 1899, 2257, 1497, 614, 2363, 2362,
-	1207, 2344, 1457, 1458, 1891, 819, 2174, 1502, 915, 916,
-	917, 914, 475, 102, 102, 102, 102, 915, 916, 917,
-	914, 1856, 1507, 2097, 475, 102, 1522, 1840, 915, 916,
-	917, 914, 1461, 1811, 1507, 1449, 2340, 2339, 1489, 1795,
-	2334, 1696, 604, 1485, 1676, 915, 916, 917, 914, 1587,
-	102, 102, 1207, 2331, 1585, 22, 956, 966, 967, 959,
-	960, 961, 962, 963, 964, 965, 958, 63, 1523, 1207,
-	2330, 1582, 1441, 2279, 2278, 1581, 1439, 2081, 2271, 1283,
-	1446, 803, 2075, 2081, 2266, 1545, 957, 956, 966, 967,
-	959, 960, 961, 962, 963, 964, 965, 958, 1530, 1531,
-	1579, 1460, 1300, 2254, 915, 916, 917, 914, 1549, 1236,
-	1484, 827, 15, 1546, 6, 1541, 1490, 1477, 1542, 1543,
-	1501, 1496, 5, 841, 1526, 1499, 1539, 1500, 1504, 1508,
-	1509, 1510, 1511, 1503, 2243, 2242, 1521, 1520, 1519, 2081,
-	2217, 2081, 2216, 2081, 2215, 2081, 2214, 1529, 2206, 2205,
-	1564, 2096, 2095, 2003, 2094, 2093, 30, 1551, 1552, 1553,
-	1554, 1555, 841, 1559, 2090, 2091, 1274, 1560, 1561, 1562,
-	1563, 2090, 2089, 1524, 1535, 915, 916, 917, 914, 1887,
-	1009, 1538, 1599, 1009, 1886, 1492, 1602, 1245, 2086, 1418,
-	1498, 2081, 2080, 604, 1417, 1572, 1567, 1568, 1576, 613,
-	1605, 915, 916, 917, 914, 1683, 915, 916, 917, 914,
-	1885, 1588, 1540, 1769, 475, 889, 1540, 1755, 2361, 2311,
-	1670, 889, 973, 1493, 1633, 1540, 1548, 1540, 1547, 1493,
-	102, 1442, 915, 916, 917, 914, 1606, 1426, 1566, 475,
-	1596, 1245, 1440, 102, 1242, 1812, 1674, 1594, 2309, 1633,
-	1435, 1434, 63, 1601, 1796, 1565, 446, 1429, 1428, 1589,
-	1397, 1574, 1245, 1244, 1598, 957, 956, 966, 967, 959,
-	960, 961, 962, 963, 964, 965, 958, 1591, 1664, 1603,
-	1274, 1615, 1600, 1604, 1590, 1700, 1665, 1666, 1667, 1610,
-	501, 1609, 1597, 1771, 957, 956, 966, 967, 959, 960,
-	961, 962, 963, 964, 965, 958, 1207, 1206, 770, 769,
-	1432, 1407, 1300, 1672, 957, 956, 966, 967, 959, 960,
-	961, 962, 963, 964, 965, 958, 1229, 1273, 1702, 604,
-	1792, 1669, 1198, 1695, 1673, 604, 1668, 1217, 1756, 1189,
-	548, 2069, 1774, 1903, 1681, 1762, 1763, 792, 1680, 1691,
-	1228, 499, 915, 916, 917, 914, 1788, 912, 793, 604,
-	1768, 1274, 1790, 1776, 915, 916, 917, 914, 1789, 498,
-	102, 602, 97, 499, 1679, 589, 1700, 602, 102, 2313,
-	2307, 1791, 915, 916, 917, 914, 2291, 1815, 1773, 957,
-	956, 966, 967, 959, 960, 961, 962, 963, 964, 965,
-	958, 910, 501, 1770, 2288, 2286, 2180, 2165, 1772, 2153,
-	2151, 2146, 2108, 1779, 1866, 2073, 604, 604, 1801, 2072,
-	94, 102, 1843, 2071, 1767, 1794, 1810, 2068, 1232, 1797,
-	1766, 2063, 2048, 97, 475, 551, 87, 69, 1809, 1677,
-	1678, 1798, 1799, 1835, 1507, 1682, 915, 916, 917, 914,
-	63, 1855, 915, 916, 917, 914, 1876, 1819, 602, 1837,
-	1694, 1868, 1880, 1814, 1813, 1765, 1818, 1883, 1818, 1283,
-	1820, 1761, 889, 1848, 1873, 1851, 445, 1872, 1853, 1852,
-	1845, 94, 1834, 1822, 1398, 94, 1854, 915, 916, 917,
-	914, 1842, 1841, 915, 916, 917, 914, 1488, 1463, 1427,
-	1292, 1243, 1849, 1000, 1850, 350, 999, 349, 353, 345,
-	1870, 1871, 997, 1571, 996, 995, 993, 941, 990, 989,
-	987, 341, 986, 1860, 1874, 985, 983, 1878, 953, 952,
-	951, 360, 950, 1869, 957, 956, 966, 967, 959, 960,
-	961, 962, 963, 964, 965, 958, 949, 947, 946, 1877,
-	945, 944, 1888, 2347, 943, 942, 939, 363, 938, 937,
-	364, 936, 935, 475, 1921, 1890, 934, 1969, 1971, 1760,
-	1969, 1969, 1892, 1633, 933, 1894, 932, 1896, 1881, 789,
-	1884, 760, 1759, 503, 889, 475, 1687, 1688, 1889, 491,
-	2296, 915, 916, 917, 914, 2294, 2262, 1893, 1690, 1895,
-	1897, 1898, 1758, 839, 915, 916, 917, 914, 1757, 1479,
-	1975, 1966, 1844, 1970, 1299, 502, 1905, 1693, 1705, 1847,
-	1692, 1918, 1946, 1513, 915, 916, 917, 914, 1972, 1973,
-	915, 916, 917, 914, 1991, 1518, 1512, 1270, 1271, 1974,
-	915, 916, 917, 914, 1430, 1516, 1514, 1237, 1988, 1845,
-	1517, 1515, 1219, 1220, 50, 1453, 1704, 1992, 957, 956,
-	966, 967, 959, 960, 961, 962, 963, 964, 965, 958,
-	522, 29, 28, 2023, 1703, 1627, 1226, 1999, 915, 916,
-	917, 914, 497, 2019, 1626, 307, 343, 342, 346, 2004,
-	1406, 1276, 837, 2230, 348, 573, 915, 916, 917, 914,
-	1329, 1328, 308, 309, 534, 535, 352, 604, 532, 533,
-	530, 531, 915, 916, 917, 914, 102, 528, 529, 572,
-	344, 1185, 524, 2024, 2025, 1971, 2028, 2029, 2030, 2031,
-	2308, 2026, 2034, 2035, 2036, 2037, 2038, 2039, 2040, 2041,
-	2042, 2043, 2044, 2045, 2046, 2047, 1966, 2051, 2185, 1837,
-	2049, 2183, 2111, 2053, 1266, 1269, 1270, 1271, 1267, 2109,
-	1268, 1272, 2106, 2105, 2067, 1261, 370, 371, 372, 373,
-	2074, 2103, 1914, 1808, 2083, 2112, 1807, 2082, 2084, 369,
-	2085, 1989, 1990, 445, 1699, 1266, 1269, 1270, 1271, 1267,
-	2102, 1268, 1272, 527, 369, 1698, 1533, 2145, 841, 2298,
-	2297, 875, 1608, 1550, 489, 2297, 2298, 347, 351, 354,
-	1275, 355, 356, 398, 35, 357, 358, 359, 1, 475,
-	361, 362, 475, 475, 475, 2125, 1203, 2115, 2116, 1824,
-	1662, 2110, 475, 2121, 2122, 1647, 1283, 546, 388, 1375,
-	536, 774, 458, 63, 2154, 484, 771, 2162, 2163, 2164,
-	483, 481, 2161, 1408, 1340, 710, 1004, 2173, 1010, 2190,
-	2147, 2172, 2229, 2275, 2179, 2232, 604, 604, 787, 696,
-	2098, 2175, 1621, 2005, 2100, 2007, 1455, 2184, 1907, 2186,
-	2187, 2182, 1208, 2191, 519, 1592, 1593, 722, 713, 988,
-	715, 755, 460, 2198, 2199, 712, 1833, 102, 1473, 374,
-	457, 399, 1993, 1803, 475, 1861, 1882, 1865, 602, 602,
-	2357, 2346, 2326, 2306, 2196, 2342, 475, 2244, 2289, 2282,
-	2192, 2020, 339, 2204, 876, 583, 430, 2166, 340, 2213,
-	2220, 2236, 2152, 378, 1231, 379, 1234, 1233, 1320, 2209,
-	924, 2218, 1396, 991, 981, 904, 2235, 630, 1573, 2226,
-	686, 680, 1470, 1961, 1527, 34, 33, 32, 913, 2240,
-	1018, 711, 104, 1257, 1019, 2189, 2247, 2249, 2014, 2234,
-	695, 694, 693, 692, 691, 1265, 1263, 2255, 1262, 867,
-	866, 911, 2259, 2258, 2267, 2268, 2269, 2270, 2210, 2211,
-	2277, 1911, 2062, 2132, 2281, 2058, 2054, 2202, 1920, 1919,
-	1947, 1948, 1954, 1714, 1710, 1712, 1713, 1711, 1778, 1706,
-	1631, 1632, 1629, 1689, 1685, 1006, 1013, 817, 99, 864,
-	1987, 11, 2272, 10, 778, 2292, 9, 14, 2295, 21,
-	2236, 2305, 2293, 20, 19, 58, 2299, 475, 2301, 475,
-	57, 2285, 56, 2287, 2310, 2235, 2312, 809, 2304, 809,
-	55, 18, 8, 54, 53, 52, 17, 16, 47, 48,
-	2321, 45, 2277, 44, 475, 2322, 43, 2329, 42, 41,
-	40, 2332, 39, 46, 809, 38, 2338, 37, 36, 67,
-	66, 65, 64, 23, 24, 25, 2315, 26, 77, 76,
-	78, 2324, 74, 72, 75, 73, 71, 2345, 31, 13,
-	2, 0, 0, 2356, 0, 0, 2355, 0, 0, 0,
-	0, 0, 0, 2367, 0, 0, 0, 2368, 2370, 2369,
-	1130, 1173, 2356, 0, 1118, 0, 1080, 1132, 1054, 1069,
-	1140, 1070, 1071, 1105, 1033, 1089, 229, 1067, 0, 1121,
-	1025, 1057, 1058, 1027, 1064, 1028, 1055, 1082, 174, 1053,
-	1092, 199, 1138, 0, 0, 258, 213, 0, 0, 1085,
-	1123, 1087, 1110, 1079, 1106, 1041, 1099, 1133, 1068, 1103,
-	1134, 0, 0, 0, 0, 0, 830, 831, 832, 0,
-	0, 0, 0, 156, 0, 0, 0, 0, 0, 1102,
-	1127, 1066, 0, 159, 1131, 1086, 1104, 0, 0, 1026,
-	1100, 0, 1031, 1034, 1139, 1125, 1061, 1062, 0, 0,
-	0, 0, 0, 0, 0, 1083, 1088, 1107, 1076, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1059, 0,
-	1096, 0, 0, 0, 1036, 1032, 0, 1081, 0, 148,
-	263, 277, 157, 254, 290, 162, 261, 153, 228, 250,
-	0, 1172, 150, 275, 260, 210, 193, 194, 149, 0,
-	245, 172, 185, 169, 226, 0, 1129, 302, 168, 293,
-	1035, 285, 152, 1167, 284, 225, 272, 276, 211, 205,
-	151, 274, 209, 204, 197, 176, 189, 237, 203, 238,
-	190, 215, 214, 216, 1151, 1152, 1153, 1154, 1155, 1163,
-	1164, 0, 1168, 1169, 1170, 1040, 0, 1060, 1108, 0,
-	1024, 1116, 1124, 1078, 287, 1126, 1075, 1074, 1158, 0,
-	1157, 262, 1159, 1160, 198, 1122, 1056, 1065, 303, 1063,
-	248, 231, 1128, 1095, 1171, 246, 201, 273, 239, 278,
-	264, 286, 242, 240, 144, 265, 171, 212, 154, 155,
-	167, 173, 175, 177, 178, 221, 222, 234, 253, 266,
-	267, 268, 170, 163, 247, 164, 187, 165, 145, 255,
-	166, 146, 235, 271, 1156, 183, 243, 208, 147, 207,
-	236, 270, 269, 294, 300, 301, 305, 0, 306, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1165, 0, 1166, 299, 181, 142, 282, 0, 227, 1119,
-	1029, 1039, 1037, 1072, 1097, 1098, 223, 298, 1112, 1115,
-	1113, 1141, 251, 0, 0, 0, 0, 0, 192, 233,
-	0, 252, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1030, 0, 259, 280, 292, 1174, 1175, 1176,
-	1177, 0, 1178, 1179, 1180, 1181, 1182, 1183, 1184, 283,
-	1073, 1047, 1084, 291, 1050, 1048, 1111, 1049, 1101, 1143,
-	217, 218, 219, 220, 184, 0, 161, 1093, 1077, 1144,
-	1145, 1146, 1147, 1148, 1149, 1150, 1052, 304, 180, 186,
-	0, 188, 160, 232, 182, 289, 195, 1117, 224, 191,
-	256, 196, 202, 244, 288, 230, 249, 158, 279, 257,
-	206, 1046, 1051, 1045, 1090, 1091, 1135, 1136, 1137, 1109,
-	1038, 1120, 1042, 1044, 1043, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1114, 0, 1094, 143, 0, 200,
-	1142, 241, 179, 718, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 229, 0, 0, 0, 0, 0, 0,
-	0, 688, 0, 0, 0, 174, 0, 0, 199, 0,
-	0, 0, 258, 213, 0, 0, 0, 0, 733, 739,
-	1161, 1162, 295, 296, 297, 281, 0, 0, 0, 681,
-	0, 0, 0, 631, 725, 724, 698, 707, 0, 0,
-	156, 699, 0, 706, 700, 704, 703, 701, 702, 0,
-	668, 0, 0, 0, 0, 0, 0, 628, 685, 0,
-	689, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 682, 683, 0, 0, 0, 0, 719, 0, 684,
-	0, 0, 721, 0, 708, 0, 148, 263, 277, 157,
-	254, 290, 162, 261, 153, 228, 250, 0, 0, 150,
-	275, 260, 210, 193, 194, 149, 0, 245, 172, 185,
-	169, 226, 705, 717, 674, 168, 672, 716, 285, 152,
-	0, 284, 225, 272, 276, 211, 205, 151, 274, 209,
-	204, 197, 176, 189, 237, 203, 238, 190, 215, 214,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 714, 0,
-	0, 287, 0, 0, 732, 0, 0, 0, 262, 0,
-	0, 198, 0, 0, 0, 675, 0, 248, 231, 742,
-	629, 0, 246, 201, 273, 239, 278, 264, 286, 242,
-	240, 144, 265, 171, 212, 154, 155, 167, 173, 175,
-	177, 178, 221, 222, 234, 253, 266, 267, 268, 170,
-	163, 247, 164, 187, 165, 145, 255, 166, 146, 235,
-	271, 0, 183, 243, 208, 147, 207, 236, 270, 269,
-	294, 300, 301, 305, 0, 306, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1377, 1376, 1378,
-	299, 181, 142, 282, 730, 227, 741, 726, 727, 728,
-	731, 734, 735, 670, 673, 736, 738, 740, 743, 251,
-	0, 0, 0, 0, 0, 192, 233, 0, 252, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 259, 280, 292, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 671, 0, 0, 0,
-	291, 0, 0, 0, 0, 0, 720, 217, 218, 219,
-	220, 669, 0, 161, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 304, 180, 186, 0, 188, 160,
-	232, 182, 289, 195, 0, 224, 191, 256, 196, 202,
-	244, 288, 230, 249, 158, 279, 257, 206, 749, 729,
-	748, 750, 751, 747, 752, 753, 737, 690, 0, 745,
-	744, 746, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 143, 0, 200, 0, 241, 179,
-	633, 634, 635, 636, 637, 638, 639, 640, 641, 642,
-	643, 644, 645, 646, 647, 121, 648, 649, 650, 651,
-	652, 653, 654, 655, 656, 657, 658, 659, 660, 661,
-	662, 663, 664, 665, 666, 667, 723, 0, 0, 295,
-	296, 297, 281, 97, 0, 718, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 229, 0, 0, 0, 0,
-	0, 0, 0, 688, 0, 0, 0, 174, 0, 0,
-	199, 0, 0, 0, 258, 213, 0, 0, 0, 0,
-	733, 739, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 681, 0, 0, 0, 631, 725, 724, 698, 707,
-	0, 0, 156, 699, 0, 706, 700, 704, 703, 701,
-	702, 0, 668, 0, 0, 0, 0, 0, 0, 628,
-	685, 0, 689, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 682, 683, 0, 0, 0, 0, 719,
-	0, 684, 0, 0, 721, 0, 708, 0, 148, 263,
-	277, 157, 254, 290, 162, 261, 153, 228, 250, 0,
-	0, 150, 275, 260, 210, 193, 194, 149, 0, 245,
-	172, 185, 169, 226, 705, 717, 674, 168, 672, 716,
-	285, 152, 0, 284, 225, 272, 276, 211, 205, 151,
-	274, 209, 204, 197, 176, 189, 237, 203, 238, 190,
-	215, 214, 216, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	714, 0, 0, 287, 0, 0, 732, 0, 0, 0,
-	262, 0, 0, 198, 0, 0, 0, 675, 0, 248,
-	231, 742, 629, 0, 246, 201, 273, 239, 278, 264,
-	286, 242, 240, 144, 265, 171, 212, 154, 155, 167,
-	173, 175, 177, 178, 221, 222, 234, 253, 266, 267,
-	268, 170, 163, 247, 164, 187, 165, 145, 255, 166,
-	146, 235, 271, 0, 183, 243, 208, 147, 207, 236,
-	270, 269, 294, 300, 301, 305, 0, 306, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 299, 181, 142, 282, 730, 227, 741, 726,
-	727, 728, 731, 734, 735, 670, 673, 736, 738, 740,
-	743, 251, 0, 0, 0, 0, 0, 192, 233, 0,
-	252, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 259, 280, 292, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 671, 0,
-	0, 0, 291, 0, 0, 0, 0, 0, 720, 217,
-	218, 219, 220, 669, 0, 161, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 304, 180, 186, 0,
-	188, 160, 232, 182, 289, 195, 0, 224, 191, 256,
-	196, 202, 244, 288, 230, 249, 158, 279, 257, 206,
-	749, 729, 748, 750, 751, 747, 752, 753, 737, 690,
-	0, 745, 744, 746, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 143, 0, 200, 70,
-	241, 179, 633, 634, 635, 636, 637, 638, 639, 640,
-	641, 642, 643, 644, 645, 646, 647, 121, 648, 649,
-	650, 651, 652, 653, 654, 655, 656, 657, 658, 659,
-	660, 661, 662, 663, 664, 665, 666, 667, 723, 718,
-	0, 295, 296, 297, 281, 0, 0, 0, 0, 229,
-	0, 0, 0, 0, 0, 0, 0, 688, 0, 0,
-	0, 174, 890, 0, 199, 0, 0, 0, 258, 213,
-	0, 0, 0, 0, 733, 739, 0, 0, 0, 0,
-	0, 0, 886, 0, 0, 681, 0, 0, 0, 631,
-	725, 724, 698, 707, 0, 0, 156, 699, 0, 706,
-	700, 704, 703, 701, 702, 0, 668, 0, 0, 0,
-	0, 0, 0, 628, 685, 0, 689, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 682, 683, 0,
-	0, 0, 0, 719, 0, 684, 0, 0, 887, 0,
-	708, 0, 148, 263, 277, 157, 254, 290, 162, 261,
-	153, 228, 250, 0, 0, 150, 275, 260, 210, 193,
-	194, 149, 0, 245, 172, 185, 169, 226, 705, 717,
-	674, 168, 672, 716, 285, 152, 0, 284, 225, 272,
-	276, 211, 205, 151, 274, 209, 204, 197, 176, 189,
-	237, 203, 238, 190, 215, 214, 216, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 714, 0, 0, 287, 0, 0,
-	732, 0, 0, 0, 262, 0, 0, 198, 0, 0,
-	0, 675, 0, 248, 231, 742, 629, 0, 246, 201,
-	273, 239, 278, 264, 286, 242, 240, 144, 265, 171,
-	212, 154, 155, 167, 173, 175, 177, 178, 221, 222,
-	234, 253, 266, 267, 268, 170, 163, 247, 164, 187,
-	165, 145, 255, 166, 146, 235, 271, 0, 183, 243,
-	208, 147, 207, 236, 270, 269, 294, 300, 301, 305,
-	0, 306, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 299, 181, 142, 282,
-	730, 227, 741, 726, 727, 728, 731, 734, 735, 670,
-	673, 736, 738, 740, 743, 251, 0, 0, 0, 0,
-	0, 192, 233, 0, 252, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 259, 280, 292,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 671, 0, 0, 0, 291, 0, 0, 0,
-	0, 0, 720, 217, 218, 219, 220, 669, 0, 161,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	304, 180, 186, 0, 188, 160, 232, 182, 289, 195,
-	0, 224, 191, 256, 196, 202, 244, 288, 230, 249,
-	158, 279, 257, 206, 749, 729, 748, 750, 751, 747,
-	752, 753, 737, 690, 0, 745, 744, 746, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	143, 0, 200, 0, 241, 179, 633, 634, 635, 636,
-	637, 638, 639, 640, 641, 642, 643, 644, 645, 646,
-	647, 121, 648, 649, 650, 651, 652, 653, 654, 655,
-	656, 657, 658, 659, 660, 661, 662, 663, 664, 665,
-	666, 667, 723, 718, 0, 295, 296, 297, 281, 0,
-	0, 0, 0, 229, 0, 0, 0, 0, 0, 0,
-	0, 688, 0, 0, 0, 174, 2323, 0, 199, 0,
-	0, 0, 258, 213, 0, 0, 0, 0, 733, 739,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 681,
-	0, 0, 0, 631, 725, 724, 698, 707, 0, 0,
-	156, 699, 0, 706, 700, 704, 703, 701, 702, 0,
-	668, 0, 0, 0, 0, 0, 0, 628, 685, 0,
-	689, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 682, 683, 0, 0, 0, 0, 719, 0, 684,
-	0, 0, 721, 0, 708, 0, 148, 263, 277, 157,
-	254, 290, 162, 261, 153, 228, 250, 0, 0, 150,
-	275, 260, 210, 193, 194, 149, 0, 245, 172, 185,
-	169, 226, 705, 717, 674, 168, 672, 716, 285, 152,
-	0, 284, 225, 272, 276, 211, 205, 151, 274, 209,
-	204, 197, 176, 189, 237, 203, 238, 190, 215, 214,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 714, 0,
-	0, 287, 0, 0, 732, 0, 0, 0, 262, 0,
-	0, 198, 0, 0, 0, 675, 0, 248, 231, 742,
-	629, 0, 246, 201, 273, 239, 278, 264, 286, 242,
-	240, 144, 265, 171, 212, 154, 155, 167, 173, 175,
-	177, 178, 221, 222, 234, 253, 266, 267, 268, 170,
-	163, 247, 164, 187, 165, 145, 255, 166, 146, 235,
-	271, 0, 183, 243, 208, 147, 207, 236, 270, 269,
-	294, 300, 301, 305, 0, 306, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	299, 181, 142, 282, 730, 227, 741, 726, 727, 728,
-	731, 734, 735, 670, 673, 736, 738, 740, 743, 251,
-	0, 0, 0, 0, 0, 192, 233, 0, 252, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 259, 280, 292, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 671, 0, 0, 0,
-	291, 0, 0, 0, 0, 0, 720, 217, 218, 219,
-	220, 669, 0, 161, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 304, 180, 186, 0, 188, 160,
-	232, 182, 289, 195, 0, 224, 191, 256, 196, 202,
-	244, 288, 230, 249, 158, 279, 257, 206, 749, 729,
-	748, 750, 751, 747, 752, 753, 737, 690, 0, 745,
-	744, 746, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 143, 0, 200, 0, 241, 179,
-	633, 634, 635, 636, 637, 638, 639, 640, 641, 642,
-	643, 644, 645, 646, 647, 121, 648, 649, 650, 651,
-	652, 653, 654, 655, 656, 657, 658, 659, 660, 661,
-	662, 663, 664, 665, 666, 667, 723, 718, 0, 295,
-	296, 297, 281, 0, 0, 0, 0, 229, 0, 0,
-	0, 0, 0, 0, 0, 688, 0, 0, 0, 174,
-	890, 0, 199, 0, 0, 0, 258, 213, 0, 0,
-	0, 0, 733, 739, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 681, 0, 0, 0, 631, 725, 724,
-	698, 707, 0, 0, 156, 699, 0, 706, 700, 704,
-	703, 701, 702, 0, 668, 0, 0, 0, 0, 0,
-	0, 628, 685, 0, 689, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 682, 683, 0, 0, 0,
-	0, 719, 0, 684, 0, 0, 721, 0, 708, 0,
-	148, 263, 277, 157, 254, 290, 162, 261, 153, 228,
-	250, 0, 0, 150, 275, 260, 210, 193, 194, 149,
-	0, 245, 172, 185, 169, 226, 705, 717, 674, 168,
-	672, 716, 285, 152, 0, 284, 225, 272, 276, 211,
-	205, 151, 274, 209, 204, 197, 176, 189, 237, 203,
-	238, 190, 215, 214, 216, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 714, 0, 0, 287, 0, 0, 732, 0,
-	0, 0, 262, 0, 0, 198, 0, 0, 0, 675,
-	0, 248, 231, 742, 629, 0, 246, 201, 273, 239,
-	278, 264, 286, 242, 240, 144, 265, 171, 212, 154,
-	155, 167, 173, 175, 177, 178, 221, 222, 234, 253,
-	266, 267, 268, 170, 163, 247, 164, 187, 165, 145,
-	255, 166, 146, 235, 271, 0, 183, 243, 208, 147,
-	207, 236, 270, 269, 294, 300, 301, 305, 0, 306,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 299, 181, 142, 282, 730, 227,
-	741, 726, 727, 728, 731, 734, 735, 670, 673, 736,
-	738, 740, 743, 251, 0, 0, 0, 0, 0, 192,
-	233, 0, 252, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 259, 280, 292, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	671, 0, 0, 0, 291, 0, 0, 0, 0, 0,
-	720, 217, 218, 219, 220, 669, 0, 161, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 304, 180,
-	186, 0, 188, 160, 232, 182, 289, 195, 0, 224,
-	191, 256, 196, 202, 244, 288, 230, 249, 158, 279,
-	257, 206, 749, 729, 748, 750, 751, 747, 752, 753,
-	737, 690, 0, 745, 744, 746, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 143, 0,
-	200, 0, 241, 179, 633, 634, 635, 636, 637, 638,
-	639, 640, 641, 642, 643, 644, 645, 646, 647, 121,
-	648, 649, 650, 651, 652, 653, 654, 655, 656, 657,
-	658, 659, 660, 661, 662, 663, 664, 665, 666, 667,
-	723, 0, 0, 295, 296, 297, 281, 718, 0, 0,
-	1558, 0, 0, 0, 0, 0, 0, 229, 0, 0,
-	0, 0, 0, 0, 0, 688, 0, 0, 0, 174,
-	0, 0, 199, 0, 0, 0, 258, 213, 0, 0,
-	0, 0, 733, 739, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 681, 0, 0, 0, 631, 725, 724,
-	698, 707, 0, 0, 156, 699, 0, 706, 700, 704,
-	703, 701, 702, 0, 668, 0, 0, 0, 0, 0,
-	0, 628, 685, 0, 689, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 682, 683, 0, 0, 0,
-	0, 719, 0, 684, 0, 0, 721, 0, 708, 0,
-	148, 263, 277, 157, 254, 290, 162, 261, 153, 228,
-	250, 0, 0, 150, 275, 260, 210, 193, 194, 149,
-	0, 245, 172, 185, 169, 226, 705, 717, 674, 168,
-	672, 716, 285, 152, 0, 284, 225, 272, 276, 211,
-	205, 151, 274, 209, 204, 197, 176, 189, 237, 203,
-	238, 190, 215, 214, 216, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 714, 0, 0, 287, 0, 0, 732, 0,
-	0, 0, 262, 0, 0, 198, 0, 0, 0, 675,
-	0, 248, 231, 742, 629, 0, 246, 201, 273, 239,
-	278, 264, 286, 242, 240, 144, 265, 171, 212, 154,
-	155, 167, 173, 175, 177, 178, 221, 222, 234, 253,
-	266, 267, 268, 170, 163, 247, 164, 187, 165, 145,
-	255, 166, 146, 235, 271, 0, 183, 243, 208, 147,
-	207, 236, 270, 269, 294, 300, 301, 305, 0, 306,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 299, 181, 142, 282, 730, 227,
-	741, 726, 727, 728, 731, 734, 735, 670, 673, 736,
-	738, 740, 743, 251, 0, 0, 0, 0, 0, 192,
-	233, 0, 252, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 259, 280, 292, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	671, 0, 0, 0, 291, 0, 0, 0, 0, 0,
-	720, 217, 218, 219, 220, 669, 0, 161, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 304, 180,
-	186, 0, 188, 160, 232, 182, 289, 195, 0, 224,
-	191, 256, 196, 202, 244, 288, 230, 249, 158, 279,
-	257, 206, 749, 729, 748, 750, 751, 747, 752, 753,
-	737, 690, 0, 745, 744, 746, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 143, 0,
-	200, 0, 241, 179, 633, 634, 635, 636, 637, 638,
-	639, 640, 641, 642, 643, 644, 645, 646, 647, 121,
-	648, 649, 650, 651, 652, 653, 654, 655, 656, 657,
-	658, 659, 660, 661, 662, 663, 664, 665, 666, 667,
-	723, 718, 0, 295, 296, 297, 281, 0, 0, 0,
-	0, 229, 0, 0, 0, 0, 0, 0, 0, 688,
-	0, 0, 0, 174, 0, 0, 199, 0, 0, 0,
-	258, 213, 0, 0, 0, 0, 733, 739, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 681, 0, 0,
-	0, 631, 725, 724, 698, 707, 0, 0, 156, 699,
-	0, 706, 700, 704, 703, 701, 702, 0, 668, 0,
-	0, 0, 0, 0, 0, 628, 685, 0, 689, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 682,
-	683, 625, 0, 0, 0, 719, 0, 684, 0, 0,
-	721, 0, 708, 0, 148, 263, 277, 157, 254, 290,
-	162, 261, 153, 228, 250, 0, 0, 150, 275, 260,
-	210, 193, 194, 149, 0, 245, 172, 185, 169, 226,
-	705, 717, 674, 168, 672, 716, 285, 152, 0, 284,
-	225, 272, 276, 211, 205, 151, 274, 209, 204, 197,
-	176, 189, 237, 203, 238, 190, 215, 214, 216, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 714, 0, 0, 287,
-	0, 0, 732, 0, 0, 0, 262, 0, 0, 198,
-	0, 0, 0, 675, 0, 248, 231, 742, 629, 0,
-	246, 201, 273, 239, 278, 264, 286, 242, 240, 144,
-	265, 171, 212, 154, 155, 167, 173, 175, 177, 178,
-	221, 222, 234, 253, 266, 267, 268, 170, 163, 247,
-	164, 187, 165, 145, 255, 166, 146, 235, 271, 0,
-	183, 243, 208, 147, 207, 236, 270, 269, 294, 300,
-	301, 305, 0, 306, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 299, 181,
-	142, 282, 730, 227, 741, 726, 727, 728, 731, 734,
-	735, 670, 673, 736, 738, 740, 743, 251, 0, 0,
-	0, 0, 0, 192, 233, 0, 252, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 259,
-	280, 292, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 671, 0, 0, 0, 291, 0,
-	0, 0, 0, 0, 720, 217, 218, 219, 220, 669,
-	0, 161, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 304, 180, 186, 0, 188, 160, 232, 182,
-	289, 195, 0, 224, 191, 256, 196, 202, 244, 288,
-	230, 249, 158, 279, 257, 206, 749, 729, 748, 750,
-	751, 747, 752, 753, 737, 690, 0, 745, 744, 746,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 143, 0, 200, 0, 241, 179, 633, 634,
-	635, 636, 637, 638, 639, 640, 641, 642, 643, 644,
-	645, 646, 647, 121, 648, 649, 650, 651, 652, 653,
-	654, 655, 656, 657, 658, 659, 660, 661, 662, 663,
-	664, 665, 666, 667, 723, 718, 0, 295, 296, 297,
-	281, 0, 0, 0, 0, 229, 0, 0, 0, 0,
-	0, 0, 0, 688, 0, 0, 0, 174, 0, 0,
-	199, 0, 0, 0, 258, 213, 0, 0, 0, 0,
-	733, 739, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 681, 0, 0, 0, 631, 725, 724, 698, 707,
-	0, 0, 156, 699, 0, 706, 700, 704, 703, 701,
-	702, 0, 668, 0, 0, 0, 0, 0, 0, 628,
-	685, 0, 689, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 682, 683, 0, 0, 0, 0, 719,
-	0, 684, 0, 0, 721, 0, 708, 0, 148, 263,
-	277, 157, 254, 290, 162, 261, 153, 228, 250, 0,
-	0, 150, 275, 260, 210, 193, 194, 149, 0, 245,
-	172, 185, 169, 226, 705, 717, 674, 168, 672, 716,
-	285, 152, 0, 284, 225, 272, 276, 211, 205, 151,
-	274, 209, 204, 197, 176, 189, 237, 203, 238, 190,
-	215, 214, 216, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	714, 0, 0, 287, 0, 0, 732, 0, 0, 0,
-	262, 0, 0, 198, 0, 0, 0, 675, 0, 248,
-	231, 742, 629, 0, 246, 201, 273, 239, 278, 264,
-	286, 242, 240, 144, 265, 171, 212, 154, 155, 167,
-	173, 175, 177, 178, 221, 222, 234, 253, 266, 267,
-	268, 170, 163, 247, 164, 187, 165, 145, 255, 166,
-	146, 235, 271, 0, 183, 243, 208, 147, 207, 236,
-	270, 269, 294, 300, 301, 305, 0, 306, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 299, 181, 142, 282, 730, 227, 741, 726,
-	727, 728, 731, 734, 735, 670, 673, 736, 738, 740,
-	743, 251, 0, 0, 0, 0, 0, 192, 233, 0,
-	252, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 259, 280, 292, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 671, 0,
-	0, 0, 291, 0, 0, 0, 0, 0, 720, 217,
-	218, 219, 220, 669, 0, 161, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 304, 180, 186, 0,
-	188, 160, 232, 182, 289, 195, 0, 224, 191, 256,
-	196, 202, 244, 288, 230, 249, 158, 279, 257, 206,
-	749, 729, 748, 750, 751, 747, 752, 753, 737, 690,
-	0, 745, 744, 746, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 143, 0, 200, 0,
-	241, 179, 633, 634, 635, 636, 637, 638, 639, 640,
-	641, 642, 643, 644, 645, 646, 647, 121, 648, 649,
-	650, 651, 652, 653, 654, 655, 656, 657, 658, 659,
-	660, 661, 662, 663, 664, 665, 666, 667, 723, 718,
-	0, 295, 296, 297, 281, 0, 0, 0, 0, 229,
-	0, 0, 0, 1321, 0, 0, 0, 688, 0, 0,
-	0, 174, 0, 0, 199, 0, 0, 0, 258, 213,
-	0, 0, 0, 0, 733, 739, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 681, 0, 0, 0, 631,
-	725, 724, 698, 707, 0, 0, 156, 699, 0, 706,
-	700, 704, 703, 701, 702, 0, 668, 0, 0, 0,
-	0, 0, 0, 0, 685, 0, 689, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 682, 683, 0,
-	0, 0, 0, 719, 0, 684, 0, 0, 721, 0,
-	708, 0, 148, 263, 277, 157, 254, 290, 162, 261,
-	153, 228, 250, 0, 0, 150, 275, 260, 210, 193,
-	194, 149, 0, 245, 172, 185, 169, 226, 705, 717,
-	674, 168, 672, 716, 285, 152, 0, 284, 225, 272,
-	276, 211, 205, 151, 274, 209, 204, 197, 176, 189,
-	237, 203, 238, 190, 215, 214, 216, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 714, 0, 0, 287, 0, 0,
-	732, 0, 0, 0, 262, 0, 0, 198, 0, 0,
-	0, 675, 0, 248, 231, 742, 0, 0, 246, 201,
-	273, 239, 278, 264, 286, 242, 240, 144, 265, 171,
-	212, 154, 155, 167, 173, 175, 177, 178, 221, 222,
-	234, 253, 266, 267, 268, 170, 163, 247, 164, 187,
-	165, 145, 255, 166, 146, 235, 271, 0, 183, 243,
-	208, 147, 207, 236, 270, 269, 294, 1322, 1323, 305,
-	0, 306, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 299, 181, 142, 282,
-	730, 227, 741, 726, 727, 728, 731, 734, 735, 670,
-	673, 736, 738, 740, 743, 251, 0, 0, 0, 0,
-	0, 192, 233, 0, 252, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 259, 280, 292,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 671, 0, 0, 0, 291, 0, 0, 0,
-	0, 0, 720, 217, 218, 219, 220, 669, 0, 161,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	304, 180, 186, 0, 188, 160, 232, 182, 289, 195,
-	0, 224, 191, 256, 196, 202, 244, 288, 230, 249,
-	158, 279, 257, 206, 749, 729, 748, 750, 751, 747,
-	752, 753, 737, 690, 0, 745, 744, 746, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	143, 0, 200, 0, 241, 179, 633, 634, 635, 636,
-	637, 638, 639, 640, 641, 642, 643, 644, 645, 646,
-	647, 121, 648, 649, 650, 651, 652, 653, 654, 655,
-	656, 657, 658, 659, 660, 661, 662, 663, 664, 665,
-	666, 667, 723, 718, 0, 295, 296, 297, 281, 0,
-	0, 0, 0, 229, 0, 0, 0, 0, 0, 0,
-	0, 688, 0, 0, 0, 174, 0, 0, 199, 0,
-	0, 0, 258, 213, 0, 0, 0, 0, 733, 739,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 631, 725, 724, 698, 707, 0, 0,
-	156, 699, 0, 706, 700, 704, 703, 701, 702, 0,
-	668, 0, 0, 0, 0, 0, 0, 628, 685, 0,
-	689, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 682, 683, 0, 0, 0, 0, 719, 0, 684,
-	0, 0, 721, 0, 708, 0, 148, 263, 277, 157,
-	254, 290, 162, 261, 153, 228, 250, 0, 0, 150,
-	275, 260, 210, 193, 194, 149, 0, 245, 172, 185,
-	169, 226, 705, 717, 674, 168, 672, 716, 285, 152,
-	0, 284, 225, 272, 276, 211, 205, 151, 274, 209,
-	204, 197, 176, 189, 237, 203, 238, 190, 215, 214,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 714, 0,
-	0, 287, 0, 0, 732, 0, 0, 0, 262, 0,
-	0, 198, 0, 0, 0, 675, 0, 248, 231, 742,
-	629, 0, 246, 201, 273, 239, 278, 264, 286, 242,
-	240, 144, 265, 171, 212, 154, 155, 167, 173, 175,
-	177, 178, 221, 222, 234, 253, 266, 267, 268, 170,
-	163, 247, 164, 187, 165, 145, 255, 166, 146, 235,
-	271, 0, 183, 243, 208, 147, 207, 236, 270, 269,
-	294, 300, 301, 305, 0, 306, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	299, 181, 142, 282, 730, 227, 741, 726, 727, 728,
-	731, 734, 735, 670, 673, 736, 738, 740, 743, 251,
-	0, 0, 0, 0, 0, 192, 233, 0, 252, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 259, 280, 292, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 671, 0, 0, 0,
-	291, 0, 0, 0, 0, 0, 720, 217, 218, 219,
-	220, 669, 0, 161, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 304, 180, 186, 0, 188, 160,
-	232, 182, 289, 195, 0, 224, 191, 256, 196, 202,
-	244, 288, 230, 249, 158, 279, 257, 206, 749, 729,
-	748, 750, 751, 747, 752, 753, 737, 690, 0, 745,
-	744, 746, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 143, 0, 200, 0, 241, 179,
-	633, 634, 635, 636, 637, 638, 639, 640, 641, 642,
-	643, 644, 645, 646, 647, 121, 648, 649, 650, 651,
-	652, 653, 654, 655, 656, 657, 658, 659, 660, 661,
-	662, 663, 664, 665, 666, 667, 723, 718, 0, 295,
-	296, 297, 281, 0, 0, 0, 0, 229, 0, 0,
-	0, 0, 0, 0, 0, 688, 0, 0, 0, 174,
-	0, 0, 199, 0, 0, 0, 258, 213, 0, 0,
-	0, 0, 733, 739, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 681, 0, 0, 0, 631, 725, 724,
-	698, 707, 0, 0, 156, 699, 0, 706, 700, 704,
-	703, 701, 702, 0, 668, 0, 0, 0, 0, 0,
-	0, 0, 685, 0, 689, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 682, 683, 0, 0, 0,
-	0, 719, 0, 684, 0, 0, 721, 0, 708, 0,
-	148, 263, 277, 157, 254, 290, 162, 261, 153, 228,
-	250, 0, 0, 150, 275, 260, 210, 193, 194, 149,
-	0, 245, 172, 185, 169, 226, 705, 717, 674, 168,
-	672, 716, 285, 152, 0, 284, 225, 272, 276, 211,
-	205, 151, 274, 209, 204, 197, 176, 189, 237, 203,
-	238, 190, 215, 214, 216, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 714, 0, 0, 287, 0, 0, 732, 0,
-	0, 0, 262, 0, 0, 198, 0, 0, 0, 675,
-	0, 248, 231, 742, 0, 0, 246, 201, 273, 239,
-	278, 264, 286, 242, 240, 144, 265, 171, 212, 154,
-	155, 167, 173, 175, 177, 178, 221, 222, 234, 253,
-	266, 267, 268, 170, 163, 247, 164, 187, 165, 145,
-	255, 166, 146, 235, 271, 0, 183, 243, 208, 147,
-	207, 236, 270, 269, 294, 300, 301, 305, 0, 306,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 299, 181, 142, 282, 730, 227,
-	741, 726, 727, 728, 731, 734, 735, 670, 673, 736,
-	738, 740, 743, 251, 0, 0, 0, 0, 0, 192,
-	233, 0, 252, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 259, 280, 292, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	671, 0, 0, 0, 291, 0, 0, 0, 0, 0,
-	720, 217, 218, 219, 220, 669, 0, 161, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 304, 180,
-	186, 0, 188, 160, 232, 182, 289, 195, 0, 224,
-	191, 256, 196, 202, 244, 288, 230, 249, 158, 279,
-	257, 206, 749, 729, 748, 750, 751, 747, 752, 753,
-	737, 690, 0, 745, 744, 746, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 143, 0,
-	200, 0, 241, 179, 633, 634, 635, 636, 637, 638,
-	639, 640, 641, 642, 643, 644, 645, 646, 647, 121,
-	648, 649, 650, 651, 652, 653, 654, 655, 656, 657,
-	658, 659, 660, 661, 662, 663, 664, 665, 666, 667,
-	723, 0, 0, 295, 296, 297, 281, 97, 0, 27,
-	87, 69, 0, 0, 0, 0, 0, 0, 0, 229,
-	313, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 174, 0, 0, 199, 0, 0, 0, 258, 213,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 318, 0, 0, 0, 103,
-	0, 0, 0, 0, 0, 0, 156, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 159, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 350, 0, 349, 353, 345, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 341, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 360, 0,
-	0, 0, 148, 263, 277, 157, 254, 290, 162, 261,
-	153, 228, 250, 0, 0, 150, 275, 260, 210, 193,
-	194, 149, 0, 245, 172, 185, 169, 226, 0, 0,
-	302, 168, 293, 0, 285, 152, 0, 284, 225, 272,
-	276, 211, 205, 151, 274, 209, 204, 197, 176, 189,
-	237, 203, 238, 190, 215, 214, 216, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 317, 0, 0, 0, 0, 287, 0, 0,
-	0, 0, 0, 0, 262, 0, 0, 198, 0, 0,
-	0, 303, 0, 248, 231, 0, 0, 0, 246, 201,
-	273, 239, 278, 264, 286, 242, 240, 144, 265, 171,
-	212, 154, 155, 167, 173, 175, 177, 178, 221, 222,
-	234, 253, 266, 267, 268, 170, 163, 247, 164, 187,
-	165, 145, 255, 166, 146, 235, 271, 0, 183, 243,
-	208, 147, 207, 236, 270, 269, 294, 300, 301, 305,
-	0, 306, 0, 343, 342, 346, 0, 0, 0, 0,
-	0, 348, 0, 0, 0, 0, 299, 181, 142, 282,
-	0, 227, 0, 352, 0, 0, 0, 0, 0, 223,
-	298, 0, 0, 0, 0, 251, 0, 344, 0, 0,
-	0, 192, 233, 0, 252, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 259, 280, 292,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 283, 0, 0, 0, 291, 0, 0, 0,
-	0, 0, 0, 217, 218, 219, 220, 314, 316, 161,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	304, 180, 186, 0, 188, 160, 232, 182, 289, 195,
-	0, 224, 191, 256, 196, 202, 244, 288, 230, 249,
-	158, 279, 257, 206, 347, 351, 354, 0, 355, 356,
-	0, 0, 357, 358, 359, 0, 0, 361, 362, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	143, 0, 200, 70, 241, 179, 106, 107, 108, 109,
-	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
-	120, 121, 122, 123, 124, 125, 126, 127, 128, 129,
-	130, 131, 132, 133, 134, 135, 136, 137, 138, 139,
-	140, 141, 229, 0, 0, 295, 296, 297, 281, 0,
-	0, 0, 0, 0, 174, 0, 0, 199, 0, 0,
-	0, 258, 213, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 103, 0, 0, 0, 0, 0, 0, 156,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 159,
-	1640, 1643, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 148, 263, 277, 157, 254,
-	290, 162, 261, 153, 228, 250, 0, 0, 150, 275,
-	260, 210, 193, 194, 149, 0, 245, 172, 185, 169,
-	226, 0, 0, 302, 168, 293, 0, 285, 152, 0,
-	284, 225, 272, 276, 211, 205, 151, 274, 209, 204,
-	197, 176, 189, 237, 203, 238, 190, 215, 214, 216,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1644,
-	287, 0, 0, 0, 1637, 0, 1636, 262, 1638, 1641,
-	198, 0, 0, 0, 303, 0, 248, 231, 0, 0,
-	0, 246, 201, 273, 239, 278, 264, 286, 242, 240,
-	144, 265, 171, 212, 154, 155, 167, 173, 175, 177,
-	178, 221, 222, 234, 253, 266, 267, 268, 170, 163,
-	247, 164, 187, 165, 145, 255, 166, 146, 235, 271,
-	1642, 183, 243, 208, 147, 207, 236, 270, 269, 294,
-	300, 301, 305, 0, 306, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 299,
-	181, 142, 282, 0, 227, 0, 0, 0, 0, 0,
-	0, 0, 223, 298, 0, 0, 0, 0, 251, 0,
-	0, 0, 0, 0, 192, 233, 0, 252, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	259, 280, 292, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 283, 0, 0, 0, 291,
-	0, 0, 0, 0, 0, 0, 217, 218, 219, 220,
-	184, 0, 161, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 304, 180, 186, 0, 188, 160, 232,
-	182, 289, 195, 0, 224, 191, 256, 196, 202, 244,
-	288, 230, 249, 158, 279, 257, 206, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 143, 0, 200, 0, 241, 179, 106,
-	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
-	127, 128, 129, 130, 131, 132, 133, 134, 135, 136,
-	137, 138, 139, 140, 141, 229, 0, 0, 295, 296,
-	297, 281, 919, 0, 0, 0, 0, 174, 0, 0,
-	199, 0, 0, 0, 258, 213, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 103, 0, 0, 920, 0,
-	0, 0, 156, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 159, 0, 0, 915, 916, 917, 914, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 148, 263,
-	277, 157, 254, 290, 162, 261, 153, 228, 250, 0,
-	0, 150, 275, 260, 210, 193, 194, 149, 0, 245,
-	172, 185, 169, 226, 0, 0, 302, 168, 293, 0,
-	285, 152, 0, 284, 225, 272, 276, 211, 205, 151,
-	274, 209, 204, 197, 176, 189, 237, 203, 238, 190,
-	215, 214, 216, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 287, 0, 0, 0, 0, 0, 0,
-	262, 0, 0, 198, 0, 0, 0, 303, 0, 248,
-	231, 0, 0, 0, 246, 201, 273, 239, 278, 264,
-	286, 242, 240, 144, 265, 171, 212, 154, 155, 167,
-	173, 175, 177, 178, 221, 222, 234, 253, 266, 267,
-	268, 170, 163, 247, 164, 187, 165, 145, 255, 166,
-	146, 235, 271, 0, 183, 243, 208, 147, 207, 236,
-	270, 269, 294, 300, 301, 305, 0, 306, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 299, 181, 142, 282, 0, 227, 0, 0,
-	0, 0, 0, 0, 0, 223, 298, 0, 0, 0,
-	0, 251, 0, 0, 0, 0, 0, 192, 233, 0,
-	252, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 259, 280, 292, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 283, 0,
-	0, 0, 291, 0, 0, 0, 0, 0, 0, 217,
-	218, 219, 220, 184, 0, 161, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 304, 180, 186, 0,
-	188, 160, 232, 182, 289, 195, 0, 224, 191, 256,
-	196, 202, 244, 288, 230, 249, 158, 279, 257, 206,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 143, 0, 200, 0,
-	241, 179, 106, 107, 108, 109, 110, 111, 112, 113,
-	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
-	124, 125, 126, 127, 128, 129, 130, 131, 132, 133,
-	134, 135, 136, 137, 138, 139, 140, 141, 229, 0,
-	0, 295, 296, 297, 281, 0, 0, 0, 0, 0,
-	174, 429, 0, 199, 0, 0, 0, 258, 213, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 103, 437,
-	438, 0, 0, 0, 0, 156, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 442, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 148, 263, 277, 157, 254, 290, 162, 261, 153,
-	228, 250, 0, 0, 150, 275, 260, 210, 193, 194,
-	149, 0, 245, 172, 185, 169, 226, 0, 0, 302,
-	168, 293, 410, 285, 152, 409, 284, 225, 272, 276,
-	211, 205, 151, 274, 209, 204, 197, 176, 189, 237,
-	203, 238, 190, 215, 214, 216, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 287, 0, 0, 0,
-	0, 0, 0, 262, 0, 0, 198, 0, 0, 0,
-	303, 0, 248, 231, 0, 0, 0, 246, 201, 273,
-	239, 278, 264, 286, 428, 240, 144, 265, 171, 212,
-	154, 155, 167, 173, 175, 177, 178, 221, 222, 234,
-	253, 266, 267, 268, 170, 163, 247, 164, 187, 165,
-	145, 255, 166, 146, 235, 271, 0, 183, 243, 208,
-	147, 207, 236, 270, 269, 294, 300, 301, 305, 0,
-	306, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 299, 181, 142, 282, 0,
-	227, 0, 0, 0, 0, 0, 0, 0, 223, 298,
-	0, 0, 0, 0, 251, 0, 0, 0, 0, 0,
-	192, 233, 0, 252, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 259, 280, 292, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 283, 0, 0, 0, 291, 0, 0, 0, 0,
-	0, 431, 217, 218, 219, 220, 184, 0, 161, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 304,
-	180, 186, 0, 188, 160, 232, 182, 289, 195, 0,
-	439, 434, 435, 196, 202, 244, 288, 230, 249, 158,
-	279, 257, 436, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 143,
-	0, 200, 0, 241, 179, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
-	131, 132, 133, 134, 135, 136, 137, 138, 139, 140,
-	141, 97, 0, 0, 295, 296, 297, 281, 0, 0,
-	0, 0, 0, 229, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 174, 0, 0, 199, 0,
-	0, 0, 258, 213, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 94,
-	0, 1007, 0, 103, 0, 0, 0, 0, 0, 0,
-	156, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	159, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 148, 263, 277, 157,
-	254, 290, 162, 261, 153, 228, 250, 0, 0, 150,
-	275, 260, 210, 193, 194, 149, 0, 245, 172, 185,
-	169, 226, 0, 0, 302, 168, 293, 0, 285, 152,
-	0, 284, 225, 272, 276, 211, 205, 151, 274, 209,
-	204, 197, 176, 189, 237, 203, 238, 190, 215, 214,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 287, 0, 0, 0, 0, 0, 0, 262, 0,
-	0, 198, 0, 0, 0, 303, 0, 248, 231, 0,
-	0, 0, 246, 201, 273, 239, 278, 264, 286, 242,
-	240, 144, 265, 171, 212, 154, 155, 167, 173, 175,
-	177, 178, 221, 222, 234, 253, 266, 267, 268, 170,
-	163, 247, 164, 187, 165, 145, 255, 166, 146, 235,
-	271, 0, 183, 243, 208, 147, 207, 236, 270, 269,
-	294, 300, 301, 305, 0, 306, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	299, 181, 142, 282, 0, 227, 0, 0, 0, 0,
-	0, 0, 0, 223, 298, 0, 0, 0, 0, 251,
-	0, 0, 0, 0, 0, 192, 233, 0, 252, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 259, 280, 292, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 283, 0, 0, 0,
-	291, 0, 0, 0, 0, 0, 0, 217, 218, 219,
-	220, 184, 0, 161, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 304, 180, 186, 0, 188, 160,
-	232, 182, 289, 195, 0, 224, 191, 256, 196, 202,
-	244, 288, 230, 249, 158, 279, 257, 206, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 143, 0, 200, 70, 241, 179,
-	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+	874, 1414, 2552, 2558, 2550, 2549, 2527, 2380, 730, 2155,
+	863, 732, 2468, 2515, 2446, 751, 1371, 2128, 2451, 2352,
+	2452, 1291, 2419, 2331, 2186, 2063, 2153, 2356, 965, 644,
+	111, 2071, 2154, 653, 2340, 349, 355, 2173, 355, 399,
+	114, 1367, 353, 24, 1957, 2061, 476, 2138, 2006, 901,
+	930, 1609, 866, 859, 899, 359, 2137, 1812, 2117, 1582,
+	1808, 2080, 950, 593, 1757, 2028, 1624, 678, 1366, 1967,
+	924, 1817, 1813, 576, 1573, 1986, 1960, 1072, 430, 1763,
+	110, 1877, 1972, 1262, 1267, 1693, 1413, 1866, 763, 68,
+	1828, 1326, 505, 1559, 1806, 1824, 1082, 1263, 1087, 1503,
+	1090, 1073, 477, 1489, 1402, 1081, 943, 741, 927, 481,
+	111, 1623, 1584, 1650, 1353, 925, 365, 1579, 1345, 3,
+	908, 341, 1325, 68, 352, 15, 67, 731, 876, 350,
+	6, 2092, 1320, 857, 1346, 862, 887, 1264, 1428, 670,
+	947, 432, 479, 595, 351, 5, 733, 1312, 342, 1310,
+	24, 484, 32, 909, 1415, 1429, 910, 970, 849, 1274,
+	507, 520, 1416, 345, 1369, 1004, 636, 884, 973, 856,
+	468, 1393, 561, 886, 916, 429, 620, 12, 398, 7,
+	367, 4, 654, 368, 2068, 2427, 32, 1746, 1282, 1271,
+	2065, 107, 483, 2285, 2182, 2070, 68, 871, 1075, 669,
+	354, 1935, 102, 106, 105, 29, 96, 76, 1561, 2146,
+	1545, 2399, 106, 580, 29, 96, 76, 1561, 469, 622,
+	2409, 539, 2034, 1537, 1279, 1268, 106, 504, 106, 427,
+	482, 558, 15, 1749, 106, 340, 437, 6, 357, 1581,
+	612, 814, 613, 106, 932, 933, 606, 607, 451, 1562,
+	912, 103, 5, 2435, 811, 1779, 1721, 865, 1562, 32,
+	103, 2433, 850, 556, 854, 552, 623, 2171, 106, 1949,
+	29, 96, 76, 2288, 813, 604, 103, 1449, 603, 606,
+	607, 2276, 103, 1580, 2455, 2456, 2423, 2424, 853, 2279,
+	2072, 103, 2174, 2175, 2176, 2177, 1950, 868, 1951, 1532,
+	514, 523, 1553, 1281, 944, 2355, 1943, 1995, 1275, 2151,
+	2002, 1311, 452, 1837, 2077, 543, 103, 2135, 1839, 1956,
+	1955, 1542, 1829, 834, 554, 555, 2258, 1740, 553, 542,
+	2261, 2437, 2148, 1833, 364, 2245, 1742, 453, 2341, 2342,
+	2343, 2345, 2344, 513, 1405, 1406, 1407, 845, 2465, 2447,
+	2448, 1766, 512, 1952, 2188, 1403, 1284, 355, 870, 111,
+	1834, 1835, 2408, 400, 396, 362, 1565, 356, 1786, 1790,
+	1792, 1794, 1796, 1797, 1799, 1836, 1803, 1800, 1801, 1802,
+	509, 511, 1781, 1782, 1783, 1784, 1764, 1765, 1787, 852,
+	1767, 454, 1768, 1769, 1770, 1771, 1772, 1773, 1774, 1775,
+	1776, 1778, 1777, 1785, 1656, 1406, 1407, 1587, 2543, 530,
+	2252, 1789, 1791, 1793, 1795, 1798, 2559, 2454, 628, 940,
+	523, 1831, 1595, 1596, 1597, 1598, 2477, 629, 2432, 430,
+	2484, 1564, 1445, 1566, 1442, 68, 68, 483, 1444, 1441,
+	1443, 1447, 1448, 1280, 2382, 1780, 1446, 2411, 2412, 2354,
+	75, 510, 104, 551, 393, 393, 614, 394, 394, 2378,
+	2379, 581, 2382, 605, 2537, 2244, 480, 2406, 395, 2518,
+	94, 477, 477, 477, 2213, 482, 648, 648, 851, 534,
+	2212, 578, 525, 524, 583, 584, 585, 2388, 587, 566,
+	2439, 2440, 2235, 1593, 632, 355, 673, 673, 32, 32,
+	2239, 550, 602, 601, 2560, 532, 650, 2554, 2528, 816,
+	516, 517, 2201, 506, 577, 1694, 363, 2274, 621, 1538,
+	1380, 1272, 397, 881, 2316, 582, 1821, 832, 559, 646,
+	646, 586, 1745, 588, 358, 424, 425, 426, 590, 648,
+	1643, 648, 513, 817, 531, 1376, 2030, 2029, 812, 2438,
+	2566, 864, 1378, 1377, 626, 624, 625, 528, 935, 936,
+	111, 1452, 1453, 1454, 1455, 1456, 1457, 1450, 1451, 656,
+	841, 518, 1375, 341, 934, 1404, 597, 111, 2519, 111,
+	456, 631, 563, 609, 610, 648, 457, 598, 894, 606,
+	607, 1620, 430, 398, 68, 900, 1751, 606, 607, 111,
+	1569, 525, 524, 890, 2410, 565, 1830, 68, 1840, 1832,
+	869, 945, 2259, 917, 917, 1269, 68, 1269, 111, 1269,
+	648, 111, 1743, 2525, 2500, 2353, 2553, 878, 539, 880,
+	1283, 2152, 1270, 2067, 477, 1655, 648, 915, 861, 2064,
+	642, 643, 2147, 1546, 2473, 1822, 77, 904, 2016, 905,
+	672, 672, 840, 959, 882, 77, 883, 837, 592, 913,
+	914, 648, 895, 964, 111, 111, 111, 111, 920, 77,
+	32, 77, 836, 982, 858, 608, 843, 77, 611, 32,
+	1586, 1788, 823, 818, 971, 655, 77, 340, 919, 639,
+	640, 641, 846, 967, 819, 668, 809, 533, 2240, 2241,
+	2516, 2517, 889, 1850, 480, 839, 2237, 838, 972, 835,
+	2236, 77, 2565, 1653, 966, 966, 968, 969, 855, 873,
+	888, 1653, 877, 860, 903, 1610, 1540, 1539, 1531, 1590,
+	1591, 1526, 443, 946, 1341, 1289, 872, 1044, 2317, 2319,
+	2320, 2321, 2318, 1589, 1258, 906, 907, 547, 985, 941,
+	820, 489, 488, 490, 652, 888, 526, 508, 2001, 911,
+	958, 1027, 443, 630, 939, 637, 896, 897, 1854, 1301,
+	1561, 538, 1737, 1601, 596, 548, 638, 1299, 963, 1751,
+	2512, 487, 1739, 956, 957, 1496, 921, 1652, 918, 1418,
+	1417, 951, 923, 922, 827, 828, 858, 951, 951, 1494,
+	1495, 1493, 599, 2207, 942, 458, 953, 954, 955, 445,
+	422, 1562, 444, 2499, 1079, 1079, 1084, 1583, 617, 618,
+	619, 497, 502, 503, 1042, 2392, 960, 961, 494, 986,
+	962, 1560, 1738, 635, 2283, 492, 2282, 1092, 2066, 445,
+	1818, 1821, 444, 1864, 1266, 900, 1747, 1528, 1382, 648,
+	1049, 659, 660, 661, 662, 663, 664, 665, 666, 667,
+	485, 1093, 482, 1300, 1045, 1046, 1047, 1048, 515, 1301,
+	1916, 1504, 1576, 1013, 545, 1423, 879, 1299, 491, 978,
+	831, 1561, 111, 111, 111, 2248, 546, 549, 830, 2247,
+	1043, 600, 460, 1602, 1265, 1990, 111, 1327, 1051, 1260,
+	1292, 1293, 483, 1067, 634, 1985, 463, 455, 544, 1504,
+	1078, 1699, 349, 68, 486, 981, 978, 1053, 2536, 2230,
+	1343, 2548, 1562, 2533, 2495, 2478, 971, 1297, 1298, 1302,
+	1303, 1347, 1306, 1308, 1309, 1061, 2364, 459, 2327, 2325,
+	482, 462, 461, 2333, 2323, 2313, 1323, 2311, 2310, 2309,
+	972, 979, 980, 981, 978, 1332, 1333, 1334, 2535, 648,
+	1822, 1449, 2149, 1300, 2306, 1815, 2300, 493, 1921, 1816,
+	1819, 1372, 2297, 2326, 2324, 673, 32, 111, 1071, 2322,
+	2312, 499, 500, 501, 1398, 1091, 1400, 1257, 1256, 1999,
+	1085, 2296, 1086, 1803, 1800, 1801, 1802, 2150, 2292, 1926,
+	2291, 1925, 1924, 1922, 1424, 1425, 1261, 979, 980, 981,
+	978, 2286, 1387, 1296, 2085, 1426, 1918, 2084, 2083, 1461,
+	1374, 1820, 2079, 1390, 2000, 1427, 1321, 1297, 1298, 1302,
+	1303, 1337, 2534, 1339, 1477, 1478, 1479, 1480, 1481, 1482,
+	1483, 1484, 1485, 1486, 1487, 1488, 2078, 1392, 1315, 1498,
+	1499, 1998, 1335, 1838, 1379, 1753, 1408, 1331, 1373, 1733,
+	1338, 821, 1923, 1825, 1513, 2464, 2443, 1336, 2487, 911,
+	1349, 1348, 1340, 393, 1515, 2332, 394, 1067, 1026, 1025,
+	1035, 1036, 1028, 1029, 1030, 1031, 1032, 1033, 1034, 1027,
+	979, 980, 981, 978, 1383, 1384, 1385, 1025, 1035, 1036,
+	1028, 1029, 1030, 1031, 1032, 1033, 1034, 1027, 951, 951,
+	951, 891, 892, 893, 1391, 2444, 1445, 2187, 1442, 2425,
+	2564, 1520, 1444, 1441, 1443, 1447, 1448, 2386, 2385, 672,
+	1446, 2372, 2371, 1394, 1395, 1396, 1397, 979, 980, 981,
+	978, 1507, 1509, 1510, 2314, 1491, 979, 980, 981, 978,
+	2307, 1514, 398, 1516, 2303, 1421, 2302, 2301, 2290, 2287,
+	2260, 1419, 1420, 401, 1422, 2509, 2232, 1497, 2185, 1464,
+	1458, 1459, 1460, 2183, 1462, 1463, 1474, 1475, 1469, 1470,
+	1471, 1472, 1473, 2180, 2179, 1476, 1030, 1031, 1032, 1033,
+	1034, 1027, 1506, 2178, 1927, 1928, 1035, 1036, 1028, 1029,
+	1030, 1031, 1032, 1033, 1034, 1027, 2164, 1505, 2081, 1508,
+	2009, 1026, 1025, 1035, 1036, 1028, 1029, 1030, 1031, 1032,
+	1033, 1034, 1027, 1997, 1518, 1996, 979, 980, 981, 978,
+	1993, 1517, 1713, 1714, 1430, 1431, 1432, 1433, 1434, 1435,
+	1436, 1437, 1438, 1439, 1440, 1452, 1453, 1454, 1455, 1456,
+	1457, 1450, 1451, 1947, 1946, 979, 980, 981, 978, 1533,
+	1028, 1029, 1030, 1031, 1032, 1033, 1034, 1027, 2054, 648,
+	1912, 648, 1936, 648, 1827, 1750, 1288, 1744, 513, 1663,
+	1901, 1646, 1551, 1702, 1550, 1549, 1701, 1547, 780, 779,
+	1556, 1026, 1025, 1035, 1036, 1028, 1029, 1030, 1031, 1032,
+	1033, 1034, 1027, 1519, 1286, 2053, 1574, 1063, 419, 979,
+	980, 981, 978, 1287, 648, 989, 990, 991, 992, 993,
+	994, 995, 987, 1024, 1543, 1606, 1023, 979, 980, 981,
+	978, 1612, 979, 980, 981, 978, 979, 980, 981, 978,
+	822, 560, 1617, 2541, 1842, 885, 1677, 979, 980, 981,
+	978, 513, 111, 111, 111, 111, 1544, 2497, 1708, 2393,
+	1625, 1659, 1707, 513, 111, 1640, 1659, 2572, 1600, 2376,
+	1575, 24, 1625, 1578, 2375, 1604, 1659, 2571, 2563, 2562,
+	2194, 648, 1277, 2544, 2190, 1613, 2540, 2539, 2189, 111,
+	111, 1676, 1563, 2163, 1567, 1568, 1570, 2059, 2396, 1536,
+	1277, 2531, 1541, 902, 858, 2055, 1534, 2052, 2041, 1372,
+	1641, 1277, 2530, 979, 980, 981, 978, 68, 1704, 1557,
+	979, 980, 981, 978, 1664, 2044, 421, 2475, 2474, 2039,
+	1558, 979, 980, 981, 978, 2027, 418, 417, 2026, 1648,
+	1649, 1619, 1554, 1555, 2010, 877, 1363, 1605, 1611, 888,
+	1592, 1321, 1599, 15, 2472, 2471, 1980, 412, 6, 1938,
+	1670, 1616, 1622, 1618, 1644, 1876, 1621, 1626, 1627, 1628,
+	1629, 1855, 1639, 5, 1638, 2359, 1637, 2263, 2462, 1849,
+	32, 1614, 1608, 1615, 398, 979, 980, 981, 978, 1647,
+	2271, 2263, 2457, 1755, 1688, 1711, 2270, 979, 980, 981,
+	978, 415, 1389, 2441, 1691, 1692, 409, 1709, 1654, 1706,
+	2269, 406, 979, 980, 981, 978, 2040, 1657, 979, 980,
+	981, 978, 2257, 2263, 2404, 1079, 1705, 1725, 1079, 2263,
+	2403, 1728, 979, 980, 981, 978, 410, 2165, 648, 979,
+	980, 981, 978, 902, 979, 980, 981, 978, 1731, 2263,
+	2402, 2263, 2401, 1703, 407, 2391, 2390, 1330, 2268, 979,
+	980, 981, 978, 2263, 2262, 1668, 1722, 401, 416, 2198,
+	2197, 1660, 1732, 1665, 1661, 1662, 2196, 2195, 513, 2192,
+	2193, 1042, 2192, 2191, 1658, 1720, 1863, 1811, 1659, 1910,
+	411, 1727, 1659, 1891, 1642, 111, 1512, 1689, 1659, 1667,
+	1511, 1491, 1698, 1724, 513, 1659, 1666, 1659, 111, 1327,
+	657, 1853, 68, 1811, 1671, 1672, 2057, 1674, 1675, 482,
+	1679, 1723, 1690, 1717, 1680, 1681, 1682, 1683, 1684, 1741,
+	1729, 1687, 1730, 1726, 1716, 1735, 1330, 1535, 979, 980,
+	981, 978, 1362, 420, 2037, 1843, 1530, 1529, 1524, 1523,
+	1330, 1329, 1607, 1880, 1752, 1736, 1696, 2036, 976, 1700,
+	1844, 1845, 1846, 1754, 1856, 1857, 979, 980, 981, 978,
+	2035, 848, 1712, 1862, 1277, 1276, 1363, 951, 1851, 979,
+	980, 981, 978, 951, 825, 824, 106, 1314, 1874, 96,
+	76, 2498, 979, 980, 981, 978, 1608, 1848, 1847, 1659,
+	1852, 1537, 974, 648, 1875, 402, 403, 404, 405, 648,
+	1893, 1313, 1521, 1861, 1859, 539, 1871, 1860, 401, 1932,
+	2032, 1981, 847, 536, 1906, 1933, 537, 537, 1888, 1939,
+	1756, 1715, 1929, 1686, 103, 648, 1685, 1710, 1931, 1363,
+	1882, 448, 979, 980, 981, 978, 111, 979, 980, 981,
+	978, 380, 1880, 379, 383, 375, 646, 1574, 2561, 658,
+	1858, 539, 646, 1914, 1527, 1501, 1389, 371, 1268, 1290,
+	1259, 591, 106, 633, 2511, 2505, 1911, 390, 1915, 2485,
+	2482, 1920, 2480, 443, 1026, 1025, 1035, 1036, 1028, 1029,
+	1030, 1031, 1032, 1033, 1034, 1027, 1944, 2395, 1937, 1940,
+	979, 980, 981, 978, 2373, 2363, 111, 2350, 2338, 2334,
+	2329, 1963, 1964, 1909, 1883, 1984, 1959, 2255, 1941, 2254,
+	103, 1942, 1913, 2253, 1892, 2250, 2246, 2243, 1896, 1971,
+	2228, 1953, 1899, 1900, 2169, 979, 980, 981, 978, 594,
+	1907, 1908, 1962, 1968, 1961, 648, 648, 1978, 2022, 2004,
+	111, 2013, 1969, 1970, 1917, 1905, 1979, 1991, 1973, 1930,
+	445, 1976, 1966, 444, 513, 1965, 1992, 1492, 1934, 103,
+	1603, 1577, 1522, 1625, 2005, 1381, 1982, 979, 980, 981,
+	978, 2025, 1328, 1305, 1974, 1983, 1977, 1070, 1987, 1372,
+	1987, 1988, 1069, 68, 1989, 1068, 442, 1066, 646, 2007,
+	1904, 2014, 2018, 1065, 446, 2015, 1064, 1062, 2017, 1005,
+	1059, 1058, 68, 1056, 1903, 1055, 1054, 2011, 1052, 2251,
+	2012, 1902, 979, 980, 981, 978, 1898, 2020, 1022, 1021,
+	2019, 1020, 1019, 373, 372, 376, 979, 980, 981, 978,
+	1897, 378, 1018, 979, 980, 981, 978, 1017, 979, 980,
+	981, 978, 1895, 382, 1016, 1015, 2031, 1014, 1012, 1011,
+	1010, 1009, 979, 980, 981, 978, 2045, 374, 1008, 2047,
+	1007, 1006, 1003, 1002, 979, 980, 981, 978, 1001, 1000,
+	2042, 999, 513, 2089, 1894, 998, 2139, 2141, 1886, 2139,
+	2139, 1811, 997, 2050, 2051, 2048, 2049, 996, 844, 2046,
+	815, 541, 2060, 1867, 1868, 513, 979, 980, 981, 978,
+	979, 980, 981, 978, 1885, 1317, 529, 2075, 2491, 951,
+	2489, 2547, 2021, 900, 2453, 2023, 1884, 1870, 2082, 1594,
+	1873, 1388, 540, 2024, 2140, 1500, 979, 980, 981, 978,
+	2136, 1872, 2114, 2086, 1631, 2142, 2143, 1630, 979, 980,
+	981, 978, 2161, 1525, 1322, 1548, 2038, 979, 980, 981,
+	978, 2159, 2160, 2158, 2144, 2015, 1636, 562, 1359, 1360,
+	377, 381, 384, 2043, 385, 386, 1634, 2162, 387, 388,
+	389, 1635, 1632, 391, 392, 2112, 1805, 1633, 1304, 1350,
+	535, 2203, 2335, 439, 951, 441, 451, 1292, 1293, 53,
+	438, 436, 435, 447, 440, 31, 449, 450, 30, 1355,
+	1358, 1359, 1360, 1356, 1322, 1357, 1361, 1355, 1358, 1359,
+	1360, 1356, 2199, 1357, 1361, 648, 1823, 1804, 1365, 2231,
+	898, 2416, 337, 1418, 1417, 616, 111, 2551, 338, 2206,
+	615, 339, 574, 575, 1255, 2141, 2145, 564, 2094, 2506,
+	2204, 2205, 431, 2208, 2209, 2210, 2211, 572, 573, 2214,
+	2215, 2216, 2217, 2218, 2219, 2220, 2221, 2222, 2223, 2224,
+	2225, 2226, 2227, 2233, 2136, 2229, 570, 571, 2007, 568,
+	569, 2374, 2507, 2368, 2366, 2361, 2249, 2294, 2281, 2280,
+	1695, 2278, 2256, 2184, 2074, 2166, 2167, 2073, 1879, 2267,
+	2264, 567, 2272, 401, 2266, 2295, 1878, 2058, 1651, 2168,
+	2277, 1026, 1025, 1035, 1036, 1028, 1029, 1030, 1031, 1032,
+	1033, 1034, 1027, 902, 2493, 2492, 2492, 2328, 1026, 1025,
+	1035, 1036, 1028, 1029, 1030, 1031, 1032, 1033, 1034, 1027,
+	1734, 1669, 2293, 1285, 527, 2493, 402, 403, 404, 405,
+	937, 513, 1364, 433, 513, 513, 513, 1372, 2308, 401,
+	37, 1, 1273, 1994, 513, 1841, 2298, 2299, 1826, 589,
+	423, 1465, 2304, 2305, 579, 829, 496, 2339, 522, 2098,
+	2347, 2348, 2349, 826, 521, 519, 2358, 2346, 68, 1502,
+	2102, 764, 1074, 1080, 2330, 2415, 2357, 2467, 2362, 648,
+	648, 2360, 2418, 2367, 842, 2369, 2370, 2365, 750, 2273,
+	2091, 2265, 1948, 2170, 2093, 2095, 2097, 2275, 2099, 2100,
+	2101, 2103, 2104, 2105, 2107, 2108, 2109, 2110, 2383, 2384,
+	111, 2172, 1748, 2062, 1278, 557, 1718, 1719, 777, 513,
+	776, 767, 1057, 2289, 769, 810, 498, 766, 2003, 1588,
+	408, 513, 646, 646, 495, 434, 2076, 2069, 2389, 1954,
+	1975, 1958, 2557, 2546, 2422, 2400, 2526, 2504, 2381, 2542,
+	2431, 2483, 2476, 2113, 2429, 2377, 2200, 2405, 369, 2421,
+	966, 2413, 938, 627, 466, 2351, 370, 2407, 2337, 413,
+	1316, 414, 1319, 2426, 1318, 1409, 988, 1490, 1060, 2430,
+	1050, 2336, 676, 1697, 2434, 2436, 740, 2111, 734, 1585,
+	2129, 1645, 36, 35, 34, 2442, 977, 2470, 1088, 765,
+	113, 1342, 1089, 2428, 2090, 2284, 2420, 749, 2469, 2458,
+	2459, 2460, 2461, 748, 747, 746, 2466, 745, 1354, 1352,
+	1351, 929, 2479, 928, 2481, 975, 2450, 2449, 2397, 2398,
+	2181, 2106, 2242, 2315, 2238, 2234, 2387, 2088, 2096, 2087,
+	2115, 2116, 2122, 1762, 2486, 1758, 1760, 1761, 2463, 1759,
+	1919, 2490, 2488, 2422, 2502, 1887, 2503, 1809, 1810, 1807,
+	2494, 513, 2496, 513, 1869, 1865, 1076, 1083, 2421, 2394,
+	864, 2508, 864, 2510, 2513, 2501, 2033, 875, 108, 2470,
+	926, 2524, 2514, 1945, 867, 2157, 1552, 2520, 11, 10,
+	2469, 2521, 513, 1038, 2529, 1041, 2414, 833, 9, 23,
+	22, 864, 2532, 14, 21, 20, 2538, 19, 61, 1039,
+	1040, 1037, 60, 1026, 1025, 1035, 1036, 1028, 1029, 1030,
+	1031, 1032, 1033, 1034, 1027, 59, 58, 2545, 18, 8,
+	2445, 57, 56, 2556, 55, 17, 2555, 16, 49, 50,
+	47, 46, 45, 2567, 44, 43, 42, 2568, 2570, 2569,
+	41, 48, 2556, 1200, 1243, 40, 39, 1188, 38, 1150,
+	1202, 1124, 1139, 1210, 1140, 1141, 1175, 1103, 1159, 257,
+	1137, 1572, 1191, 1095, 1127, 1128, 1097, 1134, 1098, 1125,
+	1152, 189, 1123, 1162, 215, 1208, 1571, 1344, 287, 241,
+	1295, 1294, 1155, 1193, 1157, 1180, 1149, 1176, 1111, 1169,
+	1203, 1138, 1173, 1204, 51, 74, 73, 72, 71, 891,
+	892, 893, 70, 69, 25, 26, 171, 27, 28, 86,
+	85, 87, 1172, 1197, 1136, 83, 174, 1201, 1156, 1174,
+	82, 81, 1096, 1170, 2523, 1101, 1104, 1209, 1195, 1131,
+	1132, 79, 84, 80, 78, 33, 13, 2, 1153, 1158,
+	1177, 1146, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1129, 0, 1166, 0, 0, 0, 1106, 1102, 0,
+	1151, 0, 163, 292, 306, 172, 283, 320, 177, 290,
+	168, 256, 279, 0, 1242, 165, 304, 289, 238, 208,
+	209, 164, 0, 273, 187, 200, 184, 254, 0, 1199,
+	332, 183, 323, 1105, 315, 167, 1237, 314, 253, 301,
+	305, 239, 221, 166, 303, 237, 220, 213, 191, 313,
+	204, 265, 219, 266, 205, 243, 242, 244, 1221, 1222,
+	1223, 1224, 1225, 1233, 1234, 0, 1238, 1239, 1240, 1110,
+	0, 1130, 1178, 0, 1094, 1186, 1194, 1148, 317, 1196,
+	1145, 1144, 1228, 0, 1227, 291, 1229, 1230, 214, 1192,
+	1126, 1135, 333, 1133, 277, 259, 1198, 1165, 1241, 275,
+	217, 302, 267, 307, 293, 316, 270, 268, 158, 294,
+	186, 240, 169, 170, 182, 188, 190, 192, 193, 249,
+	250, 262, 282, 295, 296, 297, 185, 178, 276, 179,
+	202, 180, 159, 284, 181, 160, 263, 300, 1226, 198,
+	271, 224, 161, 223, 264, 299, 298, 324, 330, 331,
+	335, 0, 336, 0, 0, 225, 274, 210, 0, 226,
+	227, 228, 229, 230, 231, 232, 162, 233, 234, 235,
+	236, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1235, 0, 1236, 329, 196, 156, 311, 0, 255, 1189,
+	1099, 1109, 1107, 1142, 1167, 1168, 251, 328, 1182, 1185,
+	1183, 1211, 280, 0, 0, 0, 0, 0, 207, 261,
+	0, 281, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1100, 0, 288, 309, 322, 1244, 1245, 1246,
+	1247, 0, 1248, 1249, 1250, 1251, 1252, 1253, 1254, 312,
+	1143, 1117, 1154, 321, 1120, 1118, 1181, 1119, 1171, 1213,
+	245, 246, 247, 248, 199, 0, 176, 1163, 1147, 1214,
+	1215, 1216, 1217, 1218, 1219, 1220, 1122, 334, 195, 201,
+	0, 203, 175, 260, 197, 319, 211, 1187, 252, 206,
+	285, 212, 218, 272, 318, 258, 278, 173, 308, 286,
+	222, 1116, 1121, 1115, 1160, 1161, 1205, 1206, 1207, 1179,
+	1108, 1190, 1112, 1114, 1113, 1673, 0, 1026, 1025, 1035,
+	1036, 1028, 1029, 1030, 1031, 1032, 1033, 1034, 1027, 0,
+	0, 0, 0, 0, 1184, 0, 1164, 157, 0, 216,
+	1212, 269, 194, 0, 0, 0, 772, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 257, 0, 0, 0,
+	0, 0, 0, 0, 742, 0, 0, 0, 189, 0,
+	0, 215, 0, 0, 0, 287, 722, 0, 2056, 0,
+	0, 788, 794, 0, 0, 0, 1231, 1232, 325, 326,
+	327, 310, 735, 0, 0, 0, 677, 780, 779, 752,
+	761, 0, 0, 171, 753, 0, 760, 754, 758, 757,
+	755, 756, 0, 720, 0, 0, 0, 0, 0, 0,
+	674, 739, 0, 743, 1026, 1025, 1035, 1036, 1028, 1029,
+	1030, 1031, 1032, 1033, 1034, 1027, 0, 0, 0, 0,
+	0, 0, 0, 0, 736, 737, 0, 0, 0, 0,
+	773, 0, 738, 0, 0, 775, 0, 762, 0, 163,
+	292, 306, 172, 283, 320, 177, 290, 168, 256, 279,
+	0, 0, 165, 304, 289, 238, 208, 209, 164, 0,
+	273, 187, 200, 184, 254, 759, 771, 728, 183, 726,
+	770, 315, 167, 0, 314, 253, 301, 305, 239, 221,
+	166, 303, 237, 220, 213, 191, 725, 204, 265, 219,
+	266, 205, 243, 242, 244, 1026, 1025, 1035, 1036, 1028,
+	1029, 1030, 1031, 1032, 1033, 1034, 1027, 0, 0, 0,
+	0, 0, 768, 0, 0, 317, 0, 0, 787, 0,
+	0, 0, 291, 0, 0, 214, 0, 0, 0, 729,
+	0, 277, 259, 797, 675, 0, 275, 217, 302, 267,
+	307, 293, 316, 270, 268, 158, 294, 186, 240, 169,
+	170, 182, 188, 190, 192, 193, 249, 250, 262, 282,
+	295, 296, 297, 185, 178, 276, 179, 202, 180, 159,
+	284, 181, 160, 263, 300, 0, 198, 271, 224, 161,
+	223, 264, 299, 298, 324, 330, 331, 335, 0, 336,
+	0, 0, 225, 274, 210, 0, 226, 227, 228, 229,
+	230, 231, 232, 162, 233, 234, 235, 236, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1467, 1466, 1468,
+	329, 196, 156, 311, 785, 255, 796, 781, 782, 783,
+	786, 789, 790, 723, 727, 791, 793, 795, 798, 280,
+	0, 0, 0, 0, 0, 207, 261, 0, 281, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 288, 309, 322, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 724, 0, 0, 0,
+	321, 0, 0, 0, 0, 0, 774, 245, 246, 247,
+	248, 721, 0, 176, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 334, 195, 201, 0, 203, 175,
+	260, 197, 319, 211, 0, 252, 206, 285, 212, 218,
+	272, 318, 258, 278, 173, 308, 286, 222, 804, 784,
+	803, 805, 806, 802, 807, 808, 792, 744, 0, 800,
+	799, 801, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 157, 0, 216, 0, 269, 194,
+	679, 680, 681, 682, 683, 684, 685, 686, 687, 688,
+	689, 690, 691, 692, 693, 694, 695, 696, 697, 698,
+	699, 700, 701, 702, 703, 704, 705, 706, 707, 708,
+	709, 710, 711, 712, 713, 714, 715, 716, 0, 717,
+	718, 719, 778, 0, 0, 325, 326, 327, 310, 106,
+	0, 772, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 257, 0, 0, 0, 0, 0, 0, 0, 742,
+	0, 0, 0, 189, 0, 0, 215, 0, 0, 0,
+	287, 722, 0, 0, 0, 0, 788, 794, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 735, 0, 0,
+	0, 677, 780, 779, 752, 761, 0, 0, 171, 753,
+	0, 760, 754, 758, 757, 755, 756, 0, 720, 0,
+	0, 0, 0, 0, 0, 674, 739, 0, 743, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 736,
+	737, 0, 0, 0, 0, 773, 0, 738, 0, 0,
+	775, 0, 762, 0, 163, 292, 306, 172, 283, 320,
+	177, 290, 168, 256, 279, 0, 0, 165, 304, 289,
+	238, 208, 209, 164, 0, 273, 187, 200, 184, 254,
+	759, 771, 728, 183, 726, 770, 315, 167, 0, 314,
+	253, 301, 305, 239, 221, 166, 303, 237, 220, 213,
+	191, 725, 204, 265, 219, 266, 205, 243, 242, 244,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 768, 0, 0,
+	317, 0, 0, 787, 0, 0, 0, 291, 0, 0,
+	214, 0, 0, 0, 729, 0, 277, 259, 797, 675,
+	0, 275, 217, 302, 267, 307, 293, 316, 270, 268,
+	158, 294, 186, 240, 169, 170, 182, 188, 190, 192,
+	193, 249, 250, 262, 282, 295, 296, 297, 185, 178,
+	276, 179, 202, 180, 159, 284, 181, 160, 263, 300,
+	0, 198, 271, 224, 161, 223, 264, 299, 298, 324,
+	330, 331, 335, 0, 336, 0, 0, 225, 274, 210,
+	0, 226, 227, 228, 229, 230, 231, 232, 162, 233,
+	234, 235, 236, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 329, 196, 156, 311, 785,
+	255, 796, 781, 782, 783, 786, 789, 790, 723, 727,
+	791, 793, 795, 798, 280, 0, 0, 0, 0, 0,
+	207, 261, 0, 281, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 288, 309, 322, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 724, 0, 0, 0, 321, 0, 0, 0, 0,
+	0, 774, 245, 246, 247, 248, 721, 0, 176, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 334,
+	195, 201, 0, 203, 175, 260, 197, 319, 211, 0,
+	252, 206, 285, 212, 218, 272, 318, 258, 278, 173,
+	308, 286, 222, 804, 784, 803, 805, 806, 802, 807,
+	808, 792, 744, 0, 800, 799, 801, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 157,
+	0, 216, 77, 269, 194, 679, 680, 681, 682, 683,
+	684, 685, 686, 687, 688, 689, 690, 691, 692, 693,
+	694, 695, 696, 697, 698, 699, 700, 701, 702, 703,
+	704, 705, 706, 707, 708, 709, 710, 711, 712, 713,
+	714, 715, 716, 0, 717, 718, 719, 778, 772, 0,
+	325, 326, 327, 310, 0, 0, 0, 0, 257, 0,
+	0, 0, 0, 0, 0, 0, 742, 0, 0, 0,
+	189, 952, 0, 215, 0, 0, 0, 287, 722, 0,
+	0, 0, 0, 788, 794, 0, 0, 0, 0, 0,
+	0, 948, 0, 0, 735, 0, 0, 0, 677, 780,
+	779, 752, 761, 0, 0, 171, 753, 0, 760, 754,
+	758, 757, 755, 756, 0, 720, 0, 0, 0, 0,
+	0, 0, 674, 739, 0, 743, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 736, 737, 0, 0,
+	0, 0, 773, 0, 738, 0, 0, 949, 0, 762,
+	0, 163, 292, 306, 172, 283, 320, 177, 290, 168,
+	256, 279, 0, 0, 165, 304, 289, 238, 208, 209,
+	164, 0, 273, 187, 200, 184, 254, 759, 771, 728,
+	183, 726, 770, 315, 167, 0, 314, 253, 301, 305,
+	239, 221, 166, 303, 237, 220, 213, 191, 725, 204,
+	265, 219, 266, 205, 243, 242, 244, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 768, 0, 0, 317, 0, 0,
+	787, 0, 0, 0, 291, 0, 0, 214, 0, 0,
+	0, 729, 0, 277, 259, 797, 675, 0, 275, 217,
+	302, 267, 307, 293, 316, 270, 268, 158, 294, 186,
+	240, 169, 170, 182, 188, 190, 192, 193, 249, 250,
+	262, 282, 295, 296, 297, 185, 178, 276, 179, 202,
+	180, 159, 284, 181, 160, 263, 300, 0, 198, 271,
+	224, 161, 223, 264, 299, 298, 324, 330, 331, 335,
+	0, 336, 0, 0, 225, 274, 210, 0, 226, 227,
+	228, 229, 230, 231, 232, 162, 233, 234, 235, 236,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 329, 196, 156, 311, 785, 255, 796, 781,
+	782, 783, 786, 789, 790, 723, 727, 791, 793, 795,
+	798, 280, 0, 0, 0, 0, 0, 207, 261, 0,
+	281, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 288, 309, 322, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 724, 0,
+	0, 0, 321, 0, 0, 0, 0, 0, 774, 245,
+	246, 247, 248, 721, 0, 176, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 334, 195, 201, 0,
+	203, 175, 260, 197, 319, 211, 0, 252, 206, 285,
+	212, 218, 272, 318, 258, 278, 173, 308, 286, 222,
+	804, 784, 803, 805, 806, 802, 807, 808, 792, 744,
+	0, 800, 799, 801, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 157, 0, 216, 0,
+	269, 194, 679, 680, 681, 682, 683, 684, 685, 686,
+	687, 688, 689, 690, 691, 692, 693, 694, 695, 696,
+	697, 698, 699, 700, 701, 702, 703, 704, 705, 706,
+	707, 708, 709, 710, 711, 712, 713, 714, 715, 716,
+	0, 717, 718, 719, 778, 772, 0, 325, 326, 327,
+	310, 0, 0, 0, 0, 257, 0, 0, 0, 0,
+	0, 0, 0, 742, 0, 0, 0, 189, 2522, 0,
+	215, 0, 0, 0, 287, 722, 0, 0, 0, 0,
+	788, 794, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 735, 0, 0, 0, 677, 780, 779, 752, 761,
+	0, 0, 171, 753, 0, 760, 754, 758, 757, 755,
+	756, 0, 720, 0, 0, 0, 0, 0, 0, 674,
+	739, 0, 743, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 736, 737, 0, 0, 0, 0, 773,
+	0, 738, 0, 0, 775, 0, 762, 0, 163, 292,
+	306, 172, 283, 320, 177, 290, 168, 256, 279, 0,
+	0, 165, 304, 289, 238, 208, 209, 164, 0, 273,
+	187, 200, 184, 254, 759, 771, 728, 183, 726, 770,
+	315, 167, 0, 314, 253, 301, 305, 239, 221, 166,
+	303, 237, 220, 213, 191, 725, 204, 265, 219, 266,
+	205, 243, 242, 244, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 768, 0, 0, 317, 0, 0, 787, 0, 0,
+	0, 291, 0, 0, 214, 0, 0, 0, 729, 0,
+	277, 259, 797, 675, 0, 275, 217, 302, 267, 307,
+	293, 316, 270, 268, 158, 294, 186, 240, 169, 170,
+	182, 188, 190, 192, 193, 249, 250, 262, 282, 295,
+	296, 297, 185, 178, 276, 179, 202, 180, 159, 284,
+	181, 160, 263, 300, 0, 198, 271, 224, 161, 223,
+	264, 299, 298, 324, 330, 331, 335, 0, 336, 0,
+	0, 225, 274, 210, 0, 226, 227, 228, 229, 230,
+	231, 232, 162, 233, 234, 235, 236, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 329,
+	196, 156, 311, 785, 255, 796, 781, 782, 783, 786,
+	789, 790, 723, 727, 791, 793, 795, 798, 280, 0,
+	0, 0, 0, 0, 207, 261, 0, 281, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	288, 309, 322, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 724, 0, 0, 0, 321,
+	0, 0, 0, 0, 0, 774, 245, 246, 247, 248,
+	721, 0, 176, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 334, 195, 201, 0, 203, 175, 260,
+	197, 319, 211, 0, 252, 206, 285, 212, 218, 272,
+	318, 258, 278, 173, 308, 286, 222, 804, 784, 803,
+	805, 806, 802, 807, 808, 792, 744, 0, 800, 799,
+	801, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 157, 0, 216, 0, 269, 194, 679,
+	680, 681, 682, 683, 684, 685, 686, 687, 688, 689,
+	690, 691, 692, 693, 694, 695, 696, 697, 698, 699,
+	700, 701, 702, 703, 704, 705, 706, 707, 708, 709,
+	710, 711, 712, 713, 714, 715, 716, 0, 717, 718,
+	719, 778, 772, 0, 325, 326, 327, 310, 0, 0,
+	0, 0, 257, 0, 0, 0, 0, 0, 0, 0,
+	742, 0, 0, 0, 189, 952, 0, 215, 0, 0,
+	0, 287, 722, 0, 0, 0, 0, 788, 794, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 735, 0,
+	0, 0, 677, 780, 779, 752, 761, 0, 0, 171,
+	753, 0, 760, 754, 758, 757, 755, 756, 0, 720,
+	0, 0, 0, 0, 0, 0, 674, 739, 0, 743,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	736, 737, 0, 0, 0, 0, 773, 0, 738, 0,
+	0, 775, 0, 762, 0, 163, 292, 306, 172, 283,
+	320, 177, 290, 168, 256, 279, 0, 0, 165, 304,
+	289, 238, 208, 209, 164, 0, 273, 187, 200, 184,
+	254, 759, 771, 728, 183, 726, 770, 315, 167, 0,
+	314, 253, 301, 305, 239, 221, 166, 303, 237, 220,
+	213, 191, 725, 204, 265, 219, 266, 205, 243, 242,
+	244, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 768, 0,
+	0, 317, 0, 0, 787, 0, 0, 0, 291, 0,
+	0, 214, 0, 0, 0, 729, 0, 277, 259, 797,
+	675, 0, 275, 217, 302, 267, 307, 293, 316, 270,
+	268, 158, 294, 186, 240, 169, 170, 182, 188, 190,
+	192, 193, 249, 250, 262, 282, 295, 296, 297, 185,
+	178, 276, 179, 202, 180, 159, 284, 181, 160, 263,
+	300, 0, 198, 271, 224, 161, 223, 264, 299, 298,
+	324, 330, 331, 335, 0, 336, 0, 0, 225, 274,
+	210, 0, 226, 227, 228, 229, 230, 231, 232, 162,
+	233, 234, 235, 236, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 329, 196, 156, 311,
+	785, 255, 796, 781, 782, 783, 786, 789, 790, 723,
+	727, 791, 793, 795, 798, 280, 0, 0, 0, 0,
+	0, 207, 261, 0, 281, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 288, 309, 322,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 724, 0, 0, 0, 321, 0, 0, 0,
+	0, 0, 774, 245, 246, 247, 248, 721, 0, 176,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	334, 195, 201, 0, 203, 175, 260, 197, 319, 211,
+	0, 252, 206, 285, 212, 218, 272, 318, 258, 278,
+	173, 308, 286, 222, 804, 784, 803, 805, 806, 802,
+	807, 808, 792, 744, 0, 800, 799, 801, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	157, 0, 216, 0, 269, 194, 679, 680, 681, 682,
+	683, 684, 685, 686, 687, 688, 689, 690, 691, 692,
+	693, 694, 695, 696, 697, 698, 699, 700, 701, 702,
+	703, 704, 705, 706, 707, 708, 709, 710, 711, 712,
+	713, 714, 715, 716, 0, 717, 718, 719, 778, 0,
+	0, 325, 326, 327, 310, 772, 0, 0, 1678, 0,
+	0, 0, 0, 0, 0, 257, 0, 0, 0, 0,
+	0, 0, 0, 742, 0, 0, 0, 189, 0, 0,
+	215, 0, 0, 0, 287, 722, 0, 0, 0, 0,
+	788, 794, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 735, 0, 0, 0, 677, 780, 779, 752, 761,
+	0, 0, 171, 753, 0, 760, 754, 758, 757, 755,
+	756, 0, 720, 0, 0, 0, 0, 0, 0, 674,
+	739, 0, 743, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 736, 737, 0, 0, 0, 0, 773,
+	0, 738, 0, 0, 775, 0, 762, 0, 163, 292,
+	306, 172, 283, 320, 177, 290, 168, 256, 279, 0,
+	0, 165, 304, 289, 238, 208, 209, 164, 0, 273,
+	187, 200, 184, 254, 759, 771, 728, 183, 726, 770,
+	315, 167, 0, 314, 253, 301, 305, 239, 221, 166,
+	303, 237, 220, 213, 191, 725, 204, 265, 219, 266,
+	205, 243, 242, 244, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 768, 0, 0, 317, 0, 0, 787, 0, 0,
+	0, 291, 0, 0, 214, 0, 0, 0, 729, 0,
+	277, 259, 797, 675, 0, 275, 217, 302, 267, 307,
+	293, 316, 270, 268, 158, 294, 186, 240, 169, 170,
+	182, 188, 190, 192, 193, 249, 250, 262, 282, 295,
+	296, 297, 185, 178, 276, 179, 202, 180, 159, 284,
+	181, 160, 263, 300, 0, 198, 271, 224, 161, 223,
+	264, 299, 298, 324, 330, 331, 335, 0, 336, 0,
+	0, 225, 274, 210, 0, 226, 227, 228, 229, 230,
+	231, 232, 162, 233, 234, 235, 236, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 329,
+	196, 156, 311, 785, 255, 796, 781, 782, 783, 786,
+	789, 790, 723, 727, 791, 793, 795, 798, 280, 0,
+	0, 0, 0, 0, 207, 261, 0, 281, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	288, 309, 322, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 724, 0, 0, 0, 321,
+	0, 0, 0, 0, 0, 774, 245, 246, 247, 248,
+	721, 0, 176, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 334, 195, 201, 0, 203, 175, 260,
+	197, 319, 211, 0, 252, 206, 285, 212, 218, 272,
+	318, 258, 278, 173, 308, 286, 222, 804, 784, 803,
+	805, 806, 802, 807, 808, 792, 744, 0, 800, 799,
+	801, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 157, 0, 216, 0, 269, 194, 679,
+	680, 681, 682, 683, 684, 685, 686, 687, 688, 689,
+	690, 691, 692, 693, 694, 695, 696, 697, 698, 699,
+	700, 701, 702, 703, 704, 705, 706, 707, 708, 709,
+	710, 711, 712, 713, 714, 715, 716, 0, 717, 718,
+	719, 778, 772, 0, 325, 326, 327, 310, 0, 0,
+	0, 0, 257, 0, 0, 0, 0, 0, 0, 0,
+	742, 0, 0, 0, 189, 0, 0, 215, 0, 0,
+	0, 287, 722, 0, 0, 0, 0, 788, 794, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 735, 0,
+	0, 0, 677, 780, 779, 752, 761, 0, 0, 171,
+	753, 0, 760, 754, 758, 757, 755, 756, 0, 720,
+	0, 0, 0, 0, 0, 0, 674, 739, 0, 743,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	736, 737, 671, 0, 0, 0, 773, 0, 738, 0,
+	0, 775, 0, 762, 0, 163, 292, 306, 172, 283,
+	320, 177, 290, 168, 256, 279, 0, 0, 165, 304,
+	289, 238, 208, 209, 164, 0, 273, 187, 200, 184,
+	254, 759, 771, 728, 183, 726, 770, 315, 167, 0,
+	314, 253, 301, 305, 239, 221, 166, 303, 237, 220,
+	213, 191, 725, 204, 265, 219, 266, 205, 243, 242,
+	244, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 768, 0,
+	0, 317, 0, 0, 787, 0, 0, 0, 291, 0,
+	0, 214, 0, 0, 0, 729, 0, 277, 259, 797,
+	675, 0, 275, 217, 302, 267, 307, 293, 316, 270,
+	268, 158, 294, 186, 240, 169, 170, 182, 188, 190,
+	192, 193, 249, 250, 262, 282, 295, 296, 297, 185,
+	178, 276, 179, 202, 180, 159, 284, 181, 160, 263,
+	300, 0, 198, 271, 224, 161, 223, 264, 299, 298,
+	324, 330, 331, 335, 0, 336, 0, 0, 225, 274,
+	210, 0, 226, 227, 228, 229, 230, 231, 232, 162,
+	233, 234, 235, 236, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 329, 196, 156, 311,
+	785, 255, 796, 781, 782, 783, 786, 789, 790, 723,
+	727, 791, 793, 795, 798, 280, 0, 0, 0, 0,
+	0, 207, 261, 0, 281, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 288, 309, 322,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 724, 0, 0, 0, 321, 0, 0, 0,
+	0, 0, 774, 245, 246, 247, 248, 721, 0, 176,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	334, 195, 201, 0, 203, 175, 260, 197, 319, 211,
+	0, 252, 206, 285, 212, 218, 272, 318, 258, 278,
+	173, 308, 286, 222, 804, 784, 803, 805, 806, 802,
+	807, 808, 792, 744, 0, 800, 799, 801, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	157, 0, 216, 0, 269, 194, 679, 680, 681, 682,
+	683, 684, 685, 686, 687, 688, 689, 690, 691, 692,
+	693, 694, 695, 696, 697, 698, 699, 700, 701, 702,
+	703, 704, 705, 706, 707, 708, 709, 710, 711, 712,
+	713, 714, 715, 716, 0, 717, 718, 719, 778, 772,
+	0, 325, 326, 327, 310, 0, 0, 0, 0, 257,
+	0, 0, 0, 0, 0, 0, 0, 742, 0, 0,
+	0, 189, 0, 0, 215, 0, 0, 0, 287, 722,
+	0, 0, 0, 0, 788, 794, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 735, 0, 0, 0, 677,
+	780, 779, 752, 761, 0, 0, 171, 753, 0, 760,
+	754, 758, 757, 755, 756, 0, 720, 0, 0, 0,
+	0, 0, 0, 674, 739, 0, 743, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 736, 737, 0,
+	0, 0, 0, 773, 0, 738, 0, 0, 775, 0,
+	762, 0, 163, 292, 306, 172, 283, 320, 177, 290,
+	168, 256, 279, 0, 0, 165, 304, 289, 238, 208,
+	209, 164, 0, 273, 187, 200, 184, 254, 759, 771,
+	728, 183, 726, 770, 315, 167, 0, 314, 253, 301,
+	305, 239, 221, 166, 303, 237, 220, 213, 191, 725,
+	204, 265, 219, 266, 205, 243, 242, 244, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 768, 0, 0, 317, 0,
+	0, 787, 0, 0, 0, 291, 0, 0, 214, 0,
+	0, 0, 729, 0, 277, 259, 797, 675, 0, 275,
+	217, 302, 267, 307, 293, 316, 270, 268, 158, 294,
+	186, 240, 169, 170, 182, 188, 190, 192, 193, 249,
+	250, 262, 282, 295, 296, 297, 185, 178, 276, 179,
+	202, 180, 159, 284, 181, 160, 263, 300, 0, 198,
+	271, 224, 161, 223, 264, 299, 298, 324, 330, 331,
+	335, 0, 336, 0, 0, 225, 274, 210, 0, 226,
+	227, 228, 229, 230, 231, 232, 162, 233, 234, 235,
+	236, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 329, 196, 156, 311, 785, 255, 796,
+	781, 782, 783, 786, 789, 790, 723, 727, 791, 793,
+	795, 798, 280, 0, 0, 0, 0, 0, 207, 261,
+	0, 281, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 288, 309, 322, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 724,
+	0, 0, 0, 321, 0, 0, 0, 0, 0, 774,
+	245, 246, 247, 248, 721, 0, 176, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 334, 195, 201,
+	0, 203, 175, 260, 197, 319, 211, 0, 252, 206,
+	285, 212, 218, 272, 318, 258, 278, 173, 308, 286,
+	222, 804, 784, 803, 805, 806, 802, 807, 808, 792,
+	744, 0, 800, 799, 801, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 157, 0, 216,
+	0, 269, 194, 679, 680, 681, 682, 683, 684, 685,
+	686, 687, 688, 689, 690, 691, 692, 693, 694, 695,
+	696, 697, 698, 699, 700, 701, 702, 703, 704, 705,
+	706, 707, 708, 709, 710, 711, 712, 713, 714, 715,
+	716, 0, 717, 718, 719, 778, 772, 0, 325, 326,
+	327, 310, 0, 0, 0, 0, 257, 0, 0, 0,
+	1410, 0, 0, 0, 742, 0, 0, 0, 189, 0,
+	0, 215, 0, 0, 0, 287, 722, 0, 0, 0,
+	0, 788, 794, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 735, 0, 0, 0, 677, 780, 779, 752,
+	761, 0, 0, 171, 753, 0, 760, 754, 758, 757,
+	755, 756, 0, 720, 0, 0, 0, 0, 0, 0,
+	0, 739, 0, 743, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 736, 737, 0, 0, 0, 0,
+	773, 0, 738, 0, 0, 775, 0, 762, 0, 163,
+	292, 306, 172, 283, 320, 177, 290, 168, 256, 279,
+	0, 0, 165, 304, 289, 238, 208, 209, 164, 0,
+	273, 187, 200, 184, 254, 759, 771, 728, 183, 726,
+	770, 315, 167, 0, 314, 253, 301, 305, 239, 221,
+	166, 303, 237, 220, 213, 191, 725, 204, 265, 219,
+	266, 205, 243, 242, 244, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 768, 0, 0, 317, 0, 0, 787, 0,
+	0, 0, 291, 0, 0, 214, 0, 0, 0, 729,
+	0, 277, 259, 797, 0, 0, 275, 217, 302, 267,
+	307, 293, 316, 270, 268, 158, 294, 186, 240, 169,
+	170, 182, 188, 190, 192, 193, 249, 250, 262, 282,
+	295, 296, 297, 185, 178, 276, 179, 202, 180, 159,
+	284, 181, 160, 263, 300, 0, 198, 271, 224, 161,
+	223, 264, 299, 298, 324, 1411, 1412, 335, 0, 336,
+	0, 0, 225, 274, 210, 0, 226, 227, 228, 229,
+	230, 231, 232, 162, 233, 234, 235, 236, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	329, 196, 156, 311, 785, 255, 796, 781, 782, 783,
+	786, 789, 790, 723, 727, 791, 793, 795, 798, 280,
+	0, 0, 0, 0, 0, 207, 261, 0, 281, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 288, 309, 322, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 724, 0, 0, 0,
+	321, 0, 0, 0, 0, 0, 774, 245, 246, 247,
+	248, 721, 0, 176, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 334, 195, 201, 0, 203, 175,
+	260, 197, 319, 211, 0, 252, 206, 285, 212, 218,
+	272, 318, 258, 278, 173, 308, 286, 222, 804, 784,
+	803, 805, 806, 802, 807, 808, 792, 744, 0, 800,
+	799, 801, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 157, 0, 216, 0, 269, 194,
+	679, 680, 681, 682, 683, 684, 685, 686, 687, 688,
+	689, 690, 691, 692, 693, 694, 695, 696, 697, 698,
+	699, 700, 701, 702, 703, 704, 705, 706, 707, 708,
+	709, 710, 711, 712, 713, 714, 715, 716, 0, 717,
+	718, 719, 778, 772, 0, 325, 326, 327, 310, 0,
+	0, 0, 0, 257, 0, 0, 0, 0, 0, 0,
+	0, 742, 0, 0, 0, 189, 0, 0, 215, 0,
+	0, 0, 287, 722, 0, 0, 0, 0, 788, 794,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 677, 780, 779, 752, 761, 0, 0,
+	171, 753, 0, 760, 754, 758, 757, 755, 756, 0,
+	720, 0, 0, 0, 0, 0, 0, 674, 739, 0,
+	743, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 736, 737, 0, 0, 0, 0, 773, 0, 738,
+	0, 0, 775, 0, 762, 0, 163, 292, 306, 172,
+	283, 320, 177, 290, 168, 256, 279, 0, 0, 165,
+	304, 289, 238, 208, 209, 164, 0, 273, 187, 200,
+	184, 254, 759, 771, 728, 183, 726, 770, 315, 167,
+	0, 314, 253, 301, 305, 239, 221, 166, 303, 237,
+	220, 213, 191, 725, 204, 265, 219, 266, 205, 243,
+	242, 244, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 768,
+	0, 0, 317, 0, 0, 787, 0, 0, 0, 291,
+	0, 0, 214, 0, 0, 0, 729, 0, 277, 259,
+	797, 675, 0, 275, 217, 302, 267, 307, 293, 316,
+	270, 268, 158, 294, 186, 240, 169, 170, 182, 188,
+	190, 192, 193, 249, 250, 262, 282, 295, 296, 297,
+	185, 178, 276, 179, 202, 180, 159, 284, 181, 160,
+	263, 300, 0, 198, 271, 224, 161, 223, 264, 299,
+	298, 324, 330, 331, 335, 0, 336, 0, 0, 225,
+	274, 210, 0, 226, 227, 228, 229, 230, 231, 232,
+	162, 233, 234, 235, 236, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 329, 196, 156,
+	311, 785, 255, 796, 781, 782, 783, 786, 789, 790,
+	723, 727, 791, 793, 795, 798, 280, 0, 0, 0,
+	0, 0, 207, 261, 0, 281, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 288, 309,
+	322, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 724, 0, 0, 0, 321, 0, 0,
+	0, 0, 0, 774, 245, 246, 247, 248, 721, 0,
+	176, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 334, 195, 201, 0, 203, 175, 260, 197, 319,
+	211, 0, 252, 206, 285, 212, 218, 272, 318, 258,
+	278, 173, 308, 286, 222, 804, 784, 803, 805, 806,
+	802, 807, 808, 792, 744, 0, 800, 799, 801, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 157, 0, 216, 0, 269, 194, 679, 680, 681,
+	682, 683, 684, 685, 686, 687, 688, 689, 690, 691,
+	692, 693, 694, 695, 696, 697, 698, 699, 700, 701,
+	702, 703, 704, 705, 706, 707, 708, 709, 710, 711,
+	712, 713, 714, 715, 716, 0, 717, 718, 719, 778,
+	772, 0, 325, 326, 327, 310, 0, 0, 0, 0,
+	257, 0, 0, 0, 0, 0, 0, 0, 742, 0,
+	0, 0, 189, 0, 0, 215, 0, 0, 0, 287,
+	722, 0, 0, 0, 0, 788, 794, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 735, 0, 0, 0,
+	677, 780, 779, 752, 761, 0, 0, 171, 753, 0,
+	760, 754, 758, 757, 755, 756, 0, 720, 0, 0,
+	0, 0, 0, 0, 0, 739, 0, 743, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 736, 737,
+	0, 0, 0, 0, 773, 0, 738, 0, 0, 775,
+	0, 762, 0, 163, 292, 306, 172, 283, 320, 177,
+	290, 168, 256, 279, 0, 0, 165, 304, 289, 238,
+	208, 209, 164, 0, 273, 187, 200, 184, 254, 759,
+	771, 728, 183, 726, 770, 315, 167, 0, 314, 253,
+	301, 305, 239, 221, 166, 303, 237, 220, 213, 191,
+	725, 204, 265, 219, 266, 205, 243, 242, 244, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 768, 0, 0, 317,
+	0, 0, 787, 0, 0, 0, 291, 0, 0, 214,
+	0, 0, 0, 729, 0, 277, 259, 797, 0, 0,
+	275, 217, 302, 267, 307, 293, 316, 270, 268, 158,
+	294, 186, 240, 169, 170, 182, 188, 190, 192, 193,
+	249, 250, 262, 282, 295, 296, 297, 185, 178, 276,
+	179, 202, 180, 159, 284, 181, 160, 263, 300, 0,
+	198, 271, 224, 161, 223, 264, 299, 298, 324, 330,
+	331, 335, 0, 336, 0, 0, 225, 274, 210, 0,
+	226, 227, 228, 229, 230, 231, 232, 162, 233, 234,
+	235, 236, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 329, 196, 156, 311, 785, 255,
+	796, 781, 782, 783, 786, 789, 790, 723, 727, 791,
+	793, 795, 798, 280, 0, 0, 0, 0, 0, 207,
+	261, 0, 281, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 288, 309, 322, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	724, 0, 0, 0, 321, 0, 0, 0, 0, 0,
+	774, 245, 246, 247, 248, 721, 0, 176, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 334, 195,
+	201, 0, 203, 175, 260, 197, 319, 211, 0, 252,
+	206, 285, 212, 218, 272, 318, 258, 278, 173, 308,
+	286, 222, 804, 784, 803, 805, 806, 802, 807, 808,
+	792, 744, 0, 800, 799, 801, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 157, 0,
+	216, 0, 269, 194, 679, 680, 681, 682, 683, 684,
+	685, 686, 687, 688, 689, 690, 691, 692, 693, 694,
+	695, 696, 697, 698, 699, 700, 701, 702, 703, 704,
+	705, 706, 707, 708, 709, 710, 711, 712, 713, 714,
+	715, 716, 0, 717, 718, 719, 778, 0, 0, 325,
+	326, 327, 310, 106, 0, 29, 96, 76, 0, 0,
+	0, 0, 0, 0, 0, 257, 343, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 189, 0, 0,
+	215, 0, 0, 0, 287, 241, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 348, 0, 0, 0, 112, 0, 0, 0, 0,
+	0, 0, 171, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 174, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 163, 292,
+	306, 172, 283, 320, 177, 290, 168, 256, 279, 0,
+	0, 165, 304, 289, 238, 208, 209, 164, 0, 273,
+	187, 200, 184, 254, 0, 0, 332, 183, 323, 0,
+	315, 167, 0, 314, 253, 301, 305, 239, 221, 166,
+	303, 237, 220, 213, 191, 313, 204, 265, 219, 266,
+	205, 243, 242, 244, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 347,
+	0, 0, 0, 0, 317, 0, 0, 0, 0, 0,
+	0, 291, 0, 0, 214, 0, 0, 0, 333, 0,
+	277, 259, 0, 0, 0, 275, 217, 302, 267, 307,
+	293, 316, 270, 268, 158, 294, 186, 240, 169, 170,
+	182, 188, 190, 192, 193, 249, 250, 262, 282, 295,
+	296, 297, 185, 178, 276, 179, 202, 180, 159, 284,
+	181, 160, 263, 300, 0, 198, 271, 224, 161, 223,
+	264, 299, 298, 324, 330, 331, 335, 0, 336, 0,
+	0, 225, 274, 210, 0, 226, 227, 228, 229, 230,
+	231, 232, 162, 233, 234, 235, 236, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 329,
+	196, 156, 311, 0, 255, 0, 0, 0, 0, 0,
+	0, 0, 251, 328, 0, 0, 0, 0, 280, 0,
+	0, 0, 0, 0, 207, 261, 0, 281, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	288, 309, 322, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 312, 0, 0, 0, 321,
+	0, 0, 0, 0, 0, 0, 245, 246, 247, 248,
+	344, 346, 176, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 334, 195, 201, 0, 203, 175, 260,
+	197, 319, 211, 0, 252, 206, 285, 212, 218, 272,
+	318, 258, 278, 173, 308, 286, 222, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 157, 0, 216, 77, 269, 194, 115,
 	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
 	126, 127, 128, 129, 130, 131, 132, 133, 134, 135,
-	136, 137, 138, 139, 140, 141, 229, 0, 0, 295,
-	296, 297, 281, 0, 0, 0, 0, 0, 174, 0,
-	0, 199, 0, 0, 0, 258, 213, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 103, 437, 438, 0,
-	0, 0, 0, 156, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 442, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 148,
-	263, 277, 157, 254, 290, 162, 261, 153, 228, 250,
-	0, 0, 150, 275, 260, 210, 193, 194, 149, 0,
-	245, 172, 185, 169, 226, 0, 0, 302, 168, 293,
-	410, 285, 152, 409, 284, 225, 272, 276, 211, 205,
-	151, 274, 209, 204, 197, 176, 189, 237, 203, 238,
-	190, 215, 214, 216, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 287, 0, 0, 0, 0, 0,
-	0, 262, 0, 0, 198, 0, 0, 0, 303, 0,
-	248, 231, 0, 0, 0, 246, 201, 273, 239, 278,
-	264, 286, 242, 240, 144, 265, 171, 212, 154, 155,
-	167, 173, 175, 177, 178, 221, 222, 234, 253, 266,
-	267, 268, 170, 163, 247, 164, 187, 165, 145, 255,
-	166, 146, 235, 271, 0, 183, 243, 208, 147, 207,
-	236, 270, 269, 294, 300, 301, 305, 0, 306, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 299, 181, 142, 282, 0, 227, 0,
-	0, 0, 0, 0, 0, 0, 223, 298, 0, 0,
-	0, 0, 251, 0, 0, 0, 0, 0, 192, 233,
-	0, 252, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 259, 280, 292, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 283,
-	0, 0, 0, 291, 0, 0, 0, 0, 0, 0,
-	217, 218, 219, 220, 184, 0, 161, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 304, 180, 186,
-	0, 188, 160, 232, 182, 289, 195, 0, 439, 434,
-	435, 196, 202, 244, 288, 230, 249, 158, 279, 257,
-	436, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 143, 0, 200,
-	0, 241, 179, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
-	123, 124, 125, 126, 127, 128, 129, 130, 131, 132,
-	133, 134, 135, 136, 137, 138, 139, 140, 141, 229,
-	0, 0, 295, 296, 297, 281, 0, 0, 0, 0,
-	0, 174, 607, 0, 199, 0, 0, 0, 258, 213,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 103,
-	0, 0, 605, 0, 0, 0, 156, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 159, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 603, 0,
-	0, 0, 148, 263, 277, 157, 254, 290, 162, 261,
-	153, 228, 250, 0, 0, 150, 275, 260, 210, 193,
-	194, 149, 0, 245, 172, 185, 169, 226, 0, 0,
-	302, 168, 293, 0, 285, 152, 0, 284, 225, 272,
-	276, 211, 205, 151, 274, 209, 204, 197, 176, 189,
-	237, 203, 238, 190, 215, 214, 216, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 287, 0, 0,
-	0, 0, 0, 0, 262, 0, 0, 198, 0, 0,
-	0, 303, 0, 248, 231, 0, 0, 0, 246, 201,
-	273, 239, 278, 264, 286, 242, 240, 144, 265, 171,
-	212, 154, 155, 167, 173, 175, 177, 178, 221, 222,
-	234, 253, 266, 267, 268, 170, 163, 247, 164, 187,
-	165, 145, 255, 166, 146, 235, 271, 0, 183, 243,
-	208, 147, 207, 236, 270, 269, 294, 300, 301, 305,
-	0, 306, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 299, 181, 142, 282,
-	0, 227, 0, 0, 0, 0, 0, 0, 0, 223,
-	298, 0, 0, 0, 0, 251, 0, 0, 0, 0,
-	0, 192, 233, 0, 252, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 259, 280, 292,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 283, 0, 0, 0, 291, 0, 0, 0,
-	0, 0, 0, 217, 218, 219, 220, 184, 0, 161,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	304, 180, 186, 0, 188, 160, 232, 182, 289, 195,
-	0, 224, 191, 256, 196, 202, 244, 288, 230, 249,
-	158, 279, 257, 206, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	143, 0, 200, 0, 241, 179, 106, 107, 108, 109,
-	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
-	120, 121, 122, 123, 124, 125, 126, 127, 128, 129,
-	130, 131, 132, 133, 134, 135, 136, 137, 138, 139,
-	140, 141, 229, 0, 0, 295, 296, 297, 281, 0,
-	0, 0, 0, 0, 174, 601, 0, 199, 0, 0,
-	0, 258, 213, 0, 0, 0, 0, 0, 0, 0,
+	136, 137, 138, 139, 140, 141, 142, 143, 144, 145,
+	146, 147, 148, 149, 150, 151, 152, 257, 153, 154,
+	155, 0, 0, 0, 325, 326, 327, 310, 0, 189,
+	0, 0, 215, 0, 0, 0, 287, 241, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 103, 0, 0, 605, 0, 0, 0, 156,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 159,
+	0, 0, 0, 0, 0, 0, 0, 112, 0, 0,
+	0, 0, 0, 0, 171, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 174, 1818, 1821, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 603, 0, 0, 0, 148, 263, 277, 157, 254,
-	290, 162, 261, 153, 228, 250, 0, 0, 150, 275,
-	260, 210, 193, 194, 149, 0, 245, 172, 185, 169,
-	226, 0, 0, 302, 168, 293, 0, 285, 152, 0,
-	284, 225, 272, 276, 211, 205, 151, 274, 209, 204,
-	197, 176, 189, 237, 203, 238, 190, 215, 214, 216,
+	163, 292, 306, 172, 283, 320, 177, 290, 168, 256,
+	279, 0, 0, 165, 304, 289, 238, 208, 209, 164,
+	0, 273, 187, 200, 184, 254, 0, 0, 332, 183,
+	323, 0, 315, 167, 0, 314, 253, 301, 305, 239,
+	221, 166, 303, 237, 220, 213, 191, 313, 204, 265,
+	219, 266, 205, 243, 242, 244, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1822, 317, 0, 0, 0,
+	1815, 0, 1814, 291, 1816, 1819, 214, 0, 0, 0,
+	333, 0, 277, 259, 0, 0, 0, 275, 217, 302,
+	267, 307, 293, 316, 270, 268, 158, 294, 186, 240,
+	169, 170, 182, 188, 190, 192, 193, 249, 250, 262,
+	282, 295, 296, 297, 185, 178, 276, 179, 202, 180,
+	159, 284, 181, 160, 263, 300, 1820, 198, 271, 224,
+	161, 223, 264, 299, 298, 324, 330, 331, 335, 0,
+	336, 0, 0, 225, 274, 210, 0, 226, 227, 228,
+	229, 230, 231, 232, 162, 233, 234, 235, 236, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	287, 0, 0, 0, 0, 0, 0, 262, 0, 0,
-	198, 0, 0, 0, 303, 0, 248, 231, 0, 0,
-	0, 246, 201, 273, 239, 278, 264, 286, 242, 240,
-	144, 265, 171, 212, 154, 155, 167, 173, 175, 177,
-	178, 221, 222, 234, 253, 266, 267, 268, 170, 163,
-	247, 164, 187, 165, 145, 255, 166, 146, 235, 271,
-	0, 183, 243, 208, 147, 207, 236, 270, 269, 294,
-	300, 301, 305, 0, 306, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 299,
-	181, 142, 282, 0, 227, 0, 0, 0, 0, 0,
-	0, 0, 223, 298, 0, 0, 0, 0, 251, 0,
-	0, 0, 0, 0, 192, 233, 0, 252, 0, 0,
+	0, 329, 196, 156, 311, 0, 255, 979, 980, 981,
+	978, 0, 0, 0, 251, 328, 0, 0, 0, 0,
+	280, 0, 0, 0, 0, 0, 207, 261, 0, 281,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	259, 280, 292, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 283, 0, 0, 0, 291,
-	0, 0, 0, 0, 0, 0, 217, 218, 219, 220,
-	184, 0, 161, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 304, 180, 186, 0, 188, 160, 232,
-	182, 289, 195, 0, 224, 191, 256, 196, 202, 244,
-	288, 230, 249, 158, 279, 257, 206, 0, 0, 0,
+	0, 0, 288, 309, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 312, 0, 0,
+	0, 321, 0, 0, 0, 0, 0, 0, 245, 246,
+	247, 248, 199, 0, 176, 0, 0, 0, 0, 0,
+	1449, 0, 0, 0, 0, 334, 195, 201, 0, 203,
+	175, 260, 197, 319, 211, 0, 252, 206, 285, 212,
+	218, 272, 318, 258, 278, 173, 308, 286, 222, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 143, 0, 200, 0, 241, 179, 106,
-	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
-	127, 128, 129, 130, 131, 132, 133, 134, 135, 136,
-	137, 138, 139, 140, 141, 229, 0, 0, 295, 296,
-	297, 281, 0, 0, 0, 0, 0, 174, 0, 0,
-	199, 0, 0, 0, 258, 213, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2231, 0, 103, 725, 0, 0, 0,
-	0, 0, 156, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 159, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 148, 263,
-	277, 157, 254, 290, 162, 261, 153, 228, 250, 0,
-	0, 150, 275, 260, 210, 193, 194, 149, 0, 245,
-	172, 185, 169, 226, 0, 0, 302, 168, 293, 0,
-	285, 152, 0, 284, 225, 272, 276, 211, 205, 151,
-	274, 209, 204, 197, 176, 189, 237, 203, 238, 190,
-	215, 214, 216, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 287, 0, 0, 0, 0, 0, 0,
-	262, 0, 0, 198, 0, 0, 0, 303, 0, 248,
-	231, 0, 0, 0, 246, 201, 273, 239, 278, 264,
-	286, 242, 240, 144, 265, 171, 212, 154, 155, 167,
-	173, 175, 177, 178, 221, 222, 234, 253, 266, 267,
-	268, 170, 163, 247, 164, 187, 165, 145, 255, 166,
-	146, 235, 271, 0, 183, 243, 208, 147, 207, 236,
-	270, 269, 294, 300, 301, 305, 0, 306, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 299, 181, 142, 282, 0, 227, 0, 0,
-	0, 0, 0, 0, 0, 223, 298, 0, 0, 0,
-	0, 251, 0, 0, 0, 0, 0, 192, 233, 0,
-	252, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 259, 280, 292, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 283, 0,
-	0, 0, 291, 0, 0, 0, 0, 0, 0, 217,
-	218, 219, 220, 184, 0, 161, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 304, 180, 186, 0,
-	188, 160, 232, 182, 289, 195, 0, 224, 191, 256,
-	196, 202, 244, 288, 230, 249, 158, 279, 257, 206,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 143, 0, 200, 0,
-	241, 179, 106, 107, 108, 109, 110, 111, 112, 113,
-	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
+	0, 0, 0, 0, 0, 157, 0, 216, 0, 269,
+	194, 115, 116, 117, 118, 119, 120, 121, 122, 123,
 	124, 125, 126, 127, 128, 129, 130, 131, 132, 133,
-	134, 135, 136, 137, 138, 139, 140, 141, 229, 0,
-	0, 295, 296, 297, 281, 0, 0, 0, 0, 0,
-	174, 0, 0, 199, 0, 0, 0, 258, 213, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 103, 0,
-	0, 605, 0, 0, 0, 156, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 159, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 603, 0, 0,
-	0, 148, 263, 277, 157, 254, 290, 162, 261, 153,
-	228, 250, 0, 0, 150, 275, 260, 210, 193, 194,
-	149, 0, 245, 172, 185, 169, 226, 0, 0, 302,
-	168, 293, 0, 285, 152, 0, 284, 225, 272, 276,
-	211, 205, 151, 274, 209, 204, 197, 176, 189, 237,
-	203, 238, 190, 215, 214, 216, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 287, 0, 0, 0,
-	0, 0, 0, 262, 0, 0, 198, 0, 0, 0,
-	303, 0, 248, 231, 0, 0, 0, 246, 201, 273,
-	239, 278, 264, 286, 242, 240, 144, 265, 171, 212,
-	154, 155, 167, 173, 175, 177, 178, 221, 222, 234,
-	253, 266, 267, 268, 170, 163, 247, 164, 187, 165,
-	145, 255, 166, 146, 235, 271, 0, 183, 243, 208,
-	147, 207, 236, 270, 269, 294, 300, 301, 305, 0,
-	306, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 299, 181, 142, 282, 0,
-	227, 0, 0, 0, 0, 0, 0, 0, 223, 298,
-	0, 0, 0, 0, 251, 0, 0, 0, 0, 0,
-	192, 233, 0, 252, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 259, 280, 292, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 283, 0, 0, 0, 291, 0, 0, 0, 0,
-	0, 0, 217, 218, 219, 220, 184, 0, 161, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 304,
-	180, 186, 0, 188, 160, 232, 182, 289, 195, 0,
-	224, 191, 256, 196, 202, 244, 288, 230, 249, 158,
-	279, 257, 206, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 143,
-	0, 200, 0, 241, 179, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
-	131, 132, 133, 134, 135, 136, 137, 138, 139, 140,
-	141, 229, 0, 0, 295, 296, 297, 281, 0, 0,
-	0, 0, 0, 174, 0, 0, 199, 0, 0, 0,
-	258, 213, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 103, 0, 0, 605, 0, 0, 0, 156, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 159, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1838, 0, 0, 0, 148, 263, 277, 157, 254, 290,
-	162, 261, 153, 228, 250, 0, 0, 150, 275, 260,
-	210, 193, 194, 149, 0, 245, 172, 185, 169, 226,
-	0, 0, 302, 168, 293, 0, 285, 152, 0, 284,
-	225, 272, 276, 211, 205, 151, 274, 209, 204, 197,
-	176, 189, 237, 203, 238, 190, 215, 214, 216, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 287,
-	0, 0, 0, 0, 0, 0, 262, 0, 0, 198,
-	0, 0, 0, 303, 0, 248, 231, 0, 0, 0,
-	246, 201, 273, 239, 278, 264, 286, 242, 240, 144,
-	265, 171, 212, 154, 155, 167, 173, 175, 177, 178,
-	221, 222, 234, 253, 266, 267, 268, 170, 163, 247,
-	164, 187, 165, 145, 255, 166, 146, 235, 271, 0,
-	183, 243, 208, 147, 207, 236, 270, 269, 294, 300,
-	301, 305, 0, 306, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 299, 181,
-	142, 282, 0, 227, 0, 0, 0, 0, 0, 0,
-	0, 223, 298, 0, 0, 0, 0, 251, 0, 0,
-	0, 0, 0, 192, 233, 0, 252, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 259,
-	280, 292, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 283, 0, 0, 0, 291, 0,
-	0, 0, 0, 0, 0, 217, 218, 219, 220, 184,
-	0, 161, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 304, 180, 186, 0, 188, 160, 232, 182,
-	289, 195, 0, 224, 191, 256, 196, 202, 244, 288,
-	230, 249, 158, 279, 257, 206, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 143, 0, 200, 0, 241, 179, 106, 107,
-	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	134, 135, 136, 137, 138, 139, 140, 141, 142, 143,
+	144, 145, 146, 147, 148, 149, 150, 151, 152, 0,
+	153, 154, 155, 257, 0, 0, 325, 326, 327, 310,
+	983, 0, 0, 0, 0, 189, 0, 0, 215, 0,
+	0, 0, 287, 241, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 112, 0, 1445, 984, 1442, 0, 0,
+	171, 1444, 1441, 1443, 1447, 1448, 0, 0, 0, 1446,
+	174, 0, 0, 979, 980, 981, 978, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 163, 292, 306, 172,
+	283, 320, 177, 290, 168, 256, 279, 0, 0, 165,
+	304, 289, 238, 208, 209, 164, 0, 273, 187, 200,
+	184, 254, 0, 0, 332, 183, 323, 0, 315, 167,
+	0, 314, 253, 301, 305, 239, 221, 166, 303, 237,
+	220, 213, 191, 313, 204, 265, 219, 266, 205, 243,
+	242, 244, 0, 1430, 1431, 1432, 1433, 1434, 1435, 1436,
+	1437, 1438, 1439, 1440, 1452, 1453, 1454, 1455, 1456, 1457,
+	1450, 1451, 317, 0, 0, 0, 0, 0, 0, 291,
+	0, 0, 214, 0, 0, 0, 333, 0, 277, 259,
+	0, 0, 0, 275, 217, 302, 267, 307, 293, 316,
+	270, 268, 158, 294, 186, 240, 169, 170, 182, 188,
+	190, 192, 193, 249, 250, 262, 282, 295, 296, 297,
+	185, 178, 276, 179, 202, 180, 159, 284, 181, 160,
+	263, 300, 0, 198, 271, 224, 161, 223, 264, 299,
+	298, 324, 330, 331, 335, 0, 336, 0, 0, 225,
+	274, 210, 0, 226, 227, 228, 229, 230, 231, 232,
+	162, 233, 234, 235, 236, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 329, 196, 156,
+	311, 0, 255, 0, 0, 0, 0, 0, 0, 0,
+	251, 328, 0, 0, 0, 0, 280, 0, 0, 0,
+	0, 0, 207, 261, 0, 281, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 288, 309,
+	322, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 312, 0, 0, 0, 321, 0, 0,
+	0, 0, 0, 0, 245, 246, 247, 248, 199, 0,
+	176, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 334, 195, 201, 0, 203, 175, 260, 197, 319,
+	211, 0, 252, 206, 285, 212, 218, 272, 318, 258,
+	278, 173, 308, 286, 222, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 157, 0, 216, 0, 269, 194, 115, 116, 117,
 	118, 119, 120, 121, 122, 123, 124, 125, 126, 127,
 	128, 129, 130, 131, 132, 133, 134, 135, 136, 137,
-	138, 139, 140, 141, 229, 0, 0, 295, 296, 297,
-	281, 0, 0, 0, 0, 0, 174, 1297, 0, 199,
-	0, 0, 0, 258, 213, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 103, 0, 0, 605, 0, 0,
-	0, 156, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 159, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 148, 263, 277,
-	157, 254, 290, 162, 261, 153, 228, 250, 0, 0,
-	150, 275, 260, 210, 193, 194, 149, 0, 245, 172,
-	185, 169, 226, 0, 0, 302, 168, 293, 0, 285,
-	152, 0, 284, 225, 272, 276, 211, 205, 151, 274,
-	209, 204, 197, 176, 189, 237, 203, 238, 190, 215,
-	214, 216, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 287, 0, 0, 0, 0, 0, 0, 262,
-	0, 0, 198, 0, 0, 0, 303, 0, 248, 231,
-	0, 0, 0, 246, 201, 273, 239, 278, 264, 286,
-	242, 240, 144, 265, 171, 212, 154, 155, 167, 173,
-	175, 177, 178, 221, 222, 234, 253, 266, 267, 268,
-	170, 163, 247, 164, 187, 165, 145, 255, 166, 146,
-	235, 271, 0, 183, 243, 208, 147, 207, 236, 270,
-	269, 294, 300, 301, 305, 0, 306, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 299, 181, 142, 282, 0, 227, 0, 0, 0,
-	0, 0, 0, 0, 223, 298, 0, 0, 0, 0,
-	251, 0, 0, 0, 0, 0, 192, 233, 0, 252,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 259, 280, 292, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 283, 0, 0,
-	0, 291, 0, 0, 0, 0, 0, 0, 217, 218,
-	219, 220, 184, 0, 161, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 304, 180, 186, 0, 188,
-	160, 232, 182, 289, 195, 0, 224, 191, 256, 196,
-	202, 244, 288, 230, 249, 158, 279, 257, 206, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 143, 0, 200, 0, 241,
-	179, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
-	125, 126, 127, 128, 129, 130, 131, 132, 133, 134,
-	135, 136, 137, 138, 139, 140, 141, 229, 0, 0,
-	295, 296, 297, 281, 0, 0, 0, 0, 0, 174,
-	0, 0, 199, 0, 0, 0, 258, 213, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 103, 725, 0,
-	0, 0, 0, 0, 156, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 159, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	148, 263, 277, 157, 254, 290, 162, 261, 153, 228,
-	250, 0, 0, 150, 275, 260, 210, 193, 194, 149,
-	0, 245, 172, 185, 169, 226, 0, 0, 302, 168,
-	293, 0, 285, 152, 0, 284, 225, 272, 276, 211,
-	205, 151, 274, 209, 204, 197, 176, 189, 237, 203,
-	238, 190, 215, 214, 216, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 287, 0, 0, 0, 0,
-	0, 0, 262, 0, 0, 198, 0, 0, 0, 303,
-	0, 248, 231, 0, 0, 0, 246, 201, 273, 239,
-	278, 264, 286, 242, 240, 144, 265, 171, 212, 154,
-	155, 167, 173, 175, 177, 178, 221, 222, 234, 253,
-	266, 267, 268, 170, 163, 247, 164, 187, 165, 145,
-	255, 166, 146, 235, 271, 0, 183, 243, 208, 147,
-	207, 236, 270, 269, 294, 300, 301, 305, 0, 306,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 299, 181, 142, 282, 0, 227,
-	0, 0, 0, 0, 0, 0, 0, 223, 298, 0,
-	0, 0, 0, 251, 0, 0, 0, 0, 0, 192,
-	233, 0, 252, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 259, 280, 292, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	283, 0, 0, 0, 291, 0, 0, 0, 0, 0,
-	0, 217, 218, 219, 220, 184, 0, 161, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 304, 180,
-	186, 0, 188, 160, 232, 182, 289, 195, 0, 224,
-	191, 256, 196, 202, 244, 288, 230, 249, 158, 279,
-	257, 206, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 143, 0,
-	200, 0, 241, 179, 106, 107, 108, 109, 110, 111,
-	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
+	138, 139, 140, 141, 142, 143, 144, 145, 146, 147,
+	148, 149, 150, 151, 152, 257, 153, 154, 155, 0,
+	0, 0, 325, 326, 327, 310, 0, 189, 465, 0,
+	215, 0, 0, 0, 287, 241, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 112, 473, 474, 0, 0,
+	0, 0, 171, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 478, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 163, 292,
+	306, 172, 283, 320, 177, 290, 168, 256, 279, 0,
+	0, 165, 304, 289, 238, 208, 209, 164, 0, 273,
+	187, 200, 184, 254, 0, 0, 332, 183, 323, 445,
+	315, 167, 444, 314, 253, 301, 305, 239, 221, 166,
+	303, 237, 220, 213, 191, 313, 204, 265, 219, 266,
+	205, 243, 242, 244, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 317, 0, 0, 0, 0, 0,
+	0, 291, 0, 0, 214, 0, 0, 0, 333, 0,
+	277, 259, 0, 0, 0, 275, 217, 302, 267, 307,
+	293, 316, 464, 268, 158, 294, 186, 240, 169, 170,
+	182, 188, 190, 192, 193, 249, 250, 262, 282, 295,
+	296, 297, 185, 178, 276, 179, 202, 180, 159, 284,
+	181, 160, 263, 300, 0, 198, 271, 224, 161, 223,
+	264, 299, 298, 324, 330, 331, 335, 0, 336, 0,
+	0, 225, 274, 210, 0, 226, 227, 228, 229, 230,
+	231, 232, 162, 233, 234, 235, 236, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 329,
+	196, 156, 311, 0, 255, 0, 0, 0, 0, 0,
+	0, 0, 251, 328, 0, 0, 0, 0, 280, 0,
+	0, 0, 0, 0, 207, 261, 0, 281, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	288, 309, 322, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 312, 0, 0, 0, 321,
+	0, 0, 0, 0, 0, 467, 245, 246, 247, 248,
+	199, 0, 176, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 334, 195, 201, 0, 203, 175, 260,
+	197, 319, 211, 0, 475, 470, 471, 212, 218, 272,
+	318, 258, 278, 173, 308, 286, 472, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 157, 0, 216, 0, 269, 194, 115,
+	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
+	126, 127, 128, 129, 130, 131, 132, 133, 134, 135,
+	136, 137, 138, 139, 140, 141, 142, 143, 144, 145,
+	146, 147, 148, 149, 150, 151, 152, 106, 153, 154,
+	155, 0, 0, 0, 325, 326, 327, 310, 0, 257,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 189, 0, 0, 215, 0, 0, 0, 287, 241,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 103, 0, 1077, 0, 112,
+	0, 0, 0, 0, 0, 0, 171, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 174, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 163, 292, 306, 172, 283, 320, 177, 290,
+	168, 256, 279, 0, 0, 165, 304, 289, 238, 208,
+	209, 164, 0, 273, 187, 200, 184, 254, 0, 0,
+	332, 183, 323, 0, 315, 167, 0, 314, 253, 301,
+	305, 239, 221, 166, 303, 237, 220, 213, 191, 313,
+	204, 265, 219, 266, 205, 243, 242, 244, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 317, 0,
+	0, 0, 0, 0, 0, 291, 0, 0, 214, 0,
+	0, 0, 333, 0, 277, 259, 0, 0, 0, 275,
+	217, 302, 267, 307, 293, 316, 270, 268, 158, 294,
+	186, 240, 169, 170, 182, 188, 190, 192, 193, 249,
+	250, 262, 282, 295, 296, 297, 185, 178, 276, 179,
+	202, 180, 159, 284, 181, 160, 263, 300, 0, 198,
+	271, 224, 161, 223, 264, 299, 298, 324, 330, 331,
+	335, 0, 336, 0, 0, 225, 274, 210, 0, 226,
+	227, 228, 229, 230, 231, 232, 162, 233, 234, 235,
+	236, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 329, 196, 156, 311, 0, 255, 0,
+	0, 0, 0, 0, 0, 0, 251, 328, 0, 0,
+	0, 0, 280, 0, 0, 0, 0, 0, 207, 261,
+	0, 281, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 288, 309, 322, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 312,
+	0, 0, 0, 321, 0, 0, 0, 0, 0, 0,
+	245, 246, 247, 248, 199, 0, 176, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 334, 195, 201,
+	0, 203, 175, 260, 197, 319, 211, 0, 252, 206,
+	285, 212, 218, 272, 318, 258, 278, 173, 308, 286,
+	222, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 157, 0, 216,
+	77, 269, 194, 115, 116, 117, 118, 119, 120, 121,
 	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
 	132, 133, 134, 135, 136, 137, 138, 139, 140, 141,
-	229, 0, 0, 295, 296, 297, 281, 0, 0, 0,
-	0, 0, 174, 0, 0, 199, 0, 0, 0, 258,
-	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1986, 0, 0, 0,
-	103, 0, 0, 0, 0, 0, 0, 156, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 159, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 148, 263, 277, 157, 254, 290, 162,
-	261, 153, 228, 250, 0, 0, 150, 275, 260, 210,
-	193, 194, 149, 0, 245, 172, 185, 169, 226, 0,
-	0, 302, 168, 293, 0, 285, 152, 0, 284, 225,
-	272, 276, 211, 205, 151, 274, 209, 204, 197, 176,
-	189, 237, 203, 238, 190, 215, 214, 216, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 287, 0,
-	0, 0, 0, 0, 0, 262, 0, 0, 198, 0,
-	0, 0, 303, 0, 248, 231, 0, 0, 0, 246,
-	201, 273, 239, 278, 264, 286, 242, 240, 144, 265,
-	171, 212, 154, 155, 167, 173, 175, 177, 178, 221,
-	222, 234, 253, 266, 267, 268, 170, 163, 247, 164,
-	187, 165, 145, 255, 166, 146, 235, 271, 0, 183,
-	243, 208, 147, 207, 236, 270, 269, 294, 300, 301,
-	305, 0, 306, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 299, 181, 142,
-	282, 0, 227, 0, 0, 0, 0, 0, 0, 0,
-	223, 298, 0, 0, 0, 0, 251, 0, 0, 0,
-	0, 0, 192, 233, 0, 252, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 259, 280,
-	292, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 283, 0, 0, 0, 291, 0, 0,
-	0, 0, 0, 0, 217, 218, 219, 220, 184, 0,
-	161, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 304, 180, 186, 0, 188, 160, 232, 182, 289,
-	195, 0, 224, 191, 256, 196, 202, 244, 288, 230,
-	249, 158, 279, 257, 206, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 143, 0, 200, 0, 241, 179, 106, 107, 108,
-	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
-	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
-	139, 140, 141, 229, 0, 0, 295, 296, 297, 281,
-	0, 0, 0, 0, 0, 174, 0, 0, 199, 0,
-	0, 0, 258, 213, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 103, 0, 0, 0, 0, 0, 0,
-	156, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	159, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1675, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 148, 263, 277, 157,
-	254, 290, 162, 261, 153, 228, 250, 0, 0, 150,
-	275, 260, 210, 193, 194, 149, 0, 245, 172, 185,
-	169, 226, 0, 0, 302, 168, 293, 0, 285, 152,
-	0, 284, 225, 272, 276, 211, 205, 151, 274, 209,
-	204, 197, 176, 189, 237, 203, 238, 190, 215, 214,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 287, 0, 0, 0, 0, 0, 0, 262, 0,
-	0, 198, 0, 0, 0, 303, 0, 248, 231, 0,
-	0, 0, 246, 201, 273, 239, 278, 264, 286, 242,
-	240, 144, 265, 171, 212, 154, 155, 167, 173, 175,
-	177, 178, 221, 222, 234, 253, 266, 267, 268, 170,
-	163, 247, 164, 187, 165, 145, 255, 166, 146, 235,
-	271, 0, 183, 243, 208, 147, 207, 236, 270, 269,
-	294, 300, 301, 305, 0, 306, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	299, 181, 142, 282, 0, 227, 0, 0, 0, 0,
-	0, 0, 0, 223, 298, 0, 0, 0, 0, 251,
-	0, 0, 0, 0, 0, 192, 233, 0, 252, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 259, 280, 292, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 283, 0, 0, 0,
-	291, 0, 0, 0, 0, 0, 0, 217, 218, 219,
-	220, 184, 0, 161, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 304, 180, 186, 0, 188, 160,
-	232, 182, 289, 195, 0, 224, 191, 256, 196, 202,
-	244, 288, 230, 249, 158, 279, 257, 206, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 143, 0, 200, 0, 241, 179,
-	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+	142, 143, 144, 145, 146, 147, 148, 149, 150, 151,
+	152, 257, 153, 154, 155, 0, 0, 0, 325, 326,
+	327, 310, 0, 189, 0, 0, 215, 0, 0, 0,
+	287, 241, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 112, 473, 474, 0, 0, 0, 0, 171, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 478, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 163, 292, 306, 172, 283, 320,
+	177, 290, 168, 256, 279, 0, 0, 165, 304, 289,
+	238, 208, 209, 164, 0, 273, 187, 200, 184, 254,
+	0, 0, 332, 183, 323, 445, 315, 167, 444, 314,
+	253, 301, 305, 239, 221, 166, 303, 237, 220, 213,
+	191, 313, 204, 265, 219, 266, 205, 243, 242, 244,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	317, 0, 0, 0, 0, 0, 0, 291, 0, 0,
+	214, 0, 0, 0, 333, 0, 277, 259, 0, 0,
+	0, 275, 217, 302, 267, 307, 293, 316, 270, 268,
+	158, 294, 186, 240, 169, 170, 182, 188, 190, 192,
+	193, 249, 250, 262, 282, 295, 296, 297, 185, 178,
+	276, 179, 202, 180, 159, 284, 181, 160, 263, 300,
+	0, 198, 271, 224, 161, 223, 264, 299, 298, 324,
+	330, 331, 335, 0, 336, 0, 0, 225, 274, 210,
+	0, 226, 227, 228, 229, 230, 231, 232, 162, 233,
+	234, 235, 236, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 329, 196, 156, 311, 0,
+	255, 0, 0, 0, 0, 0, 0, 0, 251, 328,
+	0, 0, 0, 0, 280, 0, 0, 0, 0, 0,
+	207, 261, 0, 281, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 288, 309, 322, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 312, 0, 0, 0, 321, 0, 0, 0, 0,
+	0, 0, 245, 246, 247, 248, 199, 0, 176, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 334,
+	195, 201, 0, 203, 175, 260, 197, 319, 211, 0,
+	475, 470, 471, 212, 218, 272, 318, 258, 278, 173,
+	308, 286, 472, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 157,
+	0, 216, 0, 269, 194, 115, 116, 117, 118, 119,
+	120, 121, 122, 123, 124, 125, 126, 127, 128, 129,
+	130, 131, 132, 133, 134, 135, 136, 137, 138, 139,
+	140, 141, 142, 143, 144, 145, 146, 147, 148, 149,
+	150, 151, 152, 257, 153, 154, 155, 0, 0, 0,
+	325, 326, 327, 310, 0, 189, 651, 0, 215, 0,
+	0, 0, 287, 241, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 112, 0, 0, 649, 0, 0, 0,
+	171, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	174, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 647, 0, 0, 0, 163, 292, 306, 172,
+	283, 320, 177, 290, 168, 256, 279, 0, 0, 165,
+	304, 289, 238, 208, 209, 164, 0, 273, 187, 200,
+	184, 254, 0, 0, 332, 183, 323, 0, 315, 167,
+	0, 314, 253, 301, 305, 239, 221, 166, 303, 237,
+	220, 213, 191, 313, 204, 265, 219, 266, 205, 243,
+	242, 244, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 317, 0, 0, 0, 0, 0, 0, 291,
+	0, 0, 214, 0, 0, 0, 333, 0, 277, 259,
+	0, 0, 0, 275, 217, 302, 267, 307, 293, 316,
+	270, 268, 158, 294, 186, 240, 169, 170, 182, 188,
+	190, 192, 193, 249, 250, 262, 282, 295, 296, 297,
+	185, 178, 276, 179, 202, 180, 159, 284, 181, 160,
+	263, 300, 0, 198, 271, 224, 161, 223, 264, 299,
+	298, 324, 330, 331, 335, 0, 336, 0, 0, 225,
+	274, 210, 0, 226, 227, 228, 229, 230, 231, 232,
+	162, 233, 234, 235, 236, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 329, 196, 156,
+	311, 0, 255, 0, 0, 0, 0, 0, 0, 0,
+	251, 328, 0, 0, 0, 0, 280, 0, 0, 0,
+	0, 0, 207, 261, 0, 281, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 288, 309,
+	322, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 312, 0, 0, 0, 321, 0, 0,
+	0, 0, 0, 0, 245, 246, 247, 248, 199, 0,
+	176, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 334, 195, 201, 0, 203, 175, 260, 197, 319,
+	211, 0, 252, 206, 285, 212, 218, 272, 318, 258,
+	278, 173, 308, 286, 222, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 157, 0, 216, 0, 269, 194, 115, 116, 117,
+	118, 119, 120, 121, 122, 123, 124, 125, 126, 127,
+	128, 129, 130, 131, 132, 133, 134, 135, 136, 137,
+	138, 139, 140, 141, 142, 143, 144, 145, 146, 147,
+	148, 149, 150, 151, 152, 257, 153, 154, 155, 0,
+	0, 0, 325, 326, 327, 310, 0, 189, 645, 0,
+	215, 0, 0, 0, 287, 241, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 112, 0, 0, 649, 0,
+	0, 0, 171, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 174, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 647, 0, 0, 0, 163, 292,
+	306, 172, 283, 320, 177, 290, 168, 256, 279, 0,
+	0, 165, 304, 289, 238, 208, 209, 164, 0, 273,
+	187, 200, 184, 254, 0, 0, 332, 183, 323, 0,
+	315, 167, 0, 314, 253, 301, 305, 239, 221, 166,
+	303, 237, 220, 213, 191, 313, 204, 265, 219, 266,
+	205, 243, 242, 244, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 317, 0, 0, 0, 0, 0,
+	0, 291, 0, 0, 214, 0, 0, 0, 333, 0,
+	277, 259, 0, 0, 0, 275, 217, 302, 267, 307,
+	293, 316, 270, 268, 158, 294, 186, 240, 169, 170,
+	182, 188, 190, 192, 193, 249, 250, 262, 282, 295,
+	296, 297, 185, 178, 276, 179, 202, 180, 159, 284,
+	181, 160, 263, 300, 0, 198, 271, 224, 161, 223,
+	264, 299, 298, 324, 330, 331, 335, 0, 336, 0,
+	0, 225, 274, 210, 0, 226, 227, 228, 229, 230,
+	231, 232, 162, 233, 234, 235, 236, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 329,
+	196, 156, 311, 0, 255, 0, 0, 0, 0, 0,
+	0, 0, 251, 328, 0, 0, 0, 0, 280, 0,
+	0, 0, 0, 0, 207, 261, 0, 281, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	288, 309, 322, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 312, 0, 0, 0, 321,
+	0, 0, 0, 0, 0, 0, 245, 246, 247, 248,
+	199, 0, 176, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 334, 195, 201, 0, 203, 175, 260,
+	197, 319, 211, 0, 252, 206, 285, 212, 218, 272,
+	318, 258, 278, 173, 308, 286, 222, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 157, 0, 216, 0, 269, 194, 115,
 	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
 	126, 127, 128, 129, 130, 131, 132, 133, 134, 135,
-	136, 137, 138, 139, 140, 141, 229, 0, 0, 295,
-	296, 297, 281, 0, 0, 0, 0, 0, 174, 0,
-	0, 199, 0, 0, 0, 258, 213, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 869, 0, 0, 0, 103, 0, 0, 0,
-	0, 0, 0, 156, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 159, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 148,
-	263, 277, 157, 254, 290, 162, 261, 153, 228, 250,
-	0, 0, 150, 275, 260, 210, 193, 194, 149, 0,
-	245, 172, 185, 169, 226, 0, 0, 302, 168, 293,
-	0, 285, 152, 0, 284, 225, 272, 276, 211, 205,
-	151, 274, 209, 204, 197, 176, 189, 237, 203, 238,
-	190, 215, 214, 216, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 287, 0, 0, 0, 0, 0,
-	0, 262, 0, 0, 198, 0, 0, 0, 303, 0,
-	248, 231, 0, 0, 0, 246, 201, 273, 239, 278,
-	264, 286, 242, 240, 144, 265, 171, 212, 154, 155,
-	167, 173, 175, 177, 178, 221, 222, 234, 253, 266,
-	267, 268, 170, 163, 247, 164, 187, 165, 145, 255,
-	166, 146, 235, 271, 0, 183, 243, 208, 147, 207,
-	236, 270, 269, 294, 300, 301, 305, 0, 306, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 299, 181, 142, 282, 0, 227, 0,
-	0, 0, 0, 0, 0, 0, 223, 298, 0, 0,
-	0, 0, 251, 0, 0, 0, 0, 0, 192, 233,
-	0, 252, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 259, 280, 292, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 283,
-	0, 0, 0, 291, 0, 0, 0, 0, 0, 0,
-	217, 218, 219, 220, 184, 0, 161, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 304, 180, 186,
-	0, 188, 160, 232, 182, 289, 195, 0, 224, 191,
-	256, 196, 202, 244, 288, 230, 249, 158, 279, 257,
-	206, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 143, 0, 200,
-	0, 241, 179, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
-	123, 124, 125, 126, 127, 128, 129, 130, 131, 132,
-	133, 134, 135, 136, 137, 138, 139, 140, 141, 229,
-	0, 0, 295, 296, 297, 281, 0, 0, 0, 0,
-	0, 174, 0, 0, 199, 0, 0, 0, 258, 213,
+	136, 137, 138, 139, 140, 141, 142, 143, 144, 145,
+	146, 147, 148, 149, 150, 151, 152, 257, 153, 154,
+	155, 0, 0, 0, 325, 326, 327, 310, 0, 189,
+	0, 0, 215, 0, 0, 0, 287, 241, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 103,
-	0, 0, 605, 0, 0, 0, 156, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 159, 0, 0, 0,
+	0, 0, 0, 0, 0, 2417, 0, 112, 780, 0,
+	0, 0, 0, 0, 171, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 174, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 148, 263, 277, 157, 254, 290, 162, 261,
-	153, 228, 250, 0, 0, 150, 275, 260, 210, 193,
-	194, 149, 0, 245, 172, 185, 169, 226, 0, 0,
-	302, 168, 293, 0, 285, 152, 0, 284, 225, 272,
-	276, 211, 205, 151, 274, 209, 204, 197, 176, 189,
-	237, 203, 238, 190, 215, 214, 216, 0, 0, 0,
+	163, 292, 306, 172, 283, 320, 177, 290, 168, 256,
+	279, 0, 0, 165, 304, 289, 238, 208, 209, 164,
+	0, 273, 187, 200, 184, 254, 0, 0, 332, 183,
+	323, 0, 315, 167, 0, 314, 253, 301, 305, 239,
+	221, 166, 303, 237, 220, 213, 191, 313, 204, 265,
+	219, 266, 205, 243, 242, 244, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 287, 0, 0,
-	0, 0, 0, 0, 262, 0, 0, 198, 0, 0,
-	0, 303, 0, 248, 231, 0, 0, 0, 246, 201,
-	273, 239, 278, 264, 286, 242, 240, 144, 265, 171,
-	212, 154, 155, 167, 173, 175, 177, 178, 221, 222,
-	234, 253, 266, 267, 268, 170, 163, 247, 164, 187,
-	165, 145, 255, 166, 146, 235, 271, 0, 183, 243,
-	208, 147, 207, 236, 270, 269, 294, 300, 301, 305,
-	0, 306, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 299, 181, 142, 282,
-	0, 227, 0, 0, 0, 0, 0, 0, 0, 223,
-	298, 0, 0, 0, 0, 251, 0, 0, 0, 0,
-	0, 192, 233, 0, 252, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 259, 280, 292,
+	0, 0, 0, 0, 0, 0, 317, 0, 0, 0,
+	0, 0, 0, 291, 0, 0, 214, 0, 0, 0,
+	333, 0, 277, 259, 0, 0, 0, 275, 217, 302,
+	267, 307, 293, 316, 270, 268, 158, 294, 186, 240,
+	169, 170, 182, 188, 190, 192, 193, 249, 250, 262,
+	282, 295, 296, 297, 185, 178, 276, 179, 202, 180,
+	159, 284, 181, 160, 263, 300, 0, 198, 271, 224,
+	161, 223, 264, 299, 298, 324, 330, 331, 335, 0,
+	336, 0, 0, 225, 274, 210, 0, 226, 227, 228,
+	229, 230, 231, 232, 162, 233, 234, 235, 236, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 283, 0, 0, 0, 291, 0, 0, 0,
-	0, 0, 0, 217, 218, 219, 220, 184, 0, 161,
+	0, 329, 196, 156, 311, 0, 255, 0, 0, 0,
+	0, 0, 0, 0, 251, 328, 0, 0, 0, 0,
+	280, 0, 0, 0, 0, 0, 207, 261, 0, 281,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	304, 180, 186, 0, 188, 160, 232, 182, 289, 195,
-	0, 224, 191, 256, 196, 202, 244, 288, 230, 249,
-	158, 279, 257, 206, 0, 0, 0, 0, 0, 0,
+	0, 0, 288, 309, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 312, 0, 0,
+	0, 321, 0, 0, 0, 0, 0, 0, 245, 246,
+	247, 248, 199, 0, 176, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 334, 195, 201, 0, 203,
+	175, 260, 197, 319, 211, 0, 252, 206, 285, 212,
+	218, 272, 318, 258, 278, 173, 308, 286, 222, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	143, 0, 200, 0, 241, 179, 106, 107, 108, 109,
-	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+	0, 0, 0, 0, 0, 157, 0, 216, 0, 269,
+	194, 115, 116, 117, 118, 119, 120, 121, 122, 123,
+	124, 125, 126, 127, 128, 129, 130, 131, 132, 133,
+	134, 135, 136, 137, 138, 139, 140, 141, 142, 143,
+	144, 145, 146, 147, 148, 149, 150, 151, 152, 257,
+	153, 154, 155, 0, 0, 0, 325, 326, 327, 310,
+	0, 189, 0, 0, 215, 0, 0, 0, 287, 241,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 112,
+	0, 0, 649, 0, 0, 0, 171, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 174, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 647, 0,
+	0, 0, 163, 292, 306, 172, 283, 320, 177, 290,
+	168, 256, 279, 0, 0, 165, 304, 289, 238, 208,
+	209, 164, 0, 273, 187, 200, 184, 254, 0, 0,
+	332, 183, 323, 0, 315, 167, 0, 314, 253, 301,
+	305, 239, 221, 166, 303, 237, 220, 213, 191, 313,
+	204, 265, 219, 266, 205, 243, 242, 244, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 317, 0,
+	0, 0, 0, 0, 0, 291, 0, 0, 214, 0,
+	0, 0, 333, 0, 277, 259, 0, 0, 0, 275,
+	217, 302, 267, 307, 293, 316, 270, 268, 158, 294,
+	186, 240, 169, 170, 182, 188, 190, 192, 193, 249,
+	250, 262, 282, 295, 296, 297, 185, 178, 276, 179,
+	202, 180, 159, 284, 181, 160, 263, 300, 0, 198,
+	271, 224, 161, 223, 264, 299, 298, 324, 330, 331,
+	335, 0, 336, 0, 0, 225, 274, 210, 0, 226,
+	227, 228, 229, 230, 231, 232, 162, 233, 234, 235,
+	236, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 329, 196, 156, 311, 0, 255, 0,
+	0, 0, 0, 0, 0, 0, 251, 328, 0, 0,
+	0, 0, 280, 0, 0, 0, 0, 0, 207, 261,
+	0, 281, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 288, 309, 322, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 312,
+	0, 0, 0, 321, 0, 0, 0, 0, 0, 0,
+	245, 246, 247, 248, 199, 0, 176, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 334, 195, 201,
+	0, 203, 175, 260, 197, 319, 211, 0, 252, 206,
+	285, 212, 218, 272, 318, 258, 278, 173, 308, 286,
+	222, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 157, 0, 216,
+	0, 269, 194, 115, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
+	132, 133, 134, 135, 136, 137, 138, 139, 140, 141,
+	142, 143, 144, 145, 146, 147, 148, 149, 150, 151,
+	152, 257, 153, 154, 155, 0, 0, 0, 325, 326,
+	327, 310, 0, 189, 0, 0, 215, 0, 0, 0,
+	287, 241, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 112, 0, 0, 649, 0, 0, 0, 171, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 174, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2008, 0, 0, 0, 163, 292, 306, 172, 283, 320,
+	177, 290, 168, 256, 279, 0, 0, 165, 304, 289,
+	238, 208, 209, 164, 0, 273, 187, 200, 184, 254,
+	0, 0, 332, 183, 323, 0, 315, 167, 0, 314,
+	253, 301, 305, 239, 221, 166, 303, 237, 220, 213,
+	191, 313, 204, 265, 219, 266, 205, 243, 242, 244,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	317, 0, 0, 0, 0, 0, 0, 291, 0, 0,
+	214, 0, 0, 0, 333, 0, 277, 259, 0, 0,
+	0, 275, 217, 302, 267, 307, 293, 316, 270, 268,
+	158, 294, 186, 240, 169, 170, 182, 188, 190, 192,
+	193, 249, 250, 262, 282, 295, 296, 297, 185, 178,
+	276, 179, 202, 180, 159, 284, 181, 160, 263, 300,
+	0, 198, 271, 224, 161, 223, 264, 299, 298, 324,
+	330, 331, 335, 0, 336, 0, 0, 225, 274, 210,
+	0, 226, 227, 228, 229, 230, 231, 232, 162, 233,
+	234, 235, 236, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 329, 196, 156, 311, 0,
+	255, 0, 0, 0, 0, 0, 0, 0, 251, 328,
+	0, 0, 0, 0, 280, 0, 0, 0, 0, 0,
+	207, 261, 0, 281, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 288, 309, 322, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 312, 0, 0, 0, 321, 0, 0, 0, 0,
+	0, 0, 245, 246, 247, 248, 199, 0, 176, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 334,
+	195, 201, 0, 203, 175, 260, 197, 319, 211, 0,
+	252, 206, 285, 212, 218, 272, 318, 258, 278, 173,
+	308, 286, 222, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 157,
+	0, 216, 0, 269, 194, 115, 116, 117, 118, 119,
 	120, 121, 122, 123, 124, 125, 126, 127, 128, 129,
 	130, 131, 132, 133, 134, 135, 136, 137, 138, 139,
-	140, 141, 229, 0, 0, 295, 296, 297, 281, 0,
-	0, 0, 0, 0, 174, 0, 0, 199, 0, 0,
-	0, 258, 213, 0, 0, 0, 0, 0, 0, 0,
+	140, 141, 142, 143, 144, 145, 146, 147, 148, 149,
+	150, 151, 152, 257, 153, 154, 155, 0, 0, 0,
+	325, 326, 327, 310, 0, 189, 1386, 0, 215, 0,
+	0, 0, 287, 241, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 112, 0, 0, 649, 0, 0, 0,
+	171, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	174, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 163, 292, 306, 172,
+	283, 320, 177, 290, 168, 256, 279, 0, 0, 165,
+	304, 289, 238, 208, 209, 164, 0, 273, 187, 200,
+	184, 254, 0, 0, 332, 183, 323, 0, 315, 167,
+	0, 314, 253, 301, 305, 239, 221, 166, 303, 237,
+	220, 213, 191, 313, 204, 265, 219, 266, 205, 243,
+	242, 244, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 317, 0, 0, 0, 0, 0, 0, 291,
+	0, 0, 214, 0, 0, 0, 333, 0, 277, 259,
+	0, 0, 0, 275, 217, 302, 267, 307, 293, 316,
+	270, 268, 158, 294, 186, 240, 169, 170, 182, 188,
+	190, 192, 193, 249, 250, 262, 282, 295, 296, 297,
+	185, 178, 276, 179, 202, 180, 159, 284, 181, 160,
+	263, 300, 0, 198, 271, 224, 161, 223, 264, 299,
+	298, 324, 330, 331, 335, 0, 336, 0, 0, 225,
+	274, 210, 0, 226, 227, 228, 229, 230, 231, 232,
+	162, 233, 234, 235, 236, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 329, 196, 156,
+	311, 0, 255, 0, 0, 0, 0, 0, 0, 0,
+	251, 328, 0, 0, 0, 0, 280, 0, 0, 0,
+	0, 0, 207, 261, 0, 281, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 288, 309,
+	322, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 312, 0, 0, 0, 321, 0, 0,
+	0, 0, 0, 0, 245, 246, 247, 248, 199, 0,
+	176, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 334, 195, 201, 0, 203, 175, 260, 197, 319,
+	211, 0, 252, 206, 285, 212, 218, 272, 318, 258,
+	278, 173, 308, 286, 222, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 157, 0, 216, 0, 269, 194, 115, 116, 117,
+	118, 119, 120, 121, 122, 123, 124, 125, 126, 127,
+	128, 129, 130, 131, 132, 133, 134, 135, 136, 137,
+	138, 139, 140, 141, 142, 143, 144, 145, 146, 147,
+	148, 149, 150, 151, 152, 257, 153, 154, 155, 0,
+	0, 0, 325, 326, 327, 310, 0, 189, 0, 0,
+	215, 0, 0, 0, 287, 241, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 112, 780, 0, 0, 0,
+	0, 0, 171, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 174, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 163, 292,
+	306, 172, 283, 320, 177, 290, 168, 256, 279, 0,
+	0, 165, 304, 289, 238, 208, 209, 164, 0, 273,
+	187, 200, 184, 254, 0, 0, 332, 183, 323, 0,
+	315, 167, 0, 314, 253, 301, 305, 239, 221, 166,
+	303, 237, 220, 213, 191, 313, 204, 265, 219, 266,
+	205, 243, 242, 244, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 317, 0, 0, 0, 0, 0,
+	0, 291, 0, 0, 214, 0, 0, 0, 333, 0,
+	277, 259, 0, 0, 0, 275, 217, 302, 267, 307,
+	293, 316, 270, 268, 158, 294, 186, 240, 169, 170,
+	182, 188, 190, 192, 193, 249, 250, 262, 282, 295,
+	296, 297, 185, 178, 276, 179, 202, 180, 159, 284,
+	181, 160, 263, 300, 0, 198, 271, 224, 161, 223,
+	264, 299, 298, 324, 330, 331, 335, 0, 336, 0,
+	0, 225, 274, 210, 0, 226, 227, 228, 229, 230,
+	231, 232, 162, 233, 234, 235, 236, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 329,
+	196, 156, 311, 0, 255, 0, 0, 0, 0, 0,
+	0, 0, 251, 328, 0, 0, 0, 0, 280, 0,
+	0, 0, 0, 0, 207, 261, 0, 281, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	288, 309, 322, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 312, 0, 0, 0, 321,
+	0, 0, 0, 0, 0, 0, 245, 246, 247, 248,
+	199, 0, 176, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 334, 195, 201, 0, 203, 175, 260,
+	197, 319, 211, 0, 252, 206, 285, 212, 218, 272,
+	318, 258, 278, 173, 308, 286, 222, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 157, 0, 216, 0, 269, 194, 115,
+	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
+	126, 127, 128, 129, 130, 131, 132, 133, 134, 135,
+	136, 137, 138, 139, 140, 141, 142, 143, 144, 145,
+	146, 147, 148, 149, 150, 151, 152, 257, 153, 154,
+	155, 0, 0, 0, 325, 326, 327, 310, 0, 189,
+	0, 0, 215, 0, 0, 0, 287, 241, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 103, 0, 0, 0, 0, 0, 0, 156,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 159,
+	0, 0, 0, 2156, 0, 0, 0, 112, 0, 0,
+	0, 0, 0, 0, 171, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 174, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1701, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 148, 263, 277, 157, 254,
-	290, 162, 261, 153, 228, 250, 0, 0, 150, 275,
-	260, 210, 193, 194, 149, 0, 245, 172, 185, 169,
-	226, 0, 0, 302, 168, 293, 0, 285, 152, 0,
-	284, 225, 272, 276, 211, 205, 151, 274, 209, 204,
-	197, 176, 189, 237, 203, 238, 190, 215, 214, 216,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	163, 292, 306, 172, 283, 320, 177, 290, 168, 256,
+	279, 0, 0, 165, 304, 289, 238, 208, 209, 164,
+	0, 273, 187, 200, 184, 254, 0, 0, 332, 183,
+	323, 0, 315, 167, 0, 314, 253, 301, 305, 239,
+	221, 166, 303, 237, 220, 213, 191, 313, 204, 265,
+	219, 266, 205, 243, 242, 244, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	287, 0, 0, 0, 0, 0, 0, 262, 0, 0,
-	198, 0, 0, 0, 303, 0, 248, 231, 0, 0,
-	0, 246, 201, 273, 239, 278, 264, 286, 242, 240,
-	144, 265, 171, 212, 154, 155, 167, 173, 175, 177,
-	178, 221, 222, 234, 253, 266, 267, 268, 170, 163,
-	247, 164, 187, 165, 145, 255, 166, 146, 235, 271,
-	0, 183, 243, 208, 147, 207, 236, 270, 269, 294,
-	300, 301, 305, 0, 306, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 299,
-	181, 142, 282, 0, 227, 0, 0, 0, 0, 0,
-	0, 0, 223, 298, 0, 0, 0, 0, 251, 0,
-	0, 0, 0, 0, 192, 233, 0, 252, 0, 0,
+	0, 0, 0, 0, 0, 0, 317, 0, 0, 0,
+	0, 0, 0, 291, 0, 0, 214, 0, 0, 0,
+	333, 0, 277, 259, 0, 0, 0, 275, 217, 302,
+	267, 307, 293, 316, 270, 268, 158, 294, 186, 240,
+	169, 170, 182, 188, 190, 192, 193, 249, 250, 262,
+	282, 295, 296, 297, 185, 178, 276, 179, 202, 180,
+	159, 284, 181, 160, 263, 300, 0, 198, 271, 224,
+	161, 223, 264, 299, 298, 324, 330, 331, 335, 0,
+	336, 0, 0, 225, 274, 210, 0, 226, 227, 228,
+	229, 230, 231, 232, 162, 233, 234, 235, 236, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	259, 280, 292, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 283, 0, 0, 0, 291,
-	0, 0, 0, 0, 0, 0, 217, 218, 219, 220,
-	184, 0, 161, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 304, 180, 186, 0, 188, 160, 232,
-	182, 289, 195, 0, 224, 191, 256, 196, 202, 244,
-	288, 230, 249, 158, 279, 257, 206, 0, 0, 0,
+	0, 329, 196, 156, 311, 0, 255, 0, 0, 0,
+	0, 0, 0, 0, 251, 328, 0, 0, 0, 0,
+	280, 0, 0, 0, 0, 0, 207, 261, 0, 281,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 288, 309, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 312, 0, 0,
+	0, 321, 0, 0, 0, 0, 0, 0, 245, 246,
+	247, 248, 199, 0, 176, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 334, 195, 201, 0, 203,
+	175, 260, 197, 319, 211, 0, 252, 206, 285, 212,
+	218, 272, 318, 258, 278, 173, 308, 286, 222, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 143, 0, 200, 0, 241, 179, 106,
-	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
-	127, 128, 129, 130, 131, 132, 133, 134, 135, 136,
-	137, 138, 139, 140, 141, 229, 0, 0, 295, 296,
-	297, 281, 0, 0, 0, 0, 0, 174, 0, 0,
-	199, 0, 0, 0, 258, 213, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 103, 0, 0, 0, 0,
-	0, 0, 156, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 159, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 148, 263,
-	277, 157, 254, 290, 162, 261, 153, 228, 250, 0,
-	0, 150, 275, 260, 210, 193, 194, 149, 0, 245,
-	172, 185, 169, 226, 0, 0, 302, 168, 293, 0,
-	285, 152, 0, 284, 225, 272, 276, 211, 205, 151,
-	274, 209, 204, 197, 176, 189, 237, 203, 238, 190,
-	215, 214, 216, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 287, 0, 0, 0, 0, 0, 0,
-	262, 0, 0, 198, 0, 0, 0, 303, 0, 248,
-	231, 0, 0, 0, 246, 201, 273, 239, 278, 264,
-	286, 242, 240, 144, 265, 171, 212, 154, 155, 167,
-	173, 175, 177, 178, 221, 222, 234, 253, 266, 267,
-	268, 170, 163, 247, 164, 187, 165, 145, 255, 166,
-	146, 235, 271, 0, 183, 243, 208, 147, 207, 236,
-	270, 269, 294, 300, 301, 305, 0, 306, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 299, 181, 142, 282, 0, 227, 0, 0,
-	0, 0, 0, 0, 0, 223, 298, 0, 0, 0,
-	0, 251, 0, 0, 0, 0, 0, 192, 233, 0,
-	252, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 259, 280, 292, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 283, 0,
-	0, 0, 291, 0, 0, 0, 0, 0, 0, 217,
-	218, 219, 220, 184, 0, 161, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 304, 180, 186, 0,
-	188, 160, 232, 182, 289, 195, 0, 224, 191, 256,
-	196, 202, 244, 288, 230, 249, 158, 279, 257, 206,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 143, 0, 200, 0,
-	241, 179, 106, 107, 108, 109, 110, 111, 112, 113,
-	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 157, 0, 216, 0, 269,
+	194, 115, 116, 117, 118, 119, 120, 121, 122, 123,
 	124, 125, 126, 127, 128, 129, 130, 131, 132, 133,
-	134, 135, 136, 137, 138, 139, 140, 141, 229, 0,
-	0, 295, 296, 297, 281, 0, 0, 0, 0, 0,
-	174, 0, 0, 199, 0, 0, 0, 258, 213, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 103, 0,
-	0, 1310, 0, 0, 0, 156, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 159, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 148, 263, 277, 157, 254, 290, 162, 261, 153,
-	228, 250, 0, 0, 150, 275, 260, 210, 193, 194,
-	149, 0, 245, 172, 185, 169, 226, 0, 0, 302,
-	168, 293, 0, 285, 152, 0, 284, 225, 272, 276,
-	211, 205, 151, 274, 209, 204, 197, 176, 189, 237,
-	203, 238, 190, 215, 214, 216, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 287, 0, 0, 0,
-	0, 0, 0, 262, 0, 0, 198, 0, 0, 0,
-	303, 0, 248, 231, 0, 0, 0, 246, 201, 273,
-	239, 278, 264, 286, 242, 240, 144, 265, 171, 212,
-	154, 155, 167, 173, 175, 177, 178, 221, 222, 234,
-	253, 266, 267, 268, 170, 163, 247, 164, 187, 165,
-	145, 255, 166, 146, 235, 271, 0, 183, 243, 208,
-	147, 207, 236, 270, 269, 294, 300, 301, 305, 0,
-	306, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 299, 181, 142, 282, 0,
-	227, 0, 0, 0, 0, 0, 0, 0, 223, 298,
-	0, 0, 0, 0, 251, 0, 0, 0, 0, 0,
-	192, 233, 0, 252, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 259, 280, 292, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 283, 0, 0, 0, 291, 0, 0, 0, 0,
-	0, 0, 217, 218, 219, 220, 184, 0, 161, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 304,
-	180, 186, 0, 188, 160, 232, 182, 289, 195, 0,
-	224, 191, 256, 196, 202, 244, 288, 230, 249, 158,
-	279, 257, 206, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 143,
-	0, 200, 0, 241, 179, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
-	131, 132, 133, 134, 135, 136, 137, 138, 139, 140,
-	141, 1239, 0, 0, 295, 296, 297, 281, 229, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	174, 0, 0, 199, 0, 0, 0, 258, 213, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 103, 0,
-	0, 0, 0, 0, 0, 156, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 159, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 148, 263, 277, 157, 254, 290, 162, 261, 153,
-	228, 250, 0, 0, 150, 275, 260, 210, 193, 194,
-	149, 0, 245, 172, 185, 169, 226, 0, 0, 302,
-	168, 293, 0, 285, 152, 0, 284, 225, 272, 276,
-	211, 205, 151, 274, 209, 204, 197, 176, 189, 237,
-	203, 238, 190, 215, 214, 216, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 287, 0, 0, 0,
-	0, 0, 0, 262, 0, 0, 198, 0, 0, 0,
-	303, 0, 248, 231, 0, 0, 0, 246, 201, 273,
-	239, 278, 264, 286, 242, 240, 144, 265, 171, 212,
-	154, 155, 167, 173, 175, 177, 178, 221, 222, 234,
-	253, 266, 267, 268, 170, 163, 247, 164, 187, 165,
-	145, 255, 166, 146, 235, 271, 0, 183, 243, 208,
-	147, 207, 236, 270, 269, 294, 300, 301, 305, 0,
-	306, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 299, 181, 142, 282, 0,
-	227, 0, 0, 0, 0, 0, 0, 0, 223, 298,
-	0, 0, 0, 0, 251, 0, 0, 0, 0, 0,
-	192, 233, 0, 252, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 259, 280, 292, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 283, 0, 0, 0, 291, 0, 0, 0, 0,
-	0, 0, 217, 218, 219, 220, 184, 0, 161, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 304,
-	180, 186, 0, 188, 160, 232, 182, 289, 195, 0,
-	224, 191, 256, 196, 202, 244, 288, 230, 249, 158,
-	279, 257, 206, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 143,
-	0, 200, 0, 241, 179, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
-	131, 132, 133, 134, 135, 136, 137, 138, 139, 140,
-	141, 229, 0, 0, 295, 296, 297, 281, 0, 0,
-	0, 0, 0, 174, 0, 0, 199, 0, 0, 0,
-	258, 213, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 103, 0, 0, 0, 0, 0, 0, 156, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 159, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 148, 263, 277, 157, 254, 290,
-	162, 261, 153, 228, 250, 0, 0, 150, 275, 260,
-	210, 193, 194, 149, 0, 245, 172, 185, 169, 226,
-	0, 0, 302, 168, 293, 0, 285, 152, 0, 284,
-	225, 272, 276, 211, 205, 151, 274, 209, 204, 197,
-	176, 189, 237, 203, 238, 190, 215, 214, 216, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 287,
-	0, 0, 1222, 0, 0, 0, 262, 0, 0, 198,
-	0, 0, 0, 303, 0, 248, 231, 0, 0, 0,
-	246, 201, 273, 239, 278, 264, 286, 242, 240, 144,
-	265, 171, 212, 154, 155, 167, 173, 175, 177, 178,
-	221, 222, 234, 253, 266, 267, 268, 170, 163, 247,
-	164, 187, 165, 145, 255, 166, 146, 235, 271, 0,
-	183, 243, 208, 147, 207, 236, 270, 269, 294, 300,
-	301, 305, 0, 306, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 299, 181,
-	142, 282, 0, 227, 0, 0, 0, 0, 0, 0,
-	0, 223, 298, 0, 0, 0, 0, 251, 0, 0,
-	0, 0, 0, 192, 233, 0, 252, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 259,
-	280, 292, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 283, 0, 0, 0, 291, 0,
-	0, 0, 0, 0, 0, 217, 218, 219, 220, 184,
-	0, 161, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 304, 180, 186, 0, 188, 160, 232, 182,
-	289, 195, 0, 224, 191, 256, 196, 202, 244, 288,
-	230, 249, 158, 279, 257, 206, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 143, 0, 200, 0, 241, 179, 106, 107,
-	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	134, 135, 136, 137, 138, 139, 140, 141, 142, 143,
+	144, 145, 146, 147, 148, 149, 150, 151, 152, 257,
+	153, 154, 155, 0, 0, 0, 325, 326, 327, 310,
+	0, 189, 0, 0, 215, 0, 0, 0, 287, 241,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 112,
+	0, 0, 0, 0, 0, 0, 171, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 174, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1854,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 163, 292, 306, 172, 283, 320, 177, 290,
+	168, 256, 279, 0, 0, 165, 304, 289, 238, 208,
+	209, 164, 0, 273, 187, 200, 184, 254, 0, 0,
+	332, 183, 323, 0, 315, 167, 0, 314, 253, 301,
+	305, 239, 221, 166, 303, 237, 220, 213, 191, 313,
+	204, 265, 219, 266, 205, 243, 242, 244, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 317, 0,
+	0, 0, 0, 0, 0, 291, 0, 0, 214, 0,
+	0, 0, 333, 0, 277, 259, 0, 0, 0, 275,
+	217, 302, 267, 307, 293, 316, 270, 268, 158, 294,
+	186, 240, 169, 170, 182, 188, 190, 192, 193, 249,
+	250, 262, 282, 295, 296, 297, 185, 178, 276, 179,
+	202, 180, 159, 284, 181, 160, 263, 300, 0, 198,
+	271, 224, 161, 223, 264, 299, 298, 324, 330, 331,
+	335, 0, 336, 0, 0, 225, 274, 210, 0, 226,
+	227, 228, 229, 230, 231, 232, 162, 233, 234, 235,
+	236, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 329, 196, 156, 311, 0, 255, 0,
+	0, 0, 0, 0, 0, 0, 251, 328, 0, 0,
+	0, 0, 280, 0, 0, 0, 0, 0, 207, 261,
+	0, 281, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 288, 309, 322, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 312,
+	0, 0, 0, 321, 0, 0, 0, 0, 0, 0,
+	245, 246, 247, 248, 199, 0, 176, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 334, 195, 201,
+	0, 203, 175, 260, 197, 319, 211, 0, 252, 206,
+	285, 212, 218, 272, 318, 258, 278, 173, 308, 286,
+	222, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 157, 0, 216,
+	0, 269, 194, 115, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
+	132, 133, 134, 135, 136, 137, 138, 139, 140, 141,
+	142, 143, 144, 145, 146, 147, 148, 149, 150, 151,
+	152, 257, 153, 154, 155, 0, 0, 0, 325, 326,
+	327, 310, 0, 189, 0, 0, 215, 0, 0, 0,
+	287, 241, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 931, 0, 0,
+	0, 112, 0, 0, 0, 0, 0, 0, 171, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 174, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 163, 292, 306, 172, 283, 320,
+	177, 290, 168, 256, 279, 0, 0, 165, 304, 289,
+	238, 208, 209, 164, 0, 273, 187, 200, 184, 254,
+	0, 0, 332, 183, 323, 0, 315, 167, 0, 314,
+	253, 301, 305, 239, 221, 166, 303, 237, 220, 213,
+	191, 313, 204, 265, 219, 266, 205, 243, 242, 244,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	317, 0, 0, 0, 0, 0, 0, 291, 0, 0,
+	214, 0, 0, 0, 333, 0, 277, 259, 0, 0,
+	0, 275, 217, 302, 267, 307, 293, 316, 270, 268,
+	158, 294, 186, 240, 169, 170, 182, 188, 190, 192,
+	193, 249, 250, 262, 282, 295, 296, 297, 185, 178,
+	276, 179, 202, 180, 159, 284, 181, 160, 263, 300,
+	0, 198, 271, 224, 161, 223, 264, 299, 298, 324,
+	330, 331, 335, 0, 336, 0, 0, 225, 274, 210,
+	0, 226, 227, 228, 229, 230, 231, 232, 162, 233,
+	234, 235, 236, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 329, 196, 156, 311, 0,
+	255, 0, 0, 0, 0, 0, 0, 0, 251, 328,
+	0, 0, 0, 0, 280, 0, 0, 0, 0, 0,
+	207, 261, 0, 281, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 288, 309, 322, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 312, 0, 0, 0, 321, 0, 0, 0, 0,
+	0, 0, 245, 246, 247, 248, 199, 0, 176, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 334,
+	195, 201, 0, 203, 175, 260, 197, 319, 211, 0,
+	252, 206, 285, 212, 218, 272, 318, 258, 278, 173,
+	308, 286, 222, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 157,
+	0, 216, 0, 269, 194, 115, 116, 117, 118, 119,
+	120, 121, 122, 123, 124, 125, 126, 127, 128, 129,
+	130, 131, 132, 133, 134, 135, 136, 137, 138, 139,
+	140, 141, 142, 143, 144, 145, 146, 147, 148, 149,
+	150, 151, 152, 257, 153, 154, 155, 0, 0, 0,
+	325, 326, 327, 310, 0, 189, 0, 0, 215, 0,
+	0, 0, 287, 241, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 112, 0, 0, 649, 0, 0, 0,
+	171, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	174, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 163, 292, 306, 172,
+	283, 320, 177, 290, 168, 256, 279, 0, 0, 165,
+	304, 289, 238, 208, 209, 164, 0, 273, 187, 200,
+	184, 254, 0, 0, 332, 183, 323, 0, 315, 167,
+	0, 314, 253, 301, 305, 239, 221, 166, 303, 237,
+	220, 213, 191, 313, 204, 265, 219, 266, 205, 243,
+	242, 244, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 317, 0, 0, 0, 0, 0, 0, 291,
+	0, 0, 214, 0, 0, 0, 333, 0, 277, 259,
+	0, 0, 0, 275, 217, 302, 267, 307, 293, 316,
+	270, 268, 158, 294, 186, 240, 169, 170, 182, 188,
+	190, 192, 193, 249, 250, 262, 282, 295, 296, 297,
+	185, 178, 276, 179, 202, 180, 159, 284, 181, 160,
+	263, 300, 0, 198, 271, 224, 161, 223, 264, 299,
+	298, 324, 330, 331, 335, 0, 336, 0, 0, 225,
+	274, 210, 0, 226, 227, 228, 229, 230, 231, 232,
+	162, 233, 234, 235, 236, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 329, 196, 156,
+	311, 0, 255, 0, 0, 0, 0, 0, 0, 0,
+	251, 328, 0, 0, 0, 0, 280, 0, 0, 0,
+	0, 0, 207, 261, 0, 281, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 288, 309,
+	322, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 312, 0, 0, 0, 321, 0, 0,
+	0, 0, 0, 0, 245, 246, 247, 248, 199, 0,
+	176, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 334, 195, 201, 0, 203, 175, 260, 197, 319,
+	211, 0, 252, 206, 285, 212, 218, 272, 318, 258,
+	278, 173, 308, 286, 222, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 157, 0, 216, 0, 269, 194, 115, 116, 117,
 	118, 119, 120, 121, 122, 123, 124, 125, 126, 127,
 	128, 129, 130, 131, 132, 133, 134, 135, 136, 137,
-	138, 139, 140, 141, 229, 0, 0, 295, 296, 297,
-	281, 0, 0, 0, 0, 0, 174, 0, 0, 199,
-	0, 0, 0, 258, 213, 0, 0, 0, 0, 0,
+	138, 139, 140, 141, 142, 143, 144, 145, 146, 147,
+	148, 149, 150, 151, 152, 257, 153, 154, 155, 0,
+	0, 0, 325, 326, 327, 310, 0, 189, 0, 0,
+	215, 0, 0, 0, 287, 241, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 112, 0, 0, 0, 0,
+	0, 0, 171, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 174, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1881, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 163, 292,
+	306, 172, 283, 320, 177, 290, 168, 256, 279, 0,
+	0, 165, 304, 289, 238, 208, 209, 164, 0, 273,
+	187, 200, 184, 254, 0, 0, 332, 183, 323, 0,
+	315, 167, 0, 314, 253, 301, 305, 239, 221, 166,
+	303, 237, 220, 213, 191, 313, 204, 265, 219, 266,
+	205, 243, 242, 244, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 317, 0, 0, 0, 0, 0,
+	0, 291, 0, 0, 214, 0, 0, 0, 333, 0,
+	277, 259, 0, 0, 0, 275, 217, 302, 267, 307,
+	293, 316, 270, 268, 158, 294, 186, 240, 169, 170,
+	182, 188, 190, 192, 193, 249, 250, 262, 282, 295,
+	296, 297, 185, 178, 276, 179, 202, 180, 159, 284,
+	181, 160, 263, 300, 0, 198, 271, 224, 161, 223,
+	264, 299, 298, 324, 330, 331, 335, 0, 336, 0,
+	0, 225, 274, 210, 0, 226, 227, 228, 229, 230,
+	231, 232, 162, 233, 234, 235, 236, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 329,
+	196, 156, 311, 0, 255, 0, 0, 0, 0, 0,
+	0, 0, 251, 328, 0, 0, 0, 0, 280, 0,
+	0, 0, 0, 0, 207, 261, 0, 281, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	288, 309, 322, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 312, 0, 0, 0, 321,
+	0, 0, 0, 0, 0, 0, 245, 246, 247, 248,
+	199, 0, 176, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 334, 195, 201, 0, 203, 175, 260,
+	197, 319, 211, 0, 252, 206, 285, 212, 218, 272,
+	318, 258, 278, 173, 308, 286, 222, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 157, 0, 216, 0, 269, 194, 115,
+	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
+	126, 127, 128, 129, 130, 131, 132, 133, 134, 135,
+	136, 137, 138, 139, 140, 141, 142, 143, 144, 145,
+	146, 147, 148, 149, 150, 151, 152, 257, 153, 154,
+	155, 0, 0, 0, 325, 326, 327, 310, 0, 189,
+	0, 0, 215, 0, 0, 0, 287, 241, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 112, 0, 0,
+	0, 0, 0, 0, 171, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 174, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1401, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	163, 292, 306, 172, 283, 320, 177, 290, 168, 256,
+	279, 0, 0, 165, 304, 289, 238, 208, 209, 164,
+	0, 273, 187, 200, 184, 254, 0, 0, 332, 183,
+	323, 0, 315, 167, 0, 314, 253, 301, 305, 239,
+	221, 166, 303, 237, 220, 213, 191, 313, 204, 265,
+	219, 266, 205, 243, 242, 244, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 317, 0, 0, 0,
+	0, 0, 0, 291, 0, 0, 214, 0, 0, 0,
+	333, 0, 277, 259, 0, 0, 0, 275, 217, 302,
+	267, 307, 293, 316, 270, 268, 158, 294, 186, 240,
+	169, 170, 182, 188, 190, 192, 193, 249, 250, 262,
+	282, 295, 296, 297, 185, 178, 276, 179, 202, 180,
+	159, 284, 181, 160, 263, 300, 0, 198, 271, 224,
+	161, 223, 264, 299, 298, 324, 330, 331, 335, 0,
+	336, 0, 0, 225, 274, 210, 0, 226, 227, 228,
+	229, 230, 231, 232, 162, 233, 234, 235, 236, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 329, 196, 156, 311, 0, 255, 0, 0, 0,
+	0, 0, 0, 0, 251, 328, 0, 0, 0, 0,
+	280, 0, 0, 0, 0, 0, 207, 261, 0, 281,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 288, 309, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 312, 0, 0,
+	0, 321, 0, 0, 0, 0, 0, 0, 245, 246,
+	247, 248, 199, 0, 176, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 334, 195, 201, 0, 203,
+	175, 260, 197, 319, 211, 0, 252, 206, 285, 212,
+	218, 272, 318, 258, 278, 173, 308, 286, 222, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 157, 0, 216, 0, 269,
+	194, 115, 116, 117, 118, 119, 120, 121, 122, 123,
+	124, 125, 126, 127, 128, 129, 130, 131, 132, 133,
+	134, 135, 136, 137, 138, 139, 140, 141, 142, 143,
+	144, 145, 146, 147, 148, 149, 150, 151, 152, 257,
+	153, 154, 155, 0, 0, 0, 325, 326, 327, 310,
+	0, 189, 0, 0, 215, 0, 0, 0, 287, 241,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 112,
+	0, 0, 1399, 0, 0, 0, 171, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 174, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 163, 292, 306, 172, 283, 320, 177, 290,
+	168, 256, 279, 0, 0, 165, 304, 289, 238, 208,
+	209, 164, 0, 273, 187, 200, 184, 254, 0, 0,
+	332, 183, 323, 0, 315, 167, 0, 314, 253, 301,
+	305, 239, 221, 166, 303, 237, 220, 213, 191, 313,
+	204, 265, 219, 266, 205, 243, 242, 244, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 317, 0,
+	0, 0, 0, 0, 0, 291, 0, 0, 214, 0,
+	0, 0, 333, 0, 277, 259, 0, 0, 0, 275,
+	217, 302, 267, 307, 293, 316, 270, 268, 158, 294,
+	186, 240, 169, 170, 182, 188, 190, 192, 193, 249,
+	250, 262, 282, 295, 296, 297, 185, 178, 276, 179,
+	202, 180, 159, 284, 181, 160, 263, 300, 0, 198,
+	271, 224, 161, 223, 264, 299, 298, 324, 330, 331,
+	335, 0, 336, 0, 0, 225, 274, 210, 0, 226,
+	227, 228, 229, 230, 231, 232, 162, 233, 234, 235,
+	236, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 329, 196, 156, 311, 0, 255, 0,
+	0, 0, 0, 0, 0, 0, 251, 328, 0, 0,
+	0, 0, 280, 0, 0, 0, 0, 0, 207, 261,
+	0, 281, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 288, 309, 322, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 312,
+	0, 0, 0, 321, 0, 0, 0, 0, 0, 0,
+	245, 246, 247, 248, 199, 0, 176, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 334, 195, 201,
+	0, 203, 175, 260, 197, 319, 211, 0, 252, 206,
+	285, 212, 218, 272, 318, 258, 278, 173, 308, 286,
+	222, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 157, 0, 216,
+	0, 269, 194, 115, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
+	132, 133, 134, 135, 136, 137, 138, 139, 140, 141,
+	142, 143, 144, 145, 146, 147, 148, 149, 150, 151,
+	152, 0, 153, 154, 155, 1324, 0, 0, 325, 326,
+	327, 310, 257, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 189, 0, 0, 215, 0, 0,
+	0, 287, 241, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 103, 0, 0, 0, 0, 0,
-	0, 156, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 159, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 112, 0, 0, 0, 0, 0, 0, 171,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 174,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 148, 263, 277,
-	157, 254, 290, 162, 261, 153, 228, 250, 0, 0,
-	150, 275, 260, 210, 193, 194, 149, 0, 245, 172,
-	185, 169, 226, 0, 0, 302, 168, 293, 0, 285,
-	152, 0, 284, 225, 272, 276, 211, 205, 151, 274,
-	209, 204, 197, 176, 189, 237, 203, 238, 190, 215,
-	214, 216, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 287, 0, 0, 0, 0, 0, 0, 262,
-	0, 0, 198, 0, 0, 0, 303, 0, 248, 231,
-	0, 0, 0, 246, 201, 273, 239, 278, 264, 286,
-	242, 240, 144, 265, 171, 212, 154, 155, 167, 173,
-	175, 177, 178, 221, 222, 234, 253, 266, 267, 268,
-	170, 163, 247, 164, 187, 165, 145, 255, 166, 146,
-	235, 271, 0, 183, 243, 208, 147, 207, 236, 270,
-	269, 294, 300, 301, 305, 0, 306, 0, 0, 0,
+	0, 0, 0, 0, 0, 163, 292, 306, 172, 283,
+	320, 177, 290, 168, 256, 279, 0, 0, 165, 304,
+	289, 238, 208, 209, 164, 0, 273, 187, 200, 184,
+	254, 0, 0, 332, 183, 323, 0, 315, 167, 0,
+	314, 253, 301, 305, 239, 221, 166, 303, 237, 220,
+	213, 191, 313, 204, 265, 219, 266, 205, 243, 242,
+	244, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 299, 181, 142, 282, 0, 227, 0, 0, 0,
-	0, 0, 0, 0, 223, 298, 0, 0, 0, 0,
-	251, 0, 0, 0, 0, 0, 192, 233, 0, 252,
+	0, 317, 0, 0, 0, 0, 0, 0, 291, 0,
+	0, 214, 0, 0, 0, 333, 0, 277, 259, 0,
+	0, 0, 275, 217, 302, 267, 307, 293, 316, 270,
+	268, 158, 294, 186, 240, 169, 170, 182, 188, 190,
+	192, 193, 249, 250, 262, 282, 295, 296, 297, 185,
+	178, 276, 179, 202, 180, 159, 284, 181, 160, 263,
+	300, 0, 198, 271, 224, 161, 223, 264, 299, 298,
+	324, 330, 331, 335, 0, 336, 0, 0, 225, 274,
+	210, 0, 226, 227, 228, 229, 230, 231, 232, 162,
+	233, 234, 235, 236, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 329, 196, 156, 311,
+	0, 255, 0, 0, 0, 0, 0, 0, 0, 251,
+	328, 0, 0, 0, 0, 280, 0, 0, 0, 0,
+	0, 207, 261, 0, 281, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 288, 309, 322,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 259, 280, 292, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 283, 0, 0,
-	0, 291, 0, 0, 0, 0, 0, 0, 217, 218,
-	219, 220, 184, 0, 161, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 304, 180, 186, 0, 188,
-	160, 232, 182, 289, 195, 0, 224, 191, 256, 196,
-	202, 244, 288, 230, 249, 158, 279, 257, 206, 0,
+	0, 0, 312, 0, 0, 0, 321, 0, 0, 0,
+	0, 0, 0, 245, 246, 247, 248, 199, 0, 176,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	334, 195, 201, 0, 203, 175, 260, 197, 319, 211,
+	0, 252, 206, 285, 212, 218, 272, 318, 258, 278,
+	173, 308, 286, 222, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 393, 0, 0, 143, 0, 200, 0, 241,
-	179, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
-	125, 126, 127, 128, 129, 130, 131, 132, 133, 134,
-	135, 136, 137, 138, 139, 140, 141, 229, 0, 0,
-	295, 296, 297, 281, 0, 0, 0, 0, 0, 174,
-	0, 0, 199, 0, 0, 0, 258, 213, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 103, 0, 0,
-	0, 0, 0, 0, 156, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 159, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	148, 263, 277, 157, 254, 290, 162, 261, 153, 228,
-	250, 0, 0, 150, 275, 260, 210, 193, 194, 149,
-	0, 245, 172, 185, 169, 226, 0, 0, 302, 168,
-	293, 0, 285, 152, 0, 284, 225, 272, 276, 211,
-	205, 151, 274, 209, 204, 197, 176, 189, 237, 203,
-	238, 190, 215, 214, 216, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 287, 0, 0, 0, 0,
-	0, 0, 262, 0, 0, 198, 0, 0, 0, 303,
-	0, 248, 231, 0, 0, 0, 246, 201, 273, 239,
-	278, 264, 286, 330, 240, 144, 265, 171, 212, 154,
-	155, 167, 173, 175, 177, 178, 221, 222, 234, 253,
-	266, 267, 268, 170, 163, 247, 164, 187, 165, 145,
-	255, 166, 146, 235, 271, 0, 183, 243, 208, 147,
-	207, 236, 270, 269, 294, 300, 301, 305, 0, 306,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 299, 181, 142, 282, 0, 227,
-	0, 0, 0, 0, 0, 0, 0, 223, 298, 0,
-	0, 0, 0, 251, 0, 0, 0, 0, 0, 192,
-	233, 0, 252, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 259, 280, 292, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 331,
-	283, 0, 0, 0, 291, 0, 0, 0, 0, 0,
-	0, 217, 218, 219, 220, 184, 0, 161, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 304, 180,
-	186, 0, 188, 160, 232, 182, 289, 195, 0, 224,
-	191, 256, 196, 202, 244, 288, 230, 249, 158, 279,
-	257, 206, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 143, 0,
-	200, 0, 241, 179, 106, 107, 108, 109, 110, 111,
-	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
-	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
-	132, 133, 134, 135, 136, 137, 138, 139, 140, 141,
-	229, 0, 0, 295, 296, 297, 281, 0, 0, 0,
-	0, 100, 174, 0, 0, 199, 0, 0, 0, 258,
-	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	103, 0, 0, 0, 0, 0, 0, 156, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 159, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 148, 263, 277, 157, 254, 290, 162,
-	261, 153, 228, 250, 0, 0, 150, 275, 260, 210,
-	193, 194, 149, 0, 245, 172, 185, 169, 226, 0,
-	0, 302, 168, 293, 0, 285, 152, 0, 284, 225,
-	272, 276, 211, 205, 151, 274, 209, 204, 197, 176,
-	189, 237, 203, 238, 190, 215, 214, 216, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 287, 0,
-	0, 0, 0, 0, 0, 262, 0, 0, 198, 0,
-	0, 0, 303, 0, 248, 231, 0, 0, 0, 246,
-	201, 273, 239, 278, 264, 286, 242, 240, 144, 265,
-	171, 212, 154, 155, 167, 173, 175, 177, 178, 221,
-	222, 234, 253, 266, 267, 268, 170, 163, 247, 164,
-	187, 165, 145, 255, 166, 146, 235, 271, 0, 183,
-	243, 208, 147, 207, 236, 270, 269, 294, 300, 301,
-	305, 0, 306, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 299, 181, 142,
-	282, 0, 227, 0, 0, 0, 0, 0, 0, 0,
-	223, 298, 0, 0, 0, 0, 251, 0, 0, 0,
-	0, 0, 192, 233, 0, 252, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 259, 280,
-	292, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 283, 0, 0, 0, 291, 0, 0,
-	0, 0, 0, 0, 217, 218, 219, 220, 184, 0,
-	161, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 304, 180, 186, 0, 188, 160, 232, 182, 289,
-	195, 0, 224, 191, 256, 196, 202, 244, 288, 230,
-	249, 158, 279, 257, 206, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 143, 0, 200, 0, 241, 179, 106, 107, 108,
-	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	157, 0, 216, 0, 269, 194, 115, 116, 117, 118,
 	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
 	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
-	139, 140, 141, 229, 0, 0, 295, 296, 297, 281,
-	0, 0, 0, 0, 0, 174, 0, 0, 199, 0,
-	0, 0, 258, 213, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 103, 0, 0, 0, 0, 0, 0,
-	156, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	159, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
+	149, 150, 151, 152, 257, 153, 154, 155, 0, 0,
+	0, 325, 326, 327, 310, 0, 189, 0, 0, 215,
+	0, 0, 0, 287, 241, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 112, 0, 0, 0, 0, 0,
+	0, 171, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 174, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 163, 292, 306,
+	172, 283, 320, 177, 290, 168, 256, 279, 0, 0,
+	165, 304, 289, 238, 208, 209, 164, 0, 273, 187,
+	200, 184, 254, 0, 0, 332, 183, 323, 0, 315,
+	167, 0, 314, 253, 301, 305, 239, 221, 166, 303,
+	237, 220, 213, 191, 313, 204, 265, 219, 266, 205,
+	243, 242, 244, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 317, 0, 0, 1307, 0, 0, 0,
+	291, 0, 0, 214, 0, 0, 0, 333, 0, 277,
+	259, 0, 0, 0, 275, 217, 302, 267, 307, 293,
+	316, 270, 268, 158, 294, 186, 240, 169, 170, 182,
+	188, 190, 192, 193, 249, 250, 262, 282, 295, 296,
+	297, 185, 178, 276, 179, 202, 180, 159, 284, 181,
+	160, 263, 300, 0, 198, 271, 224, 161, 223, 264,
+	299, 298, 324, 330, 331, 335, 0, 336, 0, 0,
+	225, 274, 210, 0, 226, 227, 228, 229, 230, 231,
+	232, 162, 233, 234, 235, 236, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 329, 196,
+	156, 311, 0, 255, 0, 0, 0, 0, 0, 0,
+	0, 251, 328, 0, 0, 0, 0, 280, 0, 0,
+	0, 0, 0, 207, 261, 0, 281, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 288,
+	309, 322, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 312, 0, 0, 0, 321, 0,
+	0, 0, 0, 0, 0, 245, 246, 247, 248, 199,
+	0, 176, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 334, 195, 201, 0, 203, 175, 260, 197,
+	319, 211, 0, 252, 206, 285, 212, 218, 272, 318,
+	258, 278, 173, 308, 286, 222, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 157, 0, 216, 0, 269, 194, 115, 116,
+	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
+	127, 128, 129, 130, 131, 132, 133, 134, 135, 136,
+	137, 138, 139, 140, 141, 142, 143, 144, 145, 146,
+	147, 148, 149, 150, 151, 152, 257, 153, 154, 155,
+	0, 0, 0, 325, 326, 327, 310, 0, 189, 0,
+	0, 215, 0, 0, 0, 287, 241, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 112, 0, 0, 0,
+	0, 0, 0, 171, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 174, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 163,
+	292, 306, 172, 283, 320, 177, 290, 168, 256, 279,
+	0, 0, 165, 304, 289, 238, 208, 209, 164, 0,
+	273, 187, 200, 184, 254, 0, 0, 332, 183, 323,
+	0, 315, 167, 0, 314, 253, 301, 305, 239, 221,
+	166, 303, 237, 220, 213, 191, 313, 204, 265, 219,
+	266, 205, 243, 242, 244, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 317, 0, 0, 0, 0,
+	0, 0, 291, 0, 0, 214, 0, 0, 0, 333,
+	0, 277, 259, 0, 0, 0, 275, 217, 302, 267,
+	307, 293, 316, 270, 268, 158, 294, 186, 240, 169,
+	170, 182, 188, 190, 192, 193, 249, 250, 262, 282,
+	295, 296, 297, 185, 178, 276, 179, 202, 180, 159,
+	284, 181, 160, 263, 300, 0, 198, 271, 224, 161,
+	223, 264, 299, 298, 324, 330, 331, 335, 0, 336,
+	0, 0, 225, 274, 210, 0, 226, 227, 228, 229,
+	230, 231, 232, 162, 233, 234, 235, 236, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	329, 196, 156, 311, 0, 255, 0, 0, 0, 0,
+	0, 0, 0, 251, 328, 0, 0, 0, 0, 280,
+	0, 0, 0, 0, 0, 207, 261, 0, 281, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 288, 309, 322, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 312, 0, 0, 0,
+	321, 0, 0, 0, 0, 0, 0, 245, 246, 247,
+	248, 199, 0, 176, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 334, 195, 201, 0, 203, 175,
+	260, 197, 319, 211, 0, 252, 206, 285, 212, 218,
+	272, 318, 258, 278, 173, 308, 286, 222, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 428, 0, 0, 157, 0, 216, 0, 269, 194,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	125, 126, 127, 128, 129, 130, 131, 132, 133, 134,
+	135, 136, 137, 138, 139, 140, 141, 142, 143, 144,
+	145, 146, 147, 148, 149, 150, 151, 152, 257, 153,
+	154, 155, 0, 0, 0, 325, 326, 327, 310, 0,
+	189, 0, 0, 215, 0, 0, 0, 287, 241, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 112, 0,
+	0, 0, 0, 0, 0, 171, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 174, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 163, 292, 306, 172, 283, 320, 177, 290, 168,
+	256, 279, 0, 0, 165, 304, 289, 238, 208, 209,
+	164, 0, 273, 187, 200, 184, 254, 0, 0, 332,
+	183, 323, 0, 315, 167, 0, 314, 253, 301, 305,
+	239, 221, 166, 303, 237, 220, 213, 191, 313, 204,
+	265, 219, 266, 205, 243, 242, 244, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 317, 0, 0,
+	0, 0, 0, 0, 291, 0, 0, 214, 0, 0,
+	0, 333, 0, 277, 259, 0, 0, 0, 275, 217,
+	302, 267, 307, 293, 316, 360, 268, 158, 294, 186,
+	240, 169, 170, 182, 188, 190, 192, 193, 249, 250,
+	262, 282, 295, 296, 297, 185, 178, 276, 179, 202,
+	180, 159, 284, 181, 160, 263, 300, 0, 198, 271,
+	224, 161, 223, 264, 299, 298, 324, 330, 331, 335,
+	0, 336, 0, 0, 225, 274, 210, 0, 226, 227,
+	228, 229, 230, 231, 232, 162, 233, 234, 235, 236,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 329, 196, 156, 311, 0, 255, 0, 0,
+	0, 0, 0, 0, 0, 251, 328, 0, 0, 0,
+	0, 280, 0, 0, 0, 0, 0, 207, 261, 0,
+	281, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 288, 309, 322, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 361, 312, 0,
+	0, 0, 321, 0, 0, 0, 0, 0, 0, 245,
+	246, 247, 248, 199, 0, 176, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 334, 195, 201, 0,
+	203, 175, 260, 197, 319, 211, 0, 252, 206, 285,
+	212, 218, 272, 318, 258, 278, 173, 308, 286, 222,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 157, 0, 216, 0,
+	269, 194, 115, 116, 117, 118, 119, 120, 121, 122,
+	123, 124, 125, 126, 127, 128, 129, 130, 131, 132,
+	133, 134, 135, 136, 137, 138, 139, 140, 141, 142,
+	143, 144, 145, 146, 147, 148, 149, 150, 151, 152,
+	257, 153, 154, 155, 0, 0, 0, 325, 326, 327,
+	310, 109, 189, 0, 0, 215, 0, 0, 0, 287,
+	241, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	112, 0, 0, 0, 0, 0, 0, 171, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 174, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 163, 292, 306, 172, 283, 320, 177,
+	290, 168, 256, 279, 0, 0, 165, 304, 289, 238,
+	208, 209, 164, 0, 273, 187, 200, 184, 254, 0,
+	0, 332, 183, 323, 0, 315, 167, 0, 314, 253,
+	301, 305, 239, 221, 166, 303, 237, 220, 213, 191,
+	313, 204, 265, 219, 266, 205, 243, 242, 244, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 317,
+	0, 0, 0, 0, 0, 0, 291, 0, 0, 214,
+	0, 0, 0, 333, 0, 277, 259, 0, 0, 0,
+	275, 217, 302, 267, 307, 293, 316, 270, 268, 158,
+	294, 186, 240, 169, 170, 182, 188, 190, 192, 193,
+	249, 250, 262, 282, 295, 296, 297, 185, 178, 276,
+	179, 202, 180, 159, 284, 181, 160, 263, 300, 0,
+	198, 271, 224, 161, 223, 264, 299, 298, 324, 330,
+	331, 335, 0, 336, 0, 0, 225, 274, 210, 0,
+	226, 227, 228, 229, 230, 231, 232, 162, 233, 234,
+	235, 236, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 329, 196, 156, 311, 0, 255,
+	0, 0, 0, 0, 0, 0, 0, 251, 328, 0,
+	0, 0, 0, 280, 0, 0, 0, 0, 0, 207,
+	261, 0, 281, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 288, 309, 322, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	312, 0, 0, 0, 321, 0, 0, 0, 0, 0,
+	0, 245, 246, 247, 248, 199, 0, 176, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 334, 195,
+	201, 0, 203, 175, 260, 197, 319, 211, 0, 252,
+	206, 285, 212, 218, 272, 318, 258, 278, 173, 308,
+	286, 222, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 157, 0,
+	216, 0, 269, 194, 115, 116, 117, 118, 119, 120,
+	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
+	131, 132, 133, 134, 135, 136, 137, 138, 139, 140,
+	141, 142, 143, 144, 145, 146, 147, 148, 149, 150,
+	151, 152, 257, 153, 154, 155, 0, 0, 0, 325,
+	326, 327, 310, 0, 189, 0, 0, 215, 0, 0,
+	0, 287, 241, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 112, 0, 0, 0, 0, 0, 0, 171,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 174,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 148, 263, 277, 157,
-	254, 290, 162, 261, 153, 228, 250, 0, 0, 150,
-	275, 260, 210, 193, 194, 149, 0, 245, 172, 185,
-	169, 226, 0, 0, 302, 168, 293, 0, 285, 152,
-	0, 284, 225, 272, 276, 211, 205, 151, 274, 209,
-	204, 197, 176, 189, 237, 203, 238, 190, 215, 214,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 287, 0, 0, 0, 0, 0, 0, 262, 0,
-	0, 198, 0, 0, 0, 303, 0, 248, 231, 0,
-	0, 0, 246, 201, 273, 239, 278, 264, 286, 242,
-	240, 144, 265, 171, 212, 154, 155, 167, 173, 175,
-	177, 178, 221, 222, 234, 253, 266, 267, 268, 170,
-	163, 247, 164, 187, 165, 145, 255, 166, 146, 235,
-	271, 0, 183, 243, 208, 147, 207, 236, 270, 269,
-	294, 300, 301, 305, 0, 306, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	299, 181, 142, 282, 0, 227, 0, 0, 0, 0,
-	0, 0, 0, 223, 298, 0, 0, 0, 0, 251,
-	0, 0, 0, 0, 0, 192, 233, 0, 252, 0,
+	0, 0, 0, 0, 0, 163, 292, 306, 172, 283,
+	320, 177, 290, 168, 256, 279, 0, 0, 165, 304,
+	289, 238, 208, 209, 164, 0, 273, 187, 200, 184,
+	254, 0, 0, 332, 183, 323, 0, 315, 167, 0,
+	314, 253, 301, 305, 239, 221, 166, 303, 237, 220,
+	213, 191, 313, 204, 265, 219, 266, 205, 243, 242,
+	244, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 259, 280, 292, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 283, 0, 0, 0,
-	291, 0, 0, 0, 0, 0, 0, 217, 218, 219,
-	220, 184, 0, 161, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 304, 180, 186, 0, 188, 160,
-	232, 182, 289, 195, 0, 224, 191, 256, 196, 202,
-	244, 288, 230, 249, 158, 279, 257, 206, 0, 0,
+	0, 317, 0, 0, 0, 0, 0, 0, 291, 0,
+	0, 214, 0, 0, 0, 333, 0, 277, 259, 0,
+	0, 0, 275, 217, 302, 267, 307, 293, 316, 270,
+	268, 158, 294, 186, 240, 169, 170, 182, 188, 190,
+	192, 193, 249, 250, 262, 282, 295, 296, 297, 185,
+	178, 276, 179, 202, 180, 159, 284, 181, 160, 263,
+	300, 0, 198, 271, 224, 161, 223, 264, 299, 298,
+	324, 330, 331, 335, 0, 336, 0, 0, 225, 274,
+	210, 0, 226, 227, 228, 229, 230, 231, 232, 162,
+	233, 234, 235, 236, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 329, 196, 156, 311,
+	0, 255, 0, 0, 0, 0, 0, 0, 0, 251,
+	328, 0, 0, 0, 0, 280, 0, 0, 0, 0,
+	0, 207, 261, 0, 281, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 288, 309, 322,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 312, 0, 0, 0, 321, 0, 0, 0,
+	0, 0, 0, 245, 246, 247, 248, 199, 0, 176,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	334, 195, 201, 0, 203, 175, 260, 197, 319, 211,
+	0, 252, 206, 285, 212, 218, 272, 318, 258, 278,
+	173, 308, 286, 222, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 143, 0, 200, 0, 241, 179,
-	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
-	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
-	126, 127, 128, 129, 130, 131, 132, 133, 134, 135,
-	136, 137, 138, 139, 140, 141, 229, 0, 0, 295,
-	296, 297, 281, 1279, 0, 0, 0, 0, 174, 0,
-	0, 199, 0, 0, 0, 258, 213, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 830, 831, 832, 1281,
-	0, 0, 0, 156, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 159, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 148,
-	263, 277, 157, 254, 290, 162, 261, 153, 228, 250,
-	0, 0, 150, 275, 260, 210, 193, 194, 149, 0,
-	245, 172, 185, 169, 226, 0, 0, 302, 168, 293,
-	0, 285, 152, 0, 284, 225, 272, 276, 211, 205,
-	151, 274, 209, 204, 197, 176, 189, 237, 203, 238,
-	190, 215, 214, 216, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 287, 0, 0, 0, 0, 0,
-	0, 262, 0, 0, 198, 0, 0, 0, 303, 0,
-	248, 231, 0, 0, 0, 246, 201, 273, 239, 278,
-	264, 286, 242, 240, 144, 265, 171, 212, 154, 155,
-	167, 173, 175, 177, 178, 221, 222, 234, 253, 266,
-	267, 268, 170, 163, 247, 164, 187, 165, 145, 255,
-	166, 146, 235, 271, 0, 183, 243, 208, 147, 207,
-	236, 270, 269, 294, 300, 301, 305, 0, 306, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 299, 181, 142, 282, 0, 227, 0,
-	0, 0, 0, 0, 0, 0, 223, 298, 0, 0,
-	0, 0, 251, 0, 0, 0, 0, 0, 192, 233,
-	0, 252, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 259, 280, 292, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 283,
-	0, 0, 0, 291, 0, 0, 0, 0, 0, 0,
-	217, 218, 219, 220, 184, 0, 161, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 304, 180, 186,
-	0, 188, 160, 232, 182, 289, 195, 0, 224, 191,
-	256, 196, 202, 244, 288, 230, 249, 158, 279, 257,
-	206, 0, 0, 229, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 174, 0, 0, 199, 0,
-	0, 0, 258, 213, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 143, 0, 200,
-	0, 241, 179, 830, 831, 832, 1281, 0, 0, 0,
-	156, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	159, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 295, 296, 297, 281, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 148, 263, 277, 157,
-	254, 290, 162, 261, 153, 228, 250, 0, 0, 150,
-	275, 260, 210, 193, 194, 149, 0, 245, 172, 185,
-	169, 226, 0, 0, 302, 168, 293, 0, 285, 152,
-	0, 284, 225, 272, 276, 211, 205, 151, 274, 209,
-	204, 197, 176, 189, 237, 203, 238, 190, 215, 214,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 287, 0, 0, 0, 0, 0, 0, 262, 0,
-	0, 198, 0, 0, 0, 303, 0, 248, 231, 0,
-	0, 0, 246, 201, 273, 239, 278, 264, 286, 242,
-	240, 144, 265, 171, 212, 154, 155, 167, 173, 175,
-	177, 178, 221, 222, 234, 253, 266, 267, 268, 170,
-	163, 247, 164, 187, 165, 145, 255, 166, 146, 235,
-	271, 0, 183, 243, 208, 147, 207, 236, 270, 269,
-	294, 300, 301, 305, 0, 306, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	299, 181, 142, 282, 0, 227, 0, 0, 0, 0,
-	0, 0, 0, 223, 298, 0, 0, 0, 0, 251,
-	0, 0, 0, 0, 0, 192, 233, 0, 252, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 259, 280, 292, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 283, 0, 0, 0,
-	291, 0, 0, 0, 0, 0, 0, 217, 218, 219,
-	220, 184, 0, 161, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 304, 180, 186, 0, 188, 160,
-	232, 182, 289, 195, 0, 224, 191, 256, 196, 202,
-	244, 288, 230, 249, 158, 279, 257, 206, 0, 0,
-	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 174, 0, 0, 199, 0, 0, 0, 258,
-	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 143, 0, 200, 0, 241, 179,
-	830, 831, 832, 0, 0, 0, 0, 156, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 159, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 295,
-	296, 297, 281, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 148, 263, 277, 157, 254, 290, 162,
-	261, 153, 228, 250, 0, 0, 150, 275, 260, 210,
-	193, 194, 149, 0, 245, 172, 185, 169, 226, 0,
-	0, 302, 168, 293, 0, 285, 152, 0, 284, 225,
-	272, 276, 211, 205, 151, 274, 209, 204, 197, 176,
-	189, 237, 203, 238, 190, 215, 214, 216, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 287, 0,
-	0, 0, 0, 0, 0, 262, 0, 0, 198, 0,
-	0, 0, 303, 0, 248, 231, 0, 0, 0, 246,
-	201, 273, 239, 278, 264, 286, 242, 240, 144, 265,
-	171, 212, 154, 155, 167, 173, 175, 177, 178, 221,
-	222, 234, 253, 266, 267, 268, 170, 163, 247, 164,
-	187, 165, 145, 255, 166, 146, 235, 271, 0, 183,
-	243, 208, 147, 207, 236, 270, 269, 294, 300, 301,
-	305, 0, 306, 0, 0, 915, 916, 917, 914, 0,
-	0, 0, 0, 0, 0, 0, 0, 299, 181, 142,
-	282, 0, 227, 0, 0, 0, 0, 0, 0, 0,
-	223, 298, 0, 0, 0, 0, 251, 0, 0, 0,
-	0, 0, 192, 233, 0, 252, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1730, 0, 0, 259, 280,
-	292, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 283, 0, 0, 0, 291, 1360, 0,
-	0, 0, 0, 0, 217, 218, 219, 220, 184, 0,
-	161, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 304, 180, 186, 0, 188, 160, 232, 182, 289,
-	195, 0, 224, 191, 256, 196, 202, 244, 288, 230,
-	249, 158, 279, 257, 206, 0, 0, 0, 0, 0,
-	0, 0, 1730, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1718, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 143, 0, 200, 0, 241, 179, 0, 1737, 1741,
-	1743, 1745, 1747, 1748, 1750, 1944, 1754, 1751, 1752, 1753,
-	0, 0, 1732, 1733, 1734, 1735, 1716, 1717, 1738, 0,
-	1719, 0, 1720, 1721, 1722, 1723, 1724, 1725, 1726, 1727,
-	1728, 1729, 1736, 0, 1237, 0, 295, 296, 297, 281,
-	1740, 1742, 1744, 1746, 1749, 0, 1356, 0, 1353, 0,
-	0, 0, 1355, 1352, 1354, 1358, 1359, 2351, 1718, 0,
-	1357, 0, 0, 0, 0, 0, 0, 0, 1926, 0,
-	0, 0, 0, 0, 1731, 1737, 1741, 1743, 1745, 1747,
-	1748, 1750, 0, 1754, 1751, 1752, 1753, 0, 0, 1732,
-	1733, 1734, 1735, 1716, 1717, 1738, 1944, 1719, 0, 1720,
-	1721, 1722, 1723, 1724, 1725, 1726, 1727, 1728, 1729, 1736,
-	0, 0, 0, 0, 0, 0, 0, 1740, 1742, 1744,
-	1746, 1749, 0, 0, 0, 1237, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2022,
-	0, 1731, 0, 0, 1944, 0, 0, 0, 0, 1926,
-	0, 0, 0, 0, 1341, 1342, 1343, 1344, 1345, 1346,
-	1347, 1348, 1349, 1350, 1351, 1363, 1364, 1365, 1366, 1367,
-	1368, 1361, 1362, 1237, 0, 0, 0, 0, 0, 0,
-	1708, 1709, 0, 0, 0, 0, 0, 0, 1930, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1934,
-	0, 0, 0, 0, 0, 0, 0, 1926, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1923,
-	0, 0, 0, 1925, 1927, 1929, 0, 1931, 1932, 1933,
-	1935, 1936, 1937, 1939, 1940, 1941, 1942, 350, 0, 349,
-	353, 345, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 341, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 360, 0, 0, 1945, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1930,
-	0, 0, 0, 0, 1739, 0, 0, 0, 0, 363,
-	1934, 0, 364, 0, 0, 0, 0, 0, 0, 0,
-	1943, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1923, 0, 0, 0, 1925, 1927, 1929, 1922, 1931, 1932,
-	1933, 1935, 1936, 1937, 1939, 1940, 1941, 1942, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1930, 0, 0,
-	0, 0, 0, 0, 1938, 0, 0, 0, 1934, 0,
-	0, 1928, 0, 0, 0, 0, 0, 1945, 0, 0,
-	0, 1739, 0, 0, 0, 0, 0, 0, 1923, 0,
-	0, 0, 1925, 1927, 1929, 0, 1931, 1932, 1933, 1935,
-	1936, 1937, 1939, 1940, 1941, 1942, 0, 0, 0, 0,
-	0, 1943, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1922, 0,
-	0, 0, 0, 0, 0, 1945, 0, 0, 343, 342,
-	346, 0, 0, 0, 0, 0, 348, 0, 0, 0,
-	0, 0, 0, 0, 0, 1938, 0, 0, 352, 0,
-	0, 0, 1928, 0, 0, 0, 0, 0, 0, 1943,
-	0, 0, 344, 0, 0, 0, 336, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1922, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1938, 0, 0, 0, 0, 0, 0,
-	1928, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 347,
-	351, 354, 0, 355, 356, 0, 0, 357, 358, 359,
-	0, 0, 361, 362,
+	157, 0, 216, 0, 269, 194, 115, 116, 117, 118,
+	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
+	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
+	149, 150, 151, 152, 0, 153, 154, 155, 257, 0,
+	0, 325, 326, 327, 310, 1368, 0, 0, 0, 0,
+	189, 0, 0, 215, 0, 0, 0, 287, 241, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 891, 892,
+	893, 1370, 0, 0, 0, 171, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 174, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 163, 292, 306, 172, 283, 320, 177, 290, 168,
+	256, 279, 0, 0, 165, 304, 289, 238, 208, 209,
+	164, 0, 273, 187, 200, 184, 254, 0, 0, 332,
+	183, 323, 0, 315, 167, 0, 314, 253, 301, 305,
+	239, 221, 166, 303, 237, 220, 213, 191, 313, 204,
+	265, 219, 266, 205, 243, 242, 244, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 317, 0, 0,
+	0, 0, 0, 0, 291, 0, 0, 214, 0, 0,
+	0, 333, 0, 277, 259, 0, 0, 0, 275, 217,
+	302, 267, 307, 293, 316, 270, 268, 158, 294, 186,
+	240, 169, 170, 182, 188, 190, 192, 193, 249, 250,
+	262, 282, 295, 296, 297, 185, 178, 276, 179, 202,
+	180, 159, 284, 181, 160, 263, 300, 0, 198, 271,
+	224, 161, 223, 264, 299, 298, 324, 330, 331, 335,
+	0, 336, 0, 0, 225, 274, 210, 0, 226, 227,
+	228, 229, 230, 231, 232, 162, 233, 234, 235, 236,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 329, 196, 156, 311, 0, 255, 0, 0,
+	0, 0, 0, 0, 0, 251, 328, 0, 0, 0,
+	0, 280, 0, 0, 0, 0, 0, 207, 261, 0,
+	281, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 288, 309, 322, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 312, 0,
+	0, 0, 321, 0, 0, 0, 0, 0, 0, 245,
+	246, 247, 248, 199, 0, 176, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 334, 195, 201, 0,
+	203, 175, 260, 197, 319, 211, 0, 252, 206, 285,
+	212, 218, 272, 318, 258, 278, 173, 308, 286, 222,
+	0, 0, 257, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 189, 0, 0, 215, 0, 0,
+	0, 287, 241, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 157, 0, 216, 0,
+	269, 194, 891, 892, 893, 1370, 0, 0, 0, 171,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 174,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 325, 326, 327,
+	310, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 163, 292, 306, 172, 283,
+	320, 177, 290, 168, 256, 279, 0, 0, 165, 304,
+	289, 238, 208, 209, 164, 0, 273, 187, 200, 184,
+	254, 0, 0, 332, 183, 323, 0, 315, 167, 0,
+	314, 253, 301, 305, 239, 221, 166, 303, 237, 220,
+	213, 191, 313, 204, 265, 219, 266, 205, 243, 242,
+	244, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 317, 0, 0, 0, 0, 0, 0, 291, 0,
+	0, 214, 0, 0, 0, 333, 0, 277, 259, 0,
+	0, 0, 275, 217, 302, 267, 307, 293, 316, 270,
+	268, 158, 294, 186, 240, 169, 170, 182, 188, 190,
+	192, 193, 249, 250, 262, 282, 295, 296, 297, 185,
+	178, 276, 179, 202, 180, 159, 284, 181, 160, 263,
+	300, 0, 198, 271, 224, 161, 223, 264, 299, 298,
+	324, 330, 331, 335, 0, 336, 0, 0, 225, 274,
+	210, 0, 226, 227, 228, 229, 230, 231, 232, 162,
+	233, 234, 235, 236, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 329, 196, 156, 311,
+	0, 255, 0, 0, 0, 0, 0, 0, 0, 251,
+	328, 0, 0, 0, 0, 280, 0, 0, 0, 0,
+	0, 207, 261, 0, 281, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 288, 309, 322,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 312, 0, 0, 0, 321, 0, 0, 0,
+	0, 0, 0, 245, 246, 247, 248, 199, 0, 176,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	334, 195, 201, 0, 203, 175, 260, 197, 319, 211,
+	0, 252, 206, 285, 212, 218, 272, 318, 258, 278,
+	173, 308, 286, 222, 0, 0, 257, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 189, 0,
+	0, 215, 0, 0, 0, 287, 241, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	157, 0, 216, 0, 269, 194, 891, 892, 893, 0,
+	0, 0, 0, 171, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 174, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 325, 326, 327, 310, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 163,
+	292, 306, 172, 283, 320, 177, 290, 168, 256, 279,
+	0, 0, 165, 304, 289, 238, 208, 209, 164, 0,
+	273, 187, 200, 184, 254, 0, 0, 332, 183, 323,
+	0, 315, 167, 0, 314, 253, 301, 305, 239, 221,
+	166, 303, 237, 220, 213, 191, 313, 204, 265, 219,
+	266, 205, 243, 242, 244, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 317, 0, 0, 0, 0,
+	0, 0, 291, 0, 0, 214, 0, 0, 0, 333,
+	0, 277, 259, 0, 0, 0, 275, 217, 302, 267,
+	307, 293, 316, 270, 268, 158, 294, 186, 240, 169,
+	170, 182, 188, 190, 192, 193, 249, 250, 262, 282,
+	295, 296, 297, 185, 178, 276, 179, 202, 180, 159,
+	284, 181, 160, 263, 300, 0, 198, 271, 224, 161,
+	223, 264, 299, 298, 324, 330, 331, 335, 0, 336,
+	0, 0, 225, 274, 210, 0, 226, 227, 228, 229,
+	230, 231, 232, 162, 233, 234, 235, 236, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	329, 196, 156, 311, 0, 255, 0, 0, 0, 0,
+	0, 0, 0, 251, 328, 0, 0, 0, 0, 280,
+	0, 0, 0, 0, 0, 207, 261, 0, 281, 0,
+	0, 0, 0, 0, 0, 106, 0, 29, 96, 76,
+	0, 288, 309, 322, 1779, 0, 0, 0, 0, 0,
+	101, 0, 0, 0, 0, 0, 312, 90, 2112, 0,
+	321, 0, 0, 0, 0, 0, 0, 245, 246, 247,
+	248, 199, 0, 176, 0, 0, 0, 0, 54, 0,
+	0, 0, 0, 103, 334, 195, 201, 1322, 203, 175,
+	260, 197, 319, 211, 0, 252, 206, 285, 212, 218,
+	272, 318, 258, 278, 173, 308, 286, 222, 0, 0,
+	0, 2202, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2094, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1766, 0, 0, 0, 157, 0, 216, 0, 269, 194,
+	97, 98, 0, 99, 100, 0, 0, 1786, 1790, 1792,
+	1794, 1796, 1797, 1799, 0, 1803, 1800, 1801, 1802, 0,
+	0, 1781, 1782, 1783, 1784, 1764, 1765, 1787, 2112, 1767,
+	0, 1768, 1769, 1770, 1771, 1772, 1773, 1774, 1775, 1776,
+	1778, 1777, 1785, 0, 0, 325, 326, 327, 310, 0,
+	1789, 1791, 1793, 1795, 1798, 0, 0, 1322, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 75, 95, 104,
+	0, 52, 2131, 0, 0, 0, 0, 0, 2120, 0,
+	0, 0, 0, 0, 1780, 0, 0, 94, 89, 88,
+	0, 2094, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 380, 2098, 379, 383, 375, 0, 0, 0, 0,
+	0, 0, 2123, 2102, 0, 0, 0, 371, 2118, 0,
+	0, 0, 0, 2133, 2134, 0, 0, 390, 0, 2119,
+	0, 0, 0, 2091, 0, 0, 0, 2093, 2095, 2097,
+	0, 2099, 2100, 2101, 2103, 2104, 2105, 2107, 2108, 2109,
+	2110, 91, 92, 393, 65, 380, 394, 379, 383, 375,
+	0, 0, 0, 2124, 66, 0, 0, 0, 0, 0,
+	0, 371, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 390, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2113, 0, 0, 0,
+	62, 0, 0, 0, 93, 0, 63, 393, 0, 0,
+	394, 0, 0, 0, 0, 0, 1889, 1890, 0, 0,
+	0, 0, 2098, 0, 0, 0, 0, 0, 0, 0,
+	2111, 0, 0, 2102, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2090, 2132, 0,
+	1814, 0, 0, 2091, 0, 64, 0, 2093, 2095, 2097,
+	0, 2099, 2100, 2101, 2103, 2104, 2105, 2107, 2108, 2109,
+	2110, 0, 0, 0, 2106, 2126, 0, 0, 0, 0,
+	0, 2096, 0, 373, 372, 376, 0, 0, 0, 0,
+	0, 378, 0, 0, 0, 0, 0, 2125, 2127, 0,
+	0, 0, 0, 382, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2113, 374, 0, 0,
+	1788, 366, 0, 0, 0, 0, 0, 0, 77, 0,
+	0, 0, 0, 2130, 0, 0, 0, 373, 372, 376,
+	0, 0, 0, 0, 0, 378, 0, 0, 0, 0,
+	2111, 0, 0, 0, 0, 0, 0, 382, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2090, 0, 0,
+	0, 374, 0, 0, 0, 0, 2135, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2121, 0,
+	0, 0, 0, 0, 2106, 0, 0, 0, 0, 0,
+	0, 2096, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	377, 381, 384, 0, 385, 386, 0, 0, 387, 388,
+	389, 0, 0, 391, 392, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 377, 381, 384, 0, 385, 386,
+	0, 0, 387, 388, 389, 0, 0, 391, 392,
 }
 
 var yyPact = [...]int{
-	218, -1000, -302, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	21815, -1000, -314, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 19748,
+	-1000, -1000, 1676, -1000, 8393, 20220, 91, 20220, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 335, -1000, 19276, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 312, 22031, 231, 86, 320, -1000, 2240, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1228, 346, 18804, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1704, 29, 346, 450, 459, 807,
+	807, 9813, 2240, 233, 552, -1000, 795, 21815, 292, 20220,
+	-1000, 633, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 18808, -1000, -1000,
-	1673, -1000, 7917, 19261, 78, 19261, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 293,
-	-1000, 18355, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 263,
-	21147, 164, -1000, 2010, -1000, -1000, -1000, -1000, 133, 321,
-	17902, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 769, 17, 321, 403, 417,
-	405, 405, 9276, 2010, 215, 57, -1000, 705, 218, 245,
-	19261, -1000, 573, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -3332,79 +3485,82 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	2010, 2010, 19261, -61, 690, -1000, 234, 208, 219, 572,
-	-1000, -1000, -1000, -1000, 2039, -1000, 19261, 1783, 19261, -1000,
-	907, 199, 1745, 1902, 1564, 415, 1810, -1000, -1000, 1775,
-	-1000, 25, -2, 128, -1000, -1000, 194, -1000, -1000, -1000,
-	-1000, -1000, 49, -1000, 16, -1000, 18, -1000, -1000, -1000,
-	-128, -1000, -1000, -1000, -1000, -193, 1887, 1951, 1612, 2023,
-	1941, 1934, 1932, 1928, 2, 259, 259, 259, 276, 259,
-	289, -1000, -1000, -1000, -1000, -1000, -1000, 355, -1000, -1000,
-	-1000, -1000, 1531, 19261, -1000, 1627, 610, 610, 701, 222,
-	-1000, -1000, -108, -152, 610, 610, -152, 36, -1000, 1944,
-	1920, -1000, -1000, -1000, -1000, -1000, -1000, 263, 263, 267,
-	-1000, -197, -1000, -1000, 413, -1000, 384, -1000, 280, 192,
-	1566, 702, -1000, 659, 19261, 19261, 19261, 659, 659, 11100,
-	10647, 526, -1000, 1951, 1612, -1000, 1389, 1187, 1612, 263,
-	263, 263, 263, 263, 263, 263, 19261, 5589, 5589, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 242, 1773, -1000,
-	19261, 1951, 1887, 1951, -1000, 508, 886, 1082, -1000, -1000,
-	234, 1499, -1000, 615, -1000, -1000, -1000, -1000, 19261, 195,
-	-1000, 1073, 1771, -1000, 292, 1542, 1593, -1000, 40, 8002,
-	15177, 907, 15177, 19261, -1000, -1000, -1000, -1000, -132, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -67,
-	-1000, -1000, -291, 1887, 6053, -1000, -1000, 6053, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 277, 259, -1000, 1102,
-	724, 15177, 907, 926, 19261, 259, 267, -1000, 19261, 1531,
-	1913, 19261, 2032, 7445, 2032, 19261, -1000, -1000, 610, 610,
-	-1000, 701, 701, -1000, -1000, -140, 2032, 2032, -119, 19261,
-	19261, 259, -1000, -1000, 1102, 15177, 14724, -1000, -175, 394,
-	371, 388, -1000, -1000, 2044, -1000, -1000, 1481, 331, 10194,
-	238, 15177, 3727, -1000, -1000, 659, 659, 659, 3727, 3727,
-	580, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 19261, 1887,
-	-1000, -1000, -1000, -1000, -1000, 1102, 15177, 907, 19261, 19261,
-	19261, 20468, -1000, 1592, -1000, -1000, 8823, 483, 6053, -1000,
-	1062, 1768, -1000, -1000, 1766, 1758, 1754, 1753, 1751, 1750,
-	1748, -1000, 1709, -1000, -1000, 1747, 1746, 1743, 1742, -1000,
-	-1000, -1000, -1000, -1000, -1000, 1740, -1000, -1000, -1000, 1739,
-	1709, -1000, -1000, 1738, 1724, 1722, 1721, 1720, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1081, 1080, 994, -1000, -1000, -1000,
-	-1000, 3263, 7445, 7445, 7445, 7445, -1000, -1000, 1677, 6053,
-	1718, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 6981, -1000,
-	1717, 1714, 1712, 1711, 1710, 1709, 1708, 1077, 1707, 1706,
-	1704, 7445, 1698, 1695, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	2240, 2240, 20220, -79, 774, -1000, 202, 193, 224, 632,
+	-1000, -1000, -1000, -1000, 2229, -1000, 20220, 1960, 20220, -1000,
+	1011, 276, 22085, 2060, 1668, 569, 1977, -1000, -1000, 1943,
+	-1000, 2, -26, 546, -1000, -1000, 272, -1000, -1000, -1000,
+	-1000, -1000, 64, -1000, -4, -1000, -11, -1000, -1000, -1000,
+	-127, -1000, -1000, -1000, -1000, -182, 327, 1276, 2034, 2126,
+	1762, 2181, 2153, 2150, 2131, 2116, 310, 310, 14, 324,
+	310, 310, 310, 331, 310, 334, -1000, -1000, -1000, -1000,
+	-1000, -1000, 401, -1000, -1000, -1000, -1000, 1712, 20220, -1000,
+	1781, 672, 672, 787, 275, -1000, -1000, -115, -144, 672,
+	672, -144, 41, -1000, 2115, 2110, -1000, -1000, -1000, -1000,
+	-1000, -1000, 312, 312, 312, 315, -1000, -187, -1000, -1000,
+	422, -1000, 419, -1000, 392, 265, 1714, 810, -1000, 671,
+	20220, 20220, 20220, 671, 671, 11713, 11241, 630, -1000, 2126,
+	1762, -1000, 1550, 1699, 1762, 312, 312, 312, 312, 312,
+	312, 312, 312, 312, 20220, 5950, 5950, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 216, 1942, -1000, 20220, 2126,
+	2034, 2126, -1000, 626, 991, 1275, -1000, -1000, 202, 1625,
+	-1000, 718, -1000, -1000, -1000, -1000, 20220, 258, -1000, 1225,
+	1940, -1000, 321, 1667, 1656, -1000, 63, 1741, 15961, 1011,
+	15961, 20220, -1000, -1000, -1000, -1000, -133, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -84, -1000, 20220,
+	78, -1000, -303, 2034, 6437, -1000, -1000, 6437, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 20220, 790, 20220, 322,
+	310, -1000, 310, 1283, 15961, 1011, 1049, 20220, 310, 315,
+	-1000, 20220, 1712, 2101, 20220, 2207, 7898, 2207, 20220, -1000,
+	-1000, 672, 672, -1000, 787, 787, -1000, -1000, -140, 2207,
+	2207, -148, 20220, 20220, 310, -1000, -1000, 20220, 1283, 15961,
+	15489, -1000, -163, 443, 424, 426, -1000, -1000, 2243, -1000,
+	-1000, 1702, 393, 10769, 239, 15961, 3996, -1000, -1000, 671,
+	671, 671, 3996, 3996, 640, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 20220, 2034, -1000, -1000, -1000, -1000, -1000, 1283,
+	15961, 1011, 20220, 20220, 20220, 20220, 20220, 21484, -1000, 1643,
+	-1000, -1000, 9341, 624, 6437, -1000, 1221, 1939, -1000, -1000,
+	1934, 1927, 1923, 1921, 1920, 1915, 1914, -1000, 1851, -1000,
+	-1000, 1913, 1912, 1910, -1000, 1903, -1000, 1902, -1000, -1000,
+	-1000, -1000, 1901, -1000, -1000, -1000, 1900, 1851, -1000, -1000,
+	1899, 1897, 1896, 1889, 1884, -1000, -1000, 1874, 1873, 1871,
+	-1000, -1000, 1870, -1000, -1000, -1000, -1000, -1000, 1261, 1258,
+	2427, -1000, -1000, -1000, -1000, 3509, 7898, 7898, 7898, 7898,
+	-1000, -1000, 1811, 6437, 1860, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1592, -1000, -289, -1000, 9741, 19261,
-	19261, -1000, 1887, -1000, 1887, 2364, -1000, 1948, -1000, 234,
-	103, -1000, -1000, -1000, -1000, -1000, -1000, 476, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1530, -1000, 19261,
-	-1000, -1000, 40, 15177, 681, -1000, -1000, -1000, -1000, -1000,
-	-1000, 151, -1000, -1000, 120, -1000, 275, -39, 1497, -1000,
-	-1000, 10, 114, 1075, -1000, 1176, 475, 1528, -1000, 1103,
-	17449, 19261, -21, -1000, 1896, 1541, -1000, -39, 1481, 1622,
-	-1000, -1000, -1000, 1862, 19261, 16996, -1000, 1693, 1453, -1000,
-	-1000, 6053, -1000, -1000, 2032, 2032, 2032, 610, 20468, 701,
-	19261, 701, -1000, -1000, 701, -1000, 473, -1000, 19261, -21,
-	1541, 1990, 1552, -1000, -1000, -1000, -1000, 1912, 19714, 215,
-	-1000, -1000, 391, 381, 402, 907, 271, -1000, -1000, 1481,
-	-1000, -1000, -1000, 1692, 689, -1000, -1000, 7445, -1000, 803,
-	-1000, 3727, 3727, 3727, -1000, -1000, 12912, -1000, -1000, -1000,
-	1523, 1481, 1809, 1503, -1000, 1503, -1000, -1000, -1000, 2032,
-	5589, -1000, 14724, -1000, 6053, 6053, 6053, 6053, -1000, 16536,
-	-1000, 16083, -1000, 422, 6517, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 6053, 1924, 1924, 1924, 6053, 688, 6053, 6053,
-	-1000, 780, 754, 1924, 1924, 1924, 1924, 1924, -1000, 2791,
-	1924, 1924, 1924, 1924, -1000, -1000, 7445, 7445, 7445, 7445,
-	7445, 7445, 7445, 7445, 7445, 7445, 7445, 7445, 1676, 761,
-	7445, 7445, 7445, 1187, 1880, 1502, -1000, -1000, -1000, -1000,
-	-1000, 696, 803, 6053, 20663, 6053, 6053, 6053, -1000, 1384,
-	1379, -1000, -1000, 6053, -1000, 6053, 7445, 6053, -1000, 1924,
-	1067, 2032, 1428, -1000, 1691, -1000, 1448, 1856, -1000, 472,
-	1501, -1000, 682, 1441, -1000, -1000, -1000, -1000, 462, -1000,
+	-1000, -1000, 7411, -1000, 1858, 1857, 1855, 1853, 1852, 1851,
+	1849, 1242, 1848, 1845, 1839, 7898, 1837, 1834, 1829, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1643,
+	-1000, -301, -1000, 10297, 20220, 20220, -1000, 2034, -1000, 2034,
+	2567, -1000, 2121, -1000, 202, 105, -1000, -1000, -1000, -1000,
+	-1000, -1000, 620, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1711, -1000, 20220, -1000, -1000, 63, 15961, 782,
+	-1000, -1000, -1000, -1000, -1000, -1000, 166, -1000, -1000, 126,
+	-1000, 319, -55, 1615, -1000, -1000, 21, -74, 123, 76,
+	2228, 1239, -1000, 1254, 611, 1710, -1000, 869, 743, 2058,
+	1825, 18332, 20220, 20220, -39, -1000, 1652, -1000, -55, 1702,
+	1959, -1000, -1000, -1000, 2019, 20220, 17860, -1000, 1824, 1591,
+	-1000, -1000, 6437, -1000, -1000, 2207, 2207, 2207, 672, 21484,
+	787, 20220, 787, -1000, -1000, 787, -1000, 610, -1000, 20220,
+	643, -39, 1652, 2064, 1617, -1000, -1000, -1000, -1000, 2099,
+	20696, 233, -1000, -1000, 440, 410, 418, 1011, 318, -1000,
+	-1000, 1702, -1000, -1000, -1000, 1817, 754, -1000, -1000, 7898,
+	-1000, 1064, -1000, 3996, 3996, 3996, -1000, -1000, 13601, -1000,
+	-1000, -1000, 1709, 1702, 1976, 1707, -1000, 1707, -1000, -1000,
+	-1000, -1000, -1000, 2207, 5950, -1000, 15489, -1000, 6437, 6437,
+	6437, 6437, -1000, 17377, -1000, 16905, -1000, 269, 6924, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 6437, 2107, 2107, 2107,
+	6437, 763, 6437, 6437, -1000, 955, 806, 2107, 2107, 2107,
+	7898, 2107, 2107, -1000, 3014, 2107, 2107, 2107, 2107, 2107,
+	122, 122, 2107, -1000, -1000, 7898, 7898, 7898, 7898, 7898,
+	7898, 7898, 7898, 7898, 7898, 7898, 7898, 1809, 697, 7898,
+	7898, 7898, 1699, 1975, 1706, -1000, -1000, -1000, -1000, -1000,
+	781, 1064, 6437, 9095, 6437, 6437, 6437, -1000, 1540, 1536,
+	-1000, -1000, 6437, -1000, 6437, 7898, 6437, -1000, 2107, 6437,
+	1238, 2207, 1653, -1000, 1814, -1000, 1589, 2015, -1000, 607,
+	1705, -1000, 753, 1587, -1000, -1000, -1000, -1000, 604, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -3419,170 +3575,187 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -63, -1000, -1000, 19261, 1073,
-	1432, 681, 1422, -1000, 269, 461, 444, -1000, 15177, 19,
-	15177, -1000, 15177, -1000, -1000, 179, -1000, 19261, 1872, -1000,
-	-1000, -1000, 1065, -166, 6053, 6053, 19261, 6053, -1000, -1000,
-	-1000, 1627, 722, 1690, -98, 646, -1000, 397, 15177, 184,
-	-1000, 1804, 135, -1000, 1862, -1000, 675, -1000, 1689, 19261,
-	1420, -1000, 443, 218, -1000, 19261, 803, -1000, -1000, -1000,
-	2032, -1000, 610, -1000, 610, 701, 19261, -1000, -1000, -98,
-	397, 19261, 14724, 14724, 14724, 14724, -1000, 1841, 1828, -1000,
-	1851, 1850, 1840, 19261, 14724, 19261, -1000, -1000, -1000, 20091,
-	-1000, -1000, -1000, -1000, 1363, 2010, -1000, -1000, -1000, 365,
-	1481, 15177, 1064, 238, -1000, -1000, -1000, -1000, -1000, 19261,
-	19261, 2029, -1000, 1471, 1959, -1000, 739, 739, 706, -1000,
-	-1000, 434, -1000, -1000, 306, -1000, -1000, -1000, -1000, -1000,
-	1677, -1000, -1000, -1000, 1316, 1177, 803, 6053, -1000, -1000,
-	6053, 6053, 955, 6053, 1303, 1418, 1416, -1000, 1298, 2038,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 6053,
-	6053, 6053, 6053, 6053, 897, 5125, -1000, -1000, -1000, 6053,
-	6053, 6053, 6053, 1111, 1199, -1000, 741, 741, 589, 589,
-	589, 589, 589, 1002, 1002, -1000, -1000, -1000, 3263, 1676,
-	7445, 7445, 7445, 239, 1802, 1678, -1000, 6053, 712, -1000,
-	6053, 1148, -1000, 1290, 947, 1265, 1261, -1000, -1000, 1178,
-	1244, 956, 1239, 6053, 1450, 2029, -289, 4655, 204, 19261,
-	-289, 19261, 19261, 4655, -1000, 19261, 2364, 881, -1000, -1000,
-	-1000, 2037, 15177, 907, 598, 662, -1000, 11, -1000, 142,
-	260, 1063, -1000, 288, 674, -86, -72, 803, 803, 431,
-	-1000, 1905, 1895, 8370, 777, -1000, -1000, 1061, -1000, 248,
-	-1000, -1000, -1000, 411, 21, 880, 246, -1000, 1076, 19261,
-	-1000, -1000, -1000, -1000, -1000, 646, 646, 646, 8370, 245,
-	1414, 429, 14724, 19261, -1000, 14271, 1234, -1000, -1000, 2032,
-	2032, 610, -1000, 777, 248, 1396, -1000, 671, 1781, 1793,
-	1781, -1000, -1000, -1000, -1000, 1825, -1000, 1822, -1000, -1000,
-	1396, -1000, 1627, -1000, -1000, -1000, 1422, 1231, -1000, -1000,
-	-1000, -1000, 2027, 2014, 15630, -1000, -1000, -1000, -1000, -1000,
-	6053, 1864, 1846, 1808, 20770, 1407, -1000, -1000, -1000, -1000,
-	6053, 1798, 1792, 1772, 1759, 1661, -1000, 6053, 6053, 811,
-	1655, 1620, 1614, 1550, 1403, -1000, 239, 1802, 1458, -1000,
-	7445, 7445, 1532, 676, -1000, 6053, 793, 574, 12006, -1000,
-	6053, -1000, -1000, -1000, 12006, -1000, 7445, -1000, 1520, 1059,
-	2027, -1000, 1229, 1445, -1000, -289, -1000, -1000, 1428, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 15177, -1000,
-	139, -1000, -1000, -1000, -1000, -1000, -1000, -51, -1000, 19261,
-	1055, -293, -69, 2006, 2003, 19261, 215, 19261, 1223, 1436,
-	-1000, -1000, -1000, 20847, 621, -1000, 19261, 736, 407, 259,
-	407, 735, 1675, -1000, -1000, -1000, 1030, -41, -1000, -1000,
-	1029, 1024, 879, -1000, -1000, 865, 576, -29, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1674, 12006, 12459, 1023, 1217, 19714,
-	14724, 14271, 1357, -1000, 426, -1000, -1000, -1000, -1000, 2032,
-	-1000, -1000, 2025, 19261, 4655, -1000, -1000, 6053, 1671, -1000,
-	6053, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 6053, 6053,
-	-1000, -1000, 803, -1000, -1000, -1000, 1211, -1000, 370, 370,
-	-10, -1000, -1000, -1000, 1606, -1000, 1653, 1653, 1606, 1606,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1669,
-	1666, -1000, 1606, 1648, 1648, 1606, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -80, -1000, -1000, 20220, 1225,
+	1577, 782, 1642, -1000, 317, 603, 602, -1000, 15961, -10,
+	15961, -1000, 15961, -1000, -1000, 190, -1000, 20220, 2022, -1000,
+	-1000, 1220, -1000, 1219, -1000, 1217, -75, 6437, 6437, 20220,
+	6437, -1000, -1000, -1000, -1000, 743, -1000, 811, 700, 146,
+	700, 147, 700, -1000, -1000, 20220, 1781, 786, 1813, 1781,
+	-113, 723, 371, 15961, 264, -1000, 1974, 124, -1000, 2019,
+	-1000, 653, -1000, 1812, 20220, 1637, -1000, 601, 21815, -1000,
+	20220, 1064, -1000, -1000, -1000, 2207, -1000, 672, -1000, 672,
+	787, 20220, -1000, -1000, 643, -1000, -1000, 138, -113, 371,
+	20220, 15489, 15489, 15489, 15489, -1000, 2002, 1999, -1000, 2037,
+	2031, 2021, 20220, 15489, 20220, -1000, -1000, -1000, 21090, -1000,
+	-1000, -1000, -1000, 1534, 2240, -1000, -1000, -1000, 405, 1702,
+	15961, 1216, 239, -1000, -1000, -1000, -1000, -1000, 20220, 20220,
+	2191, -1000, 1680, 2072, -1000, 831, 831, 794, -1000, -1000,
+	663, -1000, -1000, 329, -1000, -1000, -1000, -1000, -1000, 1811,
+	-1000, -1000, -1000, 1524, 1640, 1064, 6437, -1000, -1000, 6437,
+	6437, 1250, 6437, 1513, 1546, 1539, -1000, 1505, 2226, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1654, 1654, 1659, 1654, -1000, 1400, -1000, -1000, -1000,
-	-1000, -1000, 1374, 1369, 6053, -1000, -1000, -1000, -1000, -1000,
-	-1000, 7445, -1000, -1000, -1000, -1000, 803, 6053, 1194, -1000,
-	1606, 1653, -1000, 1606, 1648, 1606, 370, 370, 1184, 1130,
-	1179, 1533, -1000, 1156, -1000, -1000, 4655, 1428, 1422, -1000,
-	-1000, -119, 117, -297, 1016, -1000, 2002, 1013, 902, -1000,
-	1627, 21058, 8370, 732, -1000, -1000, 19261, 19261, -1000, 19261,
-	19261, 259, 6053, -1000, -1000, 176, -1000, -1000, 46, -1000,
-	-1000, 864, 274, -1000, 13818, -1000, -1000, -1000, -1000, -1000,
-	-67, 2032, 1357, 426, -1000, -1000, 597, -1000, 1951, -1000,
-	-1000, 803, 19261, 803, 803, 1177, -1000, -1000, -1000, -1000,
-	-1000, -18, -1000, -1000, -1000, -1000, 877, -1000, 875, -1000,
-	-1000, -1000, 1011, 1011, -1000, -1000, 874, -1000, -1000, -1000,
-	872, -1000, -1000, 863, -1000, -1000, -1000, -1000, 1343, -1000,
-	803, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 6053, -1000, -1000, -1000, -1000, -1000, -91, -79, -1000,
-	986, -299, 857, -1000, 985, -73, -1000, -1000, 1904, 241,
-	21000, -1000, 646, 646, 683, 646, 646, 646, 646, 161,
-	158, 646, 646, 646, 646, 646, 646, 646, 646, 646,
-	646, 646, 646, 646, 646, 1624, -1000, -1000, 732, -1000,
-	-1000, 753, 7445, -1000, -1000, 981, 12459, 446, 471, 1623,
-	-1000, 119, 733, 717, -1000, 19261, -1000, 1619, 1535, 95,
-	1615, -1000, 1611, 1607, 19261, 1272, 254, 977, -1000, -1000,
-	45, -1000, -1000, 1382, -1000, 1606, 6053, -1000, -119, 2025,
-	-1000, -1000, 1378, -1000, -1000, 1151, 1145, 1362, -1000, 1355,
-	1142, 1345, 1342, -1000, 1213, 265, -78, -79, -1000, 2001,
-	-77, 1993, 1992, 669, -1000, 1604, -1000, -1000, 1989, 215,
-	-1000, 1982, 21058, -1000, 856, 854, 646, 646, 846, 968,
-	957, 954, 646, 646, 845, 953, 20091, 840, 837, 836,
-	838, 935, 776, 812, 802, 798, 19261, 1603, 900, -1000,
-	-1000, 1802, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 833, 1602, -1000, -1000, 1601, 13818, 86,
-	86, 13818, 13818, 13818, 1599, 311, -1000, -53, -1000, -1000,
-	-1000, 13818, 1871, 1196, -1000, 1951, -1000, -1000, -1000, -1000,
-	929, -1000, -1000, -1000, 831, -1000, 797, -1000, 1598, 791,
-	-69, 1981, -1000, 902, 1978, 902, 902, 928, 19261, 902,
-	-1000, 113, -1000, -1000, -1000, 12006, 12006, -1000, -1000, -1000,
-	-1000, 927, 911, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 170, 19261, 1339, -1000, 664,
-	1137, 6053, -238, 13818, 1336, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1334, 1332, 1330, 13818, -1000, -1000, -1000, 126,
-	-1000, 124, -1000, -1000, 1871, -1000, -1000, 1132, 1124, 1918,
-	11553, -96, -1000, 908, -1000, 902, -1000, -1000, -307, 1325,
-	-1000, -1000, 118, 205, 196, -1000, 282, -1000, -1000, -1000,
-	-1000, -1000, -1000, 175, 1293, -1000, 900, 896, -1000, 1185,
-	1791, -1000, 24, 1274, -1000, -1000, -1000, -1000, 1268, -1000,
-	-1000, 646, 894, 72, -1000, -1000, -1000, -1000, -1000, -1000,
-	19261, -1000, 1264, -1000, -1000, -1000, 424, -1000, -1000, -1000,
-	-1000, -1000, -1000, 19261, 107, 790, 7445, 1597, 7445, 1596,
-	121, 1578, -1000, -1000, -1000, -1000, -1000, 311, -1000, -1000,
-	1790, 1785, 2036, -1000, -1000, -1000, -1000, 124, 124, 124,
-	124, 8, 775, -1000, 926, 1068, -1000, 643, -1000, 13365,
-	19261, -1000, 1572, 1960, -1000, 1438, 19261, 1409, 19261, 1571,
-	641, 7445, -1000, -1000, 2043, -1000, 2041, 492, 492, -1000,
-	-1000, -1000, 19261, 4191, -1000, 414, -1000, 233, 115, -1000,
-	1260, -1000, 1243, 19261, 771, 1230, -1000, -1000, -1000, 752,
-	132, -1000, -1000, -1000, 803, 19261, 1227, -1000, 997, 97,
-	-1000, -1000, 1191, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	233, 1765, -1000, 768, -1000, -1000, 20909, 339, -1000, -1000,
-	20909, 105, -1000, 200, 1410, -1000, -1000, 1189, -1000, 958,
-	392, 6053, -1000, 105, 21058, -1000, 6053, 1182, -1000, 21058,
-	1150, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 6437, 6437,
+	6437, 2891, 6437, 6437, 1331, 5463, -1000, -1000, -1000, 6437,
+	6437, 6437, 6437, 6437, 1677, 1674, 6437, 1088, 990, -1000,
+	1074, 1074, 644, 644, 644, 644, 644, 1150, 1150, -1000,
+	-1000, -1000, 3509, 1809, 7898, 7898, 7898, 299, 3089, 2105,
+	-1000, 6437, 819, -1000, 6437, 1227, -1000, 1493, 1403, 1466,
+	1449, -1000, -1000, 1302, 1447, 1678, 1435, 6437, 1173, 1672,
+	2191, -301, 4970, 218, 20220, -301, 20220, 20220, 4970, -1000,
+	20220, 2567, 989, -1000, -1000, -1000, 2225, 15961, 1011, 660,
+	720, -1000, -5, -1000, 164, 271, 1212, -1000, 333, -320,
+	752, -1000, -161, 1210, 1064, 1064, 655, -1000, -1000, -1000,
+	700, -1000, 985, -1000, -1000, -1000, -1000, -1000, -1000, 700,
+	-1000, 1433, 1671, -1000, 230, 2098, 2056, 8865, 2097, 994,
+	-1000, -1000, 1209, -1000, 253, -1000, -1000, -1000, 298, 6,
+	983, 248, -1000, 1282, 20220, -1000, -1000, -1000, -1000, -1000,
+	723, 723, 723, 8865, 292, 1423, 579, 15489, 20220, -1000,
+	15017, 1411, -1000, -1000, 2207, 2207, 672, -1000, -1000, -1000,
+	700, 994, 253, 1527, -1000, 749, 1948, 1972, 1948, -1000,
+	-1000, -1000, -1000, 1996, -1000, 1985, -1000, -1000, 1527, -1000,
+	1781, -1000, -1000, -1000, 1642, 1405, -1000, -1000, -1000, -1000,
+	2188, 2178, 16433, 6437, -1000, -1000, -1000, -1000, -1000, 6437,
+	1966, 1954, 1928, 21809, 1533, -1000, -1000, -1000, -1000, 6437,
+	1548, 1924, 1892, 6437, 1880, 1866, -1000, 6437, 6437, 1265,
+	1861, 1854, 1840, 1795, 1144, 6437, 6437, 1753, 1529, -1000,
+	299, 3089, 1185, -1000, 7898, 7898, 1718, 777, -1000, 6437,
+	925, 847, 12657, -1000, 6437, -1000, -1000, -1000, 12657, -1000,
+	7898, -1000, 1665, 6437, -293, 1207, 2188, -1000, 1399, 1670,
+	-1000, -301, -1000, -1000, 1653, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 15961, -1000, 162, -1000, -1000, -1000,
+	-1000, -1000, -1000, -66, -1000, 20220, 1189, 1188, -118, -86,
+	-1000, 20220, -1000, -1000, -1000, 71, 20220, -1000, -28, -1000,
+	-1000, -1000, 1758, -1000, 1786, 1786, 1758, 1758, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1807, 1804,
+	-1000, 1785, 1785, 1785, 1758, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1800, 1800, 1803, 1800, 233, 20220, 1396, 1662, -1000, -1000,
+	-1000, 230, 760, -1000, 20220, 824, 445, 310, 445, 814,
+	1799, -1000, -1000, 233, -1000, 1165, -56, -1000, -1000, 1160,
+	1158, 981, -1000, -1000, 954, 635, -46, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1791, 12657, 13129, 1145, 1384, 20696, 15489,
+	15017, 1387, -1000, 524, -1000, -1000, -1000, -1000, 2207, -1000,
+	-1000, -1000, 2184, 20220, 4970, -1000, -1000, 6437, 1790, -1000,
+	6437, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 6437, 6437,
+	-1000, -1000, 1378, 1064, -1000, -1000, -1000, 1375, -1000, 404,
+	404, -1000, 1660, -202, -1000, -1000, 1610, -1000, -1000, 1597,
+	1584, 6437, -1000, -1000, -1000, -1000, 1369, 1457, 1349, -1000,
+	-1000, -1000, 7898, -1000, -1000, -1000, -1000, 1064, 6437, 1365,
+	-1000, 1758, 1786, -1000, 1758, 1785, 1785, 404, 404, 1347,
+	1245, 1345, 2998, -1000, 1556, 2190, 1337, -1000, -1000, 4970,
+	1653, 1642, -1000, -1000, -148, 131, 744, 125, -306, -92,
+	2177, 2174, 230, -1000, -35, -1000, -1000, -1000, -1000, 976,
+	-1000, 952, -1000, -1000, -1000, 1143, 1143, -1000, 948, -1000,
+	-1000, -1000, -1000, 947, -1000, -1000, 944, -1000, -1000, 1781,
+	21962, 8865, 21983, -1000, -1000, 20220, 20220, -1000, 20220, 20220,
+	310, 6437, -1000, -1000, -1000, 189, -1000, -1000, 22, -1000,
+	-1000, 927, 274, -1000, 14545, -1000, -1000, -1000, -1000, -1000,
+	-84, 2207, 1387, 524, -1000, -1000, 656, -1000, 2126, -1000,
+	-1000, 1064, 20220, 1064, 1064, 1640, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1333, 1141, -1000, -1000, -1000, 1477, -1000,
+	6437, 6437, -1000, 1064, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 6437, -1000, -1000, -1000, 1776, -1000,
+	-1000, -1000, -120, -90, -1000, 1128, 1119, -1000, 1118, -308,
+	1108, -1000, 2173, 1103, 1052, 73, -1000, -1000, 1328, 1324,
+	1523, -1000, 1520, 1320, 1517, 1510, 2093, 291, 21842, -1000,
+	723, 723, 683, 723, 723, 723, 723, 242, 236, 723,
+	723, 723, 723, 723, 723, 723, 723, 723, 723, 723,
+	723, 723, 723, 1772, -1000, -1000, 21983, -1000, -1000, 844,
+	7898, -1000, -1000, 1101, 13129, 457, 465, 1769, -1000, 204,
+	44, 1768, 808, 804, -1000, 20220, -1000, 1767, 1863, 140,
+	1765, -1000, 1761, 1759, 20220, 1462, 261, 1095, -1000, -1000,
+	20, -1000, -1000, 1504, -1000, 1758, 6437, -1000, -148, 2184,
+	-1000, -1000, 1498, -1000, -1000, -1000, 1450, 1436, 1430, 2183,
+	314, -105, -90, -1000, 2171, -95, 2169, 2168, 742, -1000,
+	740, -310, 941, -1000, 1094, -111, -1000, -1000, 6437, -1000,
+	-1000, -1000, 1093, -1000, -1000, -1000, 930, -1000, 928, 233,
+	-1000, 2167, 21962, -1000, 921, 902, 723, 723, 896, 1092,
+	1091, 1089, 723, 723, 894, 1085, 21090, 879, 878, 877,
+	910, 1079, 489, 909, 904, 903, 20220, 1752, 1010, -1000,
+	-1000, 3089, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 873, 1751, 2063, 6437, -1000, -1000, 1750,
+	14545, 40, 40, 14545, 14545, 14545, 1749, 363, -1000, -67,
+	-1000, -1000, -1000, 14545, 2066, 1415, -1000, 2126, -1000, -1000,
+	-1000, -1000, 2165, 1747, 866, -92, 2164, -1000, 1052, 2163,
+	1052, 1052, 1067, 1066, -1000, 1746, -1000, -1000, 2161, 1064,
+	-1000, 1314, 1309, -1000, 196, -1000, -1000, -1000, 12657, 12657,
+	-1000, -1000, -1000, -1000, 1063, 1062, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 255, 20220,
+	1496, -1000, 731, 1299, 6437, 1739, 1338, -238, 14545, 1492,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1490, 1470, 1464,
+	14545, -1000, -1000, -1000, 205, -1000, 151, -1000, -1000, 2066,
+	-1000, 6437, 2106, 12185, -101, -1000, 1054, -1000, 1052, -1000,
+	-1000, -1000, -323, 20220, 1052, -1000, -1000, 161, 203, 195,
+	-1000, 250, -1000, -1000, -1000, -1000, -1000, -1000, 257, 1443,
+	-1000, 1010, 1001, -1000, 1055, 6437, 57, 1969, -1000, 25,
+	1432, -1000, -1000, -1000, -1000, 1418, -1000, -1000, 723, 1000,
+	53, -1000, -1000, -1000, 869, -1000, 20220, -1000, 1395, -1000,
+	-1000, -1000, 520, -1000, -1000, -1000, -1000, -1000, 1368, -1000,
+	-1000, 158, 855, 7898, 1724, 7898, 1722, 165, 1721, -1000,
+	-1000, -1000, -1000, -1000, 363, 1008, -1000, -1000, -1000, -1000,
+	-1000, 1965, 1963, 2211, -1000, -1000, -1000, -1000, 151, 151,
+	151, 151, -16, 854, -1000, 1049, 1297, 1632, -1000, 719,
+	500, -1000, 14073, 20220, -1000, 20220, 1717, 2129, -1000, 2122,
+	20220, 1105, 20220, 1716, 686, 7898, -1000, 57, -1000, 2232,
+	-1000, 2212, 444, 444, -1000, -1000, -1000, -1000, 20220, 4483,
+	20220, -1000, 499, -1000, -1000, 287, 178, -1000, 1352, -1000,
+	1341, 20220, 853, 972, -1000, -1000, -1000, -1000, 883, 206,
+	-1000, -1000, -1000, 1064, 472, 20220, 1327, -1000, 1281, 139,
+	-1000, -1000, 1323, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	287, 1983, -1000, 851, -1000, -1000, 2079, 403, -1000, -1000,
+	2079, 148, -1000, 280, 1700, -1000, -1000, 1319, -1000, 1058,
+	492, 6437, -1000, 148, 21962, -1000, 6437, 1317, -1000, 21962,
+	1307, -1000, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 127, 2350, 177, 128, 120, 174, 2349, 1922, 1921,
-	2348, 2346, 2345, 2344, 2343, 2342, 2340, 2339, 2338, 2337,
-	2335, 2334, 2333, 2332, 2331, 2330, 2329, 173, 2328, 2327,
-	2325, 2323, 2322, 2320, 2319, 2318, 2316, 2313, 2311, 2309,
-	2308, 118, 2307, 1904, 2306, 2305, 2304, 2303, 2302, 167,
-	2301, 2300, 2292, 2290, 2285, 2284, 2283, 2279, 2277, 2276,
-	2274, 2273, 2271, 156, 57, 2270, 39, 61, 107, 204,
-	73, 197, 190, 141, 70, 126, 164, 116, 2269, 2268,
-	111, 24, 133, 2267, 43, 40, 35, 191, 112, 54,
-	60, 91, 2266, 2265, 88, 2264, 2263, 97, 2262, 59,
-	2261, 78, 48, 90, 26, 2260, 2259, 2258, 80, 2257,
-	2256, 2255, 2254, 85, 2253, 63, 51, 2252, 2251, 2250,
-	2249, 2248, 27, 2247, 46, 2246, 2245, 2243, 2242, 2241,
-	2239, 2238, 11, 16, 21, 2233, 2232, 14, 2, 2231,
-	147, 189, 84, 108, 2230, 366, 2229, 2228, 2226, 115,
-	2225, 139, 2224, 2223, 2222, 2221, 2220, 10, 2219, 53,
-	2218, 2215, 2214, 56, 2213, 2212, 2211, 96, 49, 50,
-	92, 2210, 2208, 72, 157, 13, 42, 0, 148, 38,
-	2207, 2206, 2205, 188, 145, 143, 165, 122, 272, 114,
-	2204, 2203, 47, 2202, 117, 2201, 109, 66, 44, 2200,
-	87, 2198, 37, 86, 2197, 105, 2194, 135, 1, 100,
-	2193, 158, 2192, 2190, 2188, 125, 2187, 2186, 67, 124,
-	2185, 2184, 2183, 25, 2182, 29, 19, 2180, 95, 171,
-	2178, 132, 98, 131, 138, 82, 161, 166, 68, 113,
-	797, 103, 81, 17, 2177, 155, 2176, 251, 184, 151,
-	2175, 2174, 175, 360, 153, 2172, 130, 7, 2171, 2170,
-	8, 2169, 22, 2168, 2167, 2165, 2164, 6, 2163, 2162,
-	2161, 4, 3, 2160, 5, 110, 41, 65, 2157, 64,
-	75, 2156, 2155, 2153, 2152, 2151, 206, 2150, 2149, 2148,
-	2146, 2145, 2142, 2141, 83, 2140, 2139, 2138, 2137, 69,
-	2136, 2135, 2134, 2132, 2128, 32, 2126, 2125, 23, 2124,
-	30, 2123, 2122, 2120, 15, 136, 2119, 2118, 18, 2115,
-	2114, 9, 12, 2113, 2112, 58, 45, 31, 79, 77,
-	2110, 20, 2108, 99, 2106, 2105, 140, 2104, 102, 2103,
-	162, 169, 278, 2101, 146, 2100, 2096, 2095, 2092, 2091,
-	2090, 2089, 792, 2088, 2087, 170, 55, 159, 2085, 2080,
-	154, 2079, 123, 94, 89, 150, 2076, 152, 2068, 2064,
-	2063, 144, 2060,
-}
-
-//line mysql_sql.y:7222
+	0, 119, 2667, 181, 144, 129, 179, 2666, 2108, 2105,
+	2665, 2664, 2663, 2662, 2661, 2651, 2650, 2645, 2641, 2640,
+	2639, 2638, 2637, 2635, 2634, 2633, 2632, 2628, 2627, 2626,
+	2625, 2624, 134, 118, 2611, 2610, 2607, 93, 74, 2606,
+	2591, 177, 2578, 2576, 2575, 2571, 2570, 2566, 2565, 2564,
+	2562, 2561, 2560, 2559, 2558, 124, 2557, 2099, 2555, 2554,
+	2552, 2551, 2549, 163, 2548, 2546, 2545, 2532, 2528, 2527,
+	2525, 2524, 2523, 2520, 2519, 2518, 2517, 2509, 2508, 172,
+	2506, 52, 2505, 2504, 2503, 45, 42, 126, 202, 88,
+	204, 199, 139, 70, 122, 171, 115, 2500, 2498, 108,
+	21, 128, 2497, 109, 39, 2496, 33, 182, 96, 54,
+	63, 92, 2487, 2486, 87, 2485, 2484, 94, 2479, 60,
+	2478, 72, 50, 91, 28, 2477, 2475, 2470, 64, 2469,
+	2467, 2466, 2465, 79, 2463, 65, 58, 2462, 2461, 2460,
+	2459, 2457, 29, 2456, 48, 2455, 2454, 2453, 2452, 2450,
+	2449, 2448, 13, 18, 20, 2447, 2446, 17, 2, 2445,
+	168, 49, 81, 113, 2443, 363, 2441, 2440, 2439, 114,
+	2438, 162, 2437, 2435, 2434, 2433, 2427, 9, 2426, 61,
+	2425, 2423, 2422, 46, 2421, 2420, 2419, 100, 40, 59,
+	98, 2418, 2416, 68, 164, 16, 53, 0, 157, 41,
+	2414, 2413, 2412, 200, 148, 156, 174, 143, 236, 120,
+	2411, 2410, 57, 2409, 112, 2408, 104, 67, 8, 2406,
+	127, 2403, 154, 85, 2402, 103, 2400, 86, 1, 101,
+	2398, 165, 2397, 2396, 2395, 132, 2394, 2392, 73, 131,
+	2391, 2390, 2389, 26, 2388, 32, 27, 2387, 116, 183,
+	2386, 135, 97, 133, 136, 83, 169, 173, 66, 111,
+	805, 106, 84, 19, 2385, 170, 2384, 218, 166, 140,
+	2383, 2382, 180, 334, 158, 2378, 137, 10, 2376, 2375,
+	7, 2372, 25, 2371, 2370, 2369, 2368, 14, 6, 2367,
+	2366, 2363, 4, 3, 2362, 5, 107, 44, 76, 2361,
+	69, 82, 2360, 2359, 2357, 2356, 2355, 365, 2354, 2350,
+	2349, 2348, 2347, 2346, 2345, 77, 2344, 2342, 2341, 2340,
+	2338, 62, 2337, 2336, 2335, 2334, 2333, 37, 2332, 2331,
+	24, 2317, 31, 2313, 2312, 2309, 15, 146, 2308, 2304,
+	22, 2302, 2298, 11, 12, 2297, 2295, 56, 47, 34,
+	75, 71, 2294, 23, 2293, 105, 2292, 2291, 138, 155,
+	99, 2289, 151, 175, 229, 2285, 161, 2284, 2283, 2278,
+	2276, 2275, 2274, 2271, 810, 2270, 2269, 176, 51, 167,
+	2268, 2265, 149, 2263, 117, 95, 90, 159, 2262, 147,
+	2261, 2260, 2253, 153, 2252,
+}
+
+//line mysql_sql.y:7668
 type yySymType struct {
 	union interface{}
 	id    int
@@ -3781,6 +3954,16 @@ func (st *yySymType) funcTypeUnion() tree.FuncType {
 	return v
 }
 
+func (st *yySymType) functionArgUnion() *tree.FunctionArg {
+	v, _ := st.union.(*tree.FunctionArg)
+	return v
+}
+
+func (st *yySymType) functionArgsUnion() []*tree.FunctionArg {
+	v, _ := st.union.([]*tree.FunctionArg)
+	return v
+}
+
 func (st *yySymType) groupByUnion() tree.GroupBy {
 	v, _ := st.union.(tree.GroupBy)
 	return v
@@ -4011,6 +4194,16 @@ func (st *yySymType) selectStatementUnion() tree.SelectStatement {
 	return v
 }
 
+func (st *yySymType) sequenceOptionUnion() *tree.SequenceOption {
+	v, _ := st.union.(*tree.SequenceOption)
+	return v
+}
+
+func (st *yySymType) sequenceOptionsUnion() tree.SequenceOptions {
+	v, _ := st.union.(tree.SequenceOptions)
+	return v
+}
+
 func (st *yySymType) setDefaultRoleUnion() *tree.SetDefaultRole {
 	v, _ := st.union.(*tree.SetDefaultRole)
 	return v
@@ -4227,200 +4420,211 @@ func (st *yySymType) zeroFillOptUnion() bool {
 }
 
 var yyR1 = [...]int{
-	0, 368, 2, 2, 1, 1, 1, 1, 1, 1,
+	0, 390, 2, 2, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 57, 324, 324, 323, 323, 322,
-	322, 321, 321, 321, 320, 320, 320, 319, 319, 318,
-	318, 317, 317, 315, 315, 316, 314, 313, 313, 313,
-	311, 311, 309, 309, 310, 310, 304, 304, 307, 307,
-	305, 305, 305, 305, 308, 303, 303, 303, 302, 302,
-	56, 56, 56, 242, 242, 55, 55, 256, 256, 256,
-	256, 256, 254, 254, 254, 254, 254, 254, 253, 253,
-	252, 252, 257, 257, 255, 255, 255, 255, 255, 255,
-	255, 255, 255, 255, 255, 255, 255, 255, 255, 255,
-	255, 255, 255, 255, 255, 255, 255, 255, 255, 255,
-	255, 255, 255, 255, 255, 255, 255, 50, 50, 50,
-	50, 53, 54, 250, 250, 250, 250, 250, 251, 251,
-	251, 51, 52, 52, 241, 241, 246, 246, 245, 245,
-	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
-	249, 249, 249, 248, 248, 247, 247, 44, 44, 44,
-	47, 46, 240, 240, 240, 240, 240, 240, 240, 240,
-	240, 45, 45, 45, 45, 45, 45, 42, 42, 42,
-	42, 42, 41, 41, 43, 43, 239, 239, 238, 60,
-	60, 60, 60, 60, 60, 60, 59, 59, 62, 62,
-	61, 49, 49, 49, 49, 48, 48, 48, 48, 48,
-	48, 48, 48, 48, 347, 347, 347, 349, 349, 181,
-	182, 369, 180, 180, 180, 343, 343, 344, 345, 346,
-	346, 346, 58, 7, 7, 39, 40, 367, 367, 286,
-	286, 194, 194, 193, 193, 193, 193, 193, 193, 193,
-	193, 193, 193, 193, 193, 193, 193, 193, 193, 289,
-	290, 190, 27, 27, 27, 27, 27, 27, 27, 27,
-	27, 27, 27, 31, 31, 38, 370, 370, 370, 36,
-	37, 285, 285, 285, 35, 34, 33, 32, 32, 30,
-	30, 29, 29, 187, 187, 189, 189, 185, 371, 371,
-	262, 262, 188, 188, 28, 28, 28, 186, 186, 164,
-	184, 184, 184, 6, 10, 10, 10, 10, 10, 10,
-	10, 10, 16, 18, 236, 236, 233, 17, 13, 12,
-	15, 11, 14, 5, 5, 5, 5, 8, 8, 9,
-	75, 75, 103, 103, 356, 356, 352, 352, 353, 353,
-	353, 354, 354, 355, 355, 4, 293, 293, 293, 293,
-	293, 293, 332, 332, 332, 333, 93, 93, 88, 88,
-	294, 294, 209, 334, 334, 301, 301, 300, 300, 299,
-	299, 91, 91, 92, 92, 79, 79, 63, 63, 306,
-	306, 306, 306, 312, 312, 283, 283, 129, 129, 160,
-	160, 161, 161, 67, 67, 68, 68, 68, 68, 68,
-	68, 340, 340, 342, 342, 341, 90, 90, 86, 86,
-	87, 87, 87, 85, 85, 84, 83, 83, 82, 81,
-	81, 81, 70, 70, 69, 69, 69, 69, 69, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 71, 71, 348, 348, 348, 287, 287, 287, 292,
-	292, 142, 142, 143, 143, 141, 141, 72, 72, 73,
-	73, 73, 73, 140, 140, 139, 74, 74, 80, 76,
-	76, 78, 78, 78, 78, 150, 150, 149, 149, 149,
-	149, 96, 96, 148, 147, 147, 147, 95, 95, 94,
-	94, 89, 89, 77, 77, 146, 372, 372, 144, 173,
-	173, 173, 179, 179, 172, 172, 172, 172, 172, 183,
-	178, 178, 174, 174, 175, 175, 175, 3, 3, 3,
-	3, 22, 22, 22, 22, 26, 26, 350, 350, 19,
-	357, 360, 358, 361, 361, 361, 362, 362, 362, 363,
-	363, 20, 359, 364, 364, 364, 237, 237, 234, 235,
-	235, 232, 231, 231, 231, 366, 366, 365, 365, 365,
-	176, 176, 21, 228, 228, 229, 230, 230, 222, 222,
-	222, 222, 25, 226, 226, 227, 227, 227, 227, 227,
-	223, 223, 225, 225, 221, 221, 221, 221, 221, 24,
-	220, 220, 218, 218, 216, 216, 217, 217, 215, 215,
-	215, 219, 219, 23, 23, 65, 64, 64, 64, 66,
-	288, 288, 258, 258, 261, 261, 268, 268, 269, 269,
-	267, 267, 274, 274, 273, 273, 272, 272, 271, 271,
-	270, 270, 270, 270, 265, 265, 264, 264, 259, 259,
-	259, 259, 259, 260, 260, 263, 263, 266, 266, 120,
-	120, 121, 121, 121, 138, 138, 138, 138, 138, 138,
-	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
-	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
-	138, 138, 138, 330, 330, 331, 123, 123, 123, 127,
-	127, 127, 127, 127, 127, 122, 122, 124, 124, 104,
-	104, 102, 102, 97, 97, 98, 98, 99, 99, 100,
-	100, 101, 101, 101, 101, 101, 101, 244, 244, 328,
-	328, 329, 329, 325, 325, 325, 327, 327, 327, 327,
-	327, 326, 326, 105, 158, 158, 158, 177, 177, 177,
-	157, 157, 157, 119, 119, 118, 118, 116, 116, 116,
-	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
-	243, 243, 191, 191, 192, 192, 137, 135, 135, 136,
-	136, 136, 136, 133, 134, 132, 132, 132, 132, 132,
-	131, 131, 130, 130, 130, 224, 224, 128, 128, 126,
-	126, 126, 125, 125, 125, 275, 198, 198, 198, 198,
-	198, 198, 198, 198, 198, 198, 198, 198, 198, 200,
-	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
-	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
-	201, 201, 206, 206, 339, 339, 338, 106, 106, 106,
-	107, 107, 107, 107, 107, 107, 107, 107, 107, 115,
-	115, 115, 155, 155, 155, 155, 155, 155, 155, 155,
-	155, 155, 155, 155, 155, 155, 155, 298, 298, 298,
-	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
-	152, 152, 156, 351, 351, 351, 335, 335, 336, 336,
-	336, 336, 336, 336, 336, 336, 336, 336, 336, 336,
-	337, 337, 337, 337, 337, 337, 337, 337, 337, 337,
-	337, 337, 337, 337, 337, 337, 337, 154, 154, 153,
-	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
-	153, 153, 210, 210, 211, 211, 295, 295, 295, 295,
-	295, 295, 296, 296, 297, 297, 297, 297, 291, 291,
-	291, 291, 291, 291, 291, 291, 291, 291, 291, 291,
-	291, 291, 291, 291, 291, 291, 291, 291, 291, 291,
-	291, 291, 291, 291, 291, 291, 199, 151, 151, 151,
-	212, 207, 207, 208, 208, 202, 202, 202, 202, 202,
-	202, 202, 202, 202, 202, 202, 204, 204, 204, 204,
-	204, 196, 196, 197, 197, 197, 197, 197, 197, 197,
-	197, 197, 203, 203, 205, 205, 214, 214, 214, 213,
-	213, 213, 213, 213, 213, 213, 117, 117, 117, 117,
-	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
-	108, 108, 108, 108, 112, 112, 114, 114, 114, 114,
-	114, 114, 114, 114, 114, 114, 114, 114, 114, 114,
-	113, 113, 113, 113, 111, 111, 111, 111, 111, 109,
-	109, 109, 109, 109, 109, 109, 109, 109, 109, 109,
-	109, 109, 109, 109, 110, 159, 159, 276, 276, 279,
-	279, 277, 277, 278, 280, 280, 280, 281, 281, 281,
-	282, 282, 282, 284, 284, 163, 163, 163, 169, 169,
-	162, 162, 170, 170, 171, 171, 167, 167, 167, 167,
-	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
-	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
-	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
-	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
-	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
-	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
-	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
-	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
-	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
-	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
-	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
-	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
-	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
-	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
-	167, 167, 167, 167, 167, 167, 167, 167, 167, 167,
-	167, 167, 167, 167, 167, 167, 167, 168, 168, 168,
-	168, 168, 168, 168, 168, 168, 168, 168, 168, 168,
-	168, 168, 168, 168, 168, 168, 168, 168, 168, 168,
-	168, 168, 168, 168, 168, 168, 168, 168, 168, 168,
-	168, 168, 168, 168, 168, 168, 168, 168, 168, 168,
-	168, 168, 168, 168, 168, 168, 168, 168, 168, 168,
-	168, 168, 168, 168, 168, 168, 168, 168, 168, 168,
-	168, 168, 168, 168, 168, 168, 168, 168, 168, 168,
-	168, 168, 168, 168, 168, 168, 168, 168, 168, 168,
-	168, 168, 168, 168, 168, 168, 168, 168, 168, 168,
-	168, 168, 168, 168, 168, 168, 168, 168, 168, 168,
-	168, 168, 168, 168, 168, 168, 168, 168, 168, 168,
-	168, 168, 168, 168, 168, 168, 168, 168, 168, 168,
-	168, 168, 168, 168, 168, 168, 168, 168, 168, 168,
-	168, 168, 168, 168, 168, 168, 168, 168, 168, 168,
-	168, 168, 168, 168, 168, 168, 168, 168, 168, 168,
-	168, 168, 168, 168, 168, 168, 168, 168, 168, 168,
-	168, 168, 166, 166, 166, 166, 166, 166, 166, 166,
-	166, 166, 165, 165, 165, 165, 165, 165, 165, 165,
+	1, 1, 1, 1, 1, 1, 71, 346, 346, 345,
+	345, 344, 344, 343, 343, 343, 342, 342, 342, 341,
+	341, 340, 340, 339, 339, 337, 337, 338, 336, 335,
+	335, 335, 333, 333, 331, 331, 332, 332, 326, 326,
+	329, 329, 327, 327, 327, 327, 330, 325, 325, 325,
+	324, 324, 70, 70, 70, 262, 262, 69, 69, 276,
+	276, 276, 276, 276, 274, 274, 274, 274, 274, 274,
+	273, 273, 272, 272, 277, 277, 275, 275, 275, 275,
+	275, 275, 275, 275, 275, 275, 275, 275, 275, 275,
+	275, 275, 275, 275, 275, 275, 275, 275, 275, 275,
+	275, 275, 275, 275, 275, 275, 275, 275, 275, 64,
+	64, 64, 64, 67, 68, 270, 270, 270, 270, 270,
+	271, 271, 271, 65, 66, 66, 261, 261, 266, 266,
+	265, 265, 265, 265, 265, 265, 265, 265, 265, 265,
+	265, 265, 269, 269, 269, 268, 268, 267, 267, 58,
+	58, 58, 61, 60, 260, 260, 260, 260, 260, 260,
+	260, 260, 260, 59, 59, 59, 59, 59, 59, 56,
+	56, 56, 56, 56, 55, 55, 57, 57, 259, 259,
+	258, 76, 76, 76, 76, 76, 76, 76, 75, 75,
+	78, 78, 77, 63, 63, 63, 63, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 369, 369, 369, 371,
+	371, 201, 202, 391, 200, 200, 200, 365, 365, 366,
+	367, 368, 368, 368, 72, 74, 74, 73, 73, 7,
+	7, 7, 31, 36, 36, 33, 33, 33, 33, 53,
+	54, 389, 389, 307, 307, 214, 214, 213, 213, 213,
+	213, 213, 213, 213, 213, 213, 213, 213, 213, 213,
+	213, 213, 213, 310, 311, 210, 41, 41, 41, 41,
+	41, 41, 41, 41, 41, 41, 41, 45, 45, 52,
+	392, 392, 392, 50, 51, 306, 306, 306, 49, 48,
+	47, 46, 46, 44, 44, 43, 43, 207, 207, 209,
+	209, 205, 393, 393, 282, 282, 208, 208, 42, 42,
+	42, 206, 206, 184, 204, 204, 204, 6, 10, 10,
+	10, 10, 10, 10, 10, 10, 10, 10, 18, 20,
+	256, 256, 253, 19, 13, 12, 15, 16, 17, 11,
+	14, 5, 5, 5, 5, 8, 8, 9, 94, 94,
+	123, 123, 378, 378, 374, 374, 375, 375, 375, 376,
+	376, 377, 377, 4, 314, 314, 314, 314, 314, 314,
+	354, 354, 354, 355, 113, 113, 108, 108, 315, 315,
+	229, 356, 356, 323, 323, 322, 322, 321, 321, 111,
+	111, 112, 112, 98, 98, 79, 79, 80, 80, 328,
+	328, 328, 328, 334, 334, 304, 304, 149, 149, 180,
+	180, 181, 181, 86, 86, 87, 87, 87, 87, 87,
+	87, 362, 362, 364, 364, 363, 110, 110, 106, 106,
+	107, 107, 107, 104, 104, 103, 102, 102, 101, 100,
+	100, 100, 89, 89, 88, 88, 88, 88, 88, 165,
 	165, 165, 165, 165, 165, 165, 165, 165, 165, 165,
-	165, 165, 165, 165, 165, 165, 165, 165, 165, 165,
-	165, 165, 165, 165, 165, 165, 165, 165,
+	165, 90, 90, 370, 370, 370, 308, 308, 308, 313,
+	313, 162, 162, 163, 163, 161, 161, 91, 91, 92,
+	92, 92, 92, 160, 160, 159, 93, 93, 99, 95,
+	95, 97, 97, 97, 97, 170, 170, 169, 169, 169,
+	169, 116, 116, 168, 167, 167, 167, 115, 115, 114,
+	114, 109, 109, 96, 96, 166, 394, 394, 164, 193,
+	193, 193, 199, 199, 192, 192, 192, 192, 192, 203,
+	198, 198, 194, 194, 195, 195, 195, 3, 3, 3,
+	3, 24, 24, 24, 24, 24, 24, 30, 34, 34,
+	35, 35, 32, 32, 32, 32, 32, 32, 32, 32,
+	32, 32, 32, 37, 37, 29, 39, 39, 40, 40,
+	38, 28, 28, 28, 372, 372, 21, 379, 382, 380,
+	383, 383, 383, 384, 384, 384, 385, 385, 22, 381,
+	386, 386, 386, 257, 257, 254, 255, 255, 252, 251,
+	251, 251, 388, 388, 387, 387, 387, 196, 196, 23,
+	248, 248, 249, 250, 250, 242, 242, 242, 242, 27,
+	246, 246, 247, 247, 247, 247, 247, 243, 243, 245,
+	245, 241, 241, 241, 241, 241, 26, 240, 240, 238,
+	238, 236, 236, 237, 237, 235, 235, 235, 239, 239,
+	25, 25, 82, 81, 81, 81, 84, 84, 83, 83,
+	83, 85, 309, 309, 278, 278, 281, 281, 289, 289,
+	290, 290, 288, 288, 295, 295, 294, 294, 293, 293,
+	292, 292, 291, 291, 291, 291, 285, 285, 284, 284,
+	279, 279, 279, 279, 279, 280, 280, 283, 283, 286,
+	286, 140, 140, 141, 141, 141, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 352, 352, 353, 143, 143,
+	143, 147, 147, 147, 147, 147, 147, 142, 142, 144,
+	144, 124, 124, 122, 122, 117, 117, 118, 118, 119,
+	119, 120, 120, 121, 121, 121, 121, 121, 121, 264,
+	264, 350, 350, 351, 351, 347, 347, 347, 349, 349,
+	349, 349, 349, 348, 348, 125, 178, 178, 178, 197,
+	197, 197, 177, 177, 177, 139, 139, 138, 138, 136,
+	136, 136, 136, 136, 136, 136, 136, 136, 136, 136,
+	136, 136, 136, 136, 287, 287, 287, 263, 263, 211,
+	211, 212, 212, 157, 155, 155, 156, 156, 156, 156,
+	153, 154, 152, 152, 152, 152, 152, 151, 151, 150,
+	150, 150, 244, 244, 148, 148, 146, 146, 146, 145,
+	145, 145, 296, 218, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 220, 220, 220, 220, 221, 221, 226,
+	226, 361, 361, 360, 126, 126, 126, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 135, 135, 135, 175,
+	175, 175, 175, 175, 175, 175, 175, 175, 175, 175,
+	175, 175, 175, 175, 175, 175, 175, 320, 320, 319,
+	319, 319, 172, 172, 172, 172, 172, 172, 172, 172,
+	172, 172, 172, 172, 172, 172, 172, 172, 172, 176,
+	105, 105, 373, 373, 373, 357, 357, 357, 358, 358,
+	358, 358, 358, 358, 358, 358, 358, 358, 358, 358,
+	359, 359, 359, 359, 359, 359, 359, 359, 359, 359,
+	359, 359, 359, 359, 359, 359, 359, 174, 174, 173,
+	173, 173, 173, 173, 173, 173, 173, 173, 173, 173,
+	173, 173, 230, 230, 231, 231, 316, 316, 316, 316,
+	316, 316, 317, 317, 318, 318, 318, 318, 312, 312,
+	312, 312, 312, 312, 312, 312, 312, 312, 312, 312,
+	312, 312, 312, 312, 312, 312, 312, 312, 312, 312,
+	312, 312, 312, 312, 312, 312, 312, 219, 171, 171,
+	171, 232, 227, 227, 228, 228, 222, 222, 222, 222,
+	222, 222, 222, 222, 222, 222, 222, 224, 224, 224,
+	224, 224, 216, 216, 217, 217, 217, 217, 217, 217,
+	217, 217, 217, 223, 223, 225, 225, 234, 234, 234,
+	233, 233, 233, 233, 233, 233, 233, 137, 137, 137,
+	137, 215, 215, 215, 215, 215, 215, 215, 215, 215,
+	215, 128, 128, 128, 128, 132, 132, 134, 134, 134,
+	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
+	134, 133, 133, 133, 133, 131, 131, 131, 131, 131,
+	129, 129, 129, 129, 129, 129, 129, 129, 129, 129,
+	129, 129, 129, 129, 129, 129, 130, 179, 179, 297,
+	297, 300, 300, 298, 298, 299, 301, 301, 301, 302,
+	302, 302, 303, 303, 303, 305, 305, 183, 183, 183,
+	189, 189, 182, 182, 190, 190, 191, 191, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
+	187, 187, 187, 187, 187, 187, 187, 187, 187, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	186, 186, 186, 186, 186, 186, 186, 186, 186, 185,
+	185, 185, 185, 185, 185, 185, 185, 185, 185, 185,
+	185, 185, 185, 185, 185, 185, 185, 185, 185, 185,
+	185, 185, 185, 185, 185, 185, 185, 185, 185, 185,
+	185, 185, 185, 185, 185, 185, 185, 185, 185, 185,
 }
 
 var yyR2 = [...]int{
 	0, 1, 1, 3, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 0, 9, 0, 2, 1, 3, 3,
-	3, 1, 3, 5, 0, 2, 3, 1, 3, 1,
-	1, 1, 3, 1, 1, 1, 1, 0, 3, 3,
-	0, 3, 0, 3, 0, 3, 0, 2, 1, 2,
-	3, 4, 3, 3, 1, 0, 1, 1, 0, 1,
-	8, 5, 7, 0, 3, 8, 5, 1, 3, 3,
-	3, 1, 0, 1, 1, 1, 1, 1, 1, 3,
-	1, 4, 1, 3, 1, 2, 1, 1, 2, 2,
-	1, 1, 1, 1, 1, 1, 1, 1, 2, 1,
-	2, 1, 2, 2, 1, 1, 1, 3, 2, 2,
-	2, 2, 2, 2, 2, 1, 1, 1, 1, 1,
-	1, 3, 6, 3, 1, 1, 1, 1, 1, 1,
-	1, 2, 4, 6, 1, 4, 1, 3, 3, 4,
-	4, 4, 3, 3, 2, 4, 4, 2, 2, 2,
-	1, 1, 1, 1, 1, 1, 3, 1, 1, 1,
-	2, 2, 0, 1, 4, 2, 4, 1, 5, 3,
-	2, 1, 2, 2, 4, 4, 5, 2, 1, 3,
-	4, 4, 1, 2, 9, 7, 1, 3, 3, 1,
-	1, 1, 1, 1, 1, 1, 4, 4, 2, 4,
-	3, 1, 1, 1, 1, 2, 3, 4, 7, 2,
-	3, 3, 4, 5, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 3, 2, 1, 1,
-	1, 1, 6, 1, 1, 7, 7, 0, 3, 0,
-	2, 0, 1, 1, 1, 1, 4, 2, 2, 3,
-	3, 4, 5, 3, 4, 4, 2, 2, 2, 3,
-	0, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 4, 4, 5, 1, 1, 1, 5,
-	5, 0, 1, 1, 2, 2, 3, 6, 7, 4,
-	4, 7, 8, 0, 2, 0, 2, 2, 1, 1,
-	1, 1, 0, 1, 4, 4, 5, 1, 3, 1,
-	1, 3, 5, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 4, 4, 1, 3, 1, 4, 6, 4,
-	4, 4, 3, 1, 1, 2, 2, 11, 8, 9,
-	1, 3, 2, 4, 0, 2, 0, 1, 1, 1,
-	1, 0, 1, 0, 1, 4, 2, 1, 5, 4,
-	4, 2, 0, 1, 3, 3, 1, 3, 1, 3,
-	1, 3, 4, 0, 1, 0, 1, 1, 3, 1,
-	1, 0, 4, 1, 3, 2, 1, 0, 8, 0,
+	1, 1, 1, 1, 1, 0, 9, 0, 2, 1,
+	3, 3, 3, 1, 3, 5, 0, 2, 3, 1,
+	3, 1, 1, 1, 3, 1, 1, 1, 1, 0,
+	3, 3, 0, 3, 0, 3, 0, 3, 0, 2,
+	1, 2, 3, 4, 3, 3, 1, 0, 1, 1,
+	0, 1, 8, 5, 7, 0, 3, 8, 5, 1,
+	3, 3, 3, 1, 0, 1, 1, 1, 1, 1,
+	1, 3, 1, 4, 1, 3, 1, 2, 1, 1,
+	2, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	2, 1, 2, 1, 2, 2, 1, 1, 1, 3,
+	2, 2, 2, 2, 2, 2, 2, 1, 1, 1,
+	1, 1, 1, 3, 6, 3, 1, 1, 1, 1,
+	1, 1, 1, 2, 4, 6, 1, 4, 1, 3,
+	3, 4, 4, 4, 3, 3, 2, 4, 4, 2,
+	2, 2, 1, 1, 1, 1, 1, 1, 3, 1,
+	1, 1, 2, 2, 0, 1, 4, 2, 4, 1,
+	5, 3, 2, 1, 2, 2, 4, 4, 5, 2,
+	1, 3, 4, 4, 1, 2, 9, 7, 1, 3,
+	3, 1, 1, 1, 1, 1, 1, 1, 4, 4,
+	2, 4, 3, 1, 1, 1, 1, 2, 3, 4,
+	7, 2, 3, 3, 4, 5, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 3, 2,
+	1, 1, 1, 1, 6, 3, 6, 4, 5, 1,
+	1, 1, 5, 1, 2, 1, 1, 2, 3, 7,
+	7, 0, 3, 0, 2, 0, 1, 1, 1, 1,
+	4, 2, 2, 3, 3, 4, 5, 3, 4, 4,
+	2, 2, 2, 3, 0, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 4, 4, 5,
+	1, 1, 1, 5, 5, 0, 1, 1, 2, 2,
+	3, 6, 7, 4, 4, 7, 8, 0, 2, 0,
+	2, 2, 1, 1, 1, 1, 0, 1, 4, 4,
+	5, 1, 3, 1, 1, 3, 5, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 4, 4,
+	1, 3, 1, 4, 6, 4, 4, 4, 4, 4,
+	3, 1, 1, 2, 2, 11, 8, 9, 1, 3,
+	2, 4, 0, 2, 0, 1, 1, 1, 1, 0,
+	1, 0, 1, 4, 2, 1, 5, 4, 4, 2,
+	0, 1, 3, 3, 1, 3, 1, 3, 1, 3,
+	4, 0, 1, 0, 1, 1, 3, 1, 1, 0,
+	4, 1, 3, 2, 1, 0, 9, 0, 2, 0,
 	4, 7, 4, 0, 2, 0, 2, 0, 2, 0,
 	4, 1, 3, 1, 1, 4, 3, 4, 5, 4,
 	5, 2, 3, 1, 3, 6, 0, 3, 0, 1,
@@ -4435,43 +4639,48 @@ var yyR2 = [...]int{
 	4, 1, 3, 1, 4, 3, 0, 1, 2, 0,
 	1, 2, 1, 1, 0, 1, 2, 1, 2, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 8, 11, 0, 1, 7,
-	1, 4, 1, 3, 4, 3, 0, 1, 1, 0,
-	2, 7, 1, 0, 2, 2, 1, 3, 2, 1,
-	3, 2, 1, 3, 2, 0, 1, 3, 4, 3,
-	1, 1, 4, 1, 3, 1, 1, 1, 0, 1,
-	1, 1, 11, 0, 2, 3, 2, 3, 1, 1,
-	1, 3, 3, 4, 0, 2, 2, 2, 2, 5,
-	1, 1, 0, 3, 0, 1, 1, 2, 4, 4,
-	4, 0, 1, 10, 9, 2, 2, 6, 10, 5,
-	0, 1, 0, 6, 0, 4, 0, 3, 1, 3,
-	4, 5, 0, 3, 1, 3, 2, 3, 1, 2,
-	0, 4, 6, 5, 0, 2, 0, 2, 4, 5,
-	4, 5, 1, 6, 5, 0, 3, 0, 1, 0,
-	1, 1, 3, 2, 3, 3, 4, 4, 3, 3,
-	3, 3, 4, 4, 3, 3, 3, 3, 3, 3,
+	1, 1, 1, 1, 1, 1, 1, 5, 0, 1,
+	1, 2, 2, 3, 2, 2, 2, 2, 2, 3,
+	2, 1, 2, 1, 2, 11, 0, 1, 1, 3,
+	2, 8, 11, 8, 0, 1, 7, 1, 4, 1,
+	3, 4, 3, 0, 1, 1, 0, 2, 7, 1,
+	0, 2, 2, 1, 3, 2, 1, 3, 2, 1,
+	3, 2, 0, 1, 3, 4, 3, 1, 1, 4,
+	1, 3, 1, 1, 1, 0, 1, 1, 1, 11,
+	0, 2, 3, 2, 3, 1, 1, 1, 3, 3,
+	4, 0, 2, 2, 2, 2, 5, 1, 1, 0,
+	3, 0, 1, 1, 2, 4, 4, 4, 0, 1,
+	10, 9, 2, 1, 3, 6, 3, 5, 2, 6,
+	10, 5, 0, 1, 0, 6, 0, 4, 0, 3,
+	1, 3, 4, 5, 0, 3, 1, 3, 2, 3,
+	1, 2, 0, 4, 6, 5, 0, 2, 0, 2,
+	4, 5, 4, 5, 1, 6, 5, 0, 3, 0,
+	1, 0, 1, 1, 3, 2, 3, 3, 4, 4,
+	3, 3, 3, 3, 4, 4, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	4, 5, 4, 1, 3, 3, 0, 2, 2, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	3, 1, 3, 0, 1, 1, 3, 1, 1, 2,
-	1, 7, 7, 7, 7, 8, 5, 0, 1, 0,
-	1, 1, 1, 1, 3, 3, 1, 1, 1, 1,
-	1, 0, 1, 3, 1, 3, 5, 1, 1, 1,
-	1, 3, 5, 0, 1, 1, 2, 1, 2, 2,
-	1, 1, 2, 2, 2, 2, 2, 1, 5, 6,
-	1, 2, 0, 1, 1, 2, 5, 0, 1, 1,
-	1, 2, 2, 3, 3, 1, 1, 2, 2, 2,
-	0, 1, 2, 2, 2, 0, 3, 0, 3, 1,
-	1, 1, 1, 1, 1, 1, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 1, 1,
-	1, 1, 3, 5, 2, 2, 2, 2, 1, 1,
-	2, 5, 6, 6, 6, 1, 1, 1, 1, 1,
-	0, 2, 0, 1, 1, 2, 4, 1, 2, 2,
-	1, 2, 2, 1, 2, 2, 2, 2, 2, 0,
-	1, 1, 5, 4, 4, 5, 5, 5, 5, 4,
-	5, 5, 5, 5, 5, 5, 5, 1, 1, 1,
-	4, 4, 6, 8, 6, 4, 5, 5, 4, 6,
-	6, 7, 6, 1, 1, 1, 1, 1, 1, 1,
+	3, 3, 4, 5, 4, 1, 3, 3, 0, 2,
+	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 3, 1, 3, 0, 1, 1, 3, 1,
+	1, 2, 1, 7, 7, 7, 7, 8, 5, 0,
+	1, 0, 1, 1, 1, 1, 3, 3, 1, 1,
+	1, 1, 1, 0, 1, 3, 1, 3, 5, 1,
+	1, 1, 1, 3, 5, 0, 1, 1, 2, 1,
+	2, 2, 1, 1, 2, 2, 2, 2, 2, 1,
+	5, 6, 7, 5, 0, 1, 1, 1, 2, 0,
+	1, 1, 2, 5, 0, 1, 1, 1, 2, 2,
+	3, 3, 1, 1, 2, 2, 2, 0, 1, 2,
+	2, 2, 0, 3, 0, 3, 1, 1, 1, 1,
+	1, 1, 1, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 1, 1, 1, 1, 3,
+	5, 2, 2, 2, 2, 1, 1, 2, 5, 6,
+	6, 6, 1, 1, 1, 1, 1, 0, 2, 0,
+	1, 1, 2, 4, 1, 2, 2, 1, 2, 2,
+	1, 2, 2, 2, 2, 2, 0, 1, 1, 5,
+	4, 4, 5, 5, 5, 5, 4, 5, 5, 5,
+	5, 5, 5, 5, 5, 6, 12, 1, 1, 1,
+	1, 1, 4, 6, 4, 6, 8, 6, 8, 8,
+	4, 5, 7, 6, 4, 6, 6, 6, 7, 6,
+	0, 2, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 2, 2, 4,
@@ -4480,20 +4689,23 @@ var yyR2 = [...]int{
 	1, 1, 0, 2, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 3, 0, 1, 1,
-	3, 0, 1, 1, 3, 3, 3, 3, 3, 2,
-	1, 3, 4, 3, 4, 1, 3, 4, 3, 4,
-	1, 1, 1, 3, 4, 4, 5, 3, 4, 5,
-	6, 1, 0, 2, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 2, 2, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 3, 0, 1,
+	1, 3, 0, 1, 1, 3, 3, 3, 3, 3,
+	2, 1, 3, 4, 3, 4, 1, 3, 4, 3,
+	4, 1, 1, 1, 3, 4, 4, 5, 3, 4,
+	5, 6, 1, 0, 2, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 2, 2, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 3, 1, 1, 1, 2, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 2, 2, 2, 2, 1, 2, 2, 2, 2,
+	2, 2, 2, 2, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 4, 4, 1, 1, 3, 0,
+	1, 0, 3, 0, 3, 3, 0, 3, 5, 0,
+	3, 5, 0, 1, 1, 0, 1, 1, 2, 2,
+	0, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	3, 1, 1, 1, 2, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	2, 2, 2, 2, 1, 2, 2, 2, 2, 2,
-	2, 2, 2, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 4, 4, 1, 1, 3, 0, 1, 0,
-	3, 0, 3, 3, 0, 3, 5, 0, 3, 5,
-	0, 1, 1, 0, 1, 1, 2, 2, 0, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -4531,489 +4743,528 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -368, -2, -1, -3, -4, -5, -6, -48, -59,
-	-61, -62, -27, -7, -58, -41, -42, -44, -50, -55,
-	-56, -57, -67, -22, -21, -20, -19, 12, -8, -9,
-	-340, -10, -180, -181, -182, -369, -28, -29, -30, -32,
-	-33, -34, -35, -36, -37, -38, -31, -40, -39, 195,
-	-43, 53, -45, -46, -47, -51, -52, -53, -54, 308,
-	314, 363, -68, -70, -23, -24, -25, -26, 191, 14,
-	436, -11, -14, -12, -15, -13, -17, -18, -16, 213,
-	212, 32, 275, 276, 312, 211, 192, 13, 125, 126,
-	128, 129, 25, -69, 58, 193, -71, 10, 482, -79,
-	33, -102, -177, 62, -165, -168, 439, 440, 441, 442,
-	443, 444, 445, 446, 447, 448, 449, 450, 451, 452,
-	453, 454, 455, 456, 457, 458, 459, 460, 461, 462,
-	463, 464, 465, 466, 467, 468, 469, 470, 471, 472,
-	473, 474, 291, 433, 230, 254, 257, 264, 125, 144,
-	138, 166, 158, 133, 234, 235, 69, 128, 393, 79,
-	378, 362, 131, 249, 251, 253, 256, 236, 154, 149,
-	248, 232, 147, 237, 34, 238, 171, 239, 240, 438,
-	374, 290, 380, 261, 360, 148, 375, 252, 377, 172,
-	176, 385, 314, 142, 143, 382, 387, 170, 210, 37,
-	435, 222, 388, 174, 169, 165, 396, 265, 263, 168,
-	141, 164, 233, 42, 178, 177, 179, 356, 357, 358,
-	359, 241, 242, 302, 384, 161, 150, 294, 134, 22,
-	391, 217, 379, 315, 243, 258, 266, 173, 175, 224,
-	229, 437, 228, 262, 389, 146, 221, 250, 216, 392,
-	135, 308, 317, 244, 129, 255, 386, 395, 41, 330,
-	140, 132, 207, 126, 226, 231, 245, 246, 247, 268,
-	267, 259, 162, 223, 167, 139, 163, 127, 225, 394,
-	331, 481, 292, 345, 160, 157, 227, 200, 390, 381,
-	130, 349, 332, 155, 269, 478, 479, 480, 303, 289,
-	270, 271, 153, 214, 373, 272, 274, -43, -9, -8,
-	-69, -70, -184, 23, 360, -49, 361, 195, 58, -177,
-	-5, -4, -41, -67, -183, -177, 275, -183, 198, -177,
-	228, 344, -286, 203, -253, -228, 229, -252, -229, -255,
-	-230, 26, 192, 191, 225, 14, 193, 312, 199, 12,
-	10, 313, 211, 13, 314, 316, 317, 320, 321, 322,
-	36, 325, 326, 62, 65, 236, -84, -85, -145, 19,
-	6, 7, 8, 9, -288, 291, 345, 228, -222, -220,
-	272, 323, 208, 207, 80, 398, 197, -352, -353, 188,
-	189, 190, -342, 430, -341, -177, 431, -163, -370, -285,
-	381, 380, -188, 379, 372, 383, 374, 191, 79, 159,
-	156, 199, 382, 37, 385, 386, 375, 291, 345, -352,
-	130, 127, -240, 130, 85, 135, 134, -240, 228, 35,
-	-246, 355, -245, -247, 385, 386, 396, 63, 64, 384,
-	-163, -177, 79, -85, -145, -84, -68, -70, -340, 291,
-	345, 228, 199, 198, 200, 398, 275, -287, -348, 26,
-	-292, 185, 186, 187, 27, 28, -1, -91, 220, -102,
-	124, -85, -84, -85, -157, -177, 362, 94, -49, -49,
-	361, -343, -344, -345, -347, 195, 361, 360, 124, 15,
-	-183, 56, -102, -229, 228, -253, -228, 30, 55, 59,
-	201, 59, 55, 58, 310, 324, 345, 311, 323, 200,
-	228, 324, 228, 372, 200, 315, 318, 319, 373, -302,
-	396, -63, 33, -87, 21, -71, -70, 20, 26, 27,
-	26, 27, 26, 27, 26, 27, -350, 198, 430, -218,
-	203, -218, -218, 199, -218, 198, -354, 137, 59, -342,
-	-90, 58, -187, 102, -187, -371, 15, 104, 227, 226,
-	376, 373, -262, 377, 378, -188, -187, -187, -188, 198,
-	200, 398, 25, 25, -286, -286, -355, 202, 389, 436,
-	133, 134, 135, -250, 26, 35, 354, -228, 228, 59,
-	94, 23, -248, 94, 105, -247, -247, -247, -248, -248,
-	-122, 35, -176, 121, -177, 65, -122, 35, 124, -86,
-	-87, -71, -70, 60, 60, -286, -286, -286, -286, -286,
-	-286, -286, -183, -72, -73, 112, -202, -177, 86, 219,
-	-204, 62, -197, 439, 440, 441, 442, 443, 444, 445,
-	446, 447, 448, 449, 450, 451, 452, 453, 455, 456,
+	-1000, -390, -2, -1, -3, -4, -5, -6, -62, -75,
+	-77, -78, -41, -7, -72, -55, -56, -58, -64, -69,
+	-70, -71, -73, -74, -86, -24, -23, -22, -21, 12,
+	-8, -9, -362, -10, -200, -201, -202, -391, -42, -43,
+	-44, -46, -47, -48, -49, -50, -51, -52, -45, -54,
+	-53, -31, 196, -57, 53, -59, -60, -61, -65, -66,
+	-67, -68, 325, 331, 380, 279, 289, -87, -89, -25,
+	-26, -27, -28, -29, -30, 192, 14, 453, -11, -14,
+	-12, -15, -16, -17, -13, -19, -20, -18, 214, 213,
+	32, 276, 277, 329, 212, 193, 13, 125, 126, 128,
+	129, 25, -88, 58, 194, -90, 10, 505, -98, 33,
+	-122, -197, 62, -185, -188, 456, 457, 458, 459, 460,
+	461, 462, 463, 464, 465, 466, 467, 468, 469, 470,
+	471, 472, 473, 474, 475, 476, 477, 478, 479, 480,
+	481, 482, 483, 484, 485, 486, 487, 488, 489, 490,
+	491, 492, 493, 495, 496, 497, 308, 450, 231, 255,
+	258, 265, 289, 125, 144, 138, 166, 158, 133, 235,
+	236, 69, 128, 410, 79, 395, 379, 131, 250, 252,
+	254, 257, 237, 154, 149, 249, 233, 147, 238, 34,
+	239, 171, 240, 241, 455, 391, 307, 397, 262, 377,
+	148, 392, 253, 394, 173, 177, 402, 331, 142, 143,
+	280, 399, 404, 170, 211, 37, 452, 223, 405, 175,
+	169, 165, 413, 266, 264, 278, 282, 283, 284, 285,
+	286, 287, 288, 290, 291, 292, 293, 168, 141, 164,
+	234, 42, 179, 178, 180, 373, 374, 375, 376, 242,
+	243, 319, 401, 161, 150, 311, 134, 22, 408, 218,
+	396, 332, 244, 259, 267, 174, 176, 225, 230, 454,
+	229, 263, 406, 146, 279, 222, 251, 217, 409, 135,
+	325, 334, 245, 129, 256, 403, 412, 41, 347, 140,
+	132, 208, 126, 227, 232, 246, 247, 248, 269, 268,
+	260, 162, 224, 167, 139, 163, 127, 226, 411, 348,
+	504, 309, 362, 172, 160, 157, 228, 201, 407, 398,
+	130, 366, 349, 155, 270, 501, 502, 503, 320, 306,
+	271, 272, 153, 215, 390, 273, 275, -57, -9, -8,
+	-88, -89, -204, 23, 377, -63, 378, 196, 58, -197,
+	-5, -4, -55, -86, -203, -197, 276, -203, 199, -197,
+	229, 361, -307, 204, -273, -248, 230, -272, -249, -275,
+	-250, 26, 193, 192, 226, 14, 194, 329, 200, 12,
+	10, 330, 212, 13, 331, 333, 334, 337, 338, 339,
+	36, 342, 343, 62, 65, 237, 278, 202, -103, -104,
+	-165, 19, 6, 7, 8, 9, 283, 326, -309, 278,
+	308, 362, 229, -242, -240, 273, 340, 209, 208, 80,
+	415, 198, -374, -375, 189, 190, 191, -364, 447, -363,
+	-197, 448, -183, -392, -306, 398, 397, -208, 396, 389,
+	400, 391, 192, 79, 159, 156, 200, 399, 37, 402,
+	403, 392, 283, 308, 362, -374, 130, 127, -260, 130,
+	85, 135, 134, -260, 229, 35, -266, 372, -265, -267,
+	402, 403, 413, 63, 64, 401, -183, -197, 79, -104,
+	-165, -103, -87, -89, -362, 308, 362, 229, 200, 199,
+	201, 326, 283, 415, 276, -308, -370, 26, -313, 186,
+	187, 188, 27, 28, -1, -111, 221, -122, 124, -104,
+	-103, -104, -177, -197, 379, 94, -63, -63, 378, -365,
+	-366, -367, -369, 196, 378, 377, 124, 15, -203, 56,
+	-122, -249, 229, -273, -248, 30, 55, 59, 202, 59,
+	55, 58, 327, 341, 362, 328, 340, 201, 229, 341,
+	229, 389, 201, 332, 335, 336, 390, -324, 413, 201,
+	65, -79, 33, -107, 21, -90, -89, 20, 26, 27,
+	26, 27, 26, 27, 26, 27, -238, 204, -238, -372,
+	199, 447, 201, -238, -238, -238, 200, -238, 199, -376,
+	137, 59, -364, -110, 58, -207, 102, -207, -393, 15,
+	104, 228, 227, 393, 390, -282, 394, 395, -208, -207,
+	-207, -208, 199, 201, 415, 25, 25, -307, -307, -307,
+	-377, 203, 406, 453, 133, 134, 135, -270, 26, 35,
+	371, -248, 229, 59, 94, 23, -268, 94, 105, -267,
+	-267, -267, -268, -268, -142, 35, -196, 121, -197, 65,
+	-142, 35, 124, -106, -107, -90, -89, 60, 60, -307,
+	-307, -307, -307, -307, -307, -307, -307, -307, -203, -91,
+	-92, 112, -222, -197, 86, 220, -224, 62, -217, 456,
 	457, 458, 459, 460, 461, 462, 463, 464, 465, 466,
-	467, 468, 469, 470, 471, 472, 473, 474, 79, 360,
-	302, 345, 155, 303, 153, 214, -198, -200, -321, -315,
-	-195, 58, 110, 111, 118, 87, -199, -275, 30, 89,
-	406, -152, -153, -154, -155, -156, -316, -314, 65, 70,
-	73, 76, 77, 75, 74, 151, 72, 66, 123, -70,
-	-335, -166, -291, -297, 197, -295, 156, 152, 12, 116,
-	355, 121, -298, 475, 64, 63, 296, 297, 298, 398,
-	293, 299, 203, 47, 300, 301, 304, 405, 305, 48,
-	306, 295, 218, 307, 409, 408, 410, 402, 399, 397,
-	400, 401, 403, 404, -72, -293, 38, -67, 58, 25,
-	58, -177, -86, -63, -87, 124, 70, 65, -49, 60,
-	59, -346, 76, 77, -349, 170, 162, -177, -60, 65,
-	-3, -4, -5, -6, -27, -41, -67, -317, -315, 58,
-	26, 354, 55, 15, -254, 198, 398, 309, 224, 200,
-	-252, -236, -233, -231, -176, -229, -232, -231, -257, -157,
-	373, -64, 364, 477, -63, -202, -177, -83, -82, -202,
-	200, -218, -357, 62, 86, -237, -234, -231, -228, -175,
-	62, 63, 64, -177, -218, -355, -341, 29, -89, -177,
-	-141, 16, -200, -141, -102, -187, -187, -189, -371, -185,
-	-371, 373, -141, -141, -262, -186, -177, -186, -218, -357,
-	-237, -76, -74, -77, -78, -80, -144, -146, -102, 58,
-	390, 391, 131, 134, 133, 7, -251, 354, 26, -228,
-	-245, -241, 65, 355, -232, -249, 55, 121, -299, -202,
-	35, -248, -248, -248, -249, -249, 120, -177, -63, -357,
-	-236, -228, -177, -104, -102, -104, -178, -175, -168, -140,
-	59, -139, 15, -172, 85, 82, 83, 84, -177, 29,
-	65, 124, -202, 101, -213, 94, 95, 96, 97, 98,
-	99, 100, 58, 58, 58, 58, 58, 58, 58, 58,
-	-211, 58, 58, 58, 58, 58, 58, 58, -211, 58,
-	58, 58, 58, 58, 65, 65, 107, 106, 117, 110,
-	111, 112, 113, 114, 115, 116, 108, 109, 104, 86,
-	102, 103, 88, -70, -202, -208, -200, -200, -200, -200,
-	-275, -206, -202, 58, -202, 58, 58, 58, -296, 58,
-	58, -210, -211, 58, 65, 58, 58, 58, -200, 58,
-	58, -140, -294, -209, -334, 476, -93, 60, -88, -177,
-	-332, -333, -88, -92, -177, -63, -63, -170, -171, -162,
-	-167, -174, -175, -168, 196, 26, 85, 29, 31, 296,
-	328, 88, 121, 20, 89, 156, 120, 298, 406, 297,
-	191, 51, 408, 410, 409, 399, 397, 347, 351, 353,
-	350, 398, 372, 35, 14, 32, 212, 27, 28, 114,
-	193, 92, 93, 215, 30, 213, 77, 23, 54, 15,
-	17, 18, 299, 346, 203, 202, 104, 364, 199, 49,
-	12, 123, 33, 101, 348, 45, 81, 47, 102, 21,
-	400, 401, 36, 363, 432, 219, 116, 300, 301, 52,
-	86, 354, 75, 55, 82, 19, 50, 103, 194, 405,
-	48, 352, 304, 306, 430, 305, 197, 383, 10, 295,
-	407, 25, 211, 46, 198, 91, 201, 76, 218, 152,
-	6, 80, 13, 53, 56, 402, 403, 404, 38, 90,
-	16, 307, 436, 355, 365, 366, 367, 368, 369, 370,
-	371, 180, 181, 182, 183, 184, 260, 206, 204, 208,
-	209, 476, 477, 185, 186, 286, 288, 159, 188, 189,
-	190, 220, 137, 7, 333, 334, 335, 336, 338, 339,
-	340, 341, 342, 343, 344, 23, -49, -344, 124, 59,
-	-89, -254, -235, -232, -256, 112, 62, -242, 59, 436,
-	483, 63, 201, -366, -365, 346, 60, 59, -303, 202,
-	405, 65, 484, 65, 59, 22, 124, 59, -81, 31,
-	32, -102, 203, -102, -360, 333, 30, -367, 59, 35,
-	-365, -221, 56, -216, -217, -215, -219, 35, -102, 15,
-	-75, -103, -177, 58, 60, 59, -202, -141, -141, -141,
-	-187, -178, -189, -184, -189, -185, 124, -164, -177, -360,
-	-367, 25, -147, -148, -149, -150, 45, 49, 51, 46,
-	47, 48, 52, 25, 59, -372, 29, -173, -179, 29,
-	-174, 65, -175, -168, -68, -70, 132, 135, 135, 134,
-	-228, 201, 58, 94, -249, -249, -249, 35, -176, 55,
-	59, -141, -73, -74, -76, -202, -202, -202, -202, -177,
-	65, -177, 112, -196, 86, 289, 75, 76, 77, -197,
-	-214, 26, 270, 271, -207, -208, -202, -151, 27, 26,
-	-151, -151, -202, -151, 112, -208, -208, 60, 70, -336,
-	-337, 411, 412, 413, 414, 415, 416, 417, 418, 419,
-	420, 421, 300, 295, 301, 299, 293, 307, 302, 303,
-	155, 428, 429, 422, 423, 424, 425, 426, 427, -151,
-	-151, -151, -151, -151, -202, -351, 287, 286, 288, -151,
-	-151, -151, -151, -198, -198, -198, -198, -198, -198, -198,
-	-198, -198, -198, -198, -198, -205, -212, -275, 58, 104,
-	102, 103, 88, -200, -198, -198, 60, 59, -339, -338,
-	90, -202, -336, -207, -202, -207, -207, 60, 60, -208,
-	-207, -198, -207, -151, 65, -141, 59, 58, 60, 59,
-	38, 124, 59, 94, 60, 59, 124, 362, -177, -315,
-	60, -256, 59, 201, 124, 124, -233, 314, -176, -235,
-	20, 436, -157, 33, 65, -306, 377, -202, -202, -177,
-	-82, -90, 86, 58, -362, 379, 335, -169, 94, -194,
-	-193, 292, 36, -289, 355, 341, 342, -234, 228, 55,
-	281, 282, 283, 284, -215, -163, 120, 239, 58, -102,
-	-75, -177, 15, 59, -356, 124, -1, -177, -141, -187,
-	-187, -189, -177, -362, -194, -239, -238, -157, -77, -77,
-	-77, -77, 45, 45, 45, 50, 45, 50, 45, -149,
-	-239, -80, -177, -179, 60, 135, -235, -190, 65, -241,
-	-102, -102, -143, 17, 124, -196, 289, 75, -275, 60,
-	59, -202, -202, -202, 29, -208, 60, 60, 60, 60,
-	15, -202, -202, -202, -202, -202, 60, 15, 15, -202,
-	-202, -202, -202, -202, -208, -205, -200, -198, -198, -203,
-	215, 85, -202, -201, -338, 92, -202, 59, 56, 60,
-	15, 60, 60, 60, 56, 60, 59, 60, -202, 59,
-	-143, -209, -301, -300, -299, 38, -67, -88, -294, -177,
-	-333, -299, -177, -170, -167, -175, -168, 70, 15, -232,
-	-228, 112, 112, 62, 315, -242, 65, 334, 65, 198,
-	94, -312, 370, 365, 367, 124, 29, 30, -97, -98,
-	-99, -105, -100, -157, -192, -101, 206, 204, 208, -329,
-	80, 209, 260, 81, 199, -363, 69, -358, 65, -364,
-	69, 336, 123, 294, 35, 337, 338, 352, 290, 70,
-	70, 343, -359, 62, -102, -169, -169, -169, -97, -91,
-	56, 124, -74, -103, -177, 112, 60, -141, -141, -187,
-	-363, -364, -141, 59, 94, -95, -94, 55, 56, -96,
-	55, -94, 45, 45, -141, -90, 60, -142, 18, 20,
-	-177, 112, -202, 60, 60, 60, -106, -108, 330, 331,
-	-112, -109, -111, -110, -114, -113, 156, 157, 121, 160,
-	162, 163, 164, 165, 166, 167, 168, 169, 170, 171,
-	25, 214, 152, 153, 154, 155, 172, 138, 158, 434,
-	180, 139, 181, 140, 182, 141, 183, 142, 143, 184,
-	144, 147, 148, 149, 146, 60, -202, 60, 60, 60,
-	60, 60, -202, -202, 15, 60, 60, 60, 60, 60,
-	-203, 85, -200, -197, 60, 93, -202, 91, -107, -113,
-	121, 156, 214, 155, 154, 152, 330, 331, -122, -202,
-	-122, -198, 60, 65, -142, 60, 59, -294, -235, -242,
-	355, -102, 65, -283, 478, -310, 365, 20, 20, -67,
-	-102, 60, 59, -108, -101, -177, 81, -328, -329, -218,
-	-328, 81, 58, 65, -361, 346, 65, 65, 70, 35,
-	70, 123, 339, -290, 58, -122, -124, -176, 121, 65,
-	60, -173, -74, -177, -141, -356, 124, -141, -85, -238,
-	-299, -202, 58, -202, -202, -208, 60, -115, 143, 142,
-	-115, -282, 331, 330, -276, -278, 58, -277, 58, -277,
-	-276, -276, 58, 58, -276, -279, 58, -279, -276, -280,
-	58, -280, -281, 58, -280, 60, 60, 60, -202, -197,
-	-202, 60, -276, -277, -276, -279, -276, -115, -115, 60,
-	60, 23, 60, 60, 60, -299, -66, -304, -262, 485,
-	59, -129, 479, 65, 20, 65, -308, 65, -90, -120,
-	-121, -138, 328, 230, -219, 234, 69, 235, 362, 236,
-	199, 238, 239, 240, 210, 241, 242, 243, 355, 244,
-	245, 246, 247, 311, 6, 277, -99, -119, -118, -116,
-	75, 86, 35, 328, -117, 69, 120, 253, 231, 254,
-	-137, -191, 204, 80, 81, 316, -192, -325, -326, -177,
-	-326, -177, -325, -325, -218, -202, 20, 436, 293, 35,
-	70, 35, 340, -223, -225, -157, 58, -65, -64, -141,
-	-141, -86, -89, -284, 332, 70, 70, -159, 65, -159,
-	70, 70, 70, 60, -202, -311, 370, -307, -305, 365,
-	366, 367, 368, 65, -160, 480, 70, 65, 367, 29,
-	-258, 220, 59, -138, -169, -169, -163, 120, -169, -169,
-	-169, -169, 237, 237, -169, -169, -169, -169, -169, -169,
-	-169, -169, -169, -169, -169, -169, -169, -169, 58, -116,
-	75, -198, 65, -124, -125, 35, 252, 248, -126, 35,
-	232, 233, -128, 58, 260, 81, 81, -102, 58, 56,
-	269, 58, 58, 58, -326, 60, 65, 334, 65, 293,
-	60, 59, -276, -202, -66, -85, 60, 60, 60, 60,
-	59, 60, 60, 60, 59, 60, 59, 60, -313, 202,
-	-309, 369, -305, 20, 367, 20, 20, 94, 58, 20,
-	-67, 20, -138, 70, 70, -169, -169, 70, 65, 65,
-	65, -169, -169, 70, 65, -179, 70, 70, 70, 70,
-	35, 65, -127, 35, 248, 252, 249, 250, 251, 70,
-	35, 70, 35, 70, 35, -177, 58, -330, -331, 65,
-	70, 58, -224, 58, -223, -327, 281, 282, 283, 285,
-	284, -327, -223, -223, -223, 58, -244, -243, 261, 86,
-	355, -226, -225, -81, 60, -86, 65, 70, 70, -320,
-	58, 70, -310, 20, -308, 20, -308, -308, 65, -161,
-	-177, -308, -259, 262, 263, -260, -266, 265, -122, -122,
-	65, 65, -123, 231, -104, 60, 59, 94, 60, -202,
-	-131, -130, 432, -223, 60, 60, 60, 60, -223, 261,
-	-227, 210, 69, 436, 279, 280, -81, 60, 60, -324,
-	25, 60, -319, -318, -158, -314, -177, 370, 371, 65,
-	-308, 485, 60, 59, -264, 266, 58, -262, 58, -262,
-	81, 282, 232, 233, 60, -331, 65, 60, -135, -136,
-	-133, -134, 55, 375, 258, 259, 60, -226, -226, -226,
-	-226, 60, -169, 65, 278, -323, -322, -321, 60, 59,
-	124, -177, -261, 267, 70, -198, 58, -198, 58, -263,
-	264, 58, -243, -134, 55, -133, 55, 14, 13, -137,
-	70, -175, 59, 94, -318, -177, -268, 58, 20, 60,
-	-257, 60, -257, 58, 94, -198, -132, 255, 256, 25,
-	134, -132, -322, 35, -202, 124, -269, -267, 220, -260,
-	60, 60, -257, 70, 60, 75, 35, 257, -177, 60,
-	59, 62, -265, 268, 60, -267, -270, 38, 70, -274,
-	-271, 58, -138, 222, 104, -274, -138, -273, -272, 267,
-	223, 58, 60, 59, 62, 219, 58, -208, -272, -271,
-	-208, 60, 60,
+	467, 468, 469, 470, 471, 472, 473, 474, 475, 476,
+	477, 478, 479, 480, 481, 482, 483, 484, 485, 486,
+	487, 488, 489, 490, 491, 492, 493, 495, 496, 497,
+	79, 377, 42, 319, 362, 172, 155, 320, 153, 215,
+	-218, -220, -343, -337, -215, 58, 110, 111, 118, 87,
+	-219, -296, 30, 89, 423, -172, -173, -174, -175, -176,
+	-338, -336, 65, 70, 73, 76, 77, 75, 74, 151,
+	72, 66, 123, -89, -357, -186, -312, -318, 198, -316,
+	156, 152, 12, 116, 372, 121, -319, -320, 498, 64,
+	63, 313, 314, 315, 415, 310, 316, 204, 47, 317,
+	318, 321, 422, 322, 48, 323, 312, 219, 324, 426,
+	425, 427, 419, 416, 414, 417, 418, 420, 421, -91,
+	-314, 38, -86, 58, 25, 58, -197, -106, -79, -107,
+	124, 70, 65, -63, 60, 59, -368, 76, 77, -371,
+	170, 162, -197, -76, 65, -3, -4, -5, -6, -41,
+	-55, -86, -339, -337, 58, 26, 371, 55, 15, -274,
+	199, 415, 326, 225, 201, -272, -256, -253, -251, -196,
+	-249, -252, -251, -277, -177, 390, -81, -83, 381, -122,
+	280, 500, -79, -222, -197, -102, -101, -222, -122, 86,
+	-122, 201, -238, -238, -379, 62, -257, -254, -251, -248,
+	-195, 62, 63, 64, -197, -238, -377, -363, 29, -109,
+	-197, -161, 16, -220, -161, -122, -207, -207, -209, -393,
+	-205, -393, 390, -161, -161, -282, -206, -197, -206, -238,
+	-122, -379, -257, -95, -93, -96, -97, -99, -164, -166,
+	-122, 58, 407, 408, 131, 134, 133, 7, -271, 371,
+	26, -248, -265, -261, 65, 372, -252, -269, 55, 121,
+	-321, -222, 35, -268, -268, -268, -269, -269, 120, -197,
+	-79, -379, -256, -248, -197, -124, -122, -124, -122, -122,
+	-198, -195, -188, -160, 59, -159, 15, -192, 85, 82,
+	83, 84, -197, 29, 65, 124, -222, 101, -233, 94,
+	95, 96, 97, 98, 99, 100, 58, 58, 58, 58,
+	58, 58, 58, 58, -231, 58, 58, 58, 58, 58,
+	58, 58, 58, -231, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 65, 65, 107, 106, 117, 110, 111,
+	112, 113, 114, 115, 116, 108, 109, 104, 86, 102,
+	103, 88, -89, -222, -228, -220, -220, -220, -220, -296,
+	-226, -222, 58, -222, 58, 58, 58, -317, 58, 58,
+	-230, -231, 58, 65, 58, 58, 58, -220, 58, 58,
+	58, -160, -315, -229, -356, 499, -113, 60, -108, -197,
+	-354, -355, -108, -112, -197, -79, -79, -190, -191, -182,
+	-187, -194, -195, -188, 197, 26, 85, 29, 31, 313,
+	345, 88, 121, 20, 89, 156, 120, 315, 423, 314,
+	192, 51, 425, 427, 426, 416, 414, 364, 368, 370,
+	367, 415, 389, 35, 14, 32, 213, 27, 28, 114,
+	194, 92, 93, 216, 30, 214, 77, 23, 54, 15,
+	17, 18, 316, 363, 204, 203, 104, 381, 200, 49,
+	12, 123, 33, 101, 365, 45, 81, 47, 102, 21,
+	417, 418, 36, 380, 449, 220, 116, 317, 318, 52,
+	86, 371, 75, 55, 82, 19, 50, 103, 195, 422,
+	48, 369, 321, 323, 447, 322, 198, 400, 10, 312,
+	424, 25, 212, 46, 199, 91, 202, 76, 219, 152,
+	6, 80, 13, 53, 56, 419, 420, 421, 38, 90,
+	16, 324, 453, 372, 382, 383, 384, 385, 386, 387,
+	388, 181, 182, 183, 184, 185, 261, 207, 205, 209,
+	210, 499, 500, 186, 187, 303, 305, 159, 189, 190,
+	191, 221, 137, 7, 350, 351, 352, 353, 355, 356,
+	357, 358, 359, 360, 361, 23, -63, -366, 124, 59,
+	-109, -274, -255, -252, -276, 112, 62, -262, 59, 453,
+	506, 63, 202, -388, -387, 363, 60, 59, -325, 203,
+	422, 377, 65, 507, 280, 15, 65, 59, 22, 124,
+	59, -100, 31, 32, -34, -35, -32, 284, 285, 134,
+	220, 126, 286, 287, 30, 58, -122, 204, -122, -122,
+	-382, 350, -389, 59, 35, -387, -241, 56, -236, -237,
+	-235, -239, 35, -122, 15, -94, -123, -197, 58, 60,
+	59, -222, -161, -161, -161, -207, -198, -209, -204, -209,
+	-205, 124, -184, -197, -36, -33, -32, 288, -382, -389,
+	25, -167, -168, -169, -170, 45, 49, 51, 46, 47,
+	48, 52, 25, 59, -394, 29, -193, -199, 29, -194,
+	65, -195, -188, -87, -89, 132, 135, 135, 134, -248,
+	202, 58, 94, -269, -269, -269, 35, -196, 55, 59,
+	-161, -92, -93, -95, -222, -222, -222, -222, -197, 65,
+	-197, 112, -216, 86, 306, 75, 76, 77, -217, -234,
+	26, 271, 272, -227, -228, -222, -171, 27, 26, -171,
+	-171, -222, -171, 112, -228, -228, 60, 70, -358, -359,
+	428, 429, 430, 431, 432, 433, 434, 435, 436, 437,
+	438, 317, 312, 318, 316, 310, 324, 319, 320, 155,
+	445, 446, 439, 440, 441, 442, 443, 444, -171, -171,
+	-171, -218, -171, -171, -222, -373, 304, 303, 305, -171,
+	-171, -171, -171, -171, -359, -359, -171, -218, -218, -218,
+	-218, -218, -218, -218, -218, -218, -218, -218, -218, -225,
+	-232, -296, 58, 104, 102, 103, 88, -220, -218, -218,
+	60, 59, -361, -360, 90, -222, -358, -227, -222, -227,
+	-227, 60, 60, -228, -227, -218, -227, -171, -222, 65,
+	-161, 59, 58, 60, 59, 38, 124, 59, 94, 60,
+	59, 124, 379, -197, -337, 60, -276, 59, 202, 124,
+	124, -253, 331, -196, -255, 20, 453, -177, 33, 65,
+	65, 65, -80, 377, -222, -222, -197, -101, -32, -37,
+	20, 70, 111, -37, 285, 220, 287, -37, -37, 453,
+	-37, -39, -40, -38, -197, -110, 86, 58, -110, -384,
+	396, 352, -189, 94, -214, -213, 309, 36, -310, 372,
+	358, 359, -254, 229, 55, 298, 299, 300, 301, -235,
+	-183, 120, 240, 58, -122, -94, -197, 15, 59, -378,
+	124, -1, -197, -161, -207, -207, -209, -197, -33, -37,
+	453, -384, -214, -259, -258, -177, -96, -96, -96, -96,
+	45, 45, 45, 50, 45, 50, 45, -169, -259, -99,
+	-197, -199, 60, 135, -255, -210, 65, -261, -122, -122,
+	-163, 17, 124, 58, -216, 306, 75, -296, 60, 59,
+	-222, -222, -222, 29, -228, 60, 60, 60, 60, 15,
+	-228, -222, -222, 104, -222, -222, 60, 15, 15, -222,
+	-222, -222, -222, -222, -222, 59, 59, -222, -228, -225,
+	-220, -218, -218, -223, 216, 85, -222, -221, -360, 92,
+	-222, 59, 56, 60, 15, 60, 60, 60, 56, 60,
+	59, 60, -222, 59, 60, 59, -163, -229, -323, -322,
+	-321, 38, -86, -108, -315, -197, -355, -321, -197, -190,
+	-187, -195, -188, 70, 15, -252, -248, 112, 112, 62,
+	332, -262, 65, 351, 65, 199, 507, 94, -328, 394,
+	65, 124, -37, 70, -37, 60, 59, -128, -132, -129,
+	-131, -130, -134, -133, 156, 157, 121, 160, 162, 163,
+	164, 165, 166, 167, 168, 169, 170, 172, 171, 25,
+	215, 152, 153, 154, 155, 173, 138, 158, 451, 181,
+	139, 182, 140, 183, 141, 184, 142, 143, 185, 144,
+	147, 148, 149, 146, 29, 30, -117, -118, -119, -125,
+	-120, -177, -212, -121, 207, 205, 209, -351, 80, 210,
+	261, 81, 200, 29, -385, 69, -380, 65, -386, 69,
+	353, 123, 311, 35, 354, 355, 369, 307, 70, 70,
+	360, -381, 62, -122, -189, -189, -189, -117, -111, 56,
+	124, -93, -123, -197, 112, 60, -161, -161, -207, -37,
+	-385, -386, -161, 59, 94, -115, -114, 55, 56, -116,
+	55, -114, 45, 45, -161, -110, 60, -162, 18, 20,
+	-197, 112, -227, -222, 60, 60, 60, -126, -128, 347,
+	348, 60, -222, -104, 60, 60, -222, 60, 60, -222,
+	-222, 15, 60, 60, 60, 60, -104, -222, -222, 60,
+	60, -223, 85, -220, -217, 60, 93, -222, 91, -127,
+	-133, 121, 156, 215, 155, 154, 152, 347, 348, -142,
+	-222, -142, -218, 60, -222, 494, 65, -162, 60, 59,
+	-315, -255, -262, 372, -122, -84, 65, 65, -334, 387,
+	382, 384, 282, -38, -303, 348, 347, -297, -299, 58,
+	-298, 58, -298, -297, -297, 58, 58, -300, 58, -300,
+	-300, -297, -301, 58, -301, -302, 58, -301, -86, -122,
+	60, 59, -128, -121, -197, 81, -350, -351, -238, -350,
+	81, 58, -86, 65, -383, 363, 65, 65, 70, 35,
+	70, 123, 356, -311, 58, -142, -144, -196, 121, 65,
+	60, -193, -93, -197, -161, -378, 124, -161, -104, -258,
+	-321, -222, 58, -222, -222, -228, 60, 60, -135, 143,
+	142, -135, 60, -105, 424, 60, 60, 60, -222, 60,
+	59, 59, -217, -222, 60, -297, -298, -297, -300, -300,
+	-135, -135, 60, 60, 23, 60, 60, 60, 17, 60,
+	-321, -85, -326, -282, 508, 59, 94, 508, 59, -304,
+	501, -332, 382, 20, 20, -128, -305, 349, 70, 70,
+	-179, 65, -179, 70, 70, 70, -110, -140, -141, -158,
+	345, 231, -239, 235, 69, 236, 379, 237, 200, 239,
+	240, 241, 211, 242, 243, 244, 372, 245, 246, 247,
+	248, 328, 6, 294, -119, -139, -138, -136, 75, 86,
+	35, 345, -137, 69, 120, 254, 232, 255, -157, -211,
+	290, 29, 205, 80, 81, 333, -212, -347, -348, -197,
+	-348, -197, -347, -347, -238, -222, 20, 453, 310, 35,
+	70, 35, 357, -243, -245, -177, 58, -82, -81, -161,
+	-161, -106, -109, 60, 65, 60, -222, -222, -222, 58,
+	-333, 387, -329, -327, 382, 383, 384, 385, 65, 65,
+	65, -149, 502, 65, 20, 65, -330, 65, 281, 60,
+	60, 60, 59, 60, 60, 60, 59, 60, 59, 29,
+	-278, 221, 59, -158, -189, -189, -183, 120, -189, -189,
+	-189, -189, 238, 238, -189, -189, -189, -189, -189, -189,
+	-189, -189, -189, -189, -189, -189, -189, -189, 58, -136,
+	75, -218, 65, -144, -145, 35, 253, 249, -146, 35,
+	233, 234, -148, 58, 261, 291, 58, 81, 81, -122,
+	58, 56, 270, 58, 58, 58, -348, 60, 65, 351,
+	65, 310, 60, 59, -297, -222, -85, -104, 60, 60,
+	60, 60, 19, -335, 203, -331, 386, -327, 20, 384,
+	20, 20, 94, 94, -180, 503, 70, 65, 384, -222,
+	65, 70, 70, -86, 20, -158, 70, 70, -189, -189,
+	70, 65, 65, 65, -189, -189, 70, 65, -199, 70,
+	70, 70, 70, 35, 65, -147, 35, 249, 253, 250,
+	251, 252, 70, 35, 70, 35, 70, 35, -197, 58,
+	-352, -353, 65, 70, 58, 29, -222, -244, 58, -243,
+	-349, 298, 299, 300, 302, 301, -349, -243, -243, -243,
+	58, -264, -263, 262, 86, 372, -246, -245, -100, 60,
+	-106, 20, -342, 58, 70, -332, 20, -330, 20, -330,
+	-330, 65, 65, 58, 20, 60, 60, -279, 263, 264,
+	-280, -286, 266, -142, -142, 65, 65, -143, 232, -124,
+	60, 59, 94, 60, -222, 58, 60, -151, -150, 449,
+	-243, 60, 60, 60, 60, -243, 262, -247, 211, 69,
+	453, 296, 297, -100, -222, -346, 25, 60, -341, -340,
+	-178, -336, -197, 387, 388, 65, -330, 508, -181, -197,
+	-330, -284, 267, 58, -282, 58, -282, 81, 299, 233,
+	234, 60, -353, 65, 60, -222, -287, 292, 293, -155,
+	-156, -153, -154, 55, 392, 259, 260, 60, -246, -246,
+	-246, -246, 60, -189, 65, 295, -100, -345, -344, -343,
+	-197, 60, 59, 124, 60, 59, -281, 268, 70, -218,
+	58, -218, 58, -283, 265, 58, -263, 60, -154, 55,
+	-153, 55, 14, 13, -157, 70, -195, 60, 59, 94,
+	124, -340, -197, -197, -289, 58, 20, 60, -277, 60,
+	-277, 58, 94, -218, -287, -152, 256, 257, 25, 134,
+	-152, -344, 35, -222, -197, 124, -290, -288, 221, -280,
+	60, 60, -277, 70, 60, 75, 35, 258, -197, 60,
+	59, 62, -285, 269, 60, -288, -291, 38, 70, -295,
+	-292, 58, -158, 223, 104, -295, -158, -294, -293, 268,
+	224, 58, 60, 59, 62, 220, 58, -228, -293, -292,
+	-228, 60, 60,
 }
 
 var yyDef = [...]int{
-	23, -2, 1, 2, 4, 5, 6, 7, 8, 9,
+	25, -2, 1, 2, 4, 5, 6, 7, 8, 9,
 	10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
-	20, 21, 22, 537, 538, 539, 540, 0, 343, 344,
-	0, 323, 0, 0, 0, 0, 272, 273, 274, 275,
-	276, 277, 278, 279, 280, 281, 282, 243, 244, 0,
-	192, 188, 167, 168, 169, 127, 128, 129, 130, 249,
-	0, 0, 413, -2, 541, 542, 543, 544, -2, 356,
-	0, 324, 325, 326, 327, 328, 329, 330, 331, 232,
-	233, 234, 229, 230, 231, -2, 0, 356, 181, 0,
-	172, 172, 0, 433, 0, 0, 444, 466, 23, 391,
-	0, 396, 721, 757, 758, 759, 1472, 1473, 1474, 1475,
-	1476, 1477, 1478, 1479, 1480, 1481, 1482, 1483, 1484, 1485,
-	1486, 1487, 1488, 1489, 1490, 1491, 1492, 1493, 1494, 1495,
-	1496, 1497, 1498, 1499, 1500, 1501, 1502, 1503, 1504, 1505,
-	1506, 1507, 1297, 1298, 1299, 1300, 1301, 1302, 1303, 1304,
-	1305, 1306, 1307, 1308, 1309, 1310, 1311, 1312, 1313, 1314,
-	1315, 1316, 1317, 1318, 1319, 1320, 1321, 1322, 1323, 1324,
-	1325, 1326, 1327, 1328, 1329, 1330, 1331, 1332, 1333, 1334,
-	1335, 1336, 1337, 1338, 1339, 1340, 1341, 1342, 1343, 1344,
-	1345, 1346, 1347, 1348, 1349, 1350, 1351, 1352, 1353, 1354,
-	1355, 1356, 1357, 1358, 1359, 1360, 1361, 1362, 1363, 1364,
-	1365, 1366, 1367, 1368, 1369, 1370, 1371, 1372, 1373, 1374,
-	1375, 1376, 1377, 1378, 1379, 1380, 1381, 1382, 1383, 1384,
-	1385, 1386, 1387, 1388, 1389, 1390, 1391, 1392, 1393, 1394,
-	1395, 1396, 1397, 1398, 1399, 1400, 1401, 1402, 1403, 1404,
-	1405, 1406, 1407, 1408, 1409, 1410, 1411, 1412, 1413, 1414,
-	1415, 1416, 1417, 1418, 1419, 1420, 1421, 1422, 1423, 1424,
-	1425, 1426, 1427, 1428, 1429, 1430, 1431, 1432, 1433, 1434,
-	1435, 1436, 1437, 1438, 1439, 1440, 1441, 1442, 1443, 1444,
-	1445, 1446, 1447, 1448, 1449, 1450, 1451, 1452, 1453, 1454,
-	1455, 1456, 1457, 1458, 1459, 1460, 1461, 193, 345, 346,
-	433, 433, 215, 0, 1339, 219, 0, 0, 0, 320,
-	211, 212, 213, 214, 0, 529, 0, 208, 0, 187,
-	1397, 0, 0, 0, 0, 0, 0, 88, 583, 90,
-	585, 94, 96, 97, 100, 101, 102, 103, 104, 105,
-	106, 107, 0, 109, 0, 111, 0, 114, 115, 116,
-	0, 125, 126, 586, 587, 68, -2, 0, 0, 0,
-	449, 452, 455, 458, 547, 612, 612, 612, 0, 612,
-	0, 631, 589, 590, 591, 610, 611, 361, 357, 358,
-	359, 360, 421, 0, 423, 426, 303, 303, 0, 0,
-	294, 295, 0, 312, 303, 303, 312, 0, 1125, 0,
-	0, 286, 287, 288, 292, 293, 313, 249, 249, 363,
-	182, 183, 171, 173, 0, 177, 0, 170, 1397, 0,
-	141, 0, 146, 0, 1346, 1411, 1361, 0, 0, 1379,
-	0, 165, -2, 428, 0, 434, 0, 433, 0, 249,
-	249, 249, 249, 249, 249, 249, 0, 0, 0, 467,
-	468, 463, 464, 465, 469, 470, 3, 0, 0, 395,
-	0, 428, -2, 0, 216, 760, 0, 0, 220, 221,
-	0, 0, 235, 0, 238, 224, 225, 226, 0, 0,
-	210, 0, 0, 189, 0, 0, 0, 250, 82, 0,
-	0, 0, 0, 0, 95, 124, 98, 99, 0, 119,
-	121, 123, 122, 108, 120, 110, 112, 113, 118, 0,
-	69, 416, 0, 397, 0, 446, 448, 0, 450, 451,
-	453, 454, 456, 457, 459, 460, 0, 612, 548, 0,
-	0, 0, 0, 0, 0, 612, 363, 362, 0, 422,
-	0, 0, 475, 0, 475, 0, 308, 309, 303, 303,
-	296, 305, 0, 310, 311, 0, 475, 475, 0, 0,
-	0, 612, 1126, 1127, 0, 0, 0, 364, 0, 0,
-	175, 0, 180, 131, 136, 134, 135, 137, 0, 0,
-	0, 0, 0, 163, 164, 0, 0, 0, 0, 0,
-	154, 157, 715, 716, 580, 581, 158, 159, 0, 397,
-	429, 445, 447, 442, 443, 0, 0, 0, 0, 0,
-	0, 0, 342, 483, 477, 479, 524, 31, 0, 1010,
-	1015, 757, 1020, -2, 1473, 1474, 1475, 1476, 1477, 1478,
-	1479, -2, 1481, -2, -2, 1484, 1485, 1486, 1488, -2,
-	-2, -2, -2, -2, -2, 1495, -2, -2, -2, 1499,
-	1500, -2, -2, 1503, 1504, 1505, 1506, 1507, -2, -2,
-	-2, -2, -2, -2, -2, -2, 1031, 828, 829, 830,
-	831, 0, 0, 0, 0, 0, 838, 839, 0, 852,
-	0, 845, 846, 847, 848, 849, 43, 44, 1050, 1051,
-	1052, 1053, 1054, 1055, 1056, 1057, 1058, 1059, 977, 815,
-	0, 0, 0, 962, 0, 952, 0, 990, 0, 0,
-	0, 0, 0, 0, 45, 46, 968, 970, 971, 973,
-	974, 975, 976, 979, 980, 981, 984, 985, 986, 987,
-	988, 989, 992, 994, 964, 965, 966, 967, 956, 957,
-	958, 959, 960, 961, 483, 365, 383, 367, 0, 372,
-	0, 722, 397, 419, 397, 0, 217, 0, 222, 0,
-	0, 237, 239, 240, 241, 227, 228, 321, 206, 207,
-	199, 200, 201, 202, 203, 204, 205, 209, 41, 0,
-	190, 191, 82, 0, 0, 83, 84, 85, 86, 87,
-	89, 73, 334, 336, 572, 584, 0, 575, 0, 92,
-	117, 65, 0, 0, 417, 430, 31, 435, 436, 439,
-	0, 0, 0, 550, 0, 247, 566, 0, 582, 604,
-	534, 535, 536, -2, 0, 0, 424, 0, 0, 511,
-	283, 0, 304, 284, 475, 475, 475, 303, 0, 305,
-	0, 305, 299, 300, 0, 314, 317, 315, 0, 0,
-	247, 488, 0, 489, 490, 486, 513, 516, 519, 0,
-	184, 185, 0, 0, 179, 0, 0, 138, 139, 140,
-	147, 142, 144, 0, 0, 148, 160, 161, 162, 389,
-	390, 0, 0, 0, 152, 153, 0, 166, 415, 332,
-	333, 337, 0, 339, 719, 340, 341, 530, 531, 475,
-	0, 484, 0, 480, 0, 0, 0, 0, 525, 0,
-	527, 0, 1009, 0, 0, 1039, 1040, 1041, 1042, 1043,
-	1044, 1045, 1001, 997, 997, 997, 0, 997, 0, 0,
-	937, 0, 0, 997, 997, 997, 997, 997, 938, 0,
-	997, 997, 997, 997, 951, 945, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, -2, 1003, 0, 834, 835, 836, 837,
-	840, 0, 853, 0, 0, 1001, 1001, 1001, 940, 0,
-	0, 942, 953, 0, 946, 1001, 0, 1001, 950, 997,
-	0, 475, 366, 380, 0, 384, 0, 0, 376, 378,
-	371, 373, 0, 0, 393, 418, 420, 761, 0, 1132,
-	-2, 1134, -2, -2, 1136, 1137, 1138, 1139, 1140, 1141,
-	1142, 1143, 1144, 1145, 1146, 1147, 1148, 1149, 1150, 1151,
-	1152, 1153, 1154, 1155, 1156, 1157, 1158, 1159, 1160, 1161,
-	1162, 1163, 1164, 1165, 1166, 1167, 1168, 1169, 1170, 1171,
-	1172, 1173, 1174, 1175, 1176, 1177, 1178, 1179, 1180, 1181,
-	1182, 1183, 1184, 1185, 1186, 1187, 1188, 1189, 1190, 1191,
-	1192, 1193, 1194, 1195, 1196, 1197, 1198, 1199, 1200, 1201,
-	1202, 1203, 1204, 1205, 1206, 1207, 1208, 1209, 1210, 1211,
-	1212, 1213, 1214, 1215, 1216, 1217, 1218, 1219, 1220, 1221,
-	1222, 1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230, 1231,
-	1232, 1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241,
-	1242, 1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251,
-	1252, 1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260, 1261,
-	1262, 1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270, 1271,
-	1272, 1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281,
-	1282, 1283, 1284, 1285, 1286, 1287, 1288, 1289, 1290, 1291,
-	1292, 1293, 1294, 1295, 1296, 0, 223, 236, 0, 0,
-	0, 0, 76, 569, 0, 77, 81, 71, 0, 0,
-	0, 574, 0, 571, 576, 0, 91, 0, 0, 66,
-	67, 626, 0, 399, 0, 0, 0, 0, 438, 440,
-	441, 426, 0, 0, 556, 1128, 613, 251, 0, 0,
-	568, 0, 0, 609, -2, 616, 0, 622, 0, 0,
-	0, 350, 354, 23, 427, 0, 476, 285, 289, 290,
-	475, 306, 303, 307, 303, 305, 0, 316, 319, 556,
-	251, 0, 0, 0, 0, 0, 504, 0, 0, 503,
-	0, 0, 0, 0, 0, 0, 517, 518, 520, 0,
-	522, 523, 532, 533, 0, 433, 186, 174, 176, 0,
-	133, 0, 0, 0, 149, 150, 151, 155, 156, 0,
-	0, 473, 478, 485, 488, 1005, 1006, 1007, 1008, 526,
-	528, 32, 481, 1011, 0, 1013, 1016, 1021, 1022, 1018,
-	0, 1036, -2, -2, 0, 1002, 1003, 0, 998, 999,
-	0, 0, 0, 0, 0, 0, 0, 954, 0, 0,
-	908, 909, 910, 911, 912, 913, 914, 915, 916, 917,
-	918, 919, 920, 921, 922, 923, 924, 925, 926, 927,
-	928, 929, 930, 931, 932, 933, 934, 935, 936, 0,
-	0, 0, 0, 0, 0, 0, 903, 904, 905, 0,
-	0, 0, 0, 816, 817, 818, 819, 820, 821, 822,
-	823, 824, 825, 826, 827, 1023, 1034, 1035, 0, 0,
-	0, 0, 0, 1032, 1027, 0, 832, 0, 850, 854,
-	0, 0, 996, 0, 1003, 0, 0, 963, 941, 0,
-	0, 0, 0, 0, 0, 473, 383, 385, 0, 0,
-	383, 0, 0, 0, 392, 0, 0, 0, 322, 42,
-	242, 0, 0, 0, 0, 0, 335, 0, 573, 73,
-	0, 0, 93, 0, 0, 403, 0, 431, 432, 32,
-	437, 0, 0, 723, 559, 557, 558, 0, 1129, 563,
-	252, 253, 254, 255, 0, 0, 0, 567, 0, 0,
-	605, 606, 607, 608, 617, 1128, 1128, 1128, 723, 391,
-	0, -2, 0, 0, 352, 0, 0, 512, 297, 475,
-	475, 303, 318, 559, 563, 475, 196, 0, 507, 501,
-	0, 494, 505, 506, 497, 0, 499, 0, 495, 496,
-	475, 487, 426, 521, 515, 178, 132, 0, 271, 143,
-	338, 720, 471, 0, 0, 1012, 1014, 1017, 1019, 890,
-	0, 0, 0, 0, 0, 0, 879, 873, 874, 955,
-	0, 0, 0, 0, 0, 0, 898, 0, 0, 0,
-	0, 0, 0, 0, 0, 1024, 1032, 1028, 0, 1025,
-	0, 0, 1004, 0, 855, 0, 0, 0, 0, 891,
-	0, 895, 939, 943, 0, 947, 0, 949, 0, 0,
-	471, 381, 0, 386, 387, 383, 370, 377, 369, 379,
-	374, 375, 394, 762, 1133, 1130, 1131, 218, 0, 570,
-	73, 78, 79, 80, 74, 72, 577, 0, 579, 0,
-	0, 405, 54, 0, 0, 0, 0, 0, 0, 724,
-	725, 727, 728, 0, 0, 730, 784, 0, 739, 612,
-	739, 0, 0, 741, 742, 549, 0, 0, 552, 561,
-	0, 0, 0, 257, 258, 0, 0, 0, 270, 266,
-	267, 268, 248, 562, 0, 0, 0, 0, 0, 519,
-	0, 0, 475, 351, 354, 355, 425, 301, 298, 475,
-	245, 246, 433, 0, 0, 491, 508, 0, 0, 492,
-	0, 493, 498, 500, 195, 514, 145, 461, 0, 0,
-	33, 482, 1004, 875, 876, 877, 0, 857, 869, 869,
-	1120, 1061, 1062, 1063, 1107, 1065, 1111, 1111, 1107, 1107,
-	1093, 1094, 1095, 1096, 1097, 1098, 1099, 1100, 1101, 0,
-	0, 1084, 1107, 1109, 1109, 1107, 1104, 1066, 1067, 1068,
-	1069, 1070, 1071, 1072, 1073, 1074, 1075, 1076, 1077, 1078,
-	1079, 1114, 1114, 1117, 1114, 878, 0, 897, 880, 881,
-	884, 882, 0, 0, 0, 896, 885, 886, 872, 1000,
-	1026, 0, 1033, 1029, 833, 841, 851, 0, 0, 860,
-	1107, 1111, 863, 1107, 1109, 1107, 869, 869, 0, 0,
-	0, 0, 883, 0, 462, 382, 0, 368, 75, 70,
-	578, 56, 0, 407, 0, 404, 0, 0, 0, 545,
-	426, -2, 0, -2, 729, 785, 751, 751, 740, 751,
-	751, 612, 0, 560, 551, 0, 564, 565, 0, 259,
-	260, 0, 263, 269, 0, 618, 619, 717, 718, 620,
-	0, 475, 475, -2, 348, 353, 0, 302, 428, 197,
-	198, 509, 0, 502, 472, 474, 842, 858, 870, 871,
-	859, 1123, 1121, 1122, 1064, 1108, 0, 1089, 0, 1090,
-	1091, 1092, 0, 0, 1085, 1086, 0, 1087, 1088, 1080,
-	0, 1081, 1082, 0, 1083, 894, 899, 900, 0, 1030,
-	856, 843, 861, 862, 864, 865, 866, 867, 868, 844,
-	892, 0, 944, 948, 902, 388, 24, 50, 0, 627,
-	0, 409, 0, 406, 0, 400, 402, 64, 0, 632,
-	-2, 671, 1128, 1128, 0, 1128, 1128, 1128, 1128, 0,
-	0, 1128, 1128, 1128, 1128, 1128, 1128, 1128, 1128, 1128,
-	1128, 1128, 1128, 1128, 1128, 0, 726, 753, -2, 765,
-	767, 0, 0, 770, 771, 0, 0, 0, 0, 807,
-	777, 0, 0, 1048, 1049, 0, 783, 0, 743, 752,
-	0, 752, 0, 0, 751, 0, 0, 0, 256, 261,
-	0, 264, 265, 0, 600, 1107, 0, 624, 56, 433,
-	349, 194, 0, 1060, 1124, 0, 0, 0, 1105, 0,
-	0, 0, 0, 901, 0, 47, 52, 57, 58, 0,
-	0, 0, 0, 0, 398, 0, 408, 55, 0, 0,
-	623, 0, 621, 673, 0, 0, 1128, 1128, 0, 0,
-	0, 0, 1128, 1128, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 766,
-	768, 769, 772, 773, 774, 812, 813, 814, 775, 809,
-	810, 811, 776, 0, 0, 1046, 1047, 805, 0, 0,
-	0, 0, 0, 0, 0, 737, 553, 0, 555, 262,
-	593, 0, 439, 0, 625, 428, 510, 1113, 1112, 1102,
-	0, 1103, 1110, 1115, 0, 1118, 0, 893, 34, 0,
-	54, 0, 59, 0, 0, 0, 0, 0, 0, 0,
-	546, 667, 672, 674, 675, 0, 0, 678, 679, 680,
-	681, 0, 0, 684, 685, 686, 687, 688, 689, 690,
-	691, 692, 693, 709, 710, 711, 712, 713, 714, 694,
-	695, 696, 697, 698, 699, 706, 0, 0, 703, 0,
-	0, 0, 800, 0, 0, 744, 746, 747, 748, 749,
-	750, 745, 0, 0, 0, 0, 736, 738, 780, 0,
-	554, 592, 601, 602, 439, 347, 1106, 0, 0, 25,
-	0, 0, 51, 0, 60, 0, 62, 63, 0, 0,
-	411, 401, 656, 0, 0, 662, 0, 668, 676, 677,
-	682, 683, 700, 0, 0, 702, 0, 0, 808, 0,
-	787, 801, 0, 0, 593, 593, 593, 593, 0, 781,
-	594, 1128, 0, 0, 598, 599, 603, 1116, 1119, 629,
-	0, 35, 0, 37, 39, 40, 754, 48, 49, 53,
-	61, 628, 410, 0, 634, 0, 0, 0, 0, 0,
-	665, 0, 707, 708, 701, 704, 705, 778, 786, 788,
-	789, 790, 0, 802, 803, 804, 806, 731, 732, 733,
-	734, 0, 0, 596, 0, 26, 27, 0, 36, 0,
-	0, 412, 636, 0, 657, 0, 0, 0, 0, 0,
-	0, 0, 779, 791, 0, 792, 0, 0, 0, 735,
-	595, 597, 0, 0, 38, 755, 633, 0, 667, 658,
-	0, 660, 0, 0, 0, 0, 793, 795, 796, 0,
-	0, 794, 28, 29, 30, 0, 0, 638, 0, 654,
-	659, 661, 0, 666, 664, 797, 799, 798, 756, 637,
-	0, 650, 635, 0, 663, 639, -2, 0, 655, 640,
-	-2, 0, 648, 0, 0, 641, 649, 0, 644, 0,
-	0, 0, 643, 0, -2, 651, 0, 0, 645, -2,
-	0, 653, 652,
+	20, 21, 22, 23, 24, 557, 558, 559, 560, 0,
+	361, 362, 0, 337, 0, 0, 0, 0, 286, 287,
+	288, 289, 290, 291, 292, 293, 294, 295, 296, 249,
+	250, 251, 0, 194, 190, 169, 170, 171, 129, 130,
+	131, 132, 263, 0, 0, 0, 0, 433, -2, 561,
+	562, 563, 564, 565, 566, -2, 374, 0, 338, 339,
+	340, 341, 342, 343, 344, 345, 346, 347, 234, 235,
+	236, 231, 232, 233, -2, 0, 374, 183, 0, 174,
+	174, 0, 453, 0, 0, 464, 486, 25, 409, 0,
+	414, 773, 809, 810, 811, 1559, 1560, 1561, 1562, 1563,
+	1564, 1565, 1566, 1567, 1568, 1569, 1570, 1571, 1572, 1573,
+	1574, 1575, 1576, 1577, 1578, 1579, 1580, 1581, 1582, 1583,
+	1584, 1585, 1586, 1587, 1588, 1589, 1590, 1591, 1592, 1593,
+	1594, 1595, 1596, 1597, 1598, 1599, 1369, 1370, 1371, 1372,
+	1373, 1374, 1375, 1376, 1377, 1378, 1379, 1380, 1381, 1382,
+	1383, 1384, 1385, 1386, 1387, 1388, 1389, 1390, 1391, 1392,
+	1393, 1394, 1395, 1396, 1397, 1398, 1399, 1400, 1401, 1402,
+	1403, 1404, 1405, 1406, 1407, 1408, 1409, 1410, 1411, 1412,
+	1413, 1414, 1415, 1416, 1417, 1418, 1419, 1420, 1421, 1422,
+	1423, 1424, 1425, 1426, 1427, 1428, 1429, 1430, 1431, 1432,
+	1433, 1434, 1435, 1436, 1437, 1438, 1439, 1440, 1441, 1442,
+	1443, 1444, 1445, 1446, 1447, 1448, 1449, 1450, 1451, 1452,
+	1453, 1454, 1455, 1456, 1457, 1458, 1459, 1460, 1461, 1462,
+	1463, 1464, 1465, 1466, 1467, 1468, 1469, 1470, 1471, 1472,
+	1473, 1474, 1475, 1476, 1477, 1478, 1479, 1480, 1481, 1482,
+	1483, 1484, 1485, 1486, 1487, 1488, 1489, 1490, 1491, 1492,
+	1493, 1494, 1495, 1496, 1497, 1498, 1499, 1500, 1501, 1502,
+	1503, 1504, 1505, 1506, 1507, 1508, 1509, 1510, 1511, 1512,
+	1513, 1514, 1515, 1516, 1517, 1518, 1519, 1520, 1521, 1522,
+	1523, 1524, 1525, 1526, 1527, 1528, 1529, 1530, 1531, 1532,
+	1533, 1534, 1535, 1536, 1537, 1538, 1539, 1540, 1541, 1542,
+	1543, 1544, 1545, 1546, 1547, 1548, 1549, 195, 363, 364,
+	453, 453, 217, 0, 1412, 221, 0, 0, 0, 334,
+	213, 214, 215, 216, 0, 549, 0, 210, 0, 189,
+	1483, 0, 0, 0, 0, 0, 0, 90, 630, 92,
+	632, 96, 98, 99, 102, 103, 104, 105, 106, 107,
+	108, 109, 0, 111, 0, 113, 0, 116, 117, 118,
+	0, 127, 128, 633, 634, 70, 0, 0, -2, 0,
+	0, 0, 469, 472, 475, 478, 659, 659, 594, 0,
+	659, 659, 659, 0, 659, 0, 683, 636, 637, 638,
+	657, 658, 379, 375, 376, 377, 378, 441, 0, 443,
+	446, 317, 317, 0, 0, 308, 309, 0, 326, 317,
+	317, 326, 0, 1197, 0, 0, 300, 301, 302, 306,
+	307, 327, 263, 263, 263, 381, 184, 185, 173, 175,
+	0, 179, 0, 172, 1483, 0, 143, 0, 148, 0,
+	1419, 1498, 1435, 0, 0, 1465, 0, 167, -2, 448,
+	0, 454, 0, 453, 0, 263, 263, 263, 263, 263,
+	263, 263, 263, 263, 0, 0, 0, 487, 488, 483,
+	484, 485, 489, 490, 3, 0, 0, 413, 0, 448,
+	-2, 0, 218, 812, 0, 0, 222, 223, 0, 0,
+	237, 0, 240, 226, 227, 228, 0, 0, 212, 0,
+	0, 191, 0, 0, 0, 264, 84, 0, 0, 0,
+	0, 0, 97, 126, 100, 101, 0, 121, 123, 125,
+	124, 110, 122, 112, 114, 115, 120, 0, 71, 0,
+	245, 436, 0, 415, 0, 466, 468, 0, 470, 471,
+	473, 474, 476, 477, 479, 480, 0, 0, 0, 0,
+	659, 595, 659, 0, 0, 0, 0, 0, 659, 381,
+	380, 0, 442, 0, 0, 495, 0, 495, 0, 322,
+	323, 317, 317, 310, 319, 0, 324, 325, 0, 495,
+	495, 0, 0, 0, 659, 1198, 1199, 0, 0, 0,
+	0, 382, 0, 0, 177, 0, 182, 133, 138, 136,
+	137, 139, 0, 0, 0, 0, 0, 165, 166, 0,
+	0, 0, 0, 0, 156, 159, 767, 768, 627, 628,
+	160, 161, 0, 415, 449, 465, 467, 462, 463, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 360, 503,
+	497, 499, 544, 33, 0, 1081, 1086, 809, 1091, -2,
+	1560, 1561, 1562, 1563, 1564, 1565, 1566, -2, 1568, -2,
+	-2, 1571, 1572, 1573, -2, 1575, -2, 1577, -2, -2,
+	-2, -2, 1582, -2, -2, -2, 1586, 1587, -2, -2,
+	1590, 1591, 1592, 1593, 1594, -2, -2, 1597, 1598, 1599,
+	-2, -2, 1454, -2, -2, -2, -2, -2, -2, -2,
+	1102, 885, 886, 887, 888, 0, 0, 0, 0, 0,
+	895, 896, 0, 909, 0, 902, 903, 904, 905, 906,
+	45, 46, 1121, 1122, 1123, 1124, 1125, 1126, 1127, 1128,
+	1129, 1130, 1047, 872, 0, 0, 0, 1032, 0, 1022,
+	0, 1060, 0, 0, 0, 0, 0, 0, 0, 47,
+	48, 1038, 1040, 1041, 1043, 1044, 1045, 1046, 1049, 1050,
+	1051, 1054, 1055, 1056, 1057, 1058, 1059, 1062, 1065, 1034,
+	1035, 1036, 1037, 1026, 1027, 1028, 1029, 1030, 1031, 503,
+	383, 401, 385, 0, 390, 0, 774, 415, 439, 415,
+	0, 219, 0, 224, 0, 0, 239, 241, 242, 243,
+	229, 230, 335, 208, 209, 201, 202, 203, 204, 205,
+	206, 207, 211, 43, 0, 192, 193, 84, 0, 0,
+	85, 86, 87, 88, 89, 91, 75, 350, 352, 619,
+	631, 0, 622, 0, 94, 119, 67, 673, 0, 247,
+	0, 0, 437, 450, 33, 455, 456, 459, 568, 0,
+	0, 0, 0, 0, 0, 597, 261, 613, 0, 629,
+	651, 554, 555, 556, -2, 0, 0, 444, 0, 0,
+	531, 297, 0, 318, 298, 495, 495, 495, 317, 0,
+	319, 0, 319, 313, 314, 0, 328, 331, 329, 0,
+	0, 0, 261, 508, 0, 509, 510, 506, 533, 536,
+	539, 0, 186, 187, 0, 0, 181, 0, 0, 140,
+	141, 142, 149, 144, 146, 0, 0, 150, 162, 163,
+	164, 407, 408, 0, 0, 0, 154, 155, 0, 168,
+	435, 348, 349, 353, 0, 355, 771, 356, 357, 358,
+	359, 550, 551, 495, 0, 504, 0, 500, 0, 0,
+	0, 0, 545, 0, 547, 0, 1080, 0, 0, 1110,
+	1111, 1112, 1113, 1114, 1115, 1116, 1072, 1068, 1068, 1068,
+	0, 1068, 0, 0, 1007, 0, 0, 1068, 1068, 1068,
+	0, 1068, 1068, 1008, 0, 1068, 1068, 1068, 1068, 1068,
+	0, 0, 1068, 1021, 1015, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, -2, 1074, 0, 891, 892, 893, 894, 897,
+	0, 910, 0, 0, 1072, 1072, 1072, 1010, 0, 0,
+	1012, 1023, 0, 1016, 1072, 0, 1072, 1020, 1068, 0,
+	0, 495, 384, 398, 0, 402, 0, 0, 394, 396,
+	389, 391, 0, 0, 411, 438, 440, 813, 0, 1204,
+	-2, 1206, -2, -2, 1208, 1209, 1210, 1211, 1212, 1213,
+	1214, 1215, 1216, 1217, 1218, 1219, 1220, 1221, 1222, 1223,
+	1224, 1225, 1226, 1227, 1228, 1229, 1230, 1231, 1232, 1233,
+	1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241, 1242, 1243,
+	1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252, 1253,
+	1254, 1255, 1256, 1257, 1258, 1259, 1260, 1261, 1262, 1263,
+	1264, 1265, 1266, 1267, 1268, 1269, 1270, 1271, 1272, 1273,
+	1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281, 1282, 1283,
+	1284, 1285, 1286, 1287, 1288, 1289, 1290, 1291, 1292, 1293,
+	1294, 1295, 1296, 1297, 1298, 1299, 1300, 1301, 1302, 1303,
+	1304, 1305, 1306, 1307, 1308, 1309, 1310, 1311, 1312, 1313,
+	1314, 1315, 1316, 1317, 1318, 1319, 1320, 1321, 1322, 1323,
+	1324, 1325, 1326, 1327, 1328, 1329, 1330, 1331, 1332, 1333,
+	1334, 1335, 1336, 1337, 1338, 1339, 1340, 1341, 1342, 1343,
+	1344, 1345, 1346, 1347, 1348, 1349, 1350, 1351, 1352, 1353,
+	1354, 1355, 1356, 1357, 1358, 1359, 1360, 1361, 1362, 1363,
+	1364, 1365, 1366, 1367, 1368, 0, 225, 238, 0, 0,
+	0, 0, 78, 616, 0, 79, 83, 73, 0, 0,
+	0, 621, 0, 618, 623, 0, 93, 0, 0, 68,
+	69, 0, 678, 0, 248, 0, 417, 0, 0, 0,
+	0, 458, 460, 461, 567, 569, 570, 0, 0, 0,
+	0, 0, 0, 581, 660, 586, 446, 0, 0, 446,
+	603, 1200, 265, 0, 0, 615, 0, 0, 656, -2,
+	663, 0, 669, 0, 0, 0, 368, 372, 25, 447,
+	0, 496, 299, 303, 304, 495, 320, 317, 321, 317,
+	319, 0, 330, 333, 252, 253, 255, 256, 603, 265,
+	0, 0, 0, 0, 0, 524, 0, 0, 523, 0,
+	0, 0, 0, 0, 0, 537, 538, 540, 0, 542,
+	543, 552, 553, 0, 453, 188, 176, 178, 0, 135,
+	0, 0, 0, 151, 152, 153, 157, 158, 0, 0,
+	493, 498, 505, 508, 1076, 1077, 1078, 1079, 546, 548,
+	34, 501, 1082, 0, 1084, 1087, 1092, 1093, 1089, 0,
+	1107, -2, -2, 0, 1073, 1074, 0, 1069, 1070, 0,
+	0, 0, 0, 0, 0, 0, 1024, 0, 0, 978,
+	979, 980, 981, 982, 983, 984, 985, 986, 987, 988,
+	989, 990, 991, 992, 993, 994, 995, 996, 997, 998,
+	999, 1000, 1001, 1002, 1003, 1004, 1005, 1006, 0, 0,
+	0, 0, 0, 0, 0, 0, 972, 973, 974, 0,
+	0, 0, 0, 0, 0, 0, 0, 873, 874, 875,
+	876, 877, 878, 879, 880, 881, 882, 883, 884, 1094,
+	1105, 1106, 0, 0, 0, 0, 0, 1103, 1098, 0,
+	889, 0, 907, 911, 0, 0, 1067, 0, 1074, 0,
+	0, 1033, 1011, 0, 0, 0, 0, 0, 0, 0,
+	493, 401, 403, 0, 0, 401, 0, 0, 0, 410,
+	0, 0, 0, 336, 44, 244, 0, 0, 0, 0,
+	0, 351, 0, 620, 75, 0, 0, 95, 0, 674,
+	0, 246, 419, 0, 451, 452, 34, 457, 571, 572,
+	0, 583, 0, 574, 575, 577, 582, 576, 578, 0,
+	580, 0, 587, 588, 0, 0, 0, 775, 0, 606,
+	604, 605, 0, 1201, 610, 266, 267, 268, 269, 0,
+	0, 0, 614, 0, 0, 652, 653, 654, 655, 664,
+	1200, 1200, 1200, 775, 409, 0, -2, 0, 0, 370,
+	0, 0, 532, 311, 495, 495, 317, 332, 254, 257,
+	0, 606, 610, 495, 198, 0, 527, 521, 0, 514,
+	525, 526, 517, 0, 519, 0, 515, 516, 495, 507,
+	446, 541, 535, 180, 134, 0, 285, 145, 354, 772,
+	491, 0, 0, 1072, 1083, 1085, 1088, 1090, 952, 0,
+	0, 0, 0, 0, 0, 936, 930, 931, 1025, 0,
+	453, 0, 0, 0, 0, 0, 964, 0, 0, 0,
+	0, 0, 0, 0, 453, 0, 0, 0, 0, 1095,
+	1103, 1099, 0, 1096, 0, 0, 1075, 0, 912, 0,
+	0, 0, 0, 954, 0, 960, 1009, 1013, 0, 1017,
+	0, 1019, 0, 0, 0, 0, 491, 399, 0, 404,
+	405, 401, 388, 395, 387, 397, 392, 393, 412, 814,
+	1205, 1202, 1203, 220, 0, 617, 75, 80, 81, 82,
+	76, 74, 624, 0, 626, 0, 0, 0, 423, 0,
+	418, 0, 573, 584, 579, 0, 0, 590, 1192, 1132,
+	1133, 1134, 1179, 1136, 1183, 1183, 1179, 1179, 1164, 1165,
+	1166, 1167, 1168, 1169, 1170, 1171, 1172, 1173, 0, 0,
+	1155, 1181, 1181, 1181, 1179, 1176, 1137, 1138, 1139, 1140,
+	1141, 1142, 1143, 1144, 1145, 1146, 1147, 1148, 1149, 1150,
+	1186, 1186, 1189, 1186, 0, 0, 0, 776, 777, 779,
+	780, 0, 0, 782, 841, 0, 791, 659, 791, 0,
+	0, 793, 794, 0, 596, 0, 0, 599, 608, 0,
+	0, 0, 271, 272, 0, 0, 0, 284, 280, 281,
+	282, 262, 609, 0, 0, 0, 0, 0, 539, 0,
+	0, 495, 369, 372, 373, 445, 315, 312, 495, 258,
+	259, 260, 453, 0, 0, 511, 528, 0, 0, 512,
+	0, 513, 518, 520, 197, 534, 147, 481, 0, 0,
+	35, 502, 0, 1075, 932, 933, 934, 0, 914, 926,
+	926, 935, 0, 970, 937, 938, 0, 941, 939, 0,
+	0, 0, 961, 942, 943, 929, 0, 0, 0, 944,
+	1071, 1097, 0, 1104, 1100, 890, 898, 908, 0, 0,
+	917, 1179, 1183, 920, 1179, 1181, 1181, 926, 926, 0,
+	0, 0, 0, 940, 0, 0, 0, 482, 400, 0,
+	386, 77, 72, 625, 58, 0, 0, 0, 425, 56,
+	0, 0, 0, 589, 1195, 1193, 1194, 1135, 1180, 0,
+	1160, 0, 1161, 1162, 1163, 0, 0, 1156, 0, 1157,
+	1158, 1159, 1151, 0, 1152, 1153, 0, 1154, 591, 446,
+	-2, 0, -2, 781, 842, 803, 803, 792, 803, 803,
+	659, 0, 593, 607, 598, 0, 611, 612, 0, 273,
+	274, 0, 277, 283, 0, 665, 666, 769, 770, 667,
+	0, 495, 495, -2, 366, 371, 0, 316, 448, 199,
+	200, 529, 0, 522, 492, 494, 953, 899, 915, 927,
+	928, 916, 957, 0, 0, 966, 965, 967, 0, 963,
+	0, 0, 1101, 913, 900, 918, 919, 921, 922, 923,
+	924, 925, 901, 955, 0, 1014, 1018, 945, 0, 969,
+	406, 26, 52, 0, 675, 0, 0, 679, 0, 427,
+	0, 424, 0, 0, 0, 0, 1131, 1196, 0, 0,
+	0, 1177, 0, 0, 0, 0, 0, 684, -2, 723,
+	1200, 1200, 0, 1200, 1200, 1200, 1200, 0, 0, 1200,
+	1200, 1200, 1200, 1200, 1200, 1200, 1200, 1200, 1200, 1200,
+	1200, 1200, 1200, 0, 778, 805, -2, 817, 819, 0,
+	0, 822, 823, 0, 0, 0, 0, 864, 829, 0,
+	0, 0, 0, 1119, 1120, 0, 840, 0, 795, 804,
+	0, 804, 0, 0, 803, 0, 0, 0, 270, 275,
+	0, 278, 279, 0, 647, 1179, 0, 671, 58, 453,
+	367, 196, 0, 962, 971, 968, 0, 0, 0, 0,
+	49, 54, 59, 60, 0, 0, 0, 0, 0, 676,
+	0, 429, 0, 426, 0, 420, 422, 66, 0, 1185,
+	1184, 1174, 0, 1175, 1182, 1187, 0, 1190, 0, 0,
+	670, 0, 668, 725, 0, 0, 1200, 1200, 0, 0,
+	0, 0, 1200, 1200, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 818,
+	820, 821, 824, 825, 826, 869, 870, 871, 827, 866,
+	867, 868, 828, 0, 0, 0, 0, 1117, 1118, 862,
+	0, 0, 0, 0, 0, 0, 0, 789, 600, 0,
+	602, 276, 640, 0, 459, 0, 672, 448, 530, 958,
+	959, 956, 0, 36, 0, 56, 0, 61, 0, 0,
+	0, 0, 0, 0, 416, 0, 428, 57, 0, 585,
+	1178, 0, 0, 592, 719, 724, 726, 727, 0, 0,
+	730, 731, 732, 733, 0, 0, 736, 737, 738, 739,
+	740, 741, 742, 743, 744, 745, 761, 762, 763, 764,
+	765, 766, 746, 747, 748, 749, 750, 751, 758, 0,
+	0, 755, 0, 0, 0, 0, 0, 857, 0, 0,
+	796, 798, 799, 800, 801, 802, 797, 0, 0, 0,
+	0, 788, 790, 837, 0, 601, 639, 648, 649, 459,
+	365, 0, 27, 0, 0, 53, 0, 62, 0, 64,
+	65, 677, 0, 0, 0, 1188, 1191, 708, 0, 0,
+	714, 0, 720, 728, 729, 734, 735, 752, 0, 0,
+	754, 0, 0, 865, 0, 0, 834, 844, 858, 0,
+	0, 640, 640, 640, 640, 0, 838, 641, 1200, 0,
+	0, 645, 646, 650, 459, 681, 0, 37, 0, 39,
+	41, 42, 806, 50, 51, 55, 63, 680, 0, 431,
+	421, 686, 0, 0, 0, 0, 0, 717, 0, 759,
+	760, 753, 756, 757, 830, 0, 833, 835, 836, 843,
+	845, 846, 847, 0, 859, 860, 861, 863, 783, 784,
+	785, 786, 0, 0, 643, 0, 0, 28, 29, 0,
+	33, 38, 0, 0, 430, 0, 688, 0, 709, 0,
+	0, 0, 0, 0, 0, 0, 831, 834, 848, 0,
+	849, 0, 0, 0, 787, 642, 644, 946, 0, 0,
+	0, 40, 807, 432, 685, 0, 719, 710, 0, 712,
+	0, 0, 0, 0, 832, 850, 852, 853, 0, 0,
+	851, 30, 31, 32, 34, 0, 0, 690, 0, 706,
+	711, 713, 0, 718, 716, 854, 856, 855, 808, 689,
+	0, 702, 687, 0, 715, 691, -2, 0, 707, 692,
+	-2, 0, 700, 0, 0, 693, 701, 0, 696, 0,
+	0, 0, 695, 0, -2, 703, 0, 0, 697, -2,
+	0, 705, 704,
 }
 
 var yyTok1 = [...]int{
@@ -5022,14 +5273,14 @@ var yyTok1 = [...]int{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 87, 3, 3, 3, 115, 107, 3,
 	58, 60, 112, 110, 59, 111, 124, 113, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 482,
-	95, 94, 96, 3, 483, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 505,
+	95, 94, 96, 3, 506, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 117, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 484, 106, 485, 118,
+	3, 3, 3, 507, 106, 508, 118,
 }
 
 var yyTok2 = [...]int{
@@ -5103,7 +5354,12 @@ var yyTok3 = [...]int{
 	57790, 465, 57791, 466, 57792, 467, 57793, 468, 57794, 469,
 	57795, 470, 57796, 471, 57797, 472, 57798, 473, 57799, 474,
 	57800, 475, 57801, 476, 57802, 477, 57803, 478, 57804, 479,
-	57805, 480, 57806, 481, 0,
+	57805, 480, 57806, 481, 57807, 482, 57808, 483, 57809, 484,
+	57810, 485, 57811, 486, 57812, 487, 57813, 488, 57814, 489,
+	57815, 490, 57816, 491, 57817, 492, 57818, 493, 57819, 494,
+	57820, 495, 57821, 496, 57822, 497, 57823, 498, 57824, 499,
+	57825, 500, 57826, 501, 57827, 502, 57828, 503, 57829, 504,
+	0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -5453,7 +5709,7 @@ yydefault:
 
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:541
+//line mysql_sql.y:573
 		{
 			if yyDollar[1].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[1].statementUnion())
@@ -5461,32 +5717,32 @@ yydefault:
 		}
 	case 3:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:547
+//line mysql_sql.y:579
 		{
 			if yyDollar[3].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[3].statementUnion())
 			}
 		}
-	case 22:
+	case 24:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:573
+//line mysql_sql.y:607
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 23:
+	case 25:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:577
+//line mysql_sql.y:611
 		{
 			yyLOCAL = tree.Statement(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 24:
+	case 26:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:583
+//line mysql_sql.y:617
 		{
 			yyLOCAL = &tree.Load{
 				Local:             yyDollar[3].boolValUnion(),
@@ -5497,42 +5753,42 @@ yydefault:
 			yyLOCAL.(*tree.Load).Param.Tail = yyDollar[9].tailParamUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 25:
+	case 27:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:594
+//line mysql_sql.y:628
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 26:
+	case 28:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:598
+//line mysql_sql.y:632
 		{
 			yyLOCAL = yyDollar[2].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 27:
+	case 29:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:604
+//line mysql_sql.y:638
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 28:
+	case 30:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:608
+//line mysql_sql.y:642
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 29:
+	case 31:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:614
+//line mysql_sql.y:648
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -5540,10 +5796,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 30:
+	case 32:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:621
+//line mysql_sql.y:655
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -5551,58 +5807,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 31:
+	case 33:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:630
+//line mysql_sql.y:664
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 32:
+	case 34:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:634
+//line mysql_sql.y:668
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 33:
+	case 35:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:638
+//line mysql_sql.y:672
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 34:
+	case 36:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:643
+//line mysql_sql.y:677
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 35:
+	case 37:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:647
+//line mysql_sql.y:681
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 36:
+	case 38:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:651
+//line mysql_sql.y:685
 		{
 			yyLOCAL = yyDollar[2].loadColumnsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 37:
+	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:657
+//line mysql_sql.y:691
 		{
 			switch yyDollar[1].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -5612,10 +5868,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 38:
+	case 40:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:666
+//line mysql_sql.y:700
 		{
 			switch yyDollar[3].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -5625,58 +5881,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 39:
+	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:677
+//line mysql_sql.y:711
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 40:
+	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:681
+//line mysql_sql.y:715
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 41:
+	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.VarExpr
-//line mysql_sql.y:687
+//line mysql_sql.y:721
 		{
 			yyLOCAL = []*tree.VarExpr{yyDollar[1].varExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 42:
+	case 44:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.VarExpr
-//line mysql_sql.y:691
+//line mysql_sql.y:725
 		{
 			yyLOCAL = append(yyDollar[1].varExprsUnion(), yyDollar[3].varExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 43:
+	case 45:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:697
+//line mysql_sql.y:731
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 44:
+	case 46:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:701
+//line mysql_sql.y:735
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 45:
+	case 47:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:707
+//line mysql_sql.y:741
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -5699,10 +5955,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 46:
+	case 48:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:731
+//line mysql_sql.y:765
 		{
 			//        vs := strings.Split($1, ".")
 			//        var r string
@@ -5721,42 +5977,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 47:
+	case 49:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:750
+//line mysql_sql.y:784
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 48:
+	case 50:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:754
+//line mysql_sql.y:788
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 49:
+	case 51:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:758
+//line mysql_sql.y:792
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 50:
+	case 52:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:763
+//line mysql_sql.y:797
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 51:
+	case 53:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:767
+//line mysql_sql.y:801
 		{
 			yyLOCAL = &tree.Lines{
 				StartingBy:   yyDollar[2].str,
@@ -5764,42 +6020,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 52:
+	case 54:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:775
+//line mysql_sql.y:809
 		{
 			yyVAL.str = ""
 		}
-	case 53:
+	case 55:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:779
+//line mysql_sql.y:813
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 54:
+	case 56:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:784
+//line mysql_sql.y:818
 		{
 			yyVAL.str = "\n"
 		}
-	case 55:
+	case 57:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:788
+//line mysql_sql.y:822
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 56:
+	case 58:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:793
+//line mysql_sql.y:827
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 57:
+	case 59:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:797
+//line mysql_sql.y:831
 		{
 			res := &tree.Fields{
 				Terminated: "\t",
@@ -5822,36 +6078,36 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 58:
+	case 60:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:821
+//line mysql_sql.y:855
 		{
 			yyLOCAL = []*tree.Fields{yyDollar[1].fieldsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 59:
+	case 61:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:825
+//line mysql_sql.y:859
 		{
 			yyLOCAL = append(yyDollar[1].fieldsListUnion(), yyDollar[2].fieldsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 60:
+	case 62:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:831
+//line mysql_sql.y:865
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 61:
+	case 63:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:837
+//line mysql_sql.y:871
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -5870,10 +6126,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 62:
+	case 64:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:855
+//line mysql_sql.y:889
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -5891,10 +6147,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 63:
+	case 65:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:872
+//line mysql_sql.y:906
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -5912,50 +6168,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 65:
+	case 67:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:895
+//line mysql_sql.y:929
 		{
 			yyLOCAL = &tree.DuplicateKeyError{}
 		}
 		yyVAL.union = yyLOCAL
-	case 66:
+	case 68:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:899
+//line mysql_sql.y:933
 		{
 			yyLOCAL = &tree.DuplicateKeyIgnore{}
 		}
 		yyVAL.union = yyLOCAL
-	case 67:
+	case 69:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:903
+//line mysql_sql.y:937
 		{
 			yyLOCAL = &tree.DuplicateKeyReplace{}
 		}
 		yyVAL.union = yyLOCAL
-	case 68:
+	case 70:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:908
+//line mysql_sql.y:942
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 69:
+	case 71:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:912
+//line mysql_sql.y:946
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 70:
+	case 72:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:918
+//line mysql_sql.y:952
 		{
 			yyLOCAL = &tree.Grant{
 				Typ: tree.GrantTypePrivilege,
@@ -5969,10 +6225,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 71:
+	case 73:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:931
+//line mysql_sql.y:965
 		{
 			yyLOCAL = &tree.Grant{
 				Typ: tree.GrantTypeRole,
@@ -5984,10 +6240,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 72:
+	case 74:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:942
+//line mysql_sql.y:976
 		{
 			yyLOCAL = &tree.Grant{
 				Typ: tree.GrantTypeProxy,
@@ -6000,26 +6256,26 @@ yydefault:
 
 		}
 		yyVAL.union = yyLOCAL
-	case 73:
+	case 75:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:955
+//line mysql_sql.y:989
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 74:
+	case 76:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:959
+//line mysql_sql.y:993
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 75:
+	case 77:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:969
+//line mysql_sql.y:1003
 		{
 			yyLOCAL = &tree.Revoke{
 				Typ: tree.RevokeTypePrivilege,
@@ -6033,10 +6289,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 76:
+	case 78:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:982
+//line mysql_sql.y:1016
 		{
 			yyLOCAL = &tree.Revoke{
 				Typ: tree.RevokeTypeRole,
@@ -6048,30 +6304,30 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 77:
+	case 79:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:995
+//line mysql_sql.y:1029
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_STAR,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 78:
+	case 80:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1001
+//line mysql_sql.y:1035
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_STAR_STAR,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 79:
+	case 81:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1007
+//line mysql_sql.y:1041
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:  tree.PRIVILEGE_LEVEL_TYPE_DATABASE_STAR,
@@ -6079,10 +6335,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 80:
+	case 82:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1014
+//line mysql_sql.y:1048
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_DATABASE_TABLE,
@@ -6091,10 +6347,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 81:
+	case 83:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1022
+//line mysql_sql.y:1056
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -6102,74 +6358,74 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 82:
+	case 84:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1030
+//line mysql_sql.y:1064
 		{
 			yyLOCAL = tree.OBJECT_TYPE_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 83:
+	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1034
+//line mysql_sql.y:1068
 		{
 			yyLOCAL = tree.OBJECT_TYPE_TABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 84:
+	case 86:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1038
+//line mysql_sql.y:1072
 		{
 			yyLOCAL = tree.OBJECT_TYPE_DATABASE
 		}
 		yyVAL.union = yyLOCAL
-	case 85:
+	case 87:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1042
+//line mysql_sql.y:1076
 		{
 			yyLOCAL = tree.OBJECT_TYPE_FUNCTION
 		}
 		yyVAL.union = yyLOCAL
-	case 86:
+	case 88:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1046
+//line mysql_sql.y:1080
 		{
 			yyLOCAL = tree.OBJECT_TYPE_PROCEDURE
 		}
 		yyVAL.union = yyLOCAL
-	case 87:
+	case 89:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1050
+//line mysql_sql.y:1084
 		{
 			yyLOCAL = tree.OBJECT_TYPE_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 88:
+	case 90:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:1056
+//line mysql_sql.y:1090
 		{
 			yyLOCAL = []*tree.Privilege{yyDollar[1].privilegeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 89:
+	case 91:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:1060
+//line mysql_sql.y:1094
 		{
 			yyLOCAL = append(yyDollar[1].privilegesUnion(), yyDollar[3].privilegeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 90:
+	case 92:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:1066
+//line mysql_sql.y:1100
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -6177,10 +6433,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 91:
+	case 93:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:1073
+//line mysql_sql.y:1107
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -6188,418 +6444,418 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 92:
+	case 94:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1082
+//line mysql_sql.y:1116
 		{
 			yyLOCAL = []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 93:
+	case 95:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1086
+//line mysql_sql.y:1120
 		{
 			yyLOCAL = append(yyDollar[1].unresolveNamesUnion(), yyDollar[3].unresolvedNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 94:
+	case 96:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1092
+//line mysql_sql.y:1126
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 95:
+	case 97:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1096
+//line mysql_sql.y:1130
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 96:
+	case 98:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1100
+//line mysql_sql.y:1134
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER
 		}
 		yyVAL.union = yyLOCAL
-	case 97:
+	case 99:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1104
+//line mysql_sql.y:1138
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE
 		}
 		yyVAL.union = yyLOCAL
-	case 98:
+	case 100:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1108
+//line mysql_sql.y:1142
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_USER
 		}
 		yyVAL.union = yyLOCAL
-	case 99:
+	case 101:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1112
+//line mysql_sql.y:1146
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TABLESPACE
 		}
 		yyVAL.union = yyLOCAL
-	case 100:
+	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1116
+//line mysql_sql.y:1150
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_TRIGGER
 		}
 		yyVAL.union = yyLOCAL
-	case 101:
+	case 103:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1120
+//line mysql_sql.y:1154
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DELETE
 		}
 		yyVAL.union = yyLOCAL
-	case 102:
+	case 104:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1124
+//line mysql_sql.y:1158
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP
 		}
 		yyVAL.union = yyLOCAL
-	case 103:
+	case 105:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1128
+//line mysql_sql.y:1162
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EXECUTE
 		}
 		yyVAL.union = yyLOCAL
-	case 104:
+	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1132
+//line mysql_sql.y:1166
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INDEX
 		}
 		yyVAL.union = yyLOCAL
-	case 105:
+	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1136
+//line mysql_sql.y:1170
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INSERT
 		}
 		yyVAL.union = yyLOCAL
-	case 106:
+	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1140
+//line mysql_sql.y:1174
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SELECT
 		}
 		yyVAL.union = yyLOCAL
-	case 107:
+	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1144
+//line mysql_sql.y:1178
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SUPER
 		}
 		yyVAL.union = yyLOCAL
-	case 108:
+	case 110:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1148
+//line mysql_sql.y:1182
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_DATABASES
 		}
 		yyVAL.union = yyLOCAL
-	case 109:
+	case 111:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1152
+//line mysql_sql.y:1186
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_UPDATE
 		}
 		yyVAL.union = yyLOCAL
-	case 110:
+	case 112:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1156
+//line mysql_sql.y:1190
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION
 		}
 		yyVAL.union = yyLOCAL
-	case 111:
+	case 113:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1160
+//line mysql_sql.y:1194
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REFERENCES
 		}
 		yyVAL.union = yyLOCAL
-	case 112:
+	case 114:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1164
+//line mysql_sql.y:1198
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_SLAVE
 		}
 		yyVAL.union = yyLOCAL
-	case 113:
+	case 115:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1168
+//line mysql_sql.y:1202
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_CLIENT
 		}
 		yyVAL.union = yyLOCAL
-	case 114:
+	case 116:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1172
+//line mysql_sql.y:1206
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_USAGE
 		}
 		yyVAL.union = yyLOCAL
-	case 115:
+	case 117:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1176
+//line mysql_sql.y:1210
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_RELOAD
 		}
 		yyVAL.union = yyLOCAL
-	case 116:
+	case 118:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1180
+//line mysql_sql.y:1214
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_FILE
 		}
 		yyVAL.union = yyLOCAL
-	case 117:
+	case 119:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1184
+//line mysql_sql.y:1218
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TEMPORARY_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 118:
+	case 120:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1188
+//line mysql_sql.y:1222
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_LOCK_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 119:
+	case 121:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1192
+//line mysql_sql.y:1226
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 120:
+	case 122:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1196
+//line mysql_sql.y:1230
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 121:
+	case 123:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1200
+//line mysql_sql.y:1234
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 122:
+	case 124:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1204
+//line mysql_sql.y:1238
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 123:
+	case 125:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1208
+//line mysql_sql.y:1242
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 124:
+	case 126:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1212
+//line mysql_sql.y:1246
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 125:
+	case 127:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1216
+//line mysql_sql.y:1250
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EVENT
 		}
 		yyVAL.union = yyLOCAL
-	case 126:
+	case 128:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1220
+//line mysql_sql.y:1254
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHUTDOWN
 		}
 		yyVAL.union = yyLOCAL
-	case 131:
+	case 133:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1232
+//line mysql_sql.y:1266
 		{
 			yyLOCAL = yyDollar[3].setRoleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 132:
+	case 134:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1238
+//line mysql_sql.y:1272
 		{
 			dr := yyDollar[4].setDefaultRoleUnion()
 			dr.Users = yyDollar[6].usersUnion()
 			yyLOCAL = dr
 		}
 		yyVAL.union = yyLOCAL
-	case 133:
+	case 135:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1246
+//line mysql_sql.y:1280
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_ALL_EXCEPT, Roles: yyDollar[3].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 134:
+	case 136:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1250
+//line mysql_sql.y:1284
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_DEFAULT, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 135:
+	case 137:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1254
+//line mysql_sql.y:1288
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_NONE, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 136:
+	case 138:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1258
+//line mysql_sql.y:1292
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_ALL, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 137:
+	case 139:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1262
+//line mysql_sql.y:1296
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 138:
+	case 140:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1268
+//line mysql_sql.y:1302
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NONE, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 139:
+	case 141:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1272
+//line mysql_sql.y:1306
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_ALL, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 140:
+	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1276
+//line mysql_sql.y:1310
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 141:
+	case 143:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1282
+//line mysql_sql.y:1316
 		{
 			yyLOCAL = &tree.SetVar{Assignments: yyDollar[2].varAssignmentExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 142:
+	case 144:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1288
+//line mysql_sql.y:1322
 		{
 			yyLOCAL = &tree.SetPassword{Password: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 143:
+	case 145:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1292
+//line mysql_sql.y:1326
 		{
 			yyLOCAL = &tree.SetPassword{User: yyDollar[4].userUnion(), Password: yyDollar[6].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 145:
+	case 147:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line mysql_sql.y:1299
+//line mysql_sql.y:1333
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 146:
+	case 148:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1305
+//line mysql_sql.y:1339
 		{
 			yyLOCAL = []*tree.VarAssignmentExpr{yyDollar[1].varAssignmentExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 147:
+	case 149:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1309
+//line mysql_sql.y:1343
 		{
 			yyLOCAL = append(yyDollar[1].varAssignmentExprsUnion(), yyDollar[3].varAssignmentExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 148:
+	case 150:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1315
+//line mysql_sql.y:1349
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -6608,10 +6864,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 149:
+	case 151:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1323
+//line mysql_sql.y:1357
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -6621,10 +6877,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 150:
+	case 152:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1332
+//line mysql_sql.y:1366
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -6633,10 +6889,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 151:
+	case 153:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1340
+//line mysql_sql.y:1374
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -6645,10 +6901,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 152:
+	case 154:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1348
+//line mysql_sql.y:1382
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -6672,10 +6928,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 153:
+	case 155:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1371
+//line mysql_sql.y:1405
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -6699,10 +6955,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 154:
+	case 156:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1394
+//line mysql_sql.y:1428
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -6710,10 +6966,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 155:
+	case 157:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1401
+//line mysql_sql.y:1435
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -6721,10 +6977,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 156:
+	case 158:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1408
+//line mysql_sql.y:1442
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:     yyDollar[1].str,
@@ -6733,10 +6989,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 157:
+	case 159:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1416
+//line mysql_sql.y:1450
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -6744,10 +7000,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 158:
+	case 160:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1423
+//line mysql_sql.y:1457
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -6755,10 +7011,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 159:
+	case 161:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1430
+//line mysql_sql.y:1464
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -6766,190 +7022,190 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 160:
+	case 162:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1439
+//line mysql_sql.y:1473
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
 		yyVAL.union = yyLOCAL
-	case 161:
+	case 163:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1443
+//line mysql_sql.y:1477
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
 		yyVAL.union = yyLOCAL
-	case 162:
+	case 164:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1447
+//line mysql_sql.y:1481
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 163:
+	case 165:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1453
+//line mysql_sql.y:1487
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 164:
+	case 166:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1457
+//line mysql_sql.y:1491
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 166:
+	case 168:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1464
+//line mysql_sql.y:1498
 		{
 			yyVAL.str = yyDollar[1].str + "." + yyDollar[3].str
 		}
-	case 170:
+	case 172:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1475
+//line mysql_sql.y:1509
 		{
 			yyLOCAL = &tree.RollbackTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 171:
+	case 173:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1481
+//line mysql_sql.y:1515
 		{
 			yyLOCAL = &tree.CommitTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 172:
+	case 174:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1486
+//line mysql_sql.y:1520
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 173:
+	case 175:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1490
+//line mysql_sql.y:1524
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 174:
+	case 176:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1494
+//line mysql_sql.y:1528
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 175:
+	case 177:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1498
+//line mysql_sql.y:1532
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 176:
+	case 178:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1502
+//line mysql_sql.y:1536
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 177:
+	case 179:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1506
+//line mysql_sql.y:1540
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 178:
+	case 180:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1510
+//line mysql_sql.y:1544
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 179:
+	case 181:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1514
+//line mysql_sql.y:1548
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 180:
+	case 182:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1518
+//line mysql_sql.y:1552
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 181:
+	case 183:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1524
+//line mysql_sql.y:1558
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 182:
+	case 184:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1528
+//line mysql_sql.y:1562
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 183:
+	case 185:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1532
+//line mysql_sql.y:1566
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 184:
+	case 186:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1536
+//line mysql_sql.y:1570
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_WRITE)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 185:
+	case 187:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1541
+//line mysql_sql.y:1575
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_ONLY)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 186:
+	case 188:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1546
+//line mysql_sql.y:1580
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 187:
+	case 189:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1552
+//line mysql_sql.y:1586
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole: false,
@@ -6957,20 +7213,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 188:
+	case 190:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1559
+//line mysql_sql.y:1593
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 189:
+	case 191:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1565
+//line mysql_sql.y:1599
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole: false,
@@ -6978,10 +7234,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 190:
+	case 192:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1572
+//line mysql_sql.y:1606
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole:     true,
@@ -6989,10 +7245,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 191:
+	case 193:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1579
+//line mysql_sql.y:1613
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole:     true,
@@ -7000,19 +7256,19 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 193:
+	case 195:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1589
+//line mysql_sql.y:1623
 		{
 			yyDollar[2].statementUnion().(*tree.Update).With = yyDollar[1].withClauseUnion()
 			yyLOCAL = yyDollar[2].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 194:
+	case 196:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1596
+//line mysql_sql.y:1630
 		{
 			// Single-table syntax
 			yyLOCAL = &tree.Update{
@@ -7024,10 +7280,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 195:
+	case 197:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1607
+//line mysql_sql.y:1641
 		{
 			// Multiple-table syntax
 			yyLOCAL = &tree.Update{
@@ -7037,130 +7293,130 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 196:
+	case 198:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1618
+//line mysql_sql.y:1652
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 197:
+	case 199:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1622
+//line mysql_sql.y:1656
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 198:
+	case 200:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:1628
+//line mysql_sql.y:1662
 		{
 			yyLOCAL = &tree.UpdateExpr{Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 205:
+	case 207:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1640
+//line mysql_sql.y:1674
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 206:
+	case 208:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1646
+//line mysql_sql.y:1680
 		{
 			yyLOCAL = tree.NewPrepareStmt(tree.Identifier(yyDollar[2].str), yyDollar[4].statementUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 207:
+	case 209:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1650
+//line mysql_sql.y:1684
 		{
 			yyLOCAL = tree.NewPrepareString(tree.Identifier(yyDollar[2].str), yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 208:
+	case 210:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1656
+//line mysql_sql.y:1690
 		{
 			yyLOCAL = tree.NewExecute(tree.Identifier(yyDollar[2].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 209:
+	case 211:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1660
+//line mysql_sql.y:1694
 		{
 			yyLOCAL = tree.NewExecuteWithVariables(tree.Identifier(yyDollar[2].str), yyDollar[4].varExprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 210:
+	case 212:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1666
+//line mysql_sql.y:1700
 		{
 			yyLOCAL = tree.NewDeallocate(tree.Identifier(yyDollar[3].str), false)
 		}
 		yyVAL.union = yyLOCAL
-	case 214:
+	case 216:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1675
+//line mysql_sql.y:1709
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 215:
+	case 217:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1681
+//line mysql_sql.y:1715
 		{
 			yyLOCAL = &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 216:
+	case 218:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1685
+//line mysql_sql.y:1719
 		{
 			yyLOCAL = &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion(), ColName: yyDollar[3].unresolvedNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 217:
+	case 219:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1689
+//line mysql_sql.y:1723
 		{
 			yyLOCAL = tree.NewExplainFor("", uint64(yyDollar[4].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 218:
+	case 220:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1693
+//line mysql_sql.y:1727
 		{
 			yyLOCAL = tree.NewExplainFor(yyDollar[4].str, uint64(yyDollar[7].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 219:
+	case 221:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1697
+//line mysql_sql.y:1731
 		{
 			yyLOCAL = tree.NewExplainStmt(yyDollar[2].statementUnion(), "text")
 		}
 		yyVAL.union = yyLOCAL
-	case 220:
+	case 222:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1701
+//line mysql_sql.y:1735
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("verbose", "NULL")
@@ -7169,10 +7425,10 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 221:
+	case 223:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1709
+//line mysql_sql.y:1743
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("analyze", "NULL")
@@ -7181,10 +7437,10 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 222:
+	case 224:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1717
+//line mysql_sql.y:1751
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[4].statementUnion(), "text")
 			optionElem1 := tree.MakeOptionElem("analyze", "NULL")
@@ -7195,76 +7451,161 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 223:
+	case 225:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1727
+//line mysql_sql.y:1761
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[5].statementUnion(), "text")
 			explainStmt.Options = yyDollar[3].epxlainOptionsUnion()
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 235:
+	case 237:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:1759
+//line mysql_sql.y:1793
 		{
 			yyLOCAL = tree.MakeOptions(yyDollar[1].epxlainOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 236:
+	case 238:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:1763
+//line mysql_sql.y:1797
 		{
 			yyLOCAL = append(yyDollar[1].epxlainOptionsUnion(), yyDollar[3].epxlainOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 237:
+	case 239:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.OptionElem
-//line mysql_sql.y:1769
+//line mysql_sql.y:1803
 		{
 			yyLOCAL = tree.MakeOptionElem(yyDollar[1].str, yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 238:
+	case 240:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1775
+//line mysql_sql.y:1809
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 239:
+	case 241:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1780
+//line mysql_sql.y:1814
 		{
 			yyVAL.str = "true"
 		}
-	case 240:
+	case 242:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1781
+//line mysql_sql.y:1815
 		{
 			yyVAL.str = "false"
 		}
-	case 241:
+	case 243:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1782
+//line mysql_sql.y:1816
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 242:
+	case 244:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1787
+//line mysql_sql.y:1821
 		{
 			yyLOCAL = tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), yyDollar[5].identifierListUnion())
 		}
 		yyVAL.union = yyLOCAL
 	case 245:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1827
+		{
+			yyLOCAL = &tree.Backup{Dir: yyDollar[3].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 246:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1831
+		{
+			yyLOCAL = &tree.Backup{Dir: yyDollar[3].str, Base: yyDollar[6].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 247:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1837
+		{
+			yyLOCAL = &tree.RefreshMaterializedView{
+				Name: yyDollar[4].tableNameUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 248:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1843
+		{
+			yyLOCAL = &tree.RefreshMaterializedView{
+				Name:        yyDollar[4].tableNameUnion(),
+				Incremental: true,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 252:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1858
+		{
+			yyLOCAL = &tree.AlterSequence{IfExists: yyDollar[3].boolValUnion(), Name: yyDollar[4].tableNameUnion(), Options: yyDollar[5].sequenceOptionsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 253:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.SequenceOptions
+//line mysql_sql.y:1864
+		{
+			yyLOCAL = tree.SequenceOptions{yyDollar[1].sequenceOptionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 254:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.SequenceOptions
+//line mysql_sql.y:1868
+		{
+			yyLOCAL = append(yyDollar[1].sequenceOptionsUnion(), yyDollar[2].sequenceOptionUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 256:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.SequenceOption
+//line mysql_sql.y:1875
+		{
+			yyLOCAL = &tree.SequenceOption{Type: tree.SequenceRestart}
+		}
+		yyVAL.union = yyLOCAL
+	case 257:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.SequenceOption
+//line mysql_sql.y:1879
+		{
+			yyLOCAL = &tree.SequenceOption{Type: tree.SequenceRestart, Value: yyDollar[2].int64ValUnion(), HasValue: true}
+		}
+		yyVAL.union = yyLOCAL
+	case 258:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.SequenceOption
+//line mysql_sql.y:1883
+		{
+			yyLOCAL = &tree.SequenceOption{Type: tree.SequenceRestart, Value: yyDollar[3].int64ValUnion(), HasValue: true}
+		}
+		yyVAL.union = yyLOCAL
+	case 259:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1798
+//line mysql_sql.y:1889
 		{
 			yyLOCAL = &tree.AlterAccount{
 				IfExists:     yyDollar[3].boolValUnion(),
@@ -7275,10 +7616,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 246:
+	case 260:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1810
+//line mysql_sql.y:1901
 		{
 			yyLOCAL = &tree.AlterUser{
 				IfExists:           yyDollar[3].boolValUnion(),
@@ -7289,214 +7630,214 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 247:
+	case 261:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:1821
+//line mysql_sql.y:1912
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 248:
+	case 262:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:1825
+//line mysql_sql.y:1916
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 249:
+	case 263:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1830
+//line mysql_sql.y:1921
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 250:
+	case 264:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1834
+//line mysql_sql.y:1925
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 251:
+	case 265:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1839
+//line mysql_sql.y:1930
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 252:
+	case 266:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1843
+//line mysql_sql.y:1934
 		{
 			yyLOCAL = yyDollar[1].userMiscOptionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 253:
+	case 267:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1859
+//line mysql_sql.y:1950
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountUnlock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 254:
+	case 268:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1863
+//line mysql_sql.y:1954
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountLock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 255:
+	case 269:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1867
+//line mysql_sql.y:1958
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNone{}
 		}
 		yyVAL.union = yyLOCAL
-	case 256:
+	case 270:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1871
+//line mysql_sql.y:1962
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireInterval{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 257:
+	case 271:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1875
+//line mysql_sql.y:1966
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNever{}
 		}
 		yyVAL.union = yyLOCAL
-	case 258:
+	case 272:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1879
+//line mysql_sql.y:1970
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 259:
+	case 273:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1883
+//line mysql_sql.y:1974
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordHistoryDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 260:
+	case 274:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1887
+//line mysql_sql.y:1978
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordHistoryCount{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 261:
+	case 275:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1891
+//line mysql_sql.y:1982
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordReuseIntervalDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 262:
+	case 276:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1895
+//line mysql_sql.y:1986
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordReuseIntervalCount{Value: yyDollar[4].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 263:
+	case 277:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1899
+//line mysql_sql.y:1990
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordRequireCurrentNone{}
 		}
 		yyVAL.union = yyLOCAL
-	case 264:
+	case 278:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1903
+//line mysql_sql.y:1994
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordRequireCurrentDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 265:
+	case 279:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1907
+//line mysql_sql.y:1998
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordRequireCurrentOptional{}
 		}
 		yyVAL.union = yyLOCAL
-	case 266:
+	case 280:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1911
+//line mysql_sql.y:2002
 		{
 			yyLOCAL = &tree.UserMiscOptionFailedLoginAttempts{Value: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 267:
+	case 281:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1915
+//line mysql_sql.y:2006
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordLockTimeCount{Value: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 268:
+	case 282:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1919
+//line mysql_sql.y:2010
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordLockTimeUnbounded{}
 		}
 		yyVAL.union = yyLOCAL
-	case 269:
+	case 283:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1925
+//line mysql_sql.y:2016
 		{
 			yyVAL.item = nil
 		}
-	case 270:
+	case 284:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1930
+//line mysql_sql.y:2021
 		{
 			yyVAL.item = nil
 		}
-	case 283:
+	case 297:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1952
+//line mysql_sql.y:2043
 		{
 			yyLOCAL = &tree.ShowTarget{Target: yyDollar[2].str, Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 284:
+	case 298:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1956
+//line mysql_sql.y:2047
 		{
 			yyLOCAL = &tree.ShowTarget{Target: "charset", Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 285:
+	case 299:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1962
+//line mysql_sql.y:2053
 		{
 			yyLOCAL = &tree.ShowIndex{
 				TableName: *yyDollar[4].tableNameUnion(),
@@ -7504,10 +7845,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 289:
+	case 303:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1976
+//line mysql_sql.y:2067
 		{
 			yyLOCAL = &tree.ShowVariables{
 				Global: yyDollar[2].boolValUnion(),
@@ -7516,10 +7857,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 290:
+	case 304:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1986
+//line mysql_sql.y:2077
 		{
 			yyLOCAL = &tree.ShowStatus{
 				Global: yyDollar[2].boolValUnion(),
@@ -7528,58 +7869,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 291:
+	case 305:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1995
+//line mysql_sql.y:2086
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 292:
+	case 306:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1999
+//line mysql_sql.y:2090
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 293:
+	case 307:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2003
+//line mysql_sql.y:2094
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 294:
+	case 308:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2009
+//line mysql_sql.y:2100
 		{
 			yyLOCAL = &tree.ShowWarnings{}
 		}
 		yyVAL.union = yyLOCAL
-	case 295:
+	case 309:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2015
+//line mysql_sql.y:2106
 		{
 			yyLOCAL = &tree.ShowErrors{}
 		}
 		yyVAL.union = yyLOCAL
-	case 296:
+	case 310:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2021
+//line mysql_sql.y:2112
 		{
 			yyLOCAL = &tree.ShowProcessList{Full: yyDollar[2].fullOptUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 297:
+	case 311:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2027
+//line mysql_sql.y:2118
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   false,
@@ -7590,10 +7931,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 298:
+	case 312:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2037
+//line mysql_sql.y:2128
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   true,
@@ -7604,26 +7945,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 299:
+	case 313:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2049
+//line mysql_sql.y:2140
 		{
 			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 300:
+	case 314:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2053
+//line mysql_sql.y:2144
 		{
 			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 301:
+	case 315:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2059
+//line mysql_sql.y:2150
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   false,
@@ -7636,10 +7977,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 302:
+	case 316:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2071
+//line mysql_sql.y:2162
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   true,
@@ -7652,126 +7993,126 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 303:
+	case 317:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:2084
+//line mysql_sql.y:2175
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 304:
+	case 318:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:2088
+//line mysql_sql.y:2179
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.LIKE, nil, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 305:
+	case 319:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2093
+//line mysql_sql.y:2184
 		{
 			yyVAL.str = ""
 		}
-	case 306:
+	case 320:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2097
+//line mysql_sql.y:2188
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 307:
+	case 321:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:2103
+//line mysql_sql.y:2194
 		{
 			yyLOCAL = yyDollar[2].unresolvedObjectNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 312:
+	case 326:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2116
+//line mysql_sql.y:2207
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 313:
+	case 327:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2120
+//line mysql_sql.y:2211
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 314:
+	case 328:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2126
+//line mysql_sql.y:2217
 		{
 			yyLOCAL = &tree.ShowCreateTable{Name: yyDollar[4].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 315:
+	case 329:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2131
+//line mysql_sql.y:2222
 		{
 			yyLOCAL = &tree.ShowCreateView{Name: yyDollar[4].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 316:
+	case 330:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2135
+//line mysql_sql.y:2226
 		{
 			yyLOCAL = &tree.ShowCreateDatabase{IfNotExists: yyDollar[4].ifNotExistsUnion(), Name: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 317:
+	case 331:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:2141
+//line mysql_sql.y:2232
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 318:
+	case 332:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:2145
+//line mysql_sql.y:2236
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 320:
+	case 334:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:2154
+//line mysql_sql.y:2245
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 321:
+	case 335:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:2158
+//line mysql_sql.y:2249
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 322:
+	case 336:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:2162
+//line mysql_sql.y:2253
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(3, [3]string{yyDollar[5].str, yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 332:
+	case 348:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2181
+//line mysql_sql.y:2274
 		{
 			yyLOCAL = &tree.DropAccount{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -7779,10 +8120,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 333:
+	case 349:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2190
+//line mysql_sql.y:2283
 		{
 			yyLOCAL = &tree.DropUser{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -7790,26 +8131,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 334:
+	case 350:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:2199
+//line mysql_sql.y:2292
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 335:
+	case 351:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:2203
+//line mysql_sql.y:2296
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 336:
+	case 352:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:2209
+//line mysql_sql.y:2302
 		{
 			yyLOCAL = &tree.User{
 				Username: yyDollar[1].usernameRecordUnion().Username,
@@ -7817,10 +8158,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 337:
+	case 353:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2218
+//line mysql_sql.y:2311
 		{
 			yyLOCAL = &tree.DropRole{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -7828,10 +8169,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 338:
+	case 354:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2227
+//line mysql_sql.y:2320
 		{
 			yyLOCAL = &tree.DropIndex{
 				Name:      tree.Identifier(yyDollar[4].str),
@@ -7840,60 +8181,76 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 339:
+	case 355:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2237
+//line mysql_sql.y:2330
 		{
 			yyLOCAL = &tree.DropTable{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 340:
+	case 356:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2243
+//line mysql_sql.y:2336
 		{
 			yyLOCAL = &tree.DropView{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 341:
+	case 357:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2249
+//line mysql_sql.y:2342
+		{
+			yyLOCAL = &tree.DropFunction{IfExists: yyDollar[3].boolValUnion(), Name: yyDollar[4].tableNameUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 358:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2348
+		{
+			yyLOCAL = &tree.DropSequence{IfExists: yyDollar[3].boolValUnion(), Name: yyDollar[4].tableNameUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 359:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2354
 		{
 			yyLOCAL = &tree.DropDatabase{Name: tree.Identifier(yyDollar[4].str), IfExists: yyDollar[3].boolValUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 342:
+	case 360:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2255
+//line mysql_sql.y:2360
 		{
 			yyLOCAL = tree.NewDeallocate(tree.Identifier(yyDollar[3].str), true)
 		}
 		yyVAL.union = yyLOCAL
-	case 345:
+	case 363:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2263
+//line mysql_sql.y:2368
 		{
 			yyDollar[2].statementUnion().(*tree.Delete).With = yyDollar[1].withClauseUnion()
 			yyLOCAL = yyDollar[2].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 346:
+	case 364:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2268
+//line mysql_sql.y:2373
 		{
 			yyDollar[2].statementUnion().(*tree.Delete).With = yyDollar[1].withClauseUnion()
 			yyLOCAL = yyDollar[2].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 347:
+	case 365:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2275
+//line mysql_sql.y:2380
 		{
 			// Single-Table Syntax
 			t := &tree.AliasedTableExpr{
@@ -7910,10 +8267,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 348:
+	case 366:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2291
+//line mysql_sql.y:2396
 		{
 			// Multiple-Table Syntax
 			yyLOCAL = &tree.Delete{
@@ -7923,10 +8280,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 349:
+	case 367:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2304
+//line mysql_sql.y:2409
 		{
 			// Multiple-Table Syntax
 			yyLOCAL = &tree.Delete{
@@ -7936,69 +8293,69 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 350:
+	case 368:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:2315
+//line mysql_sql.y:2420
 		{
 			yyLOCAL = tree.TableExprs{yyDollar[1].tableNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 351:
+	case 369:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:2319
+//line mysql_sql.y:2424
 		{
 			yyLOCAL = append(yyDollar[1].tableExprsUnion(), yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 352:
+	case 370:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:2325
+//line mysql_sql.y:2430
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 353:
+	case 371:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:2330
+//line mysql_sql.y:2435
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].str), ExplicitSchema: true}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 354:
+	case 372:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2337
+//line mysql_sql.y:2442
 		{
 		}
-	case 355:
+	case 373:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2339
+//line mysql_sql.y:2444
 		{
 		}
-	case 356:
+	case 374:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2342
+//line mysql_sql.y:2447
 		{
 		}
-	case 361:
+	case 379:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2351
+//line mysql_sql.y:2456
 		{
 		}
-	case 363:
+	case 381:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2355
+//line mysql_sql.y:2460
 		{
 		}
-	case 365:
+	case 383:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2360
+//line mysql_sql.y:2465
 		{
 			ins := yyDollar[4].insertUnion()
 			ins.Table = yyDollar[2].tableExprUnion()
@@ -8006,10 +8363,10 @@ yydefault:
 			yyLOCAL = ins
 		}
 		yyVAL.union = yyLOCAL
-	case 366:
+	case 384:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2369
+//line mysql_sql.y:2474
 		{
 			vc := tree.NewValuesClause(yyDollar[2].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -8017,20 +8374,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 367:
+	case 385:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2376
+//line mysql_sql.y:2481
 		{
 			yyLOCAL = &tree.Insert{
 				Rows: yyDollar[1].selectUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 368:
+	case 386:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2382
+//line mysql_sql.y:2487
 		{
 			vc := tree.NewValuesClause(yyDollar[5].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -8039,10 +8396,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 369:
+	case 387:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2390
+//line mysql_sql.y:2495
 		{
 			vc := tree.NewValuesClause(yyDollar[4].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -8050,10 +8407,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 370:
+	case 388:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2397
+//line mysql_sql.y:2502
 		{
 			yyLOCAL = &tree.Insert{
 				Columns: yyDollar[2].identifierListUnion(),
@@ -8061,10 +8418,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 371:
+	case 389:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2404
+//line mysql_sql.y:2509
 		{
 			if yyDollar[2].assignmentsUnion() == nil {
 				yylex.Error("the set list of insert can not be empty")
@@ -8083,34 +8440,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 372:
+	case 390:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:2423
+//line mysql_sql.y:2528
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 373:
+	case 391:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:2427
+//line mysql_sql.y:2532
 		{
 			yyLOCAL = []*tree.Assignment{yyDollar[1].assignmentUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 374:
+	case 392:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:2431
+//line mysql_sql.y:2536
 		{
 			yyLOCAL = append(yyDollar[1].assignmentsUnion(), yyDollar[3].assignmentUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 375:
+	case 393:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Assignment
-//line mysql_sql.y:2437
+//line mysql_sql.y:2542
 		{
 			yyLOCAL = &tree.Assignment{
 				Column: tree.Identifier(yyDollar[1].str),
@@ -8118,171 +8475,184 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 376:
+	case 394:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2446
+//line mysql_sql.y:2551
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 377:
+	case 395:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2450
+//line mysql_sql.y:2555
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 378:
+	case 396:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2456
+//line mysql_sql.y:2561
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 379:
+	case 397:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2460
+//line mysql_sql.y:2565
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 380:
+	case 398:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:2466
+//line mysql_sql.y:2571
 		{
 			yyLOCAL = []tree.Exprs{yyDollar[1].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 381:
+	case 399:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:2470
+//line mysql_sql.y:2575
 		{
 			yyLOCAL = append(yyDollar[1].rowsExprsUnion(), yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 382:
+	case 400:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2476
+//line mysql_sql.y:2581
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 383:
+	case 401:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2481
+//line mysql_sql.y:2586
 		{
 		}
-	case 385:
+	case 403:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2485
+//line mysql_sql.y:2590
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 387:
+	case 405:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2492
+//line mysql_sql.y:2597
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 388:
+	case 406:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2496
+//line mysql_sql.y:2601
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 390:
+	case 408:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:2503
+//line mysql_sql.y:2608
 		{
 			yyLOCAL = &tree.DefaultVal{}
 		}
 		yyVAL.union = yyLOCAL
-	case 391:
+	case 409:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2508
+//line mysql_sql.y:2613
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 392:
+	case 410:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2512
+//line mysql_sql.y:2617
 		{
 			yyLOCAL = yyDollar[3].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 393:
+	case 411:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2518
+//line mysql_sql.y:2623
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 394:
+	case 412:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2522
+//line mysql_sql.y:2627
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 395:
+	case 413:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2528
+//line mysql_sql.y:2633
 		{
 			yyLOCAL = yyDollar[2].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 396:
+	case 414:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2532
+//line mysql_sql.y:2637
 		{
 			yyLOCAL = yyDollar[1].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 397:
+	case 415:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:2537
+//line mysql_sql.y:2642
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 398:
-		yyDollar = yyS[yypt-8 : yypt+1]
+	case 416:
+		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:2541
+//line mysql_sql.y:2646
 		{
 			yyLOCAL = &tree.ExportParam{
 				Outfile:     true,
 				FilePath:    yyDollar[3].str,
-				Fields:      yyDollar[4].fieldsUnion(),
-				Lines:       yyDollar[5].linesUnion(),
-				Header:      yyDollar[6].unsignedOptUnion(),
-				MaxFileSize: uint64(yyDollar[7].int64ValUnion()) * 1024,
-				ForceQuote:  yyDollar[8].strsUnion(),
+				FileFormat:  yyDollar[4].str,
+				Fields:      yyDollar[5].fieldsUnion(),
+				Lines:       yyDollar[6].linesUnion(),
+				Header:      yyDollar[7].unsignedOptUnion(),
+				MaxFileSize: uint64(yyDollar[8].int64ValUnion()) * 1024,
+				ForceQuote:  yyDollar[9].strsUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 399:
+	case 417:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:2660
+		{
+			yyVAL.str = ""
+		}
+	case 418:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line mysql_sql.y:2664
+		{
+			yyVAL.str = strings.ToLower(yyDollar[2].str)
+		}
+	case 419:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2554
+//line mysql_sql.y:2669
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: ",",
@@ -8290,10 +8660,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 400:
+	case 420:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2561
+//line mysql_sql.y:2676
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[4].str,
@@ -8301,10 +8671,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 401:
+	case 421:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2568
+//line mysql_sql.y:2683
 		{
 			str := yyDollar[7].str
 			if str != "\\" && len(str) > 1 {
@@ -8323,10 +8693,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 402:
+	case 422:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2586
+//line mysql_sql.y:2701
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -8345,38 +8715,38 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 403:
+	case 423:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:2605
+//line mysql_sql.y:2720
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: "\n",
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 404:
+	case 424:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:2611
+//line mysql_sql.y:2726
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: yyDollar[2].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 405:
+	case 425:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2618
+//line mysql_sql.y:2733
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 406:
+	case 426:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2622
+//line mysql_sql.y:2737
 		{
 			str := strings.ToLower(yyDollar[2].str)
 			if str == "true" {
@@ -8389,115 +8759,115 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 407:
+	case 427:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:2635
+//line mysql_sql.y:2750
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 408:
+	case 428:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:2639
+//line mysql_sql.y:2754
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 409:
+	case 429:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2644
+//line mysql_sql.y:2759
 		{
 			yyLOCAL = []string{}
 		}
 		yyVAL.union = yyLOCAL
-	case 410:
+	case 430:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2648
+//line mysql_sql.y:2763
 		{
 			yyLOCAL = yyDollar[3].strsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 411:
+	case 431:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2655
+//line mysql_sql.y:2770
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 412:
+	case 432:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2660
+//line mysql_sql.y:2775
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 414:
+	case 434:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2667
+//line mysql_sql.y:2782
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 415:
+	case 435:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2673
+//line mysql_sql.y:2788
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 416:
+	case 436:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2677
+//line mysql_sql.y:2792
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Ep: yyDollar[3].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 417:
+	case 437:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2681
+//line mysql_sql.y:2796
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 418:
+	case 438:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2685
+//line mysql_sql.y:2800
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 419:
+	case 439:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2689
+//line mysql_sql.y:2804
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Ep: yyDollar[4].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 420:
+	case 440:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2693
+//line mysql_sql.y:2808
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 421:
+	case 441:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:2699
+//line mysql_sql.y:2814
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: false,
@@ -8505,10 +8875,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 422:
+	case 442:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:2706
+//line mysql_sql.y:2821
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: true,
@@ -8516,26 +8886,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 423:
+	case 443:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:2715
+//line mysql_sql.y:2830
 		{
 			yyLOCAL = []*tree.CTE{yyDollar[1].cteUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 424:
+	case 444:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:2719
+//line mysql_sql.y:2834
 		{
 			yyLOCAL = append(yyDollar[1].cteListUnion(), yyDollar[3].cteUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 425:
+	case 445:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.CTE
-//line mysql_sql.y:2725
+//line mysql_sql.y:2840
 		{
 			yyLOCAL = &tree.CTE{
 				Name: &tree.AliasClause{Alias: tree.Identifier(yyDollar[1].str), Cols: yyDollar[2].identifierListUnion()},
@@ -8543,162 +8913,162 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 426:
+	case 446:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2733
+//line mysql_sql.y:2848
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 427:
+	case 447:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2737
+//line mysql_sql.y:2852
 		{
 			yyLOCAL = yyDollar[2].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 428:
+	case 448:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2742
+//line mysql_sql.y:2857
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 429:
+	case 449:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2746
+//line mysql_sql.y:2861
 		{
 			yyLOCAL = yyDollar[1].limitUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 430:
+	case 450:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2752
+//line mysql_sql.y:2867
 		{
 			yyLOCAL = &tree.Limit{Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 431:
+	case 451:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2756
+//line mysql_sql.y:2871
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[2].exprUnion(), Count: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 432:
+	case 452:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2760
+//line mysql_sql.y:2875
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[4].exprUnion(), Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 433:
+	case 453:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2765
+//line mysql_sql.y:2880
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 434:
+	case 454:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2769
+//line mysql_sql.y:2884
 		{
 			yyLOCAL = yyDollar[1].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 435:
+	case 455:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2775
+//line mysql_sql.y:2890
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 436:
+	case 456:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2781
+//line mysql_sql.y:2896
 		{
 			yyLOCAL = tree.OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 437:
+	case 457:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2785
+//line mysql_sql.y:2900
 		{
 			yyLOCAL = append(yyDollar[1].orderByUnion(), yyDollar[3].orderUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 438:
+	case 458:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Order
-//line mysql_sql.y:2791
+//line mysql_sql.y:2906
 		{
 			yyLOCAL = &tree.Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 439:
+	case 459:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2796
+//line mysql_sql.y:2911
 		{
 			yyLOCAL = tree.DefaultDirection
 		}
 		yyVAL.union = yyLOCAL
-	case 440:
+	case 460:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2800
+//line mysql_sql.y:2915
 		{
 			yyLOCAL = tree.Ascending
 		}
 		yyVAL.union = yyLOCAL
-	case 441:
+	case 461:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2804
+//line mysql_sql.y:2919
 		{
 			yyLOCAL = tree.Descending
 		}
 		yyVAL.union = yyLOCAL
-	case 442:
+	case 462:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2811
+//line mysql_sql.y:2926
 		{
 			yyLOCAL = &tree.ParenSelect{Select: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 443:
+	case 463:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2815
+//line mysql_sql.y:2930
 		{
 			yyLOCAL = &tree.ParenSelect{Select: &tree.Select{Select: yyDollar[2].selectStatementUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 444:
+	case 464:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2821
+//line mysql_sql.y:2936
 		{
 			yyLOCAL = yyDollar[1].selectStatementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 445:
+	case 465:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2825
+//line mysql_sql.y:2940
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -8709,10 +9079,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 446:
+	case 466:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2835
+//line mysql_sql.y:2950
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -8723,10 +9093,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 447:
+	case 467:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2845
+//line mysql_sql.y:2960
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -8737,10 +9107,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 448:
+	case 468:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2855
+//line mysql_sql.y:2970
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -8751,10 +9121,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 449:
+	case 469:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2867
+//line mysql_sql.y:2982
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -8763,10 +9133,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 450:
+	case 470:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2875
+//line mysql_sql.y:2990
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -8775,10 +9145,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 451:
+	case 471:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2883
+//line mysql_sql.y:2998
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -8787,10 +9157,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 452:
+	case 472:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2892
+//line mysql_sql.y:3007
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.EXCEPT,
@@ -8799,10 +9169,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 453:
+	case 473:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2900
+//line mysql_sql.y:3015
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.EXCEPT,
@@ -8811,10 +9181,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 454:
+	case 474:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2908
+//line mysql_sql.y:3023
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.EXCEPT,
@@ -8823,10 +9193,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 455:
+	case 475:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2916
+//line mysql_sql.y:3031
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.INTERSECT,
@@ -8835,10 +9205,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 456:
+	case 476:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2924
+//line mysql_sql.y:3039
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.INTERSECT,
@@ -8847,10 +9217,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 457:
+	case 477:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2932
+//line mysql_sql.y:3047
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.INTERSECT,
@@ -8859,10 +9229,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 458:
+	case 478:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2940
+//line mysql_sql.y:3055
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UT_MINUS,
@@ -8871,10 +9241,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 459:
+	case 479:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2948
+//line mysql_sql.y:3063
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UT_MINUS,
@@ -8883,10 +9253,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 460:
+	case 480:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2956
+//line mysql_sql.y:3071
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UT_MINUS,
@@ -8895,10 +9265,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 461:
+	case 481:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2966
+//line mysql_sql.y:3081
 		{
 			yyLOCAL = &tree.SelectClause{
 				Distinct: yyDollar[2].boolValUnion(),
@@ -8910,10 +9280,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 462:
+	case 482:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2977
+//line mysql_sql.y:3092
 		{
 			yyLOCAL = &tree.SelectClause{
 				Distinct: false,
@@ -8926,130 +9296,130 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 466:
+	case 486:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2995
+//line mysql_sql.y:3110
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 467:
+	case 487:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2999
+//line mysql_sql.y:3114
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 468:
+	case 488:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3003
+//line mysql_sql.y:3118
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 471:
+	case 491:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:3012
+//line mysql_sql.y:3127
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 472:
+	case 492:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:3016
+//line mysql_sql.y:3131
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstHaving, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 473:
+	case 493:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:3021
+//line mysql_sql.y:3136
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 474:
+	case 494:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:3025
+//line mysql_sql.y:3140
 		{
 			yyLOCAL = tree.GroupBy(yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 475:
+	case 495:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:3030
+//line mysql_sql.y:3145
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 476:
+	case 496:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:3034
+//line mysql_sql.y:3149
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstWhere, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 477:
+	case 497:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:3040
+//line mysql_sql.y:3155
 		{
 			yyLOCAL = tree.SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 478:
+	case 498:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:3044
+//line mysql_sql.y:3159
 		{
 			yyLOCAL = append(yyDollar[1].selectExprsUnion(), yyDollar[3].selectExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 479:
+	case 499:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:3050
+//line mysql_sql.y:3165
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.StarExpr()}
 		}
 		yyVAL.union = yyLOCAL
-	case 480:
+	case 500:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:3054
+//line mysql_sql.y:3169
 		{
 			yyLOCAL = tree.SelectExpr{Expr: yyDollar[1].exprUnion(), As: tree.UnrestrictedIdentifier(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 481:
+	case 501:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:3058
+//line mysql_sql.y:3173
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 482:
+	case 502:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:3062
+//line mysql_sql.y:3177
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[3].str, yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 483:
+	case 503:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:3067
+//line mysql_sql.y:3182
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			tn := tree.NewTableName(tree.Identifier(""), prefix)
@@ -9058,44 +9428,44 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 484:
+	case 504:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:3075
+//line mysql_sql.y:3190
 		{
 			yyLOCAL = yyDollar[1].fromUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 485:
+	case 505:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:3081
+//line mysql_sql.y:3196
 		{
 			yyLOCAL = &tree.From{
 				Tables: yyDollar[2].tableExprsUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 486:
+	case 506:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:3089
+//line mysql_sql.y:3204
 		{
 			yyLOCAL = tree.TableExprs{yyDollar[1].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 487:
+	case 507:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:3093
+//line mysql_sql.y:3208
 		{
 			yyLOCAL = append(yyDollar[1].tableExprsUnion(), yyDollar[3].tableExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 491:
+	case 511:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:3106
+//line mysql_sql.y:3221
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -9105,10 +9475,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 492:
+	case 512:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:3115
+//line mysql_sql.y:3230
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -9118,10 +9488,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 493:
+	case 513:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:3125
+//line mysql_sql.y:3240
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -9131,10 +9501,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 494:
+	case 514:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:3134
+//line mysql_sql.y:3249
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -9143,15 +9513,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 495:
+	case 515:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3144
+//line mysql_sql.y:3259
 		{
 			yyVAL.str = tree.JOIN_TYPE_NATURAL
 		}
-	case 496:
+	case 516:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3148
+//line mysql_sql.y:3263
 		{
 			if yyDollar[2].str == tree.JOIN_TYPE_LEFT {
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_LEFT
@@ -9159,130 +9529,130 @@ yydefault:
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_RIGHT
 			}
 		}
-	case 497:
+	case 517:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3158
+//line mysql_sql.y:3273
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 498:
+	case 518:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:3162
+//line mysql_sql.y:3277
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 499:
+	case 519:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3166
+//line mysql_sql.y:3281
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 500:
+	case 520:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:3170
+//line mysql_sql.y:3285
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 501:
+	case 521:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:3176
+//line mysql_sql.y:3291
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 502:
+	case 522:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:3180
+//line mysql_sql.y:3295
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 503:
+	case 523:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3186
+//line mysql_sql.y:3301
 		{
 			yyVAL.str = tree.JOIN_TYPE_STRAIGHT
 		}
-	case 504:
+	case 524:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3192
+//line mysql_sql.y:3307
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 505:
+	case 525:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3196
+//line mysql_sql.y:3311
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 506:
+	case 526:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3200
+//line mysql_sql.y:3315
 		{
 			yyVAL.str = tree.JOIN_TYPE_CROSS
 		}
-	case 507:
+	case 527:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:3206
+//line mysql_sql.y:3321
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 508:
+	case 528:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:3210
+//line mysql_sql.y:3325
 		{
 			yyLOCAL = yyDollar[1].joinCondUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 509:
+	case 529:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:3216
+//line mysql_sql.y:3331
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 510:
+	case 530:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:3220
+//line mysql_sql.y:3335
 		{
 			yyLOCAL = &tree.UsingJoinCond{Cols: yyDollar[3].identifierListUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 511:
+	case 531:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3226
+//line mysql_sql.y:3341
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 512:
+	case 532:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3230
+//line mysql_sql.y:3345
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 513:
+	case 533:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:3236
+//line mysql_sql.y:3351
 		{
 			yyLOCAL = yyDollar[1].aliasedTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 514:
+	case 534:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:3240
+//line mysql_sql.y:3355
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].parenTableExprUnion(),
@@ -9293,28 +9663,28 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 515:
+	case 535:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ParenTableExpr
-//line mysql_sql.y:3253
+//line mysql_sql.y:3368
 		{
 			yyLOCAL = &tree.ParenTableExpr{Expr: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 516:
+	case 536:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3258
+//line mysql_sql.y:3373
 		{
 		}
-	case 517:
+	case 537:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3259
+//line mysql_sql.y:3374
 		{
 		}
-	case 518:
+	case 538:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.AliasedTableExpr
-//line mysql_sql.y:3263
+//line mysql_sql.y:3378
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].tableNameUnion(),
@@ -9324,58 +9694,254 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 519:
+	case 539:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3275
+//line mysql_sql.y:3390
 		{
 			yyVAL.str = ""
 		}
-	case 520:
+	case 540:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3279
+//line mysql_sql.y:3394
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 521:
+	case 541:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3283
+//line mysql_sql.y:3398
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 524:
+	case 544:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3292
+//line mysql_sql.y:3407
 		{
 			yyVAL.str = ""
 		}
-	case 525:
+	case 545:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3296
+//line mysql_sql.y:3411
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 526:
+	case 546:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3300
+//line mysql_sql.y:3415
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 527:
+	case 547:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3304
+//line mysql_sql.y:3419
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 528:
+	case 548:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3308
+//line mysql_sql.y:3423
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 545:
+	case 567:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:3459
+		{
+			yyLOCAL = &tree.CreateSequence{IfNotExists: yyDollar[3].ifNotExistsUnion(), Name: yyDollar[4].tableNameUnion(), Options: yyDollar[5].sequenceOptionsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 568:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.SequenceOptions
+//line mysql_sql.y:3464
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 570:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.SequenceOptions
+//line mysql_sql.y:3471
+		{
+			yyLOCAL = tree.SequenceOptions{yyDollar[1].sequenceOptionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 571:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.SequenceOptions
+//line mysql_sql.y:3475
+		{
+			yyLOCAL = append(yyDollar[1].sequenceOptionsUnion(), yyDollar[2].sequenceOptionUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 572:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.SequenceOption
+//line mysql_sql.y:3481
+		{
+			yyLOCAL = &tree.SequenceOption{Type: tree.SequenceIncrement, Value: yyDollar[2].int64ValUnion(), HasValue: true}
+		}
+		yyVAL.union = yyLOCAL
+	case 573:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.SequenceOption
+//line mysql_sql.y:3485
+		{
+			yyLOCAL = &tree.SequenceOption{Type: tree.SequenceIncrement, Value: yyDollar[3].int64ValUnion(), HasValue: true}
+		}
+		yyVAL.union = yyLOCAL
+	case 574:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.SequenceOption
+//line mysql_sql.y:3489
+		{
+			yyLOCAL = &tree.SequenceOption{Type: tree.SequenceMinValue, Value: yyDollar[2].int64ValUnion(), HasValue: true}
+		}
+		yyVAL.union = yyLOCAL
+	case 575:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.SequenceOption
+//line mysql_sql.y:3493
+		{
+			yyLOCAL = &tree.SequenceOption{Type: tree.SequenceNoMinValue}
+		}
+		yyVAL.union = yyLOCAL
+	case 576:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.SequenceOption
+//line mysql_sql.y:3497
+		{
+			yyLOCAL = &tree.SequenceOption{Type: tree.SequenceMaxValue, Value: yyDollar[2].int64ValUnion(), HasValue: true}
+		}
+		yyVAL.union = yyLOCAL
+	case 577:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.SequenceOption
+//line mysql_sql.y:3501
+		{
+			yyLOCAL = &tree.SequenceOption{Type: tree.SequenceNoMaxValue}
+		}
+		yyVAL.union = yyLOCAL
+	case 578:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.SequenceOption
+//line mysql_sql.y:3505
+		{
+			yyLOCAL = &tree.SequenceOption{Type: tree.SequenceStart, Value: yyDollar[2].int64ValUnion(), HasValue: true}
+		}
+		yyVAL.union = yyLOCAL
+	case 579:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.SequenceOption
+//line mysql_sql.y:3509
+		{
+			yyLOCAL = &tree.SequenceOption{Type: tree.SequenceStart, Value: yyDollar[3].int64ValUnion(), HasValue: true}
+		}
+		yyVAL.union = yyLOCAL
+	case 580:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.SequenceOption
+//line mysql_sql.y:3513
+		{
+			yyLOCAL = &tree.SequenceOption{Type: tree.SequenceCache, Value: yyDollar[2].int64ValUnion(), HasValue: true}
+		}
+		yyVAL.union = yyLOCAL
+	case 581:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.SequenceOption
+//line mysql_sql.y:3517
+		{
+			yyLOCAL = &tree.SequenceOption{Type: tree.SequenceCycle}
+		}
+		yyVAL.union = yyLOCAL
+	case 582:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.SequenceOption
+//line mysql_sql.y:3521
+		{
+			yyLOCAL = &tree.SequenceOption{Type: tree.SequenceNoCycle}
+		}
+		yyVAL.union = yyLOCAL
+	case 583:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL int64
+//line mysql_sql.y:3527
+		{
+			ival, ok := yyDollar[1].item.(int64)
+			if !ok {
+				yylex.Error("sequence value is out of range of bigint")
+				return 1
+			}
+			yyLOCAL = ival
+		}
+		yyVAL.union = yyLOCAL
+	case 584:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL int64
+//line mysql_sql.y:3536
+		{
+			switch ival := yyDollar[2].item.(type) {
+			case int64:
+				yyLOCAL = -ival
+			case uint64:
+				if ival != 1<<63 {
+					yylex.Error("sequence value is out of range of bigint")
+					return 1
+				}
+				yyLOCAL = math.MinInt64
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 585:
+		yyDollar = yyS[yypt-11 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:3551
+		{
+			yyLOCAL = &tree.CreateFunction{
+				IfNotExists: yyDollar[3].ifNotExistsUnion(),
+				Name:        yyDollar[4].tableNameUnion(),
+				Args:        yyDollar[6].functionArgsUnion(),
+				ReturnType:  yyDollar[9].columnTypeUnion(),
+				Body:        yyDollar[11].exprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 586:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []*tree.FunctionArg
+//line mysql_sql.y:3562
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 588:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*tree.FunctionArg
+//line mysql_sql.y:3569
+		{
+			yyLOCAL = []*tree.FunctionArg{yyDollar[1].functionArgUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 589:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []*tree.FunctionArg
+//line mysql_sql.y:3573
+		{
+			yyLOCAL = append(yyDollar[1].functionArgsUnion(), yyDollar[3].functionArgUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 590:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FunctionArg
+//line mysql_sql.y:3579
+		{
+			yyLOCAL = &tree.FunctionArg{Name: tree.Identifier(yyDollar[1].str), Type: yyDollar[2].columnTypeUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 591:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3342
+//line mysql_sql.y:3585
 		{
 			yyLOCAL = &tree.CreateView{
 				Name:        yyDollar[5].tableNameUnion(),
@@ -9386,10 +9952,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 546:
+	case 592:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3352
+//line mysql_sql.y:3595
 		{
 			yyLOCAL = &tree.CreateView{
 				Name:        yyDollar[8].tableNameUnion(),
@@ -9400,15 +9966,29 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 547:
+	case 593:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:3605
+		{
+			yyLOCAL = &tree.CreateView{
+				Name:         yyDollar[5].tableNameUnion(),
+				ColNames:     yyDollar[6].identifierListUnion(),
+				AsSource:     yyDollar[8].selectUnion(),
+				IfNotExists:  yyDollar[4].ifNotExistsUnion(),
+				Materialized: true,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 594:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3363
+//line mysql_sql.y:3616
 		{
 		}
-	case 549:
+	case 596:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3368
+//line mysql_sql.y:3621
 		{
 			yyLOCAL = &tree.CreateAccount{
 				IfNotExists:  yyDollar[3].ifNotExistsUnion(),
@@ -9419,16 +9999,16 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 550:
+	case 597:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3380
+//line mysql_sql.y:3633
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 551:
+	case 598:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.AccountAuthOption
-//line mysql_sql.y:3386
+//line mysql_sql.y:3639
 		{
 			yyLOCAL = tree.AccountAuthOption{
 				Equal:          yyDollar[2].str,
@@ -9437,16 +10017,16 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 552:
+	case 599:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3396
+//line mysql_sql.y:3649
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 553:
+	case 600:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AccountIdentified
-//line mysql_sql.y:3402
+//line mysql_sql.y:3655
 		{
 			yyLOCAL = tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByPassword,
@@ -9454,20 +10034,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 554:
+	case 601:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.AccountIdentified
-//line mysql_sql.y:3409
+//line mysql_sql.y:3662
 		{
 			yyLOCAL = tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByRandomPassword,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 555:
+	case 602:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AccountIdentified
-//line mysql_sql.y:3415
+//line mysql_sql.y:3668
 		{
 			yyLOCAL = tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedWithSSL,
@@ -9475,20 +10055,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 556:
+	case 603:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.AccountStatus
-//line mysql_sql.y:3423
+//line mysql_sql.y:3676
 		{
 			yyLOCAL = tree.AccountStatus{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 557:
+	case 604:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AccountStatus
-//line mysql_sql.y:3429
+//line mysql_sql.y:3682
 		{
 			yyLOCAL = tree.AccountStatus{
 				Exist:  true,
@@ -9496,10 +10076,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 558:
+	case 605:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AccountStatus
-//line mysql_sql.y:3436
+//line mysql_sql.y:3689
 		{
 			yyLOCAL = tree.AccountStatus{
 				Exist:  true,
@@ -9507,20 +10087,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 559:
+	case 606:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.AccountComment
-//line mysql_sql.y:3444
+//line mysql_sql.y:3697
 		{
 			yyLOCAL = tree.AccountComment{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 560:
+	case 607:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AccountComment
-//line mysql_sql.y:3450
+//line mysql_sql.y:3703
 		{
 			yyLOCAL = tree.AccountComment{
 				Exist:   true,
@@ -9528,10 +10108,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 561:
+	case 608:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3459
+//line mysql_sql.y:3712
 		{
 			yyLOCAL = &tree.CreateUser{
 				IfNotExists:        yyDollar[3].ifNotExistsUnion(),
@@ -9542,26 +10122,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 562:
+	case 609:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3471
+//line mysql_sql.y:3724
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 563:
+	case 610:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.AccountCommentOrAttribute
-//line mysql_sql.y:3476
+//line mysql_sql.y:3729
 		{
 			yyLOCAL = tree.AccountCommentOrAttribute{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 564:
+	case 611:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AccountCommentOrAttribute
-//line mysql_sql.y:3482
+//line mysql_sql.y:3735
 		{
 			yyLOCAL = tree.AccountCommentOrAttribute{
 				Exist:     true,
@@ -9570,10 +10150,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 565:
+	case 612:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AccountCommentOrAttribute
-//line mysql_sql.y:3490
+//line mysql_sql.y:3743
 		{
 			yyLOCAL = tree.AccountCommentOrAttribute{
 				Exist:     true,
@@ -9582,26 +10162,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 566:
+	case 613:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:3596
+//line mysql_sql.y:3849
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 567:
+	case 614:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:3600
+//line mysql_sql.y:3853
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 568:
+	case 615:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:3606
+//line mysql_sql.y:3859
 		{
 			yyLOCAL = &tree.User{
 				Username:   yyDollar[1].usernameRecordUnion().Username,
@@ -9610,26 +10190,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 569:
+	case 616:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:3616
+//line mysql_sql.y:3869
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 570:
+	case 617:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:3620
+//line mysql_sql.y:3873
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 571:
+	case 618:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:3626
+//line mysql_sql.y:3879
 		{
 			yyLOCAL = &tree.User{
 				Username:   yyDollar[1].usernameRecordUnion().Username,
@@ -9638,50 +10218,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 572:
+	case 619:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:3636
+//line mysql_sql.y:3889
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 573:
+	case 620:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:3640
+//line mysql_sql.y:3893
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 574:
+	case 621:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:3644
+//line mysql_sql.y:3897
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 575:
+	case 622:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:3649
+//line mysql_sql.y:3902
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 576:
+	case 623:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:3653
+//line mysql_sql.y:3906
 		{
 			yyLOCAL = yyDollar[1].userIdentifiedUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 577:
+	case 624:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:3659
+//line mysql_sql.y:3912
 		{
 			yyLOCAL = &tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByPassword,
@@ -9689,20 +10269,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 578:
+	case 625:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:3666
+//line mysql_sql.y:3919
 		{
 			yyLOCAL = &tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByRandomPassword,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 579:
+	case 626:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:3672
+//line mysql_sql.y:3925
 		{
 			yyLOCAL = &tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedWithSSL,
@@ -9710,10 +10290,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 582:
+	case 629:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3685
+//line mysql_sql.y:3938
 		{
 			yyLOCAL = &tree.CreateRole{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -9721,66 +10301,66 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 583:
+	case 630:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:3694
+//line mysql_sql.y:3947
 		{
 			yyLOCAL = []*tree.Role{yyDollar[1].roleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 584:
+	case 631:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:3698
+//line mysql_sql.y:3951
 		{
 			yyLOCAL = append(yyDollar[1].rolesUnion(), yyDollar[3].roleUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 585:
+	case 632:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:3704
+//line mysql_sql.y:3957
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 588:
+	case 635:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3721
+//line mysql_sql.y:3974
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 589:
+	case 636:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3725
+//line mysql_sql.y:3978
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_FULLTEXT
 		}
 		yyVAL.union = yyLOCAL
-	case 590:
+	case 637:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3729
+//line mysql_sql.y:3982
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_SPATIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 591:
+	case 638:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3733
+//line mysql_sql.y:3986
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_UNIQUE
 		}
 		yyVAL.union = yyLOCAL
-	case 592:
+	case 639:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3739
+//line mysql_sql.y:3992
 		{
 			var io *tree.IndexOption = nil
 			if yyDollar[11].indexOptionUnion() == nil && yyDollar[5].indexTypeUnion() != tree.INDEX_TYPE_INVALID {
@@ -9799,18 +10379,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 593:
+	case 640:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3758
+//line mysql_sql.y:4011
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 594:
+	case 641:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3762
+//line mysql_sql.y:4015
 		{
 			// Merge the options
 			if yyDollar[1].indexOptionUnion() == nil {
@@ -9831,123 +10411,123 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 595:
+	case 642:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3784
+//line mysql_sql.y:4037
 		{
 			yyLOCAL = &tree.IndexOption{KeyBlockSize: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 596:
+	case 643:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3788
+//line mysql_sql.y:4041
 		{
 			yyLOCAL = &tree.IndexOption{Comment: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 597:
+	case 644:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3792
+//line mysql_sql.y:4045
 		{
 			yyLOCAL = &tree.IndexOption{ParserName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 598:
+	case 645:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3796
+//line mysql_sql.y:4049
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_VISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 599:
+	case 646:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3800
+//line mysql_sql.y:4053
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_INVISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 600:
+	case 647:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:3806
+//line mysql_sql.y:4059
 		{
 			yyLOCAL = []*tree.KeyPart{yyDollar[1].keyPartUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 601:
+	case 648:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:3810
+//line mysql_sql.y:4063
 		{
 			yyLOCAL = append(yyDollar[1].keyPartsUnion(), yyDollar[3].keyPartUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 602:
+	case 649:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:3816
+//line mysql_sql.y:4069
 		{
 			// Order is parsed but just ignored as MySQL did.
 			yyLOCAL = &tree.KeyPart{ColName: yyDollar[1].unresolvedNameUnion(), Length: int(yyDollar[2].lengthOptUnion()), Direction: yyDollar[3].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 603:
+	case 650:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:3821
+//line mysql_sql.y:4074
 		{
 			yyLOCAL = &tree.KeyPart{Expr: yyDollar[2].exprUnion(), Direction: yyDollar[4].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 604:
+	case 651:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3826
+//line mysql_sql.y:4079
 		{
 			yyLOCAL = tree.INDEX_TYPE_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 605:
+	case 652:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3830
+//line mysql_sql.y:4083
 		{
 			yyLOCAL = tree.INDEX_TYPE_BTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 606:
+	case 653:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3834
+//line mysql_sql.y:4087
 		{
 			yyLOCAL = tree.INDEX_TYPE_HASH
 		}
 		yyVAL.union = yyLOCAL
-	case 607:
+	case 654:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3838
+//line mysql_sql.y:4091
 		{
 			yyLOCAL = tree.INDEX_TYPE_RTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 608:
+	case 655:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3842
+//line mysql_sql.y:4095
 		{
 			yyLOCAL = tree.INDEX_TYPE_BSI
 		}
 		yyVAL.union = yyLOCAL
-	case 609:
+	case 656:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3848
+//line mysql_sql.y:4101
 		{
 			yyLOCAL = &tree.CreateDatabase{
 				IfNotExists:   yyDollar[3].ifNotExistsUnion(),
@@ -9956,98 +10536,98 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 612:
+	case 659:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3862
+//line mysql_sql.y:4115
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 613:
+	case 660:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3866
+//line mysql_sql.y:4119
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 614:
+	case 661:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3871
+//line mysql_sql.y:4124
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 615:
+	case 662:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3875
+//line mysql_sql.y:4128
 		{
 			yyLOCAL = yyDollar[1].createOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 616:
+	case 663:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3881
+//line mysql_sql.y:4134
 		{
 			yyLOCAL = []tree.CreateOption{yyDollar[1].createOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 617:
+	case 664:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3885
+//line mysql_sql.y:4138
 		{
 			yyLOCAL = append(yyDollar[1].createOptionsUnion(), yyDollar[2].createOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 618:
+	case 665:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3891
+//line mysql_sql.y:4144
 		{
 			yyLOCAL = &tree.CreateOptionCharset{IsDefault: yyDollar[1].defaultOptionalUnion(), Charset: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 619:
+	case 666:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3895
+//line mysql_sql.y:4148
 		{
 			yyLOCAL = &tree.CreateOptionCollate{IsDefault: yyDollar[1].defaultOptionalUnion(), Collate: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 620:
+	case 667:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3899
+//line mysql_sql.y:4152
 		{
 			yyLOCAL = &tree.CreateOptionEncryption{Encrypt: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 621:
+	case 668:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3904
+//line mysql_sql.y:4157
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 622:
+	case 669:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3908
+//line mysql_sql.y:4161
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 623:
+	case 670:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3914
+//line mysql_sql.y:4167
 		{
 			yyLOCAL = &tree.CreateTable{
 				Temporary:       yyDollar[2].boolValUnion(),
@@ -10059,10 +10639,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 624:
+	case 671:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3925
+//line mysql_sql.y:4178
 		{
 			yyLOCAL = &tree.CreateTable{
 				IfNotExists: yyDollar[4].ifNotExistsUnion(),
@@ -10072,19 +10652,72 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 625:
+	case 672:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:3936
+//line mysql_sql.y:4189
 		{
 			yyLOCAL = yyDollar[1].loadParamUnion()
 			yyLOCAL.Tail = yyDollar[2].tailParamUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 626:
+	case 673:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.ExternParam
+//line mysql_sql.y:4196
+		{
+			yyLOCAL = yyDollar[1].loadParamUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 674:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.ExternParam
+//line mysql_sql.y:4200
+		{
+			yyLOCAL = yyDollar[1].loadParamUnion()
+			yyLOCAL.Format = strings.ToLower(yyDollar[3].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 675:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.ExternParam
+//line mysql_sql.y:4205
+		{
+			yyLOCAL = yyDollar[1].loadParamUnion()
+			yyLOCAL.Format = strings.ToLower(yyDollar[3].str)
+			yyLOCAL.JsonPaths = yyDollar[5].loadParamUnion().JsonPaths
+			yyLOCAL.MaxErrors = yyDollar[5].loadParamUnion().MaxErrors
+			yyLOCAL.KeepOrder = yyDollar[5].loadParamUnion().KeepOrder
+		}
+		yyVAL.union = yyLOCAL
+	case 676:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.ExternParam
+//line mysql_sql.y:4215
+		{
+			yyLOCAL = &tree.ExternParam{}
+			if err := yyLOCAL.SetFormatOption(yyDollar[1].str, yyDollar[3].str); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 677:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.ExternParam
+//line mysql_sql.y:4223
+		{
+			yyLOCAL = yyDollar[1].loadParamUnion()
+			if err := yyLOCAL.SetFormatOption(yyDollar[3].str, yyDollar[5].str); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 678:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:3943
+//line mysql_sql.y:4233
 		{
 			yyLOCAL = &tree.ExternParam{
 				Filepath:     yyDollar[2].str,
@@ -10092,10 +10725,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 627:
+	case 679:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:3950
+//line mysql_sql.y:4240
 		{
 			if strings.ToLower(yyDollar[3].str) != "filepath" {
 				yylex.Error(fmt.Sprintf("can not recognize the '%s'", yyDollar[3].str))
@@ -10107,10 +10740,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 628:
+	case 680:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:3961
+//line mysql_sql.y:4251
 		{
 			if strings.ToLower(yyDollar[3].str) != "filepath" || strings.ToLower(yyDollar[7].str) != "compression" {
 				yylex.Error(fmt.Sprintf("can not recognize the '%s' or '%s' ", yyDollar[3].str, yyDollar[7].str))
@@ -10122,10 +10755,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 629:
+	case 681:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.TailParameter
-//line mysql_sql.y:3974
+//line mysql_sql.y:4264
 		{
 			yyLOCAL = &tree.TailParameter{
 				Fields:       yyDollar[1].fieldsUnion(),
@@ -10136,34 +10769,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 630:
+	case 682:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3985
+//line mysql_sql.y:4275
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 631:
+	case 683:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3989
+//line mysql_sql.y:4279
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 632:
+	case 684:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:3994
+//line mysql_sql.y:4284
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 633:
+	case 685:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:3998
+//line mysql_sql.y:4288
 		{
 			yyDollar[3].partitionByUnion().Num = uint64(yyDollar[4].int64ValUnion())
 			yyLOCAL = &tree.PartitionOption{
@@ -10173,18 +10806,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 634:
+	case 686:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:4008
+//line mysql_sql.y:4298
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 635:
+	case 687:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:4012
+//line mysql_sql.y:4302
 		{
 			yyLOCAL = &tree.PartitionBy{
 				IsSubPartition: true,
@@ -10193,42 +10826,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 636:
+	case 688:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:4021
+//line mysql_sql.y:4311
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 637:
+	case 689:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:4025
+//line mysql_sql.y:4315
 		{
 			yyLOCAL = yyDollar[2].partitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 638:
+	case 690:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:4031
+//line mysql_sql.y:4321
 		{
 			yyLOCAL = []*tree.Partition{yyDollar[1].partitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 639:
+	case 691:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:4035
+//line mysql_sql.y:4325
 		{
 			yyLOCAL = append(yyDollar[1].partitionsUnion(), yyDollar[3].partitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 640:
+	case 692:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:4041
+//line mysql_sql.y:4331
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -10238,10 +10871,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 641:
+	case 693:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:4050
+//line mysql_sql.y:4340
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -10251,42 +10884,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 642:
+	case 694:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:4060
+//line mysql_sql.y:4350
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 643:
+	case 695:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:4064
+//line mysql_sql.y:4354
 		{
 			yyLOCAL = yyDollar[2].subPartitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 644:
+	case 696:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:4070
+//line mysql_sql.y:4360
 		{
 			yyLOCAL = []*tree.SubPartition{yyDollar[1].subPartitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 645:
+	case 697:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:4074
+//line mysql_sql.y:4364
 		{
 			yyLOCAL = append(yyDollar[1].subPartitionsUnion(), yyDollar[3].subPartitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 646:
+	case 698:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:4080
+//line mysql_sql.y:4370
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -10294,10 +10927,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 647:
+	case 699:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:4087
+//line mysql_sql.y:4377
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -10305,67 +10938,67 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 648:
+	case 700:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:4096
+//line mysql_sql.y:4386
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 649:
+	case 701:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:4100
+//line mysql_sql.y:4390
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 650:
+	case 702:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:4105
+//line mysql_sql.y:4395
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 651:
+	case 703:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:4109
+//line mysql_sql.y:4399
 		{
 			expr := tree.NewMaxValue()
 			yyLOCAL = &tree.ValuesLessThan{ValueList: tree.Exprs{expr}}
 		}
 		yyVAL.union = yyLOCAL
-	case 652:
+	case 704:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:4114
+//line mysql_sql.y:4404
 		{
 			yyLOCAL = &tree.ValuesLessThan{ValueList: yyDollar[5].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 653:
+	case 705:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:4118
+//line mysql_sql.y:4408
 		{
 			yyLOCAL = &tree.ValuesIn{ValueList: yyDollar[4].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 654:
+	case 706:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4123
+//line mysql_sql.y:4413
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 655:
+	case 707:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4127
+//line mysql_sql.y:4417
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -10375,18 +11008,18 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 656:
+	case 708:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4137
+//line mysql_sql.y:4427
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 657:
+	case 709:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4141
+//line mysql_sql.y:4431
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -10396,10 +11029,10 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 658:
+	case 710:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:4152
+//line mysql_sql.y:4442
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -10408,10 +11041,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 659:
+	case 711:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:4160
+//line mysql_sql.y:4450
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -10420,10 +11053,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 660:
+	case 712:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:4168
+//line mysql_sql.y:4458
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -10432,10 +11065,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 661:
+	case 713:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:4176
+//line mysql_sql.y:4466
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -10444,10 +11077,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 663:
+	case 715:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:4187
+//line mysql_sql.y:4477
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.KeyType{
@@ -10458,10 +11091,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 664:
+	case 716:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:4197
+//line mysql_sql.y:4487
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.HashType{
@@ -10471,478 +11104,478 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 665:
+	case 717:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4207
+//line mysql_sql.y:4497
 		{
 			yyLOCAL = 2
 		}
 		yyVAL.union = yyLOCAL
-	case 666:
+	case 718:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4211
+//line mysql_sql.y:4501
 		{
 			yyLOCAL = yyDollar[3].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 667:
+	case 719:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4216
+//line mysql_sql.y:4506
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 668:
+	case 720:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4220
+//line mysql_sql.y:4510
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 669:
+	case 721:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:4225
+//line mysql_sql.y:4515
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 670:
+	case 722:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:4229
+//line mysql_sql.y:4519
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 671:
+	case 723:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:4235
+//line mysql_sql.y:4525
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 672:
+	case 724:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:4239
+//line mysql_sql.y:4529
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[3].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 673:
+	case 725:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:4243
+//line mysql_sql.y:4533
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 726:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4249
+//line mysql_sql.y:4539
 		{
 			yyLOCAL = tree.NewTableOptionAutoIncrement(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 727:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4253
+//line mysql_sql.y:4543
 		{
 			yyLOCAL = tree.NewTableOptionAvgRowLength(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 676:
+	case 728:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4257
+//line mysql_sql.y:4547
 		{
 			yyLOCAL = tree.NewTableOptionCharset(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 677:
+	case 729:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4261
+//line mysql_sql.y:4551
 		{
 			yyLOCAL = tree.NewTableOptionCollate(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 678:
+	case 730:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4265
+//line mysql_sql.y:4555
 		{
 			yyLOCAL = tree.NewTableOptionChecksum(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 679:
+	case 731:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4269
+//line mysql_sql.y:4559
 		{
 			yyLOCAL = tree.NewTableOptionComment(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 680:
+	case 732:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4273
+//line mysql_sql.y:4563
 		{
 			yyLOCAL = tree.NewTableOptionCompression(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 681:
+	case 733:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4277
+//line mysql_sql.y:4567
 		{
 			yyLOCAL = tree.NewTableOptionConnection(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 682:
+	case 734:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4281
+//line mysql_sql.y:4571
 		{
 			yyLOCAL = tree.NewTableOptionDataDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 683:
+	case 735:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4285
+//line mysql_sql.y:4575
 		{
 			yyLOCAL = tree.NewTableOptionIndexDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 684:
+	case 736:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4289
+//line mysql_sql.y:4579
 		{
 			yyLOCAL = tree.NewTableOptionDelayKeyWrite(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 685:
+	case 737:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4293
+//line mysql_sql.y:4583
 		{
 			yyLOCAL = tree.NewTableOptionEncryption(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 686:
+	case 738:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4297
+//line mysql_sql.y:4587
 		{
 			yyLOCAL = tree.NewTableOptionEngine(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 687:
+	case 739:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4301
+//line mysql_sql.y:4591
 		{
 			yyLOCAL = tree.NewTableOptionKeyBlockSize(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 688:
+	case 740:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4305
+//line mysql_sql.y:4595
 		{
 			yyLOCAL = tree.NewTableOptionMaxRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 689:
+	case 741:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4309
+//line mysql_sql.y:4599
 		{
 			yyLOCAL = tree.NewTableOptionMinRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 690:
+	case 742:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4313
+//line mysql_sql.y:4603
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 691:
+	case 743:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4317
+//line mysql_sql.y:4607
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 692:
+	case 744:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4321
+//line mysql_sql.y:4611
 		{
 			yyLOCAL = tree.NewTableOptionPassword(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 693:
+	case 745:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4325
+//line mysql_sql.y:4615
 		{
 			yyLOCAL = tree.NewTableOptionRowFormat(yyDollar[3].rowFormatTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 694:
+	case 746:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4329
+//line mysql_sql.y:4619
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 695:
+	case 747:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4333
+//line mysql_sql.y:4623
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 696:
+	case 748:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4337
+//line mysql_sql.y:4627
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 697:
+	case 749:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4341
+//line mysql_sql.y:4631
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 698:
+	case 750:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4345
+//line mysql_sql.y:4635
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 699:
+	case 751:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4349
+//line mysql_sql.y:4639
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 700:
+	case 752:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4353
+//line mysql_sql.y:4643
 		{
 			yyLOCAL = tree.NewTableOptionTablespace(yyDollar[3].str, yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 701:
+	case 753:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4357
+//line mysql_sql.y:4647
 		{
 			yyLOCAL = tree.NewTableOptionUnion(yyDollar[4].tableNamesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 702:
+	case 754:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:4361
+//line mysql_sql.y:4651
 		{
 			yyLOCAL = &tree.TableOptionProperties{Preperties: yyDollar[3].propertiesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 703:
+	case 755:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:4368
+//line mysql_sql.y:4658
 		{
 			yyLOCAL = []tree.Property{yyDollar[1].propertyUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 704:
+	case 756:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:4372
+//line mysql_sql.y:4662
 		{
 			yyLOCAL = append(yyDollar[1].propertiesUnion(), yyDollar[3].propertyUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 705:
+	case 757:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Property
-//line mysql_sql.y:4378
+//line mysql_sql.y:4668
 		{
 			yyLOCAL = tree.Property{Key: yyDollar[1].str, Value: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 706:
+	case 758:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4383
+//line mysql_sql.y:4673
 		{
 			yyVAL.str = ""
 		}
-	case 707:
+	case 759:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4387
+//line mysql_sql.y:4677
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 708:
+	case 760:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4391
+//line mysql_sql.y:4681
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 709:
+	case 761:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:4397
+//line mysql_sql.y:4687
 		{
 			yyLOCAL = tree.ROW_FORMAT_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 710:
+	case 762:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:4401
+//line mysql_sql.y:4691
 		{
 			yyLOCAL = tree.ROW_FORMAT_DYNAMIC
 		}
 		yyVAL.union = yyLOCAL
-	case 711:
+	case 763:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:4405
+//line mysql_sql.y:4695
 		{
 			yyLOCAL = tree.ROW_FORMAT_FIXED
 		}
 		yyVAL.union = yyLOCAL
-	case 712:
+	case 764:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:4409
+//line mysql_sql.y:4699
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPRESSED
 		}
 		yyVAL.union = yyLOCAL
-	case 713:
+	case 765:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:4413
+//line mysql_sql.y:4703
 		{
 			yyLOCAL = tree.ROW_FORMAT_REDUNDANT
 		}
 		yyVAL.union = yyLOCAL
-	case 714:
+	case 766:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:4417
+//line mysql_sql.y:4707
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPACT
 		}
 		yyVAL.union = yyLOCAL
-	case 719:
+	case 771:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:4431
+//line mysql_sql.y:4721
 		{
 			yyLOCAL = tree.TableNames{yyDollar[1].tableNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 720:
+	case 772:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:4435
+//line mysql_sql.y:4725
 		{
 			yyLOCAL = append(yyDollar[1].tableNamesUnion(), yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 721:
+	case 773:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:4444
+//line mysql_sql.y:4734
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 722:
+	case 774:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:4449
+//line mysql_sql.y:4739
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].str), ExplicitSchema: true}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 723:
+	case 775:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:4455
+//line mysql_sql.y:4745
 		{
 			yyLOCAL = tree.TableDefs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 725:
+	case 777:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:4462
+//line mysql_sql.y:4752
 		{
 			yyLOCAL = tree.TableDefs{yyDollar[1].tableDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 726:
+	case 778:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:4466
+//line mysql_sql.y:4756
 		{
 			yyLOCAL = append(yyDollar[1].tableDefsUnion(), yyDollar[3].tableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 727:
+	case 779:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:4472
+//line mysql_sql.y:4762
 		{
 			yyLOCAL = tree.TableDef(yyDollar[1].columnTableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 728:
+	case 780:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:4476
+//line mysql_sql.y:4766
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 729:
+	case 781:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:4482
+//line mysql_sql.y:4772
 		{
 			if yyDollar[1].str != "" {
 				switch v := yyDollar[2].tableDefUnion().(type) {
@@ -10953,18 +11586,18 @@ yydefault:
 			yyLOCAL = yyDollar[2].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 730:
+	case 782:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:4492
+//line mysql_sql.y:4782
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 731:
+	case 783:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:4498
+//line mysql_sql.y:4788
 		{
 			yyLOCAL = &tree.PrimaryKeyIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -10974,10 +11607,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 732:
+	case 784:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:4507
+//line mysql_sql.y:4797
 		{
 			yyLOCAL = &tree.FullTextIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -10987,10 +11620,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 733:
+	case 785:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:4516
+//line mysql_sql.y:4806
 		{
 			keyTyp := tree.INDEX_TYPE_INVALID
 			if yyDollar[3].strsUnion()[1] != "" {
@@ -11014,10 +11647,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 734:
+	case 786:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:4539
+//line mysql_sql.y:4829
 		{
 			yyLOCAL = &tree.UniqueIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -11027,10 +11660,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 735:
+	case 787:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:4548
+//line mysql_sql.y:4838
 		{
 			yyLOCAL = &tree.ForeignKey{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -11041,10 +11674,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 736:
+	case 788:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:4558
+//line mysql_sql.y:4848
 		{
 			yyLOCAL = &tree.CheckIndex{
 				Expr:     yyDollar[3].exprUnion(),
@@ -11052,298 +11685,338 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 737:
+	case 789:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4566
+//line mysql_sql.y:4856
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 739:
+	case 791:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4572
+//line mysql_sql.y:4862
 		{
 			yyVAL.str = ""
 		}
-	case 740:
+	case 792:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4576
+//line mysql_sql.y:4866
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 743:
+	case 795:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4586
+//line mysql_sql.y:4876
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = ""
 		}
 		yyVAL.union = yyLOCAL
-	case 744:
+	case 796:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4592
+//line mysql_sql.y:4882
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 745:
+	case 797:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4598
+//line mysql_sql.y:4888
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 751:
+	case 803:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4612
+//line mysql_sql.y:4902
 		{
 			yyVAL.str = ""
 		}
-	case 753:
+	case 805:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ColumnTableDef
-//line mysql_sql.y:4619
+//line mysql_sql.y:4909
 		{
 			yyLOCAL = tree.NewColumnTableDef(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), yyDollar[3].columnAttributesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 754:
+	case 806:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:4625
+//line mysql_sql.y:4915
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 755:
+	case 807:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:4629
+//line mysql_sql.y:4919
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 756:
+	case 808:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:4633
+//line mysql_sql.y:4923
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 760:
+	case 812:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:4644
+//line mysql_sql.y:4934
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 761:
+	case 813:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:4648
+//line mysql_sql.y:4938
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 762:
+	case 814:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:4652
+//line mysql_sql.y:4942
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 763:
+	case 815:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:4657
+//line mysql_sql.y:4947
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 764:
+	case 816:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:4661
+//line mysql_sql.y:4951
 		{
 			yyLOCAL = yyDollar[1].columnAttributesUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 765:
+	case 817:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:4667
+//line mysql_sql.y:4957
 		{
 			yyLOCAL = []tree.ColumnAttribute{yyDollar[1].columnAttributeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 766:
+	case 818:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:4671
+//line mysql_sql.y:4961
 		{
 			yyLOCAL = append(yyDollar[1].columnAttributesUnion(), yyDollar[2].columnAttributeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 767:
+	case 819:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4677
+//line mysql_sql.y:4967
 		{
 			yyLOCAL = tree.NewAttributeNull(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 768:
+	case 820:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4681
+//line mysql_sql.y:4971
 		{
 			yyLOCAL = tree.NewAttributeNull(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 769:
+	case 821:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4685
+//line mysql_sql.y:4975
 		{
 			yyLOCAL = tree.NewAttributeDefault(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 770:
+	case 822:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4689
+//line mysql_sql.y:4979
 		{
 			yyLOCAL = tree.NewAttributeAutoIncrement()
 		}
 		yyVAL.union = yyLOCAL
-	case 771:
+	case 823:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4693
+//line mysql_sql.y:4983
 		{
 			yyLOCAL = yyDollar[1].columnAttributeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 772:
+	case 824:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4697
+//line mysql_sql.y:4987
 		{
 			yyLOCAL = tree.NewAttributeComment(tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char))
 		}
 		yyVAL.union = yyLOCAL
-	case 773:
+	case 825:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4701
+//line mysql_sql.y:4991
 		{
 			yyLOCAL = tree.NewAttributeCollate(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 774:
+	case 826:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4705
+//line mysql_sql.y:4995
 		{
 			yyLOCAL = tree.NewAttributeColumnFormat(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 775:
+	case 827:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4709
+//line mysql_sql.y:4999
 		{
 			yyLOCAL = tree.NewAttributeStorage(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 776:
+	case 828:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4713
+//line mysql_sql.y:5003
 		{
 			yyLOCAL = tree.NewAttributeAutoRandom(int(yyDollar[2].int64ValUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 777:
+	case 829:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4717
+//line mysql_sql.y:5007
 		{
 			yyLOCAL = yyDollar[1].attributeReferenceUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 778:
+	case 830:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4721
+//line mysql_sql.y:5011
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), false, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 779:
+	case 831:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4725
+//line mysql_sql.y:5015
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), yyDollar[6].boolValUnion(), yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 780:
+	case 832:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line mysql_sql.y:5019
+		{
+			yyLOCAL = tree.NewAttributeGeneratedAlways(yyDollar[5].exprUnion(), yyDollar[7].boolValUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 833:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line mysql_sql.y:5023
+		{
+			yyLOCAL = tree.NewAttributeGeneratedAlways(yyDollar[3].exprUnion(), yyDollar[5].boolValUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 834:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:5032
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 835:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4735
+//line mysql_sql.y:5036
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 836:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:5040
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 781:
+	case 837:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:5046
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 838:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4739
+//line mysql_sql.y:5050
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 782:
+	case 839:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4744
+//line mysql_sql.y:5055
 		{
 			yyVAL.str = ""
 		}
-	case 783:
+	case 840:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4748
+//line mysql_sql.y:5059
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 784:
+	case 841:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4754
+//line mysql_sql.y:5065
 		{
 			yyVAL.str = ""
 		}
-	case 785:
+	case 842:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4758
+//line mysql_sql.y:5069
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 786:
+	case 843:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AttributeReference
-//line mysql_sql.y:4764
+//line mysql_sql.y:5075
 		{
 			yyLOCAL = &tree.AttributeReference{
 				TableName: yyDollar[2].tableNameUnion(),
@@ -11354,10 +12027,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 787:
+	case 844:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4775
+//line mysql_sql.y:5086
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -11365,10 +12038,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 789:
+	case 846:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4785
+//line mysql_sql.y:5096
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -11376,10 +12049,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 790:
+	case 847:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4792
+//line mysql_sql.y:5103
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -11387,10 +12060,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 791:
+	case 848:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4799
+//line mysql_sql.y:5110
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -11398,10 +12071,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 792:
+	case 849:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4806
+//line mysql_sql.y:5117
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[2].referenceOptionTypeUnion(),
@@ -11409,339 +12082,339 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 793:
+	case 850:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4815
+//line mysql_sql.y:5126
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 794:
+	case 851:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4821
+//line mysql_sql.y:5132
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 795:
+	case 852:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4827
+//line mysql_sql.y:5138
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_RESTRICT
 		}
 		yyVAL.union = yyLOCAL
-	case 796:
+	case 853:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4831
+//line mysql_sql.y:5142
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_CASCADE
 		}
 		yyVAL.union = yyLOCAL
-	case 797:
+	case 854:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4835
+//line mysql_sql.y:5146
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_NULL
 		}
 		yyVAL.union = yyLOCAL
-	case 798:
+	case 855:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4839
+//line mysql_sql.y:5150
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_NO_ACTION
 		}
 		yyVAL.union = yyLOCAL
-	case 799:
+	case 856:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4843
+//line mysql_sql.y:5154
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 800:
+	case 857:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4848
+//line mysql_sql.y:5159
 		{
 			yyLOCAL = tree.MATCH_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 802:
+	case 859:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4855
+//line mysql_sql.y:5166
 		{
 			yyLOCAL = tree.MATCH_FULL
 		}
 		yyVAL.union = yyLOCAL
-	case 803:
+	case 860:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4859
+//line mysql_sql.y:5170
 		{
 			yyLOCAL = tree.MATCH_PARTIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 804:
+	case 861:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4863
+//line mysql_sql.y:5174
 		{
 			yyLOCAL = tree.MATCH_SIMPLE
 		}
 		yyVAL.union = yyLOCAL
-	case 805:
+	case 862:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:4868
+//line mysql_sql.y:5179
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 806:
+	case 863:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:4872
+//line mysql_sql.y:5183
 		{
 			yyLOCAL = yyDollar[2].keyPartsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 807:
+	case 864:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4877
+//line mysql_sql.y:5188
 		{
 			yyLOCAL = -1
 		}
 		yyVAL.union = yyLOCAL
-	case 808:
+	case 865:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4881
+//line mysql_sql.y:5192
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 815:
+	case 872:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Subquery
-//line mysql_sql.y:4897
+//line mysql_sql.y:5208
 		{
 			yyLOCAL = &tree.Subquery{Select: yyDollar[1].selectStatementUnion(), Exists: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 816:
+	case 873:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4903
+//line mysql_sql.y:5214
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_AND, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 817:
+	case 874:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4907
+//line mysql_sql.y:5218
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_OR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 818:
+	case 875:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4911
+//line mysql_sql.y:5222
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_XOR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 819:
+	case 876:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4915
+//line mysql_sql.y:5226
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.PLUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 820:
+	case 877:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4919
+//line mysql_sql.y:5230
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MINUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 821:
+	case 878:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4923
+//line mysql_sql.y:5234
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MULTI, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 822:
+	case 879:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4927
+//line mysql_sql.y:5238
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 823:
+	case 880:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4931
+//line mysql_sql.y:5242
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.INTEGER_DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 824:
+	case 881:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4935
+//line mysql_sql.y:5246
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 825:
+	case 882:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4939
+//line mysql_sql.y:5250
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 826:
+	case 883:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4943
+//line mysql_sql.y:5254
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.LEFT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 827:
+	case 884:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4947
+//line mysql_sql.y:5258
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.RIGHT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 828:
+	case 885:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4951
+//line mysql_sql.y:5262
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 829:
+	case 886:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4957
+//line mysql_sql.y:5268
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 830:
+	case 887:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4961
+//line mysql_sql.y:5272
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 831:
+	case 888:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4965
+//line mysql_sql.y:5276
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 832:
+	case 889:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4969
+//line mysql_sql.y:5280
 		{
 			yyLOCAL = tree.NewParenExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 833:
+	case 890:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4973
+//line mysql_sql.y:5284
 		{
 			yyLOCAL = tree.NewTuple(append(yyDollar[2].exprsUnion(), yyDollar[4].exprUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 834:
+	case 891:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4977
+//line mysql_sql.y:5288
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_PLUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 835:
+	case 892:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4981
+//line mysql_sql.y:5292
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MINUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 836:
+	case 893:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4985
+//line mysql_sql.y:5296
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_TILDE, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 837:
+	case 894:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4989
+//line mysql_sql.y:5300
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MARK, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 838:
+	case 895:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4993
+//line mysql_sql.y:5304
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 839:
+	case 896:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4997
+//line mysql_sql.y:5308
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 840:
+	case 897:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5001
+//line mysql_sql.y:5312
 		{
 			yyDollar[2].subqueryUnion().Exists = true
 			yyLOCAL = yyDollar[2].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 841:
+	case 898:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5006
+//line mysql_sql.y:5317
 		{
 			yyLOCAL = &tree.CaseExpr{
 				Expr:  yyDollar[2].exprUnion(),
@@ -11750,26 +12423,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 842:
+	case 899:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5014
+//line mysql_sql.y:5325
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 843:
+	case 900:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5018
+//line mysql_sql.y:5329
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 844:
+	case 901:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5022
+//line mysql_sql.y:5333
 		{
 			name := tree.SetUnresolvedName("convert")
 			es := tree.NewNumValWithType(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false, tree.P_char)
@@ -11779,98 +12452,98 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 845:
+	case 902:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5031
+//line mysql_sql.y:5342
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 846:
+	case 903:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5035
+//line mysql_sql.y:5346
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 847:
+	case 904:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5039
+//line mysql_sql.y:5350
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 848:
+	case 905:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5043
+//line mysql_sql.y:5354
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 849:
+	case 906:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5047
+//line mysql_sql.y:5358
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 850:
+	case 907:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5052
+//line mysql_sql.y:5363
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 851:
+	case 908:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5056
+//line mysql_sql.y:5367
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 852:
+	case 909:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5061
+//line mysql_sql.y:5372
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 853:
+	case 910:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5065
+//line mysql_sql.y:5376
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 854:
+	case 911:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:5071
+//line mysql_sql.y:5382
 		{
 			yyLOCAL = []*tree.When{yyDollar[1].whenClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 855:
+	case 912:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:5075
+//line mysql_sql.y:5386
 		{
 			yyLOCAL = append(yyDollar[1].whenClauseListUnion(), yyDollar[2].whenClauseUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 856:
+	case 913:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.When
-//line mysql_sql.y:5081
+//line mysql_sql.y:5392
 		{
 			yyLOCAL = &tree.When{
 				Cond: yyDollar[2].exprUnion(),
@@ -11878,10 +12551,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 858:
+	case 915:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5091
+//line mysql_sql.y:5402
 		{
 			name := yyDollar[1].str
 			if yyDollar[2].str != "" {
@@ -11899,10 +12572,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 859:
+	case 916:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5108
+//line mysql_sql.y:5419
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11917,27 +12590,32 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 861:
+	case 918:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5125
+//line mysql_sql.y:5436
 		{
 			locale := ""
+			// cast as binary without the length is the varbinary
+			oid := uint32(defines.MYSQL_TYPE_STRING)
+			if yyDollar[2].lengthOptUnion() == 0 {
+				oid = uint32(defines.MYSQL_TYPE_VAR_STRING)
+			}
 			yyLOCAL = &tree.T{
 				InternalType: tree.InternalType{
 					Family:       tree.StringFamily,
 					FamilyString: yyDollar[1].str,
 					Locale:       &locale,
-					Oid:          uint32(defines.MYSQL_TYPE_VARCHAR),
+					Oid:          oid,
 					DisplayWith:  yyDollar[2].lengthOptUnion(),
 				},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 862:
+	case 919:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5138
+//line mysql_sql.y:5454
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11951,10 +12629,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 863:
+	case 920:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5151
+//line mysql_sql.y:5467
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11967,10 +12645,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 864:
+	case 921:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5163
+//line mysql_sql.y:5479
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11985,10 +12663,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 865:
+	case 922:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5177
+//line mysql_sql.y:5493
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12004,18 +12682,17 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 866:
+	case 923:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5192
+//line mysql_sql.y:5508
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
 				InternalType: tree.InternalType{
 					Family:             tree.TimeFamily,
 					FamilyString:       yyDollar[1].str,
-					DisplayWith:        yyDollar[2].lengthOptUnion(),
-					Precision:          0,
+					Precision:          yyDollar[2].lengthOptUnion(),
 					TimePrecisionIsSet: false,
 					Locale:             &locale,
 					Oid:                uint32(defines.MYSQL_TYPE_TIME),
@@ -12023,10 +12700,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 867:
+	case 924:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5207
+//line mysql_sql.y:5522
 		{
 			name := yyDollar[1].str
 			if yyDollar[2].str != "" {
@@ -12044,10 +12721,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 868:
+	case 925:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5224
+//line mysql_sql.y:5539
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12062,15 +12739,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 869:
+	case 926:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5239
+//line mysql_sql.y:5554
 		{
 		}
-	case 872:
+	case 929:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5245
+//line mysql_sql.y:5560
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12080,10 +12757,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 873:
+	case 930:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5254
+//line mysql_sql.y:5569
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12092,10 +12769,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 874:
+	case 931:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5262
+//line mysql_sql.y:5577
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12104,10 +12781,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 875:
+	case 932:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5270
+//line mysql_sql.y:5585
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12117,10 +12794,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 876:
+	case 933:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5279
+//line mysql_sql.y:5594
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12130,10 +12807,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 877:
+	case 934:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5288
+//line mysql_sql.y:5603
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12143,10 +12820,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 878:
+	case 935:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5297
+//line mysql_sql.y:5612
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12156,10 +12833,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 879:
+	case 936:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5306
+//line mysql_sql.y:5621
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			es := tree.NewNumValWithType(constant.MakeString("*"), "*", false, tree.P_char)
@@ -12169,10 +12846,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 880:
+	case 937:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5315
+//line mysql_sql.y:5630
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12182,10 +12859,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 881:
+	case 938:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5324
+//line mysql_sql.y:5639
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12195,10 +12872,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 882:
+	case 939:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5333
+//line mysql_sql.y:5648
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12208,10 +12885,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 883:
+	case 940:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5342
+//line mysql_sql.y:5657
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12221,10 +12898,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 884:
+	case 941:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5351
+//line mysql_sql.y:5666
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12234,10 +12911,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 885:
+	case 942:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5360
+//line mysql_sql.y:5675
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12247,10 +12924,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 886:
+	case 943:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5369
+//line mysql_sql.y:5684
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12260,10 +12937,54 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 890:
+	case 944:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5693
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
+				Type:  yyDollar[3].funcTypeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 945:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5702
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[3].exprUnion(), yyDollar[5].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 946:
+		yyDollar = yyS[yypt-12 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5710
+		{
+			// PERCENTILE_CONT(p) WITHIN GROUP (ORDER BY x) is kept as percentile_cont(x, p),
+			// a descending order is folded into the fraction as 1 - p.
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			fraction := yyDollar[3].exprUnion()
+			if yyDollar[11].directionUnion() == tree.Descending {
+				one := tree.NewNumValWithType(constant.MakeInt64(1), "1", false, tree.P_int64)
+				fraction = tree.NewBinaryExpr(tree.MINUS, one, yyDollar[3].exprUnion())
+			}
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[10].exprUnion(), fraction},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 952:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5385
+//line mysql_sql.y:5736
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12272,10 +12993,22 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 891:
+	case 953:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5744
+		{
+			name := tree.SetUnresolvedName(yyDollar[1].str, strings.ToLower(yyDollar[3].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: yyDollar[5].exprsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 954:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5393
+//line mysql_sql.y:5752
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12284,10 +13017,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 892:
+	case 955:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5401
+//line mysql_sql.y:5760
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12296,10 +13029,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 893:
+	case 956:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5409
+//line mysql_sql.y:5768
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12308,10 +13041,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 894:
+	case 957:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5417
+//line mysql_sql.y:5776
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			timeUinit := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false, tree.P_char)
@@ -12321,10 +13054,36 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 895:
+	case 958:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5785
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			timeUnit := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false, tree.P_char)
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{timeUnit, yyDollar[5].exprUnion(), yyDollar[7].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 959:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5794
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			timeUnit := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false, tree.P_char)
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{timeUnit, yyDollar[5].exprUnion(), yyDollar[7].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 960:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5426
+//line mysql_sql.y:5803
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12333,60 +13092,90 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 896:
-		yyDollar = yyS[yypt-5 : yypt+1]
+	case 961:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5811
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
+				Type:  yyDollar[3].funcTypeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 962:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5820
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:      tree.FuncName2ResolvableFunctionReference(name),
+				Exprs:     yyDollar[4].exprsUnion(),
+				Type:      yyDollar[3].funcTypeUnion(),
+				OrderBy:   yyDollar[5].orderByUnion(),
+				Separator: yyDollar[6].numValUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 963:
+		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5434
+//line mysql_sql.y:5831
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
-				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
-				Type:  yyDollar[3].funcTypeUnion(),
+				Func:    tree.FuncName2ResolvableFunctionReference(name),
+				Exprs:   tree.Exprs{yyDollar[4].exprUnion()},
+				Type:    yyDollar[3].funcTypeUnion(),
+				OrderBy: yyDollar[5].orderByUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 897:
-		yyDollar = yyS[yypt-5 : yypt+1]
+	case 964:
+		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5443
+//line mysql_sql.y:5841
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
 				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
-				Type:  yyDollar[3].funcTypeUnion(),
+				Exprs: tree.Exprs{yyDollar[3].exprUnion()},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 898:
-		yyDollar = yyS[yypt-4 : yypt+1]
+	case 965:
+		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5452
+//line mysql_sql.y:5849
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			arg1 := tree.NewNumValWithType(constant.MakeString("both"), "both", false, tree.P_char)
 			yyLOCAL = &tree.FuncExpr{
 				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: tree.Exprs{yyDollar[3].exprUnion()},
+				Exprs: tree.Exprs{arg1, yyDollar[3].exprUnion(), yyDollar[5].exprUnion()},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 899:
+	case 966:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5460
+//line mysql_sql.y:5858
 		{
-			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			// POSITION(substr IN str) is a synonym for LOCATE(substr, str).
+			name := tree.SetUnresolvedName("locate")
 			yyLOCAL = &tree.FuncExpr{
 				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: tree.Exprs{yyDollar[3].exprUnion()},
+				Exprs: tree.Exprs{yyDollar[3].exprUnion(), yyDollar[5].exprUnion()},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 900:
+	case 967:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5468
+//line mysql_sql.y:5867
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg1 := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false, tree.P_char)
@@ -12396,10 +13185,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 901:
+	case 968:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5477
+//line mysql_sql.y:5876
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg1 := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false, tree.P_char)
@@ -12409,10 +13198,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 902:
+	case 969:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5487
+//line mysql_sql.y:5886
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			a1 := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false, tree.P_char)
@@ -12423,16 +13212,32 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 908:
+	case 970:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.NumVal
+//line mysql_sql.y:5897
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 971:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.NumVal
+//line mysql_sql.y:5901
+		{
+			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
+		}
+		yyVAL.union = yyLOCAL
+	case 978:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5508
+//line mysql_sql.y:5917
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 937:
+	case 1007:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5544
+//line mysql_sql.y:5953
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -12445,10 +13250,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 938:
+	case 1008:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5556
+//line mysql_sql.y:5965
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -12461,10 +13266,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 939:
+	case 1009:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5570
+//line mysql_sql.y:5979
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12473,10 +13278,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 940:
+	case 1010:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5578
+//line mysql_sql.y:5987
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12484,10 +13289,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 941:
+	case 1011:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5585
+//line mysql_sql.y:5994
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12495,10 +13300,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 942:
+	case 1012:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5592
+//line mysql_sql.y:6001
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -12511,10 +13316,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 943:
+	case 1013:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5604
+//line mysql_sql.y:6013
 		{
 			name := tree.SetUnresolvedName("char")
 			yyLOCAL = &tree.FuncExpr{
@@ -12523,10 +13328,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 944:
+	case 1014:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5612
+//line mysql_sql.y:6021
 		{
 			cn := tree.NewNumValWithType(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false, tree.P_char)
 			es := yyDollar[3].exprsUnion()
@@ -12538,10 +13343,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 945:
+	case 1015:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5623
+//line mysql_sql.y:6032
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("date")
@@ -12551,10 +13356,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 946:
+	case 1016:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5632
+//line mysql_sql.y:6041
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("time")
@@ -12564,10 +13369,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 947:
+	case 1017:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5641
+//line mysql_sql.y:6050
 		{
 			name := tree.SetUnresolvedName("insert")
 			yyLOCAL = &tree.FuncExpr{
@@ -12576,10 +13381,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 948:
+	case 1018:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5649
+//line mysql_sql.y:6058
 		{
 			es := tree.Exprs{yyDollar[3].exprUnion()}
 			es = append(es, yyDollar[5].exprUnion())
@@ -12590,10 +13395,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 949:
+	case 1019:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5659
+//line mysql_sql.y:6068
 		{
 			name := tree.SetUnresolvedName("password")
 			yyLOCAL = &tree.FuncExpr{
@@ -12602,10 +13407,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 950:
+	case 1020:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5667
+//line mysql_sql.y:6076
 		{
 			name := tree.SetUnresolvedName("binary")
 			yyLOCAL = &tree.FuncExpr{
@@ -12614,10 +13419,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 951:
+	case 1021:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5675
+//line mysql_sql.y:6084
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("timestamp")
@@ -12627,34 +13432,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 952:
+	case 1022:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5685
+//line mysql_sql.y:6094
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 953:
+	case 1023:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5689
+//line mysql_sql.y:6098
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 954:
+	case 1024:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5695
+//line mysql_sql.y:6104
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 955:
+	case 1025:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5699
+//line mysql_sql.y:6108
 		{
 			ival, errStr := util.GetInt64(yyDollar[2].item)
 			if errStr != "" {
@@ -12665,20 +13470,20 @@ yydefault:
 			yyLOCAL = tree.NewNumValWithType(constant.MakeInt64(ival), str, false, tree.P_int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 962:
+	case 1032:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5718
+//line mysql_sql.y:6127
 		{
 		}
-	case 963:
+	case 1033:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:5720
+//line mysql_sql.y:6129
 		{
 		}
-	case 996:
+	case 1067:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5760
+//line mysql_sql.y:6170
 		{
 			name := tree.SetUnresolvedName("interval")
 			arg2 := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false, tree.P_char)
@@ -12688,90 +13493,90 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 997:
+	case 1068:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:5770
+//line mysql_sql.y:6180
 		{
 			yyLOCAL = tree.FUNC_TYPE_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 998:
+	case 1069:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:5774
+//line mysql_sql.y:6184
 		{
 			yyLOCAL = tree.FUNC_TYPE_DISTINCT
 		}
 		yyVAL.union = yyLOCAL
-	case 999:
+	case 1070:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:5778
+//line mysql_sql.y:6188
 		{
 			yyLOCAL = tree.FUNC_TYPE_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 1000:
+	case 1071:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Tuple
-//line mysql_sql.y:5784
+//line mysql_sql.y:6194
 		{
 			yyLOCAL = tree.NewTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1001:
+	case 1072:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:5789
+//line mysql_sql.y:6199
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1002:
+	case 1073:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:5793
+//line mysql_sql.y:6203
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1003:
+	case 1074:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:5799
+//line mysql_sql.y:6209
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1004:
+	case 1075:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:5803
+//line mysql_sql.y:6213
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1005:
+	case 1076:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5810
+//line mysql_sql.y:6220
 		{
 			yyLOCAL = tree.NewAndExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1006:
+	case 1077:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5814
+//line mysql_sql.y:6224
 		{
 			yyLOCAL = tree.NewOrExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1007:
+	case 1078:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5818
+//line mysql_sql.y:6228
 		{
 			name := tree.SetUnresolvedName(strings.ToLower("concat"))
 			yyLOCAL = &tree.FuncExpr{
@@ -12780,340 +13585,340 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1008:
+	case 1079:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5826
+//line mysql_sql.y:6236
 		{
 			yyLOCAL = tree.NewXorExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1009:
+	case 1080:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5830
+//line mysql_sql.y:6240
 		{
 			yyLOCAL = tree.NewNotExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1010:
+	case 1081:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5834
+//line mysql_sql.y:6244
 		{
 			yyLOCAL = tree.NewMaxValue()
 		}
 		yyVAL.union = yyLOCAL
-	case 1011:
+	case 1082:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5838
+//line mysql_sql.y:6248
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.EQUAL, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1012:
+	case 1083:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5842
+//line mysql_sql.y:6252
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_EQUAL, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1013:
+	case 1084:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5846
+//line mysql_sql.y:6256
 		{
 			arg := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), "", false, tree.P_char)
 			yyLOCAL = tree.NewComparisonExpr(tree.EQUAL, yyDollar[1].exprUnion(), arg)
 		}
 		yyVAL.union = yyLOCAL
-	case 1014:
+	case 1085:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5851
+//line mysql_sql.y:6261
 		{
 			arg := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), "", false, tree.P_char)
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_EQUAL, yyDollar[1].exprUnion(), arg)
 		}
 		yyVAL.union = yyLOCAL
-	case 1015:
+	case 1086:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5856
+//line mysql_sql.y:6266
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1016:
+	case 1087:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5862
+//line mysql_sql.y:6272
 		{
 			yyLOCAL = tree.NewIsNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1017:
+	case 1088:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5866
+//line mysql_sql.y:6276
 		{
 			yyLOCAL = tree.NewIsNotNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1018:
+	case 1089:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5870
+//line mysql_sql.y:6280
 		{
 			yyLOCAL = tree.NewComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1019:
+	case 1090:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5874
+//line mysql_sql.y:6284
 		{
 			yyLOCAL = tree.NewSubqueryComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[3].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[4].subqueryUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1021:
+	case 1092:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5881
+//line mysql_sql.y:6291
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(true), "", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 1022:
+	case 1093:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5885
+//line mysql_sql.y:6295
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(false), "", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 1023:
+	case 1094:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5891
+//line mysql_sql.y:6301
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.IN, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1024:
+	case 1095:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5895
+//line mysql_sql.y:6305
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_IN, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1025:
+	case 1096:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5899
+//line mysql_sql.y:6309
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.LIKE, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1026:
+	case 1097:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5903
+//line mysql_sql.y:6313
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.NOT_LIKE, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1027:
+	case 1098:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5907
+//line mysql_sql.y:6317
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.REG_MATCH, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1028:
+	case 1099:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5911
+//line mysql_sql.y:6321
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_REG_MATCH, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1029:
+	case 1100:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5915
+//line mysql_sql.y:6325
 		{
 			yyLOCAL = tree.NewRangeCond(false, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1030:
+	case 1101:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5919
+//line mysql_sql.y:6329
 		{
 			yyLOCAL = tree.NewRangeCond(true, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[6].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1032:
+	case 1103:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5925
+//line mysql_sql.y:6335
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1033:
+	case 1104:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5929
+//line mysql_sql.y:6339
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1034:
+	case 1105:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5935
+//line mysql_sql.y:6345
 		{
 			yyLOCAL = yyDollar[1].tupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1035:
+	case 1106:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5939
+//line mysql_sql.y:6349
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1036:
+	case 1107:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5946
+//line mysql_sql.y:6356
 		{
 			yyLOCAL = tree.ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 1037:
+	case 1108:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5950
+//line mysql_sql.y:6360
 		{
 			yyLOCAL = tree.ANY
 		}
 		yyVAL.union = yyLOCAL
-	case 1038:
+	case 1109:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5954
+//line mysql_sql.y:6364
 		{
 			yyLOCAL = tree.SOME
 		}
 		yyVAL.union = yyLOCAL
-	case 1039:
+	case 1110:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5960
+//line mysql_sql.y:6370
 		{
 			yyLOCAL = tree.EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1040:
+	case 1111:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5964
+//line mysql_sql.y:6374
 		{
 			yyLOCAL = tree.LESS_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 1041:
+	case 1112:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5968
+//line mysql_sql.y:6378
 		{
 			yyLOCAL = tree.GREAT_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 1042:
+	case 1113:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5972
+//line mysql_sql.y:6382
 		{
 			yyLOCAL = tree.LESS_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1043:
+	case 1114:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5976
+//line mysql_sql.y:6386
 		{
 			yyLOCAL = tree.GREAT_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1044:
+	case 1115:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5980
+//line mysql_sql.y:6390
 		{
 			yyLOCAL = tree.NOT_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1045:
+	case 1116:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5984
+//line mysql_sql.y:6394
 		{
 			yyLOCAL = tree.NULL_SAFE_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1046:
+	case 1117:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5990
+//line mysql_sql.y:6400
 		{
 			yyLOCAL = tree.NewAttributePrimaryKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 1047:
+	case 1118:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5994
+//line mysql_sql.y:6404
 		{
 			yyLOCAL = tree.NewAttributeUniqueKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 1048:
+	case 1119:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5998
+//line mysql_sql.y:6408
 		{
 			yyLOCAL = tree.NewAttributeUnique()
 		}
 		yyVAL.union = yyLOCAL
-	case 1049:
+	case 1120:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6002
+//line mysql_sql.y:6412
 		{
 			yyLOCAL = tree.NewAttributeKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 1050:
+	case 1121:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6008
+//line mysql_sql.y:6418
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
 		yyVAL.union = yyLOCAL
-	case 1051:
+	case 1122:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6012
+//line mysql_sql.y:6422
 		{
 			str := fmt.Sprintf("%v", yyDollar[1].item)
 			switch v := yyDollar[1].item.(type) {
@@ -13127,43 +13932,43 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1052:
+	case 1123:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6025
+//line mysql_sql.y:6435
 		{
 			fval := yyDollar[1].item.(float64)
 			yyLOCAL = tree.NewNumValWithType(constant.MakeFloat64(fval), yylex.(*Lexer).scanner.LastToken, false, tree.P_float64)
 		}
 		yyVAL.union = yyLOCAL
-	case 1053:
+	case 1124:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6030
+//line mysql_sql.y:6440
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(true), "true", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 1054:
+	case 1125:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6034
+//line mysql_sql.y:6444
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(false), "false", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 1055:
+	case 1126:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6038
+//line mysql_sql.y:6448
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeUnknown(), "null", false, tree.P_null)
 		}
 		yyVAL.union = yyLOCAL
-	case 1056:
+	case 1127:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6042
+//line mysql_sql.y:6452
 		{
 			switch v := yyDollar[1].item.(type) {
 			case uint64:
@@ -13171,25 +13976,25 @@ yydefault:
 			case int64:
 				yyLOCAL = tree.NewNumValWithType(constant.MakeInt64(v), yylex.(*Lexer).scanner.LastToken, false, tree.P_int64)
 			case string:
-				yyLOCAL = tree.NewNumValWithType(constant.MakeString(v), v, false, tree.P_hexnum)
+				yyLOCAL = tree.NewNumValWithType(constant.MakeString(v), "x'"+v+"'", false, tree.P_hexnum)
 			default:
 				yylex.Error("parse integral fail")
 				return 1
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1057:
+	case 1128:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6056
+//line mysql_sql.y:6466
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_decimal)
 		}
 		yyVAL.union = yyLOCAL
-	case 1058:
+	case 1129:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6060
+//line mysql_sql.y:6470
 		{
 			switch v := yyDollar[1].item.(type) {
 			case uint64:
@@ -13197,52 +14002,52 @@ yydefault:
 			case int64:
 				yyLOCAL = tree.NewNumValWithType(constant.MakeInt64(v), yylex.(*Lexer).scanner.LastToken, false, tree.P_int64)
 			case string:
-				yyLOCAL = tree.NewNumValWithType(constant.MakeString(v), v, false, tree.P_bit)
+				yyLOCAL = tree.NewNumValWithType(constant.MakeString(v), "b'"+v+"'", false, tree.P_bit)
 			default:
 				yylex.Error("parse integral fail")
 				return 1
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1059:
+	case 1130:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6074
+//line mysql_sql.y:6484
 		{
 			yyLOCAL = tree.NewParamExpr(yylex.(*Lexer).GetParamIndex())
 		}
 		yyVAL.union = yyLOCAL
-	case 1060:
+	case 1131:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6080
+//line mysql_sql.y:6490
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.Unsigned = yyDollar[2].unsignedOptUnion()
 			yyLOCAL.InternalType.Zerofill = yyDollar[3].zeroFillOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1064:
+	case 1135:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6091
+//line mysql_sql.y:6501
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.DisplayWith = yyDollar[2].lengthOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1065:
+	case 1136:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6096
+//line mysql_sql.y:6506
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1066:
+	case 1137:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6102
+//line mysql_sql.y:6512
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13255,10 +14060,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1067:
+	case 1138:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6114
+//line mysql_sql.y:6524
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13271,10 +14076,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1068:
+	case 1139:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6126
+//line mysql_sql.y:6536
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13287,10 +14092,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1069:
+	case 1140:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6138
+//line mysql_sql.y:6548
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13304,10 +14109,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1070:
+	case 1141:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6151
+//line mysql_sql.y:6561
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13321,10 +14126,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1071:
+	case 1142:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6164
+//line mysql_sql.y:6574
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13338,10 +14143,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1072:
+	case 1143:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6177
+//line mysql_sql.y:6587
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13355,10 +14160,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1073:
+	case 1144:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6190
+//line mysql_sql.y:6600
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13372,10 +14177,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1074:
+	case 1145:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6203
+//line mysql_sql.y:6613
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13389,10 +14194,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1075:
+	case 1146:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6216
+//line mysql_sql.y:6626
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13406,10 +14211,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1076:
+	case 1147:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6229
+//line mysql_sql.y:6639
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13423,10 +14228,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1077:
+	case 1148:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6242
+//line mysql_sql.y:6652
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13440,10 +14245,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1078:
+	case 1149:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6255
+//line mysql_sql.y:6665
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13457,10 +14262,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1079:
+	case 1150:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6268
+//line mysql_sql.y:6678
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13474,10 +14279,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1080:
+	case 1151:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6283
+//line mysql_sql.y:6693
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 255 {
@@ -13501,10 +14306,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1081:
+	case 1152:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6306
+//line mysql_sql.y:6716
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().Precision != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Precision > yyDollar[2].lengthScaleOptUnion().DisplayWith {
@@ -13541,10 +14346,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1082:
+	case 1153:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6343
+//line mysql_sql.y:6753
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().Precision != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Precision > yyDollar[2].lengthScaleOptUnion().DisplayWith {
@@ -13581,10 +14386,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1083:
+	case 1154:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6391
+//line mysql_sql.y:6801
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13600,10 +14405,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1084:
+	case 1155:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6408
+//line mysql_sql.y:6818
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13616,29 +14421,33 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1085:
+	case 1156:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6420
+//line mysql_sql.y:6830
 		{
 			locale := ""
-			yyLOCAL = &tree.T{
-				InternalType: tree.InternalType{
-					Family:             tree.TimeFamily,
-					FamilyString:       yyDollar[1].str,
-					DisplayWith:        yyDollar[2].lengthOptUnion(),
-					Precision:          0,
-					TimePrecisionIsSet: false,
-					Locale:             &locale,
-					Oid:                uint32(defines.MYSQL_TYPE_TIME),
-				},
+			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
+				yylex.Error("For Time(fsp), fsp must in [0, 6]")
+				return 1
+			} else {
+				yyLOCAL = &tree.T{
+					InternalType: tree.InternalType{
+						Family:             tree.TimeFamily,
+						Precision:          yyDollar[2].lengthOptUnion(),
+						FamilyString:       yyDollar[1].str,
+						TimePrecisionIsSet: true,
+						Locale:             &locale,
+						Oid:                uint32(defines.MYSQL_TYPE_TIME),
+					},
+				}
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1086:
+	case 1157:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6435
+//line mysql_sql.y:6849
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -13659,10 +14468,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1087:
+	case 1158:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6455
+//line mysql_sql.y:6869
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -13683,10 +14492,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1088:
+	case 1159:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6475
+//line mysql_sql.y:6889
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13701,10 +14510,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1089:
+	case 1160:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6491
+//line mysql_sql.y:6905
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13718,10 +14527,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1090:
+	case 1161:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6504
+//line mysql_sql.y:6918
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13735,10 +14544,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1091:
+	case 1162:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6517
+//line mysql_sql.y:6931
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13746,16 +14555,16 @@ yydefault:
 					Family:       tree.StringFamily,
 					FamilyString: yyDollar[1].str,
 					Locale:       &locale,
-					Oid:          uint32(defines.MYSQL_TYPE_VARCHAR),
+					Oid:          uint32(defines.MYSQL_TYPE_STRING),
 					DisplayWith:  yyDollar[2].lengthOptUnion(),
 				},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1092:
+	case 1163:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6530
+//line mysql_sql.y:6944
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13764,15 +14573,15 @@ yydefault:
 					Locale:       &locale,
 					FamilyString: yyDollar[1].str,
 					DisplayWith:  yyDollar[2].lengthOptUnion(),
-					Oid:          uint32(defines.MYSQL_TYPE_VARCHAR),
+					Oid:          uint32(defines.MYSQL_TYPE_VAR_STRING),
 				},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1093:
+	case 1164:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6543
+//line mysql_sql.y:6957
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13785,10 +14594,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1094:
+	case 1165:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6555
+//line mysql_sql.y:6969
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13801,10 +14610,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1095:
+	case 1166:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6567
+//line mysql_sql.y:6981
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13817,10 +14626,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1096:
+	case 1167:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6579
+//line mysql_sql.y:6993
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13833,10 +14642,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1097:
+	case 1168:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6591
+//line mysql_sql.y:7005
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13849,10 +14658,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1098:
+	case 1169:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6603
+//line mysql_sql.y:7017
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13865,10 +14674,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1099:
+	case 1170:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6615
+//line mysql_sql.y:7029
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13881,10 +14690,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1100:
+	case 1171:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6627
+//line mysql_sql.y:7041
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13897,10 +14706,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1101:
+	case 1172:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6639
+//line mysql_sql.y:7053
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13913,10 +14722,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1102:
+	case 1173:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line mysql_sql.y:7065
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.UuidFamily,
+					FamilyString: yyDollar[1].str,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_UUID),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1174:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6651
+//line mysql_sql.y:7077
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13930,10 +14755,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1103:
+	case 1175:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6664
+//line mysql_sql.y:7090
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13947,10 +14772,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1104:
+	case 1176:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6679
+//line mysql_sql.y:7105
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13963,75 +14788,75 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1105:
+	case 1177:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:6702
+//line mysql_sql.y:7128
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1106:
+	case 1178:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:6707
+//line mysql_sql.y:7133
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1107:
+	case 1179:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:6713
+//line mysql_sql.y:7139
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 1109:
+	case 1181:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:6720
+//line mysql_sql.y:7146
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 1110:
+	case 1182:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:6724
+//line mysql_sql.y:7150
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 1111:
+	case 1183:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:6729
+//line mysql_sql.y:7155
 		{
 			yyLOCAL = int32(-1)
 		}
 		yyVAL.union = yyLOCAL
-	case 1112:
+	case 1184:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:6733
+//line mysql_sql.y:7159
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 1113:
+	case 1185:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:6739
+//line mysql_sql.y:7165
 		{
 			yyLOCAL = tree.GetDisplayWith(int32(yyDollar[2].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 1114:
+	case 1186:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:6745
+//line mysql_sql.y:7171
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.NotDefineDisplayWidth,
@@ -14039,10 +14864,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1115:
+	case 1187:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:6752
+//line mysql_sql.y:7178
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -14050,10 +14875,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1116:
+	case 1188:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:6759
+//line mysql_sql.y:7185
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -14061,10 +14886,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1117:
+	case 1189:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:6768
+//line mysql_sql.y:7194
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: 34, // this is the default precision for decimal
@@ -14072,10 +14897,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1118:
+	case 1190:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:6775
+//line mysql_sql.y:7201
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -14083,10 +14908,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1119:
+	case 1191:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:6782
+//line mysql_sql.y:7208
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -14094,52 +14919,52 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1120:
+	case 1192:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6791
+//line mysql_sql.y:7217
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1121:
+	case 1193:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6795
+//line mysql_sql.y:7221
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1122:
+	case 1194:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6799
+//line mysql_sql.y:7225
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1123:
+	case 1195:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:6805
+//line mysql_sql.y:7231
 		{
 		}
-	case 1124:
+	case 1196:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6807
+//line mysql_sql.y:7233
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1128:
+	case 1200:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:6817
+//line mysql_sql.y:7243
 		{
 			yyVAL.str = ""
 		}
-	case 1129:
+	case 1201:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:6821
+//line mysql_sql.y:7247
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
@@ -17,6 +17,7 @@ package mysql
 
 import (
 	"fmt"
+    "math"
     "strings"
     "go/constant"
 
@@ -138,6 +139,10 @@ import (
     unsignedOpt bool
     zeroFillOpt bool
     ifNotExists bool
+    functionArg *tree.FunctionArg
+    functionArgs []*tree.FunctionArg
+    sequenceOption *tree.SequenceOption
+    sequenceOptions tree.SequenceOptions
     defaultOptional bool
     fullOpt bool
     boolVal bool
@@ -224,7 +229,7 @@ import (
 %token <str> TIME TIMESTAMP DATETIME YEAR
 %token <str> CHAR VARCHAR BOOL CHARACTER VARBINARY NCHAR
 %token <str> TEXT TINYTEXT MEDIUMTEXT LONGTEXT
-%token <str> BLOB TINYBLOB MEDIUMBLOB LONGBLOB JSON ENUM
+%token <str> BLOB TINYBLOB MEDIUMBLOB LONGBLOB JSON ENUM UUID
 %token <str> GEOMETRY POINT LINESTRING POLYGON GEOMETRYCOLLECTION MULTIPOINT MULTILINESTRING MULTIPOLYGON
 %token <str> INT1 INT2 INT3 INT4 INT8
 
@@ -246,6 +251,21 @@ import (
 %token <str> TYPE ANY SOME EXTERNAL LOCALFILE URL
 %token <str> PREPARE DEALLOCATE
 
+// Materialized view
+%token <str> MATERIALIZED REFRESH INCREMENTAL
+
+// User-defined function
+%token <str> RETURN RETURNS
+
+// Sequence
+%token <str> SEQUENCE INCREMENT MINVALUE CACHE CYCLE RESTART
+
+// Backup
+%token <str> BACKUP
+
+// Generated Column
+%token <str> GENERATED ALWAYS VIRTUAL STORED
+
 // MO table option
 %token <str> PROPERTIES
 
@@ -313,6 +333,8 @@ import (
 %token <str> GROUP_CONCAT MAX MID MIN NOW POSITION SESSION_USER STD STDDEV
 %token <str> STDDEV_POP STDDEV_SAMP SUBDATE SUBSTR SUBSTRING SUM SYSDATE
 %token <str> SYSTEM_USER TRANSLATE TRIM VARIANCE VAR_POP VAR_SAMP AVG
+%token <str> PERCENTILE_CONT PERCENTILE_DISC WITHIN ARRAY_AGG
+%token <str> TIMESTAMPADD TIMESTAMPDIFF
 
 //JSON function
 %token <str> JSON_EXTRACT
@@ -326,10 +348,16 @@ import (
 %type <statements> stmt_list
 %type <statement> create_stmt insert_stmt delete_stmt drop_stmt alter_stmt
 %type <statement> delete_without_using_stmt delete_with_using_stmt
-%type <statement> drop_ddl_stmt drop_database_stmt drop_table_stmt drop_index_stmt drop_prepare_stmt drop_view_stmt
+%type <statement> drop_ddl_stmt drop_database_stmt drop_table_stmt drop_index_stmt drop_prepare_stmt drop_view_stmt drop_function_stmt drop_sequence_stmt
 %type <statement> drop_account_stmt drop_role_stmt drop_user_stmt
 %type <statement> create_account_stmt create_user_stmt create_role_stmt
-%type <statement> create_ddl_stmt create_table_stmt create_database_stmt create_index_stmt create_view_stmt
+%type <statement> create_ddl_stmt create_table_stmt create_database_stmt create_index_stmt create_view_stmt create_function_stmt create_sequence_stmt
+%type <statement> alter_sequence_stmt
+%type <sequenceOption> sequence_option alter_sequence_option
+%type <sequenceOptions> sequence_option_list_opt sequence_option_list alter_sequence_option_list
+%type <int64Val> sequence_int
+%type <functionArg> func_arg
+%type <functionArgs> func_arg_list_opt func_arg_list
 %type <statement> show_stmt show_create_stmt show_columns_stmt show_databases_stmt show_target_filter_stmt
 %type <statement> show_tables_stmt show_process_stmt show_errors_stmt show_warnings_stmt
 %type <statement> show_variables_stmt show_status_stmt show_index_stmt
@@ -340,9 +368,11 @@ import (
 %type <statement> revoke_stmt grant_stmt
 %type <statement> load_data_stmt
 %type <statement> analyze_stmt
+%type <statement> refresh_stmt backup_stmt
 %type <statement> prepare_stmt prepareable_stmt deallocate_stmt execute_stmt
 %type <exportParm> export_data_param_opt
-%type <loadParam> load_param_opt load_param_opt_2
+%type <str> export_format_opt
+%type <loadParam> load_param_opt load_param_opt_2 infile_param format_option_list
 %type <tailParam> tail_param_opt
 
 %type <select> select_stmt select_no_parens
@@ -354,6 +384,7 @@ import (
 %type <direction> asc_desc_opt
 %type <order> order
 %type <orderBy> order_list order_by_clause order_by_opt
+%type <numVal> separator_opt
 %type <limit> limit_opt limit_clause
 %type <str> insert_column
 %type <identifierList> column_list column_list_opt partition_clause_opt partition_id_list insert_column_list
@@ -462,6 +493,7 @@ import (
 %type <str> fields_or_columns
 %type <int64Val> algorithm_opt partition_num_opt sub_partition_num_opt
 %type <boolVal> linear_opt
+%type <boolVal> generated_stored_opt
 %type <partition> partition
 %type <partitions> partition_list_opt partition_list
 %type <values> values_opt
@@ -480,7 +512,7 @@ import (
 %type <insert> insert_data
 %type <rowsExprs> values_list
 %type <str> name_datetime_precision braces_opt name_braces
-%type <str> std_dev_pop
+%type <str> std_dev_pop percentile_func
 %type <expr> expr_or_default
 %type <exprs> data_values data_opt row_value
 
@@ -569,6 +601,8 @@ stmt:
 |   revoke_stmt
 |   grant_stmt
 |   load_data_stmt
+|   refresh_stmt
+|   backup_stmt
 |   select_stmt
     {
         $$ = $1
@@ -1788,11 +1822,68 @@ analyze_stmt:
         $$ = tree.NewAnalyzeStmt($3, $5)
     }
 
+backup_stmt:
+    BACKUP TO STRING
+    {
+        $$ = &tree.Backup{Dir: $3}
+    }
+|   BACKUP TO STRING INCREMENTAL FROM STRING
+    {
+        $$ = &tree.Backup{Dir: $3, Base: $6}
+    }
+
+refresh_stmt:
+    REFRESH MATERIALIZED VIEW table_name
+    {
+        $$ = &tree.RefreshMaterializedView{
+            Name: $4,
+        }
+    }
+|   REFRESH MATERIALIZED VIEW table_name INCREMENTAL
+    {
+        $$ = &tree.RefreshMaterializedView{
+            Name: $4,
+            Incremental: true,
+        }
+    }
+
 alter_stmt:
     alter_user_stmt
 |   alter_account_stmt
+|   alter_sequence_stmt
 // |    alter_ddl_stmt
 
+alter_sequence_stmt:
+    ALTER SEQUENCE exists_opt table_name alter_sequence_option_list
+    {
+        $$ = &tree.AlterSequence{IfExists: $3, Name: $4, Options: $5}
+    }
+
+alter_sequence_option_list:
+    alter_sequence_option
+    {
+        $$ = tree.SequenceOptions{$1}
+    }
+|   alter_sequence_option_list alter_sequence_option
+    {
+        $$ = append($1, $2)
+    }
+
+alter_sequence_option:
+    sequence_option
+|   RESTART
+    {
+        $$ = &tree.SequenceOption{Type: tree.SequenceRestart}
+    }
+|   RESTART sequence_int
+    {
+        $$ = &tree.SequenceOption{Type: tree.SequenceRestart, Value: $2, HasValue: true}
+    }
+|   RESTART WITH sequence_int
+    {
+        $$ = &tree.SequenceOption{Type: tree.SequenceRestart, Value: $3, HasValue: true}
+    }
+
 alter_account_stmt:
     ALTER ACCOUNT exists_opt account_name account_auth_option account_status_option account_comment_opt
     {
@@ -2171,6 +2262,8 @@ drop_ddl_stmt:
 |   drop_prepare_stmt
 |   drop_table_stmt
 |   drop_view_stmt
+|   drop_function_stmt
+|   drop_sequence_stmt
 |   drop_index_stmt
 |   drop_role_stmt
 |   drop_user_stmt
@@ -2244,6 +2337,18 @@ drop_view_stmt:
         $$ = &tree.DropView{IfExists: $3, Names: $4}
     }
 
+drop_function_stmt:
+    DROP FUNCTION exists_opt table_name
+    {
+        $$ = &tree.DropFunction{IfExists: $3, Name: $4}
+    }
+
+drop_sequence_stmt:
+    DROP SEQUENCE exists_opt table_name
+    {
+        $$ = &tree.DropSequence{IfExists: $3, Name: $4}
+    }
+
 drop_database_stmt:
     DROP DATABASE exists_opt database_id
     {
@@ -2537,19 +2642,29 @@ export_data_param_opt:
     {
         $$ = nil
     }
-|   INTO OUTFILE STRING export_fields export_lines_opt header_opt max_file_size_opt force_quote_opt
+|   INTO OUTFILE STRING export_format_opt export_fields export_lines_opt header_opt max_file_size_opt force_quote_opt
     {
         $$ = &tree.ExportParam{
             Outfile:    true,
             FilePath :  $3,
-            Fields:     $4,
-            Lines:      $5,
-            Header:     $6,
-            MaxFileSize:uint64($7)*1024,
-            ForceQuote: $8,
+            FileFormat: $4,
+            Fields:     $5,
+            Lines:      $6,
+            Header:     $7,
+            MaxFileSize:uint64($8)*1024,
+            ForceQuote: $9,
         }
     }
 
+export_format_opt:
+    {
+        $$ = ""
+    }
+|   FORMAT STRING
+    {
+        $$ = strings.ToLower($2)
+    }
+
 export_fields:
     {
         $$ = &tree.Fields{
@@ -3336,6 +3451,134 @@ create_ddl_stmt:
 |   create_database_stmt
 |   create_index_stmt
 |	create_view_stmt
+|	create_function_stmt
+|	create_sequence_stmt
+
+create_sequence_stmt:
+    CREATE SEQUENCE not_exists_opt table_name sequence_option_list_opt
+    {
+        $$ = &tree.CreateSequence{IfNotExists: $3, Name: $4, Options: $5}
+    }
+
+sequence_option_list_opt:
+    {
+        $$ = nil
+    }
+|   sequence_option_list
+
+sequence_option_list:
+    sequence_option
+    {
+        $$ = tree.SequenceOptions{$1}
+    }
+|   sequence_option_list sequence_option
+    {
+        $$ = append($1, $2)
+    }
+
+sequence_option:
+    INCREMENT sequence_int
+    {
+        $$ = &tree.SequenceOption{Type: tree.SequenceIncrement, Value: $2, HasValue: true}
+    }
+|   INCREMENT BY sequence_int
+    {
+        $$ = &tree.SequenceOption{Type: tree.SequenceIncrement, Value: $3, HasValue: true}
+    }
+|   MINVALUE sequence_int
+    {
+        $$ = &tree.SequenceOption{Type: tree.SequenceMinValue, Value: $2, HasValue: true}
+    }
+|   NO MINVALUE
+    {
+        $$ = &tree.SequenceOption{Type: tree.SequenceNoMinValue}
+    }
+|   MAXVALUE sequence_int
+    {
+        $$ = &tree.SequenceOption{Type: tree.SequenceMaxValue, Value: $2, HasValue: true}
+    }
+|   NO MAXVALUE
+    {
+        $$ = &tree.SequenceOption{Type: tree.SequenceNoMaxValue}
+    }
+|   START sequence_int
+    {
+        $$ = &tree.SequenceOption{Type: tree.SequenceStart, Value: $2, HasValue: true}
+    }
+|   START WITH sequence_int
+    {
+        $$ = &tree.SequenceOption{Type: tree.SequenceStart, Value: $3, HasValue: true}
+    }
+|   CACHE sequence_int
+    {
+        $$ = &tree.SequenceOption{Type: tree.SequenceCache, Value: $2, HasValue: true}
+    }
+|   CYCLE
+    {
+        $$ = &tree.SequenceOption{Type: tree.SequenceCycle}
+    }
+|   NO CYCLE
+    {
+        $$ = &tree.SequenceOption{Type: tree.SequenceNoCycle}
+    }
+
+sequence_int:
+    INTEGRAL
+    {
+        ival, ok := $1.(int64)
+        if !ok {
+            yylex.Error("sequence value is out of range of bigint")
+            return 1
+        }
+        $$ = ival
+    }
+|   '-' INTEGRAL
+    {
+        switch ival := $2.(type) {
+        case int64:
+            $$ = -ival
+        case uint64:
+            if ival != 1 << 63 {
+                yylex.Error("sequence value is out of range of bigint")
+                return 1
+            }
+            $$ = math.MinInt64
+        }
+    }
+
+create_function_stmt:
+    CREATE FUNCTION not_exists_opt table_name '(' func_arg_list_opt ')' RETURNS column_type RETURN expression
+    {
+        $$ = &tree.CreateFunction{
+            IfNotExists: $3,
+            Name: $4,
+            Args: $6,
+            ReturnType: $9,
+            Body: $11,
+        }
+    }
+
+func_arg_list_opt:
+    {
+        $$ = nil
+    }
+|   func_arg_list
+
+func_arg_list:
+    func_arg
+    {
+        $$ = []*tree.FunctionArg{$1}
+    }
+|   func_arg_list ',' func_arg
+    {
+        $$ = append($1, $3)
+    }
+
+func_arg:
+    ident column_type
+    {
+        $$ = &tree.FunctionArg{Name: tree.Identifier($1), Type: $2}
+    }
 
 create_view_stmt:
 	CREATE temporary_opt view_recursive_opt VIEW table_name column_list_opt AS select_stmt
@@ -3358,6 +3601,16 @@ create_view_stmt:
         	IfNotExists: true,
        }
     }
+|	CREATE MATERIALIZED VIEW not_exists_opt table_name column_list_opt AS select_stmt
+	{
+		$$ = &tree.CreateView{
+			Name: $5,
+			ColNames: $6,
+			AsSource: $8,
+			IfNotExists: $4,
+			Materialized: true,
+		}
+	}
 
 view_recursive_opt:
 	{}
@@ -3939,6 +4192,43 @@ load_param_opt_2:
     }
 
 load_param_opt:
+    infile_param
+    {
+        $$ = $1
+    }
+|   infile_param FORMAT STRING
+    {
+        $$ = $1
+        $$.Format = strings.ToLower($3)
+    }
+|   infile_param FORMAT STRING '{' format_option_list '}'
+    {
+        $$ = $1
+        $$.Format = strings.ToLower($3)
+        $$.JsonPaths = $5.JsonPaths
+        $$.MaxErrors = $5.MaxErrors
+        $$.KeepOrder = $5.KeepOrder
+    }
+
+format_option_list:
+    STRING '=' STRING
+    {
+        $$ = &tree.ExternParam{}
+        if err := $$.SetFormatOption($1, $3); err != nil {
+            yylex.Error(err.Error())
+            return 1
+        }
+    }
+|   format_option_list ',' STRING '=' STRING
+    {
+        $$ = $1
+        if err := $$.SetFormatOption($3, $5); err != nil {
+            yylex.Error(err.Error())
+            return 1
+        }
+    }
+
+infile_param:
     INFILE STRING
     {
         $$ = &tree.ExternParam{
@@ -4725,11 +5015,32 @@ column_attribute_elem:
     {
         $$ = tree.NewAttributeCheck($4, $6, $1)
     }
+|   GENERATED ALWAYS AS '(' expression ')' generated_stored_opt
+    {
+        $$ = tree.NewAttributeGeneratedAlways($5, $7)
+    }
+|   AS '(' expression ')' generated_stored_opt
+    {
+        $$ = tree.NewAttributeGeneratedAlways($3, $5)
+    }
 // |   ON UPDATE function_call_nonkeyword
 //     {
 //         $$ = tree.NewAttributeOnUpdate($3)
 //     }
 
+generated_stored_opt:
+    {
+        $$ = false
+    }
+|   VIRTUAL
+    {
+        $$ = false
+    }
+|   STORED
+    {
+        $$ = true
+    }
+
 enforce:
     ENFORCED
     {
@@ -5124,12 +5435,17 @@ mysql_cast_type:
 |   BINARY length_opt
     {
         locale := ""
+        // cast as binary without the length is the varbinary
+        oid := uint32(defines.MYSQL_TYPE_STRING)
+        if $2 == 0 {
+            oid = uint32(defines.MYSQL_TYPE_VAR_STRING)
+        }
         $$ = &tree.T{
             InternalType: tree.InternalType{
 		        Family: tree.StringFamily,
                 FamilyString: $1,
 		        Locale: &locale,
-		        Oid:    uint32(defines.MYSQL_TYPE_VARCHAR),
+		        Oid:    oid,
                 DisplayWith: $2,
 	        },
         }
@@ -5188,15 +5504,14 @@ mysql_cast_type:
 	        },
         }
     }
-|   TIME length_opt
+|   TIME timestamp_option_opt
     {
         locale := ""
         $$ = &tree.T{
             InternalType: tree.InternalType{
 		        Family: tree.TimeFamily,
                 FamilyString: $1,
-                DisplayWith: $2,
-		        Precision: 0,
+		        Precision: $2,
 		        TimePrecisionIsSet: false,
 		        Locale: &locale,
 		        Oid: uint32(defines.MYSQL_TYPE_TIME),
@@ -5374,6 +5689,42 @@ function_call_aggregate:
             Type: $3,
         }
     }
+|   MODE '(' func_type_opt expression ')'
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$4},
+            Type: $3,
+        }
+    }
+|   percentile_func '(' expression ',' expression ')'
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$3, $5},
+        }
+    }
+|   percentile_func '(' expression ')' WITHIN GROUP '(' ORDER BY expression asc_desc_opt ')'
+    {
+        // PERCENTILE_CONT(p) WITHIN GROUP (ORDER BY x) is kept as percentile_cont(x, p),
+        // a descending order is folded into the fraction as 1 - p.
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        fraction := $3
+        if $11 == tree.Descending {
+            one := tree.NewNumValWithType(constant.MakeInt64(1), "1", false, tree.P_int64)
+            fraction = tree.NewBinaryExpr(tree.MINUS, one, $3)
+        }
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$10, fraction},
+        }
+    }
+
+percentile_func:
+    PERCENTILE_CONT
+|   PERCENTILE_DISC
 
 std_dev_pop:
     STD
@@ -5389,6 +5740,14 @@ function_call_generic:
             Exprs: $3,
         }
     }
+|   ident '.' ident '(' expression_list_opt ')'
+    {
+        name := tree.SetUnresolvedName($1, strings.ToLower($3))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: $5,
+        }
+    }
 |   substr_option '(' expression_list_opt ')'
     {
     	name := tree.SetUnresolvedName(strings.ToLower($1))
@@ -5422,6 +5781,24 @@ function_call_generic:
              Exprs: tree.Exprs{timeUinit, $5},
        }
     }
+|   TIMESTAMPADD '(' time_stamp_unit ',' expression ',' expression ')'
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        timeUnit := tree.NewNumValWithType(constant.MakeString($3), $3, false, tree.P_char)
+        $$ = &tree.FuncExpr{
+             Func: tree.FuncName2ResolvableFunctionReference(name),
+             Exprs: tree.Exprs{timeUnit, $5, $7},
+        }
+    }
+|   TIMESTAMPDIFF '(' time_stamp_unit ',' expression ',' expression ')'
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        timeUnit := tree.NewNumValWithType(constant.MakeString($3), $3, false, tree.P_char)
+        $$ = &tree.FuncExpr{
+             Func: tree.FuncName2ResolvableFunctionReference(name),
+             Exprs: tree.Exprs{timeUnit, $5, $7},
+        }
+    }
 |	func_not_keyword '(' expression_list_opt ')'
 	{
         name := tree.SetUnresolvedName(strings.ToLower($1))
@@ -5439,13 +5816,25 @@ function_call_generic:
             Type: $3,
         }
 	}
-|	GROUP_CONCAT '(' func_type_opt expression ')'
+|	GROUP_CONCAT '(' func_type_opt expression_list order_by_opt separator_opt ')'
+	{
+		name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: $4,
+            Type: $3,
+            OrderBy: $5,
+            Separator: $6,
+        }
+	}
+|	ARRAY_AGG '(' func_type_opt expression order_by_opt ')'
 	{
 		name := tree.SetUnresolvedName(strings.ToLower($1))
         $$ = &tree.FuncExpr{
             Func: tree.FuncName2ResolvableFunctionReference(name),
             Exprs: tree.Exprs{$4},
             Type: $3,
+            OrderBy: $5,
         }
 	}
 |	TRIM '(' expression ')'
@@ -5459,9 +5848,19 @@ function_call_generic:
 |	TRIM '(' expression FROM expression ')'
 	{
 		name := tree.SetUnresolvedName(strings.ToLower($1))
+		arg1 := tree.NewNumValWithType(constant.MakeString("both"), "both", false, tree.P_char)
         $$ = &tree.FuncExpr{
              Func: tree.FuncName2ResolvableFunctionReference(name),
-             Exprs: tree.Exprs{$3},
+             Exprs: tree.Exprs{arg1, $3, $5},
+        }
+	}
+|	POSITION '(' bit_expr IN expression ')'
+	{
+		// POSITION(substr IN str) is a synonym for LOCATE(substr, str).
+		name := tree.SetUnresolvedName("locate")
+        $$ = &tree.FuncExpr{
+             Func: tree.FuncName2ResolvableFunctionReference(name),
+             Exprs: tree.Exprs{$3, $5},
         }
 	}
 |	TRIM '(' trim_direction FROM expression ')'
@@ -5494,6 +5893,15 @@ function_call_json:
 	}
 	}
 
+separator_opt:
+	{
+		$$ = nil
+	}
+|	SEPARATOR STRING
+	{
+		$$ = tree.NewNumValWithType(constant.MakeString($2), $2, false, tree.P_char)
+	}
+
 trim_direction:
 	BOTH
 |	LEADING
@@ -5502,6 +5910,7 @@ trim_direction:
 substr_option:
 	SUBSTRING
 |	SUBSTR
+|	MID
 
 time_unit:
 	time_stamp_unit
@@ -5752,6 +6161,7 @@ name_confict:
 |   TIMESTAMP
 |   TRUNCATE
 |   USER
+|   UUID
 |   WEEK
 |   YEAR
 
@@ -6046,7 +6456,7 @@ literal:
         case int64:
             $$ = tree.NewNumValWithType(constant.MakeInt64(v), yylex.(*Lexer).scanner.LastToken, false, tree.P_int64)
         case string:
-        	$$ = tree.NewNumValWithType(constant.MakeString(v), v, false, tree.P_hexnum)
+        	$$ = tree.NewNumValWithType(constant.MakeString(v), "x'" + v + "'", false, tree.P_hexnum)
         default:
             yylex.Error("parse integral fail")
             return 1
@@ -6064,7 +6474,7 @@ literal:
         case int64:
             $$ = tree.NewNumValWithType(constant.MakeInt64(v), yylex.(*Lexer).scanner.LastToken, false, tree.P_int64)
         case string:
-        	$$ = tree.NewNumValWithType(constant.MakeString(v), v, false, tree.P_bit)
+        	$$ = tree.NewNumValWithType(constant.MakeString(v), "b'" + v + "'", false, tree.P_bit)
         default:
             yylex.Error("parse integral fail")
             return 1
@@ -6416,19 +6826,23 @@ time_type:
 	        },
         }
     }
-|   TIME length_opt
+|   TIME timestamp_option_opt
     {
         locale := ""
-        $$ = &tree.T{
-            InternalType: tree.InternalType{
-		        Family: tree.TimeFamily,
-                FamilyString: $1,
-                DisplayWith: $2,
-		        Precision: 0,
-		        TimePrecisionIsSet: false,
-		        Locale: &locale,
-		        Oid: uint32(defines.MYSQL_TYPE_TIME),
+        if $2 < 0 || $2 > 6 {
+        		yylex.Error("For Time(fsp), fsp must in [0, 6]")
+        		return 1
+                } else {
+                $$ = &tree.T{
+            		InternalType: tree.InternalType{
+		        Family:             tree.TimeFamily,
+		        Precision:          $2,
+                	FamilyString: $1,
+		        TimePrecisionIsSet: true,
+		        Locale:             &locale,
+		        Oid:                uint32(defines.MYSQL_TYPE_TIME),
 	        },
+	    }
         }
     }
 |   TIMESTAMP timestamp_option_opt
@@ -6521,7 +6935,7 @@ char_type:
 		        Family: tree.StringFamily,
                 FamilyString: $1,
 		        Locale: &locale,
-		        Oid:    uint32(defines.MYSQL_TYPE_VARCHAR),
+		        Oid:    uint32(defines.MYSQL_TYPE_STRING),
                 DisplayWith: $2,
 	        },
         }
@@ -6535,7 +6949,7 @@ char_type:
 		        Locale: &locale,
                 FamilyString: $1,
                 DisplayWith: $2,
-		        Oid:    uint32(defines.MYSQL_TYPE_VARCHAR),
+		        Oid:    uint32(defines.MYSQL_TYPE_VAR_STRING),
 	        },
         }
     }
@@ -6647,6 +7061,18 @@ char_type:
             },
         }
     }
+|   UUID
+    {
+        locale := ""
+        $$ = &tree.T{
+            InternalType: tree.InternalType{
+		        Family: tree.UuidFamily,
+                FamilyString: $1,
+		        Locale: &locale,
+		        Oid:uint32(defines.MYSQL_TYPE_UUID),
+            },
+        }
+    }
 |   ENUM '(' enum_values ')'
     {
         locale := ""
@@ -7004,6 +7430,7 @@ non_reserved_keyword:
 |   AUTO_RANDOM
 |   ACTION
 |   ALGORITHM
+|   BACKUP
 |   BEGIN
 |   BIGINT
 |   BIT
@@ -7051,6 +7478,7 @@ non_reserved_keyword:
 |   GRANT
 |   INT
 |   INTEGER
+|   INCREMENTAL
 |   INDEXES
 |   ISOLATION
 |   JSON
@@ -7065,6 +7493,18 @@ non_reserved_keyword:
 |   LOCAL
 |   LINEAR
 |   LIST
+|   MATERIALIZED
+|   RETURNS
+|   SEQUENCE
+|   INCREMENT
+|   MINVALUE
+|   CACHE
+|   CYCLE
+|   RESTART
+|   GENERATED
+|   ALWAYS
+|   VIRTUAL
+|   STORED
 |   MEDIUMBLOB
 |   MEDIUMINT
 |   MEDIUMTEXT
@@ -7102,6 +7542,7 @@ non_reserved_keyword:
 |   RANGE
 |   READ
 |   REAL
+|   REFRESH
 |   REORGANIZE
 |   REDUNDANT
 |   REPAIR
@@ -7140,6 +7581,7 @@ non_reserved_keyword:
 |   UNUSED
 |   UNLOCK
 |   USER
+|   UUID
 |   VARBINARY
 |   VARCHAR
 |   VARIABLES
@@ -7170,7 +7612,6 @@ func_not_keyword:
 |   NOW
 |	ADDDATE
 |   CURDATE
-|   POSITION
 |   SESSION_USER
 |   SUBDATE
 |   SYSTEM_USER
@@ -7213,6 +7654,11 @@ not_keyword:
 |   VAR_POP
 |   VAR_SAMP
 |   AVG
+|   PERCENTILE_CONT
+|   PERCENTILE_DISC
+|   ARRAY_AGG
+|   TIMESTAMPADD
+|   TIMESTAMPDIFF
 
 //mo_keywords:
 //	PROPERTIES
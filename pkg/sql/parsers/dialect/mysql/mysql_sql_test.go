@@ -105,6 +105,9 @@ var (
 	}, {
 		input:  "select cast(\"2022-01-30\" as varchar);",
 		output: "select cast(2022-01-30 as varchar)",
+	}, {
+		input:  "select cast(a as time), cast(b as time(3)) from t2",
+		output: "select cast(a as time), cast(b as time(3)) from t2",
 	}, {
 		input:  "select cast(b as timestamp) from t2",
 		output: "select cast(b as timestamp(26)) from t2",
@@ -187,6 +190,12 @@ var (
 	}, {
 		input:  "select ltrim(\"a\"),rtrim(\"a\"),trim(BOTH \"\" from \"a\"),trim(BOTH \" \" from \"a\");",
 		output: "select ltrim(a), rtrim(a), trim(both, , a), trim(both,  , a)",
+	}, {
+		input:  "select median(a), mode(b), corr(a, b), percentile_cont(0.9) within group (order by a desc), percentile_disc(0.5) within group (order by a) from t1",
+		output: "select median(a), mode(b), corr(a, b), percentile_cont(a, 1 - 0.9), percentile_disc(a, 0.5) from t1",
+	}, {
+		input:  "select mid('abc', 1, 2), position('a' in 'abc'), trim('x' from 'xax'), ucase(a), locate('b', a)",
+		output: "select mid(abc, 1, 2), locate(a, abc), trim(both, x, xax), ucase(a), locate(b, a)",
 	}, {
 		input:  "select rpad('hello', -18446744073709551616, '1');",
 		output: "select rpad(hello, -18446744073709551616, 1)",
@@ -282,6 +291,14 @@ var (
 	}, {
 		input:  "SELECT GROUP_CONCAT(DISTINCT 2) from t1",
 		output: "select group_concat(distinct 2) from t1",
+	}, {
+		input:  "SELECT GROUP_CONCAT(DISTINCT a, b ORDER BY c DESC, d SEPARATOR ';') from t1 group by e",
+		output: "select group_concat(distinct a, b order by c desc, d separator ';') from t1 group by e",
+	}, {
+		input: "select array_agg(a order by b), array_agg(distinct a) from t1",
+	}, {
+		input:  "select TIMESTAMPDIFF(MONTH, a, b), TIMESTAMPADD(SQL_TSI_DAY, 1, a) from t1",
+		output: "select timestampdiff(month, a, b), timestampadd(sql_tsi_day, 1, a) from t1",
 	}, {
 		input: "select variance(2) from t1",
 	}, {
@@ -353,6 +370,67 @@ var (
 		output: "create view v as select qty, price, qty * price as value from t",
 	}, {
 		input: "create view v_today (today) as select current_day from t",
+	}, {
+		input:  "CREATE MATERIALIZED VIEW mv AS SELECT a, sum(b) FROM t GROUP BY a",
+		output: "create materialized view mv as select a, sum(b) from t group by a",
+	}, {
+		input: "create materialized view if not exists db.mv (a, total) as select a, count(*) from t group by a",
+	}, {
+		input: "refresh materialized view db.mv",
+	}, {
+		input:  "REFRESH MATERIALIZED VIEW mv INCREMENTAL",
+		output: "refresh materialized view mv incremental",
+	}, {
+		input:  "CREATE FUNCTION net_price(price DECIMAL(10,2), rate DOUBLE) RETURNS DECIMAL(10,2) RETURN price * (1 - rate)",
+		output: "create function net_price(price decimal(10, 2), rate double) returns decimal(10, 2) return price * (1 - rate)",
+	}, {
+		input: "create function if not exists db.one() returns int return 1",
+	}, {
+		input: "create function returns(a varchar(10)) returns int return length(a)",
+	}, {
+		input: "drop function f",
+	}, {
+		input: "drop function if exists db.f",
+	}, {
+		input:  "select DB.Net_Price(price, 0.1) from t",
+		output: "select db.net_price(price, 0.1) from t",
+	}, {
+		input:  "create table t (a date, b int generated always as (month(a)) stored)",
+		output: "create table t (a date, b int generated always as (month(a)) stored)",
+	}, {
+		input:  "create table t (a int, b int as (a + 1), c int generated always as (a * 2) virtual not null)",
+		output: "create table t (a int, b int generated always as (a + 1) virtual, c int generated always as (a * 2) virtual not null)",
+	}, {
+		input:  "create table generated (virtual int, stored int)",
+		output: "create table generated (virtual int, stored int)",
+	}, {
+		input: "create sequence s",
+	}, {
+		input:  "create sequence if not exists db.s increment 2 minvalue 1 maxvalue 100 start 5 cache 10 cycle",
+		output: "create sequence if not exists db.s increment by 2 minvalue 1 maxvalue 100 start with 5 cache 10 cycle",
+	}, {
+		input: "create sequence s increment by -1 no minvalue no maxvalue start with -9223372036854775808 no cycle",
+	}, {
+		input: "alter sequence s increment by 3 restart",
+	}, {
+		input:  "alter sequence if exists s restart 7 cache 5",
+		output: "alter sequence if exists s restart with 7 cache 5",
+	}, {
+		input: "drop sequence s",
+	}, {
+		input: "drop sequence if exists db.s",
+	}, {
+		input: "backup to 's3:backups/b1'",
+	}, {
+		input:  "backup to \"backups/b2\" incremental from 'backups/b1'",
+		output: "backup to 'backups/b2' incremental from 'backups/b1'",
+	}, {
+		input: "create table backup (a int)",
+	}, {
+		input:  "select nextval('s'), currval('s'), setval('s', 10, false), lastval()",
+		output: "select nextval(s), currval(s), setval(s, 10, false), lastval()",
+	}, {
+		input: "create table cache (cycle int, sequence int)",
 	}, {
 		input: "explain (analyze true,verbose false) select * from emp",
 	}, {
@@ -494,6 +572,21 @@ var (
 	}, {
 		input:  "SELECT userID as user, MAX(score) as max FROM t1 GROUP BY userID order by user",
 		output: "select userid as user, max(score) as max from t1 group by userid order by user",
+	}, {
+		input:  "load data infile 'data.parquet' format 'Parquet' into table t",
+		output: "load data infile data.parquet format 'parquet' into table t",
+	}, {
+		input:  "create external table t (a int) infile 'data.parquet' format 'parquet'",
+		output: "create external table t (a int) infile 'data.parquet' format 'parquet'",
+	}, {
+		input:  "load data infile 'events.json' format 'jsonline' {'MAX_ERRORS'='10', 'uid'='$.user.id'} into table t",
+		output: "load data infile events.json format 'jsonline' {'max_errors'='10', 'uid'='$.user.id'} into table t",
+	}, {
+		input:  "create external table t (a int, b json) infile 'events.json' format 'jsonline' {'b'='$.tags[0]'}",
+		output: "create external table t (a int, b json) infile 'events.json' format 'jsonline' {'b'='$.tags[0]'}",
+	}, {
+		input:  "load data infile 'data.csv' format 'csv' {'keep_order'='TRUE'} into table t",
+		output: "load data infile data.csv format 'csv' {'keep_order'='true'} into table t",
 	}, {
 		input:  "load data infile 'test/loadfile5' ignore INTO TABLE T.A FIELDS TERMINATED BY  ',' (@,@,c,d,e,f)",
 		output: "load data infile test/loadfile5 ignore into table t.a fields terminated by , (, , c, d, e, f)",
@@ -1104,6 +1197,23 @@ var (
 		}, {
 			input:  "create table t1 (t time(3) null, dt datetime(6) null, ts timestamp(1) null)",
 			output: "create table t1 (t time(3) null, dt datetime(26, 6) null, ts timestamp(26, 1) null)",
+		}, {
+			input:  "create table t1 (a time, b time(6) default '-838:59:59.000000')",
+			output: "create table t1 (a time, b time(6) default -838:59:59.000000)",
+		}, {
+			input:  "create table t1 (a enum('x-small', 'small', 'medium'), b set(\"a\", 'b c') not null)",
+			output: "create table t1 (a enum('x-small', 'small', 'medium'), b set('a', 'b c') not null)",
+		}, {
+			input:  "create table t1 (a binary(4), b varbinary(10), c bit(8))",
+			output: "create table t1 (a binary(4), b varbinary(10), c bit(8))",
+		}, {
+			input:  "select x'4D4f', X'', b'0101', 0x4d, 0b101, 0x10000000000000000",
+			output: "select x'4D4f', x'', b'0101', 0x4d, 0b101, x'010000000000000000'",
+		}, {
+			input:  "create table t1 (a uuid primary key, b int)",
+			output: "create table t1 (a uuid primary key, b int)",
+		}, {
+			input: "select uuid(), cast(a as uuid) from t1",
 		}, {
 			input:  "create table t1 (a int default 1 + 1 - 2 * 3 / 4 div 7 ^ 8 << 9 >> 10 % 11)",
 			output: "create table t1 (a int default 1 + 1 - 2 * 3 / 4 div 7 ^ 8 << 9 >> 10 % 11)",
@@ -1193,6 +1303,12 @@ var (
 		}, {
 			input:  "select $ from t into outfile '/Users/tmp/test' FIELDS TERMINATED BY ',' LINES TERMINATED BY '\n' header 'FALSE' MAX_FILE_SIZE 100 FORCE_QUOTE (a, b)",
 			output: "select $ from t into outfile /Users/tmp/test fields terminated by , enclosed by \" lines terminated by \n header false max_file_size 102400 force_quote a, b",
+		}, {
+			input:  "select a, b from t into outfile 's3-service:bucket/result.json' FORMAT 'JSONLINE' MAX_FILE_SIZE 100",
+			output: "select a, b from t into outfile s3-service:bucket/result.json format 'jsonline' fields terminated by , enclosed by \" lines terminated by \n header true max_file_size 102400",
+		}, {
+			input:  "select a, b from t into outfile '/Users/tmp/test.parquet' format 'parquet'",
+			output: "select a, b from t into outfile /Users/tmp/test.parquet format 'parquet' fields terminated by , enclosed by \" lines terminated by \n header true",
 		}, {
 			input: "drop prepare stmt_name1",
 		}, {
@@ -0,0 +1,36 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+// Backup takes an online backup of the storage into Dir, which may be
+// prefixed by the name of a file service, e.g. s3:backups/b1.
+type Backup struct {
+	statementImpl
+	Dir string
+	// Base is the dir of a prior backup. The files not changed since then
+	// are not copied again.
+	Base string
+}
+
+func (node *Backup) Format(ctx *FmtCtx) {
+	ctx.WriteString("backup to '")
+	ctx.WriteString(node.Dir)
+	ctx.WriteByte('\'')
+	if node.Base != "" {
+		ctx.WriteString(" incremental from '")
+		ctx.WriteString(node.Base)
+		ctx.WriteByte('\'')
+	}
+}
@@ -178,6 +178,7 @@ func (node *CreateTable) Format(ctx *FmtCtx) {
 			ctx.WriteString(" infile ")
 			ctx.WriteString("{'filepath':'" + node.Param.Filepath + "', 'compression':'" + strings.ToLower(node.Param.CompressType) + "'}")
 		}
+		node.Param.formatOptions(ctx)
 		if node.Param.Tail.Fields != nil {
 			ctx.WriteByte(' ')
 			node.Param.Tail.Fields.Format(ctx)
@@ -459,7 +460,14 @@ type AttributeGeneratedAlways struct {
 }
 
 func (node *AttributeGeneratedAlways) Format(ctx *FmtCtx) {
+	ctx.WriteString("generated always as (")
 	node.Expr.Format(ctx)
+	ctx.WriteString(")")
+	if node.Stored {
+		ctx.WriteString(" stored")
+	} else {
+		ctx.WriteString(" virtual")
+	}
 }
 
 func NewAttributeGeneratedAlways(e Expr, s bool) *AttributeGeneratedAlways {
@@ -545,6 +545,9 @@ type FuncExpr struct {
 
 	//aggregations which specify an order.
 	OrderBy OrderBy
+
+	//separator of group_concat, nil means the default ','.
+	Separator *NumVal
 }
 
 func (node *FuncExpr) Format(ctx *FmtCtx) {
@@ -556,6 +559,15 @@ func (node *FuncExpr) Format(ctx *FmtCtx) {
 		ctx.WriteByte(' ')
 	}
 	node.Exprs.Format(ctx)
+	if len(node.OrderBy) > 0 {
+		ctx.WriteByte(' ')
+		node.OrderBy.Format(ctx)
+	}
+	if node.Separator != nil {
+		ctx.WriteString(" separator '")
+		ctx.WriteString(node.Separator.String())
+		ctx.WriteByte('\'')
+	}
 	ctx.WriteByte(')')
 }
 
@@ -0,0 +1,71 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+// FunctionArg is a named parameter of a user-defined function.
+type FunctionArg struct {
+	Name Identifier
+	Type ResolvableTypeReference
+}
+
+func (node *FunctionArg) Format(ctx *FmtCtx) {
+	ctx.WriteString(string(node.Name))
+	ctx.WriteByte(' ')
+	node.Type.(*T).InternalType.Format(ctx)
+}
+
+// CreateFunction defines a scalar SQL function whose body is a single
+// expression over its arguments.
+type CreateFunction struct {
+	statementImpl
+	IfNotExists bool
+	Name        *TableName
+	Args        []*FunctionArg
+	ReturnType  ResolvableTypeReference
+	Body        Expr
+}
+
+func (node *CreateFunction) Format(ctx *FmtCtx) {
+	ctx.WriteString("create function ")
+	if node.IfNotExists {
+		ctx.WriteString("if not exists ")
+	}
+	node.Name.Format(ctx)
+	ctx.WriteByte('(')
+	for i, arg := range node.Args {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		arg.Format(ctx)
+	}
+	ctx.WriteString(") returns ")
+	node.ReturnType.(*T).InternalType.Format(ctx)
+	ctx.WriteString(" return ")
+	node.Body.Format(ctx)
+}
+
+type DropFunction struct {
+	statementImpl
+	IfExists bool
+	Name     *TableName
+}
+
+func (node *DropFunction) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop function ")
+	if node.IfExists {
+		ctx.WriteString("if exists ")
+	}
+	node.Name.Format(ctx)
+}
@@ -0,0 +1,127 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import "strconv"
+
+type SequenceOptionType int
+
+const (
+	SequenceIncrement SequenceOptionType = iota
+	SequenceMinValue
+	SequenceNoMinValue
+	SequenceMaxValue
+	SequenceNoMaxValue
+	SequenceStart
+	SequenceCache
+	SequenceCycle
+	SequenceNoCycle
+	// SequenceRestart is only used by ALTER SEQUENCE, it restarts the
+	// sequence at Value, or the start value if Value is not set.
+	SequenceRestart
+)
+
+// SequenceOption is an option of CREATE SEQUENCE and ALTER SEQUENCE.
+type SequenceOption struct {
+	Type     SequenceOptionType
+	Value    int64
+	HasValue bool
+}
+
+func (node *SequenceOption) Format(ctx *FmtCtx) {
+	switch node.Type {
+	case SequenceIncrement:
+		ctx.WriteString("increment by ")
+	case SequenceMinValue:
+		ctx.WriteString("minvalue ")
+	case SequenceNoMinValue:
+		ctx.WriteString("no minvalue")
+	case SequenceMaxValue:
+		ctx.WriteString("maxvalue ")
+	case SequenceNoMaxValue:
+		ctx.WriteString("no maxvalue")
+	case SequenceStart:
+		ctx.WriteString("start with ")
+	case SequenceCache:
+		ctx.WriteString("cache ")
+	case SequenceCycle:
+		ctx.WriteString("cycle")
+	case SequenceNoCycle:
+		ctx.WriteString("no cycle")
+	case SequenceRestart:
+		ctx.WriteString("restart")
+		if node.HasValue {
+			ctx.WriteString(" with ")
+		}
+	}
+	if node.HasValue {
+		ctx.WriteString(strconv.FormatInt(node.Value, 10))
+	}
+}
+
+type SequenceOptions []*SequenceOption
+
+func (node SequenceOptions) Format(ctx *FmtCtx) {
+	for _, opt := range node {
+		ctx.WriteByte(' ')
+		opt.Format(ctx)
+	}
+}
+
+type CreateSequence struct {
+	statementImpl
+	IfNotExists bool
+	Name        *TableName
+	Options     SequenceOptions
+}
+
+func (node *CreateSequence) Format(ctx *FmtCtx) {
+	ctx.WriteString("create sequence ")
+	if node.IfNotExists {
+		ctx.WriteString("if not exists ")
+	}
+	node.Name.Format(ctx)
+	node.Options.Format(ctx)
+}
+
+type AlterSequence struct {
+	statementImpl
+	IfExists bool
+	Name     *TableName
+	Options  SequenceOptions
+}
+
+func (node *AlterSequence) Format(ctx *FmtCtx) {
+	ctx.WriteString("alter sequence ")
+	if node.IfExists {
+		ctx.WriteString("if exists ")
+	}
+	node.Name.Format(ctx)
+	node.Options.Format(ctx)
+}
+
+type DropSequence struct {
+	statementImpl
+	IfExists bool
+	Name     *TableName
+}
+
+func (node *DropSequence) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop sequence ")
+	if node.IfExists {
+		ctx.WriteString("if exists ")
+	}
+	node.Name.Format(ctx)
+}
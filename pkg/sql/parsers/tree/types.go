@@ -37,6 +37,7 @@ const (
 	JsonFamily
 	EnumFamily
 	SetFamily
+	UuidFamily
 
 	//test
 	BlobFamily
@@ -140,6 +141,22 @@ func (node *InternalType) Format(ctx *FmtCtx) {
 
 	switch fs {
 	case "set", "enum":
+		ctx.WriteByte('(')
+		for i, v := range node.EnumValues {
+			if i > 0 {
+				ctx.WriteString(", ")
+			}
+			ctx.WriteByte('\'')
+			ctx.WriteString(v)
+			ctx.WriteByte('\'')
+		}
+		ctx.WriteByte(')')
+	case "time":
+		if node.Precision > 0 {
+			ctx.WriteByte('(')
+			ctx.WriteString(strconv.FormatInt(int64(node.Precision), 10))
+			ctx.WriteByte(')')
+		}
 	case "char":
 		if node.DisplayWith >= 0 {
 			ctx.WriteByte('(')
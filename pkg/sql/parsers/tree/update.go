@@ -16,7 +16,8 @@ package tree
 
 import (
 	"bufio"
-	"os"
+	"fmt"
+	"io"
 	"strconv"
 	"strings"
 
@@ -112,11 +113,83 @@ const (
 	LZ4        = "lz4"
 )
 
+// the file formats of LOAD DATA and external tables, the empty format is csv
+const (
+	CSV      = "csv"
+	PARQUET  = "parquet"
+	JSONLINE = "jsonline"
+)
+
+// the option of the jsonline format for the number of the malformed lines tolerated,
+// the other options map the columns to the JSONPath-like expressions.
+const MAX_ERRORS = "max_errors"
+
+// the option to read the files in order by one scan, instead of the parallel scans of their parts
+const KEEP_ORDER = "keep_order"
+
+// JsonPath maps a column to the value at the path of every json line
+type JsonPath struct {
+	Column string
+	Path   string
+}
+
 type ExternParam struct {
 	Filepath     string
 	CompressType string
-	Tail         *TailParameter
-	FileService  fileservice.FileService
+	Format       string
+	// JsonPaths are the columns not mapped to the json values by their names
+	JsonPaths []JsonPath
+	// MaxErrors is the number of the malformed lines skipped before failing
+	MaxErrors uint64
+	// KeepOrder is true if the lines are read in the order of the files
+	KeepOrder   bool
+	Tail        *TailParameter
+	FileService fileservice.FileService
+}
+
+func (node *ExternParam) SetFormatOption(key, value string) error {
+	key = strings.ToLower(key)
+	if key == MAX_ERRORS {
+		n, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("the value '%s' of '%s' is not a number", value, key)
+		}
+		node.MaxErrors = n
+		return nil
+	}
+	if key == KEEP_ORDER {
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("the value '%s' of '%s' is not a bool", value, key)
+		}
+		node.KeepOrder = b
+		return nil
+	}
+	node.JsonPaths = append(node.JsonPaths, JsonPath{Column: key, Path: value})
+	return nil
+}
+
+func (node *ExternParam) formatOptions(ctx *FmtCtx) {
+	if node.Format != "" {
+		ctx.WriteString(" format '" + node.Format + "'")
+	}
+	if node.MaxErrors == 0 && len(node.JsonPaths) == 0 && !node.KeepOrder {
+		return
+	}
+	prefix := " {"
+	if node.KeepOrder {
+		ctx.WriteString(prefix + "'" + KEEP_ORDER + "'='true'")
+		prefix = ", "
+	}
+	if node.MaxErrors != 0 {
+		ctx.WriteString(prefix + "'" + MAX_ERRORS + "'='" + strconv.FormatUint(node.MaxErrors, 10) + "'")
+		prefix = ", "
+	}
+	for _, p := range node.JsonPaths {
+		ctx.WriteString(prefix + "'" + p.Column + "'='" + p.Path + "'")
+		prefix = ", "
+	}
+	ctx.WriteByte('}')
 }
 
 type TailParameter struct {
@@ -155,6 +228,7 @@ func (node *Load) Format(ctx *FmtCtx) {
 		ctx.WriteString(" infile ")
 		ctx.WriteString("{'filepath':'" + node.Param.Filepath + "', 'compression':'" + strings.ToLower(node.Param.CompressType) + "'}")
 	}
+	node.Param.formatOptions(ctx)
 
 	switch node.DuplicateHandling.(type) {
 	case *DuplicateKeyError:
@@ -297,14 +371,22 @@ type LoadColumn interface {
 }
 
 type ExportParam struct {
-	// file handler
-	File *os.File
-	// bufio.writer
-	Writer *bufio.Writer
 	// outfile flag
 	Outfile bool
 	// filename path
 	FilePath string
+	// FileFormat is one of csv, jsonline and parquet, it is csv if empty
+	FileFormat string
+	// FileService writes the files to the service of FilePath
+	FileService fileservice.FileService
+	// Writer buffers the content of the current file written into Pipe
+	Writer *bufio.Writer
+	// Pipe streams the current file to the file service
+	Pipe *io.PipeWriter
+	// WriteDone receives the result of writing the current file to the file service
+	WriteDone chan error
+	// FormatWriter writes the rows into Writer for the parquet format
+	FormatWriter any
 	// Fields
 	Fields *Fields
 	// Lines
@@ -332,6 +414,9 @@ func (ep *ExportParam) Format(ctx *FmtCtx) {
 		return
 	}
 	ctx.WriteString("into outfile " + ep.FilePath)
+	if ep.FileFormat != "" {
+		ctx.WriteString(" format '" + ep.FileFormat + "'")
+	}
 	if ep.Fields != nil {
 		ctx.WriteByte(' ')
 		ep.Fields.Format(ctx)
@@ -21,6 +21,9 @@ type CreateView struct {
 	AsSource    *Select
 	IfNotExists bool
 	Temporary   bool
+	// Materialized views store the result of AsSource in a table, which is
+	// recomputed by REFRESH MATERIALIZED VIEW.
+	Materialized bool
 }
 
 func (node *CreateView) Format(ctx *FmtCtx) {
@@ -29,6 +32,9 @@ func (node *CreateView) Format(ctx *FmtCtx) {
 	if node.Temporary {
 		ctx.WriteString("temporary ")
 	}
+	if node.Materialized {
+		ctx.WriteString("materialized ")
+	}
 	ctx.WriteString("view ")
 
 	if node.IfNotExists {
@@ -44,3 +50,19 @@ func (node *CreateView) Format(ctx *FmtCtx) {
 	ctx.WriteString(" as ")
 	node.AsSource.Format(ctx)
 }
+
+type RefreshMaterializedView struct {
+	statementImpl
+	Name *TableName
+	// Incremental applies the changes of the base table since the last
+	// refresh instead of recomputing the view if possible.
+	Incremental bool
+}
+
+func (node *RefreshMaterializedView) Format(ctx *FmtCtx) {
+	ctx.WriteString("refresh materialized view ")
+	node.Name.Format(ctx)
+	if node.Incremental {
+		ctx.WriteString(" incremental")
+	}
+}
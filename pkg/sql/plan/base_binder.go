@@ -17,9 +17,11 @@ package plan
 import (
 	"fmt"
 	"go/constant"
+	"strconv"
 	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
@@ -80,7 +82,11 @@ func (b *baseBinder) baseBindExpr(astExpr tree.Expr, depth int32, isRoot bool) (
 		expr, err = b.bindRangeCond(exprImpl, depth, isRoot)
 
 	case *tree.UnresolvedName:
-		expr, err = b.impl.BindColRef(exprImpl, depth, isRoot)
+		if b.udfArgs != nil {
+			expr, err = b.bindUdfArg(exprImpl)
+		} else {
+			expr, err = b.impl.BindColRef(exprImpl, depth, isRoot)
+		}
 
 	case *tree.CastExpr:
 		expr, err = b.impl.BindExpr(exprImpl.Expr, depth, false)
@@ -221,6 +227,7 @@ func (b *baseBinder) baseBindColRef(astExpr *tree.UnresolvedName, depth int32, i
 	relPos := NotFound
 	colPos := NotFound
 	var typ *plan.Type
+	var colBinding *Binding
 
 	if len(table) == 0 {
 		if binding, ok := b.ctx.bindingByCol[col]; ok {
@@ -229,6 +236,7 @@ func (b *baseBinder) baseBindColRef(astExpr *tree.UnresolvedName, depth int32, i
 				colPos = binding.colIdByName[col]
 				typ = binding.types[colPos]
 				table = binding.table
+				colBinding = binding
 			} else {
 				// return nil, errors.New("", fmt.Sprintf("column reference %q is ambiguous", name))
 				return nil, errors.New("", fmt.Sprintf("Column reference '%s' is ambiguous", name))
@@ -247,6 +255,7 @@ func (b *baseBinder) baseBindColRef(astExpr *tree.UnresolvedName, depth int32, i
 			if colPos != NotFound {
 				typ = binding.types[colPos]
 				relPos = binding.tag
+				colBinding = binding
 			} else {
 				// err = errors.New("", fmt.Sprintf("column %q does not exist", name))
 				err = errors.New("", fmt.Sprintf("Column '%s' does not exist", name))
@@ -260,6 +269,10 @@ func (b *baseBinder) baseBindColRef(astExpr *tree.UnresolvedName, depth int32, i
 	if colPos != NotFound {
 		b.boundCols = append(b.boundCols, table+"."+col)
 
+		if expr = colBinding.virtualColumn(colPos, depth); expr != nil {
+			return
+		}
+
 		expr = &plan.Expr{
 			Typ: typ,
 		}
@@ -613,6 +626,19 @@ func (b *baseBinder) bindFuncExpr(astExpr *tree.FuncExpr, depth int32, isRoot bo
 	}
 	funcName := funcRef.Parts[0]
 
+	if funcRef.NumParts > 1 || !function.IsBuiltinFunction(funcName) {
+		udf, err := b.resolveUdf(funcRef)
+		if err != nil {
+			return nil, err
+		}
+		if udf != nil {
+			return b.bindUdfExpr(udf, astExpr.Exprs, depth)
+		}
+		if funcRef.NumParts > 1 {
+			return nil, errors.New(errno.UndefinedFunction, fmt.Sprintf("function %s does not exist", tree.String(funcRef, dialect.MYSQL)))
+		}
+	}
+
 	if function.GetFunctionIsAggregateByName(funcName) {
 		return b.impl.BindAggFunc(funcName, astExpr, depth, isRoot)
 	} else if function.GetFunctionIsWinfunByName(funcName) {
@@ -690,6 +716,13 @@ func (b *baseBinder) bindFuncExprImplByAstExpr(name string, astArgs []tree.Expr,
 func bindFuncExprImplByPlanExpr(name string, args []*Expr) (*plan.Expr, error) {
 	var err error
 
+	if args, err = rewriteEnumArgs(name, args); err != nil {
+		return nil, err
+	}
+	if args, err = rewriteCollationArgs(name, args); err != nil {
+		return nil, err
+	}
+
 	// deal with some special function
 	switch name {
 	case "date":
@@ -742,6 +775,37 @@ func bindFuncExprImplByPlanExpr(name string, args []*Expr) (*plan.Expr, error) {
 		if err != nil {
 			return nil, err
 		}
+	case "timestampadd":
+		// rewrite timestampadd(unit, number, col_name) to date_add(col_name, number, unit)
+		if len(args) != 3 {
+			return nil, errors.New("", "timestampadd function need three args")
+		}
+		intervalExpr := &Expr{
+			Expr: &plan.Expr_List{
+				List: &plan.ExprList{
+					List: []*Expr{args[1], args[0]},
+				},
+			},
+			Typ: &plan.Type{
+				Id: int32(types.T_interval),
+			},
+		}
+		args, err = resetDateFunctionArgs(args[2], intervalExpr)
+		if err != nil {
+			return nil, err
+		}
+		name = "date_add"
+	case "timestampdiff":
+		// rewrite the unit of timestampdiff to the interval type
+		if len(args) != 3 {
+			return nil, errors.New("", "timestampdiff function need three args")
+		}
+		unit := args[0].Expr.(*plan.Expr_C).C.Value.(*plan.Const_Sval).Sval
+		intervalType, err := types.IntervalTypeOf(unit)
+		if err != nil {
+			return nil, err
+		}
+		args[0] = makePlan2Int64ConstExprWithType(int64(intervalType))
 	case "adddate", "subdate":
 		if len(args) != 2 {
 			return nil, errors.New("", "add_date/sub_date function need two args")
@@ -1023,11 +1087,13 @@ func (b *baseBinder) bindNumVal(astExpr *tree.NumVal, typ *Type) (*Expr, error)
 			},
 		}, nil
 	case tree.P_hexnum:
-		return returnDecimalExpr(astExpr.String())
+		// the hex string literals are the binary strings
+		return bindFuncExprImplByPlanExpr("unhex", []*Expr{getStringExpr(constant.StringVal(astExpr.Value))})
 	case tree.P_bit:
-		return returnDecimalExpr(astExpr.String())
+		return bindFuncExprImplByPlanExpr("unhex", []*Expr{getStringExpr(bitStringToHex(constant.StringVal(astExpr.Value)))})
 	case tree.P_char:
 		expr := getStringExpr(astExpr.String())
+		expr.Typ.Collation = b.connectionCollation()
 		return expr, nil
 	default:
 		return nil, errors.New("", fmt.Sprintf("unsupport value: %v", astExpr.Value))
@@ -1036,10 +1102,119 @@ func (b *baseBinder) bindNumVal(astExpr *tree.NumVal, typ *Type) (*Expr, error)
 
 // --- util functions ----
 
+// bitStringToHex converts the digits of the bit string literal to the hex digits,
+// the leading bits are padded with zeros to the whole bytes
+func bitStringToHex(bits string) string {
+	if pad := len(bits) % 8; pad != 0 {
+		bits = strings.Repeat("0", 8-pad) + bits
+	}
+	buf := make([]byte, 0, len(bits)/4)
+	for i := 0; i < len(bits); i += 4 {
+		v, _ := strconv.ParseUint(bits[i:i+4], 2, 8)
+		buf = append(buf, "0123456789ABCDEF"[v])
+	}
+	return string(buf)
+}
+
+// rewriteEnumArgs makes the enum and set values compared by the ordinals with the constants and each other,
+// they are used as the strings by the other functions
+func rewriteEnumArgs(name string, args []*Expr) ([]*Expr, error) {
+	var err error
+	switch name {
+	case "isnull", "isnotnull", "enum_to_string", "cast_to_enum", "cast_to_set":
+		return args, nil
+	case "=", "<", "<=", ">", ">=", "<>":
+		if isEnumType(args[0].Typ) && isSameEnumType(args[0].Typ, args[1].Typ) {
+			return args, nil
+		}
+		for i := 0; i < 2; i++ {
+			if !isEnumType(args[i].Typ) || isEnumType(args[1-i].Typ) {
+				continue
+			}
+			if _, ok := args[1-i].Expr.(*plan.Expr_C); ok {
+				args[1-i], err = makeEnumCastExpr(args[1-i], args[i].Typ)
+				return args, err
+			}
+		}
+	}
+	for i, arg := range args {
+		if isEnumType(arg.Typ) {
+			if args[i], err = makeEnumToStringExpr(arg); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return args, nil
+}
+
+// rewriteCollationArgs makes a string constant compared by the collation of the other argument,
+// as the collation of a column takes precedence over the one of a literal, it is an error to
+// compare two strings of different non binary collations
+func rewriteCollationArgs(name string, args []*Expr) ([]*Expr, error) {
+	switch name {
+	case "=", "<", "<=", ">", ">=", "<>", "like":
+	default:
+		return args, nil
+	}
+	if len(args) != 2 || args[0].Typ.Collation == args[1].Typ.Collation || !isStringType(args[0].Typ) || !isStringType(args[1].Typ) {
+		return args, nil
+	}
+	for i := 0; i < 2; i++ {
+		_, isConst := args[i].Expr.(*plan.Expr_C)
+		_, otherIsConst := args[1-i].Expr.(*plan.Expr_C)
+		if isConst && !otherIsConst {
+			typ := copyType(args[i].Typ)
+			typ.Collation = args[1-i].Typ.Collation
+			args[i] = &Expr{Expr: args[i].Expr, Typ: typ}
+			return args, nil
+		}
+	}
+	collation, err := types.MergeCollation(types.Collation(args[0].Typ.Collation), types.Collation(args[1].Typ.Collation))
+	if err != nil {
+		return nil, err
+	}
+	// both sides are converted to the collation, so that the hash join gets the same keys of them
+	for i := range args {
+		if args[i].Typ.Collation != int32(collation) {
+			typ := copyType(args[i].Typ)
+			typ.Collation = int32(collation)
+			if args[i], err = appendCastBeforeExpr(args[i], typ); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return args, nil
+}
+
+// connectionCollation returns the collation of the string literals, which is the collation_connection of the session
+func (b *baseBinder) connectionCollation() int32 {
+	if b.builder == nil || b.builder.compCtx == nil {
+		return 0
+	}
+	v, err := b.builder.compCtx.ResolveVariable("collation_connection", true, false)
+	if err != nil {
+		return 0
+	}
+	name, _ := v.(string)
+	c, err := types.ParseCollation(name)
+	if err != nil {
+		return 0
+	}
+	return int32(c)
+}
+
 func appendCastBeforeExpr(expr *Expr, toType *Type) (*Expr, error) {
 	if expr.Typ.Id == int32(types.T_any) {
 		return expr, nil
 	}
+	if isEnumType(expr.Typ) || isEnumType(toType) {
+		return makeEnumCastExpr(expr, toType)
+	}
+	if toType.Collation == 0 && expr.Typ.Collation != 0 && isStringType(expr.Typ) && isStringType(toType) {
+		// the string keeps its collation when converted to another string type
+		toType = copyType(toType)
+		toType.Collation = expr.Typ.Collation
+	}
 	argsType := []types.Type{
 		makeTypeByPlan2Expr(expr),
 		makeTypeByPlan2Type(toType),
@@ -26,6 +26,38 @@ func (b *Binding) FindColumn(col string) int32 {
 	return NotFound
 }
 
+// virtualColumn returns the generation expression of the column at colPos
+// if it is a virtual generated column, with its column references bound to
+// this binding.
+func (b *Binding) virtualColumn(colPos int32, depth int32) *plan.Expr {
+	if b.virtualExprs == nil || b.virtualExprs[colPos] == nil {
+		return nil
+	}
+	return bindGeneratedColRefs(DeepCopyExpr(b.virtualExprs[colPos]), b.tag, depth)
+}
+
+func bindGeneratedColRefs(expr *plan.Expr, tag int32, depth int32) *plan.Expr {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_F:
+		for i, arg := range exprImpl.F.Args {
+			exprImpl.F.Args[i] = bindGeneratedColRefs(arg, tag, depth)
+		}
+	case *plan.Expr_Col:
+		if depth == 0 {
+			exprImpl.Col.RelPos = tag
+		} else {
+			expr.Expr = &plan.Expr_Corr{
+				Corr: &plan.CorrColRef{
+					RelPos: tag,
+					ColPos: exprImpl.Col.ColPos,
+					Depth:  depth,
+				},
+			}
+		}
+	}
+	return expr
+}
+
 func NewBinding(tag, nodeID int32, table string, cols []string, types []*plan.Type) *Binding {
 	binding := &Binding{
 		tag:     tag,
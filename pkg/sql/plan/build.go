@@ -118,7 +118,7 @@ func BuildPlan(ctx CompilerContext, stmt tree.Statement) (*Plan, error) {
 }
 
 // GetExecType get executor will execute base AP or TP
-func GetExecTypeFromPlan(_ *Plan) ExecInfo {
+func GetExecTypeFromPlan(pn *Plan) ExecInfo {
 	defInfo := ExecInfo{
 		Typ:        ExecTypeAP,
 		WithGPU:    false,
@@ -126,13 +126,23 @@ func GetExecTypeFromPlan(_ *Plan) ExecInfo {
 		CnNumbers:  2,
 	}
 
-	// TODO : fill the function
-
-	// empty function with default return
-	// just for test
+	// the plan works on big inputs if any node is estimated to output
+	// too many rows to be held by a single scope
+	for _, node := range pn.GetQuery().GetNodes() {
+		if IsBigInput(node) {
+			defInfo.WithBigMem = true
+			break
+		}
+	}
 	return defInfo
 }
 
+// IsBigInput returns true if the node is estimated to output at least
+// BigInputCardinality rows.
+func IsBigInput(node *plan.Node) bool {
+	return node.GetCost().GetCard() >= BigInputCardinality
+}
+
 // GetResultColumnsFromPlan
 func GetResultColumnsFromPlan(p *Plan) []*ColDef {
 	getResultColumnsByProjectionlist := func(query *Query) []*ColDef {
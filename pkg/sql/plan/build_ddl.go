@@ -24,6 +24,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 )
 
@@ -80,10 +81,15 @@ func buildCreateView(stmt *tree.CreateView, ctx CompilerContext) (*Plan, error)
 			},
 		},
 	})
+	kind := catalog.SystemViewRel
+	if stmt.Materialized {
+		// the result of a materialized view is stored in the table of the view
+		kind = catalog.SystemMaterializedRel
+	}
 	properties := []*plan.Property{
 		{
 			Key:   catalog.SystemRelAttr_Kind,
-			Value: catalog.SystemViewRel,
+			Value: kind,
 		},
 	}
 	createTable.TableDef.Defs = append(createTable.TableDef.Defs, &plan.TableDef_DefType{
@@ -123,7 +129,11 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 	}
 
 	// set tableDef
-	err := buildTableDefs(stmt.Defs, ctx, createTable.TableDef)
+	collation, err := getDefaultCollation(stmt.Options, ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = buildTableDefs(stmt.Defs, ctx, createTable.TableDef, collation)
 	if err != nil {
 		return nil, err
 	}
@@ -166,6 +176,8 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 					},
 				},
 			})
+		case *tree.TableOptionCollate:
+			// it is the default collation of the columns, applied by buildTableDefs
 		// these table options is not support in plan
 		// case *tree.TableOptionEngine, *tree.TableOptionSecondaryEngine, *tree.TableOptionCharset,
 		// 	*tree.TableOptionCollate, *tree.TableOptionAutoIncrement, *tree.TableOptionComment,
@@ -184,6 +196,9 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 
 	// After handleTableOptions, so begin the partitions processing depend on TableDef
 	if stmt.Param != nil {
+		if err := checkExternFormat(stmt.Param, createTable.TableDef.Cols); err != nil {
+			return nil, err
+		}
 		json_byte, err := json.Marshal(stmt.Param)
 		if err != nil {
 			return nil, err
@@ -259,10 +274,27 @@ func buildPartitionByClause(partitionBinder *PartitionBinder, partitionOp *tree.
 	return err
 }
 
-func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef) error {
+// getDefaultCollation returns the default collation of the string columns of a table, it is the COLLATE
+// table option, or the collation_database of the session
+func getDefaultCollation(options []tree.TableOption, ctx CompilerContext) (types.Collation, error) {
+	for _, option := range options {
+		if opt, ok := option.(*tree.TableOptionCollate); ok {
+			return types.ParseCollation(opt.Collate)
+		}
+	}
+	if v, err := ctx.ResolveVariable("collation_database", true, false); err == nil {
+		if name, ok := v.(string); ok {
+			return types.ParseCollation(name)
+		}
+	}
+	return types.CollationBinary, nil
+}
+
+func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef, collation types.Collation) error {
 	var primaryKeys []string
 	var indexs []string
 	colNameMap := make(map[string]int32)
+	generatedAttrs := make(map[int]*tree.AttributeGeneratedAlways)
 	for _, item := range defs {
 		switch def := item.(type) {
 		case *tree.ColumnTableDef:
@@ -279,6 +311,9 @@ func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef
 			var pks []string
 			var comment string
 			var auto_incr bool
+			var hasDefault bool
+			var generated *tree.AttributeGeneratedAlways
+			colCollation := collation
 			for _, attr := range def.Attributes {
 				if _, ok := attr.(*tree.AttributePrimaryKey); ok {
 					if colType.GetId() == int32(types.T_blob) {
@@ -295,6 +330,20 @@ func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef
 					}
 				}
 
+				if attrCollate, ok := attr.(*tree.AttributeCollate); ok {
+					if colCollation, err = types.ParseCollation(attrCollate.Collate); err != nil {
+						return err
+					}
+				}
+
+				if _, ok := attr.(*tree.AttributeDefault); ok {
+					hasDefault = true
+				}
+
+				if attrGenerated, ok := attr.(*tree.AttributeGeneratedAlways); ok {
+					generated = attrGenerated
+				}
+
 				if _, ok := attr.(*tree.AttributeAutoIncrement); ok {
 					auto_incr = true
 					if colType.GetId() != int32(types.T_int32) && colType.GetId() != int32(types.T_int64) {
@@ -302,6 +351,15 @@ func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef
 					}
 				}
 			}
+			if isStringType(colType) {
+				colType.Collation = int32(colCollation)
+			}
+			if generated != nil {
+				if hasDefault || auto_incr {
+					return errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("Generated column '%s' cannot have a default value or be auto_increment", def.Name.Parts[0]))
+				}
+				generatedAttrs[len(tableDef.Cols)] = generated
+			}
 			if len(pks) > 0 {
 				if len(primaryKeys) > 0 {
 					return errors.New(errno.SyntaxErrororAccessRuleViolation, "Multiple primary key defined")
@@ -380,6 +438,27 @@ func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef
 		}
 	}
 
+	if len(generatedAttrs) > 0 {
+		for _, name := range primaryKeys {
+			for i, col := range tableDef.Cols {
+				if col.Name == name && generatedAttrs[i] != nil && !generatedAttrs[i].Stored {
+					return errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("Virtual generated column '%s' cannot be a primary key", name))
+				}
+			}
+		}
+		// the values of virtual columns are not stored, so there is nothing to index
+		for _, name := range indexs {
+			for i, col := range tableDef.Cols {
+				if col.Name == name && generatedAttrs[i] != nil && !generatedAttrs[i].Stored {
+					return errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("Virtual generated column '%s' cannot be indexed", name))
+				}
+			}
+		}
+		if err := buildGeneratedColumns(ctx, tableDef, generatedAttrs); err != nil {
+			return err
+		}
+	}
+
 	if len(primaryKeys) > 0 {
 		tableDef.Defs = append(tableDef.Defs, &plan.TableDef_DefType{
 			Def: &plan.TableDef_DefType_Pk{
@@ -400,6 +479,84 @@ func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef
 	return nil
 }
 
+// buildGeneratedColumns binds the generation expressions of the generated
+// columns. The expressions reference the columns of the table by position,
+// so they can be evaluated on a batch ordered as the table definition.
+func buildGeneratedColumns(ctx CompilerContext, tableDef *TableDef, generatedAttrs map[int]*tree.AttributeGeneratedAlways) error {
+	builder := NewQueryBuilder(plan.Query_SELECT, ctx)
+	bindContext := NewBindContext(builder, nil)
+	nodeID := builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_TABLE_SCAN,
+		TableDef:    tableDef,
+		BindingTags: []int32{builder.genNewTag()},
+	}, bindContext)
+	if err := builder.addBinding(nodeID, tree.AliasClause{}, bindContext); err != nil {
+		return err
+	}
+	binding := bindContext.bindings[0]
+	binding.virtualExprs = make([]*plan.Expr, len(tableDef.Cols))
+	binder := NewGeneratedBinder(builder, bindContext)
+
+	for i, col := range tableDef.Cols {
+		attr, ok := generatedAttrs[i]
+		if !ok {
+			continue
+		}
+		expr, err := binder.BindExpr(attr.Expr, 0, true)
+		if err != nil {
+			return err
+		}
+		if err = checkGeneratedExpr(expr, col.Name, tableDef, generatedAttrs); err != nil {
+			return err
+		}
+		if expr, err = makePlan2CastExpr(expr, col.Typ); err != nil {
+			return err
+		}
+		col.Generated = &plan.GeneratedCol{
+			Expr:         expr,
+			OriginString: tree.String(attr.Expr, dialect.MYSQL),
+			Stored:       attr.Stored,
+		}
+		if !attr.Stored {
+			binding.virtualExprs[i] = expr
+		}
+	}
+	return nil
+}
+
+// checkGeneratedExpr checks the columns and functions referenced by a
+// generation expression and resets its column references to the table.
+func checkGeneratedExpr(expr *plan.Expr, name string, tableDef *TableDef, generatedAttrs map[int]*tree.AttributeGeneratedAlways) error {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_F:
+		f, err := function.GetFunctionByID(exprImpl.F.Func.GetObj())
+		if err != nil {
+			return err
+		}
+		if f.Volatile {
+			return errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("Expression of generated column '%s' contains a disallowed function", name))
+		}
+		for _, arg := range exprImpl.F.Args {
+			if err = checkGeneratedExpr(arg, name, tableDef, generatedAttrs); err != nil {
+				return err
+			}
+		}
+	case *plan.Expr_Col:
+		col := tableDef.Cols[exprImpl.Col.ColPos]
+		if _, ok := generatedAttrs[int(exprImpl.Col.ColPos)]; ok && col.Generated == nil {
+			return errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("Generated column '%s' can refer only to generated columns defined prior to it", name))
+		}
+		if col.AutoIncrement {
+			return errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("Generated column '%s' cannot refer to auto-increment column", name))
+		}
+		exprImpl.Col.RelPos = 0
+	case *plan.Expr_C, *plan.Expr_T:
+	default:
+		return errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("Expression of generated column '%s' is not allowed", name))
+	}
+	return nil
+}
+
 func buildDropTable(stmt *tree.DropTable, ctx CompilerContext) (*Plan, error) {
 	dropTable := &plan.DropTable{
 		IfExists: stmt.IfExists,
@@ -495,6 +652,14 @@ func buildCreateDatabase(stmt *tree.CreateDatabase, ctx CompilerContext) (*Plan,
 		IfNotExists: stmt.IfNotExists,
 		Database:    string(stmt.Name),
 	}
+	for _, option := range stmt.CreateOptions {
+		// the default collation of the tables in the database is the collation_database of the session
+		if opt, ok := option.(*tree.CreateOptionCollate); ok {
+			if _, err := types.ParseCollation(opt.Collate); err != nil {
+				return nil, err
+			}
+		}
+	}
 
 	return &Plan{
 		Plan: &plan.Plan_Ddl{
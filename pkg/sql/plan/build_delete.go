@@ -74,6 +74,8 @@ func buildDeleteSingleTable(stmt *tree.Delete, ctx CompilerContext) (*Plan, erro
 		return nil, fmt.Errorf("the external table is not support delete operation")
 	} else if tableDef.TableType == catalog.SystemViewRel {
 		return nil, fmt.Errorf("view is not support delete operation")
+	} else if tableDef.TableType == catalog.SystemMaterializedRel {
+		return nil, fmt.Errorf("materialized view is not support delete operation, refresh it instead")
 	}
 
 	// optimize to truncate,
@@ -183,6 +185,8 @@ func buildDeleteMultipleTable(stmt *tree.Delete, ctx CompilerContext) (*Plan, er
 			return nil, fmt.Errorf("the external table is not support delete operation")
 		} else if tblDefs[i].TableType == catalog.SystemViewRel {
 			return nil, fmt.Errorf("view is not support delete operation")
+		} else if tblDefs[i].TableType == catalog.SystemMaterializedRel {
+			return nil, fmt.Errorf("materialized view is not support delete operation, refresh it instead")
 		}
 	}
 	tf.baseNameMap = reverseMap(tf.baseNameMap)
@@ -21,6 +21,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
 	"github.com/smartystreets/goconvey/convey"
 )
 
@@ -395,3 +396,51 @@ func runOneExprStmt(opt Optimizer, t *testing.T, sql string) (*plan.Plan, error)
 	}
 	return pl, nil
 }
+
+func TestMergeJoinKeyCollations(t *testing.T) {
+	makeCol := func(relPos int32, collation types.Collation) *Expr {
+		return &Expr{
+			Typ:  &plan.Type{Id: int32(types.T_varchar), Width: 10, Collation: int32(collation)},
+			Expr: &plan.Expr_Col{Col: &plan.ColRef{RelPos: relPos}},
+		}
+	}
+	makeEqual := func(l, r *Expr) *Expr {
+		argTypes := []types.Type{makeTypeByPlan2Expr(l), makeTypeByPlan2Expr(r)}
+		id, _, _, err := function.GetFunctionByName("=", argTypes)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		return &Expr{
+			Typ:  &plan.Type{Id: int32(types.T_bool)},
+			Expr: &plan.Expr_F{F: &plan.Function{Func: &ObjectRef{Obj: id, ObjName: "="}, Args: []*Expr{l, r}}},
+		}
+	}
+
+	builder := NewQueryBuilder(plan.Query_SELECT, nil)
+	builder.qry.Nodes = []*plan.Node{
+		{NodeType: plan.Node_TABLE_SCAN},
+		{NodeType: plan.Node_TABLE_SCAN},
+		{
+			NodeType: plan.Node_JOIN,
+			Children: []int32{0, 1},
+			OnList: []*Expr{
+				makeEqual(makeCol(0, types.CollationGeneralCI), makeCol(1, types.CollationBinary)),
+			},
+		},
+	}
+	if err := builder.mergeJoinKeyCollations(2); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	// the binary key is converted to the collation of the other one
+	args := builder.qry.Nodes[2].OnList[0].GetF().Args
+	if args[1].GetF() == nil || args[1].Typ.Collation != int32(types.CollationGeneralCI) {
+		t.Fatalf("the join key is not converted: %v", args[1])
+	}
+
+	builder.qry.Nodes[2].OnList = []*Expr{
+		makeEqual(makeCol(0, types.CollationGeneralCI), makeCol(1, types.CollationUnicodeCI)),
+	}
+	if err := builder.mergeJoinKeyCollations(2); err == nil {
+		t.Fatalf("the mix of collations is not rejected")
+	}
+}
@@ -57,6 +57,8 @@ func buildInsertValues(stmt *tree.Insert, ctx CompilerContext) (p *Plan, err err
 		return nil, fmt.Errorf("the external table '%s' is not support insert operation", tblName)
 	} else if tblRef.TableType == catalog.SystemViewRel {
 		return nil, fmt.Errorf("view is not support insert operation")
+	} else if tblRef.TableType == catalog.SystemMaterializedRel {
+		return nil, fmt.Errorf("materialized view is not support insert operation, refresh it instead")
 	}
 
 	// build columns
@@ -134,7 +136,7 @@ func buildInsertValues(stmt *tree.Insert, ctx CompilerContext) (p *Plan, err err
 			}
 			// build column
 			for j, col := range explicitCols {
-				expr, err := getDefaultExpr(col.Default, col.Typ)
+				expr, err := getColumnDefaultExpr(col)
 				if err != nil {
 					return nil, err
 				}
@@ -151,11 +153,13 @@ func buildInsertValues(stmt *tree.Insert, ctx CompilerContext) (p *Plan, err err
 			idx := 0
 			for j, col := range explicitCols {
 				if _, ok := row[idx].(*tree.DefaultVal); ok {
-					expr, err := getDefaultExpr(col.Default, col.Typ)
+					expr, err := getColumnDefaultExpr(col)
 					if err != nil {
 						return nil, err
 					}
 					columns[idx].Column = append(columns[idx].Column, expr)
+				} else if col.Generated != nil {
+					return nil, makeGeneratedValueError(col.Name, tblName)
 				} else {
 					binder := NewDefaultBinder(nil, nil, col.Typ)
 					planExpr, err := binder.BindExpr(row[idx], 0, false)
@@ -174,7 +178,7 @@ func buildInsertValues(stmt *tree.Insert, ctx CompilerContext) (p *Plan, err err
 			}
 
 			for _, col := range otherCols {
-				expr, err := getDefaultExpr(col.Default, col.Typ)
+				expr, err := getColumnDefaultExpr(col)
 				if err != nil {
 					return nil, err
 				}
@@ -229,6 +233,8 @@ func buildInsertSelect(stmt *tree.Insert, ctx CompilerContext) (p *Plan, err err
 		return nil, fmt.Errorf("the external table is not support insert operation")
 	} else if tableDef.TableType == catalog.SystemViewRel {
 		return nil, fmt.Errorf("view is not support insert operation")
+	} else if tableDef.TableType == catalog.SystemMaterializedRel {
+		return nil, fmt.Errorf("materialized view is not support insert operation, refresh it instead")
 	}
 
 	valueCount := len(stmt.Columns)
@@ -270,6 +276,11 @@ func getInsertExprs(stmt *tree.Insert, cols []*ColDef, tableDef *TableDef) ([]*E
 	var exprs []*Expr
 
 	if len(stmt.Columns) == 0 {
+		for _, col := range tableDef.Cols {
+			if col.Generated != nil {
+				return nil, makeGeneratedValueError(col.Name, tableDef.Name)
+			}
+		}
 		exprs = make([]*Expr, len(cols))
 		for i := range exprs {
 			exprs[i] = &plan.Expr{
@@ -299,6 +310,9 @@ func getInsertExprs(stmt *tree.Insert, cols []*ColDef, tableDef *TableDef) ([]*E
 		}
 		for i := range exprs {
 			if ref, ok := targetMap[tableDef.Cols[i].GetName()]; ok {
+				if tableDef.Cols[i].Generated != nil {
+					return nil, makeGeneratedValueError(tableDef.Cols[i].Name, tableDef.Name)
+				}
 				exprs[i] = &plan.Expr{
 					Typ: cols[ref].Typ,
 					Expr: &plan.Expr_Col{
@@ -309,7 +323,7 @@ func getInsertExprs(stmt *tree.Insert, cols []*ColDef, tableDef *TableDef) ([]*E
 				}
 			} else {
 				var err error
-				exprs[i], err = getDefaultExpr(tableDef.Cols[i].Default, tableDef.Cols[i].Typ)
+				exprs[i], err = getColumnDefaultExpr(tableDef.Cols[i])
 				if err != nil {
 					return nil, err
 				}
@@ -35,6 +35,9 @@ func buildLoad(stmt *tree.Load, ctx CompilerContext) (*Plan, error) {
 	if tableDef.TableType == catalog.SystemExternalRel {
 		return nil, fmt.Errorf("the external table is not support load operation")
 	}
+	if err := checkExternFormat(stmt.Param, tableDef.Cols); err != nil {
+		return nil, err
+	}
 
 	tableDef.Name2ColIndex = map[string]int32{}
 	node1 := &plan.Node{}
@@ -124,7 +127,12 @@ func GetProjectNode(stmt *tree.Load, ctx CompilerContext, node *plan.Node, Name2
 				if _, ok := Name2ColIndex[realCol.Parts[0]]; !ok {
 					return fmt.Errorf("column '%s' does not exist", realCol.Parts[0])
 				}
-				colToIndex[int32(i)] = realCol.Parts[0]
+				if stmt.Param.Format == tree.PARQUET || stmt.Param.Format == tree.JSONLINE {
+					// the fields of parquet and jsonline are read by the column names
+					colToIndex[Name2ColIndex[realCol.Parts[0]]] = realCol.Parts[0]
+				} else {
+					colToIndex[int32(i)] = realCol.Parts[0]
+				}
 			case *tree.VarExpr:
 				//NOTE:variable like '@abc' will be passed by.
 			default:
@@ -168,3 +176,26 @@ func GetProjectNode(stmt *tree.Load, ctx CompilerContext, node *plan.Node, Name2
 	}
 	return nil
 }
+
+func checkExternFormat(param *tree.ExternParam, cols []*ColDef) error {
+	switch param.Format {
+	case "", tree.CSV, tree.PARQUET:
+		// only keep_order is the option of all the formats
+		if len(param.JsonPaths) > 0 || param.MaxErrors > 0 {
+			return fmt.Errorf("the format '%s' does not support the options", param.Format)
+		}
+		return nil
+	case tree.JSONLINE:
+		for _, p := range param.JsonPaths {
+			found := false
+			for _, col := range cols {
+				found = found || col.Name == p.Column
+			}
+			if !found {
+				return fmt.Errorf("column '%s' of the json path '%s' does not exist", p.Column, p.Path)
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("the format '%s' is not support now", param.Format)
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
@@ -60,7 +61,7 @@ func buildShowCreateTable(stmt *tree.ShowCreateTable, ctx CompilerContext) (*Pla
 	if tableDef == nil {
 		return nil, errors.New(errno.UndefinedTable, fmt.Sprintf("table '%v' doesn't exist", tblName))
 	}
-	if tableDef.TableType == catalog.SystemViewRel {
+	if isViewKind(tableDef.TableType) {
 		newStmt := tree.NewShowCreateView(tree.SetUnresolvedObjectName(1, [3]string{tblName, "", ""}))
 		return buildShowCreateView(newStmt, ctx)
 	}
@@ -101,8 +102,24 @@ func buildShowCreateTable(stmt *tree.ShowCreateTable, ctx CompilerContext) (*Pla
 		}
 		typ := types.Type{Oid: types.T(col.Typ.Id)}
 		typeStr := typ.String()
-		if typ.Oid == types.T_varchar || typ.Oid == types.T_char {
+		switch typ.Oid {
+		case types.T_varchar, types.T_char, types.T_binary, types.T_varbinary, types.T_bit:
 			typeStr += fmt.Sprintf("(%d)", col.Typ.Width)
+		case types.T_enum, types.T_set:
+			typeStr += "('" + strings.Join(col.Typ.Enumvalues, "','") + "')"
+		}
+		if collation := types.Collation(col.Typ.Collation); !collation.IsBinary() {
+			typeStr += " COLLATE " + collation.String()
+		}
+		if g := col.Generated; g != nil {
+			storage := "VIRTUAL"
+			if g.Stored {
+				storage = "STORED"
+			}
+			typeStr += fmt.Sprintf(" GENERATED ALWAYS AS (%s) %s", g.OriginString, storage)
+			if nullOrNot != "NOT NULL" {
+				nullOrNot = "NULL"
+			}
 		}
 		createStr += fmt.Sprintf("`%s` %s %s%s", colName, typeStr, nullOrNot, hasAttrComment)
 		rowCount++
@@ -152,17 +169,15 @@ func buildShowCreateView(stmt *tree.ShowCreateView, ctx CompilerContext) (*Plan,
 	}
 
 	_, tableDef := ctx.Resolve(dbName, tblName)
-	if tableDef == nil || tableDef.TableType != catalog.SystemViewRel {
+	if tableDef == nil || !isViewKind(tableDef.TableType) {
 		return nil, errors.New(errno.UndefinedTable, fmt.Sprintf("view '%v' doesn't exist", tblName))
 	}
 	sqlStr := "select \"%s\" as `View`, \"%s\" as `Create View`"
 	var viewStr string
-	if tableDef.TableType == catalog.SystemViewRel {
-		for _, def := range tableDef.Defs {
-			if viewDef, ok := def.Def.(*plan.TableDef_DefType_View); ok {
-				viewStr = viewDef.View.View
-				break
-			}
+	for _, def := range tableDef.Defs {
+		if viewDef, ok := def.Def.(*plan.TableDef_DefType_View); ok {
+			viewStr = viewDef.View.View
+			break
 		}
 	}
 
@@ -219,7 +234,7 @@ func buildShowTables(stmt *tree.ShowTables, ctx CompilerContext) (*Plan, error)
 	}
 
 	ddlType := plan.DataDefinition_SHOW_TABLES
-	sql := fmt.Sprintf("SELECT relname as Tables_in_%s FROM %s.mo_tables WHERE reldatabase = '%s' and relname != '%s'", dbName, MO_CATALOG_DB_NAME, dbName, "%!%mo_increment_columns")
+	sql := fmt.Sprintf("SELECT relname as Tables_in_%s FROM %s.mo_tables WHERE reldatabase = '%s' and relname != '%s' and relname != '%s' and relname != '%s'", dbName, MO_CATALOG_DB_NAME, dbName, "%!%mo_increment_columns", MVRefreshTable, colexec.SEQUENCE_TABLE)
 
 	if stmt.Where != nil {
 		return returnByWhereAndBaseSQL(ctx, sql, stmt.Where, ddlType)
@@ -409,6 +409,25 @@ func TestSingleTableSQLBuilder(t *testing.T) {
 		"select 18446744073709551500",
 		"select 0xffffffffffffffff",
 		"select 0xffff",
+		"select x'4d4f', b'0101', 0x10000000000000000",
+		"select hex(n_name), hex(n_nationkey), unhex(hex(n_name)), bin(n_regionkey) from nation",
+		"select cast(n_name as binary(10)), cast(n_nationkey as char) = x'31' from nation",
+		"select uuid(), cast(uuid() as char), cast(n_name as uuid) = uuid() from nation",
+		"select upper(n_name), ucase(n_comment), lcase(n_name), trim(n_name), trim(leading 'A' from n_name), ascii(n_name), chr(n_nationkey + 65) from nation",
+		"select replace(n_name, 'A', 'a'), translate(n_name, 'AB', 'ab'), left(n_name, 2), right(n_name, n_regionkey), repeat(n_name, 2), mid(n_name, 2, 3) from nation",
+		"select position('A' in n_name), locate('A', n_name, 2), instr(n_name, 'A'), split(n_comment, ' ', 2), substring_index(n_comment, ' ', -1) from nation",
+		"select hex_decode(hex_encode(n_name)), base64_decode(base64_encode(n_name)), upper('abc') = 'ABC' from nation",
+		"select n_regionkey, median(n_nationkey), mode(n_name), var_samp(n_nationkey), stddev_samp(n_nationkey), var_pop(n_nationkey), stddev(n_nationkey) from nation group by n_regionkey",
+		"select corr(n_nationkey, n_regionkey), covar_pop(n_nationkey, n_regionkey), covar_samp(n_nationkey, n_regionkey) from nation",
+		"select percentile_cont(0.9) within group (order by n_nationkey), percentile_disc(0.5) within group (order by n_nationkey desc) from nation group by n_regionkey",
+		"select n_regionkey, group_concat(n_name), group_concat(distinct n_name, n_nationkey order by n_nationkey desc, n_name separator ';') from nation group by n_regionkey",
+		"select array_agg(n_nationkey order by n_name), array_agg(distinct n_name), array_agg(n_regionkey > 1) from nation",
+		"select md5(n_name), sha1(n_name), sha(n_nationkey), sha2(n_name, 256), crc32(n_name) from nation",
+		"select nextval('s'), currval('tpch.s'), setval('s', n_nationkey), setval('s', 10, false), lastval() from nation",
+		"select aes_decrypt(aes_encrypt(n_name, 'key'), 'key'), aes_encrypt(n_name, 'key', '1234567890abcdef') from nation",
+		"select datediff('2022-03-01', '2022-02-01'), timestampdiff(month, '2022-01-31', '2022-03-01 10:00:00'), timestampadd(sql_tsi_hour, n_nationkey, '2022-01-01') from nation",
+		"select convert_tz('2022-01-01 12:00:00', '+00:00', '+08:00'), str_to_date('01,5,2013', '%d,%m,%Y'), last_day('2022-02-10'), makedate(2022, n_nationkey), now(3) from nation",
+		"select hour(current_timestamp()), minute('2022-01-01 10:20:30'), second(cast('10:20:30' as time)), quarter(date '2022-05-01') from nation",
 
 		"SELECT N_REGIONKEY + 2 as a, N_REGIONKEY/2, N_REGIONKEY* N_NATIONKEY, N_REGIONKEY % N_NATIONKEY, N_REGIONKEY - N_NATIONKEY FROM NATION WHERE -N_NATIONKEY < -20", //test more expr
 		"SELECT N_REGIONKEY FROM NATION where N_REGIONKEY >= N_NATIONKEY or (N_NAME like '%ddd' and N_REGIONKEY >0.5)",                                                    //test more expr
@@ -716,6 +735,11 @@ func TestDdl(t *testing.T) {
 		"create table tbl_name (t bool(20), b int unsigned, c char(20), d varchar(20), primary key(b), index idx_t(c)) comment 'test comment'",
 		"create table if not exists tbl_name (b int default 20 primary key, c char(20) default 'ss', d varchar(20) default 'kkk')",
 		"create table if not exists nation (t bool(20), b int, c char(20), d varchar(20))",
+		"create table tbl_name (a enum('small', 'medium', 'large') not null, b set('x', 'y', 'z'))",
+		"create table tbl_name (a binary(4), b varbinary(16) not null, c bit(8), d bit)",
+		"create table tbl_name (a uuid primary key, b int)",
+		"create table tbl_name (a varchar(20) collate utf8mb4_bin, b char(10), c text) collate utf8mb4_general_ci",
+		"create database db_name default collate utf8mb4_0900_ai_ci",
 		"drop table if exists tbl_name",
 		"drop table if exists nation",
 		"drop table nation",
@@ -725,6 +749,16 @@ func TestDdl(t *testing.T) {
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 
+	sqls = []string{
+		"create table tbl_name (a enum('x', 'X'))",
+		"create table tbl_name (a set('x,y', 'z'))",
+		"create table tbl_name (a bit(65))",
+		"create table tbl_name (a varchar(20) collate latin1_swedish_ci)",
+		"create table tbl_name (a varchar(20)) collate latin1_swedish_ci",
+		"create database db_name collate latin1_swedish_ci",
+	}
+	runTestShouldError(mock, t, sqls)
+
 	// should error
 	//sqls = []string{
 	//	"create database tpch",  //we mock database tpch。 so tpch is exist
@@ -741,6 +775,80 @@ func TestDdl(t *testing.T) {
 	//runTestShouldError(mock, t, sqls)
 }
 
+func TestGeneratedColumn(t *testing.T) {
+	mock := NewMockOptimizer()
+	// should pass
+	sqls := []string{
+		"create table gen_t (a int primary key, b date, c int as (a + 1) stored, d int generated always as (month(b)) virtual)",
+		"create table gen_t (a int, b int as (a * 2) stored, c int as (b + 1), index idx_b(b))",
+		"create table gen_t (a int, b int as (a + 1) stored primary key)",
+		"create table gen_t (a datetime, b int as (year(a) * 100 + month(a)) stored) partition by range (b) (partition p0 values less than (202201), partition p1 values less than (maxvalue))",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"create table gen_t (a int, b int as (a + 1) default 1)",
+		"create table gen_t (a int auto_increment, b int as (a + 1))",
+		"create table gen_t (a int, b int as (c + 1), c int as (a + 1))",
+		"create table gen_t (a int, b int as (b + 1))",
+		"create table gen_t (a int, b int as (a + 1) virtual primary key)",
+		"create table gen_t (a int, b int as (a + 1), primary key(b))",
+		"create table gen_t (a timestamp, b bigint as (unix_timestamp(a)))",
+		"create table gen_t (a int, b int as (sum(a)))",
+		"create table gen_t (a int, b int as ((select 1)))",
+		"create table gen_t (a int, b int as (a * 2), index idx_b(b))",
+		"create table gen_t (a int, b int as (a * 2) virtual, primary key(a), index idx_ab(a, b))",
+		"create table gen_t (a datetime, b int as (year(a) * 100 + month(a)) virtual) partition by range (b) (partition p0 values less than (202201), partition p1 values less than (maxvalue))",
+		"create table gen_t (a int, b int as (a * 2)) partition by hash (b + 1) partitions 4",
+		"create table gen_t (a int, b int as (a * 2)) partition by key (b) partitions 4",
+	}
+	runTestShouldError(mock, t, sqls)
+
+	logicPlan, err := runOneStmt(mock, t, "create table gen_t (a int primary key, b date, c int as (a + 1) stored, d int generated always as (month(b)) virtual)")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	tableDef := logicPlan.GetDdl().GetCreateTable().GetTableDef()
+	if tableDef.Cols[2].Generated.Expr.GetF() == nil || !tableDef.Cols[2].Generated.Stored || tableDef.Cols[3].Generated.Stored {
+		t.Fatalf("unexpected generated columns: %v", tableDef.Cols)
+	}
+	mock.ctxt.objects["gen_t"] = &ObjectRef{SchemaName: "tpch", ObjName: "gen_t"}
+	mock.ctxt.tables["gen_t"] = tableDef
+	mock.ctxt.costs["gen_t"] = &plan.Cost{Card: 1}
+
+	// virtual columns are computed when they are read
+	logicPlan, err = runOneStmt(mock, t, "select d from gen_t")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	qry := logicPlan.GetQuery()
+	if qry.Nodes[qry.Steps[0]].ProjectList[0].GetF() == nil {
+		t.Fatalf("virtual column should be computed: %v", qry.Nodes[qry.Steps[0]].ProjectList[0])
+	}
+
+	sqls = []string{
+		"insert into gen_t values (1, '2022-01-01', default, default)",
+		"insert into gen_t (a, b) values (1, '2022-01-01')",
+		"insert into gen_t (a, b) select a, b from gen_t",
+		"select a, c, d from gen_t where d = 1 order by c",
+		"select d, count(*) from gen_t group by d",
+		"update gen_t set b = '2022-02-01' where d = 1",
+		"update gen_t set a = a + 1, c = default",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	sqls = []string{
+		"insert into gen_t values (1, '2022-01-01', 2, 1)",
+		"insert into gen_t (a, b, c) values (1, '2022-01-01', 2)",
+		"insert into gen_t select * from gen_t",
+		"insert into gen_t (a, d) select a, d from gen_t",
+		"update gen_t set c = 1",
+		"update gen_t set d = d + 1",
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
 func TestShow(t *testing.T) {
 	mock := NewMockOptimizer()
 	// should pass
@@ -17,6 +17,7 @@ package plan
 import (
 	"errors"
 	"fmt"
+	"go/constant"
 
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
@@ -59,6 +60,8 @@ func buildUpdate(stmt *tree.Update, ctx CompilerContext) (*Plan, error) {
 			return nil, fmt.Errorf("the external table is not support update operation")
 		} else if tblRef.TableType == catalog.SystemViewRel {
 			return nil, fmt.Errorf("view is not support update operation")
+		} else if tblRef.TableType == catalog.SystemMaterializedRel {
+			return nil, fmt.Errorf("materialized view is not support update operation, refresh it instead")
 		}
 		objRefs = append(objRefs, objRef)
 		tblRefs = append(tblRefs, tblRef)
@@ -70,8 +73,14 @@ func buildUpdate(stmt *tree.Update, ctx CompilerContext) (*Plan, error) {
 		return nil, err
 	}
 
+	// generated columns of the updated tables are always rewritten
+	updateCols, updateExprs, err := buildGeneratedUpdateColumns(updateCols, stmt.Exprs, tblRefs)
+	if err != nil {
+		return nil, err
+	}
+
 	// group update columns
-	updateColsArray, updateExprsArray := groupUpdateAttrs(updateCols, stmt.Exprs)
+	updateColsArray, updateExprsArray := groupUpdateAttrs(updateCols, updateExprs)
 
 	// build update ctx and projection
 	updateCtxs, useProjectExprs, err := buildCtxAndProjection(updateColsArray, updateExprsArray, tf.baseNameMap, tblRefs, ctx)
@@ -465,6 +474,47 @@ func buildUpdateColumns(exprs tree.UpdateExprs, objRefs []*ObjectRef, tblRefs []
 	return updateCols, nil
 }
 
+// buildGeneratedUpdateColumns rejects the explicit values of generated
+// columns, and appends every generated column of the updated tables as an
+// update column with a null placeholder, which is computed when the new
+// rows are written.
+func buildGeneratedUpdateColumns(updateCols []updateCol, exprs tree.UpdateExprs, tblRefs []*TableDef) ([]updateCol, tree.UpdateExprs, error) {
+	newExprs := make(tree.UpdateExprs, len(exprs), len(exprs)+len(updateCols))
+	copy(newExprs, exprs)
+
+	updated := make(map[string]bool)
+	for i, col := range updateCols {
+		updated[col.tblName+"."+col.colDef.Name] = true
+		if col.colDef.Generated == nil {
+			continue
+		}
+		if _, ok := exprs[i].Expr.(*tree.DefaultVal); !ok {
+			return nil, nil, makeGeneratedValueError(col.colDef.Name, col.tblName)
+		}
+		newExprs[i] = &tree.UpdateExpr{Names: exprs[i].Names, Expr: tree.NewNumValWithType(constant.MakeUnknown(), "null", false, tree.P_null)}
+	}
+
+	n := len(updateCols)
+	for i := 0; i < n; i++ {
+		for _, tblRef := range tblRefs {
+			if tblRef.Name != updateCols[i].tblName {
+				continue
+			}
+			for _, colDef := range tblRef.Cols {
+				if colDef.Generated == nil || updated[tblRef.Name+"."+colDef.Name] {
+					continue
+				}
+				updated[tblRef.Name+"."+colDef.Name] = true
+				col := updateCols[i]
+				col.colDef = colDef
+				updateCols = append(updateCols, col)
+				newExprs = append(newExprs, &tree.UpdateExpr{Expr: tree.NewNumValWithType(constant.MakeUnknown(), "null", false, tree.P_null)})
+			}
+		}
+	}
+	return updateCols, newExprs, nil
+}
+
 func isSameColumnType(t1 *Type, t2 *Type) bool {
 	if t1.Id != t2.Id {
 		return false
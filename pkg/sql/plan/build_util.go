@@ -68,6 +68,12 @@ func getTypeFromAst(typ tree.ResolvableTypeReference) (*plan.Type, error) {
 				// create table t1(a char) -> DisplayWith = -1；but get width=1 in MySQL and PgSQL
 				width = 1
 			}
+			if n.InternalType.FamilyString == "binary" { // type binary, the values are padded to the width
+				if width == 0 {
+					width = 1
+				}
+				return &plan.Type{Id: int32(types.T_binary), Size: 24, Width: width}, nil
+			}
 			if n.InternalType.FamilyString == "char" { // type char
 				return &plan.Type{Id: int32(types.T_char), Size: 24, Width: width}, nil
 			}
@@ -81,9 +87,23 @@ func getTypeFromAst(typ tree.ResolvableTypeReference) (*plan.Type, error) {
 			if n.InternalType.FamilyString == "char" { // type char
 				return &plan.Type{Id: int32(types.T_char), Size: 24, Width: width}, nil
 			}
+			if n.InternalType.FamilyString == "binary" || n.InternalType.FamilyString == "varbinary" { // type varbinary
+				return &plan.Type{Id: int32(types.T_varbinary), Size: 24, Width: n.InternalType.DisplayWith}, nil
+			}
 			return &plan.Type{Id: int32(types.T_varchar), Size: 24, Width: width}, nil
+		case defines.MYSQL_TYPE_BIT:
+			width := n.InternalType.DisplayWith
+			if width <= 0 {
+				width = 1
+			}
+			if width > types.MaxBitLen {
+				return nil, errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("Display width out of range for column BIT (max = %d)", types.MaxBitLen))
+			}
+			return &plan.Type{Id: int32(types.T_bit), Size: 8, Width: width}, nil
 		case defines.MYSQL_TYPE_DATE:
 			return &plan.Type{Id: int32(types.T_date), Size: 4}, nil
+		case defines.MYSQL_TYPE_TIME:
+			return &plan.Type{Id: int32(types.T_time), Size: 8, Width: n.InternalType.Width, Precision: n.InternalType.Precision}, nil
 		case defines.MYSQL_TYPE_DATETIME:
 			// currently the ast's width for datetime's is 26, this is not accurate and may need revise, not important though, as we don't need it anywhere else except to differentiate empty vector.Typ.
 			return &plan.Type{Id: int32(types.T_datetime), Size: 8, Width: n.InternalType.Width, Precision: n.InternalType.Precision}, nil
@@ -100,6 +120,18 @@ func getTypeFromAst(typ tree.ResolvableTypeReference) (*plan.Type, error) {
 			return &plan.Type{Id: int32(types.T_blob), Size: 24}, nil
 		case defines.MYSQL_TYPE_JSON:
 			return &plan.Type{Id: int32(types.T_json)}, nil
+		case defines.MYSQL_TYPE_ENUM:
+			if err := types.CheckEnumValues(n.InternalType.EnumValues, false); err != nil {
+				return nil, err
+			}
+			return &plan.Type{Id: int32(types.T_enum), Size: 2, Enumvalues: n.InternalType.EnumValues}, nil
+		case defines.MYSQL_TYPE_SET:
+			if err := types.CheckEnumValues(n.InternalType.EnumValues, true); err != nil {
+				return nil, err
+			}
+			return &plan.Type{Id: int32(types.T_set), Size: 8, Enumvalues: n.InternalType.EnumValues}, nil
+		case defines.MYSQL_TYPE_UUID:
+			return &plan.Type{Id: int32(types.T_uuid), Size: 16}, nil
 		default:
 			return nil, errors.New("", fmt.Sprintf("Data type: '%s', will be supported in future version.", tree.String(&n.InternalType, dialect.MYSQL)))
 		}
@@ -209,17 +241,35 @@ func getDefaultExpr(d *plan.Default, typ *plan.Type) (*Expr, error) {
 		return nil, errors.New("", "invalid default value")
 	}
 	if d.Expr == nil {
-		return &Expr{
-			Expr: &plan.Expr_C{
-				C: &Const{
-					Isnull: true,
-				},
-			},
-			Typ: &plan.Type{
-				Id:       typ.Id,
-				Nullable: true,
-			},
-		}, nil
+		return makeNullExpr(typ), nil
 	}
 	return d.Expr, nil
 }
+
+// getColumnDefaultExpr returns the value of a column which is not given
+// by a write. Generated columns get a null placeholder, their values are
+// computed when the batch is written.
+func getColumnDefaultExpr(col *ColDef) (*Expr, error) {
+	if col.Generated != nil {
+		return makeNullExpr(col.Typ), nil
+	}
+	return getDefaultExpr(col.Default, col.Typ)
+}
+
+func makeGeneratedValueError(colName, tblName string) error {
+	return errors.New(errno.GeneratedAlways, fmt.Sprintf("The value specified for generated column '%s' in table '%s' is not allowed", colName, tblName))
+}
+
+func makeNullExpr(typ *plan.Type) *Expr {
+	return &Expr{
+		Expr: &plan.Expr_C{
+			C: &Const{
+				Isnull: true,
+			},
+		},
+		Typ: &plan.Type{
+			Id:       typ.Id,
+			Nullable: true,
+		},
+	}
+}
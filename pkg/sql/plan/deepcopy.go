@@ -107,16 +107,19 @@ func DeepCopyNode(node *plan.Node) *plan.Node {
 				Name: col.Name,
 				Alg:  col.Alg,
 				Typ: &plan.Type{
-					Id:        col.Typ.Id,
-					Nullable:  col.Typ.Nullable,
-					Width:     col.Typ.Width,
-					Precision: col.Typ.Precision,
-					Size:      col.Typ.Size,
-					Scale:     col.Typ.Scale,
+					Id:         col.Typ.Id,
+					Nullable:   col.Typ.Nullable,
+					Width:      col.Typ.Width,
+					Precision:  col.Typ.Precision,
+					Size:       col.Typ.Size,
+					Scale:      col.Typ.Scale,
+					Enumvalues: col.Typ.Enumvalues,
+					Collation:  col.Typ.Collation,
 				},
-				Default: DeepCopyDefault(col.Default),
-				Primary: col.Primary,
-				Pkidx:   col.Pkidx,
+				Default:   DeepCopyDefault(col.Default),
+				Primary:   col.Primary,
+				Pkidx:     col.Pkidx,
+				Generated: DeepCopyGeneratedCol(col.Generated),
 			}
 		}
 		copy(newNode.UpdateCtxs[i].OtherAttrs, updateCtx.OtherAttrs)
@@ -201,21 +204,35 @@ func DeepCopyDefault(def *plan.Default) *plan.Default {
 	}
 }
 
+func DeepCopyGeneratedCol(g *plan.GeneratedCol) *plan.GeneratedCol {
+	if g == nil {
+		return nil
+	}
+	return &plan.GeneratedCol{
+		Expr:         DeepCopyExpr(g.Expr),
+		OriginString: g.OriginString,
+		Stored:       g.Stored,
+	}
+}
+
 func DeepCopyColDef(col *plan.ColDef) *plan.ColDef {
 	return &plan.ColDef{
 		Name: col.Name,
 		Alg:  col.Alg,
 		Typ: &plan.Type{
-			Id:        col.Typ.Id,
-			Nullable:  col.Typ.Nullable,
-			Width:     col.Typ.Width,
-			Precision: col.Typ.Precision,
-			Size:      col.Typ.Size,
-			Scale:     col.Typ.Scale,
+			Id:         col.Typ.Id,
+			Nullable:   col.Typ.Nullable,
+			Width:      col.Typ.Width,
+			Precision:  col.Typ.Precision,
+			Size:       col.Typ.Size,
+			Scale:      col.Typ.Scale,
+			Enumvalues: col.Typ.Enumvalues,
+			Collation:  col.Typ.Collation,
 		},
-		Default: DeepCopyDefault(col.Default),
-		Primary: col.Primary,
-		Pkidx:   col.Pkidx,
+		Default:   DeepCopyDefault(col.Default),
+		Primary:   col.Primary,
+		Pkidx:     col.Pkidx,
+		Generated: DeepCopyGeneratedCol(col.Generated),
 	}
 }
 
@@ -190,6 +190,46 @@ var aggregates = map[int]Functions{
 				ReturnTyp:     types.T_blob,
 				AggregateInfo: aggregate.Max,
 			},
+			{
+				Index:         19,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_time},
+				ReturnTyp:     types.T_time,
+				AggregateInfo: aggregate.Max,
+			},
+			{
+				Index:         20,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_binary},
+				ReturnTyp:     types.T_binary,
+				AggregateInfo: aggregate.Max,
+			},
+			{
+				Index:         21,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_varbinary},
+				ReturnTyp:     types.T_varbinary,
+				AggregateInfo: aggregate.Max,
+			},
+			{
+				Index:         22,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_bit},
+				ReturnTyp:     types.T_bit,
+				AggregateInfo: aggregate.Max,
+			},
+			{
+				Index:         23,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_uuid},
+				ReturnTyp:     types.T_uuid,
+				AggregateInfo: aggregate.Max,
+			},
 		},
 	},
 	MIN: {
@@ -347,6 +387,46 @@ var aggregates = map[int]Functions{
 				ReturnTyp:     types.T_blob,
 				AggregateInfo: aggregate.Min,
 			},
+			{
+				Index:         19,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_time},
+				ReturnTyp:     types.T_time,
+				AggregateInfo: aggregate.Min,
+			},
+			{
+				Index:         20,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_binary},
+				ReturnTyp:     types.T_binary,
+				AggregateInfo: aggregate.Min,
+			},
+			{
+				Index:         21,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_varbinary},
+				ReturnTyp:     types.T_varbinary,
+				AggregateInfo: aggregate.Min,
+			},
+			{
+				Index:         22,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_bit},
+				ReturnTyp:     types.T_bit,
+				AggregateInfo: aggregate.Min,
+			},
+			{
+				Index:         23,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_uuid},
+				ReturnTyp:     types.T_uuid,
+				AggregateInfo: aggregate.Min,
+			},
 		},
 	},
 	SUM: {
@@ -918,6 +998,380 @@ var aggregates = map[int]Functions{
 				ReturnTyp:     types.T_blob,
 				AggregateInfo: aggregate.AnyValue,
 			},
+			{
+				Index:         19,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_time},
+				ReturnTyp:     types.T_time,
+				AggregateInfo: aggregate.AnyValue,
+			},
+			{
+				Index:         20,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_binary},
+				ReturnTyp:     types.T_binary,
+				AggregateInfo: aggregate.AnyValue,
+			},
+			{
+				Index:         21,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_varbinary},
+				ReturnTyp:     types.T_varbinary,
+				AggregateInfo: aggregate.AnyValue,
+			},
+			{
+				Index:         22,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_bit},
+				ReturnTyp:     types.T_bit,
+				AggregateInfo: aggregate.AnyValue,
+			},
+			{
+				Index:         23,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_uuid},
+				ReturnTyp:     types.T_uuid,
+				AggregateInfo: aggregate.AnyValue,
+			},
+		},
+	},
+	MODE: {
+		Id:          MODE,
+		TypeCheckFn: generalTypeCheckForUnaryAggregate,
+		Overloads: []Function{
+			{
+				Index:         0,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_uint8},
+				ReturnTyp:     types.T_uint8,
+				AggregateInfo: aggregate.Mode,
+			},
+			{
+				Index:         1,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_uint16},
+				ReturnTyp:     types.T_uint16,
+				AggregateInfo: aggregate.Mode,
+			},
+			{
+				Index:         2,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_uint32},
+				ReturnTyp:     types.T_uint32,
+				AggregateInfo: aggregate.Mode,
+			},
+			{
+				Index:         3,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_uint64},
+				ReturnTyp:     types.T_uint64,
+				AggregateInfo: aggregate.Mode,
+			},
+			{
+				Index:         4,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_int8},
+				ReturnTyp:     types.T_int8,
+				AggregateInfo: aggregate.Mode,
+			},
+			{
+				Index:         5,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_int16},
+				ReturnTyp:     types.T_int16,
+				AggregateInfo: aggregate.Mode,
+			},
+			{
+				Index:         6,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_int32},
+				ReturnTyp:     types.T_int32,
+				AggregateInfo: aggregate.Mode,
+			},
+			{
+				Index:         7,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_int64},
+				ReturnTyp:     types.T_int64,
+				AggregateInfo: aggregate.Mode,
+			},
+			{
+				Index:         8,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_float32},
+				ReturnTyp:     types.T_float32,
+				AggregateInfo: aggregate.Mode,
+			},
+			{
+				Index:         9,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_float64},
+				ReturnTyp:     types.T_float64,
+				AggregateInfo: aggregate.Mode,
+			},
+			{
+				Index:         10,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_date},
+				ReturnTyp:     types.T_date,
+				AggregateInfo: aggregate.Mode,
+			},
+			{
+				Index:         11,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_datetime},
+				ReturnTyp:     types.T_datetime,
+				AggregateInfo: aggregate.Mode,
+			},
+			{
+				Index:         12,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_decimal64},
+				ReturnTyp:     types.T_decimal64,
+				AggregateInfo: aggregate.Mode,
+			},
+			{
+				Index:         13,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_decimal128},
+				ReturnTyp:     types.T_decimal128,
+				AggregateInfo: aggregate.Mode,
+			},
+			{
+				Index:         14,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_bool},
+				ReturnTyp:     types.T_bool,
+				AggregateInfo: aggregate.Mode,
+			},
+			{
+				Index:         15,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_char},
+				ReturnTyp:     types.T_char,
+				AggregateInfo: aggregate.Mode,
+			},
+			{
+				Index:         16,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_varchar},
+				ReturnTyp:     types.T_varchar,
+				AggregateInfo: aggregate.Mode,
+			},
+			{
+				Index:         17,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_timestamp},
+				ReturnTyp:     types.T_timestamp,
+				AggregateInfo: aggregate.Mode,
+			},
+			{
+				Index:         18,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_blob},
+				ReturnTyp:     types.T_blob,
+				AggregateInfo: aggregate.Mode,
+			},
+			{
+				Index:         19,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_time},
+				ReturnTyp:     types.T_time,
+				AggregateInfo: aggregate.Mode,
+			},
+			{
+				Index:         20,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_binary},
+				ReturnTyp:     types.T_binary,
+				AggregateInfo: aggregate.Mode,
+			},
+			{
+				Index:         21,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_varbinary},
+				ReturnTyp:     types.T_varbinary,
+				AggregateInfo: aggregate.Mode,
+			},
+			{
+				Index:         22,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_bit},
+				ReturnTyp:     types.T_bit,
+				AggregateInfo: aggregate.Mode,
+			},
+			{
+				Index:         23,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_uuid},
+				ReturnTyp:     types.T_uuid,
+				AggregateInfo: aggregate.Mode,
+			},
+		},
+	},
+	MEDIAN: {
+		Id: MEDIAN,
+		Overloads: []Function{
+			{
+				Index:         0,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_float64},
+				ReturnTyp:     types.T_float64,
+				AggregateInfo: aggregate.Median,
+			},
+		},
+	},
+	VAR_SAMPLE: {
+		Id: VAR_SAMPLE,
+		Overloads: []Function{
+			{
+				Index:         0,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_float64},
+				ReturnTyp:     types.T_float64,
+				AggregateInfo: aggregate.VarSample,
+			},
+		},
+	},
+	STDDEV_SAMPLE: {
+		Id: STDDEV_SAMPLE,
+		Overloads: []Function{
+			{
+				Index:         0,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_float64},
+				ReturnTyp:     types.T_float64,
+				AggregateInfo: aggregate.StdDevSample,
+			},
+		},
+	},
+	PERCENTILE_CONT: {
+		Id: PERCENTILE_CONT,
+		Overloads: []Function{
+			{
+				Index:         0,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_float64, types.T_float64},
+				ReturnTyp:     types.T_float64,
+				AggregateInfo: aggregate.PercentileCont,
+			},
+		},
+	},
+	PERCENTILE_DISC: {
+		Id: PERCENTILE_DISC,
+		Overloads: []Function{
+			{
+				Index:         0,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_float64, types.T_float64},
+				ReturnTyp:     types.T_float64,
+				AggregateInfo: aggregate.PercentileDisc,
+			},
+		},
+	},
+	CORR: {
+		Id: CORR,
+		Overloads: []Function{
+			{
+				Index:         0,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_float64, types.T_float64},
+				ReturnTyp:     types.T_float64,
+				AggregateInfo: aggregate.Corr,
+			},
+		},
+	},
+	COVAR_POP: {
+		Id: COVAR_POP,
+		Overloads: []Function{
+			{
+				Index:         0,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_float64, types.T_float64},
+				ReturnTyp:     types.T_float64,
+				AggregateInfo: aggregate.CovarPop,
+			},
+		},
+	},
+	COVAR_SAMPLE: {
+		Id: COVAR_SAMPLE,
+		Overloads: []Function{
+			{
+				Index:         0,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_float64, types.T_float64},
+				ReturnTyp:     types.T_float64,
+				AggregateInfo: aggregate.CovarSample,
+			},
+		},
+	},
+	GROUP_CONCAT: {
+		Id: GROUP_CONCAT,
+		TypeCheckFn: func(_ []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			// group_concat(value, separator, max length, descending flags, keys...)
+			return typeCheckForOrderedAggregate(inputs, []types.T{types.T_varchar, types.T_int64, types.T_int64}, isGroupConcatValue)
+		},
+		Overloads: []Function{
+			{
+				Index:         0,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				ReturnTyp:     types.T_varchar,
+				AggregateInfo: aggregate.GroupConcat,
+			},
+		},
+	},
+	ARRAY_AGG: {
+		Id: ARRAY_AGG,
+		TypeCheckFn: func(_ []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			// array_agg(value, descending flags, keys...)
+			return typeCheckForOrderedAggregate(inputs, []types.T{types.T_int64}, isArrayAggValue)
+		},
+		Overloads: []Function{
+			{
+				Index:         0,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				ReturnTyp:     types.T_json,
+				AggregateInfo: aggregate.ArrayAgg,
+			},
 		},
 	},
 }
@@ -0,0 +1,32 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/addtime"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func AddTime(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultType := types.Type{Oid: types.T_time, Size: 8, Precision: maxPrecision(vectors)}
+	return timeBinary(vectors, proc, resultType, addtime.AddTime)
+}
+
+func AddDatetime(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultType := types.Type{Oid: types.T_datetime, Size: 8, Precision: maxPrecision(vectors)}
+	return timeBinary(vectors, proc, resultType, addtime.AddDatetime)
+}
@@ -0,0 +1,67 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/datefunc"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func DateDiff(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return timeBinary(vectors, proc, types.T_int64.ToType(), datefunc.DateDiff)
+}
+
+// MakeDate returns null for the invalid dates
+func MakeDate(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	nsp := new(nulls.Nulls)
+	resultVector, err := timeBinary(vectors, proc, types.T_date.ToType(), func(years, ydays []int64, rs []types.Date) []types.Date {
+		return datefunc.MakeDate(years, ydays, rs, nsp)
+	})
+	if err != nil {
+		return nil, err
+	}
+	nulls.Set(resultVector.Nsp, nsp)
+	return resultVector, nil
+}
+
+// StrToDate returns null for the strings which do not match the formats
+func StrToDate(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultType := types.T_datetime.ToType()
+	left, right := vectors[0], vectors[1]
+	if left.IsScalarNull() || right.IsScalarNull() {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	leftValues, rightValues := vector.MustBytesCols(left), vector.MustBytesCols(right)
+	if left.IsScalar() && right.IsScalar() {
+		resultVector := vector.NewConst(resultType, 1)
+		vector.SetCol(resultVector, datefunc.StrToDate(leftValues, rightValues, make([]types.Datetime, 1), resultVector.Nsp))
+		return resultVector, nil
+	}
+	length := len(leftValues.Lengths)
+	if left.IsScalar() {
+		length = len(rightValues.Lengths)
+	}
+	resultVector, err := proc.AllocVector(resultType, int64(int(resultType.Size)*length))
+	if err != nil {
+		return nil, err
+	}
+	resultValues := types.DecodeDatetimeSlice(resultVector.Data)[:length]
+	nulls.Or(left.Nsp, right.Nsp, resultVector.Nsp)
+	vector.SetCol(resultVector, datefunc.StrToDate(leftValues, rightValues, resultValues, resultVector.Nsp))
+	return resultVector, nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDateDiff(t *testing.T) {
+	proc := testutil.NewProc()
+	got, err := DateDiff([]*vector.Vector{
+		testutil.MakeDateVector([]string{"2007-12-31", "2010-11-30", ""}, []uint64{2}),
+		testutil.MakeScalarDate("2007-12-30", 3),
+	}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 1066}, vector.MustTCols[int64](got)[:2])
+	require.True(t, nulls.Contains(got.Nsp, 2))
+}
+
+func TestMakeDate(t *testing.T) {
+	proc := testutil.NewProc()
+	got, err := MakeDate([]*vector.Vector{
+		testutil.MakeInt64Vector([]int64{2011, 2011, 2011}, nil),
+		testutil.MakeInt64Vector([]int64{31, 32, 0}, nil),
+	}, proc)
+	require.NoError(t, err)
+	col := vector.MustTCols[types.Date](got)
+	require.Equal(t, "2011-01-31", col[0].String())
+	require.Equal(t, "2011-02-01", col[1].String())
+	require.True(t, nulls.Contains(got.Nsp, 2))
+
+	got, err = MakeDate([]*vector.Vector{testutil.MakeScalarInt64(2011, 1), testutil.MakeScalarInt64(0, 1)}, proc)
+	require.NoError(t, err)
+	require.True(t, got.IsScalarNull())
+}
+
+func TestStrToDate(t *testing.T) {
+	proc := testutil.NewProc()
+	got, err := StrToDate([]*vector.Vector{
+		testutil.MakeVarcharVector([]string{"01,5,2013", "May 1, 2013", "2013-13-01"}, nil),
+		testutil.MakeVarcharVector([]string{"%d,%m,%Y", "%M %d,%Y", "%Y-%m-%d"}, nil),
+	}, proc)
+	require.NoError(t, err)
+	col := vector.MustTCols[types.Datetime](got)
+	require.Equal(t, "2013-05-01 00:00:00", col[0].String())
+	require.Equal(t, "2013-05-01 00:00:00", col[1].String())
+	require.True(t, nulls.Contains(got.Nsp, 2))
+
+	got, err = StrToDate([]*vector.Vector{
+		testutil.MakeScalarVarchar("2009-10-18 09:30:17 pm", 1),
+		testutil.MakeScalarVarchar("%Y-%m-%d %h:%i:%s %p", 1),
+	}, proc)
+	require.NoError(t, err)
+	require.Equal(t, "2009-10-18 21:30:17", vector.MustTCols[types.Datetime](got)[0].String())
+}
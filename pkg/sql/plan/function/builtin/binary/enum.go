@@ -0,0 +1,133 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// The value list of the enum and set column is not a part of the types.Type, so the planner passes it
+// to these functions as the second argument, encoded by types.EncodeEnumValues.
+
+// CastToEnum converts the strings or the ordinals to be the enum values
+func CastToEnum(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return castToEnum(vectors, proc, types.T_enum, types.ParseEnum, types.ParseEnumIndex)
+}
+
+// CastToSet converts the strings or the bitmaps to be the set values
+func CastToSet(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return castToEnum(vectors, proc, types.T_set, types.ParseSet, types.ParseSetBitmap)
+}
+
+// EnumToString converts the enum values to be the strings
+func EnumToString(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return enumToString(vectors, proc, types.EnumString)
+}
+
+// SetToString converts the set values to be the comma separated strings
+func SetToString(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return enumToString(vectors, proc, types.SetString)
+}
+
+func castToEnum[R uint16 | uint64](vectors []*vector.Vector, proc *process.Process, oid types.T,
+	parseStr func([]string, string) (R, error), parseNum func([]string, uint64) (R, error)) (*vector.Vector, error) {
+	inputVector := vectors[0]
+	resultType := oid.ToType()
+	values, err := types.DecodeEnumValues(string(vector.MustBytesCols(vectors[1]).Get(0)))
+	if err != nil {
+		return nil, err
+	}
+	if inputVector.ConstVectorIsNull() {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	parse := func(i int64) (R, error) {
+		switch inputVector.Typ.Oid {
+		case types.T_int64:
+			v := vector.MustTCols[int64](inputVector)[i]
+			if v < 0 {
+				return parseStr(values, strconv.FormatInt(v, 10))
+			}
+			return parseNum(values, uint64(v))
+		case types.T_uint64:
+			return parseNum(values, vector.MustTCols[uint64](inputVector)[i])
+		default:
+			return parseStr(values, string(vector.MustBytesCols(inputVector).Get(i)))
+		}
+	}
+	length := vector.Length(inputVector)
+	var resultVector *vector.Vector
+	var resultValues []R
+	if inputVector.IsScalar() {
+		resultVector = proc.AllocScalarVector(resultType)
+		resultValues = make([]R, 1)
+	} else {
+		if resultVector, err = proc.AllocVector(resultType, int64(resultType.Size)*int64(length)); err != nil {
+			return nil, err
+		}
+		resultValues = types.DecodeFixedSlice[R](resultVector.Data, int(resultType.Size))[:length]
+	}
+	for i := range resultValues {
+		if nulls.Contains(inputVector.Nsp, uint64(i)) {
+			continue
+		}
+		if resultValues[i], err = parse(int64(i)); err != nil {
+			return nil, err
+		}
+	}
+	nulls.Set(resultVector.Nsp, inputVector.Nsp)
+	vector.SetCol(resultVector, resultValues)
+	return resultVector, nil
+}
+
+func enumToString[T uint16 | uint64](vectors []*vector.Vector, proc *process.Process, toString func([]string, T) string) (*vector.Vector, error) {
+	inputVector := vectors[0]
+	resultType := types.T_varchar.ToType()
+	values, err := types.DecodeEnumValues(string(vector.MustBytesCols(vectors[1]).Get(0)))
+	if err != nil {
+		return nil, err
+	}
+	if inputVector.ConstVectorIsNull() {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	inputValues := vector.MustTCols[T](inputVector)
+	col := &types.Bytes{
+		Offsets: make([]uint32, len(inputValues)),
+		Lengths: make([]uint32, len(inputValues)),
+	}
+	for i, v := range inputValues {
+		s := ""
+		if !nulls.Contains(inputVector.Nsp, uint64(i)) {
+			s = toString(values, v)
+		}
+		col.Offsets[i] = uint32(len(col.Data))
+		col.Lengths[i] = uint32(len(s))
+		col.Data = append(col.Data, s...)
+	}
+	resultVector := vector.New(resultType)
+	if inputVector.IsScalar() {
+		resultVector.IsConst = true
+	} else if err = proc.Mp.Gm.Alloc(int64(cap(col.Data))); err != nil {
+		return nil, err
+	}
+	resultVector.Data = col.Data
+	nulls.Set(resultVector.Nsp, inputVector.Nsp)
+	vector.SetCol(resultVector, col)
+	return resultVector, nil
+}
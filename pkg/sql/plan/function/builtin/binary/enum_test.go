@@ -0,0 +1,70 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCastToEnum(t *testing.T) {
+	proc := testutil.NewProc()
+	values := testutil.MakeScalarVarchar(types.EncodeEnumValues([]string{"small", "medium", "large"}), 1)
+
+	vec, err := CastToEnum([]*vector.Vector{testutil.MakeVarcharVector([]string{"large", "", "Small", "2"}, []uint64{1}), values}, proc)
+	require.NoError(t, err)
+	require.Equal(t, types.T_enum, vec.Typ.Oid)
+	require.Equal(t, []uint16{3, 0, 1, 2}, vector.MustTCols[uint16](vec))
+	require.True(t, vec.Nsp.Contains(1))
+
+	vec, err = CastToEnum([]*vector.Vector{testutil.MakeInt64Vector([]int64{3, 1}, nil), values}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []uint16{3, 1}, vector.MustTCols[uint16](vec))
+
+	vec, err = CastToEnum([]*vector.Vector{testutil.MakeScalarVarchar("medium", 1), values}, proc)
+	require.NoError(t, err)
+	require.True(t, vec.IsScalar())
+	require.Equal(t, []uint16{2}, vector.MustTCols[uint16](vec))
+
+	_, err = CastToEnum([]*vector.Vector{testutil.MakeVarcharVector([]string{"tiny"}, nil), values}, proc)
+	require.Error(t, err)
+	_, err = CastToEnum([]*vector.Vector{testutil.MakeInt64Vector([]int64{4}, nil), values}, proc)
+	require.Error(t, err)
+
+	str, err := EnumToString([]*vector.Vector{vec, values}, proc)
+	require.NoError(t, err)
+	require.Equal(t, "medium", string(str.GetString(0)))
+}
+
+func TestCastToSet(t *testing.T) {
+	proc := testutil.NewProc()
+	values := testutil.MakeScalarVarchar(types.EncodeEnumValues([]string{"a", "b", "c"}), 1)
+
+	vec, err := CastToSet([]*vector.Vector{testutil.MakeVarcharVector([]string{"c,a", "", "B", "7"}, nil), values}, proc)
+	require.NoError(t, err)
+	require.Equal(t, types.T_set, vec.Typ.Oid)
+	require.Equal(t, []uint64{5, 0, 2, 7}, vector.MustTCols[uint64](vec))
+
+	_, err = CastToSet([]*vector.Vector{testutil.MakeUint64Vector([]uint64{8}, nil), values}, proc)
+	require.Error(t, err)
+
+	str, err := SetToString([]*vector.Vector{vec, values}, proc)
+	require.NoError(t, err)
+	require.True(t, testutil.CompareVectors(testutil.MakeVarcharVector([]string{"a,c", "", "b", "a,b,c"}, nil), str))
+}
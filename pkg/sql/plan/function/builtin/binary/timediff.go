@@ -0,0 +1,68 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/timediff"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func TimeDiff(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultType := types.Type{Oid: types.T_time, Size: 8, Precision: maxPrecision(vectors)}
+	return timeBinary(vectors, proc, resultType, timediff.TimeDiff)
+}
+
+func DatetimeDiff(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultType := types.Type{Oid: types.T_time, Size: 8, Precision: maxPrecision(vectors)}
+	return timeBinary(vectors, proc, resultType, timediff.DatetimeDiff)
+}
+
+func maxPrecision(vectors []*vector.Vector) int32 {
+	precision := vectors[0].Typ.Precision
+	if vectors[1].Typ.Precision > precision {
+		precision = vectors[1].Typ.Precision
+	}
+	return precision
+}
+
+// timeBinary evaluates fn over the time arguments, fn treats a slice of length 1 as a constant
+func timeBinary[T1, T2 types.Date | types.Time | types.Datetime | int64, R any](vectors []*vector.Vector, proc *process.Process, resultType types.Type, fn func([]T1, []T2, []R) []R) (*vector.Vector, error) {
+	left, right := vectors[0], vectors[1]
+	leftValues, rightValues := vector.MustTCols[T1](left), vector.MustTCols[T2](right)
+	if left.IsScalarNull() || right.IsScalarNull() {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	if left.IsScalar() && right.IsScalar() {
+		resultVector := vector.NewConst(resultType, 1)
+		resultValues := make([]R, 1)
+		vector.SetCol(resultVector, fn(leftValues, rightValues, resultValues))
+		return resultVector, nil
+	}
+	length := len(leftValues)
+	if left.IsScalar() {
+		length = len(rightValues)
+	}
+	resultVector, err := proc.AllocVector(resultType, int64(int(resultType.Size)*length))
+	if err != nil {
+		return nil, err
+	}
+	resultValues := types.DecodeFixedSlice[R](resultVector.Data, int(resultType.Size))[:length]
+	nulls.Or(left.Nsp, right.Nsp, resultVector.Nsp)
+	vector.SetCol(resultVector, fn(leftValues, rightValues, resultValues))
+	return resultVector, nil
+}
@@ -0,0 +1,63 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestTimeDiff(t *testing.T) {
+	convey.Convey("TimeDiffCase", t, func() {
+		left := testutil.MakeTimeVector([]string{"12:00:00", "-838:00:00", "10:00:00.5", ""}, []uint64{3})
+		right := testutil.MakeTimeVector([]string{"13:30:00", "10:00:00", "09:00:00", "00:00:00"}, nil)
+		want := testutil.MakeTimeVector([]string{"-01:30:00", "-838:59:59.999999", "01:00:00.5", ""}, []uint64{3})
+		res, err := TimeDiff([]*vector.Vector{left, right}, testutil.NewProc())
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(testutil.CompareVectors(want, res), convey.ShouldBeTrue)
+	})
+
+	convey.Convey("DatetimeDiffCase", t, func() {
+		left := testutil.MakeDateTimeVector([]string{"2022-08-13 01:00:00", "2022-08-12 10:00:00"}, nil)
+		right := testutil.MakeScalarDateTime("2022-08-12 10:00:00", 2)
+		want := testutil.MakeTimeVector([]string{"15:00:00", "00:00:00"}, nil)
+		res, err := DatetimeDiff([]*vector.Vector{left, right}, testutil.NewProc())
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(testutil.CompareVectors(want, res), convey.ShouldBeTrue)
+	})
+}
+
+func TestAddTime(t *testing.T) {
+	convey.Convey("AddTimeCase", t, func() {
+		left := testutil.MakeTimeVector([]string{"12:00:00", "838:00:00", "-10:00:00"}, nil)
+		right := testutil.MakeScalarTime("01:30:00.25", 3)
+		want := testutil.MakeTimeVector([]string{"13:30:00.25", "838:59:59.999999", "-08:29:59.75"}, nil)
+		res, err := AddTime([]*vector.Vector{left, right}, testutil.NewProc())
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(testutil.CompareVectors(want, res), convey.ShouldBeTrue)
+	})
+
+	convey.Convey("AddDatetimeCase", t, func() {
+		left := testutil.MakeScalarDateTime("2022-08-12 23:00:00", 1)
+		right := testutil.MakeScalarTime("-25:00:00", 1)
+		want := testutil.MakeScalarDateTime("2022-08-11 22:00:00", 1)
+		res, err := AddDatetime([]*vector.Vector{left, right}, testutil.NewProc())
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(testutil.CompareVectors(want, res), convey.ShouldBeTrue)
+	})
+}
@@ -168,6 +168,10 @@ var (
 	DateToDatetime      = dateToDateTime
 	DateTimeToBytes     = datetimeToBytes
 	DateTimeToDate      = datetimeToDate
+	DatetimeToTime      = datetimeToTime
+	TimeToDatetime      = timeToDatetime
+	TimeToBytes         = timeToBytes
+	TimeToInt64         = timeToInt64
 )
 
 func NumericToNumeric[T1, T2 constraints.Integer | constraints.Float](xs []T1, rs []T2) ([]T2, error) {
@@ -435,6 +439,40 @@ func datetimeToBytes(xs []types.Datetime, rs *types.Bytes, precision int32) (*ty
 	return rs, nil
 }
 
+func datetimeToTime(xs []types.Datetime, rs []types.Time, precision int32) ([]types.Time, error) {
+	for i := range xs {
+		rs[i] = xs[i].ToTime(precision)
+	}
+	return rs, nil
+}
+
+// timeToDatetime converts the times to the datetimes on the date
+func timeToDatetime(xs []types.Time, rs []types.Datetime, date types.Date) ([]types.Datetime, error) {
+	for i := range xs {
+		rs[i] = xs[i].ToDatetime(date)
+	}
+	return rs, nil
+}
+
+func timeToBytes(xs []types.Time, rs *types.Bytes, precision int32) (*types.Bytes, error) {
+	oldLen := uint32(0)
+	for _, x := range xs {
+		rs.Data = append(rs.Data, []byte(x.String2(precision))...)
+		newLen := uint32(len(rs.Data))
+		rs.Offsets = append(rs.Offsets, oldLen)
+		rs.Lengths = append(rs.Lengths, newLen-oldLen)
+		oldLen = newLen
+	}
+	return rs, nil
+}
+
+func timeToInt64(xs []types.Time, rs []int64) ([]int64, error) {
+	for i := range xs {
+		rs[i] = xs[i].ToInt64()
+	}
+	return rs, nil
+}
+
 func NumericToTimestamp[T constraints.Integer](xs []T, rs []types.Timestamp) ([]types.Timestamp, error) {
 	for i, x := range xs {
 		rs[i] = types.UnixToTimestamp(int64(x))
@@ -0,0 +1,68 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/cryptofunc"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// Sha2 returns the hexadecimal strings of the sha2 digests of the bit length given by the second parameter
+func Sha2(vecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	args := newStringArgs(vecs)
+	if args == nil {
+		return proc.AllocScalarNullVector(types.T_varchar.ToType()), nil
+	}
+	xs, bits := vector.MustBytesCols(vecs[0]), vector.MustTCols[int64](vecs[1])
+	col := cryptofunc.Sha2(xs, bits, args.newBytesResult(128*args.rowCount), args.nsp)
+	return args.bytesResultVector(col, proc)
+}
+
+// AesEncrypt encrypts the string with the key and the optional initialization vector
+// in the block encryption mode of the session
+func AesEncrypt(vecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return aesCrypt(vecs, types.T_varbinary.ToType(), cryptofunc.AesEncrypt, proc)
+}
+
+// AesDecrypt decrypts the string encrypted by AesEncrypt, the invalid encrypted string gets null
+func AesDecrypt(vecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return aesCrypt(vecs, types.T_varchar.ToType(), cryptofunc.AesDecrypt, proc)
+}
+
+func aesCrypt(vecs []*vector.Vector, resultType types.Type, fn func(mode string, xs, keys, ivs, rs *types.Bytes, nsp *nulls.Nulls) (*types.Bytes, error),
+	proc *process.Process) (*vector.Vector, error) {
+	args := newStringArgs(vecs)
+	if args == nil {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	var ivs *types.Bytes
+	if len(vecs) > 2 {
+		ivs = vector.MustBytesCols(vecs[2])
+	}
+	xs, keys := vector.MustBytesCols(vecs[0]), vector.MustBytesCols(vecs[1])
+	col, err := fn(proc.SessionInfo.BlockEncryptionMode, xs, keys, ivs, args.newBytesResult(len(xs.Data)+16*args.rowCount), args.nsp)
+	if err != nil {
+		return nil, err
+	}
+	resultVector, err := args.bytesResultVector(col, proc)
+	if err != nil {
+		return nil, err
+	}
+	resultVector.Typ = resultType
+	return resultVector, nil
+}
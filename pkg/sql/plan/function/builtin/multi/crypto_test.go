@@ -0,0 +1,72 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSha2(t *testing.T) {
+	proc := testutil.NewProc()
+
+	vec, err := Sha2([]*vector.Vector{
+		testutil.MakeVarcharVector([]string{"abc", "abc", "abc"}, nil),
+		testutil.MakeInt64Vector([]int64{224, 0, 1}, nil),
+	}, proc)
+	require.NoError(t, err)
+	col := vec.Col.(*types.Bytes)
+	require.Equal(t, "23097d223405d8228642a477bda255b32aadbce4bda0b3f7e36c9da7", col.GetString(0))
+	require.Equal(t, "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad", col.GetString(1))
+	require.True(t, nulls.Contains(vec.Nsp, 2))
+
+	vec, err = Sha2([]*vector.Vector{testutil.MakeScalarNull(1), testutil.MakeScalarInt64(256, 1)}, proc)
+	require.NoError(t, err)
+	require.True(t, vec.IsScalarNull())
+}
+
+func TestAes(t *testing.T) {
+	proc := testutil.NewProc()
+	strs := testutil.MakeVarcharVector([]string{"text", "", "text"}, []uint64{2})
+
+	// the default block_encryption_mode is aes-128-ecb
+	vec, err := AesEncrypt([]*vector.Vector{strs, testutil.MakeScalarVarchar("key", 3)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, types.T_varbinary, vec.Typ.Oid)
+	require.True(t, nulls.Contains(vec.Nsp, 2))
+	vec, err = AesDecrypt([]*vector.Vector{vec, testutil.MakeScalarVarchar("key", 3)}, proc)
+	require.NoError(t, err)
+	col := vec.Col.(*types.Bytes)
+	require.Equal(t, "text", col.GetString(0))
+	require.Equal(t, "", col.GetString(1))
+	require.True(t, nulls.Contains(vec.Nsp, 2))
+
+	proc.SessionInfo.BlockEncryptionMode = "aes-256-cbc"
+	iv := testutil.MakeScalarVarchar("1234567890abcdef", 3)
+	vec, err = AesEncrypt([]*vector.Vector{strs, testutil.MakeScalarVarchar("key", 3), iv}, proc)
+	require.NoError(t, err)
+	vec, err = AesDecrypt([]*vector.Vector{vec, testutil.MakeScalarVarchar("key", 3), iv}, proc)
+	require.NoError(t, err)
+	require.Equal(t, "text", vec.Col.(*types.Bytes).GetString(0))
+
+	// the cbc mode requires the initialization vector
+	_, err = AesEncrypt([]*vector.Vector{strs, testutil.MakeScalarVarchar("key", 3)}, proc)
+	require.Error(t, err)
+}
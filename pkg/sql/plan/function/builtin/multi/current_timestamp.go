@@ -15,21 +15,28 @@
 package multi
 
 import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
-// a general round method is needed for timestamp fsp
+// CurrentTimestamp returns the current timestamp truncated to the fractional seconds precision of the parameter
 func CurrentTimestamp(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
 	resultPrecision := int32(6)
 	if len(vectors) == 1 {
-		resultPrecision = int32(vector.MustTCols[int64](vectors[0])[0])
+		fsp := vector.MustTCols[int64](vectors[0])[0]
+		if fsp < 0 || fsp > 6 {
+			return nil, moerr.NewError(moerr.INVALID_ARGUMENT, fmt.Sprintf("too-big precision %d specified for 'now', maximum is 6", fsp))
+		}
+		resultPrecision = int32(fsp)
 	}
 	resultType := types.Type{Oid: types.T_timestamp, Size: 8, Precision: resultPrecision}
 	resultVector := vector.NewConst(resultType, 1)
 	result := make([]types.Timestamp, 1)
-	result[0] = types.CurrentTimestamp()
+	result[0] = types.CurrentTimestamp().Truncate(resultPrecision)
 	vector.SetCol(resultVector, result)
 	return resultVector, nil
 }
@@ -40,4 +40,8 @@ func TestCurrentTimestamp(t *testing.T) {
 	resultValues = vector.MustTCols[types.Timestamp](resultVector)
 	resultStr = resultValues[0].String2(time.Local, resultVector.Typ.Precision)
 	fmt.Println(resultStr)
+	require.Equal(t, types.Timestamp(0), resultValues[0]%1000)
+
+	_, err = CurrentTimestamp([]*vector.Vector{testutil.MakeScalarInt64(7, 1)}, myProc)
+	require.Error(t, err)
 }
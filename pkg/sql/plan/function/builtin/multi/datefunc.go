@@ -0,0 +1,100 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/datefunc"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// TimestampDiff returns the third parameter minus the second one in the unit of the first parameter,
+// which is the constant interval type rewritten by the planner
+func TimestampDiff(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultType := types.T_int64.ToType()
+	if !vectors[0].IsScalar() {
+		return nil, moerr.NewError(moerr.INVALID_ARGUMENT, "the unit of timestampdiff must be a constant")
+	}
+	its := types.IntervalType(vector.MustTCols[int64](vectors[0])[0])
+	left, right := vectors[1], vectors[2]
+	if left.IsScalarNull() || right.IsScalarNull() {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	leftValues, rightValues := vector.MustTCols[types.Datetime](left), vector.MustTCols[types.Datetime](right)
+	if left.IsScalar() && right.IsScalar() {
+		resultValues, err := datefunc.TimestampDiff(its, leftValues, rightValues, make([]int64, 1))
+		if err != nil {
+			return nil, err
+		}
+		resultVector := vector.NewConst(resultType, 1)
+		vector.SetCol(resultVector, resultValues)
+		return resultVector, nil
+	}
+	length := len(leftValues)
+	if left.IsScalar() {
+		length = len(rightValues)
+	}
+	resultVector, err := proc.AllocVector(resultType, int64(int(resultType.Size)*length))
+	if err != nil {
+		return nil, err
+	}
+	resultValues, err := datefunc.TimestampDiff(its, leftValues, rightValues, types.DecodeInt64Slice(resultVector.Data)[:length])
+	if err != nil {
+		resultVector.Free(proc.Mp)
+		return nil, err
+	}
+	nulls.Or(left.Nsp, right.Nsp, resultVector.Nsp)
+	vector.SetCol(resultVector, resultValues)
+	return resultVector, nil
+}
+
+// ConvertTz converts the datetime from the time zone of the second parameter to the one of the third,
+// it returns null for the invalid time zones
+func ConvertTz(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultType := types.T_datetime.ToType()
+	for _, v := range vectors {
+		if v.IsScalarNull() {
+			return proc.AllocScalarNullVector(resultType), nil
+		}
+	}
+	xs := vector.MustTCols[types.Datetime](vectors[0])
+	froms, tos := vector.MustBytesCols(vectors[1]), vector.MustBytesCols(vectors[2])
+	length := 1
+	isConst := true
+	for _, v := range vectors {
+		if !v.IsScalar() {
+			length = vector.Length(v)
+			isConst = false
+		}
+	}
+	if isConst {
+		resultVector := vector.NewConst(resultType, 1)
+		vector.SetCol(resultVector, datefunc.ConvertTz(xs, froms, tos, make([]types.Datetime, 1), resultVector.Nsp))
+		return resultVector, nil
+	}
+	resultVector, err := proc.AllocVector(resultType, int64(int(resultType.Size)*length))
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range vectors {
+		nulls.Set(resultVector.Nsp, v.Nsp)
+	}
+	resultValues := types.DecodeDatetimeSlice(resultVector.Data)[:length]
+	vector.SetCol(resultVector, datefunc.ConvertTz(xs, froms, tos, resultValues, resultVector.Nsp))
+	return resultVector, nil
+}
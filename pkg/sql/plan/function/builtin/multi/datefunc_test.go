@@ -0,0 +1,66 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimestampDiff(t *testing.T) {
+	proc := testutil.NewProc()
+	got, err := TimestampDiff([]*vector.Vector{
+		testutil.MakeScalarInt64(int64(types.Month), 3),
+		testutil.MakeScalarDateTime("2003-02-01 00:00:00", 3),
+		testutil.MakeDateTimeVector([]string{"2003-05-01 00:00:00", "2002-05-01 12:05:55", ""}, []uint64{2}),
+	}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{3, -8}, vector.MustTCols[int64](got)[:2])
+	require.True(t, nulls.Contains(got.Nsp, 2))
+
+	_, err = TimestampDiff([]*vector.Vector{
+		testutil.MakeScalarInt64(int64(types.Day_Hour), 1),
+		testutil.MakeScalarDateTime("2003-02-01 00:00:00", 1),
+		testutil.MakeScalarDateTime("2003-02-01 00:00:00", 1),
+	}, proc)
+	require.Error(t, err)
+}
+
+func TestConvertTz(t *testing.T) {
+	proc := testutil.NewProc()
+	got, err := ConvertTz([]*vector.Vector{
+		testutil.MakeDateTimeVector([]string{"2004-01-01 12:00:00", "2004-01-01 12:00:00", "2004-01-01 12:00:00"}, nil),
+		testutil.MakeScalarVarchar("+00:00", 3),
+		testutil.MakeVarcharVector([]string{"+10:00", "-05:30", "+15:00"}, nil),
+	}, proc)
+	require.NoError(t, err)
+	col := vector.MustTCols[types.Datetime](got)
+	require.Equal(t, "2004-01-01 22:00:00", col[0].String())
+	require.Equal(t, "2004-01-01 06:30:00", col[1].String())
+	require.True(t, nulls.Contains(got.Nsp, 2))
+
+	got, err = ConvertTz([]*vector.Vector{
+		testutil.MakeScalarDateTime("2004-01-01 12:00:00", 1),
+		testutil.MakeScalarNull(1),
+		testutil.MakeScalarVarchar("+10:00", 1),
+	}, proc)
+	require.NoError(t, err)
+	require.True(t, got.IsScalarNull())
+}
@@ -15,6 +15,8 @@
 package multi
 
 import (
+	"strings"
+
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -76,3 +78,21 @@ func TriggerFaultPoint(vecs []*vector.Vector, proc *process.Process) (*vector.Ve
 	}
 	return proc.AllocInt64ScalarVector(iv), nil
 }
+
+// ListFaultPoints returns armed fault points and then the known fault points
+// that are not armed, one per line.
+func ListFaultPoints(vecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	points, err := fault.ListFaultPoints()
+	if err != nil {
+		return nil, err
+	}
+
+	str := strings.Join(points, "\n")
+	resultVector := vector.NewConst(types.Type{Oid: types.T_varchar, Size: 24}, 1)
+	vector.SetCol(resultVector, &types.Bytes{
+		Data:    []byte(str),
+		Offsets: []uint32{0},
+		Lengths: []uint32{uint32(len(str))},
+	})
+	return resultVector, nil
+}
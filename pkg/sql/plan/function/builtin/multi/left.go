@@ -0,0 +1,55 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/strfunc"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// Left returns the leftmost n characters of the string
+func Left(vecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	args := newStringArgs(vecs)
+	if args == nil {
+		return proc.AllocScalarNullVector(types.T_varchar.ToType()), nil
+	}
+	xs, ns := vector.MustBytesCols(vecs[0]), vector.MustTCols[int64](vecs[1])
+	col := strfunc.Left(xs, ns, args.newBytesResult(len(xs.Data)), args.nsp)
+	return args.bytesResultVector(col, proc)
+}
+
+// Right returns the rightmost n characters of the string
+func Right(vecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	args := newStringArgs(vecs)
+	if args == nil {
+		return proc.AllocScalarNullVector(types.T_varchar.ToType()), nil
+	}
+	xs, ns := vector.MustBytesCols(vecs[0]), vector.MustTCols[int64](vecs[1])
+	col := strfunc.Right(xs, ns, args.newBytesResult(len(xs.Data)), args.nsp)
+	return args.bytesResultVector(col, proc)
+}
+
+// Repeat returns the string repeated n times
+func Repeat(vecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	args := newStringArgs(vecs)
+	if args == nil {
+		return proc.AllocScalarNullVector(types.T_varchar.ToType()), nil
+	}
+	xs, ns := vector.MustBytesCols(vecs[0]), vector.MustTCols[int64](vecs[1])
+	col := strfunc.Repeat(xs, ns, args.newBytesResult(len(xs.Data)), args.nsp)
+	return args.bytesResultVector(col, proc)
+}
@@ -0,0 +1,54 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/strfunc"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+/*
+Position returns the position of the first occurrence of the substring, the parameters are
+position(substr, str) and position(substr, str, start) as the locate of mysql.
+*/
+func Position(vecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return position(vecs[0], vecs[1], vecs[2:], proc)
+}
+
+// Instr is the position with the parameters instr(str, substr)
+func Instr(vecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return position(vecs[1], vecs[0], nil, proc)
+}
+
+func position(substrVec, strVec *vector.Vector, startVecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	args := newStringArgs(append([]*vector.Vector{substrVec, strVec}, startVecs...))
+	if args == nil {
+		return proc.AllocScalarNullVector(types.T_int64.ToType()), nil
+	}
+	var starts []int64
+	if len(startVecs) > 0 {
+		starts = vector.MustTCols[int64](startVecs[0])
+	}
+	resultVector, rs, err := args.allocInt64Result(proc)
+	if err != nil {
+		return nil, err
+	}
+	rs = strfunc.Position(vector.MustBytesCols(substrVec), vector.MustBytesCols(strVec), starts, rs, args.nsp)
+	resultVector.Nsp = args.nsp
+	vector.SetCol(resultVector, rs)
+	return resultVector, nil
+}
@@ -0,0 +1,153 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// NextVal advances the sequence once for every row and returns its new values
+func NextVal(vecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return sequenceFunction(vecs, proc, func(seqs process.Sequences, dbName, name string, _ int) (int64, bool, error) {
+		v, err := seqs.NextVal(dbName, name)
+		return v, false, err
+	})
+}
+
+// CurrVal returns the value most recently returned by nextval for the sequence in the session
+func CurrVal(vecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return sequenceFunction(vecs, proc, func(seqs process.Sequences, dbName, name string, _ int) (int64, bool, error) {
+		v, err := seqs.CurrVal(dbName, name)
+		return v, false, err
+	})
+}
+
+// SetVal sets the last value of the sequence, the optional third parameter tells whether
+// the value is used already, the next value of the sequence is the given one if it is false
+func SetVal(vecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	values := vector.MustTCols[int64](vecs[1])
+	var isCalled []bool
+	if len(vecs) > 2 {
+		isCalled = vector.MustTCols[bool](vecs[2])
+	}
+	return sequenceFunction(vecs, proc, func(seqs process.Sequences, dbName, name string, row int) (int64, bool, error) {
+		i := sequenceArgIndex(vecs[1], row)
+		if vecs[1].IsScalarNull() || nulls.Contains(vecs[1].Nsp, uint64(i)) {
+			return 0, true, nil
+		}
+		called := true
+		if len(vecs) > 2 {
+			j := sequenceArgIndex(vecs[2], row)
+			if vecs[2].IsScalarNull() || nulls.Contains(vecs[2].Nsp, uint64(j)) {
+				return 0, true, nil
+			}
+			called = isCalled[j]
+		}
+		return values[i], false, seqs.SetVal(dbName, name, values[i], called)
+	})
+}
+
+// LastVal returns the value most recently returned by nextval in the session, it
+// contains a hidden placeholder parameter
+func LastVal(vecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	if len(vecs) != 1 {
+		return nil, moerr.NewError(moerr.INTERNAL_ERROR, "lastval function requires a hidden parameter")
+	}
+	seqs := proc.SessionInfo.Sequences
+	if seqs == nil {
+		return nil, moerr.NewError(moerr.INTERNAL_ERROR, "lastval is only supported in a session")
+	}
+	rows := vecs[0].Length
+	resultVector, rs, err := allocSequenceResult(rows, proc)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rs {
+		if rs[i], err = seqs.LastVal(); err != nil {
+			vector.Clean(resultVector, proc.Mp)
+			return nil, err
+		}
+	}
+	vector.SetCol(resultVector, rs)
+	return resultVector, nil
+}
+
+// sequenceFunction calls fn for every row with the sequence named by the first parameter,
+// the name without a database refers to the sequence of the current database. The
+// constant parameters are not folded, so the sequence is advanced for every row.
+func sequenceFunction(vecs []*vector.Vector, proc *process.Process, fn func(seqs process.Sequences, dbName, name string, row int) (int64, bool, error)) (*vector.Vector, error) {
+	seqs := proc.SessionInfo.Sequences
+	if seqs == nil {
+		return nil, moerr.NewError(moerr.INTERNAL_ERROR, "sequence functions are only supported in a session")
+	}
+	nameVec := vecs[0]
+	if nameVec.IsScalarNull() {
+		return proc.AllocScalarNullVector(types.T_int64.ToType()), nil
+	}
+	rows := vector.Length(nameVec)
+	if nameVec.IsScalar() {
+		rows = nameVec.Length
+	}
+	resultVector, rs, err := allocSequenceResult(rows, proc)
+	if err != nil {
+		return nil, err
+	}
+	names := vector.MustBytesCols(nameVec)
+	nsp := new(nulls.Nulls)
+	for i := range rs {
+		j := sequenceArgIndex(nameVec, i)
+		if nulls.Contains(nameVec.Nsp, uint64(j)) {
+			nulls.Add(nsp, uint64(i))
+			continue
+		}
+		dbName, name := proc.SessionInfo.Database, strings.ToLower(string(names.Get(int64(j))))
+		if k := strings.IndexByte(name, '.'); k >= 0 {
+			dbName, name = name[:k], name[k+1:]
+		}
+		isNull := false
+		if rs[i], isNull, err = fn(seqs, dbName, name, i); err != nil {
+			vector.Clean(resultVector, proc.Mp)
+			return nil, err
+		}
+		if isNull {
+			nulls.Add(nsp, uint64(i))
+		}
+	}
+	resultVector.Nsp = nsp
+	vector.SetCol(resultVector, rs)
+	return resultVector, nil
+}
+
+func sequenceArgIndex(vec *vector.Vector, row int) int {
+	if vec.IsScalar() {
+		return 0
+	}
+	return row
+}
+
+func allocSequenceResult(rows int, proc *process.Process) (*vector.Vector, []int64, error) {
+	resultType := types.T_int64.ToType()
+	resultVector, err := proc.AllocVector(resultType, int64(resultType.Size)*int64(rows))
+	if err != nil {
+		return nil, nil, err
+	}
+	return resultVector, types.DecodeInt64Slice(resultVector.Data)[:rows], nil
+}
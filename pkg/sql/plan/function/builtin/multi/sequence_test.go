@@ -0,0 +1,117 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// testSequences keeps the sequences in memory, the next value of a sequence is
+// its last value plus one
+type testSequences struct {
+	values  map[string]int64
+	currs   map[string]int64
+	last    int64
+	hasLast bool
+}
+
+func (s *testSequences) NextVal(dbName, name string) (int64, error) {
+	key := dbName + "." + name
+	v, ok := s.values[key]
+	if !ok {
+		return 0, moerr.NewInternalError("sequence '%s' doesn't exist", name)
+	}
+	v++
+	s.values[key], s.currs[key] = v, v
+	s.last, s.hasLast = v, true
+	return v, nil
+}
+
+func (s *testSequences) SetVal(dbName, name string, value int64, isCalled bool) error {
+	if !isCalled {
+		value--
+	}
+	s.values[dbName+"."+name] = value
+	return nil
+}
+
+func (s *testSequences) CurrVal(dbName, name string) (int64, error) {
+	v, ok := s.currs[dbName+"."+name]
+	if !ok {
+		return 0, moerr.NewInternalError("currval of sequence '%s' is not yet defined in this session", name)
+	}
+	return v, nil
+}
+
+func (s *testSequences) LastVal() (int64, error) {
+	if !s.hasLast {
+		return 0, moerr.NewInternalError("lastval is not yet defined in this session")
+	}
+	return s.last, nil
+}
+
+func TestSequenceFunctions(t *testing.T) {
+	proc := testutil.NewProc()
+	_, err := NextVal([]*vector.Vector{testutil.MakeScalarVarchar("s", 1)}, proc)
+	require.Error(t, err)
+
+	seqs := &testSequences{values: map[string]int64{"db.s": 0, "db2.s": 10}, currs: map[string]int64{}}
+	proc.SessionInfo.Database = "db"
+	proc.SessionInfo.Sequences = seqs
+
+	_, err = LastVal([]*vector.Vector{testutil.MakeScalarNull(1)}, proc)
+	require.Error(t, err)
+	_, err = CurrVal([]*vector.Vector{testutil.MakeScalarVarchar("s", 1)}, proc)
+	require.Error(t, err)
+
+	// the constant name advances the sequence for every row
+	res, err := NextVal([]*vector.Vector{testutil.MakeScalarVarchar("S", 3)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 3}, vector.MustTCols[int64](res))
+
+	res, err = NextVal([]*vector.Vector{testutil.MakeVarcharVector([]string{"db2.s", "", "s"}, []uint64{1})}, proc)
+	require.NoError(t, err)
+	require.Equal(t, int64(11), vector.MustTCols[int64](res)[0])
+	require.True(t, nulls.Contains(res.Nsp, 1))
+	require.Equal(t, int64(4), vector.MustTCols[int64](res)[2])
+
+	res, err = CurrVal([]*vector.Vector{testutil.MakeScalarVarchar("db2.s", 1)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{11}, vector.MustTCols[int64](res))
+
+	res, err = LastVal([]*vector.Vector{testutil.MakeScalarNull(2)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{4, 4}, vector.MustTCols[int64](res))
+
+	res, err = SetVal([]*vector.Vector{testutil.MakeScalarVarchar("s", 1), testutil.MakeScalarInt64(100, 1), testutil.MakeScalarBool(false, 1)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{100}, vector.MustTCols[int64](res))
+	res, err = NextVal([]*vector.Vector{testutil.MakeScalarVarchar("s", 1)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{100}, vector.MustTCols[int64](res))
+
+	res, err = SetVal([]*vector.Vector{testutil.MakeScalarVarchar("s", 1), testutil.MakeScalarNull(1)}, proc)
+	require.NoError(t, err)
+	require.True(t, nulls.Contains(res.Nsp, 0))
+
+	_, err = NextVal([]*vector.Vector{testutil.MakeScalarVarchar("t", 1)}, proc)
+	require.Error(t, err)
+}
@@ -0,0 +1,45 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/strfunc"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// Split returns the nth field of the string split by the delimiter, the parameters are split(str, delimiter, n)
+func Split(vecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	args := newStringArgs(vecs)
+	if args == nil {
+		return proc.AllocScalarNullVector(types.T_varchar.ToType()), nil
+	}
+	xs, delims, ns := vector.MustBytesCols(vecs[0]), vector.MustBytesCols(vecs[1]), vector.MustTCols[int64](vecs[2])
+	col := strfunc.Split(xs, delims, ns, args.newBytesResult(len(xs.Data)), args.nsp)
+	return args.bytesResultVector(col, proc)
+}
+
+// SubstringIndex returns the substring before the count-th occurrence of the delimiter,
+// the parameters are substring_index(str, delimiter, count)
+func SubstringIndex(vecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	args := newStringArgs(vecs)
+	if args == nil {
+		return proc.AllocScalarNullVector(types.T_varchar.ToType()), nil
+	}
+	xs, delims, counts := vector.MustBytesCols(vecs[0]), vector.MustBytesCols(vecs[1]), vector.MustTCols[int64](vecs[2])
+	col := strfunc.SubstringIndex(xs, delims, counts, args.newBytesResult(len(xs.Data)), args.nsp)
+	return args.bytesResultVector(col, proc)
+}
@@ -0,0 +1,81 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// stringArgs keeps the row count and the result nulls of the arguments of a string function,
+// the constant argument is taken as the same value for all the rows.
+type stringArgs struct {
+	rowCount int
+	isConst  bool
+	nsp      *nulls.Nulls
+}
+
+// newStringArgs returns nil if any argument is the constant null
+func newStringArgs(vecs []*vector.Vector) *stringArgs {
+	args := &stringArgs{rowCount: 1, isConst: true, nsp: new(nulls.Nulls)}
+	for _, vec := range vecs {
+		if vec.IsScalarNull() {
+			return nil
+		}
+		if !vec.IsScalar() {
+			args.isConst = false
+			args.rowCount = vector.Length(vec)
+			nsp := new(nulls.Nulls)
+			nulls.Or(args.nsp, vec.Nsp, nsp)
+			args.nsp = nsp
+		}
+	}
+	return args
+}
+
+func (args *stringArgs) newBytesResult(capacity int) *types.Bytes {
+	return &types.Bytes{
+		Data:    make([]byte, 0, capacity),
+		Offsets: make([]uint32, args.rowCount),
+		Lengths: make([]uint32, args.rowCount),
+	}
+}
+
+func (args *stringArgs) bytesResultVector(col *types.Bytes, proc *process.Process) (*vector.Vector, error) {
+	resultVector := vector.New(types.T_varchar.ToType())
+	if args.isConst {
+		resultVector.IsConst = true
+	} else if err := proc.Mp.Gm.Alloc(int64(cap(col.Data))); err != nil {
+		return nil, err
+	}
+	resultVector.Data = col.Data
+	resultVector.Nsp = args.nsp
+	vector.SetCol(resultVector, col)
+	return resultVector, nil
+}
+
+func (args *stringArgs) allocInt64Result(proc *process.Process) (*vector.Vector, []int64, error) {
+	resultType := types.T_int64.ToType()
+	if args.isConst {
+		return proc.AllocScalarVector(resultType), make([]int64, 1), nil
+	}
+	resultVector, err := proc.AllocVector(resultType, int64(resultType.Size)*int64(args.rowCount))
+	if err != nil {
+		return nil, nil, err
+	}
+	return resultVector, types.DecodeInt64Slice(resultVector.Data)[:args.rowCount], nil
+}
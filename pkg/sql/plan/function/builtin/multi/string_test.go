@@ -0,0 +1,88 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrim(t *testing.T) {
+	proc := testutil.NewProc()
+
+	// TRIM(str)
+	vec, err := Trim([]*vector.Vector{testutil.MakeVarcharVector([]string{"  a b  ", "", "c"}, []uint64{2})}, proc)
+	require.NoError(t, err)
+	col := vec.Col.(*types.Bytes)
+	require.Equal(t, "a b", col.GetString(0))
+	require.Equal(t, "", col.GetString(1))
+	require.True(t, nulls.Contains(vec.Nsp, 2))
+
+	// TRIM(LEADING 'x' FROM str)
+	vec, err = Trim([]*vector.Vector{
+		testutil.MakeScalarVarchar("leading", 2),
+		testutil.MakeScalarVarchar("x", 2),
+		testutil.MakeVarcharVector([]string{"xxaxx", "b"}, nil),
+	}, proc)
+	require.NoError(t, err)
+	col = vec.Col.(*types.Bytes)
+	require.Equal(t, "axx", col.GetString(0))
+	require.Equal(t, "b", col.GetString(1))
+
+	// constant null argument
+	vec, err = Trim([]*vector.Vector{testutil.MakeScalarNull(1)}, proc)
+	require.NoError(t, err)
+	require.True(t, vec.IsScalarNull())
+}
+
+func TestPositionAndInstr(t *testing.T) {
+	proc := testutil.NewProc()
+	strs := testutil.MakeVarcharVector([]string{"foobarbar", "你好世界", "abc"}, nil)
+
+	vec, err := Position([]*vector.Vector{testutil.MakeScalarVarchar("bar", 3), strs}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{4, 0, 0}, vec.Col.([]int64))
+
+	vec, err = Position([]*vector.Vector{testutil.MakeScalarVarchar("bar", 3), strs, testutil.MakeScalarInt64(5, 3)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{7, 0, 0}, vec.Col.([]int64))
+
+	vec, err = Instr([]*vector.Vector{strs, testutil.MakeVarcharVector([]string{"o", "世", "c"}, nil)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{2, 3, 3}, vec.Col.([]int64))
+}
+
+func TestSplitAndSubstringIndex(t *testing.T) {
+	proc := testutil.NewProc()
+	strs := testutil.MakeVarcharVector([]string{"a,b,c", "x"}, nil)
+	delim := testutil.MakeScalarVarchar(",", 2)
+
+	vec, err := Split([]*vector.Vector{strs, delim, testutil.MakeScalarInt64(-1, 2)}, proc)
+	require.NoError(t, err)
+	col := vec.Col.(*types.Bytes)
+	require.Equal(t, "c", col.GetString(0))
+	require.Equal(t, "x", col.GetString(1))
+
+	vec, err = SubstringIndex([]*vector.Vector{strs, delim, testutil.MakeScalarInt64(2, 2)}, proc)
+	require.NoError(t, err)
+	col = vec.Col.(*types.Bytes)
+	require.Equal(t, "a,b", col.GetString(0))
+	require.Equal(t, "x", col.GetString(1))
+}
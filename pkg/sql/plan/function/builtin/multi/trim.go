@@ -0,0 +1,52 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/strfunc"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+var (
+	trimBoth  = &types.Bytes{Data: []byte("both"), Offsets: []uint32{0}, Lengths: []uint32{4}}
+	trimSpace = &types.Bytes{Data: []byte(" "), Offsets: []uint32{0}, Lengths: []uint32{1}}
+)
+
+/*
+Trim removes the leading and trailing strings, the parameters are
+trim(str), trim(direction, str) and trim(direction, remstr, str),
+the direction is both, leading or trailing, and the remstr is the space by default.
+*/
+func Trim(vecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	args := newStringArgs(vecs)
+	if args == nil {
+		return proc.AllocScalarNullVector(types.T_varchar.ToType()), nil
+	}
+	directions, remstrs := trimBoth, trimSpace
+	switch len(vecs) {
+	case 2:
+		directions = vector.MustBytesCols(vecs[0])
+	case 3:
+		directions, remstrs = vector.MustBytesCols(vecs[0]), vector.MustBytesCols(vecs[1])
+	}
+	xs := vector.MustBytesCols(vecs[len(vecs)-1])
+	col, err := strfunc.Trim(directions, remstrs, xs, args.newBytesResult(len(xs.Data)), args.nsp)
+	if err != nil {
+		return nil, err
+	}
+	return args.bytesResultVector(col, proc)
+}
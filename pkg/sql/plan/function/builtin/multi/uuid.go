@@ -15,45 +15,30 @@
 package multi
 
 import (
-	"github.com/google/uuid"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
-const UUID_LENGTH uint32 = 36
-
 func UUID(inputVecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
 	if len(inputVecs) != 1 {
 		return nil, moerr.NewError(moerr.INTERNAL_ERROR, "uuid function requires a hidden parameter")
 	}
 	rows := inputVecs[0].Length
-	resultType := types.T_varchar.ToType()
-	resultVector := vector.New(resultType)
-
-	results := &types.Bytes{
-		Data:    make([]byte, int(UUID_LENGTH)*rows),
-		Offsets: make([]uint32, rows),
-		Lengths: make([]uint32, rows),
+	resultType := types.T_uuid.ToType()
+	resultVector, err := proc.AllocVector(resultType, int64(types.UuidSize*rows))
+	if err != nil {
+		return nil, err
 	}
-	var retCursor uint32 = 0
-	for i := 0; i < rows; i++ {
-		id, err := uuid.NewUUID()
+	results := types.DecodeUuidSlice(resultVector.Data)[:rows]
+	for i := range results {
+		id, err := types.NewUuid()
 		if err != nil {
+			vector.Clean(resultVector, proc.Mp)
 			return nil, moerr.NewError(moerr.INTERNAL_ERROR, "generation uuid error")
 		}
-		slice := []byte(id.String())
-		for _, b := range slice {
-			results.Data[retCursor] = b
-			retCursor++
-		}
-		if i != 0 {
-			results.Offsets[i] = results.Offsets[i-1] + results.Lengths[i-1]
-		} else {
-			results.Offsets[i] = uint32(0)
-		}
-		results.Lengths[i] = UUID_LENGTH
+		results[i] = id
 	}
 	vector.SetCol(resultVector, results)
 	return resultVector, nil
@@ -32,16 +32,11 @@ func TestUUID(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	bytes := res.Col.(*types.Bytes)
-	uuids := make([]string, 5)
+	uuids := res.Col.([]types.Uuid)
+	require.Equal(t, 5, len(uuids))
 
 	for i := 0; i < 5; i++ {
-		offset := bytes.Offsets[i]
-		length := bytes.Lengths[i]
-		bytes := bytes.Data[offset : offset+length]
-		uuid := string(bytes)
-		uuids[i] = uuid
-		t.Log(uuid)
+		t.Log(uuids[i].String())
 	}
 
 	for i := 0; i < 5; i++ {
@@ -64,15 +59,12 @@ func TestUUID2(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	bytes := res.Col.(*types.Bytes)
-	uuids := make([]string, 1)
+	uuids := res.Col.([]types.Uuid)
+	require.Equal(t, 1, len(uuids))
 
 	for i := 0; i < 1; i++ {
-		offset := bytes.Offsets[i]
-		length := bytes.Lengths[i]
-		bytes := bytes.Data[offset : offset+length]
-		uuid := string(bytes)
-		uuids[i] = uuid
-		t.Log(uuid)
+		_, err := types.ParseUuid(uuids[i].String())
+		require.NoError(t, err)
+		t.Log(uuids[i].String())
 	}
 }
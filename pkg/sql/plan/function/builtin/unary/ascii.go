@@ -0,0 +1,66 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/strfunc"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// Ascii returns the code of the leftmost byte of the string
+func Ascii(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	inputVector := vectors[0]
+	resultType := types.T_int64.ToType()
+	if inputVector.ConstVectorIsNull() {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	inputValues := vector.MustBytesCols(inputVector)
+	if inputVector.IsScalar() {
+		resultVector := proc.AllocScalarVector(resultType)
+		vector.SetCol(resultVector, strfunc.Ascii(inputValues, make([]int64, 1)))
+		return resultVector, nil
+	}
+	length := len(inputValues.Lengths)
+	resultVector, err := proc.AllocVector(resultType, int64(resultType.Size)*int64(length))
+	if err != nil {
+		return nil, err
+	}
+	resultValues := types.DecodeInt64Slice(resultVector.Data)[:length]
+	nulls.Set(resultVector.Nsp, inputVector.Nsp)
+	vector.SetCol(resultVector, strfunc.Ascii(inputValues, resultValues))
+	return resultVector, nil
+}
+
+// Chr returns the character of the unicode code point, the invalid code point gets null
+func Chr(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	inputVector := vectors[0]
+	resultType := types.T_varchar.ToType()
+	if inputVector.ConstVectorIsNull() {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	inputValues := vector.MustTCols[int64](inputVector)
+	nsp := new(nulls.Nulls)
+	nulls.Set(nsp, inputVector.Nsp)
+	col := strfunc.Chr(inputValues, newBytesResult(len(inputValues), len(inputValues)), nsp)
+	resultVector, err := bytesResultVector(inputVector, resultType, col, proc)
+	if err != nil {
+		return nil, err
+	}
+	resultVector.Nsp = nsp
+	return resultVector, nil
+}
@@ -0,0 +1,55 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"encoding/base64"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/strfunc"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func Base64Encode(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	inputVector := vectors[0]
+	resultType := types.T_varchar.ToType()
+	if inputVector.ConstVectorIsNull() {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	inputValues := vector.MustBytesCols(inputVector)
+	col := strfunc.Base64Encode(inputValues, newBytesResult(len(inputValues.Lengths), base64.StdEncoding.EncodedLen(len(inputValues.Data))))
+	return bytesResultVector(inputVector, resultType, col, proc)
+}
+
+// Base64Decode returns the strings decoded from the base64 strings, the invalid string gets null
+func Base64Decode(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	inputVector := vectors[0]
+	resultType := types.T_varchar.ToType()
+	if inputVector.ConstVectorIsNull() {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	inputValues := vector.MustBytesCols(inputVector)
+	nsp := new(nulls.Nulls)
+	nulls.Set(nsp, inputVector.Nsp)
+	col := strfunc.Base64Decode(inputValues, newBytesResult(len(inputValues.Lengths), base64.StdEncoding.DecodedLen(len(inputValues.Data))), nsp)
+	resultVector, err := bytesResultVector(inputVector, resultType, col, proc)
+	if err != nil {
+		return nil, err
+	}
+	resultVector.Nsp = nsp
+	return resultVector, nil
+}
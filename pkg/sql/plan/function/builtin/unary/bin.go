@@ -0,0 +1,45 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/bin"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// BinInt64 returns the binary representation strings of the signed integers
+func BinInt64(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return binInteger(vectors, proc, bin.Int64BitLen, bin.Int64ToBinary)
+}
+
+// BinUint64 returns the binary representation strings of the unsigned integers
+func BinUint64(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return binInteger(vectors, proc, bin.Uint64BitLen, bin.Uint64ToBinary)
+}
+
+func binInteger[T int64 | uint64](vectors []*vector.Vector, proc *process.Process,
+	bitLen func([]T) int64, toBinary func([]T, *types.Bytes) *types.Bytes) (*vector.Vector, error) {
+	inputVector := vectors[0]
+	resultType := types.T_varchar.ToType()
+	if inputVector.ConstVectorIsNull() {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	inputValues := vector.MustTCols[T](inputVector)
+	col := newBytesResult(len(inputValues), 0)
+	col.Data = make([]byte, bitLen(inputValues))
+	return bytesResultVector(inputVector, resultType, toBinary(inputValues, col), proc)
+}
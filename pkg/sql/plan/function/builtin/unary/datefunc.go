@@ -0,0 +1,82 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/datefunc"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func DatetimeHour(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return temporalUnary(vectors, proc, types.T_uint8.ToType(), datefunc.DatetimeHour)
+}
+
+func DatetimeMinute(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return temporalUnary(vectors, proc, types.T_uint8.ToType(), datefunc.DatetimeMinute)
+}
+
+func DatetimeSecond(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return temporalUnary(vectors, proc, types.T_uint8.ToType(), datefunc.DatetimeSecond)
+}
+
+// TimeHour returns int64 since the hour of a time can be up to 838
+func TimeHour(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return temporalUnary(vectors, proc, types.T_int64.ToType(), datefunc.TimeHour)
+}
+
+func TimeMinute(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return temporalUnary(vectors, proc, types.T_uint8.ToType(), datefunc.TimeMinute)
+}
+
+func TimeSecond(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return temporalUnary(vectors, proc, types.T_uint8.ToType(), datefunc.TimeSecond)
+}
+
+func DateQuarter(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return temporalUnary(vectors, proc, types.T_uint8.ToType(), datefunc.DateQuarter)
+}
+
+func DatetimeQuarter(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return temporalUnary(vectors, proc, types.T_uint8.ToType(), datefunc.DatetimeQuarter)
+}
+
+func LastDay(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return temporalUnary(vectors, proc, types.T_date.ToType(), datefunc.LastDay)
+}
+
+// temporalUnary evaluates fn over the date or time argument
+func temporalUnary[T types.Date | types.Datetime | types.Time, R any](vectors []*vector.Vector, proc *process.Process, resultType types.Type, fn func([]T, []R) []R) (*vector.Vector, error) {
+	inputVector := vectors[0]
+	inputValues := vector.MustTCols[T](inputVector)
+	if inputVector.IsScalar() {
+		if inputVector.IsScalarNull() {
+			return proc.AllocScalarNullVector(resultType), nil
+		}
+		resultVector := vector.NewConst(resultType, 1)
+		vector.SetCol(resultVector, fn(inputValues, make([]R, 1)))
+		return resultVector, nil
+	}
+	resultVector, err := proc.AllocVector(resultType, int64(int(resultType.Size)*len(inputValues)))
+	if err != nil {
+		return nil, err
+	}
+	resultValues := types.DecodeFixedSlice[R](resultVector.Data, int(resultType.Size))[:len(inputValues)]
+	nulls.Set(resultVector.Nsp, inputVector.Nsp)
+	vector.SetCol(resultVector, fn(inputValues, resultValues))
+	return resultVector, nil
+}
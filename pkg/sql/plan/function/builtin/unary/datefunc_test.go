@@ -0,0 +1,78 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHourMinuteSecond(t *testing.T) {
+	proc := testutil.NewProc()
+	datetimes := testutil.MakeDateTimeVector([]string{"2022-01-01 10:20:30", "2022-01-01 00:00:00", ""}, []uint64{2})
+
+	got, err := DatetimeHour([]*vector.Vector{datetimes}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []uint8{10, 0}, vector.MustTCols[uint8](got)[:2])
+	require.True(t, nulls.Contains(got.Nsp, 2))
+	got, err = DatetimeMinute([]*vector.Vector{datetimes}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []uint8{20, 0}, vector.MustTCols[uint8](got)[:2])
+	got, err = DatetimeSecond([]*vector.Vector{datetimes}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []uint8{30, 0}, vector.MustTCols[uint8](got)[:2])
+
+	times := testutil.MakeTimeVector([]string{"838:59:58", "-01:02:03"}, nil)
+	got, err = TimeHour([]*vector.Vector{times}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{838, 1}, vector.MustTCols[int64](got))
+	got, err = TimeMinute([]*vector.Vector{times}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []uint8{59, 2}, vector.MustTCols[uint8](got))
+	got, err = TimeSecond([]*vector.Vector{times}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []uint8{58, 3}, vector.MustTCols[uint8](got))
+
+	got, err = DatetimeHour([]*vector.Vector{testutil.MakeScalarDateTime("2022-01-01 23:00:00", 3)}, proc)
+	require.NoError(t, err)
+	require.True(t, got.IsScalar())
+	require.Equal(t, []uint8{23}, vector.MustTCols[uint8](got))
+}
+
+func TestQuarterAndLastDay(t *testing.T) {
+	proc := testutil.NewProc()
+	dates := testutil.MakeDateVector([]string{"2022-01-01", "2022-05-31", "2024-02-01"}, nil)
+
+	got, err := DateQuarter([]*vector.Vector{dates}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []uint8{1, 2, 1}, vector.MustTCols[uint8](got))
+	got, err = DatetimeQuarter([]*vector.Vector{testutil.MakeDateTimeVector([]string{"2022-12-31 23:59:59"}, nil)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []uint8{4}, vector.MustTCols[uint8](got))
+
+	got, err = LastDay([]*vector.Vector{dates}, proc)
+	require.NoError(t, err)
+	want := testutil.MakeDateVector([]string{"2022-01-31", "2022-05-31", "2024-02-29"}, nil)
+	require.Equal(t, vector.MustTCols[types.Date](want), vector.MustTCols[types.Date](got))
+
+	got, err = LastDay([]*vector.Vector{testutil.MakeScalarNull(2)}, proc)
+	require.NoError(t, err)
+	require.True(t, got.IsScalarNull())
+}
@@ -0,0 +1,68 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/cryptofunc"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// Md5 returns the hexadecimal strings of the md5 digests
+func Md5(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return digest(vectors, 32, cryptofunc.Md5, proc)
+}
+
+// Sha1 returns the hexadecimal strings of the sha1 digests
+func Sha1(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return digest(vectors, 40, cryptofunc.Sha1, proc)
+}
+
+func digest(vectors []*vector.Vector, size int, fn func(xs, rs *types.Bytes) *types.Bytes, proc *process.Process) (*vector.Vector, error) {
+	inputVector := vectors[0]
+	resultType := types.T_varchar.ToType()
+	if inputVector.ConstVectorIsNull() {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	inputValues := vector.MustBytesCols(inputVector)
+	col := fn(inputValues, newBytesResult(len(inputValues.Lengths), size*len(inputValues.Lengths)))
+	return bytesResultVector(inputVector, resultType, col, proc)
+}
+
+// Crc32 returns the cyclic redundancy check values of the strings
+func Crc32(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	inputVector := vectors[0]
+	resultType := types.T_uint32.ToType()
+	if inputVector.ConstVectorIsNull() {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	inputValues := vector.MustBytesCols(inputVector)
+	if inputVector.IsScalar() {
+		resultVector := proc.AllocScalarVector(resultType)
+		vector.SetCol(resultVector, cryptofunc.Crc32(inputValues, make([]uint32, 1)))
+		return resultVector, nil
+	}
+	length := len(inputValues.Lengths)
+	resultVector, err := proc.AllocVector(resultType, int64(resultType.Size)*int64(length))
+	if err != nil {
+		return nil, err
+	}
+	resultValues := types.DecodeUint32Slice(resultVector.Data)[:length]
+	nulls.Set(resultVector.Nsp, inputVector.Nsp)
+	vector.SetCol(resultVector, cryptofunc.Crc32(inputValues, resultValues))
+	return resultVector, nil
+}
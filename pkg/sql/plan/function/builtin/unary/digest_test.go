@@ -0,0 +1,50 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDigest(t *testing.T) {
+	proc := testutil.NewProc()
+	inputVec := testutil.MakeVarcharVector([]string{"abc", "", "abc"}, []uint64{2})
+
+	got, err := Md5([]*vector.Vector{inputVec}, proc)
+	require.NoError(t, err)
+	want := testutil.MakeVarcharVector([]string{"900150983cd24fb0d6963f7d28e17f72", "d41d8cd98f00b204e9800998ecf8427e", ""}, []uint64{2})
+	require.True(t, testutil.CompareVectors(want, got))
+
+	got, err = Sha1([]*vector.Vector{inputVec}, proc)
+	require.NoError(t, err)
+	want = testutil.MakeVarcharVector([]string{"a9993e364706816aba3e25717850c26c9cd0d89d", "da39a3ee5e6b4b0d3255bfef95601890afd80709", ""}, []uint64{2})
+	require.True(t, testutil.CompareVectors(want, got))
+
+	got, err = Crc32([]*vector.Vector{inputVec}, proc)
+	require.NoError(t, err)
+	require.True(t, testutil.CompareVectors(testutil.MakeUint32Vector([]uint32{891568578, 0, 0}, []uint64{2}), got))
+
+	got, err = Crc32([]*vector.Vector{testutil.MakeScalarVarchar("MySQL", 2)}, proc)
+	require.NoError(t, err)
+	require.True(t, testutil.CompareVectors(testutil.MakeScalarUint3(3259397556, 2), got))
+
+	got, err = Md5([]*vector.Vector{testutil.MakeScalarNull(3)}, proc)
+	require.NoError(t, err)
+	require.True(t, testutil.CompareVectors(testutil.MakeScalarNull(3), got))
+}
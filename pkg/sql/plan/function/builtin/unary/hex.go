@@ -0,0 +1,107 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/hex"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// HexString returns the hexadecimal strings of the string or binary values
+func HexString(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	inputVector := vectors[0]
+	resultType := types.T_varchar.ToType()
+	if inputVector.ConstVectorIsNull() {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	inputValues := vector.MustBytesCols(inputVector)
+	col := hex.HexString(inputValues, newBytesResult(len(inputValues.Lengths), 2*len(inputValues.Data)))
+	return bytesResultVector(inputVector, resultType, col, proc)
+}
+
+// HexInt64 returns the hexadecimal strings of the signed integers
+func HexInt64(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	inputVector := vectors[0]
+	resultType := types.T_varchar.ToType()
+	if inputVector.ConstVectorIsNull() {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	inputValues := vector.MustTCols[int64](inputVector)
+	col := hex.HexInt64(inputValues, newBytesResult(len(inputValues), 0))
+	return bytesResultVector(inputVector, resultType, col, proc)
+}
+
+// HexUint64 returns the hexadecimal strings of the unsigned integers
+func HexUint64(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	inputVector := vectors[0]
+	resultType := types.T_varchar.ToType()
+	if inputVector.ConstVectorIsNull() {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	inputValues := vector.MustTCols[uint64](inputVector)
+	col := hex.HexUint64(inputValues, newBytesResult(len(inputValues), 0))
+	return bytesResultVector(inputVector, resultType, col, proc)
+}
+
+// Unhex returns the binary strings decoded from the hexadecimal strings, the invalid string gets null
+func Unhex(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return unhex(vectors, types.T_varbinary.ToType(), proc)
+}
+
+// HexDecode is the unhex returning the strings
+func HexDecode(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return unhex(vectors, types.T_varchar.ToType(), proc)
+}
+
+func unhex(vectors []*vector.Vector, resultType types.Type, proc *process.Process) (*vector.Vector, error) {
+	inputVector := vectors[0]
+	if inputVector.ConstVectorIsNull() {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	inputValues := vector.MustBytesCols(inputVector)
+	nsp := new(nulls.Nulls)
+	nulls.Set(nsp, inputVector.Nsp)
+	col := hex.Unhex(inputValues, newBytesResult(len(inputValues.Lengths), len(inputValues.Data)/2), nsp)
+	resultVector, err := bytesResultVector(inputVector, resultType, col, proc)
+	if err != nil {
+		return nil, err
+	}
+	resultVector.Nsp = nsp
+	return resultVector, nil
+}
+
+func newBytesResult(length, capacity int) *types.Bytes {
+	return &types.Bytes{
+		Data:    make([]byte, 0, capacity),
+		Offsets: make([]uint32, length),
+		Lengths: make([]uint32, length),
+	}
+}
+
+func bytesResultVector(inputVector *vector.Vector, resultType types.Type, col *types.Bytes, proc *process.Process) (*vector.Vector, error) {
+	resultVector := vector.New(resultType)
+	if inputVector.IsScalar() {
+		resultVector.IsConst = true
+	} else if err := proc.Mp.Gm.Alloc(int64(cap(col.Data))); err != nil {
+		return nil, err
+	}
+	resultVector.Data = col.Data
+	nulls.Set(resultVector.Nsp, inputVector.Nsp)
+	vector.SetCol(resultVector, col)
+	return resultVector, nil
+}
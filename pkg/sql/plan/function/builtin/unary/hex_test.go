@@ -0,0 +1,71 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHex(t *testing.T) {
+	proc := testutil.NewProc()
+
+	strVec := testutil.MakeVarcharVector([]string{"abc", "", "\x00\xff", "x"}, []uint64{3})
+	got, err := HexString([]*vector.Vector{strVec}, proc)
+	require.NoError(t, err)
+	require.True(t, testutil.CompareVectors(testutil.MakeVarcharVector([]string{"616263", "", "00FF", ""}, []uint64{3}), got))
+
+	intVec := testutil.MakeInt64Vector([]int64{0, 255, -1}, nil)
+	got, err = HexInt64([]*vector.Vector{intVec}, proc)
+	require.NoError(t, err)
+	require.True(t, testutil.CompareVectors(testutil.MakeVarcharVector([]string{"0", "FF", "FFFFFFFFFFFFFFFF"}, nil), got))
+
+	uintVec := testutil.MakeUint64Vector([]uint64{10, 4096}, nil)
+	got, err = HexUint64([]*vector.Vector{uintVec}, proc)
+	require.NoError(t, err)
+	require.True(t, testutil.CompareVectors(testutil.MakeVarcharVector([]string{"A", "1000"}, nil), got))
+
+	got, err = HexString([]*vector.Vector{testutil.MakeScalarNull(3)}, proc)
+	require.NoError(t, err)
+	require.True(t, testutil.CompareVectors(testutil.MakeScalarNull(3), got))
+}
+
+func TestUnhex(t *testing.T) {
+	proc := testutil.NewProc()
+
+	inputVec := testutil.MakeVarcharVector([]string{"616263", "00ff", "F", "xyz", ""}, nil)
+	got, err := Unhex([]*vector.Vector{inputVec}, proc)
+	require.NoError(t, err)
+	want := testutil.MakeVarbinaryVector([]string{"abc", "\x00\xff", "\x0f", "", ""}, []uint64{3})
+	require.True(t, testutil.CompareVectors(want, got))
+}
+
+func TestBin(t *testing.T) {
+	proc := testutil.NewProc()
+
+	intVec := testutil.MakeInt64Vector([]int64{0, 5, -1}, nil)
+	got, err := BinInt64([]*vector.Vector{intVec}, proc)
+	require.NoError(t, err)
+	want := testutil.MakeVarcharVector([]string{"0", "101", "1111111111111111111111111111111111111111111111111111111111111111"}, nil)
+	require.True(t, testutil.CompareVectors(want, got))
+
+	uintVec := testutil.MakeUint64Vector([]uint64{12}, nil)
+	got, err = BinUint64([]*vector.Vector{uintVec}, proc)
+	require.NoError(t, err)
+	require.True(t, testutil.CompareVectors(testutil.MakeVarcharVector([]string{"1100"}, nil), got))
+}
@@ -0,0 +1,72 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpperLower(t *testing.T) {
+	proc := testutil.NewProc()
+	input := testutil.MakeVarcharVector([]string{"Hello", "Ábc", ""}, []uint64{2})
+
+	vec, err := Upper([]*vector.Vector{input}, proc)
+	require.NoError(t, err)
+	col := vec.Col.(*types.Bytes)
+	require.Equal(t, "HELLO", col.GetString(0))
+	require.Equal(t, "ÁBC", col.GetString(1))
+	require.True(t, nulls.Contains(vec.Nsp, 2))
+
+	vec, err = Lower([]*vector.Vector{testutil.MakeScalarVarchar("ÁBC", 1)}, proc)
+	require.NoError(t, err)
+	require.True(t, vec.IsScalar())
+	require.Equal(t, "ábc", vec.Col.(*types.Bytes).GetString(0))
+}
+
+func TestAsciiChr(t *testing.T) {
+	proc := testutil.NewProc()
+
+	vec, err := Ascii([]*vector.Vector{testutil.MakeVarcharVector([]string{"a", "", "2x"}, nil)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{97, 0, 50}, vec.Col.([]int64))
+
+	vec, err = Chr([]*vector.Vector{testutil.MakeInt64Vector([]int64{65, 20320, -1}, nil)}, proc)
+	require.NoError(t, err)
+	col := vec.Col.(*types.Bytes)
+	require.Equal(t, "A", col.GetString(0))
+	require.Equal(t, "你", col.GetString(1))
+	require.True(t, nulls.Contains(vec.Nsp, 2))
+}
+
+func TestBase64(t *testing.T) {
+	proc := testutil.NewProc()
+
+	vec, err := Base64Encode([]*vector.Vector{testutil.MakeVarcharVector([]string{"abc", ""}, nil)}, proc)
+	require.NoError(t, err)
+	col := vec.Col.(*types.Bytes)
+	require.Equal(t, "YWJj", col.GetString(0))
+	require.Equal(t, "", col.GetString(1))
+
+	vec, err = Base64Decode([]*vector.Vector{testutil.MakeVarcharVector([]string{"YWJj", "!!"}, nil)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, "abc", vec.Col.(*types.Bytes).GetString(0))
+	require.True(t, nulls.Contains(vec.Nsp, 1))
+}
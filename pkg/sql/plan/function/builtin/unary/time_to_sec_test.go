@@ -0,0 +1,51 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestTimeToSec(t *testing.T) {
+	convey.Convey("TimeToSecCase", t, func() {
+		in := testutil.MakeTimeVector([]string{"01:00:00", "-00:01:01.9", "838:59:59", ""}, []uint64{3})
+		want := testutil.MakeInt64Vector([]int64{3600, -61, 3020399, 0}, []uint64{3})
+		res, err := TimeToSec([]*vector.Vector{in}, testutil.NewProc())
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(testutil.CompareVectors(want, res), convey.ShouldBeTrue)
+	})
+}
+
+func TestSecToTime(t *testing.T) {
+	convey.Convey("SecToTimeCase", t, func() {
+		in := testutil.MakeInt64Vector([]int64{3600, -61, 4000000, -4000000}, nil)
+		want := testutil.MakeTimeVector([]string{"01:00:00", "-00:01:01", "838:59:59", "-838:59:59"}, nil)
+		res, err := SecToTime([]*vector.Vector{in}, testutil.NewProc())
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(testutil.CompareVectors(want, res), convey.ShouldBeTrue)
+	})
+
+	convey.Convey("ScalarSecToTimeCase", t, func() {
+		in := testutil.MakeScalarInt64(45296, 1)
+		want := testutil.MakeScalarTime("12:34:56", 1)
+		res, err := SecToTime([]*vector.Vector{in}, testutil.NewProc())
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(testutil.CompareVectors(want, res), convey.ShouldBeTrue)
+	})
+}
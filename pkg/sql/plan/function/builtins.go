@@ -207,7 +207,7 @@ var builtins = map[int]Functions{
 				Layout:        STANDARD_FUNCTION,
 				Volatile:      true,
 				AppendHideArg: true,
-				ReturnTyp:     types.T_varchar, Fn: multi.UUID,
+				ReturnTyp:     types.T_uuid, Fn: multi.UUID,
 			},
 		},
 	},
@@ -2059,7 +2059,7 @@ var builtins = map[int]Functions{
 		},
 	},
 	TRIGGER_FAULT_POINT: {
-		Id: REMOVE_FAULT_POINT,
+		Id: TRIGGER_FAULT_POINT,
 		Overloads: []Function{
 			{
 				Index:     0,
@@ -2072,4 +2072,1045 @@ var builtins = map[int]Functions{
 			},
 		},
 	},
+	LIST_FAULT_POINTS: {
+		Id: LIST_FAULT_POINTS,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Volatile:  true,
+				Flag:      plan.Function_INTERNAL,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.ListFaultPoints,
+			},
+		},
+	},
+	TIMEDIFF: {
+		Id: TIMEDIFF,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_time, types.T_time},
+				ReturnTyp: types.T_time,
+				Fn:        binary.TimeDiff,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_datetime, types.T_datetime},
+				ReturnTyp: types.T_time,
+				Fn:        binary.DatetimeDiff,
+			},
+		},
+	},
+	ADDTIME: {
+		Id: ADDTIME,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_time, types.T_time},
+				ReturnTyp: types.T_time,
+				Fn:        binary.AddTime,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_datetime, types.T_time},
+				ReturnTyp: types.T_datetime,
+				Fn:        binary.AddDatetime,
+			},
+		},
+	},
+	TIME_TO_SEC: {
+		Id: TIME_TO_SEC,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_time},
+				ReturnTyp: types.T_int64,
+				Fn:        unary.TimeToSec,
+			},
+		},
+	},
+	SEC_TO_TIME: {
+		Id: SEC_TO_TIME,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_int64},
+				ReturnTyp: types.T_time,
+				Fn:        unary.SecToTime,
+			},
+		},
+	},
+	CAST_TO_ENUM: {
+		Id: CAST_TO_ENUM,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_char, types.T_varchar},
+				ReturnTyp: types.T_enum,
+				Fn:        binary.CastToEnum,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar},
+				ReturnTyp: types.T_enum,
+				Fn:        binary.CastToEnum,
+			},
+			{
+				Index:     2,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_blob, types.T_varchar},
+				ReturnTyp: types.T_enum,
+				Fn:        binary.CastToEnum,
+			},
+			{
+				Index:     3,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_int64, types.T_varchar},
+				ReturnTyp: types.T_enum,
+				Fn:        binary.CastToEnum,
+			},
+			{
+				Index:     4,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_uint64, types.T_varchar},
+				ReturnTyp: types.T_enum,
+				Fn:        binary.CastToEnum,
+			},
+		},
+	},
+	CAST_TO_SET: {
+		Id: CAST_TO_SET,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_char, types.T_varchar},
+				ReturnTyp: types.T_set,
+				Fn:        binary.CastToSet,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar},
+				ReturnTyp: types.T_set,
+				Fn:        binary.CastToSet,
+			},
+			{
+				Index:     2,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_blob, types.T_varchar},
+				ReturnTyp: types.T_set,
+				Fn:        binary.CastToSet,
+			},
+			{
+				Index:     3,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_int64, types.T_varchar},
+				ReturnTyp: types.T_set,
+				Fn:        binary.CastToSet,
+			},
+			{
+				Index:     4,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_uint64, types.T_varchar},
+				ReturnTyp: types.T_set,
+				Fn:        binary.CastToSet,
+			},
+		},
+	},
+	ENUM_TO_STRING: {
+		Id: ENUM_TO_STRING,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_enum, types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        binary.EnumToString,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_set, types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        binary.SetToString,
+			},
+		},
+	},
+	HEX: {
+		Id: HEX,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.HexString,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_char},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.HexString,
+			},
+			{
+				Index:     2,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_blob},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.HexString,
+			},
+			{
+				Index:     3,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_binary},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.HexString,
+			},
+			{
+				Index:     4,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varbinary},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.HexString,
+			},
+			{
+				Index:     5,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_int64},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.HexInt64,
+			},
+			{
+				Index:     6,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_uint64},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.HexUint64,
+			},
+		},
+	},
+	UNHEX: {
+		Id: UNHEX,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar},
+				ReturnTyp: types.T_varbinary,
+				Fn:        unary.Unhex,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_char},
+				ReturnTyp: types.T_varbinary,
+				Fn:        unary.Unhex,
+			},
+			{
+				Index:     2,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_blob},
+				ReturnTyp: types.T_varbinary,
+				Fn:        unary.Unhex,
+			},
+			{
+				Index:     3,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_binary},
+				ReturnTyp: types.T_varbinary,
+				Fn:        unary.Unhex,
+			},
+			{
+				Index:     4,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varbinary},
+				ReturnTyp: types.T_varbinary,
+				Fn:        unary.Unhex,
+			},
+		},
+	},
+	BIN: {
+		Id: BIN,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_int64},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.BinInt64,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_uint64},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.BinUint64,
+			},
+		},
+	},
+	UPPER: {
+		Id: UPPER,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.Upper,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_char},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.Upper,
+			},
+			{
+				Index:     2,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_blob},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.Upper,
+			},
+		},
+	},
+	LOWER: {
+		Id: LOWER,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.Lower,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_char},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.Lower,
+			},
+			{
+				Index:     2,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_blob},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.Lower,
+			},
+		},
+	},
+	ASCII: {
+		Id: ASCII,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar},
+				ReturnTyp: types.T_int64,
+				Fn:        unary.Ascii,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_char},
+				ReturnTyp: types.T_int64,
+				Fn:        unary.Ascii,
+			},
+			{
+				Index:     2,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_blob},
+				ReturnTyp: types.T_int64,
+				Fn:        unary.Ascii,
+			},
+		},
+	},
+	BASE64_ENCODE: {
+		Id: BASE64_ENCODE,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.Base64Encode,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_char},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.Base64Encode,
+			},
+			{
+				Index:     2,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_blob},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.Base64Encode,
+			},
+		},
+	},
+	BASE64_DECODE: {
+		Id: BASE64_DECODE,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.Base64Decode,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_char},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.Base64Decode,
+			},
+			{
+				Index:     2,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_blob},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.Base64Decode,
+			},
+		},
+	},
+	HEX_ENCODE: {
+		Id: HEX_ENCODE,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.HexString,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_char},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.HexString,
+			},
+			{
+				Index:     2,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_blob},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.HexString,
+			},
+		},
+	},
+	HEX_DECODE: {
+		Id: HEX_DECODE,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.HexDecode,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_char},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.HexDecode,
+			},
+			{
+				Index:     2,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_blob},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.HexDecode,
+			},
+		},
+	},
+	CHR: {
+		Id: CHR,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_int64},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.Chr,
+			},
+		},
+	},
+	TRIM: {
+		Id: TRIM,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.Trim,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.Trim,
+			},
+			{
+				Index:     2,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar, types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.Trim,
+			},
+		},
+	},
+	REPLACE: {
+		Id: REPLACE,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar, types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.Replace,
+			},
+		},
+	},
+	TRANSLATE: {
+		Id: TRANSLATE,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar, types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.Translate,
+			},
+		},
+	},
+	LEFT: {
+		Id: LEFT,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_int64},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.Left,
+			},
+		},
+	},
+	RIGHT: {
+		Id: RIGHT,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_int64},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.Right,
+			},
+		},
+	},
+	REPEAT: {
+		Id: REPEAT,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_int64},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.Repeat,
+			},
+		},
+	},
+	POSITION: {
+		Id: POSITION,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar},
+				ReturnTyp: types.T_int64,
+				Fn:        multi.Position,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar, types.T_int64},
+				ReturnTyp: types.T_int64,
+				Fn:        multi.Position,
+			},
+		},
+	},
+	INSTR: {
+		Id: INSTR,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar},
+				ReturnTyp: types.T_int64,
+				Fn:        multi.Instr,
+			},
+		},
+	},
+	SPLIT: {
+		Id: SPLIT,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar, types.T_int64},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.Split,
+			},
+		},
+	},
+	SUBSTRING_INDEX: {
+		Id: SUBSTRING_INDEX,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar, types.T_int64},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.SubstringIndex,
+			},
+		},
+	},
+	MD5: {
+		Id: MD5,
+		TypeCheckFn: func(_ []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			return typeCheckForBytesFunction(inputs, []types.T{types.T_varchar})
+		},
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.Md5,
+			},
+		},
+	},
+	SHA1: {
+		Id: SHA1,
+		TypeCheckFn: func(_ []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			return typeCheckForBytesFunction(inputs, []types.T{types.T_varchar})
+		},
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        unary.Sha1,
+			},
+		},
+	},
+	SHA2: {
+		Id: SHA2,
+		TypeCheckFn: func(_ []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			return typeCheckForBytesFunction(inputs, []types.T{types.T_varchar, types.T_int64})
+		},
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_int64},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.Sha2,
+			},
+		},
+	},
+	CRC32: {
+		Id: CRC32,
+		TypeCheckFn: func(_ []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			return typeCheckForBytesFunction(inputs, []types.T{types.T_varchar})
+		},
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar},
+				ReturnTyp: types.T_uint32,
+				Fn:        unary.Crc32,
+			},
+		},
+	},
+	AES_ENCRYPT: {
+		Id: AES_ENCRYPT,
+		TypeCheckFn: func(_ []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			if len(inputs) == 2 {
+				return typeCheckForBytesFunction(inputs, []types.T{types.T_varchar, types.T_varchar})
+			}
+			return typeCheckForBytesFunction(inputs, []types.T{types.T_varchar, types.T_varchar, types.T_varchar})
+		},
+		Overloads: []Function{
+			{
+				Index:     0,
+				Volatile:  true,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_varbinary,
+				Fn:        multi.AesEncrypt,
+			},
+		},
+	},
+	AES_DECRYPT: {
+		Id: AES_DECRYPT,
+		TypeCheckFn: func(_ []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			if len(inputs) == 2 {
+				return typeCheckForBytesFunction(inputs, []types.T{types.T_varchar, types.T_varchar})
+			}
+			return typeCheckForBytesFunction(inputs, []types.T{types.T_varchar, types.T_varchar, types.T_varchar})
+		},
+		Overloads: []Function{
+			{
+				Index:     0,
+				Volatile:  true,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.AesDecrypt,
+			},
+		},
+	},
+	DATEDIFF: {
+		Id:          DATEDIFF,
+		TypeCheckFn: typeCheckForTemporalFunction,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_date, types.T_date},
+				ReturnTyp: types.T_int64,
+				Fn:        binary.DateDiff,
+			},
+		},
+	},
+	TIMESTAMPDIFF: {
+		Id:          TIMESTAMPDIFF,
+		TypeCheckFn: typeCheckForTemporalFunction,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_int64, types.T_datetime, types.T_datetime},
+				ReturnTyp: types.T_int64,
+				Fn:        multi.TimestampDiff,
+			},
+		},
+	},
+	CONVERT_TZ: {
+		Id:          CONVERT_TZ,
+		TypeCheckFn: typeCheckForTemporalFunction,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_datetime, types.T_varchar, types.T_varchar},
+				ReturnTyp: types.T_datetime,
+				Fn:        multi.ConvertTz,
+			},
+		},
+	},
+	STR_TO_DATE: {
+		Id:          STR_TO_DATE,
+		TypeCheckFn: typeCheckForTemporalFunction,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar},
+				ReturnTyp: types.T_datetime,
+				Fn:        binary.StrToDate,
+			},
+		},
+	},
+	LAST_DAY: {
+		Id:          LAST_DAY,
+		TypeCheckFn: typeCheckForTemporalFunction,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_date},
+				ReturnTyp: types.T_date,
+				Fn:        unary.LastDay,
+			},
+		},
+	},
+	MAKEDATE: {
+		Id:          MAKEDATE,
+		TypeCheckFn: typeCheckForTemporalFunction,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_int64, types.T_int64},
+				ReturnTyp: types.T_date,
+				Fn:        binary.MakeDate,
+			},
+		},
+	},
+	HOUR: {
+		Id:          HOUR,
+		TypeCheckFn: typeCheckForTemporalFunction,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_datetime},
+				ReturnTyp: types.T_uint8,
+				Fn:        unary.DatetimeHour,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_time},
+				ReturnTyp: types.T_int64,
+				Fn:        unary.TimeHour,
+			},
+		},
+	},
+	MINUTE: {
+		Id:          MINUTE,
+		TypeCheckFn: typeCheckForTemporalFunction,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_datetime},
+				ReturnTyp: types.T_uint8,
+				Fn:        unary.DatetimeMinute,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_time},
+				ReturnTyp: types.T_uint8,
+				Fn:        unary.TimeMinute,
+			},
+		},
+	},
+	SECOND: {
+		Id:          SECOND,
+		TypeCheckFn: typeCheckForTemporalFunction,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_datetime},
+				ReturnTyp: types.T_uint8,
+				Fn:        unary.DatetimeSecond,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_time},
+				ReturnTyp: types.T_uint8,
+				Fn:        unary.TimeSecond,
+			},
+		},
+	},
+	QUARTER: {
+		Id:          QUARTER,
+		TypeCheckFn: typeCheckForTemporalFunction,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_date},
+				ReturnTyp: types.T_uint8,
+				Fn:        unary.DateQuarter,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_datetime},
+				ReturnTyp: types.T_uint8,
+				Fn:        unary.DatetimeQuarter,
+			},
+		},
+	},
+	NEXTVAL: {
+		Id: NEXTVAL,
+		TypeCheckFn: func(_ []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			return typeCheckForBytesFunction(inputs, []types.T{types.T_varchar})
+		},
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Volatile:  true,
+				Args:      []types.T{},
+				ReturnTyp: types.T_int64,
+				Fn:        multi.NextVal,
+			},
+		},
+	},
+	SETVAL: {
+		Id: SETVAL,
+		TypeCheckFn: func(_ []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			if len(inputs) == 2 {
+				return typeCheckForBytesFunction(inputs, []types.T{types.T_varchar, types.T_int64})
+			}
+			return typeCheckForBytesFunction(inputs, []types.T{types.T_varchar, types.T_int64, types.T_bool})
+		},
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Volatile:  true,
+				Args:      []types.T{},
+				ReturnTyp: types.T_int64,
+				Fn:        multi.SetVal,
+			},
+		},
+	},
+	CURRVAL: {
+		Id: CURRVAL,
+		TypeCheckFn: func(_ []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			return typeCheckForBytesFunction(inputs, []types.T{types.T_varchar})
+		},
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Volatile:  true,
+				Args:      []types.T{},
+				ReturnTyp: types.T_int64,
+				Fn:        multi.CurrVal,
+			},
+		},
+	},
+	LASTVAL: {
+		// lastval function contains a hidden placeholder parameter
+		Id: LASTVAL,
+		TypeCheckFn: func(_ []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			if len(inputs) == 0 {
+				return int32(0), nil
+			}
+			return wrongFunctionParameters, nil
+		},
+		Overloads: []Function{
+			{
+				Index:         0,
+				Flag:          plan.Function_STRICT,
+				Layout:        STANDARD_FUNCTION,
+				Volatile:      true,
+				AppendHideArg: true,
+				ReturnTyp:     types.T_int64,
+				Fn:            multi.LastVal,
+			},
+		},
+	},
 }
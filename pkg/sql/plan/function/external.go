@@ -0,0 +1,107 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// Overload is an overload of a function registered by RegisterFunction.
+type Overload struct {
+	// Args are the types of the arguments, an argument of another type is
+	// converted to the type implicitly if it's possible.
+	Args []types.T
+
+	// ReturnType resolves the type of the result from the types of the
+	// arguments after conversion.
+	ReturnType func(args []types.Type) types.Type
+
+	// Fn evaluates the function on the vectors of the arguments, each of
+	// which is either a constant or has a value for every row. The result
+	// must be of the type returned by ReturnType.
+	Fn func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error)
+
+	// Volatile function is not folded when all the arguments are constants.
+	Volatile bool
+}
+
+// RegisterFunction adds a vectorized scalar function implemented outside of
+// MO, which is resolved and evaluated like the builtin functions.
+//
+// It must be called before the services are started, e.g. in the init of the
+// embedding program. All the CNs must register the same functions in the same
+// order, because the plan refers to a function by its id.
+func RegisterFunction(name string, overloads []Overload) error {
+	name = strings.ToLower(name)
+	if name == "" {
+		return errors.New(errno.InvalidFunctionDefinition, "function name is empty")
+	}
+	if len(overloads) == 0 {
+		return errors.New(errno.InvalidFunctionDefinition, fmt.Sprintf("function %s has no overload", name))
+	}
+
+	var fs Functions
+	for i, o := range overloads {
+		if o.ReturnType == nil || o.Fn == nil {
+			return errors.New(errno.InvalidFunctionDefinition, fmt.Sprintf("overload %d of function %s misses the return type or the implementation", i, name))
+		}
+		for j := 0; j < i; j++ {
+			if sameArgs(overloads[j].Args, o.Args) {
+				return errors.New(errno.InvalidFunctionDefinition, fmt.Sprintf("overloads %d and %d of function %s have the same arguments", j, i, name))
+			}
+		}
+		fs.Overloads = append(fs.Overloads, Function{
+			Index:        int32(i),
+			Volatile:     o.Volatile,
+			Flag:         plan.Function_STRICT,
+			Layout:       STANDARD_FUNCTION,
+			Args:         append([]types.T{}, o.Args...),
+			ReturnTypeFn: o.ReturnType,
+			Fn:           o.Fn,
+		})
+	}
+
+	// the check, the id and the registration are done under the lock, so
+	// concurrent calls can neither register a name twice nor share an id
+	registerMutex.Lock()
+	defer registerMutex.Unlock()
+	if IsBuiltinFunction(name) {
+		return errors.New(errno.DuplicateFunction, fmt.Sprintf("function %s already exists", name))
+	}
+	fs.Id = len(functionRegister)
+	functionRegister = append(functionRegister, fs)
+	functionIdRegister[name] = int32(fs.Id)
+	return nil
+}
+
+func sameArgs(a, b []types.T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
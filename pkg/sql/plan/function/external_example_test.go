@@ -0,0 +1,99 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// weightedScore is a sample external function, it returns score * weight
+// of every row.
+func weightedScore(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	score, weight := vs[0], vs[1]
+	resultType := types.Type{Oid: types.T_float64, Size: 8}
+	scores, weights := vector.MustTCols[float64](score), vector.MustTCols[float64](weight)
+	if score.ConstVectorIsNull() || weight.ConstVectorIsNull() {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	if score.IsScalar() && weight.IsScalar() {
+		resultVector := vector.NewConst(resultType, 1)
+		vector.SetCol(resultVector, []float64{scores[0] * weights[0]})
+		return resultVector, nil
+	}
+
+	n := len(scores)
+	if score.IsScalar() {
+		n = len(weights)
+	}
+	resultVector, err := proc.AllocVector(resultType, int64(resultType.Size)*int64(n))
+	if err != nil {
+		return nil, err
+	}
+	results := types.DecodeFloat64Slice(resultVector.Data)[:n]
+	for i := range results {
+		s, w := scores[0], weights[0]
+		if !score.IsScalar() {
+			s = scores[i]
+		}
+		if !weight.IsScalar() {
+			w = weights[i]
+		}
+		results[i] = s * w
+	}
+	nulls.Or(score.Nsp, weight.Nsp, resultVector.Nsp)
+	vector.SetCol(resultVector, results)
+	return resultVector, nil
+}
+
+func ExampleRegisterFunction() {
+	err := RegisterFunction("weighted_score", []Overload{{
+		Args: []types.T{types.T_float64, types.T_float64},
+		ReturnType: func(_ []types.Type) types.Type {
+			return types.T_float64.ToType()
+		},
+		Fn: weightedScore,
+	}})
+	if err != nil {
+		panic(err)
+	}
+
+	// the function is resolved like the builtins, integers are converted
+	// to float64 implicitly.
+	args := []types.Type{types.T_int64.ToType(), types.T_float64.ToType()}
+	overloadID, returnType, targetTypes, err := GetFunctionByName("weighted_score", args)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(returnType.Oid, targetTypes[0].Oid)
+
+	f, _ := GetFunctionByID(overloadID)
+	result, err := f.VecFn([]*vector.Vector{
+		testutil.MakeFloat64Vector([]float64{1, 2, 3}, []uint64{1}),
+		testutil.MakeScalarFloat64(0.5, 3),
+	}, testutil.NewProc())
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(vector.MustTCols[float64](result), result.Nsp.Contains(1))
+	// Output:
+	// DOUBLE DOUBLE
+	// [0.5 1 1.5] true
+}
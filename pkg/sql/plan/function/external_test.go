@@ -0,0 +1,121 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterFunction(t *testing.T) {
+	firstArgType := func(args []types.Type) types.Type {
+		return args[0]
+	}
+	pick := []Overload{
+		{
+			Args:       []types.T{types.T_decimal128, types.T_int64},
+			ReturnType: firstArgType,
+			Fn:         weightedScore,
+		},
+		{
+			Args:       []types.T{types.T_varchar, types.T_int64},
+			ReturnType: firstArgType,
+			Fn:         weightedScore,
+			Volatile:   true,
+		},
+	}
+	require.NoError(t, RegisterFunction("Ext_Pick", pick))
+	require.True(t, IsBuiltinFunction("ext_pick"))
+
+	decimal := types.Type{Oid: types.T_decimal128, Size: 16, Width: 20, Scale: 4}
+	overloadID, returnType, targetTypes, err := GetFunctionByName("ext_pick", []types.Type{decimal, types.T_int64.ToType()})
+	require.NoError(t, err)
+	require.Empty(t, targetTypes)
+	require.Equal(t, decimal, returnType)
+	f, err := GetFunctionByID(overloadID)
+	require.NoError(t, err)
+	require.False(t, f.Volatile)
+
+	overloadID, returnType, targetTypes, err = GetFunctionByName("ext_pick", []types.Type{types.T_char.ToType(), types.T_int32.ToType()})
+	require.NoError(t, err)
+	require.Equal(t, types.T_varchar, returnType.Oid)
+	require.Equal(t, types.T_int64, targetTypes[1].Oid)
+	f, err = GetFunctionByID(overloadID)
+	require.NoError(t, err)
+	require.True(t, f.Volatile)
+
+	_, _, _, err = GetFunctionByName("ext_pick", []types.Type{types.T_date.ToType()})
+	require.Error(t, err)
+
+	for name, overloads := range map[string][]Overload{
+		"ext_pick":  pick,
+		"abs":       pick,
+		"nullif":    pick,
+		"":          pick,
+		"ext_empty": nil,
+		"ext_no_fn": {{Args: []types.T{types.T_int64}, ReturnType: firstArgType}},
+		"ext_no_rt": {{Args: []types.T{types.T_int64}, Fn: weightedScore}},
+		"ext_dup":   {pick[0], pick[1], pick[0]},
+	} {
+		require.Error(t, RegisterFunction(name, overloads), name)
+	}
+	require.False(t, IsBuiltinFunction("ext_dup"))
+}
+
+func TestRegisterFunctionConcurrently(t *testing.T) {
+	overloads := []Overload{
+		{
+			Args: []types.T{types.T_decimal128, types.T_int64},
+			ReturnType: func(args []types.Type) types.Type {
+				return args[0]
+			},
+			Fn: weightedScore,
+		},
+	}
+	var wg sync.WaitGroup
+	errs := make([]error, 8)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			name := "ext_same"
+			if i%2 == 0 {
+				name = fmt.Sprintf("ext_concurrent_%d", i)
+			}
+			errs[i] = RegisterFunction(name, overloads)
+		}(i)
+	}
+	wg.Wait()
+
+	// only one of the calls registers ext_same, and every function gets its own id
+	succeeded := 0
+	for i := 1; i < len(errs); i += 2 {
+		if errs[i] == nil {
+			succeeded++
+		}
+	}
+	require.Equal(t, 1, succeeded)
+	ids := make(map[int32]struct{})
+	for i := 0; i < len(errs); i += 2 {
+		require.NoError(t, errs[i])
+		ids[functionIdRegister[fmt.Sprintf("ext_concurrent_%d", i)]] = struct{}{}
+	}
+	ids[functionIdRegister["ext_same"]] = struct{}{}
+	require.Equal(t, len(errs)/2+1, len(ids))
+}
@@ -126,6 +126,10 @@ type Function struct {
 	Args      []types.T
 	ReturnTyp types.T
 
+	// ReturnTypeFn makes the result type from the types of the arguments,
+	// it's used instead of ReturnTyp if set.
+	ReturnTypeFn func(args []types.Type) types.Type
+
 	// Fn is implementation of built-in function and operator
 	// it received vector list, and return result vector.
 	Fn func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error)
@@ -340,6 +344,8 @@ func setDefaultPrecision(typ *types.Type) {
 		typ.Precision = 6
 	} else if typ.Oid == types.T_datetime {
 		typ.Precision = 6
+	} else if typ.Oid == types.T_time {
+		typ.Precision = 6
 	}
 	typ.Size = int32(typ.Oid.TypeLen())
 }
@@ -353,6 +359,9 @@ func getRealReturnType(fid int32, f Function, realArgs []types.Type) types.Type
 			}
 		}
 	}
+	if f.ReturnTypeFn != nil {
+		return f.ReturnTypeFn(realArgs)
+	}
 	rt := f.ReturnTyp.ToType()
 	for i := range realArgs {
 		if realArgs[i].Oid == rt.Oid {
@@ -232,6 +232,42 @@ const (
 	REMOVE_FAULT_POINT  // Remove
 	TRIGGER_FAULT_POINT // Trigger.
 	UUID
+	LIST_FAULT_POINTS // List armed fault points
+
+	TIMEDIFF    // TIMEDIFF
+	TIME_TO_SEC // TIME_TO_SEC
+	SEC_TO_TIME // SEC_TO_TIME
+
+	CAST_TO_ENUM   // CAST_TO_ENUM, used by the planner to convert values to the enum column
+	CAST_TO_SET    // CAST_TO_SET, used by the planner to convert values to the set column
+	ENUM_TO_STRING // ENUM_TO_STRING, used by the planner to convert the enum and set values to strings
+
+	HEX   // HEX
+	UNHEX // UNHEX
+	BIN   // BIN
+
+	INSTR           // INSTR
+	SUBSTRING_INDEX // SUBSTRING_INDEX
+	PERCENTILE_CONT // PERCENTILE_CONT
+	PERCENTILE_DISC // PERCENTILE_DISC
+	GROUP_CONCAT    // GROUP_CONCAT
+	MD5             // MD5
+	SHA1            // SHA1
+	SHA2            // SHA2
+	CRC32           // CRC32
+	TIMESTAMPDIFF   // TIMESTAMPDIFF
+	CONVERT_TZ      // CONVERT_TZ
+	STR_TO_DATE     // STR_TO_DATE
+	LAST_DAY        // LAST_DAY
+	MAKEDATE        // MAKEDATE
+	HOUR            // HOUR
+	MINUTE          // MINUTE
+	SECOND          // SECOND
+	QUARTER         // QUARTER
+	NEXTVAL         // NEXTVAL
+	SETVAL          // SETVAL
+	CURRVAL         // CURRVAL
+	LASTVAL         // LASTVAL
 
 	// FUNCTION_END_NUMBER is not a function, just a flag to record the max number of function.
 	// TODO: every one should put the new function id in front of this one if you want to make a new function.
@@ -294,6 +330,19 @@ var functionIdRegister = map[string]int32{
 	"variance":              VAR_POP,
 	"approx_count_distinct": APPROX_COUNT_DISTINCT,
 	"any_value":             ANY_VALUE,
+	"median":                MEDIAN,
+	"mode":                  MODE,
+	"percentile_cont":       PERCENTILE_CONT,
+	"percentile_disc":       PERCENTILE_DISC,
+	"corr":                  CORR,
+	"covar_pop":             COVAR_POP,
+	"covar_samp":            COVAR_SAMPLE,
+	"stddev":                STDDEV_POP,
+	"stddev_samp":           STDDEV_SAMPLE,
+	"var_pop":               VAR_POP,
+	"var_samp":              VAR_SAMPLE,
+	"group_concat":          GROUP_CONCAT,
+	"array_agg":             ARRAY_AGG,
 	// builtin
 	// whoever edit this, please follow the lexical order, or come up with a better ordering method
 	// binary functions
@@ -313,12 +362,24 @@ var functionIdRegister = map[string]int32{
 	"current_timestamp": CURRENT_TIMESTAMP,
 	"now":               CURRENT_TIMESTAMP,
 	"floor":             FLOOR,
+	"left":              LEFT,
+	"locate":            POSITION,
 	"lpad":              LPAD,
+	"instr":             INSTR,
+	"mid":               SUBSTRING,
+	"position":          POSITION,
+	"repeat":            REPEAT,
+	"replace":           REPLACE,
+	"right":             RIGHT,
 	"pi":                PI,
 	"round":             ROUND,
 	"rpad":              RPAD,
 	"substr":            SUBSTRING,
+	"split":             SPLIT,
 	"substring":         SUBSTRING,
+	"substring_index":   SUBSTRING_INDEX,
+	"translate":         TRANSLATE,
+	"trim":              TRIM,
 	"utc_timestamp":     UTC_TIMESTAMP,
 	"unix_timestamp":    UNIX_TIMESTAMP,
 	"from_unixtime":     FROM_UNIXTIME,
@@ -326,17 +387,25 @@ var functionIdRegister = map[string]int32{
 	// whoever edit this, please follow the lexical order, or come up with a better ordering method
 	"abs":                     ABS,
 	"acos":                    ACOS,
+	"ascii":                   ASCII,
+	"base64_decode":           BASE64_DECODE,
+	"base64_encode":           BASE64_ENCODE,
 	"bit_length":              BIT_LENGTH,
+	"chr":                     CHR,
 	"date":                    DATE,
 	"day":                     DAY,
 	"dayofyear":               DAYOFYEAR,
 	"exp":                     EXP,
 	"empty":                   EMPTY,
+	"hex_decode":              HEX_DECODE,
+	"hex_encode":              HEX_ENCODE,
+	"lcase":                   LOWER,
 	"length":                  LENGTH,
 	"lengthutf8":              LENGTH_UTF8,
 	"char_length":             LENGTH_UTF8,
 	"ln":                      LN,
 	"log":                     LOG,
+	"lower":                   LOWER,
 	"ltrim":                   LTRIM,
 	"month":                   MONTH,
 	"oct":                     OCT,
@@ -346,6 +415,8 @@ var functionIdRegister = map[string]int32{
 	"sinh":                    SINH,
 	"space":                   SPACE,
 	"tan":                     TAN,
+	"ucase":                   UPPER,
+	"upper":                   UPPER,
 	"week":                    WEEK,
 	"weekday":                 WEEKDAY,
 	"year":                    YEAR,
@@ -380,7 +451,39 @@ var functionIdRegister = map[string]int32{
 	"add_fault_point":         ADD_FAULT_POINT,
 	"remove_fault_point":      REMOVE_FAULT_POINT,
 	"trigger_fault_point":     TRIGGER_FAULT_POINT,
+	"list_fault_points":       LIST_FAULT_POINTS,
 	"uuid":                    UUID,
+	"timediff":                TIMEDIFF,
+	"addtime":                 ADDTIME,
+	"time_to_sec":             TIME_TO_SEC,
+	"sec_to_time":             SEC_TO_TIME,
+	"cast_to_enum":            CAST_TO_ENUM,
+	"cast_to_set":             CAST_TO_SET,
+	"enum_to_string":          ENUM_TO_STRING,
+	"hex":                     HEX,
+	"unhex":                   UNHEX,
+	"bin":                     BIN,
+	"md5":                     MD5,
+	"sha1":                    SHA1,
+	"sha":                     SHA1,
+	"sha2":                    SHA2,
+	"crc32":                   CRC32,
+	"aes_encrypt":             AES_ENCRYPT,
+	"aes_decrypt":             AES_DECRYPT,
+	"datediff":                DATEDIFF,
+	"timestampdiff":           TIMESTAMPDIFF,
+	"convert_tz":              CONVERT_TZ,
+	"str_to_date":             STR_TO_DATE,
+	"last_day":                LAST_DAY,
+	"makedate":                MAKEDATE,
+	"hour":                    HOUR,
+	"minute":                  MINUTE,
+	"second":                  SECOND,
+	"quarter":                 QUARTER,
+	"nextval":                 NEXTVAL,
+	"setval":                  SETVAL,
+	"currval":                 CURRVAL,
+	"lastval":                 LASTVAL,
 }
 
 func GetFunctionIsWinfunByName(name string) bool {
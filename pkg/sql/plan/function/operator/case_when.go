@@ -86,6 +86,10 @@ var (
 		return cwGeneral[types.Datetime](vs, proc, types.Type{Oid: types.T_datetime})
 	}
 
+	CaseWhenTime = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return cwGeneral[types.Time](vs, proc, types.Type{Oid: types.T_time})
+	}
+
 	CaseWhenVarchar = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
 		return cwString(vs, proc, types.Type{Oid: types.T_varchar})
 	}
@@ -138,12 +142,12 @@ func CwTypeCheckFn(inputTypes []types.T, _ []types.T, ret types.T) bool {
 }
 
 type OrderedValue interface {
-	constraints.Integer | constraints.Float | types.Date | types.Datetime | types.Decimal64 | types.Timestamp
+	constraints.Integer | constraints.Float | types.Date | types.Time | types.Datetime | types.Decimal64 | types.Timestamp
 }
 
 type NormalType interface {
-	constraints.Integer | constraints.Float | bool | types.Date | types.Datetime |
-		types.Decimal64 | types.Decimal128 | types.Timestamp
+	constraints.Integer | constraints.Float | bool | types.Date | types.Time | types.Datetime |
+		types.Decimal64 | types.Decimal128 | types.Timestamp | types.Uuid
 }
 
 // cwGeneral is a general evaluate function for case-when operator
@@ -16,6 +16,7 @@ package operator
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 
@@ -88,6 +89,14 @@ func doCast(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error)
 		return proc.AllocScalarNullVector(rv.Typ), nil
 	}
 
+	if lv.Typ.Oid == types.T_bit || rv.Typ.Oid == types.T_bit {
+		return CastBit(lv, rv, proc)
+	}
+
+	if lv.Typ.Oid == types.T_uuid || rv.Typ.Oid == types.T_uuid {
+		return CastUuid(lv, rv, proc)
+	}
+
 	if lv.Typ.Oid == rv.Typ.Oid && IsNumeric(lv.Typ.Oid) {
 		switch lv.Typ.Oid {
 		case types.T_int8:
@@ -121,6 +130,8 @@ func doCast(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error)
 			return CastSameType2[types.Datetime](lv, rv, proc)
 		case types.T_timestamp:
 			return CastSameType2[types.Timestamp](lv, rv, proc)
+		case types.T_time:
+			return CastTimeAsTime(lv, rv, proc)
 		}
 	}
 
@@ -416,6 +427,10 @@ func doCast(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error)
 		}
 	}
 
+	if isString(lv.Typ.Oid) && (rv.Typ.Oid == types.T_binary || rv.Typ.Oid == types.T_varbinary) {
+		return CastStringAsBinary(lv, rv, proc)
+	}
+
 	if isString(lv.Typ.Oid) && isString(rv.Typ.Oid) {
 		return CastSpecials3(lv, rv, proc)
 	}
@@ -498,6 +513,47 @@ func doCast(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error)
 	if isString(lv.Typ.Oid) && rv.Typ.Oid == types.T_timestamp {
 		return CastVarcharAsTimestamp(lv, rv, proc)
 	}
+
+	if isString(lv.Typ.Oid) && rv.Typ.Oid == types.T_time {
+		return CastVarcharAsTime(lv, rv, proc)
+	}
+
+	if lv.Typ.Oid == types.T_time && isString(rv.Typ.Oid) {
+		return CastTimeAsString(lv, rv, proc)
+	}
+
+	if lv.Typ.Oid == types.T_datetime && rv.Typ.Oid == types.T_time {
+		return CastDatetimeAsTime(lv, rv, proc)
+	}
+
+	if lv.Typ.Oid == types.T_time && rv.Typ.Oid == types.T_datetime {
+		return CastTimeAsDatetime(lv, rv, proc)
+	}
+
+	if lv.Typ.Oid == types.T_time && rv.Typ.Oid == types.T_int64 {
+		return CastTimeAsInt64(lv, rv, proc)
+	}
+
+	if IsInteger(lv.Typ.Oid) && rv.Typ.Oid == types.T_time {
+		switch lv.Typ.Oid {
+		case types.T_int8:
+			return CastIntAsTime[int8](lv, rv, proc)
+		case types.T_int16:
+			return CastIntAsTime[int16](lv, rv, proc)
+		case types.T_int32:
+			return CastIntAsTime[int32](lv, rv, proc)
+		case types.T_int64:
+			return CastIntAsTime[int64](lv, rv, proc)
+		case types.T_uint8:
+			return CastIntAsTime[uint8](lv, rv, proc)
+		case types.T_uint16:
+			return CastIntAsTime[uint16](lv, rv, proc)
+		case types.T_uint32:
+			return CastIntAsTime[uint32](lv, rv, proc)
+		case types.T_uint64:
+			return CastIntAsTime[uint64](lv, rv, proc)
+		}
+	}
 	if lv.Typ.Oid == types.T_decimal64 && rv.Typ.Oid == types.T_decimal128 {
 		return CastDecimal64AsDecimal128(lv, rv, proc)
 	}
@@ -1200,6 +1256,228 @@ func CastSpecials3(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector
 	return vec, nil
 }
 
+// CastStringAsBinary : Cast converts the string to binary and varbinary byte by byte,
+// the value longer than the width is an error, and the binary value is padded with 0x00 to the width.
+func CastStringAsBinary(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	source := vector.MustBytesCols(lv)
+	width := int(rv.Typ.Width)
+	col := &types.Bytes{
+		Offsets: make([]uint32, len(source.Lengths)),
+		Lengths: make([]uint32, len(source.Lengths)),
+	}
+	for i := range source.Lengths {
+		col.Offsets[i] = uint32(len(col.Data))
+		if nulls.Contains(lv.Nsp, uint64(i)) {
+			continue
+		}
+		v := source.Get(int64(i))
+		if width > 0 && len(v) > width {
+			return nil, moerr.NewError(moerr.OUT_OF_RANGE, fmt.Sprintf("data too long for %s(%d)", rv.Typ, width))
+		}
+		col.Data = append(col.Data, v...)
+		if rv.Typ.Oid == types.T_binary {
+			for j := len(v); j < width; j++ {
+				col.Data = append(col.Data, 0)
+			}
+		}
+		col.Lengths[i] = uint32(len(col.Data)) - col.Offsets[i]
+	}
+	if lv.IsScalar() {
+		vec := proc.AllocScalarVector(rv.Typ)
+		nulls.Set(vec.Nsp, lv.Nsp)
+		vector.SetCol(vec, col)
+		return vec, nil
+	}
+	vec, err := proc.AllocVector(rv.Typ, int64(len(col.Data)))
+	if err != nil {
+		return nil, err
+	}
+	copy(vec.Data, col.Data)
+	col.Data = vec.Data[:len(col.Data)]
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, col)
+	return vec, nil
+}
+
+// CastBit : Cast converts from and to the bit, the bit is converted as the uint64 with the numbers
+// and as the big-endian bytes with the strings.
+func CastBit(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	if lv.Typ.Oid == types.T_bit && isString(rv.Typ.Oid) {
+		return CastBitAsString(lv, rv, proc)
+	}
+	if lv.Typ.Oid == types.T_bit && rv.Typ.Oid != types.T_bit {
+		uv := *lv
+		uv.Typ = types.T_uint64.ToType()
+		return doCast([]*vector.Vector{&uv, rv}, proc)
+	}
+
+	var toBit func(int64) (uint64, error)
+	if isString(lv.Typ.Oid) {
+		vs := vector.MustBytesCols(lv)
+		toBit = func(i int64) (uint64, error) {
+			return types.DecodeBit(vs.Get(i), rv.Typ.Width)
+		}
+	} else {
+		if lv.Typ.Oid != types.T_uint64 && lv.Typ.Oid != types.T_bit {
+			uv, err := doCast([]*vector.Vector{lv, vector.New(types.T_uint64.ToType())}, proc)
+			if err != nil {
+				return nil, err
+			}
+			defer vector.Clean(uv, proc.Mp)
+			lv = uv
+		}
+		vs := vector.MustTCols[uint64](lv)
+		toBit = func(i int64) (uint64, error) {
+			return types.CheckBit(vs[i], rv.Typ.Width)
+		}
+	}
+
+	length := vector.Length(lv)
+	var vec *vector.Vector
+	var rs []uint64
+	if lv.IsScalar() {
+		vec = proc.AllocScalarVector(rv.Typ)
+		rs = make([]uint64, 1)
+	} else {
+		var err error
+		if vec, err = proc.AllocVector(rv.Typ, int64(rv.Typ.Oid.TypeLen()*length)); err != nil {
+			return nil, err
+		}
+		rs = types.DecodeFixedSlice[uint64](vec.Data, rv.Typ.Oid.TypeLen())[:length]
+	}
+	for i := range rs {
+		if nulls.Contains(lv.Nsp, uint64(i)) {
+			continue
+		}
+		v, err := toBit(int64(i))
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = v
+	}
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
+
+// CastBitAsString : Cast converts the bit to the binary string of its big-endian bytes
+func CastBitAsString(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	vs := vector.MustTCols[uint64](lv)
+	col := &types.Bytes{
+		Offsets: make([]uint32, len(vs)),
+		Lengths: make([]uint32, len(vs)),
+	}
+	for i, v := range vs {
+		col.Offsets[i] = uint32(len(col.Data))
+		if nulls.Contains(lv.Nsp, uint64(i)) {
+			continue
+		}
+		data := types.EncodeBit(v, lv.Typ.Width)
+		col.Data = append(col.Data, data...)
+		col.Lengths[i] = uint32(len(data))
+	}
+	if lv.IsScalar() {
+		vec := proc.AllocScalarVector(rv.Typ)
+		nulls.Set(vec.Nsp, lv.Nsp)
+		vector.SetCol(vec, col)
+		return vec, nil
+	}
+	vec, err := proc.AllocVector(rv.Typ, int64(len(col.Data)))
+	if err != nil {
+		return nil, err
+	}
+	copy(vec.Data, col.Data)
+	col.Data = vec.Data[:len(col.Data)]
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, col)
+	return vec, nil
+}
+
+// CastUuid : Cast converts from and to the uuid, the uuid is converted as its canonical text form
+// with the strings, and a 16-byte binary string is taken as the raw bytes of the uuid.
+func CastUuid(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	if lv.Typ.Oid == types.T_uuid && isString(rv.Typ.Oid) {
+		return CastUuidAsString(lv, rv, proc)
+	}
+
+	var toUuid func(int64) (types.Uuid, error)
+	if isString(lv.Typ.Oid) {
+		vs := vector.MustBytesCols(lv)
+		isBinary := lv.Typ.Oid == types.T_binary || lv.Typ.Oid == types.T_varbinary
+		toUuid = func(i int64) (types.Uuid, error) {
+			data := vs.Get(i)
+			if isBinary && len(data) == types.UuidSize {
+				return types.BytesToUuid(data)
+			}
+			return types.ParseUuid(string(data))
+		}
+	} else {
+		vs := vector.MustTCols[types.Uuid](lv)
+		toUuid = func(i int64) (types.Uuid, error) {
+			return vs[i], nil
+		}
+	}
+
+	length := vector.Length(lv)
+	var vec *vector.Vector
+	var rs []types.Uuid
+	if lv.IsScalar() {
+		vec = proc.AllocScalarVector(rv.Typ)
+		rs = make([]types.Uuid, 1)
+	} else {
+		var err error
+		if vec, err = proc.AllocVector(rv.Typ, int64(types.UuidSize*length)); err != nil {
+			return nil, err
+		}
+		rs = types.DecodeUuidSlice(vec.Data)[:length]
+	}
+	for i := range rs {
+		if nulls.Contains(lv.Nsp, uint64(i)) {
+			continue
+		}
+		v, err := toUuid(int64(i))
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = v
+	}
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
+
+// CastUuidAsString : Cast converts the uuid to its canonical text form
+func CastUuidAsString(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	vs := vector.MustTCols[types.Uuid](lv)
+	col := &types.Bytes{
+		Offsets: make([]uint32, len(vs)),
+		Lengths: make([]uint32, len(vs)),
+	}
+	for i, v := range vs {
+		col.Offsets[i] = uint32(len(col.Data))
+		if nulls.Contains(lv.Nsp, uint64(i)) {
+			continue
+		}
+		col.Data = append(col.Data, v.String()...)
+		col.Lengths[i] = types.UuidStringLen
+	}
+	if lv.IsScalar() {
+		vec := proc.AllocScalarVector(rv.Typ)
+		nulls.Set(vec.Nsp, lv.Nsp)
+		vector.SetCol(vec, col)
+		return vec, nil
+	}
+	vec, err := proc.AllocVector(rv.Typ, int64(len(col.Data)))
+	if err != nil {
+		return nil, err
+	}
+	copy(vec.Data, col.Data)
+	col.Data = vec.Data[:len(col.Data)]
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, col)
+	return vec, nil
+}
+
 func CastSpecialIntToDecimal[T constraints.Integer](
 	lv, _ *vector.Vector,
 	i2d func(xs []T, rs []types.Decimal128) ([]types.Decimal128, error),
@@ -1429,6 +1707,46 @@ func CastVarcharAsDatetime(lv, rv *vector.Vector, proc *process.Process) (*vecto
 	return vec, nil
 }
 
+// CastVarcharAsTime : Cast converts varchar to time type
+func CastVarcharAsTime(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	vs := vector.MustBytesCols(lv)
+
+	if lv.IsScalar() {
+		vec := proc.AllocScalarVector(rv.Typ)
+		rs := make([]types.Time, 1)
+		if !nulls.Contains(lv.Nsp, 0) {
+			data, err := types.ParseTime(string(vs.Get(0)), rv.Typ.Precision)
+			if err != nil {
+				return nil, err
+			}
+			rs[0] = data
+		}
+		nulls.Set(vec.Nsp, lv.Nsp)
+		vector.SetCol(vec, rs)
+		return vec, nil
+	}
+
+	vec, err := proc.AllocVector(rv.Typ, int64(rv.Typ.Oid.TypeLen()*len(vs.Lengths)))
+	if err != nil {
+		return nil, err
+	}
+	rs := types.DecodeTimeSlice(vec.Data)
+	rs = rs[:len(vs.Lengths)]
+	for i := range vs.Lengths {
+		if nulls.Contains(lv.Nsp, uint64(i)) {
+			continue
+		}
+		data, err := types.ParseTime(string(vs.Get(int64(i))), rv.Typ.Precision)
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = data
+	}
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
+
 // CastVarcharAsTimestamp : Cast converts varchar to timestamp type
 func CastVarcharAsTimestamp(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
 	vs := vector.MustBytesCols(lv)
@@ -1875,6 +2193,183 @@ func CastDatetimeAsString(lv, rv *vector.Vector, proc *process.Process) (*vector
 	return vec, nil
 }
 
+func CastTimeAsString(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	var err error
+	lvs := vector.MustTCols[types.Time](lv)
+	col := &types.Bytes{
+		Data:    make([]byte, 0, len(lvs)),
+		Offsets: make([]uint32, 0, len(lvs)),
+		Lengths: make([]uint32, 0, len(lvs)),
+	}
+	if col, err = binary.TimeToBytes(lvs, col, lv.Typ.Precision); err != nil {
+		return nil, err
+	}
+	if err = proc.Mp.Gm.Alloc(int64(cap(col.Data))); err != nil {
+		return nil, err
+	}
+	vec := vector.New(rv.Typ)
+	if lv.IsScalar() {
+		vec.IsConst = true
+	}
+	vec.Data = col.Data
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, col)
+	return vec, nil
+}
+
+// CastTimeAsTime : rounds the time to the precision of the target type
+func CastTimeAsTime(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	rtl := 8
+	lvs := vector.MustTCols[types.Time](lv)
+	if lv.IsScalar() {
+		vec := proc.AllocScalarVector(rv.Typ)
+		rs := []types.Time{lvs[0].Round(rv.Typ.Precision)}
+		nulls.Set(vec.Nsp, lv.Nsp)
+		vector.SetCol(vec, rs)
+		return vec, nil
+	}
+	vec, err := proc.AllocVector(rv.Typ, int64(rtl)*int64(len(lvs)))
+	if err != nil {
+		return nil, err
+	}
+	rs := types.DecodeTimeSlice(vec.Data)
+	rs = rs[:len(lvs)]
+	for i := range lvs {
+		rs[i] = lvs[i].Round(rv.Typ.Precision)
+	}
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
+
+// CastDatetimeAsTime : convert datetime to the time of day
+func CastDatetimeAsTime(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	rtl := 8
+	lvs := vector.MustTCols[types.Datetime](lv)
+	if lv.IsScalar() {
+		vec := proc.AllocScalarVector(rv.Typ)
+		rs := make([]types.Time, 1)
+		if _, err := binary.DatetimeToTime(lvs, rs, rv.Typ.Precision); err != nil {
+			return nil, err
+		}
+		nulls.Set(vec.Nsp, lv.Nsp)
+		vector.SetCol(vec, rs)
+		return vec, nil
+	}
+	vec, err := proc.AllocVector(rv.Typ, int64(rtl)*int64(len(lvs)))
+	if err != nil {
+		return nil, err
+	}
+	rs := types.DecodeTimeSlice(vec.Data)
+	rs = rs[:len(lvs)]
+	if _, err := binary.DatetimeToTime(lvs, rs, rv.Typ.Precision); err != nil {
+		return nil, err
+	}
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
+
+// CastTimeAsDatetime : convert time to the datetime on the current date like mysql
+func CastTimeAsDatetime(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	rtl := 8
+	lvs := vector.MustTCols[types.Time](lv)
+	today := types.Now(proc.SessionInfo.TimeZone).ToDate()
+	if lv.IsScalar() {
+		vec := proc.AllocScalarVector(rv.Typ)
+		rs := make([]types.Datetime, 1)
+		if _, err := binary.TimeToDatetime(lvs, rs, today); err != nil {
+			return nil, err
+		}
+		nulls.Set(vec.Nsp, lv.Nsp)
+		vector.SetCol(vec, rs)
+		return vec, nil
+	}
+	vec, err := proc.AllocVector(rv.Typ, int64(rtl)*int64(len(lvs)))
+	if err != nil {
+		return nil, err
+	}
+	rs := types.DecodeDatetimeSlice(vec.Data)
+	rs = rs[:len(lvs)]
+	if _, err := binary.TimeToDatetime(lvs, rs, today); err != nil {
+		return nil, err
+	}
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
+
+// CastTimeAsInt64 : convert time to the integer of the format hhmmss
+func CastTimeAsInt64(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	rtl := 8
+	lvs := vector.MustTCols[types.Time](lv)
+	if lv.IsScalar() {
+		vec := proc.AllocScalarVector(rv.Typ)
+		rs := make([]int64, 1)
+		if _, err := binary.TimeToInt64(lvs, rs); err != nil {
+			return nil, err
+		}
+		nulls.Set(vec.Nsp, lv.Nsp)
+		vector.SetCol(vec, rs)
+		return vec, nil
+	}
+	vec, err := proc.AllocVector(rv.Typ, int64(rtl)*int64(len(lvs)))
+	if err != nil {
+		return nil, err
+	}
+	rs := types.DecodeInt64Slice(vec.Data)
+	rs = rs[:len(lvs)]
+	if _, err := binary.TimeToInt64(lvs, rs); err != nil {
+		return nil, err
+	}
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
+
+// CastIntAsTime : convert the integer of the format hhmmss to time
+func CastIntAsTime[T constraints.Integer](lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	rtl := 8
+	lvs := vector.MustTCols[T](lv)
+	toTime := func(v T) (types.Time, error) {
+		if v > 0 && uint64(v) > math.MaxInt64 {
+			return 0, types.ErrTimeOutOfRange
+		}
+		return types.ParseInt64ToTime(int64(v))
+	}
+	if lv.IsScalar() {
+		vec := proc.AllocScalarVector(rv.Typ)
+		rs := make([]types.Time, 1)
+		if !nulls.Contains(lv.Nsp, 0) {
+			t, err := toTime(lvs[0])
+			if err != nil {
+				return nil, err
+			}
+			rs[0] = t
+		}
+		nulls.Set(vec.Nsp, lv.Nsp)
+		vector.SetCol(vec, rs)
+		return vec, nil
+	}
+	vec, err := proc.AllocVector(rv.Typ, int64(rtl)*int64(len(lvs)))
+	if err != nil {
+		return nil, err
+	}
+	rs := types.DecodeTimeSlice(vec.Data)
+	rs = rs[:len(lvs)]
+	for i := range lvs {
+		if nulls.Contains(lv.Nsp, uint64(i)) {
+			continue
+		}
+		if rs[i], err = toTime(lvs[i]); err != nil {
+			return nil, err
+		}
+	}
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
+
 // CastDatetimeAsDate : convert datetime to date
 // DateTime : high 44 bits stands for the seconds passed by, low 20 bits stands for the microseconds passed by
 func CastDatetimeAsDate(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
@@ -2468,7 +2963,7 @@ func IsNumeric(t types.T) bool {
 
 // isString: return true if the types.T is string type
 func isString(t types.T) bool {
-	if t == types.T_char || t == types.T_varchar || t == types.T_blob {
+	if t == types.T_char || t == types.T_varchar || t == types.T_blob || t == types.T_binary || t == types.T_varbinary {
 		return true
 	}
 	return false
@@ -2476,7 +2971,7 @@ func isString(t types.T) bool {
 
 // isDateSeries: return true if the types.T is date related type
 func isDateSeries(t types.T) bool {
-	if t == types.T_date || t == types.T_datetime || t == types.T_timestamp {
+	if t == types.T_date || t == types.T_time || t == types.T_datetime || t == types.T_timestamp {
 		return true
 	}
 	return false
@@ -4600,3 +4600,57 @@ func makeStringVector(src string, t types.T, isConst bool) *vector.Vector {
 	}
 	return nil
 }
+
+func TestCastBinaryAndBit(t *testing.T) {
+	procs := testutil.NewProc()
+	binaryType := types.Type{Oid: types.T_binary, Size: 24, Width: 4}
+	varbinaryType := types.Type{Oid: types.T_varbinary, Size: 24, Width: 4}
+	bitType := types.Type{Oid: types.T_bit, Size: 8, Width: 10}
+
+	// binary is padded with the zero bytes, and the longer values are rejected
+	res, err := Cast([]*vector.Vector{testutil.MakeVarcharVector([]string{"ab", "abcd"}, nil), vector.New(binaryType)}, procs)
+	require.NoError(t, err)
+	require.Equal(t, []byte("ab\x00\x00"), vector.MustBytesCols(res).Get(0))
+	require.Equal(t, []byte("abcd"), vector.MustBytesCols(res).Get(1))
+	res, err = Cast([]*vector.Vector{testutil.MakeVarcharVector([]string{"ab"}, nil), vector.New(varbinaryType)}, procs)
+	require.NoError(t, err)
+	require.Equal(t, []byte("ab"), vector.MustBytesCols(res).Get(0))
+	_, err = Cast([]*vector.Vector{testutil.MakeVarcharVector([]string{"abcde"}, nil), vector.New(varbinaryType)}, procs)
+	require.Error(t, err)
+
+	// bit values are checked with the width and converted to the big-endian bytes
+	res, err = Cast([]*vector.Vector{testutil.MakeInt64Vector([]int64{1, 1023}, nil), vector.New(bitType)}, procs)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 1023}, vector.MustTCols[uint64](res))
+	_, err = Cast([]*vector.Vector{testutil.MakeInt64Vector([]int64{1024}, nil), vector.New(bitType)}, procs)
+	require.Error(t, err)
+	res, err = Cast([]*vector.Vector{res, vector.New(types.T_varbinary.ToType())}, procs)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x00, 0x01}, vector.MustBytesCols(res).Get(0))
+	require.Equal(t, []byte{0x03, 0xff}, vector.MustBytesCols(res).Get(1))
+}
+
+func TestCastUuid(t *testing.T) {
+	procs := testutil.NewProc()
+	uuidType := types.T_uuid.ToType()
+	s := "6ccd780c-baba-1026-9564-5b8c656024db"
+
+	res, err := Cast([]*vector.Vector{testutil.MakeVarcharVector([]string{s, "6CCD780CBABA102695645B8C656024DB"}, nil), vector.New(uuidType)}, procs)
+	require.NoError(t, err)
+	vs := vector.MustTCols[types.Uuid](res)
+	require.Equal(t, s, vs[0].String())
+	require.Equal(t, vs[0], vs[1])
+	_, err = Cast([]*vector.Vector{testutil.MakeVarcharVector([]string{"not a uuid"}, nil), vector.New(uuidType)}, procs)
+	require.Error(t, err)
+
+	// the 16-byte binary strings are taken as the raw bytes
+	bin := testutil.MakeVarbinaryVector([]string{string(vs[0][:])}, nil)
+	res2, err := Cast([]*vector.Vector{bin, vector.New(uuidType)}, procs)
+	require.NoError(t, err)
+	require.Equal(t, vs[0], vector.MustTCols[types.Uuid](res2)[0])
+
+	res, err = Cast([]*vector.Vector{res, vector.New(types.T_varchar.ToType())}, procs)
+	require.NoError(t, err)
+	require.Equal(t, []byte(s), vector.MustBytesCols(res).Get(0))
+	require.Equal(t, []byte(s), vector.MustBytesCols(res).Get(1))
+}
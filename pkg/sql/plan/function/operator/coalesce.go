@@ -74,6 +74,10 @@ var (
 		return coalesceGeneral[types.Datetime](vs, proc, types.Type{Oid: types.T_datetime})
 	}
 
+	CoalesceTime = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return coalesceGeneral[types.Time](vs, proc, types.Type{Oid: types.T_time})
+	}
+
 	CoalesceVarchar = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
 		return coalesceString(vs, proc, types.Type{Oid: types.T_varchar})
 	}
@@ -28,7 +28,7 @@ import (
 
 type compareT interface {
 	constraints.Integer | constraints.Float | bool |
-		types.Date | types.Datetime | types.Timestamp
+		types.Date | types.Time | types.Datetime | types.Timestamp
 }
 
 var boolType = types.T_bool.ToType()
@@ -182,12 +182,28 @@ func CompareBytesNe(v1, v2 []byte, s1, s2 int32) bool {
 	return !bytes.Equal(v1, v2)
 }
 
+// collatedStringFn makes fn compare the sort keys of the strings under the collation
+func collatedStringFn(collation types.Collation, fn compStringFn) compStringFn {
+	var k1, k2 []byte
+	return func(v1, v2 []byte, s1, s2 int32) bool {
+		k1, k2 = collation.AppendKey(k1[:0], v1), collation.AppendKey(k2[:0], v2)
+		return fn(k1, k2, s1, s2)
+	}
+}
+
 func CompareString(vs []*vector.Vector, fn compStringFn, proc *process.Process) (*vector.Vector, error) {
 	v1, v2 := vs[0], vs[1]
 	col1, col2 := vector.MustBytesCols(v1), vector.MustBytesCols(v2)
 	if v1.IsScalarNull() || v2.IsScalarNull() {
 		return handleScalarNull(v1, v2, proc)
 	}
+	collation, err := types.MergeCollation(v1.Typ.Collation, v2.Typ.Collation)
+	if err != nil {
+		return nil, err
+	}
+	if !collation.IsBinary() {
+		fn = collatedStringFn(collation, fn)
+	}
 
 	if v1.IsScalar() && v2.IsScalar() {
 		vec := proc.AllocScalarVector(boolType)
@@ -252,3 +268,46 @@ func GtString(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error
 func NeString(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
 	return CompareString(vs, CompareBytesNe, proc)
 }
+
+// uuid compare
+func CompareUuid(vs []*vector.Vector, fn func(int) bool, proc *process.Process) (*vector.Vector, error) {
+	return CompareOrdered(vs, proc, func(v1, v2, r *vector.Vector) error {
+		col1, col2 := vector.MustTCols[types.Uuid](v1), vector.MustTCols[types.Uuid](v2)
+		rs := vector.MustTCols[bool](r)
+		for i := range rs {
+			x, y := col1[0], col2[0]
+			if !v1.IsScalar() {
+				x = col1[i]
+			}
+			if !v2.IsScalar() {
+				y = col2[i]
+			}
+			rs[i] = fn(x.Compare(y))
+		}
+		return nil
+	})
+}
+
+func EqUuid(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return CompareUuid(vs, func(c int) bool { return c == 0 }, proc)
+}
+
+func NeUuid(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return CompareUuid(vs, func(c int) bool { return c != 0 }, proc)
+}
+
+func GtUuid(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return CompareUuid(vs, func(c int) bool { return c > 0 }, proc)
+}
+
+func GeUuid(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return CompareUuid(vs, func(c int) bool { return c >= 0 }, proc)
+}
+
+func LtUuid(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return CompareUuid(vs, func(c int) bool { return c < 0 }, proc)
+}
+
+func LeUuid(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return CompareUuid(vs, func(c int) bool { return c <= 0 }, proc)
+}
@@ -85,6 +85,10 @@ var (
 		return ifGeneral[types.Datetime](vs, proc, types.Type{Oid: types.T_datetime})
 	}
 
+	IfTime = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		return ifGeneral[types.Time](vs, proc, types.Type{Oid: types.T_time})
+	}
+
 	IfVarchar = func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
 		return ifForString(vs, proc, types.Type{Oid: types.T_varchar})
 	}
@@ -116,7 +120,7 @@ func IfTypeCheckFn(inputTypes []types.T, _ []types.T, ret types.T) bool {
 }
 
 type IfRet interface {
-	constraints.Integer | constraints.Float | bool | types.Date | types.Datetime |
+	constraints.Integer | constraints.Float | bool | types.Date | types.Time | types.Datetime |
 		types.Decimal64 | types.Decimal128 | types.Timestamp
 }
 
@@ -36,6 +36,13 @@ func Like(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, erro
 	if lv.IsScalarNull() || rv.IsScalarNull() {
 		return proc.AllocScalarNullVector(types.Type{Oid: types.T_bool}), nil
 	}
+	collation, err := types.MergeCollation(lv.Typ.Collation, rv.Typ.Collation)
+	if err != nil {
+		return nil, err
+	}
+	if !collation.IsBinary() {
+		lvs, rvs = foldBytes(collation, lvs), foldBytes(collation, rvs)
+	}
 
 	switch {
 	case !lv.IsScalar() && rv.IsScalar():
@@ -172,3 +179,19 @@ func Like(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, erro
 	}
 	return nil, errUnexpected
 }
+
+// foldBytes folds the strings under the collation, the folded pattern matches the folded
+// strings as the pattern matches the strings under the collation
+func foldBytes(collation types.Collation, bs *types.Bytes) *types.Bytes {
+	r := &types.Bytes{
+		Data:    make([]byte, 0, len(bs.Data)),
+		Offsets: make([]uint32, len(bs.Offsets)),
+		Lengths: make([]uint32, len(bs.Lengths)),
+	}
+	for i := range bs.Offsets {
+		r.Offsets[i] = uint32(len(r.Data))
+		r.Data = collation.Fold(r.Data, bs.Get(int64(i)))
+		r.Lengths[i] = uint32(len(r.Data)) - r.Offsets[i]
+	}
+	return r
+}
@@ -160,6 +160,18 @@ func TestLpadVarchar2(t *testing.T) {
 	}
 }
 
+func TestLikeCollation(t *testing.T) {
+	vecs := makeLikeVectors("Café au lait", "CAFE%", false, true)
+	likeRes, err := Like(vecs, makeProcess())
+	require.NoError(t, err)
+	require.Equal(t, []bool{false}, likeRes.Col.([]bool))
+
+	vecs[0].Typ.Collation = types.CollationGeneralCI
+	likeRes, err = Like(vecs, makeProcess())
+	require.NoError(t, err)
+	require.Equal(t, []bool{true}, likeRes.Col.([]bool))
+}
+
 func makeProcess() *process.Process {
 	hm := host.New(1 << 40)
 	gm := guest.New(1<<40, hm)
@@ -221,6 +221,76 @@ var operators = map[int]Functions{
 				ReturnTyp: types.T_bool,
 				Fn:        operator.IsStringNull,
 			},
+			{
+				Index:  18,
+				Flag:   plan.Function_STRICT,
+				Layout: IS_NULL_EXPRESSION,
+				Args: []types.T{
+					types.T_time,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.IsNull[types.Time],
+			},
+			{
+				Index:  19,
+				Flag:   plan.Function_STRICT,
+				Layout: IS_NULL_EXPRESSION,
+				Args: []types.T{
+					types.T_enum,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.IsNull[uint16],
+			},
+			{
+				Index:  20,
+				Flag:   plan.Function_STRICT,
+				Layout: IS_NULL_EXPRESSION,
+				Args: []types.T{
+					types.T_set,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.IsNull[uint64],
+			},
+			{
+				Index:  21,
+				Flag:   plan.Function_STRICT,
+				Layout: IS_NULL_EXPRESSION,
+				Args: []types.T{
+					types.T_binary,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.IsStringNull,
+			},
+			{
+				Index:  22,
+				Flag:   plan.Function_STRICT,
+				Layout: IS_NULL_EXPRESSION,
+				Args: []types.T{
+					types.T_varbinary,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.IsStringNull,
+			},
+			{
+				Index:  23,
+				Flag:   plan.Function_STRICT,
+				Layout: IS_NULL_EXPRESSION,
+				Args: []types.T{
+					types.T_bit,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.IsNull[uint64],
+			},
+			{
+				Index:  24,
+				Flag:   plan.Function_STRICT,
+				Layout: IS_NULL_EXPRESSION,
+				Args: []types.T{
+					types.T_uuid,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.IsNull[types.Uuid],
+			},
 		},
 	},
 
@@ -407,6 +477,76 @@ var operators = map[int]Functions{
 				ReturnTyp: types.T_bool,
 				Fn:        operator.IsStringNotNull,
 			},
+			{
+				Index:  18,
+				Flag:   plan.Function_STRICT,
+				Layout: IS_NULL_EXPRESSION,
+				Args: []types.T{
+					types.T_time,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.IsNotNull[types.Time],
+			},
+			{
+				Index:  19,
+				Flag:   plan.Function_STRICT,
+				Layout: IS_NULL_EXPRESSION,
+				Args: []types.T{
+					types.T_enum,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.IsNotNull[uint16],
+			},
+			{
+				Index:  20,
+				Flag:   plan.Function_STRICT,
+				Layout: IS_NULL_EXPRESSION,
+				Args: []types.T{
+					types.T_set,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.IsNotNull[uint64],
+			},
+			{
+				Index:  21,
+				Flag:   plan.Function_STRICT,
+				Layout: IS_NULL_EXPRESSION,
+				Args: []types.T{
+					types.T_binary,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.IsStringNotNull,
+			},
+			{
+				Index:  22,
+				Flag:   plan.Function_STRICT,
+				Layout: IS_NULL_EXPRESSION,
+				Args: []types.T{
+					types.T_varbinary,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.IsStringNotNull,
+			},
+			{
+				Index:  23,
+				Flag:   plan.Function_STRICT,
+				Layout: IS_NULL_EXPRESSION,
+				Args: []types.T{
+					types.T_bit,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.IsNotNull[uint64],
+			},
+			{
+				Index:  24,
+				Flag:   plan.Function_STRICT,
+				Layout: IS_NULL_EXPRESSION,
+				Args: []types.T{
+					types.T_uuid,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.IsNotNull[types.Uuid],
+			},
 		},
 	},
 	// comparison operator
@@ -939,6 +1079,83 @@ var operators = map[int]Functions{
 				ReturnTyp: types.T_bool,
 				Fn:        operator.EqString,
 			},
+			{
+				Index:  19,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_time,
+					types.T_time,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.EqGeneral[types.Time],
+			},
+			{
+				Index:  20,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_enum,
+					types.T_enum,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.EqGeneral[uint16],
+			},
+			{
+				Index:  21,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_set,
+					types.T_set,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.EqGeneral[uint64],
+			},
+			{
+				Index:  22,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_binary,
+					types.T_binary,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.EqString,
+			},
+			{
+				Index:  23,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_varbinary,
+					types.T_varbinary,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.EqString,
+			},
+			{
+				Index:  24,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_bit,
+					types.T_bit,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.EqGeneral[uint64],
+			},
+			{
+				Index:  25,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_uuid,
+					types.T_uuid,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.EqUuid,
+			},
 		},
 	},
 
@@ -1155,6 +1372,83 @@ var operators = map[int]Functions{
 				ReturnTyp: types.T_bool,
 				Fn:        operator.GtString,
 			},
+			{
+				Index:  19,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_time,
+					types.T_time,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.GtGeneral[types.Time],
+			},
+			{
+				Index:  20,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_enum,
+					types.T_enum,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.GtGeneral[uint16],
+			},
+			{
+				Index:  21,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_set,
+					types.T_set,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.GtGeneral[uint64],
+			},
+			{
+				Index:  22,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_binary,
+					types.T_binary,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.GtString,
+			},
+			{
+				Index:  23,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_varbinary,
+					types.T_varbinary,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.GtString,
+			},
+			{
+				Index:  24,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_bit,
+					types.T_bit,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.GtGeneral[uint64],
+			},
+			{
+				Index:  25,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_uuid,
+					types.T_uuid,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.GtUuid,
+			},
 		},
 	},
 
@@ -1371,85 +1665,162 @@ var operators = map[int]Functions{
 				ReturnTyp: types.T_bool,
 				Fn:        operator.GeString,
 			},
-		},
-	},
-
-	LESS_THAN: {
-		Id:          LESS_THAN,
-		TypeCheckFn: GeneralBinaryOperatorTypeCheckFn1,
-		Overloads: []Function{
 			{
-				Index:  0,
+				Index:  19,
 				Flag:   plan.Function_STRICT,
 				Layout: COMPARISON_OPERATOR,
 				Args: []types.T{
-					types.T_uint8,
-					types.T_uint8,
+					types.T_time,
+					types.T_time,
 				},
 				ReturnTyp: types.T_bool,
-				Fn:        operator.LtGeneral[uint8],
+				Fn:        operator.GeGeneral[types.Time],
 			},
 			{
-				Index:  1,
+				Index:  20,
 				Flag:   plan.Function_STRICT,
 				Layout: COMPARISON_OPERATOR,
 				Args: []types.T{
-					types.T_uint16,
-					types.T_uint16,
+					types.T_enum,
+					types.T_enum,
 				},
 				ReturnTyp: types.T_bool,
-				Fn:        operator.LtGeneral[uint16],
+				Fn:        operator.GeGeneral[uint16],
 			},
 			{
-				Index:  2,
+				Index:  21,
 				Flag:   plan.Function_STRICT,
 				Layout: COMPARISON_OPERATOR,
 				Args: []types.T{
-					types.T_uint32,
-					types.T_uint32,
+					types.T_set,
+					types.T_set,
 				},
 				ReturnTyp: types.T_bool,
-				Fn:        operator.LtGeneral[uint32],
+				Fn:        operator.GeGeneral[uint64],
 			},
 			{
-				Index:  3,
+				Index:  22,
 				Flag:   plan.Function_STRICT,
 				Layout: COMPARISON_OPERATOR,
 				Args: []types.T{
-					types.T_uint64,
-					types.T_uint64,
+					types.T_binary,
+					types.T_binary,
 				},
 				ReturnTyp: types.T_bool,
-				Fn:        operator.LtGeneral[uint64],
+				Fn:        operator.GeString,
 			},
 			{
-				Index:  4,
+				Index:  23,
 				Flag:   plan.Function_STRICT,
 				Layout: COMPARISON_OPERATOR,
 				Args: []types.T{
-					types.T_int8,
-					types.T_int8,
+					types.T_varbinary,
+					types.T_varbinary,
 				},
 				ReturnTyp: types.T_bool,
-				Fn:        operator.LtGeneral[int8],
+				Fn:        operator.GeString,
 			},
 			{
-				Index:  5,
+				Index:  24,
 				Flag:   plan.Function_STRICT,
 				Layout: COMPARISON_OPERATOR,
 				Args: []types.T{
-					types.T_int16,
-					types.T_int16,
+					types.T_bit,
+					types.T_bit,
 				},
 				ReturnTyp: types.T_bool,
-				Fn:        operator.LtGeneral[int16],
+				Fn:        operator.GeGeneral[uint64],
 			},
 			{
-				Index:  6,
+				Index:  25,
 				Flag:   plan.Function_STRICT,
 				Layout: COMPARISON_OPERATOR,
 				Args: []types.T{
-					types.T_int32,
+					types.T_uuid,
+					types.T_uuid,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.GeUuid,
+			},
+		},
+	},
+
+	LESS_THAN: {
+		Id:          LESS_THAN,
+		TypeCheckFn: GeneralBinaryOperatorTypeCheckFn1,
+		Overloads: []Function{
+			{
+				Index:  0,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_uint8,
+					types.T_uint8,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.LtGeneral[uint8],
+			},
+			{
+				Index:  1,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_uint16,
+					types.T_uint16,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.LtGeneral[uint16],
+			},
+			{
+				Index:  2,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_uint32,
+					types.T_uint32,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.LtGeneral[uint32],
+			},
+			{
+				Index:  3,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_uint64,
+					types.T_uint64,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.LtGeneral[uint64],
+			},
+			{
+				Index:  4,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_int8,
+					types.T_int8,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.LtGeneral[int8],
+			},
+			{
+				Index:  5,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_int16,
+					types.T_int16,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.LtGeneral[int16],
+			},
+			{
+				Index:  6,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_int32,
 					types.T_int32,
 				},
 				ReturnTyp: types.T_bool,
@@ -1587,6 +1958,83 @@ var operators = map[int]Functions{
 				ReturnTyp: types.T_bool,
 				Fn:        operator.LtString,
 			},
+			{
+				Index:  19,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_time,
+					types.T_time,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.LtGeneral[types.Time],
+			},
+			{
+				Index:  20,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_enum,
+					types.T_enum,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.LtGeneral[uint16],
+			},
+			{
+				Index:  21,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_set,
+					types.T_set,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.LtGeneral[uint64],
+			},
+			{
+				Index:  22,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_binary,
+					types.T_binary,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.LtString,
+			},
+			{
+				Index:  23,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_varbinary,
+					types.T_varbinary,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.LtString,
+			},
+			{
+				Index:  24,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_bit,
+					types.T_bit,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.LtGeneral[uint64],
+			},
+			{
+				Index:  25,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_uuid,
+					types.T_uuid,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.LtUuid,
+			},
 		},
 	},
 
@@ -1803,6 +2251,83 @@ var operators = map[int]Functions{
 				ReturnTyp: types.T_bool,
 				Fn:        operator.LeString,
 			},
+			{
+				Index:  19,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_time,
+					types.T_time,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.LeGeneral[types.Time],
+			},
+			{
+				Index:  20,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_enum,
+					types.T_enum,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.LeGeneral[uint16],
+			},
+			{
+				Index:  21,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_set,
+					types.T_set,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.LeGeneral[uint64],
+			},
+			{
+				Index:  22,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_binary,
+					types.T_binary,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.LeString,
+			},
+			{
+				Index:  23,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_varbinary,
+					types.T_varbinary,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.LeString,
+			},
+			{
+				Index:  24,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_bit,
+					types.T_bit,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.LeGeneral[uint64],
+			},
+			{
+				Index:  25,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_uuid,
+					types.T_uuid,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.LeUuid,
+			},
 		},
 	},
 
@@ -2019,6 +2544,83 @@ var operators = map[int]Functions{
 				ReturnTyp: types.T_bool,
 				Fn:        operator.NeString,
 			},
+			{
+				Index:  19,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_time,
+					types.T_time,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.NeGeneral[types.Time],
+			},
+			{
+				Index:  20,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_enum,
+					types.T_enum,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.NeGeneral[uint16],
+			},
+			{
+				Index:  21,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_set,
+					types.T_set,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.NeGeneral[uint64],
+			},
+			{
+				Index:  22,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_binary,
+					types.T_binary,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.NeString,
+			},
+			{
+				Index:  23,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_varbinary,
+					types.T_varbinary,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.NeString,
+			},
+			{
+				Index:  24,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_bit,
+					types.T_bit,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.NeGeneral[uint64],
+			},
+			{
+				Index:  25,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_uuid,
+					types.T_uuid,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.NeUuid,
+			},
 		},
 	},
 
@@ -2029,10 +2631,10 @@ var operators = map[int]Functions{
 				return wrongFunctionParameters, nil
 			}
 			typ1, typ2 := inputs[0], inputs[1]
-			if typ1 != types.T_char && typ1 != types.T_varchar && typ1 != types.T_blob {
+			if !types.IsString(typ1) {
 				return wrongFunctionParameters, nil
 			}
-			if typ2 != types.T_char && typ2 != types.T_varchar && typ2 != types.T_blob {
+			if !types.IsString(typ2) {
 				return wrongFunctionParameters, nil
 			}
 			return 0, nil
@@ -5115,18 +5717,626 @@ var operators = map[int]Functions{
 				ReturnTyp: types.T_json,
 				Fn:        operator.Cast,
 			},
-		},
-	},
-
-	COALESCE: {
-		Id: COALESCE,
-		TypeCheckFn: func(overloads []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
-			l := len(inputs)
-			if l == 0 {
-				return wrongFunctionParameters, nil
-			}
-
-			for i, o := range overloads {
+			{
+				Index:     266,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_time, types.T_time},
+				ReturnTyp: types.T_time,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     267,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_char, types.T_time},
+				ReturnTyp: types.T_time,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     268,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_varchar, types.T_time},
+				ReturnTyp: types.T_time,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     269,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_blob, types.T_time},
+				ReturnTyp: types.T_time,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     270,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_time, types.T_char},
+				ReturnTyp: types.T_char,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     271,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_time, types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     272,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_time, types.T_blob},
+				ReturnTyp: types.T_blob,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     273,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_datetime, types.T_time},
+				ReturnTyp: types.T_time,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     274,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_time, types.T_datetime},
+				ReturnTyp: types.T_datetime,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     275,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_time, types.T_int64},
+				ReturnTyp: types.T_int64,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     276,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_int8, types.T_time},
+				ReturnTyp: types.T_time,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     277,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_int16, types.T_time},
+				ReturnTyp: types.T_time,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     278,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_int32, types.T_time},
+				ReturnTyp: types.T_time,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     279,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_int64, types.T_time},
+				ReturnTyp: types.T_time,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     280,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_uint8, types.T_time},
+				ReturnTyp: types.T_time,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     281,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_uint16, types.T_time},
+				ReturnTyp: types.T_time,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     282,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_uint32, types.T_time},
+				ReturnTyp: types.T_time,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     283,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_uint64, types.T_time},
+				ReturnTyp: types.T_time,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     284,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_char, types.T_binary},
+				ReturnTyp: types.T_binary,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     285,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_char, types.T_varbinary},
+				ReturnTyp: types.T_varbinary,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     286,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_varchar, types.T_binary},
+				ReturnTyp: types.T_binary,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     287,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_varchar, types.T_varbinary},
+				ReturnTyp: types.T_varbinary,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     288,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_blob, types.T_binary},
+				ReturnTyp: types.T_binary,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     289,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_blob, types.T_varbinary},
+				ReturnTyp: types.T_varbinary,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     290,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_binary, types.T_binary},
+				ReturnTyp: types.T_binary,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     291,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_binary, types.T_varbinary},
+				ReturnTyp: types.T_varbinary,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     292,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_varbinary, types.T_binary},
+				ReturnTyp: types.T_binary,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     293,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_varbinary, types.T_varbinary},
+				ReturnTyp: types.T_varbinary,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     294,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_binary, types.T_char},
+				ReturnTyp: types.T_char,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     295,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_binary, types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     296,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_binary, types.T_blob},
+				ReturnTyp: types.T_blob,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     297,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_varbinary, types.T_char},
+				ReturnTyp: types.T_char,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     298,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_varbinary, types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     299,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_varbinary, types.T_blob},
+				ReturnTyp: types.T_blob,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     300,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_bit, types.T_bit},
+				ReturnTyp: types.T_bit,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     301,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_bit, types.T_int8},
+				ReturnTyp: types.T_int8,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     302,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_bit, types.T_int16},
+				ReturnTyp: types.T_int16,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     303,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_bit, types.T_int32},
+				ReturnTyp: types.T_int32,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     304,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_bit, types.T_int64},
+				ReturnTyp: types.T_int64,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     305,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_bit, types.T_uint8},
+				ReturnTyp: types.T_uint8,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     306,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_bit, types.T_uint16},
+				ReturnTyp: types.T_uint16,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     307,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_bit, types.T_uint32},
+				ReturnTyp: types.T_uint32,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     308,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_bit, types.T_uint64},
+				ReturnTyp: types.T_uint64,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     309,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_bit, types.T_float32},
+				ReturnTyp: types.T_float32,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     310,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_bit, types.T_float64},
+				ReturnTyp: types.T_float64,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     311,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_bit, types.T_decimal64},
+				ReturnTyp: types.T_decimal64,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     312,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_bit, types.T_decimal128},
+				ReturnTyp: types.T_decimal128,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     313,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_bit, types.T_char},
+				ReturnTyp: types.T_char,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     314,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_bit, types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     315,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_bit, types.T_blob},
+				ReturnTyp: types.T_blob,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     316,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_bit, types.T_binary},
+				ReturnTyp: types.T_binary,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     317,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_bit, types.T_varbinary},
+				ReturnTyp: types.T_varbinary,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     318,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_int8, types.T_bit},
+				ReturnTyp: types.T_bit,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     319,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_int16, types.T_bit},
+				ReturnTyp: types.T_bit,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     320,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_int32, types.T_bit},
+				ReturnTyp: types.T_bit,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     321,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_int64, types.T_bit},
+				ReturnTyp: types.T_bit,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     322,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_uint8, types.T_bit},
+				ReturnTyp: types.T_bit,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     323,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_uint16, types.T_bit},
+				ReturnTyp: types.T_bit,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     324,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_uint32, types.T_bit},
+				ReturnTyp: types.T_bit,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     325,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_uint64, types.T_bit},
+				ReturnTyp: types.T_bit,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     326,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_char, types.T_bit},
+				ReturnTyp: types.T_bit,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     327,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_varchar, types.T_bit},
+				ReturnTyp: types.T_bit,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     328,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_blob, types.T_bit},
+				ReturnTyp: types.T_bit,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     329,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_binary, types.T_bit},
+				ReturnTyp: types.T_bit,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     330,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_varbinary, types.T_bit},
+				ReturnTyp: types.T_bit,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     331,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_uuid, types.T_uuid},
+				ReturnTyp: types.T_uuid,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     332,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_uuid, types.T_char},
+				ReturnTyp: types.T_char,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     333,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_uuid, types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     334,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_uuid, types.T_blob},
+				ReturnTyp: types.T_blob,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     335,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_uuid, types.T_binary},
+				ReturnTyp: types.T_binary,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     336,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_uuid, types.T_varbinary},
+				ReturnTyp: types.T_varbinary,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     337,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_char, types.T_uuid},
+				ReturnTyp: types.T_uuid,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     338,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_varchar, types.T_uuid},
+				ReturnTyp: types.T_uuid,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     339,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_blob, types.T_uuid},
+				ReturnTyp: types.T_uuid,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     340,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_binary, types.T_uuid},
+				ReturnTyp: types.T_uuid,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     341,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_varbinary, types.T_uuid},
+				ReturnTyp: types.T_uuid,
+				Fn:        operator.Cast,
+			},
+		},
+	},
+
+	COALESCE: {
+		Id: COALESCE,
+		TypeCheckFn: func(overloads []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			l := len(inputs)
+			if l == 0 {
+				return wrongFunctionParameters, nil
+			}
+
+			for i, o := range overloads {
 				if operator.CoalesceTypeCheckFn(inputs, nil, o.ReturnTyp) {
 					return int32(i), nil
 				}
@@ -5298,6 +6508,14 @@ var operators = map[int]Functions{
 				ReturnTyp: types.T_timestamp,
 				Fn:        operator.CoalesceTimestamp,
 			},
+			{
+				Index:     18,
+				Volatile:  true,
+				Flag:      plan.Function_NONE,
+				Layout:    STANDARD_FUNCTION,
+				ReturnTyp: types.T_time,
+				Fn:        operator.CoalesceTime,
+			},
 		},
 	},
 
@@ -5498,6 +6716,14 @@ var operators = map[int]Functions{
 				ReturnTyp: types.T_blob,
 				Fn:        operator.CaseWhenText,
 			},
+			{
+				Index:     19,
+				Volatile:  true,
+				Flag:      plan.Function_NONE,
+				Layout:    CASE_WHEN_EXPRESSION,
+				ReturnTyp: types.T_time,
+				Fn:        operator.CaseWhenTime,
+			},
 		},
 	},
 
@@ -5687,6 +6913,14 @@ var operators = map[int]Functions{
 				ReturnTyp: types.T_blob,
 				Fn:        operator.IfText,
 			},
+			{
+				Index:     19,
+				Volatile:  true,
+				Flag:      plan.Function_NONE,
+				Layout:    STANDARD_FUNCTION,
+				ReturnTyp: types.T_time,
+				Fn:        operator.IfTime,
+			},
 		},
 	},
 }
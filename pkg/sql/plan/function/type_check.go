@@ -58,9 +58,12 @@ func initTypeCheckRelated() {
 		types.T_int8, types.T_int16, types.T_int32, types.T_int64,
 		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
 		types.T_float32, types.T_float64,
-		types.T_date, types.T_datetime, types.T_timestamp,
+		types.T_date, types.T_time, types.T_datetime, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob,
+		types.T_binary, types.T_varbinary,
 		types.T_decimal64, types.T_decimal128,
+		types.T_bit,
+		types.T_uuid,
 	}
 	numbers := []types.T{ // numbers without decimal
 		types.T_int8, types.T_int16, types.T_int32, types.T_int64,
@@ -69,7 +72,7 @@ func initTypeCheckRelated() {
 	ints := []types.T{types.T_int8, types.T_int16, types.T_int32, types.T_int64}
 	uints := []types.T{types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64}
 	floats := []types.T{types.T_float32, types.T_float64}
-	strings := []types.T{types.T_char, types.T_varchar, types.T_blob}
+	strings := []types.T{types.T_char, types.T_varchar, types.T_blob, types.T_binary, types.T_varbinary}
 	decimals := []types.T{types.T_decimal64, types.T_decimal128}
 
 	// init binaryTable
@@ -146,6 +149,8 @@ func initTypeCheckRelated() {
 		convertRuleForBinaryTable = append(convertRuleForBinaryTable, [4]types.T{types.T_int64, types.T_uint64, types.T_int64, types.T_int64})
 		convertRuleForBinaryTable = append(convertRuleForBinaryTable, [4]types.T{types.T_date, types.T_datetime, types.T_datetime, types.T_datetime})
 		convertRuleForBinaryTable = append(convertRuleForBinaryTable, [4]types.T{types.T_datetime, types.T_date, types.T_datetime, types.T_datetime})
+		convertRuleForBinaryTable = append(convertRuleForBinaryTable, [4]types.T{types.T_time, types.T_datetime, types.T_datetime, types.T_datetime})
+		convertRuleForBinaryTable = append(convertRuleForBinaryTable, [4]types.T{types.T_datetime, types.T_time, types.T_datetime, types.T_datetime})
 		for _, t1 := range strings {
 			for _, t2 := range all {
 				if t1 == t2 || t2 == types.T_any {
@@ -155,6 +160,17 @@ func initTypeCheckRelated() {
 				convertRuleForBinaryTable = append(convertRuleForBinaryTable, [4]types.T{t2, t1, t2, t2})
 			}
 		}
+		// bit is computed as the uint64 with the numbers
+		for _, typ := range append(append(append([]types.T{}, numbers...), floats...), decimals...) {
+			l, r := types.T_uint64, typ
+			for _, rule := range convertRuleForBinaryTable {
+				if rule[0] == types.T_uint64 && rule[1] == typ {
+					l, r = rule[2], rule[3]
+				}
+			}
+			convertRuleForBinaryTable = append(convertRuleForBinaryTable, [4]types.T{types.T_bit, typ, l, r})
+			convertRuleForBinaryTable = append(convertRuleForBinaryTable, [4]types.T{typ, types.T_bit, r, l})
+		}
 	}
 
 	binaryTable = make([][]binaryTargetTypes, maxTypes)
@@ -264,10 +280,19 @@ func initTypeCheckRelated() {
 		castTable[types.T_datetime][types.T_datetime] = true
 		castTable[types.T_datetime][types.T_date] = true
 		castTable[types.T_datetime][types.T_timestamp] = true
+		castTable[types.T_datetime][types.T_time] = true
 		for _, typ := range strings {
 			castTable[types.T_datetime][typ] = true
 		}
 	}
+	{ // time
+		castTable[types.T_time][types.T_time] = true
+		castTable[types.T_time][types.T_datetime] = true
+		castTable[types.T_time][types.T_int64] = true
+		for _, typ := range strings {
+			castTable[types.T_time][typ] = true
+		}
+	}
 	{ //  float
 		for _, t := range floats {
 			castTable[t][types.T_bool] = true
@@ -296,6 +321,7 @@ func initTypeCheckRelated() {
 				castTable[t][typ] = true
 			}
 			castTable[t][types.T_timestamp] = true
+			castTable[t][types.T_time] = true
 			castTable[t][types.T_decimal64] = true
 			castTable[t][types.T_decimal128] = true
 			for _, typ := range strings {
@@ -319,6 +345,28 @@ func initTypeCheckRelated() {
 			}
 		}
 	}
+	{ // bit
+		castTable[types.T_bit][types.T_bit] = true
+		for _, typ := range numbers {
+			castTable[types.T_bit][typ] = true
+			castTable[typ][types.T_bit] = true
+		}
+		for _, typ := range floats {
+			castTable[types.T_bit][typ] = true
+		}
+		for _, typ := range decimals {
+			castTable[types.T_bit][typ] = true
+		}
+		for _, typ := range strings {
+			castTable[types.T_bit][typ] = true
+		}
+	}
+	{ // uuid
+		castTable[types.T_uuid][types.T_uuid] = true
+		for _, typ := range strings {
+			castTable[types.T_uuid][typ] = true
+		}
+	}
 	{ // timestamp
 		castTable[types.T_timestamp][types.T_timestamp] = true
 		castTable[types.T_timestamp][types.T_date] = true
@@ -349,6 +397,10 @@ func initTypeCheckRelated() {
 		types.T_char:       {types.T_varchar, types.T_int64},
 		types.T_varchar:    {types.T_char, types.T_int64},
 		types.T_blob:       {types.T_blob},
+		types.T_binary:     {types.T_varbinary},
+		types.T_varbinary:  {types.T_binary},
+		types.T_bit:        {types.T_uint64, types.T_int64},
+		types.T_uuid:       {types.T_varchar},
 		types.T_decimal64:  {types.T_decimal128, types.T_float64},
 		types.T_decimal128: {types.T_float64},
 		types.T_date:       {types.T_datetime},
@@ -435,6 +487,120 @@ func generalTypeCheckForUnaryAggregate(overloads []Function, inputs []types.T) (
 	return wrongFuncParamForAgg, nil
 }
 
+// typeCheckForOrderedAggregate checks the arguments of group_concat and array_agg,
+// which are the value, the constant arguments of types consts and the keys the
+// values are ordered by. A value is cast to varchar if isValue returns false for it.
+func typeCheckForOrderedAggregate(inputs []types.T, consts []types.T, isValue func(types.T) bool) (overloadIndex int32, ts []types.T) {
+	if len(inputs) <= len(consts) {
+		return wrongFunctionParameters, nil
+	}
+	convert := false
+	ts = make([]types.T, len(inputs))
+	copy(ts, inputs)
+	if !isValue(inputs[0]) {
+		if !castTable[inputs[0]][types.T_varchar] {
+			return wrongFuncParamForAgg, nil
+		}
+		ts[0] = types.T_varchar
+		convert = true
+	}
+	for i, t := range consts {
+		if inputs[i+1] != t {
+			ts[i+1] = t
+			convert = true
+		}
+	}
+	for _, t := range inputs[len(consts)+1:] {
+		switch t {
+		case types.T_json, types.T_interval:
+			return wrongFuncParamForAgg, nil
+		}
+	}
+	if convert {
+		return 0, ts
+	}
+	return 0, nil
+}
+
+func isGroupConcatValue(t types.T) bool {
+	switch t {
+	case types.T_char, types.T_varchar, types.T_blob, types.T_binary, types.T_varbinary, types.T_any:
+		return true
+	}
+	return false
+}
+
+// isArrayAggValue returns true if the type has a json counterpart
+func isArrayAggValue(t types.T) bool {
+	switch t {
+	case types.T_bool, types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_decimal64, types.T_decimal128,
+		types.T_char, types.T_varchar, types.T_blob, types.T_json, types.T_any:
+		return true
+	}
+	return false
+}
+
+// typeCheckForBytesFunction checks the arguments of the digest and encryption functions.
+// A varchar in requires accepts any string or binary type without conversion,
+// other types of inputs are cast to the required types.
+func typeCheckForBytesFunction(inputs []types.T, requires []types.T) (overloadIndex int32, ts []types.T) {
+	if len(inputs) != len(requires) {
+		return wrongFunctionParameters, nil
+	}
+	convert := false
+	ts = make([]types.T, len(inputs))
+	for i, t := range inputs {
+		ts[i] = t
+		if requires[i] == types.T_varchar && isGroupConcatValue(t) || t == requires[i] || t == ScalarNull {
+			continue
+		}
+		if !castTable[t][requires[i]] {
+			return wrongFunctionParameters, nil
+		}
+		ts[i] = requires[i]
+		convert = true
+	}
+	if convert {
+		return 0, ts
+	}
+	return 0, nil
+}
+
+// typeCheckForTemporalFunction matches the arguments with the overload which has exactly the same
+// argument types, or casts them to the argument types of the first overload, so that the strings
+// and the timestamps are converted to the date and time values the first overload wants.
+func typeCheckForTemporalFunction(overloads []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+	for _, o := range overloads {
+		if len(o.Args) != len(inputs) {
+			continue
+		}
+		matched := true
+		for i, t := range inputs {
+			if t != o.Args[i] && t != ScalarNull {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return o.Index, nil
+		}
+	}
+	requires := overloads[0].Args
+	if len(requires) != len(inputs) {
+		return wrongFunctionParameters, nil
+	}
+	ts = make([]types.T, len(inputs))
+	for i, t := range inputs {
+		ts[i] = requires[i]
+		if t != requires[i] && t != ScalarNull && !castTable[t][requires[i]] {
+			return wrongFunctionParameters, nil
+		}
+	}
+	return overloads[0].Index, ts
+}
+
 // tryToMatch checks whether the types of the two input parameters match directly
 // or can be matched by implicit type conversion.
 // If the match is successful,
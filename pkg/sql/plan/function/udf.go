@@ -0,0 +1,43 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+// Udf is a user-defined SQL function of a database. Definition is the
+// text of its CREATE FUNCTION statement, the body of which is inlined
+// into the calling expression when a query is bound.
+type Udf struct {
+	Db         string
+	Name       string
+	Definition string
+}
+
+// binderFunctions are rewritten by the binder into other functions, so
+// they do not appear in functionIdRegister.
+var binderFunctions = map[string]struct{}{
+	"nullif":  {},
+	"adddate": {},
+	"subdate": {},
+}
+
+// IsBuiltinFunction returns true if name is a builtin function or operator,
+// or a function added by RegisterFunction. Such names can not be used by
+// user-defined functions.
+func IsBuiltinFunction(name string) bool {
+	if _, ok := functionIdRegister[name]; ok {
+		return true
+	}
+	_, ok := binderFunctions[name]
+	return ok
+}
@@ -0,0 +1,50 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func NewGeneratedBinder(builder *QueryBuilder, ctx *BindContext) *GeneratedBinder {
+	b := &GeneratedBinder{}
+	b.builder = builder
+	b.ctx = ctx
+	b.impl = b
+	return b
+}
+
+func (b *GeneratedBinder) BindExpr(expr tree.Expr, i int32, isRoot bool) (*plan.Expr, error) {
+	return b.baseBindExpr(expr, i, isRoot)
+}
+
+func (b *GeneratedBinder) BindColRef(name *tree.UnresolvedName, i int32, isRoot bool) (*plan.Expr, error) {
+	return b.baseBindColRef(name, i, isRoot)
+}
+
+func (b *GeneratedBinder) BindAggFunc(funcName string, expr *tree.FuncExpr, i int32, isRoot bool) (*plan.Expr, error) {
+	return nil, errors.New(errno.GroupingError, "aggregate functions not allowed in generated column")
+}
+
+func (b *GeneratedBinder) BindWinFunc(funcName string, expr *tree.FuncExpr, i int32, isRoot bool) (*plan.Expr, error) {
+	return nil, errors.New(errno.WindowingError, "window functions not allowed in generated column")
+}
+
+func (b *GeneratedBinder) BindSubquery(astExpr *tree.Subquery, isRoot bool) (*plan.Expr, error) {
+	return nil, errors.New(errno.InvalidColumnReference, "subquery not allowed in generated column")
+}
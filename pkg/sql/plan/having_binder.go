@@ -16,9 +16,12 @@ package plan
 
 import (
 	"fmt"
+	"go/constant"
+	"strconv"
 
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg/groupconcat"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
@@ -94,8 +97,18 @@ func (b *HavingBinder) BindAggFunc(funcName string, astExpr *tree.FuncExpr, dept
 		return nil, errors.New(errno.GroupingError, "aggregate function calls cannot be nested")
 	}
 
+	astArgs := astExpr.Exprs
+	switch funcName {
+	case "group_concat", "array_agg":
+		astArgs = b.orderedAggArgs(funcName, astExpr)
+	default:
+		if len(astExpr.OrderBy) > 0 {
+			return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("aggregate function %s does not support order by", funcName))
+		}
+	}
+
 	b.insideAgg = true
-	expr, err := b.bindFuncExprImplByAstExpr(funcName, astExpr.Exprs, depth)
+	expr, err := b.bindFuncExprImplByAstExpr(funcName, astArgs, depth)
 	if err != nil {
 		return nil, err
 	}
@@ -120,6 +133,53 @@ func (b *HavingBinder) BindAggFunc(funcName string, astExpr *tree.FuncExpr, dept
 	}, nil
 }
 
+// orderedAggArgs rewrites the arguments of group_concat and array_agg to the
+// value, the constant arguments and the keys to order the values by, see
+// groupconcat.GroupConcat for the layout.
+func (b *HavingBinder) orderedAggArgs(funcName string, astExpr *tree.FuncExpr) []tree.Expr {
+	var desc int64
+
+	keys := make([]tree.Expr, len(astExpr.OrderBy))
+	for i, order := range astExpr.OrderBy {
+		if order.Direction == tree.Descending {
+			desc |= 1 << i
+		}
+		keys[i] = order.Expr
+	}
+	descArg := tree.NewNumValWithType(constant.MakeInt64(desc), strconv.FormatInt(desc, 10), false, tree.P_int64)
+	if funcName == "array_agg" {
+		return append([]tree.Expr{astExpr.Exprs[0], descArg}, keys...)
+	}
+
+	// group_concat(a, b) concatenates the values of a and b of every row
+	value := astExpr.Exprs[0]
+	if len(astExpr.Exprs) > 1 {
+		value = &tree.FuncExpr{
+			Func:  tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName("concat")),
+			Exprs: astExpr.Exprs,
+		}
+	}
+	sep := groupconcat.DefaultSeparator
+	if astExpr.Separator != nil {
+		sep = astExpr.Separator.String()
+	}
+	maxLen := int64(groupconcat.DefaultMaxLen)
+	if v, err := b.builder.compCtx.ResolveVariable("group_concat_max_len", true, false); err == nil {
+		switch v := v.(type) {
+		case int64:
+			maxLen = v
+		case int:
+			maxLen = int64(v)
+		}
+	}
+	return append([]tree.Expr{
+		value,
+		tree.NewNumValWithType(constant.MakeString(sep), sep, false, tree.P_char),
+		tree.NewNumValWithType(constant.MakeInt64(maxLen), strconv.FormatInt(maxLen, 10), false, tree.P_int64),
+		descArg,
+	}, keys...)
+}
+
 func (b *HavingBinder) BindWinFunc(funcName string, astExpr *tree.FuncExpr, depth int32, isRoot bool) (*plan.Expr, error) {
 	if b.insideAgg {
 		return nil, errors.New(errno.GroupingError, "aggregate function calls cannot contain window function calls")
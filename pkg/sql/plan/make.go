@@ -136,6 +136,9 @@ func makePlan2StringConstExprWithType(v string) *plan.Expr {
 }
 
 func makePlan2CastExpr(expr *Expr, targetType *Type) (*Expr, error) {
+	if isEnumType(expr.Typ) || isEnumType(targetType) {
+		return makeEnumCastExpr(expr, targetType)
+	}
 	t1, t2 := makeTypeByPlan2Expr(expr), makeTypeByPlan2Type(targetType)
 	if isSameColumnType(expr.Typ, targetType) {
 		return expr, nil
@@ -162,14 +165,88 @@ func makePlan2CastExpr(expr *Expr, targetType *Type) (*Expr, error) {
 	}, nil
 }
 
+func isEnumType(t *Type) bool {
+	return t.Id == int32(types.T_enum) || t.Id == int32(types.T_set)
+}
+
+// isStringType returns true if the type is the char, varchar or text type which has a collation
+func isStringType(t *Type) bool {
+	return t.Id == int32(types.T_char) || t.Id == int32(types.T_varchar) || t.Id == int32(types.T_blob)
+}
+
+func isSameEnumType(t1, t2 *Type) bool {
+	if t1.Id != t2.Id || len(t1.Enumvalues) != len(t2.Enumvalues) {
+		return false
+	}
+	for i, v := range t1.Enumvalues {
+		if v != t2.Enumvalues[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// makeEnumCastExpr converts between the enum or set type and the other types, the value list
+// of the enum or set type is not kept by the vectors, so it is passed to the functions as a constant
+func makeEnumCastExpr(expr *Expr, targetType *Type) (*Expr, error) {
+	var err error
+	if isEnumType(expr.Typ) {
+		if isSameEnumType(expr.Typ, targetType) {
+			return expr, nil
+		}
+		if expr, err = makeEnumToStringExpr(expr); err != nil {
+			return nil, err
+		}
+		if !isEnumType(targetType) {
+			if targetType.Id == int32(types.T_varchar) {
+				return expr, nil
+			}
+			return appendCastBeforeExpr(expr, targetType)
+		}
+	}
+
+	switch types.T(expr.Typ.Id) {
+	case types.T_char, types.T_varchar, types.T_blob, types.T_int64, types.T_uint64, types.T_binary, types.T_varbinary:
+	case types.T_int8, types.T_int16, types.T_int32:
+		expr, err = appendCastBeforeExpr(expr, &Type{Id: int32(types.T_int64), Size: 8})
+	case types.T_uint8, types.T_uint16, types.T_uint32:
+		expr, err = appendCastBeforeExpr(expr, &Type{Id: int32(types.T_uint64), Size: 8})
+	case types.T_any:
+		expr.Typ = &Type{Id: int32(types.T_varchar), Size: 24}
+	default:
+		expr, err = appendCastBeforeExpr(expr, &Type{Id: int32(types.T_varchar), Size: 24})
+	}
+	if err != nil {
+		return nil, err
+	}
+	name := "cast_to_enum"
+	if targetType.Id == int32(types.T_set) {
+		name = "cast_to_set"
+	}
+	values := makePlan2StringConstExprWithType(types.EncodeEnumValues(targetType.Enumvalues))
+	if expr, err = bindFuncExprImplByPlanExpr(name, []*Expr{expr, values}); err != nil {
+		return nil, err
+	}
+	expr.Typ = copyType(targetType)
+	return expr, nil
+}
+
+// makeEnumToStringExpr converts the enum or set values to be the strings
+func makeEnumToStringExpr(expr *Expr) (*Expr, error) {
+	values := makePlan2StringConstExprWithType(types.EncodeEnumValues(expr.Typ.Enumvalues))
+	return bindFuncExprImplByPlanExpr("enum_to_string", []*Expr{expr, values})
+}
+
 func copyType(t *Type) *Type {
 	return &Type{
-		Id:        t.Id,
-		Nullable:  t.Nullable,
-		Width:     t.Width,
-		Precision: t.Precision,
-		Size:      t.Size,
-		Scale:     t.Scale,
+		Id:         t.Id,
+		Nullable:   t.Nullable,
+		Width:      t.Width,
+		Precision:  t.Precision,
+		Size:       t.Size,
+		Scale:      t.Scale,
+		Enumvalues: t.Enumvalues,
+		Collation:  t.Collation,
 	}
 }
 
@@ -196,6 +273,7 @@ func makePlan2Type(typ *types.Type) *plan.Type {
 		Precision: typ.Precision,
 		Size:      typ.Size,
 		Scale:     typ.Scale,
+		Collation: int32(typ.Collation),
 	}
 }
 
@@ -206,6 +284,7 @@ func makeTypeByPlan2Type(typ *plan.Type) types.Type {
 		Width:     typ.Width,
 		Scale:     typ.Scale,
 		Precision: typ.Precision,
+		Collation: types.Collation(typ.Collation),
 	}
 }
 
@@ -221,5 +300,6 @@ func makeTypeByPlan2Expr(expr *plan.Expr) types.Type {
 		Width:     expr.Typ.Width,
 		Scale:     expr.Typ.Scale,
 		Precision: expr.Typ.Precision,
+		Collation: types.Collation(expr.Typ.Collation),
 	}
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 )
 
@@ -335,6 +336,23 @@ func (m *MockCompilerContext) Cost(obj *ObjectRef, e *Expr) *Cost {
 	return m.costs[obj.ObjName]
 }
 
+var mockUdfs = map[string]string{
+	"net_price": "create function net_price(price decimal(15, 2), rate double) returns decimal(15, 2) return price * (1 - rate)",
+	"add_one":   "create function add_one(a int) returns bigint return a + 1",
+	"add_two":   "create function add_two(a int) returns bigint return add_one(add_one(a))",
+	"loop_f":    "create function loop_f(a int) returns int return loop_f(a)",
+}
+
+func (m *MockCompilerContext) ResolveUdf(dbName string, funcName string) (*function.Udf, error) {
+	if dbName == "" {
+		dbName = m.DefaultDatabase()
+	}
+	if def, ok := mockUdfs[funcName]; ok && dbName == "tpch" {
+		return &function.Udf{Db: dbName, Name: funcName, Definition: def}, nil
+	}
+	return nil, nil
+}
+
 type MockOptimizer struct {
 	ctxt MockCompilerContext
 }
@@ -0,0 +1,174 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+)
+
+// MVRefreshTable is the hidden table of a database keeping the ts of the
+// last refresh of its materialized views.
+var MVRefreshTable = "%!%mo_mv_refresh"
+var MVRefreshTableColNames = []string{"name", "physical", "logical"}
+
+// IncrementalViewCol is a column of a materialized view which can be
+// refreshed incrementally.
+type IncrementalViewCol struct {
+	// Name is the column name in the materialized view
+	Name string
+	// Agg is one of sum, count, min and max, empty for a group by column
+	Agg string
+	// Arg is the column of the base table, empty for count(*)
+	Arg string
+}
+
+// IncrementalView is a materialized view aggregating a single base table,
+// its result can be maintained by applying the changes of the base table.
+type IncrementalView struct {
+	Database string
+	Table    string
+	Cols     []IncrementalViewCol
+}
+
+func isViewKind(kind string) bool {
+	return kind == catalog.SystemViewRel || kind == catalog.SystemMaterializedRel
+}
+
+// IsMaterializedView tells whether the table is the storage of a
+// materialized view.
+func IsMaterializedView(tableDef *TableDef) bool {
+	return tableDef.TableType == catalog.SystemMaterializedRel
+}
+
+// GetMaterializedView returns the definition of the materialized view.
+func GetMaterializedView(tableDef *TableDef) (*tree.CreateView, *ViewData, error) {
+	if !IsMaterializedView(tableDef) {
+		return nil, nil, errors.New(errno.WrongObjectType, fmt.Sprintf("'%s' is not a materialized view", tableDef.Name))
+	}
+	viewData := new(ViewData)
+	for _, def := range tableDef.Defs {
+		if viewDef, ok := def.Def.(*plan.TableDef_DefType_View); ok {
+			if err := json.Unmarshal([]byte(viewDef.View.View), viewData); err != nil {
+				return nil, nil, err
+			}
+			break
+		}
+	}
+	stmts, err := mysql.Parse(viewData.Stmt)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, stmt := range stmts {
+		if view, ok := stmt.(*tree.CreateView); ok && view.Materialized {
+			return view, viewData, nil
+		}
+	}
+	return nil, nil, errors.New("", "can not get materialized view statement")
+}
+
+// BuildIncrementalView returns nil if the materialized view can only be
+// refreshed by recomputing it. Views with a single base table, no filter and
+// only sum, count, min and max of the columns grouped by columns are supported.
+func BuildIncrementalView(tableDef *TableDef, view *tree.CreateView, defaultDB string) *IncrementalView {
+	sel := view.AsSource
+	if sel.With != nil || sel.OrderBy != nil || sel.Limit != nil {
+		return nil
+	}
+	clause, ok := sel.Select.(*tree.SelectClause)
+	if !ok || clause.Distinct || clause.Where != nil || clause.Having != nil ||
+		clause.From == nil || len(clause.From.Tables) != 1 || len(clause.GroupBy) == 0 {
+		return nil
+	}
+	expr := clause.From.Tables[0]
+	if aliased, ok := expr.(*tree.AliasedTableExpr); ok {
+		expr = aliased.Expr
+	}
+	table, ok := expr.(*tree.TableName)
+	if !ok {
+		return nil
+	}
+	iv := &IncrementalView{
+		Database: string(table.SchemaName),
+		Table:    string(table.ObjectName),
+	}
+	if iv.Database == "" {
+		iv.Database = defaultDB
+	}
+
+	groupBy := make(map[string]bool)
+	for _, expr := range clause.GroupBy {
+		name, ok := expr.(*tree.UnresolvedName)
+		if !ok || name.Star {
+			return nil
+		}
+		groupBy[name.Parts[0]] = true
+	}
+	if len(clause.Exprs) > len(tableDef.Cols) {
+		return nil
+	}
+	groups := 0
+	for i, selExpr := range clause.Exprs {
+		col := IncrementalViewCol{Name: tableDef.Cols[i].Name}
+		switch expr := selExpr.Expr.(type) {
+		case *tree.UnresolvedName:
+			if expr.Star || !groupBy[expr.Parts[0]] {
+				return nil
+			}
+			col.Arg = expr.Parts[0]
+			groups++
+		case *tree.FuncExpr:
+			name, ok := expr.Func.FunctionReference.(*tree.UnresolvedName)
+			if !ok || expr.Type == tree.FUNC_TYPE_DISTINCT || len(expr.Exprs) != 1 {
+				return nil
+			}
+			col.Agg = strings.ToLower(name.Parts[0])
+			switch col.Agg {
+			case "sum", "count", "min", "max":
+			default:
+				return nil
+			}
+			switch arg := expr.Exprs[0].(type) {
+			case *tree.UnresolvedName:
+				if arg.Star {
+					return nil
+				}
+				col.Arg = arg.Parts[0]
+			case *tree.NumVal:
+				if col.Agg != "count" || arg.String() != "*" {
+					return nil
+				}
+			default:
+				return nil
+			}
+		default:
+			return nil
+		}
+		iv.Cols = append(iv.Cols, col)
+	}
+	// every group by column must be in the view to locate the rows to update
+	if groups != len(groupBy) {
+		return nil
+	}
+	return iv
+}
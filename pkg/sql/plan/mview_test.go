@@ -0,0 +1,95 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/stretchr/testify/require"
+)
+
+func mockMaterializedView(t *testing.T, sql string, cols ...string) *TableDef {
+	viewData, err := json.Marshal(ViewData{Stmt: sql, DefaultDatabase: "db"})
+	require.NoError(t, err)
+	tableDef := &TableDef{
+		Name:      "mv",
+		TableType: catalog.SystemMaterializedRel,
+		Defs: []*plan.TableDef_DefType{{
+			Def: &plan.TableDef_DefType_View{
+				View: &plan.ViewDef{View: string(viewData)},
+			},
+		}},
+	}
+	for _, col := range cols {
+		tableDef.Cols = append(tableDef.Cols, &ColDef{Name: col})
+	}
+	return tableDef
+}
+
+func TestBuildIncrementalView(t *testing.T) {
+	tableDef := mockMaterializedView(t,
+		"create materialized view mv as select a, sum(b) as s, count(*), min(c), max(c) from t group by a",
+		"a", "s", "count(*)", "min(c)", "max(c)")
+	view, viewData, err := GetMaterializedView(tableDef)
+	require.NoError(t, err)
+	iv := BuildIncrementalView(tableDef, view, viewData.DefaultDatabase)
+	require.NotNil(t, iv)
+	require.Equal(t, "db", iv.Database)
+	require.Equal(t, "t", iv.Table)
+	require.Equal(t, []IncrementalViewCol{
+		{Name: "a", Arg: "a"},
+		{Name: "s", Agg: "sum", Arg: "b"},
+		{Name: "count(*)", Agg: "count"},
+		{Name: "min(c)", Agg: "min", Arg: "c"},
+		{Name: "max(c)", Agg: "max", Arg: "c"},
+	}, iv.Cols)
+
+	for _, sql := range []string{
+		"create materialized view mv as select a, sum(b) from t where b > 1 group by a",
+		"create materialized view mv as select a, avg(b) from t group by a",
+		"create materialized view mv as select a, count(distinct b) from t group by a",
+		"create materialized view mv as select sum(b), count(*) from t",
+		"create materialized view mv as select sum(b), count(*) from t group by a",
+		"create materialized view mv as select a, sum(b) from t join s on t.a = s.a group by a",
+		"create materialized view mv as select a, sum(b) + 1 from t group by a",
+	} {
+		tableDef := mockMaterializedView(t, sql, "a", "b")
+		view, viewData, err := GetMaterializedView(tableDef)
+		require.NoError(t, err)
+		require.Nil(t, BuildIncrementalView(tableDef, view, viewData.DefaultDatabase), sql)
+	}
+
+	tableDef.TableType = catalog.SystemViewRel
+	_, _, err = GetMaterializedView(tableDef)
+	require.Error(t, err)
+}
+
+func TestMaterializedViewDML(t *testing.T) {
+	mock := NewMockOptimizer()
+	mock.ctxt.tables["v1"].TableType = catalog.SystemMaterializedRel
+	for _, sql := range []string{
+		"insert into v1 values ('a')",
+		"insert into v1 select n_name from nation",
+		"update v1 set n_name = 'a'",
+		"delete from v1",
+		"delete from v1 where n_name = 'a'",
+	} {
+		_, err := runOneStmt(mock, t, sql)
+		require.ErrorContains(t, err, "materialized view", sql)
+	}
+}
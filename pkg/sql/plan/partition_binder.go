@@ -15,6 +15,8 @@
 package plan
 
 import (
+	"fmt"
+
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
@@ -34,6 +36,15 @@ func (p *PartitionBinder) BindExpr(expr tree.Expr, i int32, b bool) (*plan.Expr,
 }
 
 func (p *PartitionBinder) BindColRef(name *tree.UnresolvedName, i int32, b bool) (*plan.Expr, error) {
+	// the values of virtual generated columns are not stored, rows could not be routed by them
+	if p.ctx != nil {
+		col := name.Parts[0]
+		if binding, ok := p.ctx.bindingByCol[col]; ok && binding != nil && binding.virtualExprs != nil {
+			if colPos, ok := binding.colIdByName[col]; ok && binding.virtualExprs[colPos] != nil {
+				return nil, errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("Virtual generated column '%s' cannot be used in the partitioning key", col))
+			}
+		}
+	}
 	return p.baseBindColRef(name, i, b)
 }
 
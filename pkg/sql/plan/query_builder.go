@@ -636,6 +636,10 @@ func (builder *QueryBuilder) createQuery() (*Query, error) {
 		rootId = builder.determineJoinOrder(rootId)
 		builder.qry.Steps[i] = rootId
 
+		if err := builder.mergeJoinKeyCollations(rootId); err != nil {
+			return nil, err
+		}
+
 		colRefCnt := make(map[[2]int32]int)
 		rootNode := builder.qry.Nodes[rootId]
 		resultTag := rootNode.BindingTags[0]
@@ -915,6 +919,10 @@ func (builder *QueryBuilder) buildUnion(stmt *tree.Select, ctx *BindContext, isR
 
 	// set heading
 	if isRoot {
+		var err error
+		if lastNodeId, err = builder.appendEnumResultProject(lastNodeId, ctx); err != nil {
+			return 0, err
+		}
 		builder.qry.Headings = append(builder.qry.Headings, ctx.headings...)
 	}
 
@@ -1316,12 +1324,59 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 	}
 
 	if isRoot {
+		if nodeID, err = builder.appendEnumResultProject(nodeID, ctx); err != nil {
+			return 0, err
+		}
 		builder.qry.Headings = append(builder.qry.Headings, ctx.headings...)
 	}
 
 	return nodeID, nil
 }
 
+// appendEnumResultProject converts the enum and set results of the root query to be the strings,
+// the PROJECT node is above the SORT node, so they are still sorted by the ordinals
+func (builder *QueryBuilder) appendEnumResultProject(nodeID int32, ctx *BindContext) (int32, error) {
+	hasEnum := false
+	for _, expr := range ctx.results {
+		if isEnumType(expr.Typ) {
+			hasEnum = true
+			break
+		}
+	}
+	if !hasEnum {
+		return nodeID, nil
+	}
+
+	var err error
+	tag := builder.qry.Nodes[nodeID].BindingTags[0]
+	results := make([]*plan.Expr, len(ctx.results))
+	for i, expr := range ctx.results {
+		results[i] = &plan.Expr{
+			Typ: expr.Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: tag,
+					ColPos: int32(i),
+				},
+			},
+		}
+		if isEnumType(expr.Typ) {
+			if results[i], err = makeEnumToStringExpr(results[i]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	ctx.results = results
+	ctx.resultTag = builder.genNewTag()
+
+	return builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_PROJECT,
+		ProjectList: ctx.results,
+		Children:    []int32{nodeID},
+		BindingTags: []int32{ctx.resultTag},
+	}, ctx), nil
+}
+
 func (builder *QueryBuilder) appendNode(node *plan.Node, ctx *BindContext) int32 {
 	nodeID := int32(len(builder.qry.Nodes))
 	node.NodeId = nodeID
@@ -1544,7 +1599,8 @@ func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext) (
 				break
 			}
 		}
-		if viewDefString != "" {
+		// materialized views are scanned as tables
+		if viewDefString != "" && tableDef.TableType != catalog.SystemMaterializedRel {
 			if ctx.cteByName == nil {
 				ctx.cteByName = make(map[string]*CTERef)
 			}
@@ -1687,6 +1743,14 @@ func (builder *QueryBuilder) addBinding(nodeID int32, alias tree.AliasClause, ct
 		}
 
 		binding = NewBinding(tag, nodeID, table, cols, types)
+		for i, col := range node.TableDef.Cols {
+			if g := col.GetGenerated(); g != nil && !g.Stored {
+				if binding.virtualExprs == nil {
+					binding.virtualExprs = make([]*plan.Expr, len(cols))
+				}
+				binding.virtualExprs[i] = g.Expr
+			}
+		}
 	} else {
 		// Subquery
 		subCtx := builder.ctxByNode[nodeID]
@@ -2084,6 +2148,33 @@ func (builder *QueryBuilder) pushdownFilters(nodeID int32, filters []*plan.Expr)
 	return nodeID, cantPushdown
 }
 
+// mergeJoinKeyCollations converts both string keys of every equi join condition to the collation
+// given by types.MergeCollation, the hash join encodes the keys by the collations of them, so
+// the keys that compare equal must have the same collation.
+func (builder *QueryBuilder) mergeJoinKeyCollations(nodeID int32) error {
+	node := builder.qry.Nodes[nodeID]
+	for _, childID := range node.Children {
+		if err := builder.mergeJoinKeyCollations(childID); err != nil {
+			return err
+		}
+	}
+	if node.NodeType != plan.Node_JOIN {
+		return nil
+	}
+	for _, cond := range node.OnList {
+		f, ok := cond.Expr.(*plan.Expr_F)
+		if !ok || f.F.Func.ObjName != "=" || len(f.F.Args) != 2 {
+			continue
+		}
+		args, err := rewriteCollationArgs("=", f.F.Args)
+		if err != nil {
+			return err
+		}
+		f.F.Args = args
+	}
+	return nil
+}
+
 func (builder *QueryBuilder) pushdownSemiAntiJoins(nodeID int32) int32 {
 	node := builder.qry.Nodes[nodeID]
 
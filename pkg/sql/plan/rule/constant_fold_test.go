@@ -0,0 +1,73 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rule
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/stretchr/testify/require"
+)
+
+func makeFuncExpr(t *testing.T, name string, args ...*plan.Expr) *plan.Expr {
+	argTypes := make([]types.Type, len(args))
+	for i, arg := range args {
+		argTypes[i] = types.T(arg.Typ.Id).ToType()
+	}
+	id, rtyp, _, err := function.GetFunctionByName(name, argTypes)
+	require.NoError(t, err)
+	return &plan.Expr{
+		Typ: &plan.Type{Id: int32(rtyp.Oid)},
+		Expr: &plan.Expr_F{
+			F: &plan.Function{
+				Func: &plan.ObjectRef{Obj: id, ObjName: name},
+				Args: args,
+			},
+		},
+	}
+}
+
+func makeStringConst(s string) *plan.Expr {
+	return &plan.Expr{
+		Typ:  &plan.Type{Id: int32(types.T_varchar)},
+		Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_Sval{Sval: s}}},
+	}
+}
+
+func makeInt64Const(v int64) *plan.Expr {
+	return &plan.Expr{
+		Typ:  &plan.Type{Id: int32(types.T_int64)},
+		Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_Ival{Ival: v}}},
+	}
+}
+
+func TestConstantFold(t *testing.T) {
+	node := &plan.Node{
+		ProjectList: []*plan.Expr{
+			makeFuncExpr(t, "+", makeInt64Const(1), makeInt64Const(2)),
+			// the functions that need the session are not folded without a process
+			makeFuncExpr(t, "aes_encrypt", makeStringConst("text"), makeStringConst("key")),
+			makeFuncExpr(t, "aes_decrypt", makeStringConst("text"), makeStringConst("key")),
+			makeFuncExpr(t, "aes_encrypt", makeStringConst("text"), makeStringConst("key"), makeStringConst("1234567890123456")),
+		},
+	}
+	NewConstantFold().Apply(node, nil)
+	require.Equal(t, int64(3), node.ProjectList[0].GetC().GetIval())
+	for _, e := range node.ProjectList[1:] {
+		require.NotNil(t, e.GetF())
+	}
+}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
 )
 
 const (
@@ -67,6 +68,8 @@ type CompilerContext interface {
 	Cost(obj *ObjectRef, e *Expr) *Cost
 	// get origin sql string of the root
 	GetRootSql() string
+	// get user-defined function by database & name, nil if it does not exist
+	ResolveUdf(dbName string, funcName string) (*function.Udf, error)
 }
 
 type Optimizer interface {
@@ -98,6 +101,11 @@ const (
 	ExecTypeTP
 )
 
+// BigInputCardinality is the estimated rows of a node above which its
+// consumers are partitioned by the hash of the keys across the scopes,
+// rather than broadcasting or merging it into a single scope.
+const BigInputCardinality = 1 << 20
+
 type ExecInfo struct {
 	Typ        ExecType
 	WithGPU    bool
@@ -196,6 +204,9 @@ type baseBinder struct {
 	ctx       *BindContext
 	impl      Binder
 	boundCols []string
+	// arguments and call stack of the user-defined functions being inlined
+	udfArgs  map[string]*Expr
+	udfStack []string
 }
 
 type DefaultBinder struct {
@@ -238,6 +249,10 @@ type PartitionBinder struct {
 	baseBinder
 }
 
+type GeneratedBinder struct {
+	baseBinder
+}
+
 var _ Binder = (*TableBinder)(nil)
 var _ Binder = (*WhereBinder)(nil)
 var _ Binder = (*GroupBinder)(nil)
@@ -245,6 +260,7 @@ var _ Binder = (*HavingBinder)(nil)
 var _ Binder = (*ProjectionBinder)(nil)
 var _ Binder = (*LimitBinder)(nil)
 var _ Binder = (*PartitionBinder)(nil)
+var _ Binder = (*GeneratedBinder)(nil)
 
 const (
 	NotFound      int32 = math.MaxInt32
@@ -259,6 +275,9 @@ type Binding struct {
 	types       []*plan.Type
 	refCnts     []uint
 	colIdByName map[string]int32
+	// generation expressions of the virtual generated columns, which are
+	// computed from the other columns instead of being read
+	virtualExprs []*plan.Expr
 }
 
 const (
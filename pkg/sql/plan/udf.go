@@ -0,0 +1,166 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+)
+
+// GetUdfDefinition parses the CREATE FUNCTION statement of the function.
+func GetUdfDefinition(udf *function.Udf) (*tree.CreateFunction, error) {
+	stmts, err := mysql.Parse(udf.Definition)
+	if err != nil {
+		return nil, err
+	}
+	stmt, ok := stmts[0].(*tree.CreateFunction)
+	if !ok {
+		return nil, errors.New(errno.InvalidFunctionDefinition, fmt.Sprintf("can not get definition of function %s.%s", udf.Db, udf.Name))
+	}
+	return stmt, nil
+}
+
+// CheckCreateFunction validates the CREATE FUNCTION statement and returns the
+// database of the function. The body must be a scalar expression over the
+// arguments, which can call builtin and other user-defined functions.
+func CheckCreateFunction(stmt *tree.CreateFunction, ctx CompilerContext) (string, error) {
+	name := strings.ToLower(string(stmt.Name.ObjectName))
+	if function.IsBuiltinFunction(name) {
+		return "", errors.New(errno.DuplicateFunction, fmt.Sprintf("function %s is a builtin function", name))
+	}
+	dbName := string(stmt.Name.SchemaName)
+	if dbName == "" {
+		dbName = ctx.DefaultDatabase()
+	}
+	if dbName == "" {
+		return "", errors.New(errno.InvalidSchemaName, "no database selected")
+	}
+	if !ctx.DatabaseExists(dbName) {
+		return "", errors.New(errno.InvalidSchemaName, fmt.Sprintf("database %s does not exist", dbName))
+	}
+
+	builder := NewQueryBuilder(plan.Query_SELECT, ctx)
+	binder := NewDefaultBinder(builder, NewBindContext(builder, nil), nil)
+	binder.udfArgs = make(map[string]*Expr)
+	binder.udfStack = []string{udfKey(dbName, name)}
+	for i, arg := range stmt.Args {
+		argName := strings.ToLower(string(arg.Name))
+		if _, ok := binder.udfArgs[argName]; ok {
+			return "", errors.New(errno.InvalidFunctionDefinition, fmt.Sprintf("duplicate argument %s of function %s", argName, name))
+		}
+		typ, err := getTypeFromAst(arg.Type)
+		if err != nil {
+			return "", err
+		}
+		// the arguments are unknown before the function is called
+		binder.udfArgs[argName] = &Expr{
+			Typ: typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{RelPos: -1, ColPos: int32(i), Name: argName},
+			},
+		}
+	}
+	if _, err := binder.bindUdfBody(stmt); err != nil {
+		return "", err
+	}
+	return dbName, nil
+}
+
+func udfKey(dbName, name string) string {
+	return dbName + "." + name
+}
+
+// resolveUdf returns the user-defined function called by funcRef, nil if
+// there is none.
+func (b *baseBinder) resolveUdf(funcRef *tree.UnresolvedName) (*function.Udf, error) {
+	if b.builder == nil {
+		return nil, nil
+	}
+	dbName := ""
+	if funcRef.NumParts > 1 {
+		dbName = funcRef.Parts[1]
+	}
+	return b.builder.compCtx.ResolveUdf(dbName, funcRef.Parts[0])
+}
+
+// bindUdfExpr inlines the body of the user-defined function. The arguments
+// are converted to the types of the parameters, and the result to the
+// return type of the function.
+func (b *baseBinder) bindUdfExpr(udf *function.Udf, astArgs []tree.Expr, depth int32) (*Expr, error) {
+	key := udfKey(udf.Db, udf.Name)
+	for _, k := range b.udfStack {
+		if k == key {
+			return nil, errors.New(errno.InvalidRecursion, fmt.Sprintf("function %s calls itself", key))
+		}
+	}
+	stmt, err := GetUdfDefinition(udf)
+	if err != nil {
+		return nil, err
+	}
+	if len(astArgs) != len(stmt.Args) {
+		return nil, errors.New(errno.UndefinedFunction, fmt.Sprintf("function %s needs %d arguments, but got %d", key, len(stmt.Args), len(astArgs)))
+	}
+
+	// the body can only see the arguments, so it's bound by another binder
+	binder := NewDefaultBinder(b.builder, b.ctx, nil)
+	binder.udfArgs = make(map[string]*Expr)
+	binder.udfStack = append(append([]string{}, b.udfStack...), key)
+	for i, arg := range stmt.Args {
+		expr, err := b.impl.BindExpr(astArgs[i], depth, false)
+		if err != nil {
+			return nil, err
+		}
+		typ, err := getTypeFromAst(arg.Type)
+		if err != nil {
+			return nil, err
+		}
+		if expr, err = appendCastBeforeExpr(expr, typ); err != nil {
+			return nil, err
+		}
+		binder.udfArgs[strings.ToLower(string(arg.Name))] = expr
+	}
+	return binder.bindUdfBody(stmt)
+}
+
+func (b *baseBinder) bindUdfBody(stmt *tree.CreateFunction) (*Expr, error) {
+	expr, err := b.impl.BindExpr(stmt.Body, 0, false)
+	if err != nil {
+		return nil, err
+	}
+	typ, err := getTypeFromAst(stmt.ReturnType)
+	if err != nil {
+		return nil, err
+	}
+	return appendCastBeforeExpr(expr, typ)
+}
+
+// bindUdfArg binds a name in the body of a user-defined function, which must
+// be one of its arguments.
+func (b *baseBinder) bindUdfArg(astExpr *tree.UnresolvedName) (*Expr, error) {
+	if astExpr.NumParts == 1 {
+		if expr, ok := b.udfArgs[strings.ToLower(astExpr.Parts[0])]; ok {
+			return DeepCopyExpr(expr), nil
+		}
+	}
+	return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("column %s is not an argument of function %s", tree.String(astExpr, dialect.MYSQL), b.udfStack[len(b.udfStack)-1]))
+}
@@ -0,0 +1,119 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUdf(t *testing.T) {
+	mock := NewMockOptimizer()
+
+	runTestShouldPass(mock, t, []string{
+		"select net_price(l_extendedprice, l_discount) from lineitem",
+		"select tpch.add_one(l_partkey) from lineitem where add_two(l_suppkey) > 10",
+		"select l_returnflag, sum(net_price(l_extendedprice, 0.1)) from lineitem group by l_returnflag",
+		"select add_one('1')",
+	}, false, false)
+
+	runTestShouldError(mock, t, []string{
+		"select add_one(l_partkey, 1) from lineitem",
+		"select mo.add_one(l_partkey) from lineitem",
+		"select loop_f(1)",
+		"select no_such_function(1)",
+	})
+
+	logicPlan, err := runOneStmt(mock, t, "select add_one(1)")
+	require.NoError(t, err)
+	nodes := logicPlan.GetQuery().Nodes
+	expr := nodes[len(nodes)-1].ProjectList[0]
+	require.Equal(t, int32(types.T_int64), expr.Typ.Id)
+	require.Equal(t, "cast", expr.GetF().Func.ObjName)
+	require.Equal(t, "+", expr.GetF().Args[0].GetF().Func.ObjName)
+}
+
+func TestCheckCreateFunction(t *testing.T) {
+	ctx := NewMockCompilerContext()
+	check := func(sql string) (string, error) {
+		stmts, err := mysql.Parse(sql)
+		require.NoError(t, err)
+		return CheckCreateFunction(stmts[0].(*tree.CreateFunction), ctx)
+	}
+
+	for _, sql := range []string{
+		"create function f(a int, b varchar(10)) returns varchar(20) return concat(b, a)",
+		"create function f() returns double return pi()",
+		"create function f(a int) returns bigint return add_two(a) * 2",
+	} {
+		dbName, err := check(sql)
+		require.NoError(t, err, sql)
+		require.Equal(t, "tpch", dbName)
+	}
+	dbName, err := check("create function mo.f(a int) returns int return a")
+	require.NoError(t, err)
+	require.Equal(t, "mo", dbName)
+
+	for _, sql := range []string{
+		"create function abs(a int) returns int return a",
+		"create function f(a int, a int) returns int return a",
+		"create function f(a int) returns int return b",
+		"create function f(a int) returns int return sum(a)",
+		"create function f(a int) returns int return (select a)",
+		"create function no_db.f(a int) returns int return a",
+	} {
+		_, err := check(sql)
+		require.Error(t, err, sql)
+	}
+}
+
+func TestExternalFunction(t *testing.T) {
+	err := function.RegisterFunction("ext_score", []function.Overload{{
+		Args: []types.T{types.T_float64, types.T_float64},
+		ReturnType: func(_ []types.Type) types.Type {
+			return types.T_float64.ToType()
+		},
+		Fn: func(vs []*vector.Vector, _ *process.Process) (*vector.Vector, error) {
+			return vs[0], nil
+		},
+	}})
+	require.NoError(t, err)
+
+	mock := NewMockOptimizer()
+	runTestShouldPass(mock, t, []string{
+		"select ext_score(l_partkey, 0.5) from lineitem where ext_score(l_discount, l_tax) > 0.1",
+		"select l_returnflag, sum(ext_score(l_quantity, 2)) from lineitem group by l_returnflag order by ext_score(l_returnflag, 1)",
+	}, false, false)
+	runTestShouldError(mock, t, []string{
+		"select ext_score(l_partkey) from lineitem",
+		"select ext_score(l_shipdate, 1) from lineitem",
+	})
+
+	stmts, err := mysql.Parse("create function ext_score(a int) returns int return a")
+	require.NoError(t, err)
+	_, err = CheckCreateFunction(stmts[0].(*tree.CreateFunction), mock.CurrentContext())
+	require.Error(t, err)
+	stmts, err = mysql.Parse("create function f(a int) returns double return ext_score(a, 2)")
+	require.NoError(t, err)
+	_, err = CheckCreateFunction(stmts[0].(*tree.CreateFunction), mock.CurrentContext())
+	require.NoError(t, err)
+}
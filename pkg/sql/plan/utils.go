@@ -124,12 +124,14 @@ func DeepCopyExpr(expr *Expr) *Expr {
 	}
 	newExpr := &Expr{
 		Typ: &plan.Type{
-			Id:        expr.Typ.GetId(),
-			Nullable:  expr.Typ.GetNullable(),
-			Width:     expr.Typ.GetWidth(),
-			Precision: expr.Typ.GetPrecision(),
-			Size:      expr.Typ.GetSize(),
-			Scale:     expr.Typ.GetScale(),
+			Id:         expr.Typ.GetId(),
+			Nullable:   expr.Typ.GetNullable(),
+			Width:      expr.Typ.GetWidth(),
+			Precision:  expr.Typ.GetPrecision(),
+			Size:       expr.Typ.GetSize(),
+			Scale:      expr.Typ.GetScale(),
+			Enumvalues: expr.Typ.GetEnumvalues(),
+			Collation:  expr.Typ.GetCollation(),
 		},
 	}
 
@@ -205,12 +207,14 @@ func DeepCopyExpr(expr *Expr) *Expr {
 		newExpr.Expr = &plan.Expr_T{
 			T: &plan.TargetType{
 				Typ: &plan.Type{
-					Id:        item.T.Typ.GetId(),
-					Nullable:  item.T.Typ.GetNullable(),
-					Width:     item.T.Typ.GetWidth(),
-					Precision: item.T.Typ.GetPrecision(),
-					Size:      item.T.Typ.GetSize(),
-					Scale:     item.T.Typ.GetScale(),
+					Id:         item.T.Typ.GetId(),
+					Nullable:   item.T.Typ.GetNullable(),
+					Width:      item.T.Typ.GetWidth(),
+					Precision:  item.T.Typ.GetPrecision(),
+					Size:       item.T.Typ.GetSize(),
+					Scale:      item.T.Typ.GetScale(),
+					Enumvalues: item.T.Typ.GetEnumvalues(),
+					Collation:  item.T.Typ.GetCollation(),
 				},
 			},
 		}
@@ -0,0 +1,220 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net"
+	"path"
+	"strconv"
+	"sync"
+
+	// register mysql driver for database/sql
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+
+	"github.com/matrixorigin/matrixone/pkg/cnservice"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+)
+
+// CNService describes expected behavior for cn service.
+type CNService interface {
+	// Start starts the frontend and sends heartbeat to hakeeper.
+	Start() error
+	// Close stops the frontend and closes opened sql connections.
+	Close() error
+	// Status returns the status of service.
+	Status() ServiceStatus
+
+	// ID returns uuid of store
+	ID() string
+	// SQLAddress returns the address of mysql protocol listener.
+	SQLAddress() string
+	// GetSQLDB returns the *sql.DB connected to this cn service.
+	//
+	// The returned *sql.DB is shared and closed along with the service.
+	GetSQLDB() (*sql.DB, error)
+}
+
+// cnService wraps cnservice.Service.
+//
+// The main purpose of this structure is to maintain status.
+type cnService struct {
+	sync.Mutex
+	status ServiceStatus
+	uuid   string
+	cfg    *cnservice.Config
+	svc    cnservice.Service
+	db     *sql.DB
+}
+
+func (cs *cnService) Start() error {
+	cs.Lock()
+	defer cs.Unlock()
+
+	// the frontend of a cn service can not be started again
+	if cs.status == ServiceClosed {
+		return ErrServiceClosed
+	}
+
+	if cs.status == ServiceInitialized {
+		err := cs.svc.Start()
+		if err != nil {
+			return err
+		}
+		cs.status = ServiceStarted
+	}
+
+	return nil
+}
+
+func (cs *cnService) Close() error {
+	cs.Lock()
+	defer cs.Unlock()
+
+	if cs.status == ServiceStarted {
+		if cs.db != nil {
+			if err := cs.db.Close(); err != nil {
+				return err
+			}
+			cs.db = nil
+		}
+		err := cs.svc.Close()
+		if err != nil {
+			return err
+		}
+		cs.status = ServiceClosed
+	}
+
+	return nil
+}
+
+func (cs *cnService) Status() ServiceStatus {
+	cs.Lock()
+	defer cs.Unlock()
+	return cs.status
+}
+
+func (cs *cnService) ID() string {
+	cs.Lock()
+	defer cs.Unlock()
+	return cs.uuid
+}
+
+func (cs *cnService) SQLAddress() string {
+	cs.Lock()
+	defer cs.Unlock()
+	return buildCNSQLAddress(cs.cfg)
+}
+
+func (cs *cnService) GetSQLDB() (*sql.DB, error) {
+	cs.Lock()
+	defer cs.Unlock()
+
+	if cs.status != ServiceStarted {
+		return nil, ErrServiceNotStarted
+	}
+
+	if cs.db == nil {
+		db, err := sql.Open("mysql", buildCNSQLDSN(cs.cfg))
+		if err != nil {
+			return nil, err
+		}
+		cs.db = db
+	}
+	return cs.db, nil
+}
+
+// cnOptions is options for a cn service.
+type cnOptions []cnservice.Options
+
+// newCNService initializes an instance of `CNService`.
+//
+// NB: dn shards must be available, the catalog is initialized here.
+func newCNService(
+	cfg *cnservice.Config,
+	fs fileservice.FileService,
+	opts cnOptions,
+) (CNService, error) {
+	svc, err := cnservice.NewService(cfg, context.Background(), fs, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &cnService{
+		status: ServiceInitialized,
+		uuid:   cfg.UUID,
+		cfg:    cfg,
+		svc:    svc,
+	}, nil
+}
+
+// buildCNConfig builds configuration for a cn service.
+func buildCNConfig(
+	index int, opt Options, address serviceAddresses,
+) *cnservice.Config {
+	cfg := &cnservice.Config{
+		UUID:          uuid.New().String(),
+		ListenAddress: address.getCNListenAddress(index),
+	}
+	cfg.Engine.Type = opt.cn.engine
+	cfg.Engine.CommitOrRollbackTimeout.Duration = defaultCNCommitTimeout
+	cfg.HAKeeper.ClientConfig.ServiceAddresses = address.listHAKeeperListenAddresses()
+	cfg.HAKeeper.HeatbeatDuration.Duration = opt.cn.heartbeatInterval
+
+	host, port, err := net.SplitHostPort(address.getCNSQLAddress(index))
+	if err != nil {
+		panic(fmt.Sprintf("fatal when building cnservice.Config: %s", err))
+	}
+	cfg.Frontend.Host = host
+	cfg.Frontend.Port, err = strconv.ParseInt(port, 10, 64)
+	if err != nil {
+		panic(fmt.Sprintf("fatal when building cnservice.Config: %s", err))
+	}
+	cfg.Frontend.StorePath = buildCNDataDir(opt.rootDataDir, index)
+	// metric and trace are process-wide, disable them for in-process cluster.
+	cfg.Frontend.DisableMetric = true
+	cfg.Frontend.DisableTrace = true
+	cfg.Frontend.SetDefaultValues()
+
+	if err := cfg.Validate(); err != nil {
+		panic(fmt.Sprintf("fatal when building cnservice.Config: %s", err))
+	}
+
+	return cfg
+}
+
+// buildCNDataDir generates data directory for a cn service.
+func buildCNDataDir(root string, index int) string {
+	return path.Join(root, "cn", strconv.Itoa(index))
+}
+
+// buildCNSQLAddress returns the address of frontend listener.
+func buildCNSQLAddress(cfg *cnservice.Config) string {
+	return net.JoinHostPort(
+		cfg.Frontend.Host, strconv.FormatInt(cfg.Frontend.Port, 10),
+	)
+}
+
+// buildCNSQLDSN returns the data source name used by mysql driver.
+func buildCNSQLDSN(cfg *cnservice.Config) string {
+	return fmt.Sprintf(
+		"%s:%s@tcp(%s)/",
+		cfg.Frontend.DumpUser,
+		cfg.Frontend.DumpPassword,
+		buildCNSQLAddress(cfg),
+	)
+}
@@ -22,6 +22,7 @@ import (
 var (
 	ErrServiceNotExist     = errors.New("service not exist")
 	ErrServiceNotStarted   = errors.New("service not started")
+	ErrServiceClosed       = errors.New("service closed")
 	ErrStoreNotReported    = errors.New("store not reported")
 	ErrInvalidServiceIndex = errors.New("invalid service index")
 	ErrFailAllocatePort    = errors.New("fail to allocate port")
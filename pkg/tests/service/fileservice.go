@@ -30,13 +30,15 @@ type fileServices struct {
 
 	t            *testing.T
 	dnServiceNum int
+	cnServiceNum int
 
-	localFSs []fileservice.FileService
-	s3FS     fileservice.FileService
+	localFSs   []fileservice.FileService
+	cnLocalFSs []fileservice.FileService
+	s3FS       fileservice.FileService
 }
 
 // newFileServices construcs an instance of fileServices.
-func newFileServices(t *testing.T, dnServiceNum int) *fileServices {
+func newFileServices(t *testing.T, dnServiceNum, cnServiceNum int) *fileServices {
 	locals := make([]fileservice.FileService, 0, dnServiceNum)
 	for i := 0; i < dnServiceNum; i++ {
 		fs, err := fileservice.NewMemoryFS("LOCAL")
@@ -44,13 +46,22 @@ func newFileServices(t *testing.T, dnServiceNum int) *fileServices {
 		locals = append(locals, fs)
 	}
 
+	cnLocals := make([]fileservice.FileService, 0, cnServiceNum)
+	for i := 0; i < cnServiceNum; i++ {
+		fs, err := fileservice.NewMemoryFS("LOCAL")
+		require.NoError(t, err)
+		cnLocals = append(cnLocals, fs)
+	}
+
 	s3fs, err := fileservice.NewMemoryFS("S3")
 	require.NoError(t, err)
 
 	return &fileServices{
 		t:            t,
 		dnServiceNum: dnServiceNum,
+		cnServiceNum: cnServiceNum,
 		localFSs:     locals,
+		cnLocalFSs:   cnLocals,
 		s3FS:         s3fs,
 	}
 }
@@ -58,6 +69,7 @@ func newFileServices(t *testing.T, dnServiceNum int) *fileServices {
 // assertFileServiceLocked asserts constructed file services.
 func (f *fileServices) assertFileServiceLocked() {
 	assert.Equal(f.t, f.dnServiceNum, len(f.localFSs))
+	assert.Equal(f.t, f.cnServiceNum, len(f.cnLocalFSs))
 }
 
 // getLocalFileService gets local FileService for DN service.
@@ -73,7 +85,20 @@ func (f *fileServices) getLocalFileService(index int) fileservice.FileService {
 	return f.localFSs[index]
 }
 
-// getS3FileService gets S3 FileService for all DN services.
+// getCNLocalFileService gets local FileService for CN service.
+func (f *fileServices) getCNLocalFileService(index int) fileservice.FileService {
+	f.RLock()
+	defer f.RUnlock()
+
+	f.assertFileServiceLocked()
+
+	if index >= len(f.cnLocalFSs) {
+		return nil
+	}
+	return f.cnLocalFSs[index]
+}
+
+// getS3FileService gets S3 FileService for all services.
 func (f *fileServices) getS3FileService() fileservice.FileService {
 	f.RLock()
 	defer f.RUnlock()
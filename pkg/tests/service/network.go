@@ -38,19 +38,22 @@ type serviceAddresses struct {
 	// Construct service addresses according to service number
 	logServiceNum int
 	dnServiceNum  int
+	cnServiceNum  int
 
 	logAddresses []logServiceAddress
 	dnAddresses  []dnServiceAddress
+	cnAddresses  []cnServiceAddress
 }
 
 // newServiceAddresses constructs addresses for all services.
 func newServiceAddresses(
-	t *testing.T, logServiceNum, dnServiceNum int, hostAddr string,
+	t *testing.T, logServiceNum, dnServiceNum, cnServiceNum int, hostAddr string,
 ) serviceAddresses {
 	address := serviceAddresses{
 		t:             t,
 		logServiceNum: logServiceNum,
 		dnServiceNum:  dnServiceNum,
+		cnServiceNum:  cnServiceNum,
 	}
 
 	// build log service addresses
@@ -73,6 +76,16 @@ func newServiceAddresses(
 	}
 	address.dnAddresses = dnAddrs
 
+	// build cn service addresses
+	cnBatch := address.cnServiceNum
+	cnAddrs := make([]cnServiceAddress, cnBatch)
+	for i := 0; i < cnBatch; i++ {
+		cnAddr, err := newCNServiceAddress(hostAddr)
+		require.NoError(t, err)
+		cnAddrs[i] = cnAddr
+	}
+	address.cnAddresses = cnAddrs
+
 	return address
 }
 
@@ -81,6 +94,11 @@ func (a serviceAddresses) assertDNService() {
 	assert.Equal(a.t, a.dnServiceNum, len(a.dnAddresses))
 }
 
+// assertCNService asserts constructed address for cn service.
+func (a serviceAddresses) assertCNService() {
+	assert.Equal(a.t, a.cnServiceNum, len(a.cnAddresses))
+}
+
 // assertLogService asserts constructed address for log service.
 func (a serviceAddresses) assertLogService() {
 	assert.Equal(a.t, a.logServiceNum, len(a.logAddresses))
@@ -96,6 +114,26 @@ func (a serviceAddresses) getDnListenAddress(index int) string {
 	return a.dnAddresses[index].listenAddr
 }
 
+// getCNListenAddress gets cn service address by its index.
+func (a serviceAddresses) getCNListenAddress(index int) string {
+	a.assertCNService()
+
+	if index >= len(a.cnAddresses) || index < 0 {
+		return ""
+	}
+	return a.cnAddresses[index].listenAddr
+}
+
+// getCNSQLAddress gets address of cn frontend by its index.
+func (a serviceAddresses) getCNSQLAddress(index int) string {
+	a.assertCNService()
+
+	if index >= len(a.cnAddresses) || index < 0 {
+		return ""
+	}
+	return a.cnAddresses[index].sqlAddr
+}
+
 // getLogListenAddress gets log service address by its index.
 func (a serviceAddresses) getLogListenAddress(index int) string {
 	a.assertLogService()
@@ -246,6 +284,28 @@ func (da dnServiceAddress) listAddresses() []string {
 	return []string{da.listenAddr}
 }
 
+// cnServiceAddress contains addresses for cn service.
+type cnServiceAddress struct {
+	listenAddr string
+	sqlAddr    string
+}
+
+func newCNServiceAddress(host string) (cnServiceAddress, error) {
+	addrs, err := getAddressBatch(host, 2)
+	if err != nil {
+		return cnServiceAddress{}, err
+	}
+	return cnServiceAddress{
+		listenAddr: addrs[0],
+		sqlAddr:    addrs[1],
+	}, nil
+}
+
+// listAddresses returns all addresses for single cn service.
+func (ca cnServiceAddress) listAddresses() []string {
+	return []string{ca.listenAddr, ca.sqlAddr}
+}
+
 // getAddressBatch generates service addresses by batch.
 func getAddressBatch(host string, batch int) ([]string, error) {
 	addrs := make([]string, batch)
@@ -40,7 +40,7 @@ func TestServiceAddress(t *testing.T) {
 	logServiceNum := 3
 	dnServiceNum := 2
 
-	address := newServiceAddresses(t, logServiceNum, dnServiceNum, "127.0.0.1")
+	address := newServiceAddresses(t, logServiceNum, dnServiceNum, 0, "127.0.0.1")
 	address.assertDNService()
 	address.assertLogService()
 
@@ -99,7 +99,7 @@ func TestServiceAddress(t *testing.T) {
 
 func TestGetDnListenAddress(t *testing.T) {
 	dnNum := 3
-	address := newServiceAddresses(t, 1, dnNum, "127.0.0.1")
+	address := newServiceAddresses(t, 1, dnNum, 0, "127.0.0.1")
 
 	addr0 := address.getDnListenAddress(0)
 	addr1 := address.getDnListenAddress(1)
@@ -112,9 +112,25 @@ func TestGetDnListenAddress(t *testing.T) {
 	require.Equal(t, "", addr3)
 }
 
+func TestGetCNAddress(t *testing.T) {
+	cnNum := 2
+	address := newServiceAddresses(t, 1, 1, cnNum, "127.0.0.1")
+
+	listen0 := address.getCNListenAddress(0)
+	listen1 := address.getCNListenAddress(1)
+	sql0 := address.getCNSQLAddress(0)
+	sql1 := address.getCNSQLAddress(1)
+
+	require.NotEqual(t, listen0, listen1)
+	require.NotEqual(t, sql0, sql1)
+	require.NotEqual(t, listen0, sql0)
+	require.Equal(t, "", address.getCNListenAddress(2))
+	require.Equal(t, "", address.getCNSQLAddress(2))
+}
+
 func TestGetLogListenAddress(t *testing.T) {
 	logNum := 3
-	address := newServiceAddresses(t, logNum, 1, "127.0.0.1")
+	address := newServiceAddresses(t, logNum, 1, 0, "127.0.0.1")
 
 	addr0 := address.getLogListenAddress(0)
 	addr1 := address.getLogListenAddress(1)
@@ -129,7 +145,7 @@ func TestGetLogListenAddress(t *testing.T) {
 
 func TestGetLogRaftAddress(t *testing.T) {
 	logNum := 3
-	address := newServiceAddresses(t, logNum, 1, "127.0.0.1")
+	address := newServiceAddresses(t, logNum, 1, 0, "127.0.0.1")
 
 	addr0 := address.getLogRaftAddress(0)
 	addr1 := address.getLogRaftAddress(1)
@@ -144,7 +160,7 @@ func TestGetLogRaftAddress(t *testing.T) {
 
 func TestGetLogGossipAddress(t *testing.T) {
 	logNum := 3
-	address := newServiceAddresses(t, logNum, 1, "127.0.0.1")
+	address := newServiceAddresses(t, logNum, 1, 0, "127.0.0.1")
 
 	addr0 := address.getLogGossipAddress(0)
 	addr1 := address.getLogGossipAddress(1)
@@ -159,7 +175,7 @@ func TestGetLogGossipAddress(t *testing.T) {
 
 func TestListHAKeeperListenAddresses(t *testing.T) {
 	logNum := 3
-	address := newServiceAddresses(t, logNum, 1, "127.0.0.1")
+	address := newServiceAddresses(t, logNum, 1, 0, "127.0.0.1")
 	addrs := address.listHAKeeperListenAddresses()
 	require.Equal(t, logNum, len(addrs))
 	require.NotEqual(t, addrs[0], addrs[1])
@@ -169,7 +185,7 @@ func TestListHAKeeperListenAddresses(t *testing.T) {
 
 func TestGetLogGossipSeedAddresses(t *testing.T) {
 	logNum := 4
-	address := newServiceAddresses(t, logNum, 1, "127.0.0.1")
+	address := newServiceAddresses(t, logNum, 1, 0, "127.0.0.1")
 	addrs := address.getLogGossipSeedAddresses()
 	require.Equal(t, defaultGossipSeedNum, len(addrs))
 	require.NotEqual(t, addrs[0], addrs[1])
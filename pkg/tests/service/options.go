@@ -19,12 +19,14 @@ import (
 
 	"go.uber.org/zap/zapcore"
 
+	"github.com/matrixorigin/matrixone/pkg/cnservice"
 	"github.com/matrixorigin/matrixone/pkg/hakeeper"
 )
 
 const (
 	// default cluster initial information
 	defaultDNServiceNum  = 1
+	defaultCNServiceNum  = 0
 	defaultDnShardNum    = 1
 	defaultLogServiceNum = 3
 	defaultLogShardNum   = 1
@@ -37,6 +39,11 @@ const (
 	// default configuration for dn service
 	defaultDnStorage = "MEM"
 
+	// default configuration for cn service
+	defaultCNEngine = cnservice.EngineMemory
+	// a txn on a failed dn should not wait for the default timeout
+	defaultCNCommitTimeout = 10 * time.Second
+
 	// default configuration for log service
 	defaultGossipSeedNum = 3
 	defaultHAKeeperNum   = 3
@@ -48,11 +55,13 @@ const (
 	defaultTickPerSecond   = 10
 	defaultLogStoreTimeout = 4 * time.Second
 	defaultDNStoreTimeout  = 3 * time.Second
+	defaultCNStoreTimeout  = 3 * time.Second
 	defaultCheckInterval   = 1 * time.Second
 
 	// default heartbeat configuration
 	defaultLogHeartbeatInterval = 1 * time.Second
 	defaultDNHeartbeatInterval  = 1 * time.Second
+	defaultCNHeartbeatInterval  = 1 * time.Second
 )
 
 // Options are params for creating test cluster.
@@ -64,6 +73,7 @@ type Options struct {
 	initial struct {
 		dnServiceNum  int
 		logServiceNum int
+		cnServiceNum  int
 		dnShardNum    uint64
 		logShardNum   uint64
 		logReplicaNum uint64
@@ -78,11 +88,17 @@ type Options struct {
 		heartbeatInterval time.Duration
 	}
 
+	cn struct {
+		engine            cnservice.EngineType
+		heartbeatInterval time.Duration
+	}
+
 	hakeeper struct {
 		tickPerSecond   int
 		checkInterval   time.Duration
 		logStoreTimeout time.Duration
 		dnStoreTimeout  time.Duration
+		cnStoreTimeout  time.Duration
 	}
 }
 
@@ -107,6 +123,9 @@ func (opt *Options) validate() {
 	if opt.initial.logServiceNum <= 0 {
 		opt.initial.logServiceNum = defaultLogServiceNum
 	}
+	if opt.initial.cnServiceNum <= 0 {
+		opt.initial.cnServiceNum = defaultCNServiceNum
+	}
 	if opt.initial.dnShardNum <= 0 {
 		opt.initial.dnShardNum = defaultDnShardNum
 	}
@@ -119,6 +138,9 @@ func (opt *Options) validate() {
 	if opt.dn.txnStorageBackend == "" {
 		opt.dn.txnStorageBackend = defaultDnStorage
 	}
+	if opt.cn.engine == "" {
+		opt.cn.engine = defaultCNEngine
+	}
 
 	opt.logLevel = defaultLogLevel
 
@@ -132,6 +154,9 @@ func (opt *Options) validate() {
 	if opt.hakeeper.dnStoreTimeout == 0 {
 		opt.hakeeper.dnStoreTimeout = defaultDNStoreTimeout
 	}
+	if opt.hakeeper.cnStoreTimeout == 0 {
+		opt.hakeeper.cnStoreTimeout = defaultCNStoreTimeout
+	}
 	if opt.hakeeper.checkInterval == 0 {
 		opt.hakeeper.checkInterval = defaultCheckInterval
 	}
@@ -143,6 +168,9 @@ func (opt *Options) validate() {
 	if opt.dn.heartbeatInterval == 0 {
 		opt.dn.heartbeatInterval = defaultDNHeartbeatInterval
 	}
+	if opt.cn.heartbeatInterval == 0 {
+		opt.cn.heartbeatInterval = defaultCNHeartbeatInterval
+	}
 }
 
 // BuildHAKeeperConfig returns hakeeper.Config
@@ -153,6 +181,7 @@ func (opt Options) BuildHAKeeperConfig() hakeeper.Config {
 		TickPerSecond:   opt.hakeeper.tickPerSecond,
 		LogStoreTimeout: opt.hakeeper.logStoreTimeout,
 		DNStoreTimeout:  opt.hakeeper.dnStoreTimeout,
+		CNStoreTimeout:  opt.hakeeper.cnStoreTimeout,
 	}
 }
 
@@ -168,6 +197,12 @@ func (opt Options) WithLogServiceNum(num int) Options {
 	return opt
 }
 
+// WithCNServiceNum sets cn service number in the cluster.
+func (opt Options) WithCNServiceNum(num int) Options {
+	opt.initial.cnServiceNum = num
+	return opt
+}
+
 // WithLogShardNum sets log shard number in the cluster.
 func (opt Options) WithLogShardNum(num uint64) Options {
 	opt.initial.logShardNum = num
@@ -198,6 +233,12 @@ func (opt Options) WithDnTxnStorage(s string) Options {
 	return opt
 }
 
+// WithCNEngine sets storage engine for cn services.
+func (opt Options) WithCNEngine(engine cnservice.EngineType) Options {
+	opt.cn.engine = engine
+	return opt
+}
+
 // WithHostAddress sets host address for all services.
 func (opt Options) WithHostAddress(host string) Options {
 	opt.hostAddr = host
@@ -228,6 +269,12 @@ func (opt Options) WithHKDNStoreTimeout(timeout time.Duration) Options {
 	return opt
 }
 
+// WithHKCNStoreTimeout sets cn store timeout for hakeeper.
+func (opt Options) WithHKCNStoreTimeout(timeout time.Duration) Options {
+	opt.hakeeper.cnStoreTimeout = timeout
+	return opt
+}
+
 // WithHKCheckInterval sets check interval for hakeeper.
 func (opt Options) WithHKCheckInterval(interval time.Duration) Options {
 	opt.hakeeper.checkInterval = interval
@@ -240,6 +287,12 @@ func (opt Options) WithDNHeartbeatInterval(interval time.Duration) Options {
 	return opt
 }
 
+// WithCNHeartbeatInterval sets heartbeat interval fo cn service.
+func (opt Options) WithCNHeartbeatInterval(interval time.Duration) Options {
+	opt.cn.heartbeatInterval = interval
+	return opt
+}
+
 // WithLogHeartbeatInterval sets heartbeat interval fo log service.
 func (opt Options) WithLogHeartbeatInterval(interval time.Duration) Options {
 	opt.log.heartbeatInterval = interval
@@ -20,6 +20,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zapcore"
+
+	"github.com/matrixorigin/matrixone/pkg/cnservice"
 )
 
 func TestDefaultOptons(t *testing.T) {
@@ -39,6 +41,15 @@ func TestWithLogServiceNum(t *testing.T) {
 	require.Equal(t, num, opt.initial.logServiceNum)
 }
 
+func TestWithCNServiceNum(t *testing.T) {
+	opt := DefaultOptions()
+	require.Equal(t, defaultCNServiceNum, opt.initial.cnServiceNum)
+
+	num := 3
+	opt = opt.WithCNServiceNum(num)
+	require.Equal(t, num, opt.initial.cnServiceNum)
+}
+
 func TestWithLogShardNum(t *testing.T) {
 	num := uint64(4)
 	opt := Options{}.WithLogShardNum(num)
@@ -108,6 +119,15 @@ func TestWithHKDNStoreTimeout(t *testing.T) {
 	require.Equal(t, timeout, opt.hakeeper.dnStoreTimeout)
 }
 
+func TestWithHKCNStoreTimeout(t *testing.T) {
+	opt := DefaultOptions()
+	require.Equal(t, defaultCNStoreTimeout, opt.hakeeper.cnStoreTimeout)
+
+	timeout := 22 * time.Second
+	opt = opt.WithHKCNStoreTimeout(timeout)
+	require.Equal(t, timeout, opt.hakeeper.cnStoreTimeout)
+}
+
 func TestWithDNHeartbeatInterval(t *testing.T) {
 	opt := DefaultOptions()
 	require.Equal(t, defaultDNHeartbeatInterval, opt.dn.heartbeatInterval)
@@ -117,6 +137,23 @@ func TestWithDNHeartbeatInterval(t *testing.T) {
 	require.Equal(t, interval, opt.dn.heartbeatInterval)
 }
 
+func TestWithCNHeartbeatInterval(t *testing.T) {
+	opt := DefaultOptions()
+	require.Equal(t, defaultCNHeartbeatInterval, opt.cn.heartbeatInterval)
+
+	interval := 23 * time.Second
+	opt = opt.WithCNHeartbeatInterval(interval)
+	require.Equal(t, interval, opt.cn.heartbeatInterval)
+}
+
+func TestWithCNEngine(t *testing.T) {
+	opt := DefaultOptions()
+	require.Equal(t, defaultCNEngine, opt.cn.engine)
+
+	opt = opt.WithCNEngine(cnservice.EngineDistributedTAE)
+	require.Equal(t, cnservice.EngineDistributedTAE, opt.cn.engine)
+}
+
 func TestWithLogHeartbeatInterval(t *testing.T) {
 	opt := DefaultOptions()
 	require.Equal(t, defaultLogHeartbeatInterval, opt.log.heartbeatInterval)
@@ -155,4 +192,5 @@ func TestBuildHAKeeperConfig(t *testing.T) {
 	require.Equal(t, opt.hakeeper.tickPerSecond, cfg.TickPerSecond)
 	require.Equal(t, opt.hakeeper.logStoreTimeout, cfg.LogStoreTimeout)
 	require.Equal(t, opt.hakeeper.dnStoreTimeout, cfg.DNStoreTimeout)
+	require.Equal(t, opt.hakeeper.cnStoreTimeout, cfg.CNStoreTimeout)
 }
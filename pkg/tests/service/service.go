@@ -16,6 +16,7 @@ package service
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"sync"
 	"testing"
@@ -25,6 +26,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
+	"github.com/matrixorigin/matrixone/pkg/cnservice"
 	"github.com/matrixorigin/matrixone/pkg/common/morpc"
 	"github.com/matrixorigin/matrixone/pkg/dnservice"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
@@ -38,7 +40,8 @@ import (
 )
 
 var (
-	defaultWaitInterval = 100 * time.Millisecond
+	defaultWaitInterval  = 100 * time.Millisecond
+	defaultCNWaitTimeout = 30 * time.Second
 )
 
 // Cluster describes behavior of test framwork.
@@ -52,6 +55,7 @@ type Cluster interface {
 	ClusterAwareness
 	ClusterState
 	ClusterWaitState
+	ClusterSQL
 }
 
 // ClusterOperation supports kinds of cluster operations.
@@ -66,6 +70,16 @@ type ClusterOperation interface {
 	// StartDNServiceIndexed starts dn service by its index.
 	StartDNServiceIndexed(index int) error
 
+	// CloseCNService closes cn service by uuid.
+	CloseCNService(uuid string) error
+	// StartCNService starts cn service by uuid.
+	StartCNService(uuid string) error
+
+	// CloseCNServiceIndexed closes cn service by its index.
+	CloseCNServiceIndexed(index int) error
+	// StartCNServiceIndexed starts cn service by its index.
+	StartCNServiceIndexed(index int) error
+
 	// CloseLogService closes log service by uuid.
 	CloseLogService(uuid string) error
 	// StartLogService starts log service by uuid.
@@ -92,6 +106,8 @@ type ClusterAwareness interface {
 	ListDNServices() []string
 	// ListLogServices lists uuid of all log services.
 	ListLogServices() []string
+	// ListCNServices lists uuid of all cn services.
+	ListCNServices() []string
 	// ListHAKeeperServices lists all hakeeper log services.
 	ListHAKeeperServices() []LogService
 
@@ -103,6 +119,10 @@ type ClusterAwareness interface {
 	GetDNServiceIndexed(index int) (DNService, error)
 	// GetLogServiceIndexed fetches log service instance by index.
 	GetLogServiceIndexed(index int) (LogService, error)
+	// GetCNService fetches cn service instance by uuid.
+	GetCNService(uuid string) (CNService, error)
+	// GetCNServiceIndexed fetches cn service instance by index.
+	GetCNServiceIndexed(index int) (CNService, error)
 
 	// GetClusterState fetches current cluster state
 	GetClusterState(ctx context.Context) (*logpb.CheckerState, error)
@@ -125,6 +145,11 @@ type ClusterState interface {
 	// GetLogStoreInfoIndexed gets dn store information by index.
 	GetLogStoreInfoIndexed(ctx context.Context, index int) (logpb.LogStoreInfo, error)
 
+	// GetCNStoreInfo gets cn store information by uuid.
+	GetCNStoreInfo(ctx context.Context, uuid string) (logpb.CNStoreInfo, error)
+	// GetCNStoreInfoIndexed gets cn store information by index.
+	GetCNStoreInfoIndexed(ctx context.Context, index int) (logpb.CNStoreInfo, error)
+
 	// GetHAKeeperState returns hakeeper state from running hakeeper.
 	GetHAKeeperState() logpb.HAKeeperState
 	// GetHAKeeperConfig returns hakeeper configuration.
@@ -138,6 +163,10 @@ type ClusterState interface {
 	LogStoreExpired(uuid string) (bool, error)
 	// LogStoreExpiredIndexed checks log store expired or not by index.
 	LogStoreExpiredIndexed(index int) (bool, error)
+	// CNStoreExpired checks cn store expired or not by uuid.
+	CNStoreExpired(uuid string) (bool, error)
+	// CNStoreExpiredIndexed checks cn store expired or not by index.
+	CNStoreExpiredIndexed(index int) (bool, error)
 
 	// IsClusterHealthy checks whether cluster is healthy or not.
 	IsClusterHealthy() bool
@@ -176,13 +205,30 @@ type ClusterWaitState interface {
 	WaitLogStoreReported(ctx context.Context, uuid string)
 	// WaitLogStoreReportedIndexed waits log store reported by index.
 	WaitLogStoreReportedIndexed(ctx context.Context, index int)
+
+	// WaitCNStoreTimeout waits cn store timeout by uuid.
+	WaitCNStoreTimeout(ctx context.Context, uuid string)
+	// WaitCNStoreTimeoutIndexed waits cn store timeout by index.
+	WaitCNStoreTimeoutIndexed(ctx context.Context, index int)
+	// WaitCNStoreReported waits cn store reported by uuid.
+	WaitCNStoreReported(ctx context.Context, uuid string)
+	// WaitCNStoreReportedIndexed waits cn store reported by index.
+	WaitCNStoreReportedIndexed(ctx context.Context, index int)
+}
+
+// ClusterSQL provides sql access to cn services.
+type ClusterSQL interface {
+	// GetSQLDB returns *sql.DB connected to cn service by uuid.
+	GetSQLDB(uuid string) (*sql.DB, error)
+	// GetSQLDBIndexed returns *sql.DB connected to cn service by index.
+	GetSQLDBIndexed(index int) (*sql.DB, error)
 }
 
 // ----------------------------------------------------
 // The following are implements for interface `Cluster`.
 // ----------------------------------------------------
 
-// testCluster simulates a cluster with dn and log service.
+// testCluster simulates a cluster with cn, dn and log service.
 type testCluster struct {
 	t      *testing.T
 	opt    Options
@@ -195,6 +241,12 @@ type testCluster struct {
 		svcs []DNService
 	}
 
+	cn struct {
+		sync.Mutex
+		cfgs []*cnservice.Config
+		svcs []CNService
+	}
+
 	log struct {
 		once sync.Once
 
@@ -243,6 +295,9 @@ func NewCluster(t *testing.T, opt Options) (Cluster, error) {
 	// build dn service configurations
 	c.dn.cfgs, c.dn.opts = c.buildDnConfigs(c.network.addresses)
 
+	// build cn service configurations
+	c.cn.cfgs = c.buildCNConfigs(c.network.addresses)
+
 	return c, nil
 }
 
@@ -264,6 +319,11 @@ func (c *testCluster) Start() error {
 		return err
 	}
 
+	// start cn services
+	if err := c.startCNServices(); err != nil {
+		return err
+	}
+
 	c.mu.running = true
 	return nil
 }
@@ -276,7 +336,12 @@ func (c *testCluster) Close() error {
 		return nil
 	}
 
-	// close all dn services first
+	// close all cn services first
+	if err := c.closeCNServices(); err != nil {
+		return err
+	}
+
+	// close all dn services
 	if err := c.closeDNServices(); err != nil {
 		return err
 	}
@@ -361,6 +426,30 @@ func (c *testCluster) GetLogStoreInfoIndexed(
 	return c.GetLogStoreInfo(ctx, ls.ID())
 }
 
+func (c *testCluster) GetCNStoreInfo(
+	ctx context.Context, uuid string,
+) (logpb.CNStoreInfo, error) {
+	state, err := c.GetClusterState(ctx)
+	if err != nil {
+		return logpb.CNStoreInfo{}, err
+	}
+	stores := state.CNState.Stores
+	if storeInfo, ok := stores[uuid]; ok {
+		return storeInfo, nil
+	}
+	return logpb.CNStoreInfo{}, ErrServiceNotExist
+}
+
+func (c *testCluster) GetCNStoreInfoIndexed(
+	ctx context.Context, index int,
+) (logpb.CNStoreInfo, error) {
+	cs, err := c.GetCNServiceIndexed(index)
+	if err != nil {
+		return logpb.CNStoreInfo{}, err
+	}
+	return c.GetCNStoreInfo(ctx, cs.ID())
+}
+
 func (c *testCluster) GetHAKeeperState() logpb.HAKeeperState {
 	state := c.getClusterState()
 	require.NotNil(c.t, state)
@@ -433,6 +522,37 @@ func (c *testCluster) LogStoreExpiredIndexed(index int) (bool, error) {
 	return c.LogStoreExpired(ls.ID())
 }
 
+func (c *testCluster) CNStoreExpired(uuid string) (bool, error) {
+	state := c.getClusterState()
+	require.NotNil(c.t, state)
+
+	cnStore, ok := state.CNState.Stores[uuid]
+	if !ok {
+		return false, wrappedError(ErrStoreNotReported, uuid)
+	}
+
+	hkcfg := c.GetHAKeeperConfig()
+	expired := hkcfg.CnStoreExpired(cnStore.Tick, state.Tick)
+
+	c.logger.Info(
+		"check cn store expired or not",
+		zap.Any("hakeeper config", hkcfg),
+		zap.Uint64("cn store tick", cnStore.Tick),
+		zap.Uint64("current tick", state.Tick),
+		zap.Bool("expired", expired),
+	)
+
+	return expired, nil
+}
+
+func (c *testCluster) CNStoreExpiredIndexed(index int) (bool, error) {
+	cs, err := c.GetCNServiceIndexed(index)
+	if err != nil {
+		return false, err
+	}
+	return c.CNStoreExpired(cs.ID())
+}
+
 func (c *testCluster) IsClusterHealthy() bool {
 	hkcfg := c.GetHAKeeperConfig()
 	state := c.getClusterState()
@@ -748,6 +868,76 @@ func (c *testCluster) WaitLogStoreReportedIndexed(ctx context.Context, index int
 	c.WaitLogStoreReported(ctx, ls.ID())
 }
 
+func (c *testCluster) WaitCNStoreTimeout(ctx context.Context, uuid string) {
+	for {
+		select {
+		case <-ctx.Done():
+			assert.FailNow(
+				c.t,
+				"terminated when waiting cn store timeout",
+				"cn store %s, error: %s", uuid, ctx.Err(),
+			)
+		default:
+			time.Sleep(defaultWaitInterval)
+
+			expired, err := c.CNStoreExpired(uuid)
+			if err != nil {
+				c.logger.Error("fail to check cn store expired or not",
+					zap.Error(err),
+					zap.String("uuid", uuid),
+				)
+				continue
+			}
+
+			if expired {
+				return
+			}
+		}
+	}
+}
+
+func (c *testCluster) WaitCNStoreTimeoutIndexed(ctx context.Context, index int) {
+	cs, err := c.GetCNServiceIndexed(index)
+	require.NoError(c.t, err)
+
+	c.WaitCNStoreTimeout(ctx, cs.ID())
+}
+
+func (c *testCluster) WaitCNStoreReported(ctx context.Context, uuid string) {
+	for {
+		select {
+		case <-ctx.Done():
+			assert.FailNow(
+				c.t,
+				"terminated when waiting cn store reported",
+				"cn store %s, error: %s", uuid, ctx.Err(),
+			)
+		default:
+			time.Sleep(defaultWaitInterval)
+
+			expired, err := c.CNStoreExpired(uuid)
+			if err != nil {
+				c.logger.Error("fail to check cn store expired or not",
+					zap.Error(err),
+					zap.String("uuid", uuid),
+				)
+				continue
+			}
+
+			if !expired {
+				return
+			}
+		}
+	}
+}
+
+func (c *testCluster) WaitCNStoreReportedIndexed(ctx context.Context, index int) {
+	cs, err := c.GetCNServiceIndexed(index)
+	require.NoError(c.t, err)
+
+	c.WaitCNStoreReported(ctx, cs.ID())
+}
+
 // --------------------------------------------------------------
 // The following are implements for interface `ClusterAwareness`.
 // --------------------------------------------------------------
@@ -767,6 +957,14 @@ func (c *testCluster) ListLogServices() []string {
 	return ids
 }
 
+func (c *testCluster) ListCNServices() []string {
+	ids := make([]string, 0, len(c.cn.cfgs))
+	for _, cfg := range c.cn.cfgs {
+		ids = append(ids, cfg.UUID)
+	}
+	return ids
+}
+
 func (c *testCluster) ListHAKeeperServices() []LogService {
 	return c.selectHAkeeperServices()
 }
@@ -819,6 +1017,30 @@ func (c *testCluster) GetLogServiceIndexed(index int) (LogService, error) {
 	return c.log.svcs[index], nil
 }
 
+func (c *testCluster) GetCNService(uuid string) (CNService, error) {
+	c.cn.Lock()
+	defer c.cn.Unlock()
+
+	for _, svc := range c.cn.svcs {
+		if svc.ID() == uuid {
+			return svc, nil
+		}
+	}
+	return nil, wrappedError(ErrServiceNotExist, uuid)
+}
+
+func (c *testCluster) GetCNServiceIndexed(index int) (CNService, error) {
+	c.cn.Lock()
+	defer c.cn.Unlock()
+
+	if index >= len(c.cn.svcs) || index < 0 {
+		return nil, wrappedError(
+			ErrInvalidServiceIndex, fmt.Sprintf("index: %d", index),
+		)
+	}
+	return c.cn.svcs[index], nil
+}
+
 // NB: we could also fetch cluster state from non-leader hakeeper.
 func (c *testCluster) GetClusterState(
 	ctx context.Context,
@@ -863,6 +1085,38 @@ func (c *testCluster) StartDNServiceIndexed(index int) error {
 	return ds.Start()
 }
 
+func (c *testCluster) CloseCNService(uuid string) error {
+	cs, err := c.GetCNService(uuid)
+	if err != nil {
+		return err
+	}
+	return cs.Close()
+}
+
+func (c *testCluster) StartCNService(uuid string) error {
+	cs, err := c.GetCNService(uuid)
+	if err != nil {
+		return err
+	}
+	return cs.Start()
+}
+
+func (c *testCluster) CloseCNServiceIndexed(index int) error {
+	cs, err := c.GetCNServiceIndexed(index)
+	if err != nil {
+		return err
+	}
+	return cs.Close()
+}
+
+func (c *testCluster) StartCNServiceIndexed(index int) error {
+	cs, err := c.GetCNServiceIndexed(index)
+	if err != nil {
+		return err
+	}
+	return cs.Start()
+}
+
 func (c *testCluster) CloseLogService(uuid string) error {
 	ls, err := c.GetLogService(uuid)
 	if err != nil {
@@ -929,6 +1183,25 @@ func (c *testCluster) CloseNetworkPartition() {
 	c.network.addressSets = nil
 }
 
+// --------------------------------------------------------
+// The following are implements for interface `ClusterSQL`.
+// --------------------------------------------------------
+func (c *testCluster) GetSQLDB(uuid string) (*sql.DB, error) {
+	cs, err := c.GetCNService(uuid)
+	if err != nil {
+		return nil, err
+	}
+	return cs.GetSQLDB()
+}
+
+func (c *testCluster) GetSQLDBIndexed(index int) (*sql.DB, error) {
+	cs, err := c.GetCNServiceIndexed(index)
+	if err != nil {
+		return nil, err
+	}
+	return cs.GetSQLDB()
+}
+
 // ------------------------------------------------------
 // The following are private utilities for `testCluster`.
 // ------------------------------------------------------
@@ -939,13 +1212,16 @@ func (c *testCluster) buildServiceAddresses() serviceAddresses {
 		c.t,
 		c.opt.initial.logServiceNum,
 		c.opt.initial.dnServiceNum,
+		c.opt.initial.cnServiceNum,
 		c.opt.hostAddr,
 	)
 }
 
 // buildFileServices builds all file services.
 func (c *testCluster) buildFileServices() *fileServices {
-	return newFileServices(c.t, c.opt.initial.dnServiceNum)
+	return newFileServices(
+		c.t, c.opt.initial.dnServiceNum, c.opt.initial.cnServiceNum,
+	)
 }
 
 // buildDnConfigs builds configurations for all dn services.
@@ -967,6 +1243,20 @@ func (c *testCluster) buildDnConfigs(
 	return cfgs, opts
 }
 
+// buildCNConfigs builds configurations for all cn services.
+func (c *testCluster) buildCNConfigs(
+	address serviceAddresses,
+) []*cnservice.Config {
+	batch := c.opt.initial.cnServiceNum
+
+	cfgs := make([]*cnservice.Config, 0, batch)
+	for i := 0; i < batch; i++ {
+		cfg := buildCNConfig(i, c.opt, address)
+		cfgs = append(cfgs, cfg)
+	}
+	return cfgs
+}
+
 // buildLogConfigs builds configurations for all log services.
 func (c *testCluster) buildLogConfigs(
 	address serviceAddresses,
@@ -1022,6 +1312,42 @@ func (c *testCluster) initDNServices(fileservices *fileServices) []DNService {
 	return svcs
 }
 
+// initCNServices builds all cn services.
+//
+// Before initializing cn service, dn shards must be reported already.
+func (c *testCluster) initCNServices(fileservices *fileServices) []CNService {
+	batch := c.opt.initial.cnServiceNum
+
+	c.logger.Info("initialize cn services", zap.Int("batch", batch))
+
+	svcs := make([]CNService, 0, batch)
+	for i := 0; i < batch; i++ {
+		cfg := c.cn.cfgs[i]
+		fs, err := fileservice.NewFileServices(
+			"LOCAL",
+			fileservices.getCNLocalFileService(i),
+			fileservices.getS3FileService(),
+		)
+		if err != nil {
+			panic(err)
+		}
+
+		// NB: cn services are not affected by network partition for now.
+		cs, err := newCNService(cfg, fs, nil)
+		require.NoError(c.t, err)
+
+		c.logger.Info(
+			"cn service initialized",
+			zap.Int("index", i),
+			zap.Any("config", cfg),
+		)
+
+		svcs = append(svcs, cs)
+	}
+
+	return svcs
+}
+
 // initLogServices builds all log services.
 func (c *testCluster) initLogServices() []LogService {
 	batch := c.opt.initial.logServiceNum
@@ -1061,6 +1387,34 @@ func (c *testCluster) startDNServices() error {
 	return nil
 }
 
+// startCNServices initializes and starts all cn services.
+func (c *testCluster) startCNServices() error {
+	if c.opt.initial.cnServiceNum == 0 {
+		return nil
+	}
+
+	// cn service would access dn shards during initialization
+	ctx, cancel := context.WithTimeout(context.Background(), defaultCNWaitTimeout)
+	defer cancel()
+	c.WaitDNShardsReported(ctx)
+
+	// initialize all cn services
+	svcs := c.initCNServices(c.fileservices)
+
+	c.cn.Lock()
+	c.cn.svcs = svcs
+	c.cn.Unlock()
+
+	// start cn services
+	for _, cs := range svcs {
+		if err := cs.Start(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // startLogServices initializes and starts all log services.
 func (c *testCluster) startLogServices() error {
 	// initialize all log service
@@ -1101,6 +1455,21 @@ func (c *testCluster) closeDNServices() error {
 	return nil
 }
 
+// closeCNServices closes all cn services.
+func (c *testCluster) closeCNServices() error {
+	c.logger.Info("start to close cn services")
+
+	for i, cs := range c.cn.svcs {
+		c.logger.Info("close cn service", zap.Int("index", i))
+		if err := cs.Close(); err != nil {
+			return err
+		}
+		c.logger.Info("cn service closed", zap.Int("index", i))
+	}
+
+	return nil
+}
+
 // closeLogServices closes all log services.
 func (c *testCluster) closeLogServices() error {
 	c.logger.Info("start to close log services")
@@ -21,6 +21,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/matrixorigin/matrixone/pkg/cnservice"
 	logpb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
 )
 
@@ -528,3 +529,207 @@ func TestNetworkPartition(t *testing.T) {
 	defer cancel5()
 	c.WaitLogStoreReportedIndexed(ctx5, 3)
 }
+
+func TestClusterSQL(t *testing.T) {
+	cnSvcNum := 2
+	opt := DefaultOptions().
+		WithCNServiceNum(cnSvcNum).
+		WithRootDataDir(t.TempDir())
+
+	// initialize cluster
+	c, err := NewCluster(t, opt)
+	require.NoError(t, err)
+
+	// start the cluster
+	err = c.Start()
+	require.NoError(t, err)
+
+	// close the cluster after all
+	defer func() {
+		err := c.Close()
+		require.NoError(t, err)
+	}()
+
+	cnuuids := c.ListCNServices()
+	require.Equal(t, cnSvcNum, len(cnuuids))
+
+	cn, err := c.GetCNService(cnuuids[0])
+	require.NoError(t, err)
+	require.Equal(t, ServiceStarted, cn.Status())
+	require.NotEqual(t, "", cn.SQLAddress())
+
+	// test WaitCNStoreReported
+	{
+		ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+		defer cancel()
+		c.WaitCNStoreReportedIndexed(ctx, 0)
+		c.WaitCNStoreReportedIndexed(ctx, 1)
+
+		info, err := c.GetCNStoreInfoIndexed(ctx, 0)
+		require.NoError(t, err)
+		require.NotZero(t, info.Tick)
+	}
+
+	// test GetSQLDB
+	{
+		db, err := c.GetSQLDBIndexed(0)
+		require.NoError(t, err)
+		_, err = db.Exec("create database tests_service")
+		require.NoError(t, err)
+		_, err = db.Exec("create table tests_service.t (a int, b varchar(10))")
+		require.NoError(t, err)
+		_, err = db.Exec("insert into tests_service.t values (1, 'a'), (2, 'b')")
+		require.NoError(t, err)
+
+		var count int
+		err = db.QueryRow("select count(*) from tests_service.t").Scan(&count)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+	}
+
+	// test WaitCNStoreTimeout
+	{
+		err = c.CloseCNServiceIndexed(1)
+		require.NoError(t, err)
+
+		_, err = c.GetSQLDBIndexed(1)
+		require.Error(t, err)
+
+		err = c.StartCNServiceIndexed(1)
+		require.ErrorIs(t, err, ErrServiceClosed)
+
+		ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+		defer cancel()
+		c.WaitCNStoreTimeoutIndexed(ctx, 1)
+
+		expired, err := c.CNStoreExpiredIndexed(1)
+		require.NoError(t, err)
+		require.True(t, expired)
+	}
+}
+
+func TestClusterSQLDNFailure(t *testing.T) {
+	opt := DefaultOptions().
+		WithCNServiceNum(1).
+		WithRootDataDir(t.TempDir())
+
+	c, err := NewCluster(t, opt)
+	require.NoError(t, err)
+	err = c.Start()
+	require.NoError(t, err)
+	defer func() {
+		err := c.Close()
+		require.NoError(t, err)
+	}()
+
+	db, err := c.GetSQLDBIndexed(0)
+	require.NoError(t, err)
+	_, err = db.Exec("create database tests_service")
+	require.NoError(t, err)
+	_, err = db.Exec("create table tests_service.t (a int)")
+	require.NoError(t, err)
+
+	// the writes of the transaction are held by the dn until it commits
+	txn, err := db.Begin()
+	require.NoError(t, err)
+	_, err = txn.Exec("insert into tests_service.t values (1)")
+	require.NoError(t, err)
+
+	err = c.CloseDNServiceIndexed(0)
+	require.NoError(t, err)
+
+	// the transaction can not commit without the dn
+	err = txn.Commit()
+	require.Error(t, err)
+}
+
+func TestClusterMaterializedView(t *testing.T) {
+	// incremental refresh reads the change sets of tae
+	opt := DefaultOptions().
+		WithCNServiceNum(1).
+		WithCNEngine(cnservice.EngineTAE).
+		WithRootDataDir(t.TempDir())
+
+	c, err := NewCluster(t, opt)
+	require.NoError(t, err)
+	err = c.Start()
+	require.NoError(t, err)
+	defer func() {
+		err := c.Close()
+		require.NoError(t, err)
+	}()
+
+	db, err := c.GetSQLDBIndexed(0)
+	require.NoError(t, err)
+	exec := func(sql string) {
+		_, err := db.Exec(sql)
+		require.NoError(t, err, sql)
+	}
+	query := func(sql string) (rows [][2]int64) {
+		res, err := db.Query(sql)
+		require.NoError(t, err, sql)
+		defer res.Close()
+		for res.Next() {
+			var row [2]int64
+			require.NoError(t, res.Scan(&row[0], &row[1]))
+			rows = append(rows, row)
+		}
+		require.NoError(t, res.Err())
+		return
+	}
+
+	exec("create database mv")
+	exec("create table mv.t (k int, v bigint not null)")
+	exec("insert into mv.t values (1, 10), (1, 20), (2, 30)")
+	exec("create materialized view mv.s as select k, sum(v) as total, count(*) as cnt, max(v) as top from mv.t group by k")
+
+	const view = "select k, total from mv.s order by k"
+	require.Equal(t, [][2]int64{{1, 30}, {2, 30}}, query(view))
+
+	// the view is not changed until refreshed
+	exec("insert into mv.t values (2, 5), (3, 1)")
+	require.Equal(t, [][2]int64{{1, 30}, {2, 30}}, query(view))
+	exec("refresh materialized view mv.s incremental")
+	require.Equal(t, [][2]int64{{1, 30}, {2, 35}, {3, 1}}, query(view))
+
+	// deleting all rows of a group removes the group
+	exec("delete from mv.t where k = 3")
+	exec("refresh materialized view mv.s incremental")
+	require.Equal(t, [][2]int64{{1, 30}, {2, 35}}, query(view))
+	require.Equal(t, [][2]int64{{1, 20}, {2, 30}}, query("select k, top from mv.s order by k"))
+
+	// deleting the max of a group falls back to recomputing the view
+	exec("delete from mv.t where v = 20")
+	exec("refresh materialized view mv.s incremental")
+	require.Equal(t, [][2]int64{{1, 10}, {2, 35}}, query(view))
+	require.Equal(t, [][2]int64{{1, 10}, {2, 30}}, query("select k, top from mv.s order by k"))
+
+	exec("update mv.t set v = 40 where v = 10")
+	exec("refresh materialized view mv.s")
+	require.Equal(t, [][2]int64{{1, 40}, {2, 35}}, query(view))
+
+	var name string
+	err = db.QueryRow("show tables from mv like 's'").Scan(&name)
+	require.NoError(t, err)
+	require.Equal(t, "s", name)
+
+	// the storage of the view is only written by refresh
+	for _, sql := range []string{
+		"insert into mv.s values (9, 9, 1, 9)",
+		"update mv.s set total = 0",
+		"delete from mv.s",
+	} {
+		_, err = db.Exec(sql)
+		require.ErrorContains(t, err, "materialized view", sql)
+	}
+
+	// drop removes the refresh ts of the view
+	refreshTS := func() (cnt int64) {
+		err := db.QueryRow("select count(*) from mv.`%!%mo_mv_refresh`").Scan(&cnt)
+		require.NoError(t, err)
+		return
+	}
+	require.Equal(t, int64(1), refreshTS())
+	exec("drop view mv.s")
+	require.Equal(t, int64(0), refreshTS())
+}
@@ -36,9 +36,11 @@ var (
 	decimal64Type  = types.T_decimal64.ToType()
 	decimal128Type = types.T_decimal128.ToType()
 	dateType       = types.T_date.ToType()
+	timeType       = types.T_time.ToType()
 	datetimeType   = types.T_datetime.ToType()
 	timestampType  = types.T_timestamp.ToType()
 	textType       = types.T_blob.ToType()
+	varbinaryType  = types.T_varbinary.ToType()
 )
 
 func MakeDecimal64Type(precision, scalar int32) types.Type {
@@ -30,7 +30,7 @@ func CompareVectors(expected *vector.Vector, got *vector.Vector) bool {
 			return got.IsScalarNull()
 		} else {
 			switch expected.Typ.Oid {
-			case types.T_char, types.T_varchar, types.T_json:
+			case types.T_char, types.T_varchar, types.T_json, types.T_binary, types.T_varbinary:
 				if got.Typ.Oid != expected.Typ.Oid {
 					return false
 				}
@@ -65,7 +65,7 @@ func CompareVectors(expected *vector.Vector, got *vector.Vector) bool {
 			}
 		}
 		switch expected.Typ.Oid {
-		case types.T_char, types.T_varchar, types.T_json:
+		case types.T_char, types.T_varchar, types.T_json, types.T_binary, types.T_varbinary:
 			if got.Typ.Oid != expected.Typ.Oid {
 				return false
 			}
@@ -91,6 +91,10 @@ var (
 		return makeStringVector(values, nsp, textType)
 	}
 
+	MakeVarbinaryVector = func(values []string, nsp []uint64) *vector.Vector {
+		return makeStringVector(values, nsp, varbinaryType)
+	}
+
 	MakeDecimal64Vector = func(values []int64, nsp []uint64, _ types.Type) *vector.Vector {
 		vec := vector.New(decimal64Type)
 		cols := make([]types.Decimal64, len(values))
@@ -179,6 +183,27 @@ var (
 		return vec
 	}
 
+	MakeTimeVector = func(values []string, nsp []uint64) *vector.Vector {
+		vec := vector.New(timeType)
+		ds := make([]types.Time, len(values))
+		for _, n := range nsp {
+			nulls.Add(vec.Nsp, n)
+		}
+		for i, s := range values {
+			if nulls.Contains(vec.Nsp, uint64(i)) {
+				continue
+			}
+			d, err := types.ParseTime(s, 6)
+			if err != nil {
+				panic(err)
+			}
+			ds[i] = d
+		}
+		vec.Data = types.EncodeTimeSlice(ds)
+		vec.Col = ds
+		return vec
+	}
+
 	MakeTimeStampVector = func(values []string, nsp []uint64) *vector.Vector {
 		vec := vector.New(timestampType)
 		ds := make([]types.Timestamp, len(values))
@@ -287,6 +312,17 @@ var (
 		return vec
 	}
 
+	MakeScalarTime = func(value string, length int) *vector.Vector {
+		vec := NewProc().AllocScalarVector(timeType)
+		vec.Length = length
+		d, err := types.ParseTime(value, 6)
+		if err != nil {
+			panic(err)
+		}
+		vec.Col = []types.Time{d}
+		return vec
+	}
+
 	MakeScalarTimeStamp = func(value string, length int) *vector.Vector {
 		vec := NewProc().AllocScalarVector(timestampType)
 		vec.Length = length
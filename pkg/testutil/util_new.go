@@ -175,6 +175,11 @@ func NewVector(n int, typ types.Type, m *mheap.Mheap, random bool, Values interf
 			return NewDatetimeVector(n, typ, m, random, vs)
 		}
 		return NewDatetimeVector(n, typ, m, random, nil)
+	case types.T_time:
+		if vs, ok := Values.([]string); ok {
+			return NewTimeVector(n, typ, m, random, vs)
+		}
+		return NewTimeVector(n, typ, m, random, nil)
 	case types.T_timestamp:
 		if vs, ok := Values.([]string); ok {
 			return NewTimestampVector(n, typ, m, random, vs)
@@ -190,7 +195,7 @@ func NewVector(n int, typ types.Type, m *mheap.Mheap, random bool, Values interf
 			return NewDecimal128Vector(n, typ, m, random, vs)
 		}
 		return NewDecimal128Vector(n, typ, m, random, nil)
-	case types.T_char, types.T_varchar, types.T_blob:
+	case types.T_char, types.T_varchar, types.T_blob, types.T_binary, types.T_varbinary:
 		if vs, ok := Values.([]string); ok {
 			return NewStringVector(n, typ, m, random, vs)
 		}
@@ -565,6 +570,34 @@ func NewDatetimeVector(n int, typ types.Type, m *mheap.Mheap, random bool, vs []
 	return vec
 }
 
+func NewTimeVector(n int, typ types.Type, m *mheap.Mheap, random bool, vs []string) *vector.Vector {
+	vec := vector.New(typ)
+	if vs != nil {
+		for i := range vs {
+			d, err := types.ParseTime(vs[i], 6)
+			if err != nil {
+				return nil
+			}
+			if err := vec.Append(d, m); err != nil {
+				vec.Free(m)
+				return nil
+			}
+		}
+		return vec
+	}
+	for i := 0; i < n; i++ {
+		v := i
+		if random {
+			v = rand.Intn(int(types.MaxTime))
+		}
+		if err := vec.Append(types.Time(v), m); err != nil {
+			vec.Free(m)
+			return nil
+		}
+	}
+	return vec
+}
+
 func NewTimestampVector(n int, typ types.Type, m *mheap.Mheap, random bool, vs []string) *vector.Vector {
 	vec := vector.New(typ)
 	if vs != nil {
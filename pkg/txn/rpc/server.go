@@ -60,6 +60,15 @@ func NewTxnServer(address string, logger *zap.Logger) (TxnServer, error) {
 	rpc, err := morpc.NewRPCServer("txn-server", address,
 		morpc.NewMessageCodecWithChecksum(s.acquireRequest, 16*1024),
 		morpc.WithServerLogger(s.logger),
+		morpc.WithServerErrorResponse(func(request morpc.Message, err error) morpc.Message {
+			resp := s.acquireResponse()
+			resp.Method = request.(*txn.TxnRequest).Method
+			resp.TxnError = &txn.TxnError{
+				Code:    txn.ErrorCode_RPCError,
+				Message: err.Error(),
+			}
+			return resp
+		}),
 		morpc.WithServerGoettyOptions(goetty.WithSessionReleaseMsgFunc(func(v interface{}) {
 			m := v.(morpc.RPCMessage)
 			s.releaseResponse(m.Message.(*txn.TxnResponse))
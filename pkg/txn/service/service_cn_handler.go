@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/pb/txn"
 	"github.com/matrixorigin/matrixone/pkg/txn/util"
+	"github.com/matrixorigin/matrixone/pkg/util/fault"
 	"go.uber.org/zap"
 )
 
@@ -216,7 +217,11 @@ func (s *service) Commit(ctx context.Context, request *txn.TxnRequest, response
 		txnCtx.updateTxnLocked(newTxn)
 
 		util.LogTxnStart1PCCommit(s.logger, newTxn)
-		if err := s.storage.Commit(ctx, newTxn); err != nil {
+		_, err := fault.Inject(fault.TxnCommit)
+		if err == nil {
+			err = s.storage.Commit(ctx, newTxn)
+		}
+		if err != nil {
 			util.LogTxnStart1PCCommitFailed(s.logger, newTxn, err)
 			response.TxnError = newTAECommitError(err)
 			changeStatus(txn.TxnStatus_Aborted)
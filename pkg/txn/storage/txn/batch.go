@@ -80,7 +80,7 @@ func NewBatchIter(b *batch.Batch) BatchIter {
 					return
 				}
 
-			case types.T_uint16:
+			case types.T_uint16, types.T_enum:
 				col := vec.Col.([]uint16)
 				if i < len(col) {
 					tuple = append(tuple, col[i])
@@ -96,7 +96,7 @@ func NewBatchIter(b *batch.Batch) BatchIter {
 					return
 				}
 
-			case types.T_uint64:
+			case types.T_uint64, types.T_set, types.T_bit:
 				col := vec.Col.([]uint64)
 				if i < len(col) {
 					tuple = append(tuple, col[i])
@@ -136,10 +136,10 @@ func NewBatchIter(b *batch.Batch) BatchIter {
 					return
 				}
 
-			case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+			case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 				info := vec.Col.(*types.Bytes)
 				if i < len(info.Offsets) {
-					str := vec.Data[info.Offsets[i] : info.Offsets[i]+info.Lengths[i]]
+					str := info.Get(int64(i))
 					tuple = append(tuple, str)
 				} else {
 					return
@@ -161,6 +161,14 @@ func NewBatchIter(b *batch.Batch) BatchIter {
 					return
 				}
 
+			case types.T_time:
+				col := vec.Col.([]types.Time)
+				if i < len(col) {
+					tuple = append(tuple, col[i])
+				} else {
+					return
+				}
+
 			case types.T_timestamp:
 				col := vec.Col.([]types.Timestamp)
 				if i < len(col) {
@@ -185,6 +193,14 @@ func NewBatchIter(b *batch.Batch) BatchIter {
 					return
 				}
 
+			case types.T_uuid:
+				col := vec.Col.([]types.Uuid)
+				if i < len(col) {
+					tuple = append(tuple, col[i])
+				} else {
+					return
+				}
+
 			}
 		}
 
@@ -217,13 +233,13 @@ func vectorAt(vec *vector.Vector, i int) any {
 	case types.T_uint8:
 		return vec.Col.([]uint8)[i]
 
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return vec.Col.([]uint16)[i]
 
 	case types.T_uint32:
 		return vec.Col.([]uint32)[i]
 
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		return vec.Col.([]uint64)[i]
 
 	case types.T_float32:
@@ -238,9 +254,9 @@ func vectorAt(vec *vector.Vector, i int) any {
 	case types.T_tuple:
 		return vec.Col.([][]any)[i]
 
-	case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+	case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		info := vec.Col.(*types.Bytes)
-		str := vec.Data[info.Offsets[i] : info.Offsets[i]+info.Lengths[i]]
+		str := info.Get(int64(i))
 		return str
 
 	case types.T_date:
@@ -249,6 +265,9 @@ func vectorAt(vec *vector.Vector, i int) any {
 	case types.T_datetime:
 		return vec.Col.([]types.Datetime)[i]
 
+	case types.T_time:
+		return vec.Col.([]types.Time)[i]
+
 	case types.T_timestamp:
 		return vec.Col.([]types.Timestamp)[i]
 
@@ -258,6 +277,9 @@ func vectorAt(vec *vector.Vector, i int) any {
 	case types.T_decimal128:
 		return vec.Col.([]types.Decimal128)[i]
 
+	case types.T_uuid:
+		return vec.Col.([]types.Uuid)[i]
+
 	}
 
 	panic(fmt.Errorf("unknown column type: %v", vec.Typ))
@@ -58,6 +58,7 @@ func NewCatalogHandler(upstream *MemHandler) *CatalogHandler {
 		ID:   uuid.NewString(),
 		Name: "mo_catalog", // hardcoded in frontend package
 	}
+	handler.createDatabase()
 
 	// relations
 	databasesRelRow := &RelationRow{
@@ -123,6 +124,29 @@ func NewCatalogHandler(upstream *MemHandler) *CatalogHandler {
 	return handler
 }
 
+// createDatabase saves the system database in upstream, so the other
+// catalog tables of the frontend can be created in it
+func (c *CatalogHandler) createDatabase() {
+	tx := NewTransaction(uuid.NewString(), Timestamp{}, SnapshotIsolation)
+	if err := c.upstream.databases.Insert(tx, *c.database); err != nil {
+		panic(err)
+	}
+	tx.State.Store(Committed)
+}
+
+// systemRelation returns the built-in relation of the system database
+func (c *CatalogHandler) systemRelation(databaseID string, name string) *RelationRow {
+	if databaseID != c.database.ID {
+		return nil
+	}
+	for _, row := range c.relations {
+		if row.Name == name {
+			return row
+		}
+	}
+	return nil
+}
+
 func (c *CatalogHandler) HandleAddTableDef(meta txn.TxnMeta, req txnengine.AddTableDefReq, resp *txnengine.AddTableDefResp) error {
 	if row, ok := c.relations[req.TableID]; ok {
 		resp.ErrReadOnly.Why = fmt.Sprintf("%s is system table", row.Name)
@@ -183,8 +207,8 @@ func (c *CatalogHandler) HandleCreateDatabase(meta txn.TxnMeta, req txnengine.Cr
 }
 
 func (c *CatalogHandler) HandleCreateRelation(meta txn.TxnMeta, req txnengine.CreateRelationReq, resp *txnengine.CreateRelationResp) error {
-	if req.DatabaseID == c.database.ID {
-		resp.ErrReadOnly.Why = "can't create relation in system database"
+	if c.systemRelation(req.DatabaseID, req.Name) != nil {
+		resp.ErrExisted = true
 		return nil
 	}
 	return c.upstream.HandleCreateRelation(meta, req, resp)
@@ -215,25 +239,25 @@ func (c *CatalogHandler) HandleDeleteDatabase(meta txn.TxnMeta, req txnengine.De
 }
 
 func (c *CatalogHandler) HandleDeleteRelation(meta txn.TxnMeta, req txnengine.DeleteRelationReq, resp *txnengine.DeleteRelationResp) error {
-	if req.DatabaseID == c.database.ID {
-		resp.ErrReadOnly.Why = "can't delete relation from system database"
+	if row := c.systemRelation(req.DatabaseID, req.Name); row != nil {
+		resp.ErrReadOnly.Why = fmt.Sprintf("%s is system table", row.Name)
 		return nil
 	}
 	return c.upstream.HandleDeleteRelation(meta, req, resp)
 }
 
 func (c *CatalogHandler) HandleDestroy() error {
-	return c.upstream.HandleDestroy()
-}
-
-func (c *CatalogHandler) HandleGetDatabases(meta txn.TxnMeta, req txnengine.GetDatabasesReq, resp *txnengine.GetDatabasesResp) error {
-	if err := c.upstream.HandleGetDatabases(meta, req, resp); err != nil {
+	if err := c.upstream.HandleDestroy(); err != nil {
 		return err
 	}
-	resp.Names = append(resp.Names, c.database.Name)
+	c.createDatabase()
 	return nil
 }
 
+func (c *CatalogHandler) HandleGetDatabases(meta txn.TxnMeta, req txnengine.GetDatabasesReq, resp *txnengine.GetDatabasesResp) error {
+	return c.upstream.HandleGetDatabases(meta, req, resp)
+}
+
 func (c *CatalogHandler) HandleGetPrimaryKeys(meta txn.TxnMeta, req txnengine.GetPrimaryKeysReq, resp *txnengine.GetPrimaryKeysResp) error {
 	if rel, ok := c.relations[req.TableID]; ok {
 		for _, attr := range c.attributes {
@@ -255,11 +279,18 @@ func (c *CatalogHandler) HandleGetRelations(meta txn.TxnMeta, req txnengine.GetR
 		for _, row := range c.relations {
 			resp.Names = append(resp.Names, row.Name)
 		}
-		return nil
 	}
 	return c.upstream.HandleGetRelations(meta, req, resp)
 }
 
+func (c *CatalogHandler) HandleGetHiddenKeys(meta txn.TxnMeta, req txnengine.GetHiddenKeysReq, resp *txnengine.GetHiddenKeysResp) error {
+	if _, ok := c.relations[req.TableID]; ok {
+		// system relations have no hidden attributes
+		return nil
+	}
+	return c.upstream.HandleGetHiddenKeys(meta, req, resp)
+}
+
 func (c *CatalogHandler) HandleGetTableDefs(meta txn.TxnMeta, req txnengine.GetTableDefsReq, resp *txnengine.GetTableDefsResp) error {
 	if rel, ok := c.relations[req.TableID]; ok {
 
@@ -367,15 +398,9 @@ func (c *CatalogHandler) HandleOpenDatabase(meta txn.TxnMeta, req txnengine.Open
 }
 
 func (c *CatalogHandler) HandleOpenRelation(meta txn.TxnMeta, req txnengine.OpenRelationReq, resp *txnengine.OpenRelationResp) error {
-	if req.DatabaseID == c.database.ID {
-		for _, row := range c.relations {
-			if row.Name == req.Name {
-				resp.ID = row.ID
-				resp.Type = row.Type
-				return nil
-			}
-		}
-		resp.ErrNotFound.Name = req.Name
+	if row := c.systemRelation(req.DatabaseID, req.Name); row != nil {
+		resp.ID = row.ID
+		resp.Type = row.Type
 		return nil
 	}
 	return c.upstream.HandleOpenRelation(meta, req, resp)
@@ -391,6 +416,7 @@ func (c *CatalogHandler) HandleRead(meta txn.TxnMeta, req txnengine.ReadReq, res
 	v, ok := c.iterators.Map[req.IterID]
 	c.iterators.Unlock()
 	if ok {
+		tx := c.upstream.getTx(meta)
 		b := batch.New(false, req.ColNames)
 		maxRows := 4096
 		rows := 0
@@ -428,7 +454,9 @@ func (c *CatalogHandler) HandleRead(meta txn.TxnMeta, req txnengine.ReadReq, res
 						return nil
 					}
 
-					b.Vecs[i].Append(value, c.upstream.mheap)
+					if err := b.Vecs[i].Append(catalogValue(b.Vecs[i].Typ, value), c.upstream.mheap); err != nil {
+						return err
+					}
 				}
 				rows++
 				if rows >= maxRows {
@@ -459,9 +487,9 @@ func (c *CatalogHandler) HandleRead(meta txn.TxnMeta, req txnengine.ReadReq, res
 					case "relname":
 						value = row.Name
 					case "reldatabase":
-						value = c.database.Name
+						value = c.databaseName(tx, row.DatabaseID)
 					case "relpersistence":
-						value = true
+						value = "p"
 					case "relkind":
 						value = "r"
 					case "rel_comment":
@@ -473,7 +501,9 @@ func (c *CatalogHandler) HandleRead(meta txn.TxnMeta, req txnengine.ReadReq, res
 						return nil
 					}
 
-					b.Vecs[i].Append(value, c.upstream.mheap)
+					if err := b.Vecs[i].Append(catalogValue(b.Vecs[i].Typ, value), c.upstream.mheap); err != nil {
+						return err
+					}
 				}
 				rows++
 				if rows >= maxRows {
@@ -501,11 +531,16 @@ func (c *CatalogHandler) HandleRead(meta txn.TxnMeta, req txnengine.ReadReq, res
 					var value any
 
 					switch name {
-					case "att_database":
-						value = c.database.Name
-					case "att_relname":
-						rel := c.relations[row.RelationID]
-						value = rel.Name
+					case "att_database", "att_relname":
+						rel, err := c.upstream.relations.Get(tx, Text(row.RelationID))
+						if err != nil {
+							return err
+						}
+						if name == "att_database" {
+							value = c.databaseName(tx, rel.DatabaseID)
+						} else {
+							value = rel.Name
+						}
 					case "attname":
 						value = row.Name
 					case "atttyp":
@@ -515,11 +550,14 @@ func (c *CatalogHandler) HandleRead(meta txn.TxnMeta, req txnengine.ReadReq, res
 					case "att_length":
 						value = row.Type.Size
 					case "attnotnull":
-						value = row.Default.NullAbility
+						value = row.Default != nil && !row.Default.NullAbility
 					case "atthasdef":
-						value = row.Default.Expr != nil
+						value = row.Default != nil && row.Default.Expr != nil
 					case "att_default":
-						value = row.Default.Expr.String()
+						value = ""
+						if row.Default != nil && row.Default.Expr != nil {
+							value = row.Default.Expr.String()
+						}
 					case "attisdropped":
 						value = false
 					case "att_constraint_type":
@@ -535,7 +573,7 @@ func (c *CatalogHandler) HandleRead(meta txn.TxnMeta, req txnengine.ReadReq, res
 							row.Type.Oid == types.T_uint64 ||
 							row.Type.Oid == types.T_uint128
 					case "att_is_auto_increment":
-						value = false
+						value = row.AutoIncrement
 					case "att_comment":
 						value = row.Comment
 					case "att_is_hidden":
@@ -545,7 +583,9 @@ func (c *CatalogHandler) HandleRead(meta txn.TxnMeta, req txnengine.ReadReq, res
 						return nil
 					}
 
-					b.Vecs[i].Append(value, c.upstream.mheap)
+					if err := b.Vecs[i].Append(catalogValue(b.Vecs[i].Typ, value), c.upstream.mheap); err != nil {
+						return err
+					}
 				}
 				rows++
 				if rows >= maxRows {
@@ -568,6 +608,45 @@ func (c *CatalogHandler) HandleRead(meta txn.TxnMeta, req txnengine.ReadReq, res
 	return c.upstream.HandleRead(meta, req, resp)
 }
 
+func (c *CatalogHandler) databaseName(tx *Transaction, id string) string {
+	row, err := c.upstream.databases.Get(tx, Text(id))
+	if err != nil {
+		return ""
+	}
+	return row.Name
+}
+
+// catalogValue converts the value of a catalog attribute to the type of
+// the column declared in the frontend package
+func catalogValue(typ types.Type, value any) any {
+	switch typ.Oid {
+	case types.T_char, types.T_varchar:
+		return []byte(value.(string))
+	case types.T_int8:
+		switch v := value.(type) {
+		case bool:
+			if v {
+				return int8(1)
+			}
+			return int8(0)
+		case int:
+			return int8(v)
+		case int32:
+			return int8(v)
+		case types.T:
+			return int8(v)
+		}
+	case types.T_int32:
+		switch v := value.(type) {
+		case int:
+			return int32(v)
+		case int32:
+			return v
+		}
+	}
+	return value
+}
+
 func (c *CatalogHandler) HandleRollback(meta txn.TxnMeta) error {
 	return c.upstream.HandleRollback(meta)
 }
@@ -77,6 +77,12 @@ type Handler interface {
 		resp *txnengine.GetPrimaryKeysResp,
 	) error
 
+	HandleGetHiddenKeys(
+		meta txn.TxnMeta,
+		req txnengine.GetHiddenKeysReq,
+		resp *txnengine.GetHiddenKeysResp,
+	) error
+
 	HandleGetTableDefs(
 		meta txn.TxnMeta,
 		req txnengine.GetTableDefsReq,
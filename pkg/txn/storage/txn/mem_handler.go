@@ -509,7 +509,7 @@ func (m *MemHandler) HandleDelete(meta txn.TxnMeta, req txnengine.DeleteReq, res
 	}
 
 	tx := m.getTx(meta)
-	for i := 0; i < req.Vector.Length; i++ {
+	for i := 0; i < vector.Length(req.Vector); i++ {
 		primaryKey := AnyKey{
 			typeConv(vectorAt(req.Vector, i)),
 		}
@@ -598,6 +598,26 @@ func (m *MemHandler) HandleGetPrimaryKeys(meta txn.TxnMeta, req txnengine.GetPri
 	return nil
 }
 
+func (m *MemHandler) HandleGetHiddenKeys(meta txn.TxnMeta, req txnengine.GetHiddenKeysReq, resp *txnengine.GetHiddenKeysResp) error {
+	tx := m.getTx(meta)
+	iter := m.attributes.NewIter(tx)
+	defer iter.Close()
+	for ok := iter.First(); ok; ok = iter.Next() {
+		_, row, err := iter.Read()
+		if err != nil {
+			return err
+		}
+		if row.RelationID != req.TableID {
+			continue
+		}
+		if !row.IsHidden {
+			continue
+		}
+		resp.Attrs = append(resp.Attrs, &row.Attribute)
+	}
+	return nil
+}
+
 func (m *MemHandler) HandleGetRelations(meta txn.TxnMeta, req txnengine.GetRelationsReq, resp *txnengine.GetRelationsResp) error {
 	tx := m.getTx(meta)
 	iter := m.relations.NewIter(tx)
@@ -646,6 +666,10 @@ func (m *MemHandler) HandleGetTableDefs(meta txn.TxnMeta, req txnengine.GetTable
 			if attrRow.RelationID != req.TableID {
 				continue
 			}
+			// hidden attributes are returned by HandleGetHiddenKeys
+			if attrRow.IsHidden {
+				continue
+			}
 			attrRows = append(attrRows, attrRow)
 			if attrRow.Primary {
 				primaryAttrNames = append(primaryAttrNames, attrRow.Name)
@@ -955,6 +979,10 @@ func (m *MemHandler) rangeBatchPhysicalRows(
 		b := rand.Int63()
 		version := types.Decimal128FromInt64Raw(a, b)
 		physicalRow.attributes[nameToAttrs[rowVersionColumnName].ID] = version
+		// rows of the tables without primary key are identified by version
+		if len(physicalRow.primaryKey) == 0 {
+			physicalRow.primaryKey = AnyKey{typeConv(version)}
+		}
 
 		if err := fn(table, physicalRow); err != nil {
 			return err
@@ -61,6 +61,12 @@ func (s *Storage) Read(ctx context.Context, txnMeta txn.TxnMeta, op uint32, payl
 			s.handler.HandleGetPrimaryKeys,
 		)
 
+	case txnengine.OpGetHiddenKeys:
+		return handleRead(
+			s, txnMeta, payload,
+			s.handler.HandleGetHiddenKeys,
+		)
+
 	case txnengine.OpGetTableDefs:
 		return handleRead(
 			s, txnMeta, payload,
@@ -15,6 +15,9 @@
 package txnstorage
 
 import (
+	"database/sql"
+	"errors"
+
 	"github.com/tidwall/btree"
 )
 
@@ -56,7 +59,7 @@ func (t *TableIter[K, R]) Next() bool {
 			return false
 		}
 		// skip invisible values
-		if !t.iter.Item().Values.Visible(t.tx, t.tx.CurrentTime) {
+		if !t.visible() {
 			continue
 		}
 		return true
@@ -69,7 +72,7 @@ func (t *TableIter[K, R]) First() bool {
 	}
 	for {
 		// skip invisible values
-		if !t.iter.Item().Values.Visible(t.tx, t.tx.CurrentTime) {
+		if !t.visible() {
 			if ok := t.iter.Next(); !ok {
 				return false
 			}
@@ -79,6 +82,13 @@ func (t *TableIter[K, R]) First() bool {
 	}
 }
 
+// visible reports whether Read returns a value for the current row, with
+// the isolation policy of the transaction applied
+func (t *TableIter[K, R]) visible() bool {
+	_, err := t.iter.Item().Values.Read(t.tx, t.readTime)
+	return !errors.Is(err, sql.ErrNoRows)
+}
+
 func (t *TableIter[K, R]) Close() error {
 	t.iter.Release()
 	return nil
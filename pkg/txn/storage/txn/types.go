@@ -97,6 +97,8 @@ func typeConv(v any) any {
 		return Bytes(v[:])
 	case types.Decimal128:
 		return Bytes(v[:])
+	case types.Uuid:
+		return Bytes(v[:])
 	default:
 		panic(fmt.Errorf("unknown type: %T", v))
 	}
@@ -16,8 +16,10 @@
 package fault
 
 import (
+	"fmt"
 	"math"
 	"math/rand"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -33,6 +35,7 @@ const (
 	ADD
 	REMOVE
 	TRIGGER
+	LIST
 )
 
 const (
@@ -44,8 +47,23 @@ const (
 	NOTIFY
 	NOTIFYALL
 	PANIC
+	ERROR
+	DROP
 )
 
+var actionNames = map[int]string{
+	RETURN:     "RETURN",
+	GETCOUNT:   "GETCOUNT",
+	SLEEP:      "SLEEP",
+	WAIT:       "WAIT",
+	GETWAITERS: "GETWAITERS",
+	NOTIFY:     "NOTIFY",
+	NOTIFYALL:  "NOTIFYALL",
+	PANIC:      "PANIC",
+	ERROR:      "ERROR",
+	DROP:       "DROP",
+}
+
 // faultEntry describes how we shall fail
 type faultEntry struct {
 	cmd              int     // command
@@ -60,6 +78,9 @@ type faultEntry struct {
 	nWaiters int
 	mutex    sync.Mutex
 	cond     *sync.Cond
+
+	// result of LIST command
+	points []string
 }
 
 type faultMap struct {
@@ -104,12 +125,38 @@ func (fm *faultMap) run() {
 			fm.chOut <- out
 		case LOOKUP:
 			fm.chOut <- fm.faultPoints[e.sarg]
+		case LIST:
+			for _, v := range fm.faultPoints {
+				e.points = append(e.points, v.String())
+			}
+			sort.Strings(e.points)
+			var unarmed []string
+			for _, name := range KnownFaultPoints() {
+				if _, ok := fm.faultPoints[name]; !ok {
+					unarmed = append(unarmed, name+" not armed")
+				}
+			}
+			sort.Strings(unarmed)
+			e.points = append(e.points, unarmed...)
+			fm.chOut <- e
 		default:
 			fm.chOut <- nil
 		}
 	}
 }
 
+// String returns the description of an armed fault point, the counter is
+// only accessed in the fault map goroutine.
+func (e *faultEntry) String() string {
+	end := strconv.Itoa(e.end)
+	if e.end == math.MaxInt {
+		end = ""
+	}
+	return fmt.Sprintf("%s %d:%s:%d:%g %s %d %q count=%d",
+		e.name, e.start, end, e.skip, e.prob,
+		actionNames[e.action], e.iarg, e.sarg, e.cnt)
+}
+
 func (e *faultEntry) do() int64 {
 	switch e.action {
 	case RETURN: // no op
@@ -142,6 +189,8 @@ func (e *faultEntry) do() int64 {
 		}
 	case PANIC:
 		panic(e.sarg)
+	case ERROR, DROP:
+		// handled by Inject, no op for TriggerFault
 	}
 	return 0
 }
@@ -187,19 +236,43 @@ func IsEnabled() bool {
 
 // Trigger a fault point.
 func TriggerFault(name string) (int64, bool) {
-	if !IsEnabled() {
+	out := trigger(name)
+	if out == nil {
 		return 0, false
 	}
+	return out.do(), true
+}
+
+// Inject triggers a fault point placed on a storage or rpc path. SLEEP, WAIT
+// and PANIC take effect inside Inject. An ERROR action returns an error which
+// should be returned to the caller as if the operation failed. A DROP action
+// returns drop = true, the caller should silently discard the request or
+// response if it is able to, otherwise DROP is treated as a no op.
+func Inject(name string) (drop bool, err error) {
+	out := trigger(name)
+	if out == nil {
+		return false, nil
+	}
+	switch out.action {
+	case ERROR:
+		return false, moerr.NewInternalError("fault injection %s: %s", name, out.sarg)
+	case DROP:
+		return true, nil
+	default:
+		out.do()
+		return false, nil
+	}
+}
+
+func trigger(name string) *faultEntry {
+	if !IsEnabled() {
+		return nil
+	}
 	var msg faultEntry
 	msg.cmd = TRIGGER
 	msg.name = name
 	gfm.chIn <- &msg
-	out := <-gfm.chOut
-
-	if out == nil {
-		return 0, false
-	}
-	return out.do(), true
+	return <-gfm.chOut
 }
 
 func AddFaultPoint(name string, freq string, action string, iarg int64, sarg string) error {
@@ -271,6 +344,10 @@ func AddFaultPoint(name string, freq string, action string, iarg int64, sarg str
 		msg.action = NOTIFYALL
 	case "PANIC":
 		msg.action = PANIC
+	case "ERROR":
+		msg.action = ERROR
+	case "DROP":
+		msg.action = DROP
 	default:
 		return moerr.NewError(moerr.INVALID_ARGUMENT, "invalid fault action")
 	}
@@ -318,3 +395,17 @@ func lookup(name string) *faultEntry {
 	out := <-gfm.chOut
 	return out
 }
+
+// ListFaultPoints returns the description of all armed fault points, sorted
+// by name, followed by the known fault points that are not armed.
+func ListFaultPoints() ([]string, error) {
+	if !IsEnabled() {
+		return nil, moerr.NewError(moerr.INVALID_ARGUMENT, "fault injection not enabled")
+	}
+
+	var msg faultEntry
+	msg.cmd = LIST
+	gfm.chIn <- &msg
+	out := <-gfm.chOut
+	return out.points, nil
+}
@@ -162,3 +162,74 @@ func TestWait(t *testing.T) {
 
 	Disable()
 }
+
+func TestInject(t *testing.T) {
+	drop, err := Inject(S3FSRead)
+	require.False(t, drop)
+	require.NoError(t, err)
+
+	Enable()
+	defer Disable()
+
+	require.NoError(t, AddFaultPoint(S3FSRead, ":::", "error", 0, "disk broken"))
+	require.NoError(t, AddFaultPoint(RPCSend, "2:2::", "drop", 0, ""))
+	require.NoError(t, AddFaultPoint(TxnCommit, ":::", "return", 0, ""))
+
+	drop, err = Inject(S3FSRead)
+	require.False(t, drop)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "disk broken")
+
+	drop, err = Inject(RPCSend)
+	require.False(t, drop)
+	require.NoError(t, err)
+	drop, err = Inject(RPCSend)
+	require.True(t, drop)
+	require.NoError(t, err)
+	drop, err = Inject(RPCSend)
+	require.False(t, drop)
+	require.NoError(t, err)
+
+	drop, err = Inject(TxnCommit)
+	require.False(t, drop)
+	require.NoError(t, err)
+
+	// the action is a no op for TriggerFault
+	_, ok := TriggerFault(S3FSRead)
+	require.True(t, ok)
+
+	require.NoError(t, RemoveFaultPoint(S3FSRead))
+	_, err = Inject(S3FSRead)
+	require.NoError(t, err)
+}
+
+func TestListFaultPoints(t *testing.T) {
+	_, err := ListFaultPoints()
+	require.Error(t, err)
+
+	Enable()
+	defer Disable()
+
+	points, err := ListFaultPoints()
+	require.NoError(t, err)
+	require.Equal(t, len(KnownFaultPoints()), len(points))
+
+	require.NoError(t, AddFaultPoint(TAECheckpoint, ":::0.5", "sleep", 1, ""))
+	require.NoError(t, AddFaultPoint(LogServiceAppend, "2:3:1:", "panic", 0, "crash"))
+	// not fired, the counter is increased
+	_, ok := TriggerFault(LogServiceAppend)
+	require.False(t, ok)
+
+	points, err = ListFaultPoints()
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		`logservice.append 2:3:1:1 PANIC 0 "crash" count=1`,
+		`tae.checkpoint 1::1:0.5 SLEEP 1 "" count=0`,
+		`fileservice.s3.read not armed`,
+		`fileservice.s3.write not armed`,
+		`morpc.receive not armed`,
+		`morpc.send not armed`,
+		`tae.flush.block not armed`,
+		`txn.commit not armed`,
+	}, points)
+}
@@ -0,0 +1,53 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fault
+
+// Names of the fault points placed in the storage and rpc paths. They can be
+// armed with add_fault_point() and disarmed with remove_fault_point().
+const (
+	// S3FSRead is triggered before S3FS reads an object.
+	S3FSRead = "fileservice.s3.read"
+	// S3FSWrite is triggered before S3FS puts an object.
+	S3FSWrite = "fileservice.s3.write"
+	// RPCSend is triggered before a morpc backend sends a request. DROP
+	// discards the request and the future will time out.
+	RPCSend = "morpc.send"
+	// RPCReceive is triggered before a morpc server handles a request. DROP
+	// discards the request without response. ERROR is sent back as the
+	// response, or discards the request if the server can not build one.
+	RPCReceive = "morpc.receive"
+	// LogServiceAppend is triggered before a log service appends a record.
+	LogServiceAppend = "logservice.append"
+	// TAEFlushBlock is triggered before TAE flushes a block to the file.
+	TAEFlushBlock = "tae.flush.block"
+	// TAECheckpoint is triggered before TAE checkpoints the wal.
+	TAECheckpoint = "tae.checkpoint"
+	// TxnCommit is triggered before a dn commits a 1PC transaction.
+	TxnCommit = "txn.commit"
+)
+
+// KnownFaultPoints returns the names of all fault points placed in the code.
+func KnownFaultPoints() []string {
+	return []string{
+		S3FSRead,
+		S3FSWrite,
+		RPCSend,
+		RPCReceive,
+		LogServiceAppend,
+		TAEFlushBlock,
+		TAECheckpoint,
+		TxnCommit,
+	}
+}
@@ -0,0 +1,255 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptofunc
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// DefaultBlockEncryptionMode is the default value of the block_encryption_mode variable
+const DefaultBlockEncryptionMode = "aes-128-ecb"
+
+// blockMode is a parsed block_encryption_mode such as aes-256-cbc
+type blockMode struct {
+	keyLen int
+	chain  string
+}
+
+func parseBlockMode(mode string) (blockMode, error) {
+	if mode == "" {
+		mode = DefaultBlockEncryptionMode
+	}
+	var m blockMode
+	parts := strings.Split(strings.ToLower(mode), "-")
+	if len(parts) != 3 || parts[0] != "aes" {
+		return m, fmt.Errorf("unsupported block encryption mode '%s'", mode)
+	}
+	switch parts[1] {
+	case "128":
+		m.keyLen = 16
+	case "192":
+		m.keyLen = 24
+	case "256":
+		m.keyLen = 32
+	default:
+		return m, fmt.Errorf("unsupported block encryption mode '%s'", mode)
+	}
+	switch parts[2] {
+	case "ecb", "cbc", "cfb1", "cfb8", "cfb128", "ofb":
+		m.chain = parts[2]
+	default:
+		return m, fmt.Errorf("unsupported block encryption mode '%s'", mode)
+	}
+	return m, nil
+}
+
+// AesEncrypt encrypts the strings with the keys in the block encryption mode,
+// ivs is nil if no initialization vector is given, which is ignored by the ecb mode
+func AesEncrypt(mode string, xs, keys, ivs, rs *types.Bytes, nsp *nulls.Nulls) (*types.Bytes, error) {
+	return aesCrypt(mode, true, xs, keys, ivs, rs, nsp)
+}
+
+// AesDecrypt decrypts the strings encrypted by AesEncrypt, the string that
+// is not a valid encrypted string gets null
+func AesDecrypt(mode string, xs, keys, ivs, rs *types.Bytes, nsp *nulls.Nulls) (*types.Bytes, error) {
+	return aesCrypt(mode, false, xs, keys, ivs, rs, nsp)
+}
+
+func aesCrypt(mode string, encrypt bool, xs, keys, ivs, rs *types.Bytes, nsp *nulls.Nulls) (*types.Bytes, error) {
+	m, err := parseBlockMode(mode)
+	if err != nil {
+		return nil, err
+	}
+	if m.chain != "ecb" && ivs == nil {
+		return nil, fmt.Errorf("the initialization vector is required by the block encryption mode '%s'", mode)
+	}
+	var lastKey []byte
+	var block cipher.Block
+	for i := range rs.Lengths {
+		rs.Offsets[i] = uint32(len(rs.Data))
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		if key := keys.Get(index(len(keys.Lengths), i)); block == nil || !bytes.Equal(key, lastKey) {
+			if block, err = aes.NewCipher(foldKey(key, m.keyLen)); err != nil {
+				return nil, err
+			}
+			lastKey = key
+		}
+		var iv []byte
+		if m.chain != "ecb" {
+			if iv = ivs.Get(index(len(ivs.Lengths), i)); len(iv) < aes.BlockSize {
+				return nil, fmt.Errorf("the initialization vector is too short, at least %d bytes are required", aes.BlockSize)
+			}
+			iv = iv[:aes.BlockSize]
+		}
+		x := xs.Get(index(len(xs.Lengths), i))
+		var v []byte
+		if encrypt {
+			v = m.encrypt(block, iv, x)
+		} else if v = m.decrypt(block, iv, x); v == nil {
+			nulls.Add(nsp, uint64(i))
+			continue
+		}
+		appendResult(rs, i, v)
+	}
+	return rs, nil
+}
+
+// foldKey returns the key of n bytes by xor-ing the bytes of key in turn like mysql does
+func foldKey(key []byte, n int) []byte {
+	k := make([]byte, n)
+	for i, b := range key {
+		k[i%n] ^= b
+	}
+	return k
+}
+
+func (m blockMode) encrypt(block cipher.Block, iv, x []byte) []byte {
+	switch m.chain {
+	case "ecb", "cbc":
+		pad := aes.BlockSize - len(x)%aes.BlockSize
+		v := make([]byte, len(x)+pad)
+		copy(v, x)
+		for i := len(x); i < len(v); i++ {
+			v[i] = byte(pad)
+		}
+		if m.chain == "cbc" {
+			cipher.NewCBCEncrypter(block, iv).CryptBlocks(v, v)
+		} else {
+			for i := 0; i < len(v); i += aes.BlockSize {
+				block.Encrypt(v[i:], v[i:])
+			}
+		}
+		return v
+	default:
+		v := make([]byte, len(x))
+		m.stream(block, iv, false).XORKeyStream(v, x)
+		return v
+	}
+}
+
+// decrypt returns nil if x is not a valid encrypted string
+func (m blockMode) decrypt(block cipher.Block, iv, x []byte) []byte {
+	switch m.chain {
+	case "ecb", "cbc":
+		if len(x) == 0 || len(x)%aes.BlockSize != 0 {
+			return nil
+		}
+		v := make([]byte, len(x))
+		if m.chain == "cbc" {
+			cipher.NewCBCDecrypter(block, iv).CryptBlocks(v, x)
+		} else {
+			for i := 0; i < len(v); i += aes.BlockSize {
+				block.Decrypt(v[i:], x[i:])
+			}
+		}
+		pad := int(v[len(v)-1])
+		if pad == 0 || pad > aes.BlockSize {
+			return nil
+		}
+		for _, b := range v[len(v)-pad:] {
+			if int(b) != pad {
+				return nil
+			}
+		}
+		return v[:len(v)-pad]
+	default:
+		v := make([]byte, len(x))
+		m.stream(block, iv, true).XORKeyStream(v, x)
+		return v
+	}
+}
+
+func (m blockMode) stream(block cipher.Block, iv []byte, decrypt bool) cipher.Stream {
+	switch m.chain {
+	case "cfb128":
+		if decrypt {
+			return cipher.NewCFBDecrypter(block, iv)
+		}
+		return cipher.NewCFBEncrypter(block, iv)
+	case "ofb":
+		return cipher.NewOFB(block, iv)
+	case "cfb8":
+		return newCFBStream(block, iv, 8, decrypt)
+	default:
+		return newCFBStream(block, iv, 1, decrypt)
+	}
+}
+
+// cfbStream is the cfb mode with the segments of 1 or 8 bits,
+// crypto/cipher only provides the segments of the block size.
+type cfbStream struct {
+	block   cipher.Block
+	reg     []byte
+	out     []byte
+	bits    int
+	decrypt bool
+}
+
+func newCFBStream(block cipher.Block, iv []byte, bits int, decrypt bool) cipher.Stream {
+	return &cfbStream{
+		block:   block,
+		reg:     append([]byte{}, iv...),
+		out:     make([]byte, block.BlockSize()),
+		bits:    bits,
+		decrypt: decrypt,
+	}
+}
+
+func (x *cfbStream) XORKeyStream(dst, src []byte) {
+	for i, s := range src {
+		if x.bits == 8 {
+			x.block.Encrypt(x.out, x.reg)
+			d := s ^ x.out[0]
+			copy(x.reg, x.reg[1:])
+			if x.decrypt {
+				x.reg[len(x.reg)-1] = s
+			} else {
+				x.reg[len(x.reg)-1] = d
+			}
+			dst[i] = d
+			continue
+		}
+		var d byte
+		for j := 7; j >= 0; j-- {
+			x.block.Encrypt(x.out, x.reg)
+			in := (s >> j) & 1
+			o := in ^ (x.out[0] >> 7)
+			d |= o << j
+			if x.decrypt {
+				x.shift(in)
+			} else {
+				x.shift(o)
+			}
+		}
+		dst[i] = d
+	}
+}
+
+// shift shifts the register left by one bit and appends the bit b
+func (x *cfbStream) shift(b byte) {
+	for i := 0; i < len(x.reg)-1; i++ {
+		x.reg[i] = x.reg[i]<<1 | x.reg[i+1]>>7
+	}
+	x.reg[len(x.reg)-1] = x.reg[len(x.reg)-1]<<1 | b
+}
@@ -0,0 +1,116 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptofunc
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func makeBytes(strs ...string) *types.Bytes {
+	xs := &types.Bytes{
+		Offsets: make([]uint32, len(strs)),
+		Lengths: make([]uint32, len(strs)),
+	}
+	for i, s := range strs {
+		xs.Offsets[i] = uint32(len(xs.Data))
+		xs.Data = append(xs.Data, s...)
+		xs.Lengths[i] = uint32(len(s))
+	}
+	return xs
+}
+
+func newResult(n int) *types.Bytes {
+	return &types.Bytes{
+		Offsets: make([]uint32, n),
+		Lengths: make([]uint32, n),
+	}
+}
+
+func toStrings(rs *types.Bytes) []string {
+	strs := make([]string, len(rs.Lengths))
+	for i := range strs {
+		strs[i] = string(rs.Get(int64(i)))
+	}
+	return strs
+}
+
+func unhex(t *testing.T, s string) string {
+	v, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return string(v)
+}
+
+func TestDigest(t *testing.T) {
+	xs := makeBytes("abc", "")
+	require.Equal(t, []string{"900150983cd24fb0d6963f7d28e17f72", "d41d8cd98f00b204e9800998ecf8427e"}, toStrings(Md5(xs, newResult(2))))
+	require.Equal(t, []string{"a9993e364706816aba3e25717850c26c9cd0d89d", "da39a3ee5e6b4b0d3255bfef95601890afd80709"}, toStrings(Sha1(xs, newResult(2))))
+	require.Equal(t, []uint32{3259397556}, Crc32(makeBytes("MySQL"), make([]uint32, 1)))
+
+	nsp := new(nulls.Nulls)
+	rs := Sha2(makeBytes("abc"), []int64{256, 224, 0, 100}, newResult(4), nsp)
+	require.Equal(t, "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad", string(rs.Get(0)))
+	require.Equal(t, "23097d223405d8228642a477bda255b32aadbce4bda0b3f7e36c9da7", string(rs.Get(1)))
+	require.Equal(t, string(rs.Get(0)), string(rs.Get(2)))
+	require.True(t, nulls.Contains(nsp, 3))
+}
+
+func TestAes(t *testing.T) {
+	// the known answers of FIPS-197 and NIST SP 800-38A
+	key := makeBytes(unhex(t, "000102030405060708090a0b0c0d0e0f"))
+	rs, err := AesEncrypt("aes-128-ecb", makeBytes(unhex(t, "00112233445566778899aabbccddeeff")), key, nil, newResult(1), new(nulls.Nulls))
+	require.NoError(t, err)
+	require.Equal(t, "69c4e0d86a7b0430d8cdb78070b4c55a", hex.EncodeToString(rs.Get(0))[:32])
+
+	key = makeBytes(unhex(t, "2b7e151628aed2a6abf7158809cf4f3c"))
+	iv := makeBytes(unhex(t, "000102030405060708090a0b0c0d0e0f"))
+	rs, err = AesEncrypt("aes-128-cfb8", makeBytes(unhex(t, "6bc1bee22e409f96e93d7e117393172aae2d")), key, iv, newResult(1), new(nulls.Nulls))
+	require.NoError(t, err)
+	require.Equal(t, "3b79424c9c0dd436bace9e0ed4586a4f32b9", hex.EncodeToString(rs.Get(0)))
+	rs, err = AesEncrypt("aes-128-cfb1", makeBytes(unhex(t, "6bc1")), key, iv, newResult(1), new(nulls.Nulls))
+	require.NoError(t, err)
+	require.Equal(t, "68b3", hex.EncodeToString(rs.Get(0)))
+
+	// every mode decrypts what it encrypts
+	xs := makeBytes("", "a", "0123456789abcdef", "the quick brown fox jumps over the lazy dog")
+	keys := makeBytes("a secret key longer than thirty-two bytes")
+	ivs := makeBytes("1234567890abcdef-ignored")
+	for _, mode := range []string{"aes-128-ecb", "aes-192-cbc", "aes-256-cfb1", "aes-128-cfb8", "aes-192-cfb128", "AES-256-OFB"} {
+		encrypted, err := AesEncrypt(mode, xs, keys, ivs, newResult(4), new(nulls.Nulls))
+		require.NoError(t, err)
+		decrypted, err := AesDecrypt(mode, encrypted, keys, ivs, newResult(4), new(nulls.Nulls))
+		require.NoError(t, err)
+		require.Equal(t, toStrings(xs), toStrings(decrypted), mode)
+	}
+
+	// a wrong key breaks the padding
+	encrypted, err := AesEncrypt("", makeBytes("abc"), makeBytes("key"), nil, newResult(1), new(nulls.Nulls))
+	require.NoError(t, err)
+	nsp := new(nulls.Nulls)
+	_, err = AesDecrypt("", encrypted, makeBytes("another key"), nil, newResult(1), nsp)
+	require.NoError(t, err)
+	require.True(t, nulls.Contains(nsp, 0))
+
+	_, err = AesEncrypt("aes-128-cbc", xs, keys, nil, newResult(4), new(nulls.Nulls))
+	require.Error(t, err)
+	_, err = AesEncrypt("aes-128-cbc", xs, keys, makeBytes("short"), newResult(4), new(nulls.Nulls))
+	require.Error(t, err)
+	_, err = AesEncrypt("des-128-ecb", xs, keys, nil, newResult(4), new(nulls.Nulls))
+	require.Error(t, err)
+}
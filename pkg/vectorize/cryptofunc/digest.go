@@ -0,0 +1,111 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptofunc
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"hash"
+	"hash/crc32"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// The functions work on the columns of the same row count as the result,
+// a column with the only one value is taken as the constant for all the rows.
+// The rows in the nsp are skipped, and the rows get null are added to the nsp.
+
+func index(n, i int) int64 {
+	if n == 1 {
+		return 0
+	}
+	return int64(i)
+}
+
+func appendResult(rs *types.Bytes, i int, v []byte) {
+	rs.Offsets[i] = uint32(len(rs.Data))
+	rs.Data = append(rs.Data, v...)
+	rs.Lengths[i] = uint32(len(v))
+}
+
+// Md5 returns the hexadecimal strings of the md5 digests
+func Md5(xs, rs *types.Bytes) *types.Bytes {
+	return digest(md5.New(), xs, rs)
+}
+
+// Sha1 returns the hexadecimal strings of the sha1 digests
+func Sha1(xs, rs *types.Bytes) *types.Bytes {
+	return digest(sha1.New(), xs, rs)
+}
+
+// Sha2 returns the hexadecimal strings of the sha2 digests of the bit lengths,
+// which are 224, 256, 384, 512 or 0 for 256, the other bit lengths get null
+func Sha2(xs *types.Bytes, bits []int64, rs *types.Bytes, nsp *nulls.Nulls) *types.Bytes {
+	hs := make(map[int64]hash.Hash)
+	for i := range rs.Lengths {
+		rs.Offsets[i] = uint32(len(rs.Data))
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		bit := bits[index(len(bits), i)]
+		h, ok := hs[bit]
+		if !ok {
+			switch bit {
+			case 224:
+				h = sha256.New224()
+			case 0, 256:
+				h = sha256.New()
+			case 384:
+				h = sha512.New384()
+			case 512:
+				h = sha512.New()
+			default:
+				nulls.Add(nsp, uint64(i))
+				continue
+			}
+			hs[bit] = h
+		}
+		appendResult(rs, i, hexSum(h, xs.Get(index(len(xs.Lengths), i))))
+	}
+	return rs
+}
+
+// Crc32 returns the cyclic redundancy check values of the IEEE polynomial
+func Crc32(xs *types.Bytes, rs []uint32) []uint32 {
+	for i := range rs {
+		rs[i] = crc32.ChecksumIEEE(xs.Get(index(len(xs.Lengths), i)))
+	}
+	return rs
+}
+
+func digest(h hash.Hash, xs, rs *types.Bytes) *types.Bytes {
+	for i := range rs.Lengths {
+		appendResult(rs, i, hexSum(h, xs.Get(index(len(xs.Lengths), i))))
+	}
+	return rs
+}
+
+func hexSum(h hash.Hash, x []byte) []byte {
+	h.Reset()
+	h.Write(x)
+	sum := h.Sum(nil)
+	v := make([]byte, hex.EncodedLen(len(sum)))
+	hex.Encode(v, sum)
+	return v
+}
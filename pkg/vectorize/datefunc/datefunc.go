@@ -0,0 +1,190 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datefunc
+
+import (
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// the vectorized date and time functions, a slice of length 1 is a constant in the binary functions
+
+func DatetimeHour(xs []types.Datetime, rs []uint8) []uint8 {
+	for i, x := range xs {
+		rs[i] = uint8(x.Hour())
+	}
+	return rs
+}
+
+func DatetimeMinute(xs []types.Datetime, rs []uint8) []uint8 {
+	for i, x := range xs {
+		rs[i] = uint8(x.Minute())
+	}
+	return rs
+}
+
+func DatetimeSecond(xs []types.Datetime, rs []uint8) []uint8 {
+	for i, x := range xs {
+		rs[i] = uint8(x.Sec())
+	}
+	return rs
+}
+
+func TimeHour(xs []types.Time, rs []int64) []int64 {
+	for i, x := range xs {
+		_, rs[i], _, _, _ = x.Clock()
+	}
+	return rs
+}
+
+func TimeMinute(xs []types.Time, rs []uint8) []uint8 {
+	for i, x := range xs {
+		_, _, minute, _, _ := x.Clock()
+		rs[i] = uint8(minute)
+	}
+	return rs
+}
+
+func TimeSecond(xs []types.Time, rs []uint8) []uint8 {
+	for i, x := range xs {
+		_, _, _, sec, _ := x.Clock()
+		rs[i] = uint8(sec)
+	}
+	return rs
+}
+
+func DateQuarter(xs []types.Date, rs []uint8) []uint8 {
+	for i, x := range xs {
+		rs[i] = uint8(x.Quarter())
+	}
+	return rs
+}
+
+func DatetimeQuarter(xs []types.Datetime, rs []uint8) []uint8 {
+	for i, x := range xs {
+		rs[i] = uint8(x.ToDate().Quarter())
+	}
+	return rs
+}
+
+func LastDay(xs []types.Date, rs []types.Date) []types.Date {
+	for i, x := range xs {
+		rs[i] = x.LastDayOfMonth()
+	}
+	return rs
+}
+
+// DateDiff returns the days from ys to xs
+func DateDiff(xs, ys []types.Date, rs []int64) []int64 {
+	for i := range rs {
+		x, y := xs[0], ys[0]
+		if len(xs) > 1 {
+			x = xs[i]
+		}
+		if len(ys) > 1 {
+			y = ys[i]
+		}
+		rs[i] = int64(x) - int64(y)
+	}
+	return rs
+}
+
+// TimestampDiff returns ys - xs in the unit its
+func TimestampDiff(its types.IntervalType, xs, ys []types.Datetime, rs []int64) ([]int64, error) {
+	for i := range rs {
+		x, y := xs[0], ys[0]
+		if len(xs) > 1 {
+			x = xs[i]
+		}
+		if len(ys) > 1 {
+			y = ys[i]
+		}
+		r, ok := types.TimestampDiff(its, x, y)
+		if !ok {
+			return nil, moerr.NewError(moerr.INVALID_ARGUMENT, "invalid unit "+its.String()+" of timestampdiff")
+		}
+		rs[i] = r
+	}
+	return rs, nil
+}
+
+// MakeDate returns the dates of the days of the years, the invalid dates are null
+func MakeDate(years, ydays []int64, rs []types.Date, nsp *nulls.Nulls) []types.Date {
+	for i := range rs {
+		year, yday := years[0], ydays[0]
+		if len(years) > 1 {
+			year = years[i]
+		}
+		if len(ydays) > 1 {
+			yday = ydays[i]
+		}
+		d, ok := types.MakeDate(year, yday)
+		if !ok {
+			nulls.Add(nsp, uint64(i))
+		}
+		rs[i] = d
+	}
+	return rs
+}
+
+// StrToDate parses the strings by the formats, the strings which do not match the formats are null
+func StrToDate(xs, formats *types.Bytes, rs []types.Datetime, nsp *nulls.Nulls) []types.Datetime {
+	for i := range rs {
+		s, format := string(index(xs, i)), string(index(formats, i))
+		dt, ok := types.ParseDatetimeByFormat(s, format)
+		if !ok {
+			nulls.Add(nsp, uint64(i))
+		}
+		rs[i] = dt
+	}
+	return rs
+}
+
+// ConvertTz converts the datetimes from the time zones froms to the time zones tos,
+// the results of the invalid time zones are null
+func ConvertTz(xs []types.Datetime, froms, tos *types.Bytes, rs []types.Datetime, nsp *nulls.Nulls) []types.Datetime {
+	zones := make(map[string]*time.Location)
+	zone := func(name string) *time.Location {
+		loc, ok := zones[name]
+		if !ok {
+			loc, _ = types.ParseTimeZone(name)
+			zones[name] = loc
+		}
+		return loc
+	}
+	for i := range rs {
+		x := xs[0]
+		if len(xs) > 1 {
+			x = xs[i]
+		}
+		from, to := zone(string(index(froms, i))), zone(string(index(tos, i)))
+		if from == nil || to == nil {
+			nulls.Add(nsp, uint64(i))
+			continue
+		}
+		rs[i] = x.ConvertTimeZone(from, to)
+	}
+	return rs
+}
+
+func index(xs *types.Bytes, i int) []byte {
+	if len(xs.Lengths) == 1 {
+		i = 0
+	}
+	return xs.Get(int64(i))
+}
@@ -0,0 +1,77 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hex
+
+import (
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// HexString returns the upper-case hexadecimal strings of the bytes
+func HexString(xs *types.Bytes, rs *types.Bytes) *types.Bytes {
+	for i := range xs.Lengths {
+		rs.Offsets[i] = uint32(len(rs.Data))
+		s := strings.ToUpper(hex.EncodeToString(xs.Get(int64(i))))
+		rs.Data = append(rs.Data, s...)
+		rs.Lengths[i] = uint32(len(s))
+	}
+	return rs
+}
+
+// HexInt64 returns the upper-case hexadecimal strings of the integers, the negative is taken as the uint64
+func HexInt64(xs []int64, rs *types.Bytes) *types.Bytes {
+	for i, x := range xs {
+		appendHexUint64(uint64(x), i, rs)
+	}
+	return rs
+}
+
+// HexUint64 returns the upper-case hexadecimal strings of the unsigned integers
+func HexUint64(xs []uint64, rs *types.Bytes) *types.Bytes {
+	for i, x := range xs {
+		appendHexUint64(x, i, rs)
+	}
+	return rs
+}
+
+func appendHexUint64(x uint64, i int, rs *types.Bytes) {
+	rs.Offsets[i] = uint32(len(rs.Data))
+	s := strings.ToUpper(strconv.FormatUint(x, 16))
+	rs.Data = append(rs.Data, s...)
+	rs.Lengths[i] = uint32(len(s))
+}
+
+// Unhex returns the bytes of the hexadecimal strings, the invalid string is null
+func Unhex(xs *types.Bytes, rs *types.Bytes, nsp *nulls.Nulls) *types.Bytes {
+	for i := range xs.Lengths {
+		rs.Offsets[i] = uint32(len(rs.Data))
+		x := xs.Get(int64(i))
+		if len(x)%2 == 1 {
+			x = append([]byte{'0'}, x...)
+		}
+		data := make([]byte, len(x)/2)
+		if _, err := hex.Decode(data, x); err != nil {
+			nulls.Add(nsp, uint64(i))
+			continue
+		}
+		rs.Data = append(rs.Data, data...)
+		rs.Lengths[i] = uint32(len(data))
+	}
+	return rs
+}
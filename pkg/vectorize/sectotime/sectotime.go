@@ -0,0 +1,42 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sectotime
+
+import "github.com/matrixorigin/matrixone/pkg/container/types"
+
+// vectorize sec_to_time function
+var (
+	SecToTime func([]int64, []types.Time) []types.Time
+)
+
+func init() {
+	SecToTime = secToTime
+}
+
+// the result is truncated to the range of the Time like mysql
+func secToTime(xs []int64, rs []types.Time) []types.Time {
+	const maxSecs = int64(types.MaxTime) / 1000000
+	for i, x := range xs {
+		switch {
+		case x > maxSecs:
+			rs[i] = types.MaxTime.Round(0)
+		case x < -maxSecs:
+			rs[i] = types.MinTime.Round(0)
+		default:
+			rs[i] = types.Time(x * 1000000)
+		}
+	}
+	return rs
+}
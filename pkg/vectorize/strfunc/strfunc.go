@@ -0,0 +1,379 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfunc
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// The string functions work on the columns of the same row count as the result,
+// a column with the only one value is taken as the constant for all the rows.
+// The rows in the nsp are skipped, and the rows get null are added to the nsp.
+// The lengths and the positions are counted in the utf-8 characters.
+
+// MaxRepeatLength is the max length of the result of repeat, the longer result gets null
+const MaxRepeatLength = 16 * 1024 * 1024
+
+func index(n, i int) int64 {
+	if n == 1 {
+		return 0
+	}
+	return int64(i)
+}
+
+func appendResult(rs *types.Bytes, i int, v []byte) {
+	rs.Offsets[i] = uint32(len(rs.Data))
+	rs.Data = append(rs.Data, v...)
+	rs.Lengths[i] = uint32(len(v))
+}
+
+// charsOffset returns the byte offset after the first n characters of s, and whether s has n characters
+func charsOffset(s []byte, n int64) (int, bool) {
+	offset := 0
+	for ; n > 0; n-- {
+		if offset >= len(s) {
+			return offset, false
+		}
+		_, size := utf8.DecodeRune(s[offset:])
+		offset += size
+	}
+	return offset, true
+}
+
+func Upper(xs, rs *types.Bytes) *types.Bytes {
+	for i := range rs.Lengths {
+		appendResult(rs, i, bytes.ToUpper(xs.Get(index(len(xs.Lengths), i))))
+	}
+	return rs
+}
+
+func Lower(xs, rs *types.Bytes) *types.Bytes {
+	for i := range rs.Lengths {
+		appendResult(rs, i, bytes.ToLower(xs.Get(index(len(xs.Lengths), i))))
+	}
+	return rs
+}
+
+// Ascii returns the code of the leftmost byte, or 0 for the empty string
+func Ascii(xs *types.Bytes, rs []int64) []int64 {
+	for i := range rs {
+		if x := xs.Get(index(len(xs.Lengths), i)); len(x) > 0 {
+			rs[i] = int64(x[0])
+		} else {
+			rs[i] = 0
+		}
+	}
+	return rs
+}
+
+// Chr returns the character of the unicode code point, the invalid code point gets null
+func Chr(xs []int64, rs *types.Bytes, nsp *nulls.Nulls) *types.Bytes {
+	var buf [utf8.UTFMax]byte
+	for i := range rs.Lengths {
+		rs.Offsets[i] = uint32(len(rs.Data))
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		x := xs[index(len(xs), i)]
+		if x < 0 || x > utf8.MaxRune || !utf8.ValidRune(rune(x)) {
+			nulls.Add(nsp, uint64(i))
+			continue
+		}
+		n := utf8.EncodeRune(buf[:], rune(x))
+		appendResult(rs, i, buf[:n])
+	}
+	return rs
+}
+
+func Base64Encode(xs, rs *types.Bytes) *types.Bytes {
+	for i := range rs.Lengths {
+		x := xs.Get(index(len(xs.Lengths), i))
+		appendResult(rs, i, []byte(base64.StdEncoding.EncodeToString(x)))
+	}
+	return rs
+}
+
+// Base64Decode returns the bytes of the base64 strings, the invalid string gets null
+func Base64Decode(xs, rs *types.Bytes, nsp *nulls.Nulls) *types.Bytes {
+	for i := range rs.Lengths {
+		rs.Offsets[i] = uint32(len(rs.Data))
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		x := xs.Get(index(len(xs.Lengths), i))
+		data := make([]byte, base64.StdEncoding.DecodedLen(len(x)))
+		n, err := base64.StdEncoding.Decode(data, x)
+		if err != nil {
+			nulls.Add(nsp, uint64(i))
+			continue
+		}
+		appendResult(rs, i, data[:n])
+	}
+	return rs
+}
+
+// Trim removes the remstr from the both, leading or trailing side of the string as many times as it occurs
+func Trim(directions, remstrs, xs, rs *types.Bytes, nsp *nulls.Nulls) (*types.Bytes, error) {
+	for i := range rs.Lengths {
+		rs.Offsets[i] = uint32(len(rs.Data))
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		direction := strings.ToLower(string(directions.Get(index(len(directions.Lengths), i))))
+		remstr := remstrs.Get(index(len(remstrs.Lengths), i))
+		x := xs.Get(index(len(xs.Lengths), i))
+		leading, trailing := false, false
+		switch direction {
+		case "both":
+			leading, trailing = true, true
+		case "leading":
+			leading = true
+		case "trailing":
+			trailing = true
+		default:
+			return nil, moerr.NewError(moerr.INVALID_ARGUMENT, "invalid trim direction '"+direction+"'")
+		}
+		if len(remstr) > 0 {
+			for leading && bytes.HasPrefix(x, remstr) {
+				x = x[len(remstr):]
+			}
+			for trailing && bytes.HasSuffix(x, remstr) {
+				x = x[:len(x)-len(remstr)]
+			}
+		}
+		appendResult(rs, i, x)
+	}
+	return rs, nil
+}
+
+// Replace replaces all the occurrences of from with to
+func Replace(xs, froms, tos, rs *types.Bytes, nsp *nulls.Nulls) *types.Bytes {
+	for i := range rs.Lengths {
+		rs.Offsets[i] = uint32(len(rs.Data))
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		x := xs.Get(index(len(xs.Lengths), i))
+		from := froms.Get(index(len(froms.Lengths), i))
+		if len(from) == 0 {
+			appendResult(rs, i, x)
+			continue
+		}
+		appendResult(rs, i, bytes.ReplaceAll(x, from, tos.Get(index(len(tos.Lengths), i))))
+	}
+	return rs
+}
+
+// Left returns the leftmost n characters
+func Left(xs *types.Bytes, ns []int64, rs *types.Bytes, nsp *nulls.Nulls) *types.Bytes {
+	for i := range rs.Lengths {
+		rs.Offsets[i] = uint32(len(rs.Data))
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		x := xs.Get(index(len(xs.Lengths), i))
+		offset, _ := charsOffset(x, ns[index(len(ns), i)])
+		appendResult(rs, i, x[:offset])
+	}
+	return rs
+}
+
+// Right returns the rightmost n characters
+func Right(xs *types.Bytes, ns []int64, rs *types.Bytes, nsp *nulls.Nulls) *types.Bytes {
+	for i := range rs.Lengths {
+		rs.Offsets[i] = uint32(len(rs.Data))
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		x := xs.Get(index(len(xs.Lengths), i))
+		n := ns[index(len(ns), i)]
+		if n <= 0 {
+			continue
+		}
+		count := int64(utf8.RuneCount(x))
+		if n >= count {
+			appendResult(rs, i, x)
+			continue
+		}
+		offset, _ := charsOffset(x, count-n)
+		appendResult(rs, i, x[offset:])
+	}
+	return rs
+}
+
+// Repeat returns the string repeated n times, the result longer than MaxRepeatLength gets null
+func Repeat(xs *types.Bytes, ns []int64, rs *types.Bytes, nsp *nulls.Nulls) *types.Bytes {
+	for i := range rs.Lengths {
+		rs.Offsets[i] = uint32(len(rs.Data))
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		x := xs.Get(index(len(xs.Lengths), i))
+		n := ns[index(len(ns), i)]
+		if n <= 0 || len(x) == 0 {
+			continue
+		}
+		if n > MaxRepeatLength/int64(len(x)) {
+			nulls.Add(nsp, uint64(i))
+			continue
+		}
+		appendResult(rs, i, bytes.Repeat(x, int(n)))
+	}
+	return rs
+}
+
+// Position returns the character position of the first occurrence of substr in the string
+// from the start position, or 0 if it is not found. The starts is nil to search from the beginning.
+func Position(substrs, xs *types.Bytes, starts []int64, rs []int64, nsp *nulls.Nulls) []int64 {
+	for i := range rs {
+		rs[i] = 0
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		substr := substrs.Get(index(len(substrs.Lengths), i))
+		x := xs.Get(index(len(xs.Lengths), i))
+		start := int64(1)
+		if starts != nil {
+			start = starts[index(len(starts), i)]
+		}
+		if start < 1 {
+			continue
+		}
+		offset, ok := charsOffset(x, start-1)
+		if !ok {
+			continue
+		}
+		if pos := bytes.Index(x[offset:], substr); pos >= 0 {
+			rs[i] = start + int64(utf8.RuneCount(x[offset:offset+pos]))
+		}
+	}
+	return rs
+}
+
+// Translate replaces each character of the string found in from with the character at the same position in to,
+// the character without the counterpart in to is removed
+func Translate(xs, froms, tos, rs *types.Bytes, nsp *nulls.Nulls) *types.Bytes {
+	var buf []byte
+	for i := range rs.Lengths {
+		rs.Offsets[i] = uint32(len(rs.Data))
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		x := xs.Get(index(len(xs.Lengths), i))
+		from := []rune(string(froms.Get(index(len(froms.Lengths), i))))
+		to := []rune(string(tos.Get(index(len(tos.Lengths), i))))
+		mapping := make(map[rune]int, len(from))
+		for j, r := range from {
+			if _, ok := mapping[r]; !ok {
+				mapping[r] = j
+			}
+		}
+		buf = buf[:0]
+		for _, r := range string(x) {
+			j, ok := mapping[r]
+			if !ok {
+				buf = utf8.AppendRune(buf, r)
+			} else if j < len(to) {
+				buf = utf8.AppendRune(buf, to[j])
+			}
+		}
+		appendResult(rs, i, buf)
+	}
+	return rs
+}
+
+// Split returns the nth field of the string split by the delimiter, the negative n counts from the end.
+// The field out of range is the empty string, and the zero n gets null.
+func Split(xs, delims *types.Bytes, ns []int64, rs *types.Bytes, nsp *nulls.Nulls) *types.Bytes {
+	for i := range rs.Lengths {
+		rs.Offsets[i] = uint32(len(rs.Data))
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		x := xs.Get(index(len(xs.Lengths), i))
+		delim := delims.Get(index(len(delims.Lengths), i))
+		n := ns[index(len(ns), i)]
+		if n == 0 {
+			nulls.Add(nsp, uint64(i))
+			continue
+		}
+		fields := [][]byte{x}
+		if len(delim) > 0 {
+			fields = bytes.Split(x, delim)
+		}
+		if n < 0 {
+			n += int64(len(fields)) + 1
+		}
+		if n >= 1 && n <= int64(len(fields)) {
+			appendResult(rs, i, fields[n-1])
+		}
+	}
+	return rs
+}
+
+// SubstringIndex returns the substring before the count-th occurrence of the delimiter,
+// the negative count counts from the right and returns the substring after it
+func SubstringIndex(xs, delims *types.Bytes, counts []int64, rs *types.Bytes, nsp *nulls.Nulls) *types.Bytes {
+	for i := range rs.Lengths {
+		rs.Offsets[i] = uint32(len(rs.Data))
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		x := xs.Get(index(len(xs.Lengths), i))
+		delim := delims.Get(index(len(delims.Lengths), i))
+		count := counts[index(len(counts), i)]
+		if count == 0 || len(delim) == 0 {
+			continue
+		}
+		if count > 0 {
+			end := 0
+			for ; count > 0; count-- {
+				pos := bytes.Index(x[end:], delim)
+				if pos < 0 {
+					end = len(x)
+					break
+				}
+				end += pos
+				if count > 1 {
+					end += len(delim)
+				}
+			}
+			appendResult(rs, i, x[:end])
+		} else {
+			start := len(x)
+			for ; count < 0; count++ {
+				pos := bytes.LastIndex(x[:start], delim)
+				if pos < 0 {
+					break
+				}
+				start = pos
+			}
+			if count < 0 {
+				appendResult(rs, i, x)
+				continue
+			}
+			start += len(delim)
+			appendResult(rs, i, x[start:])
+		}
+	}
+	return rs
+}
@@ -0,0 +1,123 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfunc
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func makeBytes(strs ...string) *types.Bytes {
+	xs := &types.Bytes{
+		Offsets: make([]uint32, len(strs)),
+		Lengths: make([]uint32, len(strs)),
+	}
+	for i, s := range strs {
+		xs.Offsets[i] = uint32(len(xs.Data))
+		xs.Data = append(xs.Data, s...)
+		xs.Lengths[i] = uint32(len(s))
+	}
+	return xs
+}
+
+func newResult(n int) *types.Bytes {
+	return &types.Bytes{
+		Offsets: make([]uint32, n),
+		Lengths: make([]uint32, n),
+	}
+}
+
+func toStrings(rs *types.Bytes) []string {
+	strs := make([]string, len(rs.Lengths))
+	for i := range strs {
+		strs[i] = string(rs.Get(int64(i)))
+	}
+	return strs
+}
+
+func TestCaseAndCode(t *testing.T) {
+	xs := makeBytes("abc", "Ábc", "你好", "")
+	require.Equal(t, []string{"ABC", "ÁBC", "你好", ""}, toStrings(Upper(xs, newResult(4))))
+	require.Equal(t, []string{"abc", "ábc", "你好", ""}, toStrings(Lower(makeBytes("ABC", "ÁBC", "你好", ""), newResult(4))))
+	require.Equal(t, []int64{97, 195, 228, 0}, Ascii(xs, make([]int64, 4)))
+
+	nsp := new(nulls.Nulls)
+	rs := Chr([]int64{65, 0x4f60, -1, 0xd800}, newResult(4), nsp)
+	require.Equal(t, []string{"A", "你"}, toStrings(rs)[:2])
+	require.True(t, nulls.Contains(nsp, 2))
+	require.True(t, nulls.Contains(nsp, 3))
+}
+
+func TestBase64(t *testing.T) {
+	rs := Base64Encode(makeBytes("abc", "你好", ""), newResult(3))
+	require.Equal(t, []string{"YWJj", "5L2g5aW9", ""}, toStrings(rs))
+	nsp := new(nulls.Nulls)
+	rs = Base64Decode(makeBytes("YWJj", "5L2g5aW9", "!!"), newResult(3), nsp)
+	require.Equal(t, []string{"abc", "你好", ""}, toStrings(rs))
+	require.True(t, nulls.Contains(nsp, 2))
+}
+
+func TestTrimAndReplace(t *testing.T) {
+	xs := makeBytes("xxaxx", "xaxbx", "a")
+	rs, err := Trim(makeBytes("both"), makeBytes("x"), xs, newResult(3), new(nulls.Nulls))
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "axb", "a"}, toStrings(rs))
+	rs, err = Trim(makeBytes("LEADING", "trailing", "both"), makeBytes("xx", "x", ""), xs, newResult(3), new(nulls.Nulls))
+	require.NoError(t, err)
+	require.Equal(t, []string{"axx", "xaxb", "a"}, toStrings(rs))
+	_, err = Trim(makeBytes("middle"), makeBytes(" "), xs, newResult(3), new(nulls.Nulls))
+	require.Error(t, err)
+
+	rs = Replace(makeBytes("aXbXc", "你好你"), makeBytes("X", "你"), makeBytes("--"), newResult(2), new(nulls.Nulls))
+	require.Equal(t, []string{"a--b--c", "--好--"}, toStrings(rs))
+	rs = Replace(makeBytes("abc"), makeBytes(""), makeBytes("x"), newResult(1), new(nulls.Nulls))
+	require.Equal(t, []string{"abc"}, toStrings(rs))
+}
+
+func TestLeftRightRepeat(t *testing.T) {
+	xs := makeBytes("你好世界")
+	require.Equal(t, []string{"", "你", "你好世界", ""}, toStrings(Left(xs, []int64{0, 1, 10, -1}, newResult(4), new(nulls.Nulls))))
+	require.Equal(t, []string{"", "界", "你好世界", ""}, toStrings(Right(xs, []int64{0, 1, 10, -1}, newResult(4), new(nulls.Nulls))))
+
+	nsp := new(nulls.Nulls)
+	rs := Repeat(makeBytes("ab"), []int64{3, 0, MaxRepeatLength}, newResult(3), nsp)
+	require.Equal(t, []string{"ababab", ""}, toStrings(rs)[:2])
+	require.True(t, nulls.Contains(nsp, 2))
+}
+
+func TestPosition(t *testing.T) {
+	substrs := makeBytes("好", "x", "", "a", "a")
+	xs := makeBytes("你好你好", "abc", "abc", "abca", "abca")
+	require.Equal(t, []int64{2, 0, 1, 1, 1}, Position(substrs, xs, nil, make([]int64, 5), new(nulls.Nulls)))
+	require.Equal(t, []int64{4, 0, 4, 4, 0}, Position(substrs, xs, []int64{3, 1, 4, 2, 0}, make([]int64, 5), new(nulls.Nulls)))
+}
+
+func TestTranslate(t *testing.T) {
+	rs := Translate(makeBytes("12345", "你好"), makeBytes("143", "好"), makeBytes("ax", "们"), newResult(2), new(nulls.Nulls))
+	require.Equal(t, []string{"a2x5", "你们"}, toStrings(rs))
+}
+
+func TestSplitAndSubstringIndex(t *testing.T) {
+	nsp := new(nulls.Nulls)
+	rs := Split(makeBytes("a,b,,c"), makeBytes(","), []int64{2, 3, -1, 5, 0}, newResult(5), nsp)
+	require.Equal(t, []string{"b", "", "c", ""}, toStrings(rs)[:4])
+	require.True(t, nulls.Contains(nsp, 4))
+
+	rs = SubstringIndex(makeBytes("www.mysql.com"), makeBytes("."), []int64{2, -2, 1, -1, 5, -5, 0}, newResult(7), new(nulls.Nulls))
+	require.Equal(t, []string{"www.mysql", "mysql.com", "www", "com", "www.mysql.com", "www.mysql.com", ""}, toStrings(rs))
+}
@@ -0,0 +1,56 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timediff
+
+import "github.com/matrixorigin/matrixone/pkg/container/types"
+
+// vectorize timediff function, a slice of length 1 is a constant
+var (
+	TimeDiff     func([]types.Time, []types.Time, []types.Time) []types.Time
+	DatetimeDiff func([]types.Datetime, []types.Datetime, []types.Time) []types.Time
+)
+
+func init() {
+	TimeDiff = timeDiff
+	DatetimeDiff = datetimeDiff
+}
+
+func timeDiff(xs, ys, rs []types.Time) []types.Time {
+	for i := range rs {
+		x, y := xs[0], ys[0]
+		if len(xs) > 1 {
+			x = xs[i]
+		}
+		if len(ys) > 1 {
+			y = ys[i]
+		}
+		rs[i] = types.TruncateTime(int64(x) - int64(y))
+	}
+	return rs
+}
+
+func datetimeDiff(xs, ys []types.Datetime, rs []types.Time) []types.Time {
+	for i := range rs {
+		x, y := xs[0], ys[0]
+		if len(xs) > 1 {
+			x = xs[i]
+		}
+		if len(ys) > 1 {
+			y = ys[i]
+		}
+		rs[i] = types.TruncateTime(int64(x) - int64(y))
+	}
+	return rs
+}
@@ -97,6 +97,9 @@ func (e *Engine) Nodes() (engine.Nodes, error) {
 
 	var nodes engine.Nodes
 	for _, store := range clusterDetails.CNStores {
+		if store.State != logservice.NormalState {
+			continue
+		}
 		nodes = append(nodes, engine.Node{
 			Mcpu: 1,
 			Id:   store.UUID,
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine/kv"
@@ -88,6 +89,7 @@ func (e *MemEngine) Resolve(_ string, tableName string) (*plan.ObjectRef, *plan.
 				Width: attr.Attr.Type.Width,
 				Size:  attr.Attr.Type.Size,
 			},
+			Generated: attr.Attr.Generated,
 		})
 	}
 	return &plan.ObjectRef{SchemaName: schemaName, ObjName: schemaName}, &plan.TableDef{Name: tableName, Cols: cols}
@@ -113,6 +115,10 @@ func (e *MemEngine) GetRootSql() string {
 	return ""
 }
 
+func (e *MemEngine) ResolveUdf(_ string, _ string) (*function.Udf, error) {
+	return nil, nil
+}
+
 func (e *MemEngine) Hints() (h engine.Hints) {
 	h.CommitOrRollbackTimeout = time.Second
 	return
@@ -15,12 +15,15 @@
 package catalog
 
 import (
+	"bytes"
 	"fmt"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
@@ -59,6 +62,47 @@ func TestCompoundPKSchema(t *testing.T) {
 	assert.ErrorIs(t, err, ErrSchemaValidation)
 }
 
+func TestGeneratedColumnSchema(t *testing.T) {
+	schema := NewEmptySchema(t.Name())
+	err := schema.AppendPKColWithAttribute(engine.Attribute{
+		Name:          "a",
+		Type:          types.T_int64.ToType(),
+		Default:       &plan.Default{},
+		AutoIncrement: true,
+	}, 0)
+	assert.NoError(t, err)
+	generated := &plan.GeneratedCol{
+		Expr: &plan.Expr{
+			Typ:  &plan.Type{Id: int32(types.T_int64)},
+			Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: 0}},
+		},
+		OriginString: "a",
+		Stored:       true,
+	}
+	err = schema.AppendColWithAttribute(engine.Attribute{
+		Name:      "b",
+		Type:      types.T_int64.ToType(),
+		Default:   &plan.Default{NullAbility: true},
+		Generated: generated,
+	})
+	assert.NoError(t, err)
+	err = schema.Finalize(false)
+	assert.NoError(t, err)
+
+	buf, err := schema.Marshal()
+	assert.NoError(t, err)
+	schema2 := NewEmptySchema("")
+	_, err = schema2.ReadFrom(bytes.NewReader(buf))
+	assert.NoError(t, err)
+	assert.True(t, schema2.ColDefs[0].IsAutoIncrement())
+	assert.False(t, schema2.ColDefs[0].IsGenerated())
+	assert.False(t, schema2.ColDefs[1].IsAutoIncrement())
+	assert.Equal(t, schema.ColDefs[1].Generated, schema2.ColDefs[1].Generated)
+	expr := &plan.Expr{}
+	assert.NoError(t, expr.Unmarshal(schema2.ColDefs[1].Generated.Expr))
+	assert.Equal(t, int32(0), expr.GetCol().GetColPos())
+}
+
 func TestCreateDB1(t *testing.T) {
 	dir := testutils.InitTestEnv(ModuleName, t)
 	catalog := MockCatalog(dir, "mock", nil, nil)
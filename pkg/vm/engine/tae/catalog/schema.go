@@ -49,6 +49,20 @@ type Default struct {
 	OriginString string
 }
 
+// Generated keeps the generation expression of a generated column
+type Generated struct {
+	Expr         []byte
+	OriginString string
+	Stored       bool
+}
+
+// flags of a column definition, persisted in the byte that used to
+// hold only the auto increment attribute
+const (
+	colFlagAutoIncrement = uint8(1) << iota
+	colFlagGenerated
+)
+
 func NewIndexInfo(name string, typ IndexT, colIdx ...int) *IndexInfo {
 	index := &IndexInfo{
 		Name:    name,
@@ -74,6 +88,8 @@ type ColDef struct {
 	SortKey       bool
 	Comment       string
 	Default       Default
+	EnumValues    []string
+	Generated     *Generated
 }
 
 func (def *ColDef) GetName() string     { return def.Name }
@@ -85,6 +101,7 @@ func (def *ColDef) IsPhyAddr() bool       { return def.PhyAddr }
 func (def *ColDef) IsPrimary() bool       { return def.Primary }
 func (def *ColDef) IsAutoIncrement() bool { return def.AutoIncrement }
 func (def *ColDef) IsSortKey() bool       { return def.SortKey }
+func (def *ColDef) IsGenerated() bool     { return def.Generated != nil }
 
 type SortKey struct {
 	Defs      []*ColDef
@@ -248,6 +265,43 @@ func UnMarshalDefault(r io.Reader, data *Default) (n int64, err error) {
 	return n, nil
 }
 
+func MarshalGenerated(w *bytes.Buffer, data *Generated) (err error) {
+	if err = binary.Write(w, binary.BigEndian, data.Stored); err != nil {
+		return
+	}
+	if _, err = common.WriteString(data.OriginString, w); err != nil {
+		return
+	}
+	if err = binary.Write(w, binary.BigEndian, uint32(len(data.Expr))); err != nil {
+		return
+	}
+	_, err = w.Write(data.Expr)
+	return
+}
+
+func UnMarshalGenerated(r io.Reader, data *Generated) (n int64, err error) {
+	if err = binary.Read(r, binary.BigEndian, &data.Stored); err != nil {
+		return
+	}
+	n = 1
+	var sn int64
+	if data.OriginString, sn, err = common.ReadString(r); err != nil {
+		return
+	}
+	n += sn
+	var exprLen uint32
+	if err = binary.Read(r, binary.BigEndian, &exprLen); err != nil {
+		return
+	}
+	n += 4
+	data.Expr = make([]byte, exprLen)
+	if _, err = io.ReadFull(r, data.Expr); err != nil {
+		return
+	}
+	n += int64(exprLen)
+	return n, nil
+}
+
 func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 	if err = binary.Read(r, binary.BigEndian, &s.BlockMaxRows); err != nil {
 		return
@@ -315,10 +369,12 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 			return
 		}
 		n += 1
-		if err = binary.Read(r, binary.BigEndian, &def.AutoIncrement); err != nil {
+		flags := uint8(0)
+		if err = binary.Read(r, binary.BigEndian, &flags); err != nil {
 			return
 		}
 		n += 1
+		def.AutoIncrement = flags&colFlagAutoIncrement != 0
 		if err = binary.Read(r, binary.BigEndian, &def.SortIdx); err != nil {
 			return
 		}
@@ -336,6 +392,27 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 			return
 		}
 		n += sn
+		if flags&colFlagGenerated != 0 {
+			def.Generated = new(Generated)
+			if sn, err = UnMarshalGenerated(r, def.Generated); err != nil {
+				return
+			}
+			n += sn
+		}
+		if def.Type.Oid == types.T_enum || def.Type.Oid == types.T_set {
+			valueCnt := uint16(0)
+			if err = binary.Read(r, binary.BigEndian, &valueCnt); err != nil {
+				return
+			}
+			n += 2
+			def.EnumValues = make([]string, valueCnt)
+			for j := range def.EnumValues {
+				if def.EnumValues[j], sn, err = common.ReadString(r); err != nil {
+					return
+				}
+				n += sn
+			}
+		}
 		if err = s.AppendColDef(def); err != nil {
 			return
 		}
@@ -392,7 +469,14 @@ func (s *Schema) Marshal() (buf []byte, err error) {
 		if err = binary.Write(&w, binary.BigEndian, def.PhyAddr); err != nil {
 			return
 		}
-		if err = binary.Write(&w, binary.BigEndian, def.AutoIncrement); err != nil {
+		flags := uint8(0)
+		if def.AutoIncrement {
+			flags |= colFlagAutoIncrement
+		}
+		if def.Generated != nil {
+			flags |= colFlagGenerated
+		}
+		if err = binary.Write(&w, binary.BigEndian, flags); err != nil {
 			return
 		}
 		if err = binary.Write(&w, binary.BigEndian, def.SortIdx); err != nil {
@@ -407,6 +491,21 @@ func (s *Schema) Marshal() (buf []byte, err error) {
 		if err = MarshalDefault(&w, def.Default); err != nil {
 			return
 		}
+		if def.Generated != nil {
+			if err = MarshalGenerated(&w, def.Generated); err != nil {
+				return
+			}
+		}
+		if def.Type.Oid == types.T_enum || def.Type.Oid == types.T_set {
+			if err = binary.Write(&w, binary.BigEndian, uint16(len(def.EnumValues))); err != nil {
+				return
+			}
+			for _, v := range def.EnumValues {
+				if _, err = common.WriteString(v, &w); err != nil {
+					return
+				}
+			}
+		}
 	}
 	buf = w.Bytes()
 	return
@@ -460,6 +559,10 @@ func (s *Schema) AppendPKColWithAttribute(attr engine.Attribute, idx int) error
 		Expr:         bs,
 		OriginString: attr.Default.OriginString,
 	}
+	generated, err := generatedFromAttribute(attr)
+	if err != nil {
+		return err
+	}
 	def := &ColDef{
 		Name:          attr.Name,
 		Type:          attr.Type,
@@ -470,6 +573,8 @@ func (s *Schema) AppendPKColWithAttribute(attr engine.Attribute, idx int) error
 		Comment:       attr.Comment,
 		Default:       attrDefault,
 		AutoIncrement: attr.AutoIncrement,
+		EnumValues:    attr.EnumValues,
+		Generated:     generated,
 	}
 	return s.AppendColDef(def)
 }
@@ -507,6 +612,10 @@ func (s *Schema) AppendColWithAttribute(attr engine.Attribute) error {
 		Expr:         bs,
 		OriginString: attr.Default.OriginString,
 	}
+	generated, err := generatedFromAttribute(attr)
+	if err != nil {
+		return err
+	}
 	def := &ColDef{
 		Name:          attr.Name,
 		Type:          attr.Type,
@@ -515,10 +624,27 @@ func (s *Schema) AppendColWithAttribute(attr engine.Attribute) error {
 		Comment:       attr.Comment,
 		Default:       attrDefault,
 		AutoIncrement: attr.AutoIncrement,
+		EnumValues:    attr.EnumValues,
+		Generated:     generated,
 	}
 	return s.AppendColDef(def)
 }
 
+func generatedFromAttribute(attr engine.Attribute) (*Generated, error) {
+	if attr.Generated == nil {
+		return nil, nil
+	}
+	bs, err := attr.Generated.Expr.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return &Generated{
+		Expr:         bs,
+		OriginString: attr.Generated.OriginString,
+		Stored:       attr.Generated.Stored,
+	}, nil
+}
+
 func (s *Schema) String() string {
 	buf, _ := json.Marshal(s)
 	return string(buf)
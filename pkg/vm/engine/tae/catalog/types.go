@@ -55,7 +55,7 @@ const (
 
 func EstimateColumnBlockSize(colIdx int, rows uint32, meta *BlockEntry) uint32 {
 	switch meta.GetSegment().GetTable().GetSchema().ColDefs[colIdx].Type.Oid {
-	case types.T_json, types.T_char, types.T_varchar:
+	case types.T_json, types.T_char, types.T_varchar, types.T_binary, types.T_varbinary:
 		return rows * 2 * 4
 	default:
 		return rows * uint32(meta.GetSegment().GetTable().GetSchema().ColDefs[colIdx].Type.Size)
@@ -0,0 +1,223 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDB = "cdc"
+
+func initTestDB(t *testing.T) (*db.DB, *catalog.Schema) {
+	tae, err := db.Open(t.TempDir(), config.WithLongScanAndCKPOpts(nil))
+	require.NoError(t, err)
+	schema := catalog.MockSchemaAll(4, 2)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+
+	txn, err := tae.StartTxn(nil)
+	require.NoError(t, err)
+	database, err := txn.CreateDatabase(testDB)
+	require.NoError(t, err)
+	_, err = database.CreateRelation(schema)
+	require.NoError(t, err)
+	require.NoError(t, txn.Commit())
+	return tae, schema
+}
+
+func withRelation(t *testing.T, tae *db.DB, schema *catalog.Schema, fn func(handle.Relation)) {
+	txn, err := tae.StartTxn(nil)
+	require.NoError(t, err)
+	database, err := txn.GetDatabase(testDB)
+	require.NoError(t, err)
+	rel, err := database.GetRelationByName(schema.Name)
+	require.NoError(t, err)
+	fn(rel)
+	require.NoError(t, txn.Commit())
+}
+
+func changeRows(t *testing.T, tae *db.DB, schema *catalog.Schema) {
+	bat := catalog.MockBatch(schema, 5)
+	defer bat.Close()
+	withRelation(t, tae, schema, func(rel handle.Relation) {
+		assert.NoError(t, rel.Append(bat))
+	})
+	withRelation(t, tae, schema, func(rel handle.Relation) {
+		assert.NoError(t, rel.UpdateByFilter(handle.NewEQFilter(int32(1)), 3, int64(100)))
+	})
+	withRelation(t, tae, schema, func(rel handle.Relation) {
+		assert.NoError(t, rel.DeleteByFilter(handle.NewEQFilter(int32(2))))
+	})
+}
+
+func TestSubscriber(t *testing.T) {
+	tae, schema := initTestDB(t)
+	defer tae.Close()
+	changeRows(t, tae, schema)
+
+	var txns [][]Event
+	sub, err := NewSubscriber(tae, Checkpoint{})
+	require.NoError(t, err)
+	assert.NoError(t, sub.Poll(func(events []Event) error {
+		txns = append(txns, events)
+		return nil
+	}))
+	require.Equal(t, 3, len(txns))
+
+	inserts := txns[0]
+	assert.Equal(t, 5, len(inserts))
+	for i, e := range inserts {
+		assert.Equal(t, OpInsert, e.Op)
+		assert.Equal(t, testDB, e.Database)
+		assert.Equal(t, schema.Name, e.Table)
+		assert.Nil(t, e.Before)
+		assert.Equal(t, int32(i), e.After["mock_2"])
+	}
+
+	update := txns[1]
+	require.Equal(t, 1, len(update))
+	assert.Equal(t, OpUpdate, update[0].Op)
+	assert.Equal(t, int32(1), update[0].Before["mock_2"])
+	assert.Equal(t, int32(1), update[0].After["mock_2"])
+	assert.Equal(t, int64(100), update[0].After["mock_3"])
+	assert.NotEqual(t, int64(100), update[0].Before["mock_3"])
+	assert.True(t, update[0].CommitTS.Greater(inserts[0].CommitTS))
+
+	del := txns[2]
+	require.Equal(t, 1, len(del))
+	assert.Equal(t, OpDelete, del[0].Op)
+	assert.Equal(t, int32(2), del[0].Before["mock_2"])
+	assert.Nil(t, del[0].After)
+
+	// resume from the checkpoint, only new transactions are polled
+	ckp := sub.Checkpoint()
+	assert.Equal(t, del[0].CommitTS.ToString(), ckp.TS)
+	withRelation(t, tae, schema, func(rel handle.Relation) {
+		assert.NoError(t, rel.DeleteByFilter(handle.NewEQFilter(int32(3))))
+	})
+	txns = txns[:0]
+	sub, err = NewSubscriber(tae, ckp)
+	require.NoError(t, err)
+	assert.NoError(t, sub.Poll(func(events []Event) error {
+		txns = append(txns, events)
+		return nil
+	}))
+	require.Equal(t, 1, len(txns))
+	assert.Equal(t, int32(3), txns[0][0].Before["mock_2"])
+}
+
+func TestSubscriberErrors(t *testing.T) {
+	tae, schema := initTestDB(t)
+	defer tae.Close()
+	changeRows(t, tae, schema)
+
+	// the position of a ts without lsn is unknown
+	_, err := NewSubscriber(tae, Checkpoint{TS: types.NextGlobalTsForTest().ToString()})
+	assert.ErrorIs(t, err, ErrNoStartLSN)
+
+	txn, err := tae.StartTxn(nil)
+	require.NoError(t, err)
+	database, err := txn.GetDatabase(testDB)
+	require.NoError(t, err)
+	rel, err := database.GetRelationByName(schema.Name)
+	require.NoError(t, err)
+	table := rel.GetMeta().(*catalog.TableEntry)
+	require.NoError(t, txn.Commit())
+
+	d := &txnDecoder{tae: tae}
+	// the before-image of a row of a removed block cannot be read
+	id := &common.ID{TableID: table.GetID(), SegmentID: 1 << 40, BlockID: 1 << 40}
+	_, err = d.readRow(table.GetDB().GetID(), id, table, 0)
+	assert.ErrorIs(t, err, ErrUnavailable)
+	// a table missing from the catalog is an error, not a skipped change
+	_, err = d.getTable(table.GetDB().GetID(), 1<<40)
+	assert.Error(t, err)
+}
+
+func TestFileSink(t *testing.T) {
+	tae, schema := initTestDB(t)
+	defer tae.Close()
+	changeRows(t, tae, schema)
+
+	for _, name := range []string{"debezium", "canal"} {
+		format, err := NewFormat(name)
+		require.NoError(t, err)
+		path := filepath.Join(t.TempDir(), name+".json")
+		sink, err := NewFileSink(tae, path, format)
+		require.NoError(t, err)
+		assert.NoError(t, sink.Sync())
+		assert.NoError(t, sink.Close())
+
+		ckp, err := LoadCheckpoint(path)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, ckp.TS)
+
+		lines := readLines(t, path)
+		switch name {
+		case "debezium":
+			assert.Equal(t, 7, len(lines))
+			assert.Equal(t, "c", lines[0]["op"])
+			assert.Equal(t, "u", lines[5]["op"])
+			assert.Equal(t, "d", lines[6]["op"])
+			source := lines[0]["source"].(map[string]any)
+			assert.Equal(t, testDB, source["db"])
+		case "canal":
+			assert.Equal(t, 3, len(lines))
+			assert.Equal(t, "INSERT", lines[0]["type"])
+			assert.Equal(t, 5, len(lines[0]["data"].([]any)))
+			assert.Equal(t, "UPDATE", lines[1]["type"])
+			old := lines[1]["old"].([]any)[0].(map[string]any)
+			assert.Equal(t, 1, len(old))
+			assert.Contains(t, old, "mock_3")
+			assert.Equal(t, "DELETE", lines[2]["type"])
+		}
+
+		// a reopened sink resumes from the saved checkpoint
+		sink, err = NewFileSink(tae, path, format)
+		require.NoError(t, err)
+		assert.NoError(t, sink.Sync())
+		assert.NoError(t, sink.Close())
+		assert.Equal(t, len(lines), len(readLines(t, path)))
+	}
+
+	_, err := NewFormat("avro")
+	assert.Error(t, err)
+}
+
+func readLines(t *testing.T, path string) (lines []map[string]any) {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := make(map[string]any)
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		lines = append(lines, line)
+	}
+	return
+}
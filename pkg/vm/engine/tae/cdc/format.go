@@ -0,0 +1,146 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Format encodes the events of a transaction into lines of output.
+type Format interface {
+	Name() string
+	Encode(events []Event) ([][]byte, error)
+}
+
+// NewFormat returns the format by name, debezium or canal.
+func NewFormat(name string) (Format, error) {
+	switch strings.ToLower(name) {
+	case "debezium":
+		return DebeziumFormat{}, nil
+	case "canal":
+		return CanalFormat{}, nil
+	default:
+		return nil, fmt.Errorf("tae cdc: unknown format %s", name)
+	}
+}
+
+// DebeziumFormat encodes every event into a Debezium like envelope.
+type DebeziumFormat struct{}
+
+type debeziumSource struct {
+	DB       string `json:"db"`
+	Table    string `json:"table"`
+	CommitTS string `json:"commit_ts"`
+	LSN      uint64 `json:"lsn"`
+}
+
+type debeziumEnvelope struct {
+	Before map[string]any `json:"before"`
+	After  map[string]any `json:"after"`
+	Source debeziumSource `json:"source"`
+	Op     Op             `json:"op"`
+}
+
+func (DebeziumFormat) Name() string { return "debezium" }
+
+func (DebeziumFormat) Encode(events []Event) ([][]byte, error) {
+	lines := make([][]byte, 0, len(events))
+	for _, e := range events {
+		line, err := json.Marshal(debeziumEnvelope{
+			Before: e.Before,
+			After:  e.After,
+			Source: debeziumSource{
+				DB:       e.Database,
+				Table:    e.Table,
+				CommitTS: e.CommitTS.ToString(),
+				LSN:      e.LSN,
+			},
+			Op: e.Op,
+		})
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, line)
+	}
+	return lines, nil
+}
+
+// CanalFormat encodes the events of a transaction into Canal flat messages,
+// consecutive events of the same table and op share a message.
+type CanalFormat struct{}
+
+type canalMessage struct {
+	Database string           `json:"database"`
+	Table    string           `json:"table"`
+	Type     string           `json:"type"`
+	IsDdl    bool             `json:"isDdl"`
+	TS       string           `json:"ts"`
+	Data     []map[string]any `json:"data"`
+	Old      []map[string]any `json:"old"`
+}
+
+var canalTypes = map[Op]string{
+	OpInsert: "INSERT",
+	OpUpdate: "UPDATE",
+	OpDelete: "DELETE",
+}
+
+func (CanalFormat) Name() string { return "canal" }
+
+func (CanalFormat) Encode(events []Event) ([][]byte, error) {
+	var msgs []*canalMessage
+	var last *canalMessage
+	for _, e := range events {
+		typ := canalTypes[e.Op]
+		if last == nil || last.Database != e.Database ||
+			last.Table != e.Table || last.Type != typ {
+			last = &canalMessage{
+				Database: e.Database,
+				Table:    e.Table,
+				Type:     typ,
+				TS:       e.CommitTS.ToString(),
+			}
+			msgs = append(msgs, last)
+		}
+		switch e.Op {
+		case OpInsert:
+			last.Data = append(last.Data, e.After)
+		case OpDelete:
+			last.Data = append(last.Data, e.Before)
+		case OpUpdate:
+			last.Data = append(last.Data, e.After)
+			// canal only keeps the old values of changed columns
+			old := make(map[string]any)
+			for k, v := range e.Before {
+				if fmt.Sprint(e.After[k]) != fmt.Sprint(v) {
+					old[k] = v
+				}
+			}
+			last.Old = append(last.Old, old)
+		}
+	}
+
+	lines := make([][]byte, 0, len(msgs))
+	for _, msg := range msgs {
+		line, err := json.Marshal(msg)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, line)
+	}
+	return lines, nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+)
+
+const checkpointSuffix = ".ckp"
+
+// FileSink appends encoded events to a local file and persists the
+// checkpoint of the subscriber next to it, so that it resumes after restart.
+//
+// The events of a transaction are synced before the checkpoint is saved,
+// a transaction may be output again after crash, but never lost.
+type FileSink struct {
+	path   string
+	format Format
+	file   *os.File
+	sub    *Subscriber
+}
+
+// NewFileSink opens the file sink of the TAE instance, it resumes from the
+// checkpoint saved by a previous sink on the same path.
+func NewFileSink(tae *db.DB, path string, format Format) (*FileSink, error) {
+	ckp, err := LoadCheckpoint(path)
+	if err != nil {
+		return nil, err
+	}
+	sub, err := NewSubscriber(tae, ckp)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &FileSink{
+		path:   path,
+		format: format,
+		file:   file,
+		sub:    sub,
+	}, nil
+}
+
+// Sync outputs all transactions committed since the last sync.
+func (fs *FileSink) Sync() error {
+	err := fs.sub.Poll(func(events []Event) error {
+		lines, err := fs.format.Encode(events)
+		if err != nil {
+			return err
+		}
+		for _, line := range lines {
+			if _, err = fs.file.Write(append(line, '\n')); err != nil {
+				return err
+			}
+		}
+		return fs.file.Sync()
+	})
+	if err != nil {
+		return err
+	}
+	return SaveCheckpoint(fs.path, fs.sub.Checkpoint())
+}
+
+func (fs *FileSink) Close() error {
+	return fs.file.Close()
+}
+
+// LoadCheckpoint loads the checkpoint saved for the sink path, a zero
+// checkpoint is returned if there is none.
+func LoadCheckpoint(path string) (ckp Checkpoint, err error) {
+	data, err := os.ReadFile(path + checkpointSuffix)
+	if os.IsNotExist(err) {
+		return ckp, nil
+	}
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal(data, &ckp)
+	return
+}
+
+// SaveCheckpoint saves the checkpoint for the sink path atomically.
+func SaveCheckpoint(path string, ckp Checkpoint) error {
+	data, err := json.Marshal(ckp)
+	if err != nil {
+		return err
+	}
+	tmp := path + checkpointSuffix + ".tmp"
+	if err = os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path+checkpointSuffix)
+}
@@ -0,0 +1,367 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/store"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/updates"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnimpl"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
+)
+
+// Subscriber tails committed transactions from the commit log of a TAE
+// instance. It is not safe for concurrent use.
+type Subscriber struct {
+	tae *db.DB
+	// next is the lsn of the next wal entry to decode
+	next uint64
+	// from skips transactions committed at or before it
+	from types.TS
+	last types.TS
+}
+
+// NewSubscriber creates a subscriber resuming from the checkpoint. A zero
+// checkpoint starts from the oldest wal entry not truncated yet, a checkpoint
+// with a ts must have its lsn too.
+func NewSubscriber(tae *db.DB, ckp Checkpoint) (*Subscriber, error) {
+	if ckp.LSN == 0 && ckp.TS != "" {
+		return nil, fmt.Errorf("%w: %s", ErrNoStartLSN, ckp.TS)
+	}
+	s := &Subscriber{
+		tae:  tae,
+		next: ckp.LSN,
+	}
+	if ckp.TS != "" {
+		s.from = types.StringToTS(ckp.TS)
+		s.last = s.from
+	}
+	if s.next == 0 {
+		s.next = tae.Wal.GetCheckpointed() + 1
+	}
+	return s, nil
+}
+
+// Checkpoint returns the current position of the subscriber.
+func (s *Subscriber) Checkpoint() Checkpoint {
+	ckp := Checkpoint{LSN: s.next}
+	if !s.last.IsEmpty() {
+		ckp.TS = s.last.ToString()
+	}
+	return ckp
+}
+
+// Poll decodes all transactions committed since the last poll and calls fn
+// with their row events in commit order. The checkpoint advances after fn
+// returns nil for all events of a transaction.
+func (s *Subscriber) Poll(fn func([]Event) error) error {
+	curr := s.tae.Wal.GetCurrSeqNum()
+	for ; s.next <= curr; s.next++ {
+		events, ts, err := s.decode(s.next)
+		if err != nil {
+			return err
+		}
+		if len(events) > 0 && ts.Greater(s.from) {
+			if err = fn(events); err != nil {
+				return err
+			}
+		}
+		if ts.Greater(s.last) {
+			s.last = ts
+		}
+	}
+	return nil
+}
+
+func (s *Subscriber) decode(lsn uint64) (events []Event, ts types.TS, err error) {
+	e, err := s.tae.Wal.LoadEntry(wal.GroupC, lsn)
+	if err != nil {
+		if errors.Is(err, store.ErrLsnTooSmall) {
+			err = ErrTruncated
+		}
+		return
+	}
+	defer e.Free()
+	cmd, _, err := txnbase.BuildCommandFrom(bytes.NewBuffer(e.GetPayload()))
+	if err != nil {
+		return
+	}
+	d := &txnDecoder{tae: s.tae, lsn: lsn}
+	if err = d.onCmd(cmd); err != nil {
+		return
+	}
+	if err = d.flushUpdates(); err != nil {
+		return
+	}
+	return d.events, d.ts, nil
+}
+
+type rowKey struct {
+	blk common.ID
+	row uint32
+}
+
+type txnDecoder struct {
+	tae     *db.DB
+	lsn     uint64
+	ts      types.TS
+	events  []Event
+	updates map[rowKey]map[uint16]any
+	dbIDs   map[rowKey]uint64
+}
+
+func (d *txnDecoder) onCmd(cmd txnif.TxnCmd) (err error) {
+	switch c := cmd.(type) {
+	case *txnbase.ComposedCmd:
+		for _, sub := range c.Cmds {
+			if err = d.onCmd(sub); err != nil {
+				return
+			}
+		}
+	case *txnimpl.AppendCmd:
+		err = d.onAppend(c)
+	case *updates.UpdateCmd:
+		switch c.GetType() {
+		case txnbase.CmdDelete:
+			err = d.onDelete(c)
+		case txnbase.CmdUpdate:
+			d.onUpdate(c)
+		}
+	}
+	return
+}
+
+func (d *txnDecoder) onAppend(cmd *txnimpl.AppendCmd) (err error) {
+	d.ts = cmd.Ts
+	var data *containers.Batch
+	for _, sub := range cmd.Cmds {
+		switch c := sub.(type) {
+		case *txnbase.BatchCmd:
+			data = c.Bat
+		case *txnbase.PointerCmd:
+			var e wal.LogEntry
+			if e, err = d.tae.Wal.LoadEntry(c.Group, c.Lsn); err != nil {
+				if errors.Is(err, store.ErrLsnTooSmall) {
+					err = ErrTruncated
+				}
+				return
+			}
+			var batCmd txnif.TxnCmd
+			batCmd, _, err = txnbase.BuildCommandFrom(bytes.NewBuffer(e.GetPayload()))
+			e.Free()
+			if err != nil {
+				return
+			}
+			data = batCmd.(*txnbase.BatchCmd).Bat
+		}
+	}
+	if data == nil {
+		return
+	}
+	defer data.Close()
+
+	for _, info := range cmd.Infos {
+		table, err := d.getTable(info.GetDBID(), info.GetDest().TableID)
+		if err != nil {
+			return err
+		}
+		if table == nil {
+			continue
+		}
+		start := int(info.GetSrcOff())
+		end := start + int(info.GetSrcLen())
+		for i := start; i < end; i++ {
+			if data.IsDeleted(i) {
+				continue
+			}
+			after := make(map[string]any)
+			for _, def := range table.GetSchema().ColDefs {
+				if def.IsHidden() || def.IsPhyAddr() {
+					continue
+				}
+				vec := data.GetVectorByName(def.Name)
+				if vec == nil {
+					continue
+				}
+				after[def.Name] = normalize(vec.Get(i))
+			}
+			d.events = append(d.events, d.newEvent(table, OpInsert, nil, after))
+		}
+	}
+	return
+}
+
+func (d *txnDecoder) onDelete(cmd *updates.UpdateCmd) error {
+	node := cmd.GetDeleteNode()
+	d.ts = node.GetCommitTSLocked()
+	table, err := d.getTable(cmd.GetDBID(), cmd.GetDest().TableID)
+	if err != nil || table == nil {
+		return err
+	}
+	mask := node.GetDeleteMaskLocked()
+	if mask == nil {
+		return nil
+	}
+	it := mask.Iterator()
+	for it.HasNext() {
+		row := it.Next()
+		before, err := d.readRow(cmd.GetDBID(), cmd.GetDest(), table, row)
+		if err != nil {
+			return err
+		}
+		d.events = append(d.events, d.newEvent(table, OpDelete, before, nil))
+	}
+	return nil
+}
+
+// onUpdate collects the new column values, updates of different columns of
+// a row are merged into one event by flushUpdates.
+func (d *txnDecoder) onUpdate(cmd *updates.UpdateCmd) {
+	node := cmd.GetUpdateNode()
+	d.ts = node.GetCommitTSLocked()
+	if d.updates == nil {
+		d.updates = make(map[rowKey]map[uint16]any)
+		d.dbIDs = make(map[rowKey]uint64)
+	}
+	blk := cmd.GetDest().AsBlockID()
+	col := node.GetID().Idx
+	for row, v := range node.GetValues() {
+		key := rowKey{blk: blk, row: row}
+		if d.updates[key] == nil {
+			d.updates[key] = make(map[uint16]any)
+			d.dbIDs[key] = cmd.GetDBID()
+		}
+		d.updates[key][col] = v
+	}
+}
+
+func (d *txnDecoder) flushUpdates() error {
+	keys := make([]rowKey, 0, len(d.updates))
+	for key := range d.updates {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].blk.BlockID != keys[j].blk.BlockID {
+			return keys[i].blk.BlockID < keys[j].blk.BlockID
+		}
+		return keys[i].row < keys[j].row
+	})
+	for _, key := range keys {
+		table, err := d.getTable(d.dbIDs[key], key.blk.TableID)
+		if err != nil {
+			return err
+		}
+		if table == nil {
+			continue
+		}
+		before, err := d.readRow(d.dbIDs[key], &key.blk, table, key.row)
+		if err != nil {
+			return err
+		}
+		after := make(map[string]any, len(before))
+		for k, v := range before {
+			after[k] = v
+		}
+		for col, v := range d.updates[key] {
+			after[table.GetSchema().ColDefs[col].Name] = normalize(v)
+		}
+		d.events = append(d.events, d.newEvent(table, OpUpdate, before, after))
+	}
+	return nil
+}
+
+// readRow reads the row as of the commit ts of the transaction. Rows of
+// blocks which have been compacted and removed are not readable anymore and
+// ErrUnavailable is returned.
+func (d *txnDecoder) readRow(
+	dbID uint64, id *common.ID, table *catalog.TableEntry, row uint32,
+) (map[string]any, error) {
+	database, err := d.tae.Catalog.GetDatabaseByID(dbID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: database %d: %v", ErrUnavailable, dbID, err)
+	}
+	blk, err := database.GetBlockEntryByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: block %s: %v", ErrUnavailable, id.BlockString(), err)
+	}
+	if blk.GetBlockData() == nil {
+		return nil, fmt.Errorf("%w: block %s has no data", ErrUnavailable, id.BlockString())
+	}
+	reader := txnbase.NewTxn(nil, nil, 0, d.ts.Prev(), nil)
+	values := make(map[string]any)
+	for _, def := range table.GetSchema().ColDefs {
+		if def.IsHidden() || def.IsPhyAddr() {
+			continue
+		}
+		v, err := blk.GetBlockData().GetValue(reader, int(row), def.Idx)
+		if err != nil {
+			return nil, fmt.Errorf("%w: row %d of block %s: %v", ErrUnavailable, row, id.BlockString(), err)
+		}
+		values[def.Name] = normalize(v)
+	}
+	return values, nil
+}
+
+// getTable returns nil for the tables of the system database, their changes
+// are not output.
+func (d *txnDecoder) getTable(dbID, tableID uint64) (*catalog.TableEntry, error) {
+	database, err := d.tae.Catalog.GetDatabaseByID(dbID)
+	if err != nil {
+		return nil, err
+	}
+	if database.IsSystemDB() {
+		return nil, nil
+	}
+	return database.GetTableEntryByID(tableID)
+}
+
+func (d *txnDecoder) newEvent(
+	table *catalog.TableEntry, op Op, before, after map[string]any,
+) Event {
+	return Event{
+		Database: table.GetDB().GetName(),
+		Table:    table.GetSchema().Name,
+		Op:       op,
+		Before:   before,
+		After:    after,
+		CommitTS: d.ts,
+		LSN:      d.lsn,
+	}
+}
+
+// normalize converts values read from vectors to json friendly ones.
+func normalize(v any) any {
+	switch val := v.(type) {
+	case types.Null:
+		return nil
+	case []byte:
+		return string(val)
+	case fmt.Stringer:
+		return val.String()
+	default:
+		return v
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cdc tails committed transactions from the TAE commit log and
+// decodes them into logical row events.
+package cdc
+
+import (
+	"errors"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var (
+	// ErrTruncated is returned if the wal entries after the checkpoint of a
+	// subscriber have been truncated by a TAE checkpoint.
+	ErrTruncated = errors.New("tae cdc: wal truncated")
+	// ErrUnavailable is returned if the row before a change cannot be read,
+	// e.g. its block has been compacted and removed.
+	ErrUnavailable = errors.New("tae cdc: before-image unavailable")
+	// ErrNoStartLSN is returned if a checkpoint has a ts but no lsn, the
+	// position of the ts in the wal is unknown.
+	ErrNoStartLSN = errors.New("tae cdc: checkpoint ts without lsn")
+)
+
+// Op is the kind of a row change.
+type Op string
+
+const (
+	OpInsert Op = "c"
+	OpUpdate Op = "u"
+	OpDelete Op = "d"
+)
+
+// Event is a row level change of a committed transaction.
+type Event struct {
+	Database string
+	Table    string
+	Op       Op
+	// Before is the row before the change, nil for insert.
+	Before map[string]any
+	// After is the row after the change, nil for delete.
+	After    map[string]any
+	CommitTS types.TS
+	// LSN is the lsn of the wal entry carrying the transaction
+	LSN uint64
+}
+
+// Checkpoint is the resumable position of a subscriber. All transactions
+// logged before LSN have been consumed.
+type Checkpoint struct {
+	LSN uint64 `json:"lsn"`
+	// TS is the commit ts of the last consumed transaction
+	TS string `json:"ts,omitempty"`
+}
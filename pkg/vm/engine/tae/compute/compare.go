@@ -52,6 +52,10 @@ func CompareBytes(a, b any) int64 {
 	}
 }
 
+func CompareUuid(a, b types.Uuid) int64 {
+	return int64(a.Compare(b))
+}
+
 func CompareGeneric(a, b any, t types.Type) int64 {
 	switch t.Oid {
 	case types.T_bool:
@@ -66,16 +70,18 @@ func CompareGeneric(a, b any, t types.Type) int64 {
 		return CompareOrdered[int64](a, b)
 	case types.T_uint8:
 		return CompareOrdered[uint8](a, b)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return CompareOrdered[uint16](a, b)
 	case types.T_uint32:
 		return CompareOrdered[uint32](a, b)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		return CompareOrdered[uint64](a, b)
 	case types.T_decimal64:
 		return types.CompareDecimal64Decimal64Aligned(a.(types.Decimal64), b.(types.Decimal64))
 	case types.T_decimal128:
 		return types.CompareDecimal128Decimal128Aligned(a.(types.Decimal128), b.(types.Decimal128))
+	case types.T_uuid:
+		return CompareUuid(a.(types.Uuid), b.(types.Uuid))
 	case types.T_float32:
 		return CompareOrdered[float32](a, b)
 	case types.T_float64:
@@ -86,7 +92,9 @@ func CompareGeneric(a, b any, t types.Type) int64 {
 		return CompareOrdered[types.Date](a, b)
 	case types.T_datetime:
 		return CompareOrdered[types.Datetime](a, b)
-	case types.T_char, types.T_varchar, types.T_blob, types.T_json:
+	case types.T_time:
+		return CompareOrdered[types.Time](a, b)
+	case types.T_char, types.T_varchar, types.T_blob, types.T_json, types.T_binary, types.T_varbinary:
 		return CompareBytes(a, b)
 	default:
 		panic("unsupported type")
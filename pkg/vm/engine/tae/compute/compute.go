@@ -184,11 +184,11 @@ func GetOffsetByVal(data containers.Vector, v any, skipmask *roaring.Bitmap) (of
 		return GetOffsetOfOrdered[int64](data.Slice(), v, skipmask)
 	case types.T_uint8:
 		return GetOffsetOfOrdered[uint8](data.Slice(), v, skipmask)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return GetOffsetOfOrdered[uint16](data.Slice(), v, skipmask)
 	case types.T_uint32:
 		return GetOffsetOfOrdered[uint32](data.Slice(), v, skipmask)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		return GetOffsetOfOrdered[uint64](data.Slice(), v, skipmask)
 	case types.T_float32:
 		return GetOffsetOfOrdered[float32](data.Slice(), v, skipmask)
@@ -198,6 +198,8 @@ func GetOffsetByVal(data containers.Vector, v any, skipmask *roaring.Bitmap) (of
 		return GetOffsetOfOrdered[types.Date](data.Slice(), v, skipmask)
 	case types.T_datetime:
 		return GetOffsetOfOrdered[types.Datetime](data.Slice(), v, skipmask)
+	case types.T_time:
+		return GetOffsetOfOrdered[types.Time](data.Slice(), v, skipmask)
 	case types.T_timestamp:
 		return GetOffsetOfOrdered[types.Timestamp](data.Slice(), v, skipmask)
 	case types.T_decimal64:
@@ -212,7 +214,13 @@ func GetOffsetByVal(data containers.Vector, v any, skipmask *roaring.Bitmap) (of
 			v.(types.Decimal128),
 			types.CompareDecimal128Decimal128Aligned,
 			skipmask)
-	case types.T_char, types.T_varchar, types.T_blob, types.T_json:
+	case types.T_uuid:
+		return GetOffsetWithFunc(
+			data.Slice().([]types.Uuid),
+			v.(types.Uuid),
+			CompareUuid,
+			skipmask)
+	case types.T_char, types.T_varchar, types.T_blob, types.T_json, types.T_binary, types.T_varbinary:
 		// column := data.Slice().(*containers.Bytes)
 		val := v.([]byte)
 		start, end := 0, data.Length()-1
@@ -32,16 +32,18 @@ func MakeVector(typ types.Type, nullable bool, opts ...*Options) (vec Vector) {
 		vec = NewVector[int64](typ, nullable, opts...)
 	case types.T_uint8:
 		vec = NewVector[uint8](typ, nullable, opts...)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		vec = NewVector[uint16](typ, nullable, opts...)
 	case types.T_uint32:
 		vec = NewVector[uint32](typ, nullable, opts...)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		vec = NewVector[uint64](typ, nullable, opts...)
 	case types.T_decimal64:
 		vec = NewVector[types.Decimal64](typ, nullable, opts...)
 	case types.T_decimal128:
 		vec = NewVector[types.Decimal128](typ, nullable, opts...)
+	case types.T_uuid:
+		vec = NewVector[types.Uuid](typ, nullable, opts...)
 	case types.T_float32:
 		vec = NewVector[float32](typ, nullable, opts...)
 	case types.T_float64:
@@ -52,7 +54,9 @@ func MakeVector(typ types.Type, nullable bool, opts ...*Options) (vec Vector) {
 		vec = NewVector[types.Timestamp](typ, nullable, opts...)
 	case types.T_datetime:
 		vec = NewVector[types.Datetime](typ, nullable, opts...)
-	case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+	case types.T_time:
+		vec = NewVector[types.Time](typ, nullable, opts...)
+	case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		vec = NewVector[[]byte](typ, nullable, opts...)
 	default:
 		panic("not support")
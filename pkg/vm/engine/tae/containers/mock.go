@@ -15,6 +15,7 @@
 package containers
 
 import (
+	"encoding/binary"
 	"fmt"
 	"math"
 	"math/rand"
@@ -128,7 +129,7 @@ func MockVector(t types.Type, rows int, unique, nullable bool, provider Vector)
 				vec.Append(uint8(ival))
 			}
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		if unique {
 			for i := 0; i < rows; i++ {
 				vec.Append(uint16(i))
@@ -150,7 +151,7 @@ func MockVector(t types.Type, rows int, unique, nullable bool, provider Vector)
 				vec.Append(uint32(ival))
 			}
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		if unique {
 			for i := 0; i < rows; i++ {
 				vec.Append(uint64(i))
@@ -173,7 +174,7 @@ func MockVector(t types.Type, rows int, unique, nullable bool, provider Vector)
 			v2 := rand.Intn(math.MaxInt32) + 1
 			vec.Append(float64(v1) / float64(v2))
 		}
-	case types.T_varchar, types.T_char, types.T_blob:
+	case types.T_varchar, types.T_char, types.T_blob, types.T_binary, types.T_varbinary:
 		if unique {
 			for i := 0; i < rows; i++ {
 				s := fmt.Sprintf("%d-%d", i, 0)
@@ -189,6 +190,10 @@ func MockVector(t types.Type, rows int, unique, nullable bool, provider Vector)
 		for i := 1; i <= rows; i++ {
 			vec.Append(types.FromClock(int32(i*100), 1, 1, 1, 1, 1, 1))
 		}
+	case types.T_time:
+		for i := 1; i <= rows; i++ {
+			vec.Append(types.Time(i * 1000000))
+		}
 	case types.T_date:
 		for i := 1; i <= rows; i++ {
 			vec.Append(types.FromCalendar(int32(i)*100, 1, 1))
@@ -205,12 +210,22 @@ func MockVector(t types.Type, rows int, unique, nullable bool, provider Vector)
 		for i := int32(1); i <= int32(rows); i++ {
 			vec.Append(types.InitDecimal128UsingUint(common.NextGlobalSeqNum()))
 		}
+	case types.T_uuid:
+		for i := int32(1); i <= int32(rows); i++ {
+			vec.Append(mockUuid(common.NextGlobalSeqNum()))
+		}
 	default:
 		panic("not supported")
 	}
 	return
 }
 
+// mockUuid returns the uuid ordered by v
+func mockUuid(v uint64) (u types.Uuid) {
+	binary.BigEndian.PutUint64(u[8:], v)
+	return
+}
+
 func MockVector2(typ types.Type, rows int, offset int) Vector {
 	vec := MakeVector(typ, true)
 	switch typ.Oid {
@@ -242,7 +257,7 @@ func MockVector2(typ types.Type, rows int, offset int) Vector {
 		for i := 0; i < rows; i++ {
 			vec.Append(uint8(i + offset))
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		for i := 0; i < rows; i++ {
 			vec.Append(uint16(i + offset))
 		}
@@ -250,7 +265,7 @@ func MockVector2(typ types.Type, rows int, offset int) Vector {
 		for i := 0; i < rows; i++ {
 			vec.Append(uint32(i + offset))
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		for i := 0; i < rows; i++ {
 			vec.Append(uint64(i + offset))
 		}
@@ -270,6 +285,10 @@ func MockVector2(typ types.Type, rows int, offset int) Vector {
 		for i := 0; i < rows; i++ {
 			vec.Append(types.InitDecimal128(int64(i + offset)))
 		}
+	case types.T_uuid:
+		for i := 0; i < rows; i++ {
+			vec.Append(mockUuid(uint64(i + offset)))
+		}
 	case types.T_timestamp:
 		for i := 0; i < rows; i++ {
 			vec.Append(types.Timestamp(i + offset))
@@ -282,7 +301,11 @@ func MockVector2(typ types.Type, rows int, offset int) Vector {
 		for i := 0; i < rows; i++ {
 			vec.Append(types.Datetime(i + offset))
 		}
-	case types.T_char, types.T_varchar, types.T_blob:
+	case types.T_time:
+		for i := 0; i < rows; i++ {
+			vec.Append(types.Time(i + offset))
+		}
+	case types.T_char, types.T_varchar, types.T_blob, types.T_binary, types.T_varbinary:
 		for i := 0; i < rows; i++ {
 			vec.Append([]byte(strconv.Itoa(i + offset)))
 		}
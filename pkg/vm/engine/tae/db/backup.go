@@ -0,0 +1,395 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+)
+
+const (
+	BackupManifestName    = "manifest.json"
+	BackupDataDir         = "data"
+	BackupManifestVersion = 1
+	BackupMaxRetries      = 3
+)
+
+var (
+	ErrBackupFileChanged = errors.New("tae backup: file changed during backup")
+	ErrBackupChecksum    = errors.New("tae backup: checksum mismatch")
+	ErrBackupVersion     = errors.New("tae backup: unsupported manifest version")
+	ErrBackupRemoteWAL   = errors.New("tae backup: wal is not in the working directory")
+)
+
+// BackupFile describes a file of the TAE working directory in a backup.
+type BackupFile struct {
+	// Name is the path relative to the TAE working directory
+	Name     string `json:"name"`
+	Size     int64  `json:"size"`
+	Checksum uint32 `json:"checksum"`
+	// Location is the backup dir holding the content of the file. It is the
+	// dir of a prior backup if the file is not changed since then.
+	Location string `json:"location"`
+}
+
+// BackupManifest describes a backup of a TAE instance.
+type BackupManifest struct {
+	Version int `json:"version"`
+	// Dir is the backup dir in the target file service
+	Dir string `json:"dir"`
+	// Base is the dir of the prior backup for an incremental backup
+	Base string `json:"base,omitempty"`
+	// CheckpointTS is the catalog checkpoint taken before copying files,
+	// anything committed after it is replayed from the wal tail.
+	CheckpointTS string `json:"checkpoint-ts"`
+	// CheckpointLSN is the wal checkpoint pinned during the backup, the wal
+	// files copied cover every entry after it.
+	CheckpointLSN uint64       `json:"checkpoint-lsn"`
+	CreatedAt     time.Time    `json:"created-at"`
+	Files         []BackupFile `json:"files"`
+}
+
+func (m *BackupManifest) getFile(name string) (BackupFile, bool) {
+	for _, file := range m.Files {
+		if file.Name == name {
+			return file, true
+		}
+	}
+	return BackupFile{}, false
+}
+
+// Backup takes a catalog checkpoint and copies the segment files, the catalog
+// and the wal tail into dir of the target file service. If base is not nil,
+// files not changed since base are not copied again and the returned manifest
+// refers to them in the prior backup.
+//
+// The timed scanner is held for the whole backup, so no gc, compaction or
+// checkpoint is started while the files are copied. The wal checkpoint lsn is
+// pinned in the manifest; if it moves, or a file changes under the copy, the
+// files written by the attempt are deleted and the backup is retried up to
+// BackupMaxRetries times before ErrBackupFileChanged is returned.
+//
+// Backup is driven by the BACKUP statement of a CN that embeds the instance,
+// and Restore by the restoreFrom option before the instance is opened. Only
+// the local wal is supported: with the logservice driver the wal tail is not
+// in the working directory and ErrBackupRemoteWAL is returned.
+func (db *DB) Backup(
+	ctx context.Context,
+	target fileservice.FileService,
+	dir string,
+	base *BackupManifest,
+) (manifest *BackupManifest, err error) {
+	// a remote wal (e.g. the logservice driver) is not in the working
+	// directory and cannot be copied file by file
+	if walFiles, err := filepath.Glob(filepath.Join(db.Dir, WALDir+"-*")); err != nil || len(walFiles) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrBackupRemoteWAL, db.Dir)
+	}
+
+	db.backgroundMu.Lock()
+	defer db.backgroundMu.Unlock()
+
+	for i := 0; i <= BackupMaxRetries; i++ {
+		if manifest, err = db.backupOnce(ctx, target, dir, base); !errors.Is(err, ErrBackupFileChanged) {
+			return
+		}
+		logutil.Warnf("[Backup] attempt %d to %s failed: %v", i+1, dir, err)
+	}
+	return
+}
+
+func (db *DB) backupOnce(
+	ctx context.Context,
+	target fileservice.FileService,
+	dir string,
+	base *BackupManifest,
+) (manifest *BackupManifest, err error) {
+	ts := db.Scheduler.GetSafeTS()
+	if err = db.Catalog.Checkpoint(ts); err != nil {
+		return
+	}
+
+	manifest = &BackupManifest{
+		Version:       BackupManifestVersion,
+		Dir:           dir,
+		CheckpointTS:  ts.ToString(),
+		CheckpointLSN: db.Wal.GetCheckpointed(),
+		CreatedAt:     time.Now(),
+	}
+	if base != nil {
+		manifest.Base = base.Dir
+	}
+
+	var written []string
+	defer func() {
+		if err != nil {
+			removeBackupFiles(ctx, target, written)
+			manifest = nil
+		}
+	}()
+
+	names, err := listBackupFiles(db.Dir)
+	if err != nil {
+		return
+	}
+	for _, name := range names {
+		var file BackupFile
+		file, err = backupFile(ctx, target, db.Dir, dir, name, base)
+		// a changed file may be written already
+		if err != nil || (file.Location == dir && file.Size > 0) {
+			written = append(written, path.Join(dir, BackupDataDir, name))
+		}
+		if err != nil {
+			return
+		}
+		manifest.Files = append(manifest.Files, file)
+	}
+	if lsn := db.Wal.GetCheckpointed(); lsn != manifest.CheckpointLSN {
+		err = fmt.Errorf("%w: wal checkpointed from %d to %d",
+			ErrBackupFileChanged, manifest.CheckpointLSN, lsn)
+		return
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return
+	}
+	if err = target.Write(ctx, fileservice.IOVector{
+		FilePath: path.Join(dir, BackupManifestName),
+		Entries: []fileservice.IOEntry{
+			{Size: len(data), Data: data},
+		},
+	}); err != nil {
+		return
+	}
+	logutil.Infof("[Backup] %d files backed up to %s at %s",
+		len(manifest.Files), dir, manifest.CheckpointTS)
+	return
+}
+
+// removeBackupFiles deletes the files written by a failed backup attempt, so
+// that the next attempt can write them again.
+func removeBackupFiles(ctx context.Context, target fileservice.FileService, files []string) {
+	for _, file := range files {
+		if err := target.Delete(ctx, file); err != nil &&
+			!errors.Is(err, fileservice.ErrFileNotFound) {
+			logutil.Warnf("[Backup] remove %s: %v", file, err)
+		}
+	}
+}
+
+// ReadBackupManifest reads the manifest of the backup in dir.
+func ReadBackupManifest(
+	ctx context.Context,
+	source fileservice.FileService,
+	dir string,
+) (*BackupManifest, error) {
+	vec := fileservice.IOVector{
+		FilePath: path.Join(dir, BackupManifestName),
+		Entries: []fileservice.IOEntry{
+			{Size: -1},
+		},
+	}
+	if err := source.Read(ctx, &vec); err != nil {
+		return nil, err
+	}
+	manifest := new(BackupManifest)
+	if err := json.Unmarshal(vec.Entries[0].Data, manifest); err != nil {
+		return nil, err
+	}
+	if manifest.Version != BackupManifestVersion {
+		return nil, fmt.Errorf("%w: %d", ErrBackupVersion, manifest.Version)
+	}
+	return manifest, nil
+}
+
+// Restore rebuilds the TAE working directory targetDir from the backup. The
+// restored instance is opened by Open, which replays the wal tail.
+func Restore(
+	ctx context.Context,
+	source fileservice.FileService,
+	manifest *BackupManifest,
+	targetDir string,
+) error {
+	for _, file := range manifest.Files {
+		if err := restoreFile(ctx, source, targetDir, file); err != nil {
+			return err
+		}
+	}
+	logutil.Infof("[Restore] %d files restored from %s to %s",
+		len(manifest.Files), manifest.Dir, targetDir)
+	return nil
+}
+
+// listBackupFiles returns the sorted names of all files in the TAE working
+// directory except the lock file.
+func listBackupFiles(root string) ([]string, error) {
+	lockName := common.MakeLockFileName(root, LockName)
+	var names []string
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || p == lockName {
+			return nil
+		}
+		name, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		names = append(names, filepath.ToSlash(name))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// wal files are copied last, so that they cover everything written into
+	// the other files during the backup.
+	sort.SliceStable(names, func(i, j int) bool {
+		iw := isWALFile(names[i])
+		jw := isWALFile(names[j])
+		if iw != jw {
+			return jw
+		}
+		return names[i] < names[j]
+	})
+	return names, nil
+}
+
+// isWALFile tells whether name is a version file of the local wal, which the
+// batch store driver names <WALDir>-<version>.rot in the working directory.
+func isWALFile(name string) bool {
+	return strings.HasPrefix(name, WALDir+"-")
+}
+
+func backupFile(
+	ctx context.Context,
+	target fileservice.FileService,
+	root, dir, name string,
+	base *BackupManifest,
+) (file BackupFile, err error) {
+	f, err := os.Open(filepath.Join(root, filepath.FromSlash(name)))
+	if err != nil {
+		// a wal file truncated by a checkpoint that was in flight
+		if errors.Is(err, fs.ErrNotExist) {
+			err = fmt.Errorf("%w: %s removed", ErrBackupFileChanged, name)
+		}
+		return
+	}
+	defer f.Close()
+	stat, err := f.Stat()
+	if err != nil {
+		return
+	}
+
+	file.Name = name
+	file.Size = stat.Size()
+	file.Location = dir
+	hash := crc32.NewIEEE()
+	if _, err = io.Copy(hash, io.LimitReader(f, file.Size)); err != nil {
+		return
+	}
+	file.Checksum = hash.Sum32()
+
+	if base != nil {
+		if prev, ok := base.getFile(name); ok &&
+			prev.Size == file.Size && prev.Checksum == file.Checksum {
+			file.Location = prev.Location
+			return
+		}
+	}
+	if file.Size == 0 {
+		return
+	}
+
+	if _, err = f.Seek(0, io.SeekStart); err != nil {
+		return
+	}
+	hash.Reset()
+	if err = target.Write(ctx, fileservice.IOVector{
+		FilePath: path.Join(dir, BackupDataDir, name),
+		Entries: []fileservice.IOEntry{
+			{
+				Size:           int(file.Size),
+				ReaderForWrite: io.TeeReader(io.LimitReader(f, file.Size), hash),
+			},
+		},
+	}); err != nil {
+		return
+	}
+	if hash.Sum32() != file.Checksum {
+		err = fmt.Errorf("%w: %s", ErrBackupFileChanged, name)
+		return
+	}
+	// only the first Size bytes are copied, an append is caught by the size
+	if stat, err = f.Stat(); err != nil {
+		return
+	}
+	if stat.Size() != file.Size {
+		err = fmt.Errorf("%w: %s grew to %d bytes", ErrBackupFileChanged, name, stat.Size())
+	}
+	return
+}
+
+func restoreFile(
+	ctx context.Context,
+	source fileservice.FileService,
+	targetDir string,
+	file BackupFile,
+) error {
+	p := filepath.Join(targetDir, filepath.FromSlash(file.Name))
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return err
+	}
+	f, err := os.Create(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if file.Size == 0 {
+		return nil
+	}
+
+	hash := crc32.NewIEEE()
+	if err := source.Read(ctx, &fileservice.IOVector{
+		FilePath: path.Join(file.Location, BackupDataDir, file.Name),
+		Entries: []fileservice.IOEntry{
+			{
+				Size:          int(file.Size),
+				WriterForRead: io.MultiWriter(f, hash),
+			},
+		},
+	}); err != nil {
+		return err
+	}
+	if hash.Sum32() != file.Checksum {
+		return fmt.Errorf("%w: %s", ErrBackupChecksum, file.Name)
+	}
+	return f.Sync()
+}
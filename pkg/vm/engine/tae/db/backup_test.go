@@ -0,0 +1,177 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"context"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackupRestore(t *testing.T) {
+	testutils.EnsureNoLeak(t)
+	ctx := context.Background()
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := newTestEngine(t, opts)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(3, 2)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	tae.bindSchema(schema)
+	bat := catalog.MockBatch(schema, 35)
+	defer bat.Close()
+	bats := bat.Split(2)
+
+	tae.createRelAndAppend(bats[0], true)
+	tae.compactBlocks(false)
+
+	fs, err := fileservice.NewMemoryFS("backup")
+	assert.NoError(t, err)
+	full, err := tae.Backup(ctx, fs, "full", nil)
+	assert.NoError(t, err)
+	assert.Empty(t, full.Base)
+	assert.NotEmpty(t, full.Files)
+	for _, file := range full.Files {
+		assert.Equal(t, "full", file.Location)
+		assert.NotEqual(t, LockName+".lock", file.Name)
+	}
+
+	txn, rel := tae.getRelation()
+	assert.NoError(t, rel.Append(bats[1]))
+	assert.NoError(t, txn.Commit())
+
+	incr, err := tae.Backup(ctx, fs, "incr", full)
+	assert.NoError(t, err)
+	assert.Equal(t, "full", incr.Base)
+	for _, file := range incr.Files {
+		if file.Location == "full" {
+			prev, ok := full.getFile(file.Name)
+			assert.True(t, ok)
+			assert.Equal(t, prev.Checksum, file.Checksum)
+		}
+	}
+
+	restore := func(dir string, rows int) {
+		manifest, err := ReadBackupManifest(ctx, fs, dir)
+		assert.NoError(t, err)
+		assert.Equal(t, dir, manifest.Dir)
+
+		target := filepath.Join(t.TempDir(), dir)
+		assert.NoError(t, Restore(ctx, fs, manifest, target))
+		restored, err := Open(target, config.WithLongScanAndCKPOpts(nil))
+		assert.NoError(t, err)
+		defer restored.Close()
+
+		txn, rel := getDefaultRelation(t, restored, schema.Name)
+		checkAllColRowsByScan(t, rel, rows, true)
+		assert.NoError(t, txn.Commit())
+	}
+	restore("full", bats[0].Length())
+	restore("incr", bat.Length())
+
+	_, err = ReadBackupManifest(ctx, fs, "not-exist")
+	assert.Error(t, err)
+}
+
+// changingFS appends to a file of the working directory while the first
+// attempt copies it.
+type changingFS struct {
+	fileservice.FileService
+	file    string
+	changed bool
+}
+
+func (fs *changingFS) Write(ctx context.Context, vector fileservice.IOVector) error {
+	if !fs.changed && strings.HasSuffix(vector.FilePath, "/changing") {
+		fs.changed = true
+		f, err := os.OpenFile(fs.file, os.O_APPEND|os.O_WRONLY, 0)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		if _, err := f.WriteString("-changed"); err != nil {
+			return err
+		}
+	}
+	return fs.FileService.Write(ctx, vector)
+}
+
+func TestBackupRetry(t *testing.T) {
+	testutils.EnsureNoLeak(t)
+	ctx := context.Background()
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := newTestEngine(t, opts)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(3, 2)
+	tae.bindSchema(schema)
+	bat := catalog.MockBatch(schema, 10)
+	defer bat.Close()
+	tae.createRelAndAppend(bat, true)
+
+	name := filepath.Join(tae.Dir, "changing")
+	assert.NoError(t, os.WriteFile(name, []byte("content"), 0644))
+	mem, err := fileservice.NewMemoryFS("backup")
+	assert.NoError(t, err)
+	fs := &changingFS{FileService: mem, file: name}
+
+	manifest, err := tae.Backup(ctx, fs, "full", nil)
+	assert.NoError(t, err)
+	assert.True(t, fs.changed)
+	file, ok := manifest.getFile("changing")
+	assert.True(t, ok)
+	assert.Equal(t, int64(len("content-changed")), file.Size)
+	assert.Equal(t, tae.Wal.GetCheckpointed(), manifest.CheckpointLSN)
+
+	vec := fileservice.IOVector{
+		FilePath: path.Join("full", BackupDataDir, "changing"),
+		Entries:  []fileservice.IOEntry{{Size: -1}},
+	}
+	assert.NoError(t, mem.Read(ctx, &vec))
+	assert.Equal(t, "content-changed", string(vec.Entries[0].Data))
+}
+
+type countingOp struct {
+	*catalog.LoopProcessor
+	executed int
+}
+
+func (op *countingOp) PreExecute() error  { op.executed++; return nil }
+func (op *countingOp) PostExecute() error { return nil }
+
+func TestBackupHoldsScanner(t *testing.T) {
+	testutils.EnsureNoLeak(t)
+	tae := newTestEngine(t, config.WithLongScanAndCKPOpts(nil))
+	defer tae.Close()
+
+	op := &countingOp{LoopProcessor: new(catalog.LoopProcessor)}
+	scanner := NewDBScanner(tae.DB, nil)
+	scanner.RegisterOp(op)
+
+	tae.backgroundMu.Lock()
+	scanner.OnExec()
+	assert.Equal(t, 0, op.executed)
+	tae.backgroundMu.Unlock()
+	scanner.OnExec()
+	assert.Equal(t, 1, op.executed)
+}
@@ -18,6 +18,7 @@ import (
 	"errors"
 	"io"
 	"runtime"
+	"sync"
 	"sync/atomic"
 
 	"github.com/matrixorigin/matrixone/pkg/logutil"
@@ -63,6 +64,11 @@ type DB struct {
 
 	DBLocker io.Closer
 
+	// backgroundMu is read-locked by each round of the timed scanner and
+	// write-locked by Backup, which keeps gc, compaction and checkpoints
+	// from changing the working directory while it is copied.
+	backgroundMu sync.RWMutex
+
 	Closed *atomic.Value
 }
 
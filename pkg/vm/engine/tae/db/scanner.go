@@ -55,6 +55,11 @@ func (scanner *dbScanner) OnStopped() {
 }
 
 func (scanner *dbScanner) OnExec() {
+	// skip this round if a backup is in progress
+	if !scanner.db.backgroundMu.TryRLock() {
+		return
+	}
+	defer scanner.db.backgroundMu.RUnlock()
 	scanner.dbmask.Clear()
 	scanner.tablemask.Clear()
 	scanner.segmask.Clear()
@@ -20,6 +20,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/util/fault"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
@@ -101,6 +102,9 @@ func (s *taskScheduler) ScheduleMultiScopedFn(ctx *tasks.Context, taskType tasks
 }
 
 func (s *taskScheduler) Checkpoint(indexes []*wal.Index) (err error) {
+	if _, err = fault.Inject(fault.TAECheckpoint); err != nil {
+		return
+	}
 	entry, err := s.db.Wal.Checkpoint(indexes)
 	if err != nil {
 		return err
@@ -185,7 +185,7 @@ func (zm *ZoneMap) Marshal() (buf []byte, err error) {
 	}
 	buf[31] |= constZMInited
 	switch zm.typ.Oid {
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_binary, types.T_varbinary:
 		minv, maxv := zm.min.([]byte), zm.max.([]byte)
 		// write 31-byte prefix of minv
 		copy(buf[0:31], minv)
@@ -257,7 +257,7 @@ func (zm *ZoneMap) Unmarshal(buf []byte) error {
 		buf = buf[32:]
 		zm.max = types.DecodeFixed[uint8](buf[:1])
 		return nil
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		zm.min = types.DecodeFixed[uint16](buf[:2])
 		buf = buf[32:]
 		zm.max = types.DecodeFixed[uint16](buf[:2])
@@ -267,7 +267,7 @@ func (zm *ZoneMap) Unmarshal(buf []byte) error {
 		buf = buf[32:]
 		zm.max = types.DecodeFixed[uint32](buf[:4])
 		return nil
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		zm.min = types.DecodeFixed[uint64](buf[:8])
 		buf = buf[32:]
 		zm.max = types.DecodeFixed[uint64](buf[:8])
@@ -292,6 +292,11 @@ func (zm *ZoneMap) Unmarshal(buf []byte) error {
 		buf = buf[32:]
 		zm.max = types.DecodeFixed[types.Datetime](buf[:8])
 		return nil
+	case types.T_time:
+		zm.min = types.DecodeFixed[types.Time](buf[:8])
+		buf = buf[32:]
+		zm.max = types.DecodeFixed[types.Time](buf[:8])
+		return nil
 	case types.T_timestamp:
 		zm.min = types.DecodeFixed[types.Timestamp](buf[:8])
 		buf = buf[32:]
@@ -307,7 +312,12 @@ func (zm *ZoneMap) Unmarshal(buf []byte) error {
 		buf = buf[32:]
 		zm.max = types.DecodeFixed[types.Decimal128](buf[:16])
 		return nil
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_uuid:
+		zm.min = types.DecodeFixed[types.Uuid](buf[:16])
+		buf = buf[32:]
+		zm.max = types.DecodeFixed[types.Uuid](buf[:16])
+		return nil
+	case types.T_char, types.T_varchar, types.T_json, types.T_binary, types.T_varbinary:
 		minBuf := make([]byte, buf[31]&0x7f)
 		copy(minBuf, buf[0:32])
 		maxBuf := make([]byte, 32)
@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/decimal128s"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/decimal64s"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/numerics"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/uuids"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/varchar"
 )
 
@@ -42,11 +43,11 @@ func SortBlockColumns(cols []containers.Vector, pk int) ([]uint32, error) {
 		numerics.Sort[int64](cols[pk], sortedIdx)
 	case types.T_uint8:
 		numerics.Sort[uint8](cols[pk], sortedIdx)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		numerics.Sort[uint16](cols[pk], sortedIdx)
 	case types.T_uint32:
 		numerics.Sort[uint32](cols[pk], sortedIdx)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		numerics.Sort[uint64](cols[pk], sortedIdx)
 	case types.T_float32:
 		numerics.Sort[float32](cols[pk], sortedIdx)
@@ -56,13 +57,17 @@ func SortBlockColumns(cols []containers.Vector, pk int) ([]uint32, error) {
 		numerics.Sort[types.Date](cols[pk], sortedIdx)
 	case types.T_datetime:
 		numerics.Sort[types.Datetime](cols[pk], sortedIdx)
+	case types.T_time:
+		numerics.Sort[types.Time](cols[pk], sortedIdx)
 	case types.T_decimal64:
 		decimal64s.Sort(cols[pk], sortedIdx)
 	case types.T_decimal128:
 		decimal128s.Sort(cols[pk], sortedIdx)
+	case types.T_uuid:
+		uuids.Sort(cols[pk], sortedIdx)
 	case types.T_timestamp:
 		numerics.Sort[types.Timestamp](cols[pk], sortedIdx)
-	case types.T_char, types.T_json, types.T_varchar, types.T_blob:
+	case types.T_char, types.T_json, types.T_varchar, types.T_blob, types.T_binary, types.T_varbinary:
 		varchar.Sort(cols[pk], sortedIdx)
 	default:
 		panic(fmt.Sprintf("%s not supported", cols[pk].GetType().String()))
@@ -91,11 +96,11 @@ func MergeSortedColumn(column []containers.Vector, sortedIdx *[]uint32, fromLayo
 		ret, mapping = numerics.Merge[int64](column, sortedIdx, fromLayout, toLayout)
 	case types.T_uint8:
 		ret, mapping = numerics.Merge[uint8](column, sortedIdx, fromLayout, toLayout)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		ret, mapping = numerics.Merge[uint16](column, sortedIdx, fromLayout, toLayout)
 	case types.T_uint32:
 		ret, mapping = numerics.Merge[uint32](column, sortedIdx, fromLayout, toLayout)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		ret, mapping = numerics.Merge[uint64](column, sortedIdx, fromLayout, toLayout)
 	case types.T_float32:
 		ret, mapping = numerics.Merge[float32](column, sortedIdx, fromLayout, toLayout)
@@ -105,13 +110,17 @@ func MergeSortedColumn(column []containers.Vector, sortedIdx *[]uint32, fromLayo
 		ret, mapping = numerics.Merge[types.Date](column, sortedIdx, fromLayout, toLayout)
 	case types.T_datetime:
 		ret, mapping = numerics.Merge[types.Datetime](column, sortedIdx, fromLayout, toLayout)
+	case types.T_time:
+		ret, mapping = numerics.Merge[types.Time](column, sortedIdx, fromLayout, toLayout)
 	case types.T_decimal64:
 		ret, mapping = decimal64s.Merge(column, sortedIdx, fromLayout, toLayout)
 	case types.T_decimal128:
 		ret, mapping = decimal128s.Merge(column, sortedIdx, fromLayout, toLayout)
+	case types.T_uuid:
+		ret, mapping = uuids.Merge(column, sortedIdx, fromLayout, toLayout)
 	case types.T_timestamp:
 		ret, mapping = numerics.Merge[types.Timestamp](column, sortedIdx, fromLayout, toLayout)
-	case types.T_char, types.T_json, types.T_varchar, types.T_blob:
+	case types.T_char, types.T_json, types.T_varchar, types.T_blob, types.T_binary, types.T_varbinary:
 		ret, mapping = varchar.Merge(column, sortedIdx, fromLayout, toLayout)
 	default:
 		panic(fmt.Sprintf("%s not supported", column[0].GetType().String()))
@@ -21,6 +21,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestSort1(t *testing.T) {
@@ -125,3 +126,24 @@ func TestReshape2(t *testing.T) {
 		}
 	}
 }
+
+func TestSortUuid(t *testing.T) {
+	vecType := types.T_uuid.ToType()
+	vec := containers.MockVector(vecType, 100, false, false, nil)
+	vals := vec.Slice().([]types.Uuid)
+	rand.Shuffle(len(vals), func(i, j int) { vals[i], vals[j] = vals[j], vals[i] })
+	_, _ = SortBlockColumns([]containers.Vector{vec}, 0)
+	for i := 1; i < vec.Length(); i++ {
+		assert.True(t, vec.Get(i-1).(types.Uuid).Lt(vec.Get(i).(types.Uuid)))
+	}
+
+	vec2 := containers.MockVector(vecType, 100, false, false, nil)
+	sortedIdx := make([]uint32, 200)
+	ret, _ := MergeSortedColumn([]containers.Vector{vec, vec2}, &sortedIdx, []uint32{100, 100}, []uint32{100, 100})
+	for _, v := range ret {
+		for i := 1; i < v.Length(); i++ {
+			assert.True(t, v.Get(i-1).(types.Uuid).Compare(v.Get(i).(types.Uuid)) <= 0)
+		}
+		v.Close()
+	}
+}
@@ -114,6 +114,8 @@ func EncodeTypedVals(w *bytes.Buffer, vals ...any) []byte {
 			_, _ = w.Write(types.EncodeFixed(v))
 		case types.Decimal128:
 			_, _ = w.Write(types.EncodeFixed(v))
+		case types.Uuid:
+			_, _ = w.Write(types.EncodeFixed(v))
 		default:
 			panic(fmt.Errorf("%T:%v not supported", v, v))
 		}
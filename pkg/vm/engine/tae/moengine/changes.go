@@ -0,0 +1,229 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moengine
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
+)
+
+var (
+	_ engine.ChangesRelation = (*txnRelation)(nil)
+)
+
+func (rel *txnRelation) SnapshotTS() timestamp.Timestamp {
+	return rel.txn.GetStartTS().ToTimestamp()
+}
+
+// Changes compares the rows as of the ts and as of the snapshot ts of the
+// blocks whose mvcc chains have commits in between, the other blocks are
+// skipped. The rows moved by compaction and merge can not be told from the
+// changed ones, ErrChangesUnavailable is returned if any block has been
+// created or dropped by them since the ts.
+func (rel *txnRelation) Changes(
+	_ context.Context,
+	from timestamp.Timestamp,
+	attrs []string) (inserted, deleted *batch.Batch, err error) {
+	fromTS := types.TimestampToTS(from)
+	toTS := rel.txn.GetStartTS()
+	table := rel.handle.GetMeta().(*catalog.TableEntry)
+	if table.GetCreatedAt().Greater(fromTS) {
+		return nil, nil, engine.ErrChangesUnavailable
+	}
+	schema := table.GetSchema()
+	ins := newChangesCollector(schema, attrs)
+	defer ins.close()
+	dels := newChangesCollector(schema, attrs)
+	defer dels.close()
+
+	fromReader := txnbase.NewTxn(nil, nil, 0, fromTS, nil)
+	toReader := txnbase.NewTxn(nil, nil, 0, toTS, nil)
+	segIt := table.MakeSegmentIt(true)
+	for ; segIt.Valid(); segIt.Next() {
+		blkIt := segIt.Get().GetPayload().MakeBlockIt(true)
+		for ; blkIt.Valid(); blkIt.Next() {
+			blk := blkIt.Get().GetPayload()
+			can, dropped := blk.TxnCanGet(fromTS)
+			existedFrom := can && !dropped
+			can, dropped = blk.TxnCanGet(toTS)
+			existedTo := can && !dropped
+			if existedFrom != existedTo && (existedFrom || !blk.IsAppendable()) {
+				return nil, nil, engine.ErrChangesUnavailable
+			}
+			if !existedTo {
+				continue
+			}
+			// the rows of a block without appends, updates or deletes
+			// committed since the ts are not read at all
+			if existedFrom {
+				changed, err := blockChanged(blk, fromTS, toTS)
+				if err != nil {
+					return nil, nil, err
+				}
+				if !changed {
+					continue
+				}
+			}
+			var fromViews []*model.ColumnView
+			if existedFrom {
+				if fromViews, err = readBlockViews(blk, fromReader, attrs); err != nil {
+					return
+				}
+			}
+			toViews, err := readBlockViews(blk, toReader, attrs)
+			if err != nil {
+				closeViews(fromViews)
+				return nil, nil, err
+			}
+			collectBlockChanges(schema, attrs, fromViews, toViews, ins, dels)
+			closeViews(fromViews)
+			closeViews(toViews)
+		}
+	}
+	return ins.toBatch(), dels.toBatch(), nil
+}
+
+// blockChanged tells whether any append, update or delete of the block is
+// committed in (from, to].
+func blockChanged(blk *catalog.BlockEntry, from, to types.TS) (bool, error) {
+	data := blk.GetBlockData()
+	appends, err := data.CollectAppendLogIndexes(from.Next(), to)
+	if err != nil || len(appends) > 0 {
+		return true, err
+	}
+	view, err := data.CollectChangesInRange(from, to)
+	if err != nil {
+		return true, err
+	}
+	return len(view.Columns) > 0 ||
+		(view.DeleteMask != nil && !view.DeleteMask.IsEmpty()), nil
+}
+
+// readBlockViews returns nil if no row of the block is visible.
+func readBlockViews(
+	blk *catalog.BlockEntry,
+	reader *txnbase.Txn,
+	attrs []string) ([]*model.ColumnView, error) {
+	views := make([]*model.ColumnView, 0, len(attrs))
+	for _, attr := range attrs {
+		view, err := blk.GetBlockData().GetColumnDataByName(reader, attr, new(bytes.Buffer))
+		if err != nil || view == nil {
+			closeViews(views)
+			return nil, err
+		}
+		views = append(views, view)
+	}
+	return views, nil
+}
+
+func closeViews(views []*model.ColumnView) {
+	for _, view := range views {
+		view.Close()
+	}
+}
+
+func isRowDeleted(views []*model.ColumnView, row int) bool {
+	return views[0].DeleteMask != nil && views[0].DeleteMask.Contains(uint32(row))
+}
+
+func collectBlockChanges(
+	schema *catalog.Schema,
+	attrs []string,
+	fromViews, toViews []*model.ColumnView,
+	ins, dels *changesCollector) {
+	if len(toViews) == 0 {
+		return
+	}
+	fromRows := 0
+	if len(fromViews) > 0 {
+		fromRows = fromViews[0].Length()
+	}
+	for row := 0; row < toViews[0].Length(); row++ {
+		existed := row < fromRows && !isRowDeleted(fromViews, row)
+		alive := !isRowDeleted(toViews, row)
+		switch {
+		case existed && !alive:
+			dels.append(fromViews, row)
+		case !existed && alive:
+			ins.append(toViews, row)
+		case existed && alive:
+			for i, attr := range attrs {
+				typ := schema.ColDefs[schema.GetColIdx(attr)].Type
+				if !valueEqual(fromViews[i].GetValue(row), toViews[i].GetValue(row), typ) {
+					dels.append(fromViews, row)
+					ins.append(toViews, row)
+					break
+				}
+			}
+		}
+	}
+}
+
+func valueEqual(a, b any, typ types.Type) bool {
+	if types.IsNull(a) || types.IsNull(b) {
+		return types.IsNull(a) && types.IsNull(b)
+	}
+	return compute.CompareGeneric(a, b, typ) == 0
+}
+
+type changesCollector struct {
+	attrs []string
+	vecs  []containers.Vector
+}
+
+func newChangesCollector(schema *catalog.Schema, attrs []string) *changesCollector {
+	c := &changesCollector{attrs: attrs}
+	for _, attr := range attrs {
+		def := schema.ColDefs[schema.GetColIdx(attr)]
+		c.vecs = append(c.vecs, containers.MakeVector(def.Type, def.Nullable()))
+	}
+	return c
+}
+
+func (c *changesCollector) append(views []*model.ColumnView, row int) {
+	for i, view := range views {
+		c.vecs[i].Append(view.GetValue(row))
+	}
+}
+
+func (c *changesCollector) toBatch() *batch.Batch {
+	bat := batch.New(true, c.attrs)
+	bat.Vecs = make([]*vector.Vector, len(c.vecs))
+	for i, vec := range c.vecs {
+		bat.Vecs[i] = CopyToMoVector(vec)
+	}
+	bat.Zs = make([]int64, c.vecs[0].Length())
+	for i := range bat.Zs {
+		bat.Zs[i] = 1
+	}
+	return bat
+}
+
+func (c *changesCollector) close() {
+	for _, vec := range c.vecs {
+		vec.Close()
+	}
+}
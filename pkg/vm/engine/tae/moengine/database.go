@@ -20,15 +20,17 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 )
 
 var (
 	_ engine.Database = (*txnDatabase)(nil)
 )
 
-func newDatabase(h handle.Database) *txnDatabase {
+func newDatabase(h handle.Database, txn txnif.AsyncTxn) *txnDatabase {
 	return &txnDatabase{
 		handle: h,
+		txn:    txn,
 	}
 }
 
@@ -55,7 +57,7 @@ func (db *txnDatabase) Relation(_ context.Context, name string) (engine.Relation
 		rel = newSysRelation(h)
 		return rel, nil
 	}
-	rel = newRelation(h)
+	rel = newRelation(h, db.txn)
 	return rel, nil
 }
 
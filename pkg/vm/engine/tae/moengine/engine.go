@@ -18,6 +18,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
@@ -77,7 +78,7 @@ func (e *txnEngine) Database(ctx context.Context, name string, txnOp client.TxnO
 	if err != nil {
 		return nil, err
 	}
-	db := newDatabase(h)
+	db := newDatabase(h, txn)
 	return db, nil
 }
 
@@ -89,6 +90,19 @@ func (e *txnEngine) StartTxn(info []byte) (txn Txn, err error) {
 	return e.impl.StartTxn(info)
 }
 
+// Backup copies the storage into dir of fs. If base is not empty, it is the
+// dir of a prior backup in fs and only the files changed since are copied.
+func (e *txnEngine) Backup(ctx context.Context, fs fileservice.FileService, dir, base string) (*db.BackupManifest, error) {
+	var prior *db.BackupManifest
+	if base != "" {
+		var err error
+		if prior, err = db.ReadBackupManifest(ctx, fs, base); err != nil {
+			return nil, err
+		}
+	}
+	return e.impl.Backup(ctx, fs, dir, prior)
+}
+
 func (e *txnEngine) Hints() (h engine.Hints) {
 	h.CommitOrRollbackTimeout = time.Minute
 	return
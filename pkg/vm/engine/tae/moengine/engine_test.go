@@ -19,12 +19,14 @@ import (
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 
 	mobat "github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
@@ -616,3 +618,81 @@ func TestSysRelation(t *testing.T) {
 	checkSysTable(t, catalog.SystemTable_Columns_Name, dbase, txn, 3, schema)
 	assert.Nil(t, txn.Commit())
 }
+
+func TestRelationChanges(t *testing.T) {
+	ctx := context.TODO()
+	testutils.EnsureNoLeak(t)
+	tae := initDB(t, nil)
+	defer tae.Close()
+	e := NewEngine(tae)
+	schema := catalog.MockSchemaAll(4, 2)
+	bat := catalog.MockBatch(schema, 7)
+	defer bat.Close()
+	moBat := mobat.New(true, bat.Attrs)
+	moBat.Vecs = CopyToMoVectors(bat.Vecs)
+
+	getRelation := func() (Txn, engine.ChangesRelation) {
+		txn, err := e.StartTxn(nil)
+		assert.NoError(t, err)
+		dbase, err := e.Database(ctx, "db", TxnToTxnOperator(txn))
+		assert.NoError(t, err)
+		rel, err := dbase.Relation(ctx, schema.Name)
+		assert.NoError(t, err)
+		return txn, rel.(engine.ChangesRelation)
+	}
+
+	txn, err := e.StartTxn(nil)
+	assert.NoError(t, err)
+	txnOperator := TxnToTxnOperator(txn)
+	assert.NoError(t, e.Create(ctx, "db", txnOperator))
+	dbase, err := e.Database(ctx, "db", txnOperator)
+	assert.NoError(t, err)
+	defs, err := SchemaToDefs(schema)
+	assert.NoError(t, err)
+	assert.NoError(t, dbase.Create(ctx, schema.Name, defs))
+	assert.NoError(t, txn.Commit())
+
+	txn, rel := getRelation()
+	assert.NoError(t, rel.Write(ctx, BatchWindow(moBat, 0, 5)))
+	_, _, err = rel.Changes(ctx, timestamp.Timestamp{}, schema.Attrs())
+	assert.ErrorIs(t, err, engine.ErrChangesUnavailable)
+	assert.NoError(t, txn.Commit())
+
+	txn, rel = getRelation()
+	from := rel.SnapshotTS()
+	assert.NoError(t, txn.Commit())
+
+	txn, rel = getRelation()
+	assert.NoError(t, rel.Delete(ctx, BatchWindow(moBat, 1, 2).Vecs[2], schema.GetSingleSortKey().Name))
+	assert.NoError(t, rel.Write(ctx, BatchWindow(moBat, 5, 7)))
+	assert.NoError(t, rel.(*txnRelation).handle.UpdateByFilter(
+		handle.NewEQFilter(bat.Vecs[2].Get(3)), 3, int64(100)))
+	assert.NoError(t, txn.Commit())
+
+	txn, rel = getRelation()
+	inserted, deleted, err := rel.Changes(ctx, from, []string{schema.ColDefs[2].Name, schema.ColDefs[3].Name})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, vector.Length(inserted.Vecs[0]))
+	assert.Equal(t, 2, vector.Length(deleted.Vecs[0]))
+	assert.Equal(t, []int64{100}, inserted.Vecs[1].Col.([]int64)[:1])
+	assert.Equal(t, bat.Vecs[2].Get(1), deleted.Vecs[0].Col.([]int32)[0])
+	assert.NoError(t, txn.Commit())
+
+	// blocks without commits since the ts are skipped
+	txn, rel = getRelation()
+	from = rel.SnapshotTS()
+	assert.NoError(t, txn.Commit())
+	txn, rel = getRelation()
+	fromTS, toTS := types.TimestampToTS(from), rel.(*txnRelation).txn.GetStartTS()
+	blkIt := rel.(*txnRelation).handle.MakeBlockIt()
+	for ; blkIt.Valid(); blkIt.Next() {
+		changed, err := blockChanged(blkIt.GetBlock().GetMeta().(*catalog.BlockEntry), fromTS, toTS)
+		assert.NoError(t, err)
+		assert.False(t, changed)
+	}
+	inserted, deleted, err = rel.Changes(ctx, from, []string{schema.ColDefs[2].Name})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, vector.Length(inserted.Vecs[0]))
+	assert.Equal(t, 0, vector.Length(deleted.Vecs[0]))
+	assert.NoError(t, txn.Commit())
+}
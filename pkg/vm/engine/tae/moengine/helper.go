@@ -70,6 +70,18 @@ func SchemaToDefs(schema *catalog.Schema) (defs []engine.TableDef, err error) {
 			expr = nil
 		}
 
+		var generated *plan.GeneratedCol
+		if col.Generated != nil {
+			generated = &plan.GeneratedCol{
+				Expr:         &plan.Expr{},
+				OriginString: col.Generated.OriginString,
+				Stored:       col.Generated.Stored,
+			}
+			if err := generated.Expr.Unmarshal(col.Generated.Expr); err != nil {
+				return nil, err
+			}
+		}
+
 		def := &engine.AttributeDef{
 			Attr: engine.Attribute{
 				Name:    col.Name,
@@ -82,6 +94,8 @@ func SchemaToDefs(schema *catalog.Schema) (defs []engine.TableDef, err error) {
 					Expr:         expr,
 				},
 				AutoIncrement: col.IsAutoIncrement(),
+				EnumValues:    col.EnumValues,
+				Generated:     generated,
 			},
 		}
 		defs = append(defs, def)
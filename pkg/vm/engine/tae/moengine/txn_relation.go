@@ -26,14 +26,15 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 )
 
 var (
 	_ engine.Relation = (*txnRelation)(nil)
 )
 
-func newRelation(h handle.Relation) *txnRelation {
-	r := &txnRelation{}
+func newRelation(h handle.Relation, txn txnif.AsyncTxn) *txnRelation {
+	r := &txnRelation{txn: txn}
 	r.handle = h
 	r.nodes = append(r.nodes, engine.Node{
 		Addr: ADDR,
@@ -16,12 +16,16 @@ package moengine
 
 import (
 	"bytes"
+	"context"
 	"errors"
 
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 )
 
 var ErrReadOnly = errors.New("tae moengine: read only")
@@ -41,7 +45,13 @@ type TxnEngine interface {
 	StartTxn(info []byte) (txn Txn, err error)
 }
 
+// BackupEngine is an engine that can take an online backup of its storage.
+type BackupEngine interface {
+	Backup(ctx context.Context, fs fileservice.FileService, dir, base string) (*db.BackupManifest, error)
+}
+
 var _ TxnEngine = &txnEngine{}
+var _ BackupEngine = &txnEngine{}
 
 type txnEngine struct {
 	impl *db.DB
@@ -49,10 +59,12 @@ type txnEngine struct {
 
 type txnDatabase struct {
 	handle handle.Database
+	txn    txnif.AsyncTxn
 }
 
 type txnRelation struct {
 	baseRelation
+	txn txnif.AsyncTxn
 }
 
 type sysRelation struct {
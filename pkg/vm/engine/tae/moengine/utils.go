@@ -16,6 +16,7 @@ package moengine
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 	"strconv"
 
@@ -78,7 +79,7 @@ func MockVec(typ types.Type, rows int, offset int) *vector.Vector {
 			data = append(data, uint8(i+offset))
 		}
 		_ = vector.Append(vec, data)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		data := make([]uint16, 0)
 		for i := 0; i < rows; i++ {
 			data = append(data, uint16(i+offset))
@@ -90,7 +91,7 @@ func MockVec(typ types.Type, rows int, offset int) *vector.Vector {
 			data = append(data, uint32(i+offset))
 		}
 		_ = vector.Append(vec, data)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		data := make([]uint64, 0)
 		for i := 0; i < rows; i++ {
 			data = append(data, uint64(i+offset))
@@ -120,6 +121,12 @@ func MockVec(typ types.Type, rows int, offset int) *vector.Vector {
 			data = append(data, types.InitDecimal128(int64(i+offset)))
 		}
 		_ = vector.Append(vec, data)
+	case types.T_uuid:
+		data := make([]types.Uuid, rows)
+		for i := range data {
+			binary.BigEndian.PutUint64(data[i][8:], uint64(i+offset))
+		}
+		_ = vector.Append(vec, data)
 	case types.T_timestamp:
 		data := make([]types.Timestamp, 0)
 		for i := 0; i < rows; i++ {
@@ -138,7 +145,13 @@ func MockVec(typ types.Type, rows int, offset int) *vector.Vector {
 			data = append(data, types.Datetime(i+offset))
 		}
 		_ = vector.Append(vec, data)
-	case types.T_char, types.T_varchar, types.T_blob:
+	case types.T_time:
+		data := make([]types.Time, 0)
+		for i := 0; i < rows; i++ {
+			data = append(data, types.Time(i+offset))
+		}
+		_ = vector.Append(vec, data)
+	case types.T_char, types.T_varchar, types.T_blob, types.T_binary, types.T_varbinary:
 		data := make([][]byte, 0)
 		for i := 0; i < rows; i++ {
 			data = append(data, []byte(strconv.Itoa(i+offset)))
@@ -251,16 +264,18 @@ func AppendValue(vec *vector.Vector, v any) {
 		AppendFixedValue[int64](vec, v)
 	case types.T_uint8:
 		AppendFixedValue[uint8](vec, v)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		AppendFixedValue[uint16](vec, v)
 	case types.T_uint32:
 		AppendFixedValue[uint32](vec, v)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		AppendFixedValue[uint64](vec, v)
 	case types.T_decimal64:
 		AppendFixedValue[types.Decimal64](vec, v)
 	case types.T_decimal128:
 		AppendFixedValue[types.Decimal128](vec, v)
+	case types.T_uuid:
+		AppendFixedValue[types.Uuid](vec, v)
 	case types.T_float32:
 		AppendFixedValue[float32](vec, v)
 	case types.T_float64:
@@ -271,12 +286,14 @@ func AppendValue(vec *vector.Vector, v any) {
 		AppendFixedValue[types.Timestamp](vec, v)
 	case types.T_datetime:
 		AppendFixedValue[types.Datetime](vec, v)
-	case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+	case types.T_time:
+		AppendFixedValue[types.Time](vec, v)
+	case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		vvals := vec.Col.(*types.Bytes)
 		offset := len(vvals.Data)
 		var val []byte
 		if _, ok := v.(types.Null); ok {
-			nulls.Add(vec.Nsp, uint64(offset))
+			nulls.Add(vec.Nsp, uint64(len(vvals.Offsets)))
 		} else {
 			val = v.([]byte)
 		}
@@ -313,13 +330,13 @@ func GetValue(col *vector.Vector, row uint32) any {
 	case types.T_uint8:
 		data := vals.([]uint8)
 		return data[row]
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		data := vals.([]uint16)
 		return data[row]
 	case types.T_uint32:
 		data := vals.([]uint32)
 		return data[row]
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		data := vals.([]uint64)
 		return data[row]
 	case types.T_decimal64:
@@ -328,6 +345,9 @@ func GetValue(col *vector.Vector, row uint32) any {
 	case types.T_decimal128:
 		data := vals.([]types.Decimal128)
 		return data[row]
+	case types.T_uuid:
+		data := vals.([]types.Uuid)
+		return data[row]
 	case types.T_float32:
 		data := vals.([]float32)
 		return data[row]
@@ -340,10 +360,13 @@ func GetValue(col *vector.Vector, row uint32) any {
 	case types.T_datetime:
 		data := vals.([]types.Datetime)
 		return data[row]
+	case types.T_time:
+		data := vals.([]types.Time)
+		return data[row]
 	case types.T_timestamp:
 		data := vals.([]types.Timestamp)
 		return data[row]
-	case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+	case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		data := vals.(*types.Bytes)
 		s := data.Offsets[row]
 		e := data.Lengths[row]
@@ -369,16 +392,18 @@ func UpdateValue(col *vector.Vector, row uint32, val any) {
 		GenericUpdateFixedValue[int64](col, row, val)
 	case types.T_uint8:
 		GenericUpdateFixedValue[uint8](col, row, val)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		GenericUpdateFixedValue[uint16](col, row, val)
 	case types.T_uint32:
 		GenericUpdateFixedValue[uint32](col, row, val)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		GenericUpdateFixedValue[uint64](col, row, val)
 	case types.T_decimal64:
 		GenericUpdateFixedValue[types.Decimal64](col, row, val)
 	case types.T_decimal128:
 		GenericUpdateFixedValue[types.Decimal128](col, row, val)
+	case types.T_uuid:
+		GenericUpdateFixedValue[types.Uuid](col, row, val)
 	case types.T_float32:
 		GenericUpdateFixedValue[float32](col, row, val)
 	case types.T_float64:
@@ -387,9 +412,11 @@ func UpdateValue(col *vector.Vector, row uint32, val any) {
 		GenericUpdateFixedValue[types.Date](col, row, val)
 	case types.T_datetime:
 		GenericUpdateFixedValue[types.Datetime](col, row, val)
+	case types.T_time:
+		GenericUpdateFixedValue[types.Time](col, row, val)
 	case types.T_timestamp:
 		GenericUpdateFixedValue[types.Timestamp](col, row, val)
-	case types.T_varchar, types.T_char, types.T_json, types.T_blob:
+	case types.T_varchar, types.T_char, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		v := val.([]byte)
 		data := col.Col.(*types.Bytes)
 		tail := data.Data[data.Offsets[row]+data.Lengths[row]:]
@@ -512,7 +539,7 @@ func ApplyDeleteToVector(vec *vector.Vector, deletes *roaring.Bitmap) *vector.Ve
 	switch vec.Typ.Oid {
 	case types.T_bool, types.T_int8, types.T_int16, types.T_int32, types.T_int64,
 		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
-		types.T_decimal64, types.T_decimal128, types.T_float32, types.T_float64,
+		types.T_decimal64, types.T_decimal128, types.T_uuid, types.T_float32, types.T_float64,
 		types.T_date, types.T_datetime, types.T_timestamp:
 		vec.Col = compute.InplaceDeleteRows(vec.Col, deletesIterator)
 		deletesIterator = deletes.Iterator()
@@ -543,7 +570,7 @@ func ApplyDeleteToVector(vec *vector.Vector, deletes *roaring.Bitmap) *vector.Ve
 				np.Add(n - uint64(deleted))
 			}
 		}
-	case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+	case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		data := col.(*types.Bytes)
 		pre := -1
 		for deletesIterator.HasNext() {
@@ -608,13 +635,13 @@ func ApplyUpdateToVector(vec *vector.Vector, mask *roaring.Bitmap, vals map[uint
 	switch vec.Typ.Oid {
 	case types.T_bool, types.T_int8, types.T_int16, types.T_int32, types.T_int64,
 		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
-		types.T_decimal64, types.T_decimal128, types.T_float32, types.T_float64,
+		types.T_decimal64, types.T_decimal128, types.T_uuid, types.T_float32, types.T_float64,
 		types.T_date, types.T_datetime, types.T_timestamp:
 		for iterator.HasNext() {
 			row := iterator.Next()
 			UpdateValue(vec, row, vals[row])
 		}
-	case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+	case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		data := col.(*types.Bytes)
 		pre := -1
 		for iterator.HasNext() {
@@ -653,11 +680,11 @@ func MOToVector(v *vector.Vector, nullable bool) containers.Vector {
 		bs.Data = types.EncodeFixedSlice(v.Col.([]int64), 8)
 	case types.T_uint8:
 		bs.Data = types.EncodeFixedSlice(v.Col.([]uint8), 1)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		bs.Data = types.EncodeFixedSlice(v.Col.([]uint16), 2)
 	case types.T_uint32:
 		bs.Data = types.EncodeFixedSlice(v.Col.([]uint32), 4)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		bs.Data = types.EncodeFixedSlice(v.Col.([]uint64), 8)
 	case types.T_float32:
 		bs.Data = types.EncodeFixedSlice(v.Col.([]float32), 4)
@@ -667,13 +694,17 @@ func MOToVector(v *vector.Vector, nullable bool) containers.Vector {
 		bs.Data = types.EncodeFixedSlice(v.Col.([]types.Date), 4)
 	case types.T_datetime:
 		bs.Data = types.EncodeFixedSlice(v.Col.([]types.Datetime), 8)
+	case types.T_time:
+		bs.Data = types.EncodeFixedSlice(v.Col.([]types.Time), 8)
 	case types.T_timestamp:
 		bs.Data = types.EncodeFixedSlice(v.Col.([]types.Timestamp), 8)
 	case types.T_decimal64:
 		bs.Data = types.EncodeFixedSlice(v.Col.([]types.Decimal64), 8)
 	case types.T_decimal128:
 		bs.Data = types.EncodeFixedSlice(v.Col.([]types.Decimal128), 16)
-	case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+	case types.T_uuid:
+		bs.Data = types.EncodeFixedSlice(v.Col.([]types.Uuid), 16)
+	case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		vbs := v.Col.(*types.Bytes)
 		bs.Data = vbs.Data
 		bs.Offset = vbs.Offsets
@@ -744,7 +775,7 @@ func MOToVectorTmp(v *vector.Vector, nullable bool) containers.Vector {
 		} else {
 			bs.Data = types.EncodeFixedSlice(v.Col.([]uint8), 1)
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		if v.Col == nil || len(v.Col.([]uint16)) == 0 {
 			bs.Data = make([]byte, v.Length*2)
 			logutil.Warn("[Moengine]", common.OperationField("MOToVector"),
@@ -760,7 +791,7 @@ func MOToVectorTmp(v *vector.Vector, nullable bool) containers.Vector {
 		} else {
 			bs.Data = types.EncodeFixedSlice(v.Col.([]uint32), 4)
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		if v.Col == nil || len(v.Col.([]uint64)) == 0 {
 			bs.Data = make([]byte, v.Length*8)
 			logutil.Warn("[Moengine]", common.OperationField("MOToVector"),
@@ -800,6 +831,14 @@ func MOToVectorTmp(v *vector.Vector, nullable bool) containers.Vector {
 		} else {
 			bs.Data = types.EncodeFixedSlice(v.Col.([]types.Datetime), 8)
 		}
+	case types.T_time:
+		if v.Col == nil || len(v.Col.([]types.Time)) == 0 {
+			bs.Data = make([]byte, v.Length*8)
+			logutil.Warn("[Moengine]", common.OperationField("MOToVector"),
+				common.OperandField("Col length is 0"))
+		} else {
+			bs.Data = types.EncodeFixedSlice(v.Col.([]types.Time), 8)
+		}
 	case types.T_timestamp:
 		if v.Col == nil || len(v.Col.([]types.Timestamp)) == 0 {
 			bs.Data = make([]byte, v.Length*8)
@@ -824,7 +863,15 @@ func MOToVectorTmp(v *vector.Vector, nullable bool) containers.Vector {
 		} else {
 			bs.Data = types.EncodeFixedSlice(v.Col.([]types.Decimal128), 16)
 		}
-	case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+	case types.T_uuid:
+		if v.Col == nil || len(v.Col.([]types.Uuid)) == 0 {
+			bs.Data = make([]byte, v.Length*16)
+			logutil.Warn("[Moengine]", common.OperationField("MOToVector"),
+				common.OperandField("Col length is 0"))
+		} else {
+			bs.Data = types.EncodeFixedSlice(v.Col.([]types.Uuid), 16)
+		}
+	case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		if v.Col == nil {
 			bs.Data = make([]byte, 0)
 		} else {
@@ -862,7 +909,7 @@ func CopyToMoVector(vec containers.Vector) *vector.Vector {
 		_, _ = w.Write(types.EncodeFixed(uint32(0)))
 	}
 	switch vec.GetType().Oid {
-	case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+	case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		_, _ = w.Write(types.EncodeFixed(uint32(vec.Length())))
 		if vec.Length() > 0 {
 			bs := vec.Bytes()
@@ -904,11 +951,11 @@ func VectorsToMO(vec containers.Vector) *vector.Vector {
 		mov.Col = types.DecodeInt64Slice(data)
 	case types.T_uint8:
 		mov.Col = types.DecodeUint8Slice(data)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		mov.Col = types.DecodeUint16Slice(data)
 	case types.T_uint32:
 		mov.Col = types.DecodeUint32Slice(data)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		mov.Col = types.DecodeUint64Slice(data)
 	case types.T_float32:
 		mov.Col = types.DecodeFloat32Slice(data)
@@ -918,12 +965,16 @@ func VectorsToMO(vec containers.Vector) *vector.Vector {
 		mov.Col = types.DecodeDateSlice(data)
 	case types.T_datetime:
 		mov.Col = types.DecodeDatetimeSlice(data)
+	case types.T_time:
+		mov.Col = types.DecodeTimeSlice(data)
 	case types.T_timestamp:
 		mov.Col = types.DecodeTimestampSlice(data)
 	case types.T_decimal64:
 		mov.Col = types.DecodeDecimal64Slice(data)
 	case types.T_decimal128:
 		mov.Col = types.DecodeDecimal128Slice(data)
+	case types.T_uuid:
+		mov.Col = types.DecodeUuidSlice(data)
 	case types.T_tuple:
 		cnt := types.DecodeInt32(data)
 		if cnt == 0 {
@@ -932,7 +983,7 @@ func VectorsToMO(vec containers.Vector) *vector.Vector {
 		if err := types.Decode(data, &mov.Col); err != nil {
 			panic(any(err))
 		}
-	case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+	case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		Col := mov.Col.(*types.Bytes)
 		Col.Reset()
 		bs := vec.Bytes()
@@ -16,6 +16,7 @@ package jobs
 
 import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/util/fault"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
@@ -53,6 +54,9 @@ func NewFlushBlkTask(
 func (task *flushBlkTask) Scope() *common.ID { return task.meta.AsCommonID() }
 
 func (task *flushBlkTask) Execute() (err error) {
+	if _, err = fault.Inject(fault.TAEFlushBlock); err != nil {
+		return
+	}
 	if task.sortCol != nil {
 		if err = BuildAndFlushIndex(task.file, task.meta, task.sortCol); err != nil {
 			return
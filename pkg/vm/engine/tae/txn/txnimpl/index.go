@@ -82,7 +82,7 @@ func InsertOp[T comparable](input any, start, count int, fromRow uint32, dedupIn
 func (idx *simpleTableIndex) KeyToVector(kType types.Type) containers.Vector {
 	vec := containers.MakeVector(kType, false)
 	switch kType.Oid {
-	case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+	case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		for k := range idx.tree {
 			vec.Append([]byte(k.(string)))
 		}
@@ -160,16 +160,18 @@ func (idx *simpleTableIndex) BatchInsert(col containers.Vector, start, count int
 		return InsertOp[int64](col.Slice(), start, count, row, dedupInput, idx.tree)
 	case types.T_uint8:
 		return InsertOp[uint8](col.Slice(), start, count, row, dedupInput, idx.tree)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return InsertOp[uint16](col.Slice(), start, count, row, dedupInput, idx.tree)
 	case types.T_uint32:
 		return InsertOp[uint32](col.Slice(), start, count, row, dedupInput, idx.tree)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		return InsertOp[uint64](col.Slice(), start, count, row, dedupInput, idx.tree)
 	case types.T_decimal64:
 		return InsertOp[types.Decimal64](col.Slice(), start, count, row, dedupInput, idx.tree)
 	case types.T_decimal128:
 		return InsertOp[types.Decimal128](col.Slice(), start, count, row, dedupInput, idx.tree)
+	case types.T_uuid:
+		return InsertOp[types.Uuid](col.Slice(), start, count, row, dedupInput, idx.tree)
 	case types.T_float32:
 		return InsertOp[float32](col.Slice(), start, count, row, dedupInput, idx.tree)
 	case types.T_float64:
@@ -180,7 +182,9 @@ func (idx *simpleTableIndex) BatchInsert(col containers.Vector, start, count int
 		return InsertOp[types.Timestamp](col.Slice(), start, count, row, dedupInput, idx.tree)
 	case types.T_datetime:
 		return InsertOp[types.Datetime](col.Slice(), start, count, row, dedupInput, idx.tree)
-	case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+	case types.T_time:
+		return InsertOp[types.Time](col.Slice(), start, count, row, dedupInput, idx.tree)
+	case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		vs := col.Slice().(*containers.Bytes)
 		if dedupInput {
 			set := make(map[string]bool)
@@ -227,16 +231,18 @@ func (idx *simpleTableIndex) BatchDedup(col containers.Vector) error {
 		return DedupOp[int64](vals, idx.tree)
 	case types.T_uint8:
 		return DedupOp[uint8](vals, idx.tree)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return DedupOp[uint16](vals, idx.tree)
 	case types.T_uint32:
 		return DedupOp[uint32](vals, idx.tree)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		return DedupOp[uint64](vals, idx.tree)
 	case types.T_decimal64:
 		return DedupOp[types.Decimal64](vals, idx.tree)
 	case types.T_decimal128:
 		return DedupOp[types.Decimal128](vals, idx.tree)
+	case types.T_uuid:
+		return DedupOp[types.Uuid](vals, idx.tree)
 	case types.T_float32:
 		return DedupOp[float32](vals, idx.tree)
 	case types.T_float64:
@@ -245,9 +251,11 @@ func (idx *simpleTableIndex) BatchDedup(col containers.Vector) error {
 		return DedupOp[types.Date](vals, idx.tree)
 	case types.T_datetime:
 		return DedupOp[types.Datetime](vals, idx.tree)
+	case types.T_time:
+		return DedupOp[types.Time](vals, idx.tree)
 	case types.T_timestamp:
 		return DedupOp[types.Timestamp](vals, idx.tree)
-	case types.T_char, types.T_varchar, types.T_json, types.T_blob:
+	case types.T_char, types.T_varchar, types.T_json, types.T_blob, types.T_binary, types.T_varbinary:
 		vals := vals.(*containers.Bytes)
 		for i, s := range vals.Offset {
 			e := s + vals.Length[i]
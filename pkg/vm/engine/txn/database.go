@@ -48,7 +48,7 @@ func (d *Database) Create(ctx context.Context, relName string, defs []engine.Tab
 		},
 	)
 	if err != nil {
-		return nil
+		return err
 	}
 
 	return nil
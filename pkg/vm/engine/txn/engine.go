@@ -26,21 +26,37 @@ import (
 
 // Engine is an engine.Engine impl
 type Engine struct {
-	shardPolicy       ShardPolicy
-	getClusterDetails GetClusterDetailsFunc
+	shardPolicy             ShardPolicy
+	getClusterDetails       GetClusterDetailsFunc
+	commitOrRollbackTimeout time.Duration
 }
 
 type GetClusterDetailsFunc = func() (logservicepb.ClusterDetails, error)
 
+// Option engine option
+type Option func(*Engine)
+
+// WithCommitOrRollbackTimeout sets the timeout of committing or rolling back a txn
+func WithCommitOrRollbackTimeout(timeout time.Duration) Option {
+	return func(e *Engine) {
+		e.commitOrRollbackTimeout = timeout
+	}
+}
+
 func New(
 	ctx context.Context,
 	shardPolicy ShardPolicy,
 	getClusterDetails GetClusterDetailsFunc,
+	options ...Option,
 ) *Engine {
 
 	engine := &Engine{
-		shardPolicy:       shardPolicy,
-		getClusterDetails: getClusterDetails,
+		shardPolicy:             shardPolicy,
+		getClusterDetails:       getClusterDetails,
+		commitOrRollbackTimeout: time.Minute * 5,
+	}
+	for _, opt := range options {
+		opt(engine)
 	}
 
 	return engine
@@ -143,6 +159,9 @@ func (e *Engine) Nodes() (engine.Nodes, error) {
 
 	var nodes engine.Nodes
 	for _, store := range clusterDetails.CNStores {
+		if store.State != logservicepb.NormalState {
+			continue
+		}
 		nodes = append(nodes, engine.Node{
 			Mcpu: 1,
 			Id:   store.UUID,
@@ -154,6 +173,6 @@ func (e *Engine) Nodes() (engine.Nodes, error) {
 }
 
 func (e *Engine) Hints() (h engine.Hints) {
-	h.CommitOrRollbackTimeout = time.Minute * 5
+	h.CommitOrRollbackTimeout = e.commitOrRollbackTimeout
 	return
 }
@@ -44,6 +44,7 @@ const (
 	OpNewTableIter
 	OpRead
 	OpCloseTableIter
+	OpGetHiddenKeys
 )
 
 func init() {
@@ -220,6 +221,15 @@ type GetPrimaryKeysResp struct {
 	ErrTableNotFound ErrRelationNotFound
 }
 
+type GetHiddenKeysReq struct {
+	TableID string
+}
+
+type GetHiddenKeysResp struct {
+	Attrs            []*engine.Attribute
+	ErrTableNotFound ErrRelationNotFound
+}
+
 type GetTableDefsReq struct {
 	TableID string
 }
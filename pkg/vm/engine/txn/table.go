@@ -270,12 +270,27 @@ func (t *Table) Write(ctx context.Context, data *batch.Batch) error {
 	return nil
 }
 
-func (t *Table) GetHideKeys(ctx context.Context) (attrs []*engine.Attribute, err error) {
-	//TODO
-	return
+func (t *Table) GetHideKeys(ctx context.Context) ([]*engine.Attribute, error) {
+
+	resps, err := engine.DoTxnRequest[GetHiddenKeysResp](
+		ctx,
+		t.engine,
+		t.txnOperator.Read,
+		t.engine.firstNodeShard,
+		OpGetHiddenKeys,
+		GetHiddenKeysReq{
+			TableID: t.id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := resps[0]
+
+	return resp.Attrs, nil
 }
 
 func (t *Table) GetTableID(ctx context.Context) string {
-	//TODO
-	return "0"
+	return t.id
 }
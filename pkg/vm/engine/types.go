@@ -16,6 +16,7 @@ package engine
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/compress"
@@ -23,6 +24,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 )
@@ -56,6 +58,10 @@ type Attribute struct {
 	Comment string
 	// AutoIncrement is auto incr or not
 	AutoIncrement bool
+	// EnumValues is the value list of the enum and set type
+	EnumValues []string
+	// Generated is the generation expression of a generated column
+	Generated *plan.GeneratedCol
 }
 
 type PrimaryIndexDef struct {
@@ -152,6 +158,23 @@ type Relation interface {
 	NewReader(context.Context, int, *plan.Expr, [][]byte) ([]Reader, error)
 }
 
+// ErrChangesUnavailable is returned by ChangesRelation if the changes since
+// the ts can not be collected anymore, e.g. the blocks have been compacted.
+var ErrChangesUnavailable = errors.New("engine: changes unavailable")
+
+// ChangesRelation is implemented by relations which can collect the rows
+// changed by the transactions committed since a ts.
+type ChangesRelation interface {
+	Relation
+
+	// SnapshotTS returns the ts of the data read by the relation
+	SnapshotTS() timestamp.Timestamp
+
+	// Changes returns the rows inserted and deleted after the ts until the
+	// snapshot ts, an updated row is both deleted and inserted.
+	Changes(ctx context.Context, from timestamp.Timestamp, attrs []string) (inserted, deleted *batch.Batch, err error)
+}
+
 type Reader interface {
 	Close() error
 	Read([]string, *plan.Expr, *mheap.Mheap) (*batch.Batch, error)
@@ -16,6 +16,7 @@ package process
 
 import (
 	"context"
+	"sync/atomic"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
@@ -28,7 +29,8 @@ import (
 // A process stores the execution context.
 func New(m *mheap.Mheap) *Process {
 	return &Process{
-		Mp: m,
+		Mp:       m,
+		warnings: new(uint64),
 	}
 }
 
@@ -50,6 +52,7 @@ func NewFromProc(p *Process, ctx context.Context, regNumber int) *Process {
 	proc.AnalInfos = p.AnalInfos
 	proc.SessionInfo = p.SessionInfo
 	proc.FileService = p.FileService
+	proc.warnings = p.warnings
 
 	// reg and cancel
 	proc.Ctx = newctx
@@ -88,6 +91,21 @@ func (proc *Process) SetQueryId(id string) {
 	proc.Id = id
 }
 
+// AddWarnings counts n rows skipped by the statement
+func (proc *Process) AddWarnings(n uint64) {
+	if proc.warnings != nil {
+		atomic.AddUint64(proc.warnings, n)
+	}
+}
+
+// TakeWarnings returns the number of rows skipped and resets it for the next statement
+func (proc *Process) TakeWarnings() uint64 {
+	if proc.warnings == nil {
+		return 0
+	}
+	return atomic.SwapUint64(proc.warnings, 0)
+}
+
 func (proc *Process) GetMheap() *mheap.Mheap {
 	return proc.Mp
 }
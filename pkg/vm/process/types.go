@@ -74,6 +74,23 @@ type SessionInfo struct {
 	Database     string
 	Version      string
 	TimeZone     *time.Location
+	// BlockEncryptionMode is the block_encryption_mode used by aes_encrypt and aes_decrypt
+	BlockEncryptionMode string
+	// Sequences serves nextval, setval, currval and lastval, it is nil if the
+	// process doesn't belong to a session
+	Sequences Sequences
+}
+
+// Sequences is the sequence state of a session
+type Sequences interface {
+	// NextVal advances the sequence and returns its new value
+	NextVal(dbName, name string) (int64, error)
+	// SetVal sets the last value of the sequence
+	SetVal(dbName, name string, value int64, isCalled bool) error
+	// CurrVal returns the value most recently returned by NextVal for the sequence
+	CurrVal(dbName, name string) (int64, error)
+	// LastVal returns the value most recently returned by NextVal
+	LastVal() (int64, error)
 }
 
 // AnalyzeInfo  analyze information for query
@@ -118,6 +135,10 @@ type Process struct {
 	Cancel context.CancelFunc
 
 	FileService fileservice.FileService
+
+	// warnings counts the rows skipped by the statement, it is shared by
+	// the processes created from this one in the same CN.
+	warnings *uint64
 }
 
 type analyze struct {